@@ -31,6 +31,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteTaskListTasks(
+		ctx context.Context,
+		Request *shared.DeleteTaskListTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DeleteTaskListTasksResponse, error)
+
 	DeleteWorkflow(
 		ctx context.Context,
 		Request *admin.AdminDeleteWorkflowRequest,
@@ -126,6 +132,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListTaskListTasks(
+		ctx context.Context,
+		Request *shared.ListTaskListTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListTaskListTasksResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -138,6 +150,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		Request *shared.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.PauseActivityResponse, error)
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -192,6 +210,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	UnpauseActivity(
+		ctx context.Context,
+		Request *shared.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UnpauseActivityResponse, error)
+
 	UpdateDomainAsyncWorkflowConfiguraton(
 		ctx context.Context,
 		Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
@@ -302,6 +326,34 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteTaskListTasks(
+	ctx context.Context,
+	_Request *shared.DeleteTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DeleteTaskListTasksResponse, err error) {
+
+	var result admin.AdminService_DeleteTaskListTasks_Result
+	args := admin.AdminService_DeleteTaskListTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DeleteTaskListTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeleteWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminDeleteWorkflowRequest,
@@ -749,6 +801,34 @@ func (c client) ListDynamicConfig(
 	return
 }
 
+func (c client) ListTaskListTasks(
+	ctx context.Context,
+	_Request *shared.ListTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListTaskListTasksResponse, err error) {
+
+	var result admin.AdminService_ListTaskListTasks_Result
+	args := admin.AdminService_ListTaskListTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListTaskListTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MaintainCorruptWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminMaintainWorkflowRequest,
@@ -805,6 +885,34 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) PauseActivity(
+	ctx context.Context,
+	_Request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.PauseActivityResponse, err error) {
+
+	var result admin.AdminService_PauseActivity_Result
+	args := admin.AdminService_PauseActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
@@ -1057,6 +1165,34 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_Request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UnpauseActivityResponse, err error) {
+
+	var result admin.AdminService_UnpauseActivity_Result
+	args := admin.AdminService_UnpauseActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomainAsyncWorkflowConfiguraton(
 	ctx context.Context,
 	_Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
@@ -76,6 +76,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.GetSearchAttributesResponse, error)
 
+	GetTaskListBuildIDCompatibility(
+		ctx context.Context,
+		Request *shared.GetTaskListBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetTaskListBuildIDCompatibilityResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -292,11 +298,29 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	UpdateActivityOptions(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateActivityOptionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateActivityOptionsResponse, error)
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateTaskListBuildIDCompatibility(
+		ctx context.Context,
+		Request *shared.UpdateTaskListBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateTaskListBuildIDCompatibilityResponse, error)
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -606,6 +630,34 @@ func (c client) GetSearchAttributes(
 	return
 }
 
+func (c client) GetTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.GetTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetTaskListBuildIDCompatibilityResponse, err error) {
+
+	var result cadence.WorkflowService_GetTaskListBuildIDCompatibility_Result
+	args := cadence.WorkflowService_GetTaskListBuildIDCompatibility_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetTaskListBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetTaskListsByDomain(
 	ctx context.Context,
 	_Request *shared.GetTaskListsByDomainRequest,
@@ -1614,6 +1666,34 @@ func (c client) TerminateWorkflowExecution(
 	return
 }
 
+func (c client) UpdateActivityOptions(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateActivityOptionsResponse, err error) {
+
+	var result cadence.WorkflowService_UpdateActivityOptions_Result
+	args := cadence.WorkflowService_UpdateActivityOptions_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_UpdateActivityOptions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomain(
 	ctx context.Context,
 	_UpdateRequest *shared.UpdateDomainRequest,
@@ -1641,3 +1721,59 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.UpdateTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+
+	var result cadence.WorkflowService_UpdateTaskListBuildIDCompatibility_Result
+	args := cadence.WorkflowService_UpdateTaskListBuildIDCompatibility_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_UpdateTaskListBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	var result cadence.WorkflowService_UpdateWorkflowExecution_Result
+	args := cadence.WorkflowService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
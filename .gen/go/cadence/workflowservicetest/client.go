@@ -366,6 +366,39 @@ func (mr *_MockClientRecorder) GetSearchAttributes(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetSearchAttributes", args...)
 }
 
+// GetTaskListBuildIDCompatibility responds to a GetTaskListBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), ...).Return(...)
+//	... := client.GetTaskListBuildIDCompatibility(...)
+func (m *MockClient) GetTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.GetTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetTaskListBuildIDCompatibilityResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetTaskListBuildIDCompatibility", args...)
+	success, _ = ret[i].(*shared.GetTaskListBuildIDCompatibilityResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetTaskListBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListBuildIDCompatibility", args...)
+}
+
 // GetTaskListsByDomain responds to a GetTaskListsByDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1528,6 +1561,39 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
 }
 
+// UpdateActivityOptions responds to a UpdateActivityOptions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateActivityOptions(gomock.Any(), ...).Return(...)
+//	... := client.UpdateActivityOptions(...)
+func (m *MockClient) UpdateActivityOptions(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateActivityOptionsResponse, err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", args...)
+	success, _ = ret[i].(*shared.UpdateActivityOptionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateActivityOptions(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateActivityOptions", args...)
+}
+
 // UpdateDomain responds to a UpdateDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1560,3 +1626,69 @@ func (mr *_MockClientRecorder) UpdateDomain(
 	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDomain", args...)
 }
+
+// UpdateTaskListBuildIDCompatibility responds to a UpdateTaskListBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), ...).Return(...)
+//	... := client.UpdateTaskListBuildIDCompatibility(...)
+func (m *MockClient) UpdateTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.UpdateTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListBuildIDCompatibility", args...)
+	success, _ = ret[i].(*shared.UpdateTaskListBuildIDCompatibilityResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListBuildIDCompatibility", args...)
+}
+
+// UpdateWorkflowExecution responds to a UpdateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.UpdateWorkflowExecution(...)
+func (m *MockClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", args...)
+	success, _ = ret[i].(*shared.UpdateWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkflowExecution(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkflowExecution", args...)
+}
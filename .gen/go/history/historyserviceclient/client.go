@@ -91,6 +91,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	PauseActivity(
+		ctx context.Context,
+		Request *history.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.PauseActivityResponse, error)
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -276,6 +282,24 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		Request *history.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UnpauseActivityResponse, error)
+
+	UpdateActivityOptions(
+		ctx context.Context,
+		UpdateRequest *history.UpdateActivityOptionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateActivityOptionsResponse, error)
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the HistoryService service.
@@ -643,6 +667,34 @@ func (c client) NotifyFailoverMarkers(
 	return
 }
 
+func (c client) PauseActivity(
+	ctx context.Context,
+	_Request *history.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.PauseActivityResponse, err error) {
+
+	var result history.HistoryService_PauseActivity_Result
+	args := history.HistoryService_PauseActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollMutableState(
 	ctx context.Context,
 	_PollRequest *history.PollMutableStateRequest,
@@ -1510,3 +1562,87 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_Request *history.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UnpauseActivityResponse, err error) {
+
+	var result history.HistoryService_UnpauseActivity_Result
+	args := history.HistoryService_UnpauseActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateActivityOptions(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateActivityOptionsResponse, err error) {
+
+	var result history.HistoryService_UpdateActivityOptions_Result
+	args := history.HistoryService_UpdateActivityOptions_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_UpdateActivityOptions_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	var result history.HistoryService_UpdateWorkflowExecution_Result
+	args := history.HistoryService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
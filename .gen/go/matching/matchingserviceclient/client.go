@@ -36,12 +36,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteTaskListTasks(
+		ctx context.Context,
+		Request *matching.DeleteTaskListTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DeleteTaskListTasksResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *matching.DescribeTaskListRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	GetTaskListBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.GetTaskListBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetTaskListBuildIDCompatibilityResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -54,6 +66,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	ListTaskListTasks(
+		ctx context.Context,
+		Request *matching.ListTaskListTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListTaskListTasksResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -77,6 +95,12 @@ type Interface interface {
 		Request *matching.RespondQueryTaskCompletedRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateTaskListBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.UpdateTaskListBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateTaskListBuildIDCompatibilityResponse, error)
 }
 
 // New builds a new client for the MatchingService service.
@@ -192,6 +216,34 @@ func (c client) CancelOutstandingPoll(
 	return
 }
 
+func (c client) DeleteTaskListTasks(
+	ctx context.Context,
+	_Request *matching.DeleteTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DeleteTaskListTasksResponse, err error) {
+
+	var result matching.MatchingService_DeleteTaskListTasks_Result
+	args := matching.MatchingService_DeleteTaskListTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_DeleteTaskListTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeTaskList(
 	ctx context.Context,
 	_Request *matching.DescribeTaskListRequest,
@@ -220,6 +272,34 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) GetTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.GetTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetTaskListBuildIDCompatibilityResponse, err error) {
+
+	var result matching.MatchingService_GetTaskListBuildIDCompatibility_Result
+	args := matching.MatchingService_GetTaskListBuildIDCompatibility_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_GetTaskListBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetTaskListsByDomain(
 	ctx context.Context,
 	_Request *shared.GetTaskListsByDomainRequest,
@@ -276,6 +356,34 @@ func (c client) ListTaskListPartitions(
 	return
 }
 
+func (c client) ListTaskListTasks(
+	ctx context.Context,
+	_Request *matching.ListTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListTaskListTasksResponse, err error) {
+
+	var result matching.MatchingService_ListTaskListTasks_Result
+	args := matching.MatchingService_ListTaskListTasks_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_ListTaskListTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
@@ -387,3 +495,31 @@ func (c client) RespondQueryTaskCompleted(
 	err = matching.MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+
+	var result matching.MatchingService_UpdateTaskListBuildIDCompatibility_Result
+	args := matching.MatchingService_UpdateTaskListBuildIDCompatibility_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_UpdateTaskListBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
@@ -33,11 +33,21 @@ type Interface interface {
 		Request *matching.CancelOutstandingPollRequest,
 	) error
 
+	DeleteTaskListTasks(
+		ctx context.Context,
+		Request *matching.DeleteTaskListTasksRequest,
+	) (*shared.DeleteTaskListTasksResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *matching.DescribeTaskListRequest,
 	) (*shared.DescribeTaskListResponse, error)
 
+	GetTaskListBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.GetTaskListBuildIDCompatibilityRequest,
+	) (*shared.GetTaskListBuildIDCompatibilityResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -48,6 +58,11 @@ type Interface interface {
 		Request *matching.ListTaskListPartitionsRequest,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	ListTaskListTasks(
+		ctx context.Context,
+		Request *matching.ListTaskListTasksRequest,
+	) (*shared.ListTaskListTasksResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -67,6 +82,11 @@ type Interface interface {
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
 	) error
+
+	UpdateTaskListBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.UpdateTaskListBuildIDCompatibilityRequest,
+	) (*shared.UpdateTaskListBuildIDCompatibilityResponse, error)
 }
 
 // New prepares an implementation of the MatchingService service for
@@ -116,6 +136,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteTaskListTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DeleteTaskListTasks),
+					NoWire: deletetasklisttasks_NoWireHandler{impl},
+				},
+				Signature:    "DeleteTaskListTasks(Request *matching.DeleteTaskListTasksRequest) (*shared.DeleteTaskListTasksResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -128,6 +160,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetTaskListBuildIDCompatibility",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetTaskListBuildIDCompatibility),
+					NoWire: gettasklistbuildidcompatibility_NoWireHandler{impl},
+				},
+				Signature:    "GetTaskListBuildIDCompatibility(Request *matching.GetTaskListBuildIDCompatibilityRequest) (*shared.GetTaskListBuildIDCompatibilityResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetTaskListsByDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -152,6 +196,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListTaskListTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListTaskListTasks),
+					NoWire: listtasklisttasks_NoWireHandler{impl},
+				},
+				Signature:    "ListTaskListTasks(Request *matching.ListTaskListTasksRequest) (*shared.ListTaskListTasksResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -199,10 +255,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RespondQueryTaskCompleted(Request *matching.RespondQueryTaskCompletedRequest)",
 				ThriftModule: matching.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateTaskListBuildIDCompatibility",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateTaskListBuildIDCompatibility),
+					NoWire: updatetasklistbuildidcompatibility_NoWireHandler{impl},
+				},
+				Signature:    "UpdateTaskListBuildIDCompatibility(Request *matching.UpdateTaskListBuildIDCompatibilityRequest) (*shared.UpdateTaskListBuildIDCompatibilityResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 10)
+	procedures := make([]transport.Procedure, 0, 14)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -303,6 +371,36 @@ func (h handler) CancelOutstandingPoll(ctx context.Context, body wire.Value) (th
 	return response, err
 }
 
+func (h handler) DeleteTaskListTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_DeleteTaskListTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'DeleteTaskListTasks': %w", err)
+	}
+
+	success, appErr := h.impl.DeleteTaskListTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_DeleteTaskListTasks_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_DescribeTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -333,6 +431,36 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) GetTaskListBuildIDCompatibility(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_GetTaskListBuildIDCompatibility_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'GetTaskListBuildIDCompatibility': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_GetTaskListBuildIDCompatibility_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_GetTaskListsByDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -393,6 +521,36 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) ListTaskListTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_ListTaskListTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'ListTaskListTasks': %w", err)
+	}
+
+	success, appErr := h.impl.ListTaskListTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_ListTaskListTasks_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -513,6 +671,36 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) UpdateTaskListBuildIDCompatibility(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_UpdateTaskListBuildIDCompatibility_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'UpdateTaskListBuildIDCompatibility': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_UpdateTaskListBuildIDCompatibility_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 type addactivitytask_NoWireHandler struct{ impl Interface }
 
 func (h addactivitytask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -624,6 +812,43 @@ func (h canceloutstandingpoll_NoWireHandler) HandleNoWire(ctx context.Context, n
 
 }
 
+type deletetasklisttasks_NoWireHandler struct{ impl Interface }
+
+func (h deletetasklisttasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_DeleteTaskListTasks_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'DeleteTaskListTasks': %w", err)
+	}
+
+	success, appErr := h.impl.DeleteTaskListTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_DeleteTaskListTasks_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describetasklist_NoWireHandler struct{ impl Interface }
 
 func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -661,6 +886,43 @@ func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type gettasklistbuildidcompatibility_NoWireHandler struct{ impl Interface }
+
+func (h gettasklistbuildidcompatibility_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_GetTaskListBuildIDCompatibility_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'GetTaskListBuildIDCompatibility': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_GetTaskListBuildIDCompatibility_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type gettasklistsbydomain_NoWireHandler struct{ impl Interface }
 
 func (h gettasklistsbydomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -735,6 +997,43 @@ func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type listtasklisttasks_NoWireHandler struct{ impl Interface }
+
+func (h listtasklisttasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_ListTaskListTasks_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'ListTaskListTasks': %w", err)
+	}
+
+	success, appErr := h.impl.ListTaskListTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_ListTaskListTasks_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type pollforactivitytask_NoWireHandler struct{ impl Interface }
 
 func (h pollforactivitytask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -882,3 +1181,40 @@ func (h respondquerytaskcompleted_NoWireHandler) HandleNoWire(ctx context.Contex
 	return response, err
 
 }
+
+type updatetasklistbuildidcompatibility_NoWireHandler struct{ impl Interface }
+
+func (h updatetasklistbuildidcompatibility_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_UpdateTaskListBuildIDCompatibility_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'UpdateTaskListBuildIDCompatibility': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_UpdateTaskListBuildIDCompatibility_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
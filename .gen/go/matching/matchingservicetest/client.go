@@ -138,6 +138,39 @@ func (mr *_MockClientRecorder) CancelOutstandingPoll(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CancelOutstandingPoll", args...)
 }
 
+// DeleteTaskListTasks responds to a DeleteTaskListTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteTaskListTasks(gomock.Any(), ...).Return(...)
+//	... := client.DeleteTaskListTasks(...)
+func (m *MockClient) DeleteTaskListTasks(
+	ctx context.Context,
+	_Request *matching.DeleteTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DeleteTaskListTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteTaskListTasks", args...)
+	success, _ = ret[i].(*shared.DeleteTaskListTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteTaskListTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteTaskListTasks", args...)
+}
+
 // DescribeTaskList responds to a DescribeTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -171,6 +204,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// GetTaskListBuildIDCompatibility responds to a GetTaskListBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), ...).Return(...)
+//	... := client.GetTaskListBuildIDCompatibility(...)
+func (m *MockClient) GetTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.GetTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetTaskListBuildIDCompatibilityResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetTaskListBuildIDCompatibility", args...)
+	success, _ = ret[i].(*shared.GetTaskListBuildIDCompatibilityResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetTaskListBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListBuildIDCompatibility", args...)
+}
+
 // GetTaskListsByDomain responds to a GetTaskListsByDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -237,6 +303,39 @@ func (mr *_MockClientRecorder) ListTaskListPartitions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListPartitions", args...)
 }
 
+// ListTaskListTasks responds to a ListTaskListTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListTaskListTasks(gomock.Any(), ...).Return(...)
+//	... := client.ListTaskListTasks(...)
+func (m *MockClient) ListTaskListTasks(
+	ctx context.Context,
+	_Request *matching.ListTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListTaskListTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListTaskListTasks", args...)
+	success, _ = ret[i].(*shared.ListTaskListTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListTaskListTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListTasks", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -366,3 +465,36 @@ func (mr *_MockClientRecorder) RespondQueryTaskCompleted(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondQueryTaskCompleted", args...)
 }
+
+// UpdateTaskListBuildIDCompatibility responds to a UpdateTaskListBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), ...).Return(...)
+//	... := client.UpdateTaskListBuildIDCompatibility(...)
+func (m *MockClient) UpdateTaskListBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListBuildIDCompatibility", args...)
+	success, _ = ret[i].(*shared.UpdateTaskListBuildIDCompatibilityResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListBuildIDCompatibility", args...)
+}
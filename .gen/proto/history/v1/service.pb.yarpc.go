@@ -66,6 +66,10 @@ type HistoryAPIYARPCClient interface {
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest, ...yarpc.CallOption) (*GetFailoverInfoResponse, error)
 	RatelimitUpdate(context.Context, *RatelimitUpdateRequest, ...yarpc.CallOption) (*RatelimitUpdateResponse, error)
+	PauseActivity(context.Context, *PauseActivityRequest, ...yarpc.CallOption) (*PauseActivityResponse, error)
+	UnpauseActivity(context.Context, *UnpauseActivityRequest, ...yarpc.CallOption) (*UnpauseActivityResponse, error)
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest, ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
 }
 
 func newHistoryAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) HistoryAPIYARPCClient {
@@ -130,6 +134,10 @@ type HistoryAPIYARPCServer interface {
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest) (*GetFailoverInfoResponse, error)
 	RatelimitUpdate(context.Context, *RatelimitUpdateRequest) (*RatelimitUpdateResponse, error)
+	PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error)
+	UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error)
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
 }
 
 type buildHistoryAPIYARPCProceduresParams struct {
@@ -583,6 +591,46 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "PauseActivity",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PauseActivity,
+							NewRequest:  newHistoryAPIServicePauseActivityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UnpauseActivity",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UnpauseActivity,
+							NewRequest:  newHistoryAPIServiceUnpauseActivityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateActivityOptions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateActivityOptions,
+							NewRequest:  newHistoryAPIServiceUpdateActivityOptionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -1229,6 +1277,54 @@ func (c *_HistoryAPIYARPCCaller) RatelimitUpdate(ctx context.Context, request *R
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) PauseActivity(ctx context.Context, request *PauseActivityRequest, options ...yarpc.CallOption) (*PauseActivityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseActivity", request, newHistoryAPIServicePauseActivityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseActivityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServicePauseActivityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UnpauseActivity(ctx context.Context, request *UnpauseActivityRequest, options ...yarpc.CallOption) (*UnpauseActivityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UnpauseActivity", request, newHistoryAPIServiceUnpauseActivityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UnpauseActivityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseActivityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UpdateActivityOptions(ctx context.Context, request *UpdateActivityOptionsRequest, options ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateActivityOptions", request, newHistoryAPIServiceUpdateActivityOptionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateActivityOptionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateActivityOptionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest, options ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateWorkflowExecution", request, newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _HistoryAPIYARPCHandler struct {
 	server HistoryAPIYARPCServer
 }
@@ -1937,6 +2033,70 @@ func (h *_HistoryAPIYARPCHandler) RatelimitUpdate(ctx context.Context, requestMe
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) PauseActivity(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseActivityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseActivityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServicePauseActivityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseActivity(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UnpauseActivity(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UnpauseActivityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UnpauseActivityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseActivityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UnpauseActivity(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UpdateActivityOptions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateActivityOptionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateActivityOptionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateActivityOptionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateActivityOptions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UpdateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newHistoryAPIServiceStartWorkflowExecutionYARPCRequest() proto.Message {
 	return &StartWorkflowExecutionRequest{}
 }
@@ -2289,6 +2449,38 @@ func newHistoryAPIServiceRatelimitUpdateYARPCResponse() proto.Message {
 	return &RatelimitUpdateResponse{}
 }
 
+func newHistoryAPIServicePauseActivityYARPCRequest() proto.Message {
+	return &PauseActivityRequest{}
+}
+
+func newHistoryAPIServicePauseActivityYARPCResponse() proto.Message {
+	return &PauseActivityResponse{}
+}
+
+func newHistoryAPIServiceUnpauseActivityYARPCRequest() proto.Message {
+	return &UnpauseActivityRequest{}
+}
+
+func newHistoryAPIServiceUnpauseActivityYARPCResponse() proto.Message {
+	return &UnpauseActivityResponse{}
+}
+
+func newHistoryAPIServiceUpdateActivityOptionsYARPCRequest() proto.Message {
+	return &UpdateActivityOptionsRequest{}
+}
+
+func newHistoryAPIServiceUpdateActivityOptionsYARPCResponse() proto.Message {
+	return &UpdateActivityOptionsResponse{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest() proto.Message {
+	return &UpdateWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse() proto.Message {
+	return &UpdateWorkflowExecutionResponse{}
+}
+
 var (
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCRequest             = &StartWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCResponse            = &StartWorkflowExecutionResponse{}
@@ -2378,322 +2570,360 @@ var (
 	emptyHistoryAPIServiceGetFailoverInfoYARPCResponse                   = &GetFailoverInfoResponse{}
 	emptyHistoryAPIServiceRatelimitUpdateYARPCRequest                    = &RatelimitUpdateRequest{}
 	emptyHistoryAPIServiceRatelimitUpdateYARPCResponse                   = &RatelimitUpdateResponse{}
+	emptyHistoryAPIServicePauseActivityYARPCRequest                      = &PauseActivityRequest{}
+	emptyHistoryAPIServicePauseActivityYARPCResponse                     = &PauseActivityResponse{}
+	emptyHistoryAPIServiceUnpauseActivityYARPCRequest                    = &UnpauseActivityRequest{}
+	emptyHistoryAPIServiceUnpauseActivityYARPCResponse                   = &UnpauseActivityResponse{}
+	emptyHistoryAPIServiceUpdateActivityOptionsYARPCRequest              = &UpdateActivityOptionsRequest{}
+	emptyHistoryAPIServiceUpdateActivityOptionsYARPCResponse             = &UpdateActivityOptionsResponse{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest            = &UpdateWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse           = &UpdateWorkflowExecutionResponse{}
 )
 
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
-		0x76, 0xe8, 0x19, 0xf1, 0xf7, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0xbd, 0x96,
-		0x44, 0xcb, 0xeb, 0xa1, 0x45, 0xd9, 0xfa, 0x59, 0x5e, 0xad, 0x44, 0x4a, 0xf2, 0x38, 0xfa, 0x36,
-		0x69, 0x39, 0x5f, 0xf7, 0x36, 0xa7, 0x6b, 0xc8, 0x8e, 0x7a, 0xba, 0x47, 0xdd, 0x3d, 0xa4, 0xe8,
-		0x43, 0xe0, 0xc4, 0x41, 0x80, 0x2c, 0x82, 0xec, 0x66, 0x91, 0x04, 0x01, 0x02, 0x04, 0x08, 0x36,
-		0xc0, 0x62, 0x8d, 0xdc, 0x12, 0x20, 0x87, 0x24, 0xa7, 0x5c, 0x72, 0xcc, 0x35, 0xf7, 0xdd, 0x43,
-		0x02, 0xe4, 0xb6, 0xe7, 0x20, 0xa8, 0x4f, 0xf7, 0xf4, 0xa7, 0xba, 0x7a, 0x86, 0x0c, 0x22, 0xaf,
-		0xe3, 0xdb, 0x74, 0x55, 0xbd, 0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0xfa, 0xfd, 0x7a, 0xe0, 0x7c, 0x77,
-		0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b, 0x7e, 0xe0, 0x7a, 0x47, 0xeb,
-		0x07, 0x97, 0xd7, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78, 0x6e, 0xe0, 0xa2, 0x05, 0xb2,
-		0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xe5, 0xda, 0xf2, 0x9e, 0xeb, 0xee, 0xd9, 0x78,
-		0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96, 0xeb, 0x30, 0xc0, 0xda, 0xb9,
-		0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90, 0x41, 0x70, 0xe8, 0x19, 0x9d,
-		0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84, 0xb8, 0xa6, 0xdb, 0x6e, 0x47,
-		0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x5e, 0x76, 0x71, 0xb4, 0x40, 0x15,
-		0x2d, 0x08, 0x0c, 0xff, 0x85, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1, 0xeb, 0xbd, 0x68, 0xd9, 0xee,
-		0x21, 0x5f, 0x73, 0x49, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d, 0x2b, 0x5a, 0x8b, 0x3d, 0xbe,
-		0xf2, 0x5b, 0xc9, 0x95, 0x66, 0xdb, 0x72, 0x28, 0x17, 0xec, 0xae, 0x1f, 0x14, 0x2d, 0x4a, 0x32,
-		0x62, 0x55, 0xbc, 0xe8, 0x65, 0x17, 0x77, 0xf9, 0x55, 0xd7, 0x2e, 0x8a, 0x97, 0x78, 0xb8, 0x63,
-		0x5b, 0xcd, 0xf8, 0xd5, 0x26, 0x6f, 0xc6, 0xdf, 0x37, 0x3c, 0x6c, 0x92, 0x95, 0x86, 0x13, 0xee,
-		0xf6, 0x46, 0xce, 0x8a, 0x24, 0x4d, 0xe7, 0x73, 0x56, 0x25, 0xd9, 0xa5, 0xfe, 0x6c, 0x18, 0xce,
-		0x6e, 0x07, 0x86, 0x17, 0x7c, 0xc2, 0xc7, 0xef, 0xbd, 0xc2, 0xcd, 0x2e, 0xa1, 0x47, 0xc3, 0x2f,
-		0xbb, 0xd8, 0x0f, 0xd0, 0x43, 0x18, 0xf1, 0xd8, 0xcf, 0xaa, 0xb2, 0xa2, 0xac, 0x8d, 0x6f, 0x6c,
-		0xd4, 0x13, 0x62, 0x6b, 0x74, 0xac, 0xfa, 0xc1, 0xe5, 0xba, 0x14, 0x89, 0x16, 0xa2, 0x40, 0x4b,
-		0x30, 0x66, 0xba, 0x6d, 0xc3, 0x72, 0x74, 0xcb, 0xac, 0x96, 0x56, 0x94, 0xb5, 0x31, 0x6d, 0x94,
-		0x0d, 0x34, 0x4c, 0xf4, 0x9b, 0x30, 0xd7, 0x31, 0x3c, 0xec, 0x04, 0x3a, 0x0e, 0x11, 0xe8, 0x96,
-		0xd3, 0x72, 0xab, 0x65, 0xba, 0xf1, 0x9a, 0x70, 0xe3, 0xa7, 0x14, 0x22, 0xda, 0xb1, 0xe1, 0xb4,
-		0x5c, 0xed, 0x74, 0x27, 0x3b, 0x88, 0xaa, 0x30, 0x62, 0x04, 0x01, 0x6e, 0x77, 0x82, 0xea, 0xa9,
-		0x15, 0x65, 0x6d, 0x48, 0x0b, 0x1f, 0xd1, 0x26, 0x4c, 0xe1, 0x57, 0x1d, 0x8b, 0xa9, 0x98, 0x4e,
-		0x74, 0xa9, 0x3a, 0x44, 0x77, 0xac, 0xd5, 0x99, 0x1e, 0xd5, 0x43, 0x3d, 0xaa, 0xef, 0x84, 0x8a,
-		0xa6, 0x55, 0x7a, 0x20, 0x64, 0x10, 0xb5, 0x60, 0xb1, 0xe9, 0x3a, 0x81, 0xe5, 0x74, 0xb1, 0x6e,
-		0xf8, 0xba, 0x83, 0x0f, 0x75, 0xcb, 0xb1, 0x02, 0xcb, 0x08, 0x5c, 0xaf, 0x3a, 0xbc, 0xa2, 0xac,
-		0x55, 0x36, 0xde, 0x12, 0x1e, 0x60, 0x93, 0x43, 0xdd, 0xf1, 0x1f, 0xe3, 0xc3, 0x46, 0x08, 0xa2,
-		0xcd, 0x37, 0x85, 0xe3, 0xa8, 0x01, 0x33, 0xe1, 0x8c, 0xa9, 0xb7, 0x0c, 0xcb, 0xee, 0x7a, 0xb8,
-		0x3a, 0x42, 0xc9, 0x3d, 0x23, 0xc4, 0x7f, 0x9f, 0xad, 0xd1, 0xa6, 0x23, 0x30, 0x3e, 0x82, 0x34,
-		0x98, 0xb7, 0x0d, 0x3f, 0xd0, 0x9b, 0x6e, 0xbb, 0x63, 0x63, 0x7a, 0x78, 0x0f, 0xfb, 0x5d, 0x3b,
-		0xa8, 0x8e, 0x4a, 0xf0, 0x3d, 0x35, 0x8e, 0x6c, 0xd7, 0x30, 0xb5, 0x59, 0x02, 0xbb, 0x19, 0x81,
-		0x6a, 0x14, 0x12, 0xfd, 0x2a, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74, 0x13, 0x37, 0x2d, 0x9f, 0xf2,
-		0xd3, 0xf0, 0x5f, 0xe8, 0xbb, 0x46, 0xf3, 0x85, 0xdb, 0x6a, 0x55, 0xc7, 0x28, 0xe2, 0xc5, 0x0c,
-		0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef, 0x18, 0xfe, 0x8b, 0xbb, 0x0c,
-		0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74, 0x9d, 0x96, 0xb5, 0x57, 0x85,
-		0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xaf, 0xd4, 0x73, 0x0c, 0xa9, 0x5c, 0x2a, 0x89, 0xe8, 0x30, 0x74,
-		0x9b, 0x14, 0xdb, 0x3d, 0x27, 0xf0, 0x8e, 0xb4, 0xa9, 0x4e, 0x72, 0xb4, 0x76, 0x17, 0x66, 0x45,
-		0x0b, 0xd1, 0x34, 0x94, 0x5f, 0xe0, 0x23, 0xaa, 0x14, 0x63, 0x1a, 0xf9, 0x89, 0x66, 0x61, 0xe8,
-		0xc0, 0xb0, 0xbb, 0x98, 0x0b, 0x36, 0x7b, 0xb8, 0x59, 0xba, 0xae, 0xa8, 0xd7, 0x60, 0x39, 0x8f,
-		0x14, 0xbf, 0xe3, 0x3a, 0x3e, 0x46, 0x73, 0x30, 0xec, 0x75, 0xa9, 0x56, 0x30, 0x84, 0x43, 0x5e,
-		0xd7, 0x69, 0x98, 0xea, 0xdf, 0x94, 0x60, 0x79, 0xdb, 0xda, 0x73, 0x0c, 0x3b, 0x57, 0x41, 0x1f,
-		0xa5, 0x15, 0xf4, 0x8a, 0x58, 0x41, 0xa5, 0x58, 0xfa, 0xd4, 0xd0, 0x16, 0x2c, 0xe1, 0x57, 0x01,
-		0xf6, 0x1c, 0xc3, 0x8e, 0x0c, 0x6f, 0x4f, 0x59, 0xb9, 0x9e, 0x5e, 0x10, 0xee, 0x9f, 0xdd, 0x79,
-		0x31, 0x44, 0x95, 0x99, 0x42, 0x75, 0x38, 0xdd, 0xdc, 0xb7, 0x6c, 0xb3, 0xb7, 0x89, 0xeb, 0xd8,
-		0x47, 0x54, 0x6f, 0x47, 0xb5, 0x19, 0x3a, 0x15, 0x02, 0x3d, 0x71, 0xec, 0x23, 0x75, 0x15, 0xce,
-		0xe5, 0x9e, 0x8f, 0x31, 0x58, 0xfd, 0x79, 0x09, 0x2e, 0xf2, 0x35, 0x56, 0xb0, 0x2f, 0xb7, 0x79,
-		0xcf, 0xd3, 0x2c, 0xbd, 0x25, 0x63, 0x69, 0x11, 0xba, 0x3e, 0x79, 0xfb, 0xb9, 0x22, 0x10, 0xf0,
-		0x32, 0x15, 0xf0, 0x8f, 0xf3, 0x05, 0xbc, 0x3f, 0x12, 0xfe, 0x0f, 0x45, 0xfd, 0x0e, 0xac, 0x15,
-		0x13, 0x25, 0x17, 0xfa, 0xef, 0x2b, 0x70, 0x56, 0xc3, 0x3e, 0x3e, 0xf1, 0x4b, 0x49, 0x8a, 0xa4,
-		0xbf, 0x6b, 0x21, 0xaa, 0x9b, 0x87, 0x46, 0x7e, 0x8a, 0x2f, 0x4b, 0xb0, 0xba, 0x83, 0xbd, 0xb6,
-		0xe5, 0x18, 0x01, 0xce, 0x3d, 0xc9, 0xd3, 0xf4, 0x49, 0xae, 0x0a, 0x4f, 0x52, 0x88, 0xe8, 0x97,
-		0x5c, 0x81, 0xdf, 0x00, 0x55, 0x76, 0x44, 0xae, 0xc3, 0x3f, 0x54, 0x60, 0x65, 0x0b, 0xfb, 0x4d,
-		0xcf, 0xda, 0xcd, 0xe7, 0xe8, 0x93, 0x34, 0x47, 0xdf, 0x13, 0x1e, 0xa7, 0x08, 0x4f, 0x9f, 0xe2,
-		0xf1, 0xdf, 0x65, 0x58, 0x95, 0xa0, 0xe2, 0x22, 0x62, 0xc3, 0x42, 0xcf, 0xa5, 0x61, 0xaa, 0xcd,
-		0x5f, 0x78, 0x52, 0x9b, 0x9d, 0x41, 0xb8, 0x19, 0x07, 0xd5, 0xe6, 0xb1, 0x70, 0x1c, 0xed, 0xc2,
-		0x42, 0xf6, 0x6e, 0x99, 0x27, 0x55, 0xa2, 0xbb, 0x5d, 0xea, 0x6f, 0x37, 0xea, 0x4b, 0xcd, 0x1d,
-		0x8a, 0x86, 0xd1, 0x27, 0x80, 0x3a, 0xd8, 0x31, 0x2d, 0x67, 0x4f, 0x37, 0x9a, 0x81, 0x75, 0x60,
-		0x05, 0x16, 0xf6, 0xb9, 0xb9, 0xca, 0x71, 0xd4, 0xd8, 0xf2, 0x3b, 0x6c, 0xf5, 0x11, 0x45, 0x3e,
-		0xd3, 0x49, 0x0c, 0x5a, 0xd8, 0x47, 0xbf, 0x06, 0xd3, 0x21, 0x62, 0x2a, 0x26, 0x1e, 0x76, 0xaa,
-		0xa7, 0x28, 0xda, 0xba, 0x0c, 0xed, 0x26, 0x59, 0x9b, 0xa4, 0x7c, 0xaa, 0x13, 0x9b, 0xf2, 0xb0,
-		0x83, 0xb6, 0x7b, 0xa8, 0x43, 0xef, 0x84, 0x3b, 0x7a, 0x52, 0x8a, 0x43, 0x67, 0x24, 0x81, 0x34,
-		0x1c, 0x54, 0x5f, 0xc1, 0xec, 0x33, 0x12, 0xf3, 0x84, 0xdc, 0x0b, 0xc5, 0x70, 0x33, 0x2d, 0x86,
-		0x6f, 0x0a, 0xf7, 0x10, 0xc1, 0xf6, 0x29, 0x7a, 0x3f, 0x56, 0x60, 0x2e, 0x05, 0xce, 0xc5, 0xed,
-		0x36, 0x4c, 0xd0, 0x38, 0x2c, 0x74, 0xe7, 0x94, 0x3e, 0xdc, 0xb9, 0x71, 0x0a, 0xc1, 0xbd, 0xb8,
-		0x06, 0x54, 0x42, 0x04, 0xbf, 0x8d, 0x9b, 0x01, 0x36, 0xb9, 0xe0, 0xa8, 0xf9, 0x67, 0xd0, 0xf8,
-		0x4a, 0x6d, 0xf2, 0x65, 0xfc, 0x51, 0xfd, 0x7d, 0x05, 0x6a, 0xd4, 0x80, 0x6e, 0x07, 0x56, 0xf3,
-		0xc5, 0x11, 0xf1, 0xe8, 0x1e, 0x5a, 0x7e, 0x10, 0xb2, 0xa9, 0x91, 0x66, 0xd3, 0x7a, 0xbe, 0x25,
-		0x17, 0x62, 0xe8, 0x93, 0x59, 0x67, 0x61, 0x49, 0x88, 0x83, 0x5b, 0x96, 0x7f, 0x2b, 0xc1, 0xfc,
-		0x03, 0x1c, 0x3c, 0xea, 0x06, 0xc6, 0xae, 0x8d, 0xb7, 0x03, 0x23, 0xc0, 0x9a, 0x08, 0xad, 0x92,
-		0xb2, 0xa7, 0x1f, 0x03, 0x12, 0x98, 0xd1, 0xd2, 0x40, 0x66, 0x74, 0x26, 0xa3, 0x61, 0xe8, 0x0a,
-		0xcc, 0xe3, 0x57, 0x1d, 0xca, 0x40, 0xdd, 0xc1, 0xaf, 0x02, 0x1d, 0x1f, 0x90, 0xb0, 0xc8, 0x32,
-		0xa9, 0x85, 0x2e, 0x6b, 0xa7, 0xc3, 0xd9, 0xc7, 0xf8, 0x55, 0x70, 0x8f, 0xcc, 0x35, 0x4c, 0xf4,
-		0x0e, 0xcc, 0x36, 0xbb, 0x1e, 0x8d, 0x9f, 0x76, 0x3d, 0xc3, 0x69, 0xee, 0xeb, 0x81, 0xfb, 0x82,
-		0x6a, 0x8f, 0xb2, 0x36, 0xa1, 0x21, 0x3e, 0x77, 0x97, 0x4e, 0xed, 0x90, 0x19, 0xf4, 0x1b, 0x30,
-		0x7b, 0x80, 0x3d, 0xea, 0xa5, 0x73, 0x9f, 0x42, 0xb7, 0x02, 0xdc, 0xe6, 0x4a, 0x91, 0x16, 0x58,
-		0x12, 0xb4, 0x92, 0x13, 0x3c, 0x67, 0x20, 0x1f, 0x32, 0x88, 0x46, 0x80, 0xdb, 0x1a, 0x3a, 0xc8,
-		0x8c, 0xa9, 0xff, 0x30, 0x06, 0x0b, 0x19, 0x96, 0x72, 0x01, 0x15, 0xb3, 0x4d, 0x39, 0x29, 0xdb,
-		0xee, 0xc3, 0x64, 0x84, 0x36, 0x38, 0xea, 0x60, 0x7e, 0x11, 0xab, 0x52, 0x8c, 0x3b, 0x47, 0x1d,
-		0xac, 0x4d, 0x1c, 0xc6, 0x9e, 0x90, 0x0a, 0x93, 0x22, 0xae, 0x8f, 0x3b, 0x31, 0x6e, 0x3f, 0x87,
-		0xc5, 0x8e, 0x87, 0x0f, 0x2c, 0xb7, 0xeb, 0xeb, 0x3e, 0x71, 0x73, 0xb0, 0xd9, 0x5b, 0x7f, 0x8a,
-		0xee, 0xbb, 0x94, 0x09, 0x73, 0x1a, 0x4e, 0x70, 0xf5, 0xdd, 0xe7, 0xc4, 0x57, 0xd2, 0xe6, 0x43,
-		0xe8, 0x6d, 0x06, 0x1c, 0xe2, 0x7d, 0x1b, 0x4e, 0xd3, 0xa0, 0x8c, 0x45, 0x51, 0x11, 0xc6, 0x21,
-		0x4a, 0xc1, 0x34, 0x99, 0xba, 0x4f, 0x66, 0xc2, 0xe5, 0x37, 0x61, 0x8c, 0x06, 0x58, 0xb6, 0xe5,
-		0x07, 0x34, 0xcc, 0x1c, 0xdf, 0x38, 0x2b, 0xf6, 0x20, 0x42, 0x91, 0x1f, 0x0d, 0xf8, 0x2f, 0xf4,
-		0x00, 0xa6, 0x7d, 0xaa, 0x0e, 0x7a, 0x0f, 0xc5, 0x48, 0x3f, 0x28, 0x2a, 0x7e, 0x42, 0x8b, 0xd0,
-		0xbb, 0x30, 0xdf, 0xb4, 0x2d, 0x42, 0xa9, 0x6d, 0xed, 0x7a, 0x86, 0x77, 0xa4, 0x73, 0x79, 0xa0,
-		0x81, 0xe4, 0x98, 0x36, 0xcb, 0x66, 0x1f, 0xb2, 0x49, 0x2e, 0x3f, 0x31, 0xa8, 0x16, 0x36, 0x82,
-		0xae, 0x87, 0x23, 0xa8, 0xb1, 0x38, 0xd4, 0x7d, 0x36, 0x19, 0x42, 0x9d, 0x83, 0x71, 0x0e, 0x65,
-		0xb5, 0x3b, 0x76, 0x15, 0xe8, 0x52, 0x60, 0x43, 0x8d, 0x76, 0xc7, 0x46, 0x3e, 0x5c, 0x4a, 0x9f,
-		0x4a, 0xf7, 0x9b, 0xfb, 0xd8, 0xec, 0xda, 0x58, 0x0f, 0x5c, 0x76, 0x59, 0x34, 0xca, 0x77, 0xbb,
-		0x41, 0x75, 0xbc, 0x28, 0x20, 0x7d, 0x23, 0x79, 0xd6, 0x6d, 0x8e, 0x69, 0xc7, 0xa5, 0xf7, 0xb6,
-		0xc3, 0xd0, 0x10, 0x7f, 0x87, 0x5d, 0x15, 0x91, 0xff, 0xde, 0x41, 0x26, 0x68, 0xa2, 0x61, 0x86,
-		0x4e, 0x6d, 0x93, 0x99, 0xf0, 0x14, 0x79, 0xba, 0x3a, 0x99, 0xab, 0xab, 0x0f, 0xa1, 0x12, 0xc9,
-		0xb6, 0x4f, 0x94, 0xa9, 0x5a, 0xa1, 0x49, 0x85, 0xf3, 0xc9, 0xab, 0x62, 0x99, 0x9e, 0xb8, 0x7c,
-		0x33, 0xcd, 0x8b, 0x14, 0x83, 0x3e, 0xa2, 0x26, 0xcc, 0x46, 0xd8, 0x9a, 0xb6, 0xeb, 0x63, 0x8e,
-		0x73, 0x8a, 0xe2, 0xbc, 0xdc, 0xa7, 0x37, 0x42, 0x00, 0x09, 0xbe, 0xae, 0xaf, 0x45, 0xfa, 0x1c,
-		0x0d, 0x12, 0x2d, 0x9f, 0x49, 0x9a, 0x17, 0xe2, 0x22, 0x4c, 0x8b, 0x5e, 0xb8, 0x3d, 0xaa, 0x13,
-		0xc6, 0xc5, 0xc2, 0xbe, 0x36, 0x7d, 0x90, 0x1a, 0x41, 0xb7, 0x60, 0xc9, 0x22, 0x3a, 0x97, 0xba,
-		0x63, 0xec, 0x10, 0x3b, 0x63, 0x56, 0x67, 0xa8, 0x8f, 0xb9, 0x60, 0xf9, 0x49, 0x53, 0x7f, 0x8f,
-		0x4d, 0xa3, 0x55, 0x98, 0x08, 0x6d, 0x9d, 0x6f, 0x7d, 0x86, 0xab, 0x88, 0xa9, 0x36, 0x1f, 0xdb,
-		0xb6, 0x3e, 0xc3, 0xea, 0x2f, 0x14, 0x58, 0x78, 0xea, 0xda, 0xf6, 0xff, 0xaf, 0xb7, 0x81, 0xfa,
-		0x93, 0x51, 0xa8, 0x66, 0x8f, 0xfd, 0x8d, 0xc5, 0xfe, 0xc6, 0x62, 0x7f, 0x1d, 0x2d, 0x76, 0x9e,
-		0x7e, 0x4c, 0xe4, 0x5a, 0x60, 0xa1, 0x39, 0x9b, 0x3c, 0xb1, 0x39, 0xfb, 0xe5, 0x33, 0xec, 0xea,
-		0xbf, 0x94, 0x60, 0x45, 0xc3, 0x4d, 0xd7, 0x33, 0xe3, 0x89, 0x5a, 0xae, 0x16, 0xaf, 0xd3, 0x52,
-		0x9e, 0x83, 0xf1, 0x48, 0x70, 0x22, 0x23, 0x00, 0xe1, 0x50, 0xc3, 0x44, 0x0b, 0x30, 0x42, 0x65,
-		0x8c, 0x6b, 0x7c, 0x59, 0x1b, 0x26, 0x8f, 0x0d, 0x13, 0x9d, 0x05, 0xe0, 0x71, 0x44, 0xa8, 0xbb,
-		0x63, 0xda, 0x18, 0x1f, 0x69, 0x98, 0x48, 0x83, 0x89, 0x8e, 0x6b, 0xdb, 0x7a, 0x18, 0xab, 0x0c,
-		0x4b, 0x62, 0x15, 0x62, 0x43, 0xef, 0xbb, 0x5e, 0x9c, 0x35, 0x61, 0xac, 0x32, 0x4e, 0x90, 0xf0,
-		0x07, 0xf5, 0xf7, 0x46, 0x61, 0x55, 0xc2, 0x45, 0x6e, 0x78, 0x33, 0x16, 0x52, 0x39, 0x9e, 0x85,
-		0x94, 0x5a, 0xbf, 0xd2, 0xf1, 0xad, 0xdf, 0xb7, 0x01, 0x85, 0xfc, 0x35, 0xd3, 0xe6, 0x77, 0x3a,
-		0x9a, 0x09, 0x57, 0xaf, 0x11, 0x03, 0x26, 0x30, 0xbd, 0x65, 0x62, 0xa1, 0x12, 0x78, 0x33, 0x16,
-		0x7d, 0x28, 0x6b, 0xd1, 0x63, 0x25, 0x9d, 0xe1, 0x64, 0x49, 0xe7, 0x3a, 0x54, 0xb9, 0x49, 0xe9,
-		0x25, 0x40, 0x42, 0x07, 0x61, 0x84, 0x3a, 0x08, 0xf3, 0x6c, 0x3e, 0x92, 0x9d, 0xd0, 0x3f, 0xd0,
-		0x60, 0x32, 0x2a, 0x5d, 0xd0, 0x94, 0x09, 0xab, 0x85, 0xbc, 0x9d, 0xa7, 0x8d, 0x3b, 0x9e, 0xe1,
-		0xf8, 0xc4, 0x94, 0x25, 0xd2, 0x04, 0x13, 0x66, 0xec, 0x09, 0x7d, 0x0a, 0x67, 0x04, 0x09, 0x99,
-		0x9e, 0x09, 0x1f, 0xeb, 0xc7, 0x84, 0x2f, 0x66, 0xc4, 0x3d, 0xb2, 0xe6, 0x39, 0xde, 0x27, 0xe4,
-		0x79, 0x9f, 0xab, 0x30, 0x91, 0xb0, 0x79, 0xe3, 0xd4, 0xe6, 0x8d, 0xef, 0xc6, 0x8c, 0xdd, 0x1d,
-		0xa8, 0xf4, 0xae, 0x95, 0x96, 0xc4, 0x26, 0x0a, 0x4b, 0x62, 0x93, 0x11, 0x04, 0xad, 0x88, 0x7d,
-		0x00, 0x13, 0xe1, 0x5d, 0x53, 0x04, 0x93, 0x85, 0x08, 0xc6, 0xf9, 0x7a, 0x0a, 0x6e, 0xc0, 0xc8,
-		0xcb, 0x2e, 0xa6, 0x46, 0xb6, 0x42, 0xf3, 0x3f, 0x0f, 0x72, 0xb3, 0xe0, 0x85, 0x5a, 0x44, 0x53,
-		0x14, 0x16, 0xf6, 0x59, 0xde, 0x3b, 0xc4, 0x9b, 0xf1, 0x05, 0xa7, 0x32, 0xbe, 0x60, 0xed, 0x53,
-		0x98, 0x88, 0xc3, 0x0a, 0x52, 0xe1, 0xd7, 0xe3, 0xa9, 0xf0, 0xbc, 0x14, 0x49, 0xa8, 0x98, 0x2c,
-		0x55, 0x12, 0x4b, 0x97, 0xf7, 0x4c, 0x69, 0x98, 0x18, 0xfb, 0xc6, 0x94, 0x66, 0x4c, 0x69, 0x9c,
-		0x35, 0x42, 0x53, 0xfa, 0xb3, 0x72, 0x68, 0x4a, 0x85, 0x5c, 0xe4, 0xa6, 0xf4, 0x23, 0x98, 0x4a,
-		0x99, 0x2a, 0xa9, 0x31, 0xe5, 0xc9, 0x0c, 0x6a, 0x6c, 0xb4, 0x4a, 0xd2, 0x94, 0x65, 0x84, 0xbb,
-		0x34, 0x98, 0x70, 0xc7, 0x2c, 0x57, 0x39, 0x69, 0xb9, 0x3e, 0x85, 0xe5, 0xa4, 0xe2, 0xe9, 0x6e,
-		0x4b, 0x0f, 0xf6, 0x2d, 0x5f, 0x8f, 0x57, 0xaf, 0xe5, 0x5b, 0xd5, 0x12, 0x8a, 0xf8, 0xa4, 0xb5,
-		0xb3, 0x6f, 0xf9, 0x77, 0x38, 0xfe, 0x06, 0xcc, 0xec, 0x63, 0xc3, 0x0b, 0x76, 0xb1, 0x11, 0xe8,
-		0x26, 0x0e, 0x0c, 0xcb, 0xf6, 0x79, 0xc2, 0x47, 0x9e, 0x20, 0x9c, 0x8e, 0xc0, 0xb6, 0x18, 0x54,
-		0xf6, 0xd5, 0x34, 0x7c, 0xbc, 0x57, 0xd3, 0x45, 0x98, 0x8a, 0xf0, 0x30, 0xb1, 0xa6, 0x36, 0x7a,
-		0x4c, 0x8b, 0x1c, 0xa3, 0x2d, 0x3a, 0xaa, 0xfe, 0xb9, 0x02, 0xdf, 0x62, 0xb7, 0x99, 0x50, 0x76,
-		0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcf, 0x4b, 0x2a, 0x16, 0xa1, 0xea, 0x33,
-		0xbb, 0xf8, 0x77, 0x65, 0x78, 0x43, 0x8e, 0x8d, 0x8b, 0x20, 0xee, 0xbd, 0xff, 0x3c, 0x3e, 0xc6,
-		0x49, 0xbc, 0x79, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff, 0xb1, 0x02, 0xcb, 0xbd, 0xb4,
-		0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7, 0xdd, 0xa6, 0x61, 0xdb, 0x47,
-		0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x4a, 0x76, 0x2d, 0x3e, 0x4e, 0xbd, 0x97, 0xb7, 0xdf, 0x71, 0xb7,
-		0xf8, 0x0e, 0x0f, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b, 0x6a, 0xbf, 0x03, 0x2b, 0x45,
-		0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0, 0x0c, 0x50, 0x5c, 0x21, 0x62,
-		0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x2a, 0xc4, 0xf6, 0x66, 0x8e, 0x79, 0xdf, 0xb0, 0xec, 0x9e,
-		0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0x7d, 0x8b, 0xd8, 0xb1, 0x5c, 0x4c, 0x3c,
-		0x59, 0xfd, 0xa7, 0x0a, 0xa8, 0x59, 0x6b, 0xf7, 0x61, 0xa8, 0x9e, 0x21, 0xe5, 0xcf, 0xd2, 0x94,
-		0x5f, 0xcb, 0xa1, 0xbc, 0x08, 0x53, 0x9f, 0xb4, 0x3f, 0x25, 0xca, 0x29, 0xc1, 0xc5, 0x65, 0xf3,
-		0x4d, 0x98, 0x6e, 0x1a, 0x4e, 0x13, 0x47, 0x6f, 0x00, 0xcc, 0xde, 0x69, 0xa3, 0xda, 0x14, 0x1b,
-		0xd7, 0xc2, 0xe1, 0xb8, 0xbe, 0xc7, 0x71, 0x9e, 0x50, 0xdf, 0x65, 0xa8, 0xfa, 0x3c, 0xea, 0x85,
-		0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91, 0xb0, 0x5c, 0x3c, 0x03, 0x4b,
-		0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3, 0xbc, 0x6f, 0x09, 0x2b, 0xc2,
-		0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff, 0xbd, 0x02, 0xe7, 0x34, 0xdc,
-		0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e, 0x51, 0x39, 0xe5, 0x18, 0xa9,
-		0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7, 0xf9, 0x11, 0xd8, 0xb1, 0x73,
-		0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc, 0xcd, 0x9c, 0xfb, 0xeb, 0x63,
-		0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03, 0x61, 0x3b, 0x9f, 0xb8, 0x08,
-		0x7d, 0x8f, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97, 0xc1, 0x1a, 0x5c, 0x28, 0x3a,
-		0x0b, 0xe7, 0xf3, 0x3f, 0x2b, 0xb0, 0x14, 0x26, 0x8e, 0x04, 0x81, 0xfc, 0x6b, 0x11, 0x9f, 0x4b,
-		0x30, 0x63, 0xf9, 0x7a, 0xb2, 0xbb, 0x8e, 0xf2, 0x72, 0x54, 0x9b, 0xb2, 0xfc, 0xfb, 0xf1, 0xbe,
-		0x39, 0x75, 0x19, 0xce, 0x88, 0xc9, 0xe7, 0xe7, 0xfb, 0x82, 0x3a, 0x2c, 0xc4, 0x58, 0x27, 0x0b,
-		0xe7, 0x19, 0xd3, 0xfa, 0x3a, 0x0e, 0xba, 0x0a, 0x13, 0xbc, 0x75, 0x12, 0x9b, 0xb1, 0x5c, 0x6e,
-		0x34, 0xd6, 0x30, 0xd1, 0x27, 0x70, 0xba, 0x19, 0x92, 0x1a, 0xdb, 0xfa, 0xd4, 0x40, 0x5b, 0xa3,
-		0x08, 0x45, 0x6f, 0xef, 0x87, 0x30, 0x1d, 0x6b, 0x87, 0x64, 0x41, 0xc2, 0x50, 0xbf, 0x41, 0xc2,
-		0x54, 0x0f, 0x94, 0x45, 0x09, 0x67, 0x01, 0x42, 0x77, 0xcf, 0x32, 0xa9, 0x7b, 0x5c, 0xd6, 0xc6,
-		0xf8, 0x48, 0xc3, 0x54, 0x2f, 0x12, 0x65, 0x96, 0x5e, 0x02, 0xbf, 0xae, 0xff, 0x28, 0x41, 0x55,
-		0xe3, 0xbd, 0xc2, 0x98, 0xa2, 0xf6, 0x9f, 0x6f, 0xbc, 0xce, 0x2b, 0xfa, 0x2d, 0x98, 0x13, 0x55,
-		0x8e, 0xc3, 0x0e, 0x90, 0x01, 0x4a, 0xc7, 0xa7, 0xb3, 0xa5, 0x63, 0x1f, 0xbd, 0x07, 0xc3, 0x94,
-		0xf5, 0x3e, 0xbf, 0x51, 0x71, 0x6a, 0x64, 0xcb, 0x08, 0x8c, 0xbb, 0xb6, 0xbb, 0xab, 0xf1, 0xc5,
-		0x68, 0x13, 0x2a, 0x0e, 0x3e, 0xd4, 0xbd, 0x2e, 0xbf, 0xb9, 0x30, 0xb0, 0x29, 0x00, 0x9f, 0x70,
-		0xf0, 0xa1, 0xd6, 0x65, 0x57, 0xe6, 0xab, 0x4b, 0xb0, 0x28, 0x60, 0x35, 0xbf, 0x88, 0xef, 0x2b,
-		0x30, 0xbf, 0x7d, 0xe4, 0x34, 0xb7, 0xf7, 0x0d, 0xcf, 0xe4, 0x19, 0x52, 0x7e, 0x0d, 0xe7, 0xa1,
-		0xe2, 0xbb, 0x5d, 0xaf, 0x89, 0x75, 0xde, 0x42, 0xce, 0xef, 0x62, 0x92, 0x8d, 0x6e, 0xb2, 0x41,
-		0xb4, 0x08, 0xa3, 0x3e, 0x01, 0x0e, 0xdf, 0x6f, 0x43, 0xda, 0x08, 0x7d, 0x6e, 0x98, 0xa8, 0x0e,
-		0xa7, 0x68, 0x2c, 0x59, 0x2e, 0x0c, 0xf0, 0xe8, 0x3a, 0x75, 0x11, 0x16, 0x32, 0xb4, 0x70, 0x3a,
-		0xff, 0x75, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x3a, 0x65, 0xa5, 0x0a, 0x23, 0x61, 0x46,
-		0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79, 0x84, 0x28, 0xef, 0x40, 0x78,
-		0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26, 0x92, 0x1f, 0x19, 0x2c, 0x92,
-		0xff, 0x88, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88, 0x65, 0x86, 0x80, 0x45, 0xee,
-		0x31, 0xc5, 0x75, 0x15, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88, 0x3c, 0x5c, 0x1c, 0xcf, 0x26,
-		0x40, 0x32, 0x9b, 0x70, 0x1b, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a, 0x8f, 0xf7, 0xd1, 0x28, 0x3e,
-		0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x1d, 0xa0, 0x7d, 0xde, 0xfc, 0xd3, 0x09, 0xdd, 0x32, 0xb1,
-		0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d, 0x42, 0xa7, 0x1a, 0x7c, 0x06,
-		0x3d, 0x86, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19, 0x05, 0xad, 0x92, 0x34, 0x08,
-		0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0, 0x14, 0x76, 0xde, 0x7d, 0x45,
-		0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f, 0x57, 0x60, 0xbe, 0xcd, 0xc6,
-		0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7, 0xf0, 0x74, 0x3b, 0x06, 0xd5,
-		0x70, 0x36, 0xc9, 0x14, 0xba, 0x01, 0x8b, 0x19, 0x20, 0xd3, 0x08, 0x8c, 0x5d, 0xc3, 0x0f, 0x1b,
-		0x70, 0xe7, 0x93, 0x70, 0x5b, 0x7c, 0x56, 0x3d, 0x03, 0xb5, 0x90, 0x1e, 0xce, 0xcf, 0x0f, 0xdd,
-		0xa8, 0x75, 0x4a, 0xfd, 0xdd, 0x52, 0x8f, 0x85, 0x89, 0x69, 0x4e, 0xed, 0x1a, 0x4c, 0x3b, 0xdd,
-		0xf6, 0x2e, 0xf6, 0x74, 0xb7, 0xa5, 0x53, 0x2b, 0xe5, 0x53, 0x3a, 0x87, 0xb4, 0x0a, 0x1b, 0x7f,
-		0xd2, 0xa2, 0xc6, 0xc7, 0x27, 0xcc, 0x0e, 0xad, 0x9a, 0x4f, 0x53, 0x0b, 0x43, 0xda, 0x28, 0x37,
-		0x6b, 0x3e, 0x6a, 0xc0, 0x04, 0xbf, 0x09, 0x76, 0x54, 0x71, 0x97, 0x69, 0x28, 0x0e, 0x2c, 0xd7,
-		0x43, 0x4f, 0x4e, 0x7d, 0xbf, 0x71, 0xb3, 0x37, 0x80, 0xae, 0xc2, 0x02, 0xdb, 0xa7, 0xe9, 0x3a,
-		0x81, 0xe7, 0xda, 0x36, 0xf6, 0x28, 0x4f, 0xba, 0xec, 0x4d, 0x31, 0xa6, 0xcd, 0xd1, 0xe9, 0xcd,
-		0x68, 0x96, 0xd9, 0x45, 0xaa, 0x21, 0xa6, 0xe9, 0x61, 0xdf, 0xe7, 0x09, 0xc9, 0xf0, 0x51, 0xad,
-		0xc3, 0x0c, 0xab, 0x6c, 0x11, 0xb8, 0x50, 0x76, 0xe2, 0x46, 0x5a, 0x49, 0x18, 0x69, 0x75, 0x16,
-		0x50, 0x7c, 0x3d, 0x17, 0xc6, 0xff, 0x52, 0x60, 0x86, 0x39, 0xef, 0x71, 0x2f, 0x31, 0x1f, 0x0d,
-		0xba, 0xc5, 0xab, 0xc0, 0x51, 0xd1, 0xbb, 0xb2, 0x71, 0x2e, 0x87, 0x21, 0x04, 0x23, 0xcd, 0x9a,
-		0xd1, 0x3a, 0x30, 0xcd, 0x98, 0xc5, 0x72, 0xaf, 0xe5, 0x44, 0xee, 0x75, 0x13, 0xa6, 0x0e, 0x2c,
-		0xdf, 0xda, 0xb5, 0x6c, 0x2b, 0x38, 0x62, 0x96, 0xa8, 0x38, 0x5d, 0x58, 0xe9, 0x81, 0x50, 0x33,
-		0xb4, 0x0a, 0x13, 0xfc, 0x15, 0xa6, 0x3b, 0x06, 0xb7, 0xb8, 0x63, 0xda, 0x38, 0x1f, 0x7b, 0x6c,
-		0xb4, 0x31, 0xe1, 0x42, 0xfc, 0xb8, 0x9c, 0x0b, 0x3f, 0xa0, 0x5c, 0xf0, 0x71, 0xf0, 0xac, 0x8b,
-		0xbb, 0xb8, 0x0f, 0x2e, 0xa4, 0x77, 0x2a, 0x65, 0x76, 0x4a, 0x32, 0xaa, 0x3c, 0x20, 0xa3, 0x18,
-		0x9d, 0x3d, 0x82, 0x38, 0x9d, 0x3f, 0x52, 0x60, 0x36, 0x94, 0xfb, 0xaf, 0x0c, 0xa9, 0x4f, 0x60,
-		0x2e, 0x45, 0x13, 0xd7, 0xc2, 0xab, 0xb0, 0xd0, 0xf1, 0xdc, 0x26, 0xf6, 0x7d, 0xcb, 0xd9, 0xd3,
-		0xe9, 0x57, 0x65, 0xcc, 0x0e, 0x10, 0x65, 0x2c, 0x13, 0x99, 0xef, 0x4d, 0x53, 0x48, 0x6a, 0x04,
-		0x7c, 0xf5, 0x0b, 0x05, 0xce, 0x3e, 0xc0, 0x81, 0xd6, 0xfb, 0xc6, 0xec, 0x11, 0xf6, 0x7d, 0x63,
-		0x0f, 0x47, 0x2e, 0xcb, 0x6d, 0x18, 0xa6, 0x05, 0x20, 0x86, 0x68, 0x7c, 0xe3, 0x62, 0x0e, 0xb5,
-		0x31, 0x14, 0xb4, 0x3a, 0xa4, 0x71, 0xb0, 0x3e, 0x98, 0x42, 0x6c, 0xcc, 0x72, 0x1e, 0x15, 0xfc,
-		0x80, 0x2f, 0xa1, 0xc2, 0xb8, 0xde, 0xe6, 0x33, 0x9c, 0x9c, 0x8f, 0x72, 0x93, 0x93, 0x72, 0x84,
-		0x75, 0xaa, 0x9b, 0xe1, 0x28, 0x4b, 0x44, 0x4e, 0xfa, 0xf1, 0xb1, 0x9a, 0x0d, 0x28, 0xbb, 0x28,
-		0x9e, 0x6c, 0x1c, 0x62, 0xc9, 0xc6, 0xef, 0x26, 0x93, 0x8d, 0x97, 0x8a, 0x19, 0x14, 0x11, 0x13,
-		0x4b, 0x34, 0xb6, 0x61, 0xe5, 0x01, 0x0e, 0xb6, 0x1e, 0x3e, 0x93, 0xdc, 0x45, 0x03, 0x80, 0xa9,
-		0xb4, 0xd3, 0x72, 0x43, 0x06, 0xf4, 0xb1, 0x1d, 0x11, 0x24, 0x6a, 0x26, 0xa9, 0xe8, 0x91, 0x5f,
-		0xbe, 0xfa, 0x0a, 0x56, 0x25, 0xdb, 0x71, 0xa6, 0x6f, 0xc3, 0x4c, 0xec, 0xeb, 0x43, 0x5a, 0x8c,
-		0x0c, 0xb7, 0xbd, 0xd0, 0xdf, 0xb6, 0xda, 0xb4, 0x97, 0x1c, 0xf0, 0xd5, 0x7f, 0x57, 0x60, 0x56,
-		0xc3, 0x46, 0xa7, 0x63, 0xb3, 0x88, 0x28, 0x3a, 0xdd, 0x3c, 0x0c, 0xf3, 0xcc, 0x3e, 0x7b, 0xcf,
-		0xf1, 0x27, 0xf9, 0xc7, 0x0a, 0xe2, 0x97, 0x74, 0xf9, 0xa4, 0xfe, 0xe8, 0xf1, 0x82, 0x0b, 0x75,
-		0x01, 0xe6, 0x52, 0x47, 0xe3, 0xd6, 0xe4, 0xa7, 0x0a, 0x2c, 0x69, 0xb8, 0xe5, 0x61, 0x7f, 0x3f,
-		0x2a, 0x72, 0x10, 0x6e, 0x7c, 0x05, 0xcf, 0xae, 0x2e, 0xc3, 0x19, 0x31, 0xa9, 0xfc, 0x2c, 0x37,
-		0x60, 0x61, 0xd3, 0xed, 0x3a, 0x44, 0x78, 0xd2, 0x02, 0xba, 0x0c, 0xd0, 0x72, 0xbd, 0x26, 0xbe,
-		0x8f, 0x83, 0xe6, 0x3e, 0xcf, 0xd8, 0xc6, 0x46, 0x54, 0x03, 0xaa, 0x59, 0x50, 0x2e, 0x6c, 0xf7,
-		0x60, 0x04, 0x3b, 0x01, 0xad, 0xe5, 0x32, 0x11, 0x7b, 0x2b, 0x47, 0xc4, 0xb8, 0x17, 0xb2, 0xf5,
-		0xf0, 0x19, 0xc5, 0xc5, 0xeb, 0xb5, 0x1c, 0x56, 0xfd, 0x69, 0x09, 0xe6, 0x35, 0x6c, 0x98, 0x02,
-		0xea, 0x36, 0xe0, 0x54, 0xd4, 0x1d, 0x51, 0xd9, 0x58, 0xce, 0xf3, 0x2d, 0x1e, 0x3e, 0xa3, 0x56,
-		0x97, 0xae, 0x95, 0x85, 0x62, 0xd9, 0x60, 0xae, 0x2c, 0x0a, 0xe6, 0x76, 0xa0, 0x6a, 0x39, 0x64,
-		0x85, 0x75, 0x80, 0x75, 0xec, 0x44, 0x16, 0xac, 0xcf, 0x8e, 0xb2, 0xb9, 0x08, 0xf8, 0x9e, 0x13,
-		0x9a, 0xa2, 0x86, 0x49, 0x04, 0xa3, 0x43, 0x90, 0xd0, 0x9a, 0xf4, 0x10, 0x25, 0x6c, 0x94, 0x0c,
-		0x6c, 0x5b, 0x9f, 0x61, 0x74, 0x01, 0xa6, 0x68, 0x5f, 0x04, 0x5d, 0xc1, 0xca, 0xf7, 0xc3, 0xb4,
-		0x7c, 0x4f, 0xdb, 0x25, 0x9e, 0x1a, 0x7b, 0x98, 0x75, 0xf3, 0xfd, 0x6d, 0x09, 0x16, 0x32, 0xbc,
-		0xe2, 0xd7, 0x71, 0x1c, 0x66, 0x09, 0xed, 0x45, 0xe9, 0x64, 0xf6, 0x02, 0x7d, 0x0f, 0xe6, 0x33,
-		0x48, 0xc3, 0x1c, 0xe1, 0xa0, 0x06, 0x70, 0x36, 0x8d, 0x9d, 0xa6, 0x08, 0x05, 0xec, 0x3a, 0x25,
-		0x62, 0xd7, 0xcf, 0x15, 0x58, 0x78, 0xda, 0xf5, 0xf6, 0xf0, 0xd7, 0x5b, 0xb6, 0xd4, 0x1a, 0x54,
-		0xb3, 0xc7, 0xe4, 0xca, 0xff, 0x65, 0x09, 0x16, 0x1e, 0xe1, 0xaf, 0x3d, 0x0f, 0xfe, 0x77, 0xf4,
-		0xeb, 0x2e, 0x54, 0xb3, 0xbc, 0xe2, 0xfa, 0x25, 0xc0, 0xa1, 0x88, 0x70, 0x7c, 0xae, 0xc0, 0x99,
-		0xc7, 0x6e, 0x60, 0xb5, 0x8e, 0x48, 0xb8, 0xed, 0x1e, 0x60, 0xef, 0x91, 0x41, 0x62, 0xe9, 0x88,
-		0xeb, 0xdf, 0x83, 0xf9, 0x16, 0x9f, 0xd1, 0xdb, 0x74, 0x4a, 0x4f, 0x38, 0x6c, 0x79, 0xfa, 0x91,
-		0x44, 0xc7, 0x7c, 0xb6, 0xd9, 0x56, 0x76, 0xd0, 0x57, 0xcf, 0xc1, 0xd9, 0x1c, 0x0a, 0xb8, 0x50,
-		0x18, 0xb0, 0xf4, 0x00, 0x07, 0x9b, 0x9e, 0xeb, 0xfb, 0xfc, 0x56, 0x12, 0x2f, 0xb7, 0x44, 0xe0,
-		0xa7, 0xa4, 0x02, 0xbf, 0xf3, 0x50, 0x09, 0x0c, 0x6f, 0x0f, 0x07, 0xd1, 0x2d, 0xb3, 0xd7, 0xdc,
-		0x24, 0x1b, 0xe5, 0xf8, 0xd4, 0x5f, 0x94, 0xe1, 0x8c, 0x78, 0x0f, 0xce, 0xcf, 0x36, 0xc1, 0x43,
-		0x4c, 0xc3, 0xee, 0x11, 0x0b, 0x43, 0xf9, 0xf1, 0x1f, 0xc8, 0x1c, 0xc4, 0x5c, 0x74, 0xd4, 0xf9,
-		0xf6, 0xef, 0x1e, 0x51, 0x07, 0x90, 0xbd, 0x61, 0x26, 0x82, 0xd8, 0x10, 0xfa, 0x5c, 0x81, 0xb9,
-		0x16, 0x2d, 0x88, 0xe9, 0x4d, 0xa3, 0xeb, 0xe3, 0xde, 0xb6, 0xcc, 0xde, 0x3d, 0x3a, 0xde, 0xb6,
-		0xac, 0xc6, 0xb6, 0x49, 0x30, 0x26, 0x36, 0x47, 0xad, 0xcc, 0x44, 0xad, 0x03, 0x33, 0x19, 0x2a,
-		0x05, 0xee, 0xe9, 0xbd, 0xa4, 0x7b, 0xba, 0x9e, 0x23, 0x0e, 0x69, 0x9a, 0xf8, 0xe5, 0xc5, 0x7d,
-		0xd4, 0x5a, 0x07, 0x16, 0x72, 0x08, 0x14, 0xec, 0x7b, 0x3b, 0xbe, 0x6f, 0x25, 0x37, 0xdd, 0xfb,
-		0x00, 0x07, 0xbd, 0xe2, 0x22, 0xc5, 0x1b, 0xf7, 0x8a, 0xff, 0x53, 0x81, 0x35, 0x5e, 0xce, 0xcb,
-		0x30, 0x2d, 0x53, 0x87, 0x90, 0x44, 0x66, 0xfd, 0x49, 0x19, 0x7a, 0xce, 0x84, 0x28, 0xea, 0xbb,
-		0x08, 0x73, 0xd5, 0xfd, 0x33, 0x8d, 0x77, 0x5b, 0x4c, 0x06, 0xb1, 0x27, 0x1f, 0xbd, 0x01, 0x93,
-		0x2d, 0xe2, 0x00, 0x3d, 0xc6, 0xcc, 0x97, 0xe2, 0xe5, 0xa7, 0xe4, 0xa0, 0xea, 0xc1, 0x9b, 0x7d,
-		0x9c, 0x35, 0x72, 0x97, 0x86, 0x42, 0x7f, 0xfc, 0x78, 0xd7, 0x4a, 0xa1, 0xd5, 0xf7, 0xe8, 0x37,
-		0x6d, 0xa1, 0x62, 0xd3, 0x97, 0x64, 0x1f, 0xb9, 0x31, 0x35, 0xa0, 0xdf, 0x6d, 0x25, 0xc1, 0x22,
-		0xc7, 0x61, 0xae, 0x57, 0x76, 0x09, 0x13, 0x31, 0x5d, 0xde, 0x47, 0x35, 0xa4, 0xf5, 0x6a, 0x32,
-		0xdb, 0x2c, 0x0b, 0xd3, 0x75, 0x68, 0x5e, 0x3c, 0xfc, 0xea, 0x92, 0xa7, 0x90, 0x58, 0x7e, 0x68,
-		0x92, 0x8f, 0xb2, 0x0c, 0x92, 0xda, 0x80, 0x79, 0xcd, 0x08, 0xb0, 0x6d, 0xb5, 0xad, 0xe0, 0xe3,
-		0x8e, 0x19, 0x4b, 0xe4, 0xad, 0xc3, 0x29, 0xd3, 0x08, 0x0c, 0xce, 0x8c, 0xa5, 0xbc, 0x46, 0xcc,
-		0x3b, 0xce, 0x91, 0x46, 0x17, 0xaa, 0x1f, 0xc1, 0x42, 0x06, 0x15, 0x3f, 0xc0, 0xa0, 0xb8, 0x36,
-		0xfe, 0xa9, 0x0e, 0xc0, 0x9d, 0xd2, 0x3b, 0x4f, 0x1b, 0xe8, 0x0f, 0x15, 0x98, 0x17, 0x7f, 0xd4,
-		0x8e, 0xae, 0x1e, 0xef, 0x5f, 0x28, 0x6a, 0xd7, 0x06, 0x86, 0xe3, 0x67, 0xf9, 0x23, 0x05, 0x16,
-		0x72, 0xfe, 0xf5, 0x00, 0x5d, 0x2b, 0xfa, 0xc7, 0x80, 0x3c, 0x6a, 0xae, 0x0f, 0x0e, 0xc8, 0xc9,
-		0xf9, 0x89, 0x02, 0x2b, 0x45, 0x5f, 0xfe, 0xa3, 0xef, 0x9e, 0xf4, 0x9f, 0x0c, 0x6a, 0x77, 0x4e,
-		0x80, 0x81, 0x53, 0x4a, 0x2e, 0x51, 0xfc, 0x4d, 0xbf, 0xe4, 0x12, 0xa5, 0xff, 0x25, 0x20, 0xb9,
-		0xc4, 0x82, 0x3f, 0x0f, 0xf8, 0x33, 0x05, 0x6a, 0xf9, 0x5f, 0xbe, 0xa3, 0xfc, 0xae, 0xb0, 0xc2,
-		0x7f, 0x04, 0xa8, 0xbd, 0x7f, 0x2c, 0x58, 0x4e, 0xd7, 0x8f, 0x14, 0x58, 0xcc, 0xfd, 0xae, 0x1d,
-		0xdd, 0xc8, 0x45, 0x5d, 0xf4, 0x59, 0x7d, 0xed, 0xe6, 0x71, 0x40, 0x39, 0x51, 0x0e, 0x4c, 0x26,
-		0x3e, 0x78, 0x46, 0x6f, 0xe7, 0x22, 0x13, 0x7d, 0x57, 0x5d, 0xab, 0xf7, 0xbb, 0x9c, 0xef, 0xf7,
-		0xb9, 0x02, 0xa7, 0x05, 0x5f, 0x0d, 0xa3, 0x2b, 0xf2, 0xdb, 0x16, 0x7e, 0xa7, 0x5c, 0x7b, 0x77,
-		0x30, 0x20, 0x4e, 0x42, 0x00, 0x53, 0xa9, 0x8f, 0x68, 0xd1, 0xba, 0xcc, 0xfd, 0x10, 0x54, 0x42,
-		0x6a, 0xef, 0xf4, 0x0f, 0xc0, 0x77, 0x3d, 0x84, 0xe9, 0xf4, 0x97, 0x60, 0x28, 0x1f, 0x4b, 0xce,
-		0xb7, 0x72, 0xb5, 0xcb, 0x03, 0x40, 0xc4, 0xc4, 0x2e, 0xb7, 0xdf, 0x51, 0x22, 0x76, 0x45, 0x5f,
-		0xa3, 0xd4, 0x4e, 0xd0, 0x5e, 0x89, 0xfe, 0x52, 0x81, 0x33, 0xb2, 0x76, 0x48, 0x74, 0xeb, 0x98,
-		0x5d, 0x94, 0x8c, 0xb4, 0x0f, 0x4e, 0xd4, 0x83, 0xc9, 0x59, 0x96, 0xd3, 0x33, 0x28, 0x65, 0x99,
-		0xbc, 0x63, 0x51, 0xca, 0xb2, 0x82, 0x16, 0xc5, 0xd8, 0x3d, 0x0a, 0x1a, 0xb2, 0x0b, 0xef, 0x31,
-		0xbf, 0x15, 0xbe, 0xf0, 0x1e, 0x65, 0xfd, 0xdf, 0xb1, 0x7b, 0x14, 0xb6, 0xed, 0x15, 0xdf, 0xa3,
-		0xac, 0x75, 0xb0, 0xf8, 0x1e, 0xa5, 0xbd, 0x82, 0xf1, 0x7b, 0xcc, 0x76, 0xe6, 0x15, 0xdf, 0x63,
-		0x6e, 0x5f, 0x60, 0xf1, 0x3d, 0xe6, 0x37, 0x02, 0xa2, 0xbf, 0xa0, 0xb9, 0xcd, 0xdc, 0x96, 0x3b,
-		0xf4, 0xfe, 0x40, 0x67, 0x4e, 0x36, 0xfd, 0xd5, 0x6e, 0x1d, 0x0f, 0x38, 0x41, 0x5a, 0x6e, 0xbf,
-		0xa9, 0x94, 0xb4, 0xa2, 0x8e, 0x57, 0x29, 0x69, 0xc5, 0x2d, 0xae, 0x7f, 0xad, 0xc0, 0xb2, 0xbc,
-		0xd1, 0x0c, 0x7d, 0x47, 0xb2, 0x41, 0x1f, 0xdd, 0x76, 0xb5, 0xdb, 0xc7, 0x86, 0xe7, 0x34, 0xfe,
-		0x40, 0x81, 0x6a, 0x5e, 0xbb, 0x21, 0xba, 0x2e, 0xc1, 0x2e, 0xed, 0xab, 0xac, 0xdd, 0x38, 0x06,
-		0x24, 0xa7, 0xe8, 0x0b, 0x05, 0x66, 0x45, 0x4d, 0x6b, 0x28, 0xff, 0xcd, 0x29, 0x69, 0xd1, 0xab,
-		0xbd, 0x37, 0x20, 0x14, 0xa7, 0xe2, 0xaf, 0xe8, 0x9f, 0x4f, 0x49, 0x9a, 0xb2, 0xd0, 0x07, 0x05,
-		0xb2, 0x21, 0xef, 0xa8, 0xab, 0x7d, 0xe7, 0xb8, 0xe0, 0x9c, 0xc0, 0xcf, 0x60, 0x26, 0xd3, 0x9f,
-		0x84, 0x2e, 0x4b, 0x90, 0x8a, 0xdb, 0xc6, 0x6a, 0x1b, 0x83, 0x80, 0xf4, 0xbc, 0x91, 0x54, 0xc7,
-		0x91, 0xc4, 0x1b, 0x11, 0xf7, 0x49, 0x49, 0xbc, 0x91, 0x9c, 0x66, 0x26, 0xf4, 0x02, 0x26, 0xe2,
-		0x1d, 0x20, 0xe8, 0xdb, 0x52, 0x0c, 0xa9, 0x96, 0xa7, 0xda, 0xdb, 0x7d, 0xae, 0x8e, 0x49, 0xa1,
-		0xa8, 0x85, 0x43, 0x22, 0x85, 0x92, 0x2e, 0x14, 0x89, 0x14, 0x4a, 0xfb, 0x44, 0x88, 0xe7, 0x29,
-		0xe8, 0xcc, 0x90, 0x78, 0x9e, 0xf9, 0x6d, 0x1e, 0xb5, 0x77, 0x07, 0x03, 0x8a, 0x3e, 0x55, 0x81,
-		0x5e, 0xa3, 0x03, 0xba, 0x94, 0x8b, 0x23, 0xd3, 0x3d, 0x51, 0x7b, 0xab, 0xaf, 0xb5, 0xbd, 0x6d,
-		0x7a, 0x9d, 0x04, 0x92, 0x6d, 0x32, 0xdd, 0x15, 0x92, 0x6d, 0xb2, 0xad, 0x09, 0x6c, 0x9b, 0xb0,
-		0x11, 0x40, 0xba, 0x4d, 0xaa, 0x7d, 0x41, 0xba, 0x4d, 0xba, 0xb3, 0x80, 0x44, 0x28, 0x89, 0x22,
-		0xbe, 0x24, 0x42, 0x11, 0x35, 0x20, 0x48, 0x22, 0x14, 0x71, 0x6f, 0x00, 0x09, 0x65, 0xc5, 0xc5,
-		0x70, 0x49, 0x28, 0x2b, 0x6d, 0x0a, 0x90, 0x84, 0xb2, 0x05, 0x65, 0x7c, 0xe2, 0xc0, 0xe4, 0xd6,
-		0x9d, 0x25, 0x0e, 0x4c, 0x51, 0x69, 0x5c, 0xe2, 0xc0, 0x14, 0x97, 0xb9, 0x1d, 0x98, 0x4c, 0x54,
-		0x6d, 0x25, 0x17, 0x22, 0x2a, 0x5c, 0x4b, 0x2e, 0x44, 0x58, 0x0c, 0xa6, 0xe6, 0x43, 0x54, 0x61,
-		0x45, 0xb2, 0xf0, 0x2f, 0xb7, 0x76, 0x2c, 0x31, 0x1f, 0xb2, 0x32, 0x2e, 0x89, 0xdf, 0xd2, 0xb5,
-		0x58, 0x49, 0xfc, 0x96, 0x53, 0xf1, 0x95, 0xc4, 0x6f, 0xb9, 0x85, 0xde, 0x00, 0xa6, 0x52, 0x45,
-		0x47, 0xc9, 0x0b, 0x42, 0x5c, 0xca, 0x95, 0xbc, 0x20, 0xf2, 0xea, 0x99, 0x24, 0x5c, 0x4d, 0x15,
-		0xb5, 0x64, 0xe1, 0xaa, 0xb8, 0xcc, 0x27, 0x0b, 0x57, 0x73, 0x2a, 0x66, 0x64, 0xe3, 0x74, 0x11,
-		0x48, 0xb2, 0x71, 0x4e, 0x6d, 0x4d, 0xb2, 0x71, 0x6e, 0x85, 0xe9, 0x0f, 0x14, 0x98, 0x13, 0xd6,
-		0x6d, 0x50, 0xbe, 0xc4, 0xc8, 0x2a, 0x4d, 0xb5, 0xab, 0x83, 0x82, 0xc5, 0xe4, 0x5d, 0x54, 0xf5,
-		0x90, 0xc8, 0xbb, 0xa4, 0x9c, 0x24, 0x91, 0x77, 0x69, 0x81, 0xe8, 0x4b, 0x25, 0xfa, 0xaa, 0x29,
-		0x3f, 0xbd, 0x8e, 0xee, 0x14, 0xc5, 0x1b, 0x85, 0x65, 0x88, 0xda, 0xdd, 0x93, 0xa0, 0x48, 0xa4,
-		0x74, 0xe2, 0xf9, 0x75, 0x79, 0x4a, 0x47, 0x90, 0xc0, 0x97, 0xa7, 0x74, 0x84, 0xa9, 0x7b, 0xa2,
-		0x99, 0xc9, 0xa4, 0xb8, 0x4c, 0x33, 0x85, 0x99, 0x78, 0x99, 0x66, 0x8a, 0xf3, 0xed, 0x77, 0x6f,
-		0xfc, 0xfa, 0xb5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d, 0xf1, 0x9f, 0xe4,
-		0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xa8, 0x8f, 0xfd, 0x43, 0xfe, 0xfb, 0xfc, 0xe7, 0xc1, 0xe5, 0xdd,
-		0x61, 0x3a, 0x77, 0xe5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0xea, 0x8b, 0xf1, 0x4d, 0x5f,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x49, 0x6c, 0x1c, 0x49,
+		0x72, 0xa8, 0x6e, 0xf1, 0x0a, 0x92, 0x4d, 0xb2, 0xc4, 0xa3, 0x55, 0xd4, 0x41, 0xd6, 0x48, 0x1a,
+		0x8e, 0x66, 0xa7, 0x25, 0x71, 0x46, 0xc7, 0x68, 0xa4, 0xd5, 0x4a, 0xd4, 0x31, 0x3d, 0xab, 0xb3,
+		0xc8, 0xd1, 0xf8, 0x9c, 0xde, 0x62, 0x57, 0x36, 0x59, 0x56, 0x77, 0x55, 0x4f, 0x65, 0x35, 0xa5,
+		0x9e, 0x87, 0x31, 0xc6, 0x18, 0x3e, 0x16, 0x86, 0xd7, 0x5e, 0xd8, 0x86, 0x01, 0x03, 0x06, 0x8c,
+		0x35, 0xbc, 0xd8, 0x81, 0x7f, 0x36, 0x60, 0xc0, 0x07, 0x60, 0xc0, 0x1f, 0xc3, 0x2f, 0x7f, 0xfd,
+		0xb7, 0x1f, 0xb6, 0xe1, 0xdf, 0x02, 0xfe, 0x19, 0x46, 0x5e, 0x75, 0x66, 0x55, 0x57, 0x37, 0xbd,
+		0x9e, 0x63, 0xe7, 0xc7, 0xce, 0xcc, 0x88, 0x8c, 0x8c, 0x8c, 0x88, 0x8c, 0x8c, 0x88, 0x2c, 0xc2,
+		0x99, 0xde, 0x2e, 0xf2, 0xce, 0x37, 0x4d, 0x0b, 0x39, 0x4d, 0x74, 0x7e, 0xdf, 0xc6, 0xbe, 0xeb,
+		0xf5, 0xcf, 0x1f, 0x5c, 0x3c, 0x8f, 0x91, 0x77, 0x60, 0x37, 0x51, 0xad, 0xeb, 0xb9, 0xbe, 0xab,
+		0xae, 0x90, 0x61, 0x35, 0x3e, 0xac, 0xc6, 0x87, 0xd5, 0x0e, 0x2e, 0x6a, 0x27, 0xf7, 0x5c, 0x77,
+		0xaf, 0x8d, 0xce, 0xd3, 0x61, 0xbb, 0xbd, 0xd6, 0x79, 0xab, 0xe7, 0x99, 0xbe, 0xed, 0x3a, 0x0c,
+		0x50, 0x3b, 0x95, 0xec, 0xf7, 0xed, 0x0e, 0xc2, 0xbe, 0xd9, 0xe9, 0xf2, 0x01, 0x29, 0x04, 0x2f,
+		0x3c, 0xb3, 0xdb, 0x45, 0x1e, 0xe6, 0xfd, 0x6b, 0x31, 0x02, 0xcd, 0xae, 0x4d, 0x88, 0x6b, 0xba,
+		0x9d, 0x4e, 0x30, 0xc5, 0xba, 0x6c, 0x84, 0x20, 0x91, 0x53, 0x21, 0x1b, 0xf2, 0x51, 0x0f, 0x05,
+		0x03, 0x74, 0xd9, 0x00, 0xdf, 0xc4, 0xcf, 0xdb, 0x36, 0xf6, 0xf3, 0xc6, 0xbc, 0x70, 0xbd, 0xe7,
+		0xad, 0xb6, 0xfb, 0x82, 0x8f, 0x39, 0x27, 0x1b, 0xc3, 0x59, 0xd9, 0x48, 0x8c, 0xdd, 0x18, 0x34,
+		0x16, 0x79, 0x7c, 0xe4, 0x2b, 0xf1, 0x91, 0x56, 0xc7, 0x76, 0x28, 0x17, 0xda, 0x3d, 0xec, 0x0f,
+		0x1a, 0x14, 0x67, 0xc4, 0xba, 0x7c, 0xd0, 0x47, 0x3d, 0xd4, 0xe3, 0x5b, 0xad, 0xbd, 0x2a, 0x1f,
+		0xe2, 0xa1, 0x6e, 0xdb, 0x6e, 0x46, 0xb7, 0x36, 0xbe, 0x33, 0x78, 0xdf, 0xf4, 0x90, 0x45, 0x46,
+		0x9a, 0x8e, 0x98, 0xed, 0x74, 0xc6, 0x88, 0x38, 0x4d, 0x67, 0x32, 0x46, 0xc5, 0xd9, 0xa5, 0xff,
+		0xfa, 0x04, 0x9c, 0xd8, 0xf6, 0x4d, 0xcf, 0xff, 0x80, 0xb7, 0xdf, 0x7d, 0x89, 0x9a, 0x3d, 0x42,
+		0x8f, 0x81, 0x3e, 0xea, 0x21, 0xec, 0xab, 0x0f, 0x60, 0xc2, 0x63, 0x7f, 0x56, 0x95, 0x35, 0x65,
+		0x63, 0x7a, 0x73, 0xb3, 0x16, 0x13, 0x5b, 0xb3, 0x6b, 0xd7, 0x0e, 0x2e, 0xd6, 0x72, 0x91, 0x18,
+		0x02, 0x85, 0xba, 0x0a, 0x53, 0x96, 0xdb, 0x31, 0x6d, 0xa7, 0x61, 0x5b, 0xd5, 0xd2, 0x9a, 0xb2,
+		0x31, 0x65, 0x4c, 0xb2, 0x86, 0xba, 0xa5, 0xfe, 0x02, 0x2c, 0x75, 0x4d, 0x0f, 0x39, 0x7e, 0x03,
+		0x09, 0x04, 0x0d, 0xdb, 0x69, 0xb9, 0xd5, 0x32, 0x9d, 0x78, 0x43, 0x3a, 0xf1, 0x13, 0x0a, 0x11,
+		0xcc, 0x58, 0x77, 0x5a, 0xae, 0x71, 0xb4, 0x9b, 0x6e, 0x54, 0xab, 0x30, 0x61, 0xfa, 0x3e, 0xea,
+		0x74, 0xfd, 0xea, 0x91, 0x35, 0x65, 0x63, 0xcc, 0x10, 0x3f, 0xd5, 0x2d, 0x98, 0x43, 0x2f, 0xbb,
+		0x36, 0x53, 0xb1, 0x06, 0xd1, 0xa5, 0xea, 0x18, 0x9d, 0x51, 0xab, 0x31, 0x3d, 0xaa, 0x09, 0x3d,
+		0xaa, 0xed, 0x08, 0x45, 0x33, 0x2a, 0x21, 0x08, 0x69, 0x54, 0x5b, 0x70, 0xac, 0xe9, 0x3a, 0xbe,
+		0xed, 0xf4, 0x50, 0xc3, 0xc4, 0x0d, 0x07, 0xbd, 0x68, 0xd8, 0x8e, 0xed, 0xdb, 0xa6, 0xef, 0x7a,
+		0xd5, 0xf1, 0x35, 0x65, 0xa3, 0xb2, 0xf9, 0xba, 0x74, 0x01, 0x5b, 0x1c, 0xea, 0x16, 0x7e, 0x84,
+		0x5e, 0xd4, 0x05, 0x88, 0xb1, 0xdc, 0x94, 0xb6, 0xab, 0x75, 0x58, 0x10, 0x3d, 0x56, 0xa3, 0x65,
+		0xda, 0xed, 0x9e, 0x87, 0xaa, 0x13, 0x94, 0xdc, 0xe3, 0x52, 0xfc, 0xf7, 0xd8, 0x18, 0x63, 0x3e,
+		0x00, 0xe3, 0x2d, 0xaa, 0x01, 0xcb, 0x6d, 0x13, 0xfb, 0x8d, 0xa6, 0xdb, 0xe9, 0xb6, 0x11, 0x5d,
+		0xbc, 0x87, 0x70, 0xaf, 0xed, 0x57, 0x27, 0x73, 0xf0, 0x3d, 0x31, 0xfb, 0x6d, 0xd7, 0xb4, 0x8c,
+		0x45, 0x02, 0xbb, 0x15, 0x80, 0x1a, 0x14, 0x52, 0xfd, 0x19, 0x58, 0x6d, 0xd9, 0x1e, 0xf6, 0x1b,
+		0x16, 0x6a, 0xda, 0x98, 0xf2, 0xd3, 0xc4, 0xcf, 0x1b, 0xbb, 0x66, 0xf3, 0xb9, 0xdb, 0x6a, 0x55,
+		0xa7, 0x28, 0xe2, 0x63, 0x29, 0xbe, 0xde, 0xe1, 0x06, 0xce, 0xa8, 0x52, 0xe8, 0x3b, 0x1c, 0x78,
+		0xc7, 0xc4, 0xcf, 0x6f, 0x33, 0x50, 0xf5, 0x00, 0xe6, 0xbb, 0xa6, 0xe7, 0xdb, 0x94, 0xce, 0xa6,
+		0xeb, 0xb4, 0xec, 0xbd, 0x2a, 0xac, 0x95, 0x37, 0xa6, 0x37, 0xbf, 0x5d, 0xcb, 0x30, 0xa4, 0xf9,
+		0x52, 0x59, 0x7b, 0x22, 0xd0, 0x6d, 0x51, 0x6c, 0x77, 0x1d, 0xdf, 0xeb, 0x1b, 0x73, 0xdd, 0x78,
+		0xab, 0xba, 0x0e, 0x33, 0x2d, 0xd3, 0xf6, 0x1c, 0x84, 0x71, 0xe3, 0x39, 0xea, 0x57, 0xa7, 0xa9,
+		0xd4, 0x4e, 0x8b, 0xb6, 0x6f, 0xa3, 0xbe, 0x76, 0x1b, 0x16, 0x65, 0xb8, 0xd4, 0x79, 0x28, 0x13,
+		0x08, 0x85, 0x42, 0x90, 0x3f, 0xd5, 0x45, 0x18, 0x3b, 0x30, 0xdb, 0x3d, 0xc4, 0x65, 0x9f, 0xfd,
+		0xb8, 0x56, 0xba, 0xaa, 0xe8, 0x57, 0xe0, 0x64, 0x16, 0xb5, 0xb8, 0xeb, 0x3a, 0x18, 0xa9, 0x4b,
+		0x30, 0xee, 0xf5, 0xa8, 0xe2, 0x30, 0x84, 0x63, 0x5e, 0xcf, 0xa9, 0x5b, 0xfa, 0x9f, 0x96, 0xe0,
+		0xe4, 0xb6, 0xbd, 0xe7, 0x98, 0xed, 0x4c, 0x1d, 0x7e, 0x98, 0xd4, 0xe1, 0x37, 0xe5, 0x3a, 0x9c,
+		0x8b, 0xa5, 0xa0, 0x12, 0xb7, 0x60, 0x15, 0xbd, 0xf4, 0x91, 0xe7, 0x98, 0xed, 0xc0, 0x36, 0x87,
+		0xfa, 0xcc, 0x55, 0xf9, 0xac, 0x74, 0xfe, 0xf4, 0xcc, 0xc7, 0x04, 0xaa, 0x54, 0x97, 0x5a, 0x83,
+		0xa3, 0xcd, 0x7d, 0xbb, 0x6d, 0x85, 0x93, 0xb8, 0x4e, 0xbb, 0x4f, 0x55, 0x7b, 0xd2, 0x58, 0xa0,
+		0x5d, 0x02, 0xe8, 0xb1, 0xd3, 0xee, 0xeb, 0xeb, 0x70, 0x2a, 0x73, 0x7d, 0x8c, 0xc1, 0xfa, 0x6f,
+		0x94, 0xe1, 0x55, 0x3e, 0xc6, 0xf6, 0xf7, 0xf3, 0xcd, 0xe2, 0xb3, 0x24, 0x4b, 0xaf, 0xe7, 0xb1,
+		0x74, 0x10, 0xba, 0x82, 0xbc, 0xfd, 0x44, 0x91, 0xe8, 0x40, 0x99, 0xea, 0xc0, 0xfb, 0xd9, 0x3a,
+		0x50, 0x8c, 0x84, 0x11, 0xb5, 0xe1, 0xc8, 0x4f, 0x46, 0x1b, 0x6e, 0xc1, 0xc6, 0x60, 0xba, 0xf3,
+		0xf5, 0xe2, 0xbb, 0x0a, 0x9c, 0x30, 0x10, 0x46, 0x87, 0x3e, 0xda, 0x72, 0x91, 0x14, 0xdb, 0x39,
+		0xa2, 0xdd, 0x59, 0x68, 0xf2, 0x57, 0xf1, 0x59, 0x09, 0xd6, 0x77, 0x90, 0xd7, 0xb1, 0x1d, 0xd3,
+		0x47, 0x99, 0x2b, 0x79, 0x92, 0x5c, 0xc9, 0x65, 0xe9, 0x4a, 0x06, 0x22, 0xfa, 0x92, 0xeb, 0xf8,
+		0x69, 0xd0, 0xf3, 0x96, 0xc8, 0xd5, 0xfc, 0x77, 0x14, 0x58, 0xbb, 0x83, 0x70, 0xd3, 0xb3, 0x77,
+		0xb3, 0x39, 0xfa, 0x38, 0xc9, 0xd1, 0x4b, 0xd2, 0xe5, 0x0c, 0xc2, 0x53, 0x50, 0x3c, 0xfe, 0xa7,
+		0x0c, 0xeb, 0x39, 0xa8, 0xb8, 0x88, 0xb4, 0x61, 0x25, 0x74, 0x8c, 0x98, 0xf6, 0xf3, 0x63, 0x33,
+		0xd7, 0xac, 0xa7, 0x10, 0x6e, 0x45, 0x41, 0x8d, 0x65, 0x24, 0x6d, 0x57, 0x77, 0x61, 0x25, 0xbd,
+		0xb7, 0xcc, 0x1f, 0x2b, 0xd1, 0xd9, 0xce, 0x15, 0x9b, 0x8d, 0x7a, 0x64, 0x4b, 0x2f, 0x64, 0xcd,
+		0xea, 0x07, 0xa0, 0x76, 0x91, 0x63, 0xd9, 0xce, 0x5e, 0xc3, 0x6c, 0xfa, 0xf6, 0x81, 0xed, 0xdb,
+		0x08, 0x73, 0x8b, 0x96, 0xe1, 0xee, 0xb1, 0xe1, 0xb7, 0xd8, 0xe8, 0x3e, 0x45, 0xbe, 0xd0, 0x8d,
+		0x35, 0xda, 0x08, 0xab, 0x3f, 0x0b, 0xf3, 0x02, 0x31, 0x15, 0x13, 0x0f, 0x39, 0xd5, 0x23, 0x14,
+		0x6d, 0x2d, 0x0f, 0xed, 0x16, 0x19, 0x1b, 0xa7, 0x7c, 0xae, 0x1b, 0xe9, 0xf2, 0x90, 0xa3, 0x6e,
+		0x87, 0xa8, 0x85, 0x8f, 0xc3, 0xdd, 0xc5, 0x5c, 0x8a, 0x85, 0x4b, 0x13, 0x43, 0x2a, 0x1a, 0xf5,
+		0x97, 0xb0, 0xf8, 0x94, 0xdc, 0x9c, 0x04, 0xf7, 0x84, 0x18, 0x6e, 0x25, 0xc5, 0xf0, 0x35, 0xe9,
+		0x1c, 0x32, 0xd8, 0x82, 0xa2, 0xf7, 0x03, 0x05, 0x96, 0x12, 0xe0, 0x5c, 0xdc, 0x6e, 0xc2, 0x0c,
+		0xbd, 0xcd, 0x09, 0xa7, 0x50, 0x29, 0xe0, 0x14, 0x4e, 0x53, 0x08, 0xee, 0x0b, 0xd6, 0xa1, 0x22,
+		0x10, 0xfc, 0x12, 0x6a, 0xfa, 0xc8, 0xe2, 0x82, 0xa3, 0x67, 0xaf, 0xc1, 0xe0, 0x23, 0x8d, 0xd9,
+		0x8f, 0xa2, 0x3f, 0xf5, 0x5f, 0x55, 0x40, 0xa3, 0x06, 0x74, 0xdb, 0xb7, 0x9b, 0xcf, 0xfb, 0xc4,
+		0x2f, 0x7c, 0x60, 0x63, 0x5f, 0xb0, 0xa9, 0x9e, 0x64, 0xd3, 0xf9, 0x6c, 0x4b, 0x2e, 0xc5, 0x50,
+		0x90, 0x59, 0x27, 0x60, 0x55, 0x8a, 0x83, 0x5b, 0x96, 0x7f, 0x2e, 0xc1, 0xf2, 0x7d, 0xe4, 0x3f,
+		0xec, 0xf9, 0xe6, 0x6e, 0x1b, 0x6d, 0xfb, 0xa6, 0x8f, 0x0c, 0x19, 0x5a, 0x25, 0x61, 0x4f, 0xdf,
+		0x07, 0x55, 0x62, 0x46, 0x4b, 0x43, 0x99, 0xd1, 0x85, 0x94, 0x86, 0xa9, 0x6f, 0xc2, 0x32, 0x7a,
+		0xd9, 0xa5, 0x0c, 0x6c, 0x38, 0xe8, 0xa5, 0xdf, 0x40, 0x07, 0xe4, 0x72, 0x65, 0x5b, 0xd4, 0x42,
+		0x97, 0x8d, 0xa3, 0xa2, 0xf7, 0x11, 0x7a, 0xe9, 0xdf, 0x25, 0x7d, 0x75, 0x4b, 0xbd, 0x00, 0x8b,
+		0xcd, 0x9e, 0x47, 0x6f, 0x61, 0xbb, 0x9e, 0xe9, 0x34, 0xf7, 0x1b, 0xbe, 0xfb, 0x9c, 0x6a, 0x8f,
+		0xb2, 0x31, 0x63, 0xa8, 0xbc, 0xef, 0x36, 0xed, 0xda, 0x21, 0x3d, 0xea, 0xcf, 0xc3, 0xe2, 0x01,
+		0xf2, 0xa8, 0xaf, 0xcf, 0xdd, 0x8e, 0x86, 0xed, 0xa3, 0x4e, 0x75, 0x4c, 0x2a, 0xb0, 0xe4, 0xea,
+		0x4b, 0x56, 0xf0, 0x8c, 0x81, 0xbc, 0xcb, 0x20, 0xea, 0x3e, 0xea, 0x18, 0xea, 0x41, 0xaa, 0x4d,
+		0xff, 0xab, 0x29, 0x58, 0x49, 0xb1, 0x94, 0x0b, 0xa8, 0x9c, 0x6d, 0xca, 0x61, 0xd9, 0x76, 0x0f,
+		0x66, 0x03, 0xb4, 0x7e, 0xbf, 0x8b, 0xf8, 0x46, 0xac, 0xe7, 0x62, 0xdc, 0xe9, 0x77, 0x91, 0x31,
+		0xf3, 0x22, 0xf2, 0x4b, 0xd5, 0x61, 0x56, 0xc6, 0xf5, 0x69, 0x27, 0xc2, 0xed, 0x67, 0x70, 0xac,
+		0xeb, 0xa1, 0x03, 0xdb, 0xed, 0xe1, 0x06, 0x26, 0x6e, 0x0e, 0xb2, 0xc2, 0xf1, 0x47, 0xe8, 0xbc,
+		0xab, 0xa9, 0xcb, 0x52, 0xdd, 0xf1, 0x2f, 0xbf, 0xf5, 0x8c, 0xf8, 0x4a, 0xc6, 0xb2, 0x80, 0xde,
+		0x66, 0xc0, 0x02, 0xef, 0x1b, 0x70, 0x94, 0x5e, 0xed, 0xd8, 0x5d, 0x2c, 0xc0, 0x38, 0x46, 0x29,
+		0x98, 0x27, 0x5d, 0xf7, 0x48, 0x8f, 0x18, 0x7e, 0x0d, 0xa6, 0xe8, 0x35, 0xad, 0x6d, 0x63, 0x9f,
+		0x5e, 0x56, 0xa7, 0x37, 0x4f, 0xc8, 0x3d, 0x08, 0x21, 0xf2, 0x93, 0x3e, 0xff, 0x4b, 0xbd, 0x0f,
+		0xf3, 0x98, 0xaa, 0x43, 0x23, 0x44, 0x31, 0x51, 0x04, 0x45, 0x05, 0xc7, 0xb4, 0x48, 0x7d, 0x0b,
+		0x96, 0x9b, 0x6d, 0x9b, 0x50, 0xda, 0xb6, 0x77, 0x3d, 0xd3, 0xeb, 0x37, 0xb8, 0x3c, 0xd0, 0xeb,
+		0xe8, 0x94, 0xb1, 0xc8, 0x7a, 0x1f, 0xb0, 0x4e, 0x2e, 0x3f, 0x11, 0xa8, 0x16, 0x32, 0xfd, 0x9e,
+		0x87, 0x02, 0xa8, 0xa9, 0x28, 0xd4, 0x3d, 0xd6, 0x29, 0xa0, 0x4e, 0xc1, 0x34, 0x87, 0xb2, 0x3b,
+		0xdd, 0x76, 0x15, 0xe8, 0x50, 0x60, 0x4d, 0xf5, 0x4e, 0xb7, 0xad, 0x62, 0x38, 0x97, 0x5c, 0x55,
+		0x03, 0x37, 0xf7, 0x91, 0xd5, 0x6b, 0xa3, 0x86, 0xef, 0xb2, 0xcd, 0xa2, 0xb1, 0x02, 0xb7, 0xe7,
+		0x57, 0xa7, 0x07, 0x5d, 0x6b, 0x4f, 0xc7, 0xd7, 0xba, 0xcd, 0x31, 0xed, 0xb8, 0x74, 0xdf, 0x76,
+		0x18, 0x1a, 0xe2, 0xef, 0xb0, 0xad, 0x22, 0xf2, 0x1f, 0x2e, 0x64, 0x86, 0x86, 0x2b, 0x16, 0x68,
+		0xd7, 0xb6, 0xef, 0x86, 0xab, 0xc8, 0xd2, 0xd5, 0xd9, 0x4c, 0x5d, 0x7d, 0x00, 0x95, 0x40, 0xb6,
+		0x31, 0x51, 0xa6, 0x6a, 0x85, 0x86, 0x26, 0xce, 0xc4, 0xb7, 0x8a, 0xc5, 0x8b, 0xa2, 0xf2, 0xcd,
+		0x34, 0x6f, 0xf6, 0x45, 0xf4, 0xa7, 0xda, 0x84, 0xc5, 0x00, 0x5b, 0xb3, 0xed, 0x62, 0xc4, 0x71,
+		0xce, 0x51, 0x9c, 0x17, 0x0b, 0x7a, 0x23, 0x04, 0x90, 0xe0, 0xeb, 0x61, 0x23, 0xd0, 0xe7, 0xa0,
+		0x91, 0x68, 0xf9, 0x42, 0xdc, 0xbc, 0x10, 0x17, 0x61, 0x5e, 0x76, 0xe0, 0x86, 0x54, 0xc7, 0x8c,
+		0x8b, 0x8d, 0xb0, 0x31, 0x7f, 0x90, 0x68, 0x51, 0xaf, 0xc3, 0xaa, 0x8d, 0x1b, 0x6c, 0x5b, 0x22,
+		0x7b, 0x8c, 0x1c, 0x62, 0x67, 0xac, 0xea, 0x02, 0xf5, 0x31, 0x57, 0x6c, 0x1c, 0x37, 0xf5, 0x77,
+		0x59, 0x37, 0xb9, 0x06, 0x09, 0x5b, 0x87, 0xed, 0x8f, 0x51, 0x55, 0x65, 0xaa, 0xcd, 0xdb, 0xb6,
+		0xed, 0x8f, 0x91, 0xfe, 0x63, 0x05, 0x56, 0x9e, 0xb8, 0xed, 0xf6, 0x4f, 0xd7, 0x69, 0xa0, 0xff,
+		0x70, 0x12, 0xaa, 0xe9, 0x65, 0x7f, 0x6d, 0xb1, 0xbf, 0xb6, 0xd8, 0x5f, 0x45, 0x8b, 0x9d, 0xa5,
+		0x1f, 0x33, 0x99, 0x16, 0x58, 0x6a, 0xce, 0x66, 0x0f, 0x6d, 0xce, 0xbe, 0x7c, 0x86, 0x5d, 0xff,
+		0xcf, 0x12, 0xac, 0x19, 0xa8, 0xe9, 0x7a, 0x56, 0x34, 0xdc, 0xcb, 0xd5, 0xe2, 0xf3, 0xb4, 0x94,
+		0xa7, 0x60, 0x3a, 0x10, 0x9c, 0xc0, 0x08, 0x80, 0x68, 0xaa, 0x5b, 0xea, 0x0a, 0x4c, 0x50, 0x19,
+		0xe3, 0x1a, 0x5f, 0x36, 0xc6, 0xc9, 0xcf, 0xba, 0xa5, 0x9e, 0x00, 0xe0, 0xf7, 0x08, 0xa1, 0xbb,
+		0x53, 0xc6, 0x14, 0x6f, 0xa9, 0x5b, 0xaa, 0x01, 0x33, 0x5d, 0xb7, 0xdd, 0x6e, 0xf0, 0x96, 0xea,
+		0x78, 0xce, 0x5d, 0x85, 0xd8, 0xd0, 0x7b, 0xae, 0x17, 0x65, 0x8d, 0xb8, 0xab, 0x4c, 0x13, 0x24,
+		0x82, 0x41, 0x67, 0x61, 0x8e, 0xa5, 0xb5, 0x1a, 0xbb, 0x3d, 0x12, 0x2a, 0xb1, 0x2d, 0xaa, 0xcb,
+		0x53, 0x6c, 0x4b, 0x91, 0x77, 0x9b, 0xb4, 0xd6, 0x2d, 0xfd, 0x9f, 0xa6, 0x60, 0x3d, 0x87, 0xdb,
+		0xdc, 0x40, 0xa7, 0x2c, 0xa9, 0x32, 0x9a, 0x25, 0xcd, 0xb5, 0x92, 0xa5, 0xd1, 0xad, 0xe4, 0x37,
+		0x40, 0x15, 0xfb, 0x60, 0x25, 0xcd, 0xf4, 0x7c, 0xd0, 0x23, 0x46, 0x6f, 0x10, 0x43, 0x27, 0x31,
+		0xd1, 0x65, 0xa3, 0xc2, 0xdb, 0xc5, 0xc8, 0x94, 0xe5, 0x1f, 0x4b, 0x5b, 0xfe, 0x48, 0x02, 0x69,
+		0x3c, 0x9e, 0x40, 0xba, 0x0a, 0x55, 0x6e, 0x7a, 0xc2, 0x40, 0x89, 0x70, 0x24, 0x26, 0xa8, 0x23,
+		0xb1, 0xcc, 0xfa, 0x03, 0x19, 0x13, 0x7e, 0x84, 0x01, 0xb3, 0x41, 0xa2, 0x84, 0x86, 0x56, 0x58,
+		0xe6, 0xe5, 0x8d, 0x2c, 0xad, 0xdd, 0xf1, 0x4c, 0x07, 0xdb, 0xc8, 0xf1, 0x63, 0xe1, 0x84, 0x19,
+		0x2b, 0xf2, 0x4b, 0xfd, 0x10, 0x8e, 0x4b, 0x02, 0x37, 0xa1, 0xa9, 0x9f, 0x2a, 0x62, 0xea, 0x8f,
+		0xa5, 0xd4, 0x42, 0x74, 0x65, 0x79, 0xa9, 0x90, 0xe5, 0xa5, 0xae, 0xc3, 0x4c, 0xcc, 0x36, 0x4e,
+		0x53, 0xdb, 0x38, 0xbd, 0x1b, 0x31, 0x8a, 0xb7, 0xa0, 0x12, 0x6e, 0x2b, 0x4d, 0xc0, 0xcd, 0x0c,
+		0x4c, 0xc0, 0xcd, 0x06, 0x10, 0xa4, 0x4d, 0xbd, 0x01, 0x33, 0x62, 0xaf, 0x29, 0x82, 0xd9, 0x81,
+		0x08, 0xa6, 0xf9, 0x78, 0x0a, 0x6e, 0xc2, 0x04, 0x89, 0x38, 0x10, 0x63, 0x5c, 0xa1, 0x71, 0xa2,
+		0xfb, 0x99, 0x01, 0xf5, 0x81, 0x5a, 0x44, 0x43, 0x19, 0x36, 0xc2, 0x2c, 0x84, 0x2e, 0xf0, 0xa6,
+		0x7c, 0xc6, 0xb9, 0x94, 0xcf, 0x48, 0xa8, 0xe8, 0x75, 0x2d, 0xd3, 0xa7, 0x1e, 0xee, 0x61, 0xa9,
+		0x78, 0x9f, 0x61, 0xe2, 0x54, 0x70, 0xbc, 0xda, 0x87, 0x30, 0x13, 0x25, 0x4f, 0x12, 0x95, 0xbf,
+		0x1a, 0x8d, 0xca, 0x67, 0x45, 0x6b, 0x84, 0xee, 0xb3, 0xa8, 0x4d, 0x18, 0xb9, 0xd7, 0x9a, 0x30,
+		0x13, 0x9d, 0x58, 0x82, 0xff, 0x46, 0x1c, 0xff, 0xab, 0x99, 0x4b, 0x14, 0x73, 0x30, 0x7c, 0xd1,
+		0xf4, 0xc0, 0x3f, 0x04, 0x47, 0x87, 0x08, 0x04, 0x7e, 0x7d, 0x74, 0xa4, 0x8e, 0x8e, 0x28, 0x6b,
+		0x64, 0x47, 0x87, 0xfe, 0xaf, 0x65, 0x71, 0x24, 0x48, 0xb9, 0xc8, 0x8f, 0x84, 0xf7, 0x60, 0x2e,
+		0x61, 0x72, 0x73, 0x0f, 0x05, 0x1e, 0xbc, 0xa1, 0x46, 0xd3, 0xa8, 0xc4, 0x4d, 0x72, 0x4a, 0x49,
+		0x4b, 0xc3, 0x29, 0x69, 0xc4, 0x02, 0x97, 0xe3, 0x16, 0xf8, 0x43, 0x38, 0x19, 0x37, 0x20, 0x0d,
+		0xb7, 0xd5, 0xf0, 0xf7, 0x6d, 0xdc, 0x88, 0xe6, 0xfc, 0xf3, 0xa7, 0xd2, 0x62, 0x06, 0xe5, 0x71,
+		0x6b, 0x67, 0xdf, 0xc6, 0xb7, 0x38, 0xfe, 0x3a, 0x2c, 0xec, 0x23, 0xd3, 0xf3, 0x77, 0x91, 0xe9,
+		0x37, 0x2c, 0xe4, 0x9b, 0x76, 0x1b, 0x57, 0xc7, 0x0a, 0x04, 0x44, 0xe7, 0x03, 0xb0, 0x3b, 0x0c,
+		0x2a, 0x7d, 0xc4, 0x8e, 0x8f, 0x76, 0xc4, 0xbe, 0x0a, 0x73, 0xe2, 0x77, 0x83, 0x89, 0x35, 0x3f,
+		0xf8, 0x03, 0x47, 0xf0, 0x0e, 0x6d, 0xd5, 0xff, 0x40, 0x81, 0x57, 0xd8, 0x6e, 0xc6, 0xcc, 0x05,
+		0x4f, 0xdd, 0x87, 0xfa, 0x62, 0x24, 0x83, 0xa8, 0x57, 0xb3, 0x82, 0xa8, 0x83, 0x50, 0x15, 0x8c,
+		0xa6, 0xfe, 0x45, 0x19, 0x4e, 0xe7, 0x63, 0xe3, 0x22, 0x88, 0xc2, 0x73, 0xdc, 0xe3, 0x6d, 0x9c,
+		0xc4, 0x6b, 0xa3, 0xdb, 0x47, 0x63, 0x0e, 0x27, 0x24, 0xfd, 0x07, 0x0a, 0x9c, 0x0c, 0xd3, 0x10,
+		0xe4, 0xce, 0x60, 0xd9, 0xb8, 0x6b, 0xfa, 0xcd, 0xfd, 0x46, 0xdb, 0x6d, 0x9a, 0xed, 0x76, 0xbf,
+		0x5a, 0xa2, 0x56, 0xf9, 0xc3, 0x9c, 0x59, 0x07, 0x2f, 0xa7, 0x16, 0xe6, 0x29, 0x76, 0xdc, 0x3b,
+		0x7c, 0x86, 0x07, 0x6c, 0x02, 0x66, 0xac, 0x57, 0xcd, 0xec, 0x11, 0xda, 0x2f, 0xc3, 0xda, 0x20,
+		0x04, 0x12, 0xa3, 0x7b, 0x27, 0x6e, 0x74, 0xe5, 0x59, 0x10, 0x61, 0x06, 0x28, 0x2e, 0x81, 0x98,
+		0x7a, 0x18, 0x11, 0xdb, 0x4b, 0xd2, 0x67, 0x92, 0x65, 0x92, 0xa2, 0x12, 0x64, 0x0d, 0x99, 0x3e,
+		0x1b, 0x84, 0xa7, 0xa0, 0x20, 0xbd, 0x02, 0xeb, 0x39, 0x98, 0x78, 0x70, 0xfe, 0xf7, 0x14, 0xd0,
+		0xd3, 0xd6, 0xee, 0x5d, 0xa1, 0x9e, 0x82, 0xf2, 0xa7, 0x49, 0xca, 0xaf, 0x64, 0x50, 0x3e, 0x08,
+		0x53, 0x41, 0xda, 0x9f, 0xc0, 0x2b, 0xb9, 0xb8, 0xb8, 0x6c, 0xbe, 0x06, 0xf3, 0x4d, 0xd3, 0x69,
+		0xa2, 0xe0, 0x04, 0x40, 0xec, 0x4c, 0x9b, 0x34, 0xe6, 0x58, 0xbb, 0x21, 0x9a, 0xa3, 0xfa, 0x1e,
+		0xc5, 0x79, 0x48, 0x7d, 0xcf, 0x43, 0x55, 0x70, 0xa9, 0x67, 0xe1, 0x74, 0x3e, 0xb2, 0x48, 0x82,
+		0x56, 0x32, 0xf0, 0x30, 0x12, 0x96, 0x89, 0x67, 0x68, 0x09, 0x93, 0x61, 0x8a, 0x49, 0x58, 0x7a,
+		0x81, 0x74, 0x7f, 0x90, 0x35, 0xb4, 0x84, 0x0d, 0xc2, 0x54, 0x90, 0xf6, 0x33, 0xf0, 0x4a, 0x2e,
+		0x2e, 0x4e, 0xfd, 0x5f, 0x2a, 0x70, 0xca, 0x40, 0x1d, 0xf7, 0x00, 0xb1, 0xca, 0x8b, 0x2f, 0x4a,
+		0xdc, 0x32, 0xee, 0x18, 0x95, 0x13, 0x8e, 0x91, 0xae, 0xc3, 0x5a, 0x36, 0xd5, 0x7c, 0x69, 0x7f,
+		0x53, 0x82, 0x33, 0x7c, 0x09, 0x6c, 0xd9, 0x99, 0x69, 0xff, 0xdc, 0x05, 0x9a, 0x50, 0x89, 0xeb,
+		0x60, 0xb5, 0x24, 0x3b, 0x84, 0x82, 0xfd, 0x2b, 0x30, 0xa1, 0x31, 0x1b, 0xd3, 0x5e, 0x92, 0x74,
+		0x0f, 0x2a, 0x2b, 0xa4, 0x45, 0x90, 0xf2, 0xa4, 0xfb, 0x5d, 0x0e, 0x93, 0x48, 0xba, 0x23, 0x59,
+		0xf3, 0xd0, 0x55, 0x15, 0x1b, 0x70, 0x76, 0xd0, 0x5a, 0x38, 0x9f, 0xff, 0x4e, 0x81, 0x55, 0x11,
+		0x28, 0x93, 0x04, 0x2e, 0x3e, 0x17, 0xf1, 0x39, 0x07, 0x0b, 0x36, 0x6e, 0xc4, 0x6b, 0x12, 0x29,
+		0x2f, 0x27, 0x8d, 0x39, 0x1b, 0xdf, 0x8b, 0x56, 0x1b, 0xea, 0x27, 0xe1, 0xb8, 0x9c, 0x7c, 0xbe,
+		0xbe, 0x4f, 0xa9, 0xc3, 0x42, 0x8c, 0x75, 0xbc, 0x50, 0x20, 0x65, 0x5a, 0x3f, 0x8f, 0x85, 0xae,
+		0xc3, 0x0c, 0x2f, 0x38, 0x45, 0x56, 0x24, 0x76, 0x1d, 0xb4, 0xd5, 0x2d, 0xf5, 0x03, 0x38, 0xda,
+		0x14, 0xa4, 0x46, 0xa6, 0x3e, 0x32, 0xd4, 0xd4, 0x6a, 0x80, 0x22, 0x9c, 0xfb, 0x01, 0xcc, 0x47,
+		0x8a, 0x48, 0xd9, 0x25, 0x61, 0xac, 0xe8, 0x25, 0x61, 0x2e, 0x04, 0xa5, 0x0d, 0x44, 0xe3, 0x85,
+		0xbb, 0x67, 0x5b, 0xd4, 0x3d, 0x2e, 0x1b, 0x53, 0xbc, 0xa5, 0x6e, 0xe9, 0xaf, 0xc2, 0x99, 0x01,
+		0x9b, 0xc0, 0xb7, 0xeb, 0xdf, 0x4b, 0x50, 0x35, 0x78, 0x85, 0x35, 0xa2, 0xa8, 0xf1, 0xb3, 0xcd,
+		0xcf, 0x73, 0x8b, 0x7e, 0x11, 0x96, 0x64, 0x99, 0x72, 0x51, 0xf1, 0x32, 0x44, 0xaa, 0xfc, 0x68,
+		0x3a, 0x55, 0x8e, 0xd5, 0x4b, 0x30, 0x4e, 0x59, 0x8f, 0xab, 0x47, 0x72, 0x42, 0x3c, 0x77, 0x4c,
+		0xdf, 0xbc, 0xdd, 0x76, 0x77, 0x0d, 0x3e, 0x58, 0xdd, 0x82, 0x0a, 0xa9, 0x56, 0x26, 0xd5, 0x67,
+		0x1c, 0x7c, 0xac, 0x08, 0xf8, 0x8c, 0x83, 0x5e, 0x18, 0x3d, 0xb6, 0x65, 0x58, 0x5f, 0x85, 0x63,
+		0x12, 0x56, 0xf3, 0x8d, 0xf8, 0xae, 0x02, 0xcb, 0xdb, 0x7d, 0xa7, 0xb9, 0xbd, 0x6f, 0x7a, 0x16,
+		0x8f, 0x08, 0xf3, 0x6d, 0x38, 0x03, 0x15, 0xec, 0xf6, 0xbc, 0x26, 0x6a, 0xf0, 0xc2, 0x7b, 0xbe,
+		0x17, 0xb3, 0xac, 0x75, 0x8b, 0x35, 0xaa, 0xc7, 0x60, 0x92, 0x04, 0xc1, 0x2c, 0x71, 0xbe, 0x8d,
+		0x19, 0x13, 0xf4, 0x77, 0xdd, 0x52, 0x6b, 0x70, 0x84, 0xde, 0x25, 0xcb, 0x03, 0x2f, 0x78, 0x74,
+		0x9c, 0x7e, 0x0c, 0x56, 0x52, 0xb4, 0x70, 0x3a, 0xff, 0x71, 0x0c, 0x8e, 0x92, 0x3e, 0x71, 0x4e,
+		0x7e, 0x9e, 0xb2, 0x52, 0x85, 0x09, 0x11, 0x59, 0x63, 0x9a, 0x2c, 0x7e, 0x12, 0x45, 0x0f, 0xef,
+		0xba, 0x41, 0x1c, 0x21, 0x88, 0x3b, 0x10, 0x9e, 0xa4, 0xe3, 0x69, 0x63, 0xc3, 0xc6, 0xd3, 0xf2,
+		0x95, 0x30, 0x75, 0x93, 0x9f, 0x18, 0xee, 0x26, 0xff, 0x1e, 0xcf, 0x76, 0x85, 0x97, 0x6a, 0x8a,
+		0x65, 0x72, 0x20, 0x96, 0x05, 0x02, 0x16, 0xb8, 0xc7, 0x14, 0xd7, 0x65, 0x98, 0x10, 0x37, 0xf2,
+		0xa9, 0x02, 0x37, 0x72, 0x31, 0x38, 0x1a, 0x4d, 0x80, 0x78, 0x34, 0xe1, 0x26, 0xcc, 0xb0, 0x5c,
+		0x1c, 0x2f, 0xaf, 0x9f, 0x2e, 0x50, 0x5e, 0x3f, 0x4d, 0x53, 0x74, 0xec, 0x07, 0x49, 0x0b, 0x51,
+		0x04, 0x3c, 0x32, 0x6f, 0x5b, 0xc8, 0xf1, 0x6d, 0xbf, 0x4f, 0xa3, 0x9a, 0x53, 0x86, 0x4a, 0xfa,
+		0x3e, 0xa0, 0x5d, 0x75, 0xde, 0xa3, 0x3e, 0x82, 0xb9, 0x84, 0x69, 0xe0, 0x11, 0xcc, 0x33, 0x85,
+		0x8c, 0x82, 0x51, 0x89, 0x1b, 0x04, 0x7d, 0x19, 0x16, 0xe3, 0x92, 0xcc, 0x45, 0xfc, 0x77, 0x15,
+		0x58, 0x15, 0x95, 0x86, 0x5f, 0x10, 0x0f, 0x4f, 0xff, 0x6d, 0x05, 0x8e, 0xcb, 0x69, 0xe2, 0x97,
+		0x9f, 0x37, 0x61, 0xb9, 0xc3, 0xda, 0x59, 0x1e, 0xaa, 0x61, 0x3b, 0x8d, 0xa6, 0xd9, 0xdc, 0x47,
+		0x9c, 0xc2, 0xa3, 0x9d, 0x08, 0x54, 0xdd, 0xd9, 0x22, 0x5d, 0xea, 0xdb, 0x70, 0x2c, 0x05, 0x64,
+		0x99, 0xbe, 0xb9, 0x6b, 0x62, 0x51, 0x70, 0xbc, 0x1c, 0x87, 0xbb, 0xc3, 0x7b, 0xf5, 0xe3, 0xa0,
+		0x09, 0x7a, 0x38, 0x3f, 0xdf, 0x75, 0x83, 0x52, 0x31, 0xfd, 0x57, 0x4a, 0xb0, 0x2a, 0xed, 0xe6,
+		0xd4, 0x6e, 0xc0, 0xbc, 0xd3, 0xeb, 0xec, 0x22, 0x8f, 0xc4, 0xa0, 0xa8, 0x95, 0xc2, 0x94, 0xce,
+		0x31, 0xa3, 0xc2, 0xda, 0x1f, 0xb7, 0xa8, 0xf1, 0xc1, 0x84, 0xd9, 0xc2, 0xaa, 0x61, 0x1a, 0x5a,
+		0x18, 0x33, 0x26, 0xb9, 0x59, 0xc3, 0x6a, 0x1d, 0x66, 0xf8, 0x4e, 0xb0, 0xa5, 0xca, 0xab, 0x6a,
+		0x85, 0x38, 0xb0, 0x58, 0x0f, 0x5d, 0x39, 0xf5, 0xfd, 0xa6, 0xad, 0xb0, 0x41, 0xbd, 0x0c, 0x2b,
+		0x6c, 0x9e, 0xa6, 0xeb, 0xf8, 0x9e, 0xdb, 0x6e, 0x23, 0x8f, 0xf2, 0xa4, 0x87, 0x79, 0xfd, 0xf6,
+		0x12, 0xed, 0xde, 0x0a, 0x7a, 0x99, 0x5d, 0xa4, 0x1a, 0x62, 0x59, 0x1e, 0xc2, 0x98, 0x07, 0x24,
+		0xc5, 0x4f, 0xbd, 0x06, 0x0b, 0x2c, 0x93, 0x47, 0xe0, 0x84, 0xec, 0x44, 0x8d, 0xb4, 0x12, 0x33,
+		0xd2, 0xfa, 0x22, 0xa8, 0xd1, 0xf1, 0x5c, 0x18, 0xff, 0x4b, 0x81, 0x05, 0xe6, 0xbc, 0x47, 0xbd,
+		0xc4, 0x6c, 0x34, 0xea, 0x75, 0x9e, 0xf5, 0x0e, 0x92, 0xfc, 0x95, 0xcd, 0x53, 0x19, 0x0c, 0x21,
+		0x18, 0x69, 0xd4, 0x6c, 0xd2, 0xe7, 0x7f, 0x45, 0x63, 0xaf, 0xe5, 0x58, 0xec, 0x75, 0x0b, 0xe6,
+		0x0e, 0x6c, 0x6c, 0xef, 0xda, 0x6d, 0xdb, 0xef, 0x33, 0x4b, 0x34, 0x38, 0x5c, 0x58, 0x09, 0x41,
+		0x48, 0x23, 0x31, 0xcb, 0xfc, 0x08, 0x6b, 0x38, 0x26, 0xb7, 0xb8, 0x53, 0xc6, 0x34, 0x6f, 0x7b,
+		0x64, 0x76, 0x10, 0xe1, 0x42, 0x74, 0xb9, 0x9c, 0x0b, 0xdf, 0xa3, 0x5c, 0xc0, 0xc8, 0x7f, 0xda,
+		0x43, 0x3d, 0x54, 0x80, 0x0b, 0xc9, 0x99, 0x4a, 0xa9, 0x99, 0xe2, 0x8c, 0x2a, 0x0f, 0xc9, 0x28,
+		0x46, 0x67, 0x48, 0x10, 0xa7, 0xf3, 0xfb, 0x0a, 0x2c, 0x0a, 0xb9, 0xff, 0xc2, 0x90, 0xfa, 0x18,
+		0x96, 0x12, 0x34, 0x71, 0x2d, 0xbc, 0x0c, 0x2b, 0x5d, 0xcf, 0x6d, 0x22, 0x8c, 0x49, 0xa5, 0x2e,
+		0x7d, 0x8b, 0xc7, 0xec, 0x00, 0x51, 0xc6, 0x32, 0x91, 0xf9, 0xb0, 0x9b, 0x42, 0x52, 0x23, 0x80,
+		0xf5, 0x4f, 0x15, 0x38, 0x71, 0x1f, 0xf9, 0x46, 0xf8, 0x32, 0xef, 0x21, 0xc2, 0xd8, 0xdc, 0x43,
+		0x81, 0xcb, 0x72, 0x13, 0xc6, 0x69, 0x22, 0x8b, 0x21, 0x4a, 0x25, 0x30, 0x02, 0x6a, 0x23, 0x28,
+		0x68, 0x96, 0xcb, 0xe0, 0x60, 0x05, 0x98, 0x42, 0x6c, 0xcc, 0xc9, 0x2c, 0x2a, 0xf8, 0x02, 0x3f,
+		0x82, 0x0a, 0xe3, 0x7a, 0x87, 0xf7, 0x70, 0x72, 0xde, 0xcb, 0x0c, 0x4e, 0xe6, 0x23, 0xac, 0x51,
+		0xdd, 0x14, 0xad, 0x2c, 0x10, 0x39, 0x8b, 0xa3, 0x6d, 0x5a, 0x1b, 0xd4, 0xf4, 0xa0, 0x68, 0xb0,
+		0x71, 0x8c, 0x05, 0x1b, 0xbf, 0x15, 0x0f, 0x36, 0x9e, 0x1b, 0xcc, 0xa0, 0x80, 0x98, 0x48, 0xa0,
+		0xb1, 0x03, 0x6b, 0xf7, 0x91, 0x7f, 0xe7, 0xc1, 0xd3, 0x9c, 0xbd, 0xa8, 0x03, 0x30, 0x95, 0x76,
+		0x5a, 0xae, 0x60, 0x40, 0x81, 0xe9, 0x88, 0x20, 0x51, 0x33, 0x39, 0xe5, 0xf3, 0xbf, 0xb0, 0xfe,
+		0x12, 0xd6, 0x73, 0xa6, 0xe3, 0x4c, 0xdf, 0x86, 0x85, 0xc8, 0x9b, 0x4d, 0x9a, 0x54, 0x15, 0xd3,
+		0x9e, 0x2d, 0x36, 0xad, 0x31, 0xef, 0xc5, 0x1b, 0xb0, 0xfe, 0x2f, 0x0a, 0x2c, 0x1a, 0xc8, 0xec,
+		0x76, 0xdb, 0xec, 0x46, 0x14, 0xac, 0x6e, 0x19, 0xc6, 0x79, 0x64, 0x9f, 0x9d, 0x73, 0xfc, 0x57,
+		0xfe, 0xe3, 0x0c, 0xf9, 0x21, 0x5d, 0x3e, 0xac, 0x3f, 0x3a, 0xda, 0xe5, 0x42, 0x5f, 0x81, 0xa5,
+		0xc4, 0xd2, 0xb8, 0x35, 0xf9, 0x91, 0x42, 0x6a, 0xa9, 0x5b, 0x1e, 0xc2, 0xfb, 0x41, 0x92, 0x83,
+		0x70, 0xe3, 0x0b, 0xb8, 0x76, 0x12, 0x17, 0x90, 0x93, 0xca, 0xd7, 0xf2, 0x36, 0xac, 0x6c, 0xb9,
+		0x3d, 0x87, 0x08, 0x4f, 0x52, 0x40, 0x4f, 0x02, 0xb4, 0x5c, 0xaf, 0x89, 0xee, 0x21, 0xbf, 0xb9,
+		0xcf, 0x23, 0xb6, 0x91, 0x16, 0xdd, 0x84, 0x6a, 0x1a, 0x94, 0x0b, 0xdb, 0x5d, 0x98, 0x40, 0x8e,
+		0x4f, 0x73, 0xd2, 0x4c, 0xc4, 0x5e, 0xcf, 0x10, 0x31, 0xee, 0x85, 0xdc, 0x79, 0xf0, 0x94, 0xe2,
+		0xe2, 0x19, 0x5f, 0x0e, 0xab, 0xff, 0xa8, 0x04, 0xcb, 0x06, 0x32, 0x2d, 0x09, 0x75, 0x9b, 0x70,
+		0x24, 0xa8, 0xf2, 0xa8, 0x6c, 0x9e, 0xcc, 0xf2, 0x2d, 0x1e, 0x3c, 0xa5, 0x56, 0x97, 0x8e, 0xcd,
+		0xbb, 0x8a, 0xa5, 0x2f, 0x73, 0x65, 0xd9, 0x65, 0x6e, 0x07, 0xaa, 0xb6, 0x43, 0x46, 0xd8, 0x07,
+		0xa8, 0x81, 0x9c, 0xc0, 0x82, 0x15, 0xac, 0xa0, 0x5b, 0x0a, 0x80, 0xef, 0x3a, 0xc2, 0x14, 0xd5,
+		0x2d, 0x22, 0x18, 0x5d, 0x82, 0x84, 0xe6, 0xd6, 0xc7, 0x28, 0x61, 0x93, 0xa4, 0x81, 0x26, 0xd6,
+		0xcf, 0xc2, 0x1c, 0xad, 0xef, 0xa0, 0x23, 0x58, 0x19, 0xc2, 0x38, 0x2d, 0x43, 0xa0, 0x65, 0x1f,
+		0x4f, 0xcc, 0x3d, 0xc4, 0xaa, 0x17, 0xff, 0xbc, 0x04, 0x2b, 0x29, 0x5e, 0xf1, 0xed, 0x18, 0x85,
+		0x59, 0x52, 0x7b, 0x51, 0x3a, 0x9c, 0xbd, 0x50, 0xbf, 0x03, 0xcb, 0x29, 0xa4, 0x22, 0x46, 0x38,
+		0xac, 0x01, 0x5c, 0x4c, 0x62, 0x27, 0xad, 0x32, 0x76, 0x1d, 0x91, 0xb1, 0xeb, 0xdf, 0x48, 0x8d,
+		0x6b, 0xcf, 0xdb, 0x43, 0x5f, 0x6d, 0xd9, 0xd2, 0x35, 0xa8, 0xa6, 0x97, 0xc9, 0x95, 0xff, 0xb3,
+		0x12, 0xac, 0x3c, 0x44, 0x5f, 0x79, 0x1e, 0xfc, 0xdf, 0xe8, 0xd7, 0x6d, 0xa8, 0x3e, 0x44, 0x72,
+		0x46, 0xca, 0x70, 0x28, 0x32, 0x1c, 0x9f, 0x28, 0x70, 0xfc, 0x91, 0xeb, 0xdb, 0xad, 0x3e, 0xb9,
+		0x6e, 0xbb, 0x07, 0xc8, 0x7b, 0x68, 0x92, 0xbb, 0x74, 0xc0, 0xf5, 0xef, 0xc0, 0x72, 0x8b, 0xf7,
+		0x34, 0x3a, 0xb4, 0xab, 0x11, 0x73, 0xd8, 0xb2, 0xf4, 0x23, 0x8e, 0x8e, 0x4e, 0x66, 0x2c, 0xb6,
+		0xd2, 0x8d, 0x58, 0x3f, 0x05, 0x27, 0x32, 0x28, 0xe0, 0x42, 0x61, 0xc2, 0xea, 0x7d, 0xe4, 0x6f,
+		0x79, 0x2e, 0xc6, 0x7c, 0x57, 0x62, 0x87, 0x5b, 0xec, 0xe2, 0xa7, 0x24, 0x2e, 0x7e, 0x67, 0xa0,
+		0xe2, 0x9b, 0xde, 0x1e, 0xf2, 0x83, 0x5d, 0x66, 0xc7, 0xdc, 0x2c, 0x6b, 0xe5, 0xf8, 0xf4, 0x1f,
+		0x97, 0xe1, 0xb8, 0x7c, 0x0e, 0xce, 0xcf, 0x0e, 0x54, 0x98, 0x69, 0xd8, 0xed, 0xb3, 0x6b, 0x68,
+		0x55, 0x19, 0x50, 0x53, 0x94, 0x87, 0x8e, 0x3a, 0xdf, 0xf8, 0x76, 0x9f, 0x3a, 0x80, 0xec, 0x84,
+		0x99, 0xf1, 0x23, 0x4d, 0xe4, 0x71, 0xf2, 0x52, 0x8b, 0x26, 0xc4, 0x1a, 0x4d, 0xb3, 0x87, 0x51,
+		0x38, 0x2d, 0xb3, 0x77, 0x0f, 0x47, 0x9b, 0x96, 0xe5, 0xd8, 0xb6, 0x08, 0xc6, 0xd8, 0xe4, 0x6a,
+		0x2b, 0xd5, 0xa1, 0x75, 0x61, 0x21, 0x45, 0xa5, 0xc4, 0x3d, 0xbd, 0x1b, 0x77, 0x4f, 0xcf, 0x67,
+		0x88, 0x43, 0x92, 0x26, 0xbe, 0x79, 0x51, 0x1f, 0x55, 0xeb, 0xc2, 0x4a, 0x06, 0x81, 0x92, 0x79,
+		0x6f, 0x46, 0xe7, 0xad, 0x64, 0x86, 0x7b, 0xef, 0x23, 0x3f, 0x4c, 0x2e, 0x52, 0xbc, 0x51, 0xaf,
+		0xf8, 0x3f, 0x14, 0xd8, 0xe0, 0xe9, 0xbc, 0x14, 0xd3, 0x52, 0x79, 0x88, 0x9c, 0x9b, 0x59, 0x31,
+		0x29, 0x53, 0x9f, 0x31, 0x21, 0x0a, 0xea, 0x2e, 0x44, 0xac, 0xba, 0x38, 0xd3, 0x18, 0x1c, 0xc1,
+		0x1b, 0xfe, 0xc2, 0xea, 0x69, 0x98, 0x6d, 0x11, 0x07, 0xe8, 0x11, 0x62, 0xbe, 0x14, 0x4f, 0x3f,
+		0xc5, 0x1b, 0x75, 0x0f, 0x5e, 0x2b, 0xb0, 0xd6, 0xc0, 0x5d, 0x1a, 0x13, 0xfe, 0xf8, 0x68, 0xdb,
+		0x4a, 0xa1, 0xf5, 0x4b, 0xf4, 0x0d, 0x9f, 0x50, 0x6c, 0x7a, 0x48, 0x16, 0x88, 0x8d, 0xe9, 0x3e,
+		0xac, 0xa4, 0xc0, 0x02, 0xc7, 0x61, 0x29, 0x4c, 0xbb, 0x88, 0x40, 0x4c, 0x8f, 0xd7, 0x51, 0x8d,
+		0x19, 0x61, 0x4e, 0x66, 0x9b, 0x45, 0x61, 0x7a, 0x0e, 0x8d, 0x8b, 0x8b, 0x57, 0xa6, 0x3c, 0x84,
+		0xc4, 0xe2, 0x43, 0xb3, 0xbc, 0x95, 0x0e, 0xc5, 0x7a, 0x1d, 0x96, 0x0d, 0xd3, 0x47, 0x6d, 0xbb,
+		0x63, 0xfb, 0xbc, 0x4c, 0x8e, 0x13, 0x7b, 0x1e, 0x8e, 0x90, 0x68, 0x17, 0x67, 0xc6, 0x6a, 0x56,
+		0x41, 0xe9, 0x2d, 0xa7, 0x6f, 0xd0, 0x81, 0xfa, 0x7b, 0xb0, 0x92, 0x42, 0xc5, 0x17, 0x30, 0x34,
+		0xae, 0xff, 0x56, 0xc8, 0x37, 0x00, 0x7a, 0x18, 0x0d, 0x15, 0x49, 0x0f, 0x5d, 0xfe, 0x52, 0xcc,
+		0xe5, 0xff, 0x09, 0xdd, 0x68, 0x4e, 0xc1, 0x34, 0xaf, 0xb3, 0xe9, 0x8b, 0x93, 0x71, 0xca, 0x00,
+		0xd1, 0x54, 0xb7, 0x54, 0x0d, 0x26, 0x83, 0xc8, 0x2d, 0x8b, 0xe6, 0x04, 0xbf, 0x09, 0xad, 0x1e,
+		0x32, 0xb1, 0xcb, 0xce, 0xb9, 0x29, 0x83, 0xff, 0x22, 0xf7, 0x9d, 0xc4, 0xc2, 0xf9, 0x89, 0xf0,
+		0xf7, 0x25, 0x58, 0x7e, 0xdf, 0xe9, 0x7e, 0xe9, 0x99, 0x72, 0x06, 0x2a, 0x1e, 0xc2, 0xc8, 0x17,
+		0x85, 0x75, 0x2c, 0x34, 0x38, 0x69, 0xcc, 0xd2, 0x56, 0x5e, 0x2f, 0x87, 0x49, 0xf8, 0x85, 0x0d,
+		0x4b, 0x97, 0xcd, 0x8d, 0xd3, 0xf1, 0x4b, 0xb4, 0xfb, 0xdd, 0x64, 0x75, 0x5c, 0x94, 0xe7, 0x13,
+		0x71, 0x9e, 0x93, 0xcc, 0x4d, 0x8a, 0x83, 0x9c, 0xbb, 0x9f, 0x94, 0x61, 0x4e, 0x34, 0x3e, 0xee,
+		0x92, 0x95, 0xe0, 0xf8, 0x13, 0x19, 0x65, 0xb8, 0x27, 0x32, 0x3b, 0x70, 0x2c, 0xfa, 0x76, 0x84,
+		0xbd, 0x81, 0x10, 0x6f, 0x47, 0x4a, 0x83, 0xde, 0x8e, 0x2c, 0xe3, 0xe0, 0xb5, 0x08, 0x8d, 0x7a,
+		0x8a, 0xd7, 0x22, 0x8f, 0x60, 0x99, 0xbf, 0x42, 0x49, 0xa2, 0x2c, 0x0f, 0x42, 0x79, 0x94, 0x02,
+		0x26, 0xf0, 0xdd, 0x8b, 0x56, 0x25, 0x0a, 0x54, 0x47, 0x06, 0xa1, 0x0a, 0x4b, 0x12, 0x05, 0x9e,
+		0x2d, 0x98, 0xf1, 0x90, 0xef, 0xf5, 0x1b, 0x5d, 0xb7, 0x6d, 0x37, 0xfb, 0x3c, 0x59, 0xb4, 0x96,
+		0x51, 0xd6, 0xe0, 0x7b, 0xfd, 0x27, 0x74, 0x9c, 0x31, 0xed, 0x85, 0x3f, 0xf4, 0xbf, 0x2d, 0xc1,
+		0x71, 0x66, 0x37, 0x12, 0x1b, 0xf1, 0xa5, 0x14, 0xf3, 0x6d, 0x98, 0x0f, 0x06, 0xb8, 0x6c, 0x1d,
+		0xf2, 0x57, 0xfe, 0x11, 0x3f, 0x26, 0xb9, 0xee, 0x39, 0x33, 0x21, 0x91, 0x51, 0xe1, 0x1e, 0x4f,
+		0x08, 0xb7, 0x0f, 0x27, 0x32, 0xb8, 0x17, 0x84, 0x9e, 0xd2, 0x14, 0x29, 0x87, 0xa4, 0x48, 0xef,
+		0x42, 0x25, 0x5e, 0x65, 0x4d, 0x38, 0xc3, 0x4a, 0xc5, 0xc3, 0xf7, 0x1f, 0x53, 0x06, 0xb0, 0x26,
+		0x1a, 0x45, 0xbf, 0x11, 0x0c, 0x30, 0xbd, 0x3d, 0x5c, 0x2d, 0xe5, 0xe4, 0xc6, 0x44, 0xca, 0x8d,
+		0x83, 0xdf, 0xf2, 0xf6, 0xb0, 0xfe, 0x67, 0x25, 0x38, 0xc9, 0xa6, 0x1a, 0xad, 0x08, 0xe7, 0xff,
+		0x59, 0x50, 0x6e, 0xc2, 0x38, 0x23, 0x9e, 0xeb, 0x55, 0xe1, 0x6a, 0x75, 0x0e, 0x96, 0x7b, 0x88,
+		0xac, 0xc2, 0x14, 0x67, 0x25, 0x4f, 0xb1, 0x4e, 0x19, 0x93, 0xac, 0xa1, 0x6e, 0xe9, 0x1f, 0xc0,
+		0xa9, 0x4c, 0x3e, 0x71, 0x91, 0x78, 0x8b, 0x1c, 0x42, 0x85, 0xbf, 0xcd, 0xc0, 0xc7, 0x6e, 0xfe,
+		0xf5, 0x26, 0x00, 0x8f, 0x18, 0xdd, 0x7a, 0x52, 0x57, 0x7f, 0x93, 0x24, 0xe7, 0xa5, 0x5f, 0xd8,
+		0x51, 0x2f, 0x8f, 0xf6, 0x61, 0x2d, 0xed, 0xca, 0xd0, 0x70, 0x7c, 0x41, 0xbf, 0xa5, 0xc0, 0x4a,
+		0xc6, 0x57, 0x9a, 0xd4, 0x2b, 0x83, 0xbe, 0x70, 0x94, 0x45, 0xcd, 0xd5, 0xe1, 0x01, 0x39, 0x39,
+		0x3f, 0x54, 0x60, 0x6d, 0xd0, 0x67, 0x88, 0xd4, 0x6f, 0x1d, 0xf6, 0xcb, 0x4b, 0xda, 0xad, 0x43,
+		0x60, 0xe0, 0x94, 0x92, 0x4d, 0x94, 0x7f, 0x60, 0x28, 0x67, 0x13, 0x73, 0x3f, 0x6c, 0xa4, 0x5d,
+		0x19, 0x1a, 0x8e, 0xd3, 0xf2, 0xfb, 0x0a, 0x68, 0xd9, 0x9f, 0xe1, 0x51, 0xb3, 0x4b, 0xb6, 0x07,
+		0x7e, 0x9e, 0x48, 0x7b, 0x67, 0x24, 0x58, 0x4e, 0xd7, 0xf7, 0x15, 0x38, 0x96, 0xf9, 0x91, 0x1d,
+		0xf5, 0xed, 0x4c, 0xd4, 0x83, 0xbe, 0xf1, 0xa3, 0x5d, 0x1b, 0x05, 0x94, 0x13, 0xe5, 0xc0, 0x6c,
+		0xec, 0xeb, 0x2b, 0xea, 0x1b, 0x99, 0xc8, 0x64, 0x1f, 0x79, 0xd1, 0x6a, 0x45, 0x87, 0xf3, 0xf9,
+		0x3e, 0x51, 0xe0, 0xa8, 0xe4, 0x13, 0x26, 0xea, 0x9b, 0xf9, 0xbb, 0x2d, 0xfd, 0x68, 0x8a, 0xf6,
+		0xd6, 0x70, 0x40, 0x9c, 0x04, 0x1f, 0xe6, 0x12, 0x5f, 0xf4, 0x50, 0xcf, 0xe7, 0xc5, 0x06, 0x24,
+		0x65, 0x0a, 0xda, 0x85, 0xe2, 0x00, 0x7c, 0xd6, 0x17, 0x30, 0x9f, 0x7c, 0x96, 0xae, 0x66, 0x63,
+		0xc9, 0x78, 0xb8, 0xaf, 0x5d, 0x1c, 0x02, 0x22, 0x22, 0x76, 0x99, 0x8f, 0x11, 0x72, 0xc4, 0x6e,
+		0xd0, 0xd3, 0x58, 0xed, 0x10, 0x6f, 0x1f, 0xd4, 0x3f, 0x52, 0xe0, 0x38, 0xfb, 0x21, 0x7f, 0xab,
+		0xa0, 0x5e, 0x1f, 0xf1, 0x89, 0x03, 0x23, 0xed, 0xc6, 0xa1, 0x1e, 0x48, 0x70, 0x96, 0x65, 0x14,
+		0xf4, 0xe7, 0xb2, 0x2c, 0xff, 0x39, 0x81, 0x76, 0x6d, 0x14, 0xd0, 0xd4, 0x3e, 0x4a, 0x5e, 0x4b,
+		0x0d, 0xdc, 0xc7, 0xec, 0x77, 0x6a, 0xda, 0xb5, 0x51, 0x40, 0xd3, 0xfb, 0x28, 0xad, 0xa9, 0x1f,
+		0xbc, 0x8f, 0x79, 0x75, 0xfd, 0xda, 0x8d, 0x11, 0xa1, 0xd3, 0xfb, 0x98, 0x2e, 0x9b, 0x1f, 0xbc,
+		0x8f, 0x99, 0x45, 0xfb, 0xda, 0xb5, 0x51, 0x40, 0x39, 0x51, 0x7f, 0x48, 0x13, 0x8f, 0x99, 0xf5,
+		0xf0, 0xea, 0x3b, 0x43, 0xad, 0x39, 0x5e, 0x91, 0xaf, 0x5d, 0x1f, 0x0d, 0x38, 0x46, 0x5a, 0xe6,
+		0x63, 0x90, 0x5c, 0xd2, 0x06, 0x3d, 0x47, 0xd1, 0xae, 0x8f, 0x06, 0xcc, 0x49, 0xfb, 0x13, 0x05,
+		0x4e, 0x72, 0x4c, 0x19, 0x55, 0xe0, 0xea, 0x37, 0x73, 0x26, 0x28, 0x50, 0x0a, 0xaf, 0xdd, 0x1c,
+		0x19, 0x9e, 0xd3, 0xf8, 0x3d, 0x05, 0xaa, 0xac, 0xbe, 0x26, 0xfd, 0x16, 0x40, 0xbd, 0x9a, 0x83,
+		0x3d, 0xf7, 0xd1, 0x83, 0xf6, 0xf6, 0x08, 0x90, 0x9c, 0xa2, 0x4f, 0x15, 0x58, 0x94, 0x55, 0x94,
+		0xab, 0xd9, 0x27, 0x67, 0x4e, 0xfd, 0xbc, 0x76, 0x69, 0x48, 0x28, 0x4e, 0xc5, 0x1f, 0xd3, 0x2f,
+		0x61, 0xe6, 0x54, 0x4c, 0xab, 0x37, 0x06, 0xc8, 0x46, 0x7e, 0xb9, 0xbb, 0xf6, 0xcd, 0x51, 0xc1,
+		0x39, 0x81, 0x1f, 0x93, 0x02, 0xa8, 0x44, 0xf1, 0xb0, 0x7a, 0x31, 0x07, 0xa9, 0xbc, 0xa6, 0x5b,
+		0xdb, 0x1c, 0x06, 0x24, 0xf4, 0x46, 0x12, 0xe5, 0xc0, 0x39, 0xde, 0x88, 0xbc, 0x88, 0x59, 0xbb,
+		0x50, 0x1c, 0x80, 0xcf, 0xfa, 0x1c, 0x66, 0xa2, 0xe5, 0x99, 0xea, 0x37, 0x72, 0x31, 0x24, 0x02,
+		0x86, 0xda, 0x1b, 0x05, 0x47, 0x47, 0xa4, 0x50, 0x56, 0x5f, 0x99, 0x23, 0x85, 0x39, 0x25, 0xa2,
+		0xda, 0xa5, 0x21, 0xa1, 0x22, 0x9e, 0xa7, 0xa4, 0x6c, 0x32, 0xc7, 0xf3, 0xcc, 0xae, 0xc1, 0xd4,
+		0xde, 0x1a, 0x0e, 0x28, 0x78, 0x47, 0x0a, 0x61, 0x15, 0xa2, 0x7a, 0x2e, 0x13, 0x47, 0xaa, 0xb4,
+		0x51, 0x7b, 0xbd, 0xd0, 0xd8, 0x70, 0x9a, 0xb0, 0xcc, 0x2f, 0x67, 0x9a, 0x54, 0xe9, 0xa3, 0xf6,
+		0x7a, 0xa1, 0xb1, 0xd1, 0x69, 0x44, 0x95, 0x5e, 0xee, 0x34, 0x89, 0xda, 0x42, 0xed, 0xf5, 0x42,
+		0x63, 0xc3, 0x1b, 0x4a, 0xac, 0xc2, 0x2e, 0xe7, 0x86, 0x22, 0xab, 0x0e, 0xd4, 0x6a, 0x45, 0x87,
+		0x47, 0xae, 0xb2, 0xf2, 0x4a, 0xb5, 0x9c, 0xab, 0x6c, 0x6e, 0xc5, 0x9e, 0x76, 0x65, 0x68, 0xb8,
+		0x88, 0x03, 0x93, 0x59, 0x14, 0x96, 0xe3, 0xc0, 0x0c, 0xaa, 0x5b, 0xd3, 0xae, 0x8d, 0x02, 0x1a,
+		0x6e, 0x48, 0xac, 0xa4, 0x2a, 0x67, 0x43, 0x64, 0x55, 0x65, 0x5a, 0xad, 0xe8, 0xf0, 0x88, 0xf9,
+		0x90, 0x95, 0x3f, 0xa9, 0x79, 0xd7, 0xbf, 0xcc, 0xc2, 0x2e, 0xed, 0xd2, 0x90, 0x50, 0xe1, 0xfd,
+		0x2d, 0x59, 0x28, 0x95, 0x73, 0x7f, 0xcb, 0x28, 0xc7, 0xd2, 0x2e, 0x0e, 0x01, 0x11, 0x1e, 0x10,
+		0x89, 0x8a, 0xa0, 0x9c, 0x03, 0x42, 0x5e, 0x67, 0xa5, 0x5d, 0x28, 0x0e, 0x10, 0xb9, 0xae, 0x26,
+		0x2a, 0x4e, 0xf2, 0xae, 0xab, 0xf2, 0x1a, 0x1c, 0xed, 0xe2, 0x10, 0x10, 0xe1, 0xc4, 0x0f, 0x51,
+		0xe1, 0x89, 0x1f, 0xa2, 0x61, 0x27, 0xce, 0x2c, 0xff, 0xf8, 0x35, 0x05, 0x96, 0xa4, 0x45, 0x15,
+		0x6a, 0xb6, 0xc4, 0xe4, 0x95, 0x81, 0x68, 0x97, 0x87, 0x05, 0x8b, 0xc8, 0xbb, 0xac, 0x24, 0x21,
+		0x47, 0xde, 0x73, 0x6a, 0x3d, 0xb4, 0x4b, 0x43, 0x42, 0x71, 0x2a, 0x3e, 0x53, 0x82, 0x27, 0xc7,
+		0xd9, 0xb9, 0x6f, 0xf5, 0xd6, 0xa0, 0xfb, 0xc6, 0xc0, 0x1a, 0x01, 0xed, 0xf6, 0x61, 0x50, 0xc4,
+		0x42, 0x3a, 0xd1, 0xe4, 0x77, 0x7e, 0x48, 0x47, 0x92, 0x5d, 0xd7, 0x2e, 0x14, 0x07, 0x88, 0x68,
+		0x66, 0x3c, 0x63, 0x9d, 0xa7, 0x99, 0xd2, 0x34, 0xb9, 0x76, 0xa1, 0x38, 0x40, 0x68, 0x7e, 0x63,
+		0x19, 0xde, 0x1c, 0xf3, 0x2b, 0x4b, 0x81, 0x6b, 0xb5, 0xa2, 0xc3, 0xc3, 0x55, 0x26, 0xb2, 0x9e,
+		0x39, 0xab, 0x94, 0x67, 0x98, 0xb5, 0x0b, 0xc5, 0x01, 0x22, 0xda, 0x28, 0xcd, 0x47, 0xe5, 0x68,
+		0x63, 0x5e, 0xf6, 0x4f, 0xbb, 0x3c, 0x2c, 0x58, 0x24, 0x25, 0x90, 0x91, 0x07, 0xc9, 0x49, 0x09,
+		0xe4, 0x67, 0x98, 0xb4, 0xab, 0xc3, 0x03, 0x32, 0x72, 0x6e, 0xbf, 0xfd, 0x73, 0x57, 0xf6, 0x6c,
+		0x7f, 0xbf, 0xb7, 0x5b, 0x6b, 0xba, 0x9d, 0xf3, 0xb1, 0x7f, 0xb2, 0x53, 0xdb, 0x43, 0x0e, 0xfb,
+		0x8f, 0x4b, 0x91, 0x7f, 0xf9, 0xf4, 0x0e, 0xff, 0xf3, 0xe0, 0xe2, 0xee, 0x38, 0xed, 0x7b, 0xf3,
+		0x7f, 0x07, 0x00, 0x8f, 0x09, 0x1a, 0x1c, 0x1e, 0x6a, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
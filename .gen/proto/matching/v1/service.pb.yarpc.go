@@ -34,6 +34,10 @@ type MatchingAPIYARPCClient interface {
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest, ...yarpc.CallOption) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest, ...yarpc.CallOption) (*RefreshTaskListPartitionConfigResponse, error)
+	ListTaskListTasks(context.Context, *ListTaskListTasksRequest, ...yarpc.CallOption) (*ListTaskListTasksResponse, error)
+	DeleteTaskListTasks(context.Context, *DeleteTaskListTasksRequest, ...yarpc.CallOption) (*DeleteTaskListTasksResponse, error)
+	UpdateTaskListBuildIDCompatibility(context.Context, *UpdateTaskListBuildIDCompatibilityRequest, ...yarpc.CallOption) (*UpdateTaskListBuildIDCompatibilityResponse, error)
+	GetTaskListBuildIDCompatibility(context.Context, *GetTaskListBuildIDCompatibilityRequest, ...yarpc.CallOption) (*GetTaskListBuildIDCompatibilityResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -66,6 +70,10 @@ type MatchingAPIYARPCServer interface {
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest) (*RefreshTaskListPartitionConfigResponse, error)
+	ListTaskListTasks(context.Context, *ListTaskListTasksRequest) (*ListTaskListTasksResponse, error)
+	DeleteTaskListTasks(context.Context, *DeleteTaskListTasksRequest) (*DeleteTaskListTasksResponse, error)
+	UpdateTaskListBuildIDCompatibility(context.Context, *UpdateTaskListBuildIDCompatibilityRequest) (*UpdateTaskListBuildIDCompatibilityResponse, error)
+	GetTaskListBuildIDCompatibility(context.Context, *GetTaskListBuildIDCompatibilityRequest) (*GetTaskListBuildIDCompatibilityResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -199,6 +207,46 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "ListTaskListTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListTaskListTasks,
+							NewRequest:  newMatchingAPIServiceListTaskListTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteTaskListTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteTaskListTasks,
+							NewRequest:  newMatchingAPIServiceDeleteTaskListTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateTaskListBuildIDCompatibility",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateTaskListBuildIDCompatibility,
+							NewRequest:  newMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetTaskListBuildIDCompatibility",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetTaskListBuildIDCompatibility,
+							NewRequest:  newMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -461,6 +509,54 @@ func (c *_MatchingAPIYARPCCaller) RefreshTaskListPartitionConfig(ctx context.Con
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) ListTaskListTasks(ctx context.Context, request *ListTaskListTasksRequest, options ...yarpc.CallOption) (*ListTaskListTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListTaskListTasks", request, newMatchingAPIServiceListTaskListTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListTaskListTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceListTaskListTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) DeleteTaskListTasks(ctx context.Context, request *DeleteTaskListTasksRequest, options ...yarpc.CallOption) (*DeleteTaskListTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteTaskListTasks", request, newMatchingAPIServiceDeleteTaskListTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteTaskListTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDeleteTaskListTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) UpdateTaskListBuildIDCompatibility(ctx context.Context, request *UpdateTaskListBuildIDCompatibilityRequest, options ...yarpc.CallOption) (*UpdateTaskListBuildIDCompatibilityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateTaskListBuildIDCompatibility", request, newMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateTaskListBuildIDCompatibilityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) GetTaskListBuildIDCompatibility(ctx context.Context, request *GetTaskListBuildIDCompatibilityRequest, options ...yarpc.CallOption) (*GetTaskListBuildIDCompatibilityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetTaskListBuildIDCompatibility", request, newMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetTaskListBuildIDCompatibilityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -657,6 +753,70 @@ func (h *_MatchingAPIYARPCHandler) RefreshTaskListPartitionConfig(ctx context.Co
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) ListTaskListTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListTaskListTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListTaskListTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceListTaskListTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListTaskListTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) DeleteTaskListTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteTaskListTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteTaskListTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDeleteTaskListTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteTaskListTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) UpdateTaskListBuildIDCompatibility(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateTaskListBuildIDCompatibilityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateTaskListBuildIDCompatibilityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateTaskListBuildIDCompatibility(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) GetTaskListBuildIDCompatibility(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetTaskListBuildIDCompatibilityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetTaskListBuildIDCompatibilityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetTaskListBuildIDCompatibility(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -753,194 +913,274 @@ func newMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse() proto.Me
 	return &RefreshTaskListPartitionConfigResponse{}
 }
 
+func newMatchingAPIServiceListTaskListTasksYARPCRequest() proto.Message {
+	return &ListTaskListTasksRequest{}
+}
+
+func newMatchingAPIServiceListTaskListTasksYARPCResponse() proto.Message {
+	return &ListTaskListTasksResponse{}
+}
+
+func newMatchingAPIServiceDeleteTaskListTasksYARPCRequest() proto.Message {
+	return &DeleteTaskListTasksRequest{}
+}
+
+func newMatchingAPIServiceDeleteTaskListTasksYARPCResponse() proto.Message {
+	return &DeleteTaskListTasksResponse{}
+}
+
+func newMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCRequest() proto.Message {
+	return &UpdateTaskListBuildIDCompatibilityRequest{}
+}
+
+func newMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCResponse() proto.Message {
+	return &UpdateTaskListBuildIDCompatibilityResponse{}
+}
+
+func newMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCRequest() proto.Message {
+	return &GetTaskListBuildIDCompatibilityRequest{}
+}
+
+func newMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCResponse() proto.Message {
+	return &GetTaskListBuildIDCompatibilityResponse{}
+}
+
 var (
-	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest             = &PollForDecisionTaskRequest{}
-	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse            = &PollForDecisionTaskResponse{}
-	emptyMatchingAPIServicePollForActivityTaskYARPCRequest             = &PollForActivityTaskRequest{}
-	emptyMatchingAPIServicePollForActivityTaskYARPCResponse            = &PollForActivityTaskResponse{}
-	emptyMatchingAPIServiceAddDecisionTaskYARPCRequest                 = &AddDecisionTaskRequest{}
-	emptyMatchingAPIServiceAddDecisionTaskYARPCResponse                = &AddDecisionTaskResponse{}
-	emptyMatchingAPIServiceAddActivityTaskYARPCRequest                 = &AddActivityTaskRequest{}
-	emptyMatchingAPIServiceAddActivityTaskYARPCResponse                = &AddActivityTaskResponse{}
-	emptyMatchingAPIServiceQueryWorkflowYARPCRequest                   = &QueryWorkflowRequest{}
-	emptyMatchingAPIServiceQueryWorkflowYARPCResponse                  = &QueryWorkflowResponse{}
-	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCRequest       = &RespondQueryTaskCompletedRequest{}
-	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse      = &RespondQueryTaskCompletedResponse{}
-	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest           = &CancelOutstandingPollRequest{}
-	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse          = &CancelOutstandingPollResponse{}
-	emptyMatchingAPIServiceDescribeTaskListYARPCRequest                = &DescribeTaskListRequest{}
-	emptyMatchingAPIServiceDescribeTaskListYARPCResponse               = &DescribeTaskListResponse{}
-	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest          = &ListTaskListPartitionsRequest{}
-	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse         = &ListTaskListPartitionsResponse{}
-	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest            = &GetTaskListsByDomainRequest{}
-	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse           = &GetTaskListsByDomainResponse{}
-	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCRequest   = &UpdateTaskListPartitionConfigRequest{}
-	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCResponse  = &UpdateTaskListPartitionConfigResponse{}
-	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCRequest  = &RefreshTaskListPartitionConfigRequest{}
-	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse = &RefreshTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest                 = &PollForDecisionTaskRequest{}
+	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse                = &PollForDecisionTaskResponse{}
+	emptyMatchingAPIServicePollForActivityTaskYARPCRequest                 = &PollForActivityTaskRequest{}
+	emptyMatchingAPIServicePollForActivityTaskYARPCResponse                = &PollForActivityTaskResponse{}
+	emptyMatchingAPIServiceAddDecisionTaskYARPCRequest                     = &AddDecisionTaskRequest{}
+	emptyMatchingAPIServiceAddDecisionTaskYARPCResponse                    = &AddDecisionTaskResponse{}
+	emptyMatchingAPIServiceAddActivityTaskYARPCRequest                     = &AddActivityTaskRequest{}
+	emptyMatchingAPIServiceAddActivityTaskYARPCResponse                    = &AddActivityTaskResponse{}
+	emptyMatchingAPIServiceQueryWorkflowYARPCRequest                       = &QueryWorkflowRequest{}
+	emptyMatchingAPIServiceQueryWorkflowYARPCResponse                      = &QueryWorkflowResponse{}
+	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCRequest           = &RespondQueryTaskCompletedRequest{}
+	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse          = &RespondQueryTaskCompletedResponse{}
+	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest               = &CancelOutstandingPollRequest{}
+	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse              = &CancelOutstandingPollResponse{}
+	emptyMatchingAPIServiceDescribeTaskListYARPCRequest                    = &DescribeTaskListRequest{}
+	emptyMatchingAPIServiceDescribeTaskListYARPCResponse                   = &DescribeTaskListResponse{}
+	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest              = &ListTaskListPartitionsRequest{}
+	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse             = &ListTaskListPartitionsResponse{}
+	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest                = &GetTaskListsByDomainRequest{}
+	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse               = &GetTaskListsByDomainResponse{}
+	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCRequest       = &UpdateTaskListPartitionConfigRequest{}
+	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCResponse      = &UpdateTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCRequest      = &RefreshTaskListPartitionConfigRequest{}
+	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse     = &RefreshTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServiceListTaskListTasksYARPCRequest                   = &ListTaskListTasksRequest{}
+	emptyMatchingAPIServiceListTaskListTasksYARPCResponse                  = &ListTaskListTasksResponse{}
+	emptyMatchingAPIServiceDeleteTaskListTasksYARPCRequest                 = &DeleteTaskListTasksRequest{}
+	emptyMatchingAPIServiceDeleteTaskListTasksYARPCResponse                = &DeleteTaskListTasksResponse{}
+	emptyMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCRequest  = &UpdateTaskListBuildIDCompatibilityRequest{}
+	emptyMatchingAPIServiceUpdateTaskListBuildIDCompatibilityYARPCResponse = &UpdateTaskListBuildIDCompatibilityResponse{}
+	emptyMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCRequest     = &GetTaskListBuildIDCompatibilityRequest{}
+	emptyMatchingAPIServiceGetTaskListBuildIDCompatibilityYARPCResponse    = &GetTaskListBuildIDCompatibilityResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x49, 0x73, 0xdc, 0xc6,
-		0xf5, 0x2f, 0x0c, 0x39, 0x5c, 0xde, 0x90, 0x43, 0xb2, 0x49, 0x53, 0xd0, 0x48, 0x94, 0xa8, 0x91,
-		0x25, 0xd3, 0xff, 0xbf, 0x33, 0x34, 0x69, 0x49, 0x91, 0xa5, 0x8a, 0x15, 0x2e, 0xa2, 0x34, 0x29,
-		0x2b, 0x92, 0x21, 0x5a, 0xaa, 0x4a, 0x5c, 0x42, 0x9a, 0x83, 0x26, 0x07, 0x21, 0x06, 0x80, 0x80,
-		0x06, 0x69, 0xfa, 0x90, 0x43, 0x2a, 0x49, 0xa5, 0x2a, 0xd7, 0xe4, 0x9e, 0xed, 0x4b, 0xe4, 0x92,
-		0xcf, 0x91, 0x2a, 0x57, 0x0e, 0x39, 0xe4, 0x03, 0x24, 0x55, 0xb9, 0xe5, 0x90, 0xea, 0x05, 0x33,
-		0x00, 0xa6, 0x31, 0x0b, 0x49, 0xc9, 0x39, 0xe4, 0x46, 0x74, 0xbf, 0xad, 0xdf, 0xf6, 0x7b, 0xdd,
-		0x43, 0xb8, 0x19, 0xed, 0x91, 0x60, 0xb5, 0x81, 0x2d, 0xe2, 0x36, 0xc8, 0x6a, 0x0b, 0xd3, 0x46,
-		0xd3, 0x76, 0x0f, 0x56, 0x8f, 0xd6, 0x56, 0x43, 0x12, 0x1c, 0xd9, 0x0d, 0x52, 0xf3, 0x03, 0x8f,
-		0x7a, 0x48, 0x67, 0x74, 0x35, 0x49, 0x57, 0x8b, 0xe9, 0x6a, 0x47, 0x6b, 0x95, 0x2b, 0x07, 0x9e,
-		0x77, 0xe0, 0x90, 0x55, 0x4e, 0xb7, 0x17, 0xed, 0xaf, 0x5a, 0x51, 0x80, 0xa9, 0xed, 0xb9, 0x82,
-		0xb3, 0x72, 0x35, 0xbb, 0x4f, 0xed, 0x16, 0x09, 0x29, 0x6e, 0xf9, 0x92, 0xa0, 0x4b, 0xc0, 0x71,
-		0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x4e, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0xd7, 0xf0, 0x5a,
-		0xad, 0x8e, 0x0a, 0x15, 0xc5, 0xeb, 0x88, 0x04, 0x27, 0x92, 0xa0, 0xaa, 0x22, 0xa0, 0x38, 0x3c,
-		0x74, 0xec, 0x90, 0x4a, 0x9a, 0x15, 0x15, 0x8d, 0x74, 0x82, 0x79, 0xec, 0x05, 0x87, 0x24, 0x90,
-		0x94, 0xff, 0xd7, 0x8f, 0x72, 0xdf, 0xf1, 0x8e, 0x25, 0xed, 0x35, 0x15, 0x6d, 0xd3, 0x0e, 0xa9,
-		0xd7, 0x36, 0xee, 0xdd, 0x14, 0x49, 0xd8, 0xc4, 0x01, 0xb1, 0xba, 0xa9, 0x6e, 0xe4, 0x50, 0xa5,
-		0x4f, 0x51, 0xfd, 0x04, 0xe6, 0x76, 0x71, 0x78, 0xf8, 0xa9, 0x1d, 0xd2, 0x67, 0x38, 0xa0, 0x36,
-		0x0b, 0x04, 0x7a, 0x1f, 0x66, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0x83, 0xc0, 0x8b, 0xfc, 0x50,
-		0xd7, 0x96, 0x47, 0x56, 0x26, 0x8d, 0x99, 0xf6, 0xfa, 0x23, 0xbe, 0x5c, 0xfd, 0xdb, 0x28, 0x5c,
-		0xe8, 0x12, 0xb0, 0xe5, 0xb9, 0xfb, 0xf6, 0x01, 0xd2, 0x61, 0xfc, 0x88, 0x04, 0xa1, 0xed, 0xb9,
-		0xba, 0xb6, 0xac, 0xad, 0x8c, 0x18, 0xf1, 0x27, 0x5a, 0x87, 0x79, 0x37, 0x6a, 0x99, 0x01, 0xc1,
-		0x96, 0xe9, 0xc7, 0x5c, 0xa1, 0x5e, 0x58, 0xd6, 0x56, 0x8a, 0x9b, 0x05, 0x5d, 0x33, 0xe6, 0xdc,
-		0xa8, 0x65, 0x10, 0x6c, 0xb5, 0x45, 0x86, 0xe8, 0x16, 0x2c, 0x30, 0x9e, 0xe3, 0xc0, 0xa6, 0x24,
-		0xc9, 0x34, 0xd2, 0x66, 0x42, 0x6e, 0xd4, 0x7a, 0xc9, 0xb6, 0x13, 0x5c, 0x2e, 0xcc, 0x64, 0xb5,
-		0x8c, 0x2e, 0x8f, 0xac, 0x94, 0xd6, 0x1f, 0xd6, 0xf2, 0x32, 0xb4, 0x96, 0x73, 0x9e, 0x5a, 0xda,
-		0xa0, 0x87, 0x2e, 0x0d, 0x4e, 0x8c, 0x72, 0x90, 0xb6, 0xf2, 0x35, 0xcc, 0x76, 0x59, 0x58, 0xe4,
-		0x0a, 0x77, 0x86, 0x57, 0x98, 0x39, 0x8c, 0xd0, 0x38, 0x73, 0x9c, 0x5e, 0xad, 0xb8, 0x30, 0xaf,
-		0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x21, 0x39, 0xe1, 0x9e, 0x2f, 0x1a, 0xec, 0x4f, 0xb4, 0x01, 0xc5,
-		0x23, 0xec, 0x44, 0x84, 0xfb, 0xb9, 0xb4, 0xfe, 0xff, 0x43, 0x18, 0x64, 0x08, 0xce, 0x7b, 0x85,
-		0xbb, 0x5a, 0xc5, 0x83, 0x05, 0x95, 0x61, 0x6f, 0x4c, 0x61, 0xf5, 0x47, 0x30, 0xf7, 0xa9, 0x87,
-		0xad, 0x4d, 0xec, 0x60, 0xb7, 0x41, 0x82, 0xc7, 0xb6, 0x4b, 0x43, 0x74, 0x1d, 0xa6, 0xf7, 0x70,
-		0xe3, 0xd0, 0xf1, 0x0e, 0xcc, 0x86, 0x17, 0xb9, 0x54, 0xa6, 0xd8, 0x94, 0x5c, 0xdc, 0x62, 0x6b,
-		0xe8, 0x26, 0xcc, 0x04, 0x98, 0x05, 0x83, 0x04, 0x66, 0x48, 0x1a, 0x9e, 0x6b, 0x71, 0x53, 0x34,
-		0x63, 0x9a, 0x2d, 0x3f, 0x23, 0xc1, 0x73, 0xbe, 0x58, 0xfd, 0x87, 0x06, 0x95, 0x67, 0x9e, 0xe3,
-		0xec, 0x78, 0xc1, 0x36, 0x69, 0xd8, 0x2c, 0x47, 0x99, 0x45, 0x06, 0x79, 0x1d, 0x91, 0x90, 0xa2,
-		0x3a, 0x8c, 0x07, 0xe2, 0x4f, 0xae, 0xa5, 0xb4, 0xbe, 0x9a, 0x3e, 0x09, 0xf6, 0x6d, 0x76, 0x88,
-		0x7c, 0x09, 0x46, 0xcc, 0x8f, 0x2e, 0xc1, 0xa4, 0xe5, 0xb5, 0xb0, 0xed, 0x9a, 0xb6, 0xb0, 0x65,
-		0xd2, 0x98, 0x10, 0x0b, 0x75, 0x8b, 0x6d, 0xfa, 0x9e, 0xe3, 0x90, 0x80, 0x6d, 0x8e, 0x88, 0x4d,
-		0xb1, 0x50, 0xb7, 0xd0, 0x0d, 0x28, 0xef, 0x7b, 0xc1, 0x31, 0x0e, 0x2c, 0x62, 0x99, 0xfb, 0x81,
-		0xd7, 0xd2, 0x47, 0x39, 0xc5, 0x74, 0x7b, 0x75, 0x27, 0xf0, 0x5a, 0xe8, 0x3d, 0x98, 0xc9, 0xd4,
-		0xae, 0x5e, 0xe4, 0x74, 0xe5, 0x74, 0xe9, 0x56, 0xff, 0x5c, 0x82, 0x4b, 0x4a, 0x8b, 0x43, 0xdf,
-		0x73, 0x43, 0x82, 0x96, 0x00, 0x58, 0xaf, 0x30, 0xa9, 0x77, 0x48, 0x44, 0x01, 0x4f, 0x19, 0x93,
-		0x6c, 0x65, 0x97, 0x2d, 0xa0, 0xcf, 0x01, 0xc5, 0xad, 0xcb, 0x24, 0x5f, 0x92, 0x46, 0xc4, 0x24,
-		0xcb, 0x40, 0xdf, 0x54, 0xba, 0xe7, 0xa5, 0x24, 0x7f, 0x18, 0x53, 0x1b, 0x73, 0xc7, 0xd9, 0x25,
-		0xb4, 0x03, 0xd3, 0x6d, 0xb1, 0xf4, 0xc4, 0x27, 0xdc, 0x0d, 0xa5, 0xf5, 0x6b, 0x3d, 0x25, 0xee,
-		0x9e, 0xf8, 0xc4, 0x98, 0x3a, 0x4e, 0x7c, 0xa1, 0x17, 0x70, 0xd1, 0x0f, 0xc8, 0x91, 0xed, 0x45,
-		0xa1, 0x19, 0x52, 0x1c, 0x50, 0x62, 0x99, 0xe4, 0x88, 0xb8, 0x94, 0xb9, 0x76, 0x94, 0xcb, 0xbc,
-		0x54, 0x13, 0x40, 0x52, 0x8b, 0x81, 0xa4, 0x56, 0x77, 0xe9, 0x9d, 0x5b, 0x2f, 0x58, 0xde, 0x19,
-		0x8b, 0x31, 0xf7, 0x73, 0xc1, 0xfc, 0x90, 0xf1, 0xd6, 0x2d, 0xb4, 0x02, 0xb3, 0x5d, 0xe2, 0x8a,
-		0x3c, 0xf3, 0xca, 0x61, 0x9a, 0x52, 0x87, 0x71, 0x4c, 0x29, 0x69, 0xf9, 0x54, 0x1f, 0xe3, 0x25,
-		0x11, 0x7f, 0xa2, 0x2a, 0x4c, 0xbb, 0xe4, 0x4b, 0xda, 0x11, 0x30, 0xce, 0x05, 0x94, 0xd8, 0x62,
-		0xcc, 0xfd, 0x01, 0xa0, 0x54, 0x7a, 0x9b, 0x4d, 0xdb, 0xa5, 0xfa, 0x04, 0x27, 0x9c, 0x4d, 0xe6,
-		0x38, 0xab, 0x06, 0x74, 0x17, 0xf4, 0x90, 0xda, 0x8d, 0xc3, 0x93, 0x4e, 0x28, 0x4c, 0xe2, 0xe2,
-		0x3d, 0x87, 0x58, 0xfa, 0xe4, 0xb2, 0xb6, 0x32, 0x61, 0x2c, 0x8a, 0xfd, 0xb6, 0xa3, 0x1f, 0x8a,
-		0x5d, 0x74, 0x17, 0x8a, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0xa9, 0xf6, 0xf4, 0xf3, 0x67, 0x8c, 0xd2,
-		0x10, 0x0c, 0xc8, 0x80, 0x69, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0xfb, 0x9e, 0x5e, 0xe2, 0x12, 0xbe,
-		0x95, 0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3, 0xad, 0xee,
-		0xee, 0x7b, 0xc6, 0x94, 0x95, 0xf8, 0x42, 0xaf, 0xe0, 0x72, 0x77, 0x52, 0x99, 0x3c, 0x0d, 0x19,
-		0x66, 0xe9, 0x53, 0x5c, 0xc5, 0x92, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x17, 0xbb, 0xb2, 0x2a, 0xde,
-		0x42, 0x35, 0x98, 0x17, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0xa6, 0x79, 0x7c, 0xe6, 0xf8,
-		0xd6, 0x73, 0xb6, 0xf3, 0x42, 0xe2, 0xd4, 0x35, 0x98, 0xda, 0x0b, 0xb0, 0xdb, 0x68, 0xca, 0x2a,
-		0x28, 0xf3, 0x2a, 0x28, 0x89, 0x35, 0x51, 0x07, 0x1b, 0x50, 0x0e, 0x1b, 0x4d, 0x62, 0x45, 0x0e,
-		0xb1, 0x4c, 0x36, 0xaa, 0xe8, 0x33, 0xdc, 0xc8, 0x4a, 0x57, 0x76, 0xed, 0xc6, 0x73, 0x8c, 0x31,
-		0xdd, 0xe6, 0x60, 0x6b, 0xe8, 0x3b, 0x30, 0x15, 0xe7, 0x14, 0x17, 0x30, 0xdb, 0x57, 0x40, 0x49,
-		0xd2, 0x73, 0xf6, 0x2f, 0x60, 0x9c, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc7, 0x91, 0x66, 0x33, 0xbf,
-		0xcf, 0xf6, 0x28, 0xf8, 0xda, 0x67, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19, 0xf5, 0x28,
-		0x76, 0x4c, 0x39, 0x5e, 0x98, 0x7b, 0x27, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc7, 0xb7, 0x1e,
-		0x8b, 0x9d, 0x4d, 0xb6, 0x81, 0xbe, 0x80, 0xd9, 0x36, 0xf4, 0x99, 0x0d, 0x8e, 0x63, 0xfa, 0x3c,
-		0x3f, 0xd0, 0xda, 0xd0, 0x00, 0x68, 0xcc, 0xf8, 0x99, 0x91, 0xe2, 0x87, 0x30, 0xef, 0x78, 0xd8,
-		0x32, 0xf7, 0x24, 0x16, 0xf0, 0xb2, 0x08, 0xf5, 0x85, 0x7e, 0xf8, 0xd2, 0x85, 0x1f, 0xc6, 0x9c,
-		0xd3, 0x05, 0x29, 0x4f, 0x60, 0x16, 0x47, 0xd4, 0x93, 0x56, 0x8b, 0x8a, 0x7b, 0x87, 0x4b, 0xbe,
-		0xae, 0xcc, 0xb8, 0x8d, 0x88, 0x7a, 0xc2, 0x2e, 0xc6, 0x6f, 0x94, 0x71, 0xea, 0xbb, 0xf2, 0x0a,
-		0xa6, 0x92, 0x2e, 0x4d, 0xe2, 0xe3, 0xa4, 0xc0, 0xc7, 0xbb, 0x69, 0x7c, 0x1c, 0xa8, 0xf8, 0x3a,
-		0xb0, 0x98, 0x00, 0xad, 0x8d, 0x06, 0xb5, 0x8f, 0x6c, 0x7a, 0x72, 0x7a, 0xd0, 0x52, 0x48, 0xf8,
-		0x6f, 0x04, 0xad, 0xdf, 0x40, 0x1b, 0xb4, 0xd2, 0x16, 0x7f, 0xa3, 0xa0, 0x75, 0x15, 0x4a, 0x58,
-		0x5a, 0xd3, 0x71, 0x02, 0xc4, 0x4b, 0x75, 0x8b, 0xa1, 0x5a, 0x9b, 0x80, 0xa3, 0xda, 0x68, 0x0f,
-		0x54, 0x6b, 0x1f, 0x8c, 0xa3, 0x1a, 0x4e, 0x7c, 0xa1, 0x75, 0x28, 0xda, 0xae, 0x1f, 0x51, 0xee,
-		0x9d, 0xd2, 0xfa, 0x65, 0x75, 0x44, 0xf1, 0x09, 0xcb, 0x6d, 0x43, 0x90, 0x2a, 0x1a, 0xd4, 0xd8,
-		0x59, 0x1b, 0xd4, 0xf8, 0x70, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x4c, 0x56, 0x5e, 0x8e, 0x17,
-		0x12, 0x2e, 0xc8, 0x8b, 0x04, 0xa4, 0x95, 0xd6, 0x2f, 0x76, 0xc9, 0xda, 0x96, 0xb7, 0x42, 0x63,
-		0x31, 0xe6, 0xdd, 0xf5, 0xb6, 0x18, 0xe7, 0xae, 0x60, 0x44, 0xdf, 0x87, 0x45, 0xae, 0xa4, 0x5b,
-		0xe4, 0x64, 0x3f, 0x91, 0xf3, 0x9c, 0x31, 0x23, 0x6f, 0x07, 0xe6, 0x9a, 0x04, 0x07, 0x74, 0x8f,
-		0x60, 0xda, 0x16, 0x05, 0xfd, 0x44, 0xcd, 0xb6, 0x79, 0x62, 0x39, 0x09, 0xdc, 0x2f, 0xa5, 0x71,
-		0xff, 0x15, 0x5c, 0x49, 0x47, 0xc2, 0xf4, 0xf6, 0x4d, 0xda, 0xb4, 0x43, 0x33, 0x66, 0x98, 0xea,
-		0xeb, 0xd8, 0x4a, 0x2a, 0x32, 0x4f, 0xf7, 0x77, 0x9b, 0x76, 0xb8, 0x21, 0xe5, 0xd7, 0x93, 0x27,
-		0xb0, 0x08, 0xc5, 0xb6, 0x13, 0x72, 0x6c, 0xeb, 0x97, 0x29, 0x9d, 0x43, 0x6c, 0x0b, 0xae, 0xee,
-		0x31, 0xac, 0x7c, 0xba, 0x31, 0xec, 0x3d, 0x98, 0x69, 0xcb, 0x11, 0x1d, 0x83, 0xc3, 0xe3, 0xa4,
-		0x51, 0x8e, 0x97, 0xb7, 0xf9, 0x2a, 0xfa, 0x08, 0xc6, 0x9a, 0x04, 0x5b, 0x24, 0x90, 0xe8, 0x77,
-		0x49, 0xa9, 0xe9, 0x31, 0x27, 0x31, 0x24, 0x69, 0x1e, 0x1a, 0xcc, 0x9d, 0x0b, 0x1a, 0xbc, 0x59,
-		0x20, 0x53, 0x61, 0xcd, 0xc2, 0xa9, 0xb1, 0xa6, 0xfa, 0x97, 0x51, 0x58, 0xdc, 0xb0, 0x2c, 0xd5,
-		0xe5, 0x25, 0xd5, 0xbc, 0xb5, 0x4c, 0xf3, 0x7e, 0x43, 0x0d, 0xf1, 0x1e, 0x4c, 0x76, 0x86, 0xb6,
-		0x91, 0x41, 0x86, 0xb6, 0x09, 0x1a, 0xcf, 0x68, 0x57, 0xa1, 0xd4, 0xee, 0x16, 0x72, 0x56, 0x1f,
-		0x31, 0x20, 0x5e, 0xaa, 0x5b, 0xd9, 0x76, 0x22, 0x9b, 0x80, 0x2c, 0xd8, 0xe2, 0x10, 0xed, 0x84,
-		0x8f, 0xf6, 0x71, 0xd9, 0xde, 0x83, 0xb1, 0xd0, 0x8b, 0x82, 0x86, 0x68, 0x8f, 0xe5, 0x2c, 0x18,
-		0x27, 0xe6, 0x58, 0x1c, 0x1e, 0x3e, 0xe7, 0x94, 0x86, 0xe4, 0x50, 0xa0, 0xdc, 0xb8, 0x0a, 0xe5,
-		0x7c, 0x45, 0x46, 0x4d, 0xf4, 0x7b, 0x8c, 0x50, 0x47, 0xb5, 0x96, 0x49, 0x30, 0xf9, 0x34, 0x90,
-		0xc9, 0xb2, 0xca, 0x26, 0x2c, 0xa8, 0x08, 0x15, 0xa3, 0xc8, 0x42, 0x72, 0x14, 0x99, 0x4c, 0x8e,
-		0x19, 0xc7, 0x70, 0xa1, 0xcb, 0x06, 0x89, 0xb6, 0xaa, 0x12, 0xd1, 0xce, 0xab, 0x44, 0xaa, 0xff,
-		0x2c, 0xf2, 0x9c, 0x56, 0xcd, 0x36, 0xdf, 0x44, 0x4e, 0xb3, 0x9b, 0x1f, 0x0f, 0xb7, 0xd9, 0x51,
-		0x2d, 0x90, 0xbe, 0x2c, 0xd6, 0xb7, 0x63, 0x03, 0x52, 0xd9, 0x3f, 0x7a, 0xa6, 0xec, 0x2f, 0x0e,
-		0x97, 0xfd, 0x63, 0x67, 0xcf, 0xfe, 0xf1, 0x73, 0xc8, 0xfe, 0x09, 0x55, 0xf6, 0xbb, 0xa0, 0xe3,
-		0x44, 0x28, 0xb7, 0xed, 0xd0, 0x67, 0x59, 0xc1, 0xee, 0x7d, 0x12, 0xb1, 0xd7, 0x7b, 0x54, 0x41,
-		0x0e, 0xa7, 0x91, 0x2b, 0x53, 0x59, 0x6d, 0x30, 0x40, 0xb5, 0x29, 0xf2, 0xed, 0x2d, 0x56, 0xdb,
-		0xd7, 0x23, 0xa0, 0xe7, 0x1d, 0x16, 0x7d, 0x0f, 0x66, 0x3a, 0x03, 0x04, 0xbf, 0xad, 0xca, 0x72,
-		0x53, 0xe3, 0xb2, 0xbc, 0x97, 0xf1, 0x27, 0x05, 0xa3, 0x33, 0x04, 0xf2, 0xef, 0xae, 0x99, 0xae,
-		0x30, 0xdc, 0x4c, 0x97, 0x98, 0x72, 0x46, 0x86, 0x9d, 0x72, 0x46, 0xcf, 0x7f, 0xca, 0x29, 0x9e,
-		0xcf, 0x94, 0x33, 0x76, 0x6e, 0x53, 0xce, 0xb8, 0x6a, 0xca, 0x91, 0xbd, 0x54, 0x79, 0x73, 0x79,
-		0xb3, 0xbd, 0xf4, 0x6b, 0x0d, 0x16, 0xf8, 0x05, 0x32, 0x3e, 0x45, 0xdc, 0x49, 0xb7, 0xb2, 0xb7,
-		0xc4, 0xf7, 0x95, 0x87, 0x57, 0xf1, 0x0e, 0x78, 0x3f, 0x3c, 0xcb, 0x2c, 0x30, 0xd8, 0xf5, 0xb1,
-		0xfa, 0x6f, 0x0d, 0xde, 0xc9, 0x58, 0x28, 0xbd, 0xfa, 0x00, 0xa6, 0xf8, 0x6b, 0x95, 0x19, 0x90,
-		0x30, 0x72, 0xe2, 0x33, 0xf6, 0xce, 0x93, 0x12, 0xe7, 0x30, 0x38, 0x03, 0xaa, 0x43, 0x39, 0x16,
-		0xf0, 0x63, 0xd2, 0xa0, 0xc4, 0xea, 0x79, 0x57, 0x17, 0x77, 0x74, 0x49, 0x69, 0x4c, 0xbf, 0x4e,
-		0x7e, 0xa2, 0x97, 0x8a, 0x08, 0x0b, 0x7f, 0x7c, 0xd0, 0xd3, 0x1f, 0x7d, 0x83, 0xfb, 0x77, 0x0d,
-		0x96, 0xc5, 0x89, 0x2d, 0x6e, 0x00, 0x63, 0xdc, 0xf2, 0x5a, 0xbe, 0x43, 0x98, 0x15, 0x32, 0x46,
-		0x4f, 0xb3, 0x81, 0xbe, 0xad, 0x54, 0xda, 0x4f, 0xce, 0x5b, 0x08, 0xfa, 0x05, 0x18, 0xe7, 0xbc,
-		0x72, 0xf8, 0x9b, 0x34, 0xc6, 0xd8, 0x67, 0xdd, 0xaa, 0x5e, 0x87, 0x6b, 0x3d, 0xcc, 0x13, 0x11,
-		0xaf, 0xfe, 0x55, 0x83, 0xcb, 0x5b, 0x6c, 0x8c, 0x77, 0x9e, 0x46, 0x34, 0xa4, 0xd8, 0xb5, 0x6c,
-		0xf7, 0xe0, 0x99, 0xe7, 0x38, 0x03, 0xcd, 0x0e, 0xa9, 0xc7, 0x8c, 0x42, 0xe6, 0x31, 0xe3, 0x11,
-		0x94, 0xdb, 0x87, 0xea, 0x3c, 0x4e, 0x97, 0x73, 0xfa, 0x45, 0x7c, 0x32, 0xd1, 0x2f, 0x68, 0xe2,
-		0xeb, 0x2c, 0x03, 0x42, 0xf5, 0x2a, 0x2c, 0xe5, 0x1c, 0x4f, 0x3a, 0xe0, 0x27, 0x70, 0x61, 0x9b,
-		0x84, 0x8d, 0xc0, 0xde, 0x23, 0x6d, 0x76, 0x79, 0xf4, 0x9d, 0x6c, 0x0e, 0xa8, 0x13, 0x2f, 0x87,
-		0x7d, 0xb0, 0xd0, 0x57, 0xff, 0x54, 0x00, 0xbd, 0x5b, 0x82, 0xac, 0xc7, 0x8f, 0x61, 0x5c, 0xb8,
-		0x53, 0xfc, 0xa0, 0x58, 0x5a, 0xbf, 0x9a, 0xfb, 0x28, 0x45, 0x02, 0x0e, 0xf0, 0x31, 0x3d, 0xbb,
-		0x31, 0x75, 0xbc, 0x1f, 0x52, 0x4c, 0xa3, 0x50, 0xd6, 0xe2, 0xf5, 0x9e, 0xbe, 0x7b, 0xce, 0x49,
-		0x8d, 0x32, 0x4d, 0x7d, 0xbf, 0xb1, 0x6a, 0x3c, 0x53, 0x70, 0x43, 0x58, 0xe2, 0x49, 0x92, 0xd5,
-		0x15, 0xc6, 0x11, 0x5c, 0x84, 0x31, 0x09, 0x30, 0x22, 0x73, 0xe5, 0x57, 0x5a, 0x69, 0x61, 0x38,
-		0xa5, 0xbf, 0x28, 0xc0, 0x95, 0x3c, 0xad, 0x32, 0x6c, 0xaf, 0x61, 0xa9, 0xf3, 0x7e, 0xd5, 0x0e,
-		0x42, 0xe2, 0x27, 0x4e, 0x11, 0xcc, 0xda, 0x60, 0x9e, 0x7b, 0x42, 0x28, 0xb6, 0x30, 0xc5, 0x46,
-		0x25, 0x39, 0xbc, 0xa5, 0x55, 0x33, 0x95, 0xed, 0x9f, 0x17, 0x94, 0x2a, 0x0b, 0xa7, 0x53, 0x69,
-		0x25, 0x2e, 0x32, 0x69, 0x95, 0xd5, 0xdb, 0x70, 0xe9, 0x11, 0x69, 0xbb, 0x21, 0xdc, 0x3c, 0x11,
-		0xa8, 0xdd, 0xc7, 0xf7, 0xd5, 0x3f, 0x8e, 0xc2, 0x65, 0x35, 0x9f, 0xf4, 0xde, 0xcf, 0x34, 0x58,
-		0x54, 0x9c, 0xa5, 0x85, 0x7d, 0xe9, 0xb7, 0xa7, 0xf9, 0x08, 0xdf, 0x4b, 0x70, 0x6d, 0x3b, 0x73,
-		0x96, 0x27, 0xd8, 0x17, 0xa3, 0xe9, 0xbc, 0xd5, 0xbd, 0xc3, 0xcd, 0x50, 0x44, 0x91, 0x99, 0x51,
-		0x38, 0x93, 0x19, 0x1b, 0x99, 0x28, 0x76, 0xcc, 0xc0, 0xdd, 0x3b, 0x95, 0xaf, 0x58, 0x7b, 0x50,
-		0xdb, 0xad, 0x98, 0x94, 0x1f, 0xa7, 0x9f, 0xc8, 0x7b, 0x5c, 0x11, 0xf2, 0x7a, 0x4e, 0xf2, 0xa7,
-		0xeb, 0xaf, 0xd2, 0xc3, 0xf5, 0xdb, 0xd4, 0x5d, 0xfd, 0x5d, 0x01, 0xde, 0xfd, 0xdc, 0xb7, 0x30,
-		0x25, 0x79, 0xad, 0x64, 0x10, 0x80, 0x3a, 0x43, 0xa1, 0x9f, 0x1f, 0x7e, 0xa9, 0x7a, 0xe7, 0xe8,
-		0x79, 0x4c, 0x32, 0xef, 0xc1, 0x8d, 0x3e, 0x2e, 0x92, 0x20, 0xf7, 0xfb, 0x02, 0xdc, 0x30, 0xc8,
-		0x7e, 0x40, 0xc2, 0xe6, 0xff, 0xbc, 0x99, 0xe7, 0xcd, 0x15, 0xb8, 0xd9, 0xcf, 0x47, 0xc2, 0x9d,
-		0xeb, 0xff, 0x9a, 0x82, 0xd2, 0x13, 0x99, 0xcf, 0x1b, 0xcf, 0xea, 0xe8, 0xa7, 0x1a, 0xcc, 0x2b,
-		0x7e, 0x2a, 0x44, 0xb7, 0x86, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x72, 0xfb, 0x54, 0xbf, 0x47, 0x26,
-		0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96, 0x1d, 0xc1,
-		0x4c, 0xe6, 0xf5, 0x0b, 0x7d, 0x38, 0xec, 0x63, 0x5d, 0x65, 0x6d, 0x08, 0x8e, 0x94, 0xde, 0xd4,
-		0xb9, 0x3f, 0x1c, 0xf6, 0xd9, 0xa2, 0x8f, 0x5e, 0xe5, 0x79, 0x7d, 0x98, 0x4e, 0xdd, 0xa4, 0x50,
-		0x2d, 0x5f, 0x86, 0xea, 0x52, 0x58, 0x59, 0x1d, 0x98, 0x5e, 0x6a, 0xfc, 0xb5, 0x06, 0x17, 0x73,
-		0xc7, 0x7a, 0x74, 0x2f, 0x5f, 0x5c, 0xbf, 0xab, 0x4a, 0xe5, 0xfe, 0xa9, 0x78, 0xa5, 0x59, 0xbf,
-		0xd4, 0xe0, 0x1d, 0xe5, 0xa0, 0x8d, 0xee, 0xe4, 0x8b, 0xed, 0x75, 0xf1, 0xa8, 0x7c, 0x7b, 0x68,
-		0x3e, 0x69, 0xca, 0x09, 0xcc, 0x66, 0x01, 0x06, 0xad, 0x0d, 0x03, 0x46, 0x42, 0xff, 0x29, 0xf0,
-		0x0b, 0xfd, 0x4a, 0x83, 0x45, 0xf5, 0x6c, 0x88, 0x7a, 0x1c, 0xa7, 0xe7, 0x0c, 0x5b, 0xb9, 0x3b,
-		0x3c, 0xa3, 0xb4, 0xe6, 0xe7, 0x1a, 0x2c, 0xa8, 0x26, 0x11, 0x74, 0x7b, 0xd8, 0xc9, 0x45, 0x58,
-		0x72, 0xe7, 0x74, 0x03, 0x0f, 0xfa, 0xad, 0x06, 0x4b, 0x3d, 0x71, 0x0a, 0x7d, 0x92, 0x2f, 0x79,
-		0x90, 0x19, 0xa0, 0xf2, 0xe0, 0xd4, 0xfc, 0xd2, 0xc4, 0x3f, 0x68, 0x70, 0xa5, 0x77, 0xf3, 0x47,
-		0x0f, 0x7a, 0x95, 0xc7, 0x00, 0xd0, 0x5a, 0xf9, 0xee, 0xe9, 0x05, 0x08, 0x2b, 0x37, 0xef, 0xff,
-		0xe0, 0xe3, 0x03, 0x9b, 0x36, 0xa3, 0xbd, 0x5a, 0xc3, 0x6b, 0xad, 0xa6, 0xfe, 0x63, 0xb5, 0x76,
-		0x40, 0x5c, 0xf1, 0x2f, 0xbe, 0xc9, 0xff, 0x32, 0xbe, 0x1f, 0xff, 0x7d, 0xb4, 0xb6, 0x37, 0xc6,
-		0x77, 0x3f, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe2, 0xa5, 0xb6, 0x93, 0x2c, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4b, 0x6f, 0x1b, 0xc7,
+		0x19, 0x4b, 0x89, 0x7a, 0x7c, 0xa4, 0x28, 0x69, 0x24, 0xcb, 0x2b, 0xca, 0x0f, 0x79, 0x1d, 0xdb,
+		0x4a, 0x9a, 0x52, 0x91, 0x1c, 0xa7, 0xae, 0xdd, 0x38, 0xd1, 0xc3, 0x8e, 0xd9, 0xc6, 0xb5, 0xb3,
+		0x52, 0x62, 0xa0, 0x0d, 0xb2, 0x1d, 0x72, 0x47, 0xd4, 0x56, 0xe4, 0x2e, 0xbd, 0x3b, 0x94, 0x42,
+		0xa3, 0x28, 0xd0, 0xa0, 0x29, 0x0a, 0x14, 0xbd, 0xb5, 0x87, 0xdc, 0xfa, 0x42, 0xaf, 0x45, 0xfe,
+		0x40, 0x8b, 0xfe, 0x84, 0xa2, 0xc7, 0xa0, 0x87, 0x9e, 0x7a, 0xee, 0xbd, 0x87, 0x62, 0x1e, 0x4b,
+		0xee, 0x92, 0xc3, 0x25, 0xa9, 0x47, 0x1c, 0x14, 0x3d, 0x89, 0x33, 0xf3, 0x7d, 0xdf, 0x7c, 0xf3,
+		0x3d, 0xe6, 0x7b, 0xcc, 0x0a, 0xae, 0x37, 0x4a, 0xc4, 0x5f, 0x2d, 0x63, 0x9b, 0xb8, 0x65, 0xb2,
+		0x5a, 0xc3, 0xb4, 0xbc, 0xef, 0xb8, 0x95, 0xd5, 0xc3, 0xb5, 0xd5, 0x80, 0xf8, 0x87, 0x4e, 0x99,
+		0x14, 0xea, 0xbe, 0x47, 0x3d, 0xa4, 0x33, 0xb8, 0x82, 0x84, 0x2b, 0x84, 0x70, 0x85, 0xc3, 0xb5,
+		0xfc, 0xa5, 0x8a, 0xe7, 0x55, 0xaa, 0x64, 0x95, 0xc3, 0x95, 0x1a, 0x7b, 0xab, 0x76, 0xc3, 0xc7,
+		0xd4, 0xf1, 0x5c, 0x81, 0x99, 0xbf, 0xdc, 0xb9, 0x4e, 0x9d, 0x1a, 0x09, 0x28, 0xae, 0xd5, 0x25,
+		0x40, 0x17, 0x81, 0x23, 0x1f, 0xd7, 0xeb, 0xc4, 0x0f, 0xe4, 0xfa, 0x72, 0x8c, 0x45, 0x5c, 0x77,
+		0x18, 0x77, 0x65, 0xaf, 0x56, 0x6b, 0x6f, 0xa1, 0x82, 0x78, 0xd6, 0x20, 0x7e, 0x53, 0x02, 0x18,
+		0x2a, 0x00, 0x8a, 0x83, 0x83, 0xaa, 0x13, 0x50, 0x09, 0xb3, 0xa2, 0x82, 0x91, 0x42, 0xb0, 0x8e,
+		0x3c, 0xff, 0x80, 0xf8, 0x12, 0xf2, 0x95, 0x7e, 0x90, 0x7b, 0x55, 0xef, 0x48, 0xc2, 0x5e, 0x51,
+		0xc1, 0xee, 0x3b, 0x01, 0xf5, 0x5a, 0xcc, 0xbd, 0x14, 0x03, 0x09, 0xf6, 0xb1, 0x4f, 0xec, 0x6e,
+		0xa8, 0x6b, 0x3d, 0xa0, 0xe2, 0xa7, 0x30, 0xee, 0xc1, 0xec, 0x2e, 0x0e, 0x0e, 0xde, 0x75, 0x02,
+		0xfa, 0x04, 0xfb, 0xd4, 0x61, 0x8a, 0x40, 0x2f, 0xc3, 0x8c, 0x13, 0x78, 0x55, 0xae, 0x15, 0xab,
+		0xe2, 0x7b, 0x8d, 0x7a, 0xa0, 0x6b, 0xcb, 0x23, 0x2b, 0x93, 0xe6, 0x74, 0x6b, 0xfe, 0x1d, 0x3e,
+		0x6d, 0xfc, 0x73, 0x14, 0xce, 0x77, 0x11, 0xd8, 0xf2, 0xdc, 0x3d, 0xa7, 0x82, 0x74, 0x18, 0x3f,
+		0x24, 0x7e, 0xe0, 0x78, 0xae, 0xae, 0x2d, 0x6b, 0x2b, 0x23, 0x66, 0x38, 0x44, 0xeb, 0x30, 0xe7,
+		0x36, 0x6a, 0x96, 0x4f, 0xb0, 0x6d, 0xd5, 0x43, 0xac, 0x40, 0x4f, 0x2d, 0x6b, 0x2b, 0xe9, 0xcd,
+		0x94, 0xae, 0x99, 0xb3, 0x6e, 0xa3, 0x66, 0x12, 0x6c, 0xb7, 0x48, 0x06, 0xe8, 0x75, 0x98, 0x67,
+		0x38, 0x47, 0xbe, 0x43, 0x49, 0x14, 0x69, 0xa4, 0x85, 0x84, 0xdc, 0x46, 0xed, 0x29, 0x5b, 0x8e,
+		0x60, 0xb9, 0x30, 0xdd, 0xb9, 0xcb, 0xe8, 0xf2, 0xc8, 0x4a, 0x66, 0xfd, 0x7e, 0xa1, 0x97, 0x85,
+		0x16, 0x7a, 0x9c, 0xa7, 0x10, 0x67, 0xe8, 0xbe, 0x4b, 0xfd, 0xa6, 0x99, 0xf3, 0xe3, 0x5c, 0x3e,
+		0x83, 0x99, 0x2e, 0x0e, 0xd3, 0x7c, 0xc3, 0x07, 0xc3, 0x6f, 0xd8, 0x71, 0x18, 0xb1, 0xe3, 0xf4,
+		0x51, 0x7c, 0x36, 0xef, 0xc2, 0x9c, 0x82, 0x33, 0x34, 0x03, 0x23, 0x07, 0xa4, 0xc9, 0x25, 0x9f,
+		0x36, 0xd9, 0x4f, 0xb4, 0x01, 0xe9, 0x43, 0x5c, 0x6d, 0x10, 0x2e, 0xe7, 0xcc, 0xfa, 0xd7, 0x86,
+		0x60, 0xc8, 0x14, 0x98, 0x77, 0x52, 0xb7, 0xb5, 0xbc, 0x07, 0xf3, 0x2a, 0xc6, 0xce, 0x6c, 0x43,
+		0xe3, 0x07, 0x30, 0xfb, 0xae, 0x87, 0xed, 0x4d, 0x5c, 0xc5, 0x6e, 0x99, 0xf8, 0x0f, 0x1d, 0x97,
+		0x06, 0xe8, 0x2a, 0x4c, 0x95, 0x70, 0xf9, 0xa0, 0xea, 0x55, 0xac, 0xb2, 0xd7, 0x70, 0xa9, 0x34,
+		0xb1, 0xac, 0x9c, 0xdc, 0x62, 0x73, 0xe8, 0x3a, 0x4c, 0xfb, 0x98, 0x29, 0x83, 0xf8, 0x56, 0x40,
+		0xca, 0x9e, 0x6b, 0x73, 0x56, 0x34, 0x73, 0x8a, 0x4d, 0x3f, 0x21, 0xfe, 0x0e, 0x9f, 0x34, 0x3e,
+		0x4b, 0x41, 0xfe, 0x89, 0x57, 0xad, 0x3e, 0xf0, 0xfc, 0x6d, 0x52, 0x76, 0x98, 0x8d, 0x32, 0x8e,
+		0x4c, 0xf2, 0xac, 0x41, 0x02, 0x8a, 0x8a, 0x30, 0xee, 0x8b, 0x9f, 0x7c, 0x97, 0xcc, 0xfa, 0x6a,
+		0xfc, 0x24, 0xb8, 0xee, 0xb0, 0x43, 0xf4, 0xa6, 0x60, 0x86, 0xf8, 0x68, 0x09, 0x26, 0x6d, 0xaf,
+		0x86, 0x1d, 0xd7, 0x72, 0x04, 0x2f, 0x93, 0xe6, 0x84, 0x98, 0x28, 0xda, 0x6c, 0xb1, 0xee, 0x55,
+		0xab, 0xc4, 0x67, 0x8b, 0x23, 0x62, 0x51, 0x4c, 0x14, 0x6d, 0x74, 0x0d, 0x72, 0x7b, 0x9e, 0x7f,
+		0x84, 0x7d, 0x9b, 0xd8, 0xd6, 0x9e, 0xef, 0xd5, 0xf4, 0x51, 0x0e, 0x31, 0xd5, 0x9a, 0x7d, 0xe0,
+		0x7b, 0x35, 0x74, 0x03, 0xa6, 0x3b, 0x7c, 0x57, 0x4f, 0x73, 0xb8, 0x5c, 0xdc, 0x75, 0x99, 0x6c,
+		0xc4, 0x2d, 0x65, 0x95, 0x1a, 0x4e, 0xd5, 0x66, 0x5b, 0x8e, 0x09, 0x82, 0x62, 0x7a, 0x93, 0xcd,
+		0x16, 0x6d, 0xe3, 0x93, 0x29, 0x58, 0x52, 0x9e, 0x2c, 0xa8, 0x7b, 0x6e, 0x40, 0xd0, 0x45, 0x00,
+		0x76, 0xa7, 0x58, 0xd4, 0x3b, 0x20, 0xc2, 0xd1, 0xb3, 0xe6, 0x24, 0x9b, 0xd9, 0x65, 0x13, 0xe8,
+		0x7d, 0x40, 0xe1, 0x15, 0x67, 0x91, 0x8f, 0x49, 0xb9, 0xc1, 0x38, 0x90, 0x06, 0x71, 0x5d, 0x29,
+		0xc6, 0xa7, 0x12, 0xfc, 0x7e, 0x08, 0x6d, 0xce, 0x1e, 0x75, 0x4e, 0xa1, 0x07, 0x30, 0xd5, 0x22,
+		0x4b, 0x9b, 0x75, 0xc2, 0xc5, 0x95, 0x59, 0xbf, 0x92, 0x48, 0x71, 0xb7, 0x59, 0x27, 0x66, 0xf6,
+		0x28, 0x32, 0x42, 0x1f, 0xc0, 0x62, 0xdd, 0x27, 0x87, 0x8e, 0xd7, 0x08, 0xac, 0x80, 0x62, 0x9f,
+		0x12, 0xdb, 0x22, 0x87, 0xc4, 0xa5, 0x4c, 0x1e, 0xa3, 0x9c, 0xe6, 0x52, 0x41, 0x04, 0x9c, 0x42,
+		0x18, 0x70, 0x0a, 0x45, 0x97, 0xbe, 0xf1, 0xfa, 0x07, 0xcc, 0x3e, 0xcd, 0x85, 0x10, 0x7b, 0x47,
+		0x20, 0xdf, 0x67, 0xb8, 0x45, 0x1b, 0xad, 0xc0, 0x4c, 0x17, 0xb9, 0x34, 0xb7, 0xd0, 0x5c, 0x10,
+		0x87, 0xd4, 0x61, 0x1c, 0x53, 0x4a, 0x6a, 0x75, 0xca, 0xe5, 0x9f, 0x36, 0xc3, 0x21, 0x32, 0x60,
+		0xca, 0x25, 0x1f, 0xd3, 0x36, 0x81, 0x71, 0x4e, 0x20, 0xc3, 0x26, 0x43, 0xec, 0x57, 0x01, 0xc5,
+		0xdc, 0xc0, 0xda, 0x77, 0x5c, 0xaa, 0x4f, 0x70, 0xc0, 0x99, 0xa8, 0x2f, 0x30, 0xaf, 0x41, 0xb7,
+		0x41, 0x0f, 0xa8, 0x53, 0x3e, 0x68, 0xb6, 0x55, 0x61, 0x11, 0x17, 0x97, 0xaa, 0xc4, 0xd6, 0x27,
+		0x97, 0xb5, 0x95, 0x09, 0x73, 0x41, 0xac, 0xb7, 0x04, 0x7d, 0x5f, 0xac, 0xa2, 0xdb, 0x90, 0xe6,
+		0x01, 0x52, 0x07, 0x2e, 0x13, 0x23, 0x51, 0xce, 0xef, 0x31, 0x48, 0x53, 0x20, 0x20, 0x13, 0xa6,
+		0x6c, 0x69, 0x37, 0x96, 0xe3, 0xee, 0x79, 0x7a, 0x86, 0x53, 0xf8, 0x7a, 0x9c, 0x82, 0x08, 0x50,
+		0xfc, 0x2a, 0xf0, 0xb1, 0x1b, 0x38, 0xc4, 0xa5, 0xa1, 0xb5, 0x15, 0xdd, 0x3d, 0xcf, 0xcc, 0xda,
+		0x91, 0x11, 0xfa, 0x08, 0x2e, 0x74, 0x1b, 0x95, 0xc5, 0xcd, 0x90, 0xc5, 0x36, 0x3d, 0xcb, 0xb7,
+		0xb8, 0xa8, 0x64, 0x32, 0xbc, 0x6a, 0xcc, 0xc5, 0x2e, 0xab, 0x0a, 0x97, 0x50, 0x01, 0xe6, 0x84,
+		0xd0, 0x59, 0x44, 0x25, 0x56, 0x18, 0xc5, 0xa6, 0xb8, 0x7e, 0x66, 0xf9, 0xd2, 0x0e, 0x5b, 0xf9,
+		0x40, 0x2c, 0xa0, 0x2b, 0x90, 0x2d, 0xf9, 0xd8, 0x2d, 0xef, 0x4b, 0x2f, 0xc8, 0x71, 0x2f, 0xc8,
+		0x88, 0x39, 0xe1, 0x07, 0x1b, 0x90, 0x0b, 0xca, 0xfb, 0xc4, 0x6e, 0x54, 0x89, 0x6d, 0xb1, 0x94,
+		0x46, 0x9f, 0xe6, 0x4c, 0xe6, 0xbb, 0xac, 0x6b, 0x37, 0xcc, 0x77, 0xcc, 0xa9, 0x16, 0x06, 0x9b,
+		0x43, 0x6f, 0x42, 0x36, 0xb4, 0x29, 0x4e, 0x60, 0xa6, 0x2f, 0x81, 0x8c, 0x84, 0xe7, 0xe8, 0x1f,
+		0xc2, 0x38, 0xd3, 0x88, 0x43, 0x02, 0x7d, 0x96, 0x47, 0xa4, 0xcd, 0xde, 0xf7, 0x71, 0x82, 0xc3,
+		0x17, 0xde, 0x13, 0x44, 0x44, 0x34, 0x0a, 0x49, 0x32, 0x91, 0x51, 0x8f, 0xe2, 0xaa, 0x25, 0xd3,
+		0x10, 0xab, 0xd4, 0xa4, 0x24, 0xd0, 0x11, 0xb7, 0xc4, 0x59, 0xbe, 0xf4, 0x50, 0xac, 0x6c, 0xb2,
+		0x05, 0xf4, 0x21, 0xcc, 0xb4, 0x42, 0xa4, 0x55, 0xe6, 0xf1, 0x4e, 0x9f, 0xe3, 0x07, 0x5a, 0x1b,
+		0x3a, 0x50, 0x9a, 0xd3, 0xf5, 0xf8, 0x04, 0xfa, 0x3e, 0xcc, 0x55, 0x3d, 0x6c, 0x5b, 0x25, 0x19,
+		0x33, 0xb8, 0x5b, 0x04, 0xfa, 0x7c, 0xbf, 0x38, 0xd4, 0x15, 0x67, 0xcc, 0xd9, 0x6a, 0xe7, 0x14,
+		0x7a, 0x04, 0x33, 0xb8, 0x41, 0x3d, 0xc9, 0xb5, 0xf0, 0xb8, 0x73, 0x9c, 0xf2, 0x55, 0xa5, 0xc5,
+		0x6d, 0x34, 0xa8, 0x27, 0xf8, 0x62, 0xf8, 0x66, 0x0e, 0xc7, 0xc6, 0x4c, 0x2f, 0x8d, 0xba, 0x8d,
+		0x99, 0xb4, 0x16, 0x4e, 0xa2, 0x97, 0xf7, 0x05, 0x11, 0xa9, 0x17, 0x49, 0x32, 0xff, 0x11, 0x64,
+		0xa3, 0x0a, 0x8b, 0x46, 0xe9, 0x49, 0x11, 0xa5, 0x6f, 0xc7, 0xa3, 0xf4, 0x40, 0xae, 0xdd, 0xce,
+		0x06, 0x6c, 0xc8, 0x46, 0x37, 0x56, 0xd0, 0xbf, 0x17, 0xa7, 0xbf, 0xd2, 0xfb, 0x74, 0xe1, 0x26,
+		0x82, 0x60, 0x34, 0x05, 0xf8, 0xb7, 0xd6, 0x0a, 0xd0, 0x1b, 0x65, 0xea, 0x1c, 0x3a, 0xb4, 0x79,
+		0xfc, 0x00, 0xad, 0xa0, 0xf0, 0x15, 0x0c, 0xd0, 0xc6, 0xaf, 0x01, 0x96, 0x94, 0x1c, 0xbf, 0xd0,
+		0xc0, 0x7b, 0x19, 0x32, 0x58, 0x72, 0xd3, 0x16, 0x02, 0x84, 0x53, 0x45, 0x9b, 0x45, 0xe6, 0x16,
+		0x00, 0x8f, 0xcc, 0xa3, 0x09, 0x91, 0xb9, 0x75, 0x30, 0x1e, 0x99, 0x71, 0x64, 0x84, 0xd6, 0x21,
+		0xed, 0xb8, 0xf5, 0x06, 0xe5, 0xd2, 0xc9, 0xac, 0x5f, 0x50, 0x6b, 0x14, 0x37, 0x99, 0x7f, 0x9a,
+		0x02, 0x54, 0x71, 0xc9, 0x8e, 0x9d, 0xf4, 0x92, 0x1d, 0x1f, 0xee, 0x92, 0xdd, 0x85, 0xc5, 0x90,
+		0x9e, 0xc5, 0xae, 0x88, 0xaa, 0x17, 0x10, 0x4e, 0xc8, 0x6b, 0x88, 0xb0, 0x9c, 0x59, 0x5f, 0xec,
+		0xa2, 0xb5, 0x2d, 0x2b, 0x60, 0x73, 0x21, 0xc4, 0xdd, 0xf5, 0xb6, 0x18, 0xe6, 0xae, 0x40, 0x44,
+		0xdf, 0x85, 0x05, 0xbe, 0x49, 0x37, 0xc9, 0xc9, 0x7e, 0x24, 0xe7, 0x38, 0x62, 0x07, 0xbd, 0x07,
+		0x30, 0xbb, 0x4f, 0xb0, 0x4f, 0x4b, 0x04, 0xd3, 0x16, 0x29, 0xe8, 0x47, 0x6a, 0xa6, 0x85, 0x13,
+		0xd2, 0x89, 0xe4, 0x2e, 0x99, 0x78, 0xee, 0xf2, 0x11, 0x5c, 0x8a, 0x6b, 0xc2, 0xf2, 0xf6, 0x2c,
+		0xba, 0xef, 0x04, 0x56, 0x88, 0x90, 0xed, 0x2b, 0xd8, 0x7c, 0x4c, 0x33, 0x8f, 0xf7, 0x76, 0xf7,
+		0x9d, 0x60, 0x43, 0xd2, 0x2f, 0x46, 0x4f, 0x60, 0x13, 0x8a, 0x9d, 0x6a, 0xa0, 0x4f, 0x0d, 0x60,
+		0x29, 0xed, 0x43, 0x6c, 0x0b, 0xac, 0xee, 0x54, 0x32, 0x77, 0xbc, 0x54, 0xf2, 0x06, 0x4c, 0x87,
+		0x63, 0x4b, 0xdc, 0x18, 0x3c, 0xc4, 0x4f, 0x9a, 0xb9, 0x70, 0x7a, 0x9b, 0xcf, 0xa2, 0x9b, 0x30,
+		0xb6, 0x4f, 0xb0, 0x4d, 0x7c, 0x19, 0xc1, 0x97, 0x94, 0x3b, 0x3d, 0xe4, 0x20, 0xa6, 0x04, 0xed,
+		0x15, 0xd1, 0x66, 0x4f, 0x25, 0xa2, 0x9d, 0x6d, 0x30, 0x56, 0xc5, 0xcb, 0xf9, 0x63, 0xc7, 0x4b,
+		0xe3, 0x2f, 0x69, 0x58, 0xd8, 0xb0, 0x6d, 0x55, 0xa1, 0x16, 0xbb, 0xbc, 0xb5, 0x8e, 0xcb, 0xfb,
+		0x8c, 0x2e, 0xc4, 0x3b, 0x30, 0xd9, 0x4e, 0x3c, 0x47, 0x06, 0x49, 0x3c, 0x27, 0xa8, 0xfc, 0xc5,
+		0x2e, 0xd3, 0xd6, 0x6d, 0x21, 0xeb, 0x8d, 0x11, 0x13, 0xc2, 0xa9, 0xa2, 0xdd, 0x79, 0x9d, 0xc8,
+		0x4b, 0x40, 0x3a, 0x6c, 0x7a, 0x88, 0xeb, 0x84, 0x97, 0x27, 0xa1, 0xdb, 0xde, 0x81, 0xb1, 0xc0,
+		0x6b, 0xf8, 0x65, 0x71, 0x3d, 0xe6, 0xd6, 0x8d, 0x9e, 0xb9, 0x38, 0x0e, 0x0e, 0x76, 0x38, 0xa4,
+		0x29, 0x31, 0x14, 0x51, 0x6e, 0x5c, 0x15, 0xe5, 0xea, 0x0a, 0x8b, 0x9a, 0xe8, 0xd7, 0x78, 0x51,
+		0x6b, 0xb5, 0xd0, 0x61, 0x60, 0xb2, 0x0d, 0xd2, 0x69, 0x65, 0x79, 0x98, 0xa8, 0xfb, 0x8e, 0xe7,
+		0x3b, 0xb4, 0xc9, 0x6f, 0xc5, 0xb4, 0xd9, 0x1a, 0xab, 0x6a, 0x5d, 0x50, 0xd4, 0xba, 0x2c, 0x8f,
+		0xdf, 0xc3, 0x8e, 0xef, 0x92, 0x20, 0xb0, 0x58, 0x16, 0x93, 0xe1, 0x40, 0x99, 0x70, 0xee, 0x3b,
+		0xa4, 0x99, 0xdf, 0x84, 0x79, 0x15, 0x3f, 0x8a, 0xbc, 0x67, 0x3e, 0x9a, 0xf7, 0x4c, 0x46, 0xb3,
+		0x99, 0x23, 0x38, 0xdf, 0x75, 0x54, 0x19, 0xd4, 0x55, 0x9e, 0xa8, 0x9d, 0x96, 0x27, 0x1a, 0x9f,
+		0x8f, 0x71, 0xd7, 0x51, 0xa5, 0x50, 0x2f, 0xc2, 0x75, 0x58, 0x91, 0xcc, 0xad, 0xca, 0x6a, 0x6f,
+		0x2d, 0x12, 0x8a, 0x9c, 0x98, 0xdf, 0x0e, 0x19, 0x88, 0x39, 0xd9, 0xe8, 0x89, 0x9c, 0x2c, 0x3d,
+		0x9c, 0x93, 0x8d, 0x9d, 0xdc, 0xc9, 0xc6, 0x4f, 0xc1, 0xc9, 0x26, 0x54, 0x4e, 0xe6, 0x82, 0x8e,
+		0x23, 0xaa, 0xdc, 0x76, 0x82, 0x3a, 0xb3, 0x0a, 0x56, 0x22, 0xcb, 0xc4, 0x60, 0x3d, 0xc1, 0xd9,
+		0x7a, 0x60, 0x9a, 0x3d, 0x69, 0x2a, 0x9d, 0x1a, 0x06, 0x70, 0x6a, 0x85, 0xbd, 0x1d, 0xc3, 0xa9,
+		0x33, 0x1d, 0x4e, 0xdd, 0xe9, 0xac, 0xd9, 0xb3, 0x71, 0xd6, 0x2f, 0x46, 0x40, 0xef, 0x25, 0x2b,
+		0xf4, 0x6d, 0x98, 0x6e, 0xa7, 0x39, 0xbc, 0x2f, 0xa0, 0x6b, 0x09, 0xd9, 0x83, 0xac, 0x80, 0x79,
+		0xf3, 0xc6, 0x6c, 0xa7, 0xaa, 0x7c, 0xdc, 0x95, 0x79, 0xa6, 0x86, 0xcb, 0x3c, 0x23, 0xb9, 0xd8,
+		0xc8, 0xb0, 0xb9, 0xd8, 0xe8, 0xe9, 0xe7, 0x62, 0xe9, 0xd3, 0xc9, 0xc5, 0xc6, 0x4e, 0x2d, 0x17,
+		0x1b, 0x57, 0xe5, 0x62, 0xf2, 0x2a, 0x56, 0xd6, 0x57, 0x67, 0x7b, 0x15, 0x7f, 0xa1, 0xc1, 0x3c,
+		0x2f, 0xa6, 0xc3, 0x53, 0x84, 0x17, 0xf1, 0x56, 0x67, 0x2d, 0xfb, 0xb2, 0xf2, 0xf0, 0x2a, 0xdc,
+		0x01, 0xab, 0xd8, 0x93, 0x64, 0x2c, 0x83, 0x15, 0xb9, 0xc6, 0x7f, 0x34, 0x38, 0xd7, 0xc1, 0xa1,
+		0x94, 0xea, 0x5b, 0x90, 0xe5, 0x7d, 0x41, 0xcb, 0x27, 0x41, 0xa3, 0x1a, 0x9e, 0x31, 0xd9, 0x4e,
+		0x32, 0x1c, 0xc3, 0xe4, 0x08, 0xa8, 0x08, 0xb9, 0x90, 0xc0, 0x0f, 0x49, 0x99, 0x12, 0x3b, 0xb1,
+		0x6f, 0x21, 0xfa, 0x15, 0x12, 0xd2, 0x9c, 0x7a, 0x16, 0x1d, 0xa2, 0xa7, 0x0a, 0x0d, 0x0b, 0x79,
+		0xbc, 0x9a, 0x28, 0x8f, 0xbe, 0xca, 0xfd, 0x65, 0x0a, 0x96, 0xc5, 0x89, 0x6d, 0xce, 0x00, 0x43,
+		0xdc, 0xf2, 0x6a, 0xf5, 0x2a, 0x61, 0x5c, 0x48, 0x1d, 0x3d, 0xee, 0x54, 0xf4, 0x2d, 0xe5, 0xa6,
+		0xfd, 0xe8, 0x7c, 0x09, 0x4a, 0x3f, 0x0f, 0xe3, 0x1c, 0x57, 0xa6, 0xa8, 0x93, 0xe6, 0x18, 0x1b,
+		0x16, 0x6d, 0x55, 0x5e, 0x95, 0x56, 0xbd, 0x21, 0x5c, 0x85, 0x2b, 0x09, 0xc7, 0x10, 0x96, 0x61,
+		0xfc, 0x43, 0x83, 0x0b, 0x5b, 0xac, 0x28, 0xa9, 0x3e, 0x6e, 0xd0, 0x80, 0x62, 0xd7, 0x76, 0xdc,
+		0x0a, 0x6b, 0x80, 0x0c, 0x94, 0xa2, 0xc4, 0x5a, 0x33, 0xa9, 0x8e, 0xd6, 0xcc, 0x3b, 0x90, 0x6b,
+		0x1d, 0xbe, 0xfd, 0x5c, 0x90, 0xeb, 0x71, 0xaf, 0x84, 0x12, 0x10, 0xf7, 0x0a, 0x8d, 0x8c, 0x4e,
+		0x92, 0x87, 0x18, 0x97, 0xe1, 0x62, 0x8f, 0xe3, 0x49, 0x01, 0xfc, 0x18, 0xce, 0x6f, 0x93, 0xa0,
+		0xec, 0x3b, 0x25, 0xd2, 0x42, 0x97, 0x47, 0x7f, 0xd0, 0x69, 0x2b, 0x6a, 0x03, 0xed, 0x81, 0x3e,
+		0x98, 0x89, 0x18, 0x9f, 0x6b, 0x00, 0x4f, 0x84, 0xc8, 0x58, 0x6c, 0xdb, 0x86, 0x99, 0x2a, 0x0e,
+		0xa8, 0x85, 0xcb, 0x65, 0x16, 0x62, 0x79, 0x4c, 0xd2, 0xfa, 0x06, 0x8a, 0x1c, 0xc3, 0xd9, 0xe0,
+		0x28, 0x6c, 0x92, 0x45, 0x70, 0xc7, 0x26, 0x2e, 0x65, 0x11, 0x5c, 0x6e, 0x18, 0x8e, 0x55, 0xcf,
+		0x73, 0x23, 0x8a, 0xe7, 0x39, 0xb4, 0x08, 0x13, 0x2d, 0xfb, 0x12, 0x06, 0x38, 0x5e, 0x92, 0x96,
+		0xf5, 0xe7, 0x14, 0xe8, 0xdd, 0xa7, 0x96, 0x77, 0xcd, 0x3d, 0x18, 0x17, 0x26, 0x20, 0x9e, 0xaf,
+		0x33, 0xeb, 0x2f, 0x25, 0x77, 0x56, 0xc5, 0xc1, 0xcd, 0x10, 0x89, 0x15, 0xae, 0x6d, 0xb3, 0x09,
+		0x28, 0xa6, 0x8d, 0x40, 0x4f, 0x25, 0x14, 0xae, 0x21, 0x03, 0x3b, 0x1c, 0xd4, 0xcc, 0xd1, 0xd8,
+		0xf8, 0xcc, 0xae, 0x9b, 0x13, 0x59, 0x65, 0x00, 0x17, 0xd9, 0xdf, 0xae, 0xbd, 0x82, 0xd0, 0xf4,
+		0x16, 0x60, 0x4c, 0x46, 0x50, 0xe1, 0x72, 0x72, 0x14, 0xdf, 0x34, 0x35, 0xdc, 0xa6, 0x3f, 0x4b,
+		0xc1, 0xa5, 0x5e, 0xbb, 0x4a, 0xdd, 0x3d, 0x83, 0x8b, 0xed, 0x36, 0x62, 0x4b, 0x09, 0x91, 0x57,
+		0x75, 0xa1, 0xd1, 0xc2, 0x60, 0x92, 0x7b, 0x44, 0x28, 0xb6, 0x31, 0xc5, 0x66, 0x3e, 0x9a, 0xdc,
+		0xc6, 0xb7, 0x66, 0x5b, 0xb6, 0x5e, 0xaa, 0x94, 0x5b, 0xa6, 0x8e, 0xb7, 0xa5, 0x1d, 0x29, 0xf4,
+		0xe2, 0x5b, 0x1a, 0xb7, 0x60, 0xe9, 0x1d, 0xd2, 0x12, 0x43, 0xb0, 0xd9, 0x14, 0x69, 0x49, 0x1f,
+		0xd9, 0x1b, 0x7f, 0x18, 0x85, 0x0b, 0x6a, 0x3c, 0x29, 0xbd, 0x9f, 0x6a, 0xb0, 0xa0, 0x38, 0x4b,
+		0x0d, 0xd7, 0xa5, 0xdc, 0x1e, 0xf7, 0xf6, 0x84, 0x24, 0xc2, 0x85, 0xed, 0x8e, 0xb3, 0x3c, 0xc2,
+		0x75, 0x91, 0xba, 0xcf, 0xd9, 0xdd, 0x2b, 0x9c, 0x0d, 0x85, 0x16, 0x19, 0x1b, 0xa9, 0x13, 0xb1,
+		0xb1, 0xd1, 0xa1, 0xc5, 0x36, 0x1b, 0xb8, 0x7b, 0x25, 0xff, 0x9c, 0xdd, 0x11, 0x6a, 0xbe, 0x15,
+		0xa5, 0xc0, 0xc3, 0xf8, 0x7b, 0x45, 0x42, 0x09, 0xd5, 0xeb, 0xe2, 0x89, 0xbe, 0x8f, 0x3c, 0x8f,
+		0x57, 0x0f, 0x5f, 0xe6, 0xde, 0xc6, 0x6f, 0x53, 0xf0, 0x92, 0x78, 0x4b, 0xe9, 0x75, 0x95, 0x0c,
+		0x12, 0x59, 0x4f, 0xe0, 0xe8, 0xa7, 0x17, 0x78, 0x55, 0x77, 0xe7, 0xe8, 0x69, 0xa4, 0x6a, 0x37,
+		0xe0, 0x5a, 0x1f, 0x11, 0xc9, 0xe8, 0xfc, 0xbb, 0x14, 0x5c, 0x33, 0xc9, 0x9e, 0x4f, 0x82, 0xfd,
+		0xff, 0x4b, 0xb3, 0x97, 0x34, 0x57, 0xe0, 0x7a, 0x3f, 0x19, 0x49, 0x71, 0xd6, 0x21, 0x17, 0x7f,
+		0xee, 0x63, 0x7d, 0x1a, 0xf1, 0x68, 0x29, 0x8e, 0x26, 0x04, 0x07, 0x62, 0x8a, 0x73, 0xfd, 0x66,
+		0x0b, 0x00, 0xfb, 0x95, 0x30, 0x12, 0x27, 0x57, 0x0e, 0x12, 0x7d, 0xc3, 0xaf, 0x04, 0xec, 0x23,
+		0x1f, 0x3d, 0x1a, 0x74, 0xd8, 0xdf, 0x60, 0x20, 0x9d, 0xb5, 0xaf, 0xe1, 0x54, 0xef, 0x10, 0x38,
+		0x72, 0x52, 0x5d, 0x8e, 0x1e, 0x4f, 0x97, 0x2c, 0xf1, 0xc5, 0x15, 0x62, 0x05, 0xce, 0x73, 0xa2,
+		0xa7, 0x65, 0x8f, 0x04, 0x57, 0xc8, 0x8e, 0xf3, 0x9c, 0xb0, 0x0c, 0x8b, 0x7f, 0x42, 0xc2, 0x21,
+		0xc4, 0x43, 0xe1, 0x18, 0x7f, 0x28, 0xe4, 0x5f, 0x96, 0x3c, 0xc1, 0x15, 0xc2, 0x1f, 0x0b, 0x8d,
+		0xbf, 0xa6, 0x20, 0x1b, 0x95, 0x0b, 0x53, 0x46, 0xab, 0x80, 0x6e, 0x49, 0x04, 0xc2, 0xa9, 0xa2,
+		0x8d, 0xce, 0xc1, 0x98, 0xdf, 0x88, 0xa4, 0x91, 0x69, 0xbf, 0xc1, 0x44, 0x15, 0x29, 0x15, 0x46,
+		0x78, 0xa3, 0x2d, 0x2c, 0x15, 0xfa, 0xb6, 0xba, 0xdf, 0x84, 0x6c, 0xd9, 0x27, 0xb8, 0xd5, 0xfe,
+		0x48, 0xf7, 0x6f, 0x7f, 0x48, 0x78, 0x36, 0x83, 0xee, 0x42, 0x86, 0x7c, 0x5c, 0x77, 0xfc, 0xe6,
+		0xa0, 0xef, 0x7e, 0x20, 0xc0, 0x39, 0xb2, 0xe2, 0x4d, 0x76, 0x5c, 0xf9, 0xd1, 0x54, 0xb4, 0x1f,
+		0x35, 0x11, 0xef, 0x47, 0x19, 0x3f, 0xd1, 0x60, 0x51, 0x61, 0x5f, 0x32, 0x22, 0x7f, 0x0b, 0xd2,
+		0x4c, 0x12, 0x61, 0xde, 0x72, 0xbd, 0x7f, 0x0b, 0x81, 0xfd, 0x35, 0x05, 0x92, 0x4a, 0x8f, 0x29,
+		0x95, 0x1e, 0xff, 0x96, 0x82, 0xfc, 0x36, 0x61, 0x75, 0xd5, 0xff, 0x98, 0x95, 0x77, 0xd8, 0x63,
+		0x3a, 0xc1, 0x1e, 0xc7, 0xa2, 0xf6, 0xb8, 0x01, 0xb9, 0xd0, 0xaa, 0x4a, 0x64, 0xcf, 0xf3, 0x07,
+		0x79, 0xd0, 0x9d, 0x92, 0x18, 0x9b, 0x1c, 0xc1, 0xd8, 0x84, 0x25, 0xa5, 0x48, 0xa5, 0x62, 0xaf,
+		0xb2, 0xef, 0x9b, 0xd8, 0xb2, 0x1d, 0xff, 0x10, 0x51, 0x4e, 0xf2, 0x8f, 0xaf, 0x8c, 0x9b, 0x30,
+		0xcf, 0x0a, 0x5e, 0x4c, 0x9d, 0x52, 0x95, 0x88, 0xaa, 0x78, 0x7b, 0x87, 0x70, 0x85, 0x84, 0x95,
+		0x4d, 0xf8, 0x89, 0xed, 0x84, 0x2c, 0x6d, 0x02, 0xe3, 0x5f, 0x1a, 0xbc, 0x1c, 0x8f, 0x4d, 0x12,
+		0x33, 0x24, 0xe5, 0x54, 0x1d, 0xda, 0x7c, 0x61, 0xba, 0xed, 0x5d, 0x95, 0xb1, 0x8f, 0x81, 0xca,
+		0xad, 0xe3, 0x76, 0xf6, 0x06, 0x66, 0xcb, 0x1d, 0x92, 0xb0, 0x8d, 0x4f, 0x35, 0x78, 0x65, 0x90,
+		0x93, 0x4a, 0x91, 0x3f, 0x85, 0xe9, 0x08, 0xf9, 0x80, 0xd0, 0x1e, 0xd5, 0x40, 0xd4, 0xab, 0x54,
+		0xe2, 0x37, 0x73, 0x6d, 0x32, 0x3b, 0x84, 0x06, 0xc6, 0x67, 0x1a, 0x5c, 0x8f, 0xe4, 0x9d, 0x5f,
+		0x25, 0x71, 0x1b, 0x9f, 0x68, 0x70, 0xa3, 0x2f, 0x6f, 0x67, 0x2c, 0xa0, 0xf5, 0xbf, 0xcf, 0x42,
+		0xe6, 0x91, 0x44, 0xda, 0x78, 0x52, 0x44, 0x9f, 0x68, 0x30, 0xa7, 0xf8, 0x26, 0x09, 0xbd, 0x3e,
+		0xe4, 0x27, 0x4c, 0x5c, 0xa6, 0xf9, 0x5b, 0xc7, 0xfa, 0xf0, 0x29, 0xca, 0x44, 0x34, 0xd5, 0x1e,
+		0x80, 0x09, 0xc5, 0xc3, 0x44, 0xfe, 0xd6, 0x90, 0x58, 0x92, 0x89, 0x43, 0x98, 0xee, 0x78, 0xd3,
+		0x43, 0xaf, 0x0d, 0xfb, 0xd2, 0x99, 0x5f, 0x1b, 0x02, 0x23, 0xb6, 0x6f, 0xec, 0xdc, 0xaf, 0x0d,
+		0xfb, 0x18, 0x93, 0x5f, 0x1b, 0x02, 0x43, 0xee, 0x5b, 0x87, 0xa9, 0x58, 0x83, 0x17, 0x25, 0x98,
+		0x96, 0xaa, 0x57, 0x9d, 0x5f, 0x1d, 0x18, 0x5e, 0xee, 0xf8, 0x2b, 0x0d, 0x16, 0x7b, 0x76, 0x11,
+		0xd1, 0x9d, 0xde, 0xe4, 0xfa, 0x75, 0x50, 0xf3, 0x77, 0x8f, 0x85, 0x2b, 0xd9, 0xfa, 0xb9, 0x06,
+		0xe7, 0x94, 0x7d, 0x3d, 0xf4, 0x46, 0x82, 0xb3, 0x25, 0xf4, 0x39, 0xf3, 0xdf, 0x18, 0x1a, 0x4f,
+		0xb2, 0xd2, 0x84, 0x99, 0xce, 0xb2, 0x10, 0xad, 0x0d, 0x53, 0x42, 0x8a, 0xfd, 0x8f, 0x51, 0x75,
+		0xa2, 0x5f, 0x68, 0xb0, 0xa0, 0xee, 0xe8, 0xa0, 0x84, 0xe3, 0x24, 0x76, 0x9e, 0xf2, 0xb7, 0x87,
+		0x47, 0x94, 0xdc, 0x7c, 0xaa, 0xc1, 0xbc, 0xaa, 0x7f, 0x80, 0x6e, 0x0d, 0xdb, 0x6f, 0x10, 0x9c,
+		0xbc, 0x71, 0xbc, 0x36, 0x05, 0xfa, 0x8d, 0x06, 0x17, 0x13, 0xab, 0x4b, 0x74, 0xaf, 0x37, 0xe5,
+		0x41, 0x2a, 0xf7, 0xfc, 0x5b, 0xc7, 0xc6, 0x97, 0x2c, 0xfe, 0x5e, 0x83, 0x4b, 0xc9, 0x25, 0x1b,
+		0x7a, 0x2b, 0xc9, 0x3d, 0x06, 0x28, 0x88, 0xf3, 0x6f, 0x1f, 0x9f, 0x80, 0xe4, 0xf2, 0x47, 0x30,
+		0xdb, 0x95, 0x5a, 0xa3, 0xf5, 0xc1, 0xec, 0x23, 0x9a, 0x01, 0xe7, 0x6f, 0x0e, 0x85, 0x13, 0x09,
+		0x30, 0x8a, 0x14, 0x30, 0x29, 0xc0, 0xf4, 0x4e, 0xc2, 0xf3, 0xb7, 0x86, 0xc4, 0x92, 0x4c, 0xfc,
+		0x49, 0x03, 0xa3, 0x7f, 0x8e, 0x84, 0xb6, 0x06, 0x35, 0x88, 0x84, 0xe4, 0x26, 0xbf, 0x7d, 0x32,
+		0x22, 0x92, 0xe3, 0x3f, 0x6a, 0x70, 0xb9, 0x4f, 0xc6, 0x82, 0xde, 0x1e, 0xc8, 0xb3, 0x92, 0x78,
+		0xdd, 0x38, 0x01, 0x05, 0xc1, 0xe8, 0xe6, 0xdd, 0xef, 0x7d, 0xb3, 0xe2, 0xd0, 0xfd, 0x46, 0xa9,
+		0x50, 0xf6, 0x6a, 0xab, 0xb1, 0x7f, 0x9c, 0x2b, 0x54, 0x88, 0x2b, 0xfe, 0xd3, 0x30, 0xfa, 0xcf,
+		0x8e, 0x77, 0xc3, 0xdf, 0x87, 0x6b, 0xa5, 0x31, 0xbe, 0x7a, 0xf3, 0xbf, 0x03, 0x00, 0xe7, 0x35,
+		0x47, 0x66, 0x1a, 0x39, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -23,6 +23,12 @@ var _ = ioutil.NopCloser
 // ShardDistributorAPIYARPCClient is the YARPC client-side interface for the ShardDistributorAPI service.
 type ShardDistributorAPIYARPCClient interface {
 	GetShardOwner(context.Context, *GetShardOwnerRequest, ...yarpc.CallOption) (*GetShardOwnerResponse, error)
+	CordonExecutor(context.Context, *CordonExecutorRequest, ...yarpc.CallOption) (*CordonExecutorResponse, error)
+	UncordonExecutor(context.Context, *UncordonExecutorRequest, ...yarpc.CallOption) (*UncordonExecutorResponse, error)
+	DrainExecutor(context.Context, *DrainExecutorRequest, ...yarpc.CallOption) (*DrainExecutorResponse, error)
+	PinShard(context.Context, *PinShardRequest, ...yarpc.CallOption) (*PinShardResponse, error)
+	UnpinShard(context.Context, *UnpinShardRequest, ...yarpc.CallOption) (*UnpinShardResponse, error)
+	DescribeNamespaceControls(context.Context, *DescribeNamespaceControlsRequest, ...yarpc.CallOption) (*DescribeNamespaceControlsResponse, error)
 	WatchNamespaceState(context.Context, *WatchNamespaceStateRequest, ...yarpc.CallOption) (ShardDistributorAPIServiceWatchNamespaceStateYARPCClient, error)
 }
 
@@ -52,6 +58,12 @@ func NewShardDistributorAPIYARPCClient(clientConfig transport.ClientConfig, opti
 // ShardDistributorAPIYARPCServer is the YARPC server-side interface for the ShardDistributorAPI service.
 type ShardDistributorAPIYARPCServer interface {
 	GetShardOwner(context.Context, *GetShardOwnerRequest) (*GetShardOwnerResponse, error)
+	CordonExecutor(context.Context, *CordonExecutorRequest) (*CordonExecutorResponse, error)
+	UncordonExecutor(context.Context, *UncordonExecutorRequest) (*UncordonExecutorResponse, error)
+	DrainExecutor(context.Context, *DrainExecutorRequest) (*DrainExecutorResponse, error)
+	PinShard(context.Context, *PinShardRequest) (*PinShardResponse, error)
+	UnpinShard(context.Context, *UnpinShardRequest) (*UnpinShardResponse, error)
+	DescribeNamespaceControls(context.Context, *DescribeNamespaceControlsRequest) (*DescribeNamespaceControlsResponse, error)
 	WatchNamespaceState(*WatchNamespaceStateRequest, ShardDistributorAPIServiceWatchNamespaceStateYARPCServer) error
 }
 
@@ -82,6 +94,66 @@ func buildShardDistributorAPIYARPCProcedures(params buildShardDistributorAPIYARP
 						},
 					),
 				},
+				{
+					MethodName: "CordonExecutor",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.CordonExecutor,
+							NewRequest:  newShardDistributorAPIServiceCordonExecutorYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UncordonExecutor",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UncordonExecutor,
+							NewRequest:  newShardDistributorAPIServiceUncordonExecutorYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DrainExecutor",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DrainExecutor,
+							NewRequest:  newShardDistributorAPIServiceDrainExecutorYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PinShard",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PinShard,
+							NewRequest:  newShardDistributorAPIServicePinShardYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UnpinShard",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UnpinShard,
+							NewRequest:  newShardDistributorAPIServiceUnpinShardYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeNamespaceControls",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeNamespaceControls,
+							NewRequest:  newShardDistributorAPIServiceDescribeNamespaceControlsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{
@@ -222,6 +294,78 @@ func (c *_ShardDistributorAPIYARPCCaller) GetShardOwner(ctx context.Context, req
 	return response, err
 }
 
+func (c *_ShardDistributorAPIYARPCCaller) CordonExecutor(ctx context.Context, request *CordonExecutorRequest, options ...yarpc.CallOption) (*CordonExecutorResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CordonExecutor", request, newShardDistributorAPIServiceCordonExecutorYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CordonExecutorResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceCordonExecutorYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) UncordonExecutor(ctx context.Context, request *UncordonExecutorRequest, options ...yarpc.CallOption) (*UncordonExecutorResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UncordonExecutor", request, newShardDistributorAPIServiceUncordonExecutorYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UncordonExecutorResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceUncordonExecutorYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) DrainExecutor(ctx context.Context, request *DrainExecutorRequest, options ...yarpc.CallOption) (*DrainExecutorResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DrainExecutor", request, newShardDistributorAPIServiceDrainExecutorYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DrainExecutorResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceDrainExecutorYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) PinShard(ctx context.Context, request *PinShardRequest, options ...yarpc.CallOption) (*PinShardResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PinShard", request, newShardDistributorAPIServicePinShardYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PinShardResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServicePinShardYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) UnpinShard(ctx context.Context, request *UnpinShardRequest, options ...yarpc.CallOption) (*UnpinShardResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UnpinShard", request, newShardDistributorAPIServiceUnpinShardYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UnpinShardResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceUnpinShardYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) DescribeNamespaceControls(ctx context.Context, request *DescribeNamespaceControlsRequest, options ...yarpc.CallOption) (*DescribeNamespaceControlsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeNamespaceControls", request, newShardDistributorAPIServiceDescribeNamespaceControlsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeNamespaceControlsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceDescribeNamespaceControlsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_ShardDistributorAPIYARPCCaller) WatchNamespaceState(ctx context.Context, request *WatchNamespaceStateRequest, options ...yarpc.CallOption) (ShardDistributorAPIServiceWatchNamespaceStateYARPCClient, error) {
 	stream, err := c.streamClient.CallStream(ctx, "WatchNamespaceState", options...)
 	if err != nil {
@@ -253,6 +397,102 @@ func (h *_ShardDistributorAPIYARPCHandler) GetShardOwner(ctx context.Context, re
 	return response, err
 }
 
+func (h *_ShardDistributorAPIYARPCHandler) CordonExecutor(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CordonExecutorRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CordonExecutorRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceCordonExecutorYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CordonExecutor(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) UncordonExecutor(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UncordonExecutorRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UncordonExecutorRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceUncordonExecutorYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UncordonExecutor(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) DrainExecutor(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DrainExecutorRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DrainExecutorRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceDrainExecutorYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DrainExecutor(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) PinShard(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PinShardRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PinShardRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServicePinShardYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PinShard(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) UnpinShard(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UnpinShardRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UnpinShardRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceUnpinShardYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UnpinShard(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) DescribeNamespaceControls(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeNamespaceControlsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeNamespaceControlsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceDescribeNamespaceControlsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeNamespaceControls(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_ShardDistributorAPIYARPCHandler) WatchNamespaceState(serverStream *protobuf.ServerStream) error {
 	requestMessage, err := serverStream.Receive(newShardDistributorAPIServiceWatchNamespaceStateYARPCRequest)
 	if requestMessage == nil {
@@ -318,47 +558,130 @@ func newShardDistributorAPIServiceWatchNamespaceStateYARPCResponse() proto.Messa
 	return &WatchNamespaceStateResponse{}
 }
 
+func newShardDistributorAPIServiceCordonExecutorYARPCRequest() proto.Message {
+	return &CordonExecutorRequest{}
+}
+
+func newShardDistributorAPIServiceCordonExecutorYARPCResponse() proto.Message {
+	return &CordonExecutorResponse{}
+}
+
+func newShardDistributorAPIServiceUncordonExecutorYARPCRequest() proto.Message {
+	return &UncordonExecutorRequest{}
+}
+
+func newShardDistributorAPIServiceUncordonExecutorYARPCResponse() proto.Message {
+	return &UncordonExecutorResponse{}
+}
+
+func newShardDistributorAPIServiceDrainExecutorYARPCRequest() proto.Message {
+	return &DrainExecutorRequest{}
+}
+
+func newShardDistributorAPIServiceDrainExecutorYARPCResponse() proto.Message {
+	return &DrainExecutorResponse{}
+}
+
+func newShardDistributorAPIServicePinShardYARPCRequest() proto.Message {
+	return &PinShardRequest{}
+}
+
+func newShardDistributorAPIServicePinShardYARPCResponse() proto.Message {
+	return &PinShardResponse{}
+}
+
+func newShardDistributorAPIServiceUnpinShardYARPCRequest() proto.Message {
+	return &UnpinShardRequest{}
+}
+
+func newShardDistributorAPIServiceUnpinShardYARPCResponse() proto.Message {
+	return &UnpinShardResponse{}
+}
+
+func newShardDistributorAPIServiceDescribeNamespaceControlsYARPCRequest() proto.Message {
+	return &DescribeNamespaceControlsRequest{}
+}
+
+func newShardDistributorAPIServiceDescribeNamespaceControlsYARPCResponse() proto.Message {
+	return &DescribeNamespaceControlsResponse{}
+}
+
 var (
-	emptyShardDistributorAPIServiceGetShardOwnerYARPCRequest        = &GetShardOwnerRequest{}
-	emptyShardDistributorAPIServiceGetShardOwnerYARPCResponse       = &GetShardOwnerResponse{}
-	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCRequest  = &WatchNamespaceStateRequest{}
-	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCResponse = &WatchNamespaceStateResponse{}
+	emptyShardDistributorAPIServiceGetShardOwnerYARPCRequest              = &GetShardOwnerRequest{}
+	emptyShardDistributorAPIServiceGetShardOwnerYARPCResponse             = &GetShardOwnerResponse{}
+	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCRequest        = &WatchNamespaceStateRequest{}
+	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCResponse       = &WatchNamespaceStateResponse{}
+	emptyShardDistributorAPIServiceCordonExecutorYARPCRequest             = &CordonExecutorRequest{}
+	emptyShardDistributorAPIServiceCordonExecutorYARPCResponse            = &CordonExecutorResponse{}
+	emptyShardDistributorAPIServiceUncordonExecutorYARPCRequest           = &UncordonExecutorRequest{}
+	emptyShardDistributorAPIServiceUncordonExecutorYARPCResponse          = &UncordonExecutorResponse{}
+	emptyShardDistributorAPIServiceDrainExecutorYARPCRequest              = &DrainExecutorRequest{}
+	emptyShardDistributorAPIServiceDrainExecutorYARPCResponse             = &DrainExecutorResponse{}
+	emptyShardDistributorAPIServicePinShardYARPCRequest                   = &PinShardRequest{}
+	emptyShardDistributorAPIServicePinShardYARPCResponse                  = &PinShardResponse{}
+	emptyShardDistributorAPIServiceUnpinShardYARPCRequest                 = &UnpinShardRequest{}
+	emptyShardDistributorAPIServiceUnpinShardYARPCResponse                = &UnpinShardResponse{}
+	emptyShardDistributorAPIServiceDescribeNamespaceControlsYARPCRequest  = &DescribeNamespaceControlsRequest{}
+	emptyShardDistributorAPIServiceDescribeNamespaceControlsYARPCResponse = &DescribeNamespaceControlsResponse{}
 )
 
 var yarpcFileDescriptorClosure0055bfd59dff1f95 = [][]byte{
 	// uber/cadence/sharddistributor/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xd1, 0x6e, 0xd3, 0x30,
-		0x14, 0x95, 0x53, 0x6d, 0x5a, 0xef, 0x98, 0x84, 0xbc, 0x81, 0xaa, 0x0e, 0x89, 0x2a, 0x42, 0x62,
-		0x4f, 0x0e, 0x1d, 0xd2, 0x40, 0x1b, 0x08, 0x81, 0x28, 0xa8, 0x1a, 0x6c, 0x90, 0x3d, 0x80, 0x78,
-		0x99, 0xdc, 0xe4, 0xb2, 0x46, 0xa3, 0x76, 0xb1, 0x9d, 0x40, 0xdf, 0x78, 0xe3, 0x07, 0xf8, 0x0f,
-		0x3e, 0x8c, 0x9f, 0x40, 0x71, 0x9d, 0x75, 0x89, 0xb2, 0x96, 0x8e, 0xb7, 0xe4, 0xde, 0x7b, 0x8e,
-		0x8f, 0x7d, 0x8e, 0x0d, 0x2c, 0x1d, 0xa0, 0x0a, 0x22, 0x1e, 0xa3, 0x88, 0x30, 0xd0, 0x43, 0xae,
-		0xe2, 0x38, 0xd1, 0x46, 0x25, 0x83, 0xd4, 0x48, 0x15, 0x64, 0xdd, 0x40, 0xa3, 0xca, 0x92, 0x08,
-		0xd9, 0x58, 0x49, 0x23, 0x69, 0x27, 0x9f, 0x67, 0x6e, 0x9e, 0x55, 0xe7, 0x59, 0xd6, 0xf5, 0xdf,
-		0xc3, 0xd6, 0x6b, 0x34, 0x27, 0x79, 0xe7, 0xf8, 0x9b, 0x40, 0x15, 0xe2, 0xd7, 0x14, 0xb5, 0xa1,
-		0xdb, 0xd0, 0xb4, 0xe3, 0xa7, 0xe7, 0x38, 0x69, 0x91, 0x0e, 0xd9, 0x69, 0x86, 0x6b, 0xb6, 0x70,
-		0x88, 0x13, 0x7a, 0x07, 0x9a, 0x82, 0x8f, 0x50, 0x8f, 0x79, 0x84, 0x2d, 0xcf, 0x36, 0x67, 0x05,
-		0xff, 0x0f, 0x81, 0x5b, 0x15, 0x4e, 0x3d, 0x96, 0x42, 0x23, 0xdd, 0x82, 0x15, 0x99, 0x17, 0x1c,
-		0xe1, 0xf4, 0x67, 0x3e, 0x1b, 0xe5, 0xb0, 0x36, 0x42, 0xc3, 0x63, 0x6e, 0x78, 0xab, 0xd1, 0x69,
-		0xec, 0xac, 0xef, 0xf6, 0xd8, 0xa2, 0x5d, 0xb1, 0xda, 0xe5, 0xd9, 0x5b, 0xc7, 0xd3, 0x13, 0x46,
-		0x4d, 0xc2, 0x0b, 0xda, 0xf6, 0x01, 0x6c, 0x94, 0x5a, 0xf4, 0x26, 0x34, 0x66, 0xdb, 0xce, 0x3f,
-		0x73, 0xe5, 0x19, 0xff, 0x92, 0x16, 0xfa, 0xa6, 0x3f, 0xfb, 0xde, 0x63, 0xe2, 0xef, 0xc1, 0xed,
-		0xa3, 0x42, 0xec, 0x91, 0x34, 0xaf, 0x64, 0x2a, 0xe2, 0x9e, 0x52, 0xb2, 0xb2, 0x2f, 0x52, 0x3d,
-		0xa5, 0x63, 0xa0, 0x56, 0xe2, 0x12, 0x98, 0xb2, 0x29, 0x5e, 0xd9, 0x14, 0x7f, 0x1f, 0xda, 0x1f,
-		0xb8, 0x89, 0x86, 0x17, 0x6a, 0x4e, 0x0c, 0x37, 0x58, 0xf8, 0x39, 0x5f, 0xcc, 0x39, 0x6c, 0xd7,
-		0x62, 0x9d, 0x6f, 0x6f, 0xa0, 0x89, 0xdf, 0x31, 0xca, 0x4f, 0x57, 0xb7, 0x88, 0x35, 0x81, 0x2d,
-		0x36, 0xa1, 0xe7, 0x20, 0x7d, 0xf1, 0x59, 0x86, 0x33, 0x02, 0xff, 0xa7, 0x07, 0x37, 0x2e, 0xf7,
-		0xe8, 0x5d, 0x58, 0x2f, 0xba, 0xa7, 0x49, 0xec, 0xd4, 0x41, 0x51, 0xea, 0xc7, 0xf4, 0xe3, 0xa5,
-		0x0c, 0x78, 0x76, 0xf9, 0x27, 0xcb, 0x2d, 0x7f, 0x95, 0xf5, 0xf4, 0x19, 0xac, 0x5a, 0xac, 0x76,
-		0xd9, 0xba, 0xbf, 0x98, 0xd7, 0xba, 0x16, 0x3a, 0xd8, 0xff, 0x65, 0xe7, 0x1e, 0xac, 0x58, 0xb6,
-		0xb9, 0xb7, 0x6d, 0xf7, 0xb7, 0x07, 0x9b, 0x76, 0xec, 0xe5, 0x4c, 0xc8, 0xf3, 0x77, 0x7d, 0xfa,
-		0x83, 0xc0, 0x46, 0x29, 0xe8, 0x74, 0x6f, 0xe9, 0x9b, 0x61, 0xc3, 0xd1, 0x7e, 0x74, 0xcd, 0x1b,
-		0x45, 0x7f, 0x11, 0xd8, 0xac, 0x09, 0x0e, 0xfd, 0x07, 0x7b, 0xae, 0xce, 0x6a, 0xfb, 0xe9, 0x35,
-		0xd1, 0x53, 0x51, 0x0f, 0xc8, 0x8b, 0xc3, 0x4f, 0xfd, 0xb3, 0xc4, 0x0c, 0xd3, 0x01, 0x8b, 0xe4,
-		0x28, 0x28, 0xbd, 0x99, 0xec, 0x0c, 0x45, 0x60, 0x1f, 0xc7, 0xba, 0xe7, 0xf3, 0xa0, 0x5a, 0xcb,
-		0xba, 0x83, 0x55, 0x3b, 0xfd, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x73, 0x0d, 0x95,
-		0x7c, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdb, 0x4e, 0xdb, 0x48,
+		0x18, 0x96, 0x93, 0x0d, 0x72, 0x7e, 0x8e, 0x3b, 0x04, 0xc8, 0x9a, 0x95, 0x36, 0x6b, 0xad, 0x04,
+		0xda, 0x0b, 0x87, 0x40, 0x05, 0x14, 0x5a, 0xb5, 0x25, 0xa4, 0x6d, 0x44, 0x21, 0xd4, 0x88, 0x16,
+		0xf5, 0x26, 0x72, 0xec, 0x69, 0xb0, 0x20, 0x33, 0x61, 0xec, 0x04, 0xb8, 0xab, 0xd4, 0x0b, 0xd4,
+		0xfb, 0x3e, 0x42, 0x5f, 0xa5, 0xcf, 0xd0, 0x87, 0xe8, 0x4b, 0x54, 0x1e, 0x8f, 0x73, 0x30, 0x39,
+		0x38, 0x40, 0xef, 0x3c, 0x87, 0xff, 0xfb, 0xbf, 0xff, 0x30, 0xdf, 0x2f, 0x83, 0xd6, 0xa8, 0x60,
+		0x96, 0x35, 0x0d, 0x0b, 0x13, 0x13, 0x67, 0x9d, 0x53, 0x83, 0x59, 0x96, 0xed, 0xb8, 0xcc, 0xae,
+		0x34, 0x5c, 0xca, 0xb2, 0xcd, 0x5c, 0xd6, 0xc1, 0xac, 0x69, 0x9b, 0x58, 0xab, 0x33, 0xea, 0x52,
+		0x94, 0xf1, 0xee, 0x6b, 0xe2, 0xbe, 0x16, 0xbe, 0xaf, 0x35, 0x73, 0xea, 0x5b, 0x48, 0xbd, 0xc2,
+		0xee, 0x91, 0x77, 0x52, 0xba, 0x24, 0x98, 0xe9, 0xf8, 0xa2, 0x81, 0x1d, 0x17, 0x2d, 0x42, 0x92,
+		0x5f, 0x2f, 0x9f, 0xe1, 0xeb, 0xb4, 0x94, 0x91, 0x96, 0x93, 0xba, 0xcc, 0x37, 0xf6, 0xf0, 0x35,
+		0xfa, 0x1b, 0x92, 0xc4, 0xa8, 0x61, 0xa7, 0x6e, 0x98, 0x38, 0x1d, 0xe3, 0x87, 0xed, 0x0d, 0xf5,
+		0xa7, 0x04, 0x73, 0x21, 0x4c, 0xa7, 0x4e, 0x89, 0x83, 0x51, 0x0a, 0x12, 0xd4, 0xdb, 0x10, 0x80,
+		0xfe, 0x62, 0x30, 0x1a, 0x32, 0x40, 0xae, 0x61, 0xd7, 0xb0, 0x0c, 0xd7, 0x48, 0xc7, 0x33, 0xf1,
+		0xe5, 0xf1, 0xd5, 0x82, 0x36, 0x2c, 0x2a, 0xad, 0xa7, 0x7b, 0x6d, 0x5f, 0xe0, 0x14, 0x88, 0xcb,
+		0xae, 0xf5, 0x16, 0xac, 0xb2, 0x0d, 0x93, 0x5d, 0x47, 0x68, 0x06, 0xe2, 0xed, 0xb0, 0xbd, 0x4f,
+		0x8f, 0x79, 0xd3, 0x38, 0x6f, 0x04, 0xfc, 0xfc, 0xc5, 0x56, 0x6c, 0x53, 0x52, 0xd7, 0x61, 0xfe,
+		0x20, 0x20, 0x7b, 0x40, 0xdd, 0x97, 0xb4, 0x41, 0xac, 0x02, 0x63, 0x34, 0x14, 0x97, 0x14, 0xce,
+		0x52, 0x09, 0x10, 0xa7, 0x38, 0x82, 0x4d, 0x77, 0x51, 0x62, 0xdd, 0x45, 0x51, 0xb7, 0x40, 0x79,
+		0x6f, 0xb8, 0xe6, 0x69, 0x8b, 0xcd, 0x91, 0x6b, 0xb8, 0x38, 0xa8, 0xe7, 0x60, 0x32, 0x67, 0xb0,
+		0xd8, 0xd3, 0x56, 0xd4, 0xed, 0x0d, 0x24, 0xf1, 0x15, 0x36, 0xbd, 0xec, 0x3a, 0x69, 0x89, 0x17,
+		0x41, 0x1b, 0x5e, 0x84, 0x82, 0x30, 0x29, 0x92, 0x8f, 0x54, 0x6f, 0x03, 0xa8, 0x37, 0x31, 0x98,
+		0xe8, 0x3c, 0x43, 0xff, 0xc0, 0x78, 0x70, 0x5a, 0xb6, 0x2d, 0xc1, 0x0e, 0x82, 0xad, 0xa2, 0x85,
+		0x4e, 0x3a, 0x7a, 0x20, 0xc6, 0xdd, 0x3f, 0x19, 0xcd, 0x7d, 0xbf, 0xd2, 0xa3, 0x67, 0x30, 0xc6,
+		0x6d, 0x1d, 0xd1, 0x5b, 0x4b, 0xc3, 0x71, 0x79, 0xd5, 0x74, 0x61, 0x76, 0xbf, 0xde, 0xf9, 0x0f,
+		0x12, 0x1c, 0x6d, 0xe0, 0x6b, 0x53, 0xdf, 0xc1, 0x5c, 0x9e, 0x32, 0x8b, 0x92, 0x20, 0xa2, 0x48,
+		0x35, 0x0d, 0x67, 0x35, 0x16, 0xce, 0xaa, 0x9a, 0x86, 0xf9, 0x30, 0xae, 0x5f, 0x6f, 0xf5, 0x04,
+		0x16, 0x8e, 0x89, 0xf9, 0x3b, 0x7c, 0x2a, 0x90, 0xbe, 0x8d, 0x2c, 0xbc, 0xde, 0x48, 0x90, 0xda,
+		0x65, 0x86, 0xfd, 0xc0, 0x3e, 0xd1, 0x0a, 0xa4, 0x6a, 0xc6, 0x55, 0xd9, 0xa4, 0xc4, 0x6c, 0x30,
+		0x86, 0x89, 0x5b, 0xae, 0xd1, 0x26, 0xf6, 0x2a, 0x2e, 0x2d, 0x27, 0x74, 0x54, 0x33, 0xae, 0xf2,
+		0xad, 0xa3, 0x7d, 0xef, 0x44, 0x5d, 0x80, 0xb9, 0x10, 0x11, 0x41, 0xb1, 0x06, 0xd3, 0x87, 0x36,
+		0xf1, 0x3b, 0x20, 0x12, 0xb9, 0x41, 0x2f, 0x36, 0xcc, 0x3c, 0x7e, 0x2b, 0x5b, 0x08, 0x66, 0xda,
+		0xee, 0x04, 0x85, 0x03, 0xf8, 0xf3, 0x98, 0xd4, 0x1f, 0x8c, 0x84, 0x9a, 0x02, 0xd4, 0x89, 0x27,
+		0xbc, 0x3c, 0x87, 0xcc, 0x2e, 0x76, 0x4c, 0x66, 0x57, 0x70, 0x4b, 0x13, 0xf2, 0x94, 0xb8, 0x8c,
+		0x9e, 0x3b, 0xd1, 0x24, 0xe5, 0xbb, 0x04, 0xff, 0x0e, 0x80, 0x10, 0xca, 0x52, 0xba, 0xad, 0x2c,
+		0xb9, 0xe8, 0x4f, 0x5b, 0xc0, 0x75, 0x88, 0x0b, 0x2a, 0xc1, 0x64, 0xdd, 0x26, 0x04, 0x5b, 0x65,
+		0xf1, 0xae, 0x7d, 0xbd, 0xf8, 0x3f, 0xe2, 0xbb, 0x3e, 0xb4, 0x89, 0x3e, 0xe1, 0x03, 0xf0, 0xb5,
+		0xe3, 0xc5, 0x31, 0x1d, 0xf2, 0x37, 0x5c, 0xb0, 0x14, 0x90, 0xfd, 0x26, 0xc7, 0x7e, 0x43, 0xca,
+		0x7a, 0x6b, 0xed, 0x9d, 0x59, 0x5e, 0x73, 0xd9, 0xa4, 0xca, 0x4b, 0x2e, 0xeb, 0xad, 0x75, 0xdf,
+		0x56, 0xfd, 0xa3, 0x5f, 0xab, 0xa2, 0x25, 0x98, 0x36, 0x1c, 0xc7, 0xae, 0x76, 0x44, 0x9c, 0xe0,
+		0x97, 0xa7, 0x82, 0x6d, 0x11, 0xc7, 0x6b, 0x90, 0x83, 0x08, 0x07, 0x0f, 0xf7, 0x61, 0xef, 0x69,
+		0xf5, 0x87, 0x0c, 0xb3, 0x1c, 0x6a, 0xb7, 0x9d, 0xc0, 0x17, 0x87, 0x45, 0xf4, 0x49, 0x82, 0xc9,
+		0xae, 0xc1, 0x8b, 0xd6, 0x47, 0x9e, 0xd4, 0xbc, 0xb3, 0x94, 0x8d, 0x3b, 0x4e, 0x78, 0xf4, 0x55,
+		0x82, 0xd9, 0x1e, 0x83, 0x0c, 0x45, 0x18, 0x17, 0xfd, 0x67, 0xa7, 0xf2, 0xf4, 0x8e, 0xd6, 0x3e,
+		0xa9, 0x15, 0x09, 0x7d, 0x96, 0x60, 0xaa, 0x5b, 0x6a, 0x51, 0x84, 0x10, 0x7b, 0x8a, 0xbe, 0xb2,
+		0x39, 0xba, 0xa1, 0x48, 0xce, 0x17, 0x09, 0x66, 0xc2, 0xe2, 0x8b, 0x1e, 0x0f, 0x87, 0xeb, 0x33,
+		0x0a, 0x94, 0xad, 0xbb, 0x98, 0x0a, 0x2e, 0x5e, 0xaf, 0x74, 0x49, 0x6c, 0x94, 0x5e, 0xe9, 0x35,
+		0x1c, 0x94, 0x8d, 0x91, 0xed, 0x04, 0x85, 0x0b, 0x90, 0x03, 0x71, 0x45, 0x11, 0x34, 0x27, 0xa4,
+		0xfb, 0xca, 0xea, 0x28, 0x26, 0xc2, 0xe5, 0x25, 0x40, 0x5b, 0x6b, 0xd1, 0x5a, 0x94, 0xfc, 0x85,
+		0x94, 0x5e, 0x79, 0x34, 0x9a, 0x91, 0x70, 0xfc, 0x4d, 0x82, 0xbf, 0xfa, 0x8a, 0x31, 0xda, 0x89,
+		0x90, 0xc2, 0x21, 0xc3, 0x40, 0xc9, 0xdf, 0x0b, 0xc3, 0xa7, 0xb9, 0xb3, 0xf7, 0xa1, 0x58, 0xb5,
+		0xdd, 0xd3, 0x46, 0x45, 0x33, 0x69, 0x2d, 0xdb, 0xf5, 0xaf, 0xa3, 0x55, 0x31, 0xc9, 0xf2, 0x9f,
+		0x9a, 0x5e, 0xbf, 0x3d, 0xdb, 0xe1, 0xbd, 0x66, 0xae, 0x32, 0xc6, 0x6f, 0xaf, 0xfd, 0x1a, 0x00,
+		0x88, 0x90, 0xdb, 0x40, 0x34, 0x0d, 0x00, 0x00,
 	},
 }
 
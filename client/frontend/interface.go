@@ -83,6 +83,10 @@ type Client interface {
 	StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionAsyncResponse, error)
 	TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
 	UpdateDomain(context.Context, *types.UpdateDomainRequest, ...yarpc.CallOption) (*types.UpdateDomainResponse, error)
+	UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest, ...yarpc.CallOption) (*types.UpdateActivityOptionsResponse, error)
+	UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error)
+	UpdateTaskListBuildIDCompatibility(context.Context, *types.UpdateTaskListBuildIDCompatibilityRequest, ...yarpc.CallOption) (*types.UpdateTaskListBuildIDCompatibilityResponse, error)
+	GetTaskListBuildIDCompatibility(context.Context, *types.GetTaskListBuildIDCompatibilityRequest, ...yarpc.CallOption) (*types.GetTaskListBuildIDCompatibilityResponse, error)
 	FailoverDomain(context.Context, *types.FailoverDomainRequest, ...yarpc.CallOption) (*types.FailoverDomainResponse, error)
 	ListFailoverHistory(context.Context, *types.ListFailoverHistoryRequest, ...yarpc.CallOption) (*types.ListFailoverHistoryResponse, error)
 
@@ -321,6 +321,26 @@ func (mr *MockClientMockRecorder) GetSearchAttributes(arg0 any, arg1 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockClient)(nil).GetSearchAttributes), varargs...)
 }
 
+// GetTaskListBuildIDCompatibility mocks base method.
+func (m *MockClient) GetTaskListBuildIDCompatibility(arg0 context.Context, arg1 *types.GetTaskListBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(*types.GetTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListBuildIDCompatibility indicates an expected call of GetTaskListBuildIDCompatibility.
+func (mr *MockClientMockRecorder) GetTaskListBuildIDCompatibility(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).GetTaskListBuildIDCompatibility), varargs...)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockClient) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -1088,6 +1108,26 @@ func (mr *MockClientMockRecorder) UnpauseSchedule(arg0, arg1 any, arg2 ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockClient)(nil).UnpauseSchedule), varargs...)
 }
 
+// UpdateActivityOptions mocks base method.
+func (m *MockClient) UpdateActivityOptions(arg0 context.Context, arg1 *types.UpdateActivityOptionsRequest, arg2 ...yarpc.CallOption) (*types.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", varargs...)
+	ret0, _ := ret[0].(*types.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockClientMockRecorder) UpdateActivityOptions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockClient)(nil).UpdateActivityOptions), varargs...)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockClient) UpdateDomain(arg0 context.Context, arg1 *types.UpdateDomainRequest, arg2 ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -1127,3 +1167,43 @@ func (mr *MockClientMockRecorder) UpdateSchedule(arg0, arg1 any, arg2 ...any) *g
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockClient)(nil).UpdateSchedule), varargs...)
 }
+
+// UpdateTaskListBuildIDCompatibility mocks base method.
+func (m *MockClient) UpdateTaskListBuildIDCompatibility(arg0 context.Context, arg1 *types.UpdateTaskListBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) (*types.UpdateTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(*types.UpdateTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListBuildIDCompatibility indicates an expected call of UpdateTaskListBuildIDCompatibility.
+func (mr *MockClientMockRecorder) UpdateTaskListBuildIDCompatibility(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).UpdateTaskListBuildIDCompatibility), varargs...)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockClient) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.UpdateWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockClientMockRecorder) UpdateWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowExecution), varargs...)
+}
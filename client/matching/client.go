@@ -387,3 +387,67 @@ func (c *clientImpl) RefreshTaskListPartitionConfig(
 	}
 	return resp, nil
 }
+
+func (c *clientImpl) ListTaskListTasks(
+	ctx context.Context,
+	request *types.MatchingListTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListTaskListTasksResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.Request.GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListTaskListTasks(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *clientImpl) DeleteTaskListTasks(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.DeleteTaskListTasksResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.Request.GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DeleteTaskListTasks(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *clientImpl) UpdateTaskListBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListBuildIDCompatibilityResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.Request.GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.UpdateTaskListBuildIDCompatibility(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *clientImpl) GetTaskListBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetTaskListBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListBuildIDCompatibilityResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.Request.GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetTaskListBuildIDCompatibility(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
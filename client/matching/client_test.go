@@ -568,6 +568,142 @@ func TestClient_withResponse(t *testing.T) {
 			want:      nil,
 			wantError: true,
 		},
+		{
+			name: "ListTaskListTasks",
+			op: func(c Client) (any, error) {
+				return c.ListTaskListTasks(context.Background(), testMatchingListTaskListTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().ListTaskListTasks(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.ListTaskListTasksResponse{}, nil)
+			},
+			want: &types.ListTaskListTasksResponse{},
+		},
+		{
+			name: "ListTaskListTasks - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.ListTaskListTasks(context.Background(), testMatchingListTaskListTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "ListTaskListTasks - Error while listing tasks",
+			op: func(c Client) (any, error) {
+				return c.ListTaskListTasks(context.Background(), testMatchingListTaskListTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().ListTaskListTasks(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "DeleteTaskListTasks",
+			op: func(c Client) (any, error) {
+				return c.DeleteTaskListTasks(context.Background(), testMatchingDeleteTaskListTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().DeleteTaskListTasks(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.DeleteTaskListTasksResponse{DeletedCount: 2}, nil)
+			},
+			want: &types.DeleteTaskListTasksResponse{DeletedCount: 2},
+		},
+		{
+			name: "DeleteTaskListTasks - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.DeleteTaskListTasks(context.Background(), testMatchingDeleteTaskListTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "DeleteTaskListTasks - Error while deleting tasks",
+			op: func(c Client) (any, error) {
+				return c.DeleteTaskListTasks(context.Background(), testMatchingDeleteTaskListTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().DeleteTaskListTasks(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "UpdateTaskListBuildIDCompatibility",
+			op: func(c Client) (any, error) {
+				return c.UpdateTaskListBuildIDCompatibility(context.Background(), testMatchingUpdateTaskListBuildIDCompatibilityRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.UpdateTaskListBuildIDCompatibilityResponse{}, nil)
+			},
+			want: &types.UpdateTaskListBuildIDCompatibilityResponse{},
+		},
+		{
+			name: "UpdateTaskListBuildIDCompatibility - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.UpdateTaskListBuildIDCompatibility(context.Background(), testMatchingUpdateTaskListBuildIDCompatibilityRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "UpdateTaskListBuildIDCompatibility - Error from matching",
+			op: func(c Client) (any, error) {
+				return c.UpdateTaskListBuildIDCompatibility(context.Background(), testMatchingUpdateTaskListBuildIDCompatibilityRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "GetTaskListBuildIDCompatibility",
+			op: func(c Client) (any, error) {
+				return c.GetTaskListBuildIDCompatibility(context.Background(), testMatchingGetTaskListBuildIDCompatibilityRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.GetTaskListBuildIDCompatibilityResponse{}, nil)
+			},
+			want: &types.GetTaskListBuildIDCompatibilityResponse{},
+		},
+		{
+			name: "GetTaskListBuildIDCompatibility - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.GetTaskListBuildIDCompatibility(context.Background(), testMatchingGetTaskListBuildIDCompatibilityRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "GetTaskListBuildIDCompatibility - Error from matching",
+			op: func(c Client) (any, error) {
+				return c.GetTaskListBuildIDCompatibility(context.Background(), testMatchingGetTaskListBuildIDCompatibilityRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -712,3 +848,46 @@ func testMatchingRefreshTaskListPartitionConfigRequest() *types.MatchingRefreshT
 		},
 	}
 }
+
+func testMatchingListTaskListTasksRequest() *types.MatchingListTaskListTasksRequest {
+	return &types.MatchingListTaskListTasksRequest{
+		DomainUUID: _testDomainUUID,
+		Request: &types.ListTaskListTasksRequest{
+			Domain:   _testDomain,
+			TaskList: &types.TaskList{Name: _testTaskList},
+			PageSize: 10,
+		},
+	}
+}
+
+func testMatchingDeleteTaskListTasksRequest() *types.MatchingDeleteTaskListTasksRequest {
+	return &types.MatchingDeleteTaskListTasksRequest{
+		DomainUUID: _testDomainUUID,
+		Request: &types.DeleteTaskListTasksRequest{
+			Domain:     _testDomain,
+			TaskList:   &types.TaskList{Name: _testTaskList},
+			WorkflowID: "wid",
+		},
+	}
+}
+
+func testMatchingUpdateTaskListBuildIDCompatibilityRequest() *types.MatchingUpdateTaskListBuildIDCompatibilityRequest {
+	return &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+		DomainUUID: _testDomainUUID,
+		Request: &types.UpdateTaskListBuildIDCompatibilityRequest{
+			Domain:   _testDomain,
+			TaskList: &types.TaskList{Name: _testTaskList},
+			BuildID:  "build-1",
+		},
+	}
+}
+
+func testMatchingGetTaskListBuildIDCompatibilityRequest() *types.MatchingGetTaskListBuildIDCompatibilityRequest {
+	return &types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: _testDomainUUID,
+		Request: &types.GetTaskListBuildIDCompatibilityRequest{
+			Domain:   _testDomain,
+			TaskList: &types.TaskList{Name: _testTaskList},
+		},
+	}
+}
@@ -50,4 +50,8 @@ type Client interface {
 	RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest, ...yarpc.CallOption) error
 	UpdateTaskListPartitionConfig(context.Context, *types.MatchingUpdateTaskListPartitionConfigRequest, ...yarpc.CallOption) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *types.MatchingRefreshTaskListPartitionConfigRequest, ...yarpc.CallOption) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+	ListTaskListTasks(context.Context, *types.MatchingListTaskListTasksRequest, ...yarpc.CallOption) (*types.ListTaskListTasksResponse, error)
+	DeleteTaskListTasks(context.Context, *types.MatchingDeleteTaskListTasksRequest, ...yarpc.CallOption) (*types.DeleteTaskListTasksResponse, error)
+	UpdateTaskListBuildIDCompatibility(context.Context, *types.MatchingUpdateTaskListBuildIDCompatibilityRequest, ...yarpc.CallOption) (*types.UpdateTaskListBuildIDCompatibilityResponse, error)
+	GetTaskListBuildIDCompatibility(context.Context, *types.MatchingGetTaskListBuildIDCompatibilityRequest, ...yarpc.CallOption) (*types.GetTaskListBuildIDCompatibilityResponse, error)
 }
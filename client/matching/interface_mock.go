@@ -102,6 +102,26 @@ func (mr *MockClientMockRecorder) CancelOutstandingPoll(arg0, arg1 any, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockClient)(nil).CancelOutstandingPoll), varargs...)
 }
 
+// DeleteTaskListTasks mocks base method.
+func (m *MockClient) DeleteTaskListTasks(arg0 context.Context, arg1 *types.MatchingDeleteTaskListTasksRequest, arg2 ...yarpc.CallOption) (*types.DeleteTaskListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskListTasks", varargs...)
+	ret0, _ := ret[0].(*types.DeleteTaskListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListTasks indicates an expected call of DeleteTaskListTasks.
+func (mr *MockClientMockRecorder) DeleteTaskListTasks(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListTasks", reflect.TypeOf((*MockClient)(nil).DeleteTaskListTasks), varargs...)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockClient) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +142,26 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// GetTaskListBuildIDCompatibility mocks base method.
+func (m *MockClient) GetTaskListBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingGetTaskListBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(*types.GetTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListBuildIDCompatibility indicates an expected call of GetTaskListBuildIDCompatibility.
+func (mr *MockClientMockRecorder) GetTaskListBuildIDCompatibility(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).GetTaskListBuildIDCompatibility), varargs...)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockClient) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +202,26 @@ func (mr *MockClientMockRecorder) ListTaskListPartitions(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockClient)(nil).ListTaskListPartitions), varargs...)
 }
 
+// ListTaskListTasks mocks base method.
+func (m *MockClient) ListTaskListTasks(arg0 context.Context, arg1 *types.MatchingListTaskListTasksRequest, arg2 ...yarpc.CallOption) (*types.ListTaskListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskListTasks", varargs...)
+	ret0, _ := ret[0].(*types.ListTaskListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskListTasks indicates an expected call of ListTaskListTasks.
+func (mr *MockClientMockRecorder) ListTaskListTasks(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListTasks", reflect.TypeOf((*MockClient)(nil).ListTaskListTasks), varargs...)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockClient) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest, arg2 ...yarpc.CallOption) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +321,26 @@ func (mr *MockClientMockRecorder) RespondQueryTaskCompleted(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockClient)(nil).RespondQueryTaskCompleted), varargs...)
 }
 
+// UpdateTaskListBuildIDCompatibility mocks base method.
+func (m *MockClient) UpdateTaskListBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingUpdateTaskListBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) (*types.UpdateTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(*types.UpdateTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListBuildIDCompatibility indicates an expected call of UpdateTaskListBuildIDCompatibility.
+func (mr *MockClientMockRecorder) UpdateTaskListBuildIDCompatibility(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).UpdateTaskListBuildIDCompatibility), varargs...)
+}
+
 // UpdateTaskListPartitionConfig mocks base method.
 func (m *MockClient) UpdateTaskListPartitionConfig(arg0 context.Context, arg1 *types.MatchingUpdateTaskListPartitionConfigRequest, arg2 ...yarpc.CallOption) (*types.MatchingUpdateTaskListPartitionConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -40,6 +40,12 @@ import (
 type Client interface {
 	GetShardOwner(context.Context, *types.GetShardOwnerRequest, ...yarpc.CallOption) (*types.GetShardOwnerResponse, error)
 	WatchNamespaceState(context.Context, *types.WatchNamespaceStateRequest, ...yarpc.CallOption) (WatchNamespaceStateClient, error)
+	CordonExecutor(context.Context, *types.CordonExecutorRequest, ...yarpc.CallOption) error
+	UncordonExecutor(context.Context, *types.UncordonExecutorRequest, ...yarpc.CallOption) error
+	DrainExecutor(context.Context, *types.DrainExecutorRequest, ...yarpc.CallOption) error
+	PinShard(context.Context, *types.PinShardRequest, ...yarpc.CallOption) error
+	UnpinShard(context.Context, *types.UnpinShardRequest, ...yarpc.CallOption) error
+	DescribeNamespaceControls(context.Context, *types.DescribeNamespaceControlsRequest, ...yarpc.CallOption) (*types.DescribeNamespaceControlsResponse, error)
 }
 
 type WatchNamespaceStateClient interface {
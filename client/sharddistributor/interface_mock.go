@@ -43,6 +43,64 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CordonExecutor mocks base method.
+func (m *MockClient) CordonExecutor(arg0 context.Context, arg1 *types.CordonExecutorRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CordonExecutor", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CordonExecutor indicates an expected call of CordonExecutor.
+func (mr *MockClientMockRecorder) CordonExecutor(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CordonExecutor", reflect.TypeOf((*MockClient)(nil).CordonExecutor), varargs...)
+}
+
+// DescribeNamespaceControls mocks base method.
+func (m *MockClient) DescribeNamespaceControls(arg0 context.Context, arg1 *types.DescribeNamespaceControlsRequest, arg2 ...yarpc.CallOption) (*types.DescribeNamespaceControlsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNamespaceControls", varargs...)
+	ret0, _ := ret[0].(*types.DescribeNamespaceControlsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceControls indicates an expected call of DescribeNamespaceControls.
+func (mr *MockClientMockRecorder) DescribeNamespaceControls(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceControls", reflect.TypeOf((*MockClient)(nil).DescribeNamespaceControls), varargs...)
+}
+
+// DrainExecutor mocks base method.
+func (m *MockClient) DrainExecutor(arg0 context.Context, arg1 *types.DrainExecutorRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DrainExecutor", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DrainExecutor indicates an expected call of DrainExecutor.
+func (mr *MockClientMockRecorder) DrainExecutor(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainExecutor", reflect.TypeOf((*MockClient)(nil).DrainExecutor), varargs...)
+}
+
 // GetShardOwner mocks base method.
 func (m *MockClient) GetShardOwner(arg0 context.Context, arg1 *types.GetShardOwnerRequest, arg2 ...yarpc.CallOption) (*types.GetShardOwnerResponse, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +121,63 @@ func (mr *MockClientMockRecorder) GetShardOwner(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardOwner", reflect.TypeOf((*MockClient)(nil).GetShardOwner), varargs...)
 }
 
+// PinShard mocks base method.
+func (m *MockClient) PinShard(arg0 context.Context, arg1 *types.PinShardRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PinShard", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinShard indicates an expected call of PinShard.
+func (mr *MockClientMockRecorder) PinShard(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinShard", reflect.TypeOf((*MockClient)(nil).PinShard), varargs...)
+}
+
+// UncordonExecutor mocks base method.
+func (m *MockClient) UncordonExecutor(arg0 context.Context, arg1 *types.UncordonExecutorRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UncordonExecutor", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UncordonExecutor indicates an expected call of UncordonExecutor.
+func (mr *MockClientMockRecorder) UncordonExecutor(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UncordonExecutor", reflect.TypeOf((*MockClient)(nil).UncordonExecutor), varargs...)
+}
+
+// UnpinShard mocks base method.
+func (m *MockClient) UnpinShard(arg0 context.Context, arg1 *types.UnpinShardRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpinShard", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinShard indicates an expected call of UnpinShard.
+func (mr *MockClientMockRecorder) UnpinShard(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinShard", reflect.TypeOf((*MockClient)(nil).UnpinShard), varargs...)
+}
+
 // WatchNamespaceState mocks base method.
 func (m *MockClient) WatchNamespaceState(arg0 context.Context, arg1 *types.WatchNamespaceStateRequest, arg2 ...yarpc.CallOption) (WatchNamespaceStateClient, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,10 @@ func (g adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQMe
 	return proto.ToAdminCountDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DeleteTaskListTasks(ctx context.Context, request *types.DeleteTaskListTasksRequest, opts ...yarpc.CallOption) (dp1 *types.DeleteTaskListTasksResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.c.DeleteWorkflow(ctx, proto.FromAdminDeleteWorkflowRequest(ap1), p1...)
 	return proto.ToAdminDeleteWorkflowResponse(response), proto.ToError(err)
@@ -104,6 +108,10 @@ func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynam
 	return proto.ToAdminListDynamicConfigResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) ListTaskListTasks(ctx context.Context, request *types.ListTaskListTasksRequest, opts ...yarpc.CallOption) (lp1 *types.ListTaskListTasksResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	response, err := g.c.MaintainCorruptWorkflow(ctx, proto.FromAdminMaintainCorruptWorkflowRequest(ap1), p1...)
 	return proto.ToAdminMaintainCorruptWorkflowResponse(response), proto.ToError(err)
@@ -114,6 +122,10 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return proto.ToAdminMergeDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseActivityResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.PurgeDLQMessages(ctx, proto.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return proto.ToError(err)
@@ -154,6 +166,10 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return proto.ToError(err)
 }
 
+func (g adminClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseActivityResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.ToError(err)
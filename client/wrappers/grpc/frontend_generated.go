@@ -84,6 +84,10 @@ func (g frontendClient) GetSearchAttributes(ctx context.Context, p1 ...yarpc.Cal
 	return proto.ToGetSearchAttributesResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) GetTaskListBuildIDCompatibility(ctx context.Context, gp1 *types.GetTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, proto.FromGetTaskListsByDomainRequest(gp1), p1...)
 	return proto.ToGetTaskListsByDomainResponse(response), proto.ToError(err)
@@ -279,6 +283,10 @@ func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseS
 	return proto.ToUnpauseScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, proto.FromUpdateDomainRequest(up1), p1...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
@@ -288,3 +296,11 @@ func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateSch
 	response, err := g.c.UpdateSchedule(ctx, proto.FromUpdateScheduleRequest(up1), p1...)
 	return proto.ToUpdateScheduleResponse(response), proto.ToError(err)
 }
+
+func (g frontendClient) UpdateTaskListBuildIDCompatibility(ctx context.Context, up1 *types.UpdateTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
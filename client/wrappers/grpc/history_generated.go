@@ -78,6 +78,11 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return proto.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseActivityResponse, err error) {
+	response, err := g.c.PauseActivity(ctx, proto.FromHistoryPauseActivityRequest(hp1), p1...)
+	return proto.ToHistoryPauseActivityResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, proto.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return proto.ToHistoryPollMutableStateResponse(response), proto.ToError(err)
@@ -232,3 +237,18 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (up1 *types.UnpauseActivityResponse, err error) {
+	response, err := g.c.UnpauseActivity(ctx, proto.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return proto.ToHistoryUnpauseActivityResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.c.UpdateActivityOptions(ctx, proto.FromHistoryUpdateActivityOptionsRequest(hp1), p1...)
+	return proto.ToHistoryUpdateActivityOptionsResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, proto.FromHistoryUpdateWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToHistoryUpdateWorkflowExecutionResponse(response), proto.ToError(err)
+}
@@ -28,11 +28,21 @@ func (g matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.Ca
 	return proto.ToError(err)
 }
 
+func (g matchingClient) DeleteTaskListTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListTasksRequest, p1 ...yarpc.CallOption) (dp1 *types.DeleteTaskListTasksResponse, err error) {
+	response, err := g.c.DeleteTaskListTasks(ctx, proto.FromMatchingDeleteTaskListTasksRequest(mp1), p1...)
+	return proto.ToMatchingDeleteTaskListTasksResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromMatchingDescribeTaskListRequest(mp1), p1...)
 	return proto.ToMatchingDescribeTaskListResponse(response), proto.ToError(err)
 }
 
+func (g matchingClient) GetTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingGetTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.c.GetTaskListBuildIDCompatibility(ctx, proto.FromMatchingGetTaskListBuildIDCompatibilityRequest(mp1), p1...)
+	return proto.ToMatchingGetTaskListBuildIDCompatibilityResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, proto.FromMatchingGetTaskListsByDomainRequest(gp1), p1...)
 	return proto.ToMatchingGetTaskListsByDomainResponse(response), proto.ToError(err)
@@ -43,6 +53,11 @@ func (g matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.M
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
 }
 
+func (g matchingClient) ListTaskListTasks(ctx context.Context, mp1 *types.MatchingListTaskListTasksRequest, p1 ...yarpc.CallOption) (lp1 *types.ListTaskListTasksResponse, err error) {
+	response, err := g.c.ListTaskListTasks(ctx, proto.FromMatchingListTaskListTasksRequest(mp1), p1...)
+	return proto.ToMatchingListTaskListTasksResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromMatchingPollForActivityTaskRequest(mp1), p1...)
 	return proto.ToMatchingPollForActivityTaskResponse(response), proto.ToError(err)
@@ -68,6 +83,11 @@ func (g matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *type
 	return proto.ToError(err)
 }
 
+func (g matchingClient) UpdateTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingUpdateTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.c.UpdateTaskListBuildIDCompatibility(ctx, proto.FromMatchingUpdateTaskListBuildIDCompatibilityRequest(mp1), p1...)
+	return proto.ToMatchingUpdateTaskListBuildIDCompatibilityResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListPartitionConfigResponse, err error) {
 	response, err := g.c.UpdateTaskListPartitionConfig(ctx, proto.FromMatchingUpdateTaskListPartitionConfigRequest(mp1), p1...)
 	return proto.ToMatchingUpdateTaskListPartitionConfigResponse(response), proto.ToError(err)
@@ -15,11 +15,41 @@ import (
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+func (g sharddistributorClient) CordonExecutor(ctx context.Context, cp1 *types.CordonExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.CordonExecutor(ctx, proto.FromShardDistributorCordonExecutorRequest(cp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g sharddistributorClient) DescribeNamespaceControls(ctx context.Context, dp1 *types.DescribeNamespaceControlsRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeNamespaceControlsResponse, err error) {
+	response, err := g.c.DescribeNamespaceControls(ctx, proto.FromShardDistributorDescribeNamespaceControlsRequest(dp1), p1...)
+	return proto.ToShardDistributorDescribeNamespaceControlsResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) DrainExecutor(ctx context.Context, dp1 *types.DrainExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.DrainExecutor(ctx, proto.FromShardDistributorDrainExecutorRequest(dp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	response, err := g.c.GetShardOwner(ctx, proto.FromShardDistributorGetShardOwnerRequest(gp1), p1...)
 	return proto.ToShardDistributorGetShardOwnerResponse(response), proto.ToError(err)
 }
 
+func (g sharddistributorClient) PinShard(ctx context.Context, pp1 *types.PinShardRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PinShard(ctx, proto.FromShardDistributorPinShardRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g sharddistributorClient) UncordonExecutor(ctx context.Context, up1 *types.UncordonExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UncordonExecutor(ctx, proto.FromShardDistributorUncordonExecutorRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
+func (g sharddistributorClient) UnpinShard(ctx context.Context, up1 *types.UnpinShardRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpinShard(ctx, proto.FromShardDistributorUnpinShardRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
 func (g sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (w1 sharddistributor.WatchNamespaceStateClient, err error) {
 	stream, err := g.c.WatchNamespaceState(ctx, proto.FromShardDistributorWatchNamespaceStateRequest(wp1), p1...)
 	if err != nil {
@@ -99,6 +99,29 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return err
 }
 
+func (c *matchingClient) DeleteTaskListTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListTasksRequest, p1 ...yarpc.CallOption) (dp1 *types.DeleteTaskListTasksResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDeleteTaskListTasksScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDeleteTaskListTasksScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp1, err = c.client.DeleteTaskListTasks(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp1, err
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -122,6 +145,29 @@ func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.Matchi
 	return dp1, err
 }
 
+func (c *matchingClient) GetTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingGetTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientGetTaskListBuildIDCompatibilityScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientGetTaskListBuildIDCompatibilityScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp1, err = c.client.GetTaskListBuildIDCompatibility(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
 func (c *matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -168,6 +214,29 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return lp1, err
 }
 
+func (c *matchingClient) ListTaskListTasks(ctx context.Context, mp1 *types.MatchingListTaskListTasksRequest, p1 ...yarpc.CallOption) (lp1 *types.ListTaskListTasksResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientListTaskListTasksScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientListTaskListTasksScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	lp1, err = c.client.ListTaskListTasks(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return lp1, err
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -283,6 +352,29 @@ func (c *matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *typ
 	return err
 }
 
+func (c *matchingClient) UpdateTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingUpdateTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientUpdateTaskListBuildIDCompatibilityScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientUpdateTaskListBuildIDCompatibilityScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	up1, err = c.client.UpdateTaskListBuildIDCompatibility(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return up1, err
+}
+
 func (c *matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListPartitionConfigResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -177,6 +177,17 @@ func (c *frontendClient) GetSearchAttributes(ctx context.Context, p1 ...yarpc.Ca
 	return resp, err
 }
 
+func (c *frontendClient) GetTaskListBuildIDCompatibility(ctx context.Context, gp1 *types.GetTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	var resp *types.GetTaskListBuildIDCompatibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskListBuildIDCompatibility(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	var resp *types.GetTaskListsByDomainResponse
 	op := func(ctx context.Context) error {
@@ -554,6 +565,17 @@ func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.Unpause
 	return resp, err
 }
 
+func (c *frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	var resp *types.UpdateActivityOptionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	var resp *types.UpdateDomainResponse
 	op := func(ctx context.Context) error {
@@ -575,3 +597,25 @@ func (c *frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateSc
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *frontendClient) UpdateTaskListBuildIDCompatibility(ctx context.Context, up1 *types.UpdateTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	var resp *types.UpdateTaskListBuildIDCompatibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskListBuildIDCompatibility(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	var resp *types.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
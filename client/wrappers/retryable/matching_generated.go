@@ -60,6 +60,17 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *matchingClient) DeleteTaskListTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListTasksRequest, p1 ...yarpc.CallOption) (dp1 *types.DeleteTaskListTasksResponse, err error) {
+	var resp *types.DeleteTaskListTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteTaskListTasks(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	var resp *types.DescribeTaskListResponse
 	op := func(ctx context.Context) error {
@@ -71,6 +82,17 @@ func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.Matchi
 	return resp, err
 }
 
+func (c *matchingClient) GetTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingGetTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	var resp *types.GetTaskListBuildIDCompatibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskListBuildIDCompatibility(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	var resp *types.GetTaskListsByDomainResponse
 	op := func(ctx context.Context) error {
@@ -93,6 +115,17 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return resp, err
 }
 
+func (c *matchingClient) ListTaskListTasks(ctx context.Context, mp1 *types.MatchingListTaskListTasksRequest, p1 ...yarpc.CallOption) (lp1 *types.ListTaskListTasksResponse, err error) {
+	var resp *types.ListTaskListTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskListTasks(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	var resp *types.MatchingPollForActivityTaskResponse
 	op := func(ctx context.Context) error {
@@ -144,6 +177,17 @@ func (c *matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *typ
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *matchingClient) UpdateTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingUpdateTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	var resp *types.UpdateTaskListBuildIDCompatibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskListBuildIDCompatibility(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListPartitionConfigResponse, err error) {
 	var resp *types.MatchingUpdateTaskListPartitionConfigResponse
 	op := func(ctx context.Context) error {
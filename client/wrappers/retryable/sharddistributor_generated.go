@@ -31,6 +31,31 @@ func NewShardDistributorClient(client sharddistributor.Client, policy backoff.Re
 	}
 }
 
+func (c *sharddistributorClient) CordonExecutor(ctx context.Context, cp1 *types.CordonExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.CordonExecutor(ctx, cp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *sharddistributorClient) DescribeNamespaceControls(ctx context.Context, dp1 *types.DescribeNamespaceControlsRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeNamespaceControlsResponse, err error) {
+	var resp *types.DescribeNamespaceControlsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNamespaceControls(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) DrainExecutor(ctx context.Context, dp1 *types.DrainExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.DrainExecutor(ctx, dp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	var resp *types.GetShardOwnerResponse
 	op := func(ctx context.Context) error {
@@ -42,6 +67,27 @@ func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.G
 	return resp, err
 }
 
+func (c *sharddistributorClient) PinShard(ctx context.Context, pp1 *types.PinShardRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.PinShard(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *sharddistributorClient) UncordonExecutor(ctx context.Context, up1 *types.UncordonExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UncordonExecutor(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *sharddistributorClient) UnpinShard(ctx context.Context, up1 *types.UnpinShardRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UnpinShard(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (w1 sharddistributor.WatchNamespaceStateClient, err error) {
 	var resp sharddistributor.WatchNamespaceStateClient
 	op := func(ctx context.Context) error {
@@ -27,6 +27,11 @@ func (g adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQMe
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g adminClient) DeleteTaskListTasks(ctx context.Context, request *types.DeleteTaskListTasksRequest, opts ...yarpc.CallOption) (dp1 *types.DeleteTaskListTasksResponse, err error) {
+	response, err := g.c.DeleteTaskListTasks(ctx, thrift.FromAdminDeleteTaskListTasksRequest(request), opts...)
+	return thrift.ToAdminDeleteTaskListTasksResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.c.DeleteWorkflow(ctx, thrift.FromAdminDeleteWorkflowRequest(ap1), p1...)
 	return thrift.ToAdminDeleteWorkflowResponse(response), thrift.ToError(err)
@@ -102,6 +107,11 @@ func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynam
 	return thrift.ToAdminListDynamicConfigResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) ListTaskListTasks(ctx context.Context, request *types.ListTaskListTasksRequest, opts ...yarpc.CallOption) (lp1 *types.ListTaskListTasksResponse, err error) {
+	response, err := g.c.ListTaskListTasks(ctx, thrift.FromAdminListTaskListTasksRequest(request), opts...)
+	return thrift.ToAdminListTaskListTasksResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	response, err := g.c.MaintainCorruptWorkflow(ctx, thrift.FromAdminMaintainCorruptWorkflowRequest(ap1), p1...)
 	return thrift.ToAdminMaintainCorruptWorkflowResponse(response), thrift.ToError(err)
@@ -112,6 +122,11 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return thrift.ToAdminMergeDLQMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseActivityResponse, err error) {
+	response, err := g.c.PauseActivity(ctx, thrift.FromAdminPauseActivityRequest(pp1), p1...)
+	return thrift.ToAdminPauseActivityResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.PurgeDLQMessages(ctx, thrift.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return thrift.ToError(err)
@@ -152,6 +167,11 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return thrift.ToError(err)
 }
 
+func (g adminClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseActivityResponse, err error) {
+	response, err := g.c.UnpauseActivity(ctx, thrift.FromAdminUnpauseActivityRequest(up1), p1...)
+	return thrift.ToAdminUnpauseActivityResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.ToError(err)
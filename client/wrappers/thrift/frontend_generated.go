@@ -79,6 +79,11 @@ func (g frontendClient) GetSearchAttributes(ctx context.Context, p1 ...yarpc.Cal
 	return thrift.ToGetSearchAttributesResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) GetTaskListBuildIDCompatibility(ctx context.Context, gp1 *types.GetTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.c.GetTaskListBuildIDCompatibility(ctx, thrift.FromGetTaskListBuildIDCompatibilityRequest(gp1), p1...)
+	return thrift.ToGetTaskListBuildIDCompatibilityResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, thrift.FromGetTaskListsByDomainRequest(gp1), p1...)
 	return thrift.ToGetTaskListsByDomainResponse(response), thrift.ToError(err)
@@ -271,6 +276,11 @@ func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseS
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.c.UpdateActivityOptions(ctx, thrift.FromUpdateActivityOptionsRequest(up1), p1...)
+	return thrift.ToUpdateActivityOptionsResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(up1), p1...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
@@ -279,3 +289,13 @@ func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomai
 func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateScheduleResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
+
+func (g frontendClient) UpdateTaskListBuildIDCompatibility(ctx context.Context, up1 *types.UpdateTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.c.UpdateTaskListBuildIDCompatibility(ctx, thrift.FromUpdateTaskListBuildIDCompatibilityRequest(up1), p1...)
+	return thrift.ToUpdateTaskListBuildIDCompatibilityResponse(response), thrift.ToError(err)
+}
+
+func (g frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, thrift.FromUpdateWorkflowExecutionRequest(up1), p1...)
+	return thrift.ToUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
@@ -77,6 +77,11 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return thrift.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseActivityResponse, err error) {
+	response, err := g.c.PauseActivity(ctx, thrift.FromHistoryPauseActivityRequest(hp1), p1...)
+	return thrift.ToHistoryPauseActivityResponse(response), thrift.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, thrift.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return thrift.ToHistoryPollMutableStateResponse(response), thrift.ToError(err)
@@ -231,3 +236,18 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	err = g.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (up1 *types.UnpauseActivityResponse, err error) {
+	response, err := g.c.UnpauseActivity(ctx, thrift.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return thrift.ToHistoryUnpauseActivityResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.c.UpdateActivityOptions(ctx, thrift.FromHistoryUpdateActivityOptionsRequest(hp1), p1...)
+	return thrift.ToHistoryUpdateActivityOptionsResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, thrift.FromHistoryUpdateWorkflowExecutionRequest(hp1), p1...)
+	return thrift.ToHistoryUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
@@ -34,11 +34,21 @@ func (g matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.Ca
 	return thrift.ToError(err)
 }
 
+func (g matchingClient) DeleteTaskListTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListTasksRequest, p1 ...yarpc.CallOption) (dp1 *types.DeleteTaskListTasksResponse, err error) {
+	response, err := g.c.DeleteTaskListTasks(ctx, thrift.FromMatchingDeleteTaskListTasksRequest(mp1), p1...)
+	return thrift.ToMatchingDeleteTaskListTasksResponse(response), thrift.ToError(err)
+}
+
 func (g matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, thrift.FromMatchingDescribeTaskListRequest(mp1), p1...)
 	return thrift.ToMatchingDescribeTaskListResponse(response), thrift.ToError(err)
 }
 
+func (g matchingClient) GetTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingGetTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.c.GetTaskListBuildIDCompatibility(ctx, thrift.FromMatchingGetTaskListBuildIDCompatibilityRequest(mp1), p1...)
+	return thrift.ToMatchingGetTaskListBuildIDCompatibilityResponse(response), thrift.ToError(err)
+}
+
 func (g matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, thrift.FromMatchingGetTaskListsByDomainRequest(gp1), p1...)
 	return thrift.ToMatchingGetTaskListsByDomainResponse(response), thrift.ToError(err)
@@ -49,6 +59,11 @@ func (g matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.M
 	return thrift.ToMatchingListTaskListPartitionsResponse(response), thrift.ToError(err)
 }
 
+func (g matchingClient) ListTaskListTasks(ctx context.Context, mp1 *types.MatchingListTaskListTasksRequest, p1 ...yarpc.CallOption) (lp1 *types.ListTaskListTasksResponse, err error) {
+	response, err := g.c.ListTaskListTasks(ctx, thrift.FromMatchingListTaskListTasksRequest(mp1), p1...)
+	return thrift.ToMatchingListTaskListTasksResponse(response), thrift.ToError(err)
+}
+
 func (g matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, thrift.FromMatchingPollForActivityTaskRequest(mp1), p1...)
 	return thrift.ToMatchingPollForActivityTaskResponse(response), thrift.ToError(err)
@@ -73,6 +88,11 @@ func (g matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *type
 	return thrift.ToError(err)
 }
 
+func (g matchingClient) UpdateTaskListBuildIDCompatibility(ctx context.Context, mp1 *types.MatchingUpdateTaskListBuildIDCompatibilityRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.c.UpdateTaskListBuildIDCompatibility(ctx, thrift.FromMatchingUpdateTaskListBuildIDCompatibilityRequest(mp1), p1...)
+	return thrift.ToMatchingUpdateTaskListBuildIDCompatibilityResponse(response), thrift.ToError(err)
+}
+
 func (g matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListPartitionConfigResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
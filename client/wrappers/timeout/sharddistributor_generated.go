@@ -33,12 +33,48 @@ func NewShardDistributorClient(
 	}
 }
 
+func (c *sharddistributorClient) CordonExecutor(ctx context.Context, cp1 *types.CordonExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.CordonExecutor(ctx, cp1, p1...)
+}
+
+func (c *sharddistributorClient) DescribeNamespaceControls(ctx context.Context, dp1 *types.DescribeNamespaceControlsRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeNamespaceControlsResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.DescribeNamespaceControls(ctx, dp1, p1...)
+}
+
+func (c *sharddistributorClient) DrainExecutor(ctx context.Context, dp1 *types.DrainExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.DrainExecutor(ctx, dp1, p1...)
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
 	return c.client.GetShardOwner(ctx, gp1, p1...)
 }
 
+func (c *sharddistributorClient) PinShard(ctx context.Context, pp1 *types.PinShardRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.PinShard(ctx, pp1, p1...)
+}
+
+func (c *sharddistributorClient) UncordonExecutor(ctx context.Context, up1 *types.UncordonExecutorRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.UncordonExecutor(ctx, up1, p1...)
+}
+
+func (c *sharddistributorClient) UnpinShard(ctx context.Context, up1 *types.UnpinShardRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.UnpinShard(ctx, up1, p1...)
+}
+
 func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (w1 sharddistributor.WatchNamespaceStateClient, err error) {
 	return c.client.WatchNamespaceState(ctx, wp1, p1...)
 }
@@ -43,9 +43,10 @@ import (
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"github.com/uber/cadence/common/rpc/rpcfx"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/tracing/tracingfx"
 	shardDistributorCfg "github.com/uber/cadence/service/sharddistributor/config"
 	"github.com/uber/cadence/service/sharddistributor/sharddistributorfx"
-	"github.com/uber/cadence/service/sharddistributor/store/etcd"
+	"github.com/uber/cadence/service/sharddistributor/store/storefx"
 	"github.com/uber/cadence/tools/cassandra"
 	"github.com/uber/cadence/tools/sql"
 )
@@ -55,7 +56,8 @@ var _commonModule = fx.Options(
 	dynamicconfigfx.Module,
 	logfx.Module,
 	metricsfx.Module,
-	clockfx.Module)
+	clockfx.Module,
+	tracingfx.Module)
 
 // Module provides a cadence server initialization with root components.
 // AppParams allows to provide optional/overrides for implementation specific dependencies.
@@ -74,7 +76,7 @@ func Module(serviceName string) fx.Option {
 				return z.With(zap.String("service", service.ShardDistributor)), l.WithTags(tag.Service(service.ShardDistributor))
 			}),
 
-			etcd.Module,
+			storefx.Module,
 
 			rpcfx.Module,
 			sharddistributorfx.Module)
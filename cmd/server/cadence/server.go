@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/asyncworkflow/queue"
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/s3store"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
@@ -283,11 +284,20 @@ func (s *server) startService() common.Daemon {
 	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider)
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicproperties.TransactionSizeLimit)
 	params.PersistenceConfig.ErrorInjectionRate = dc.GetFloat64Property(dynamicproperties.PersistenceErrorInjectionRate)
+	params.PersistenceConfig.FaultInjectionRules = dc.GetListProperty(dynamicproperties.PersistenceFaultInjectionRules)
+	params.PersistenceConfig.HistoryBranchCacheMaxSize = dc.GetIntProperty(dynamicproperties.HistoryBranchCacheMaxSize)
 	params.AuthorizationConfig = s.cfg.Authorization
-	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
-	if err != nil {
-		s.logger.Warn("failed to create file blobstore client, will continue startup without it: %v", tag.Error(err))
-		params.BlobstoreClient = nil
+	if s.cfg.Blobstore.S3 != nil {
+		params.BlobstoreClient, err = s3store.NewS3Client(s.cfg.Blobstore.S3)
+		if err != nil {
+			s.logger.Fatal("failed to create s3 blobstore client", tag.Error(err))
+		}
+	} else {
+		params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
+		if err != nil {
+			s.logger.Warn("failed to create file blobstore client, will continue startup without it: %v", tag.Error(err))
+			params.BlobstoreClient = nil
+		}
 	}
 
 	params.AsyncWorkflowQueueProvider, err = queue.NewAsyncQueueProvider(s.cfg.AsyncWorkflowQueues)
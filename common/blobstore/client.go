@@ -33,6 +33,7 @@ type (
 		Get(context.Context, *GetRequest) (*GetResponse, error)
 		Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
 		Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+		List(context.Context, *ListRequest) (*ListResponse, error)
 		IsRetryableError(error) bool
 	}
 
@@ -73,6 +74,20 @@ type (
 	// DeleteResponse is the response from Delete
 	DeleteResponse struct{}
 
+	// ListRequest is the request to List
+	ListRequest struct {
+		// Prefix restricts the listed keys to the ones starting with it
+		Prefix        string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListResponse is the response from List, keys are listed in lexicographical order
+	ListResponse struct {
+		Keys          []string
+		NextPageToken []byte
+	}
+
 	// Blob defines a blob which can be stored and fetched from blobstore
 	Blob struct {
 		Tags map[string]string
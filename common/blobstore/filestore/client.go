@@ -28,9 +28,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/util"
 )
 
@@ -88,6 +90,9 @@ func (c *client) Put(_ context.Context, request *blobstore.PutRequest) (resp *bl
 func (c *client) Get(_ context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
 	data, err := util.ReadFile(c.bodyPath(request.Key))
 	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("blob %v does not exist", request.Key)}
+		}
 		return nil, err
 	}
 	tagsData, err := util.ReadFile(c.tagsPath(request.Key))
@@ -128,6 +133,29 @@ func (c *client) Delete(_ context.Context, request *blobstore.DeleteRequest) (*b
 	return &blobstore.DeleteResponse{}, nil
 }
 
+// List lists the keys of the blobs starting with a prefix, the page token is the last key of the previous page
+func (c *client) List(_ context.Context, request *blobstore.ListRequest) (*blobstore.ListResponse, error) {
+	entries, err := os.ReadDir(c.outputDirectory)
+	if err != nil {
+		return nil, err
+	}
+	lastKey := string(request.NextPageToken)
+	response := &blobstore.ListResponse{}
+	// entries are sorted by file name, tags files are hidden
+	for _, entry := range entries {
+		key := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(key, ".") || !strings.HasPrefix(key, request.Prefix) || key <= lastKey {
+			continue
+		}
+		if request.PageSize > 0 && len(response.Keys) == request.PageSize {
+			response.NextPageToken = []byte(response.Keys[len(response.Keys)-1])
+			break
+		}
+		response.Keys = append(response.Keys, key)
+	}
+	return response, nil
+}
+
 // IsRetryableError returns true if the error is retryable false otherwise
 func (c *client) IsRetryableError(err error) bool {
 	return false
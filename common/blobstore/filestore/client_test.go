@@ -33,6 +33,7 @@ import (
 
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/util"
 )
 
@@ -148,6 +149,35 @@ func (s *ClientSuite) TestCrudOperations() {
 	s.NoError(err)
 	s.False(exists1.Exists)
 	get1, err = c.Get(ctx, &blobstore.GetRequest{Key: key1})
-	s.Error(err)
+	s.IsType(&types.EntityNotExistsError{}, err)
 	s.Nil(get1)
 }
+
+func (s *ClientSuite) TestList() {
+	name := s.T().TempDir()
+	c, err := NewFilestoreClient(&config.FileBlobstore{OutputDirectory: name})
+	s.NoError(err)
+	ctx := context.Background()
+
+	for _, key := range []string{"b-2", "a-1", "b-1", "b-3"} {
+		_, err = c.Put(ctx, &blobstore.PutRequest{
+			Key:  key,
+			Blob: blobstore.Blob{Tags: map[string]string{"key": key}, Body: []byte(key)},
+		})
+		s.NoError(err)
+	}
+
+	all, err := c.List(ctx, &blobstore.ListRequest{})
+	s.NoError(err)
+	s.Equal([]string{"a-1", "b-1", "b-2", "b-3"}, all.Keys)
+	s.Empty(all.NextPageToken)
+
+	page1, err := c.List(ctx, &blobstore.ListRequest{Prefix: "b-", PageSize: 2})
+	s.NoError(err)
+	s.Equal([]string{"b-1", "b-2"}, page1.Keys)
+	s.NotEmpty(page1.NextPageToken)
+	page2, err := c.List(ctx, &blobstore.ListRequest{Prefix: "b-", PageSize: 2, NextPageToken: page1.NextPageToken})
+	s.NoError(err)
+	s.Equal([]string{"b-3"}, page2.Keys)
+	s.Empty(page2.NextPageToken)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
+)
+
+// tagsMetadataKey is the object metadata entry holding the tags of a blob.
+// Tags are stored JSON and base64 encoded in a single entry, as metadata keys are
+// case insensitive and metadata values are restricted to ASCII.
+const tagsMetadataKey = "Cadence-Tags"
+
+var (
+	// retryableCodes are the S3 error codes of transient failures not known as such by the SDK
+	retryableCodes = map[string]struct{}{
+		"SlowDown":           {},
+		"InternalError":      {},
+		"ServiceUnavailable": {},
+	}
+
+	errNoBucketSpecified = errors.New("no bucket specified for s3 blobstore")
+	errEmptyAwsRegion    = errors.New("empty aws region for s3 blobstore")
+)
+
+type (
+	client struct {
+		s3cli    s3iface.S3API
+		uploader *s3manager.Uploader
+		bucket   string
+		prefix   string
+	}
+)
+
+// NewS3Client constructs a blobstore backed by S3 compatible object storage
+func NewS3Client(cfg *config.S3Blobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("s3 blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errNoBucketSpecified
+	}
+	if len(cfg.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	if cfg.PartSize != 0 && cfg.PartSize < s3manager.MinUploadPartSize {
+		return nil, fmt.Errorf("s3 blobstore part size must be at least %v bytes, got %v", s3manager.MinUploadPartSize, cfg.PartSize)
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClient(s3.New(sess), cfg), nil
+}
+
+func newClient(s3cli s3iface.S3API, cfg *config.S3Blobstore) *client {
+	return &client{
+		s3cli: s3cli,
+		uploader: s3manager.NewUploaderWithClient(s3cli, func(u *s3manager.Uploader) {
+			if cfg.PartSize > 0 {
+				u.PartSize = cfg.PartSize
+			}
+			if cfg.UploadConcurrency > 0 {
+				u.Concurrency = cfg.UploadConcurrency
+			}
+		}),
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}
+}
+
+// Put stores a blob, blobs larger than the part size are uploaded in multiple parts
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	tags, err := encodeTags(request.Blob.Tags)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:   aws.String(c.bucket),
+		Key:      aws.String(c.objectKey(request.Key)),
+		Body:     bytes.NewReader(request.Blob.Body),
+		Metadata: map[string]*string{tagsMetadataKey: aws.String(tags)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	result, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("blob %v does not exist", request.Key)}
+		}
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	body, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := decodeTags(result.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Tags: tags,
+			Body: body,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return &blobstore.ExistsResponse{Exists: false}, nil
+		}
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{Exists: true}, nil
+}
+
+// Delete deletes a blob, deleting a blob which does not exist is not an error
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	_, err := c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// List lists the keys of the blobs starting with a prefix
+func (c *client) List(ctx context.Context, request *blobstore.ListRequest) (*blobstore.ListResponse, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(c.objectKey(request.Prefix)),
+	}
+	if request.PageSize > 0 {
+		input.MaxKeys = aws.Int64(int64(request.PageSize))
+	}
+	if len(request.NextPageToken) > 0 {
+		input.ContinuationToken = aws.String(string(request.NextPageToken))
+	}
+	result, err := c.s3cli.ListObjectsV2WithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	response := &blobstore.ListResponse{}
+	for _, object := range result.Contents {
+		response.Keys = append(response.Keys, strings.TrimPrefix(aws.StringValue(object.Key), c.prefix))
+	}
+	if aws.BoolValue(result.IsTruncated) {
+		response.NextPageToken = []byte(aws.StringValue(result.NextContinuationToken))
+	}
+	return response, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	if _, ok := retryableCodes[aerr.Code()]; ok {
+		return true
+	}
+	return request.IsErrorRetryable(aerr) || request.IsErrorThrottle(aerr)
+}
+
+func (c *client) objectKey(key string) string {
+	return c.prefix + key
+}
+
+func isNotFoundError(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound"
+}
+
+func encodeTags(tags map[string]string) (string, error) {
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func decodeTags(metadata map[string]*string) (map[string]string, error) {
+	var encoded *string
+	for key, value := range metadata {
+		if strings.EqualFold(key, tagsMetadataKey) {
+			encoded = value
+		}
+	}
+	if encoded == nil {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(aws.StringValue(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("invalid blob tags: %w", err)
+	}
+	var tags map[string]string
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("invalid blob tags: %w", err)
+	}
+	return tags, nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
+)
+
+type ClientSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	fake   *fakeS3
+	server *httptest.Server
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.T().Setenv("AWS_ACCESS_KEY_ID", "access-key")
+	s.T().Setenv("AWS_SECRET_ACCESS_KEY", "secret-key")
+	s.T().Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	s.fake = newFakeS3()
+	s.server = httptest.NewServer(s.fake)
+}
+
+func (s *ClientSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ClientSuite) newClient(prefix string) blobstore.Client {
+	c, err := NewS3Client(&config.S3Blobstore{
+		Bucket:           "cadence",
+		Prefix:           prefix,
+		Region:           "us-east-1",
+		Endpoint:         aws.String(s.server.URL),
+		S3ForcePathStyle: true,
+	})
+	s.NoError(err)
+	return c
+}
+
+func (s *ClientSuite) TestNewS3Client_InvalidConfig() {
+	_, err := NewS3Client(nil)
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Region: "us-east-1"})
+	s.Equal(errNoBucketSpecified, err)
+	_, err = NewS3Client(&config.S3Blobstore{Bucket: "cadence"})
+	s.Equal(errEmptyAwsRegion, err)
+	_, err = NewS3Client(&config.S3Blobstore{Bucket: "cadence", Region: "us-east-1", PartSize: 1024})
+	s.ErrorContains(err, "part size must be at least")
+}
+
+func (s *ClientSuite) TestCrudOperations() {
+	c := s.newClient("scanner/")
+	ctx := context.Background()
+
+	blob := blobstore.Blob{
+		Tags: map[string]string{"key1": "value1", "Key2": "value 2"},
+		Body: []byte{1, 2, 3},
+	}
+	_, err := c.Put(ctx, &blobstore.PutRequest{Key: "blob", Blob: blob})
+	s.NoError(err)
+	s.Contains(s.fake.objects, "scanner/blob")
+
+	get, err := c.Get(ctx, &blobstore.GetRequest{Key: "blob"})
+	s.NoError(err)
+	s.Equal(blob, get.Blob)
+
+	_, err = c.Put(ctx, &blobstore.PutRequest{Key: "untagged", Blob: blobstore.Blob{Body: []byte{4}}})
+	s.NoError(err)
+	get, err = c.Get(ctx, &blobstore.GetRequest{Key: "untagged"})
+	s.NoError(err)
+	s.Nil(get.Blob.Tags)
+	s.Equal([]byte{4}, get.Blob.Body)
+
+	exists, err := c.Exists(ctx, &blobstore.ExistsRequest{Key: "blob"})
+	s.NoError(err)
+	s.True(exists.Exists)
+
+	_, err = c.Delete(ctx, &blobstore.DeleteRequest{Key: "blob"})
+	s.NoError(err)
+	exists, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "blob"})
+	s.NoError(err)
+	s.False(exists.Exists)
+	get, err = c.Get(ctx, &blobstore.GetRequest{Key: "blob"})
+	s.IsType(&types.EntityNotExistsError{}, err)
+	s.Nil(get)
+}
+
+func (s *ClientSuite) TestMultipartUpload() {
+	c := s.newClient("")
+	ctx := context.Background()
+
+	body := bytes.Repeat([]byte("0123456789"), int(2*s3manager.MinUploadPartSize/10+1))
+	_, err := c.Put(ctx, &blobstore.PutRequest{
+		Key:  "large",
+		Blob: blobstore.Blob{Tags: map[string]string{"key": "value"}, Body: body},
+	})
+	s.NoError(err)
+	s.Equal(1, s.fake.completedUploads)
+
+	get, err := c.Get(ctx, &blobstore.GetRequest{Key: "large"})
+	s.NoError(err)
+	s.Equal(map[string]string{"key": "value"}, get.Blob.Tags)
+	s.Equal(body, get.Blob.Body)
+}
+
+func (s *ClientSuite) TestList() {
+	c := s.newClient("scanner/")
+	other := s.newClient("other/")
+	ctx := context.Background()
+
+	for _, key := range []string{"b-2", "a-1", "b-1", "b-3"} {
+		_, err := c.Put(ctx, &blobstore.PutRequest{Key: key, Blob: blobstore.Blob{Body: []byte(key)}})
+		s.NoError(err)
+	}
+	_, err := other.Put(ctx, &blobstore.PutRequest{Key: "b-0", Blob: blobstore.Blob{Body: []byte("b-0")}})
+	s.NoError(err)
+
+	all, err := c.List(ctx, &blobstore.ListRequest{})
+	s.NoError(err)
+	s.Equal([]string{"a-1", "b-1", "b-2", "b-3"}, all.Keys)
+	s.Empty(all.NextPageToken)
+
+	page1, err := c.List(ctx, &blobstore.ListRequest{Prefix: "b-", PageSize: 2})
+	s.NoError(err)
+	s.Equal([]string{"b-1", "b-2"}, page1.Keys)
+	s.NotEmpty(page1.NextPageToken)
+	page2, err := c.List(ctx, &blobstore.ListRequest{Prefix: "b-", PageSize: 2, NextPageToken: page1.NextPageToken})
+	s.NoError(err)
+	s.Equal([]string{"b-3"}, page2.Keys)
+	s.Empty(page2.NextPageToken)
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	c := s.newClient("")
+	s.True(c.IsRetryableError(awserr.New("SlowDown", "reduce your request rate", nil)))
+	s.True(c.IsRetryableError(awserr.New("RequestTimeout", "timeout", nil)))
+	s.False(c.IsRetryableError(awserr.New("NoSuchKey", "not found", nil)))
+	s.False(c.IsRetryableError(errors.New("some error")))
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type (
+	// fakeS3 is a minimal stand-in for an S3 compatible object storage, such as MinIO.
+	// It serves the path-style requests of the operations used by the client.
+	fakeS3 struct {
+		sync.Mutex
+		objects          map[string]*fakeObject
+		uploads          map[string]*fakeUpload
+		nextUploadID     int
+		completedUploads int
+	}
+
+	fakeObject struct {
+		body   []byte
+		header http.Header
+	}
+
+	fakeUpload struct {
+		key    string
+		header http.Header
+		parts  map[int][]byte
+	}
+
+	listBucketResult struct {
+		XMLName               xml.Name         `xml:"ListBucketResult"`
+		Name                  string           `xml:"Name"`
+		Prefix                string           `xml:"Prefix"`
+		KeyCount              int              `xml:"KeyCount"`
+		MaxKeys               int              `xml:"MaxKeys"`
+		IsTruncated           bool             `xml:"IsTruncated"`
+		NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+		Contents              []listBucketItem `xml:"Contents"`
+	}
+
+	listBucketItem struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	}
+
+	initiateMultipartUploadResult struct {
+		XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+		Bucket   string   `xml:"Bucket"`
+		Key      string   `xml:"Key"`
+		UploadID string   `xml:"UploadId"`
+	}
+
+	completeMultipartUploadResult struct {
+		XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		Bucket  string   `xml:"Bucket"`
+		Key     string   `xml:"Key"`
+		ETag    string   `xml:"ETag"`
+	}
+
+	s3Error struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+)
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects: map[string]*fakeObject{},
+		uploads: map[string]*fakeUpload{},
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+	switch {
+	case key == "" && r.Method == http.MethodGet && query.Get("list-type") == "2":
+		f.list(w, bucket, query.Get("prefix"), query.Get("continuation-token"), query.Get("max-keys"))
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.nextUploadID++
+		uploadID := strconv.Itoa(f.nextUploadID)
+		f.uploads[uploadID] = &fakeUpload{key: key, header: metadata(r.Header), parts: map[int][]byte{}}
+		writeXML(w, http.StatusOK, initiateMultipartUploadResult{Bucket: bucket, Key: key, UploadID: uploadID})
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		upload, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			writeXML(w, http.StatusNotFound, s3Error{Code: "NoSuchUpload"})
+			return
+		}
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		body, _ := io.ReadAll(r.Body)
+		upload.parts[partNumber] = body
+		w.Header().Set("ETag", fmt.Sprintf("%q", strconv.Itoa(partNumber)))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		upload, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			writeXML(w, http.StatusNotFound, s3Error{Code: "NoSuchUpload"})
+			return
+		}
+		partNumbers := make([]int, 0, len(upload.parts))
+		for partNumber := range upload.parts {
+			partNumbers = append(partNumbers, partNumber)
+		}
+		sort.Ints(partNumbers)
+		object := &fakeObject{header: upload.header}
+		for _, partNumber := range partNumbers {
+			object.body = append(object.body, upload.parts[partNumber]...)
+		}
+		f.objects[upload.key] = object
+		delete(f.uploads, query.Get("uploadId"))
+		f.completedUploads++
+		writeXML(w, http.StatusOK, completeMultipartUploadResult{Bucket: bucket, Key: key, ETag: `"etag"`})
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[key] = &fakeObject{body: body, header: metadata(r.Header)}
+		w.Header().Set("ETag", `"etag"`)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		object, ok := f.objects[key]
+		if !ok {
+			writeXML(w, http.StatusNotFound, s3Error{Code: "NoSuchKey", Message: "The specified key does not exist."})
+			return
+		}
+		for name, values := range object.header {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.body)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(object.body)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeXML(w, http.StatusNotImplemented, s3Error{Code: "NotImplemented"})
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, bucket, prefix, continuationToken, maxKeysParam string) {
+	maxKeys := 1000
+	if maxKeysParam != "" {
+		maxKeys, _ = strconv.Atoi(maxKeysParam)
+	}
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, prefix) && key > continuationToken {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := listBucketResult{Name: bucket, Prefix: prefix, MaxKeys: maxKeys}
+	if len(keys) > maxKeys {
+		keys = keys[:maxKeys]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		result.Contents = append(result.Contents, listBucketItem{Key: key, Size: len(f.objects[key].body)})
+	}
+	result.KeyCount = len(result.Contents)
+	writeXML(w, http.StatusOK, result)
+}
+
+func metadata(header http.Header) http.Header {
+	result := http.Header{}
+	for name, values := range header {
+		if strings.HasPrefix(strings.ToLower(name), "x-amz-meta-") {
+			result[name] = values
+		}
+	}
+	return result
+}
+
+func writeXML(w http.ResponseWriter, status int, body interface{}) {
+	data, _ := xml.Marshal(body)
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	w.Write(data)
+}
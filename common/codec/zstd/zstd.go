@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package zstd compresses persisted blobs with Zstandard, optionally using trained dictionaries.
+package zstd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxDecodedSize bounds the memory used to decompress a single blob.
+const maxDecodedSize = 256 << 20
+
+// Codec compresses and decompresses data. It is safe for concurrent use.
+type Codec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewCodec creates a Codec. When dictionaries are given, data is compressed with the first one,
+// and data compressed with any of them can be decompressed, since frames record the ID of their dictionary.
+// Dictionaries must therefore be kept for as long as data compressed with them is stored.
+func NewCodec(dictionaries ...[]byte) (*Codec, error) {
+	encoderOptions := []zstd.EOption{
+		zstd.WithEncoderLevel(zstd.SpeedDefault),
+	}
+	decoderOptions := []zstd.DOption{
+		// allows one DecodeAll per CPU
+		zstd.WithDecoderConcurrency(0),
+		zstd.WithDecoderMaxMemory(maxDecodedSize),
+	}
+	if len(dictionaries) > 0 {
+		encoderOptions = append(encoderOptions, zstd.WithEncoderDict(dictionaries[0]))
+		decoderOptions = append(decoderOptions, zstd.WithDecoderDicts(dictionaries...))
+	}
+
+	encoder, err := zstd.NewWriter(nil, encoderOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create zstd encoder: %w", err)
+	}
+	decoder, err := zstd.NewReader(nil, decoderOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create zstd decoder: %w", err)
+	}
+	return &Codec{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+// NewCodecFromFiles creates a Codec using the dictionaries stored in the given files, see NewCodec.
+// Dictionaries can be trained with `zstd --train`.
+func NewCodecFromFiles(paths ...string) (*Codec, error) {
+	dictionaries := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		dictionary, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read zstd dictionary: %w", err)
+		}
+		dictionaries = append(dictionaries, dictionary)
+	}
+	return NewCodec(dictionaries...)
+}
+
+// Compress compresses data.
+func (c *Codec) Compress(data []byte) []byte {
+	return c.encoder.EncodeAll(data, nil)
+}
+
+// Decompress decompresses data produced by Compress.
+func (c *Codec) Decompress(data []byte) ([]byte, error) {
+	return c.decoder.DecodeAll(data, nil)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zstd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodec(t *testing.T) {
+	codec, err := NewCodec()
+	require.NoError(t, err)
+
+	data := samples(1)[0]
+	compressed := codec.Compress(data)
+	assert.Less(t, len(compressed), len(data))
+
+	decompressed, err := codec.Decompress(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, data, decompressed)
+
+	_, err = codec.Decompress([]byte("not zstd"))
+	assert.Error(t, err)
+}
+
+func TestCodecWithDictionaries(t *testing.T) {
+	oldDictionary := trainDictionary(t, 1)
+	newDictionary := trainDictionary(t, 2)
+	data := samples(1)[0]
+
+	oldCodec, err := NewCodec(oldDictionary)
+	require.NoError(t, err)
+	compressedWithOld := oldCodec.Compress(data)
+
+	newCodec, err := NewCodec(newDictionary, oldDictionary)
+	require.NoError(t, err)
+	compressedWithNew := newCodec.Compress(data)
+
+	for name, compressed := range map[string][]byte{"old": compressedWithOld, "new": compressedWithNew} {
+		decompressed, err := newCodec.Decompress(compressed)
+		require.NoError(t, err, name)
+		assert.Equal(t, data, decompressed, name)
+	}
+
+	_, err = oldCodec.Decompress(compressedWithNew)
+	assert.Error(t, err, "data compressed with a dictionary needs it to be decompressed")
+
+	withoutDictionary, err := NewCodec()
+	require.NoError(t, err)
+	_, err = withoutDictionary.Decompress(compressedWithOld)
+	assert.Error(t, err, "data compressed with a dictionary needs it to be decompressed")
+
+	t.Run("invalid dictionary", func(t *testing.T) {
+		_, err := NewCodec([]byte("not a dictionary"))
+		assert.Error(t, err)
+	})
+}
+
+func TestNewCodecFromFiles(t *testing.T) {
+	dictionary := trainDictionary(t, 1)
+	path := filepath.Join(t.TempDir(), "dictionary")
+	require.NoError(t, os.WriteFile(path, dictionary, 0o600))
+
+	codec, err := NewCodecFromFiles(path)
+	require.NoError(t, err)
+	expected, err := NewCodec(dictionary)
+	require.NoError(t, err)
+	data := samples(1)[0]
+	assert.Equal(t, expected.Compress(data), codec.Compress(data))
+
+	_, err = NewCodecFromFiles(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorContains(t, err, "unable to read zstd dictionary")
+}
+
+func BenchmarkCompression(b *testing.B) {
+	withDictionary, err := NewCodec(trainDictionary(b, 1))
+	require.NoError(b, err)
+	withoutDictionary, err := NewCodec()
+	require.NoError(b, err)
+
+	codecs := []struct {
+		name       string
+		compress   func([]byte) []byte
+		decompress func([]byte) ([]byte, error)
+	}{
+		{
+			name:       "snappy",
+			compress:   func(data []byte) []byte { return snappy.Encode(nil, data) },
+			decompress: func(data []byte) ([]byte, error) { return snappy.Decode(nil, data) },
+		},
+		{
+			name:       "zstd",
+			compress:   withoutDictionary.Compress,
+			decompress: withoutDictionary.Decompress,
+		},
+		{
+			name:       "zstd with dictionary",
+			compress:   withDictionary.Compress,
+			decompress: withDictionary.Decompress,
+		},
+	}
+
+	data := samples(100)
+	for _, codec := range codecs {
+		b.Run(codec.name+"/compress", func(b *testing.B) {
+			var in, out int
+			for i := 0; i < b.N; i++ {
+				sample := data[i%len(data)]
+				in += len(sample)
+				out += len(codec.compress(sample))
+			}
+			b.ReportMetric(float64(in)/float64(out), "ratio")
+		})
+		b.Run(codec.name+"/decompress", func(b *testing.B) {
+			compressed := make([][]byte, len(data))
+			for i, sample := range data {
+				compressed[i] = codec.compress(sample)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := codec.decompress(compressed[i%len(compressed)]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// samples returns small, similar looking payloads, which are typical of persisted blobs.
+func samples(n int) [][]byte {
+	result := make([][]byte, n)
+	for i := range result {
+		result[i] = []byte(fmt.Sprintf(
+			`{"workflowType":"OrderWorkflow","taskList":"orders-%d","activityID":"%d","input":{"orderID":"order-%08d","customer":"customer-%d","items":[{"sku":"sku-%d","quantity":%d}],"status":"PENDING"}}`,
+			i%4, i, i*7919, i%13, i%29, i%5+1,
+		))
+	}
+	return result
+}
+
+func trainDictionary(tb testing.TB, id uint32) []byte {
+	contents := samples(200)
+	var history []byte
+	for _, sample := range contents[:20] {
+		history = append(history, sample...)
+	}
+	dictionary, err := zstd.BuildDict(zstd.BuildDictOptions{
+		ID:       id,
+		Contents: contents,
+		History:  history,
+		Offsets:  [3]int{1, 4, 8},
+	})
+	require.NoError(tb, err)
+	return dictionary
+}
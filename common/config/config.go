@@ -83,6 +83,8 @@ type (
 		Authorization Authorization `yaml:"authorization"`
 		// HeaderForwardingRules defines which inbound headers to include or exclude on outbound calls
 		HeaderForwardingRules []HeaderRule `yaml:"headerForwardingRules"`
+		// Tracing is the config for OpenTelemetry tracing across services and history tasks
+		Tracing Tracing `yaml:"tracing"`
 		// Note: This is not implemented yet. It's coming in the next release.
 		// AsyncWorkflowQueues is the config for predefining async workflow queue(s)
 		// To use Async APIs for a domain first specify the queue using Admin API.
@@ -186,6 +188,8 @@ type (
 	// Blobstore contains the config for blobstore
 	Blobstore struct {
 		Filestore *FileBlobstore `yaml:"filestore"`
+		// S3 takes precedence over Filestore when both are configured
+		S3 *S3Blobstore `yaml:"s3"`
 	}
 
 	// FileBlobstore contains the config for a file backed blobstore
@@ -193,6 +197,21 @@ type (
 		OutputDirectory string `yaml:"outputDirectory"`
 	}
 
+	// S3Blobstore contains the config for a blobstore backed by S3 compatible object storage
+	S3Blobstore struct {
+		Bucket string `yaml:"bucket"`
+		// Prefix is prepended to the keys of all blobs, so that a bucket can be shared
+		Prefix           string  `yaml:"prefix"`
+		Region           string  `yaml:"region"`
+		Endpoint         *string `yaml:"endpoint"`
+		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		// PartSize is the size in bytes of the parts of multipart uploads, blobs up to this size are
+		// uploaded in a single request. Defaults to, and must be at least, 5MB.
+		PartSize int64 `yaml:"partSize"`
+		// UploadConcurrency is the number of parts of a blob uploaded in parallel. Defaults to 5.
+		UploadConcurrency int `yaml:"uploadConcurrency"`
+	}
+
 	// Persistence contains the configuration for data store / persistence layer
 	Persistence struct {
 		// DefaultStore is the name of the default data store to use
@@ -215,16 +234,42 @@ type (
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
 		// DataStores contains the configuration for all datastores
 		DataStores map[string]DataStore `yaml:"datastores"`
+		// ZstdDictionaries are paths to zstd dictionaries used by the thriftrw_zstd encoding.
+		// The first one compresses new blobs, all of them are used to read blobs, so a dictionary
+		// must be kept for as long as blobs compressed with it are stored.
+		ZstdDictionaries []string `yaml:"zstdDictionaries"`
+		// Encryption configures the keys history and mutable state blobs are encrypted with,
+		// see system.enablePersistenceEncryption in dynamic config.
+		Encryption *PersistenceEncryption `yaml:"encryption"`
 		// TODO: move dynamic config out of static config
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicproperties.IntPropertyFn `yaml:"-" json:"-"`
 		// TODO: move dynamic config out of static config
 		// ErrorInjectionRate is the the rate for injecting random error
 		ErrorInjectionRate dynamicproperties.FloatPropertyFn `yaml:"-" json:"-"`
+		// FaultInjectionRules are the faults injected into persistence calls, see faultinjectors.Rule
+		FaultInjectionRules dynamicproperties.ListPropertyFn `yaml:"-" json:"-"`
+		// HistoryBranchCacheMaxSize is the size in bytes of the cache of history node batches, 0 disables it
+		HistoryBranchCacheMaxSize dynamicproperties.IntPropertyFn `yaml:"-" json:"-"`
 		// HostName for emitting per-host metrics
 		HostName string `yaml:"-" json:"-"`
 	}
 
+	// PersistenceEncryption is the configuration for encryption at rest of persisted blobs
+	PersistenceEncryption struct {
+		// KeyringFile is the path of the local keyring holding the key encryption keys.
+		// Keys must stay in the keyring for as long as blobs encrypted with them are stored.
+		// History is only re-encrypted by the admin db reencrypt-history command, so a rotated key
+		// is needed until it has run or the workflows written under it are deleted.
+		KeyringFile string `yaml:"keyringFile"`
+		// DataKeyMaxUses is the number of blobs a process encrypts under a data key before generating a new one,
+		// defaults to encryption.DefaultDataKeyMaxUses.
+		DataKeyMaxUses int64 `yaml:"dataKeyMaxUses"`
+		// DataKeyMaxAge is the time a process encrypts blobs under a data key before generating a new one,
+		// defaults to encryption.DefaultDataKeyMaxAge.
+		DataKeyMaxAge time.Duration `yaml:"dataKeyMaxAge"`
+	}
+
 	// DataStore is the configuration for a single datastore
 	DataStore struct {
 		// Cassandra contains the config for a cassandra datastore
@@ -284,6 +329,7 @@ type (
 		// Use it ONLY when a configure is too specific to a particular NoSQL database that should not be in the common struct
 		// Otherwise please add new fields to the struct for better documentation
 		// If being used in any database, update this comment here to make it clear
+		// MongoDB: the attributes are appended to the connection string as options, e.g. replicaSet
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 		// HostSelectionPolicy sets gocql policy for selecting host for a query
 		// Available selections are: "tokenaware,roundrobin", "hostpool-epsilon-greedy", "roundrobin"
@@ -707,12 +753,16 @@ func (c *Config) validate() error {
 	if err := c.Archival.Validate(&c.DomainDefaults.Archival); err != nil {
 		return err
 	}
+	if err := c.Tracing.Validate(); err != nil {
+		return err
+	}
 
 	return c.Authorization.Validate()
 }
 
 func (c *Config) fillDefaults() {
 	c.Persistence.FillDefaults()
+	c.Tracing.FillDefaults()
 
 	// TODO: remove this at the point when we decided to make some breaking changes in config.
 	if c.ClusterGroupMetadata == nil && c.ClusterMetadata != nil {
@@ -0,0 +1,88 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	// TracingExporterNone disables trace export. Spans are still created so that
+	// trace context keeps propagating across services, but nothing is recorded.
+	TracingExporterNone = "none"
+	// TracingExporterOTLP exports spans over OTLP/gRPC
+	TracingExporterOTLP = "otlp"
+
+	defaultTracingServiceName = "cadence"
+	defaultTracingSampleRate  = 1.0
+)
+
+type (
+	// Tracing is the config for OpenTelemetry tracing
+	Tracing struct {
+		// Exporter is the span exporter to use, either "none" (default) or "otlp"
+		Exporter string `yaml:"exporter"`
+		// Endpoint is the host:port of the OTLP collector
+		Endpoint string `yaml:"endpoint"`
+		// Insecure disables TLS when talking to the collector
+		Insecure bool `yaml:"insecure"`
+		// Headers are sent with every export request, e.g. for collector authentication
+		Headers map[string]string `yaml:"headers"`
+		// SampleRate is the ratio of new traces that are sampled, in (0, 1]. Defaults to 1.
+		// Spans with a sampled remote parent are always sampled.
+		SampleRate float64 `yaml:"sampleRate"`
+		// ServiceName is reported as the service.name resource attribute. Defaults to "cadence".
+		ServiceName string `yaml:"serviceName"`
+	}
+)
+
+// Enabled returns true if spans should be exported
+func (t *Tracing) Enabled() bool {
+	return t.Exporter != "" && t.Exporter != TracingExporterNone
+}
+
+// Validate validates the tracing config
+func (t *Tracing) Validate() error {
+	switch t.Exporter {
+	case "", TracingExporterNone:
+		return nil
+	case TracingExporterOTLP:
+	default:
+		return fmt.Errorf("[TracingConfig] unknown exporter %q", t.Exporter)
+	}
+	if t.Endpoint == "" {
+		return fmt.Errorf("[TracingConfig] endpoint must be set for exporter %q", t.Exporter)
+	}
+	if t.SampleRate < 0 || t.SampleRate > 1 {
+		return fmt.Errorf("[TracingConfig] sampleRate must be between 0 and 1, got %v", t.SampleRate)
+	}
+	return nil
+}
+
+// FillDefaults fills default values for unset fields
+func (t *Tracing) FillDefaults() {
+	if t.SampleRate == 0 {
+		t.SampleRate = defaultTracingSampleRate
+	}
+	if t.ServiceName == "" {
+		t.ServiceName = defaultTracingServiceName
+	}
+}
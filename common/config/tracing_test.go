@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracingValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg     Tracing
+		wantErr string
+	}{
+		"empty config is valid": {
+			cfg: Tracing{},
+		},
+		"none exporter is valid": {
+			cfg: Tracing{Exporter: TracingExporterNone},
+		},
+		"otlp exporter": {
+			cfg: Tracing{Exporter: TracingExporterOTLP, Endpoint: "localhost:4317", SampleRate: 0.5},
+		},
+		"unknown exporter": {
+			cfg:     Tracing{Exporter: "zipkin"},
+			wantErr: `[TracingConfig] unknown exporter "zipkin"`,
+		},
+		"otlp without endpoint": {
+			cfg:     Tracing{Exporter: TracingExporterOTLP},
+			wantErr: `[TracingConfig] endpoint must be set for exporter "otlp"`,
+		},
+		"sample rate out of range": {
+			cfg:     Tracing{Exporter: TracingExporterOTLP, Endpoint: "localhost:4317", SampleRate: 1.5},
+			wantErr: "[TracingConfig] sampleRate must be between 0 and 1, got 1.5",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestTracingFillDefaults(t *testing.T) {
+	cfg := Tracing{}
+	cfg.FillDefaults()
+	assert.Equal(t, Tracing{SampleRate: 1, ServiceName: "cadence"}, cfg)
+	assert.False(t, cfg.Enabled())
+
+	cfg = Tracing{Exporter: TracingExporterOTLP, SampleRate: 0.1, ServiceName: "cadence-staging"}
+	cfg.FillDefaults()
+	assert.Equal(t, 0.1, cfg.SampleRate)
+	assert.Equal(t, "cadence-staging", cfg.ServiceName)
+	assert.True(t, cfg.Enabled())
+}
@@ -63,6 +63,8 @@ const (
 	EncodingTypeJSON           EncodingType = "json"
 	EncodingTypeThriftRW       EncodingType = "thriftrw"
 	EncodingTypeThriftRWSnappy EncodingType = "thriftrw_snappy"
+	EncodingTypeThriftRWZstd   EncodingType = "thriftrw_zstd"
+	EncodingTypeEncrypted      EncodingType = "encrypted"
 	EncodingTypeGob            EncodingType = "gob"
 	EncodingTypeUnknown        EncodingType = "unknow"
 	EncodingTypeEmpty          EncodingType = ""
@@ -77,6 +79,10 @@ type (
 // MaxTaskTimeout is maximum task timeout allowed. 366 days in seconds
 const MaxTaskTimeout = 31622400
 
+// MaxTaskPriority is the highest priority of a decision or activity task. Within a task list,
+// tasks with a higher priority are dispatched first, and tasks without a priority have priority 0.
+const MaxTaskPriority = 10
+
 const (
 	// GetHistoryMaxPageSize is the max page size for get history
 	GetHistoryMaxPageSize = 1000
@@ -352,6 +352,12 @@ const (
 	// Default value: 14680064 (14*1024*1024, ~14MB)
 	// Allowed filters: N/A
 	TransactionSizeLimit
+	// HistoryBranchCacheMaxSize is the maximum size in bytes of the cache of history node batches read from persistence
+	// KeyName: system.historyBranchCacheMaxSize
+	// Value type: Int
+	// Default value: 0 (cache disabled)
+	// Allowed filters: N/A
+	HistoryBranchCacheMaxSize
 	// MaxRetentionDays is the maximum allowed retention period in days for workflow history after workflow close for all domains
 	// KeyName: system.maxRetentionDays
 	// Value type: Int
@@ -382,6 +388,12 @@ const (
 	// Default value: 262144 (256*1024)
 	// Allowed filters: DomainName
 	BlobSizeLimitWarn
+	// PayloadOffloadThreshold is the size above which workflow inputs, activity results and signal payloads are offloaded to the blobstore, 0 disables offloading
+	// KeyName: limit.payloadOffloadThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PayloadOffloadThreshold
 	// HistorySizeLimitError is the per workflow execution history size limit
 	// KeyName: limit.historySize.error
 	// Value type: Int
@@ -904,6 +916,20 @@ const (
 	// Default value: 2
 	// Allowed filters: DomainName,TasklistName,TaskType
 	MatchingIsolationGroupsPerPartition
+	// MatchingMaxCompatibleBuildIDSets is the max number of sets of compatible worker build IDs kept for a tasklist,
+	// the oldest sets are dropped when a new default set is added
+	// KeyName: matching.maxCompatibleBuildIDSets
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingMaxCompatibleBuildIDSets
+	// MatchingTaskPriorityReadAheadLimit is the max number of backlog tasks the task reader reads past a full buffer
+	// to find tasks of other priorities, zero disables reading ahead
+	// KeyName: matching.taskPriorityReadAheadLimit
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingTaskPriorityReadAheadLimit
 	// MatchingPercentageOnboardedToShardManager is the percentage of task lists that will be onboarded to the shard manager.
 	// KeyName: matching.percentageOnboardedToShardManager
 	// Value type: Int
@@ -1317,6 +1343,12 @@ const (
 	// Default value: 1
 	// Allowed filters: N/A
 	MaxBufferedQueryCount
+	// MaxBufferedUpdateCount indicates the maximum number of updates which can be in-flight at a given time for a single workflow
+	// KeyName: history.maxBufferedUpdateCount
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	MaxBufferedUpdateCount
 	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
 	// KeyName: history.mutableStateChecksumGenProbability
 	// Value type: Int
@@ -1341,6 +1373,13 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	MaxActivityCountDispatchByDomain
+	// ActivityLocalDispatchLimitPerDecision is the max # of activity tasks returned to the worker as already started by a single
+	// RespondDecisionTaskCompleted call. Activities beyond the limit fall back to dispatch through matching
+	// KeyName: history.activityLocalDispatchLimitPerDecision
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	ActivityLocalDispatchLimitPerDecision
 
 	// key for history replication
 
@@ -2002,6 +2041,12 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	HistoryScannerEnabled
+	// PayloadScannerEnabled indicates if offloaded payload scanner should be started as part of worker.Scanner
+	// KeyName: worker.payloadScannerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	PayloadScannerEnabled
 	// ConcreteExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	// KeyName: worker.executionsScannerEnabled
 	// Value type: Bool
@@ -2203,6 +2248,14 @@ const (
 	// Allowed filters: N/A
 	EnableCassandraAllConsistencyLevelDelete
 
+	// EnablePersistenceEncryption encrypts newly written history and mutable state blobs with the keyring
+	// configured in persistence.encryption. Encrypted blobs remain readable when it is disabled again
+	// KeyName: system.enablePersistenceEncryption
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnablePersistenceEncryption
+
 	// EnableTasklistIsolation Is a feature to enable subdivision of workflows by units called 'isolation-groups'
 	// and to control their movement and blast radius. This has some nontrivial operational overheads in management
 	// and a good understanding of poller distribution, so probably not worth enabling unless it's well understood.
@@ -2218,6 +2271,14 @@ const (
 	// Allowed filters: DomainName,TasklistName,TaskType
 	EnablePartitionIsolationGroupAssignment
 
+	// MatchingEnableFairDispatch enables dispatching the backlog of a tasklist fairly across fairness keys,
+	// which are supplied by the client starting the workflow and default to the workflow ID
+	// KeyName: matching.enableFairDispatch
+	// Value type: bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingEnableFairDispatch
+
 	// EnableShardIDMetrics turns on or off shardId metrics
 	// KeyName: system.enableShardIDMetrics
 	// Value type: Bool
@@ -2621,7 +2682,7 @@ const (
 	// Default value: "enabled"
 	// Allowed filters: N/A
 	VisibilityArchivalStatus
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events, one of "thriftrw", "thriftrw_zstd" or "json"
 	// KeyName: history.defaultEventEncoding
 	// Value type: String
 	// Default value: string(constants.EncodingTypeThriftRW)
@@ -2692,7 +2753,7 @@ const (
 	// Note: not currently used in open-source
 	EnableAdminAuthorization
 
-	// SerializationEncoding is the encoding type for blobs
+	// SerializationEncoding is the encoding type for blobs, one of "thriftrw", "thriftrw_snappy" or "thriftrw_zstd"
 	// KeyName: history.serializationEncoding
 	// Value type: String
 	// Default value: "thriftrw"
@@ -3199,6 +3260,12 @@ const (
 	// Value type: Duration
 	// Default value: 30 minutes
 	ESAnalyzerBufferWaitTime
+	// PayloadScavengerArchivalWindow is how long the offloaded payloads of domains with history archival enabled
+	// are kept past the retention of the domain, so that archived histories can still resolve them
+	// KeyName: worker.payloadScavengerArchivalWindow
+	// Value type: Duration
+	// Default value: 30 days
+	PayloadScavengerArchivalWindow
 	// IsolationGroupStateRefreshInterval
 	// KeyName: system.isolationGroupStateRefreshInterval
 	// Value type: Duration
@@ -3268,6 +3335,21 @@ const (
 	// Allowed filters: domainName, taskListName, taskListType
 	TaskIsolationPollerWindow
 
+	// MatchingTaskPriorityAgingInterval is the time after which a buffered task is dispatched as if its priority was one level higher
+	// KeyName: matching.taskPriorityAgingInterval
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingTaskPriorityAgingInterval
+
+	// MatchingVersionedTaskMaxWait is the time after which a backlog task pinned to a worker build ID, which no poller
+	// of a compatible build ID has picked up, can be dispatched to any poller. Zero makes the task wait indefinitely
+	// KeyName: matching.versionedTaskMaxWait
+	// Value type: Duration
+	// Default value: 10m
+	// Allowed filters: domainName, taskListName, taskListType
+	MatchingVersionedTaskMaxWait
+
 	// DomainAuditLogTTL is the TTL for domain audit log entries
 	// KeyName: system.domainAuditLogTTL
 	// Value type: Duration
@@ -3351,6 +3433,14 @@ const (
 	// Allowed filters: N/A
 	SearchAttributesHiddenValueKeys
 
+	// MatchingFairnessKeyWeights is the weights of fairness keys when dispatching the backlog of a tasklist fairly,
+	// keys which are not configured have a weight of 1
+	// KeyName: matching.fairnessKeyWeights
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName
+	MatchingFairnessKeyWeights
+
 	// LastMapKey must be the last one in this const group
 	LastMapKey
 )
@@ -3383,6 +3473,15 @@ const (
 	// Default value: forward all headers.  (this is a problematic value, and it will be changing as we reduce to a list of known values)
 	HeaderForwardingRules
 
+	// PersistenceFaultInjectionRules defines the faults injected into persistence calls, for chaos testing.
+	// Each rule selects calls by operation, domain and shard, and injects fake errors, latency or black holes,
+	// optionally following a schedule. See faultinjectors.Rule for the format.
+	// KeyName: system.persistenceFaultInjectionRules
+	// Value type: []faultinjectors.Rule or an []interface{} containing the equivalent `map[string]interface{}` values.
+	// Default value: empty list
+	// Allowed filters: N/A
+	PersistenceFaultInjectionRules
+
 	LastListKey
 )
 
@@ -3424,6 +3523,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TransactionSizeLimit is the largest allowed transaction size to persistence",
 		DefaultValue: 14680064,
 	},
+	HistoryBranchCacheMaxSize: {
+		KeyName:      "system.historyBranchCacheMaxSize",
+		Description:  "HistoryBranchCacheMaxSize is the maximum size in bytes of the cache of history node batches read from persistence, 0 disables the cache",
+		DefaultValue: 0,
+	},
 	MaxRetentionDays: {
 		KeyName:      "system.maxRetentionDays",
 		Description:  "MaxRetentionDays is the maximum allowed retention days for domain",
@@ -3450,6 +3554,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "BlobSizeLimitWarn is the per event blob size limit for warning",
 		DefaultValue: 256 * 1024,
 	},
+	PayloadOffloadThreshold: {
+		KeyName:      "limit.payloadOffloadThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "PayloadOffloadThreshold is the size above which workflow inputs, activity results and signal payloads are offloaded to the blobstore, 0 disables offloading",
+		DefaultValue: 0,
+	},
 	HistorySizeLimitError: {
 		KeyName:      "limit.historySize.error",
 		Filters:      []Filter{DomainName},
@@ -3862,6 +3972,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingIsolationGroupsPerPartition is the target number of isolation groups to assign to each partition",
 		DefaultValue: 2,
 	},
+	MatchingMaxCompatibleBuildIDSets: {
+		KeyName:      "matching.maxCompatibleBuildIDSets",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingMaxCompatibleBuildIDSets is the max number of sets of compatible worker build IDs kept for a tasklist, the oldest sets are dropped when a new default set is added",
+		DefaultValue: 10,
+	},
+	MatchingTaskPriorityReadAheadLimit: {
+		KeyName:      "matching.taskPriorityReadAheadLimit",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingTaskPriorityReadAheadLimit is the max number of backlog tasks the task reader reads past a full buffer to find tasks of other priorities, zero disables reading ahead",
+		DefaultValue: 10000,
+	},
 	MatchingPercentageOnboardedToShardManager: {
 		KeyName:      "matching.percentageOnboardedToShardManager",
 		Description:  "MatchingPercentageOnboardedToShardManager is the percentage of task lists that will be onboarded to the shard manager",
@@ -4210,6 +4332,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaxBufferedQueryCount indicates the maximum number of queries which can be buffered at a given time for a single workflow",
 		DefaultValue: 1,
 	},
+	MaxBufferedUpdateCount: {
+		KeyName:      "history.maxBufferedUpdateCount",
+		Description:  "MaxBufferedUpdateCount indicates the maximum number of updates which can be in-flight at a given time for a single workflow",
+		DefaultValue: 10,
+	},
 	MutableStateChecksumGenProbability: {
 		KeyName:      "history.mutableStateChecksumGenProbability",
 		Filters:      []Filter{DomainName},
@@ -4232,6 +4359,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "DEPRECATED: MaxActivityCountDispatchByDomain max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.",
 		DefaultValue: 0,
 	},
+	ActivityLocalDispatchLimitPerDecision: {
+		KeyName:      "history.activityLocalDispatchLimitPerDecision",
+		Filters:      []Filter{DomainName},
+		Description:  "ActivityLocalDispatchLimitPerDecision is the max # of activity tasks returned to the worker as already started by a single RespondDecisionTaskCompleted call. Activities beyond the limit fall back to dispatch through matching",
+		DefaultValue: 10,
+	},
 	ReplicationTaskFetcherParallelism: {
 		KeyName:      "history.ReplicationTaskFetcherParallelism",
 		Description:  "ReplicationTaskFetcherParallelism determines how many go routines we spin up for fetching tasks",
@@ -4812,6 +4945,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "HistoryScannerEnabled indicates if history scanner should be started as part of worker.Scanner",
 		DefaultValue: false,
 	},
+	PayloadScannerEnabled: {
+		KeyName:      "worker.payloadScannerEnabled",
+		Description:  "PayloadScannerEnabled indicates if offloaded payload scanner should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
 	ConcreteExecutionsScannerEnabled: {
 		KeyName:      "worker.executionsScannerEnabled",
 		Description:  "ConcreteExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner",
@@ -4987,6 +5125,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "Uses all consistency level for Cassandra delete operations",
 		DefaultValue: false,
 	},
+	EnablePersistenceEncryption: {
+		KeyName:      "system.enablePersistenceEncryption",
+		Description:  "EnablePersistenceEncryption encrypts newly written history and mutable state blobs with the keyring configured in persistence.encryption",
+		DefaultValue: false,
+	},
 	EnableShardIDMetrics: {
 		KeyName:      "system.enableShardIDMetrics",
 		Description:  "Enable shardId metrics in persistence client",
@@ -5034,6 +5177,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnablePartitionIsolationGroupAssignment enables assigning isolation groups to individual TaskList partitions",
 		DefaultValue: false,
 	},
+	MatchingEnableFairDispatch: {
+		KeyName:      "matching.enableFairDispatch",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableFairDispatch enables dispatching the backlog of a tasklist fairly across fairness keys, which are supplied by the client starting the workflow and default to the workflow ID",
+		DefaultValue: false,
+	},
 	EnableNoSQLHistoryTaskDualWriteMode: {
 		KeyName:      "history.enableNoSQLHistoryTaskDualWrite",
 		Description:  "EnableHistoryTaskDualWrite is to enable dual write of history events",
@@ -5322,7 +5471,7 @@ var StringKeys = map[StringKey]DynamicString{
 	DefaultEventEncoding: {
 		KeyName:      "history.defaultEventEncoding",
 		Filters:      []Filter{DomainName},
-		Description:  "DefaultEventEncoding is the encoding type for history events",
+		Description:  "DefaultEventEncoding is the encoding type for history events, one of thriftrw, thriftrw_zstd or json. Blobs remain readable whatever their encoding",
 		DefaultValue: string(constants.EncodingTypeThriftRW),
 	},
 	AdminOperationToken: {
@@ -5385,7 +5534,7 @@ var StringKeys = map[StringKey]DynamicString{
 	},
 	SerializationEncoding: {
 		KeyName:      "history.serializationEncoding",
-		Description:  "SerializationEncoding is the encoding type for blobs",
+		Description:  "SerializationEncoding is the encoding type for blobs, one of thriftrw, thriftrw_snappy or thriftrw_zstd. Blobs remain readable whatever their encoding",
 		DefaultValue: string(constants.EncodingTypeThriftRW),
 	},
 	ShardDistributorMigrationMode: {
@@ -5851,6 +6000,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ESAnalyzerBufferWaitTime controls min time required to consider a worklow stuck",
 		DefaultValue: time.Minute * 30,
 	},
+	PayloadScavengerArchivalWindow: {
+		KeyName:      "worker.payloadScavengerArchivalWindow",
+		Description:  "PayloadScavengerArchivalWindow is how long the offloaded payloads of domains with history archival enabled are kept past the retention of the domain, so that archived histories can still resolve them",
+		DefaultValue: time.Hour * 24 * 30,
+	},
 	AsyncTaskDispatchTimeout: {
 		KeyName:      "matching.asyncTaskDispatchTimeout",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5897,6 +6051,18 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "TaskIsolationDuration is the time period for which we attempt to respect tasklist isolation before allowing any poller to process the task",
 		DefaultValue: time.Second * 2,
 	},
+	MatchingTaskPriorityAgingInterval: {
+		KeyName:      "matching.taskPriorityAgingInterval",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingTaskPriorityAgingInterval is the time after which a buffered task is dispatched as if its priority was one level higher, so that low priority tasks are not starved. Zero disables aging",
+		DefaultValue: time.Minute,
+	},
+	MatchingVersionedTaskMaxWait: {
+		KeyName:      "matching.versionedTaskMaxWait",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingVersionedTaskMaxWait is the time after which a backlog task pinned to a worker build ID, which no poller of a compatible build ID has picked up, can be dispatched to any poller. Zero makes the task wait indefinitely",
+		DefaultValue: time.Minute * 10,
+	},
 	DomainAuditLogTTL: {
 		KeyName:      "system.domainAuditLogTTL",
 		Filters:      []Filter{DomainID},
@@ -5964,6 +6130,12 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "SearchAttributesHiddenValueKeys is the list of search attributes that values should be hidden",
 		DefaultValue: map[string]interface{}{},
 	},
+	MatchingFairnessKeyWeights: {
+		KeyName:      "matching.fairnessKeyWeights",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingFairnessKeyWeights is the weights of fairness keys when dispatching the backlog of a tasklist fairly, keys which are not configured have a weight of 1",
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
@@ -5995,6 +6167,11 @@ var ListKeys = map[ListKey]DynamicList{
 			},
 		},
 	},
+	PersistenceFaultInjectionRules: {
+		KeyName:      "system.persistenceFaultInjectionRules",
+		Description:  "PersistenceFaultInjectionRules is the list of faults (errors, latency, black holes) injected into persistence calls",
+		DefaultValue: []interface{}{},
+	},
 }
 
 var _keyNames map[string]Key
@@ -238,7 +238,7 @@ func (s *constantSuite) TestStringKey() {
 			Key:          DefaultEventEncoding,
 			KeyName:      "history.defaultEventEncoding",
 			Filters:      []Filter{DomainName},
-			Description:  "DefaultEventEncoding is the encoding type for history events",
+			Description:  "DefaultEventEncoding is the encoding type for history events, one of thriftrw, thriftrw_zstd or json. Blobs remain readable whatever their encoding",
 			DefaultValue: string(constants.EncodingTypeThriftRW),
 		},
 		"ReadVisibilityStoreName": {
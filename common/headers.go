@@ -56,6 +56,9 @@ const (
 
 	// ClientIsolationGroupHeaderName refers to the name of the header that contains the isolation group which the client request is from
 	ClientIsolationGroupHeaderName = "cadence-client-isolation-group"
+	// ClientFairnessKeyHeaderName refers to the name of the header that contains the key which tasks of workflows started
+	// by the client request are fairly dispatched by, for example a tenant
+	ClientFairnessKeyHeaderName = "cadence-client-fairness-key"
 
 	// CallerTypeHeaderName refers to the name of the header that contains the caller type (CLI, UI, SDK, internal, etc.)
 	CallerTypeHeaderName = types.CallerTypeHeaderName
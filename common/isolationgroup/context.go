@@ -34,6 +34,8 @@ type configKey struct{}
 
 type isolationGroupKey struct{}
 
+type fairnessKey struct{}
+
 // ConfigFromContext retrieves infomation about the partition config of the context
 // which is used for tasklist isolation
 func ConfigFromContext(ctx context.Context) map[string]string {
@@ -63,3 +65,18 @@ func IsolationGroupFromContext(ctx context.Context) string {
 func ContextWithIsolationGroup(ctx context.Context, isolationGroup string) context.Context {
 	return context.WithValue(ctx, isolationGroupKey{}, isolationGroup)
 }
+
+// FairnessKeyFromContext retrieves the caller supplied key by which matching fairly dispatches
+// the tasks of the workflow started by the request, see ClientFairnessKeyHeaderName
+func FairnessKeyFromContext(ctx context.Context) string {
+	val, ok := ctx.Value(fairnessKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// ContextWithFairnessKey stores the fairness key into the given context
+func ContextWithFairnessKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, fairnessKey{}, key)
+}
@@ -209,6 +209,11 @@ func QueryID(queryID string) Tag {
 	return newStringTag("query-id", queryID)
 }
 
+// UpdateID returns tag for UpdateID
+func UpdateID(updateID string) Tag {
+	return newStringTag("update-id", updateID)
+}
+
 // BlobSizeViolationOperation returns tag for BlobSizeViolationOperation
 func BlobSizeViolationOperation(operation string) Tag {
 	return newStringTag("blob-size-violation-operation", operation)
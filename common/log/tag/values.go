@@ -36,6 +36,8 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionUpsertWorkflowMemo             = workflowAction("add-workflow-upsert-memo-event")
+	WorkflowActionWorkflowUpdateAccepted         = workflowAction("add-workflow-update-accepted-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -58,6 +60,9 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPaused          = workflowAction("add-activitytask-paused-event")
+	WorkflowActionActivityTaskUnpaused        = workflowAction("add-activitytask-unpaused-event")
+	WorkflowActionActivityTaskOptionsUpdated  = workflowAction("add-activitytask-options-updated-event")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -326,6 +331,8 @@ var (
 	AdminClientOperationPurgeDLQMessages                      = clientOperation("admin-purge-dlq-messsages")
 	AdminClientOperationMergeDLQMessages                      = clientOperation("admin-merge-dlq-messsages")
 	AdminClientOperationRefreshWorkflowTasks                  = clientOperation("admin-refresh-wf-tasks")
+	AdminClientOperationPauseActivity                         = clientOperation("admin-pause-activity")
+	AdminClientOperationUnpauseActivity                       = clientOperation("admin-unpause-activity")
 	AdminClientOperationResendReplicationTasks                = clientOperation("admin-resend-replication-tasks")
 	AdminClientOperationGetCrossClusterTasks                  = clientOperation("admin-get-cross-cluster-tasks")
 	AdminClientOperationRespondCrossClusterTasksCompleted     = clientOperation("admin-respond-cross-cluster-tasks-completed")
@@ -343,6 +350,8 @@ var (
 	AdminDeleteWorkflow                                       = clientOperation("admin-delete-workflow")
 	MaintainCorruptWorkflow                                   = clientOperation("maintain-corrupt-workflow")
 	AdminClientOperationUpdateTaskListPartitionConfig         = clientOperation("admin-update-task-list-partition-config")
+	AdminClientOperationListTaskListTasks                     = clientOperation("admin-list-task-list-tasks")
+	AdminClientOperationDeleteTaskListTasks                   = clientOperation("admin-delete-task-list-tasks")
 
 	FrontendClientOperationDeleteDomain                          = clientOperation("frontend-delete-domain")
 	FrontendClientOperationDeprecateDomain                       = clientOperation("frontend-deprecate-domain")
@@ -369,6 +378,10 @@ var (
 	FrontendClientOperationResetStickyTaskList                   = clientOperation("frontend-reset-sticky-task-list")
 	FrontendClientOperationResetWorkflowExecution                = clientOperation("frontend-reset-wf-execution")
 	FrontendClientOperationRefreshWorkflowTasks                  = clientOperation("frontend-refresh-wf-tasks")
+	FrontendClientOperationUpdateActivityOptions                 = clientOperation("frontend-update-activity-options")
+	FrontendClientOperationUpdateWorkflowExecution               = clientOperation("frontend-update-workflow-execution")
+	FrontendClientOperationUpdateTaskListBuildIDCompatibility    = clientOperation("frontend-update-task-list-build-id-compatibility")
+	FrontendClientOperationGetTaskListBuildIDCompatibility       = clientOperation("frontend-get-task-list-build-id-compatibility")
 	FrontendClientOperationRespondActivityTaskCanceled           = clientOperation("frontend-respond-activity-task-canceled")
 	FrontendClientOperationRespondActivityTaskCanceledByID       = clientOperation("frontend-respond-activity-task-canceled-by-id")
 	FrontendClientOperationRespondActivityTaskCompleted          = clientOperation("frontend-respond-activity-task-completed")
@@ -439,6 +452,10 @@ var (
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
 	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationPauseActivity                     = clientOperation("history-pause-activity")
+	HistoryClientOperationUnpauseActivity                   = clientOperation("history-unpause-activity")
+	HistoryClientOperationUpdateActivityOptions             = clientOperation("history-update-activity-options")
+	HistoryClientOperationUpdateWorkflowExecution           = clientOperation("history-update-workflow-execution")
 	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
@@ -450,23 +467,33 @@ var (
 	HistoryClientOperationRespondDecisionTaskFailed         = clientOperation("history-respond-decision-task-failed")
 	HistoryClientOperationRatelimitUpdate                   = clientOperation("history-ratelimit-update")
 
-	MatchingClientOperationAddActivityTask                = clientOperation("matching-add-activity-task")
-	MatchingClientOperationAddDecisionTask                = clientOperation("matching-add-decision-task")
-	MatchingClientOperationPollForActivityTask            = clientOperation("matching-poll-for-activity-task")
-	MatchingClientOperationPollForDecisionTask            = clientOperation("matching-poll-for-decision-task")
-	MatchingClientOperationQueryWorkflow                  = clientOperation("matching-query-wf")
-	MatchingClientOperationQueryTaskCompleted             = clientOperation("matching-query-task-completed")
-	MatchingClientOperationCancelOutstandingPoll          = clientOperation("matching-cancel-outstanding-poll")
-	MatchingClientOperationDescribeTaskList               = clientOperation("matching-describe-task-list")
-	MatchingClientOperationListTaskListPartitions         = clientOperation("matching-list-task-list-partitions")
-	MatchingClientOperationGetTaskListsByDomain           = clientOperation("matching-get-task-list-for-domain")
-	MatchingClientOperationRespondQueryTaskCompleted      = clientOperation("matching-respond-query-task-completed")
-	MatchingClientOperationUpdateTaskListPartitionConfig  = clientOperation("matching-update-task-list-partition-config")
-	MatchingClientOperationRefreshTaskListPartitionConfig = clientOperation("matching-refresh-task-list-partition-config")
-
-	ShardDistributorClientOperationGetShardOwner       = clientOperation("shard-distributor-get-shard-owner")
-	ShardDistributorClientOperationWatchNamespaceState = clientOperation("shard-distributor-watch-namespace-state")
-	ShardDistributorExecutorClientOperationHeartbeat   = clientOperation("shard-distributor-executor-heartbeat")
+	MatchingClientOperationAddActivityTask                    = clientOperation("matching-add-activity-task")
+	MatchingClientOperationAddDecisionTask                    = clientOperation("matching-add-decision-task")
+	MatchingClientOperationPollForActivityTask                = clientOperation("matching-poll-for-activity-task")
+	MatchingClientOperationPollForDecisionTask                = clientOperation("matching-poll-for-decision-task")
+	MatchingClientOperationQueryWorkflow                      = clientOperation("matching-query-wf")
+	MatchingClientOperationQueryTaskCompleted                 = clientOperation("matching-query-task-completed")
+	MatchingClientOperationCancelOutstandingPoll              = clientOperation("matching-cancel-outstanding-poll")
+	MatchingClientOperationDescribeTaskList                   = clientOperation("matching-describe-task-list")
+	MatchingClientOperationListTaskListPartitions             = clientOperation("matching-list-task-list-partitions")
+	MatchingClientOperationGetTaskListsByDomain               = clientOperation("matching-get-task-list-for-domain")
+	MatchingClientOperationRespondQueryTaskCompleted          = clientOperation("matching-respond-query-task-completed")
+	MatchingClientOperationUpdateTaskListPartitionConfig      = clientOperation("matching-update-task-list-partition-config")
+	MatchingClientOperationRefreshTaskListPartitionConfig     = clientOperation("matching-refresh-task-list-partition-config")
+	MatchingClientOperationListTaskListTasks                  = clientOperation("matching-list-task-list-tasks")
+	MatchingClientOperationDeleteTaskListTasks                = clientOperation("matching-delete-task-list-tasks")
+	MatchingClientOperationUpdateTaskListBuildIDCompatibility = clientOperation("matching-update-task-list-build-id-compatibility")
+	MatchingClientOperationGetTaskListBuildIDCompatibility    = clientOperation("matching-get-task-list-build-id-compatibility")
+
+	ShardDistributorClientOperationGetShardOwner             = clientOperation("shard-distributor-get-shard-owner")
+	ShardDistributorClientOperationWatchNamespaceState       = clientOperation("shard-distributor-watch-namespace-state")
+	ShardDistributorClientOperationCordonExecutor            = clientOperation("shard-distributor-cordon-executor")
+	ShardDistributorClientOperationUncordonExecutor          = clientOperation("shard-distributor-uncordon-executor")
+	ShardDistributorClientOperationDrainExecutor             = clientOperation("shard-distributor-drain-executor")
+	ShardDistributorClientOperationPinShard                  = clientOperation("shard-distributor-pin-shard")
+	ShardDistributorClientOperationUnpinShard                = clientOperation("shard-distributor-unpin-shard")
+	ShardDistributorClientOperationDescribeNamespaceControls = clientOperation("shard-distributor-describe-namespace-controls")
+	ShardDistributorExecutorClientOperationHeartbeat         = clientOperation("shard-distributor-executor-heartbeat")
 )
 
 // Pre-defined values for TagIDType
@@ -408,6 +408,14 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
 	// HistoryClientNotifyFailoverMarkersScope tracks RPC calls to history service
 	HistoryClientNotifyFailoverMarkersScope
 	// HistoryClientGetCrossClusterTasksScope tracks RPC calls to history service
@@ -449,6 +457,14 @@ const (
 	MatchingClientUpdateTaskListPartitionConfigScope
 	// MatchingClientRefreshTaskListPartitionConfigScope tracks RPC calls to matching service
 	MatchingClientRefreshTaskListPartitionConfigScope
+	// MatchingClientListTaskListTasksScope tracks RPC calls to matching service
+	MatchingClientListTaskListTasksScope
+	// MatchingClientDeleteTaskListTasksScope tracks RPC calls to matching service
+	MatchingClientDeleteTaskListTasksScope
+	// MatchingClientUpdateTaskListBuildIDCompatibilityScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskListBuildIDCompatibilityScope
+	// MatchingClientGetTaskListBuildIDCompatibilityScope tracks RPC calls to matching service
+	MatchingClientGetTaskListBuildIDCompatibilityScope
 
 	// FrontendClientDeleteDomainScope tracks RPC calls to frontend service
 	FrontendClientDeleteDomainScope
@@ -494,6 +510,14 @@ const (
 	FrontendClientResetStickyTaskListScope
 	// FrontendClientRefreshWorkflowTasksScope tracks RPC calls to frontend service
 	FrontendClientRefreshWorkflowTasksScope
+	// FrontendClientUpdateActivityOptionsScope tracks RPC calls to frontend service
+	FrontendClientUpdateActivityOptionsScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
+	// FrontendClientUpdateTaskListBuildIDCompatibilityScope tracks RPC calls to frontend service
+	FrontendClientUpdateTaskListBuildIDCompatibilityScope
+	// FrontendClientGetTaskListBuildIDCompatibilityScope tracks RPC calls to frontend service
+	FrontendClientGetTaskListBuildIDCompatibilityScope
 	// FrontendClientResetWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientResetWorkflowExecutionScope
 	// FrontendClientRespondActivityTaskCanceledScope tracks RPC calls to frontend service
@@ -603,6 +627,10 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientPauseActivityScope tracks RPC calls to admin service
+	AdminClientPauseActivityScope
+	// AdminClientUnpauseActivityScope tracks RPC calls to admin service
+	AdminClientUnpauseActivityScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
@@ -645,6 +673,10 @@ const (
 	AdminClientUpdateDomainAsyncWorkflowConfiguratonScope
 	// AdminClientUpdateTaskListPartitionConfigScope is the metrics scope for admin.UpdateTaskListPartitionConfig
 	AdminClientUpdateTaskListPartitionConfigScope
+	// AdminClientListTaskListTasksScope is the metrics scope for admin.ListTaskListTasks
+	AdminClientListTaskListTasksScope
+	// AdminClientDeleteTaskListTasksScope is the metrics scope for admin.DeleteTaskListTasks
+	AdminClientDeleteTaskListTasksScope
 
 	// DCRedirectionDeleteDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeleteDomainScope
@@ -740,6 +772,14 @@ const (
 	DCRedirectionGetTaskListsByDomainScope
 	// DCRedirectionRefreshWorkflowTasksScope tracks RPC calls for dc redirection
 	DCRedirectionRefreshWorkflowTasksScope
+	// DCRedirectionUpdateActivityOptionsScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateActivityOptionsScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
+	// DCRedirectionUpdateTaskListBuildIDCompatibilityScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateTaskListBuildIDCompatibilityScope
+	// DCRedirectionGetTaskListBuildIDCompatibilityScope tracks RPC calls for dc redirection
+	DCRedirectionGetTaskListBuildIDCompatibilityScope
 	// DCRedirectionRestartWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionRestartWorkflowExecutionScope
 	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
@@ -793,6 +833,8 @@ const (
 	PersistenceForkHistoryBranchScope
 	// PersistenceDeleteHistoryBranchScope tracks DeleteHistoryBranch calls made by service to persistence layer
 	PersistenceDeleteHistoryBranchScope
+	// PersistenceReencryptHistoryBranchScope tracks ReencryptHistoryBranch calls made by service to persistence layer
+	PersistenceReencryptHistoryBranchScope
 	// PersistenceCompleteForkBranchScope tracks CompleteForkBranch calls made by service to persistence layer
 	PersistenceCompleteForkBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
@@ -933,6 +975,24 @@ const (
 	// ShardDistributorClientWatchNamespaceStateScope tracks WatchNamespaceState calls made by service to shard distributor
 	ShardDistributorClientWatchNamespaceStateScope
 
+	// ShardDistributorClientCordonExecutorScope tracks CordonExecutor calls made by operators to shard distributor
+	ShardDistributorClientCordonExecutorScope
+
+	// ShardDistributorClientUncordonExecutorScope tracks UncordonExecutor calls made by operators to shard distributor
+	ShardDistributorClientUncordonExecutorScope
+
+	// ShardDistributorClientDrainExecutorScope tracks DrainExecutor calls made by operators to shard distributor
+	ShardDistributorClientDrainExecutorScope
+
+	// ShardDistributorClientPinShardScope tracks PinShard calls made by operators to shard distributor
+	ShardDistributorClientPinShardScope
+
+	// ShardDistributorClientUnpinShardScope tracks UnpinShard calls made by operators to shard distributor
+	ShardDistributorClientUnpinShardScope
+
+	// ShardDistributorClientDescribeNamespaceControlsScope tracks DescribeNamespaceControls calls made by operators to shard distributor
+	ShardDistributorClientDescribeNamespaceControlsScope
+
 	// ShardDistributorExecutorClientHeartbeatScope tracks Heartbeat calls made by executor to shard distributor
 	ShardDistributorExecutorClientHeartbeatScope
 
@@ -972,6 +1032,14 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminPauseActivityScope is the metric scope for admin.PauseActivity
+	AdminPauseActivityScope
+	// AdminUnpauseActivityScope is the metric scope for admin.UnpauseActivity
+	AdminUnpauseActivityScope
+	// AdminListTaskListTasksScope is the metric scope for admin.ListTaskListTasks
+	AdminListTaskListTasksScope
+	// AdminDeleteTaskListTasksScope is the metric scope for admin.DeleteTaskListTasks
+	AdminDeleteTaskListTasksScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -1117,6 +1185,14 @@ const (
 	FrontendGetTaskListsByDomainScope
 	// FrontendRefreshWorkflowTasksScope is the metric scope for frontend.RefreshWorkflowTasks
 	FrontendRefreshWorkflowTasksScope
+	// FrontendUpdateActivityOptionsScope is the metric scope for frontend.UpdateActivityOptions
+	FrontendUpdateActivityOptionsScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
+	// FrontendUpdateTaskListBuildIDCompatibilityScope is the metric scope for frontend.UpdateTaskListBuildIDCompatibility
+	FrontendUpdateTaskListBuildIDCompatibilityScope
+	// FrontendGetTaskListBuildIDCompatibilityScope is the metric scope for frontend.GetTaskListBuildIDCompatibility
+	FrontendGetTaskListBuildIDCompatibilityScope
 	// FrontendResetStickyTaskListScope is the metric scope for frontend.ResetStickyTaskList
 	FrontendResetStickyTaskListScope
 	// FrontendListDomainsScope is the metric scope for frontend.ListDomain
@@ -1225,6 +1301,14 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
 	HistoryRefreshWorkflowTasksScope
+	// HistoryPauseActivityScope tracks PauseActivity API calls received by service
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope tracks UnpauseActivity API calls received by service
+	HistoryUnpauseActivityScope
+	// HistoryUpdateActivityOptionsScope tracks UpdateActivityOptions API calls received by service
+	HistoryUpdateActivityOptionsScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
 	// HistoryNotifyFailoverMarkersScope is the scope used by notify failover marker API
 	HistoryNotifyFailoverMarkersScope
 	// HistoryGetCrossClusterTasksScope tracks GetCrossClusterTasks API calls received by service
@@ -1469,6 +1553,14 @@ const (
 	MatchingUpdateTaskListPartitionConfigScope
 	// MatchingRefreshTaskListPartitionConfigScope tracks RefreshTaskListPartitionConfig API calls received by service
 	MatchingRefreshTaskListPartitionConfigScope
+	// MatchingListTaskListTasksScope tracks ListTaskListTasks API calls received by service
+	MatchingListTaskListTasksScope
+	// MatchingDeleteTaskListTasksScope tracks DeleteTaskListTasks API calls received by service
+	MatchingDeleteTaskListTasksScope
+	// MatchingUpdateTaskListBuildIDCompatibilityScope tracks UpdateTaskListBuildIDCompatibility API calls received by service
+	MatchingUpdateTaskListBuildIDCompatibilityScope
+	// MatchingGetTaskListBuildIDCompatibilityScope tracks GetTaskListBuildIDCompatibility API calls received by service
+	MatchingGetTaskListBuildIDCompatibilityScope
 
 	NumMatchingScopes
 )
@@ -1505,6 +1597,8 @@ const (
 	BatcherScope
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope
+	// PayloadScavengerScope is scope used by all metrics emitted by worker.payload.Scavenger module
+	PayloadScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
 	// ShardScannerScope is scope used by all metrics emitted by worker.shardscanner module
@@ -1532,6 +1626,12 @@ const (
 	ShardDistributorWatchNamespaceStateScope
 	ShardDistributorHeartbeatScope
 	ShardDistributorAssignLoopScope
+	ShardDistributorCordonExecutorScope
+	ShardDistributorUncordonExecutorScope
+	ShardDistributorDrainExecutorScope
+	ShardDistributorPinShardScope
+	ShardDistributorUnpinShardScope
+	ShardDistributorDescribeNamespaceControlsScope
 
 	ShardDistributorStoreGetShardOwnerScope
 	ShardDistributorStoreAssignShardScope
@@ -1546,6 +1646,8 @@ const (
 	ShardDistributorStoreSubscribeToExecutorStatusChangesScope
 	ShardDistributorStoreSubscribeToAssignmentChangesScope
 	ShardDistributorStoreDeleteAssignedStatesScope
+	ShardDistributorStoreGetNamespaceControlsScope
+	ShardDistributorStoreUpdateNamespaceControlsScope
 
 	// The scope for the shard distributor executor
 	ShardDistributorExecutorScope
@@ -1638,6 +1740,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		PersistenceReadRawHistoryBranchScope:                     {operation: "ReadHistoryBranch"},
 		PersistenceForkHistoryBranchScope:                        {operation: "ForkHistoryBranch"},
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch"},
+		PersistenceReencryptHistoryBranchScope:                   {operation: "ReencryptHistoryBranch"},
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
 		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches"},
@@ -1702,6 +1805,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:              {operation: "HistoryClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseActivityScope:                     {operation: "HistoryClientPauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                   {operation: "HistoryClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateActivityOptionsScope:             {operation: "HistoryClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:           {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientNotifyFailoverMarkersScope:             {operation: "HistoryClientNotifyFailoverMarkers", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetCrossClusterTasksScope:              {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondCrossClusterTasksCompletedScope: {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1711,18 +1818,22 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryClientWfIDCacheScope:                         {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRatelimitUpdateScope:                   {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 
-		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientAddActivityTaskScope:                {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientAddDecisionTaskScope:                {operation: "MatchingClientAddDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientQueryWorkflowScope:                  {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientRespondQueryTaskCompletedScope:      {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientCancelOutstandingPollScope:          {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientDescribeTaskListScope:               {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientListTaskListPartitionsScope:         {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientGetTaskListsByDomainScope:           {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientUpdateTaskListPartitionConfigScope:  {operation: "MatchingClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientRefreshTaskListPartitionConfigScope: {operation: "MatchingClientRefreshTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientPollForDecisionTaskScope:                {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientPollForActivityTaskScope:                {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientAddDecisionTaskScope:                    {operation: "MatchingClientAddDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientQueryWorkflowScope:                      {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRespondQueryTaskCompletedScope:          {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientUpdateTaskListPartitionConfigScope:      {operation: "MatchingClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRefreshTaskListPartitionConfigScope:     {operation: "MatchingClientRefreshTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientListTaskListTasksScope:                  {operation: "MatchingClientListTaskListTasks", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDeleteTaskListTasksScope:                {operation: "MatchingClientDeleteTaskListTasks", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientUpdateTaskListBuildIDCompatibilityScope: {operation: "MatchingClientUpdateTaskListBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientGetTaskListBuildIDCompatibilityScope:    {operation: "MatchingClientGetTaskListBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 
 		FrontendClientDeleteDomainScope:                          {operation: "FrontendClientDeleteDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                       {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1746,6 +1857,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendClientRequestCancelWorkflowExecutionScope:        {operation: "FrontendClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientResetStickyTaskListScope:                   {operation: "FrontendClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRefreshWorkflowTasksScope:                  {operation: "FrontendClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateActivityOptionsScope:                 {operation: "FrontendClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:               {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateTaskListBuildIDCompatibilityScope:    {operation: "FrontendClientUpdateTaskListBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientGetTaskListBuildIDCompatibilityScope:       {operation: "FrontendClientGetTaskListBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientResetWorkflowExecutionScope:                {operation: "FrontendClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRespondActivityTaskCanceledScope:           {operation: "FrontendClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRespondActivityTaskCanceledByIDScope:       {operation: "FrontendClientRespondActivityTaskCanceledByID", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1794,6 +1909,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPauseActivityScope:                         {operation: "AdminClientPauseActivity", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUnpauseActivityScope:                       {operation: "AdminClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1822,6 +1939,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientGetDomainAsyncWorkflowConfiguratonScope:    {operation: "AdminClientGetDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDomainAsyncWorkflowConfiguratonScope: {operation: "AdminClientUpdateDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateTaskListPartitionConfigScope:         {operation: "AdminClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListTaskListTasksScope:                     {operation: "AdminClientListTaskListTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDeleteTaskListTasksScope:                   {operation: "AdminClientDeleteTaskListTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 
 		DCRedirectionDeleteDomainScope:                          {operation: "DCRedirectionDeleteDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                       {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1870,6 +1989,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		DCRedirectionListTaskListPartitionsScope:                {operation: "DCRedirectionListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetTaskListsByDomainScope:                  {operation: "DCRedirectionGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRefreshWorkflowTasksScope:                  {operation: "DCRedirectionRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateActivityOptionsScope:                 {operation: "DCRedirectionUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:               {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateTaskListBuildIDCompatibilityScope:    {operation: "DCRedirectionUpdateTaskListBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionGetTaskListBuildIDCompatibilityScope:       {operation: "DCRedirectionGetTaskListBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRestartWorkflowExecutionScope:              {operation: "DCRedirectionRestartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionCreateScheduleScope:                        {operation: "DCRedirectionCreateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeScheduleScope:                      {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1957,9 +2080,15 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		P2PRPCPeerChooserScope:       {operation: "P2PRPCPeerChooser"},
 		PartitionConfigProviderScope: {operation: "PartitionConfigProvider"},
 
-		ShardDistributorClientGetShardOwnerScope:       {operation: "ShardDistributorClientGetShardOwner"},
-		ShardDistributorClientWatchNamespaceStateScope: {operation: "ShardDistributorClientWatchNamespaceState"},
-		ShardDistributorExecutorClientHeartbeatScope:   {operation: "ShardDistributorExecutorHeartbeat"},
+		ShardDistributorClientGetShardOwnerScope:             {operation: "ShardDistributorClientGetShardOwner"},
+		ShardDistributorClientWatchNamespaceStateScope:       {operation: "ShardDistributorClientWatchNamespaceState"},
+		ShardDistributorClientCordonExecutorScope:            {operation: "ShardDistributorClientCordonExecutor"},
+		ShardDistributorClientUncordonExecutorScope:          {operation: "ShardDistributorClientUncordonExecutor"},
+		ShardDistributorClientDrainExecutorScope:             {operation: "ShardDistributorClientDrainExecutor"},
+		ShardDistributorClientPinShardScope:                  {operation: "ShardDistributorClientPinShard"},
+		ShardDistributorClientUnpinShardScope:                {operation: "ShardDistributorClientUnpinShard"},
+		ShardDistributorClientDescribeNamespaceControlsScope: {operation: "ShardDistributorClientDescribeNamespaceControls"},
+		ShardDistributorExecutorClientHeartbeatScope:         {operation: "ShardDistributorExecutorHeartbeat"},
 
 		LoadBalancerScope: {operation: "RRLoadBalancer"},
 
@@ -1989,6 +2118,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminGetDLQReplicationMessagesScope:         {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                     {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:              {operation: "RefreshWorkflowTasks"},
+		AdminPauseActivityScope:                     {operation: "PauseActivity"},
+		AdminUnpauseActivityScope:                   {operation: "UnpauseActivity"},
+		AdminListTaskListTasksScope:                 {operation: "ListTaskListTasks"},
+		AdminDeleteTaskListTasksScope:               {operation: "DeleteTaskListTasks"},
 		AdminResendReplicationTasksScope:            {operation: "ResendReplicationTasks"},
 		AdminGetCrossClusterTasksScope:              {operation: "AdminGetCrossClusterTasks"},
 		AdminRespondCrossClusterTasksCompletedScope: {operation: "AdminRespondCrossClusterTasksCompleted"},
@@ -2051,6 +2184,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendListTaskListPartitionsScope:                {operation: "FrontendListTaskListPartitions"},
 		FrontendGetTaskListsByDomainScope:                  {operation: "FrontendGetTaskListsByDomain"},
 		FrontendRefreshWorkflowTasksScope:                  {operation: "FrontendRefreshWorkflowTasks"},
+		FrontendUpdateActivityOptionsScope:                 {operation: "UpdateActivityOptions"},
+		FrontendUpdateWorkflowExecutionScope:               {operation: "UpdateWorkflowExecution"},
+		FrontendUpdateTaskListBuildIDCompatibilityScope:    {operation: "UpdateTaskListBuildIDCompatibility"},
+		FrontendGetTaskListBuildIDCompatibilityScope:       {operation: "GetTaskListBuildIDCompatibility"},
 		FrontendDescribeTaskListScope:                      {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:                   {operation: "ResetStickyTaskList"},
 		FrontendCreateScheduleScope:                        {operation: "CreateSchedule"},
@@ -2107,6 +2244,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryShardControllerScope:                                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                                       {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                                {operation: "RefreshWorkflowTasks"},
+		HistoryPauseActivityScope:                                       {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                                     {operation: "UnpauseActivity"},
+		HistoryUpdateActivityOptionsScope:                               {operation: "UpdateActivityOptions"},
+		HistoryUpdateWorkflowExecutionScope:                             {operation: "UpdateWorkflowExecution"},
 		HistoryNotifyFailoverMarkersScope:                               {operation: "NotifyFailoverMarkers"},
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
@@ -2211,21 +2352,25 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope:            {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope:            {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:                {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:                {operation: "AddDecisionTask"},
-		MatchingAddTaskScope:                        {operation: "AddTask"},
-		MatchingTaskListMgrScope:                    {operation: "TaskListMgr"},
-		MatchingAdaptiveScalerScope:                 {operation: "adaptivescaler"},
-		MatchingQueryWorkflowScope:                  {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope:      {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:          {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskListScope:               {operation: "DescribeTaskList"},
-		MatchingListTaskListPartitionsScope:         {operation: "ListTaskListPartitions"},
-		MatchingGetTaskListsByDomainScope:           {operation: "GetTaskListsByDomain"},
-		MatchingUpdateTaskListPartitionConfigScope:  {operation: "UpdateTaskListPartitionConfig"},
-		MatchingRefreshTaskListPartitionConfigScope: {operation: "RefreshTaskListPartitionConfig"},
+		MatchingPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:                {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:                    {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:                    {operation: "AddDecisionTask"},
+		MatchingAddTaskScope:                            {operation: "AddTask"},
+		MatchingTaskListMgrScope:                        {operation: "TaskListMgr"},
+		MatchingAdaptiveScalerScope:                     {operation: "adaptivescaler"},
+		MatchingQueryWorkflowScope:                      {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:          {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:              {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskListScope:                   {operation: "DescribeTaskList"},
+		MatchingListTaskListPartitionsScope:             {operation: "ListTaskListPartitions"},
+		MatchingGetTaskListsByDomainScope:               {operation: "GetTaskListsByDomain"},
+		MatchingUpdateTaskListPartitionConfigScope:      {operation: "UpdateTaskListPartitionConfig"},
+		MatchingRefreshTaskListPartitionConfigScope:     {operation: "RefreshTaskListPartitionConfig"},
+		MatchingListTaskListTasksScope:                  {operation: "ListTaskListTasks"},
+		MatchingDeleteTaskListTasksScope:                {operation: "DeleteTaskListTasks"},
+		MatchingUpdateTaskListBuildIDCompatibilityScope: {operation: "UpdateTaskListBuildIDCompatibility"},
+		MatchingGetTaskListBuildIDCompatibilityScope:    {operation: "GetTaskListBuildIDCompatibility"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -2245,6 +2390,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		CheckDataCorruptionWorkflowScope:       {operation: "CheckDataCorruptionWorkflow"},
 		ExecutionsFixerScope:                   {operation: "ExecutionsFixer"},
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
+		PayloadScavengerScope:                  {operation: "payloadscavenger"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
@@ -2258,6 +2404,12 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		ShardDistributorWatchNamespaceStateScope:                   {operation: "WatchNamespaceState"},
 		ShardDistributorHeartbeatScope:                             {operation: "ExecutorHeartbeat"},
 		ShardDistributorAssignLoopScope:                            {operation: "ShardAssignLoop"},
+		ShardDistributorCordonExecutorScope:                        {operation: "CordonExecutor"},
+		ShardDistributorUncordonExecutorScope:                      {operation: "UncordonExecutor"},
+		ShardDistributorDrainExecutorScope:                         {operation: "DrainExecutor"},
+		ShardDistributorPinShardScope:                              {operation: "PinShard"},
+		ShardDistributorUnpinShardScope:                            {operation: "UnpinShard"},
+		ShardDistributorDescribeNamespaceControlsScope:             {operation: "DescribeNamespaceControls"},
 		ShardDistributorExecutorScope:                              {operation: "Executor"},
 		ShardDistributorStoreGetShardOwnerScope:                    {operation: "StoreGetShardOwner"},
 		ShardDistributorStoreAssignShardScope:                      {operation: "StoreAssignShard"},
@@ -2272,6 +2424,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		ShardDistributorStoreSubscribeToExecutorStatusChangesScope: {operation: "StoreSubscribeToExecutorStatusChanges"},
 		ShardDistributorStoreSubscribeToAssignmentChangesScope:     {operation: "StoreSubscribeToAssignmentChanges"},
 		ShardDistributorStoreDeleteAssignedStatesScope:             {operation: "StoreDeleteAssignedStates"},
+		ShardDistributorStoreGetNamespaceControlsScope:             {operation: "StoreGetNamespaceControls"},
+		ShardDistributorStoreUpdateNamespaceControlsScope:          {operation: "StoreUpdateNamespaceControls"},
 		ShardDistributorWatchScope:                                 {operation: "Watch"},
 		ShardDistributorLeaderScope:                                {operation: "Leader"},
 	},
@@ -2683,6 +2837,7 @@ const (
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
+	DecisionTypeUpsertWorkflowMemoCounter
 	EmptyCompletionDecisionsCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
@@ -2897,6 +3052,9 @@ const (
 	QueryBeforeFirstDecisionCount
 	QueryBufferExceededCount
 	QueryRegistryInvalidStateCount
+	UpdateBufferExceededCount
+	UpdateRegistryInvalidStateCount
+	WorkflowUpdateTimeoutCount
 	WorkerNotSupportsConsistentQueryCount
 	DecisionStartToCloseTimeoutOverrideCount
 	ReplicationTaskCleanupCount
@@ -3029,6 +3187,9 @@ const (
 	IsolationGroupUpscale
 	IsolationGroupDownscale
 	PartitionDrained
+	FairnessKeyBacklogAgePerTaskList
+	VersionedTaskPutBackPerTaskList
+	VersionedTaskFallbackPerTaskList
 
 	NumMatchingMetrics
 )
@@ -3088,6 +3249,9 @@ const (
 	HistoryScavengerSuccessCount
 	HistoryScavengerErrorCount
 	HistoryScavengerSkipCount
+	PayloadScavengerDeletedCount
+	PayloadScavengerErrorCount
+	PayloadScavengerSkipCount
 	DomainReplicationEnqueueDLQCount
 	ScannerExecutionsGauge
 	ScannerCorruptedGauge
@@ -3165,6 +3329,7 @@ const (
 	ShardDistributorErrContextTimeoutCounter
 	ShardDistributorErrNamespaceNotFound
 	ShardDistributorErrShardNotFound
+	ShardDistributorErrBadRequest
 
 	ShardDistributorAssignLoopNumRebalancedShards
 	ShardDistributorAssignLoopShardRebalanceLatency
@@ -3199,6 +3364,12 @@ const (
 	ShardDistributorAssignLoopDeletedShards
 	// ShardDistributorAssignLoopMovedShardLoad tracks the load of a shard that was moved due to load rebalancing
 	ShardDistributorAssignLoopMovedShardLoad
+	// ShardDistributorAssignLoopPlacementMoves counts the number of shards moved by a placement strategy
+	ShardDistributorAssignLoopPlacementMoves
+	// ShardDistributorAssignLoopPlacementDryRunMoves counts the number of shards a placement strategy in dry-run mode would move
+	ShardDistributorAssignLoopPlacementDryRunMoves
+	// ShardDistributorAssignLoopDrainMoves counts the number of shards moved away from draining executors
+	ShardDistributorAssignLoopDrainMoves
 
 	// ShardDistributorIsLeader reports whether this instance is currently the leader (1) or not (0) for a namespace
 	ShardDistributorIsLeader
@@ -3625,6 +3796,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		DecisionTypeContinueAsNewCounter:                              {metricName: "continue_as_new_decision", metricType: Counter},
 		DecisionTypeSignalExternalWorkflowCounter:                     {metricName: "signal_external_workflow_decision", metricType: Counter},
 		DecisionTypeUpsertWorkflowSearchAttributesCounter:             {metricName: "upsert_workflow_search_attributes_decision", metricType: Counter},
+		DecisionTypeUpsertWorkflowMemoCounter:                         {metricName: "upsert_workflow_memo_decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:                              {metricName: "child_workflow_decision", metricType: Counter},
 		EmptyCompletionDecisionsCounter:                               {metricName: "empty_completion_decisions", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                            {metricName: "multiple_completion_decisions", metricType: Counter},
@@ -3830,6 +4002,9 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		QueryBeforeFirstDecisionCount:                                 {metricName: "query_before_first_decision", metricType: Counter},
 		QueryBufferExceededCount:                                      {metricName: "query_buffer_exceeded", metricType: Counter},
 		QueryRegistryInvalidStateCount:                                {metricName: "query_registry_invalid_state", metricType: Counter},
+		UpdateBufferExceededCount:                                     {metricName: "update_buffer_exceeded", metricType: Counter},
+		UpdateRegistryInvalidStateCount:                               {metricName: "update_registry_invalid_state", metricType: Counter},
+		WorkflowUpdateTimeoutCount:                                    {metricName: "workflow_update_timeout", metricType: Counter},
 		WorkerNotSupportsConsistentQueryCount:                         {metricName: "worker_not_supports_consistent_query", metricType: Counter},
 		DecisionStartToCloseTimeoutOverrideCount:                      {metricName: "decision_start_to_close_timeout_overrides", metricType: Counter},
 		ReplicationTaskCleanupCount:                                   {metricName: "replication_task_cleanup_count", metricType: Counter},
@@ -3958,6 +4133,9 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		IsolationGroupUpscale:                                   {metricName: "ig_upscale_per_tl", metricRollupName: "ig_upscale"},
 		IsolationGroupDownscale:                                 {metricName: "ig_downscale_per_tl", metricRollupName: "ig_downscale"},
 		IsolationGroupPartitionsGauge:                           {metricName: "ig_partitions_per_tl", metricType: Gauge},
+		FairnessKeyBacklogAgePerTaskList:                        {metricName: "fairness_key_backlog_age_per_tl", metricRollupName: "fairness_key_backlog_age", metricType: Timer},
+		VersionedTaskPutBackPerTaskList:                         {metricName: "versioned_task_put_back_per_tl", metricRollupName: "versioned_task_put_back"},
+		VersionedTaskFallbackPerTaskList:                        {metricName: "versioned_task_fallback_per_tl", metricRollupName: "versioned_task_fallback"},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -4013,6 +4191,9 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		HistoryScavengerSuccessCount:                  {metricName: "scavenger_success", metricType: Counter},
 		HistoryScavengerErrorCount:                    {metricName: "scavenger_errors", metricType: Counter},
 		HistoryScavengerSkipCount:                     {metricName: "scavenger_skips", metricType: Counter},
+		PayloadScavengerDeletedCount:                  {metricName: "payload_scavenger_deleted", metricType: Counter},
+		PayloadScavengerErrorCount:                    {metricName: "payload_scavenger_errors", metricType: Counter},
+		PayloadScavengerSkipCount:                     {metricName: "payload_scavenger_skips", metricType: Counter},
 		DomainReplicationEnqueueDLQCount:              {metricName: "domain_replication_dlq_enqueue_requests", metricType: Counter},
 		ScannerExecutionsGauge:                        {metricName: "scanner_executions", metricType: Gauge},
 		ScannerCorruptedGauge:                         {metricName: "scanner_corrupted", metricType: Gauge},
@@ -4067,6 +4248,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		ShardDistributorLatency:                         {metricName: "shard_distributor_latency", metricType: Timer},
 		ShardDistributorErrNamespaceNotFound:            {metricName: "shard_distributor_err_namespace_not_found", metricType: Counter},
 		ShardDistributorErrShardNotFound:                {metricName: "shard_distributor_err_shard_not_found", metricType: Counter},
+		ShardDistributorErrBadRequest:                   {metricName: "shard_distributor_err_bad_request", metricType: Counter},
 		ShardDistributorAssignLoopShardRebalanceLatency: {metricName: "shard_distrubutor_shard_assign_latency", metricType: Histogram},
 		ShardDistributorAssignLoopNumRebalancedShards:   {metricName: "shard_distributor_shard_assign_reassigned_shards", metricType: Gauge},
 		ShardDistributorAssignLoopAttempts:              {metricName: "shard_distrubutor_shard_assign_attempt", metricType: Counter},
@@ -4088,9 +4270,12 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		ShardDistributorWatchProcessingLatency: {metricName: "shard_distributor_watch_processing_latency", metricType: Histogram, buckets: Default1ms100s.buckets()},
 		ShardDistributorWatchEventsReceived:    {metricName: "shard_distributor_watch_events_received", metricType: Counter},
 
-		ShardDistributorAssignLoopLoadBasedMoves: {metricName: "shard_distributor_shard_assign_load_based_moves", metricType: Counter},
-		ShardDistributorAssignLoopDeletedShards:  {metricName: "shard_distributor_shard_assign_deleted_shards", metricType: Gauge},
-		ShardDistributorAssignLoopMovedShardLoad: {metricName: "shard_distributor_shard_assign_moved_shard_load", metricType: Gauge},
+		ShardDistributorAssignLoopLoadBasedMoves:       {metricName: "shard_distributor_shard_assign_load_based_moves", metricType: Counter},
+		ShardDistributorAssignLoopDeletedShards:        {metricName: "shard_distributor_shard_assign_deleted_shards", metricType: Gauge},
+		ShardDistributorAssignLoopMovedShardLoad:       {metricName: "shard_distributor_shard_assign_moved_shard_load", metricType: Gauge},
+		ShardDistributorAssignLoopPlacementMoves:       {metricName: "shard_distributor_shard_assign_placement_moves", metricType: Counter},
+		ShardDistributorAssignLoopPlacementDryRunMoves: {metricName: "shard_distributor_shard_assign_placement_dry_run_moves", metricType: Counter},
+		ShardDistributorAssignLoopDrainMoves:           {metricName: "shard_distributor_shard_assign_drain_moves", metricType: Counter},
 
 		ShardDistributorIsLeader: {metricName: "shard_distributor_is_leader", metricType: Gauge},
 	},
@@ -73,6 +73,7 @@ const (
 	isRetry                   = "is_retry"
 	queryConsistencyLevel     = "query_consistency_level"
 	budgetManagerName         = "budget_manager_name"
+	fairnessKey               = "fairness_key"
 
 	// limiter-side tags
 	globalRatelimitKey            = "global_ratelimit_key"
@@ -332,6 +333,11 @@ func IsolationGroupTag(group string) Tag {
 	return simpleMetric{key: isolationGroup, value: sanitizer.Value(group)}
 }
 
+// FairnessKeyTag returns a new fairness key tag
+func FairnessKeyTag(key string) Tag {
+	return metricWithUnknown(fairnessKey, sanitizer.Value(key))
+}
+
 func IsolationLeakCause(cause string) Tag {
 	return simpleMetric{key: leakCause, value: sanitizer.Value(cause)}
 }
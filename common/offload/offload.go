@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package offload implements the claim-check pattern for large payloads: payloads above a size threshold
+// are stored in the blobstore and replaced with a small reference, which is persisted in history instead.
+// References are resolved back into payloads by the frontend in the responses of
+// GetWorkflowExecutionHistory, including archived histories, PollForDecisionTask and PollForActivityTask.
+// Every other read path, such as raw history reads, replication, queries and DescribeWorkflowExecution,
+// returns the references as they are persisted.
+//
+// References are only resolved for the domain and the workflow the payload was offloaded for, and payloads
+// of the clients which look like references are rejected, so that a reference can not be forged.
+//
+// Offloaded payloads live as long as their workflow: the payload scanner deletes them once
+// no execution of the workflow is left, after it has been deleted by retention. Payloads of domains
+// with history archival enabled are kept for an additional archival window past the retention, after which
+// archived histories return the references of the deleted payloads as they are persisted.
+package offload
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// KeyPrefix prefixes the blobstore keys of all offloaded payloads
+	KeyPrefix = "offloaded-payload."
+
+	// TagDomainID is the blob tag holding the ID of the domain of the payload
+	TagDomainID = "domainID"
+	// TagWorkflowID is the blob tag holding the ID of the workflow the payload belongs to
+	TagWorkflowID = "workflowID"
+)
+
+// referenceMagic starts every reference. It is not valid JSON nor a valid thrift payload,
+// so that it can not be confused with the payloads produced by the clients.
+var referenceMagic = []byte("\x00cadence-offloaded-payload\x00")
+
+type (
+	// Reference is stored in place of an offloaded payload
+	Reference struct {
+		Key  string `json:"key"`
+		Size int    `json:"size"`
+	}
+
+	// Key holds the information encoded in the blobstore key of an offloaded payload
+	Key struct {
+		DomainID   string
+		WorkflowID string
+		CreatedAt  time.Time
+	}
+
+	// Offloader stores payloads in a blobstore and resolves their references
+	Offloader struct {
+		client     blobstore.Client
+		timeSource clock.TimeSource
+	}
+)
+
+// NewOffloader creates a new Offloader storing payloads in the given blobstore
+func NewOffloader(client blobstore.Client, timeSource clock.TimeSource) *Offloader {
+	return &Offloader{
+		client:     client,
+		timeSource: timeSource,
+	}
+}
+
+// Offload stores the payload in the blobstore and returns the reference to persist in its place
+func (o *Offloader) Offload(ctx context.Context, domainID, workflowID string, payload []byte) ([]byte, error) {
+	if err := ValidatePayload(payload); err != nil {
+		return nil, err
+	}
+	key := newKey(domainID, workflowID, o.timeSource.Now())
+	_, err := o.client.Put(ctx, &blobstore.PutRequest{
+		Key: key,
+		Blob: blobstore.Blob{
+			Tags: map[string]string{
+				TagDomainID:   domainID,
+				TagWorkflowID: workflowID,
+			},
+			Body: payload,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to offload payload: %w", err)
+	}
+	return encodeReference(&Reference{Key: key, Size: len(payload)})
+}
+
+// Rehydrate returns the payload a reference of the workflow points to, or the payload itself if it is not a reference
+func (o *Offloader) Rehydrate(ctx context.Context, domainID, workflowID string, payload []byte) ([]byte, error) {
+	if !IsReference(payload) {
+		return payload, nil
+	}
+	ref, err := ParseReference(payload)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if key.DomainID != domainID || key.WorkflowID != workflowID {
+		return nil, fmt.Errorf("offloaded payload %v does not belong to workflow %v of domain %v", ref.Key, workflowID, domainID)
+	}
+	resp, err := o.client.Get(ctx, &blobstore.GetRequest{Key: ref.Key})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load offloaded payload %v: %w", ref.Key, err)
+	}
+	if len(resp.Blob.Body) != ref.Size {
+		return nil, fmt.Errorf("offloaded payload %v has size %v, expected %v", ref.Key, len(resp.Blob.Body), ref.Size)
+	}
+	return resp.Blob.Body, nil
+}
+
+// RehydrateHistory replaces the references in the payloads of the history events of the workflow
+// with the payloads they point to
+func (o *Offloader) RehydrateHistory(ctx context.Context, domainID, workflowID string, history *types.History) error {
+	return o.rehydrateHistory(ctx, domainID, workflowID, history, false)
+}
+
+// RehydrateArchivedHistory replaces the references in the payloads of the archived history events of the workflow
+// with the payloads they point to. References to payloads deleted after the archival window are left as they are.
+func (o *Offloader) RehydrateArchivedHistory(ctx context.Context, domainID, workflowID string, history *types.History) error {
+	return o.rehydrateHistory(ctx, domainID, workflowID, history, true)
+}
+
+func (o *Offloader) rehydrateHistory(ctx context.Context, domainID, workflowID string, history *types.History, keepDeleted bool) error {
+	if history == nil {
+		return nil
+	}
+	for _, event := range history.Events {
+		var payload *[]byte
+		switch {
+		case event.WorkflowExecutionStartedEventAttributes != nil:
+			payload = &event.WorkflowExecutionStartedEventAttributes.Input
+		case event.WorkflowExecutionSignaledEventAttributes != nil:
+			payload = &event.WorkflowExecutionSignaledEventAttributes.Input
+		case event.WorkflowExecutionContinuedAsNewEventAttributes != nil:
+			payload = &event.WorkflowExecutionContinuedAsNewEventAttributes.Input
+		case event.ActivityTaskScheduledEventAttributes != nil:
+			payload = &event.ActivityTaskScheduledEventAttributes.Input
+		case event.ActivityTaskCompletedEventAttributes != nil:
+			payload = &event.ActivityTaskCompletedEventAttributes.Result
+		default:
+			continue
+		}
+		rehydrated, err := o.Rehydrate(ctx, domainID, workflowID, *payload)
+		if err != nil {
+			var notExists *types.EntityNotExistsError
+			if keepDeleted && errors.As(err, &notExists) {
+				continue
+			}
+			return err
+		}
+		*payload = rehydrated
+	}
+	return nil
+}
+
+// ValidatePayload returns an error if a payload of a client could be mistaken for a reference
+func ValidatePayload(payload []byte) error {
+	if IsReference(payload) {
+		return &types.BadRequestError{Message: "payload must not start with the prefix of the references to offloaded payloads"}
+	}
+	return nil
+}
+
+// IsReference returns whether the payload is a reference to an offloaded payload
+func IsReference(payload []byte) bool {
+	return bytes.HasPrefix(payload, referenceMagic)
+}
+
+// ParseReference decodes a reference to an offloaded payload
+func ParseReference(payload []byte) (*Reference, error) {
+	if !IsReference(payload) {
+		return nil, fmt.Errorf("payload is not a reference to an offloaded payload")
+	}
+	var ref Reference
+	if err := json.Unmarshal(payload[len(referenceMagic):], &ref); err != nil {
+		return nil, fmt.Errorf("invalid offloaded payload reference: %w", err)
+	}
+	return &ref, nil
+}
+
+// ParseKey returns the information encoded in the key of an offloaded payload
+func ParseKey(key string) (*Key, error) {
+	parts := strings.Split(strings.TrimPrefix(key, KeyPrefix), ".")
+	if !strings.HasPrefix(key, KeyPrefix) || len(parts) != 4 {
+		return nil, fmt.Errorf("invalid offloaded payload key %q", key)
+	}
+	seconds, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offloaded payload key %q: %w", key, err)
+	}
+	workflowID, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid offloaded payload key %q: %w", key, err)
+	}
+	return &Key{
+		DomainID:   parts[0],
+		WorkflowID: string(workflowID),
+		CreatedAt:  time.Unix(seconds, 0),
+	}, nil
+}
+
+// newKey encodes the workflow ID, which can contain any character, so that the payload scanner
+// does not have to read the payload to find its workflow
+func newKey(domainID, workflowID string, createdAt time.Time) string {
+	return fmt.Sprintf("%v%v.%v.%v.%v", KeyPrefix, domainID, createdAt.Unix(), base64.RawURLEncoding.EncodeToString([]byte(workflowID)), uuid.New())
+}
+
+func encodeReference(ref *Reference) ([]byte, error) {
+	data, err := json.Marshal(ref)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, referenceMagic...), data...), nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package offload
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestOffloadAndRehydrate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	client := blobstore.NewMockClient(gomock.NewController(t))
+	offloader := NewOffloader(client, clock.NewMockedTimeSourceAt(now))
+
+	payload := []byte(strings.Repeat("a", 1024))
+	var stored blobstore.Blob
+	var storedKey string
+	client.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+		storedKey, stored = request.Key, request.Blob
+		return &blobstore.PutResponse{}, nil
+	})
+	ref, err := offloader.Offload(context.Background(), "domain-id", "workflow-id", payload)
+	require.NoError(t, err)
+	assert.True(t, IsReference(ref))
+	assert.Less(t, len(ref), len(payload))
+	assert.Equal(t, map[string]string{TagDomainID: "domain-id", TagWorkflowID: "workflow-id"}, stored.Tags)
+	assert.Equal(t, payload, stored.Body)
+
+	key, err := ParseKey(storedKey)
+	require.NoError(t, err)
+	assert.Equal(t, &Key{DomainID: "domain-id", WorkflowID: "workflow-id", CreatedAt: now}, key)
+
+	// payloads of the clients can not be references
+	_, err = offloader.Offload(context.Background(), "domain-id", "workflow-id", ref)
+	assert.IsType(t, &types.BadRequestError{}, err)
+
+	client.EXPECT().Get(gomock.Any(), &blobstore.GetRequest{Key: storedKey}).Return(&blobstore.GetResponse{Blob: stored}, nil)
+	rehydrated, err := offloader.Rehydrate(context.Background(), "domain-id", "workflow-id", ref)
+	require.NoError(t, err)
+	assert.Equal(t, payload, rehydrated)
+
+	// references are only resolved for the workflow they were offloaded for
+	_, err = offloader.Rehydrate(context.Background(), "other-domain-id", "workflow-id", ref)
+	assert.ErrorContains(t, err, "does not belong to workflow workflow-id of domain other-domain-id")
+	_, err = offloader.Rehydrate(context.Background(), "domain-id", "other-workflow-id", ref)
+	assert.ErrorContains(t, err, "does not belong to workflow other-workflow-id of domain domain-id")
+
+	// payloads which are not references are returned as is
+	plain, err := offloader.Rehydrate(context.Background(), "domain-id", "workflow-id", []byte("small"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("small"), plain)
+}
+
+func TestValidatePayload(t *testing.T) {
+	assert.NoError(t, ValidatePayload([]byte("payload")))
+	assert.NoError(t, ValidatePayload(nil))
+	assert.IsType(t, &types.BadRequestError{}, ValidatePayload(append(append([]byte{}, referenceMagic...), "{}"...)))
+}
+
+func TestOffloadErrors(t *testing.T) {
+	client := blobstore.NewMockClient(gomock.NewController(t))
+	offloader := NewOffloader(client, clock.NewMockedTimeSource())
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil, errors.New("put failed"))
+	_, err := offloader.Offload(context.Background(), "domain-id", "workflow-id", []byte("payload"))
+	assert.ErrorContains(t, err, "put failed")
+
+	ref, err := encodeReference(&Reference{Key: newKey("domain-id", "workflow-id", time.Now()), Size: 3})
+	require.NoError(t, err)
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("get failed"))
+	_, err = offloader.Rehydrate(context.Background(), "domain-id", "workflow-id", ref)
+	assert.ErrorContains(t, err, "get failed")
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&blobstore.GetResponse{Blob: blobstore.Blob{Body: []byte("ab")}}, nil)
+	_, err = offloader.Rehydrate(context.Background(), "domain-id", "workflow-id", ref)
+	assert.ErrorContains(t, err, "has size 2, expected 3")
+
+	_, err = offloader.Rehydrate(context.Background(), "domain-id", "workflow-id", append(append([]byte{}, referenceMagic...), "{"...))
+	assert.ErrorContains(t, err, "invalid offloaded payload reference")
+
+	// the key must be a key of an offloaded payload
+	forged, err := encodeReference(&Reference{Key: "some-other-blob", Size: 3})
+	require.NoError(t, err)
+	_, err = offloader.Rehydrate(context.Background(), "domain-id", "workflow-id", forged)
+	assert.ErrorContains(t, err, "invalid offloaded payload key")
+}
+
+func TestRehydrateHistory(t *testing.T) {
+	client := blobstore.NewMockClient(gomock.NewController(t))
+	offloader := NewOffloader(client, clock.NewMockedTimeSource())
+
+	bodies := make(map[string][]byte)
+	ref := func(name string) []byte {
+		key := newKey("domain-id", "workflow-id", time.Now())
+		bodies[key] = []byte(name + "-body")
+		data, err := encodeReference(&Reference{Key: key, Size: len(bodies[key])})
+		require.NoError(t, err)
+		return data
+	}
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+		return &blobstore.GetResponse{Blob: blobstore.Blob{Body: bodies[request.Key]}}, nil
+	}).Times(3)
+
+	history := &types.History{Events: []*types.HistoryEvent{
+		{
+			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: ref("input")},
+		},
+		{
+			EventType:                                types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: []byte("plain")},
+		},
+		{
+			EventType:                            types.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{Result: ref("result")},
+		},
+		{
+			EventType:                                types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: ref("signal")},
+		},
+		{
+			EventType:                            types.EventTypeDecisionTaskScheduled.Ptr(),
+			DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{},
+		},
+	}}
+	require.NoError(t, offloader.RehydrateHistory(context.Background(), "domain-id", "workflow-id", history))
+	assert.Equal(t, []byte("input-body"), history.Events[0].WorkflowExecutionStartedEventAttributes.Input)
+	assert.Equal(t, []byte("plain"), history.Events[1].WorkflowExecutionSignaledEventAttributes.Input)
+	assert.Equal(t, []byte("result-body"), history.Events[2].ActivityTaskCompletedEventAttributes.Result)
+	assert.Equal(t, []byte("signal-body"), history.Events[3].WorkflowExecutionSignaledEventAttributes.Input)
+
+	assert.NoError(t, offloader.RehydrateHistory(context.Background(), "domain-id", "workflow-id", nil))
+}
+
+func TestRehydrateArchivedHistory(t *testing.T) {
+	client := blobstore.NewMockClient(gomock.NewController(t))
+	offloader := NewOffloader(client, clock.NewMockedTimeSource())
+
+	ref, err := encodeReference(&Reference{Key: newKey("domain-id", "workflow-id", time.Now()), Size: 3})
+	require.NoError(t, err)
+	history := func() *types.History {
+		return &types.History{Events: []*types.HistoryEvent{{
+			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: ref},
+		}}}
+	}
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(2)
+
+	// references to payloads deleted after the archival window are kept in archived histories
+	archived := history()
+	require.NoError(t, offloader.RehydrateArchivedHistory(context.Background(), "domain-id", "workflow-id", archived))
+	assert.Equal(t, ref, archived.Events[0].WorkflowExecutionStartedEventAttributes.Input)
+
+	assert.Error(t, offloader.RehydrateHistory(context.Background(), "domain-id", "workflow-id", history()))
+}
+
+func TestParseKey(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	for _, workflowID := range []string{"workflow-id", "workflow.with.dots", "ünïcode/and spaces", ""} {
+		key, err := ParseKey(newKey("domain-id", workflowID, now))
+		require.NoError(t, err)
+		assert.Equal(t, &Key{DomainID: "domain-id", WorkflowID: workflowID, CreatedAt: now}, key)
+	}
+
+	_, err := ParseKey("unrelated-key")
+	assert.Error(t, err)
+	_, err = ParseKey(KeyPrefix + "domain-id.not-a-time.d29ya2Zsb3ctaWQ.uuid")
+	assert.Error(t, err)
+	_, err = ParseKey(KeyPrefix + "domain-id.1.not-base64!.uuid")
+	assert.Error(t, err)
+	_, err = ParseKey(KeyPrefix + "domain-id.1")
+	assert.Error(t, err)
+}
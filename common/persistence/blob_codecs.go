@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/codec/zstd"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence/encryption"
+)
+
+// BlobCodecs are the codecs of the blob encodings which are only known to persistence.
+// They are created once from the static persistence config, and passed to the serializers
+// and managers reading and writing such blobs.
+type BlobCodecs struct {
+	// Zstd compresses blobs of the thriftrw_zstd encoding.
+	Zstd *zstd.Codec
+	// Encryptor seals blobs of the encrypted encoding, it is nil when persistence encryption is not configured.
+	Encryptor *encryption.Encryptor
+}
+
+var (
+	errZstdNotConfigured       = errors.New("zstd compression is not configured")
+	errEncryptionNotConfigured = errors.New("persistence encryption is not configured")
+)
+
+// Compress returns the thriftrw blob compressed with zstd.
+func (c BlobCodecs) Compress(blob *DataBlob) (*DataBlob, error) {
+	if blob.Encoding != constants.EncodingTypeThriftRW {
+		return nil, NewCadenceSerializationError(fmt.Sprintf("unable to compress %v blob", blob.Encoding))
+	}
+	data, err := c.compress(blob.Data)
+	if err != nil {
+		return nil, NewCadenceSerializationError(fmt.Sprintf("unable to compress %v blob: %v", blob.Encoding, err))
+	}
+	return NewDataBlob(data, constants.EncodingTypeThriftRWZstd), nil
+}
+
+// Decompress returns the blob with its zstd compression removed, or the blob itself if it is not zstd compressed.
+func (c BlobCodecs) Decompress(blob *DataBlob) (*DataBlob, error) {
+	if blob == nil || blob.Encoding != constants.EncodingTypeThriftRWZstd {
+		return blob, nil
+	}
+	data, err := c.decompress(blob.Data)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("unable to decompress %v blob: %v", blob.Encoding, err))
+	}
+	return NewDataBlob(data, constants.EncodingTypeThriftRW), nil
+}
+
+// Encrypt returns the blob sealed in an envelope with the data key of the given domain.
+func (c BlobCodecs) Encrypt(domainID string, blob *DataBlob) (*DataBlob, error) {
+	if c.Encryptor == nil {
+		return nil, errEncryptionNotConfigured
+	}
+	data, err := c.Encryptor.Encrypt(domainID, blob.Encoding, blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	return NewDataBlob(data, constants.EncodingTypeEncrypted), nil
+}
+
+// Decrypt returns the blob with its envelope encryption removed, or the blob itself if it is not encrypted.
+func (c BlobCodecs) Decrypt(blob *DataBlob) (*DataBlob, error) {
+	if blob == nil || blob.Encoding != constants.EncodingTypeEncrypted {
+		return blob, nil
+	}
+	if c.Encryptor == nil {
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("unable to decrypt blob: %v", errEncryptionNotConfigured))
+	}
+	data, encoding, err := c.Encryptor.Decrypt(blob.Data)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("unable to decrypt blob: %v", err))
+	}
+	return NewDataBlob(data, encoding), nil
+}
+
+// Decode returns the blob with its encryption and compression removed,
+// so that it is in an encoding known outside of persistence.
+func (c BlobCodecs) Decode(blob *DataBlob) (*DataBlob, error) {
+	decrypted, err := c.Decrypt(blob)
+	if err != nil {
+		return nil, err
+	}
+	return c.Decompress(decrypted)
+}
+
+func (c BlobCodecs) compress(data []byte) ([]byte, error) {
+	if c.Zstd == nil {
+		return nil, errZstdNotConfigured
+	}
+	return c.Zstd.Compress(data), nil
+}
+
+func (c BlobCodecs) decompress(data []byte) ([]byte, error) {
+	if c.Zstd == nil {
+		return nil, errZstdNotConfigured
+	}
+	return c.Zstd.Decompress(data)
+}
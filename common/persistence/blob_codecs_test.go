@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/codec/zstd"
+	"github.com/uber/cadence/common/constants"
+)
+
+func newTestBlobCodecs(t testing.TB) BlobCodecs {
+	codec, err := zstd.NewCodec()
+	require.NoError(t, err)
+	return BlobCodecs{Zstd: codec}
+}
+
+func TestBlobCodecsCompression(t *testing.T) {
+	codecs := newTestBlobCodecs(t)
+	uncompressed := NewDataBlob([]byte("some data"), constants.EncodingTypeThriftRW)
+
+	compressed, err := codecs.Compress(uncompressed)
+	require.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeThriftRWZstd, compressed.Encoding)
+
+	decompressed, err := codecs.Decompress(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, uncompressed, decompressed)
+
+	same, err := codecs.Decompress(uncompressed)
+	require.NoError(t, err)
+	assert.Same(t, uncompressed, same, "uncompressed blobs should be returned as-is")
+
+	decompressed, err = codecs.Decompress(nil)
+	require.NoError(t, err)
+	assert.Nil(t, decompressed)
+
+	_, err = codecs.Compress(NewDataBlob([]byte("some data"), constants.EncodingTypeJSON))
+	assert.ErrorContains(t, err, "unable to compress json blob")
+
+	_, err = codecs.Decompress(NewDataBlob([]byte("not zstd"), constants.EncodingTypeThriftRWZstd))
+	assert.ErrorContains(t, err, "unable to decompress")
+
+	_, err = BlobCodecs{}.Decompress(compressed)
+	assert.ErrorContains(t, err, "zstd compression is not configured")
+}
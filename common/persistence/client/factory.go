@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/codec/zstd"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
 	es "github.com/uber/cadence/common/elasticsearch"
@@ -37,11 +38,14 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/elasticsearch"
+	"github.com/uber/cadence/common/persistence/encryption"
 	"github.com/uber/cadence/common/persistence/nosql"
 	pinotVisibility "github.com/uber/cadence/common/persistence/pinot"
 	"github.com/uber/cadence/common/persistence/serialization"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/persistence/wrappers/errorinjectors"
+	"github.com/uber/cadence/common/persistence/wrappers/faultinjectors"
+	"github.com/uber/cadence/common/persistence/wrappers/historycache"
 	"github.com/uber/cadence/common/persistence/wrappers/metered"
 	"github.com/uber/cadence/common/persistence/wrappers/ratelimited"
 	"github.com/uber/cadence/common/persistence/wrappers/sampled"
@@ -75,6 +79,8 @@ type (
 		NewDomainReplicationQueueManager() (p.QueueManager, error)
 		// NewConfigStoreManager returns a new config store manager
 		NewConfigStoreManager() (p.ConfigStoreManager, error)
+		// BlobCodecs returns the codecs the managers compress and encrypt blobs with
+		BlobCodecs() p.BlobCodecs
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -115,6 +121,9 @@ type (
 		datastores    map[storeType]Datastore
 		clusterName   string
 		dc            *p.DynamicConfiguration
+		faultInjector *faultinjectors.Injector
+		historyCache  *historycache.Cache
+		codecs        p.BlobCodecs
 	}
 
 	storeType int
@@ -164,6 +173,17 @@ func NewFactory(
 		clusterName:   clusterName,
 		dc:            dc,
 	}
+	if cfg.FaultInjectionRules != nil {
+		factory.faultInjector = faultinjectors.NewInjector(cfg.FaultInjectionRules, logger, clock.NewRealTimeSource())
+	}
+	if cfg.HistoryBranchCacheMaxSize != nil {
+		factory.historyCache = historycache.NewCache(cfg.HistoryBranchCacheMaxSize, metricsClient)
+	}
+	codecs, err := newBlobCodecs(cfg)
+	if err != nil {
+		logger.Fatal("failed to load persistence blob codecs", tag.Error(err))
+	}
+	factory.codecs = codecs
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
 	return factory
@@ -180,6 +200,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewTaskManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewTaskManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewTaskManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -200,6 +223,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewShardManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewShardManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewShardManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -216,10 +242,16 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, p.NewPayloadSerializer(), codec.NewThriftRWEncoder(), f.config.TransactionSizeLimit)
+	if f.historyCache != nil {
+		store = historycache.NewHistoryStore(store, f.historyCache)
+	}
+	result := p.NewHistoryV2ManagerImpl(store, f.logger, p.NewPayloadSerializer(p.WithBlobCodecs(f.codecs)), codec.NewThriftRWEncoder(), f.config.TransactionSizeLimit, f.dc, f.codecs)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewHistoryManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewHistoryManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -242,6 +274,9 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewDomainManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewDomainManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewDomainManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -275,10 +310,13 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger, p.NewPayloadSerializer(), f.dc)
+	result := p.NewExecutionManagerImpl(store, f.logger, p.NewPayloadSerializer(p.WithBlobCodecs(f.codecs)), f.dc, f.codecs)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewExecutionManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewExecutionManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -474,6 +512,9 @@ func (f *factoryImpl) newDBVisibilityManager(
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewVisibilityManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewVisibilityManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -507,6 +548,9 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewQueueManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewQueueManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewQueueManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -527,6 +571,9 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.logger, time.Now())
 	}
+	if f.faultInjector != nil {
+		result = faultinjectors.NewConfigStoreManager(result, f.faultInjector)
+	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewConfigStoreManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 	}
@@ -607,13 +654,38 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 }
 
 func (f *factoryImpl) getParser() serialization.Parser {
-	parser, err := serialization.NewParser(f.dc)
+	parser, err := serialization.NewParser(f.dc, f.codecs)
 	if err != nil {
 		f.logger.Fatal("failed to construct parser", tag.Error(err))
 	}
 	return parser
 }
 
+// BlobCodecs returns the codecs the managers compress and encrypt blobs with
+func (f *factoryImpl) BlobCodecs() p.BlobCodecs {
+	return f.codecs
+}
+
+// newBlobCodecs creates the codecs of the encodings only known to persistence from the static config.
+func newBlobCodecs(cfg *config.Persistence) (p.BlobCodecs, error) {
+	codec, err := zstd.NewCodecFromFiles(cfg.ZstdDictionaries...)
+	if err != nil {
+		return p.BlobCodecs{}, err
+	}
+	codecs := p.BlobCodecs{Zstd: codec}
+	if cfg.Encryption != nil && cfg.Encryption.KeyringFile != "" {
+		provider, err := encryption.NewKeyringProviderFromFile(cfg.Encryption.KeyringFile)
+		if err != nil {
+			return p.BlobCodecs{}, err
+		}
+		codecs.Encryptor = encryption.NewEncryptor(
+			provider,
+			encryption.WithDataKeyRotation(cfg.Encryption.DataKeyMaxUses, cfg.Encryption.DataKeyMaxAge),
+		)
+	}
+	return codecs, nil
+}
+
 func buildRatelimiters(cfg *config.Persistence, maxQPS quotas.RPSFunc) map[string]quotas.Limiter {
 	result := make(map[string]quotas.Limiter, len(cfg.DataStores))
 	for dsName := range cfg.DataStores {
@@ -42,6 +42,20 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 	return m.recorder
 }
 
+// BlobCodecs mocks base method.
+func (m *MockFactory) BlobCodecs() persistence.BlobCodecs {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobCodecs")
+	ret0, _ := ret[0].(persistence.BlobCodecs)
+	return ret0
+}
+
+// BlobCodecs indicates an expected call of BlobCodecs.
+func (mr *MockFactoryMockRecorder) BlobCodecs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobCodecs", reflect.TypeOf((*MockFactory)(nil).BlobCodecs))
+}
+
 // Close mocks base method.
 func (m *MockFactory) Close() {
 	m.ctrl.T.Helper()
@@ -277,6 +277,12 @@ func makeFactoryWithMetrics(t *testing.T, withMetrics bool) Factory {
 		ErrorInjectionRate: func(opts ...dynamicproperties.FilterOption) float64 {
 			return 0.5 // half errors, unused in these tests beyond "nonzero" so it wraps with the error injector
 		},
+		FaultInjectionRules: func(opts ...dynamicproperties.FilterOption) []interface{} {
+			return nil // unused in these tests beyond "non-nil" so it wraps with the fault injector
+		},
+		HistoryBranchCacheMaxSize: func(opts ...dynamicproperties.FilterOption) int {
+			return 1024 // unused in these tests beyond "non-nil" so it wraps with the history cache
+		},
 	}
 
 	return NewFactory(cfg, qpsFn, "test cluster", met, logger, pdc)
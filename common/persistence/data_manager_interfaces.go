@@ -38,6 +38,15 @@
 //go:generate gowrap gen -g -p . -i DomainManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/domain_generated.go
 //go:generate gowrap gen -g -p . -i QueueManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/queue_generated.go
 
+// Generate fault injector wrappers.
+//go:generate gowrap gen -g -p . -i ConfigStoreManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/configstore_generated.go
+//go:generate gowrap gen -g -p . -i ShardManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/shard_generated.go
+//go:generate gowrap gen -g -p . -i ExecutionManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/execution_generated.go
+//go:generate gowrap gen -g -p . -i TaskManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/task_generated.go
+//go:generate gowrap gen -g -p . -i HistoryManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/history_generated.go
+//go:generate gowrap gen -g -p . -i DomainManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/domain_generated.go
+//go:generate gowrap gen -g -p . -i QueueManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/queue_generated.go
+
 // Generate metered wrappers.
 //go:generate gowrap gen -g -p . -i ConfigStoreManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/configstore_generated.go
 //go:generate gowrap gen -g -p . -i ShardManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/shard_generated.go
@@ -439,6 +448,12 @@ type (
 		CronSchedule      string
 
 		ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+		// Priority of the decision tasks of the workflow, and the default priority of its activity tasks
+		Priority int32
+		// WorkerBuildID is the build ID the workflow is pinned to, set by the first decision started by a worker with a build ID
+		WorkerBuildID string
+		// FairnessKey is the caller supplied key by which matching fairly dispatches the tasks of the workflow
+		FairnessKey string
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -494,6 +509,7 @@ type (
 		RecordVisibility        bool
 		OriginalTaskList        string
 		OriginalTaskListKind    types.TaskListKind
+		TraceContext            map[string]string
 	}
 
 	// CrossClusterTaskInfo describes a cross-cluster task
@@ -532,6 +548,7 @@ type (
 		ScheduleAttempt     int64
 		Version             int64
 		TaskList            string
+		TraceContext        map[string]string
 	}
 
 	// TaskListInfo describes a state of a task list implementation.
@@ -545,6 +562,9 @@ type (
 		Expiry                  time.Time
 		LastUpdated             time.Time
 		AdaptivePartitionConfig *TaskListPartitionConfig
+		// CompatibleBuildIDSets are the worker build ID compatibility sets of the task list,
+		// ordered from the oldest to the default set
+		CompatibleBuildIDSets [][]string
 	}
 
 	TaskListPartition struct {
@@ -569,6 +589,9 @@ type (
 		Expiry                        time.Time
 		CreatedTime                   time.Time
 		PartitionConfig               map[string]string
+		Priority                      int32
+		WorkerBuildID                 string
+		FairnessKey                   string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -617,6 +640,9 @@ type (
 		VersionHistories    *VersionHistories
 		ReplicationState    *ReplicationState // TODO: remove this after all 2DC workflows complete
 		Checksum            checksum.Checksum
+		// ReencryptionRequired is set when some of the events are not encrypted under the current key version,
+		// so that the mutable state is fully rewritten on its next update.
+		ReencryptionRequired bool
 	}
 
 	// ActivityInfo details.
@@ -656,6 +682,10 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// Paused activities are neither dispatched nor timed out until they are unpaused
+		Paused bool
+		// Priority of the activity task within its task list
+		Priority int32
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1442,6 +1472,8 @@ type (
 
 		// DomainName to get metrics created with the domain
 		DomainName string
+		// DomainID selects the data key the events are encrypted with, when persistence encryption is enabled
+		DomainID string
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -1551,6 +1583,26 @@ type (
 		DomainName string
 	}
 
+	// ReencryptHistoryBranchRequest is used to re-encrypt the history nodes of a branch
+	ReencryptHistoryBranchRequest struct {
+		// branch to be re-encrypted, including the nodes of its ancestors
+		BranchToken []byte
+		// The domain the nodes are encrypted for
+		DomainID string
+		// Number of history nodes read at a time
+		PageSize int
+		// The shard to re-encrypt history branch data
+		ShardID *int
+		// DomainName to generate metrics for Domain Cost Attribution
+		DomainName string
+	}
+
+	// ReencryptHistoryBranchResponse is the response to ReencryptHistoryBranchRequest
+	ReencryptHistoryBranchResponse struct {
+		// Number of history nodes which were rewritten under the current key version
+		ReencryptedNodes int
+	}
+
 	// GetHistoryTreeRequest is used to retrieve branch info of a history tree
 	GetHistoryTreeRequest struct {
 		// A UUID of a tree
@@ -1713,6 +1765,8 @@ type (
 		// DeleteHistoryBranch removes a branch
 		// If this is the last branch to delete, it will also remove the root node
 		DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error
+		// ReencryptHistoryBranch rewrites the nodes of a branch which are not encrypted under the current key version
+		ReencryptHistoryBranch(ctx context.Context, request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error)
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
@@ -1924,6 +1978,7 @@ func (t *TransferTaskInfo) ToTask() (Task, error) {
 		Version:             t.Version,
 		TaskID:              t.TaskID,
 		VisibilityTimestamp: t.VisibilityTimestamp,
+		TraceContext:        t.TraceContext,
 	}
 	switch t.TaskType {
 	case TransferTaskTypeActivityTask:
@@ -2131,6 +2186,7 @@ func (t *TimerTaskInfo) ToTask() (Task, error) {
 		Version:             t.Version,
 		TaskID:              t.TaskID,
 		VisibilityTimestamp: t.VisibilityTimestamp,
+		TraceContext:        t.TraceContext,
 	}
 	switch t.TaskType {
 	case TaskTypeDecisionTimeout:
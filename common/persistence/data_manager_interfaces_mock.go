@@ -183,6 +183,20 @@ func (mr *MockTaskMockRecorder) GetTaskType() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskType", reflect.TypeOf((*MockTask)(nil).GetTaskType))
 }
 
+// GetTraceContext mocks base method.
+func (m *MockTask) GetTraceContext() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceContext")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// GetTraceContext indicates an expected call of GetTraceContext.
+func (mr *MockTaskMockRecorder) GetTraceContext() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceContext", reflect.TypeOf((*MockTask)(nil).GetTraceContext))
+}
+
 // GetVersion mocks base method.
 func (m *MockTask) GetVersion() int64 {
 	m.ctrl.T.Helper()
@@ -237,6 +251,18 @@ func (mr *MockTaskMockRecorder) SetTaskID(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskID", reflect.TypeOf((*MockTask)(nil).SetTaskID), id)
 }
 
+// SetTraceContext mocks base method.
+func (m *MockTask) SetTraceContext(traceContext map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTraceContext", traceContext)
+}
+
+// SetTraceContext indicates an expected call of SetTraceContext.
+func (mr *MockTaskMockRecorder) SetTraceContext(traceContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTraceContext", reflect.TypeOf((*MockTask)(nil).SetTraceContext), traceContext)
+}
+
 // SetVersion mocks base method.
 func (m *MockTask) SetVersion(version int64) {
 	m.ctrl.T.Helper()
@@ -1204,6 +1230,21 @@ func (mr *MockHistoryManagerMockRecorder) ReadRawHistoryBranch(ctx, request any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRawHistoryBranch", reflect.TypeOf((*MockHistoryManager)(nil).ReadRawHistoryBranch), ctx, request)
 }
 
+// ReencryptHistoryBranch mocks base method.
+func (m *MockHistoryManager) ReencryptHistoryBranch(ctx context.Context, request *ReencryptHistoryBranchRequest) (*ReencryptHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptHistoryBranch", ctx, request)
+	ret0, _ := ret[0].(*ReencryptHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReencryptHistoryBranch indicates an expected call of ReencryptHistoryBranch.
+func (mr *MockHistoryManagerMockRecorder) ReencryptHistoryBranch(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptHistoryBranch", reflect.TypeOf((*MockHistoryManager)(nil).ReencryptHistoryBranch), ctx, request)
+}
+
 // MockDomainManager is a mock of DomainManager interface.
 type MockDomainManager struct {
 	ctrl     *gomock.Controller
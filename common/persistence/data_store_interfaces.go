@@ -35,6 +35,9 @@ import (
 //go:generate mockgen -package $GOPACKAGE -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,DomainAuditStore
 //go:generate mockgen -package $GOPACKAGE -destination visibility_store_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence VisibilityStore
 
+// Generate history branch cache wrapper.
+//go:generate gowrap gen -g -p . -i HistoryStore -t ./wrappers/templates/historycache.tmpl -o wrappers/historycache/historystore_generated.go
+
 type (
 	// ////////////////////////////////////////////////////////////////////
 	// Persistence interface is a lower layer of dataInterface.
@@ -152,6 +155,8 @@ type (
 		AppendHistoryNodes(ctx context.Context, request *InternalAppendHistoryNodesRequest) error
 		// ReadHistoryBranch returns history node data for a branch
 		ReadHistoryBranch(ctx context.Context, request *InternalReadHistoryBranchRequest) (*InternalReadHistoryBranchResponse, error)
+		// UpdateHistoryNode overwrites the events of an existing node, keeping its node ID and transaction ID
+		UpdateHistoryNode(ctx context.Context, request *InternalUpdateHistoryNodeRequest) error
 		// ForkHistoryBranch forks a new branch from a old branch
 		ForkHistoryBranch(ctx context.Context, request *InternalForkHistoryBranchRequest) (*InternalForkHistoryBranchResponse, error)
 		// DeleteHistoryBranch removes a branch
@@ -408,6 +413,9 @@ type (
 		PartitionConfig    map[string]string
 
 		ActiveClusterSelectionPolicy *DataBlob
+		Priority                     int32
+		WorkerBuildID                string
+		FairnessKey                  string
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -471,6 +479,8 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		Paused             bool
+		Priority           int32
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -617,6 +627,24 @@ type (
 		CurrentTimeStamp time.Time
 	}
 
+	// InternalUpdateHistoryNodeRequest is used to overwrite the events of a history node
+	InternalUpdateHistoryNodeRequest struct {
+		// The tree of the node
+		TreeID string
+		// The branch the node was appended to
+		BranchID string
+		// The node to be updated
+		NodeID int64
+		// The transaction ID the node was appended with
+		TransactionID int64
+		// The events to be written
+		Events *DataBlob
+		// Used in sharded data stores to identify which shard to use
+		ShardID int
+
+		CurrentTimeStamp time.Time
+	}
+
 	// InternalGetWorkflowExecutionRequest is used to retrieve the info of a workflow execution
 	InternalGetWorkflowExecutionRequest struct {
 		ShardID   ShardID
@@ -708,6 +736,8 @@ type (
 	InternalReadHistoryBranchResponse struct {
 		// History events
 		History []*DataBlob
+		// The node of each batch of history events
+		Nodes []InternalHistoryNode
 		// Pagination token
 		NextPageToken []byte
 		// LastNodeID is the last known node ID attached to a history node
@@ -716,6 +746,12 @@ type (
 		LastTransactionID int64
 	}
 
+	// InternalHistoryNode identifies a history node of a branch
+	InternalHistoryNode struct {
+		NodeID        int64
+		TransactionID int64
+	}
+
 	// InternalGetHistoryTreeRequest is used to get history tree
 	InternalGetHistoryTreeRequest struct {
 		// A UUID of a tree
@@ -1114,6 +1150,10 @@ func (d *DataBlob) GetEncoding() constants.EncodingType {
 		return constants.EncodingTypeThriftRW
 	case constants.EncodingTypeThriftRWSnappy:
 		return constants.EncodingTypeThriftRWSnappy
+	case constants.EncodingTypeThriftRWZstd:
+		return constants.EncodingTypeThriftRWZstd
+	case constants.EncodingTypeEncrypted:
+		return constants.EncodingTypeEncrypted
 	case constants.EncodingTypeEmpty:
 		return constants.EncodingTypeEmpty
 	default:
@@ -1121,19 +1161,24 @@ func (d *DataBlob) GetEncoding() constants.EncodingType {
 	}
 }
 
-// ToInternal convert data blob to internal representation
-func (d *DataBlob) ToInternal() *types.DataBlob {
+// ToInternal convert data blob to internal representation.
+// Compressed and encrypted blobs are only known to persistence, which decodes them before they leave it.
+func (d *DataBlob) ToInternal() (*types.DataBlob, error) {
 	switch d.Encoding {
 	case constants.EncodingTypeJSON:
 		return &types.DataBlob{
 			EncodingType: types.EncodingTypeJSON.Ptr(),
 			Data:         d.Data,
-		}
+		}, nil
 	case constants.EncodingTypeThriftRW:
 		return &types.DataBlob{
 			EncodingType: types.EncodingTypeThriftRW.Ptr(),
 			Data:         d.Data,
-		}
+		}, nil
+	case constants.EncodingTypeThriftRWZstd:
+		return nil, NewCadenceSerializationError(fmt.Sprintf("DataBlob.ToInternal() with %v blob which was not decompressed by persistence", d.Encoding))
+	case constants.EncodingTypeEncrypted:
+		return nil, NewCadenceSerializationError(fmt.Sprintf("DataBlob.ToInternal() with %v blob which was not decrypted by persistence", d.Encoding))
 	default:
 		panic(fmt.Sprintf("DataBlob.ToInternal() with unsupported encoding type: %v", d.Encoding))
 	}
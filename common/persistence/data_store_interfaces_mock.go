@@ -982,6 +982,20 @@ func (mr *MockHistoryStoreMockRecorder) ReadHistoryBranch(ctx, request any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryBranch", reflect.TypeOf((*MockHistoryStore)(nil).ReadHistoryBranch), ctx, request)
 }
 
+// UpdateHistoryNode mocks base method.
+func (m *MockHistoryStore) UpdateHistoryNode(ctx context.Context, request *InternalUpdateHistoryNodeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHistoryNode", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHistoryNode indicates an expected call of UpdateHistoryNode.
+func (mr *MockHistoryStoreMockRecorder) UpdateHistoryNode(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHistoryNode", reflect.TypeOf((*MockHistoryStore)(nil).UpdateHistoryNode), ctx, request)
+}
+
 // MockConfigStore is a mock of ConfigStore interface.
 type MockConfigStore struct {
 	ctrl     *gomock.Controller
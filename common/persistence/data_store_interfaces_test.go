@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
@@ -119,6 +120,8 @@ func TestDataBlob(t *testing.T) {
 		same(constants.EncodingTypeGob)
 		same(constants.EncodingTypeJSON)
 		same(constants.EncodingTypeThriftRW)
+		same(constants.EncodingTypeThriftRWZstd)
+		same(constants.EncodingTypeEncrypted)
 		same(constants.EncodingTypeEmpty)
 
 		// highly suspicious
@@ -140,11 +143,11 @@ func TestDataBlob(t *testing.T) {
 					Encoding: encodingCommon,
 					Data:     data,
 				}
-				assert.Equalf(t, internal, blob.ToInternal(), "%v should encode to internal type %v", encodingCommon, encodingType)
+				assert.Equalf(t, internal, mustToInternal(t, blob), "%v should encode to internal type %v", encodingCommon, encodingType)
 				assert.Equalf(t, blob, NewDataBlobFromInternal(internal), "%v should decode from internal type %v", encodingCommon, encodingType)
 				// likely proven by above, but to be explicit: this type should round-trip without losing data.
-				assert.Equalf(t, blob, NewDataBlobFromInternal(blob.ToInternal()), "%v should round trip from blob %v", encodingCommon, encodingType)
-				assert.Equalf(t, internal, NewDataBlobFromInternal(internal).ToInternal(), "%v should round trip from internal %v", encodingCommon, encodingType)
+				assert.Equalf(t, blob, NewDataBlobFromInternal(mustToInternal(t, blob)), "%v should round trip from blob %v", encodingCommon, encodingType)
+				assert.Equalf(t, internal, mustToInternal(t, NewDataBlobFromInternal(internal)), "%v should round trip from internal %v", encodingCommon, encodingType)
 			}
 
 			t.Run("json", func(t *testing.T) {
@@ -155,6 +158,14 @@ func TestDataBlob(t *testing.T) {
 			})
 		})
 
+		t.Run("zstd fails to internal", func(t *testing.T) {
+			_, err := (&DataBlob{
+				Encoding: constants.EncodingTypeThriftRWZstd,
+				Data:     data,
+			}).ToInternal()
+			assert.ErrorContains(t, err, "not decompressed by persistence")
+		})
+
 		t.Run("other known encodings panic to internal", func(t *testing.T) {
 			for _, encoding := range []constants.EncodingType{
 				constants.EncodingTypeUnknown,
@@ -167,7 +178,7 @@ func TestDataBlob(t *testing.T) {
 					(&DataBlob{
 						Encoding: encoding,
 						Data:     data,
-					}).ToInternal()
+					}).ToInternal() //nolint:errcheck
 				}, "should panic when encoding to unhandled encoding %q", encoding)
 			}
 		})
@@ -188,3 +199,9 @@ func TestDataBlob(t *testing.T) {
 		})
 	})
 }
+
+func mustToInternal(t *testing.T, blob *DataBlob) *types.DataBlob {
+	internal, err := blob.ToInternal()
+	require.NoError(t, err)
+	return internal
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import "github.com/uber/cadence/common/constants"
+
+// encryptionEnabled returns whether new blobs are written encrypted, which requires both
+// the dynamic config flag and a keyring in the static config.
+func encryptionEnabled(dc *DynamicConfiguration, codecs BlobCodecs) bool {
+	return dc != nil && dc.EnablePersistenceEncryption != nil && dc.EnablePersistenceEncryption() && codecs.Encryptor != nil
+}
+
+// encryptBlobs encrypts the non empty blobs in place with the data key of the domain.
+func encryptBlobs(codecs BlobCodecs, domainID string, blobs ...**DataBlob) error {
+	for _, blob := range blobs {
+		if *blob == nil || len((*blob).Data) == 0 || (*blob).Encoding == constants.EncodingTypeEncrypted {
+			continue
+		}
+		encrypted, err := codecs.Encrypt(domainID, *blob)
+		if err != nil {
+			return err
+		}
+		*blob = encrypted
+	}
+	return nil
+}
+
+// encryptWorkflowEvents encrypts the history events stored in mutable state, which carry workflow payloads.
+func encryptWorkflowEvents(
+	codecs BlobCodecs,
+	executionInfo *InternalWorkflowExecutionInfo,
+	activityInfos []*InternalActivityInfo,
+	childExecutionInfos []*InternalChildExecutionInfo,
+	bufferedEvents ...**DataBlob,
+) error {
+	domainID := executionInfo.DomainID
+	blobs := append([]**DataBlob{&executionInfo.CompletionEvent}, bufferedEvents...)
+	for _, info := range activityInfos {
+		blobs = append(blobs, &info.ScheduledEvent, &info.StartedEvent)
+	}
+	for _, info := range childExecutionInfos {
+		blobs = append(blobs, &info.InitiatedEvent, &info.StartedEvent)
+	}
+	return encryptBlobs(codecs, domainID, blobs...)
+}
+
+// reencryptionRequired returns whether any history event stored in the mutable state is not
+// encrypted under the current key version, so that the mutable state should be rewritten.
+func reencryptionRequired(dc *DynamicConfiguration, codecs BlobCodecs, state *InternalWorkflowMutableState) bool {
+	if !encryptionEnabled(dc, codecs) {
+		return false
+	}
+	encryptor := codecs.Encryptor
+	stale := func(blob *DataBlob) bool {
+		return blob != nil && len(blob.Data) > 0 &&
+			(blob.Encoding != constants.EncodingTypeEncrypted || !encryptor.IsCurrent(blob.Data))
+	}
+	if state.ExecutionInfo != nil && stale(state.ExecutionInfo.CompletionEvent) {
+		return true
+	}
+	for _, info := range state.ActivityInfos {
+		if stale(info.ScheduledEvent) || stale(info.StartedEvent) {
+			return true
+		}
+	}
+	for _, info := range state.ChildExecutionInfos {
+		if stale(info.InitiatedEvent) || stale(info.StartedEvent) {
+			return true
+		}
+	}
+	for _, blob := range state.BufferedEvents {
+		if stale(blob) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package encryption implements envelope encryption of persisted blobs.
+//
+// Every blob is encrypted with AES-256-GCM under a data key owned by the domain of the blob.
+// Data keys are generated by each process, and replaced after a number of encryptions or a period
+// of time since nonces are random. They are stored alongside the blobs, wrapped by a key
+// encryption key which never leaves the KeyProvider. Rotating the key encryption key only
+// requires to make the new version current: blobs written under older versions stay
+// readable as long as their version is known to the provider.
+//
+// Mutable state is re-encrypted under the current version when its workflow is next updated.
+// History nodes keep the version they were written with until the admin db reencrypt-history
+// command rewrites them, or their workflow is deleted. The admin db encryption-report command
+// lists the versions still in use, an older version can be removed once it is no longer reported.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+)
+
+const (
+	dataKeySize = 32
+	// maxCachedDataKeys bounds the number of unwrapped data keys kept for decryption.
+	maxCachedDataKeys = 4096
+	// DefaultDataKeyMaxUses bounds the number of blobs encrypted under a data key, far below the
+	// 2^32 encryptions with random nonces after which AES-GCM nonce collisions are no longer negligible.
+	DefaultDataKeyMaxUses = 1 << 28
+	// DefaultDataKeyMaxAge bounds the time a data key is used to encrypt blobs.
+	DefaultDataKeyMaxAge = 24 * time.Hour
+)
+
+type (
+	// KeyProvider owns the key encryption keys, and wraps and unwraps data keys with them.
+	KeyProvider interface {
+		// CurrentVersion returns the version of the key encryption key new data keys are wrapped with.
+		CurrentVersion() string
+		// WrapKey encrypts the data key of a domain with the key encryption key of the given version.
+		WrapKey(version string, domainID string, dataKey []byte) ([]byte, error)
+		// UnwrapKey decrypts a data key wrapped by WrapKey.
+		UnwrapKey(version string, domainID string, wrappedKey []byte) ([]byte, error)
+	}
+
+	// EncryptorOption configures an Encryptor
+	EncryptorOption func(*Encryptor)
+
+	// Encryptor encrypts blobs into envelopes and decrypts them.
+	Encryptor struct {
+		provider   KeyProvider
+		timeSource clock.TimeSource
+		maxUses    int64
+		maxAge     time.Duration
+
+		lock      sync.RWMutex
+		dataKeys  map[dataKeyID]*dataKey
+		unwrapped map[string]cipher.AEAD
+		usage     map[string]*atomic.Int64
+	}
+
+	dataKeyID struct {
+		domainID string
+		version  string
+	}
+
+	dataKey struct {
+		aead       cipher.AEAD
+		wrappedKey []byte
+		createdAt  time.Time
+		uses       atomic.Int64
+	}
+)
+
+// WithDataKeyRotation sets the number of encryptions and the time after which a data key is replaced,
+// a value of zero keeps the default.
+func WithDataKeyRotation(maxUses int64, maxAge time.Duration) EncryptorOption {
+	return func(e *Encryptor) {
+		if maxUses > 0 {
+			e.maxUses = maxUses
+		}
+		if maxAge > 0 {
+			e.maxAge = maxAge
+		}
+	}
+}
+
+// WithTimeSource sets the time source data keys are aged with.
+func WithTimeSource(timeSource clock.TimeSource) EncryptorOption {
+	return func(e *Encryptor) {
+		e.timeSource = timeSource
+	}
+}
+
+// NewEncryptor creates an Encryptor wrapping its data keys with the given provider.
+func NewEncryptor(provider KeyProvider, opts ...EncryptorOption) *Encryptor {
+	e := &Encryptor{
+		provider:   provider,
+		timeSource: clock.NewRealTimeSource(),
+		maxUses:    DefaultDataKeyMaxUses,
+		maxAge:     DefaultDataKeyMaxAge,
+		dataKeys:   make(map[dataKeyID]*dataKey),
+		unwrapped:  make(map[string]cipher.AEAD),
+		usage:      make(map[string]*atomic.Int64),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Encrypt seals data of the given encoding into an envelope, using the data key of the domain
+// under the current key version. An empty domainID selects the cluster scoped data key.
+func (e *Encryptor) Encrypt(domainID string, encoding constants.EncodingType, data []byte) ([]byte, error) {
+	version := e.provider.CurrentVersion()
+	key, err := e.dataKey(dataKeyID{domainID: domainID, version: version})
+	if err != nil {
+		return nil, err
+	}
+	header := (&Header{
+		KeyVersion: version,
+		DomainID:   domainID,
+		Encoding:   encoding,
+		wrappedKey: key.wrappedKey,
+	}).marshal()
+
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	envelope := make([]byte, 0, len(header)+len(nonce)+len(data)+key.aead.Overhead())
+	envelope = append(append(envelope, header...), nonce...)
+	return key.aead.Seal(envelope, nonce, data, header), nil
+}
+
+// Decrypt opens an envelope, returning the plaintext and its encoding.
+func (e *Encryptor) Decrypt(data []byte) ([]byte, constants.EncodingType, error) {
+	header, headerSize, err := parseEnvelope(data)
+	if err != nil {
+		return nil, "", err
+	}
+	aead, err := e.unwrap(header)
+	if err != nil {
+		return nil, "", err
+	}
+	rest := data[headerSize:]
+	if len(rest) < aead.NonceSize() {
+		return nil, "", fmt.Errorf("corrupted envelope")
+	}
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], data[:headerSize])
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to decrypt envelope with key version %q: %w", header.KeyVersion, err)
+	}
+	e.counter(header.KeyVersion).Add(1)
+	return plaintext, header.Encoding, nil
+}
+
+// IsCurrent reports whether data is an envelope sealed under the current key version.
+func (e *Encryptor) IsCurrent(data []byte) bool {
+	header, _, err := parseEnvelope(data)
+	return err == nil && header.KeyVersion == e.provider.CurrentVersion()
+}
+
+// CurrentVersion returns the key version new envelopes are sealed under.
+func (e *Encryptor) CurrentVersion() string {
+	return e.provider.CurrentVersion()
+}
+
+// DecryptedByKeyVersion returns the number of envelopes decrypted so far, by key version.
+func (e *Encryptor) DecryptedByKeyVersion() map[string]int64 {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	result := make(map[string]int64, len(e.usage))
+	for version, counter := range e.usage {
+		result[version] = counter.Load()
+	}
+	return result
+}
+
+// dataKey returns the data key to encrypt a blob with, replacing it once it reached its number of uses or its age.
+// Replaced keys are only dropped from the encryption side, envelopes sealed under them stay readable.
+func (e *Encryptor) dataKey(id dataKeyID) (*dataKey, error) {
+	e.lock.RLock()
+	key, ok := e.dataKeys[id]
+	e.lock.RUnlock()
+	if ok && e.reserve(key) {
+		return key, nil
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if current, ok := e.dataKeys[id]; ok && current != key && e.reserve(current) {
+		return current, nil
+	}
+	plainKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(plainKey); err != nil {
+		return nil, fmt.Errorf("unable to generate data key: %w", err)
+	}
+	wrappedKey, err := e.provider.WrapKey(id.version, id.domainID, plainKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap data key with key version %q: %w", id.version, err)
+	}
+	aead, err := newAEAD(plainKey)
+	if err != nil {
+		return nil, err
+	}
+	key = &dataKey{aead: aead, wrappedKey: wrappedKey, createdAt: e.timeSource.Now()}
+	key.uses.Store(1)
+	e.dataKeys[id] = key
+	return key, nil
+}
+
+// reserve counts an encryption under the data key, and returns false if the key must be replaced instead.
+func (e *Encryptor) reserve(key *dataKey) bool {
+	return e.timeSource.Since(key.createdAt) < e.maxAge && key.uses.Add(1) <= e.maxUses
+}
+
+func (e *Encryptor) unwrap(header *Header) (cipher.AEAD, error) {
+	cacheKey := header.KeyVersion + "\x00" + header.DomainID + "\x00" + string(header.wrappedKey)
+	e.lock.RLock()
+	aead, ok := e.unwrapped[cacheKey]
+	e.lock.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	plainKey, err := e.provider.UnwrapKey(header.KeyVersion, header.DomainID, header.wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key with key version %q: %w", header.KeyVersion, err)
+	}
+	aead, err = newAEAD(plainKey)
+	if err != nil {
+		return nil, err
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if len(e.unwrapped) >= maxCachedDataKeys {
+		e.unwrapped = make(map[string]cipher.AEAD)
+	}
+	e.unwrapped[cacheKey] = aead
+	return aead, nil
+}
+
+func (e *Encryptor) counter(version string) *atomic.Int64 {
+	e.lock.RLock()
+	counter, ok := e.usage[version]
+	e.lock.RUnlock()
+	if ok {
+		return counter
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if counter, ok := e.usage[version]; ok {
+		return counter
+	}
+	counter = &atomic.Int64{}
+	e.usage[version] = counter
+	return counter
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	encryptor := NewEncryptor(testProvider(t, "1", "1"))
+	plaintext := []byte("workflow payload")
+
+	envelope, err := encryptor.Encrypt("domain-id", constants.EncodingTypeThriftRWSnappy, plaintext)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(envelope, plaintext))
+
+	header, err := ParseHeader(envelope)
+	require.NoError(t, err)
+	assert.Equal(t, "1", header.KeyVersion)
+	assert.Equal(t, "domain-id", header.DomainID)
+	assert.Equal(t, constants.EncodingTypeThriftRWSnappy, header.Encoding)
+
+	decrypted, encoding, err := encryptor.Decrypt(envelope)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+	assert.Equal(t, constants.EncodingTypeThriftRWSnappy, encoding)
+
+	// a different process generates its own data key, but can read the envelope
+	other := NewEncryptor(encryptor.provider)
+	decrypted, _, err = other.Decrypt(envelope)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+}
+
+func TestDataKeysArePerDomain(t *testing.T) {
+	encryptor := NewEncryptor(testProvider(t, "1", "1"))
+
+	first, err := encryptor.Encrypt("domain-a", constants.EncodingTypeThriftRW, []byte("a"))
+	require.NoError(t, err)
+	second, err := encryptor.Encrypt("domain-b", constants.EncodingTypeThriftRW, []byte("b"))
+	require.NoError(t, err)
+	third, err := encryptor.Encrypt("domain-a", constants.EncodingTypeThriftRW, []byte("c"))
+	require.NoError(t, err)
+
+	headerA, _ := ParseHeader(first)
+	headerB, _ := ParseHeader(second)
+	headerA2, _ := ParseHeader(third)
+	assert.NotEqual(t, headerA.wrappedKey, headerB.wrappedKey)
+	assert.Equal(t, headerA.wrappedKey, headerA2.wrappedKey)
+}
+
+func TestDataKeyRotation(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	encryptor := NewEncryptor(testProvider(t, "1", "1"), WithDataKeyRotation(2, time.Hour), WithTimeSource(timeSource))
+	wrappedKey := func() []byte {
+		envelope, err := encryptor.Encrypt("domain-id", constants.EncodingTypeThriftRW, []byte("payload"))
+		require.NoError(t, err)
+		header, err := ParseHeader(envelope)
+		require.NoError(t, err)
+		_, _, err = NewEncryptor(encryptor.provider).Decrypt(envelope)
+		require.NoError(t, err, "envelopes sealed under replaced data keys should stay readable")
+		return header.wrappedKey
+	}
+
+	first := wrappedKey()
+	assert.Equal(t, first, wrappedKey())
+	second := wrappedKey()
+	assert.NotEqual(t, first, second, "the data key should be replaced after its maximum number of uses")
+
+	timeSource.Advance(time.Hour)
+	third := wrappedKey()
+	assert.NotEqual(t, second, third, "the data key should be replaced after its maximum age")
+	assert.Equal(t, third, wrappedKey())
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	encryptor := NewEncryptor(testProvider(t, "1", "1"))
+	envelope, err := encryptor.Encrypt("domain-a", constants.EncodingTypeThriftRW, []byte("payload"))
+	require.NoError(t, err)
+
+	t.Run("ciphertext", func(t *testing.T) {
+		tampered := bytes.Clone(envelope)
+		tampered[len(tampered)-1] ^= 1
+		_, _, err := encryptor.Decrypt(tampered)
+		assert.Error(t, err)
+	})
+	t.Run("header", func(t *testing.T) {
+		header, _ := ParseHeader(envelope)
+		moved := &Header{KeyVersion: header.KeyVersion, DomainID: "domain-b", Encoding: header.Encoding, wrappedKey: header.wrappedKey}
+		_, size, err := parseEnvelope(envelope)
+		require.NoError(t, err)
+		tampered := append(moved.marshal(), envelope[size:]...)
+		_, _, err = NewEncryptor(encryptor.provider).Decrypt(tampered)
+		assert.Error(t, err)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		_, _, err := encryptor.Decrypt(envelope[:10])
+		assert.Error(t, err)
+	})
+	t.Run("not an envelope", func(t *testing.T) {
+		_, _, err := encryptor.Decrypt([]byte("plaintext"))
+		assert.Error(t, err)
+	})
+}
+
+func TestKeyRotation(t *testing.T) {
+	keys := map[string]string{"1": randomKey(t), "2": randomKey(t)}
+	before, err := NewKeyringProvider(Keyring{CurrentVersion: "1", Keys: keys})
+	require.NoError(t, err)
+	after, err := NewKeyringProvider(Keyring{CurrentVersion: "2", Keys: keys})
+	require.NoError(t, err)
+
+	old, err := NewEncryptor(before).Encrypt("domain-id", constants.EncodingTypeThriftRW, []byte("old"))
+	require.NoError(t, err)
+
+	encryptor := NewEncryptor(after)
+	assert.Equal(t, "2", encryptor.CurrentVersion())
+	assert.False(t, encryptor.IsCurrent(old))
+	assert.False(t, encryptor.IsCurrent([]byte("plaintext")))
+
+	decrypted, _, err := encryptor.Decrypt(old)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("old"), decrypted)
+
+	current, err := encryptor.Encrypt("domain-id", constants.EncodingTypeThriftRW, decrypted)
+	require.NoError(t, err)
+	assert.True(t, encryptor.IsCurrent(current))
+	_, _, err = encryptor.Decrypt(current)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"1": 1, "2": 1}, encryptor.DecryptedByKeyVersion())
+
+	retired, err := NewKeyringProvider(Keyring{CurrentVersion: "2", Keys: map[string]string{"2": keys["2"]}})
+	require.NoError(t, err)
+	_, _, err = NewEncryptor(retired).Decrypt(old)
+	assert.ErrorContains(t, err, `key version "1" is not in the keyring`)
+}
+
+func testProvider(t *testing.T, current string, versions ...string) KeyProvider {
+	keys := make(map[string]string, len(versions))
+	for _, version := range versions {
+		keys[version] = randomKey(t)
+	}
+	provider, err := NewKeyringProvider(Keyring{CurrentVersion: current, Keys: keys})
+	require.NoError(t, err)
+	return provider
+}
+
+func randomKey(t *testing.T) string {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
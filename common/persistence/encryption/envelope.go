@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+const (
+	envelopeFormatVersion byte = 1
+	maxHeaderFieldLength       = 1 << 12
+)
+
+var envelopeMagic = []byte("CENC")
+
+type (
+	// Header is the unencrypted part of an envelope. It identifies the key encryption key
+	// the data key was wrapped with, so it can be read without access to any key.
+	Header struct {
+		// KeyVersion is the version of the key encryption key which wrapped the data key.
+		KeyVersion string
+		// DomainID is the domain the data key belongs to, empty for cluster scoped data.
+		DomainID string
+		// Encoding is the encoding of the plaintext.
+		Encoding constants.EncodingType
+
+		wrappedKey []byte
+	}
+)
+
+// ParseHeader reads the header of an envelope produced by Encryptor.Encrypt.
+func ParseHeader(data []byte) (*Header, error) {
+	header, _, err := parseEnvelope(data)
+	return header, err
+}
+
+// marshal returns the encoded header, which is also the additional authenticated data of the envelope.
+func (h *Header) marshal() []byte {
+	var buf bytes.Buffer
+	buf.Write(envelopeMagic)
+	buf.WriteByte(envelopeFormatVersion)
+	for _, field := range [][]byte{[]byte(h.KeyVersion), []byte(h.DomainID), []byte(h.Encoding), h.wrappedKey} {
+		buf.Write(binary.AppendUvarint(nil, uint64(len(field))))
+		buf.Write(field)
+	}
+	return buf.Bytes()
+}
+
+// parseEnvelope splits an envelope into its header and the length of the encoded header.
+func parseEnvelope(data []byte) (*Header, int, error) {
+	if !bytes.HasPrefix(data, envelopeMagic) {
+		return nil, 0, errors.New("not an encrypted envelope")
+	}
+	offset := len(envelopeMagic)
+	if len(data) <= offset || data[offset] != envelopeFormatVersion {
+		return nil, 0, errors.New("unsupported envelope format")
+	}
+	offset++
+
+	var fields [4][]byte
+	for i := range fields {
+		length, n := binary.Uvarint(data[offset:])
+		if n <= 0 || length > maxHeaderFieldLength || uint64(len(data)-offset-n) < length {
+			return nil, 0, fmt.Errorf("corrupted envelope header")
+		}
+		offset += n
+		fields[i] = data[offset : offset+int(length)]
+		offset += int(length)
+	}
+	return &Header{
+		KeyVersion: string(fields[0]),
+		DomainID:   string(fields[1]),
+		Encoding:   constants.EncodingType(fields[2]),
+		wrappedKey: fields[3],
+	}, offset, nil
+}
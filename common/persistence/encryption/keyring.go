@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// Keyring is the content of a keyring file: the key encryption keys by version,
+	// and the version new data keys are wrapped with.
+	//
+	//	currentVersion: "2"
+	//	keys:
+	//	  "1": <base64 encoded 32 bytes key>
+	//	  "2": <base64 encoded 32 bytes key>
+	Keyring struct {
+		CurrentVersion string            `yaml:"currentVersion"`
+		Keys           map[string]string `yaml:"keys"`
+	}
+
+	keyringProvider struct {
+		currentVersion string
+		keys           map[string]cipher.AEAD
+	}
+)
+
+// NewKeyringProviderFromFile creates a KeyProvider from a local keyring file.
+func NewKeyringProviderFromFile(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keyring file: %w", err)
+	}
+	var keyring Keyring
+	if err := yaml.UnmarshalStrict(content, &keyring); err != nil {
+		return nil, fmt.Errorf("unable to parse keyring file %v: %w", path, err)
+	}
+	return NewKeyringProvider(keyring)
+}
+
+// NewKeyringProvider creates a KeyProvider holding the key encryption keys of a keyring in memory.
+func NewKeyringProvider(keyring Keyring) (KeyProvider, error) {
+	provider := &keyringProvider{
+		currentVersion: keyring.CurrentVersion,
+		keys:           make(map[string]cipher.AEAD, len(keyring.Keys)),
+	}
+	for version, encoded := range keyring.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("keyring key version %q is not valid base64: %w", version, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("keyring key version %q must be %d bytes long, got %d", version, dataKeySize, len(key))
+		}
+		provider.keys[version], err = newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := provider.keys[keyring.CurrentVersion]; !ok {
+		return nil, fmt.Errorf("keyring current version %q has no key", keyring.CurrentVersion)
+	}
+	return provider, nil
+}
+
+func (p *keyringProvider) CurrentVersion() string {
+	return p.currentVersion
+}
+
+func (p *keyringProvider) WrapKey(version string, domainID string, dataKey []byte) ([]byte, error) {
+	kek, err := p.key(version)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, kek.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	return kek.Seal(nonce, nonce, dataKey, []byte(domainID)), nil
+}
+
+func (p *keyringProvider) UnwrapKey(version string, domainID string, wrappedKey []byte) ([]byte, error) {
+	kek, err := p.key(version)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < kek.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+	return kek.Open(nil, wrappedKey[:kek.NonceSize()], wrappedKey[kek.NonceSize():], []byte(domainID))
+}
+
+func (p *keyringProvider) key(version string) (cipher.AEAD, error) {
+	kek, ok := p.keys[version]
+	if !ok {
+		return nil, fmt.Errorf("key version %q is not in the keyring", version)
+	}
+	return kek, nil
+}
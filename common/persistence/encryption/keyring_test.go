@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeyringProviderFromFile(t *testing.T) {
+	key := randomKey(t)
+	path := filepath.Join(t.TempDir(), "keyring.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("currentVersion: \"2\"\nkeys:\n  \"1\": "+randomKey(t)+"\n  \"2\": "+key+"\n"), 0600))
+
+	provider, err := NewKeyringProviderFromFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "2", provider.CurrentVersion())
+
+	wrapped, err := provider.WrapKey("1", "domain-id", []byte("data key"))
+	require.NoError(t, err)
+	unwrapped, err := provider.UnwrapKey("1", "domain-id", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data key"), unwrapped)
+
+	_, err = provider.UnwrapKey("2", "domain-id", wrapped)
+	assert.Error(t, err)
+	_, err = provider.UnwrapKey("1", "other-domain-id", wrapped)
+	assert.Error(t, err)
+
+	_, err = NewKeyringProviderFromFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "unable to read keyring file")
+}
+
+func TestNewKeyringProviderValidation(t *testing.T) {
+	tests := map[string]struct {
+		keyring Keyring
+		err     string
+	}{
+		"missing current key": {
+			keyring: Keyring{CurrentVersion: "2", Keys: map[string]string{"1": randomKey(t)}},
+			err:     `keyring current version "2" has no key`,
+		},
+		"invalid base64": {
+			keyring: Keyring{CurrentVersion: "1", Keys: map[string]string{"1": "not base64!"}},
+			err:     `keyring key version "1" is not valid base64`,
+		},
+		"short key": {
+			keyring: Keyring{CurrentVersion: "1", Keys: map[string]string{"1": base64.StdEncoding.EncodeToString([]byte("short"))}},
+			err:     `keyring key version "1" must be 32 bytes long, got 5`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewKeyringProvider(test.keyring)
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
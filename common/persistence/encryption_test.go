@@ -0,0 +1,326 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence/encryption"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestBlobCodecsEncryption(t *testing.T) {
+	data := []byte("some data")
+	blob := NewDataBlob(data, constants.EncodingTypeThriftRW)
+
+	t.Run("not configured", func(t *testing.T) {
+		codecs := newTestBlobCodecs(t)
+		_, err := codecs.Encrypt(testDomainID, blob)
+		assert.ErrorContains(t, err, "persistence encryption is not configured")
+		_, err = codecs.Decrypt(NewDataBlob(data, constants.EncodingTypeEncrypted))
+		assert.ErrorContains(t, err, "persistence encryption is not configured")
+	})
+
+	codecs := newTestEncryptedBlobCodecs(t, newTestKeyring(t, "1"), "1")
+	encrypted, err := codecs.Encrypt(testDomainID, blob)
+	require.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeEncrypted, encrypted.GetEncoding())
+	assert.NotContains(t, string(encrypted.Data), string(data))
+
+	decrypted, err := codecs.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, blob, decrypted)
+
+	same, err := codecs.Decrypt(blob)
+	require.NoError(t, err)
+	assert.Same(t, blob, same, "plaintext blobs should be returned as-is")
+
+	_, err = codecs.Decrypt(NewDataBlob([]byte("garbage"), constants.EncodingTypeEncrypted))
+	assert.ErrorContains(t, err, "unable to decrypt blob")
+
+	t.Run("decode", func(t *testing.T) {
+		compressed, err := codecs.Compress(blob)
+		require.NoError(t, err)
+		compressed, err = codecs.Encrypt(testDomainID, compressed)
+		require.NoError(t, err)
+		decoded, err := codecs.Decode(compressed)
+		require.NoError(t, err)
+		assert.Equal(t, blob, decoded)
+	})
+
+	t.Run("to internal", func(t *testing.T) {
+		_, err := encrypted.ToInternal()
+		assert.ErrorContains(t, err, "not decrypted by persistence")
+	})
+}
+
+func TestSerializerDecryptsBlobs(t *testing.T) {
+	codecs := newTestEncryptedBlobCodecs(t, newTestKeyring(t, "1"), "1")
+	serializer := NewPayloadSerializer(WithBlobCodecs(codecs))
+	event := &types.HistoryEvent{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}
+
+	for _, encoding := range []constants.EncodingType{constants.EncodingTypeThriftRW, constants.EncodingTypeThriftRWZstd, constants.EncodingTypeJSON} {
+		t.Run(string(encoding), func(t *testing.T) {
+			blob, err := serializer.SerializeEvent(event, encoding)
+			require.NoError(t, err)
+			encrypted, err := codecs.Encrypt(testDomainID, blob)
+			require.NoError(t, err)
+
+			deserialized, err := serializer.DeserializeEvent(encrypted)
+			require.NoError(t, err)
+			assert.Equal(t, event, deserialized)
+		})
+	}
+}
+
+func TestAppendHistoryNodesEncryption(t *testing.T) {
+	codecs := newTestEncryptedBlobCodecs(t, newTestKeyring(t, "1"), "1")
+	historyManager, mockStore, mockSerializer, mockEncoder := setUpMocksForHistoryV2Manager(t)
+	historyManager.codecs = codecs
+	historyManager.dc = &DynamicConfiguration{EnablePersistenceEncryption: dynamicproperties.GetBoolPropertyFn(true)}
+
+	plaintext := &DataBlob{Encoding: constants.EncodingTypeThriftRW, Data: []byte("events")}
+	mockEncoder.EXPECT().Decode([]byte("branch-token"), &workflow.HistoryBranch{}).DoAndReturn(func(data []byte, value *workflow.HistoryBranch) error {
+		value.TreeID = common.Ptr("tree-id")
+		value.BranchID = common.Ptr("branch-id")
+		return nil
+	})
+	mockSerializer.EXPECT().SerializeBatchEvents(gomock.Any(), gomock.Any()).Return(plaintext, nil)
+	mockStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *InternalAppendHistoryNodesRequest) error {
+		assert.Equal(t, constants.EncodingTypeEncrypted, request.Events.Encoding)
+		header, err := encryption.ParseHeader(request.Events.Data)
+		require.NoError(t, err)
+		assert.Equal(t, testDomainID, header.DomainID)
+		decrypted, err := codecs.Decrypt(request.Events)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+		return nil
+	})
+
+	resp, err := historyManager.AppendHistoryNodes(context.Background(), &AppendHistoryNodesRequest{
+		BranchToken: []byte("branch-token"),
+		Events:      []*types.HistoryEvent{{ID: 1, Version: 1}},
+		ShardID:     common.Ptr(10),
+		DomainID:    testDomainID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, *plaintext, resp.DataBlob, "the response should hold the plaintext blob")
+}
+
+func TestReadRawHistoryBranchDecrypts(t *testing.T) {
+	codecs := newTestEncryptedBlobCodecs(t, newTestKeyring(t, "1"), "1")
+	historyManager, _, _, _ := setUpMocksForHistoryV2Manager(t)
+	historyManager.codecs = codecs
+
+	encrypted, err := codecs.Encrypt(testDomainID, NewDataBlob([]byte("history-event-blob"), constants.EncodingTypeThriftRW))
+	require.NoError(t, err)
+	compressed, err := codecs.Compress(NewDataBlob([]byte("compressed-blob"), constants.EncodingTypeThriftRW))
+	require.NoError(t, err)
+	compressed, err = codecs.Encrypt(testDomainID, compressed)
+	require.NoError(t, err)
+	historyManager.readRawHistoryBranchFn = func(context.Context, *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
+		return []*DataBlob{encrypted, compressed}, &historyV2PagingToken{}, len(encrypted.Data) + len(compressed.Data), nil, nil
+	}
+	historyManager.serializeTokenFn = func(*historyV2PagingToken) ([]byte, error) {
+		return nil, nil
+	}
+
+	resp, err := historyManager.ReadRawHistoryBranch(context.Background(), &ReadHistoryBranchRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*DataBlob{
+		NewDataBlob([]byte("history-event-blob"), constants.EncodingTypeThriftRW),
+		NewDataBlob([]byte("compressed-blob"), constants.EncodingTypeThriftRW),
+	}, resp.HistoryEventBlobs)
+	assert.Equal(t, len("history-event-blob")+len("compressed-blob"), resp.Size)
+}
+
+func TestSerializeWorkflowSnapshotEncryption(t *testing.T) {
+	codecs := newTestEncryptedBlobCodecs(t, newTestKeyring(t, "1"), "1")
+	manager := NewExecutionManagerImpl(nil, testlogger.New(t), NewPayloadSerializer(WithBlobCodecs(codecs)), &DynamicConfiguration{
+		EnablePersistenceEncryption: dynamicproperties.GetBoolPropertyFn(true),
+	}, codecs).(*executionManagerImpl)
+
+	snapshot, err := manager.SerializeWorkflowSnapshot(sampleWorkflowSnapshot(), constants.EncodingTypeThriftRW)
+	require.NoError(t, err)
+
+	events := []*DataBlob{snapshot.ExecutionInfo.CompletionEvent}
+	for _, info := range snapshot.ActivityInfos {
+		events = append(events, info.ScheduledEvent, info.StartedEvent)
+	}
+	for _, info := range snapshot.ChildExecutionInfos {
+		events = append(events, info.InitiatedEvent, info.StartedEvent)
+	}
+	for _, blob := range events {
+		assert.Equal(t, constants.EncodingTypeEncrypted, blob.Encoding)
+	}
+	assert.Equal(t, constants.EncodingTypeThriftRW, snapshot.ExecutionInfo.AutoResetPoints.Encoding, "only events are encrypted")
+
+	info, _, err := manager.DeserializeExecutionInfo(snapshot.ExecutionInfo)
+	require.NoError(t, err)
+	assert.Equal(t, completionEvent(), info.CompletionEvent)
+}
+
+func TestReencryptionRequired(t *testing.T) {
+	keyring := newTestKeyring(t, "1", "2")
+	old, err := newTestEncryptedBlobCodecs(t, keyring, "1").Encrypt(testDomainID, NewDataBlob([]byte("event"), constants.EncodingTypeThriftRW))
+	require.NoError(t, err)
+	codecs := newTestEncryptedBlobCodecs(t, keyring, "2")
+	current, err := codecs.Encrypt(testDomainID, NewDataBlob([]byte("event"), constants.EncodingTypeThriftRW))
+	require.NoError(t, err)
+	plaintext := NewDataBlob([]byte("event"), constants.EncodingTypeThriftRW)
+
+	enabled := &DynamicConfiguration{EnablePersistenceEncryption: dynamicproperties.GetBoolPropertyFn(true)}
+	disabled := &DynamicConfiguration{EnablePersistenceEncryption: dynamicproperties.GetBoolPropertyFn(false)}
+	state := func(completion, activity, child, buffered *DataBlob) *InternalWorkflowMutableState {
+		return &InternalWorkflowMutableState{
+			ExecutionInfo:       &InternalWorkflowExecutionInfo{CompletionEvent: completion},
+			ActivityInfos:       map[int64]*InternalActivityInfo{1: {ScheduledEvent: activity}},
+			ChildExecutionInfos: map[int64]*InternalChildExecutionInfo{2: {InitiatedEvent: child}},
+			BufferedEvents:      []*DataBlob{buffered},
+		}
+	}
+
+	assert.False(t, reencryptionRequired(enabled, codecs, state(current, current, current, current)))
+	assert.False(t, reencryptionRequired(enabled, codecs, state(nil, nil, nil, nil)))
+	assert.True(t, reencryptionRequired(enabled, codecs, state(old, current, current, current)))
+	assert.True(t, reencryptionRequired(enabled, codecs, state(current, old, current, current)))
+	assert.True(t, reencryptionRequired(enabled, codecs, state(current, current, plaintext, current)))
+	assert.True(t, reencryptionRequired(enabled, codecs, state(current, current, current, old)))
+	assert.False(t, reencryptionRequired(disabled, codecs, state(old, old, plaintext, old)))
+	assert.False(t, reencryptionRequired(nil, codecs, state(old, old, plaintext, old)))
+}
+
+func TestReencryptHistoryBranch(t *testing.T) {
+	keyring := newTestKeyring(t, "1", "2")
+	plaintext := NewDataBlob([]byte("events"), constants.EncodingTypeThriftRW)
+	old, err := newTestEncryptedBlobCodecs(t, keyring, "1").Encrypt(testDomainID, plaintext)
+	require.NoError(t, err)
+	codecs := newTestEncryptedBlobCodecs(t, keyring, "2")
+	current, err := codecs.Encrypt(testDomainID, plaintext)
+	require.NoError(t, err)
+
+	historyManager, mockStore, _, mockEncoder := setUpMocksForHistoryV2Manager(t)
+	historyManager.codecs = codecs
+
+	mockEncoder.EXPECT().Decode([]byte("branch-token"), &workflow.HistoryBranch{}).DoAndReturn(func(data []byte, value *workflow.HistoryBranch) error {
+		value.TreeID = common.Ptr("tree-id")
+		value.BranchID = common.Ptr("branch-id")
+		value.Ancestors = []*workflow.HistoryBranchRange{{
+			BranchID:    common.Ptr("ancestor-id"),
+			BeginNodeID: common.Ptr(int64(1)),
+			EndNodeID:   common.Ptr(int64(3)),
+		}}
+		return nil
+	})
+	mockStore.EXPECT().ReadHistoryBranch(gomock.Any(), &InternalReadHistoryBranchRequest{
+		TreeID:            "tree-id",
+		BranchID:          "ancestor-id",
+		MinNodeID:         1,
+		MaxNodeID:         3,
+		LastNodeID:        defaultLastNodeID,
+		LastTransactionID: defaultLastTransactionID,
+		ShardID:           10,
+		PageSize:          100,
+	}).Return(&InternalReadHistoryBranchResponse{
+		History:           []*DataBlob{old},
+		Nodes:             []InternalHistoryNode{{NodeID: 1, TransactionID: 10}},
+		LastNodeID:        1,
+		LastTransactionID: 10,
+	}, nil)
+	mockStore.EXPECT().ReadHistoryBranch(gomock.Any(), &InternalReadHistoryBranchRequest{
+		TreeID:            "tree-id",
+		BranchID:          "branch-id",
+		MinNodeID:         3,
+		MaxNodeID:         constants.EndEventID,
+		LastNodeID:        1,
+		LastTransactionID: 10,
+		ShardID:           10,
+		PageSize:          100,
+	}).Return(&InternalReadHistoryBranchResponse{
+		History:           []*DataBlob{current, plaintext},
+		Nodes:             []InternalHistoryNode{{NodeID: 3, TransactionID: 11}, {NodeID: 5, TransactionID: 12}},
+		LastNodeID:        5,
+		LastTransactionID: 12,
+	}, nil)
+
+	var updated []InternalHistoryNode
+	mockStore.EXPECT().UpdateHistoryNode(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *InternalUpdateHistoryNodeRequest) error {
+		assert.True(t, codecs.Encryptor.IsCurrent(request.Events.Data))
+		decrypted, err := codecs.Decrypt(request.Events)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+		updated = append(updated, InternalHistoryNode{NodeID: request.NodeID, TransactionID: request.TransactionID})
+		return nil
+	}).Times(2)
+
+	resp, err := historyManager.ReencryptHistoryBranch(context.Background(), &ReencryptHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		DomainID:    testDomainID,
+		PageSize:    100,
+		ShardID:     common.Ptr(10),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &ReencryptHistoryBranchResponse{ReencryptedNodes: 2}, resp)
+	assert.Equal(t, []InternalHistoryNode{{NodeID: 1, TransactionID: 10}, {NodeID: 5, TransactionID: 12}}, updated)
+
+	historyManager.codecs = newTestBlobCodecs(t)
+	_, err = historyManager.ReencryptHistoryBranch(context.Background(), &ReencryptHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		DomainID:    testDomainID,
+		PageSize:    100,
+		ShardID:     common.Ptr(10),
+	})
+	assert.ErrorContains(t, err, "persistence encryption is not configured")
+}
+
+// newTestKeyring returns a keyring holding a random key for each version.
+func newTestKeyring(t *testing.T, versions ...string) map[string]string {
+	keys := make(map[string]string, len(versions))
+	for _, version := range versions {
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		keys[version] = base64.StdEncoding.EncodeToString(key)
+	}
+	return keys
+}
+
+// newTestEncryptedBlobCodecs returns codecs encrypting with the given keys.
+func newTestEncryptedBlobCodecs(t *testing.T, keys map[string]string, currentVersion string) BlobCodecs {
+	provider, err := encryption.NewKeyringProvider(encryption.Keyring{CurrentVersion: currentVersion, Keys: keys})
+	require.NoError(t, err)
+	codecs := newTestBlobCodecs(t)
+	codecs.Encryptor = encryption.NewEncryptor(provider)
+	return codecs
+}
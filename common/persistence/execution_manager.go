@@ -41,6 +41,7 @@ type (
 		logger        log.Logger
 		timeSrc       clock.TimeSource
 		dc            *DynamicConfiguration
+		codecs        BlobCodecs
 	}
 )
 
@@ -52,6 +53,7 @@ func NewExecutionManagerImpl(
 	logger log.Logger,
 	serializer PayloadSerializer,
 	dc *DynamicConfiguration,
+	codecs BlobCodecs,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:    serializer,
@@ -60,6 +62,7 @@ func NewExecutionManagerImpl(
 		logger:        logger,
 		timeSrc:       clock.NewRealTimeSource(),
 		dc:            dc,
+		codecs:        codecs,
 	}
 }
 
@@ -119,6 +122,7 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		return nil, err
 	}
 	newResponse.State.VersionHistories = versionHistories
+	newResponse.State.ReencryptionRequired = reencryptionRequired(m.dc, m.codecs, response.State)
 	newResponse.MutableStateStats = m.statsComputer.computeMutableStateStats(response)
 
 	if len(newResponse.State.Checksum.Value) == 0 {
@@ -212,6 +216,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		Priority:                           info.Priority,
+		WorkerBuildID:                      info.WorkerBuildID,
+		FairnessKey:                        info.FairnessKey,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -331,6 +338,8 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Paused:                                  v.Paused,
+			Priority:                                v.Priority,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -461,6 +470,8 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Paused:                                  v.Paused,
+			Priority:                                v.Priority,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -552,6 +563,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		PartitionConfig:                    info.PartitionConfig,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		Priority:                           info.Priority,
+		WorkerBuildID:                      info.WorkerBuildID,
+		FairnessKey:                        info.FairnessKey,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -669,6 +683,12 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 			return nil, err
 		}
 	}
+	if encryptionEnabled(m.dc, m.codecs) {
+		err = encryptWorkflowEvents(m.codecs, serializedExecutionInfo, serializedUpsertActivityInfos, serializedUpsertChildExecutionInfos, &serializedNewBufferedEvents)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	startVersion, err := getStartVersion(input.VersionHistories)
 	if err != nil {
@@ -739,6 +759,12 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	if err != nil {
 		return nil, err
 	}
+	if encryptionEnabled(m.dc, m.codecs) {
+		err = encryptWorkflowEvents(m.codecs, serializedExecutionInfo, serializedActivityInfos, serializedChildExecutionInfos)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	startVersion, err := getStartVersion(input.VersionHistories)
 	if err != nil {
@@ -147,7 +147,7 @@ func TestExecutionManager_ProxyStoreMethods(t *testing.T) {
 			tc.prepareMocks(mockedStore)
 			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			})
+			}, BlobCodecs{})
 			v := reflect.ValueOf(manager)
 			method := v.MethodByName(tc.method)
 			methodType := method.Type()
@@ -183,7 +183,7 @@ func TestExecutionManager_GetWorkflowExecution(t *testing.T) {
 
 	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-	})
+	}, BlobCodecs{})
 
 	request := &GetWorkflowExecutionRequest{
 		DomainID: testDomainID,
@@ -275,7 +275,7 @@ func TestExecutionManager_GetWorkflowExecution_NoWorkflow(t *testing.T) {
 
 	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-	})
+	}, BlobCodecs{})
 
 	request := &GetWorkflowExecutionRequest{
 		DomainID: "testDomain",
@@ -300,7 +300,7 @@ func TestExecutionManager_UpdateWorkflowExecution(t *testing.T) {
 
 	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-	})
+	}, BlobCodecs{})
 
 	expectedInfo := sampleInternalWorkflowMutation()
 
@@ -505,7 +505,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 			tc.prepareMocks(mockedSerializer)
 			manager := NewExecutionManagerImpl(nil, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			}).(*executionManagerImpl)
+			}, BlobCodecs{}).(*executionManagerImpl)
 			res, err := manager.SerializeWorkflowSnapshot(tc.input, constants.EncodingTypeThriftRW)
 			tc.checkRes(t, res, err)
 		})
@@ -551,7 +551,7 @@ func TestDeserializeBufferedEvents(t *testing.T) {
 
 			manager := NewExecutionManagerImpl(nil, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			}).(*executionManagerImpl)
+			}, BlobCodecs{}).(*executionManagerImpl)
 
 			events := []*DataBlob{
 				sampleEventData(),
@@ -569,7 +569,7 @@ func TestPutReplicationTaskToDLQ(t *testing.T) {
 	mockedStore := NewMockExecutionStore(ctrl)
 	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, &DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-	})
+	}, BlobCodecs{})
 
 	now := time.Now().UTC()
 
@@ -602,7 +602,7 @@ func TestGetReplicationTasksFromDLQ(t *testing.T) {
 	mockedStore := NewMockExecutionStore(ctrl)
 	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, &DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-	})
+	}, BlobCodecs{})
 
 	request := &GetReplicationTasksFromDLQRequest{
 		SourceClusterName: "test-cluster",
@@ -897,7 +897,7 @@ func TestListConcreteExecutions(t *testing.T) {
 
 			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			})
+			}, BlobCodecs{})
 
 			res, err := manager.ListConcreteExecutions(context.Background(), request)
 
@@ -1014,7 +1014,7 @@ func TestCreateWorkflowExecution(t *testing.T) {
 
 			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			})
+			}, BlobCodecs{})
 
 			res, err := manager.CreateWorkflowExecution(context.Background(), request)
 
@@ -1182,7 +1182,7 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 
 			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			})
+			}, BlobCodecs{})
 
 			res, err := manager.ConflictResolveWorkflowExecution(context.Background(), tc.request)
 
@@ -1196,7 +1196,7 @@ func TestCreateFailoverMarkerTasks(t *testing.T) {
 	mockedStore := NewMockExecutionStore(ctrl)
 	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, &DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-	})
+	}, BlobCodecs{})
 
 	req := &CreateFailoverMarkersRequest{
 		Markers: []*FailoverMarkerTask{{
@@ -1289,7 +1289,7 @@ func TestGetActiveClusterSelectionPolicy(t *testing.T) {
 			mockedSerializer := NewMockPayloadSerializer(ctrl)
 			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			})
+			}, BlobCodecs{})
 
 			test.prepareMocks(mockedStore, mockedSerializer)
 
@@ -1342,7 +1342,7 @@ func TestDeleteActiveClusterSelectionPolicy(t *testing.T) {
 			mockedStore := NewMockExecutionStore(ctrl)
 			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, &DynamicConfiguration{
 				SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
-			})
+			}, BlobCodecs{})
 
 			test.prepareMocks(mockedStore)
 
@@ -63,6 +63,8 @@ type (
 		logger                 log.Logger
 		thriftEncoder          codec.BinaryEncoder
 		transactionSizeLimit   dynamicproperties.IntPropertyFn
+		dc                     *DynamicConfiguration
+		codecs                 BlobCodecs
 		serializeTokenFn       func(*historyV2PagingToken) ([]byte, error)
 		deserializeTokenFn     func([]byte, int64) (*historyV2PagingToken, error)
 		readRawHistoryBranchFn func(context.Context, *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error)
@@ -90,6 +92,8 @@ func NewHistoryV2ManagerImpl(
 	historySerializer PayloadSerializer,
 	binaryEncoder codec.BinaryEncoder,
 	transactionSizeLimit dynamicproperties.IntPropertyFn,
+	dc *DynamicConfiguration,
+	codecs BlobCodecs,
 ) HistoryManager {
 	hm := &historyV2ManagerImpl{
 		historySerializer:    historySerializer,
@@ -97,6 +101,8 @@ func NewHistoryV2ManagerImpl(
 		logger:               logger,
 		thriftEncoder:        binaryEncoder,
 		transactionSizeLimit: transactionSizeLimit,
+		dc:                   dc,
+		codecs:               codecs,
 		serializeTokenFn:     serializeToken,
 		deserializeTokenFn:   deserializeToken,
 		timeSrc:              clock.NewRealTimeSource(),
@@ -182,6 +188,107 @@ func (m *historyV2ManagerImpl) DeleteHistoryBranch(
 	return m.persistence.DeleteHistoryBranch(ctx, req)
 }
 
+// ReencryptHistoryBranch rewrites the nodes of a branch, including the nodes inherited from its ancestors,
+// which are not encrypted under the current key version.
+// Plaintext nodes are encrypted as well, regardless of the dynamic config flag, since it is an explicit operation.
+// Only the node visible to readers is rewritten, nodes overridden by a higher transaction ID are never read again.
+func (m *historyV2ManagerImpl) ReencryptHistoryBranch(
+	ctx context.Context,
+	request *ReencryptHistoryBranchRequest,
+) (*ReencryptHistoryBranchResponse, error) {
+	if m.codecs.Encryptor == nil {
+		return nil, &InvalidPersistenceRequestError{
+			Msg: "persistence encryption is not configured",
+		}
+	}
+	shardID, err := getShardID(request.ShardID)
+	if err != nil {
+		m.logger.Error("shardID is not set in re-encrypt history branch operation", tag.Error(err))
+		return nil, &types.InternalServiceError{
+			Message: err.Error(),
+		}
+	}
+	if request.PageSize <= 0 {
+		return nil, &InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("no history nodes can be read for pageSize %v", request.PageSize),
+		}
+	}
+
+	var branch workflow.HistoryBranch
+	err = m.thriftEncoder.Decode(request.BranchToken, &branch)
+	if err != nil {
+		return nil, err
+	}
+	beginNodeID := constants.FirstEventID
+	if len(branch.Ancestors) > 0 {
+		beginNodeID = *branch.Ancestors[len(branch.Ancestors)-1].EndNodeID
+	}
+	allBRs := append(branch.Ancestors, &workflow.HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: common.Int64Ptr(beginNodeID),
+		EndNodeID:   common.Int64Ptr(constants.EndEventID),
+	})
+
+	resp := &ReencryptHistoryBranchResponse{}
+	lastNodeID := defaultLastNodeID
+	lastTxnID := defaultLastTransactionID
+	for _, br := range allBRs {
+		var pageToken []byte
+		for {
+			readResp, err := m.persistence.ReadHistoryBranch(ctx, &InternalReadHistoryBranchRequest{
+				TreeID:            *branch.TreeID,
+				BranchID:          *br.BranchID,
+				MinNodeID:         *br.BeginNodeID,
+				MaxNodeID:         *br.EndNodeID,
+				NextPageToken:     pageToken,
+				LastNodeID:        lastNodeID,
+				LastTransactionID: lastTxnID,
+				ShardID:           shardID,
+				PageSize:          request.PageSize,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for i, blob := range readResp.History {
+				if blob.Encoding == constants.EncodingTypeEncrypted && m.codecs.Encryptor.IsCurrent(blob.Data) {
+					continue
+				}
+				decrypted, err := m.codecs.Decrypt(blob)
+				if err != nil {
+					return nil, err
+				}
+				encrypted, err := m.codecs.Encrypt(request.DomainID, decrypted)
+				if err != nil {
+					return nil, err
+				}
+				err = m.persistence.UpdateHistoryNode(ctx, &InternalUpdateHistoryNodeRequest{
+					TreeID:           *branch.TreeID,
+					BranchID:         *br.BranchID,
+					NodeID:           readResp.Nodes[i].NodeID,
+					TransactionID:    readResp.Nodes[i].TransactionID,
+					Events:           encrypted,
+					ShardID:          shardID,
+					CurrentTimeStamp: m.timeSrc.Now(),
+				})
+				if err != nil {
+					return nil, err
+				}
+				resp.ReencryptedNodes++
+			}
+			// an empty page does not carry the last node
+			if len(readResp.Nodes) > 0 || len(readResp.NextPageToken) > 0 {
+				lastNodeID = readResp.LastNodeID
+				lastTxnID = readResp.LastTransactionID
+			}
+			pageToken = readResp.NextPageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	return resp, nil
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (m *historyV2ManagerImpl) GetHistoryTree(
 	ctx context.Context,
@@ -258,11 +365,30 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 	}
 
 	// nodeID will be the first eventID
-	blob, err := m.historySerializer.SerializeBatchEvents(request.Events, request.Encoding)
+	// compression is only known to persistence, so the batch is returned uncompressed and only compressed to be persisted
+	encoding := request.Encoding
+	if encoding == constants.EncodingTypeThriftRWZstd {
+		encoding = constants.EncodingTypeThriftRW
+	}
+	blob, err := m.historySerializer.SerializeBatchEvents(request.Events, encoding)
 	if err != nil {
 		return nil, err
 	}
-	size := len(blob.Data)
+	persistedBlob := blob
+	if request.Encoding == constants.EncodingTypeThriftRWZstd {
+		persistedBlob, err = m.codecs.Compress(blob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// history nodes are immutable, they keep the key version they are written with until they are deleted
+	if encryptionEnabled(m.dc, m.codecs) {
+		persistedBlob, err = m.codecs.Encrypt(request.DomainID, persistedBlob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	size := len(persistedBlob.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
 		return nil, &TransactionSizeLimitError{
@@ -274,7 +400,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 		Info:             request.Info,
 		BranchInfo:       *thrift.ToHistoryBranch(&branch),
 		NodeID:           nodeID,
-		Events:           blob,
+		Events:           persistedBlob,
 		TransactionID:    request.TransactionID,
 		ShardID:          shardID,
 		CurrentTimeStamp: m.timeSrc.Now(),
@@ -338,6 +464,18 @@ func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 	if err != nil {
 		return nil, err
 	}
+	// raw history leaves persistence, so encrypted and compressed batches are returned in their wire encoding
+	for i, blob := range dataBlobs {
+		if blob.Encoding != constants.EncodingTypeEncrypted && blob.Encoding != constants.EncodingTypeThriftRWZstd {
+			continue
+		}
+		decoded, err := m.codecs.Decode(blob)
+		if err != nil {
+			return nil, err
+		}
+		dataSize += len(decoded.Data) - len(blob.Data)
+		dataBlobs[i] = decoded
+	}
 
 	nextPageToken, err := m.serializeTokenFn(token)
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -54,6 +55,8 @@ func setUpMocksForHistoryV2Manager(t *testing.T) (*historyV2ManagerImpl, *MockHi
 		mockSerializer,
 		mockEncoder,
 		dynamicproperties.GetIntPropertyFn(1024*10),
+		nil,
+		newTestBlobCodecs(t),
 	)
 	assert.Equal(t, "mock history store", historyManager.GetName())
 
@@ -1197,6 +1200,62 @@ func TestReadRawHistoryBranchMethod(t *testing.T) {
 				Size:          100,
 			},
 		},
+		{
+			name: "zstd compressed batches are decompressed",
+			setupMock: func() {
+				// No additional mock setup needed
+			},
+			fakeReadRaw: func(ctx context.Context, request *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
+				compressed, err := newTestBlobCodecs(t).Compress(NewDataBlob([]byte("history-event-blob"), constants.EncodingTypeThriftRW))
+				require.NoError(t, err)
+				return []*DataBlob{
+					compressed,
+					{Encoding: constants.EncodingTypeThriftRW, Data: []byte("other-blob")},
+				}, &historyV2PagingToken{LastEventVersion: 1, LastEventID: 0}, len(compressed.Data) + len("other-blob"), nil, nil
+			},
+			fakeSerializeToken: func(pagingToken *historyV2PagingToken) ([]byte, error) {
+				return []byte("next-page-token"), nil
+			},
+			request: &ReadHistoryBranchRequest{
+				BranchToken:   []byte("branch-token"),
+				PageSize:      10,
+				MinEventID:    1,
+				MaxEventID:    100,
+				NextPageToken: []byte{},
+			},
+			expectError: false,
+			expectedResponse: &ReadRawHistoryBranchResponse{
+				HistoryEventBlobs: []*DataBlob{
+					{Encoding: constants.EncodingTypeThriftRW, Data: []byte("history-event-blob")},
+					{Encoding: constants.EncodingTypeThriftRW, Data: []byte("other-blob")},
+				},
+				NextPageToken: []byte("next-page-token"),
+				Size:          len("history-event-blob") + len("other-blob"),
+			},
+		},
+		{
+			name: "corrupted zstd batch",
+			setupMock: func() {
+				// No additional mock setup needed
+			},
+			fakeReadRaw: func(ctx context.Context, request *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
+				return []*DataBlob{
+					{Encoding: constants.EncodingTypeThriftRWZstd, Data: []byte("not zstd")},
+				}, &historyV2PagingToken{LastEventVersion: 1, LastEventID: 0}, 8, nil, nil
+			},
+			fakeSerializeToken: func(pagingToken *historyV2PagingToken) ([]byte, error) {
+				return []byte("next-page-token"), nil
+			},
+			request: &ReadHistoryBranchRequest{
+				BranchToken:   []byte("branch-token"),
+				PageSize:      10,
+				MinEventID:    1,
+				MaxEventID:    100,
+				NextPageToken: []byte{},
+			},
+			expectError:   true,
+			expectedError: "unable to decompress thriftrw_zstd blob",
+		},
 		{
 			name: "error in readRawHistoryBranchFn",
 			setupMock: func() {
@@ -96,7 +96,7 @@ func (r *TaskInfo) ByteSize() uint64 {
 		return 0
 	}
 
-	return uint64(int(unsafe.Sizeof(*r)) + len(r.DomainID) + len(r.WorkflowID) + len(r.RunID) + estimateStringMapSize(r.PartitionConfig))
+	return uint64(int(unsafe.Sizeof(*r)) + len(r.DomainID) + len(r.WorkflowID) + len(r.RunID) + len(r.WorkerBuildID) + len(r.FairnessKey) + estimateStringMapSize(r.PartitionConfig))
 }
 
 func (r *ListDomainsResponse) ByteSize() uint64 {
@@ -268,6 +268,10 @@ func (r DeleteHistoryBranchRequest) MetricTags() []metrics.Tag {
 	return []metrics.Tag{metrics.DomainTag(r.DomainName)}
 }
 
+func (r ReencryptHistoryBranchRequest) MetricTags() []metrics.Tag {
+	return []metrics.Tag{metrics.DomainTag(r.DomainName)}
+}
+
 func (r ForkHistoryBranchRequest) MetricTags() []metrics.Tag {
 	return []metrics.Tag{metrics.DomainTag(r.DomainName)}
 }
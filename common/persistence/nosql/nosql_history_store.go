@@ -134,6 +134,7 @@ func (h *nosqlHistoryStore) ReadHistoryBranch(
 	}
 
 	history := make([]*persistence.DataBlob, 0, int(request.PageSize))
+	nodes := make([]persistence.InternalHistoryNode, 0, int(request.PageSize))
 
 	eventBlob := &persistence.DataBlob{}
 	nodeID := int64(0)
@@ -174,18 +175,48 @@ func (h *nosqlHistoryStore) ReadHistoryBranch(
 			lastTxnID = txnID
 			lastNodeID = nodeID
 			history = append(history, eventBlob)
+			nodes = append(nodes, persistence.InternalHistoryNode{NodeID: nodeID, TransactionID: txnID})
 			eventBlob = &persistence.DataBlob{}
 		}
 	}
 
 	return &persistence.InternalReadHistoryBranchResponse{
 		History:           history,
+		Nodes:             nodes,
 		NextPageToken:     pagingToken,
 		LastNodeID:        lastNodeID,
 		LastTransactionID: lastTxnID,
 	}, nil
 }
 
+// UpdateHistoryNode overwrites the events of an existing history node
+// NOTE: inserting a node row with the same primary key is an upsert
+func (h *nosqlHistoryStore) UpdateHistoryNode(
+	ctx context.Context,
+	request *persistence.InternalUpdateHistoryNodeRequest,
+) error {
+	storeShard, err := h.GetStoreShardByHistoryShard(request.ShardID)
+	if err != nil {
+		return err
+	}
+
+	nodeRow := &nosqlplugin.HistoryNodeRow{
+		TreeID:          request.TreeID,
+		BranchID:        request.BranchID,
+		NodeID:          request.NodeID,
+		TxnID:           &request.TransactionID,
+		Data:            request.Events.Data,
+		DataEncoding:    string(request.Events.Encoding),
+		ShardID:         request.ShardID,
+		CreateTimestamp: request.CurrentTimeStamp,
+	}
+	err = storeShard.db.InsertIntoHistoryTreeAndNode(ctx, nil, nodeRow)
+	if err != nil {
+		return convertCommonErrors(storeShard.db, "UpdateHistoryNode", err)
+	}
+	return nil
+}
+
 // ForkHistoryBranch forks a new branch from an existing branch
 // Note that application must provide a void forking nodeID, it must be a valid nodeID in that branch.
 // A valid forking nodeID can be an ancestor from the existing branch.
@@ -273,6 +273,28 @@ func TestReadHistoryBranch(t *testing.T) {
 	// Assert that these ids corresponds to the last node and transaction id
 	assert.Equal(t, testRowNodeID2, resp.LastNodeID)
 	assert.Equal(t, testRowTxnID2, resp.LastTransactionID)
+	assert.Equal(t, []persistence.InternalHistoryNode{
+		{NodeID: testRowNodeID1, TransactionID: testRowTxnID1},
+		{NodeID: testRowNodeID2, TransactionID: testRowTxnID2},
+	}, resp.Nodes)
+}
+
+func TestUpdateHistoryNode(t *testing.T) {
+	store, dbMock, _ := setUpMocks(t)
+
+	// Inserting a node row with the same node ID and transaction ID overwrites it
+	dbMock.EXPECT().InsertIntoHistoryTreeAndNode(gomock.Any(), nil, validHistoryNodeRow()).Return(nil).Times(1)
+
+	err := store.UpdateHistoryNode(ctx.Background(), &persistence.InternalUpdateHistoryNodeRequest{
+		TreeID:           "TestTreeID",
+		BranchID:         "TestBranchID",
+		NodeID:           testNodeID,
+		TransactionID:    testTransactionID,
+		Events:           persistence.NewDataBlob([]byte("TestEvents"), constants.EncodingTypeThriftRW),
+		ShardID:          testShardID,
+		CurrentTimeStamp: FixedTime,
+	})
+	assert.NoError(t, err)
 }
 
 func TestReadHistoryBranch_ErrorIfSelectFromHistoryNodeErrors(t *testing.T) {
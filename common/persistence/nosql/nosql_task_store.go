@@ -154,6 +154,7 @@ func (t *nosqlTaskStore) LeaseTaskList(
 			LastUpdatedTime:         currentTimeStamp,
 			CurrentTimeStamp:        currentTimeStamp,
 			AdaptivePartitionConfig: currTL.AdaptivePartitionConfig,
+			CompatibleBuildIDSets:   currTL.CompatibleBuildIDSets,
 		}, currTL.RangeID-1)
 	}
 	if err != nil {
@@ -175,6 +176,7 @@ func (t *nosqlTaskStore) LeaseTaskList(
 		Kind:                    request.TaskListKind,
 		LastUpdated:             currentTimeStamp,
 		AdaptivePartitionConfig: currTL.AdaptivePartitionConfig,
+		CompatibleBuildIDSets:   currTL.CompatibleBuildIDSets,
 	}
 	return &persistence.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -204,6 +206,7 @@ func (t *nosqlTaskStore) GetTaskList(
 		Kind:                    currTL.TaskListKind,
 		LastUpdated:             currTL.LastUpdatedTime,
 		AdaptivePartitionConfig: currTL.AdaptivePartitionConfig,
+		CompatibleBuildIDSets:   currTL.CompatibleBuildIDSets,
 	}
 	return &persistence.GetTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -224,6 +227,7 @@ func (t *nosqlTaskStore) UpdateTaskList(
 		LastUpdatedTime:         request.CurrentTimeStamp,
 		CurrentTimeStamp:        request.CurrentTimeStamp,
 		AdaptivePartitionConfig: tli.AdaptivePartitionConfig,
+		CompatibleBuildIDSets:   tli.CompatibleBuildIDSets,
 	}
 	storeShard, err := t.GetStoreShardByTaskList(tli.DomainID, tli.Name, tli.TaskType)
 	if err != nil {
@@ -305,6 +309,9 @@ func (t *nosqlTaskStore) CreateTasks(
 			ScheduledID:     taskRequest.Data.ScheduleID,
 			CreatedTime:     currentTimeStamp,
 			PartitionConfig: taskRequest.Data.PartitionConfig,
+			Priority:        taskRequest.Data.Priority,
+			WorkerBuildID:   taskRequest.Data.WorkerBuildID,
+			FairnessKey:     taskRequest.Data.FairnessKey,
 		}
 
 		var ttl int
@@ -411,6 +418,9 @@ func toTaskInfo(t *nosqlplugin.TaskRow) *persistence.TaskInfo {
 		ScheduleID:      t.ScheduledID,
 		CreatedTime:     t.CreatedTime,
 		PartitionConfig: t.PartitionConfig,
+		Priority:        t.Priority,
+		WorkerBuildID:   t.WorkerBuildID,
+		FairnessKey:     t.FairnessKey,
 	}
 }
 
@@ -50,12 +50,15 @@ type TestClusterParams struct {
 	Password      string
 	Host          string
 	Port          int
+	Region        string
 	ProtoVersion  int
 	SchemaBaseDir string
 	// Replicas defaults to 1 if not set
 	Replicas int
 	// MaxConns defaults to 2 if not set
 	MaxConns int
+	// ConnectAttributes are the database specific attributes of config.NoSQL
+	ConnectAttributes map[string]string
 }
 
 // NewTestCluster returns a new cassandra test cluster
@@ -68,14 +71,16 @@ func NewTestCluster(t *testing.T, params TestClusterParams) testcluster.Persiste
 		schemaBaseDir: params.SchemaBaseDir,
 		replicas:      replicas(params.Replicas),
 		cfg: config.NoSQL{
-			PluginName:   params.PluginName,
-			User:         params.Username,
-			Password:     params.Password,
-			Hosts:        params.Host,
-			Port:         params.Port,
-			MaxConns:     maxConns(params.MaxConns),
-			Keyspace:     params.KeySpace,
-			ProtoVersion: params.ProtoVersion,
+			PluginName:        params.PluginName,
+			User:              params.Username,
+			Password:          params.Password,
+			Hosts:             params.Host,
+			Port:              params.Port,
+			Region:            params.Region,
+			MaxConns:          maxConns(params.MaxConns),
+			Keyspace:          params.KeySpace,
+			ProtoVersion:      params.ProtoVersion,
+			ConnectAttributes: params.ConnectAttributes,
 		},
 	}
 }
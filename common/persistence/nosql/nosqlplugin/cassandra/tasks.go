@@ -75,9 +75,18 @@ func (db *CDB) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 		AckLevel:                ackLevel,
 		RangeID:                 rangeID,
 		AdaptivePartitionConfig: toTaskListPartitionConfig(tlDB["adaptive_partition_config"]),
+		CompatibleBuildIDSets:   toCompatibleBuildIDSets(tlDB["compatible_build_id_sets"]),
 	}, nil
 }
 
+func toCompatibleBuildIDSets(v interface{}) [][]string {
+	sets, ok := v.([][]string)
+	if !ok || len(sets) == 0 {
+		return nil
+	}
+	return sets
+}
+
 func toTaskListPartitionConfig(v interface{}) *persistence.TaskListPartitionConfig {
 	if v == nil {
 		return nil
@@ -193,6 +202,7 @@ func (db *CDB) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow)
 		row.TaskListKind,
 		row.LastUpdatedTime,
 		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
+		row.CompatibleBuildIDSets,
 		timeStamp,
 	).WithContext(ctx)
 
@@ -222,6 +232,7 @@ func (db *CDB) UpdateTaskList(
 		row.TaskListKind,
 		row.LastUpdatedTime,
 		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
+		row.CompatibleBuildIDSets,
 		timeStamp,
 		row.DomainID,
 		row.TaskListName,
@@ -289,6 +300,7 @@ func (db *CDB) UpdateTaskListWithTTL(
 		row.TaskListKind,
 		timeStamp,
 		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
+		row.CompatibleBuildIDSets,
 		timeStamp,
 		row.DomainID,
 		row.TaskListName,
@@ -368,6 +380,9 @@ func (db *CDB) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
+				task.WorkerBuildID,
+				task.FairnessKey,
 				timeStamp,
 			)
 		} else {
@@ -386,6 +401,9 @@ func (db *CDB) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
+				task.WorkerBuildID,
+				task.FairnessKey,
 				timeStamp,
 				ttl)
 		}
@@ -508,6 +526,12 @@ func createTaskInfo(
 			info.CreatedTime = v.(time.Time)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "worker_build_id":
+			info.WorkerBuildID = v.(string)
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		}
 	}
 
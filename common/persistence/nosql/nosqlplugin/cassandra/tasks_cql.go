@@ -29,7 +29,8 @@ const (
 		`ack_level: ?, ` +
 		`kind: ?, ` +
 		`last_updated: ?, ` +
-		`adaptive_partition_config: ? ` +
+		`adaptive_partition_config: ?, ` +
+		`compatible_build_id_sets: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -38,7 +39,10 @@ const (
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
 		`created_time: ?, ` +
-		`partition_config: ? ` +
+		`partition_config: ?, ` +
+		`priority: ?, ` +
+		`worker_build_id: ?, ` +
+		`fairness_key: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
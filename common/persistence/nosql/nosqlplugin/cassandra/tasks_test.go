@@ -80,6 +80,7 @@ func TestSelectTaskList(t *testing.T) {
 							},
 						},
 					}
+					(*tlDB)["compatible_build_id_sets"] = [][]string{{"build-1", "build-2"}, {"build-3"}}
 					return nil
 				}).Times(1)
 			},
@@ -104,6 +105,7 @@ func TestSelectTaskList(t *testing.T) {
 						},
 					},
 				},
+				CompatibleBuildIDSets: [][]string{{"build-1", "build-2"}, {"build-3"}},
 			},
 			wantQueries: []string{
 				`SELECT range_id, task_list FROM tasks WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345`,
@@ -243,7 +245,7 @@ func TestInsertTaskList(t *testing.T) {
 			wantQueries: []string{
 				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, range_id, task_list, created_time ) ` +
 					`VALUES (domain1, tasklist1, 1, 1, -12345, 1, ` +
-					`{domain_id: domain1, name: tasklist1, type: 1, ack_level: 0, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[] }` +
+					`{domain_id: domain1, name: tasklist1, type: 1, ack_level: 0, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[], compatible_build_id_sets: [] }` +
 					`, 2024-04-01T22:08:41Z) IF NOT EXISTS`,
 			},
 		},
@@ -267,6 +269,7 @@ func TestInsertTaskList(t *testing.T) {
 						0: {},
 					},
 				},
+				CompatibleBuildIDSets: [][]string{{"build-1"}, {"build-2"}},
 			},
 			queryMockFn: func(query *gocql.MockQuery) {
 				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
@@ -278,7 +281,7 @@ func TestInsertTaskList(t *testing.T) {
 				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, range_id, task_list, created_time ) ` +
 					`VALUES (domain1, tasklist1, 1, 1, -12345, 1, ` +
 					`{domain_id: domain1, name: tasklist1, type: 1, ack_level: 0, kind: 2, last_updated: 2024-04-01T22:08:41Z, ` +
-					`adaptive_partition_config: map[num_read_partitions:1 num_write_partitions:1 read_partitions:map[0:map[isolation_groups:[]]] version:1 write_partitions:map[0:map[isolation_groups:[]]]] }` +
+					`adaptive_partition_config: map[num_read_partitions:1 num_write_partitions:1 read_partitions:map[0:map[isolation_groups:[]]] version:1 write_partitions:map[0:map[isolation_groups:[]]]], compatible_build_id_sets: [[build-1] [build-2]] }` +
 					`, 2024-04-01T22:08:41Z) IF NOT EXISTS`,
 			},
 		},
@@ -391,7 +394,7 @@ func TestUpdateTaskList(t *testing.T) {
 				}).Times(1)
 			},
 			wantQueries: []string{
-				`UPDATE tasks SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
+				`UPDATE tasks SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[], compatible_build_id_sets: [] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
 			},
 		},
 		{
@@ -503,7 +506,7 @@ func TestUpdateTaskListWithTTL(t *testing.T) {
 			mapExecuteBatchCASApplied: true,
 			wantQueries: []string{
 				` INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, created_time ) VALUES (domain1, tasklist1, 1, 1, -12345, 2024-04-01T22:08:41Z) USING TTL 180`,
-				`UPDATE tasks USING TTL 180 SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
+				`UPDATE tasks USING TTL 180 SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[], compatible_build_id_sets: [] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
 			},
 		},
 		{
@@ -787,8 +790,8 @@ func TestInsertTasks(t *testing.T) {
 			},
 			mapExecuteBatchCASApplied: true,
 			wantQueries: []string{
-				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, task, created_time) VALUES(domain1, tasklist1, 1, 0, 3, {domain_id: domain1, workflow_id: wid1, run_id: rid1, schedule_id: 42,created_time: 2024-04-01T22:08:41Z, partition_config: map[] }, 2024-04-01T22:08:41Z)`,
-				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, task, created_time) VALUES(domain1, tasklist1, 1, 0, 4, {domain_id: domain1, workflow_id: wid1, run_id: rid1, schedule_id: 43,created_time: 2024-04-01T22:08:42Z, partition_config: map[] }, 2024-04-01T22:08:41Z) USING TTL 157680000`,
+				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, task, created_time) VALUES(domain1, tasklist1, 1, 0, 3, {domain_id: domain1, workflow_id: wid1, run_id: rid1, schedule_id: 42,created_time: 2024-04-01T22:08:41Z, partition_config: map[], priority: 0, worker_build_id: , fairness_key:  }, 2024-04-01T22:08:41Z)`,
+				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, task, created_time) VALUES(domain1, tasklist1, 1, 0, 4, {domain_id: domain1, workflow_id: wid1, run_id: rid1, schedule_id: 43,created_time: 2024-04-01T22:08:42Z, partition_config: map[], priority: 0, worker_build_id: , fairness_key:  }, 2024-04-01T22:08:41Z) USING TTL 157680000`,
 				`UPDATE tasks SET range_id = 25, last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
 			},
 		},
@@ -1014,6 +1017,9 @@ func TestSelectTasks(t *testing.T) {
 							"created_time":     ts,
 							"run_id":           &fakeUUID{uuid: "runid1"},
 							"partition_config": map[string]string{},
+							"priority":         3,
+							"worker_build_id":  "build-1",
+							"fairness_key":     "tenant-a",
 						},
 					},
 					{
@@ -1059,6 +1065,9 @@ func TestSelectTasks(t *testing.T) {
 					ScheduledID:     45,
 					CreatedTime:     ts,
 					PartitionConfig: map[string]string{},
+					Priority:        3,
+					WorkerBuildID:   "build-1",
+					FairnessKey:     "tenant-a",
 				},
 				{
 					DomainID:        "domain1",
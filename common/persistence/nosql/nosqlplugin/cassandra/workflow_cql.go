@@ -86,7 +86,10 @@ const (
 		`memo: ?, ` +
 		`partition_config: ?, ` +
 		`active_cluster_selection_policy: ?, ` +
-		`active_cluster_selection_policy_encoding: ?` +
+		`active_cluster_selection_policy_encoding: ?, ` +
+		`priority: ?, ` +
+		`worker_build_id: ?, ` +
+		`fairness_key: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -176,6 +179,8 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
+		`paused: ?, ` +
+		`priority: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
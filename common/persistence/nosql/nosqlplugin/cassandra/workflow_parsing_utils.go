@@ -189,6 +189,12 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 			activeClusterSelectionPolicyEncoding = constants.EncodingType(v.(string))
 		case "cron_overlap_policy":
 			info.CronOverlapPolicy = types.CronOverlapPolicy(int32(v.(int)))
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "worker_build_id":
+			info.WorkerBuildID = v.(string)
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -327,6 +333,10 @@ func parseActivityInfo(
 			info.LastWorkerIdentity = v.(string)
 		case "last_failure_details":
 			info.LastFailureDetails = v.([]byte)
+		case "paused":
+			info.Paused = v.(bool)
+		case "priority":
+			info.Priority = int32(v.(int))
 		case "event_data_encoding":
 			sharedEncoding = constants.EncodingType(v.(string))
 		}
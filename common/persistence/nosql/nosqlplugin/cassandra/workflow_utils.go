@@ -1050,6 +1050,8 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["paused"] = a.Paused
+		aInfo["priority"] = a.Priority
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -1103,6 +1105,8 @@ func updateActivityInfos(
 			a.LastFailureReason,
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
+			a.Paused,
+			a.Priority,
 			a.ScheduledEvent.GetEncodingString(),
 			timeStamp,
 			shardID,
@@ -1415,6 +1419,9 @@ func updateWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Priority,
+		execution.WorkerBuildID,
+		execution.FairnessKey,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1517,6 +1524,9 @@ func createWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Priority,
+		execution.WorkerBuildID,
+		execution.FairnessKey,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -21,14 +21,166 @@
 
 package dynamodb
 
-import "github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	testSchemaDir  = "schema/dynamodb/"
+	schemaFileName = "cadence/schema.json"
+)
+
+type (
+	// schema is the format of schema/dynamodb/cadence/schema.json
+	schema struct {
+		Tables []tableSchema `json:"tables"`
+	}
+
+	tableSchema struct {
+		Name                  string        `json:"name"`
+		TTLAttribute          string        `json:"ttlAttribute,omitempty"`
+		LocalSecondaryIndexes []indexSchema `json:"localSecondaryIndexes,omitempty"`
+	}
+
+	indexSchema struct {
+		Name    string `json:"name"`
+		SortKey string `json:"sortKey"`
+	}
+)
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
 func (db *ddb) SetupTestDatabase(schemaBaseDir string, replicas int) error {
-	panic("TODO")
+	if schemaBaseDir == "" {
+		var err error
+		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+		if err != nil {
+			return err
+		}
+	}
+	s, err := readSchema(filepath.Join(schemaBaseDir, schemaFileName))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	// drop the tables left over by a previous run
+	if err := db.dropTables(ctx, s); err != nil {
+		return err
+	}
+	for _, table := range s.Tables {
+		if err := db.createTable(ctx, table); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	schemaBaseDir, err := nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+	if err != nil {
+		return err
+	}
+	s, err := readSchema(filepath.Join(schemaBaseDir, schemaFileName))
+	if err != nil {
+		return err
+	}
+	return db.dropTables(context.Background(), s)
+}
+
+func readSchema(path string) (*schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &schema{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("invalid schema file %v: %w", path, err)
+	}
+	return s, nil
+}
+
+func (db *ddb) createTable(ctx context.Context, table tableSchema) error {
+	attributes := []*dynamodb.AttributeDefinition{
+		{AttributeName: aws.String(attrPartitionKey), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+		{AttributeName: aws.String(attrSortKey), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+	}
+	var indexes []*dynamodb.LocalSecondaryIndex
+	for _, index := range table.LocalSecondaryIndexes {
+		attributes = append(attributes, &dynamodb.AttributeDefinition{
+			AttributeName: aws.String(index.SortKey),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		})
+		indexes = append(indexes, &dynamodb.LocalSecondaryIndex{
+			IndexName: aws.String(index.Name),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(attrPartitionKey), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String(index.SortKey), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+			Projection: &dynamodb.Projection{ProjectionType: aws.String(dynamodb.ProjectionTypeAll)},
+		})
+	}
+
+	_, err := db.client.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		TableName:            db.table(table.Name),
+		AttributeDefinitions: attributes,
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String(attrPartitionKey), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			{AttributeName: aws.String(attrSortKey), KeyType: aws.String(dynamodb.KeyTypeRange)},
+		},
+		LocalSecondaryIndexes: indexes,
+		BillingMode:           aws.String(dynamodb.BillingModePayPerRequest),
+	})
+	if err != nil {
+		return fmt.Errorf("create table %v error: %w", table.Name, err)
+	}
+	if err := db.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{
+		TableName: db.table(table.Name),
+	}); err != nil {
+		return err
+	}
+
+	if table.TTLAttribute != "" {
+		_, err = db.client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: db.table(table.Name),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: aws.String(table.TTLAttribute),
+				Enabled:       aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("enable TTL on table %v error: %w", table.Name, err)
+		}
+	}
+	db.logger.Debug("created table", tag.Value(*db.table(table.Name)))
+	return nil
+}
+
+func (db *ddb) dropTables(ctx context.Context, s *schema) error {
+	for _, table := range s.Tables {
+		_, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{
+			TableName: db.table(table.Name),
+		})
+		if err != nil && !isResourceNotFound(err) {
+			return fmt.Errorf("delete table %v error: %w", table.Name, err)
+		}
+	}
+	return nil
+}
+
+func isResourceNotFound(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == dynamodb.ErrCodeResourceNotFoundException
 }
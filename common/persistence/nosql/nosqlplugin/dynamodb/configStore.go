@@ -22,15 +22,58 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// The cluster_config table is partitioned by row type and sorted by version.
+
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	item := itemKey(strconv.Itoa(row.RowType), padInt64(row.Version))
+	item[attrVersion] = numAttr(row.Version)
+	item[attrCreatedTime] = numAttr(row.Timestamp.UnixNano())
+	item[attrData] = binAttr(row.Values.Data)
+	item[attrDataEncoding] = strAttr(row.Values.GetEncodingString())
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.table(tableClusterConfig),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey)},
+	})
+	if isConditionalCheckFailedException(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	resp, err := db.client.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName:                 db.table(tableClusterConfig),
+		ConsistentRead:            aws.Bool(true),
+		KeyConditionExpression:    aws.String("#pk = :pk"),
+		ExpressionAttributeNames:  map[string]*string{"#pk": aws.String(attrPartitionKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":pk": strAttr(strconv.Itoa(rowType))},
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int64(1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Items) == 0 {
+		return nil, errNotFound
+	}
+	item := resp.Items[0]
+	return &persistence.InternalConfigStoreEntry{
+		RowType:   rowType,
+		Version:   getInt64(item, attrVersion),
+		Timestamp: time.Unix(0, getInt64(item, attrCreatedTime)),
+		Values:    persistence.NewDataBlob(getBytes(item, attrData), constants.EncodingType(getString(item, attrDataEncoding))),
+	}, nil
 }
@@ -21,8 +21,12 @@
 package dynamodb
 
 import (
+	"context"
 	"errors"
-	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
@@ -36,21 +40,26 @@ const (
 
 var (
 	errConditionFailed = errors.New("internal condition fail error")
+	errNotFound        = errors.New("dynamodb item not found")
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client      client
+	tablePrefix string
+	cfg         *config.NoSQL
+	logger      log.Logger
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return newDDB(&cfg, logger)
 }
 
 func (db *ddb) Close() {
-	panic("TODO")
+	// the AWS client is stateless over HTTP, there is no connection to close
 }
 
 func (db *ddb) PluginName() string {
@@ -58,21 +67,52 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	return errors.Is(err, errNotFound)
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case request.ErrCodeResponseTimeout, "RequestTimeout", "RequestTimeoutException":
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeProvisionedThroughputExceededException,
+			dynamodb.ErrCodeRequestLimitExceeded,
+			"ThrottlingException":
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeInternalServerError, "ServiceUnavailable":
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsConditionFailedError(err error) bool {
 	return err == errConditionFailed
 }
+
+// table returns the physical name of a table, all the tables of a cluster share the keyspace as prefix
+func (db *ddb) table(name string) *string {
+	return tableName(db.tablePrefix, name)
+}
@@ -22,17 +22,155 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+// All the domains live in a single partition of the domain table, like domains_by_name_v2 of Cassandra,
+// so that a domain write and the metadata notification version can be updated in one transaction:
+//   - name#<name> holds the domain row
+//   - id#<id> points to the name of the domain
+//   - metadata holds the notification version
+const (
+	domainPartition         = "domain"
+	sortKeyDomainMetadata   = "metadata"
+	sortKeyDomainNamePrefix = "name#"
+	sortKeyDomainIDPrefix   = "id#"
+
+	attrDomainName          = "name"
+	attrNotificationVersion = "notification_version"
 )
 
+func domainNameKey(name string) map[string]*dynamodb.AttributeValue {
+	return itemKey(domainPartition, sortKeyDomainNamePrefix+name)
+}
+
+func domainIDKey(id string) map[string]*dynamodb.AttributeValue {
+	return itemKey(domainPartition, sortKeyDomainIDPrefix+id)
+}
+
+func domainMetadataKey() map[string]*dynamodb.AttributeValue {
+	return itemKey(domainPartition, sortKeyDomainMetadata)
+}
+
+func toDomainItem(row *nosqlplugin.DomainRow) (map[string]*dynamodb.AttributeValue, error) {
+	payload, err := toPayload(row)
+	if err != nil {
+		return nil, err
+	}
+	item := domainNameKey(row.Info.Name)
+	item[attrDomainID] = strAttr(row.Info.ID)
+	item[attrNotificationVersion] = numAttr(row.NotificationVersion)
+	item[attrPayload] = payload
+	return item, nil
+}
+
+func fromDomainItem(item map[string]*dynamodb.AttributeValue) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := fromPayload(item, attrPayload, row); err != nil {
+		return nil, err
+	}
+	if row.FailoverEndTime != nil && row.FailoverEndTime.IsZero() {
+		row.FailoverEndTime = nil
+	}
+	if row.Config != nil && row.Config.BadBinaries == nil {
+		row.Config.BadBinaries = persistence.NewDataBlob(nil, constants.EncodingTypeEmpty)
+	}
+	return row, nil
+}
+
+// updateMetadata bumps the notification version, conditioned on the current one
+func (db *ddb) updateMetadata(notificationVersion int64) *dynamodb.TransactWriteItem {
+	condition := "#version = :version"
+	values := map[string]*dynamodb.AttributeValue{
+		":next": numAttr(notificationVersion + 1),
+	}
+	if notificationVersion == 0 {
+		// the metadata item is created by the first domain
+		condition = "attribute_not_exists(#version) OR #version = :version"
+	}
+	values[":version"] = numAttr(notificationVersion)
+	return &dynamodb.TransactWriteItem{
+		Update: &dynamodb.Update{
+			TableName:                           db.table(tableDomain),
+			Key:                                 domainMetadataKey(),
+			UpdateExpression:                    aws.String("SET #version = :next"),
+			ConditionExpression:                 aws.String(condition),
+			ExpressionAttributeNames:            map[string]*string{"#version": aws.String(attrNotificationVersion)},
+			ExpressionAttributeValues:           values,
+			ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+		},
+	}
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := *row
+	stored.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	stored.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	stored.NotificationVersion = metadataNotificationVersion
+	item, err := toDomainItem(&stored)
+	if err != nil {
+		return err
+	}
+	idItem := domainIDKey(row.Info.ID)
+	idItem[attrDomainName] = strAttr(row.Info.Name)
+
+	notExists := aws.String("attribute_not_exists(#pk)")
+	pkName := map[string]*string{"#pk": aws.String(attrPartitionKey)}
+	reasons, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName:                db.table(tableDomain),
+				Item:                     idItem,
+				ConditionExpression:      notExists,
+				ExpressionAttributeNames: pkName,
+			},
+		},
+		{
+			Put: &dynamodb.Put{
+				TableName:                db.table(tableDomain),
+				Item:                     item,
+				ConditionExpression:      notExists,
+				ExpressionAttributeNames: pkName,
+			},
+		},
+		db.updateMetadata(metadataNotificationVersion),
+	})
+	if err != nil {
+		return err
+	}
+	if reasons == nil {
+		return nil
+	}
+	switch {
+	case isConditionalCheckFailed(reasons[1]):
+		db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	case isConditionalCheckFailed(reasons[0]):
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	default:
+		return nosqlplugin.NewConditionFailure("domain")
+	}
 }
 
 // Update domain
@@ -40,7 +178,26 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	item, err := toDomainItem(row)
+	if err != nil {
+		return err
+	}
+	reasons, err := db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName: db.table(tableDomain),
+				Item:      item,
+			},
+		},
+		db.updateMetadata(row.NotificationVersion),
+	})
+	if err != nil {
+		return err
+	}
+	if reasons != nil {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +206,26 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	name := aws.StringValue(domainName)
+	if domainID != nil {
+		idItem, err := db.getItem(ctx, tableDomain, domainIDKey(*domainID))
+		if err != nil {
+			return nil, err
+		}
+		name = getString(idItem, attrDomainName)
+	}
+
+	item, err := db.getItem(ctx, tableDomain, domainNameKey(name))
+	if err != nil {
+		return nil, err
+	}
+	return fromDomainItem(item)
 }
 
 // Get all domain data
@@ -58,7 +234,30 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, tableDomain, &dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#pk": aws.String(attrPartitionKey),
+			"#sk": aws.String(attrSortKey),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":     strAttr(domainPartition),
+			":prefix": strAttr(sortKeyDomainNamePrefix),
+		},
+	}, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(items))
+	for _, item := range items {
+		row, err := fromDomainItem(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +266,47 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	var name, id string
+	if domainName == nil {
+		item, err := db.getItem(ctx, tableDomain, domainIDKey(*domainID))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		name, id = getString(item, attrDomainName), *domainID
+	} else {
+		item, err := db.getItem(ctx, tableDomain, domainNameKey(*domainName))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		name, id = *domainName, getString(item, attrDomainID)
+	}
+
+	if err := db.deleteItem(ctx, tableDomain, domainNameKey(name)); err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, tableDomain, domainIDKey(id))
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableDomain, domainMetadataKey())
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata item is created along with the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return getInt64(item, attrNotificationVersion), nil
 }
@@ -22,16 +22,85 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
+// The audit logs of a domain operation type share a partition, the sort key is the inverted created time
+// followed by the event ID, so that an ascending query returns created_time DESC, event_id ASC.
+func domainAuditLogPartitionKey(domainID string, operationType int) string {
+	return fmt.Sprintf("%v#%v", domainID, operationType)
+}
+
+func domainAuditLogSortKeyPrefix(createdTime time.Time) string {
+	return padInt64(math.MaxInt64 - createdTime.UnixNano())
+}
+
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *ddb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	panic("TODO: InsertDomainAuditLog not implemented")
+	payload, err := toPayload(row)
+	if err != nil {
+		return err
+	}
+	item := itemKey(
+		domainAuditLogPartitionKey(row.DomainID, int(row.OperationType)),
+		domainAuditLogSortKeyPrefix(row.CreatedTime)+"#"+row.EventID,
+	)
+	item[attrPayload] = payload
+	if row.TTLSeconds > 0 {
+		item[attrTTL] = ttlAttr(time.Now(), row.TTLSeconds)
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.table(tableDomainAuditLog),
+		Item:      item,
+	})
+	return err
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *ddb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	panic("TODO: SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+
+	// MaxCreatedTime is exclusive: the first sort key after all the entries created at MaxCreatedTime.
+	// MinCreatedTime is inclusive: '$' sorts after the '#' separator of the entries created at MinCreatedTime.
+	items, nextPageToken, err := db.queryPage(ctx, tableDomainAuditLog, &dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :min AND :max"),
+		FilterExpression:       aws.String("attribute_not_exists(#ttl) OR #ttl > :now"),
+		ExpressionAttributeNames: map[string]*string{
+			"#pk":  aws.String(attrPartitionKey),
+			"#sk":  aws.String(attrSortKey),
+			"#ttl": aws.String(attrTTL),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":  strAttr(domainAuditLogPartitionKey(filter.DomainID, int(filter.OperationType))),
+			":min": strAttr(domainAuditLogSortKeyPrefix(*filter.MaxCreatedTime) + "$"),
+			":max": strAttr(domainAuditLogSortKeyPrefix(*filter.MinCreatedTime) + "$"),
+			":now": numAttr(time.Now().Unix()),
+		},
+	}, filter.NextPageToken, filter.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := fromPayload(item, attrPayload, row); err != nil {
+			return nil, nil, err
+		}
+		row.TTLSeconds = 0
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
@@ -0,0 +1,173 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func newDomainRow() *nosqlplugin.DomainRow {
+	return &nosqlplugin.DomainRow{
+		Info: &persistence.DomainInfo{
+			ID:   "domain-id",
+			Name: "domain-name",
+		},
+		Config: &persistence.InternalDomainConfig{
+			Retention:   7 * 24 * time.Hour,
+			BadBinaries: persistence.NewDataBlob([]byte("bad-binaries"), constants.EncodingTypeThriftRW),
+		},
+		ReplicationConfig: &persistence.InternalDomainReplicationConfig{
+			ActiveClusterName: "cluster1",
+		},
+		FailoverVersion: 10,
+		LastUpdatedTime: time.Date(2024, 4, 2, 18, 0, 0, 0, time.UTC),
+	}
+}
+
+func canceledTransaction(codes ...string) error {
+	reasons := make([]*dynamodb.CancellationReason, 0, len(codes))
+	for _, code := range codes {
+		reasons = append(reasons, &dynamodb.CancellationReason{Code: aws.String(code)})
+	}
+	return &dynamodb.TransactionCanceledException{CancellationReasons: reasons}
+}
+
+func TestInsertDomain(t *testing.T) {
+	tests := []struct {
+		name      string
+		txErr     error
+		wantErrFn func(t *testing.T, err error)
+	}{
+		{
+			name: "successfully applied",
+		},
+		{
+			name:  "domain already exists",
+			txErr: canceledTransaction(cancellationReasonNone, cancellationReasonConditionalCheckFailed, cancellationReasonNone),
+			wantErrFn: func(t *testing.T, err error) {
+				var alreadyExists *types.DomainAlreadyExistsError
+				assert.True(t, errors.As(err, &alreadyExists))
+			},
+		},
+		{
+			name:  "uuid collision",
+			txErr: canceledTransaction(cancellationReasonConditionalCheckFailed, cancellationReasonNone, cancellationReasonNone),
+			wantErrFn: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "uuid collision")
+			},
+		},
+		{
+			name:  "notification version changed",
+			txErr: canceledTransaction(cancellationReasonNone, cancellationReasonNone, cancellationReasonConditionalCheckFailed),
+			wantErrFn: func(t *testing.T, err error) {
+				var conditionErr *nosqlplugin.ConditionFailure
+				assert.True(t, errors.As(err, &conditionErr))
+			},
+		},
+		{
+			name:  "transaction conflict",
+			txErr: canceledTransaction(cancellationReasonNone, "TransactionConflict", cancellationReasonNone),
+			wantErrFn: func(t *testing.T, err error) {
+				var canceled *dynamodb.TransactionCanceledException
+				assert.True(t, errors.As(err, &canceled))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			db, client := newTestDDB(t)
+			client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).Return(&dynamodb.GetItemOutput{
+				Item: map[string]*dynamodb.AttributeValue{attrNotificationVersion: numAttr(5)},
+			}, nil)
+			client.EXPECT().TransactWriteItemsWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, input *dynamodb.TransactWriteItemsInput, _ ...interface{}) (*dynamodb.TransactWriteItemsOutput, error) {
+					require.Len(t, input.TransactItems, 3)
+					assert.Equal(t, sortKeyDomainIDPrefix+"domain-id", *input.TransactItems[0].Put.Item[attrSortKey].S)
+					assert.Equal(t, sortKeyDomainNamePrefix+"domain-name", *input.TransactItems[1].Put.Item[attrSortKey].S)
+					assert.Equal(t, "5", *input.TransactItems[1].Put.Item[attrNotificationVersion].N)
+					assert.Equal(t, "5", *input.TransactItems[2].Update.ExpressionAttributeValues[":version"].N)
+					assert.Equal(t, "6", *input.TransactItems[2].Update.ExpressionAttributeValues[":next"].N)
+					return &dynamodb.TransactWriteItemsOutput{}, tc.txErr
+				})
+
+			err := db.InsertDomain(context.Background(), newDomainRow())
+			if tc.wantErrFn == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			tc.wantErrFn(t, err)
+		})
+	}
+}
+
+func TestSelectDomain(t *testing.T) {
+	row := newDomainRow()
+	item, err := toDomainItem(row)
+	require.NoError(t, err)
+
+	db, client := newTestDDB(t)
+	gomock.InOrder(
+		client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *dynamodb.GetItemInput, _ ...interface{}) (*dynamodb.GetItemOutput, error) {
+				assert.Equal(t, sortKeyDomainIDPrefix+"domain-id", *input.Key[attrSortKey].S)
+				return &dynamodb.GetItemOutput{Item: map[string]*dynamodb.AttributeValue{attrDomainName: strAttr("domain-name")}}, nil
+			}),
+		client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *dynamodb.GetItemInput, _ ...interface{}) (*dynamodb.GetItemOutput, error) {
+				assert.Equal(t, sortKeyDomainNamePrefix+"domain-name", *input.Key[attrSortKey].S)
+				return &dynamodb.GetItemOutput{Item: item}, nil
+			}),
+	)
+
+	got, err := db.SelectDomain(context.Background(), aws.String("domain-id"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, row, got)
+
+	_, err = db.SelectDomain(context.Background(), aws.String("domain-id"), aws.String("domain-name"))
+	assert.Error(t, err)
+	_, err = db.SelectDomain(context.Background(), nil, nil)
+	assert.Error(t, err)
+}
+
+func TestSelectDomainMetadata(t *testing.T) {
+	db, client := newTestDDB(t)
+	client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).Return(&dynamodb.GetItemOutput{}, nil)
+
+	version, err := db.SelectDomainMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), version)
+}
@@ -22,31 +22,212 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+// The history_node table is partitioned by tree, a node is sorted by branch, node_id(ASC) and txn_id(DESC),
+// same as the clustering order in Cassandra. The history_tree table is partitioned by tree and sorted by branch.
+const (
+	attrAncestors = "ancestors"
+	attrInfo      = "info"
+	attrNodeID    = "node_id"
+	attrTxnID     = "txn_id"
+
+	// historyNodeSortKeyMax sorts after any node of a branch
+	historyNodeSortKeyMax = "~"
 )
 
+func historyNodeSortKeyPrefix(branchID string, nodeID int64) string {
+	return branchID + "#" + padInt64(nodeID) + "#"
+}
+
+func historyNodeSortKey(branchID string, nodeID int64, txnID int64) string {
+	// txn_id is stored inverted so that the bigger txn_id comes first
+	return historyNodeSortKeyPrefix(branchID, nodeID) + padInt64(math.MaxInt64-txnID)
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var items []*dynamodb.TransactWriteItem
+	if treeRow != nil {
+		ancestors, err := toPayload(treeRow.Ancestors)
+		if err != nil {
+			return err
+		}
+		item := itemKey(treeRow.TreeID, treeRow.BranchID)
+		item[attrAncestors] = ancestors
+		item[attrInfo] = strAttr(treeRow.Info)
+		item[attrCreatedTime] = numAttr(treeRow.CreateTimestamp.UnixNano())
+		items = append(items, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{TableName: db.table(tableHistoryTree), Item: item},
+		})
+	}
+	if nodeRow != nil {
+		if nodeRow.TxnID == nil {
+			return fmt.Errorf("txn_id is required for a node row")
+		}
+		item := itemKey(nodeRow.TreeID, historyNodeSortKey(nodeRow.BranchID, nodeRow.NodeID, *nodeRow.TxnID))
+		item[attrNodeID] = numAttr(nodeRow.NodeID)
+		item[attrTxnID] = numAttr(*nodeRow.TxnID)
+		item[attrData] = binAttr(nodeRow.Data)
+		item[attrDataEncoding] = strAttr(nodeRow.DataEncoding)
+		item[attrCreatedTime] = numAttr(nodeRow.CreateTimestamp.UnixNano())
+		items = append(items, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{TableName: db.table(tableHistoryNode), Item: item},
+		})
+	}
+
+	if len(items) == 1 {
+		put := items[0].Put
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{TableName: put.TableName, Item: put.Item})
+		return err
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: items})
+	return err
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	// the prefix of MaxNodeID sorts before all the nodes of MaxNodeID, so BETWEEN excludes them
+	items, nextPageToken, err := db.queryPage(ctx, tableHistoryNode, historyNodeRangeQuery(
+		filter.TreeID,
+		historyNodeSortKeyPrefix(filter.BranchID, filter.MinNodeID),
+		historyNodeSortKeyPrefix(filter.BranchID, filter.MaxNodeID),
+	), filter.NextPageToken, filter.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(items))
+	for _, item := range items {
+		txnID := getInt64(item, attrTxnID)
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			NodeID:       getInt64(item, attrNodeID),
+			TxnID:        &txnID,
+			Data:         getBytes(item, attrData),
+			DataEncoding: getString(item, attrDataEncoding),
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	if treeFilter.BranchID == nil {
+		return fmt.Errorf("branch_id is required to delete from history tree")
+	}
+	// the nodes are deleted first, so the branch can still be found to retry if any of the deletions fails
+	for _, nodeFilter := range nodeFilters {
+		query := historyNodeRangeQuery(
+			nodeFilter.TreeID,
+			historyNodeSortKeyPrefix(nodeFilter.BranchID, nodeFilter.MinNodeID),
+			nodeFilter.BranchID+"#"+historyNodeSortKeyMax,
+		)
+		query.ConsistentRead = aws.Bool(true)
+		if _, err := db.rangeDelete(ctx, tableHistoryNode, query); err != nil {
+			return err
+		}
+	}
+	return db.deleteItem(ctx, tableHistoryTree, itemKey(treeFilter.TreeID, *treeFilter.BranchID))
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	startKey, err := decodePageToken(nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := db.client.ScanWithContext(ctx, &dynamodb.ScanInput{
+		TableName:         db.table(tableHistoryTree),
+		ExclusiveStartKey: startKey,
+		Limit:             toPageSize(pageSize),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		row, err := toHistoryTreeRow(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	token, err := encodePageToken(resp.LastEvaluatedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, token, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	items, err := db.queryAll(ctx, &dynamodb.QueryInput{
+		TableName:                 db.table(tableHistoryTree),
+		KeyConditionExpression:    aws.String("#pk = :pk"),
+		ExpressionAttributeNames:  map[string]*string{"#pk": aws.String(attrPartitionKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":pk": strAttr(filter.TreeID)},
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, item := range items {
+		row, err := toHistoryTreeRow(item)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func historyNodeRangeQuery(treeID, minSortKey, maxSortKey string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		KeyConditionExpression:   aws.String("#pk = :pk AND #sk BETWEEN :min AND :max"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey), "#sk": aws.String(attrSortKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":  strAttr(treeID),
+			":min": strAttr(minSortKey),
+			":max": strAttr(maxSortKey),
+		},
+	}
+}
+
+func toHistoryTreeRow(item map[string]*dynamodb.AttributeValue) (*nosqlplugin.HistoryTreeRow, error) {
+	var ancestors []*types.HistoryBranchRange
+	if err := fromPayload(item, attrAncestors, &ancestors); err != nil {
+		return nil, err
+	}
+	if len(ancestors) > 0 {
+		// sort ancestors based on EndNodeID so that we can set BeginNodeID
+		sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+		ancestors[0].BeginNodeID = int64(1)
+		for i := 1; i < len(ancestors); i++ {
+			ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+		}
+	}
+	return &nosqlplugin.HistoryTreeRow{
+		TreeID:          getString(item, attrPartitionKey),
+		BranchID:        getString(item, attrSortKey),
+		Ancestors:       ancestors,
+		CreateTimestamp: time.Unix(0, getInt64(item, attrCreatedTime)),
+		Info:            getString(item, attrInfo),
+	}, nil
 }
@@ -0,0 +1,74 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func TestToEndpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.NoSQL
+		want string
+	}{
+		{
+			name: "no hosts",
+			cfg:  &config.NoSQL{},
+			want: "",
+		},
+		{
+			name: "host and port",
+			cfg:  &config.NoSQL{Hosts: "localhost", Port: 8000},
+			want: "http://localhost:8000",
+		},
+		{
+			name: "multiple hosts with TLS",
+			cfg:  &config.NoSQL{Hosts: " dynamodb.us-east-1.amazonaws.com, other", TLS: &config.TLS{Enabled: true}},
+			want: "https://dynamodb.us-east-1.amazonaws.com",
+		},
+		{
+			name: "host with scheme",
+			cfg:  &config.NoSQL{Hosts: "https://localhost:8000", Port: 9000},
+			want: "https://localhost:8000",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, toEndpoint(tc.cfg))
+		})
+	}
+}
+
+func TestNewDDB(t *testing.T) {
+	_, err := newDDB(&config.NoSQL{}, nil)
+	assert.Error(t, err)
+
+	db, err := newDDB(&config.NoSQL{Keyspace: "cadence", Hosts: "localhost", Port: 8000, Region: "us-east-1"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "cadence_executions", *db.table(tableExecutions))
+	assert.Equal(t, PluginName, db.PluginName())
+}
@@ -22,18 +22,81 @@ package dynamodb
 
 import (
 	"context"
+	"math"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// The queue_message table is partitioned by queue type and sorted by message ID.
+// The queue_metadata table has a single item per queue type.
+const (
+	sortKeyQueueMetadata  = "metadata"
+	attrMessageID         = "message_id"
+	attrMessagePayload    = "message_payload"
+	attrClusterAckLevels  = "cluster_ack_levels"
+	queueConditionFailure = "queue"
+)
+
+func queuePartitionKey(queueType persistence.QueueType) string {
+	return strconv.Itoa(int(queueType))
+}
+
+func queueMessageSortKey(messageID int64) string {
+	return padInt64(messageID)
+}
+
+func queueMetadataKey(queueType persistence.QueueType) map[string]*dynamodb.AttributeValue {
+	return itemKey(queuePartitionKey(queueType), sortKeyQueueMetadata)
+}
+
+// queueRangeQuery returns the query of the messages between exclusiveBeginMessageID and inclusiveEndMessageID
+func (db *ddb) queueRangeQuery(queueType persistence.QueueType, exclusiveBeginMessageID, inclusiveEndMessageID int64) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:                db.table(tableQueueMessage),
+		ConsistentRead:           aws.Bool(true),
+		KeyConditionExpression:   aws.String("#pk = :pk AND #sk BETWEEN :min AND :max"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey), "#sk": aws.String(attrSortKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":  strAttr(queuePartitionKey(queueType)),
+			":min": strAttr(queueMessageSortKey(exclusiveBeginMessageID + 1)),
+			":max": strAttr(queueMessageSortKey(inclusiveEndMessageID)),
+		},
+	}
+}
+
+func toQueueMessageRow(queueType persistence.QueueType, item map[string]*dynamodb.AttributeValue) *nosqlplugin.QueueMessageRow {
+	return &nosqlplugin.QueueMessageRow{
+		QueueType: queueType,
+		ID:        getInt64(item, attrMessageID),
+		Payload:   getBytes(item, attrMessagePayload),
+	}
+}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	item := itemKey(queuePartitionKey(row.QueueType), queueMessageSortKey(row.ID))
+	item[attrMessageID] = numAttr(row.ID)
+	item[attrMessagePayload] = binAttr(row.Payload)
+	item[attrCreatedTime] = numAttr(row.CurrentTimeStamp.UnixNano())
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.table(tableQueueMessage),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey)},
+	})
+	if isConditionalCheckFailedException(err) {
+		return nosqlplugin.NewConditionFailure(queueConditionFailure)
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +104,17 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	input := db.queueRangeQuery(queueType, -1, math.MaxInt64)
+	input.ScanIndexForward = aws.Bool(false)
+	input.Limit = aws.Int64(1)
+	resp, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Items) == 0 {
+		return 0, errNotFound
+	}
+	return getInt64(resp.Items[0], attrMessageID), nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +124,17 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	input := db.queueRangeQuery(queueType, exclusiveBeginMessageID, math.MaxInt64)
+	input.Limit = toPageSize(maxRows)
+	resp, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	var result []*nosqlplugin.QueueMessageRow
+	for _, item := range resp.Items {
+		result = append(result, toQueueMessageRow(queueType, item))
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +142,23 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	if request.ExclusiveBeginMessageID >= request.InclusiveEndMessageID {
+		return &nosqlplugin.SelectMessagesBetweenResponse{}, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, tableQueueMessage,
+		db.queueRangeQuery(request.QueueType, request.ExclusiveBeginMessageID, request.InclusiveEndMessageID),
+		request.NextPageToken, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]nosqlplugin.QueueMessageRow, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, *toQueueMessageRow(request.QueueType, item))
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +167,11 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID <= 0 {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tableQueueMessage, db.queueRangeQuery(queueType, -1, exclusiveBeginMessageID-1))
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +181,11 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID >= inclusiveEndMessageID {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tableQueueMessage, db.queueRangeQuery(queueType, exclusiveBeginMessageID, inclusiveEndMessageID))
+	return err
 }
 
 // Delete one message
@@ -87,12 +194,30 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableQueueMessage, itemKey(queuePartitionKey(queueType), queueMessageSortKey(messageID)))
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *ddb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	panic("TODO")
+	ackLevels, err := toPayload(map[string]int64{})
+	if err != nil {
+		return err
+	}
+	item := queueMetadataKey(row.QueueType)
+	item[attrClusterAckLevels] = ackLevels
+	item[attrVersion] = numAttr(row.Version)
+	item[attrCreatedTime] = numAttr(row.CurrentTimeStamp.UnixNano())
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.table(tableQueueMetadata),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey)},
+	})
+	if isConditionalCheckFailedException(err) {
+		// it's ok if the item exists already
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -102,7 +227,31 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	ackLevels, err := toPayload(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:           db.table(tableQueueMetadata),
+		Key:                 queueMetadataKey(row.QueueType),
+		UpdateExpression:    aws.String("SET #ack_levels = :ack_levels, #version = :version, #updated_time = :updated_time"),
+		ConditionExpression: aws.String("#version = :previous_version"),
+		ExpressionAttributeNames: map[string]*string{
+			"#ack_levels":   aws.String(attrClusterAckLevels),
+			"#version":      aws.String(attrVersion),
+			"#updated_time": aws.String(attrLastUpdatedTime),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":ack_levels":       ackLevels,
+			":version":          numAttr(row.Version),
+			":updated_time":     numAttr(row.CurrentTimeStamp.UnixNano()),
+			":previous_version": numAttr(row.Version - 1),
+		},
+	})
+	if isConditionalCheckFailedException(err) {
+		return nosqlplugin.NewConditionFailure(queueConditionFailure)
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -110,12 +259,28 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableQueueMetadata, queueMetadataKey(queueType))
+	if err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := fromPayload(item, attrClusterAckLevels, &ackLevels); err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          getInt64(item, attrVersion),
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.queryCount(ctx, db.queueRangeQuery(queueType, -1, math.MaxInt64))
 }
@@ -22,29 +22,165 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// The shard is an item of the executions table, so that workflow updates can be fenced
+// by a ConditionCheck on its range_id in the same transaction.
+const (
+	sortKeyShard = "shard"
+)
+
+func shardPartitionKey(shardID int) string {
+	return strconv.Itoa(shardID)
+}
+
+func shardKey(shardID int) map[string]*dynamodb.AttributeValue {
+	return itemKey(shardPartitionKey(shardID), sortKeyShard)
+}
+
+// shardRangeIDCondition returns the ConditionCheck that fences a transaction on the shard's range_id
+func (db *ddb) shardRangeIDCondition(shardID int, rangeID int64) *dynamodb.TransactWriteItem {
+	return &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                           db.table(tableExecutions),
+			Key:                                 shardKey(shardID),
+			ConditionExpression:                 aws.String("#range_id = :range_id"),
+			ExpressionAttributeNames:            map[string]*string{"#range_id": aws.String(attrRangeID)},
+			ExpressionAttributeValues:           map[string]*dynamodb.AttributeValue{":range_id": numAttr(rangeID)},
+			ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+		},
+	}
+}
+
+func toShardItem(row *nosqlplugin.ShardRow) (map[string]*dynamodb.AttributeValue, error) {
+	info := *row.InternalShardInfo
+	info.UpdatedAt = row.CurrentTimestamp
+	payload, err := toPayload(&info)
+	if err != nil {
+		return nil, err
+	}
+	item := shardKey(row.ShardID)
+	item[attrRangeID] = numAttr(row.RangeID)
+	item[attrPayload] = payload
+	item[attrData] = binAttr(row.Data)
+	item[attrDataEncoding] = strAttr(row.DataEncoding)
+	return item, nil
+}
+
+func toConflictedShardError(shardID int, item map[string]*dynamodb.AttributeValue) error {
+	rangeID := getInt64(item, attrRangeID)
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("shard_id=%v,range_id=%v,exists=%v", shardID, rangeID, len(item) > 0),
+	}
+}
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	item, err := toShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.table(tableExecutions),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey)},
+	})
+	if isConditionalCheckFailedException(err) {
+		return db.readConflictedShard(ctx, row.ShardID)
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecutions, shardKey(shardID))
+	if err != nil {
+		return 0, nil, err
+	}
+	info := &persistence.InternalShardInfo{}
+	if err := fromPayload(item, attrPayload, info); err != nil {
+		return 0, nil, err
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	rangeID := getInt64(item, attrRangeID)
+	return rangeID, &nosqlplugin.ShardRow{
+		InternalShardInfo: info,
+		Data:              getBytes(item, attrData),
+		DataEncoding:      getString(item, attrDataEncoding),
+	}, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	_, err := db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                db.table(tableExecutions),
+		Key:                      shardKey(shardID),
+		UpdateExpression:         aws.String("SET #range_id = :range_id"),
+		ConditionExpression:      aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames: map[string]*string{"#range_id": aws.String(attrRangeID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":range_id":          numAttr(rangeID),
+			":previous_range_id": numAttr(previousRangeID),
+		},
+	})
+	if isConditionalCheckFailedException(err) {
+		return db.readConflictedShard(ctx, shardID)
+	}
+	return err
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	item, err := toShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.table(tableExecutions),
+		Item:                      item,
+		ConditionExpression:       aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:  map[string]*string{"#range_id": aws.String(attrRangeID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":previous_range_id": numAttr(previousRangeID)},
+	})
+	if isConditionalCheckFailedException(err) {
+		return db.readConflictedShard(ctx, row.ShardID)
+	}
+	return err
+}
+
+func (db *ddb) readConflictedShard(ctx context.Context, shardID int) error {
+	item, err := db.getItem(ctx, tableExecutions, shardKey(shardID))
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return toConflictedShardError(shardID, item)
 }
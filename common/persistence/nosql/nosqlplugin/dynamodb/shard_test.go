@@ -0,0 +1,175 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func newTestDDB(t *testing.T) (*ddb, *Mockclient) {
+	ctrl := gomock.NewController(t)
+	client := NewMockclient(ctrl)
+	return &ddb{
+		client:      client,
+		tablePrefix: "test",
+		cfg:         &config.NoSQL{Keyspace: "test"},
+		logger:      testlogger.New(t),
+	}, client
+}
+
+func newShardRow(ts time.Time) *nosqlplugin.ShardRow {
+	return &nosqlplugin.ShardRow{
+		InternalShardInfo: &persistence.InternalShardInfo{
+			ShardID:          15,
+			Owner:            "owner",
+			RangeID:          1000,
+			TransferAckLevel: 3000,
+			TimerAckLevel:    ts.Add(-time.Hour),
+			CurrentTimestamp: ts,
+		},
+		Data:         []byte("sharddata"),
+		DataEncoding: "thriftrw",
+	}
+}
+
+func TestInsertShard(t *testing.T) {
+	ts := time.Date(2024, 4, 2, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockFn    func(client *Mockclient)
+		wantErr   bool
+		wantRange int64
+	}{
+		{
+			name: "successfully applied",
+			mockFn: func(client *Mockclient) {
+				client.EXPECT().PutItemWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *dynamodb.PutItemInput, _ ...interface{}) (*dynamodb.PutItemOutput, error) {
+						assert.Equal(t, "test_executions", *input.TableName)
+						assert.Equal(t, "15", *input.Item[attrPartitionKey].S)
+						assert.Equal(t, sortKeyShard, *input.Item[attrSortKey].S)
+						assert.Equal(t, "1000", *input.Item[attrRangeID].N)
+						assert.Equal(t, "attribute_not_exists(#pk)", *input.ConditionExpression)
+						return &dynamodb.PutItemOutput{}, nil
+					})
+			},
+		},
+		{
+			name: "shard already exists",
+			mockFn: func(client *Mockclient) {
+				client.EXPECT().PutItemWithContext(gomock.Any(), gomock.Any()).
+					Return(nil, &dynamodb.ConditionalCheckFailedException{})
+				client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).
+					Return(&dynamodb.GetItemOutput{Item: map[string]*dynamodb.AttributeValue{attrRangeID: numAttr(1001)}}, nil)
+			},
+			wantErr:   true,
+			wantRange: 1001,
+		},
+		{
+			name: "put failed",
+			mockFn: func(client *Mockclient) {
+				client.EXPECT().PutItemWithContext(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("put failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			db, client := newTestDDB(t)
+			tc.mockFn(client)
+
+			err := db.InsertShard(context.Background(), newShardRow(ts))
+			if !tc.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			if tc.wantRange != 0 {
+				var conditionErr *nosqlplugin.ShardOperationConditionFailure
+				require.True(t, errors.As(err, &conditionErr))
+				assert.Equal(t, tc.wantRange, conditionErr.RangeID)
+			}
+		})
+	}
+}
+
+func TestSelectShard(t *testing.T) {
+	ts := time.Date(2024, 4, 2, 18, 0, 0, 0, time.UTC)
+	item, err := toShardItem(newShardRow(ts))
+	require.NoError(t, err)
+
+	db, client := newTestDDB(t)
+	client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *dynamodb.GetItemInput, _ ...interface{}) (*dynamodb.GetItemOutput, error) {
+			assert.True(t, aws.BoolValue(input.ConsistentRead))
+			return &dynamodb.GetItemOutput{Item: item}, nil
+		})
+
+	rangeID, row, err := db.SelectShard(context.Background(), 15, "cluster1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), rangeID)
+	assert.Equal(t, "owner", row.Owner)
+	assert.Equal(t, ts, row.UpdatedAt.UTC())
+	assert.Equal(t, map[string]int64{"cluster1": 3000}, row.ClusterTransferAckLevel)
+	assert.Equal(t, []byte("sharddata"), row.Data)
+	assert.Equal(t, "thriftrw", row.DataEncoding)
+}
+
+func TestSelectShardNotFound(t *testing.T) {
+	db, client := newTestDDB(t)
+	client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).Return(&dynamodb.GetItemOutput{}, nil)
+
+	_, _, err := db.SelectShard(context.Background(), 15, "cluster1")
+	assert.True(t, db.IsNotFoundError(err))
+}
+
+func TestUpdateRangeID(t *testing.T) {
+	db, client := newTestDDB(t)
+	client.EXPECT().UpdateItemWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *dynamodb.UpdateItemInput, _ ...interface{}) (*dynamodb.UpdateItemOutput, error) {
+			assert.Equal(t, "1001", *input.ExpressionAttributeValues[":range_id"].N)
+			assert.Equal(t, "1000", *input.ExpressionAttributeValues[":previous_range_id"].N)
+			return nil, &dynamodb.ConditionalCheckFailedException{}
+		})
+	client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).Return(&dynamodb.GetItemOutput{}, nil)
+
+	err := db.UpdateRangeID(context.Background(), 15, 1001, 1000)
+	var conditionErr *nosqlplugin.ShardOperationConditionFailure
+	require.True(t, errors.As(err, &conditionErr))
+	assert.Equal(t, int64(0), conditionErr.RangeID)
+}
@@ -22,20 +22,142 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// The tasks table is partitioned by tasklist, the tasklist row and its tasks share the partition
+// so that InsertTasks can fence the tasks by a ConditionCheck on the range_id of the tasklist.
+const (
+	sortKeyTaskList      = "tasklist"
+	sortKeyPrefixTask    = "task#"
+	initialRangeID       = 1 // Id of the first range of a new task list
+	attrTaskListKind     = "kind"
+	attrAckLevel         = "ack_level"
+	attrLastUpdatedTime  = "last_updated"
+	attrPartitionConfig  = "adaptive_partition_config"
+	attrBuildIDSets      = "compatible_build_id_sets"
+	attrTaskListName     = "task_list_name"
+	attrTaskListType     = "task_list_type"
+	maxTasksPerInsertion = maxTransactionItems - 1
+)
+
+func taskListPartitionKey(domainID, taskListName string, taskListType int) string {
+	return fmt.Sprintf("%v#%v#%v", domainID, taskListType, taskListName)
+}
+
+func taskListKey(domainID, taskListName string, taskListType int) map[string]*dynamodb.AttributeValue {
+	return itemKey(taskListPartitionKey(domainID, taskListName, taskListType), sortKeyTaskList)
+}
+
+func taskSortKey(taskID int64) string {
+	return sortKeyPrefixTask + padInt64(taskID)
+}
+
+func toTaskListItem(row *nosqlplugin.TaskListRow, rangeID int64, lastUpdatedTime time.Time) (map[string]*dynamodb.AttributeValue, error) {
+	partitionConfig, err := toPayload(row.AdaptivePartitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	buildIDSets, err := toPayload(row.CompatibleBuildIDSets)
+	if err != nil {
+		return nil, err
+	}
+	item := taskListKey(row.DomainID, row.TaskListName, row.TaskListType)
+	item[attrDomainID] = strAttr(row.DomainID)
+	item[attrTaskListName] = strAttr(row.TaskListName)
+	item[attrTaskListType] = numAttr(int64(row.TaskListType))
+	item[attrRangeID] = numAttr(rangeID)
+	item[attrAckLevel] = numAttr(row.AckLevel)
+	item[attrTaskListKind] = numAttr(int64(row.TaskListKind))
+	item[attrLastUpdatedTime] = numAttr(lastUpdatedTime.UnixNano())
+	item[attrPartitionConfig] = partitionConfig
+	item[attrBuildIDSets] = buildIDSets
+	return item, nil
+}
+
+func toTaskListRow(item map[string]*dynamodb.AttributeValue) (*nosqlplugin.TaskListRow, error) {
+	var partitionConfig *persistence.TaskListPartitionConfig
+	if err := fromPayload(item, attrPartitionConfig, &partitionConfig); err != nil {
+		return nil, err
+	}
+	// task lists written before build ID compatibility sets were introduced don't have the attribute
+	var buildIDSets [][]string
+	if _, ok := item[attrBuildIDSets]; ok {
+		if err := fromPayload(item, attrBuildIDSets, &buildIDSets); err != nil {
+			return nil, err
+		}
+	}
+	return &nosqlplugin.TaskListRow{
+		DomainID:                getString(item, attrDomainID),
+		TaskListName:            getString(item, attrTaskListName),
+		TaskListType:            int(getInt64(item, attrTaskListType)),
+		RangeID:                 getInt64(item, attrRangeID),
+		TaskListKind:            int(getInt64(item, attrTaskListKind)),
+		AckLevel:                getInt64(item, attrAckLevel),
+		LastUpdatedTime:         time.Unix(0, getInt64(item, attrLastUpdatedTime)),
+		AdaptivePartitionConfig: partitionConfig,
+		CompatibleBuildIDSets:   buildIDSets,
+	}, nil
+}
+
+func toConflictedTaskListError(item map[string]*dynamodb.AttributeValue) error {
+	rangeID := getInt64(item, attrRangeID)
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("range_id=%v,exists=%v", rangeID, len(item) > 0),
+	}
+}
+
+func (db *ddb) readConflictedTaskList(ctx context.Context, key map[string]*dynamodb.AttributeValue) error {
+	item, err := db.getItem(ctx, tableTasks, key)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return toConflictedTaskListError(item)
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableTasks, taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType))
+	if err != nil {
+		return nil, err
+	}
+	return toTaskListRow(item)
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	item, err := toTaskListItem(&nosqlplugin.TaskListRow{
+		DomainID:                row.DomainID,
+		TaskListName:            row.TaskListName,
+		TaskListType:            row.TaskListType,
+		TaskListKind:            row.TaskListKind,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+		CompatibleBuildIDSets:   row.CompatibleBuildIDSets,
+	}, initialRangeID, row.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.table(tableTasks),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey)},
+	})
+	if isConditionalCheckFailedException(err) {
+		return db.readConflictedTaskList(ctx, taskListKey(row.DomainID, row.TaskListName, row.TaskListType))
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +167,11 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	item, err := toTaskListItem(row, row.RangeID, row.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+	return db.putTaskList(ctx, item, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,45 +184,201 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	item, err := toTaskListItem(row, row.RangeID, row.CurrentTimeStamp)
+	if err != nil {
+		return err
+	}
+	item[attrTTL] = ttlAttr(row.CurrentTimeStamp, ttlSeconds)
+	return db.putTaskList(ctx, item, previousRangeID)
+}
+
+func (db *ddb) putTaskList(ctx context.Context, item map[string]*dynamodb.AttributeValue, previousRangeID int64) error {
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.table(tableTasks),
+		Item:                      item,
+		ConditionExpression:       aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:  map[string]*string{"#range_id": aws.String(attrRangeID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":previous_range_id": numAttr(previousRangeID)},
+	})
+	if isConditionalCheckFailedException(err) {
+		return db.readConflictedTaskList(ctx, itemKey(getString(item, attrPartitionKey), sortKeyTaskList))
+	}
+	return err
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	startKey, err := decodePageToken(nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := db.client.ScanWithContext(ctx, &dynamodb.ScanInput{
+		TableName:                 db.table(tableTasks),
+		FilterExpression:          aws.String("#sk = :sk"),
+		ExpressionAttributeNames:  map[string]*string{"#sk": aws.String(attrSortKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":sk": strAttr(sortKeyTaskList)},
+		ExclusiveStartKey:         startKey,
+		Limit:                     toPageSize(pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{}
+	for _, item := range resp.Items {
+		row, err := toTaskListRow(item)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	result.NextPageToken, err = encodePageToken(resp.LastEvaluatedKey)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	key := taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType)
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 db.table(tableTasks),
+		Key:                       key,
+		ConditionExpression:       aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:  map[string]*string{"#range_id": aws.String(attrRangeID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":previous_range_id": numAttr(previousRangeID)},
+	})
+	if isConditionalCheckFailedException(err) {
+		return db.readConflictedTaskList(ctx, key)
+	}
+	return err
 }
 
 // InsertTasks inserts a batch of tasks
 // Return TaskOperationConditionFailure if the condition doesn't meet
+// A transaction is limited to 100 items, bigger batches are split into multiple transactions,
+// each of them is fenced by the range_id of the tasklist.
 func (db *ddb) InsertTasks(
 	ctx context.Context,
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	domainID := tasklistCondition.DomainID
+	key := taskListKey(domainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+	partitionKey := getString(key, attrPartitionKey)
+	timeStamp := tasklistCondition.CurrentTimeStamp
+
+	for start := 0; start < len(tasksToInsert); start += maxTasksPerInsertion {
+		end := start + maxTasksPerInsertion
+		if end > len(tasksToInsert) {
+			end = len(tasksToInsert)
+		}
+		items := make([]*dynamodb.TransactWriteItem, 0, end-start+1)
+		for _, task := range tasksToInsert[start:end] {
+			payload, err := toPayload(&task.TaskRow)
+			if err != nil {
+				return err
+			}
+			item := itemKey(partitionKey, taskSortKey(task.TaskID))
+			item[attrTaskID] = numAttr(task.TaskID)
+			item[attrPayload] = payload
+			if task.TTLSeconds > 0 {
+				item[attrTTL] = ttlAttr(timeStamp, int64(task.TTLSeconds))
+			}
+			items = append(items, &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{TableName: db.table(tableTasks), Item: item},
+			})
+		}
+		// The following condition is used to ensure that range_id didn't change
+		items = append(items, &dynamodb.TransactWriteItem{
+			ConditionCheck: &dynamodb.ConditionCheck{
+				TableName:                           db.table(tableTasks),
+				Key:                                 key,
+				ConditionExpression:                 aws.String("#range_id = :range_id"),
+				ExpressionAttributeNames:            map[string]*string{"#range_id": aws.String(attrRangeID)},
+				ExpressionAttributeValues:           map[string]*dynamodb.AttributeValue{":range_id": numAttr(tasklistCondition.RangeID)},
+				ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+			},
+		})
+		reasons, err := db.transactWrite(ctx, items)
+		if err != nil {
+			return err
+		}
+		if reasons != nil {
+			return toConflictedTaskListError(reasons[len(reasons)-1].Item)
+		}
+	}
+	return nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return nil, nil
+	}
+	now := time.Now()
+	input := db.taskRangeQuery(filter, filter.MinTaskID+1, filter.MaxTaskID)
+	// TTL deletion in DynamoDB is lazy, so the expired tasks have to be filtered out
+	input.FilterExpression = aws.String("attribute_not_exists(#ttl) OR #ttl > :now")
+	input.ExpressionAttributeNames["#ttl"] = aws.String(attrTTL)
+	input.ExpressionAttributeValues[":now"] = numAttr(now.Unix())
+
+	var response []*nosqlplugin.TaskRow
+	for {
+		resp, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			task := &nosqlplugin.TaskRow{}
+			if err := fromPayload(item, attrPayload, task); err != nil {
+				return nil, err
+			}
+			task.TaskID = getInt64(item, attrTaskID)
+			if _, ok := item[attrTTL]; ok {
+				task.Expiry = time.Unix(getInt64(item, attrTTL), 0)
+			}
+			response = append(response, task)
+			if len(response) == filter.BatchSize {
+				return response, nil
+			}
+		}
+		if len(resp.LastEvaluatedKey) == 0 {
+			return response, nil
+		}
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
 }
 
-// SelectTasks return tasks that associated to a tasklist
+// GetTasksCount returns number of tasks from a tasklist
 func (db *ddb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	input := db.taskRangeQuery(filter, filter.MinTaskID+1, math.MaxInt64)
+	return db.queryCount(ctx, input)
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
 // NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// be returned to the caller
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	input := db.taskRangeQuery(filter, 0, filter.MaxTaskID)
+	input.ExpressionAttributeValues[":min"] = strAttr(sortKeyPrefixTask)
+	return db.rangeDelete(ctx, tableTasks, input)
+}
+
+// taskRangeQuery returns the query of the tasks between inclusiveMinTaskID and inclusiveMaxTaskID
+func (db *ddb) taskRangeQuery(filter *nosqlplugin.TasksFilter, inclusiveMinTaskID, inclusiveMaxTaskID int64) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:                db.table(tableTasks),
+		ConsistentRead:           aws.Bool(true),
+		KeyConditionExpression:   aws.String("#pk = :pk AND #sk BETWEEN :min AND :max"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey), "#sk": aws.String(attrSortKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":  strAttr(taskListPartitionKey(filter.DomainID, filter.TaskListName, filter.TaskListType)),
+			":min": strAttr(taskSortKey(inclusiveMinTaskID)),
+			":max": strAttr(taskSortKey(inclusiveMaxTaskID)),
+		},
+	}
 }
@@ -0,0 +1,420 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Every table uses a string partition key(pk) and a string sort key(sk).
+// Numbers that take part in the ordering of the sort key are zero padded so that
+// the lexicographical order of the sort key is the same as the numerical order.
+// All the other columns are "non-significant" and stored as a JSON payload,
+// see the NOTE 2 of nosqlplugin.tableCRUD.
+const (
+	attrPartitionKey = "pk"
+	attrSortKey      = "sk"
+	attrPayload      = "payload"
+	attrData         = "data"
+	attrDataEncoding = "data_encoding"
+	attrRangeID      = "range_id"
+	attrTTL          = "ttl"
+)
+
+const (
+	tableExecutions     = "executions"
+	tableHistoryTree    = "history_tree"
+	tableHistoryNode    = "history_node"
+	tableTasks          = "tasks"
+	tableQueueMessage   = "queue_message"
+	tableQueueMetadata  = "queue_metadata"
+	tableDomain         = "domain"
+	tableDomainAuditLog = "domain_audit_log"
+	tableVisibility     = "visibility"
+	tableClusterConfig  = "cluster_config"
+)
+
+const (
+	// maxTransactionItems is the max number of unique items DynamoDB accepts in a single TransactWriteItems call
+	maxTransactionItems = 100
+	// maxBatchWriteItems is the max number of items DynamoDB accepts in a single BatchWriteItem call
+	maxBatchWriteItems = 25
+	// maxItemSizeInBytes is the max size of an item in DynamoDB, including the attribute names
+	maxItemSizeInBytes = 400 * 1024
+
+	cancellationReasonConditionalCheckFailed = "ConditionalCheckFailed"
+	cancellationReasonNone                   = "None"
+	cancellationReasonValidationError        = "ValidationError"
+	errCodeValidationException               = "ValidationException"
+)
+
+func tableName(prefix, name string) *string {
+	return aws.String(prefix + "_" + name)
+}
+
+func padInt64(v int64) string {
+	return fmt.Sprintf("%020d", v)
+}
+
+func strAttr(v string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(v)}
+}
+
+func numAttr(v int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v, 10))}
+}
+
+func boolAttr(v bool) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{BOOL: aws.Bool(v)}
+}
+
+// binAttr returns a binary attribute, DynamoDB doesn't accept empty binary values for key attributes
+// but it does for non-key ones, so nil is normalized to empty.
+func binAttr(v []byte) *dynamodb.AttributeValue {
+	if v == nil {
+		v = []byte{}
+	}
+	return &dynamodb.AttributeValue{B: v}
+}
+
+func ttlAttr(now time.Time, ttlSeconds int64) *dynamodb.AttributeValue {
+	return numAttr(now.Add(time.Duration(ttlSeconds) * time.Second).Unix())
+}
+
+func itemKey(pk, sk string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		attrPartitionKey: strAttr(pk),
+		attrSortKey:      strAttr(sk),
+	}
+}
+
+func getString(item map[string]*dynamodb.AttributeValue, name string) string {
+	if v, ok := item[name]; ok && v.S != nil {
+		return *v.S
+	}
+	return ""
+}
+
+func getInt64(item map[string]*dynamodb.AttributeValue, name string) int64 {
+	if v, ok := item[name]; ok && v.N != nil {
+		n, err := strconv.ParseInt(*v.N, 10, 64)
+		if err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+func getBytes(item map[string]*dynamodb.AttributeValue, name string) []byte {
+	if v, ok := item[name]; ok {
+		return v.B
+	}
+	return nil
+}
+
+func getBool(item map[string]*dynamodb.AttributeValue, name string) bool {
+	if v, ok := item[name]; ok && v.BOOL != nil {
+		return *v.BOOL
+	}
+	return false
+}
+
+// itemSize returns the size of an item as DynamoDB accounts it against maxItemSizeInBytes:
+// the lengths of the attribute names plus the sizes of the values.
+// Numbers are counted by their string length, which is an upper bound of their stored size.
+func itemSize(item map[string]*dynamodb.AttributeValue) int {
+	size := 0
+	for name, v := range item {
+		size += len(name) + attributeValueSize(v)
+	}
+	return size
+}
+
+func attributeValueSize(v *dynamodb.AttributeValue) int {
+	switch {
+	case v == nil:
+		return 0
+	case v.S != nil:
+		return len(*v.S)
+	case v.N != nil:
+		return len(*v.N)
+	case v.B != nil:
+		return len(v.B)
+	case v.BOOL != nil, v.NULL != nil:
+		return 1
+	case v.M != nil:
+		// 3 bytes of overhead for the map and 1 byte for each element
+		size := 3
+		for name, e := range v.M {
+			size += len(name) + attributeValueSize(e) + 1
+		}
+		return size
+	case v.L != nil:
+		size := 3
+		for _, e := range v.L {
+			size += attributeValueSize(e) + 1
+		}
+		return size
+	}
+	return 0
+}
+
+// checkItemSize returns a TransactionSizeLimitError if the item can't be written to DynamoDB
+func checkItemSize(item map[string]*dynamodb.AttributeValue) error {
+	if size := itemSize(item); size > maxItemSizeInBytes {
+		return &persistence.TransactionSizeLimitError{
+			Msg: fmt.Sprintf("item size %v exceeds the DynamoDB limit of %v bytes", size, maxItemSizeInBytes),
+		}
+	}
+	return nil
+}
+
+func toPayload(v interface{}) (*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return binAttr(data), nil
+}
+
+func fromPayload(item map[string]*dynamodb.AttributeValue, name string, v interface{}) error {
+	data := getBytes(item, name)
+	if len(data) == 0 {
+		return fmt.Errorf("corrupted item, attribute %v is missing", name)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// encodePageToken serializes the LastEvaluatedKey of a query/scan into an opaque page token
+func encodePageToken(lastEvaluatedKey map[string]*dynamodb.AttributeValue) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(lastEvaluatedKey)
+}
+
+// decodePageToken deserializes a page token from encodePageToken into an ExclusiveStartKey
+func decodePageToken(token []byte) (map[string]*dynamodb.AttributeValue, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	var key map[string]*dynamodb.AttributeValue
+	if err := json.Unmarshal(token, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return key, nil
+}
+
+func toPageSize(pageSize int) *int64 {
+	if pageSize <= 0 {
+		return nil
+	}
+	return aws.Int64(int64(pageSize))
+}
+
+func (db *ddb) getItem(ctx context.Context, table string, key map[string]*dynamodb.AttributeValue) (map[string]*dynamodb.AttributeValue, error) {
+	resp, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      db.table(table),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Item) == 0 {
+		return nil, errNotFound
+	}
+	return resp.Item, nil
+}
+
+func (db *ddb) deleteItem(ctx context.Context, table string, key map[string]*dynamodb.AttributeValue) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: db.table(table),
+		Key:       key,
+	})
+	return err
+}
+
+// queryAll pages through all the items that match the query, the input is modified for pagination
+func (db *ddb) queryAll(ctx context.Context, input *dynamodb.QueryInput) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+	for {
+		resp, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, resp.Items...)
+		if len(resp.LastEvaluatedKey) == 0 {
+			return items, nil
+		}
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
+}
+
+// queryCount counts all the items that match the query, the input is modified for pagination
+func (db *ddb) queryCount(ctx context.Context, input *dynamodb.QueryInput) (int64, error) {
+	input.Select = aws.String(dynamodb.SelectCount)
+	var count int64
+	for {
+		resp, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(resp.Count)
+		if len(resp.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
+}
+
+// batchDelete deletes the keys from a table in chunks of maxBatchWriteItems, retrying the unprocessed ones
+func (db *ddb) batchDelete(ctx context.Context, table string, keys []map[string]*dynamodb.AttributeValue) error {
+	for start := 0; start < len(keys); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(keys) {
+			end = len(keys)
+		}
+		requests := make([]*dynamodb.WriteRequest, 0, end-start)
+		for _, key := range keys[start:end] {
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+			})
+		}
+		pending := map[string][]*dynamodb.WriteRequest{
+			*db.table(table): requests,
+		}
+		for len(pending) > 0 {
+			resp, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return err
+			}
+			pending = resp.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+// rangeDelete deletes all the items returned by the query, only the key attributes are read
+func (db *ddb) rangeDelete(ctx context.Context, table string, input *dynamodb.QueryInput) (int, error) {
+	input.TableName = db.table(table)
+	input.ProjectionExpression = aws.String("#pk, #sk")
+	if input.ExpressionAttributeNames == nil {
+		input.ExpressionAttributeNames = map[string]*string{}
+	}
+	input.ExpressionAttributeNames["#pk"] = aws.String(attrPartitionKey)
+	input.ExpressionAttributeNames["#sk"] = aws.String(attrSortKey)
+	items, err := db.queryAll(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	keys := make([]map[string]*dynamodb.AttributeValue, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, itemKey(getString(item, attrPartitionKey), getString(item, attrSortKey)))
+	}
+	return len(keys), db.batchDelete(ctx, table, keys)
+}
+
+// transactWrite executes the items in a single transaction.
+// If the transaction is canceled because of condition checks, the cancellation reasons are returned
+// in the same order as the items, and the error is nil.
+func (db *ddb) transactWrite(ctx context.Context, items []*dynamodb.TransactWriteItem) ([]*dynamodb.CancellationReason, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	if len(items) > maxTransactionItems {
+		return nil, fmt.Errorf("transaction has %v items, exceeds the DynamoDB limit of %v", len(items), maxTransactionItems)
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	if err == nil {
+		return nil, nil
+	}
+	var canceled *dynamodb.TransactionCanceledException
+	if errors.As(err, &canceled) && isConditionalCheckFailure(canceled.CancellationReasons) {
+		return canceled.CancellationReasons, nil
+	}
+	if isItemSizeExceeded(err) {
+		// an update grew an item, e.g. the execution with its maps, beyond the limit
+		return nil, &persistence.TransactionSizeLimitError{
+			Msg: fmt.Sprintf("item size exceeds the DynamoDB limit of %v bytes: %v", maxItemSizeInBytes, err),
+		}
+	}
+	return nil, err
+}
+
+// isItemSizeExceeded returns true if DynamoDB rejected a write because an item would exceed maxItemSizeInBytes
+func isItemSizeExceeded(err error) bool {
+	var canceled *dynamodb.TransactionCanceledException
+	if errors.As(err, &canceled) {
+		for _, r := range canceled.CancellationReasons {
+			if aws.StringValue(r.Code) == cancellationReasonValidationError && isItemSizeMessage(aws.StringValue(r.Message)) {
+				return true
+			}
+		}
+		return false
+	}
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeValidationException && isItemSizeMessage(awsErr.Message())
+}
+
+func isItemSizeMessage(message string) bool {
+	return strings.Contains(message, "Item size") && strings.Contains(message, "maximum allowed size")
+}
+
+func isConditionalCheckFailure(reasons []*dynamodb.CancellationReason) bool {
+	found := false
+	for _, r := range reasons {
+		switch aws.StringValue(r.Code) {
+		case cancellationReasonConditionalCheckFailed:
+			found = true
+		case cancellationReasonNone, "":
+		default:
+			// canceled for other reasons like throttling or transaction conflicts, should be retried
+			return false
+		}
+	}
+	return found
+}
+
+func isConditionalCheckFailed(reason *dynamodb.CancellationReason) bool {
+	return reason != nil && aws.StringValue(reason.Code) == cancellationReasonConditionalCheckFailed
+}
+
+func isConditionalCheckFailedException(err error) bool {
+	var e *dynamodb.ConditionalCheckFailedException
+	return errors.As(err, &e)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestPadInt64(t *testing.T) {
+	values := []int64{math.MaxInt64, 0, 10, 9, 1000, 123456789}
+	keys := make([]string, 0, len(values))
+	for _, v := range values {
+		keys = append(keys, padInt64(v))
+	}
+	sort.Strings(keys)
+	assert.Equal(t, []string{
+		padInt64(0),
+		padInt64(9),
+		padInt64(10),
+		padInt64(1000),
+		padInt64(123456789),
+		padInt64(math.MaxInt64),
+	}, keys)
+	assert.Len(t, padInt64(math.MaxInt64), 20)
+}
+
+func TestPageToken(t *testing.T) {
+	token, err := encodePageToken(nil)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	key := itemKey("pk-value", "sk-value")
+	token, err = encodePageToken(key)
+	require.NoError(t, err)
+
+	decoded, err := decodePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	_, err = decodePageToken([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestIsConditionalCheckFailure(t *testing.T) {
+	reason := func(code string) *dynamodb.CancellationReason {
+		return &dynamodb.CancellationReason{Code: aws.String(code)}
+	}
+
+	tests := []struct {
+		name    string
+		reasons []*dynamodb.CancellationReason
+		want    bool
+	}{
+		{
+			name:    "no reasons",
+			reasons: nil,
+			want:    false,
+		},
+		{
+			name:    "condition check failed",
+			reasons: []*dynamodb.CancellationReason{reason(cancellationReasonNone), reason(cancellationReasonConditionalCheckFailed)},
+			want:    true,
+		},
+		{
+			name:    "transaction conflict",
+			reasons: []*dynamodb.CancellationReason{reason(cancellationReasonConditionalCheckFailed), reason("TransactionConflict")},
+			want:    false,
+		},
+		{
+			name:    "none",
+			reasons: []*dynamodb.CancellationReason{reason(cancellationReasonNone), {}},
+			want:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isConditionalCheckFailure(tc.reasons))
+		})
+	}
+}
+
+func TestPayload(t *testing.T) {
+	type payload struct {
+		Name  string
+		Value int64
+	}
+	attr, err := toPayload(&payload{Name: "name", Value: 10})
+	require.NoError(t, err)
+
+	item := map[string]*dynamodb.AttributeValue{attrPayload: attr}
+	got := &payload{}
+	require.NoError(t, fromPayload(item, attrPayload, got))
+	assert.Equal(t, &payload{Name: "name", Value: 10}, got)
+
+	assert.Error(t, fromPayload(item, attrData, got))
+}
+
+func TestCheckItemSize(t *testing.T) {
+	item := itemKey("pk-value", "sk-value")
+	item[attrData] = binAttr(make([]byte, 100))
+	item[attrActivityMap] = &dynamodb.AttributeValue{M: map[string]*dynamodb.AttributeValue{"1": numAttr(10)}}
+	assert.Equal(t, len("pk")+len("pk-value")+len("sk")+len("sk-value")+len(attrData)+100+len(attrActivityMap)+3+len("1")+len("10")+1, itemSize(item))
+	assert.NoError(t, checkItemSize(item))
+
+	item[attrData] = binAttr(make([]byte, maxItemSizeInBytes))
+	var sizeLimitErr *persistence.TransactionSizeLimitError
+	assert.ErrorAs(t, checkItemSize(item), &sizeLimitErr)
+}
+
+func TestIsItemSizeExceeded(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "transaction canceled for item size",
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String(cancellationReasonNone)},
+				{Code: aws.String(cancellationReasonValidationError), Message: aws.String("Item size to update has exceeded the maximum allowed size")},
+			}},
+			want: true,
+		},
+		{
+			name: "transaction canceled for other validation errors",
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String(cancellationReasonValidationError), Message: aws.String("Invalid UpdateExpression")},
+			}},
+			want: false,
+		},
+		{
+			name: "validation exception for item size",
+			err:  awserr.New(errCodeValidationException, "Item size has exceeded the maximum allowed size", nil),
+			want: true,
+		},
+		{
+			name: "other errors",
+			err:  errors.New("some error"),
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isItemSizeExceeded(tc.err))
+		})
+	}
+}
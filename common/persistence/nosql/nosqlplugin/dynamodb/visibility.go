@@ -22,43 +22,211 @@ package dynamodb
 
 import (
 	"context"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// Visibility records of a domain share a partition and the sort key is workflowID#runID.
+// Records are ordered through sparse local secondary indexes, whose sort key attributes
+// are only set on the records that belong to the index:
+//   - open_by_start: open records by start time
+//   - closed_by_start: closed records by start time
+//   - closed_by_close: closed records by close time
+//
+// Filtering by workflow type, workflowID or close status is applied on top of the index.
+const (
+	indexOpenByStart   = "open_by_start"
+	indexClosedByStart = "closed_by_start"
+	indexClosedByClose = "closed_by_close"
+
+	attrOpenStart      = "open_start"
+	attrClosedStart    = "closed_start"
+	attrClosedClose    = "closed_close"
+	attrWorkflowType   = "workflow_type"
+	attrVisibilityOpen = "open"
+)
+
+func visibilityKey(domainID, workflowID, runID string) map[string]*dynamodb.AttributeValue {
+	return itemKey(domainID, workflowID+"#"+runID)
+}
+
+// visibilityIndexKey is unique per record, the runID breaks the ties of the same timestamp
+func visibilityIndexKey(t time.Time, runID string) string {
+	return padInt64(t.UnixNano()) + "#" + runID
+}
+
+func toVisibilityItem(domainID string, row *nosqlplugin.VisibilityRow, open bool, ttlSeconds int64) (map[string]*dynamodb.AttributeValue, error) {
+	payload, err := toPayload(row)
+	if err != nil {
+		return nil, err
+	}
+	item := visibilityKey(domainID, row.WorkflowID, row.RunID)
+	item[attrPayload] = payload
+	item[attrWorkflowID] = strAttr(row.WorkflowID)
+	item[attrWorkflowType] = strAttr(row.TypeName)
+	item[attrVisibilityOpen] = boolAttr(open)
+	if open {
+		item[attrOpenStart] = strAttr(visibilityIndexKey(row.StartTime, row.RunID))
+	} else {
+		item[attrClosedStart] = strAttr(visibilityIndexKey(row.StartTime, row.RunID))
+		item[attrClosedClose] = strAttr(visibilityIndexKey(row.CloseTime, row.RunID))
+		if row.Status != nil {
+			item[attrCloseStatus] = numAttr(int64(*row.Status))
+		}
+	}
+	if ttlSeconds > 0 {
+		item[attrTTL] = ttlAttr(time.Now(), ttlSeconds)
+	}
+	return item, nil
+}
+
+func fromVisibilityItem(item map[string]*dynamodb.AttributeValue) (*nosqlplugin.VisibilityRow, error) {
+	row := &nosqlplugin.VisibilityRow{}
+	if err := fromPayload(item, attrPayload, row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	item, err := toVisibilityItem(row.DomainID, &row.VisibilityRow, true, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.table(tableVisibility),
+		Item:      item,
+	})
+	return err
 }
 
+// UpdateVisibility overwrites the record as closed, which also removes it from the open index
 func (db *ddb) UpdateVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		// TODO implement it when where is a need
+		panic("not supported operation")
+	}
+
+	item, err := toVisibilityItem(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.table(tableVisibility),
+		Item:      item,
+	})
+	return err
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	index, indexAttr := indexClosedByStart, attrClosedStart
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		index, indexAttr = indexOpenByStart, attrOpenStart
+	default:
+		if filter.SortType == nosqlplugin.SortByClosedTime {
+			index, indexAttr = indexClosedByClose, attrClosedClose
+		}
+	}
+
+	names := map[string]*string{
+		"#pk":    aws.String(attrPartitionKey),
+		"#index": aws.String(indexAttr),
+		"#ttl":   aws.String(attrTTL),
+	}
+	request := filter.ListRequest
+	values := map[string]*dynamodb.AttributeValue{
+		":pk":       strAttr(request.DomainUUID),
+		":earliest": strAttr(padInt64(request.EarliestTime.UnixNano())),
+		// '$' sorts after the '#' separator, so the records at LatestTime are included
+		":latest": strAttr(padInt64(request.LatestTime.UnixNano()) + "$"),
+		":now":    numAttr(time.Now().Unix()),
+	}
+	// TTL deletion in DynamoDB is lazy, so the expired records have to be filtered out
+	filterExpression := "(attribute_not_exists(#ttl) OR #ttl > :now)"
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		filterExpression += " AND #workflow_type = :workflow_type"
+		names["#workflow_type"] = aws.String(attrWorkflowType)
+		values[":workflow_type"] = strAttr(filter.WorkflowType)
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		filterExpression += " AND #workflow_id = :workflow_id"
+		names["#workflow_id"] = aws.String(attrWorkflowID)
+		values[":workflow_id"] = strAttr(filter.WorkflowID)
+	case nosqlplugin.ClosedByClosedStatus:
+		filterExpression += " AND #close_status = :close_status"
+		names["#close_status"] = aws.String(attrCloseStatus)
+		values[":close_status"] = numAttr(int64(filter.CloseStatus))
+	}
+
+	items, nextPageToken, err := db.queryPage(ctx, tableVisibility, &dynamodb.QueryInput{
+		IndexName:                 aws.String(index),
+		KeyConditionExpression:    aws.String("#pk = :pk AND #index BETWEEN :earliest AND :latest"),
+		FilterExpression:          aws.String(filterExpression),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+		ScanIndexForward:          aws.Bool(false),
+	}, request.NextPageToken, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(items)),
+		NextPageToken: nextPageToken,
+	}
+	for _, item := range items {
+		row, err := fromVisibilityItem(item)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
+// DeleteVisibility is a noop as the records expire by TTL, except for the admin deletion of a record
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	key := persistence.VisibilityAdminDeletionKey("visibilityAdminDelete")
+	if v := ctx.Value(key); v != nil && v.(bool) {
+		return db.deleteItem(ctx, tableVisibility, visibilityKey(domainID, workflowID, runID))
+	}
+	return nil
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableVisibility, visibilityKey(domainID, workflowID, runID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if getBool(item, attrVisibilityOpen) {
+		return nil, nil
+	}
+	return fromVisibilityItem(item)
 }
@@ -23,8 +23,14 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -40,7 +46,30 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	timeStamp := execution.CurrentTimeStamp
+
+	tx := &workflowTransaction{}
+	db.insertActiveClusterSelectionPolicy(tx, activeClusterSelectionPolicyRow, timeStamp)
+	if err := db.insertWorkflowRequests(tx, requests, timeStamp); err != nil {
+		return err
+	}
+	if err := db.createOrUpdateCurrentWorkflow(tx, shardID, execution.DomainID, execution.WorkflowID, currentWorkflowRequest, timeStamp); err != nil {
+		return err
+	}
+	if err := db.createWorkflowExecution(tx, shardID, execution); err != nil {
+		return err
+	}
+	if err := db.createTasksByCategory(tx, shardID, tasksByCategory); err != nil {
+		return err
+	}
+	tx.add(txItemKindShard, db.shardRangeIDCondition(shardID, shardCondition.RangeID))
+
+	reasons, err := db.executeWorkflowTransaction(ctx, tx)
+	if err != nil || reasons == nil {
+		return err
+	}
+	return db.toWorkflowConditionFailure(ctx, tx, reasons, currentWorkflowRequest, shardCondition)
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -54,105 +83,387 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var timeStamp time.Time
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		timeStamp = mutatedExecution.CurrentTimeStamp
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		timeStamp = resetExecution.CurrentTimeStamp
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	tx := &workflowTransaction{}
+	if err := db.insertWorkflowRequests(tx, requests, timeStamp); err != nil {
+		return err
+	}
+	if err := db.createOrUpdateCurrentWorkflow(tx, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp); err != nil {
+		return err
+	}
+	if mutatedExecution != nil {
+		if err := db.updateWorkflowExecution(tx, shardID, mutatedExecution); err != nil {
+			return err
+		}
+	}
+	if insertedExecution != nil {
+		if err := db.createWorkflowExecution(tx, shardID, insertedExecution); err != nil {
+			return err
+		}
+		db.insertActiveClusterSelectionPolicy(tx, activeClusterSelectionPolicyRow, timeStamp)
+	}
+	if resetExecution != nil {
+		if err := db.resetWorkflowExecution(tx, shardID, resetExecution); err != nil {
+			return err
+		}
+	}
+	if err := db.createTasksByCategory(tx, shardID, tasksByCategory); err != nil {
+		return err
+	}
+	tx.add(txItemKindShard, db.shardRangeIDCondition(shardID, shardCondition.RangeID))
+
+	reasons, err := db.executeWorkflowTransaction(ctx, tx)
+	if err != nil || reasons == nil {
+		return err
+	}
+	return db.toWorkflowConditionFailure(ctx, tx, reasons, currentWorkflowRequest, shardCondition)
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), currentWorkflowSortKey(domainID, workflowID)))
+	if err != nil {
+		return nil, err
+	}
+	return toCurrentWorkflowRow(shardID, domainID, workflowID, item), nil
 }
 
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), executionSortKey(domainID, workflowID, runID)))
+	if err != nil {
+		return nil, err
+	}
+	return fromExecutionItem(domainID, item)
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 db.table(tableExecutions),
+		Key:                       itemKey(shardPartitionKey(shardID), currentWorkflowSortKey(domainID, workflowID)),
+		ConditionExpression:       aws.String("#current_run_id = :current_run_id"),
+		ExpressionAttributeNames:  map[string]*string{"#current_run_id": aws.String(attrCurrentRunID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":current_run_id": strAttr(currentRunIDCondition)},
+	})
+	if isConditionalCheckFailedException(err) {
+		// same as Cassandra, the row belongs to another run and is left untouched
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), executionSortKey(domainID, workflowID, runID)))
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryShardPage(ctx, shardID, sortKeyPrefixCurrent, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(items))
+	for _, item := range items {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     getString(item, attrDomainID),
+			WorkflowID:   getString(item, attrWorkflowID),
+			RunID:        getString(item, attrCurrentRunID),
+			State:        int(getInt64(item, attrState)),
+			CurrentRunID: getString(item, attrCurrentRunID),
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryShardPage(ctx, shardID, sortKeyPrefixExecution, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(items))
+	for _, item := range items {
+		info := &persistence.InternalWorkflowExecutionInfo{}
+		if err := fromPayload(item, attrPayload, info); err != nil {
+			return nil, nil, err
+		}
+		var versionHistories *persistence.DataBlob
+		if err := fromPayload(item, attrVersionHistories, &versionHistories); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    info,
+			VersionHistories: versionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	resp, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:                db.table(tableExecutions),
+		Key:                      itemKey(shardPartitionKey(shardID), executionSortKey(domainID, workflowID, runID)),
+		ConsistentRead:           aws.Bool(true),
+		ProjectionExpression:     aws.String("#pk"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey)},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.Item) > 0, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	items, nextPageToken, err := db.queryShardRange(ctx, shardID, transferTaskSortKey(inclusiveMinTaskID), transferTaskSortKey(exclusiveMaxTaskID-1), pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, item := range items {
+		task, err := fromTaskItem(item, &nosqlplugin.TransferTask{})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), transferTaskSortKey(taskID)))
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if inclusiveBeginTaskID >= exclusiveEndTaskID {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tableExecutions, shardRangeQuery(shardID, transferTaskSortKey(inclusiveBeginTaskID), transferTaskSortKey(exclusiveEndTaskID-1)))
+	return err
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	// the sort key of a timer task is prefixed by the visibility timestamp,
+	// so [prefix(min), prefix(max)] covers [min, max) as the prefix itself sorts before all the tasks of the timestamp
+	items, nextPageToken, err := db.queryShardRange(ctx, shardID, timerTaskSortKeyPrefix(inclusiveMinTime), timerTaskSortKeyPrefix(exclusiveMaxTime), pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, item := range items {
+		task, err := fromTaskItem(item, &nosqlplugin.TimerTask{})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), timerTaskSortKey(visibilityTimestamp, taskID)))
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	_, err := db.rangeDelete(ctx, tableExecutions, shardRangeQuery(shardID, timerTaskSortKeyPrefix(inclusiveMinTime), timerTaskSortKeyPrefix(exclusiveMaxTime)))
+	return err
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	return db.selectReplicationTasks(ctx, shardID, replicationTaskSortKey(inclusiveMinTaskID), replicationTaskSortKey(exclusiveMaxTaskID-1), pageToken, pageSize)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), replicationTaskSortKey(taskID)))
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if exclusiveEndTaskID == math.MinInt64 {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tableExecutions, shardRangeQuery(shardID, sortKeyPrefixReplication, replicationTaskSortKey(exclusiveEndTaskID-1)))
+	return err
 }
 
 func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+	tx := &workflowTransaction{}
+	for _, task := range tasks {
+		item, err := toReplicationTaskItem(condition.ShardID, replicationTaskSortKey(task.Replication.TaskID), task)
+		if err != nil {
+			return err
+		}
+		tx.add(txItemKindOther, db.put(item, ""))
+	}
+	tx.add(txItemKindShard, db.shardRangeIDCondition(condition.ShardID, condition.RangeID))
+
+	reasons, err := db.executeWorkflowTransaction(ctx, tx)
+	if err != nil || reasons == nil {
+		return err
+	}
+	shardReason := reasons[len(reasons)-1]
+	if isConditionalCheckFailed(shardReason) {
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: getInt64(shardReason.Item, attrRangeID),
+		}
+	}
+	// At this point we only know that the write was not applied.
+	// It's much safer to return ShardOperationConditionFailure(which will become ShardOwnershipLostError later) as the default to force the application to reload
+	// shard to recover from such errors
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: -1,
+		Details: fmt.Sprintf("%v", reasons),
+	}
 }
 
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), crossClusterTaskSortKey(targetCluster, taskID)))
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	item, err := toReplicationTaskItem(shardID, replicationDLQTaskSortKey(sourceCluster, task.Replication.TaskID), task)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.table(tableExecutions),
+		Item:      item,
+	})
+	return err
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	return db.selectReplicationTasks(ctx, shardID, replicationDLQTaskSortKey(sourceCluster, inclusiveMinTaskID), replicationDLQTaskSortKey(sourceCluster, exclusiveMaxTaskID-1), pageToken, pageSize)
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	input := shardPrefixQuery(shardID, replicationDLQTaskSortKeyPrefix(sourceCluster))
+	input.TableName = db.table(tableExecutions)
+	input.ConsistentRead = aws.Bool(true)
+	count, err := db.queryCount(ctx, input)
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), replicationDLQTaskSortKey(sourceCluster, taskID)))
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if inclusiveBeginTaskID >= exclusiveEndTaskID {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tableExecutions, shardRangeQuery(shardID, replicationDLQTaskSortKey(sourceCluster, inclusiveBeginTaskID), replicationDLQTaskSortKey(sourceCluster, exclusiveEndTaskID-1)))
+	return err
 }
 
 func (db *ddb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), activeClusterSelectionPolicySortKey(domainID, wfID, rID)))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     persistence.NewDataBlob(getBytes(item, attrData), constants.EncodingType(getString(item, attrDataEncoding))),
+	}, nil
 }
 
 func (db *ddb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, itemKey(shardPartitionKey(shardID), activeClusterSelectionPolicySortKey(domainID, wfID, rID)))
+}
+
+func (db *ddb) selectReplicationTasks(ctx context.Context, shardID int, minSortKey, maxSortKey string, pageToken []byte, pageSize int) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	items, nextPageToken, err := db.queryShardRange(ctx, shardID, minSortKey, maxSortKey, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, item := range items {
+		task, err := fromTaskItem(item, &nosqlplugin.ReplicationTask{})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+func shardRangeQuery(shardID int, minSortKey, maxSortKey string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		KeyConditionExpression:   aws.String("#pk = :pk AND #sk BETWEEN :min AND :max"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey), "#sk": aws.String(attrSortKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":  strAttr(shardPartitionKey(shardID)),
+			":min": strAttr(minSortKey),
+			":max": strAttr(maxSortKey),
+		},
+	}
+}
+
+func shardPrefixQuery(shardID int, sortKeyPrefix string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		KeyConditionExpression:   aws.String("#pk = :pk AND begins_with(#sk, :prefix)"),
+		ExpressionAttributeNames: map[string]*string{"#pk": aws.String(attrPartitionKey), "#sk": aws.String(attrSortKey)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":     strAttr(shardPartitionKey(shardID)),
+			":prefix": strAttr(sortKeyPrefix),
+		},
+	}
+}
+
+// queryShardRange reads a page of the items of a shard, reading tasks need to be strongly consistent, otherwise we could loose task
+func (db *ddb) queryShardRange(ctx context.Context, shardID int, minSortKey, maxSortKey string, pageToken []byte, pageSize int) ([]map[string]*dynamodb.AttributeValue, []byte, error) {
+	return db.queryPage(ctx, tableExecutions, shardRangeQuery(shardID, minSortKey, maxSortKey), pageToken, pageSize)
+}
+
+func (db *ddb) queryShardPage(ctx context.Context, shardID int, sortKeyPrefix string, pageToken []byte, pageSize int) ([]map[string]*dynamodb.AttributeValue, []byte, error) {
+	return db.queryPage(ctx, tableExecutions, shardPrefixQuery(shardID, sortKeyPrefix), pageToken, pageSize)
+}
+
+func (db *ddb) queryPage(ctx context.Context, table string, input *dynamodb.QueryInput, pageToken []byte, pageSize int) ([]map[string]*dynamodb.AttributeValue, []byte, error) {
+	startKey, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.TableName = db.table(table)
+	input.ConsistentRead = aws.Bool(input.IndexName == nil)
+	input.ExclusiveStartKey = startKey
+	input.Limit = toPageSize(pageSize)
+	resp, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextPageToken, err := encodePageToken(resp.LastEvaluatedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Items, nextPageToken, nil
 }
@@ -0,0 +1,910 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// All the rows of a shard live in the same partition of the executions table,
+// the prefix of the sort key tells the row type apart.
+const (
+	sortKeyPrefixCurrent      = "current#"
+	sortKeyPrefixExecution    = "execution#"
+	sortKeyPrefixRequest      = "request#"
+	sortKeyPrefixPolicy       = "policy#"
+	sortKeyPrefixTransfer     = "transfer#"
+	sortKeyPrefixTimer        = "timer#"
+	sortKeyPrefixReplication  = "replication#"
+	sortKeyPrefixDLQ          = "dlq#"
+	sortKeyPrefixCrossCluster = "crosscluster#"
+
+	attrCurrentRunID       = "current_run_id"
+	attrCreateRequestID    = "create_request_id"
+	attrState              = "state"
+	attrCloseStatus        = "close_status"
+	attrLastWriteVersion   = "last_write_version"
+	attrNextEventID        = "next_event_id"
+	attrVersionHistories   = "version_histories"
+	attrChecksum           = "checksum"
+	attrActivityMap        = "activity_map"
+	attrTimerMap           = "timer_map"
+	attrChildExecutionMap  = "child_executions_map"
+	attrRequestCancelMap   = "request_cancel_map"
+	attrSignalMap          = "signal_map"
+	attrSignalRequestedMap = "signal_requested_map"
+	attrBufferedEvents     = "buffered_events"
+	attrTaskID             = "task_id"
+	attrVisibilityTS       = "visibility_ts"
+	attrDomainID           = "domain_id"
+	attrWorkflowID         = "workflow_id"
+	attrRunID              = "run_id"
+	attrVersion            = "version"
+	attrCreatedTime        = "created_time"
+
+	// workflowRequestTTLInSeconds is how long the request rows are kept for deduplication, same as Cassandra
+	workflowRequestTTLInSeconds = 10800
+)
+
+type (
+	// txItemKind tells which row a transaction item writes to,
+	// so that the cancellation reasons can be converted into the right condition failure
+	txItemKind int
+
+	txItem struct {
+		kind txItemKind
+		// for txItemKindExecution only
+		runID                        string
+		previousNextEventIDCondition *int64
+		// for txItemKindWorkflowRequest only
+		requestKey map[string]*dynamodb.AttributeValue
+		item       *dynamodb.TransactWriteItem
+	}
+
+	workflowTransaction struct {
+		items []*txItem
+	}
+
+	// updateExpression builds an UpdateExpression with the attribute names and values as placeholders
+	updateExpression struct {
+		sets    []string
+		removes []string
+		names   map[string]*string
+		values  map[string]*dynamodb.AttributeValue
+	}
+)
+
+const (
+	txItemKindOther txItemKind = iota
+	txItemKindShard
+	txItemKindCurrentWorkflow
+	txItemKindExecution
+	txItemKindWorkflowRequest
+)
+
+func currentWorkflowSortKey(domainID, workflowID string) string {
+	return sortKeyPrefixCurrent + domainID + "#" + workflowID
+}
+
+func executionSortKey(domainID, workflowID, runID string) string {
+	return sortKeyPrefixExecution + domainID + "#" + workflowID + "#" + runID
+}
+
+func workflowRequestSortKey(requestType persistence.WorkflowRequestType, domainID, workflowID, requestID string) string {
+	return sortKeyPrefixRequest + strconv.Itoa(int(requestType)) + "#" + domainID + "#" + workflowID + "#" + requestID
+}
+
+func activeClusterSelectionPolicySortKey(domainID, workflowID, runID string) string {
+	return sortKeyPrefixPolicy + domainID + "#" + workflowID + "#" + runID
+}
+
+func transferTaskSortKey(taskID int64) string {
+	return sortKeyPrefixTransfer + padInt64(taskID)
+}
+
+func timerTaskSortKeyPrefix(visibilityTimestamp time.Time) string {
+	return sortKeyPrefixTimer + padInt64(visibilityTimestamp.UnixNano()) + "#"
+}
+
+func timerTaskSortKey(visibilityTimestamp time.Time, taskID int64) string {
+	return timerTaskSortKeyPrefix(visibilityTimestamp) + padInt64(taskID)
+}
+
+func replicationTaskSortKey(taskID int64) string {
+	return sortKeyPrefixReplication + padInt64(taskID)
+}
+
+func replicationDLQTaskSortKeyPrefix(sourceCluster string) string {
+	return sortKeyPrefixDLQ + sourceCluster + "#"
+}
+
+func replicationDLQTaskSortKey(sourceCluster string, taskID int64) string {
+	return replicationDLQTaskSortKeyPrefix(sourceCluster) + padInt64(taskID)
+}
+
+func crossClusterTaskSortKey(targetCluster string, taskID int64) string {
+	return sortKeyPrefixCrossCluster + targetCluster + "#" + padInt64(taskID)
+}
+
+func newUpdateExpression() *updateExpression {
+	return &updateExpression{
+		names:  map[string]*string{},
+		values: map[string]*dynamodb.AttributeValue{},
+	}
+}
+
+func (u *updateExpression) name(name string) string {
+	placeholder := "#n" + strconv.Itoa(len(u.names))
+	u.names[placeholder] = aws.String(name)
+	return placeholder
+}
+
+func (u *updateExpression) value(v *dynamodb.AttributeValue) string {
+	placeholder := ":v" + strconv.Itoa(len(u.values))
+	u.values[placeholder] = v
+	return placeholder
+}
+
+func (u *updateExpression) set(attr string, v *dynamodb.AttributeValue) {
+	u.sets = append(u.sets, u.name(attr)+" = "+u.value(v))
+}
+
+func (u *updateExpression) setMapEntry(attr, key string, v *dynamodb.AttributeValue) {
+	u.sets = append(u.sets, u.name(attr)+"."+u.name(key)+" = "+u.value(v))
+}
+
+func (u *updateExpression) removeMapEntry(attr, key string) {
+	u.removes = append(u.removes, u.name(attr)+"."+u.name(key))
+}
+
+func (u *updateExpression) appendList(attr string, v *dynamodb.AttributeValue) {
+	n := u.name(attr)
+	u.sets = append(u.sets, n+" = list_append("+n+", "+u.value(&dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{v}})+")")
+}
+
+// condition adds a condition of equality on an attribute and returns the condition expression
+func (u *updateExpression) equals(attr string, v *dynamodb.AttributeValue) string {
+	return u.name(attr) + " = " + u.value(v)
+}
+
+func (u *updateExpression) expression() *string {
+	var clauses []string
+	if len(u.sets) > 0 {
+		clauses = append(clauses, "SET "+strings.Join(u.sets, ", "))
+	}
+	if len(u.removes) > 0 {
+		clauses = append(clauses, "REMOVE "+strings.Join(u.removes, ", "))
+	}
+	return aws.String(strings.Join(clauses, " "))
+}
+
+func (u *updateExpression) attributeValues() map[string]*dynamodb.AttributeValue {
+	if len(u.values) == 0 {
+		return nil
+	}
+	return u.values
+}
+
+func (t *workflowTransaction) add(kind txItemKind, item *dynamodb.TransactWriteItem) *txItem {
+	i := &txItem{kind: kind, item: item}
+	t.items = append(t.items, i)
+	return i
+}
+
+func (t *workflowTransaction) transactItems() []*dynamodb.TransactWriteItem {
+	items := make([]*dynamodb.TransactWriteItem, 0, len(t.items))
+	for _, i := range t.items {
+		items = append(items, i.item)
+	}
+	return items
+}
+
+func (db *ddb) put(item map[string]*dynamodb.AttributeValue, condition string) *dynamodb.TransactWriteItem {
+	put := &dynamodb.Put{
+		TableName:                           db.table(tableExecutions),
+		Item:                                item,
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	}
+	if condition != "" {
+		put.ConditionExpression = aws.String(condition)
+		put.ExpressionAttributeNames = map[string]*string{"#pk": aws.String(attrPartitionKey)}
+	}
+	return &dynamodb.TransactWriteItem{Put: put}
+}
+
+func (db *ddb) insertWorkflowRequests(tx *workflowTransaction, requests *nosqlplugin.WorkflowRequestsWriteRequest, timeStamp time.Time) error {
+	if requests == nil {
+		return nil
+	}
+	condition := ""
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert:
+		condition = "attribute_not_exists(#pk)"
+	case nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+	for _, row := range requests.Rows {
+		switch row.RequestType {
+		case persistence.WorkflowRequestTypeStart, persistence.WorkflowRequestTypeSignal,
+			persistence.WorkflowRequestTypeCancel, persistence.WorkflowRequestTypeReset:
+		default:
+			return fmt.Errorf("unknown workflow request type %v", row.RequestType)
+		}
+		item := itemKey(shardPartitionKey(row.ShardID), workflowRequestSortKey(row.RequestType, row.DomainID, row.WorkflowID, row.RequestID))
+		key := itemKey(shardPartitionKey(row.ShardID), workflowRequestSortKey(row.RequestType, row.DomainID, row.WorkflowID, row.RequestID))
+		item[attrRunID] = strAttr(row.RunID)
+		item[attrVersion] = numAttr(row.Version)
+		item[attrCreatedTime] = numAttr(timeStamp.UnixNano())
+		item[attrTTL] = ttlAttr(timeStamp, workflowRequestTTLInSeconds)
+		tx.add(txItemKindWorkflowRequest, db.put(item, condition)).requestKey = key
+	}
+	return nil
+}
+
+func (db *ddb) insertActiveClusterSelectionPolicy(tx *workflowTransaction, row *nosqlplugin.ActiveClusterSelectionPolicyRow, timeStamp time.Time) {
+	if row == nil || row.Policy == nil {
+		return
+	}
+	item := itemKey(shardPartitionKey(row.ShardID), activeClusterSelectionPolicySortKey(row.DomainID, row.WorkflowID, row.RunID))
+	item[attrCreatedTime] = numAttr(timeStamp.UnixNano())
+	item[attrData] = binAttr(row.Policy.Data)
+	item[attrDataEncoding] = strAttr(row.Policy.GetEncodingString())
+	tx.add(txItemKindOther, db.put(item, ""))
+}
+
+func (db *ddb) createOrUpdateCurrentWorkflow(
+	tx *workflowTransaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+	timeStamp time.Time,
+) error {
+	key := itemKey(shardPartitionKey(shardID), currentWorkflowSortKey(domainID, workflowID))
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		item := key
+		item[attrDomainID] = strAttr(domainID)
+		item[attrWorkflowID] = strAttr(workflowID)
+		item[attrCurrentRunID] = strAttr(request.Row.RunID)
+		item[attrCreateRequestID] = strAttr(request.Row.CreateRequestID)
+		item[attrState] = numAttr(int64(request.Row.State))
+		item[attrCloseStatus] = numAttr(int64(request.Row.CloseStatus))
+		item[attrLastWriteVersion] = numAttr(request.Row.LastWriteVersion)
+		item[attrCreatedTime] = numAttr(timeStamp.UnixNano())
+		tx.add(txItemKindCurrentWorkflow, db.put(item, "attribute_not_exists(#pk)"))
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		u := newUpdateExpression()
+		u.set(attrCurrentRunID, strAttr(request.Row.RunID))
+		u.set(attrCreateRequestID, strAttr(request.Row.CreateRequestID))
+		u.set(attrState, numAttr(int64(request.Row.State)))
+		u.set(attrCloseStatus, numAttr(int64(request.Row.CloseStatus)))
+		u.set(attrLastWriteVersion, numAttr(request.Row.LastWriteVersion))
+		conditions := []string{u.equals(attrCurrentRunID, strAttr(*request.Condition.CurrentRunID))}
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			conditions = append(conditions,
+				u.equals(attrLastWriteVersion, numAttr(*request.Condition.LastWriteVersion)),
+				u.equals(attrState, numAttr(int64(*request.Condition.State))),
+			)
+		}
+		tx.add(txItemKindCurrentWorkflow, &dynamodb.TransactWriteItem{
+			Update: &dynamodb.Update{
+				TableName:                           db.table(tableExecutions),
+				Key:                                 key,
+				UpdateExpression:                    u.expression(),
+				ConditionExpression:                 aws.String(strings.Join(conditions, " AND ")),
+				ExpressionAttributeNames:            u.names,
+				ExpressionAttributeValues:           u.attributeValues(),
+				ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+			},
+		})
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+	return nil
+}
+
+// toExecutionItem converts the whole execution with all the maps into an item, for create and reset
+func toExecutionItem(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) (map[string]*dynamodb.AttributeValue, error) {
+	item := itemKey(shardPartitionKey(shardID), executionSortKey(execution.DomainID, execution.WorkflowID, execution.RunID))
+	if err := setExecutionAttributes(item, execution); err != nil {
+		return nil, err
+	}
+
+	activityMap, err := toPayloadMap(execution.ActivityInfos)
+	if err != nil {
+		return nil, err
+	}
+	timerMap, err := toPayloadMap(execution.TimerInfos)
+	if err != nil {
+		return nil, err
+	}
+	childMap, err := toPayloadMap(execution.ChildWorkflowInfos)
+	if err != nil {
+		return nil, err
+	}
+	requestCancelMap, err := toPayloadMap(execution.RequestCancelInfos)
+	if err != nil {
+		return nil, err
+	}
+	signalMap, err := toPayloadMap(execution.SignalInfos)
+	if err != nil {
+		return nil, err
+	}
+	signalRequestedMap := map[string]*dynamodb.AttributeValue{}
+	for _, id := range execution.SignalRequestedIDs {
+		signalRequestedMap[id] = boolAttr(true)
+	}
+
+	item[attrActivityMap] = &dynamodb.AttributeValue{M: activityMap}
+	item[attrTimerMap] = &dynamodb.AttributeValue{M: timerMap}
+	item[attrChildExecutionMap] = &dynamodb.AttributeValue{M: childMap}
+	item[attrRequestCancelMap] = &dynamodb.AttributeValue{M: requestCancelMap}
+	item[attrSignalMap] = &dynamodb.AttributeValue{M: signalMap}
+	item[attrSignalRequestedMap] = &dynamodb.AttributeValue{M: signalRequestedMap}
+	item[attrBufferedEvents] = &dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{}}
+	return item, nil
+}
+
+func setExecutionAttributes(item map[string]*dynamodb.AttributeValue, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	info, err := toPayload(&execution.InternalWorkflowExecutionInfo)
+	if err != nil {
+		return err
+	}
+	versionHistories, err := toPayload(execution.VersionHistories)
+	if err != nil {
+		return err
+	}
+	checksums, err := toPayload(execution.Checksums)
+	if err != nil {
+		return err
+	}
+	item[attrPayload] = info
+	item[attrVersionHistories] = versionHistories
+	item[attrChecksum] = checksums
+	item[attrNextEventID] = numAttr(execution.NextEventID)
+	item[attrLastWriteVersion] = numAttr(execution.LastWriteVersion)
+	item[attrState] = numAttr(int64(execution.State))
+	item[attrCloseStatus] = numAttr(int64(execution.CloseStatus))
+	item[attrCreateRequestID] = strAttr(execution.CreateRequestID)
+	return nil
+}
+
+func toPayloadMap[K comparable, V any](m map[K]V) (map[string]*dynamodb.AttributeValue, error) {
+	result := make(map[string]*dynamodb.AttributeValue, len(m))
+	for k, v := range m {
+		payload, err := toPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		result[fmt.Sprint(k)] = payload
+	}
+	return result, nil
+}
+
+func fromPayloadMap[V any](item map[string]*dynamodb.AttributeValue, attr string, parseKey func(string) (int64, error)) (map[int64]*V, error) {
+	result := make(map[int64]*V)
+	v, ok := item[attr]
+	if !ok {
+		return result, nil
+	}
+	for k, payload := range v.M {
+		key, err := parseKey(k)
+		if err != nil {
+			return nil, err
+		}
+		value := new(V)
+		if err := fromPayload(map[string]*dynamodb.AttributeValue{attrPayload: payload}, attrPayload, value); err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+func parseInt64Key(k string) (int64, error) {
+	return strconv.ParseInt(k, 10, 64)
+}
+
+func (db *ddb) createWorkflowExecution(tx *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	item, err := toExecutionItem(shardID, execution)
+	if err != nil {
+		return err
+	}
+	if err := checkItemSize(item); err != nil {
+		return err
+	}
+	tx.add(txItemKindExecution, db.put(item, "attribute_not_exists(#pk)")).runID = execution.RunID
+	return nil
+}
+
+func (db *ddb) resetWorkflowExecution(tx *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+	if execution.PreviousNextEventIDCondition == nil {
+		return fmt.Errorf("PreviousNextEventIDCondition is required for reset")
+	}
+	// reset overrides the whole item, including the maps and the event buffer
+	item, err := toExecutionItem(shardID, execution)
+	if err != nil {
+		return err
+	}
+	if err := checkItemSize(item); err != nil {
+		return err
+	}
+	put := db.put(item, "")
+	put.Put.ConditionExpression = aws.String("#next_event_id = :previous_next_event_id")
+	put.Put.ExpressionAttributeNames = map[string]*string{"#next_event_id": aws.String(attrNextEventID)}
+	put.Put.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{
+		":previous_next_event_id": numAttr(*execution.PreviousNextEventIDCondition),
+	}
+	i := tx.add(txItemKindExecution, put)
+	i.runID = execution.RunID
+	i.previousNextEventIDCondition = execution.PreviousNextEventIDCondition
+	return nil
+}
+
+func (db *ddb) updateWorkflowExecution(tx *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+	if execution.PreviousNextEventIDCondition == nil {
+		return fmt.Errorf("PreviousNextEventIDCondition is required for update")
+	}
+
+	attributes := map[string]*dynamodb.AttributeValue{}
+	if err := setExecutionAttributes(attributes, execution); err != nil {
+		return err
+	}
+	u := newUpdateExpression()
+	// sorted so that the expression is deterministic
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		u.set(name, attributes[name])
+	}
+
+	if err := updatePayloadMap(u, attrActivityMap, execution.ActivityInfos, execution.ActivityInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updatePayloadMap(u, attrTimerMap, execution.TimerInfos, execution.TimerInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updatePayloadMap(u, attrChildExecutionMap, execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updatePayloadMap(u, attrRequestCancelMap, execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updatePayloadMap(u, attrSignalMap, execution.SignalInfos, execution.SignalInfoKeysToDelete); err != nil {
+		return err
+	}
+	signalsRequested := make(map[string]bool, len(execution.SignalRequestedIDs))
+	for _, id := range execution.SignalRequestedIDs {
+		signalsRequested[id] = true
+	}
+	if err := updatePayloadMap(u, attrSignalRequestedMap, signalsRequested, execution.SignalRequestedIDsKeysToDelete); err != nil {
+		return err
+	}
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeNone:
+	case nosqlplugin.EventBufferWriteModeAppend:
+		blob, err := toPayload(execution.NewBufferedEventBatch)
+		if err != nil {
+			return err
+		}
+		u.appendList(attrBufferedEvents, blob)
+	case nosqlplugin.EventBufferWriteModeClear:
+		u.set(attrBufferedEvents, &dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{}})
+	default:
+		return fmt.Errorf("unknown event buffer write mode %v", execution.EventBufferWriteMode)
+	}
+
+	condition := u.equals(attrNextEventID, numAttr(*execution.PreviousNextEventIDCondition))
+	i := tx.add(txItemKindExecution, &dynamodb.TransactWriteItem{
+		Update: &dynamodb.Update{
+			TableName:                           db.table(tableExecutions),
+			Key:                                 itemKey(shardPartitionKey(shardID), executionSortKey(execution.DomainID, execution.WorkflowID, execution.RunID)),
+			UpdateExpression:                    u.expression(),
+			ConditionExpression:                 aws.String(condition),
+			ExpressionAttributeNames:            u.names,
+			ExpressionAttributeValues:           u.attributeValues(),
+			ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+		},
+	})
+	i.runID = execution.RunID
+	i.previousNextEventIDCondition = execution.PreviousNextEventIDCondition
+	return nil
+}
+
+// updatePayloadMap upserts and deletes the entries of a map attribute,
+// an entry that is both upserted and deleted is deleted because the two paths cannot overlap in one expression
+func updatePayloadMap[K comparable, V any](u *updateExpression, attr string, upserts map[K]V, deletes []K) error {
+	deleted := make(map[string]struct{}, len(deletes))
+	for _, k := range deletes {
+		key := fmt.Sprint(k)
+		if _, ok := deleted[key]; ok {
+			continue
+		}
+		deleted[key] = struct{}{}
+		u.removeMapEntry(attr, key)
+	}
+	keys := make([]string, 0, len(upserts))
+	values := make(map[string]V, len(upserts))
+	for k, v := range upserts {
+		key := fmt.Sprint(k)
+		if _, ok := deleted[key]; ok {
+			continue
+		}
+		keys = append(keys, key)
+		values[key] = v
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		var value *dynamodb.AttributeValue
+		if b, ok := any(values[key]).(bool); ok {
+			value = boolAttr(b)
+		} else {
+			payload, err := toPayload(values[key])
+			if err != nil {
+				return err
+			}
+			value = payload
+		}
+		u.setMapEntry(attr, key, value)
+	}
+	return nil
+}
+
+func (db *ddb) createTasksByCategory(
+	tx *workflowTransaction,
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			var item map[string]*dynamodb.AttributeValue
+			var err error
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				item, err = toTransferTaskItem(shardID, task)
+			case persistence.HistoryTaskCategoryIDTimer:
+				item, err = toTimerTaskItem(shardID, task)
+			case persistence.HistoryTaskCategoryIDReplication:
+				item, err = toReplicationTaskItem(shardID, replicationTaskSortKey(task.Replication.TaskID), task)
+			default:
+				return fmt.Errorf("history task category %v is not supported by DynamoDB", c.Name())
+			}
+			if err != nil {
+				return err
+			}
+			tx.add(txItemKindOther, db.put(item, ""))
+		}
+	}
+	return nil
+}
+
+func toTaskItem(shardID int, sortKey string, typedTask interface{}, task *nosqlplugin.HistoryMigrationTask, taskID int64, visibilityTimestamp time.Time) (map[string]*dynamodb.AttributeValue, error) {
+	payload, err := toPayload(typedTask)
+	if err != nil {
+		return nil, err
+	}
+	data, encoding := persistence.FromDataBlob(task.Task)
+	item := itemKey(shardPartitionKey(shardID), sortKey)
+	item[attrPayload] = payload
+	item[attrData] = binAttr(data)
+	item[attrDataEncoding] = strAttr(encoding)
+	item[attrTaskID] = numAttr(taskID)
+	item[attrVisibilityTS] = numAttr(visibilityTimestamp.UnixNano())
+	return item, nil
+}
+
+func toTransferTaskItem(shardID int, task *nosqlplugin.HistoryMigrationTask) (map[string]*dynamodb.AttributeValue, error) {
+	return toTaskItem(shardID, transferTaskSortKey(task.Transfer.TaskID), task.Transfer, task, task.Transfer.TaskID, task.Transfer.VisibilityTimestamp)
+}
+
+func toTimerTaskItem(shardID int, task *nosqlplugin.HistoryMigrationTask) (map[string]*dynamodb.AttributeValue, error) {
+	ts := task.Timer.VisibilityTimestamp
+	return toTaskItem(shardID, timerTaskSortKey(ts, task.Timer.TaskID), task.Timer, task, task.Timer.TaskID, ts)
+}
+
+func toReplicationTaskItem(shardID int, sortKey string, task *nosqlplugin.HistoryMigrationTask) (map[string]*dynamodb.AttributeValue, error) {
+	return toTaskItem(shardID, sortKey, task.Replication, task, task.Replication.TaskID, time.Time{})
+}
+
+func fromTaskItem(item map[string]*dynamodb.AttributeValue, typedTask interface{}) (*nosqlplugin.HistoryMigrationTask, error) {
+	if err := fromPayload(item, attrPayload, typedTask); err != nil {
+		return nil, err
+	}
+	task := &nosqlplugin.HistoryMigrationTask{
+		TaskID: getInt64(item, attrTaskID),
+		Task:   persistence.NewDataBlob(getBytes(item, attrData), constants.EncodingType(getString(item, attrDataEncoding))),
+	}
+	switch t := typedTask.(type) {
+	case *nosqlplugin.TransferTask:
+		task.Transfer = t
+	case *nosqlplugin.TimerTask:
+		task.Timer = t
+		task.ScheduledTime = time.Unix(0, getInt64(item, attrVisibilityTS)).UTC()
+	case *nosqlplugin.ReplicationTask:
+		task.Replication = t
+	}
+	return task, nil
+}
+
+func toCurrentWorkflowRow(shardID int, domainID, workflowID string, item map[string]*dynamodb.AttributeValue) *nosqlplugin.CurrentWorkflowRow {
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            getString(item, attrCurrentRunID),
+		CreateRequestID:  getString(item, attrCreateRequestID),
+		State:            int(getInt64(item, attrState)),
+		CloseStatus:      int(getInt64(item, attrCloseStatus)),
+		LastWriteVersion: getInt64(item, attrLastWriteVersion),
+	}
+}
+
+func (db *ddb) executeWorkflowTransaction(ctx context.Context, tx *workflowTransaction) ([]*dynamodb.CancellationReason, error) {
+	return db.transactWrite(ctx, tx.transactItems())
+}
+
+// toWorkflowConditionFailure converts the cancellation reasons of a workflow transaction into a condition failure,
+// in the same precedence as the Cassandra implementation
+func (db *ddb) toWorkflowConditionFailure(
+	ctx context.Context,
+	tx *workflowTransaction,
+	reasons []*dynamodb.CancellationReason,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	var shardFailure, currentFailure, requestFailure *dynamodb.CancellationReason
+	var requestItem *txItem
+	var executionFailures []*txItem
+	var executionFailureReasons []*dynamodb.CancellationReason
+	var details []string
+	for idx, reason := range reasons {
+		if idx >= len(tx.items) || !isConditionalCheckFailed(reason) {
+			continue
+		}
+		i := tx.items[idx]
+		details = append(details, fmt.Sprintf("%v: %v", idx, reason.Item))
+		switch i.kind {
+		case txItemKindShard:
+			shardFailure = reason
+		case txItemKindCurrentWorkflow:
+			currentFailure = reason
+		case txItemKindWorkflowRequest:
+			if requestFailure == nil {
+				requestFailure = reason
+				requestItem = i
+			}
+		case txItemKindExecution:
+			executionFailures = append(executionFailures, i)
+			executionFailureReasons = append(executionFailureReasons, reason)
+		}
+	}
+
+	if shardFailure != nil {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(getInt64(shardFailure.Item, attrRangeID)),
+		}
+	}
+
+	if requestFailure != nil {
+		// the request item is read again since the item in the cancellation reason may be absent
+		item, err := db.getItem(ctx, tableExecutions, requestItem.requestKey)
+		if err != nil {
+			return err
+		}
+		requestType, err := strconv.Atoi(strings.SplitN(strings.TrimPrefix(getString(requestItem.requestKey, attrSortKey), sortKeyPrefixRequest), "#", 2)[0])
+		if err != nil {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: &nosqlplugin.DuplicateRequest{
+				RequestType: persistence.WorkflowRequestType(requestType),
+				RunID:       getString(item, attrRunID),
+			},
+		}
+	}
+
+	if currentFailure != nil {
+		actual := currentFailure.Item
+		switch currentWorkflowRequest.WriteMode {
+		case nosqlplugin.CurrentWorkflowWriteModeInsert:
+			if len(actual) > 0 {
+				row := toCurrentWorkflowRow(0, "", "", actual)
+				msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, row.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+						OtherInfo:        msg,
+						CreateRequestID:  row.CreateRequestID,
+						RunID:            row.RunID,
+						State:            row.State,
+						CloseStatus:      row.CloseStatus,
+						LastWriteVersion: row.LastWriteVersion,
+					},
+				}
+			}
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v", currentWorkflowRequest.Row.WorkflowID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+			condition := currentWorkflowRequest.Condition
+			actualCurrRunID := getString(actual, attrCurrentRunID)
+			if actualCurrRunID != condition.GetCurrentRunID() {
+				msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+					currentWorkflowRequest.Row.WorkflowID, condition.GetCurrentRunID(), actualCurrRunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if actualVersion := getInt64(actual, attrLastWriteVersion); condition.LastWriteVersion != nil && *condition.LastWriteVersion != actualVersion {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.LastWriteVersion, actualVersion)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if actualState := int(getInt64(actual, attrState)); condition.State != nil && *condition.State != actualState {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.State, actualState)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+		}
+	}
+
+	for idx, i := range executionFailures {
+		actual := executionFailureReasons[idx].Item
+		if i.previousNextEventIDCondition == nil {
+			// creating a concrete execution which already exists
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, i.runID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  getString(actual, attrCreateRequestID),
+					RunID:            i.runID,
+					State:            int(getInt64(actual, attrState)),
+					CloseStatus:      int(getInt64(actual, attrCloseStatus)),
+					LastWriteVersion: getInt64(actual, attrLastWriteVersion),
+				},
+			}
+		}
+		msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+			*i.previousNextEventIDCondition, getInt64(actual, attrNextEventID), i.runID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, items: (%v)",
+		shardCondition.RangeID, strings.Join(details, ","))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func fromExecutionItem(domainID string, item map[string]*dynamodb.AttributeValue) (*nosqlplugin.WorkflowExecution, error) {
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo: &persistence.InternalWorkflowExecutionInfo{},
+	}
+	if err := fromPayload(item, attrPayload, state.ExecutionInfo); err != nil {
+		return nil, err
+	}
+	state.ExecutionInfo.NextEventID = getInt64(item, attrNextEventID)
+	if err := fromPayload(item, attrVersionHistories, &state.VersionHistories); err != nil {
+		return nil, err
+	}
+	var checksums *checksum.Checksum
+	if err := fromPayload(item, attrChecksum, &checksums); err != nil {
+		return nil, err
+	}
+	if checksums != nil {
+		state.Checksum = *checksums
+	}
+
+	var err error
+	if state.ActivityInfos, err = fromPayloadMap[persistence.InternalActivityInfo](item, attrActivityMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+	for _, info := range state.ActivityInfos {
+		if info.DomainID == "" {
+			info.DomainID = domainID
+		}
+	}
+	if state.ChildExecutionInfos, err = fromPayloadMap[persistence.InternalChildExecutionInfo](item, attrChildExecutionMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+	if state.RequestCancelInfos, err = fromPayloadMap[persistence.RequestCancelInfo](item, attrRequestCancelMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+	if state.SignalInfos, err = fromPayloadMap[persistence.SignalInfo](item, attrSignalMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+
+	state.TimerInfos = make(map[string]*persistence.TimerInfo)
+	if v, ok := item[attrTimerMap]; ok {
+		for k, payload := range v.M {
+			info := &persistence.TimerInfo{}
+			if err := fromPayload(map[string]*dynamodb.AttributeValue{attrPayload: payload}, attrPayload, info); err != nil {
+				return nil, err
+			}
+			state.TimerInfos[k] = info
+		}
+	}
+
+	state.SignalRequestedIDs = make(map[string]struct{})
+	if v, ok := item[attrSignalRequestedMap]; ok {
+		for k := range v.M {
+			state.SignalRequestedIDs[k] = struct{}{}
+		}
+	}
+
+	state.BufferedEvents = make([]*persistence.DataBlob, 0)
+	if v, ok := item[attrBufferedEvents]; ok {
+		for _, e := range v.L {
+			blob := &persistence.DataBlob{}
+			if err := fromPayload(map[string]*dynamodb.AttributeValue{attrPayload: e}, attrPayload, blob); err != nil {
+				return nil, err
+			}
+			state.BufferedEvents = append(state.BufferedEvents, blob)
+		}
+	}
+	return state, nil
+}
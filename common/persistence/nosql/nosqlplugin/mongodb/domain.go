@@ -22,17 +22,116 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	// domainMetadataID is the _id of the single document of the domain_metadata collection
+	domainMetadataID = "metadata"
 )
 
+var domainSort = []sortField{{name: "_id"}}
+
+func toDomainEntry(row *nosqlplugin.DomainRow) (*cadence.DomainCollectionEntry, error) {
+	payload, err := toJSON(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.DomainCollectionEntry{
+		Name:                row.Info.Name,
+		DomainID:            row.Info.ID,
+		NotificationVersion: row.NotificationVersion,
+		Domain:              payload,
+	}, nil
+}
+
+func fromDomainEntry(entry *cadence.DomainCollectionEntry) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := fromJSON(entry.Domain, "domain", row); err != nil {
+		return nil, err
+	}
+	if row.FailoverEndTime != nil && row.FailoverEndTime.IsZero() {
+		row.FailoverEndTime = nil
+	}
+	if row.Config != nil && row.Config.BadBinaries == nil {
+		row.Config.BadBinaries = persistence.NewDataBlob(nil, constants.EncodingTypeEmpty)
+	}
+	return row, nil
+}
+
+// updateMetadata bumps the notification version in a transaction, conditioned on the current one.
+// The metadata document is created by the first domain, a conflicting upsert fails with a duplicate key error.
+func (db *mdb) updateMetadata(sc mongo.SessionContext, notificationVersion int64) error {
+	result, err := db.collection(cadence.DomainMetadataCollectionName).UpdateOne(sc,
+		bson.D{{Key: "_id", Value: domainMetadataID}, {Key: "notificationversion", Value: notificationVersion}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "notificationversion", Value: notificationVersion + 1}}}},
+		options.Update().SetUpsert(notificationVersion == 0),
+	)
+	if mongo.IsDuplicateKeyError(err) || (err == nil && result.MatchedCount == 0 && result.UpsertedCount == 0) {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return err
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := *row
+	stored.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	stored.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	stored.NotificationVersion = metadataNotificationVersion
+	entry, err := toDomainEntry(&stored)
+	if err != nil {
+		return err
+	}
+
+	collection := db.collection(cadence.DomainCollectionName)
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		// a failed write aborts the transaction, so the conflicts are checked before inserting
+		count, err := collection.CountDocuments(sc, bson.D{{Key: "_id", Value: row.Info.Name}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		}
+		count, err = collection.CountDocuments(sc, bson.D{{Key: "domainid", Value: row.Info.ID}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		}
+		if _, err := collection.InsertOne(sc, entry); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nosqlplugin.NewConditionFailure("domain")
+			}
+			return err
+		}
+		return db.updateMetadata(sc, metadataNotificationVersion)
+	})
 }
 
 // Update domain
@@ -40,7 +139,18 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	entry, err := toDomainEntry(row)
+	if err != nil {
+		return err
+	}
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		if _, err := db.collection(cadence.DomainCollectionName).ReplaceOne(sc,
+			bson.D{{Key: "_id", Value: entry.Name}}, entry, options.Replace().SetUpsert(true),
+		); err != nil {
+			return err
+		}
+		return db.updateMetadata(sc, row.NotificationVersion)
+	})
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +159,22 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	var filter bson.D
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	} else if domainID != nil {
+		filter = bson.D{{Key: "domainid", Value: *domainID}}
+	} else {
+		filter = bson.D{{Key: "_id", Value: *domainName}}
+	}
+
+	var entry cadence.DomainCollectionEntry
+	if err := db.findOne(ctx, cadence.DomainCollectionName, filter, &entry); err != nil {
+		return nil, err
+	}
+	return fromDomainEntry(&entry)
 }
 
 // Get all domain data
@@ -58,7 +183,23 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.findPage(ctx, cadence.DomainCollectionName, bson.D{}, domainSort, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := decodeAll[cadence.DomainCollectionEntry](docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.DomainRow, 0, len(entries))
+	for _, entry := range entries {
+		row, err := fromDomainEntry(entry)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +208,26 @@ func (db *mdb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+	if domainName != nil {
+		return db.deleteOne(ctx, cadence.DomainCollectionName, bson.D{{Key: "_id", Value: *domainName}})
+	}
+	return db.deleteOne(ctx, cadence.DomainCollectionName, bson.D{{Key: "domainid", Value: *domainID}})
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	var entry cadence.DomainMetadataCollectionEntry
+	err := db.findOne(ctx, cadence.DomainMetadataCollectionName, bson.D{{Key: "_id", Value: domainMetadataID}}, &entry)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata document is created along with the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return entry.NotificationVersion, nil
 }
@@ -22,17 +22,66 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// same as the clustering order of the domain_audit_log table of Cassandra
+var domainAuditLogSort = []sortField{{name: "createdtime", desc: true}, {name: "eventid"}}
+
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *mdb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	return fmt.Errorf("InsertDomainAuditLog not implemented")
+	payload, err := toJSON(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.DomainAuditLogCollectionName).InsertOne(ctx, &cadence.DomainAuditLogCollectionEntry{
+		DomainID:      row.DomainID,
+		OperationType: int(row.OperationType),
+		CreatedTime:   row.CreatedTime.UnixNano(),
+		EventID:       row.EventID,
+		AuditLog:      payload,
+		ExpireAt:      expireAt(time.Now(), row.TTLSeconds),
+	})
+	return err
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *mdb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+	docs, nextPageToken, err := db.findPage(ctx, cadence.DomainAuditLogCollectionName, bson.D{
+		{Key: "domainid", Value: filter.DomainID},
+		{Key: "operationtype", Value: int(filter.OperationType)},
+		{Key: "createdtime", Value: bson.D{
+			{Key: "$gte", Value: filter.MinCreatedTime.UnixNano()},
+			{Key: "$lt", Value: filter.MaxCreatedTime.UnixNano()},
+		}},
+		notExpired(time.Now()),
+	}, domainAuditLogSort, filter.NextPageToken, filter.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := decodeAll[cadence.DomainAuditLogCollectionEntry](docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(entries))
+	for _, entry := range entries {
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := fromJSON(entry.AuditLog, "auditlog", row); err != nil {
+			return nil, nil, err
+		}
+		row.TTLSeconds = 0
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
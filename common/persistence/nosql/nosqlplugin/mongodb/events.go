@@ -22,31 +22,181 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+var (
+	// same as the clustering order of the history_node table of Cassandra
+	historyNodeSort = []sortField{{name: "nodeid"}, {name: "txnid", desc: true}}
+	historyTreeSort = []sortField{{name: "treeid"}, {name: "branchid"}}
 )
 
+func historyTreeFilter(treeID, branchID string) bson.D {
+	return bson.D{{Key: "treeid", Value: treeID}, {Key: "branchid", Value: branchID}}
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var ops []func(ctx context.Context) error
+	if treeRow != nil {
+		ancestors, err := toJSON(treeRow.Ancestors)
+		if err != nil {
+			return err
+		}
+		entry := &cadence.HistoryTreeCollectionEntry{
+			TreeID:      treeRow.TreeID,
+			BranchID:    treeRow.BranchID,
+			Ancestors:   ancestors,
+			Info:        treeRow.Info,
+			CreatedTime: treeRow.CreateTimestamp.UnixNano(),
+		}
+		ops = append(ops, func(ctx context.Context) error {
+			_, err := db.collection(cadence.HistoryTreeCollectionName).ReplaceOne(ctx,
+				historyTreeFilter(treeRow.TreeID, treeRow.BranchID), entry, options.Replace().SetUpsert(true))
+			return err
+		})
+	}
+	if nodeRow != nil {
+		if nodeRow.TxnID == nil {
+			return fmt.Errorf("txn_id is required for a node row")
+		}
+		entry := &cadence.HistoryNodeCollectionEntry{
+			TreeID:       nodeRow.TreeID,
+			BranchID:     nodeRow.BranchID,
+			NodeID:       nodeRow.NodeID,
+			TxnID:        *nodeRow.TxnID,
+			Data:         nodeRow.Data,
+			DataEncoding: nodeRow.DataEncoding,
+			CreatedTime:  nodeRow.CreateTimestamp.UnixNano(),
+		}
+		filter := append(historyTreeFilter(nodeRow.TreeID, nodeRow.BranchID),
+			bson.E{Key: "nodeid", Value: entry.NodeID},
+			bson.E{Key: "txnid", Value: entry.TxnID},
+		)
+		ops = append(ops, func(ctx context.Context) error {
+			_, err := db.collection(cadence.HistoryNodeCollectionName).ReplaceOne(ctx, filter, entry, options.Replace().SetUpsert(true))
+			return err
+		})
+	}
+
+	if len(ops) == 1 {
+		return ops[0](ctx)
+	}
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		for _, op := range ops {
+			if err := op(sc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.findPage(ctx, cadence.HistoryNodeCollectionName,
+		append(historyTreeFilter(filter.TreeID, filter.BranchID),
+			bson.E{Key: "nodeid", Value: bson.D{{Key: "$gte", Value: filter.MinNodeID}, {Key: "$lt", Value: filter.MaxNodeID}}}),
+		historyNodeSort, filter.NextPageToken, filter.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := decodeAll[cadence.HistoryNodeCollectionEntry](docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(entries))
+	for _, entry := range entries {
+		txnID := entry.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			NodeID:       entry.NodeID,
+			TxnID:        &txnID,
+			Data:         entry.Data,
+			DataEncoding: entry.DataEncoding,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	if treeFilter.BranchID == nil {
+		return fmt.Errorf("branch_id is required to delete from history tree")
+	}
+	// the nodes are deleted first, so the branch can still be found to retry if any of the deletions fails
+	for _, nodeFilter := range nodeFilters {
+		if _, err := db.deleteMany(ctx, cadence.HistoryNodeCollectionName,
+			append(historyTreeFilter(nodeFilter.TreeID, nodeFilter.BranchID),
+				bson.E{Key: "nodeid", Value: bson.D{{Key: "$gte", Value: nodeFilter.MinNodeID}}}),
+		); err != nil {
+			return err
+		}
+	}
+	return db.deleteOne(ctx, cadence.HistoryTreeCollectionName, historyTreeFilter(treeFilter.TreeID, *treeFilter.BranchID))
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	docs, token, err := db.findPage(ctx, cadence.HistoryTreeCollectionName, bson.D{}, historyTreeSort, nextPageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := toHistoryTreeRows(docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, token, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	docs, _, err := db.findPage(ctx, cadence.HistoryTreeCollectionName, bson.D{{Key: "treeid", Value: filter.TreeID}}, historyTreeSort, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	return toHistoryTreeRows(docs)
+}
+
+func toHistoryTreeRows(docs []bson.Raw) ([]*nosqlplugin.HistoryTreeRow, error) {
+	entries, err := decodeAll[cadence.HistoryTreeCollectionEntry](docs)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(entries))
+	for _, entry := range entries {
+		var ancestors []*types.HistoryBranchRange
+		if err := fromJSON(entry.Ancestors, "ancestors", &ancestors); err != nil {
+			return nil, err
+		}
+		if len(ancestors) > 0 {
+			// sort ancestors based on EndNodeID so that we can set BeginNodeID
+			sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+			ancestors[0].BeginNodeID = int64(1)
+			for i := 1; i < len(ancestors); i++ {
+				ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+			}
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:          entry.TreeID,
+			BranchID:        entry.BranchID,
+			Ancestors:       ancestors,
+			CreateTimestamp: time.Unix(0, entry.CreatedTime),
+			Info:            entry.Info,
+		})
+	}
+	return rows, nil
 }
@@ -23,6 +23,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -58,7 +59,7 @@ func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger, dc *persist
 }
 
 func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error) {
-	uri := fmt.Sprintf("mongodb://%v:%v@%v:%v/", cfg.User, cfg.Password, cfg.Hosts, cfg.Port)
+	uri := buildURI(cfg)
 	// TODO CreateDB/CreateAdminDB don't pass in context.Context so we are using background for now
 	// It's okay because this is being called during server startup or CLI.
 	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
@@ -76,3 +77,20 @@ func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error)
 		logger: logger,
 	}, err
 }
+
+// buildURI returns the connection string of the database. The ConnectAttributes are appended as
+// the connection string options, e.g. replicaSet which is required to run multi-document transactions.
+func buildURI(cfg *config.NoSQL) string {
+	uri := fmt.Sprintf("mongodb://%v:%v/", cfg.Hosts, cfg.Port)
+	if cfg.User != "" {
+		uri = fmt.Sprintf("mongodb://%v:%v@%v:%v/", url.QueryEscape(cfg.User), url.QueryEscape(cfg.Password), cfg.Hosts, cfg.Port)
+	}
+	if len(cfg.ConnectAttributes) == 0 {
+		return uri
+	}
+	params := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		params.Set(k, v)
+	}
+	return uri + "?" + params.Encode()
+}
@@ -22,19 +22,66 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	queueConditionFailure = "queue"
 )
 
+var queueMessageSort = []sortField{{name: "messageid"}}
+
+func queueFilter(queueType persistence.QueueType) bson.D {
+	return bson.D{{Key: "queuetype", Value: int(queueType)}}
+}
+
+// queueRangeFilter returns the filter of the messages between exclusiveBeginMessageID and inclusiveEndMessageID
+func queueRangeFilter(queueType persistence.QueueType, exclusiveBeginMessageID, inclusiveEndMessageID int64) bson.D {
+	return append(queueFilter(queueType), bson.E{Key: "messageid", Value: bson.D{
+		{Key: "$gt", Value: exclusiveBeginMessageID},
+		{Key: "$lte", Value: inclusiveEndMessageID},
+	}})
+}
+
+func toQueueMessageRows(queueType persistence.QueueType, docs []bson.Raw) ([]*nosqlplugin.QueueMessageRow, error) {
+	entries, err := decodeAll[cadence.QueueMessageCollectionEntry](docs)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.QueueMessageRow, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        entry.MessageID,
+			Payload:   entry.Payload,
+		})
+	}
+	return rows, nil
+}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).InsertOne(ctx, &cadence.QueueMessageCollectionEntry{
+		QueueType:   int(row.QueueType),
+		MessageID:   row.ID,
+		Payload:     row.Payload,
+		CreatedTime: row.CurrentTimeStamp.UnixNano(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nosqlplugin.NewConditionFailure(queueConditionFailure)
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -42,7 +89,15 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	var entry cadence.QueueMessageCollectionEntry
+	err := db.collection(cadence.QueueMessageCollectionName).FindOne(ctx,
+		queueFilter(queueType),
+		options.FindOne().SetSort(bson.D{{Key: "messageid", Value: -1}}),
+	).Decode(&entry)
+	if err != nil {
+		return 0, err
+	}
+	return entry.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -52,7 +107,13 @@ func (db *mdb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	docs, _, err := db.findPage(ctx, cadence.QueueMessageCollectionName,
+		append(queueFilter(queueType), bson.E{Key: "messageid", Value: bson.D{{Key: "$gt", Value: exclusiveBeginMessageID}}}),
+		queueMessageSort, nil, maxRows)
+	if err != nil {
+		return nil, err
+	}
+	return toQueueMessageRows(queueType, docs)
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -60,7 +121,24 @@ func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.findPage(ctx, cadence.QueueMessageCollectionName,
+		queueRangeFilter(request.QueueType, request.ExclusiveBeginMessageID, request.InclusiveEndMessageID),
+		queueMessageSort, request.NextPageToken, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := toQueueMessageRows(request.QueueType, docs)
+	if err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          make([]nosqlplugin.QueueMessageRow, 0, len(rows)),
+		NextPageToken: nextPageToken,
+	}
+	for _, row := range rows {
+		response.Rows = append(response.Rows, *row)
+	}
+	return response, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -69,7 +147,9 @@ func (db *mdb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.deleteMany(ctx, cadence.QueueMessageCollectionName,
+		append(queueFilter(queueType), bson.E{Key: "messageid", Value: bson.D{{Key: "$lt", Value: exclusiveBeginMessageID}}}))
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -79,7 +159,9 @@ func (db *mdb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.deleteMany(ctx, cadence.QueueMessageCollectionName,
+		queueRangeFilter(queueType, exclusiveBeginMessageID, inclusiveEndMessageID))
+	return err
 }
 
 // Delete one message
@@ -88,13 +170,27 @@ func (db *mdb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.QueueMessageCollectionName,
+		append(queueFilter(queueType), bson.E{Key: "messageid", Value: messageID}))
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *mdb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil
+	ackLevels, err := toJSON(map[string]int64{})
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.QueueMetadataCollectionName).InsertOne(ctx, &cadence.QueueMetadataCollectionEntry{
+		QueueType:        int(row.QueueType),
+		ClusterAckLevels: ackLevels,
+		Version:          row.Version,
+		CreatedTime:      row.CurrentTimeStamp.UnixNano(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// it's ok if the document exists already
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -104,7 +200,25 @@ func (db *mdb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	ackLevels, err := toJSON(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	result, err := db.collection(cadence.QueueMetadataCollectionName).UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: int(row.QueueType)}, {Key: "version", Value: row.Version - 1}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "clusteracklevels", Value: ackLevels},
+			{Key: "version", Value: row.Version},
+			{Key: "updatedtime", Value: row.CurrentTimeStamp.UnixNano()},
+		}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure(queueConditionFailure)
+	}
+	return nil
 }
 
 // Read a QueueMetadata
@@ -112,13 +226,28 @@ func (db *mdb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil, nil
+	var entry cadence.QueueMetadataCollectionEntry
+	if err := db.findOne(ctx, cadence.QueueMetadataCollectionName, bson.D{{Key: "_id", Value: int(queueType)}}, &entry); err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := fromJSON(entry.ClusterAckLevels, "clusteracklevels", &ackLevels); err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          entry.Version,
+	}, nil
 }
 
 func (db *mdb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.QueueMessageCollectionName).CountDocuments(ctx, queueFilter(queueType))
 }
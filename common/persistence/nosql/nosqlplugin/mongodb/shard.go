@@ -22,31 +22,153 @@ package mongodb
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+func shardFilter(shardID int) bson.D {
+	return bson.D{{Key: "_id", Value: shardID}}
+}
+
+func shardRangeIDFilter(shardID int, rangeID int64) bson.D {
+	return bson.D{{Key: "_id", Value: shardID}, {Key: "rangeid", Value: rangeID}}
+}
+
+func toShardEntry(row *nosqlplugin.ShardRow) (*cadence.ShardCollectionEntry, error) {
+	info := *row.InternalShardInfo
+	info.UpdatedAt = row.CurrentTimestamp
+	payload, err := toJSON(&info)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardCollectionEntry{
+		ShardID:      row.ShardID,
+		RangeID:      row.RangeID,
+		Info:         payload,
+		Data:         row.Data,
+		DataEncoding: row.DataEncoding,
+	}, nil
+}
+
+// fenceShard makes a transaction conflict with any other transaction or update of the shard,
+// and aborts it if the range_id of the shard doesn't match
+func (db *mdb) fenceShard(sc mongo.SessionContext, shardID int, rangeID int64) error {
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(sc,
+		shardRangeIDFilter(shardID, rangeID),
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "txncounter", Value: 1}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return &txConditionFailure{kind: txItemKindShard}
+	}
+	return nil
+}
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	log.Println("not implemented...ignore the error for testing...")
-	return nil
+	entry, err := toShardEntry(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.ShardCollectionName).InsertOne(ctx, entry)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.readConflictedShard(ctx, row.ShardID)
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *mdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	var entry cadence.ShardCollectionEntry
+	if err := db.findOne(ctx, cadence.ShardCollectionName, shardFilter(shardID), &entry); err != nil {
+		return 0, nil, err
+	}
+	info := &persistence.InternalShardInfo{}
+	if err := fromJSON(entry.Info, "info", info); err != nil {
+		return 0, nil, err
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return entry.RangeID, &nosqlplugin.ShardRow{
+		InternalShardInfo: info,
+		Data:              entry.Data,
+		DataEncoding:      entry.DataEncoding,
+	}, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(ctx,
+		shardRangeIDFilter(shardID, previousRangeID),
+		bson.D{{Key: "$set", Value: bson.D{{Key: "rangeid", Value: rangeID}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.readConflictedShard(ctx, shardID)
+	}
+	return nil
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	entry, err := toShardEntry(row)
+	if err != nil {
+		return err
+	}
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(ctx,
+		shardRangeIDFilter(row.ShardID, previousRangeID),
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "rangeid", Value: entry.RangeID},
+			{Key: "info", Value: entry.Info},
+			{Key: "data", Value: entry.Data},
+			{Key: "dataencoding", Value: entry.DataEncoding},
+		}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.readConflictedShard(ctx, row.ShardID)
+	}
+	return nil
+}
+
+func (db *mdb) readConflictedShard(ctx context.Context, shardID int) error {
+	var entry cadence.ShardCollectionEntry
+	err := db.findOne(ctx, cadence.ShardCollectionName, shardFilter(shardID), &entry)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: entry.RangeID,
+		Details: fmt.Sprintf("shard_id=%v,range_id=%v,exists=%v", shardID, entry.RangeID, err == nil),
+	}
 }
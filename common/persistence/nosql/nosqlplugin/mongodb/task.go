@@ -22,20 +22,117 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
+)
+
+var (
+	taskListSort = []sortField{{name: "domainid"}, {name: "tasklistname"}, {name: "tasklisttype"}}
+
+	errTaskListRangeIDNotMatch = errors.New("range_id of the tasklist doesn't match")
 )
 
+func taskListFilter(domainID, taskListName string, taskListType int) bson.D {
+	return bson.D{
+		{Key: "domainid", Value: domainID},
+		{Key: "tasklistname", Value: taskListName},
+		{Key: "tasklisttype", Value: taskListType},
+	}
+}
+
+func taskListRangeIDFilter(domainID, taskListName string, taskListType int, rangeID int64) bson.D {
+	return append(taskListFilter(domainID, taskListName, taskListType), bson.E{Key: "rangeid", Value: rangeID})
+}
+
+func toTaskListEntry(row *nosqlplugin.TaskListRow, rangeID int64, lastUpdatedTime time.Time) (*cadence.TaskListCollectionEntry, error) {
+	partitionConfig, err := toJSON(row.AdaptivePartitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskListCollectionEntry{
+		DomainID:                row.DomainID,
+		TaskListName:            row.TaskListName,
+		TaskListType:            row.TaskListType,
+		RangeID:                 rangeID,
+		TaskListKind:            row.TaskListKind,
+		AckLevel:                row.AckLevel,
+		LastUpdatedTime:         lastUpdatedTime.UnixNano(),
+		AdaptivePartitionConfig: partitionConfig,
+		CompatibleBuildIDSets:   row.CompatibleBuildIDSets,
+	}, nil
+}
+
+func toTaskListRow(entry *cadence.TaskListCollectionEntry) (*nosqlplugin.TaskListRow, error) {
+	var partitionConfig *persistence.TaskListPartitionConfig
+	if err := fromJSON(entry.AdaptivePartitionConfig, "adaptivepartitionconfig", &partitionConfig); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.TaskListRow{
+		DomainID:                entry.DomainID,
+		TaskListName:            entry.TaskListName,
+		TaskListType:            entry.TaskListType,
+		RangeID:                 entry.RangeID,
+		TaskListKind:            entry.TaskListKind,
+		AckLevel:                entry.AckLevel,
+		LastUpdatedTime:         time.Unix(0, entry.LastUpdatedTime),
+		AdaptivePartitionConfig: partitionConfig,
+		CompatibleBuildIDSets:   entry.CompatibleBuildIDSets,
+	}, nil
+}
+
+func (db *mdb) readConflictedTaskList(ctx context.Context, domainID, taskListName string, taskListType int) error {
+	var entry cadence.TaskListCollectionEntry
+	err := db.findOne(ctx, cadence.TaskListCollectionName, taskListFilter(domainID, taskListName, taskListType), &entry)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: entry.RangeID,
+		Details: fmt.Sprintf("range_id=%v,exists=%v", entry.RangeID, err == nil),
+	}
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	var entry cadence.TaskListCollectionEntry
+	if err := db.findOne(ctx, cadence.TaskListCollectionName, taskListFilter(filter.DomainID, filter.TaskListName, filter.TaskListType), &entry); err != nil {
+		return nil, err
+	}
+	return toTaskListRow(&entry)
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	entry, err := toTaskListEntry(&nosqlplugin.TaskListRow{
+		DomainID:                row.DomainID,
+		TaskListName:            row.TaskListName,
+		TaskListType:            row.TaskListType,
+		TaskListKind:            row.TaskListKind,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+		CompatibleBuildIDSets:   row.CompatibleBuildIDSets,
+	}, initialRangeID, row.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.TaskListCollectionName).InsertOne(ctx, entry)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.readConflictedTaskList(ctx, row.DomainID, row.TaskListName, row.TaskListType)
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +142,11 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	entry, err := toTaskListEntry(row, row.RangeID, row.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+	return db.updateTaskList(ctx, entry, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +159,77 @@ func (db *mdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	entry, err := toTaskListEntry(row, row.RangeID, row.CurrentTimeStamp)
+	if err != nil {
+		return err
+	}
+	entry.ExpireAt = expireAt(row.CurrentTimeStamp, ttlSeconds)
+	return db.updateTaskList(ctx, entry, previousRangeID)
+}
+
+func (db *mdb) updateTaskList(ctx context.Context, entry *cadence.TaskListCollectionEntry, previousRangeID int64) error {
+	set := bson.D{
+		{Key: "rangeid", Value: entry.RangeID},
+		{Key: "tasklistkind", Value: entry.TaskListKind},
+		{Key: "acklevel", Value: entry.AckLevel},
+		{Key: "lastupdatedtime", Value: entry.LastUpdatedTime},
+		{Key: "adaptivepartitionconfig", Value: entry.AdaptivePartitionConfig},
+		{Key: "compatiblebuildidsets", Value: entry.CompatibleBuildIDSets},
+	}
+	update := bson.D{}
+	if entry.ExpireAt != nil {
+		set = append(set, bson.E{Key: "expireat", Value: *entry.ExpireAt})
+	} else {
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{Key: "expireat", Value: ""}}})
+	}
+	update = append(update, bson.E{Key: "$set", Value: set})
+	result, err := db.collection(cadence.TaskListCollectionName).UpdateOne(ctx,
+		taskListRangeIDFilter(entry.DomainID, entry.TaskListName, entry.TaskListType, previousRangeID),
+		update,
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.readConflictedTaskList(ctx, entry.DomainID, entry.TaskListName, entry.TaskListType)
+	}
+	return nil
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	docs, token, err := db.findPage(ctx, cadence.TaskListCollectionName, bson.D{}, taskListSort, nextPageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := decodeAll[cadence.TaskListCollectionEntry](docs)
+	if err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{NextPageToken: token}
+	for _, entry := range entries {
+		row, err := toTaskListRow(entry)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.collection(cadence.TaskListCollectionName).DeleteOne(ctx,
+		taskListRangeIDFilter(filter.DomainID, filter.TaskListName, filter.TaskListType, previousRangeID))
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return db.readConflictedTaskList(ctx, filter.DomainID, filter.TaskListName, filter.TaskListType)
+	}
+	return nil
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,16 +239,84 @@ func (db *mdb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	timeStamp := tasklistCondition.CurrentTimeStamp
+	entries := make([]interface{}, 0, len(tasksToInsert))
+	for _, task := range tasksToInsert {
+		payload, err := toJSON(&task.TaskRow)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, &cadence.TaskCollectionEntry{
+			DomainID:     tasklistCondition.DomainID,
+			TaskListName: tasklistCondition.TaskListName,
+			TaskListType: tasklistCondition.TaskListType,
+			TaskID:       task.TaskID,
+			Task:         payload,
+			ExpireAt:     expireAt(timeStamp, int64(task.TTLSeconds)),
+		})
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	err := db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		// The following update is used to ensure that range_id didn't change,
+		// and to conflict with any concurrent update of the tasklist
+		result, err := db.collection(cadence.TaskListCollectionName).UpdateOne(sc,
+			taskListRangeIDFilter(tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType, tasklistCondition.RangeID),
+			bson.D{{Key: "$inc", Value: bson.D{{Key: "txncounter", Value: 1}}}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return errTaskListRangeIDNotMatch
+		}
+		_, err = db.collection(cadence.TaskCollectionName).InsertMany(sc, entries)
+		return err
+	})
+	if err == errTaskListRangeIDNotMatch {
+		return db.readConflictedTaskList(ctx, tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+	}
+	return err
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return nil, nil
+	}
+	docs, _, err := db.findPage(ctx, cadence.TaskCollectionName,
+		append(taskListFilter(filter.DomainID, filter.TaskListName, filter.TaskListType),
+			bson.E{Key: "taskid", Value: bson.D{{Key: "$gt", Value: filter.MinTaskID}, {Key: "$lte", Value: filter.MaxTaskID}}},
+			notExpired(time.Now()),
+		), taskIDSort, nil, filter.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := decodeAll[cadence.TaskCollectionEntry](docs)
+	if err != nil {
+		return nil, err
+	}
+	var response []*nosqlplugin.TaskRow
+	for _, entry := range entries {
+		task := &nosqlplugin.TaskRow{}
+		if err := fromJSON(entry.Task, "task", task); err != nil {
+			return nil, err
+		}
+		task.TaskID = entry.TaskID
+		if entry.ExpireAt != nil {
+			task.Expiry = *entry.ExpireAt
+		}
+		response = append(response, task)
+	}
+	return response, nil
 }
 
 func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.TaskCollectionName).CountDocuments(ctx,
+		append(taskListFilter(filter.DomainID, filter.TaskListName, filter.TaskListType),
+			bson.E{Key: "taskid", Value: bson.D{{Key: "$gt", Value: filter.MinTaskID}}}))
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
@@ -97,5 +324,7 @@ func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilte
 // NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
 // be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	return db.deleteMany(ctx, cadence.TaskCollectionName,
+		append(taskListFilter(filter.DomainID, filter.TaskListName, filter.TaskListType),
+			bson.E{Key: "taskid", Value: bson.D{{Key: "$lte", Value: filter.MaxTaskID}}}))
 }
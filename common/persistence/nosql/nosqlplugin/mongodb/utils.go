@@ -0,0 +1,240 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+type (
+	// sortField is a field of the sort order of a query, which is also used for keyset pagination
+	sortField struct {
+		name string
+		desc bool
+	}
+
+	// pageToken holds the values of the sort fields of the last document of a page
+	pageToken struct {
+		Values bson.A `bson:"values"`
+	}
+)
+
+func (db *mdb) collection(name string) *mongo.Collection {
+	return db.dbConn.Collection(name)
+}
+
+// withTransaction runs fn in a multi-document transaction, which requires MongoDB to run as a replica set or sharded cluster.
+// The transaction is retried by the driver on transient errors like write conflicts,
+// any other error returned by fn aborts the transaction and is returned as is.
+func (db *mdb) withTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	txnOptions := options.Transaction().
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.New(writeconcern.WMajority()))
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	}, txnOptions)
+	return err
+}
+
+func toJSON(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func fromJSON(data []byte, name string, v interface{}) error {
+	if len(data) == 0 {
+		return fmt.Errorf("corrupted document, field %v is missing", name)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// escapeKey escapes a map key to be used as a field name, MongoDB field names cannot contain '.' or start with '$'
+func escapeKey(key string) string {
+	key = strings.ReplaceAll(key, "%", "%25")
+	key = strings.ReplaceAll(key, ".", "%2E")
+	return strings.ReplaceAll(key, "$", "%24")
+}
+
+func unescapeKey(key string) (string, error) {
+	return url.PathUnescape(key)
+}
+
+func expireAt(now time.Time, ttlSeconds int64) *time.Time {
+	if ttlSeconds <= 0 {
+		return nil
+	}
+	t := now.Add(time.Duration(ttlSeconds) * time.Second)
+	return &t
+}
+
+// notExpired filters out the expired documents, the TTL monitor of MongoDB removes them lazily
+func notExpired(now time.Time) bson.E {
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "expireat", Value: bson.D{{Key: "$exists", Value: false}}}},
+		bson.D{{Key: "expireat", Value: bson.D{{Key: "$gt", Value: now}}}},
+	}}
+}
+
+func toSort(fields []sortField) bson.D {
+	sort := make(bson.D, 0, len(fields))
+	for _, f := range fields {
+		order := 1
+		if f.desc {
+			order = -1
+		}
+		sort = append(sort, bson.E{Key: f.name, Value: order})
+	}
+	return sort
+}
+
+// afterKey returns the filter of the documents that come after the given values of the sort fields
+func afterKey(fields []sortField, values bson.A) (bson.D, error) {
+	if len(fields) != len(values) {
+		return nil, fmt.Errorf("invalid page token, expect %v values but got %v", len(fields), len(values))
+	}
+	conditions := make(bson.A, 0, len(fields))
+	for i, f := range fields {
+		condition := make(bson.D, 0, i+1)
+		for j := 0; j < i; j++ {
+			condition = append(condition, bson.E{Key: fields[j].name, Value: values[j]})
+		}
+		op := "$gt"
+		if f.desc {
+			op = "$lt"
+		}
+		condition = append(condition, bson.E{Key: f.name, Value: bson.D{{Key: op, Value: values[i]}}})
+		conditions = append(conditions, condition)
+	}
+	return bson.D{{Key: "$or", Value: conditions}}, nil
+}
+
+func encodePageToken(fields []sortField, last bson.Raw) ([]byte, error) {
+	values := make(bson.A, 0, len(fields))
+	for _, f := range fields {
+		v, err := last.LookupErr(f.name)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return bson.Marshal(pageToken{Values: values})
+}
+
+func decodePageToken(token []byte) (bson.A, error) {
+	var t pageToken
+	if err := bson.Unmarshal(token, &t); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return t.Values, nil
+}
+
+// findPage reads a page of the documents that match the filter, in the order of the sort fields.
+// The sort fields must be unique for a document, the next page token is empty if the page is not full.
+func (db *mdb) findPage(
+	ctx context.Context,
+	collection string,
+	filter bson.D,
+	sort []sortField,
+	token []byte,
+	pageSize int,
+) ([]bson.Raw, []byte, error) {
+	if len(token) > 0 {
+		values, err := decodePageToken(token)
+		if err != nil {
+			return nil, nil, err
+		}
+		after, err := afterKey(sort, values)
+		if err != nil {
+			return nil, nil, err
+		}
+		filter = bson.D{{Key: "$and", Value: bson.A{filter, after}}}
+	}
+	findOptions := options.Find().SetSort(toSort(sort))
+	if pageSize > 0 {
+		findOptions.SetLimit(int64(pageSize))
+	}
+	cursor, err := db.collection(collection).Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	var docs []bson.Raw
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, nil, err
+	}
+	if pageSize <= 0 || len(docs) < pageSize {
+		return docs, nil, nil
+	}
+	nextPageToken, err := encodePageToken(sort, docs[len(docs)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	return docs, nextPageToken, nil
+}
+
+func decodeAll[T any](docs []bson.Raw) ([]*T, error) {
+	entries := make([]*T, 0, len(docs))
+	for _, doc := range docs {
+		entry := new(T)
+		if err := bson.Unmarshal(doc, entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (db *mdb) findOne(ctx context.Context, collection string, filter bson.D, result interface{}) error {
+	return db.collection(collection).FindOne(ctx, filter).Decode(result)
+}
+
+func (db *mdb) deleteOne(ctx context.Context, collection string, filter bson.D) error {
+	_, err := db.collection(collection).DeleteOne(ctx, filter)
+	return err
+}
+
+func (db *mdb) deleteMany(ctx context.Context, collection string, filter bson.D) (int, error) {
+	result, err := db.collection(collection).DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.DeletedCount), nil
+}
+
+// between returns the condition of a field in [inclusiveMin, inclusiveMax]
+func between(inclusiveMin, inclusiveMax interface{}) bson.D {
+	return bson.D{{Key: "$gte", Value: inclusiveMin}, {Key: "$lte", Value: inclusiveMax}}
+}
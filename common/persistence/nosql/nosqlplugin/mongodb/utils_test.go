@@ -0,0 +1,79 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestEscapeKey(t *testing.T) {
+	for _, key := range []string{"", "1", "a.b", "$set", "100%", "%2E", "a.$%b"} {
+		escaped := escapeKey(key)
+		assert.NotContains(t, escaped, ".")
+		assert.NotContains(t, escaped, "$")
+		unescaped, err := unescapeKey(escaped)
+		require.NoError(t, err)
+		assert.Equal(t, key, unescaped)
+	}
+}
+
+func TestExpireAt(t *testing.T) {
+	now := time.Unix(1000, 0)
+	assert.Nil(t, expireAt(now, 0))
+	assert.Nil(t, expireAt(now, -1))
+	assert.Equal(t, now.Add(time.Minute), *expireAt(now, 60))
+}
+
+func TestAfterKey(t *testing.T) {
+	fields := []sortField{{name: "a"}, {name: "b", desc: true}}
+
+	_, err := afterKey(fields, bson.A{1})
+	assert.Error(t, err)
+
+	filter, err := afterKey(fields, bson.A{1, "x"})
+	require.NoError(t, err)
+	assert.Equal(t, bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "a", Value: bson.D{{Key: "$gt", Value: 1}}}},
+		bson.D{{Key: "a", Value: 1}, {Key: "b", Value: bson.D{{Key: "$lt", Value: "x"}}}},
+	}}}, filter)
+}
+
+func TestPageToken(t *testing.T) {
+	fields := []sortField{{name: "shardid"}, {name: "runid"}}
+	last, err := bson.Marshal(bson.D{{Key: "shardid", Value: int32(10)}, {Key: "other", Value: true}, {Key: "runid", Value: "r"}})
+	require.NoError(t, err)
+
+	token, err := encodePageToken(fields, last)
+	require.NoError(t, err)
+	values, err := decodePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, bson.A{int32(10), "r"}, values)
+
+	_, err = encodePageToken([]sortField{{name: "missing"}}, last)
+	assert.Error(t, err)
+	_, err = decodePageToken([]byte("invalid"))
+	assert.Error(t, err)
+}
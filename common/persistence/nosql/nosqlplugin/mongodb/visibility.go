@@ -22,43 +22,183 @@ package mongodb
 
 import (
 	"context"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+var (
+	// runid breaks the ties of the same timestamp so that the sort order is total
+	visibilityByStartSort = []sortField{{name: "starttime", desc: true}, {name: "runid", desc: true}}
+	visibilityByCloseSort = []sortField{{name: "closetime", desc: true}, {name: "runid", desc: true}}
 )
 
+func visibilityFilter(domainID, workflowID, runID string) bson.D {
+	return bson.D{
+		{Key: "domainid", Value: domainID},
+		{Key: "workflowid", Value: workflowID},
+		{Key: "runid", Value: runID},
+	}
+}
+
+func toVisibilityEntry(domainID string, row *nosqlplugin.VisibilityRow, closed bool, ttlSeconds int64) (*cadence.VisibilityCollectionEntry, error) {
+	payload, err := toJSON(row)
+	if err != nil {
+		return nil, err
+	}
+	entry := &cadence.VisibilityCollectionEntry{
+		DomainID:     domainID,
+		WorkflowID:   row.WorkflowID,
+		RunID:        row.RunID,
+		WorkflowType: row.TypeName,
+		Closed:       closed,
+		StartTime:    row.StartTime.UnixNano(),
+		Visibility:   payload,
+		ExpireAt:     expireAt(time.Now(), ttlSeconds),
+	}
+	if closed {
+		entry.CloseTime = row.CloseTime.UnixNano()
+		if row.Status != nil {
+			entry.CloseStatus = int(*row.Status)
+		}
+	}
+	return entry, nil
+}
+
+func fromVisibilityEntry(entry *cadence.VisibilityCollectionEntry) (*nosqlplugin.VisibilityRow, error) {
+	row := &nosqlplugin.VisibilityRow{}
+	if err := fromJSON(entry.Visibility, "visibility", row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (db *mdb) upsertVisibility(ctx context.Context, entry *cadence.VisibilityCollectionEntry) error {
+	_, err := db.collection(cadence.VisibilityCollectionName).ReplaceOne(
+		ctx,
+		visibilityFilter(entry.DomainID, entry.WorkflowID, entry.RunID),
+		entry,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
 func (db *mdb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	entry, err := toVisibilityEntry(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.upsertVisibility(ctx, entry)
 }
 
+// UpdateVisibility overwrites the record as closed
 func (db *mdb) UpdateVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		// TODO implement it when where is a need
+		panic("not supported operation")
+	}
+
+	entry, err := toVisibilityEntry(row.DomainID, &row.VisibilityRow, true, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.upsertVisibility(ctx, entry)
 }
 
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := filter.ListRequest
+	closed, timeField, sort := true, "starttime", visibilityByStartSort
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		closed = false
+	default:
+		if filter.SortType == nosqlplugin.SortByClosedTime {
+			timeField, sort = "closetime", visibilityByCloseSort
+		}
+	}
+
+	query := bson.D{
+		{Key: "domainid", Value: request.DomainUUID},
+		{Key: "closed", Value: closed},
+		{Key: timeField, Value: between(request.EarliestTime.UnixNano(), request.LatestTime.UnixNano())},
+	}
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		query = append(query, bson.E{Key: "workflowtype", Value: filter.WorkflowType})
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		query = append(query, bson.E{Key: "workflowid", Value: filter.WorkflowID})
+	case nosqlplugin.ClosedByClosedStatus:
+		query = append(query, bson.E{Key: "closestatus", Value: int(filter.CloseStatus)})
+	}
+	query = append(query, notExpired(time.Now()))
+
+	docs, nextPageToken, err := db.findPage(ctx, cadence.VisibilityCollectionName, query, sort, request.NextPageToken, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := decodeAll[cadence.VisibilityCollectionEntry](docs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(entries)),
+		NextPageToken: nextPageToken,
+	}
+	for _, entry := range entries {
+		row, err := fromVisibilityEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
+// DeleteVisibility is a noop as the records expire by TTL, except for the admin deletion of a record
 func (db *mdb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	key := persistence.VisibilityAdminDeletionKey("visibilityAdminDelete")
+	if v := ctx.Value(key); v != nil && v.(bool) {
+		return db.deleteOne(ctx, cadence.VisibilityCollectionName, visibilityFilter(domainID, workflowID, runID))
+	}
+	return nil
 }
 
 func (db *mdb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	entry := &cadence.VisibilityCollectionEntry{}
+	err := db.findOne(ctx, cadence.VisibilityCollectionName, visibilityFilter(domainID, workflowID, runID), entry)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !entry.Closed {
+		return nil, nil
+	}
+	return fromVisibilityEntry(entry)
 }
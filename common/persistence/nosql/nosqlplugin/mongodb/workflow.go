@@ -18,19 +18,31 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
-
 package mongodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*mdb)(nil)
 
+var (
+	currentWorkflowSort = []sortField{{name: "domainid"}, {name: "workflowid"}}
+	executionSort       = []sortField{{name: "domainid"}, {name: "workflowid"}, {name: "runid"}}
+	taskIDSort          = []sortField{{name: "taskid"}}
+	timerTaskSort       = []sortField{{name: "visibilityts"}, {name: "taskid"}}
+)
+
 func (db *mdb) InsertWorkflowExecutionWithTasks(
 	ctx context.Context,
 	requests *nosqlplugin.WorkflowRequestsWriteRequest,
@@ -40,7 +52,29 @@ func (db *mdb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	timeStamp := execution.CurrentTimeStamp
+
+	tx := &workflowTransaction{}
+	if err := db.insertWorkflowRequests(tx, requests, timeStamp); err != nil {
+		return err
+	}
+	if err := db.createOrUpdateCurrentWorkflow(tx, shardID, execution.DomainID, execution.WorkflowID, currentWorkflowRequest, timeStamp); err != nil {
+		return err
+	}
+	if err := db.createWorkflowExecution(tx, shardID, execution); err != nil {
+		return err
+	}
+	db.insertActiveClusterSelectionPolicy(tx, activeClusterSelectionPolicyRow, timeStamp)
+	if err := db.createTasksByCategory(tx, shardID, tasksByCategory); err != nil {
+		return err
+	}
+
+	failure, err := db.executeWorkflowTransaction(ctx, tx, shardCondition)
+	if err != nil || failure == nil {
+		return err
+	}
+	return db.toWorkflowConditionFailure(ctx, failure, currentWorkflowRequest, shardCondition, execution.DomainID, execution.WorkflowID)
 }
 
 func (db *mdb) UpdateWorkflowExecutionWithTasks(
@@ -54,105 +88,307 @@ func (db *mdb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var timeStamp time.Time
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		timeStamp = mutatedExecution.CurrentTimeStamp
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		timeStamp = resetExecution.CurrentTimeStamp
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	tx := &workflowTransaction{}
+	if err := db.insertWorkflowRequests(tx, requests, timeStamp); err != nil {
+		return err
+	}
+	if err := db.createOrUpdateCurrentWorkflow(tx, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp); err != nil {
+		return err
+	}
+	if mutatedExecution != nil {
+		if err := db.updateWorkflowExecution(tx, shardID, mutatedExecution); err != nil {
+			return err
+		}
+	}
+	if insertedExecution != nil {
+		if err := db.createWorkflowExecution(tx, shardID, insertedExecution); err != nil {
+			return err
+		}
+		db.insertActiveClusterSelectionPolicy(tx, activeClusterSelectionPolicyRow, timeStamp)
+	}
+	if resetExecution != nil {
+		if err := db.resetWorkflowExecution(tx, shardID, resetExecution); err != nil {
+			return err
+		}
+	}
+	if err := db.createTasksByCategory(tx, shardID, tasksByCategory); err != nil {
+		return err
+	}
+
+	failure, err := db.executeWorkflowTransaction(ctx, tx, shardCondition)
+	if err != nil || failure == nil {
+		return err
+	}
+	return db.toWorkflowConditionFailure(ctx, failure, currentWorkflowRequest, shardCondition, domainID, workflowID)
 }
 
 func (db *mdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	var entry cadence.CurrentWorkflowCollectionEntry
+	if err := db.findOne(ctx, cadence.CurrentWorkflowCollectionName, currentWorkflowFilter(shardID, domainID, workflowID), &entry); err != nil {
+		return nil, err
+	}
+	return toCurrentWorkflowRow(&entry), nil
 }
 
 func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	var entry cadence.WorkflowExecutionCollectionEntry
+	if err := db.findOne(ctx, cadence.WorkflowExecutionCollectionName, executionFilter(shardID, domainID, workflowID, runID), &entry); err != nil {
+		return nil, err
+	}
+	return fromExecutionEntry(&entry)
 }
 
 func (db *mdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	// same as Cassandra, the document is left untouched if it belongs to another run
+	return db.deleteOne(ctx, cadence.CurrentWorkflowCollectionName, executionFilter(shardID, domainID, workflowID, currentRunIDCondition))
 }
 
 func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.WorkflowExecutionCollectionName, executionFilter(shardID, domainID, workflowID, runID))
 }
 
 func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.findPage(ctx, cadence.CurrentWorkflowCollectionName,
+		bson.D{{Key: "shardid", Value: shardID}}, currentWorkflowSort, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := decodeAll[cadence.CurrentWorkflowCollectionEntry](docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(entries))
+	for _, entry := range entries {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     entry.DomainID,
+			WorkflowID:   entry.WorkflowID,
+			RunID:        entry.RunID,
+			State:        entry.State,
+			CurrentRunID: entry.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.findPage(ctx, cadence.WorkflowExecutionCollectionName,
+		bson.D{{Key: "shardid", Value: shardID}}, executionSort, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := decodeAll[cadence.WorkflowExecutionCollectionEntry](docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(entries))
+	for _, entry := range entries {
+		info := &persistence.InternalWorkflowExecutionInfo{}
+		if err := fromJSON(entry.ExecutionInfo, "executioninfo", info); err != nil {
+			return nil, nil, err
+		}
+		var versionHistories *persistence.DataBlob
+		if err := fromJSON(entry.VersionHistories, "versionhistories", &versionHistories); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    info,
+			VersionHistories: versionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	count, err := db.collection(cadence.WorkflowExecutionCollectionName).CountDocuments(ctx, executionFilter(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 func (db *mdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryTransfer, ""),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}})
+	return db.selectHistoryTasks(ctx, filter, taskIDSort, pageToken, pageSize, func() interface{} { return &nosqlplugin.TransferTask{} })
 }
 
 func (db *mdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryTransfer, ""), bson.E{Key: "taskid", Value: taskID}))
 }
 
 func (db *mdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.deleteMany(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryTransfer, ""),
+			bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveBeginTaskID}, {Key: "$lt", Value: exclusiveEndTaskID}}}))
+	return err
 }
 
 func (db *mdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryTimer, ""),
+		bson.E{Key: "visibilityts", Value: bson.D{{Key: "$gte", Value: inclusiveMinTime.UnixNano()}, {Key: "$lt", Value: exclusiveMaxTime.UnixNano()}}})
+	return db.selectHistoryTasks(ctx, filter, timerTaskSort, pageToken, pageSize, func() interface{} { return &nosqlplugin.TimerTask{} })
 }
 
 func (db *mdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryTimer, ""),
+			bson.E{Key: "visibilityts", Value: visibilityTimestamp.UnixNano()},
+			bson.E{Key: "taskid", Value: taskID}))
 }
 
 func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	_, err := db.deleteMany(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryTimer, ""),
+			bson.E{Key: "visibilityts", Value: bson.D{{Key: "$gte", Value: inclusiveMinTime.UnixNano()}, {Key: "$lt", Value: exclusiveMaxTime.UnixNano()}}}))
+	return err
 }
 
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryReplication, ""),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}})
+	return db.selectHistoryTasks(ctx, filter, taskIDSort, pageToken, pageSize, func() interface{} { return &nosqlplugin.ReplicationTask{} })
 }
 
 func (db *mdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryReplication, ""), bson.E{Key: "taskid", Value: taskID}))
 }
 
 func (db *mdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if exclusiveEndTaskID == math.MinInt64 {
+		return nil
+	}
+	_, err := db.deleteMany(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryReplication, ""),
+			bson.E{Key: "taskid", Value: bson.D{{Key: "$lt", Value: exclusiveEndTaskID}}}))
+	return err
 }
 
 func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+	entries := make([]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		entry, err := toReplicationTaskEntry(condition.ShardID, cadence.HistoryTaskCategoryReplication, "", task)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+	tx := &workflowTransaction{}
+	db.insertHistoryTasks(tx, entries)
+
+	failure, err := db.executeWorkflowTransaction(ctx, tx, &condition)
+	if err != nil || failure == nil {
+		return err
+	}
+	return db.readConflictedShard(ctx, condition.ShardID)
 }
 
 func (db *mdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryCrossCluster, targetCluster), bson.E{Key: "taskid", Value: taskID}))
 }
 
 func (db *mdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	entry, err := toReplicationTaskEntry(shardID, cadence.HistoryTaskCategoryReplicationDLQ, sourceCluster, task)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.HistoryTaskCollectionName).InsertOne(ctx, entry)
+	return err
 }
 
 func (db *mdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryReplicationDLQ, sourceCluster),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}})
+	return db.selectHistoryTasks(ctx, filter, taskIDSort, pageToken, pageSize, func() interface{} { return &nosqlplugin.ReplicationTask{} })
 }
 
 func (db *mdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	count, err := db.collection(cadence.HistoryTaskCollectionName).CountDocuments(ctx,
+		historyTaskFilter(shardID, cadence.HistoryTaskCategoryReplicationDLQ, sourceCluster))
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
 }
 
 func (db *mdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryReplicationDLQ, sourceCluster), bson.E{Key: "taskid", Value: taskID}))
 }
 
 func (db *mdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.deleteMany(ctx, cadence.HistoryTaskCollectionName,
+		append(historyTaskFilter(shardID, cadence.HistoryTaskCategoryReplicationDLQ, sourceCluster),
+			bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveBeginTaskID}, {Key: "$lt", Value: exclusiveEndTaskID}}}))
+	return err
 }
 
 func (db *mdb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	var entry cadence.ActiveClusterSelectionPolicyCollectionEntry
+	err := db.findOne(ctx, cadence.ActiveClusterSelectionPolicyCollectionName, executionFilter(shardID, domainID, wfID, rID), &entry)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     persistence.NewDataBlob(entry.Data, constants.EncodingType(entry.DataEncoding)),
+	}, nil
 }
 
 func (db *mdb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	return db.deleteOne(ctx, cadence.ActiveClusterSelectionPolicyCollectionName, executionFilter(shardID, domainID, wfID, rID))
+}
+
+// selectHistoryTasks reads a page of history tasks, newTask returns the typed task of the category to decode into
+func (db *mdb) selectHistoryTasks(
+	ctx context.Context,
+	filter bson.D,
+	sort []sortField,
+	pageToken []byte,
+	pageSize int,
+	newTask func() interface{},
+) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	docs, nextPageToken, err := db.findPage(ctx, cadence.HistoryTaskCollectionName, filter, sort, pageToken, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := decodeAll[cadence.HistoryTaskCollectionEntry](docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(entries))
+	for _, entry := range entries {
+		task, err := fromHistoryTaskEntry(entry, newTask())
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
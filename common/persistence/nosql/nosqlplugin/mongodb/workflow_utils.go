@@ -0,0 +1,842 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	// workflowRequestTTLInSeconds is how long the request documents are kept for deduplication, same as Cassandra
+	workflowRequestTTLInSeconds = 10800
+)
+
+type (
+	// txItemKind tells which document a write of a transaction is conditioned on,
+	// so that a failed condition can be converted into the right condition failure
+	txItemKind int
+
+	// txConditionFailure aborts a transaction when the condition of one of its writes doesn't meet
+	txConditionFailure struct {
+		kind txItemKind
+		// for txItemKindExecution only
+		runID                        string
+		previousNextEventIDCondition *int64
+		// for txItemKindWorkflowRequest only
+		request *nosqlplugin.WorkflowRequestRow
+	}
+
+	// workflowTransaction is a list of writes that are executed in order in a single transaction.
+	// The shard is always fenced first, and the writes are added in the same precedence as the
+	// condition failures of Cassandra, so that the first failed condition is the one to report.
+	workflowTransaction struct {
+		ops []func(sc mongo.SessionContext) error
+	}
+)
+
+const (
+	txItemKindShard txItemKind = iota
+	txItemKindWorkflowRequest
+	txItemKindCurrentWorkflow
+	txItemKindExecution
+)
+
+var _ error = (*txConditionFailure)(nil)
+
+func (f *txConditionFailure) Error() string {
+	return fmt.Sprintf("condition failure of transaction item kind %v", f.kind)
+}
+
+func (t *workflowTransaction) add(op func(sc mongo.SessionContext) error) {
+	t.ops = append(t.ops, op)
+}
+
+func currentWorkflowFilter(shardID int, domainID, workflowID string) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "domainid", Value: domainID},
+		{Key: "workflowid", Value: workflowID},
+	}
+}
+
+func executionFilter(shardID int, domainID, workflowID, runID string) bson.D {
+	return append(currentWorkflowFilter(shardID, domainID, workflowID), bson.E{Key: "runid", Value: runID})
+}
+
+func workflowRequestFilter(row *nosqlplugin.WorkflowRequestRow) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: row.ShardID},
+		{Key: "requesttype", Value: int(row.RequestType)},
+		{Key: "domainid", Value: row.DomainID},
+		{Key: "workflowid", Value: row.WorkflowID},
+		{Key: "requestid", Value: row.RequestID},
+	}
+}
+
+func historyTaskFilter(shardID int, category, cluster string) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "category", Value: category},
+		{Key: "cluster", Value: cluster},
+	}
+}
+
+func (db *mdb) insertWorkflowRequests(tx *workflowTransaction, requests *nosqlplugin.WorkflowRequestsWriteRequest, timeStamp time.Time) error {
+	if requests == nil {
+		return nil
+	}
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert, nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+	for _, row := range requests.Rows {
+		switch row.RequestType {
+		case persistence.WorkflowRequestTypeStart, persistence.WorkflowRequestTypeSignal,
+			persistence.WorkflowRequestTypeCancel, persistence.WorkflowRequestTypeReset:
+		default:
+			return fmt.Errorf("unknown workflow request type %v", row.RequestType)
+		}
+		row := row
+		entry := &cadence.WorkflowRequestCollectionEntry{
+			ShardID:     row.ShardID,
+			RequestType: int(row.RequestType),
+			DomainID:    row.DomainID,
+			WorkflowID:  row.WorkflowID,
+			RequestID:   row.RequestID,
+			RunID:       row.RunID,
+			Version:     row.Version,
+			CreatedTime: timeStamp.UnixNano(),
+			ExpireAt:    *expireAt(timeStamp, workflowRequestTTLInSeconds),
+		}
+		filter := workflowRequestFilter(row)
+		if requests.WriteMode == nosqlplugin.WorkflowRequestWriteModeInsert {
+			// an expired request which is not removed by the TTL monitor yet is overwritten,
+			// otherwise the upsert fails with a duplicate key error
+			filter = append(filter, bson.E{Key: "expireat", Value: bson.D{{Key: "$lte", Value: timeStamp}}})
+		}
+		tx.add(func(sc mongo.SessionContext) error {
+			_, err := db.collection(cadence.WorkflowRequestCollectionName).UpdateOne(sc,
+				filter,
+				bson.D{{Key: "$set", Value: entry}},
+				options.Update().SetUpsert(true),
+			)
+			if mongo.IsDuplicateKeyError(err) {
+				return &txConditionFailure{kind: txItemKindWorkflowRequest, request: row}
+			}
+			return err
+		})
+	}
+	return nil
+}
+
+func (db *mdb) insertActiveClusterSelectionPolicy(tx *workflowTransaction, row *nosqlplugin.ActiveClusterSelectionPolicyRow, timeStamp time.Time) {
+	if row == nil || row.Policy == nil {
+		return
+	}
+	entry := &cadence.ActiveClusterSelectionPolicyCollectionEntry{
+		ShardID:      row.ShardID,
+		DomainID:     row.DomainID,
+		WorkflowID:   row.WorkflowID,
+		RunID:        row.RunID,
+		Data:         row.Policy.Data,
+		DataEncoding: row.Policy.GetEncodingString(),
+		CreatedTime:  timeStamp.UnixNano(),
+	}
+	tx.add(func(sc mongo.SessionContext) error {
+		_, err := db.collection(cadence.ActiveClusterSelectionPolicyCollectionName).ReplaceOne(sc,
+			executionFilter(row.ShardID, row.DomainID, row.WorkflowID, row.RunID),
+			entry,
+			options.Replace().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (db *mdb) createOrUpdateCurrentWorkflow(
+	tx *workflowTransaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+	timeStamp time.Time,
+) error {
+	collection := db.collection(cadence.CurrentWorkflowCollectionName)
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		entry := &cadence.CurrentWorkflowCollectionEntry{
+			ShardID:          shardID,
+			DomainID:         domainID,
+			WorkflowID:       workflowID,
+			RunID:            request.Row.RunID,
+			CreateRequestID:  request.Row.CreateRequestID,
+			State:            request.Row.State,
+			CloseStatus:      request.Row.CloseStatus,
+			LastWriteVersion: request.Row.LastWriteVersion,
+			CreatedTime:      timeStamp.UnixNano(),
+		}
+		tx.add(func(sc mongo.SessionContext) error {
+			_, err := collection.InsertOne(sc, entry)
+			if mongo.IsDuplicateKeyError(err) {
+				return &txConditionFailure{kind: txItemKindCurrentWorkflow}
+			}
+			return err
+		})
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		filter := append(currentWorkflowFilter(shardID, domainID, workflowID), bson.E{Key: "runid", Value: *request.Condition.CurrentRunID})
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			filter = append(filter,
+				bson.E{Key: "lastwriteversion", Value: *request.Condition.LastWriteVersion},
+				bson.E{Key: "state", Value: *request.Condition.State},
+			)
+		}
+		update := bson.D{{Key: "$set", Value: bson.D{
+			{Key: "runid", Value: request.Row.RunID},
+			{Key: "createrequestid", Value: request.Row.CreateRequestID},
+			{Key: "state", Value: request.Row.State},
+			{Key: "closestatus", Value: request.Row.CloseStatus},
+			{Key: "lastwriteversion", Value: request.Row.LastWriteVersion},
+		}}}
+		tx.add(func(sc mongo.SessionContext) error {
+			result, err := collection.UpdateOne(sc, filter, update)
+			if err != nil {
+				return err
+			}
+			if result.MatchedCount == 0 {
+				return &txConditionFailure{kind: txItemKindCurrentWorkflow}
+			}
+			return nil
+		})
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+	return nil
+}
+
+// toExecutionEntry converts the whole execution with all the maps into a document, for create and reset
+func toExecutionEntry(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) (*cadence.WorkflowExecutionCollectionEntry, error) {
+	info, err := toJSON(&execution.InternalWorkflowExecutionInfo)
+	if err != nil {
+		return nil, err
+	}
+	versionHistories, err := toJSON(execution.VersionHistories)
+	if err != nil {
+		return nil, err
+	}
+	checksums, err := toJSON(execution.Checksums)
+	if err != nil {
+		return nil, err
+	}
+	entry := &cadence.WorkflowExecutionCollectionEntry{
+		ShardID:            shardID,
+		DomainID:           execution.DomainID,
+		WorkflowID:         execution.WorkflowID,
+		RunID:              execution.RunID,
+		NextEventID:        execution.NextEventID,
+		State:              execution.State,
+		CloseStatus:        execution.CloseStatus,
+		LastWriteVersion:   execution.LastWriteVersion,
+		CreateRequestID:    execution.CreateRequestID,
+		ExecutionInfo:      info,
+		VersionHistories:   versionHistories,
+		Checksum:           checksums,
+		SignalRequestedIDs: make(map[string]bool, len(execution.SignalRequestedIDs)),
+		BufferedEvents:     [][]byte{},
+	}
+	if entry.ActivityInfos, err = toJSONMap(execution.ActivityInfos); err != nil {
+		return nil, err
+	}
+	if entry.TimerInfos, err = toJSONMap(execution.TimerInfos); err != nil {
+		return nil, err
+	}
+	if entry.ChildExecutionInfos, err = toJSONMap(execution.ChildWorkflowInfos); err != nil {
+		return nil, err
+	}
+	if entry.RequestCancelInfos, err = toJSONMap(execution.RequestCancelInfos); err != nil {
+		return nil, err
+	}
+	if entry.SignalInfos, err = toJSONMap(execution.SignalInfos); err != nil {
+		return nil, err
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		entry.SignalRequestedIDs[escapeKey(id)] = true
+	}
+	return entry, nil
+}
+
+func toJSONMap[K comparable, V any](m map[K]V) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(m))
+	for k, v := range m {
+		payload, err := toJSON(v)
+		if err != nil {
+			return nil, err
+		}
+		result[escapeKey(fmt.Sprint(k))] = payload
+	}
+	return result, nil
+}
+
+func fromJSONMap[K comparable, V any](m map[string][]byte, name string, parseKey func(string) (K, error)) (map[K]*V, error) {
+	result := make(map[K]*V, len(m))
+	for k, payload := range m {
+		unescaped, err := unescapeKey(k)
+		if err != nil {
+			return nil, err
+		}
+		key, err := parseKey(unescaped)
+		if err != nil {
+			return nil, err
+		}
+		value := new(V)
+		if err := fromJSON(payload, name, value); err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+func parseInt64Key(k string) (int64, error) {
+	return strconv.ParseInt(k, 10, 64)
+}
+
+func parseStringKey(k string) (string, error) {
+	return k, nil
+}
+
+func (db *mdb) createWorkflowExecution(tx *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	entry, err := toExecutionEntry(shardID, execution)
+	if err != nil {
+		return err
+	}
+	tx.add(func(sc mongo.SessionContext) error {
+		_, err := db.collection(cadence.WorkflowExecutionCollectionName).InsertOne(sc, entry)
+		if mongo.IsDuplicateKeyError(err) {
+			return &txConditionFailure{kind: txItemKindExecution, runID: execution.RunID}
+		}
+		return err
+	})
+	return nil
+}
+
+func (db *mdb) resetWorkflowExecution(tx *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+	if execution.PreviousNextEventIDCondition == nil {
+		return fmt.Errorf("PreviousNextEventIDCondition is required for reset")
+	}
+	// reset replaces the whole document, including the maps and the event buffer
+	entry, err := toExecutionEntry(shardID, execution)
+	if err != nil {
+		return err
+	}
+	filter := append(
+		executionFilter(shardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+		bson.E{Key: "nexteventid", Value: *execution.PreviousNextEventIDCondition},
+	)
+	tx.add(func(sc mongo.SessionContext) error {
+		result, err := db.collection(cadence.WorkflowExecutionCollectionName).ReplaceOne(sc, filter, entry)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return &txConditionFailure{
+				kind:                         txItemKindExecution,
+				runID:                        execution.RunID,
+				previousNextEventIDCondition: execution.PreviousNextEventIDCondition,
+			}
+		}
+		return nil
+	})
+	return nil
+}
+
+func (db *mdb) updateWorkflowExecution(tx *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+	if execution.PreviousNextEventIDCondition == nil {
+		return fmt.Errorf("PreviousNextEventIDCondition is required for update")
+	}
+
+	entry, err := toExecutionEntry(shardID, &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: execution.InternalWorkflowExecutionInfo,
+		VersionHistories:              execution.VersionHistories,
+		Checksums:                     execution.Checksums,
+	})
+	if err != nil {
+		return err
+	}
+	set := bson.D{
+		{Key: "nexteventid", Value: execution.NextEventID},
+		{Key: "state", Value: execution.State},
+		{Key: "closestatus", Value: execution.CloseStatus},
+		{Key: "lastwriteversion", Value: execution.LastWriteVersion},
+		{Key: "createrequestid", Value: execution.CreateRequestID},
+		{Key: "executioninfo", Value: entry.ExecutionInfo},
+		{Key: "versionhistories", Value: entry.VersionHistories},
+		{Key: "checksum", Value: entry.Checksum},
+	}
+	var unset bson.D
+	if err := updateJSONMap(&set, &unset, "activityinfos", execution.ActivityInfos, execution.ActivityInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updateJSONMap(&set, &unset, "timerinfos", execution.TimerInfos, execution.TimerInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updateJSONMap(&set, &unset, "childexecutioninfos", execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updateJSONMap(&set, &unset, "requestcancelinfos", execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete); err != nil {
+		return err
+	}
+	if err := updateJSONMap(&set, &unset, "signalinfos", execution.SignalInfos, execution.SignalInfoKeysToDelete); err != nil {
+		return err
+	}
+	signalsRequested := make(map[string]bool, len(execution.SignalRequestedIDs))
+	for _, id := range execution.SignalRequestedIDs {
+		signalsRequested[id] = true
+	}
+	if err := updateJSONMap(&set, &unset, "signalrequestedids", signalsRequested, execution.SignalRequestedIDsKeysToDelete); err != nil {
+		return err
+	}
+
+	update := bson.D{}
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeNone:
+	case nosqlplugin.EventBufferWriteModeAppend:
+		blob, err := toJSON(execution.NewBufferedEventBatch)
+		if err != nil {
+			return err
+		}
+		update = append(update, bson.E{Key: "$push", Value: bson.D{{Key: "bufferedevents", Value: blob}}})
+	case nosqlplugin.EventBufferWriteModeClear:
+		set = append(set, bson.E{Key: "bufferedevents", Value: bson.A{}})
+	default:
+		return fmt.Errorf("unknown event buffer write mode %v", execution.EventBufferWriteMode)
+	}
+	update = append(update, bson.E{Key: "$set", Value: set})
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+
+	filter := append(
+		executionFilter(shardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+		bson.E{Key: "nexteventid", Value: *execution.PreviousNextEventIDCondition},
+	)
+	tx.add(func(sc mongo.SessionContext) error {
+		result, err := db.collection(cadence.WorkflowExecutionCollectionName).UpdateOne(sc, filter, update)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return &txConditionFailure{
+				kind:                         txItemKindExecution,
+				runID:                        execution.RunID,
+				previousNextEventIDCondition: execution.PreviousNextEventIDCondition,
+			}
+		}
+		return nil
+	})
+	return nil
+}
+
+// updateJSONMap upserts and deletes the entries of a map field,
+// an entry that is both upserted and deleted is deleted because the two paths cannot conflict in one update
+func updateJSONMap[K comparable, V any](set, unset *bson.D, field string, upserts map[K]V, deletes []K) error {
+	deleted := make(map[string]struct{}, len(deletes))
+	for _, k := range deletes {
+		key := escapeKey(fmt.Sprint(k))
+		if _, ok := deleted[key]; ok {
+			continue
+		}
+		deleted[key] = struct{}{}
+		*unset = append(*unset, bson.E{Key: field + "." + key, Value: ""})
+	}
+	keys := make([]string, 0, len(upserts))
+	values := make(map[string]V, len(upserts))
+	for k, v := range upserts {
+		key := escapeKey(fmt.Sprint(k))
+		if _, ok := deleted[key]; ok {
+			continue
+		}
+		keys = append(keys, key)
+		values[key] = v
+	}
+	// sorted so that the update is deterministic
+	sort.Strings(keys)
+	for _, key := range keys {
+		var value interface{} = values[key]
+		if _, ok := value.(bool); !ok {
+			payload, err := toJSON(value)
+			if err != nil {
+				return err
+			}
+			value = payload
+		}
+		*set = append(*set, bson.E{Key: field + "." + key, Value: value})
+	}
+	return nil
+}
+
+func (db *mdb) createTasksByCategory(
+	tx *workflowTransaction,
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	var entries []interface{}
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			var entry *cadence.HistoryTaskCollectionEntry
+			var err error
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				entry, err = toTransferTaskEntry(shardID, task)
+			case persistence.HistoryTaskCategoryIDTimer:
+				entry, err = toTimerTaskEntry(shardID, task)
+			case persistence.HistoryTaskCategoryIDReplication:
+				entry, err = toReplicationTaskEntry(shardID, cadence.HistoryTaskCategoryReplication, "", task)
+			default:
+				// TODO: implementing writing tasks for other categories
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+	}
+	db.insertHistoryTasks(tx, entries)
+	return nil
+}
+
+func (db *mdb) insertHistoryTasks(tx *workflowTransaction, entries []interface{}) {
+	if len(entries) == 0 {
+		return
+	}
+	tx.add(func(sc mongo.SessionContext) error {
+		_, err := db.collection(cadence.HistoryTaskCollectionName).InsertMany(sc, entries)
+		return err
+	})
+}
+
+func toHistoryTaskEntry(
+	shardID int,
+	category string,
+	cluster string,
+	typedTask interface{},
+	task *nosqlplugin.HistoryMigrationTask,
+	taskID int64,
+	visibilityTimestamp time.Time,
+) (*cadence.HistoryTaskCollectionEntry, error) {
+	payload, err := toJSON(typedTask)
+	if err != nil {
+		return nil, err
+	}
+	data, encoding := persistence.FromDataBlob(task.Task)
+	var visibilityTS int64
+	if !visibilityTimestamp.IsZero() {
+		visibilityTS = visibilityTimestamp.UnixNano()
+	}
+	return &cadence.HistoryTaskCollectionEntry{
+		ShardID:             shardID,
+		Category:            category,
+		Cluster:             cluster,
+		VisibilityTimestamp: visibilityTS,
+		TaskID:              taskID,
+		Task:                payload,
+		Data:                data,
+		DataEncoding:        encoding,
+	}, nil
+}
+
+func toTransferTaskEntry(shardID int, task *nosqlplugin.HistoryMigrationTask) (*cadence.HistoryTaskCollectionEntry, error) {
+	return toHistoryTaskEntry(shardID, cadence.HistoryTaskCategoryTransfer, "", task.Transfer, task, task.Transfer.TaskID, time.Time{})
+}
+
+func toTimerTaskEntry(shardID int, task *nosqlplugin.HistoryMigrationTask) (*cadence.HistoryTaskCollectionEntry, error) {
+	return toHistoryTaskEntry(shardID, cadence.HistoryTaskCategoryTimer, "", task.Timer, task, task.Timer.TaskID, task.Timer.VisibilityTimestamp)
+}
+
+func toReplicationTaskEntry(shardID int, category, cluster string, task *nosqlplugin.HistoryMigrationTask) (*cadence.HistoryTaskCollectionEntry, error) {
+	return toHistoryTaskEntry(shardID, category, cluster, task.Replication, task, task.Replication.TaskID, time.Time{})
+}
+
+func fromHistoryTaskEntry(entry *cadence.HistoryTaskCollectionEntry, typedTask interface{}) (*nosqlplugin.HistoryMigrationTask, error) {
+	if err := fromJSON(entry.Task, "task", typedTask); err != nil {
+		return nil, err
+	}
+	task := &nosqlplugin.HistoryMigrationTask{
+		TaskID: entry.TaskID,
+		Task:   persistence.NewDataBlob(entry.Data, constants.EncodingType(entry.DataEncoding)),
+	}
+	switch t := typedTask.(type) {
+	case *nosqlplugin.TransferTask:
+		task.Transfer = t
+	case *nosqlplugin.TimerTask:
+		task.Timer = t
+		task.ScheduledTime = time.Unix(0, entry.VisibilityTimestamp).UTC()
+	case *nosqlplugin.ReplicationTask:
+		task.Replication = t
+	}
+	return task, nil
+}
+
+func toCurrentWorkflowRow(entry *cadence.CurrentWorkflowCollectionEntry) *nosqlplugin.CurrentWorkflowRow {
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          entry.ShardID,
+		DomainID:         entry.DomainID,
+		WorkflowID:       entry.WorkflowID,
+		RunID:            entry.RunID,
+		CreateRequestID:  entry.CreateRequestID,
+		State:            entry.State,
+		CloseStatus:      entry.CloseStatus,
+		LastWriteVersion: entry.LastWriteVersion,
+	}
+}
+
+// executeWorkflowTransaction runs the writes in a transaction fenced by the shard.
+// If a condition doesn't meet, the transaction is aborted and the failure is returned with a nil error.
+func (db *mdb) executeWorkflowTransaction(ctx context.Context, tx *workflowTransaction, shardCondition *nosqlplugin.ShardCondition) (*txConditionFailure, error) {
+	err := db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		if err := db.fenceShard(sc, shardCondition.ShardID, shardCondition.RangeID); err != nil {
+			return err
+		}
+		for _, op := range tx.ops {
+			if err := op(sc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if failure, ok := err.(*txConditionFailure); ok {
+		return failure, nil
+	}
+	return nil, err
+}
+
+// toWorkflowConditionFailure converts the failed condition of a workflow transaction into a condition failure,
+// the documents are read again since a transaction doesn't return the documents that fail the conditions
+func (db *mdb) toWorkflowConditionFailure(
+	ctx context.Context,
+	failure *txConditionFailure,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+	domainID string,
+	workflowID string,
+) error {
+	switch failure.kind {
+	case txItemKindShard:
+		var shard cadence.ShardCollectionEntry
+		if err := db.findOne(ctx, cadence.ShardCollectionName, shardFilter(shardCondition.ShardID), &shard); err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(shard.RangeID),
+		}
+
+	case txItemKindWorkflowRequest:
+		var request cadence.WorkflowRequestCollectionEntry
+		if err := db.findOne(ctx, cadence.WorkflowRequestCollectionName, workflowRequestFilter(failure.request), &request); err != nil {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: &nosqlplugin.DuplicateRequest{
+				RequestType: failure.request.RequestType,
+				RunID:       request.RunID,
+			},
+		}
+
+	case txItemKindCurrentWorkflow:
+		var current cadence.CurrentWorkflowCollectionEntry
+		err := db.findOne(ctx, cadence.CurrentWorkflowCollectionName, currentWorkflowFilter(shardCondition.ShardID, domainID, workflowID), &current)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		exists := err == nil
+		switch currentWorkflowRequest.WriteMode {
+		case nosqlplugin.CurrentWorkflowWriteModeInsert:
+			if exists {
+				msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, current.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+						OtherInfo:        msg,
+						CreateRequestID:  current.CreateRequestID,
+						RunID:            current.RunID,
+						State:            current.State,
+						CloseStatus:      current.CloseStatus,
+						LastWriteVersion: current.LastWriteVersion,
+					},
+				}
+			}
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v", currentWorkflowRequest.Row.WorkflowID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+			condition := currentWorkflowRequest.Condition
+			if current.RunID != condition.GetCurrentRunID() {
+				msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+					currentWorkflowRequest.Row.WorkflowID, condition.GetCurrentRunID(), current.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if condition.LastWriteVersion != nil && *condition.LastWriteVersion != current.LastWriteVersion {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.LastWriteVersion, current.LastWriteVersion)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if condition.State != nil && *condition.State != current.State {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.State, current.State)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+		}
+
+	case txItemKindExecution:
+		var execution cadence.WorkflowExecutionCollectionEntry
+		err := db.findOne(ctx, cadence.WorkflowExecutionCollectionName, executionFilter(shardCondition.ShardID, domainID, workflowID, failure.runID), &execution)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		if failure.previousNextEventIDCondition == nil {
+			// creating a concrete execution which already exists
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", workflowID, failure.runID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  execution.CreateRequestID,
+					RunID:            failure.runID,
+					State:            execution.State,
+					CloseStatus:      execution.CloseStatus,
+					LastWriteVersion: execution.LastWriteVersion,
+				},
+			}
+		}
+		msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+			*failure.previousNextEventIDCondition, execution.NextEventID, failure.runID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, failed condition: %v",
+		shardCondition.RangeID, failure.kind)
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func fromExecutionEntry(entry *cadence.WorkflowExecutionCollectionEntry) (*nosqlplugin.WorkflowExecution, error) {
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo: &persistence.InternalWorkflowExecutionInfo{},
+	}
+	if err := fromJSON(entry.ExecutionInfo, "executioninfo", state.ExecutionInfo); err != nil {
+		return nil, err
+	}
+	state.ExecutionInfo.NextEventID = entry.NextEventID
+	if err := fromJSON(entry.VersionHistories, "versionhistories", &state.VersionHistories); err != nil {
+		return nil, err
+	}
+	var checksums *checksum.Checksum
+	if err := fromJSON(entry.Checksum, "checksum", &checksums); err != nil {
+		return nil, err
+	}
+	if checksums != nil {
+		state.Checksum = *checksums
+	}
+
+	var err error
+	if state.ActivityInfos, err = fromJSONMap[int64, persistence.InternalActivityInfo](entry.ActivityInfos, "activityinfos", parseInt64Key); err != nil {
+		return nil, err
+	}
+	for _, info := range state.ActivityInfos {
+		if info.DomainID == "" {
+			info.DomainID = entry.DomainID
+		}
+	}
+	if state.TimerInfos, err = fromJSONMap[string, persistence.TimerInfo](entry.TimerInfos, "timerinfos", parseStringKey); err != nil {
+		return nil, err
+	}
+	if state.ChildExecutionInfos, err = fromJSONMap[int64, persistence.InternalChildExecutionInfo](entry.ChildExecutionInfos, "childexecutioninfos", parseInt64Key); err != nil {
+		return nil, err
+	}
+	if state.RequestCancelInfos, err = fromJSONMap[int64, persistence.RequestCancelInfo](entry.RequestCancelInfos, "requestcancelinfos", parseInt64Key); err != nil {
+		return nil, err
+	}
+	if state.SignalInfos, err = fromJSONMap[int64, persistence.SignalInfo](entry.SignalInfos, "signalinfos", parseInt64Key); err != nil {
+		return nil, err
+	}
+
+	state.SignalRequestedIDs = make(map[string]struct{}, len(entry.SignalRequestedIDs))
+	for k := range entry.SignalRequestedIDs {
+		id, err := unescapeKey(k)
+		if err != nil {
+			return nil, err
+		}
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+
+	state.BufferedEvents = make([]*persistence.DataBlob, 0, len(entry.BufferedEvents))
+	for _, e := range entry.BufferedEvents {
+		blob := &persistence.DataBlob{}
+		if err := fromJSON(e, "bufferedevents", blob); err != nil {
+			return nil, err
+		}
+		state.BufferedEvents = append(state.BufferedEvents, blob)
+	}
+	return state, nil
+}
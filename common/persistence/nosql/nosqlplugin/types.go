@@ -189,6 +189,9 @@ type (
 		Expiry          time.Time
 		CreatedTime     time.Time
 		PartitionConfig map[string]string
+		Priority        int32
+		WorkerBuildID   string
+		FairnessKey     string
 	}
 
 	// TaskListFilter is for filtering tasklist
@@ -210,6 +213,7 @@ type (
 		CurrentTimeStamp        time.Time
 		LastUpdatedTime         time.Time
 		AdaptivePartitionConfig *persistence.TaskListPartitionConfig
+		CompatibleBuildIDSets   [][]string
 	}
 
 	// ListTaskListResult is the result of list tasklists
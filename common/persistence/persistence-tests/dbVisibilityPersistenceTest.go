@@ -844,6 +844,14 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
 	defer cancel()
 
+	var expectedUpsertErr error = &types.InternalServiceError{
+		Message: "Error writing to visibility: Operation is not supported",
+	}
+	if s.isSQLVisibility() {
+		// SQL visibility stores search attributes in executions_visibility_search_attributes
+		expectedUpsertErr = nil
+	}
+
 	tests := []struct {
 		request  *p.UpsertWorkflowExecutionRequest
 		expected error
@@ -880,9 +888,7 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 				SearchAttributes:   nil,
 				ShardID:            1234,
 			},
-			expected: &types.InternalServiceError{
-				Message: "Error writing to visibility: Operation is not supported",
-			},
+			expected: expectedUpsertErr,
 		},
 	}
 
@@ -896,6 +902,158 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 }
 
+// TestListWorkflowExecutionsByQuery test
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	if !s.isSQLVisibility() {
+		s.T().Skip("query based listing is only supported by SQL visibility")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(-time.Minute).UnixNano()
+	records := []struct {
+		workflowType     string
+		searchAttributes map[string][]byte
+	}{
+		{
+			workflowType: "visibility-query-workflow-a",
+			searchAttributes: map[string][]byte{
+				definition.CustomKeywordField: []byte(`"foo"`),
+				definition.CustomIntField:     []byte(`5`),
+			},
+		},
+		{
+			workflowType: "visibility-query-workflow-a",
+			searchAttributes: map[string][]byte{
+				definition.CustomKeywordField: []byte(`["foo","bar"]`),
+				definition.CustomIntField:     []byte(`10`),
+				definition.CustomBoolField:    []byte(`true`),
+			},
+		},
+		{
+			workflowType: "visibility-query-workflow-b",
+		},
+	}
+	executions := make([]types.WorkflowExecution, len(records))
+	for i, record := range records {
+		executions[i] = types.WorkflowExecution{WorkflowID: uuid.New(), RunID: uuid.New()}
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        executions[i],
+			WorkflowTypeName: record.workflowType,
+			StartTimestamp:   startTime + int64(i)*int64(time.Second),
+			SearchAttributes: record.searchAttributes,
+		})
+		s.NoError(err)
+	}
+	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        executions[2],
+		WorkflowTypeName: records[2].workflowType,
+		StartTimestamp:   startTime + 2*int64(time.Second),
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           types.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:    3,
+	})
+	s.NoError(err)
+
+	queries := map[string][]types.WorkflowExecution{
+		"": {executions[2], executions[1], executions[0]},
+		"WorkflowType = 'visibility-query-workflow-a'":     {executions[1], executions[0]},
+		"CustomIntField > 6":                               {executions[1]},
+		"CustomKeywordField = 'bar'":                       {executions[1]},
+		"CustomKeywordField != 'bar'":                      {executions[2], executions[0]},
+		"CustomBoolField = true":                           {executions[1]},
+		"CustomIntField = missing":                         {executions[2]},
+		"CloseTime = missing":                              {executions[1], executions[0]},
+		"CloseStatus = 'FAILED'":                           {executions[2]},
+		"CustomIntField in (5, 10) ORDER BY StartTime ASC": {executions[0], executions[1]},
+	}
+	for query, expected := range queries {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID: testDomainUUID,
+			PageSize:   10,
+			Query:      query,
+		})
+		s.NoError(err, query)
+		s.Len(resp.Executions, len(expected), query)
+		for i, execution := range expected {
+			s.Equal(execution.RunID, resp.Executions[i].Execution.RunID, query)
+		}
+
+		countResp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+			DomainUUID: testDomainUUID,
+			Query:      query,
+		})
+		s.NoError(err, query)
+		s.Equal(int64(len(expected)), countResp.Count, query)
+	}
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   2,
+		Query:      "CustomKeywordField = 'foo'",
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 2)
+	s.NotNil(resp.NextPageToken)
+	s.Equal([]byte(`["foo","bar"]`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomKeywordField])
+	s.Equal([]byte(`10`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomIntField])
+	s.Equal([]byte(`true`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomBoolField])
+	s.Equal([]byte(`"foo"`), resp.Executions[1].SearchAttributes.IndexedFields[definition.CustomKeywordField])
+
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    testDomainUUID,
+		PageSize:      2,
+		Query:         "CustomKeywordField = 'foo'",
+		NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Empty(resp.Executions)
+	s.Nil(resp.NextPageToken)
+
+	err = s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        executions[0],
+		WorkflowTypeName: records[0].workflowType,
+		StartTimestamp:   startTime,
+		Memo:             &types.Memo{Fields: map[string][]byte{"memoKey": []byte(`"memoValue"`)}},
+		UpdateTimestamp:  startTime + int64(time.Second),
+		SearchAttributes: map[string][]byte{
+			definition.CustomIntField: []byte(`20`),
+		},
+	})
+	s.NoError(err)
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "CustomIntField = 20 AND CustomKeywordField = missing",
+	})
+	s.NoError(err)
+	s.Equal(int64(1), countResp.Count)
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "CustomIntField = 20",
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.Equal([]byte(`"memoValue"`), resp.Executions[0].Memo.GetFields()["memoKey"])
+
+	_, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "UnknownField = 'foo'",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *DBVisibilityPersistenceSuite) isSQLVisibility() bool {
+	cfg := s.VisibilityTestCluster.Config()
+	store, ok := cfg.DataStores[cfg.VisibilityStore]
+	return ok && store.SQL != nil
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
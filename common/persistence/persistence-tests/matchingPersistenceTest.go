@@ -397,6 +397,7 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 			ReadPartitions:  readPartitions,
 			WritePartitions: writePartitions,
 		},
+		CompatibleBuildIDSets: [][]string{{"build-1", "build-2"}, {"build-3"}},
 	}
 	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
 		TaskListInfo: taskListInfo,
@@ -419,6 +420,7 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.EqualValues(1, tli.AdaptivePartitionConfig.Version)
 	s.Equal(readPartitions, tli.AdaptivePartitionConfig.ReadPartitions)
 	s.EqualValues(writePartitions, tli.AdaptivePartitionConfig.WritePartitions)
+	s.Equal([][]string{{"build-1", "build-2"}, {"build-3"}}, tli.CompatibleBuildIDSets)
 
 	taskListInfo.RangeID = 3
 	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
@@ -69,12 +69,15 @@ type (
 		DBPassword      string
 		DBHost          string
 		DBPort          int              `yaml:"-"`
+		DBRegion        string           `yaml:"-"`
 		StoreType       string           `yaml:"-"`
 		SchemaDir       string           `yaml:"-"`
 		ClusterMetadata cluster.Metadata `yaml:"-"`
 		ProtoVersion    int              `yaml:"-"`
 		Replicas        int              `yaml:"-"`
 		MaxConns        int              `yaml:"-"`
+		// DBConnectAttributes are the database specific attributes of the NoSQL config
+		DBConnectAttributes map[string]string `yaml:"-"`
 	}
 
 	// TestBase wraps the base setup needed to create workflows over persistence layer.
@@ -137,15 +140,17 @@ func NewTestBaseWithNoSQL(t *testing.T, options *TestBaseOptions) *TestBase {
 		options.DBName = "test_" + GenerateRandomDBName(10)
 	}
 	testCluster := nosql.NewTestCluster(t, nosql.TestClusterParams{
-		PluginName:   options.DBPluginName,
-		KeySpace:     options.DBName,
-		Username:     options.DBUsername,
-		Password:     options.DBPassword,
-		Host:         options.DBHost,
-		Port:         options.DBPort,
-		ProtoVersion: options.ProtoVersion,
-		Replicas:     options.Replicas,
-		MaxConns:     options.MaxConns,
+		PluginName:        options.DBPluginName,
+		KeySpace:          options.DBName,
+		Username:          options.DBUsername,
+		Password:          options.DBPassword,
+		Host:              options.DBHost,
+		Port:              options.DBPort,
+		Region:            options.DBRegion,
+		ProtoVersion:      options.ProtoVersion,
+		Replicas:          options.Replicas,
+		MaxConns:          options.MaxConns,
+		ConnectAttributes: options.DBConnectAttributes,
 	})
 	metadata := options.ClusterMetadata
 	if metadata.GetCurrentClusterName() == "" {
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// encryptedDecoder opens encrypted envelopes and decodes their content with the decoder of its encoding.
+type encryptedDecoder struct {
+	codecs   persistence.BlobCodecs
+	decoders map[constants.EncodingType]decoder
+}
+
+func newEncryptedDecoder(codecs persistence.BlobCodecs, decoders map[constants.EncodingType]decoder) decoder {
+	return &encryptedDecoder{codecs: codecs, decoders: decoders}
+}
+
+func (d *encryptedDecoder) shardInfoFromBlob(data []byte) (*ShardInfo, error) {
+	return decryptAndDecode(d, data, decoder.shardInfoFromBlob)
+}
+
+func (d *encryptedDecoder) domainInfoFromBlob(data []byte) (*DomainInfo, error) {
+	return decryptAndDecode(d, data, decoder.domainInfoFromBlob)
+}
+
+func (d *encryptedDecoder) historyTreeInfoFromBlob(data []byte) (*HistoryTreeInfo, error) {
+	return decryptAndDecode(d, data, decoder.historyTreeInfoFromBlob)
+}
+
+func (d *encryptedDecoder) workflowExecutionInfoFromBlob(data []byte) (*WorkflowExecutionInfo, error) {
+	return decryptAndDecode(d, data, decoder.workflowExecutionInfoFromBlob)
+}
+
+func (d *encryptedDecoder) activityInfoFromBlob(data []byte) (*ActivityInfo, error) {
+	return decryptAndDecode(d, data, decoder.activityInfoFromBlob)
+}
+
+func (d *encryptedDecoder) childExecutionInfoFromBlob(data []byte) (*ChildExecutionInfo, error) {
+	return decryptAndDecode(d, data, decoder.childExecutionInfoFromBlob)
+}
+
+func (d *encryptedDecoder) signalInfoFromBlob(data []byte) (*SignalInfo, error) {
+	return decryptAndDecode(d, data, decoder.signalInfoFromBlob)
+}
+
+func (d *encryptedDecoder) requestCancelInfoFromBlob(data []byte) (*RequestCancelInfo, error) {
+	return decryptAndDecode(d, data, decoder.requestCancelInfoFromBlob)
+}
+
+func (d *encryptedDecoder) timerInfoFromBlob(data []byte) (*TimerInfo, error) {
+	return decryptAndDecode(d, data, decoder.timerInfoFromBlob)
+}
+
+func (d *encryptedDecoder) taskInfoFromBlob(data []byte) (*TaskInfo, error) {
+	return decryptAndDecode(d, data, decoder.taskInfoFromBlob)
+}
+
+func (d *encryptedDecoder) taskListInfoFromBlob(data []byte) (*TaskListInfo, error) {
+	return decryptAndDecode(d, data, decoder.taskListInfoFromBlob)
+}
+
+func (d *encryptedDecoder) transferTaskInfoFromBlob(data []byte) (*TransferTaskInfo, error) {
+	return decryptAndDecode(d, data, decoder.transferTaskInfoFromBlob)
+}
+
+func (d *encryptedDecoder) crossClusterTaskInfoFromBlob(data []byte) (*CrossClusterTaskInfo, error) {
+	return decryptAndDecode(d, data, decoder.crossClusterTaskInfoFromBlob)
+}
+
+func (d *encryptedDecoder) timerTaskInfoFromBlob(data []byte) (*TimerTaskInfo, error) {
+	return decryptAndDecode(d, data, decoder.timerTaskInfoFromBlob)
+}
+
+func (d *encryptedDecoder) replicationTaskInfoFromBlob(data []byte) (*ReplicationTaskInfo, error) {
+	return decryptAndDecode(d, data, decoder.replicationTaskInfoFromBlob)
+}
+
+func decryptAndDecode[T any](d *encryptedDecoder, data []byte, decode func(decoder, []byte) (T, error)) (T, error) {
+	var empty T
+	decrypted, err := d.codecs.Decrypt(persistence.NewDataBlob(data, constants.EncodingTypeEncrypted))
+	if err != nil {
+		return empty, err
+	}
+	inner, ok := d.decoders[decrypted.Encoding]
+	if !ok || decrypted.Encoding == constants.EncodingTypeEncrypted {
+		return empty, unsupportedEncodingError(decrypted.Encoding)
+	}
+	return decode(inner, decrypted.Data)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/encryption"
+)
+
+func TestEncryptedDecoderRoundTrip(t *testing.T) {
+	codecs := newTestEncryptedBlobCodecs(t)
+	parser, err := NewParser(&persistence.DynamicConfiguration{
+		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRWZstd)),
+	}, codecs)
+	require.NoError(t, err)
+
+	for name, data := range map[string]interface{}{
+		"ShardInfo":             shardInfoTestData,
+		"DomainInfo":            domainInfoTestData,
+		"HistoryTreeInfo":       historyTreeInfoTestData,
+		"WorkflowExecutionInfo": workflowExecutionInfoTestData,
+		"ActivityInfo":          activityInfoTestData,
+		"ChildExecutionInfo":    childExecutionInfoTestData,
+		"SignalInfo":            signalInfoTestData,
+		"RequestCancelInfo":     requestCancelInfoTestData,
+		"TimerInfo":             timerInfoTestData,
+		"TaskInfo":              taskInfoTestData,
+		"TaskListInfo":          taskListInfoTestData,
+		"TransferTaskInfo":      transferTaskInfoTestData,
+		"TimerTaskInfo":         timerTaskInfoTestData,
+		"ReplicationTaskInfo":   replicationTaskInfoTestData,
+	} {
+		t.Run(name, func(t *testing.T) {
+			encrypted, err := codecs.Encrypt("", persistence.NewDataBlob(encodeWithParser(t, parser, data), constants.EncodingTypeThriftRWZstd))
+			require.NoError(t, err)
+			assert.Equal(t, data, decodeWithParser(t, parser, encrypted.Data, constants.EncodingTypeEncrypted, data))
+		})
+	}
+}
+
+func TestParserEncryptsMutableState(t *testing.T) {
+	enabled := true
+	parser, err := NewParser(&persistence.DynamicConfiguration{
+		SerializationEncoding:       dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
+		EnablePersistenceEncryption: func(...dynamicproperties.FilterOption) bool { return enabled },
+	}, newTestEncryptedBlobCodecs(t))
+	require.NoError(t, err)
+
+	blob, err := parser.ActivityInfoToBlob(activityInfoTestData)
+	require.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeEncrypted, blob.Encoding)
+	activityInfo, err := parser.ActivityInfoFromBlob(blob.Data, string(blob.Encoding))
+	require.NoError(t, err)
+	assert.Equal(t, activityInfoTestData, activityInfo)
+
+	blob, err = parser.WorkflowExecutionInfoToBlob(workflowExecutionInfoTestData)
+	require.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeEncrypted, blob.Encoding)
+
+	blob, err = parser.TransferTaskInfoToBlob(transferTaskInfoTestData)
+	require.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeThriftRW, blob.Encoding, "tasks hold no payloads and are not encrypted")
+
+	enabled = false
+	blob, err = parser.ActivityInfoToBlob(activityInfoTestData)
+	require.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeThriftRW, blob.Encoding)
+}
+
+func TestEncryptedDecoderErrorHandling(t *testing.T) {
+	codecs := newTestEncryptedBlobCodecs(t)
+	decoder := newEncryptedDecoder(codecs, map[constants.EncodingType]decoder{
+		constants.EncodingTypeThriftRW: newThriftDecoder(),
+	})
+
+	_, err := decoder.shardInfoFromBlob([]byte("not an envelope"))
+	assert.ErrorContains(t, err, "unable to decrypt blob")
+
+	encrypted, err := codecs.Encrypt("", persistence.NewDataBlob([]byte("data"), constants.EncodingTypeThriftRWSnappy))
+	require.NoError(t, err)
+	_, err = decoder.shardInfoFromBlob(encrypted.Data)
+	assert.ErrorContains(t, err, "invalid encoding type: thriftrw_snappy")
+}
+
+func newTestEncryptedBlobCodecs(t *testing.T) persistence.BlobCodecs {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	provider, err := encryption.NewKeyringProvider(encryption.Keyring{
+		CurrentVersion: "1",
+		Keys:           map[string]string{"1": base64.StdEncoding.EncodeToString(key)},
+	})
+	require.NoError(t, err)
+
+	codecs := newTestBlobCodecs(t)
+	codecs.Encryptor = encryption.NewEncryptor(provider)
+	return codecs
+}
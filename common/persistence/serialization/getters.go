@@ -555,6 +555,30 @@ func (w *WorkflowExecutionInfo) GetActiveClusterSelectionPolicyEncoding() (o str
 	return
 }
 
+// GetPriority internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPriority() (o int32) {
+	if w != nil {
+		return w.Priority
+	}
+	return
+}
+
+// GetWorkerBuildID internal sql blob getter
+func (w *WorkflowExecutionInfo) GetWorkerBuildID() (o string) {
+	if w != nil {
+		return w.WorkerBuildID
+	}
+	return
+}
+
+// GetFairnessKey internal sql blob getter
+func (w *WorkflowExecutionInfo) GetFairnessKey() (o string) {
+	if w != nil {
+		return w.FairnessKey
+	}
+	return
+}
+
 // GetInitiatedID internal sql blob getter
 func (w *WorkflowExecutionInfo) GetInitiatedID() (o int64) {
 	if w != nil {
@@ -1170,6 +1194,22 @@ func (a *ActivityInfo) GetRetryNonRetryableErrors() (o []string) {
 	return
 }
 
+// GetPaused internal sql blob getter
+func (a *ActivityInfo) GetPaused() (o bool) {
+	if a != nil {
+		return a.Paused
+	}
+	return
+}
+
+// GetPriority internal sql blob getter
+func (a *ActivityInfo) GetPriority() (o int32) {
+	if a != nil {
+		return a.Priority
+	}
+	return
+}
+
 // GetVersion internal sql blob getter
 func (c *ChildExecutionInfo) GetVersion() (o int64) {
 	if c != nil {
@@ -1434,6 +1474,30 @@ func (t *TaskInfo) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority internal sql blob getter
+func (t *TaskInfo) GetPriority() (o int32) {
+	if t != nil {
+		return t.Priority
+	}
+	return
+}
+
+// GetWorkerBuildID internal sql blob getter
+func (t *TaskInfo) GetWorkerBuildID() (o string) {
+	if t != nil {
+		return t.WorkerBuildID
+	}
+	return
+}
+
+// GetFairnessKey internal sql blob getter
+func (t *TaskInfo) GetFairnessKey() (o string) {
+	if t != nil {
+		return t.FairnessKey
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -1590,6 +1654,14 @@ func (t *TransferTaskInfo) GetOriginalTaskListKind() (o types.TaskListKind) {
 	return
 }
 
+// GetTraceContext internal sql blob getter
+func (t *TransferTaskInfo) GetTraceContext() (o map[string]string) {
+	if t != nil && t.TraceContext != nil {
+		return t.TraceContext
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TimerTaskInfo) GetDomainID() (o []byte) {
 	if t != nil && t.DomainID != nil {
@@ -1662,6 +1734,14 @@ func (t *TimerTaskInfo) GetTaskList() (o string) {
 	return
 }
 
+// GetTraceContext internal sql blob getter
+func (t *TimerTaskInfo) GetTraceContext() (o map[string]string) {
+	if t != nil && t.TraceContext != nil {
+		return t.TraceContext
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *ReplicationTaskInfo) GetDomainID() (o []byte) {
 	if t != nil {
@@ -34,6 +34,9 @@ var (
 
 var expectedNil = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
+		"GetPriority":      int32(0),
+		"GetWorkerBuildID": "",
+		"GetFairnessKey":   "",
 		"GetActiveClusterSelectionPolicyEncoding": string(""),
 		"GetAutoResetPoints":                      []uint8(nil),
 		"GetAutoResetPointsEncoding":              "",
@@ -117,6 +120,7 @@ var expectedNil = map[string]map[string]any{
 		"GetVisibilityTimestamp":     zeroUnix,
 		"GetOriginalTaskList":        "",
 		"GetOriginalTaskListKind":    types.TaskListKindNormal,
+		"GetTraceContext":            map[string]string(nil),
 	},
 	"*serialization.TimerTaskInfo": {
 		"GetDomainID":        []uint8(nil),
@@ -128,6 +132,7 @@ var expectedNil = map[string]map[string]any{
 		"GetVersion":         int64(0),
 		"GetWorkflowID":      "",
 		"GetTaskList":        "",
+		"GetTraceContext":    map[string]string(nil),
 	},
 	"*serialization.ReplicationTaskInfo": {
 		"GetBranchToken":             []uint8(nil),
@@ -151,6 +156,9 @@ var expectedNil = map[string]map[string]any{
 		"GetLastUpdated":     zeroUnix,
 	},
 	"*serialization.TaskInfo": {
+		"GetPriority":         int32(0),
+		"GetWorkerBuildID":    "",
+		"GetFairnessKey":      "",
 		"GetCreatedTimestamp": zeroUnix,
 		"GetExpiryTimestamp":  zeroUnix,
 		"GetPartitionConfig":  map[string]string(nil),
@@ -194,12 +202,14 @@ var expectedNil = map[string]map[string]any{
 		"GetWorkflowTypeName":       "",
 	},
 	"*serialization.ActivityInfo": {
+		"GetPriority":                 int32(0),
 		"GetActivityID":               "",
 		"GetAttempt":                  int32(0),
 		"GetCancelRequestID":          int64(0),
 		"GetCancelRequested":          false,
 		"GetHasRetryPolicy":           false,
 		"GetHeartbeatTimeout":         time.Duration(0),
+		"GetPaused":                   false,
 		"GetRequestID":                "",
 		"GetRetryBackoffCoefficient":  float64(0),
 		"GetRetryExpirationTimestamp": zeroUnix,
@@ -282,6 +292,9 @@ var expectedNil = map[string]map[string]any{
 
 var expectedEmpty = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
+		"GetPriority":      int32(0),
+		"GetWorkerBuildID": "",
+		"GetFairnessKey":   "",
 		"GetActiveClusterSelectionPolicyEncoding": string(""),
 		"GetAutoResetPoints":                      []uint8(nil),
 		"GetAutoResetPointsEncoding":              "",
@@ -365,6 +378,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetVisibilityTimestamp":     time.Time{},
 		"GetOriginalTaskList":        "",
 		"GetOriginalTaskListKind":    types.TaskListKindNormal,
+		"GetTraceContext":            map[string]string(nil),
 	},
 	"*serialization.TimerTaskInfo": {
 		"GetDomainID":        []uint8(nil),
@@ -376,6 +390,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetVersion":         int64(0),
 		"GetWorkflowID":      "",
 		"GetTaskList":        "",
+		"GetTraceContext":    map[string]string(nil),
 	},
 	"*serialization.ReplicationTaskInfo": {
 		"GetBranchToken":             []uint8(nil),
@@ -399,6 +414,9 @@ var expectedEmpty = map[string]map[string]any{
 		"GetLastUpdated":     time.Time{},
 	},
 	"*serialization.TaskInfo": {
+		"GetPriority":         int32(0),
+		"GetWorkerBuildID":    "",
+		"GetFairnessKey":      "",
 		"GetCreatedTimestamp": time.Time{},
 		"GetExpiryTimestamp":  time.Time{},
 		"GetPartitionConfig":  map[string]string(nil),
@@ -442,12 +460,14 @@ var expectedEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":       "",
 	},
 	"*serialization.ActivityInfo": {
+		"GetPriority":                 int32(0),
 		"GetActivityID":               "",
 		"GetAttempt":                  int32(0),
 		"GetCancelRequestID":          int64(0),
 		"GetCancelRequested":          false,
 		"GetHasRetryPolicy":           false,
 		"GetHeartbeatTimeout":         time.Duration(0),
+		"GetPaused":                   false,
 		"GetRequestID":                "",
 		"GetRetryBackoffCoefficient":  float64(0),
 		"GetRetryExpirationTimestamp": time.Time{},
@@ -530,6 +550,9 @@ var expectedEmpty = map[string]map[string]any{
 
 var expectedNonEmpty = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
+		"GetPriority":                           int32(3),
+		"GetWorkerBuildID":                      "build-1",
+		"GetFairnessKey":                        "tenant-a",
 		"GetAutoResetPoints":                    []byte("resetpoints"),
 		"GetAutoResetPointsEncoding":            "",
 		"GetCancelRequestID":                    "",
@@ -615,6 +638,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetVisibilityTimestamp":     taskInfoCreateTime,
 		"GetOriginalTaskList":        "originalTaskList",
 		"GetOriginalTaskListKind":    types.TaskListKindEphemeral,
+		"GetTraceContext":            map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
 	},
 	"*serialization.TimerTaskInfo": {
 		"GetDomainID":        []byte(taskDomainID),
@@ -626,6 +650,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetVersion":         int64(3),
 		"GetWorkflowID":      "workflowID",
 		"GetTaskList":        "taskList",
+		"GetTraceContext":    map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
 	},
 	"*serialization.ReplicationTaskInfo": {
 		"GetBranchToken":             []byte("branchToken"),
@@ -649,6 +674,9 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetLastUpdated":     taskListInfoLastUpdateTime,
 	},
 	"*serialization.TaskInfo": {
+		"GetPriority":         int32(2),
+		"GetWorkerBuildID":    "build-1",
+		"GetFairnessKey":      "tenant-a",
 		"GetCreatedTimestamp": taskInfoCreateTime,
 		"GetExpiryTimestamp":  taskInfoExpiryTime,
 		"GetPartitionConfig":  map[string]string{"key": "value"},
@@ -692,12 +720,14 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":       "workflowTypeName",
 	},
 	"*serialization.ActivityInfo": {
+		"GetPriority":                 int32(4),
 		"GetActivityID":               "activityID",
 		"GetAttempt":                  int32(6),
 		"GetCancelRequestID":          int64(4),
 		"GetCancelRequested":          true,
 		"GetHasRetryPolicy":           true,
 		"GetHeartbeatTimeout":         time.Duration(4),
+		"GetPaused":                   true,
 		"GetRequestID":                "requestID",
 		"GetRetryBackoffCoefficient":  float64(8),
 		"GetRetryExpirationTimestamp": activeInfoRetryExpirationTime,
@@ -150,6 +150,9 @@ func TestGettersForInfos(t *testing.T) {
 			LastFirstEventID:        7,
 			AutoResetPoints:         []byte("resetpoints"),
 			SearchAttributes:        map[string][]byte{"key": []byte("value")},
+			Priority:                3,
+			WorkerBuildID:           "build-1",
+			FairnessKey:             "tenant-a",
 		},
 		&TransferTaskInfo{
 			DomainID:                taskDomainID,
@@ -167,6 +170,7 @@ func TestGettersForInfos(t *testing.T) {
 			VisibilityTimestamp:     taskInfoCreateTime,
 			OriginalTaskList:        "originalTaskList",
 			OriginalTaskListKind:    types.TaskListKindEphemeral,
+			TraceContext:            map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
 		},
 		&TimerTaskInfo{
 			DomainID:        taskDomainID,
@@ -178,6 +182,7 @@ func TestGettersForInfos(t *testing.T) {
 			ScheduleAttempt: 4,
 			EventID:         5,
 			TaskList:        "taskList",
+			TraceContext:    map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
 		},
 		&ReplicationTaskInfo{
 			DomainID:                replicationTaskDomainID,
@@ -209,6 +214,9 @@ func TestGettersForInfos(t *testing.T) {
 			PartitionConfig: map[string]string{
 				"key": "value",
 			},
+			Priority:      2,
+			WorkerBuildID: "build-1",
+			FairnessKey:   "tenant-a",
 		},
 		&TimerInfo{
 			Version:         1,
@@ -277,6 +285,8 @@ func TestGettersForInfos(t *testing.T) {
 			RetryLastWorkerIdentity:  "retryLastWorkerIdentity",
 			RetryLastFailureReason:   "retryLastFailureReason",
 			RetryLastFailureDetails:  []byte("retryLastFailureDetails"),
+			Paused:                   true,
+			Priority:                 4,
 		},
 		&HistoryTreeInfo{
 			CreatedTimestamp: historyTreeEventCreatedTime,
@@ -178,6 +178,9 @@ type (
 		ChecksumEncoding                     string
 		ActiveClusterSelectionPolicy         []byte
 		ActiveClusterSelectionPolicyEncoding string
+		Priority                             int32
+		WorkerBuildID                        string
+		FairnessKey                          string
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -214,6 +217,8 @@ type (
 		RetryLastFailureReason   string
 		RetryLastWorkerIdentity  string
 		RetryLastFailureDetails  []byte
+		Paused                   bool
+		Priority                 int32
 	}
 
 	// ChildExecutionInfo blob in a serialization agnostic format
@@ -267,6 +272,9 @@ type (
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
 		PartitionConfig  map[string]string
+		Priority         int32
+		WorkerBuildID    string
+		FairnessKey      string
 	}
 
 	TaskListPartition struct {
@@ -287,6 +295,7 @@ type (
 		ExpiryTimestamp         time.Time
 		LastUpdated             time.Time
 		AdaptivePartitionConfig *TaskListPartitionConfig
+		CompatibleBuildIDSets   [][]string
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
@@ -306,6 +315,7 @@ type (
 		VisibilityTimestamp     time.Time
 		OriginalTaskList        string
 		OriginalTaskListKind    types.TaskListKind
+		TraceContext            map[string]string
 	}
 
 	// CrossClusterTaskInfo blob in a serialization agnostic format
@@ -326,6 +336,7 @@ type (
 		ScheduleAttempt int64
 		EventID         int64
 		TaskList        string
+		TraceContext    map[string]string
 	}
 
 	// ReplicationTaskInfo blob in a serialization agnostic format
@@ -32,6 +32,7 @@ import (
 type (
 	parser struct {
 		dc       *persistence.DynamicConfiguration
+		codecs   persistence.BlobCodecs
 		encoders map[constants.EncodingType]encoder
 		decoders map[constants.EncodingType]decoder
 	}
@@ -40,28 +41,35 @@ type (
 var allBlobEncodings = []constants.EncodingType{
 	constants.EncodingTypeThriftRW,
 	constants.EncodingTypeThriftRWSnappy,
+	constants.EncodingTypeThriftRWZstd,
 }
 
 // NewParser constructs a new parser using encoder as specified by encodingType and using decoders specified by decodingTypes
-func NewParser(dc *persistence.DynamicConfiguration) (Parser, error) {
+func NewParser(dc *persistence.DynamicConfiguration, codecs persistence.BlobCodecs) (Parser, error) {
 	encoders := make(map[constants.EncodingType]encoder)
 	decoders := make(map[constants.EncodingType]decoder)
 
 	for _, dt := range allBlobEncodings {
-		decoder, err := getDecoder(dt)
+		if dt == constants.EncodingTypeThriftRWZstd && codecs.Zstd == nil {
+			// blobs of this encoding can be neither read nor written without codec
+			continue
+		}
+		decoder, err := getDecoder(dt, codecs)
 		if err != nil {
 			return nil, err
 		}
 		decoders[dt] = decoder
 
-		encoder, err := getEncoder(dt)
+		encoder, err := getEncoder(dt, codecs)
 		if err != nil {
 			return nil, err
 		}
 		encoders[dt] = encoder
 	}
+	decoders[constants.EncodingTypeEncrypted] = newEncryptedDecoder(codecs, decoders)
 	return &parser{
 		dc:       dc,
+		codecs:   codecs,
 		encoders: encoders,
 		decoders: decoders,
 	}, nil
@@ -133,7 +141,7 @@ func (p *parser) WorkflowExecutionInfoToBlob(info *WorkflowExecutionInfo) (persi
 	}
 	db.Data = data
 	db.Encoding = encoder.encodingType()
-	return db, nil
+	return p.encrypt(db)
 }
 
 func (p *parser) ActivityInfoToBlob(info *ActivityInfo) (persistence.DataBlob, error) {
@@ -150,7 +158,7 @@ func (p *parser) ActivityInfoToBlob(info *ActivityInfo) (persistence.DataBlob, e
 	}
 	db.Data = data
 	db.Encoding = encoder.encodingType()
-	return db, nil
+	return p.encrypt(db)
 }
 
 func (p *parser) ChildExecutionInfoToBlob(info *ChildExecutionInfo) (persistence.DataBlob, error) {
@@ -167,7 +175,7 @@ func (p *parser) ChildExecutionInfoToBlob(info *ChildExecutionInfo) (persistence
 	}
 	db.Data = data
 	db.Encoding = encoder.encodingType()
-	return db, nil
+	return p.encrypt(db)
 }
 
 func (p *parser) SignalInfoToBlob(info *SignalInfo) (persistence.DataBlob, error) {
@@ -184,7 +192,7 @@ func (p *parser) SignalInfoToBlob(info *SignalInfo) (persistence.DataBlob, error
 	}
 	db.Data = data
 	db.Encoding = encoder.encodingType()
-	return db, nil
+	return p.encrypt(db)
 }
 
 func (p *parser) RequestCancelInfoToBlob(info *RequestCancelInfo) (persistence.DataBlob, error) {
@@ -201,7 +209,7 @@ func (p *parser) RequestCancelInfoToBlob(info *RequestCancelInfo) (persistence.D
 	}
 	db.Data = data
 	db.Encoding = encoder.encodingType()
-	return db, nil
+	return p.encrypt(db)
 }
 
 func (p *parser) TimerInfoToBlob(info *TimerInfo) (persistence.DataBlob, error) {
@@ -218,7 +226,7 @@ func (p *parser) TimerInfoToBlob(info *TimerInfo) (persistence.DataBlob, error)
 	}
 	db.Data = data
 	db.Encoding = encoder.encodingType()
-	return db, nil
+	return p.encrypt(db)
 }
 
 func (p *parser) TaskInfoToBlob(info *TaskInfo) (persistence.DataBlob, error) {
@@ -443,6 +451,19 @@ func (p *parser) ReplicationTaskInfoFromBlob(data []byte, encoding string) (*Rep
 	return decoder.replicationTaskInfoFromBlob(data)
 }
 
+// encrypt seals blobs holding mutable state when persistence encryption is enabled.
+// The domain of the blobs is not known here, so they are sealed with the cluster scoped data key.
+func (p *parser) encrypt(db persistence.DataBlob) (persistence.DataBlob, error) {
+	if p.dc.EnablePersistenceEncryption == nil || !p.dc.EnablePersistenceEncryption() || p.codecs.Encryptor == nil {
+		return db, nil
+	}
+	encrypted, err := p.codecs.Encrypt("", &db)
+	if err != nil {
+		return persistence.DataBlob{}, err
+	}
+	return *encrypted, nil
+}
+
 func (p *parser) getCachedEncoder(encoding constants.EncodingType) (encoder, error) {
 	encoder, ok := p.encoders[encoding]
 	if !ok {
@@ -459,23 +480,33 @@ func (p *parser) getCachedDecoder(encoding constants.EncodingType) (decoder, err
 	return decoder, nil
 }
 
-func getDecoder(encoding constants.EncodingType) (decoder, error) {
+func getDecoder(encoding constants.EncodingType, codecs persistence.BlobCodecs) (decoder, error) {
 	switch encoding {
 	case constants.EncodingTypeThriftRW:
 		return newThriftDecoder(), nil
 	case constants.EncodingTypeThriftRWSnappy:
 		return newSnappyThriftDecoder(), nil
+	case constants.EncodingTypeThriftRWZstd:
+		if codecs.Zstd == nil {
+			return nil, fmt.Errorf("zstd compression is not configured for encoding type: %v", encoding)
+		}
+		return newZstdThriftDecoder(codecs.Zstd), nil
 	default:
 		return nil, unsupportedEncodingError(encoding)
 	}
 }
 
-func getEncoder(encoding constants.EncodingType) (encoder, error) {
+func getEncoder(encoding constants.EncodingType, codecs persistence.BlobCodecs) (encoder, error) {
 	switch encoding {
 	case constants.EncodingTypeThriftRW:
 		return newThriftEncoder(), nil
 	case constants.EncodingTypeThriftRWSnappy:
 		return newSnappyThriftEncoder(), nil
+	case constants.EncodingTypeThriftRWZstd:
+		if codecs.Zstd == nil {
+			return nil, fmt.Errorf("zstd compression is not configured for encoding type: %v", encoding)
+		}
+		return newZstdThriftEncoder(codecs.Zstd), nil
 	default:
 		return nil, unsupportedEncodingError(encoding)
 	}
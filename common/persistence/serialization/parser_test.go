@@ -42,7 +42,7 @@ func TestParserRoundTrip(t *testing.T) {
 	dc := &persistence.DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
 	}
-	thriftParser, err := NewParser(dc)
+	thriftParser, err := NewParser(dc, newTestBlobCodecs(t))
 	assert.NoError(t, err)
 	now := time.Now().Round(time.Second)
 
@@ -362,7 +362,7 @@ func TestParser_WorkflowExecution_with_cron(t *testing.T) {
 	dc := &persistence.DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
 	}
-	parser, err := NewParser(dc)
+	parser, err := NewParser(dc, newTestBlobCodecs(t))
 	require.NoError(t, err)
 	blob, err := parser.WorkflowExecutionInfoToBlob(info)
 	require.NoError(t, err)
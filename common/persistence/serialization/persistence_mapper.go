@@ -78,6 +78,9 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		PartitionConfig:                    info.PartitionConfig,
 		IsCron:                             info.IsCron,
 		CronOverlapPolicy:                  types.CronOverlapPolicy(info.GetCronOverlapPolicy()),
+		Priority:                           info.GetPriority(),
+		WorkerBuildID:                      info.GetWorkerBuildID(),
+		FairnessKey:                        info.GetFairnessKey(),
 	}
 	if info.ParentDomainID != nil {
 		result.ParentDomainID = info.ParentDomainID.String()
@@ -171,6 +174,9 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		CronOverlapPolicy:                    executionInfo.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:         executionInfo.ActiveClusterSelectionPolicy.GetData(),
 		ActiveClusterSelectionPolicyEncoding: string(executionInfo.ActiveClusterSelectionPolicy.GetEncoding()),
+		Priority:                             executionInfo.Priority,
+		WorkerBuildID:                        executionInfo.WorkerBuildID,
+		FairnessKey:                          executionInfo.FairnessKey,
 	}
 
 	if executionInfo.CompletionEvent != nil {
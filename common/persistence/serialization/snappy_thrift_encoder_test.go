@@ -408,7 +408,7 @@ func TestSnappyThriftEncoderWithParser(t *testing.T) {
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRWSnappy)),
 	}
 	// Test encoder integration with parser
-	parser, err := NewParser(dc)
+	parser, err := NewParser(dc, newTestBlobCodecs(t))
 	require.NoError(t, err)
 
 	testData := shardInfoTestData
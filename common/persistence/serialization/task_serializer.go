@@ -82,6 +82,7 @@ func (s *taskSerializerImpl) serializeTransferTask(task persistence.Task) (persi
 		TargetWorkflowID:    persistence.TransferTaskTransferTargetWorkflowID,
 		Version:             task.GetVersion(),
 		VisibilityTimestamp: task.GetVisibilityTimestamp(),
+		TraceContext:        task.GetTraceContext(),
 	}
 	switch t := task.(type) {
 	case *persistence.ActivityTask:
@@ -189,6 +190,7 @@ func (s *taskSerializerImpl) deserializeTransferTask(blob *persistence.DataBlob)
 	taskData := persistence.TaskData{
 		Version:             info.GetVersion(),
 		VisibilityTimestamp: info.GetVisibilityTimestamp(),
+		TraceContext:        info.GetTraceContext(),
 	}
 	switch info.GetTaskType() {
 	case persistence.TransferTaskTypeDecisionTask:
@@ -287,9 +289,10 @@ func (s *taskSerializerImpl) deserializeTransferTask(blob *persistence.DataBlob)
 
 func (s *taskSerializerImpl) serializeTimerTask(task persistence.Task) (persistence.DataBlob, error) {
 	info := &TimerTaskInfo{
-		TaskType: int16(task.GetTaskType()),
-		Version:  task.GetVersion(),
-		EventID:  constants.EmptyEventID,
+		TaskType:     int16(task.GetTaskType()),
+		Version:      task.GetVersion(),
+		EventID:      constants.EmptyEventID,
+		TraceContext: task.GetTraceContext(),
 	}
 	switch t := task.(type) {
 	case *persistence.DecisionTimeoutTask:
@@ -357,7 +360,8 @@ func (s *taskSerializerImpl) deserializeTimerTask(blob *persistence.DataBlob) (p
 		RunID:      info.RunID.String(),
 	}
 	taskData := persistence.TaskData{
-		Version: info.GetVersion(),
+		Version:      info.GetVersion(),
+		TraceContext: info.GetTraceContext(),
 	}
 	switch info.GetTaskType() {
 	case persistence.TaskTypeDecisionTimeout:
@@ -38,7 +38,7 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 	dc := &persistence.DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
 	}
-	parser, err := NewParser(dc)
+	parser, err := NewParser(dc, newTestBlobCodecs(t))
 	require.NoError(t, err)
 	taskSerializer := NewTaskSerializer(parser)
 
@@ -74,6 +74,9 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 					Version:             2,
 					TaskID:              2,
 					VisibilityTimestamp: time.Unix(2, 2),
+					TraceContext: map[string]string{
+						"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+					},
 				},
 				TargetDomainID: "2be8a310-7d20-483e-a5d2-48659dc47602",
 				TaskList:       "test-tl2",
@@ -231,6 +234,9 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 					Version:             13,
 					TaskID:              13,
 					VisibilityTimestamp: time.Unix(13, 13),
+					TraceContext: map[string]string{
+						"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+					},
 				},
 				EventID: 13,
 			},
@@ -288,6 +288,9 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		ChecksumEncoding:                        &info.ChecksumEncoding,
 		ActiveClusterSelectionPolicy:            info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding:    &info.ActiveClusterSelectionPolicyEncoding,
+		Priority:                                &info.Priority,
+		WorkerBuildId:                           &info.WorkerBuildID,
+		FairnessKey:                             &info.FairnessKey,
 	}
 }
 
@@ -363,6 +366,9 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		ChecksumEncoding:                     info.GetChecksumEncoding(),
 		ActiveClusterSelectionPolicy:         info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding: info.GetActiveClusterSelectionPolicyEncoding(),
+		Priority:                             info.GetPriority(),
+		WorkerBuildID:                        info.GetWorkerBuildId(),
+		FairnessKey:                          info.GetFairnessKey(),
 	}
 }
 
@@ -403,6 +409,8 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		RetryLastFailureReason:        &info.RetryLastFailureReason,
 		RetryLastWorkerIdentity:       &info.RetryLastWorkerIdentity,
 		RetryLastFailureDetails:       info.RetryLastFailureDetails,
+		Paused:                        &info.Paused,
+		Priority:                      &info.Priority,
 	}
 }
 
@@ -443,6 +451,8 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		RetryLastFailureReason:   info.GetRetryLastFailureReason(),
 		RetryLastWorkerIdentity:  info.GetRetryLastWorkerIdentity(),
 		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+		Paused:                   info.GetPaused(),
+		Priority:                 info.GetPriority(),
 	}
 }
 
@@ -575,6 +585,9 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         &info.Priority,
+		WorkerBuildId:    &info.WorkerBuildID,
+		FairnessKey:      &info.FairnessKey,
 	}
 }
 
@@ -589,6 +602,9 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         info.GetPriority(),
+		WorkerBuildID:    info.GetWorkerBuildId(),
+		FairnessKey:      info.GetFairnessKey(),
 	}
 }
 
@@ -650,6 +666,7 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 		ExpiryTimeNanos:         timeToUnixNanoPtr(info.ExpiryTimestamp),
 		LastUpdatedNanos:        timeToUnixNanoPtr(info.LastUpdated),
 		AdaptivePartitionConfig: taskListPartitionConfigToThrift(info.AdaptivePartitionConfig),
+		CompatibleBuildIDSets:   info.CompatibleBuildIDSets,
 	}
 }
 
@@ -663,6 +680,7 @@ func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 		ExpiryTimestamp:         timeFromUnixNano(info.GetExpiryTimeNanos()),
 		LastUpdated:             timeFromUnixNano(info.GetLastUpdatedNanos()),
 		AdaptivePartitionConfig: taskListParititionConfigFromThrift(info.AdaptivePartitionConfig),
+		CompatibleBuildIDSets:   info.CompatibleBuildIDSets,
 	}
 }
 
@@ -686,6 +704,7 @@ func transferTaskInfoToThrift(info *TransferTaskInfo) *sqlblobs.TransferTaskInfo
 		VisibilityTimestampNanos: timeToUnixNanoPtr(info.VisibilityTimestamp),
 		OriginalTaskList:         &info.OriginalTaskList,
 		OriginalTaskListKind:     thrift.FromTaskListKind(&info.OriginalTaskListKind),
+		TraceContext:             info.TraceContext,
 	}
 	if len(info.TargetDomainIDs) > 0 {
 		thriftTaskInfo.TargetDomainIDs = [][]byte{}
@@ -716,6 +735,7 @@ func transferTaskInfoFromThrift(info *sqlblobs.TransferTaskInfo) *TransferTaskIn
 		VisibilityTimestamp:     timeFromUnixNano(info.GetVisibilityTimestampNanos()),
 		OriginalTaskList:        info.GetOriginalTaskList(),
 		OriginalTaskListKind:    taskListKindFromThrift(info.OriginalTaskListKind),
+		TraceContext:            info.TraceContext,
 	}
 	if len(info.GetTargetDomainIDs()) > 0 {
 		transferTaskInfo.TargetDomainIDs = []UUID{}
@@ -748,6 +768,7 @@ func timerTaskInfoToThrift(info *TimerTaskInfo) *sqlblobs.TimerTaskInfo {
 		ScheduleAttempt: &info.ScheduleAttempt,
 		EventID:         &info.EventID,
 		TaskList:        &info.TaskList,
+		TraceContext:    info.TraceContext,
 	}
 }
 
@@ -765,6 +786,7 @@ func timerTaskInfoFromThrift(info *sqlblobs.TimerTaskInfo) *TimerTaskInfo {
 		ScheduleAttempt: info.GetScheduleAttempt(),
 		EventID:         info.GetEventID(),
 		TaskList:        info.GetTaskList(),
+		TraceContext:    info.TraceContext,
 	}
 }
 
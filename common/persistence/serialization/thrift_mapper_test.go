@@ -274,6 +274,9 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		Checksum:                           []byte("Checksum"),
 		ChecksumEncoding:                   "ChecksumEncoding",
 		IsCron:                             true,
+		Priority:                           int32(rand.Intn(10)),
+		WorkerBuildID:                      "build-1",
+		FairnessKey:                        "tenant-a",
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -390,6 +393,8 @@ func TestActivityInfo(t *testing.T) {
 		RetryLastFailureReason:   "RetryLastFailureReason",
 		RetryLastWorkerIdentity:  "RetryLastWorkerIdentity",
 		RetryLastFailureDetails:  []byte("RetryLastFailureDetails"),
+		Paused:                   true,
+		Priority:                 int32(rand.Intn(10)),
 	}
 	actual := activityInfoFromThrift(activityInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -471,6 +476,9 @@ func TestTaskInfo(t *testing.T) {
 		ExpiryTimestamp:  time.Now(),
 		CreatedTimestamp: time.Now(),
 		PartitionConfig:  map[string]string{"zone": "dca1"},
+		Priority:         int32(rand.Intn(10)),
+		WorkerBuildID:    "build-1",
+		FairnessKey:      "tenant-a",
 	}
 	actual := taskInfoFromThrift(taskInfoToThrift(expected))
 	assert.Equal(t, expected.WorkflowID, actual.WorkflowID)
@@ -479,6 +487,9 @@ func TestTaskInfo(t *testing.T) {
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 	assert.Equal(t, expected.CreatedTimestamp.Sub(actual.CreatedTimestamp), time.Duration(0))
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
+	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.WorkerBuildID, actual.WorkerBuildID)
+	assert.Equal(t, expected.FairnessKey, actual.FairnessKey)
 	assert.Nil(t, taskInfoFromThrift(nil))
 	assert.Nil(t, taskInfoToThrift(nil))
 }
@@ -521,6 +532,13 @@ func TestTaskListInfo(t *testing.T) {
 				NumWritePartitions: 2,
 			},
 		},
+		{
+			Kind:                  0,
+			AckLevel:              1,
+			ExpiryTimestamp:       time.UnixMicro(2),
+			LastUpdated:           time.UnixMicro(3),
+			CompatibleBuildIDSets: [][]string{{"build-1", "build-2"}, {"build-3"}},
+		},
 	}
 	for i, info := range cases {
 		assert.Equal(t, info, taskListInfoFromThrift(taskListInfoToThrift(info)), "case %d", i)
@@ -542,6 +560,7 @@ func TestTransferTaskInfo(t *testing.T) {
 		ScheduleID:              int64(rand.Intn(1000)),
 		Version:                 int64(rand.Intn(1000)),
 		OriginalTaskList:        "OriginalTaskList",
+		TraceContext:            map[string]string{"traceparent": "traceparent"},
 	}
 	actual := transferTaskInfoFromThrift(transferTaskInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -560,6 +579,7 @@ func TestTimerTaskInfo(t *testing.T) {
 		ScheduleAttempt: int64(rand.Intn(1000)),
 		EventID:         int64(rand.Intn(1000)),
 		TaskList:        "TaskList",
+		TraceContext:    map[string]string{"traceparent": "traceparent"},
 	}
 	actual := timerTaskInfoFromThrift(timerTaskInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
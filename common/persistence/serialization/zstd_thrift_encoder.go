@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+
+	"go.uber.org/thriftrw/protocol/binary"
+
+	"github.com/uber/cadence/common/codec/zstd"
+	"github.com/uber/cadence/common/constants"
+)
+
+type zstdThriftEncoder struct {
+	codec *zstd.Codec
+}
+
+func newZstdThriftEncoder(codec *zstd.Codec) encoder {
+	return &zstdThriftEncoder{codec: codec}
+}
+
+func (e *zstdThriftEncoder) shardInfoToBlob(info *ShardInfo) ([]byte, error) {
+	return e.encode(shardInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) domainInfoToBlob(info *DomainInfo) ([]byte, error) {
+	return e.encode(domainInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) historyTreeInfoToBlob(info *HistoryTreeInfo) ([]byte, error) {
+	return e.encode(historyTreeInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) workflowExecutionInfoToBlob(info *WorkflowExecutionInfo) ([]byte, error) {
+	return e.encode(workflowExecutionInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) activityInfoToBlob(info *ActivityInfo) ([]byte, error) {
+	return e.encode(activityInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) childExecutionInfoToBlob(info *ChildExecutionInfo) ([]byte, error) {
+	return e.encode(childExecutionInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) signalInfoToBlob(info *SignalInfo) ([]byte, error) {
+	return e.encode(signalInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) requestCancelInfoToBlob(info *RequestCancelInfo) ([]byte, error) {
+	return e.encode(requestCancelInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) timerInfoToBlob(info *TimerInfo) ([]byte, error) {
+	return e.encode(timerInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) taskInfoToBlob(info *TaskInfo) ([]byte, error) {
+	return e.encode(taskInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) taskListInfoToBlob(info *TaskListInfo) ([]byte, error) {
+	return e.encode(taskListInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) transferTaskInfoToBlob(info *TransferTaskInfo) ([]byte, error) {
+	return e.encode(transferTaskInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) crossClusterTaskInfoToBlob(info *CrossClusterTaskInfo) ([]byte, error) {
+	return e.encode(crossClusterTaskInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) timerTaskInfoToBlob(info *TimerTaskInfo) ([]byte, error) {
+	return e.encode(timerTaskInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) replicationTaskInfoToBlob(info *ReplicationTaskInfo) ([]byte, error) {
+	return e.encode(replicationTaskInfoToThrift(info))
+}
+
+func (e *zstdThriftEncoder) encodingType() constants.EncodingType {
+	return constants.EncodingTypeThriftRWZstd
+}
+
+func (e *zstdThriftEncoder) encode(t thriftRWType) ([]byte, error) {
+	var b bytes.Buffer
+	sw := binary.Default.Writer(&b)
+	defer sw.Close()
+	if err := t.Encode(sw); err != nil {
+		return nil, err
+	}
+
+	return e.codec.Compress(b.Bytes()), nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/codec/zstd"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// zstdMagic starts every zstd frame.
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+func newTestBlobCodecs(t testing.TB) persistence.BlobCodecs {
+	codec, err := zstd.NewCodec()
+	require.NoError(t, err)
+	return persistence.BlobCodecs{Zstd: codec}
+}
+
+func TestZstdThriftRoundTrip(t *testing.T) {
+	dc := &persistence.DynamicConfiguration{
+		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRWZstd)),
+	}
+	parser, err := NewParser(dc, newTestBlobCodecs(t))
+	require.NoError(t, err)
+
+	for name, data := range map[string]interface{}{
+		"ShardInfo":             shardInfoTestData,
+		"DomainInfo":            domainInfoTestData,
+		"HistoryTreeInfo":       historyTreeInfoTestData,
+		"WorkflowExecutionInfo": workflowExecutionInfoTestData,
+		"ActivityInfo":          activityInfoTestData,
+		"ChildExecutionInfo":    childExecutionInfoTestData,
+		"SignalInfo":            signalInfoTestData,
+		"RequestCancelInfo":     requestCancelInfoTestData,
+		"TimerInfo":             timerInfoTestData,
+		"TaskInfo":              taskInfoTestData,
+		"TaskListInfo":          taskListInfoTestData,
+		"TransferTaskInfo":      transferTaskInfoTestData,
+		"TimerTaskInfo":         timerTaskInfoTestData,
+		"ReplicationTaskInfo":   replicationTaskInfoTestData,
+	} {
+		t.Run(name, func(t *testing.T) {
+			blob := encodeWithParser(t, parser, data)
+			assert.True(t, bytes.HasPrefix(blob, zstdMagic), "encoded data should be a zstd frame")
+			assert.Equal(t, data, decodeWithParser(t, parser, blob, constants.EncodingTypeThriftRWZstd, data))
+		})
+	}
+}
+
+func TestZstdThriftReadableAlongsideOtherEncodings(t *testing.T) {
+	codecs := newTestBlobCodecs(t)
+	parsers := make(map[constants.EncodingType]Parser)
+	for _, encoding := range allBlobEncodings {
+		parser, err := NewParser(&persistence.DynamicConfiguration{
+			SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(encoding)),
+		}, codecs)
+		require.NoError(t, err)
+		parsers[encoding] = parser
+	}
+
+	for written, writer := range parsers {
+		blob, err := writer.WorkflowExecutionInfoToBlob(workflowExecutionInfoTestData)
+		require.NoError(t, err)
+		assert.Equal(t, written, blob.Encoding)
+		for read, reader := range parsers {
+			decoded, err := reader.WorkflowExecutionInfoFromBlob(blob.Data, string(blob.Encoding))
+			require.NoError(t, err, "reading %v with a %v parser", written, read)
+			assert.Equal(t, workflowExecutionInfoTestData, decoded)
+		}
+	}
+}
+
+func TestZstdThriftDecoderErrorHandling(t *testing.T) {
+	codecs := newTestBlobCodecs(t)
+	decoder := newZstdThriftDecoder(codecs.Zstd)
+
+	_, err := decoder.shardInfoFromBlob([]byte("invalid zstd data"))
+	assert.Error(t, err)
+
+	_, err = decoder.domainInfoFromBlob([]byte{})
+	assert.Error(t, err)
+
+	_, err = decoder.workflowExecutionInfoFromBlob(codecs.Zstd.Compress([]byte("not thrift data")))
+	assert.Error(t, err)
+}
+
+func TestZstdThriftEncoderEncodingType(t *testing.T) {
+	assert.Equal(t, constants.EncodingTypeThriftRWZstd, newZstdThriftEncoder(newTestBlobCodecs(t).Zstd).encodingType())
+}
+
+func BenchmarkWorkflowExecutionInfoEncoding(b *testing.B) {
+	raw, err := newThriftEncoder().workflowExecutionInfoToBlob(workflowExecutionInfoTestData)
+	require.NoError(b, err)
+
+	codecs := newTestBlobCodecs(b)
+	for _, encoding := range allBlobEncodings {
+		encoder, err := getEncoder(encoding, codecs)
+		require.NoError(b, err)
+		decoder, err := getDecoder(encoding, codecs)
+		require.NoError(b, err)
+
+		b.Run(string(encoding)+"/encode", func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				blob, err := encoder.workflowExecutionInfoToBlob(workflowExecutionInfoTestData)
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(blob)
+			}
+			b.ReportMetric(float64(len(raw))/float64(size), "ratio")
+		})
+		b.Run(string(encoding)+"/decode", func(b *testing.B) {
+			blob, err := encoder.workflowExecutionInfoToBlob(workflowExecutionInfoTestData)
+			require.NoError(b, err)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := decoder.workflowExecutionInfoFromBlob(blob); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
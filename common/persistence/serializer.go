@@ -115,16 +115,32 @@ type (
 		encodingType constants.EncodingType
 	}
 
+	// PayloadSerializerOption configures a PayloadSerializer
+	PayloadSerializerOption func(*serializerImpl)
+
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
+		codecs          BlobCodecs
 	}
 )
 
+// WithBlobCodecs sets the codecs of the encodings only known to persistence,
+// without them blobs of these encodings can't be serialized nor deserialized.
+func WithBlobCodecs(codecs BlobCodecs) PayloadSerializerOption {
+	return func(s *serializerImpl) {
+		s.codecs = codecs
+	}
+}
+
 // NewPayloadSerializer returns a PayloadSerializer
-func NewPayloadSerializer() PayloadSerializer {
-	return &serializerImpl{
+func NewPayloadSerializer(opts ...PayloadSerializerOption) PayloadSerializer {
+	s := &serializerImpl{
 		thriftrwEncoder: codec.NewThriftRWEncoder(),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (t *serializerImpl) SerializeBatchEvents(events []*types.HistoryEvent, encodingType constants.EncodingType) (*DataBlob, error) {
@@ -389,6 +405,8 @@ func (t *serializerImpl) serialize(input interface{}, encodingType constants.Enc
 		data, err = t.thriftrwEncode(input)
 	case constants.EncodingTypeThriftRWSnappy:
 		data, err = t.thriftrwsnappyEncode(input)
+	case constants.EncodingTypeThriftRWZstd:
+		data, err = t.thriftrwzstdEncode(input)
 	case constants.EncodingTypeJSON, constants.EncodingTypeUnknown, constants.EncodingTypeEmpty: // For backward-compatibility
 		encodingType = constants.EncodingTypeJSON
 		data, err = json.Marshal(input)
@@ -450,6 +468,14 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 		err = t.thriftrwDecode(data.Data, target)
 	case constants.EncodingTypeThriftRWSnappy:
 		err = t.thriftrwsnappyDecode(data.Data, target)
+	case constants.EncodingTypeThriftRWZstd:
+		err = t.thriftrwzstdDecode(data.Data, target)
+	case constants.EncodingTypeEncrypted:
+		decrypted, err := t.codecs.Decrypt(data)
+		if err != nil {
+			return err
+		}
+		return t.deserialize(decrypted, target)
 	case constants.EncodingTypeJSON, constants.EncodingTypeUnknown, constants.EncodingTypeEmpty: // For backward-compatibility
 		err = json.Unmarshal(data.Data, target)
 	default:
@@ -581,6 +607,27 @@ func (t *serializerImpl) thriftrwsnappyDecode(data []byte, target interface{}) e
 	return t.thriftrwDecode(decompressed, target)
 }
 
+func (t *serializerImpl) thriftrwzstdEncode(input interface{}) ([]byte, error) {
+	data, err := t.thriftrwEncode(input)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	return t.codecs.compress(data)
+}
+
+func (t *serializerImpl) thriftrwzstdDecode(data []byte, target interface{}) error {
+	decompressed, err := t.codecs.decompress(data)
+	if err != nil {
+		return err
+	}
+
+	return t.thriftrwDecode(decompressed, target)
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType constants.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
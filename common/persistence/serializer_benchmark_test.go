@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+func BenchmarkBatchEventsEncoding(b *testing.B) {
+	serializer := NewPayloadSerializer(WithBlobCodecs(newTestBlobCodecs(b)))
+	batch := benchmarkHistoryBatch(20)
+	raw, err := serializer.SerializeBatchEvents(batch, constants.EncodingTypeThriftRW)
+	require.NoError(b, err)
+
+	for _, encoding := range []constants.EncodingType{
+		constants.EncodingTypeThriftRW,
+		constants.EncodingTypeThriftRWSnappy,
+		constants.EncodingTypeThriftRWZstd,
+	} {
+		b.Run(string(encoding)+"/serialize", func(b *testing.B) {
+			var size int
+			for i := 0; i < b.N; i++ {
+				blob, err := serializer.SerializeBatchEvents(batch, encoding)
+				if err != nil {
+					b.Fatal(err)
+				}
+				size = len(blob.Data)
+			}
+			b.ReportMetric(float64(len(raw.Data))/float64(size), "ratio")
+		})
+		b.Run(string(encoding)+"/deserialize", func(b *testing.B) {
+			blob, err := serializer.SerializeBatchEvents(batch, encoding)
+			require.NoError(b, err)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := serializer.DeserializeBatchEvents(blob); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkHistoryBatch returns a batch of activity completions carrying similar, but not identical, payloads.
+func benchmarkHistoryBatch(size int) []*types.HistoryEvent {
+	batch := make([]*types.HistoryEvent, size)
+	for i := range batch {
+		id := int64(100 + i)
+		batch[i] = &types.HistoryEvent{
+			ID:        id,
+			Timestamp: common.Int64Ptr(1700000000000000000 + id*1000003),
+			EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+			Version:   1,
+			TaskID:    5000 + id,
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				Result: []byte(fmt.Sprintf(
+					`{"orderID":"order-%08d","status":"SHIPPED","carrier":"carrier-%d","items":[{"sku":"sku-%d","quantity":%d}]}`,
+					id*7919, id%3, id%17, id%5+1,
+				)),
+				ScheduledEventID: id - 2,
+				StartedEventID:   id - 1,
+				Identity:         fmt.Sprintf("worker-%d@host-%d", id%4, id%2),
+			},
+		}
+	}
+	return batch
+}
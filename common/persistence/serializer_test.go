@@ -45,11 +45,12 @@ type testDef struct {
 
 // key is encoding type, value is whether the encoding type is supported
 var encodingTypes = map[constants.EncodingType]bool{
-	constants.EncodingTypeEmpty:    true,
-	constants.EncodingTypeUnknown:  true,
-	constants.EncodingTypeJSON:     true,
-	constants.EncodingTypeThriftRW: true,
-	constants.EncodingTypeGob:      false,
+	constants.EncodingTypeEmpty:        true,
+	constants.EncodingTypeUnknown:      true,
+	constants.EncodingTypeJSON:         true,
+	constants.EncodingTypeThriftRW:     true,
+	constants.EncodingTypeThriftRWZstd: true,
+	constants.EncodingTypeGob:          false,
 }
 
 type runnableTest struct {
@@ -62,7 +63,7 @@ type runnableTest struct {
 
 func TestSerializers(t *testing.T) {
 	// create serializer once and reuse it for all test cases in parallel to catch concurrency issues
-	serializer := NewPayloadSerializer()
+	serializer := NewPayloadSerializer(WithBlobCodecs(newTestBlobCodecs(t)))
 
 	tests := []testDef{
 		{
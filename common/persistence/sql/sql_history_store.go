@@ -196,6 +196,7 @@ func (m *sqlHistoryStore) ReadHistoryBranch(
 	}
 
 	history := make([]*persistence.DataBlob, 0, int(request.PageSize))
+	nodes := make([]persistence.InternalHistoryNode, 0, int(request.PageSize))
 	eventBlob := &persistence.DataBlob{}
 
 	for _, row := range rows {
@@ -240,6 +241,7 @@ func (m *sqlHistoryStore) ReadHistoryBranch(
 			lastTxnID = *row.TxnID
 			lastNodeID = row.NodeID
 			history = append(history, eventBlob)
+			nodes = append(nodes, persistence.InternalHistoryNode{NodeID: row.NodeID, TransactionID: *row.TxnID})
 			eventBlob = &persistence.DataBlob{}
 		}
 	}
@@ -251,12 +253,42 @@ func (m *sqlHistoryStore) ReadHistoryBranch(
 
 	return &persistence.InternalReadHistoryBranchResponse{
 		History:           history,
+		Nodes:             nodes,
 		NextPageToken:     pagingToken,
 		LastNodeID:        lastNodeID,
 		LastTransactionID: lastTxnID,
 	}, nil
 }
 
+// UpdateHistoryNode overwrites the events of an existing history node
+func (m *sqlHistoryStore) UpdateHistoryNode(
+	ctx context.Context,
+	request *persistence.InternalUpdateHistoryNodeRequest,
+) error {
+	txnID := request.TransactionID
+	nodeRow := &sqlplugin.HistoryNodeRow{
+		TreeID:       serialization.MustParseUUID(request.TreeID),
+		BranchID:     serialization.MustParseUUID(request.BranchID),
+		NodeID:       request.NodeID,
+		TxnID:        &txnID,
+		Data:         request.Events.Data,
+		DataEncoding: string(request.Events.Encoding),
+		ShardID:      request.ShardID,
+	}
+	result, err := m.db.UpdateHistoryNode(ctx, nodeRow)
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateHistoryNode", "", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateHistoryNode", "", err)
+	}
+	if rowsAffected != 1 {
+		return &types.EntityNotExistsError{Message: fmt.Sprintf("history node %v with transaction ID %v not found", request.NodeID, request.TransactionID)}
+	}
+	return nil
+}
+
 // ForkHistoryBranch forks a new branch from an existing branch
 // Note that application must provide a void forking nodeID, it must be a valid nodeID in that branch.
 // A valid forking nodeID can be an ancestor from the existing branch.
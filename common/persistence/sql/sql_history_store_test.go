@@ -884,6 +884,7 @@ func TestReadHistoryBranch(t *testing.T) {
 			},
 			want: &persistence.InternalReadHistoryBranchResponse{
 				History:           []*persistence.DataBlob{{Data: []byte(`b`), Encoding: constants.EncodingType("b")}},
+				Nodes:             []persistence.InternalHistoryNode{{NodeID: 202, TransactionID: 101}},
 				NextPageToken:     serializePageToken(202),
 				LastNodeID:        202,
 				LastTransactionID: 101,
@@ -1056,6 +1057,82 @@ func TestReadHistoryBranch(t *testing.T) {
 	}
 }
 
+func TestUpdateHistoryNode(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mockSetup func(*sqlplugin.MockDB)
+		wantErr   bool
+		assertErr func(*testing.T, error)
+	}{
+		{
+			name: "Success case",
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().UpdateHistoryNode(gomock.Any(), &sqlplugin.HistoryNodeRow{
+					TreeID:       serialization.MustParseUUID("530ec3d3-f74b-423f-a138-3b35494fe691"),
+					BranchID:     serialization.MustParseUUID("630ec3d3-f74b-423f-a138-3b35494fe691"),
+					NodeID:       11,
+					TxnID:        common.Int64Ptr(100),
+					Data:         []byte(`a`),
+					DataEncoding: "a",
+					ShardID:      1,
+				}).Return(&sqlResult{rowsAffected: 1}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error case - node not found",
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().UpdateHistoryNode(gomock.Any(), gomock.Any()).Return(&sqlResult{rowsAffected: 0}, nil)
+			},
+			wantErr: true,
+			assertErr: func(t *testing.T, err error) {
+				var notExistsErr *types.EntityNotExistsError
+				assert.ErrorAs(t, err, &notExistsErr)
+			},
+		},
+		{
+			name: "Error case - failed to update",
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				err := errors.New("some error")
+				mockDB.EXPECT().UpdateHistoryNode(gomock.Any(), gomock.Any()).Return(nil, err)
+				mockDB.EXPECT().IsNotFoundError(err).Return(true)
+			},
+			wantErr: true,
+			assertErr: func(t *testing.T, err error) {
+				assert.IsType(t, &types.EntityNotExistsError{}, err)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := sqlplugin.NewMockDB(ctrl)
+			store, err := NewHistoryV2Persistence(mockDB, nil, nil, nil)
+			require.NoError(t, err, "Failed to create sql history store")
+
+			tc.mockSetup(mockDB)
+			err = store.UpdateHistoryNode(context.Background(), &persistence.InternalUpdateHistoryNodeRequest{
+				TreeID:        "530ec3d3-f74b-423f-a138-3b35494fe691",
+				BranchID:      "630ec3d3-f74b-423f-a138-3b35494fe691",
+				NodeID:        11,
+				TransactionID: 100,
+				Events:        &persistence.DataBlob{Data: []byte(`a`), Encoding: constants.EncodingType("a")},
+				ShardID:       1,
+			})
+			if tc.wantErr {
+				assert.Error(t, err, "Expected an error for test case")
+				if tc.assertErr != nil {
+					tc.assertErr(t, err)
+				}
+			} else {
+				assert.NoError(t, err, "Did not expect an error for test case")
+			}
+		})
+	}
+}
+
 func TestDeleteHistoryBranch_CustomBatchSize(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -198,6 +198,7 @@ func (m *sqlTaskStore) LeaseTaskList(
 			Kind:                    request.TaskListKind,
 			LastUpdated:             now,
 			AdaptivePartitionConfig: fromSerializationTaskListPartitionConfig(tlInfo.AdaptivePartitionConfig),
+			CompatibleBuildIDSets:   tlInfo.CompatibleBuildIDSets,
 		}}
 		return nil
 	})
@@ -235,6 +236,7 @@ func (m *sqlTaskStore) GetTaskList(
 			Expiry:                  tlInfo.ExpiryTimestamp,
 			LastUpdated:             tlInfo.LastUpdated,
 			AdaptivePartitionConfig: fromSerializationTaskListPartitionConfig(tlInfo.AdaptivePartitionConfig),
+			CompatibleBuildIDSets:   tlInfo.CompatibleBuildIDSets,
 		},
 	}, nil
 }
@@ -252,6 +254,7 @@ func (m *sqlTaskStore) UpdateTaskList(
 		ExpiryTimestamp:         time.Unix(0, 0),
 		LastUpdated:             now,
 		AdaptivePartitionConfig: toSerializationTaskListPartitionConfig(request.TaskListInfo.AdaptivePartitionConfig),
+		CompatibleBuildIDSets:   request.TaskListInfo.CompatibleBuildIDSets,
 	}
 	if persistence.TaskListKindHasTTL(request.TaskListInfo.Kind) {
 		tlInfo.ExpiryTimestamp = now.Add(taskListTTL)
@@ -446,6 +449,9 @@ func (m *sqlTaskStore) CreateTasks(
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: request.CurrentTimeStamp,
 			PartitionConfig:  v.Data.PartitionConfig,
+			Priority:         v.Data.Priority,
+			WorkerBuildID:    v.Data.WorkerBuildID,
+			FairnessKey:      v.Data.FairnessKey,
 		})
 		if err != nil {
 			return nil, err
@@ -533,6 +539,9 @@ func (m *sqlTaskStore) GetTasks(
 			Expiry:          info.GetExpiryTimestamp(),
 			CreatedTime:     info.GetCreatedTimestamp(),
 			PartitionConfig: info.GetPartitionConfig(),
+			Priority:        info.GetPriority(),
+			WorkerBuildID:   info.GetWorkerBuildID(),
+			FairnessKey:     info.GetFairnessKey(),
 		}
 	}
 
@@ -1738,7 +1738,7 @@ func TestHelp(t *testing.T) {
 	dc := &persistence.DynamicConfiguration{
 		SerializationEncoding: dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
 	}
-	parser, err := serialization.NewParser(dc)
+	parser, err := serialization.NewParser(dc, persistence.BlobCodecs{})
 	require.NoError(t, err)
 	data, err := base64.StdEncoding.DecodeString("BgAKAAAKAAwAAAAABGGFYAoADgAAAAAAAAAACgAQGB6uGPaVqOUMABIKAAoAAAAAAAAAAQgADAAAAAIIAA4AAAACAAA=")
 	require.NoError(t, err)
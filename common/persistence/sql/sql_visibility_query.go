@@ -0,0 +1,549 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// visibilityQueryMissingValue is the special value used to query for absent fields, e.g. CloseTime = missing
+	visibilityQueryMissingValue = "missing"
+
+	defaultVisibilityQueryOrderBy = "v.start_time DESC, v.run_id"
+	scanVisibilityQueryOrderBy    = "v.run_id"
+
+	searchAttributeExistsTemplate = "EXISTS (SELECT 1 FROM executions_visibility_search_attributes sa " +
+		"WHERE sa.domain_id = v.domain_id AND sa.run_id = v.run_id AND sa.attr_key = ?%s)"
+)
+
+type (
+	// visibilityQuery is a visibility query translated into a condition and an ordering
+	// over the executions_visibility table, which is aliased as v
+	visibilityQuery struct {
+		// condition uses ? placeholders for args and is empty when the query has no where clause
+		condition string
+		args      []interface{}
+		orderBy   string
+	}
+
+	// visibilityColumn is a system search attribute stored as a column of executions_visibility
+	visibilityColumn struct {
+		name      string
+		valueType types.IndexedValueType
+		// parseValue overrides the parsing of query values for enum columns
+		parseValue func(string) (interface{}, error)
+	}
+
+	visibilityQueryConverter struct {
+		validSearchAttributes map[string]interface{}
+		logger                log.Logger
+		args                  []interface{}
+	}
+)
+
+// visibilityColumns maps system search attributes to executions_visibility columns.
+// All other search attributes are looked up in the executions_visibility_search_attributes table.
+var visibilityColumns = map[string]visibilityColumn{
+	definition.DomainID:               {name: "domain_id", valueType: types.IndexedValueTypeKeyword},
+	definition.WorkflowID:             {name: "workflow_id", valueType: types.IndexedValueTypeKeyword},
+	definition.RunID:                  {name: "run_id", valueType: types.IndexedValueTypeKeyword},
+	definition.WorkflowType:           {name: "workflow_type_name", valueType: types.IndexedValueTypeKeyword},
+	definition.StartTime:              {name: "start_time", valueType: types.IndexedValueTypeDatetime},
+	definition.ExecutionTime:          {name: "execution_time", valueType: types.IndexedValueTypeDatetime},
+	definition.CloseTime:              {name: "close_time", valueType: types.IndexedValueTypeDatetime},
+	definition.CloseStatus:            {name: "close_status", valueType: types.IndexedValueTypeInt, parseValue: parseCloseStatusValue},
+	definition.HistoryLength:          {name: "history_length", valueType: types.IndexedValueTypeInt},
+	definition.IsCron:                 {name: "is_cron", valueType: types.IndexedValueTypeBool},
+	definition.NumClusters:            {name: "num_clusters", valueType: types.IndexedValueTypeInt},
+	definition.UpdateTime:             {name: "update_time", valueType: types.IndexedValueTypeDatetime},
+	definition.CronSchedule:           {name: "cron_schedule", valueType: types.IndexedValueTypeKeyword},
+	definition.ExecutionStatus:        {name: "execution_status", valueType: types.IndexedValueTypeInt, parseValue: parseExecutionStatusValue},
+	definition.ScheduledExecutionTime: {name: "scheduled_execution_time", valueType: types.IndexedValueTypeDatetime},
+}
+
+// convertVisibilityQuery translates a visibility query, e.g.
+// "WorkflowType = 'foo' AND CustomIntField > 10 ORDER BY StartTime DESC",
+// into a SQL condition over executions_visibility.
+func convertVisibilityQuery(
+	query string,
+	validSearchAttributes map[string]interface{},
+	logger log.Logger,
+) (*visibilityQuery, error) {
+	result := &visibilityQuery{orderBy: defaultVisibilityQueryOrderBy}
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return result, nil
+	}
+
+	// IMPORTANT: this query is never executed, it is only used to parse the where and order by clauses
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, &types.BadRequestError{Message: "Invalid select query."}
+	}
+
+	c := &visibilityQueryConverter{
+		validSearchAttributes: validSearchAttributes,
+		logger:                logger,
+	}
+	if sel.Where != nil {
+		result.condition, err = c.convertWhereExpr(sel.Where.Expr)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+		result.args = c.args
+	}
+	if len(sel.OrderBy) > 0 {
+		result.orderBy, err = c.convertOrderBy(sel.OrderBy)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	return result, nil
+}
+
+func (c *visibilityQueryConverter) convertWhereExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinaryExpr("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return c.convertBinaryExpr("OR", expr.Left, expr.Right)
+	case *sqlparser.ParenExpr:
+		inner, err := c.convertWhereExpr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "(" + inner + ")", nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return "", errors.New("invalid where clause")
+	}
+}
+
+func (c *visibilityQueryConverter) convertBinaryExpr(operator string, left, right sqlparser.Expr) (string, error) {
+	leftStr, err := c.convertWhereExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightStr, err := c.convertWhereExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftStr, operator, rightStr), nil
+}
+
+func (c *visibilityQueryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	key, err := c.searchAttributeKey(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr, sqlparser.InStr, sqlparser.NotInStr,
+		sqlparser.LikeStr, sqlparser.NotLikeStr:
+	default:
+		return "", fmt.Errorf("operator %q is not supported", expr.Operator)
+	}
+
+	if isMissingValue(expr.Right) {
+		return c.convertMissingComparison(key, expr.Operator)
+	}
+
+	if column, ok := visibilityColumns[key]; ok {
+		return c.convertColumnComparison(column, expr.Operator, expr.Right)
+	}
+	return c.convertSearchAttributeComparison(key, expr.Operator, expr.Right)
+}
+
+func (c *visibilityQueryConverter) convertMissingComparison(key string, operator string) (string, error) {
+	if operator != sqlparser.EqualStr && operator != sqlparser.NotEqualStr {
+		return "", fmt.Errorf("operator %q is not supported for %s value", operator, visibilityQueryMissingValue)
+	}
+	if column, ok := visibilityColumns[key]; ok {
+		if operator == sqlparser.EqualStr {
+			return fmt.Sprintf("v.%s IS NULL", column.name), nil
+		}
+		return fmt.Sprintf("v.%s IS NOT NULL", column.name), nil
+	}
+
+	if _, err := c.searchAttributeType(key); err != nil {
+		return "", err
+	}
+	c.args = append(c.args, key)
+	condition := fmt.Sprintf(searchAttributeExistsTemplate, "")
+	if operator == sqlparser.EqualStr {
+		return "NOT " + condition, nil
+	}
+	return condition, nil
+}
+
+func (c *visibilityQueryConverter) convertColumnComparison(column visibilityColumn, operator string, right sqlparser.Expr) (string, error) {
+	columnName := "v." + column.name
+	switch operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		values, err := c.parseTupleValues(right, column.valueType, column.parseValue)
+		if err != nil {
+			return "", err
+		}
+		c.args = append(c.args, values...)
+		return fmt.Sprintf("%s %s (%s)", columnName, strings.ToUpper(operator), placeholders(len(values))), nil
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		if column.valueType != types.IndexedValueTypeKeyword {
+			return "", fmt.Errorf("operator %q is not supported for %s", operator, column.name)
+		}
+		value, err := c.parseValue(right, column.valueType, column.parseValue)
+		if err != nil {
+			return "", err
+		}
+		c.args = append(c.args, value)
+		return fmt.Sprintf("%s %s ?", columnName, strings.ToUpper(operator)), nil
+	default:
+		value, err := c.parseValue(right, column.valueType, column.parseValue)
+		if err != nil {
+			return "", err
+		}
+		c.args = append(c.args, value)
+		return fmt.Sprintf("%s %s ?", columnName, operator), nil
+	}
+}
+
+// convertSearchAttributeComparison matches executions where any value of the search attribute satisfies the comparison.
+// Negated operators match executions where no value satisfies the positive comparison, same as Elasticsearch's must_not.
+func (c *visibilityQueryConverter) convertSearchAttributeComparison(key string, operator string, right sqlparser.Expr) (string, error) {
+	valueType, err := c.searchAttributeType(key)
+	if err != nil {
+		return "", err
+	}
+	valueColumn := "sa." + searchAttributeValueColumn(valueType)
+
+	negate := false
+	switch operator {
+	case sqlparser.NotEqualStr:
+		negate, operator = true, sqlparser.EqualStr
+	case sqlparser.NotInStr:
+		negate, operator = true, sqlparser.InStr
+	case sqlparser.NotLikeStr:
+		negate, operator = true, sqlparser.LikeStr
+	}
+
+	c.args = append(c.args, key)
+	var valueCondition string
+	switch {
+	case operator == sqlparser.InStr:
+		values, err := c.parseTupleValues(right, valueType, nil)
+		if err != nil {
+			return "", err
+		}
+		for _, value := range values {
+			c.args = append(c.args, searchAttributeValueArg(value))
+		}
+		valueCondition = fmt.Sprintf("%s IN (%s)", valueColumn, placeholders(len(values)))
+	case operator == sqlparser.LikeStr:
+		if valueType != types.IndexedValueTypeString && valueType != types.IndexedValueTypeKeyword {
+			return "", fmt.Errorf("operator %q is not supported for %s", operator, key)
+		}
+		value, err := c.parseValue(right, valueType, nil)
+		if err != nil {
+			return "", err
+		}
+		c.args = append(c.args, value)
+		valueCondition = valueColumn + " LIKE ?"
+	case operator == sqlparser.EqualStr && valueType == types.IndexedValueTypeString:
+		// String attributes are full text fields in Elasticsearch, the closest SQL equivalent is a substring match
+		value, err := c.parseValue(right, valueType, nil)
+		if err != nil {
+			return "", err
+		}
+		c.args = append(c.args, "%"+escapeLikePattern(value.(string))+"%")
+		valueCondition = valueColumn + " LIKE ? ESCAPE '!'"
+	default:
+		value, err := c.parseValue(right, valueType, nil)
+		if err != nil {
+			return "", err
+		}
+		c.args = append(c.args, searchAttributeValueArg(value))
+		valueCondition = fmt.Sprintf("%s %s ?", valueColumn, operator)
+	}
+
+	condition := fmt.Sprintf(searchAttributeExistsTemplate, " AND "+valueCondition)
+	if negate {
+		return "NOT " + condition, nil
+	}
+	return condition, nil
+}
+
+func (c *visibilityQueryConverter) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	key, err := c.searchAttributeKey(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	if expr.Operator != sqlparser.BetweenStr && expr.Operator != sqlparser.NotBetweenStr {
+		return "", fmt.Errorf("operator %q is not supported", expr.Operator)
+	}
+
+	if column, ok := visibilityColumns[key]; ok {
+		from, err := c.parseValue(expr.From, column.valueType, column.parseValue)
+		if err != nil {
+			return "", err
+		}
+		to, err := c.parseValue(expr.To, column.valueType, column.parseValue)
+		if err != nil {
+			return "", err
+		}
+		c.args = append(c.args, from, to)
+		return fmt.Sprintf("v.%s %s ? AND ?", column.name, strings.ToUpper(expr.Operator)), nil
+	}
+
+	valueType, err := c.searchAttributeType(key)
+	if err != nil {
+		return "", err
+	}
+	from, err := c.parseValue(expr.From, valueType, nil)
+	if err != nil {
+		return "", err
+	}
+	to, err := c.parseValue(expr.To, valueType, nil)
+	if err != nil {
+		return "", err
+	}
+	c.args = append(c.args, key, searchAttributeValueArg(from), searchAttributeValueArg(to))
+	condition := fmt.Sprintf(searchAttributeExistsTemplate, fmt.Sprintf(" AND sa.%s BETWEEN ? AND ?", searchAttributeValueColumn(valueType)))
+	if expr.Operator == sqlparser.NotBetweenStr {
+		return "NOT " + condition, nil
+	}
+	return condition, nil
+}
+
+func (c *visibilityQueryConverter) convertOrderBy(orderBy sqlparser.OrderBy) (string, error) {
+	var fields []string
+	hasRunID := false
+	for _, order := range orderBy {
+		key, err := c.searchAttributeKey(order.Expr)
+		if err != nil {
+			return "", errors.New("invalid order by expression")
+		}
+		column, ok := visibilityColumns[key]
+		if !ok {
+			return "", fmt.Errorf("order by %q is not supported, only system search attributes can be sorted on", key)
+		}
+		hasRunID = hasRunID || key == definition.RunID
+		fields = append(fields, fmt.Sprintf("v.%s %s", column.name, strings.ToUpper(order.Direction)))
+	}
+	if !hasRunID {
+		// tie breaker to keep the pagination stable
+		fields = append(fields, "v.run_id")
+	}
+	return strings.Join(fields, ", "), nil
+}
+
+// searchAttributeKey returns the search attribute name referred to by expr.
+// The frontend prefixes custom search attributes with "Attr.", which is removed here.
+func (c *visibilityQueryConverter) searchAttributeKey(expr sqlparser.Expr) (string, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", errors.New("invalid search attribute expression")
+	}
+	key := colName.Name.String()
+	if colName.Qualifier.Name.String() == definition.Attr {
+		return key, nil
+	}
+	if !colName.Qualifier.IsEmpty() {
+		return "", fmt.Errorf("invalid search attribute %q", colName.Qualifier.Name.String()+"."+key)
+	}
+	return strings.TrimPrefix(key, definition.Attr+"."), nil
+}
+
+func (c *visibilityQueryConverter) searchAttributeType(key string) (types.IndexedValueType, error) {
+	fieldType, ok := c.validSearchAttributes[key]
+	if !ok {
+		return 0, fmt.Errorf("invalid search attribute %q", key)
+	}
+	return common.ConvertIndexedValueTypeToInternalType(fieldType, c.logger), nil
+}
+
+func (c *visibilityQueryConverter) parseTupleValues(
+	expr sqlparser.Expr,
+	valueType types.IndexedValueType,
+	parseValue func(string) (interface{}, error),
+) ([]interface{}, error) {
+	tuple, ok := expr.(sqlparser.ValTuple)
+	if !ok || len(tuple) == 0 {
+		return nil, errors.New("invalid IN expression, value must be a non-empty list")
+	}
+	values := make([]interface{}, len(tuple))
+	for i, item := range tuple {
+		value, err := c.parseValue(item, valueType, parseValue)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func (c *visibilityQueryConverter) parseValue(
+	expr sqlparser.Expr,
+	valueType types.IndexedValueType,
+	parseValue func(string) (interface{}, error),
+) (interface{}, error) {
+	var raw string
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal, sqlparser.IntVal, sqlparser.FloatVal:
+			raw = string(expr.Val)
+		default:
+			return nil, fmt.Errorf("invalid value %q", string(expr.Val))
+		}
+	case sqlparser.BoolVal:
+		raw = strconv.FormatBool(bool(expr))
+	default:
+		return nil, fmt.Errorf("invalid value %q", sqlparser.String(expr))
+	}
+
+	if parseValue != nil {
+		return parseValue(raw)
+	}
+	switch valueType {
+	case types.IndexedValueTypeString, types.IndexedValueTypeKeyword:
+		return raw, nil
+	case types.IndexedValueTypeInt:
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int value %q", raw)
+		}
+		return value, nil
+	case types.IndexedValueTypeDouble:
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid double value %q", raw)
+		}
+		return value, nil
+	case types.IndexedValueTypeBool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bool value %q", raw)
+		}
+		return value, nil
+	case types.IndexedValueTypeDatetime:
+		return parseDatetimeValue(raw)
+	default:
+		return nil, fmt.Errorf("unknown search attribute type %v", valueType)
+	}
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.EqualString(visibilityQueryMissingValue)
+}
+
+// parseDatetimeValue accepts either RFC3339 timestamps or unix nanoseconds, the same as Elasticsearch visibility
+func parseDatetimeValue(raw string) (interface{}, error) {
+	if t, err := time.Parse(time.RFC3339Nano, raw); err == nil {
+		return t.UTC(), nil
+	}
+	nanos, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid datetime value %q", raw)
+	}
+	return time.Unix(0, nanos).UTC(), nil
+}
+
+// parseCloseStatusValue accepts either the close status name or its numeric value
+func parseCloseStatusValue(raw string) (interface{}, error) {
+	if status, err := strconv.ParseInt(raw, 10, 32); err == nil {
+		return int32(status), nil
+	}
+	var status types.WorkflowExecutionCloseStatus
+	if err := status.UnmarshalText([]byte(raw)); err != nil {
+		return nil, fmt.Errorf("invalid close status %q", raw)
+	}
+	return int32(status), nil
+}
+
+// parseExecutionStatusValue accepts either the execution status name or its numeric value
+func parseExecutionStatusValue(raw string) (interface{}, error) {
+	if status, err := strconv.ParseInt(raw, 10, 32); err == nil {
+		return int32(status), nil
+	}
+	var status types.WorkflowExecutionStatus
+	if err := status.UnmarshalText([]byte(raw)); err != nil {
+		return nil, fmt.Errorf("invalid execution status %q", raw)
+	}
+	return int32(status), nil
+}
+
+// searchAttributeValueColumn returns the executions_visibility_search_attributes column holding values of the given type
+func searchAttributeValueColumn(valueType types.IndexedValueType) string {
+	switch valueType {
+	case types.IndexedValueTypeInt, types.IndexedValueTypeBool:
+		return "int_value"
+	case types.IndexedValueTypeDouble:
+		return "double_value"
+	case types.IndexedValueTypeDatetime:
+		return "datetime_value"
+	default:
+		return "string_value"
+	}
+}
+
+// searchAttributeValueArg converts bool values to the 0/1 stored in int_value
+func searchAttributeValueArg(value interface{}) interface{} {
+	if b, ok := value.(bool); ok {
+		if b {
+			return int64(1)
+		}
+		return int64(0)
+	}
+	return value
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestConvertVisibilityQuery(t *testing.T) {
+	customExists := func(condition string) string {
+		return "EXISTS (SELECT 1 FROM executions_visibility_search_attributes sa WHERE sa.domain_id = v.domain_id AND sa.run_id = v.run_id AND sa.attr_key = ?" + condition + ")"
+	}
+	tests := map[string]struct {
+		query     string
+		condition string
+		args      []interface{}
+		orderBy   string
+		wantErr   bool
+	}{
+		"empty query": {
+			query:   "",
+			orderBy: defaultVisibilityQueryOrderBy,
+		},
+		"system attributes": {
+			query:     "WorkflowID = 'wid' AND CloseTime = missing",
+			condition: "(v.workflow_id = ? AND v.close_time IS NULL)",
+			args:      []interface{}{"wid"},
+			orderBy:   defaultVisibilityQueryOrderBy,
+		},
+		"custom attributes": {
+			query:     "CustomIntField > 10 OR CustomKeywordField != 'foo'",
+			condition: "(" + customExists(" AND sa.int_value > ?") + " OR NOT " + customExists(" AND sa.string_value = ?") + ")",
+			args:      []interface{}{"CustomIntField", int64(10), "CustomKeywordField", "foo"},
+			orderBy:   defaultVisibilityQueryOrderBy,
+		},
+		"string attribute is matched as substring": {
+			query:     "CustomStringField = 'a%b'",
+			condition: customExists(" AND sa.string_value LIKE ? ESCAPE '!'"),
+			args:      []interface{}{"CustomStringField", "%a!%b%"},
+			orderBy:   defaultVisibilityQueryOrderBy,
+		},
+		"bool attribute": {
+			query:     "CustomBoolField = true",
+			condition: customExists(" AND sa.int_value = ?"),
+			args:      []interface{}{"CustomBoolField", int64(1)},
+			orderBy:   defaultVisibilityQueryOrderBy,
+		},
+		"in list": {
+			query:     "CustomKeywordField in ('a', 'b')",
+			condition: customExists(" AND sa.string_value IN (?, ?)"),
+			args:      []interface{}{"CustomKeywordField", "a", "b"},
+			orderBy:   defaultVisibilityQueryOrderBy,
+		},
+		"close status name and order by": {
+			query:     "CloseStatus = 'FAILED' ORDER BY CloseTime DESC",
+			condition: "v.close_status = ?",
+			args:      []interface{}{int32(types.WorkflowExecutionCloseStatusFailed)},
+			orderBy:   "v.close_time DESC, v.run_id",
+		},
+		"between datetimes": {
+			query:     "StartTime BETWEEN 1 AND 2",
+			condition: "v.start_time BETWEEN ? AND ?",
+			args:      []interface{}{time.Unix(0, 1).UTC(), time.Unix(0, 2).UTC()},
+			orderBy:   defaultVisibilityQueryOrderBy,
+		},
+		"only order by": {
+			query:   "ORDER BY StartTime",
+			orderBy: "v.start_time ASC, v.run_id",
+		},
+		"unknown attribute": {
+			query:   "UnknownField = 1",
+			wantErr: true,
+		},
+		"order by custom attribute": {
+			query:   "ORDER BY CustomIntField",
+			wantErr: true,
+		},
+		"syntax error": {
+			query:   "WorkflowID = ",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := convertVisibilityQuery(tc.query, definition.GetDefaultIndexedKeys(), log.NewNoop())
+			if tc.wantErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.condition, result.condition)
+			assert.Equal(t, tc.args, result.args)
+			assert.Equal(t, tc.orderBy, result.orderBy)
+		})
+	}
+}
@@ -25,13 +25,16 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -47,10 +50,15 @@ type (
 		Time  time.Time
 		RunID string
 	}
+
+	// visibilityQueryPageToken is the page token of query based listing, which pages by offset
+	visibilityQueryPageToken struct {
+		Offset int
+	}
 )
 
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger, dc *p.DynamicConfiguration) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -59,6 +67,7 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 		sqlStore: sqlStore{
 			db:     db,
 			logger: logger,
+			dc:     dc,
 		},
 	}, nil
 }
@@ -67,28 +76,39 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest,
 ) error {
-	_, err := s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
-		DomainID:               request.DomainUUID,
-		WorkflowID:             request.WorkflowID,
-		RunID:                  request.RunID,
-		StartTime:              request.StartTimestamp,
-		ExecutionTime:          request.ExecutionTimestamp,
-		WorkflowTypeName:       request.WorkflowTypeName,
-		Memo:                   request.Memo.Data,
-		Encoding:               string(request.Memo.GetEncoding()),
-		IsCron:                 request.IsCron,
-		CronSchedule:           request.CronSchedule,
-		NumClusters:            request.NumClusters,
-		UpdateTime:             request.UpdateTimestamp,
-		ShardID:                request.ShardID,
-		ExecutionStatus:        int32(request.ExecutionStatus),
-		ScheduledExecutionTime: request.ScheduledExecutionTime,
+	searchAttributes := s.searchAttributesToRows(request.DomainUUID, request.RunID, request.SearchAttributes)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(request.DomainUUID, s.db.GetTotalNumDBShards())
+	return s.txExecute(ctx, dbShardID, "RecordWorkflowExecutionStarted", func(tx sqlplugin.Tx) error {
+		result, err := tx.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+			DomainID:               request.DomainUUID,
+			WorkflowID:             request.WorkflowID,
+			RunID:                  request.RunID,
+			StartTime:              request.StartTimestamp,
+			ExecutionTime:          request.ExecutionTimestamp,
+			WorkflowTypeName:       request.WorkflowTypeName,
+			Memo:                   request.Memo.Data,
+			Encoding:               string(request.Memo.GetEncoding()),
+			IsCron:                 request.IsCron,
+			CronSchedule:           request.CronSchedule,
+			NumClusters:            request.NumClusters,
+			UpdateTime:             request.UpdateTimestamp,
+			ShardID:                request.ShardID,
+			ExecutionStatus:        int32(request.ExecutionStatus),
+			ScheduledExecutionTime: request.ScheduledExecutionTime,
+		})
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			// the execution is already recorded, possibly as closed, so its search attributes must not be overwritten
+			return nil
+		}
+		return replaceSearchAttributes(ctx, tx, request.DomainUUID, request.RunID, searchAttributes)
 	})
-
-	if err != nil {
-		return convertCommonErrors(s.db, "RecordWorkflowExecutionStarted", "", err)
-	}
-	return nil
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
@@ -114,41 +134,45 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 		executionStatus = types.WorkflowExecutionStatusTimedOut
 	}
 
-	result, err := s.db.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
-		DomainID:               request.DomainUUID,
-		WorkflowID:             request.WorkflowID,
-		RunID:                  request.RunID,
-		StartTime:              request.StartTimestamp,
-		ExecutionTime:          request.ExecutionTimestamp,
-		WorkflowTypeName:       request.WorkflowTypeName,
-		CloseTime:              &closeTime,
-		CloseStatus:            common.Int32Ptr(int32(*thrift.FromWorkflowExecutionCloseStatus(&request.Status))),
-		HistoryLength:          &request.HistoryLength,
-		Memo:                   request.Memo.Data,
-		Encoding:               string(request.Memo.GetEncoding()),
-		IsCron:                 request.IsCron,
-		CronSchedule:           request.CronSchedule,
-		NumClusters:            request.NumClusters,
-		UpdateTime:             request.UpdateTimestamp,
-		ShardID:                request.ShardID,
-		ExecutionStatus:        int32(executionStatus),
-		ScheduledExecutionTime: request.ScheduledExecutionTime,
-	})
-	if err != nil {
-		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
-	}
-	noRowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return &types.InternalServiceError{
-			Message: fmt.Sprintf("RecordWorkflowExecutionClosed rowsAffected error: %v", err),
+	searchAttributes := s.searchAttributesToRows(request.DomainUUID, request.RunID, request.SearchAttributes)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(request.DomainUUID, s.db.GetTotalNumDBShards())
+	return s.txExecute(ctx, dbShardID, "RecordWorkflowExecutionClosed", func(tx sqlplugin.Tx) error {
+		result, err := tx.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+			DomainID:               request.DomainUUID,
+			WorkflowID:             request.WorkflowID,
+			RunID:                  request.RunID,
+			StartTime:              request.StartTimestamp,
+			ExecutionTime:          request.ExecutionTimestamp,
+			WorkflowTypeName:       request.WorkflowTypeName,
+			CloseTime:              &closeTime,
+			CloseStatus:            common.Int32Ptr(int32(*thrift.FromWorkflowExecutionCloseStatus(&request.Status))),
+			HistoryLength:          &request.HistoryLength,
+			Memo:                   request.Memo.Data,
+			Encoding:               string(request.Memo.GetEncoding()),
+			IsCron:                 request.IsCron,
+			CronSchedule:           request.CronSchedule,
+			NumClusters:            request.NumClusters,
+			UpdateTime:             request.UpdateTimestamp,
+			ShardID:                request.ShardID,
+			ExecutionStatus:        int32(executionStatus),
+			ScheduledExecutionTime: request.ScheduledExecutionTime,
+		})
+		if err != nil {
+			return err
 		}
-	}
-	if noRowsAffected > 2 { // either adds a new row or deletes old row and adds new row
-		return &types.InternalServiceError{
-			Message: fmt.Sprintf("RecordWorkflowExecutionClosed unexpected numRows (%v) updated", noRowsAffected),
+		noRowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return &types.InternalServiceError{
+				Message: fmt.Sprintf("RecordWorkflowExecutionClosed rowsAffected error: %v", err),
+			}
 		}
-	}
-	return nil
+		if noRowsAffected > 2 { // either adds a new row or deletes old row and adds new row
+			return &types.InternalServiceError{
+				Message: fmt.Sprintf("RecordWorkflowExecutionClosed unexpected numRows (%v) updated", noRowsAffected),
+			}
+		}
+		return replaceSearchAttributes(ctx, tx, request.DomainUUID, request.RunID, searchAttributes)
+	})
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionUninitialized(
@@ -160,13 +184,24 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionUninitialized(
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(
-	_ context.Context,
+	ctx context.Context,
 	request *p.InternalUpsertWorkflowExecutionRequest,
 ) error {
-	if p.IsNopUpsertWorkflowRequest(request) {
-		return nil
-	}
-	return p.ErrVisibilityOperationNotSupported
+	searchAttributes := s.searchAttributesToRows(request.DomainUUID, request.RunID, request.SearchAttributes)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(request.DomainUUID, s.db.GetTotalNumDBShards())
+	return s.txExecute(ctx, dbShardID, "UpsertWorkflowExecution", func(tx sqlplugin.Tx) error {
+		_, err := tx.UpdateVisibilityMemo(ctx, &sqlplugin.VisibilityRow{
+			DomainID:   request.DomainUUID,
+			RunID:      request.RunID,
+			Memo:       request.Memo.GetData(),
+			Encoding:   string(request.Memo.GetEncoding()),
+			UpdateTime: request.UpdateTimestamp,
+		})
+		if err != nil {
+			return err
+		}
+		return replaceSearchAttributes(ctx, tx, request.DomainUUID, request.RunID, searchAttributes)
+	})
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -319,14 +354,21 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *p.VisibilityDeleteWorkflowExecutionRequest,
 ) error {
-	_, err := s.db.DeleteFromVisibility(ctx, &sqlplugin.VisibilityFilter{
-		DomainID: request.DomainID,
-		RunID:    &request.RunID,
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(request.DomainID, s.db.GetTotalNumDBShards())
+	return s.txExecute(ctx, dbShardID, "DeleteWorkflowExecution", func(tx sqlplugin.Tx) error {
+		_, err := tx.DeleteFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+			DomainID: request.DomainID,
+			RunID:    &request.RunID,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tx.DeleteFromVisibilitySearchAttributes(ctx, &sqlplugin.VisibilitySearchAttributeFilter{
+			DomainID: request.DomainID,
+			RunIDs:   []string{request.RunID},
+		})
+		return err
 	})
-	if err != nil {
-		return convertCommonErrors(s.db, "DeleteWorkflowExecution", "", err)
-	}
-	return nil
 }
 
 func (s *sqlVisibilityStore) DeleteUninitializedWorkflowExecution(
@@ -338,24 +380,45 @@ func (s *sqlVisibilityStore) DeleteUninitializedWorkflowExecution(
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	query, err := convertVisibilityQuery(request.Query, s.validSearchAttributes(), s.logger)
+	if err != nil {
+		return nil, err
+	}
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request, query.condition, query.args, query.orderBy)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	query, err := convertVisibilityQuery(request.Query, s.validSearchAttributes(), s.logger)
+	if err != nil {
+		return nil, err
+	}
+	// scan ignores the order by clause of the query, same as Elasticsearch
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request, query.condition, query.args, scanVisibilityQueryOrderBy)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *p.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	query, err := convertVisibilityQuery(request.Query, s.validSearchAttributes(), s.logger)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.CountFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: query.condition,
+		Args:      query.args,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -437,3 +500,220 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+	condition string,
+	args []interface{},
+	orderBy string,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	var token visibilityQueryPageToken
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+	}
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: condition,
+		Args:      args,
+		OrderBy:   orderBy,
+		Offset:    token.Offset,
+		PageSize:  request.PageSize,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+	if len(rows) == 0 {
+		return &p.InternalListWorkflowExecutionsResponse{}, nil
+	}
+
+	runIDs := make([]string, len(rows))
+	for i := range rows {
+		runIDs[i] = rows[i].RunID
+	}
+	searchAttributeRows, err := s.db.SelectFromVisibilitySearchAttributes(ctx, &sqlplugin.VisibilitySearchAttributeFilter{
+		DomainID: request.DomainUUID,
+		RunIDs:   runIDs,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+	searchAttributes := s.rowsToSearchAttributes(searchAttributeRows)
+
+	infos := make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i] = s.rowToInfo(&row)
+		infos[i].DomainID = request.DomainUUID
+		infos[i].SearchAttributes = searchAttributes[row.RunID]
+	}
+	var nextPageToken []byte
+	if len(rows) == request.PageSize {
+		nextPageToken, err = json.Marshal(&visibilityQueryPageToken{Offset: token.Offset + len(rows)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *sqlVisibilityStore) validSearchAttributes() map[string]interface{} {
+	if s.dc == nil || s.dc.ValidSearchAttributes == nil {
+		return definition.GetDefaultIndexedKeys()
+	}
+	return s.dc.ValidSearchAttributes()
+}
+
+// searchAttributesToRows converts json encoded search attributes to executions_visibility_search_attributes rows.
+// Attributes which are unknown or can't be decoded are skipped, as they have been validated by the frontend already.
+func (s *sqlVisibilityStore) searchAttributesToRows(
+	domainID string,
+	runID string,
+	searchAttributes map[string][]byte,
+) []sqlplugin.VisibilitySearchAttributeRow {
+	validSearchAttributes := s.validSearchAttributes()
+	keys := make([]string, 0, len(searchAttributes))
+	for key := range searchAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var rows []sqlplugin.VisibilitySearchAttributeRow
+	for _, key := range keys {
+		fieldType, ok := validSearchAttributes[key]
+		if !ok {
+			s.logger.Warn("Skip unknown search attribute", tag.Key(key))
+			continue
+		}
+		valueType := common.ConvertIndexedValueTypeToInternalType(fieldType, s.logger)
+		value, err := common.DeserializeSearchAttributeValue(searchAttributes[key], valueType)
+		if err != nil {
+			s.logger.Warn("Skip invalid search attribute value", tag.Key(key), tag.Error(err))
+			continue
+		}
+
+		values, isArray := searchAttributeArrayValues(value)
+		if !isArray {
+			rows = append(rows, newSearchAttributeRow(domainID, runID, key, nil, value))
+			continue
+		}
+		for i, v := range values {
+			rows = append(rows, newSearchAttributeRow(domainID, runID, key, common.Int32Ptr(int32(i)), v))
+		}
+	}
+	return rows
+}
+
+// rowsToSearchAttributes groups executions_visibility_search_attributes rows by run ID
+func (s *sqlVisibilityStore) rowsToSearchAttributes(rows []sqlplugin.VisibilitySearchAttributeRow) map[string]map[string]interface{} {
+	validSearchAttributes := s.validSearchAttributes()
+	result := make(map[string]map[string]interface{})
+	for _, row := range rows {
+		attributes, ok := result[row.RunID]
+		if !ok {
+			attributes = make(map[string]interface{})
+			result[row.RunID] = attributes
+		}
+		var value interface{}
+		switch {
+		case row.StringValue != nil:
+			value = *row.StringValue
+		case row.IntValue != nil:
+			value = *row.IntValue
+			if fieldType, ok := validSearchAttributes[row.AttrKey]; ok &&
+				common.ConvertIndexedValueTypeToInternalType(fieldType, s.logger) == types.IndexedValueTypeBool {
+				value = *row.IntValue != 0
+			}
+		case row.DoubleValue != nil:
+			value = *row.DoubleValue
+		case row.DatetimeValue != nil:
+			value = *row.DatetimeValue
+		}
+		if row.AttrIndex == nil {
+			attributes[row.AttrKey] = value
+			continue
+		}
+		// rows are ordered by attr_index, so array values can be appended
+		array, _ := attributes[row.AttrKey].([]interface{})
+		attributes[row.AttrKey] = append(array, value)
+	}
+	return result
+}
+
+func replaceSearchAttributes(
+	ctx context.Context,
+	tx sqlplugin.Tx,
+	domainID string,
+	runID string,
+	rows []sqlplugin.VisibilitySearchAttributeRow,
+) error {
+	_, err := tx.DeleteFromVisibilitySearchAttributes(ctx, &sqlplugin.VisibilitySearchAttributeFilter{
+		DomainID: domainID,
+		RunIDs:   []string{runID},
+	})
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	_, err = tx.InsertIntoVisibilitySearchAttributes(ctx, rows)
+	return err
+}
+
+func newSearchAttributeRow(domainID, runID, key string, index *int32, value interface{}) sqlplugin.VisibilitySearchAttributeRow {
+	row := sqlplugin.VisibilitySearchAttributeRow{
+		DomainID:  domainID,
+		RunID:     runID,
+		AttrKey:   key,
+		AttrIndex: index,
+	}
+	switch v := value.(type) {
+	case string:
+		row.StringValue = common.StringPtr(v)
+	case int64:
+		row.IntValue = common.Int64Ptr(v)
+	case bool:
+		row.IntValue = common.Int64Ptr(searchAttributeValueArg(v).(int64))
+	case float64:
+		row.DoubleValue = common.Float64Ptr(v)
+	case time.Time:
+		row.DatetimeValue = common.TimePtr(v)
+	}
+	return row
+}
+
+// searchAttributeArrayValues returns the elements of a decoded array search attribute value
+func searchAttributeArrayValues(value interface{}) ([]interface{}, bool) {
+	var values []interface{}
+	switch v := value.(type) {
+	case []string:
+		for _, item := range v {
+			values = append(values, item)
+		}
+	case []int64:
+		for _, item := range v {
+			values = append(values, item)
+		}
+	case []float64:
+		for _, item := range v {
+			values = append(values, item)
+		}
+	case []bool:
+		for _, item := range v {
+			values = append(values, item)
+		}
+	case []time.Time:
+		for _, item := range v {
+			values = append(values, item)
+		}
+	default:
+		return nil, false
+	}
+	return values, true
+}
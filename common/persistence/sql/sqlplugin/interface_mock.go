@@ -99,6 +99,21 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActivityInfoMaps mocks base method.
 func (m *MocktableCRUD) DeleteFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +279,66 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromRequestCancelInfoMaps(ctx, filter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromRequestCancelInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// DeleteFromShardDistributorAssignments mocks base method.
+func (m *MocktableCRUD) DeleteFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorAssignments", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorAssignments indicates an expected call of DeleteFromShardDistributorAssignments.
+func (mr *MocktableCRUDMockRecorder) DeleteFromShardDistributorAssignments(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorAssignments", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromShardDistributorAssignments), ctx, filter)
+}
+
+// DeleteFromShardDistributorExecutors mocks base method.
+func (m *MocktableCRUD) DeleteFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorExecutors", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorExecutors indicates an expected call of DeleteFromShardDistributorExecutors.
+func (mr *MocktableCRUDMockRecorder) DeleteFromShardDistributorExecutors(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorExecutors", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromShardDistributorExecutors), ctx, filter)
+}
+
+// DeleteFromShardDistributorShardStats mocks base method.
+func (m *MocktableCRUD) DeleteFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorShardStats", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorShardStats indicates an expected call of DeleteFromShardDistributorShardStats.
+func (mr *MocktableCRUDMockRecorder) DeleteFromShardDistributorShardStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorShardStats", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromShardDistributorShardStats), ctx, filter)
+}
+
+// DeleteFromShardDistributorShards mocks base method.
+func (m *MocktableCRUD) DeleteFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorShards", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorShards indicates an expected call of DeleteFromShardDistributorShards.
+func (mr *MocktableCRUDMockRecorder) DeleteFromShardDistributorShards(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorShards", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromShardDistributorShards), ctx, filter)
+}
+
 // DeleteFromSignalInfoMaps mocks base method.
 func (m *MocktableCRUD) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -384,6 +459,21 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromVisibility(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromVisibility", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromVisibility), ctx, filter)
 }
 
+// DeleteFromVisibilitySearchAttributes mocks base method.
+func (m *MocktableCRUD) DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromVisibilitySearchAttributes", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromVisibilitySearchAttributes indicates an expected call of DeleteFromVisibilitySearchAttributes.
+func (mr *MocktableCRUDMockRecorder) DeleteFromVisibilitySearchAttributes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromVisibilitySearchAttributes", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromVisibilitySearchAttributes), ctx, filter)
+}
+
 // DeleteMessage mocks base method.
 func (m *MocktableCRUD) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -742,6 +832,66 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoReplicationTasksDLQ(ctx, row any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoReplicationTasksDLQ", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoReplicationTasksDLQ), ctx, row)
 }
 
+// InsertIntoShardDistributorAssignments mocks base method.
+func (m *MocktableCRUD) InsertIntoShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorAssignments", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorAssignments indicates an expected call of InsertIntoShardDistributorAssignments.
+func (mr *MocktableCRUDMockRecorder) InsertIntoShardDistributorAssignments(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorAssignments", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoShardDistributorAssignments), ctx, row)
+}
+
+// InsertIntoShardDistributorControls mocks base method.
+func (m *MocktableCRUD) InsertIntoShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorControls", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorControls indicates an expected call of InsertIntoShardDistributorControls.
+func (mr *MocktableCRUDMockRecorder) InsertIntoShardDistributorControls(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorControls", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoShardDistributorControls), ctx, row)
+}
+
+// InsertIntoShardDistributorLeaders mocks base method.
+func (m *MocktableCRUD) InsertIntoShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorLeaders", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorLeaders indicates an expected call of InsertIntoShardDistributorLeaders.
+func (mr *MocktableCRUDMockRecorder) InsertIntoShardDistributorLeaders(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorLeaders", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoShardDistributorLeaders), ctx, row)
+}
+
+// InsertIntoShardDistributorShards mocks base method.
+func (m *MocktableCRUD) InsertIntoShardDistributorShards(ctx context.Context, rows []ShardDistributorShardRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorShards", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorShards indicates an expected call of InsertIntoShardDistributorShards.
+func (mr *MocktableCRUDMockRecorder) InsertIntoShardDistributorShards(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorShards", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoShardDistributorShards), ctx, rows)
+}
+
 // InsertIntoShards mocks base method.
 func (m *MocktableCRUD) InsertIntoShards(ctx context.Context, rows *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -877,6 +1027,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoVisibility(ctx, row any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoVisibility", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoVisibility), ctx, row)
 }
 
+// InsertIntoVisibilitySearchAttributes mocks base method.
+func (m *MocktableCRUD) InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []VisibilitySearchAttributeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoVisibilitySearchAttributes", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoVisibilitySearchAttributes indicates an expected call of InsertIntoVisibilitySearchAttributes.
+func (mr *MocktableCRUDMockRecorder) InsertIntoVisibilitySearchAttributes(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoVisibilitySearchAttributes", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoVisibilitySearchAttributes), ctx, rows)
+}
+
 // LockCurrentExecutions mocks base method.
 func (m *MocktableCRUD) LockCurrentExecutions(ctx context.Context, filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error) {
 	m.ctrl.T.Helper()
@@ -921,6 +1086,21 @@ func (mr *MocktableCRUDMockRecorder) LockDomainMetadata(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockDomainMetadata", reflect.TypeOf((*MocktableCRUD)(nil).LockDomainMetadata), ctx)
 }
 
+// LockShardDistributorLeaders mocks base method.
+func (m *MocktableCRUD) LockShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockShardDistributorLeaders", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorLeaderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockShardDistributorLeaders indicates an expected call of LockShardDistributorLeaders.
+func (mr *MocktableCRUDMockRecorder) LockShardDistributorLeaders(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockShardDistributorLeaders", reflect.TypeOf((*MocktableCRUD)(nil).LockShardDistributorLeaders), ctx, namespace)
+}
+
 // LockTaskLists mocks base method.
 func (m *MocktableCRUD) LockTaskLists(ctx context.Context, filter *TaskListsFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1116,6 +1296,36 @@ func (mr *MocktableCRUDMockRecorder) ReplaceIntoRequestCancelInfoMaps(ctx, rows
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoRequestCancelInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoRequestCancelInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoShardDistributorExecutors mocks base method.
+func (m *MocktableCRUD) ReplaceIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoShardDistributorExecutors indicates an expected call of ReplaceIntoShardDistributorExecutors.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoShardDistributorExecutors", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoShardDistributorExecutors), ctx, row)
+}
+
+// ReplaceIntoShardDistributorShardStats mocks base method.
+func (m *MocktableCRUD) ReplaceIntoShardDistributorShardStats(ctx context.Context, rows []ShardDistributorShardStatsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoShardDistributorShardStats", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoShardDistributorShardStats indicates an expected call of ReplaceIntoShardDistributorShardStats.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoShardDistributorShardStats(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoShardDistributorShardStats", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoShardDistributorShardStats), ctx, rows)
+}
+
 // ReplaceIntoSignalInfoMaps mocks base method.
 func (m *MocktableCRUD) ReplaceIntoSignalInfoMaps(ctx context.Context, rows []SignalInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1386,6 +1596,96 @@ func (mr *MocktableCRUDMockRecorder) SelectFromRequestCancelInfoMaps(ctx, filter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromRequestCancelInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// SelectFromShardDistributorAssignments mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) ([]ShardDistributorAssignmentRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorAssignments", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorAssignmentRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorAssignments indicates an expected call of SelectFromShardDistributorAssignments.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorAssignments(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorAssignments", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorAssignments), ctx, filter)
+}
+
+// SelectFromShardDistributorControls mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorControls(ctx context.Context, namespace string) (*ShardDistributorControlRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorControls", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorControlRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorControls indicates an expected call of SelectFromShardDistributorControls.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorControls(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorControls", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorControls), ctx, namespace)
+}
+
+// SelectFromShardDistributorExecutors mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) ([]ShardDistributorExecutorRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorExecutors", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorExecutorRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorExecutors indicates an expected call of SelectFromShardDistributorExecutors.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorExecutors(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorExecutors", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorExecutors), ctx, filter)
+}
+
+// SelectFromShardDistributorLeaders mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorLeaders", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorLeaderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorLeaders indicates an expected call of SelectFromShardDistributorLeaders.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorLeaders(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorLeaders", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorLeaders), ctx, namespace)
+}
+
+// SelectFromShardDistributorShardStats mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) ([]ShardDistributorShardStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorShardStats", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorShardStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorShardStats indicates an expected call of SelectFromShardDistributorShardStats.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorShardStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorShardStats", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorShardStats), ctx, filter)
+}
+
+// SelectFromShardDistributorShards mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) ([]ShardDistributorShardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorShards", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorShardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorShards indicates an expected call of SelectFromShardDistributorShards.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorShards(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorShards", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorShards), ctx, filter)
+}
+
 // SelectFromShards mocks base method.
 func (m *MocktableCRUD) SelectFromShards(ctx context.Context, filter *ShardsFilter) (*ShardsRow, error) {
 	m.ctrl.T.Helper()
@@ -1521,6 +1821,36 @@ func (mr *MocktableCRUDMockRecorder) SelectFromVisibility(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
+// SelectFromVisibilitySearchAttributes mocks base method.
+func (m *MocktableCRUD) SelectFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) ([]VisibilitySearchAttributeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilitySearchAttributes", ctx, filter)
+	ret0, _ := ret[0].([]VisibilitySearchAttributeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilitySearchAttributes indicates an expected call of SelectFromVisibilitySearchAttributes.
+func (mr *MocktableCRUDMockRecorder) SelectFromVisibilitySearchAttributes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilitySearchAttributes", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibilitySearchAttributes), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MocktableCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -1638,6 +1968,66 @@ func (mr *MocktableCRUDMockRecorder) UpdateExecutions(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExecutions", reflect.TypeOf((*MocktableCRUD)(nil).UpdateExecutions), ctx, row)
 }
 
+// UpdateHistoryNode mocks base method.
+func (m *MocktableCRUD) UpdateHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHistoryNode", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHistoryNode indicates an expected call of UpdateHistoryNode.
+func (mr *MocktableCRUDMockRecorder) UpdateHistoryNode(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHistoryNode", reflect.TypeOf((*MocktableCRUD)(nil).UpdateHistoryNode), ctx, row)
+}
+
+// UpdateShardDistributorAssignments mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorAssignments", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorAssignments indicates an expected call of UpdateShardDistributorAssignments.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorAssignments(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorAssignments", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorAssignments), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorControls mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorControls", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorControls indicates an expected call of UpdateShardDistributorControls.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorControls(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorControls", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorControls), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorLeaders mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow, previousTerm int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeaders", ctx, row, previousTerm)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorLeaders indicates an expected call of UpdateShardDistributorLeaders.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorLeaders(ctx, row, previousTerm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeaders", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorLeaders), ctx, row, previousTerm)
+}
+
 // UpdateShards mocks base method.
 func (m *MocktableCRUD) UpdateShards(ctx context.Context, row *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1683,6 +2073,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MocktableCRUD) UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MocktableCRUDMockRecorder) UpdateVisibilityMemo(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MocktableCRUD)(nil).UpdateVisibilityMemo), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MocktableCRUD) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -1922,6 +2327,21 @@ func (mr *MockTxMockRecorder) Commit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTx)(nil).Commit))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockTx) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActivityInfoMaps mocks base method.
 func (m *MockTx) DeleteFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2087,34 +2507,94 @@ func (mr *MockTxMockRecorder) DeleteFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromRequestCancelInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromRequestCancelInfoMaps), ctx, filter)
 }
 
-// DeleteFromSignalInfoMaps mocks base method.
-func (m *MockTx) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
+// DeleteFromShardDistributorAssignments mocks base method.
+func (m *MockTx) DeleteFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFromSignalInfoMaps", ctx, filter)
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorAssignments", ctx, filter)
 	ret0, _ := ret[0].(sql.Result)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteFromSignalInfoMaps indicates an expected call of DeleteFromSignalInfoMaps.
-func (mr *MockTxMockRecorder) DeleteFromSignalInfoMaps(ctx, filter any) *gomock.Call {
+// DeleteFromShardDistributorAssignments indicates an expected call of DeleteFromShardDistributorAssignments.
+func (mr *MockTxMockRecorder) DeleteFromShardDistributorAssignments(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromSignalInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromSignalInfoMaps), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorAssignments", reflect.TypeOf((*MockTx)(nil).DeleteFromShardDistributorAssignments), ctx, filter)
 }
 
-// DeleteFromSignalsRequestedSets mocks base method.
-func (m *MockTx) DeleteFromSignalsRequestedSets(ctx context.Context, filter *SignalsRequestedSetsFilter) (sql.Result, error) {
+// DeleteFromShardDistributorExecutors mocks base method.
+func (m *MockTx) DeleteFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFromSignalsRequestedSets", ctx, filter)
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorExecutors", ctx, filter)
 	ret0, _ := ret[0].(sql.Result)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteFromSignalsRequestedSets indicates an expected call of DeleteFromSignalsRequestedSets.
-func (mr *MockTxMockRecorder) DeleteFromSignalsRequestedSets(ctx, filter any) *gomock.Call {
+// DeleteFromShardDistributorExecutors indicates an expected call of DeleteFromShardDistributorExecutors.
+func (mr *MockTxMockRecorder) DeleteFromShardDistributorExecutors(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromSignalsRequestedSets", reflect.TypeOf((*MockTx)(nil).DeleteFromSignalsRequestedSets), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorExecutors", reflect.TypeOf((*MockTx)(nil).DeleteFromShardDistributorExecutors), ctx, filter)
+}
+
+// DeleteFromShardDistributorShardStats mocks base method.
+func (m *MockTx) DeleteFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorShardStats", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorShardStats indicates an expected call of DeleteFromShardDistributorShardStats.
+func (mr *MockTxMockRecorder) DeleteFromShardDistributorShardStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorShardStats", reflect.TypeOf((*MockTx)(nil).DeleteFromShardDistributorShardStats), ctx, filter)
+}
+
+// DeleteFromShardDistributorShards mocks base method.
+func (m *MockTx) DeleteFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorShards", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorShards indicates an expected call of DeleteFromShardDistributorShards.
+func (mr *MockTxMockRecorder) DeleteFromShardDistributorShards(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorShards", reflect.TypeOf((*MockTx)(nil).DeleteFromShardDistributorShards), ctx, filter)
+}
+
+// DeleteFromSignalInfoMaps mocks base method.
+func (m *MockTx) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromSignalInfoMaps", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromSignalInfoMaps indicates an expected call of DeleteFromSignalInfoMaps.
+func (mr *MockTxMockRecorder) DeleteFromSignalInfoMaps(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromSignalInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromSignalInfoMaps), ctx, filter)
+}
+
+// DeleteFromSignalsRequestedSets mocks base method.
+func (m *MockTx) DeleteFromSignalsRequestedSets(ctx context.Context, filter *SignalsRequestedSetsFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromSignalsRequestedSets", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromSignalsRequestedSets indicates an expected call of DeleteFromSignalsRequestedSets.
+func (mr *MockTxMockRecorder) DeleteFromSignalsRequestedSets(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromSignalsRequestedSets", reflect.TypeOf((*MockTx)(nil).DeleteFromSignalsRequestedSets), ctx, filter)
 }
 
 // DeleteFromTaskLists mocks base method.
@@ -2207,6 +2687,21 @@ func (mr *MockTxMockRecorder) DeleteFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromVisibility", reflect.TypeOf((*MockTx)(nil).DeleteFromVisibility), ctx, filter)
 }
 
+// DeleteFromVisibilitySearchAttributes mocks base method.
+func (m *MockTx) DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromVisibilitySearchAttributes", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromVisibilitySearchAttributes indicates an expected call of DeleteFromVisibilitySearchAttributes.
+func (mr *MockTxMockRecorder) DeleteFromVisibilitySearchAttributes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromVisibilitySearchAttributes", reflect.TypeOf((*MockTx)(nil).DeleteFromVisibilitySearchAttributes), ctx, filter)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockTx) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2565,6 +3060,66 @@ func (mr *MockTxMockRecorder) InsertIntoReplicationTasksDLQ(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoReplicationTasksDLQ", reflect.TypeOf((*MockTx)(nil).InsertIntoReplicationTasksDLQ), ctx, row)
 }
 
+// InsertIntoShardDistributorAssignments mocks base method.
+func (m *MockTx) InsertIntoShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorAssignments", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorAssignments indicates an expected call of InsertIntoShardDistributorAssignments.
+func (mr *MockTxMockRecorder) InsertIntoShardDistributorAssignments(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorAssignments", reflect.TypeOf((*MockTx)(nil).InsertIntoShardDistributorAssignments), ctx, row)
+}
+
+// InsertIntoShardDistributorControls mocks base method.
+func (m *MockTx) InsertIntoShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorControls", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorControls indicates an expected call of InsertIntoShardDistributorControls.
+func (mr *MockTxMockRecorder) InsertIntoShardDistributorControls(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorControls", reflect.TypeOf((*MockTx)(nil).InsertIntoShardDistributorControls), ctx, row)
+}
+
+// InsertIntoShardDistributorLeaders mocks base method.
+func (m *MockTx) InsertIntoShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorLeaders", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorLeaders indicates an expected call of InsertIntoShardDistributorLeaders.
+func (mr *MockTxMockRecorder) InsertIntoShardDistributorLeaders(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorLeaders", reflect.TypeOf((*MockTx)(nil).InsertIntoShardDistributorLeaders), ctx, row)
+}
+
+// InsertIntoShardDistributorShards mocks base method.
+func (m *MockTx) InsertIntoShardDistributorShards(ctx context.Context, rows []ShardDistributorShardRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorShards", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorShards indicates an expected call of InsertIntoShardDistributorShards.
+func (mr *MockTxMockRecorder) InsertIntoShardDistributorShards(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorShards", reflect.TypeOf((*MockTx)(nil).InsertIntoShardDistributorShards), ctx, rows)
+}
+
 // InsertIntoShards mocks base method.
 func (m *MockTx) InsertIntoShards(ctx context.Context, rows *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2700,6 +3255,21 @@ func (mr *MockTxMockRecorder) InsertIntoVisibility(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoVisibility", reflect.TypeOf((*MockTx)(nil).InsertIntoVisibility), ctx, row)
 }
 
+// InsertIntoVisibilitySearchAttributes mocks base method.
+func (m *MockTx) InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []VisibilitySearchAttributeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoVisibilitySearchAttributes", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoVisibilitySearchAttributes indicates an expected call of InsertIntoVisibilitySearchAttributes.
+func (mr *MockTxMockRecorder) InsertIntoVisibilitySearchAttributes(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoVisibilitySearchAttributes", reflect.TypeOf((*MockTx)(nil).InsertIntoVisibilitySearchAttributes), ctx, rows)
+}
+
 // IsDupEntryError mocks base method.
 func (m *MockTx) IsDupEntryError(err error) bool {
 	m.ctrl.T.Helper()
@@ -2800,6 +3370,21 @@ func (mr *MockTxMockRecorder) LockDomainMetadata(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockDomainMetadata", reflect.TypeOf((*MockTx)(nil).LockDomainMetadata), ctx)
 }
 
+// LockShardDistributorLeaders mocks base method.
+func (m *MockTx) LockShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockShardDistributorLeaders", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorLeaderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockShardDistributorLeaders indicates an expected call of LockShardDistributorLeaders.
+func (mr *MockTxMockRecorder) LockShardDistributorLeaders(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockShardDistributorLeaders", reflect.TypeOf((*MockTx)(nil).LockShardDistributorLeaders), ctx, namespace)
+}
+
 // LockTaskLists mocks base method.
 func (m *MockTx) LockTaskLists(ctx context.Context, filter *TaskListsFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -2995,6 +3580,36 @@ func (mr *MockTxMockRecorder) ReplaceIntoRequestCancelInfoMaps(ctx, rows any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoRequestCancelInfoMaps", reflect.TypeOf((*MockTx)(nil).ReplaceIntoRequestCancelInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoShardDistributorExecutors mocks base method.
+func (m *MockTx) ReplaceIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoShardDistributorExecutors indicates an expected call of ReplaceIntoShardDistributorExecutors.
+func (mr *MockTxMockRecorder) ReplaceIntoShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoShardDistributorExecutors", reflect.TypeOf((*MockTx)(nil).ReplaceIntoShardDistributorExecutors), ctx, row)
+}
+
+// ReplaceIntoShardDistributorShardStats mocks base method.
+func (m *MockTx) ReplaceIntoShardDistributorShardStats(ctx context.Context, rows []ShardDistributorShardStatsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoShardDistributorShardStats", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoShardDistributorShardStats indicates an expected call of ReplaceIntoShardDistributorShardStats.
+func (mr *MockTxMockRecorder) ReplaceIntoShardDistributorShardStats(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoShardDistributorShardStats", reflect.TypeOf((*MockTx)(nil).ReplaceIntoShardDistributorShardStats), ctx, rows)
+}
+
 // ReplaceIntoSignalInfoMaps mocks base method.
 func (m *MockTx) ReplaceIntoSignalInfoMaps(ctx context.Context, rows []SignalInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3279,6 +3894,96 @@ func (mr *MockTxMockRecorder) SelectFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromRequestCancelInfoMaps", reflect.TypeOf((*MockTx)(nil).SelectFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// SelectFromShardDistributorAssignments mocks base method.
+func (m *MockTx) SelectFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) ([]ShardDistributorAssignmentRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorAssignments", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorAssignmentRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorAssignments indicates an expected call of SelectFromShardDistributorAssignments.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorAssignments(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorAssignments", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorAssignments), ctx, filter)
+}
+
+// SelectFromShardDistributorControls mocks base method.
+func (m *MockTx) SelectFromShardDistributorControls(ctx context.Context, namespace string) (*ShardDistributorControlRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorControls", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorControlRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorControls indicates an expected call of SelectFromShardDistributorControls.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorControls(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorControls", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorControls), ctx, namespace)
+}
+
+// SelectFromShardDistributorExecutors mocks base method.
+func (m *MockTx) SelectFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) ([]ShardDistributorExecutorRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorExecutors", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorExecutorRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorExecutors indicates an expected call of SelectFromShardDistributorExecutors.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorExecutors(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorExecutors", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorExecutors), ctx, filter)
+}
+
+// SelectFromShardDistributorLeaders mocks base method.
+func (m *MockTx) SelectFromShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorLeaders", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorLeaderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorLeaders indicates an expected call of SelectFromShardDistributorLeaders.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorLeaders(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorLeaders", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorLeaders), ctx, namespace)
+}
+
+// SelectFromShardDistributorShardStats mocks base method.
+func (m *MockTx) SelectFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) ([]ShardDistributorShardStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorShardStats", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorShardStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorShardStats indicates an expected call of SelectFromShardDistributorShardStats.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorShardStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorShardStats", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorShardStats), ctx, filter)
+}
+
+// SelectFromShardDistributorShards mocks base method.
+func (m *MockTx) SelectFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) ([]ShardDistributorShardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorShards", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorShardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorShards indicates an expected call of SelectFromShardDistributorShards.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorShards(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorShards", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorShards), ctx, filter)
+}
+
 // SelectFromShards mocks base method.
 func (m *MockTx) SelectFromShards(ctx context.Context, filter *ShardsFilter) (*ShardsRow, error) {
 	m.ctrl.T.Helper()
@@ -3414,6 +4119,36 @@ func (mr *MockTxMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockTx)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockTx) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
+// SelectFromVisibilitySearchAttributes mocks base method.
+func (m *MockTx) SelectFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) ([]VisibilitySearchAttributeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilitySearchAttributes", ctx, filter)
+	ret0, _ := ret[0].([]VisibilitySearchAttributeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilitySearchAttributes indicates an expected call of SelectFromVisibilitySearchAttributes.
+func (mr *MockTxMockRecorder) SelectFromVisibilitySearchAttributes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilitySearchAttributes", reflect.TypeOf((*MockTx)(nil).SelectFromVisibilitySearchAttributes), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockTx) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -3531,6 +4266,66 @@ func (mr *MockTxMockRecorder) UpdateExecutions(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExecutions", reflect.TypeOf((*MockTx)(nil).UpdateExecutions), ctx, row)
 }
 
+// UpdateHistoryNode mocks base method.
+func (m *MockTx) UpdateHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHistoryNode", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHistoryNode indicates an expected call of UpdateHistoryNode.
+func (mr *MockTxMockRecorder) UpdateHistoryNode(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHistoryNode", reflect.TypeOf((*MockTx)(nil).UpdateHistoryNode), ctx, row)
+}
+
+// UpdateShardDistributorAssignments mocks base method.
+func (m *MockTx) UpdateShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorAssignments", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorAssignments indicates an expected call of UpdateShardDistributorAssignments.
+func (mr *MockTxMockRecorder) UpdateShardDistributorAssignments(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorAssignments", reflect.TypeOf((*MockTx)(nil).UpdateShardDistributorAssignments), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorControls mocks base method.
+func (m *MockTx) UpdateShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorControls", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorControls indicates an expected call of UpdateShardDistributorControls.
+func (mr *MockTxMockRecorder) UpdateShardDistributorControls(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorControls", reflect.TypeOf((*MockTx)(nil).UpdateShardDistributorControls), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorLeaders mocks base method.
+func (m *MockTx) UpdateShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow, previousTerm int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeaders", ctx, row, previousTerm)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorLeaders indicates an expected call of UpdateShardDistributorLeaders.
+func (mr *MockTxMockRecorder) UpdateShardDistributorLeaders(ctx, row, previousTerm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeaders", reflect.TypeOf((*MockTx)(nil).UpdateShardDistributorLeaders), ctx, row, previousTerm)
+}
+
 // UpdateShards mocks base method.
 func (m *MockTx) UpdateShards(ctx context.Context, row *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3576,6 +4371,21 @@ func (mr *MockTxMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockTx)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MockTx) UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MockTxMockRecorder) UpdateVisibilityMemo(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MockTx)(nil).UpdateVisibilityMemo), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockTx) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -3659,6 +4469,21 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockDB) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActivityInfoMaps mocks base method.
 func (m *MockDB) DeleteFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3824,6 +4649,66 @@ func (mr *MockDBMockRecorder) DeleteFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromRequestCancelInfoMaps", reflect.TypeOf((*MockDB)(nil).DeleteFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// DeleteFromShardDistributorAssignments mocks base method.
+func (m *MockDB) DeleteFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorAssignments", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorAssignments indicates an expected call of DeleteFromShardDistributorAssignments.
+func (mr *MockDBMockRecorder) DeleteFromShardDistributorAssignments(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorAssignments", reflect.TypeOf((*MockDB)(nil).DeleteFromShardDistributorAssignments), ctx, filter)
+}
+
+// DeleteFromShardDistributorExecutors mocks base method.
+func (m *MockDB) DeleteFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorExecutors", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorExecutors indicates an expected call of DeleteFromShardDistributorExecutors.
+func (mr *MockDBMockRecorder) DeleteFromShardDistributorExecutors(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorExecutors", reflect.TypeOf((*MockDB)(nil).DeleteFromShardDistributorExecutors), ctx, filter)
+}
+
+// DeleteFromShardDistributorShardStats mocks base method.
+func (m *MockDB) DeleteFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorShardStats", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorShardStats indicates an expected call of DeleteFromShardDistributorShardStats.
+func (mr *MockDBMockRecorder) DeleteFromShardDistributorShardStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorShardStats", reflect.TypeOf((*MockDB)(nil).DeleteFromShardDistributorShardStats), ctx, filter)
+}
+
+// DeleteFromShardDistributorShards mocks base method.
+func (m *MockDB) DeleteFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorShards", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorShards indicates an expected call of DeleteFromShardDistributorShards.
+func (mr *MockDBMockRecorder) DeleteFromShardDistributorShards(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorShards", reflect.TypeOf((*MockDB)(nil).DeleteFromShardDistributorShards), ctx, filter)
+}
+
 // DeleteFromSignalInfoMaps mocks base method.
 func (m *MockDB) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3944,6 +4829,21 @@ func (mr *MockDBMockRecorder) DeleteFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromVisibility", reflect.TypeOf((*MockDB)(nil).DeleteFromVisibility), ctx, filter)
 }
 
+// DeleteFromVisibilitySearchAttributes mocks base method.
+func (m *MockDB) DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromVisibilitySearchAttributes", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromVisibilitySearchAttributes indicates an expected call of DeleteFromVisibilitySearchAttributes.
+func (mr *MockDBMockRecorder) DeleteFromVisibilitySearchAttributes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromVisibilitySearchAttributes", reflect.TypeOf((*MockDB)(nil).DeleteFromVisibilitySearchAttributes), ctx, filter)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockDB) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4316,6 +5216,66 @@ func (mr *MockDBMockRecorder) InsertIntoReplicationTasksDLQ(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoReplicationTasksDLQ", reflect.TypeOf((*MockDB)(nil).InsertIntoReplicationTasksDLQ), ctx, row)
 }
 
+// InsertIntoShardDistributorAssignments mocks base method.
+func (m *MockDB) InsertIntoShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorAssignments", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorAssignments indicates an expected call of InsertIntoShardDistributorAssignments.
+func (mr *MockDBMockRecorder) InsertIntoShardDistributorAssignments(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorAssignments", reflect.TypeOf((*MockDB)(nil).InsertIntoShardDistributorAssignments), ctx, row)
+}
+
+// InsertIntoShardDistributorControls mocks base method.
+func (m *MockDB) InsertIntoShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorControls", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorControls indicates an expected call of InsertIntoShardDistributorControls.
+func (mr *MockDBMockRecorder) InsertIntoShardDistributorControls(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorControls", reflect.TypeOf((*MockDB)(nil).InsertIntoShardDistributorControls), ctx, row)
+}
+
+// InsertIntoShardDistributorLeaders mocks base method.
+func (m *MockDB) InsertIntoShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorLeaders", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorLeaders indicates an expected call of InsertIntoShardDistributorLeaders.
+func (mr *MockDBMockRecorder) InsertIntoShardDistributorLeaders(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorLeaders", reflect.TypeOf((*MockDB)(nil).InsertIntoShardDistributorLeaders), ctx, row)
+}
+
+// InsertIntoShardDistributorShards mocks base method.
+func (m *MockDB) InsertIntoShardDistributorShards(ctx context.Context, rows []ShardDistributorShardRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorShards", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorShards indicates an expected call of InsertIntoShardDistributorShards.
+func (mr *MockDBMockRecorder) InsertIntoShardDistributorShards(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorShards", reflect.TypeOf((*MockDB)(nil).InsertIntoShardDistributorShards), ctx, rows)
+}
+
 // InsertIntoShards mocks base method.
 func (m *MockDB) InsertIntoShards(ctx context.Context, rows *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4451,6 +5411,21 @@ func (mr *MockDBMockRecorder) InsertIntoVisibility(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoVisibility", reflect.TypeOf((*MockDB)(nil).InsertIntoVisibility), ctx, row)
 }
 
+// InsertIntoVisibilitySearchAttributes mocks base method.
+func (m *MockDB) InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []VisibilitySearchAttributeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoVisibilitySearchAttributes", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoVisibilitySearchAttributes indicates an expected call of InsertIntoVisibilitySearchAttributes.
+func (mr *MockDBMockRecorder) InsertIntoVisibilitySearchAttributes(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoVisibilitySearchAttributes", reflect.TypeOf((*MockDB)(nil).InsertIntoVisibilitySearchAttributes), ctx, rows)
+}
+
 // IsDupEntryError mocks base method.
 func (m *MockDB) IsDupEntryError(err error) bool {
 	m.ctrl.T.Helper()
@@ -4551,6 +5526,21 @@ func (mr *MockDBMockRecorder) LockDomainMetadata(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockDomainMetadata", reflect.TypeOf((*MockDB)(nil).LockDomainMetadata), ctx)
 }
 
+// LockShardDistributorLeaders mocks base method.
+func (m *MockDB) LockShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockShardDistributorLeaders", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorLeaderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockShardDistributorLeaders indicates an expected call of LockShardDistributorLeaders.
+func (mr *MockDBMockRecorder) LockShardDistributorLeaders(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockShardDistributorLeaders", reflect.TypeOf((*MockDB)(nil).LockShardDistributorLeaders), ctx, namespace)
+}
+
 // LockTaskLists mocks base method.
 func (m *MockDB) LockTaskLists(ctx context.Context, filter *TaskListsFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -4760,6 +5750,36 @@ func (mr *MockDBMockRecorder) ReplaceIntoRequestCancelInfoMaps(ctx, rows any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoRequestCancelInfoMaps", reflect.TypeOf((*MockDB)(nil).ReplaceIntoRequestCancelInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoShardDistributorExecutors mocks base method.
+func (m *MockDB) ReplaceIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoShardDistributorExecutors indicates an expected call of ReplaceIntoShardDistributorExecutors.
+func (mr *MockDBMockRecorder) ReplaceIntoShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoShardDistributorExecutors", reflect.TypeOf((*MockDB)(nil).ReplaceIntoShardDistributorExecutors), ctx, row)
+}
+
+// ReplaceIntoShardDistributorShardStats mocks base method.
+func (m *MockDB) ReplaceIntoShardDistributorShardStats(ctx context.Context, rows []ShardDistributorShardStatsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoShardDistributorShardStats", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoShardDistributorShardStats indicates an expected call of ReplaceIntoShardDistributorShardStats.
+func (mr *MockDBMockRecorder) ReplaceIntoShardDistributorShardStats(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoShardDistributorShardStats", reflect.TypeOf((*MockDB)(nil).ReplaceIntoShardDistributorShardStats), ctx, rows)
+}
+
 // ReplaceIntoSignalInfoMaps mocks base method.
 func (m *MockDB) ReplaceIntoSignalInfoMaps(ctx context.Context, rows []SignalInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5030,6 +6050,96 @@ func (mr *MockDBMockRecorder) SelectFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromRequestCancelInfoMaps", reflect.TypeOf((*MockDB)(nil).SelectFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// SelectFromShardDistributorAssignments mocks base method.
+func (m *MockDB) SelectFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) ([]ShardDistributorAssignmentRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorAssignments", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorAssignmentRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorAssignments indicates an expected call of SelectFromShardDistributorAssignments.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorAssignments(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorAssignments", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorAssignments), ctx, filter)
+}
+
+// SelectFromShardDistributorControls mocks base method.
+func (m *MockDB) SelectFromShardDistributorControls(ctx context.Context, namespace string) (*ShardDistributorControlRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorControls", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorControlRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorControls indicates an expected call of SelectFromShardDistributorControls.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorControls(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorControls", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorControls), ctx, namespace)
+}
+
+// SelectFromShardDistributorExecutors mocks base method.
+func (m *MockDB) SelectFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) ([]ShardDistributorExecutorRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorExecutors", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorExecutorRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorExecutors indicates an expected call of SelectFromShardDistributorExecutors.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorExecutors(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorExecutors", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorExecutors), ctx, filter)
+}
+
+// SelectFromShardDistributorLeaders mocks base method.
+func (m *MockDB) SelectFromShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorLeaders", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorLeaderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorLeaders indicates an expected call of SelectFromShardDistributorLeaders.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorLeaders(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorLeaders", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorLeaders), ctx, namespace)
+}
+
+// SelectFromShardDistributorShardStats mocks base method.
+func (m *MockDB) SelectFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) ([]ShardDistributorShardStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorShardStats", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorShardStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorShardStats indicates an expected call of SelectFromShardDistributorShardStats.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorShardStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorShardStats", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorShardStats), ctx, filter)
+}
+
+// SelectFromShardDistributorShards mocks base method.
+func (m *MockDB) SelectFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) ([]ShardDistributorShardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorShards", ctx, filter)
+	ret0, _ := ret[0].([]ShardDistributorShardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorShards indicates an expected call of SelectFromShardDistributorShards.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorShards(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorShards", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorShards), ctx, filter)
+}
+
 // SelectFromShards mocks base method.
 func (m *MockDB) SelectFromShards(ctx context.Context, filter *ShardsFilter) (*ShardsRow, error) {
 	m.ctrl.T.Helper()
@@ -5165,6 +6275,36 @@ func (mr *MockDBMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockDB)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockDB) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
+// SelectFromVisibilitySearchAttributes mocks base method.
+func (m *MockDB) SelectFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) ([]VisibilitySearchAttributeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilitySearchAttributes", ctx, filter)
+	ret0, _ := ret[0].([]VisibilitySearchAttributeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilitySearchAttributes indicates an expected call of SelectFromVisibilitySearchAttributes.
+func (mr *MockDBMockRecorder) SelectFromVisibilitySearchAttributes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilitySearchAttributes", reflect.TypeOf((*MockDB)(nil).SelectFromVisibilitySearchAttributes), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -5282,6 +6422,66 @@ func (mr *MockDBMockRecorder) UpdateExecutions(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExecutions", reflect.TypeOf((*MockDB)(nil).UpdateExecutions), ctx, row)
 }
 
+// UpdateHistoryNode mocks base method.
+func (m *MockDB) UpdateHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHistoryNode", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHistoryNode indicates an expected call of UpdateHistoryNode.
+func (mr *MockDBMockRecorder) UpdateHistoryNode(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHistoryNode", reflect.TypeOf((*MockDB)(nil).UpdateHistoryNode), ctx, row)
+}
+
+// UpdateShardDistributorAssignments mocks base method.
+func (m *MockDB) UpdateShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorAssignments", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorAssignments indicates an expected call of UpdateShardDistributorAssignments.
+func (mr *MockDBMockRecorder) UpdateShardDistributorAssignments(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorAssignments", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorAssignments), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorControls mocks base method.
+func (m *MockDB) UpdateShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorControls", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorControls indicates an expected call of UpdateShardDistributorControls.
+func (mr *MockDBMockRecorder) UpdateShardDistributorControls(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorControls", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorControls), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorLeaders mocks base method.
+func (m *MockDB) UpdateShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow, previousTerm int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeaders", ctx, row, previousTerm)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorLeaders indicates an expected call of UpdateShardDistributorLeaders.
+func (mr *MockDBMockRecorder) UpdateShardDistributorLeaders(ctx, row, previousTerm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeaders", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorLeaders), ctx, row, previousTerm)
+}
+
 // UpdateShards mocks base method.
 func (m *MockDB) UpdateShards(ctx context.Context, row *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5327,6 +6527,21 @@ func (mr *MockDBMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockDB)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MockDB) UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MockDBMockRecorder) UpdateVisibilityMemo(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MockDB)(nil).UpdateVisibilityMemo), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockDB) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
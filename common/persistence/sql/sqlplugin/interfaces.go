@@ -561,6 +561,40 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter filters executions_visibility rows with a condition translated from a visibility query
+	VisibilityQueryFilter struct {
+		DomainID string
+		// Condition is a boolean expression over executions_visibility, aliased as v, which may also
+		// refer to executions_visibility_search_attributes. It uses ? placeholders for Args and may be empty.
+		Condition string
+		Args      []interface{}
+		// OrderBy is the ordering of rows, e.g. "v.start_time DESC, v.run_id"
+		OrderBy  string
+		Offset   int
+		PageSize int
+	}
+
+	// VisibilitySearchAttributeRow represents a row in executions_visibility_search_attributes table.
+	// Only one of the value columns is set, depending on the type of the search attribute.
+	VisibilitySearchAttributeRow struct {
+		DomainID string
+		RunID    string
+		AttrKey  string
+		// AttrIndex is the position of the value within an array, nil for single values
+		AttrIndex     *int32
+		StringValue   *string
+		IntValue      *int64
+		DoubleValue   *float64
+		DatetimeValue *time.Time
+	}
+
+	// VisibilitySearchAttributeFilter contains the column names within executions_visibility_search_attributes table that
+	// can be used to filter results through a WHERE clause
+	VisibilitySearchAttributeFilter struct {
+		DomainID string
+		RunIDs   []string
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      persistence.QueueType
@@ -610,6 +644,86 @@ type (
 		PageMinEventID     *string
 	}
 
+	// ShardDistributorExecutorRow represents a row in shard_distributor_executors table
+	ShardDistributorExecutorRow struct {
+		Namespace    string
+		ExecutorID   string
+		Status       int32
+		Data         []byte
+		DataEncoding string
+	}
+
+	// ShardDistributorExecutorFilter contains the column names within shard_distributor_executors table that
+	// can be used to filter results through a WHERE clause. When ExecutorID is nil all executors of the namespace match.
+	ShardDistributorExecutorFilter struct {
+		Namespace  string
+		ExecutorID *string
+	}
+
+	// ShardDistributorAssignmentRow represents a row in shard_distributor_assignments table
+	ShardDistributorAssignmentRow struct {
+		Namespace    string
+		ExecutorID   string
+		Version      int64
+		Data         []byte
+		DataEncoding string
+	}
+
+	// ShardDistributorAssignmentFilter contains the column names within shard_distributor_assignments table that
+	// can be used to filter results through a WHERE clause. When ExecutorID is nil all executors of the namespace match.
+	ShardDistributorAssignmentFilter struct {
+		Namespace  string
+		ExecutorID *string
+	}
+
+	// ShardDistributorShardRow represents a row in shard_distributor_shards table
+	ShardDistributorShardRow struct {
+		Namespace  string
+		ShardID    string
+		ExecutorID string
+	}
+
+	// ShardDistributorShardFilter contains the column names within shard_distributor_shards table that
+	// can be used to filter results through a WHERE clause. At most one of ShardIDs and ExecutorID may be specified.
+	ShardDistributorShardFilter struct {
+		Namespace  string
+		ShardIDs   []string
+		ExecutorID *string
+	}
+
+	// ShardDistributorShardStatsRow represents a row in shard_distributor_shard_stats table
+	ShardDistributorShardStatsRow struct {
+		Namespace    string
+		ShardID      string
+		ExecutorID   string
+		Data         []byte
+		DataEncoding string
+	}
+
+	// ShardDistributorShardStatsFilter contains the column names within shard_distributor_shard_stats table that
+	// can be used to filter results through a WHERE clause. At most one of ShardIDs and ExecutorID may be specified.
+	ShardDistributorShardStatsFilter struct {
+		Namespace  string
+		ShardIDs   []string
+		ExecutorID *string
+	}
+
+	// ShardDistributorLeaderRow represents a row in shard_distributor_leaders table
+	ShardDistributorLeaderRow struct {
+		Namespace   string
+		LeaderID    string
+		Term        int64
+		LeaseExpiry time.Time
+	}
+
+	// ShardDistributorControlRow represents a row in shard_distributor_controls table
+	ShardDistributorControlRow struct {
+		Namespace    string
+		Version      int64
+		Data         []byte
+		DataEncoding string
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(ctx context.Context, rows *DomainRow) (sql.Result, error)
@@ -661,6 +775,7 @@ type (
 
 		// eventsV2
 		InsertIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error)
+		UpdateHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error)
 		SelectFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) ([]HistoryNodeRow, error)
 		DeleteFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) (sql.Result, error)
 		InsertIntoHistoryTree(ctx context.Context, row *HistoryTreeRow) (sql.Result, error)
@@ -819,6 +934,9 @@ type (
 		InsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// UpdateVisibilityMemo updates the memo and the update time of an existing row in visibility table
+		// Required row params - {domainID, runID, memo, encoding, updateTime}
+		UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {domainID, runID, closed=true}
@@ -829,6 +947,20 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns one page of rows from visibility table matching the filter condition
+		// Required filter params - {domainID, orderBy, pageSize}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows in visibility table matching the filter condition
+		// Required filter params - {domainID}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
+
+		InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []VisibilitySearchAttributeRow) (sql.Result, error)
+		// SelectFromVisibilitySearchAttributes returns the search attributes of one or more executions
+		// Required filter params - {domainID, runIDs}
+		SelectFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) ([]VisibilitySearchAttributeRow, error)
+		// DeleteFromVisibilitySearchAttributes deletes the search attributes of one or more executions
+		// Required filter params - {domainID, runIDs}
+		DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *VisibilitySearchAttributeFilter) (sql.Result, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
@@ -852,6 +984,52 @@ type (
 		// SelectFromDomainAuditLogs returns audit log entries for a domain. Returns paginated results ordered by created_time DESC, event_id ASC
 		SelectFromDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]*DomainAuditLogRow, error)
 
+		// ReplaceIntoShardDistributorExecutors inserts or replaces the heartbeat row of an executor
+		ReplaceIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorRow) (sql.Result, error)
+		// SelectFromShardDistributorExecutors returns the executors of a namespace. Required filter params - {namespace}
+		SelectFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) ([]ShardDistributorExecutorRow, error)
+		// DeleteFromShardDistributorExecutors deletes the executors matching the filter. Required filter params - {namespace}
+		DeleteFromShardDistributorExecutors(ctx context.Context, filter *ShardDistributorExecutorFilter) (sql.Result, error)
+
+		// InsertIntoShardDistributorAssignments inserts the assigned state of an executor, it fails if the row already exists
+		InsertIntoShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow) (sql.Result, error)
+		// UpdateShardDistributorAssignments updates the assigned state of an executor if its version is still previousVersion
+		UpdateShardDistributorAssignments(ctx context.Context, row *ShardDistributorAssignmentRow, previousVersion int64) (sql.Result, error)
+		// SelectFromShardDistributorAssignments returns the assigned states of a namespace. Required filter params - {namespace}
+		SelectFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) ([]ShardDistributorAssignmentRow, error)
+		// DeleteFromShardDistributorAssignments deletes the assigned states matching the filter. Required filter params - {namespace}
+		DeleteFromShardDistributorAssignments(ctx context.Context, filter *ShardDistributorAssignmentFilter) (sql.Result, error)
+
+		// InsertIntoShardDistributorShards inserts the owners of one or more shards, it fails if any of the shards already has an owner
+		InsertIntoShardDistributorShards(ctx context.Context, rows []ShardDistributorShardRow) (sql.Result, error)
+		// SelectFromShardDistributorShards returns the owners of the shards matching the filter. Required filter params - {namespace}
+		SelectFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) ([]ShardDistributorShardRow, error)
+		// DeleteFromShardDistributorShards deletes the owners of the shards matching the filter. Required filter params - {namespace}
+		DeleteFromShardDistributorShards(ctx context.Context, filter *ShardDistributorShardFilter) (sql.Result, error)
+
+		// ReplaceIntoShardDistributorShardStats inserts or replaces the statistics of one or more shards
+		ReplaceIntoShardDistributorShardStats(ctx context.Context, rows []ShardDistributorShardStatsRow) (sql.Result, error)
+		// SelectFromShardDistributorShardStats returns the statistics of the shards matching the filter. Required filter params - {namespace}
+		SelectFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) ([]ShardDistributorShardStatsRow, error)
+		// DeleteFromShardDistributorShardStats deletes the statistics of the shards matching the filter. Required filter params - {namespace}
+		DeleteFromShardDistributorShardStats(ctx context.Context, filter *ShardDistributorShardStatsFilter) (sql.Result, error)
+
+		// InsertIntoShardDistributorLeaders inserts the leader of a namespace, it fails if the row already exists
+		InsertIntoShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow) (sql.Result, error)
+		// UpdateShardDistributorLeaders updates the leader of a namespace if its term is still previousTerm
+		UpdateShardDistributorLeaders(ctx context.Context, row *ShardDistributorLeaderRow, previousTerm int64) (sql.Result, error)
+		// SelectFromShardDistributorLeaders returns the leader of a namespace
+		SelectFromShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error)
+		// LockShardDistributorLeaders acquires a write lock on the leader row of a namespace and returns it
+		LockShardDistributorLeaders(ctx context.Context, namespace string) (*ShardDistributorLeaderRow, error)
+
+		// InsertIntoShardDistributorControls inserts the operator controls of a namespace, it fails if the row already exists
+		InsertIntoShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow) (sql.Result, error)
+		// UpdateShardDistributorControls updates the operator controls of a namespace if its version is still previousVersion
+		UpdateShardDistributorControls(ctx context.Context, row *ShardDistributorControlRow, previousVersion int64) (sql.Result, error)
+		// SelectFromShardDistributorControls returns the operator controls of a namespace
+		SelectFromShardDistributorControls(ctx context.Context, namespace string) (*ShardDistributorControlRow, error)
+
 		// The follow provide information about the underlying sql crud implementation
 		SupportsTTL() bool
 		MaxAllowedTTL() (*time.Duration, error)
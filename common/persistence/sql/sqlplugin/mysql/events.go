@@ -33,6 +33,9 @@ const (
 		`shard_id, tree_id, branch_id, node_id, txn_id, data, data_encoding) ` +
 		`VALUES (:shard_id, :tree_id, :branch_id, :node_id, :txn_id, :data, :data_encoding) `
 
+	updateHistoryNodeQuery = `UPDATE history_node SET data = :data, data_encoding = :data_encoding ` +
+		`WHERE shard_id = :shard_id AND tree_id = :tree_id AND branch_id = :branch_id AND node_id = :node_id AND txn_id = :txn_id `
+
 	getHistoryNodesQuery = `SELECT node_id, txn_id, data, data_encoding FROM history_node ` +
 		`WHERE shard_id = ? AND tree_id = ? AND branch_id = ? AND node_id >= ? and node_id < ? ORDER BY shard_id, tree_id, branch_id, node_id, txn_id LIMIT ? `
 
@@ -62,6 +65,14 @@ func (mdb *DB) InsertIntoHistoryNode(ctx context.Context, row *sqlplugin.History
 	return mdb.driver.NamedExecContext(ctx, dbShardID, addHistoryNodesQuery, row)
 }
 
+// UpdateHistoryNode updates the data of a row in history_node table
+func (mdb *DB) UpdateHistoryNode(ctx context.Context, row *sqlplugin.HistoryNodeRow) (sql.Result, error) {
+	// NOTE: txn_id is stored multiplied by -1, see InsertIntoHistoryNode
+	*row.TxnID *= -1
+	dbShardID := sqlplugin.GetDBShardIDFromTreeID(row.TreeID, mdb.GetTotalNumDBShards())
+	return mdb.driver.NamedExecContext(ctx, dbShardID, updateHistoryNodeQuery, row)
+}
+
 // SelectFromHistoryNode reads one or more rows from history_node table
 func (mdb *DB) SelectFromHistoryNode(ctx context.Context, filter *sqlplugin.HistoryNodeFilter) ([]sqlplugin.HistoryNodeRow, error) {
 	var rows []sqlplugin.HistoryNodeRow
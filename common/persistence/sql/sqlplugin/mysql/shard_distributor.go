@@ -0,0 +1,223 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	_replaceShardDistributorExecutorQuery = `REPLACE INTO shard_distributor_executors (namespace, executor_id, status, data, data_encoding) VALUES (?, ?, ?, ?, ?)`
+	_selectShardDistributorExecutorsQuery = `SELECT namespace, executor_id, status, data, data_encoding FROM shard_distributor_executors WHERE namespace = ?`
+	_deleteShardDistributorExecutorsQuery = `DELETE FROM shard_distributor_executors WHERE namespace = ?`
+
+	_insertShardDistributorAssignmentQuery  = `INSERT INTO shard_distributor_assignments (namespace, executor_id, version, data, data_encoding) VALUES (?, ?, ?, ?, ?)`
+	_updateShardDistributorAssignmentQuery  = `UPDATE shard_distributor_assignments SET version = ?, data = ?, data_encoding = ? WHERE namespace = ? AND executor_id = ? AND version = ?`
+	_selectShardDistributorAssignmentsQuery = `SELECT namespace, executor_id, version, data, data_encoding FROM shard_distributor_assignments WHERE namespace = ?`
+	_deleteShardDistributorAssignmentsQuery = `DELETE FROM shard_distributor_assignments WHERE namespace = ?`
+
+	_insertShardDistributorShardsQuery = `INSERT INTO shard_distributor_shards (namespace, shard_id, executor_id) VALUES (:namespace, :shard_id, :executor_id)`
+	_selectShardDistributorShardsQuery = `SELECT namespace, shard_id, executor_id FROM shard_distributor_shards WHERE namespace = ?`
+	_deleteShardDistributorShardsQuery = `DELETE FROM shard_distributor_shards WHERE namespace = ?`
+
+	_replaceShardDistributorShardStatsQuery = `REPLACE INTO shard_distributor_shard_stats (namespace, shard_id, executor_id, data, data_encoding) VALUES (:namespace, :shard_id, :executor_id, :data, :data_encoding)`
+	_selectShardDistributorShardStatsQuery  = `SELECT namespace, shard_id, executor_id, data, data_encoding FROM shard_distributor_shard_stats WHERE namespace = ?`
+	_deleteShardDistributorShardStatsQuery  = `DELETE FROM shard_distributor_shard_stats WHERE namespace = ?`
+
+	_insertShardDistributorLeaderQuery = `INSERT INTO shard_distributor_leaders (namespace, leader_id, term, lease_expiry) VALUES (?, ?, ?, ?)`
+	_updateShardDistributorLeaderQuery = `UPDATE shard_distributor_leaders SET leader_id = ?, term = ?, lease_expiry = ? WHERE namespace = ? AND term = ?`
+	_selectShardDistributorLeaderQuery = `SELECT namespace, leader_id, term, lease_expiry FROM shard_distributor_leaders WHERE namespace = ?`
+	_lockShardDistributorLeaderQuery   = _selectShardDistributorLeaderQuery + ` FOR UPDATE`
+
+	_insertShardDistributorControlsQuery = `INSERT INTO shard_distributor_controls (namespace, version, data, data_encoding) VALUES (?, ?, ?, ?)`
+	_updateShardDistributorControlsQuery = `UPDATE shard_distributor_controls SET version = ?, data = ?, data_encoding = ? WHERE namespace = ? AND version = ?`
+	_selectShardDistributorControlsQuery = `SELECT namespace, version, data, data_encoding FROM shard_distributor_controls WHERE namespace = ?`
+
+	_shardDistributorExecutorIDCondition = ` AND executor_id = ?`
+	_shardDistributorShardIDsCondition   = ` AND shard_id IN ( ? )`
+)
+
+// ReplaceIntoShardDistributorExecutors replaces a single row in shard_distributor_executors table
+func (mdb *DB) ReplaceIntoShardDistributorExecutors(ctx context.Context, row *sqlplugin.ShardDistributorExecutorRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _replaceShardDistributorExecutorQuery, row.Namespace, row.ExecutorID, row.Status, row.Data, row.DataEncoding)
+}
+
+// SelectFromShardDistributorExecutors reads one or more rows from shard_distributor_executors table
+func (mdb *DB) SelectFromShardDistributorExecutors(ctx context.Context, filter *sqlplugin.ShardDistributorExecutorFilter) ([]sqlplugin.ShardDistributorExecutorRow, error) {
+	query, args := withExecutorIDCondition(_selectShardDistributorExecutorsQuery, filter.Namespace, filter.ExecutorID)
+	var rows []sqlplugin.ShardDistributorExecutorRow
+	err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, query, args...)
+	return rows, err
+}
+
+// DeleteFromShardDistributorExecutors deletes one or more rows from shard_distributor_executors table
+func (mdb *DB) DeleteFromShardDistributorExecutors(ctx context.Context, filter *sqlplugin.ShardDistributorExecutorFilter) (sql.Result, error) {
+	query, args := withExecutorIDCondition(_deleteShardDistributorExecutorsQuery, filter.Namespace, filter.ExecutorID)
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, query, args...)
+}
+
+// InsertIntoShardDistributorAssignments inserts a single row into shard_distributor_assignments table
+func (mdb *DB) InsertIntoShardDistributorAssignments(ctx context.Context, row *sqlplugin.ShardDistributorAssignmentRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertShardDistributorAssignmentQuery, row.Namespace, row.ExecutorID, row.Version, row.Data, row.DataEncoding)
+}
+
+// UpdateShardDistributorAssignments updates a single row in shard_distributor_assignments table
+func (mdb *DB) UpdateShardDistributorAssignments(ctx context.Context, row *sqlplugin.ShardDistributorAssignmentRow, previousVersion int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorAssignmentQuery, row.Version, row.Data, row.DataEncoding, row.Namespace, row.ExecutorID, previousVersion)
+}
+
+// SelectFromShardDistributorAssignments reads one or more rows from shard_distributor_assignments table
+func (mdb *DB) SelectFromShardDistributorAssignments(ctx context.Context, filter *sqlplugin.ShardDistributorAssignmentFilter) ([]sqlplugin.ShardDistributorAssignmentRow, error) {
+	query, args := withExecutorIDCondition(_selectShardDistributorAssignmentsQuery, filter.Namespace, filter.ExecutorID)
+	var rows []sqlplugin.ShardDistributorAssignmentRow
+	err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, query, args...)
+	return rows, err
+}
+
+// DeleteFromShardDistributorAssignments deletes one or more rows from shard_distributor_assignments table
+func (mdb *DB) DeleteFromShardDistributorAssignments(ctx context.Context, filter *sqlplugin.ShardDistributorAssignmentFilter) (sql.Result, error) {
+	query, args := withExecutorIDCondition(_deleteShardDistributorAssignmentsQuery, filter.Namespace, filter.ExecutorID)
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, query, args...)
+}
+
+// InsertIntoShardDistributorShards inserts one or more rows into shard_distributor_shards table
+func (mdb *DB) InsertIntoShardDistributorShards(ctx context.Context, rows []sqlplugin.ShardDistributorShardRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, _insertShardDistributorShardsQuery, rows)
+}
+
+// SelectFromShardDistributorShards reads one or more rows from shard_distributor_shards table
+func (mdb *DB) SelectFromShardDistributorShards(ctx context.Context, filter *sqlplugin.ShardDistributorShardFilter) ([]sqlplugin.ShardDistributorShardRow, error) {
+	query, args, err := withShardCondition(_selectShardDistributorShardsQuery, filter.Namespace, filter.ShardIDs, filter.ExecutorID)
+	if err != nil {
+		return nil, err
+	}
+	var rows []sqlplugin.ShardDistributorShardRow
+	err = mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, query, args...)
+	return rows, err
+}
+
+// DeleteFromShardDistributorShards deletes one or more rows from shard_distributor_shards table
+func (mdb *DB) DeleteFromShardDistributorShards(ctx context.Context, filter *sqlplugin.ShardDistributorShardFilter) (sql.Result, error) {
+	query, args, err := withShardCondition(_deleteShardDistributorShardsQuery, filter.Namespace, filter.ShardIDs, filter.ExecutorID)
+	if err != nil {
+		return nil, err
+	}
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, query, args...)
+}
+
+// ReplaceIntoShardDistributorShardStats replaces one or more rows in shard_distributor_shard_stats table
+func (mdb *DB) ReplaceIntoShardDistributorShardStats(ctx context.Context, rows []sqlplugin.ShardDistributorShardStatsRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, _replaceShardDistributorShardStatsQuery, rows)
+}
+
+// SelectFromShardDistributorShardStats reads one or more rows from shard_distributor_shard_stats table
+func (mdb *DB) SelectFromShardDistributorShardStats(ctx context.Context, filter *sqlplugin.ShardDistributorShardStatsFilter) ([]sqlplugin.ShardDistributorShardStatsRow, error) {
+	query, args, err := withShardCondition(_selectShardDistributorShardStatsQuery, filter.Namespace, filter.ShardIDs, filter.ExecutorID)
+	if err != nil {
+		return nil, err
+	}
+	var rows []sqlplugin.ShardDistributorShardStatsRow
+	err = mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, query, args...)
+	return rows, err
+}
+
+// DeleteFromShardDistributorShardStats deletes one or more rows from shard_distributor_shard_stats table
+func (mdb *DB) DeleteFromShardDistributorShardStats(ctx context.Context, filter *sqlplugin.ShardDistributorShardStatsFilter) (sql.Result, error) {
+	query, args, err := withShardCondition(_deleteShardDistributorShardStatsQuery, filter.Namespace, filter.ShardIDs, filter.ExecutorID)
+	if err != nil {
+		return nil, err
+	}
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, query, args...)
+}
+
+// InsertIntoShardDistributorLeaders inserts a single row into shard_distributor_leaders table
+func (mdb *DB) InsertIntoShardDistributorLeaders(ctx context.Context, row *sqlplugin.ShardDistributorLeaderRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertShardDistributorLeaderQuery, row.Namespace, row.LeaderID, row.Term, mdb.converter.ToDateTime(row.LeaseExpiry))
+}
+
+// UpdateShardDistributorLeaders updates a single row in shard_distributor_leaders table
+func (mdb *DB) UpdateShardDistributorLeaders(ctx context.Context, row *sqlplugin.ShardDistributorLeaderRow, previousTerm int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorLeaderQuery, row.LeaderID, row.Term, mdb.converter.ToDateTime(row.LeaseExpiry), row.Namespace, previousTerm)
+}
+
+// SelectFromShardDistributorLeaders reads a single row from shard_distributor_leaders table
+func (mdb *DB) SelectFromShardDistributorLeaders(ctx context.Context, namespace string) (*sqlplugin.ShardDistributorLeaderRow, error) {
+	return mdb.getShardDistributorLeader(ctx, _selectShardDistributorLeaderQuery, namespace)
+}
+
+// LockShardDistributorLeaders acquires a write lock on a single row in shard_distributor_leaders table
+func (mdb *DB) LockShardDistributorLeaders(ctx context.Context, namespace string) (*sqlplugin.ShardDistributorLeaderRow, error) {
+	return mdb.getShardDistributorLeader(ctx, _lockShardDistributorLeaderQuery, namespace)
+}
+
+func (mdb *DB) getShardDistributorLeader(ctx context.Context, query string, namespace string) (*sqlplugin.ShardDistributorLeaderRow, error) {
+	var row sqlplugin.ShardDistributorLeaderRow
+	if err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, query, namespace); err != nil {
+		return nil, err
+	}
+	row.LeaseExpiry = mdb.converter.FromDateTime(row.LeaseExpiry)
+	return &row, nil
+}
+
+// InsertIntoShardDistributorControls inserts a single row into shard_distributor_controls table
+func (mdb *DB) InsertIntoShardDistributorControls(ctx context.Context, row *sqlplugin.ShardDistributorControlRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertShardDistributorControlsQuery, row.Namespace, row.Version, row.Data, row.DataEncoding)
+}
+
+// UpdateShardDistributorControls updates a single row in shard_distributor_controls table
+func (mdb *DB) UpdateShardDistributorControls(ctx context.Context, row *sqlplugin.ShardDistributorControlRow, previousVersion int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorControlsQuery, row.Version, row.Data, row.DataEncoding, row.Namespace, previousVersion)
+}
+
+// SelectFromShardDistributorControls reads a single row from shard_distributor_controls table
+func (mdb *DB) SelectFromShardDistributorControls(ctx context.Context, namespace string) (*sqlplugin.ShardDistributorControlRow, error) {
+	var row sqlplugin.ShardDistributorControlRow
+	if err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectShardDistributorControlsQuery, namespace); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func withExecutorIDCondition(query string, namespace string, executorID *string) (string, []interface{}) {
+	args := []interface{}{namespace}
+	if executorID != nil {
+		query += _shardDistributorExecutorIDCondition
+		args = append(args, *executorID)
+	}
+	return query, args
+}
+
+func withShardCondition(query string, namespace string, shardIDs []string, executorID *string) (string, []interface{}, error) {
+	if len(shardIDs) > 0 {
+		query, args, err := sqlx.In(query+_shardDistributorShardIDsCondition, namespace, shardIDs)
+		if err != nil {
+			return "", nil, err
+		}
+		return sqlx.Rebind(sqlx.BindType(PluginName), query), args, nil
+	}
+	query, args := withExecutorIDCondition(query, namespace, executorID)
+	return query, args, nil
+}
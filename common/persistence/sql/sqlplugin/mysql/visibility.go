@@ -25,6 +25,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
@@ -71,7 +74,28 @@ const (
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
+	templateUpdateWorkflowExecutionMemo = `UPDATE executions_visibility SET memo = ?, encoding = ?, update_time = ? WHERE domain_id = ? AND run_id = ?`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateQueryFieldNames = `v.workflow_id, v.run_id, v.start_time, v.execution_time, v.workflow_type_name, v.memo, v.encoding, v.is_cron, v.update_time, v.shard_id,
+		 v.close_time, v.close_status, v.history_length,
+		 COALESCE(v.cron_schedule, '') AS cron_schedule, COALESCE(v.num_clusters, 0) AS num_clusters, COALESCE(v.execution_status, 0) AS execution_status`
+
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility v WHERE v.domain_id = ?`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility v WHERE v.domain_id = ?`
+
+	templateCreateSearchAttributes = `INSERT INTO executions_visibility_search_attributes (` +
+		`domain_id, run_id, attr_key, attr_index, string_value, int_value, double_value, datetime_value) ` +
+		`VALUES (:domain_id, :run_id, :attr_key, :attr_index, :string_value, :int_value, :double_value, :datetime_value)`
+
+	templateGetSearchAttributes = `SELECT run_id, attr_key, attr_index, string_value, int_value, double_value, datetime_value
+		 FROM executions_visibility_search_attributes
+		 WHERE domain_id = ? AND run_id IN ( ? )
+		 ORDER BY run_id, attr_key, attr_index`
+
+	templateDeleteSearchAttributes = `DELETE FROM executions_visibility_search_attributes WHERE domain_id = ? AND run_id IN ( ? )`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -136,6 +160,19 @@ func (mdb *DB) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 	}
 }
 
+// UpdateVisibilityMemo updates the memo and the update time of an existing row in visibility table
+func (mdb *DB) UpdateVisibilityMemo(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.UpdateTime,
+		row.DomainID,
+		row.RunID)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *DB) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
@@ -235,3 +272,99 @@ func (mdb *DB) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads one page of rows from visibility table matching the filter condition
+func (mdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := mdb.buildVisibilityQuery(templateGetWorkflowExecutionsByQuery, filter)
+	query += ` ORDER BY ` + filter.OrderBy + ` LIMIT ? OFFSET ?`
+	args = append(args, filter.PageSize, filter.Offset)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows in visibility table matching the filter condition
+func (mdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := mdb.buildVisibilityQuery(templateCountWorkflowExecutionsByQuery, filter)
+	var count int64
+	err := mdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// InsertIntoVisibilitySearchAttributes inserts one or more rows into executions_visibility_search_attributes table
+func (mdb *DB) InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []sqlplugin.VisibilitySearchAttributeRow) (sql.Result, error) {
+	if len(rows) == 0 {
+		return nil, errors.New("no search attribute rows to insert")
+	}
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(rows[0].DomainID, mdb.GetTotalNumDBShards())
+	converted := make([]sqlplugin.VisibilitySearchAttributeRow, len(rows))
+	for i, row := range rows {
+		if row.DatetimeValue != nil {
+			datetimeValue := mdb.converter.ToDateTime(*row.DatetimeValue)
+			row.DatetimeValue = &datetimeValue
+		}
+		converted[i] = row
+	}
+	return mdb.driver.NamedExecContext(ctx, dbShardID, templateCreateSearchAttributes, converted)
+}
+
+// SelectFromVisibilitySearchAttributes reads the search attributes of one or more executions
+func (mdb *DB) SelectFromVisibilitySearchAttributes(ctx context.Context, filter *sqlplugin.VisibilitySearchAttributeFilter) ([]sqlplugin.VisibilitySearchAttributeRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args, err := sqlx.In(templateGetSearchAttributes, filter.DomainID, filter.RunIDs)
+	if err != nil {
+		return nil, err
+	}
+	var rows []sqlplugin.VisibilitySearchAttributeRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, sqlx.Rebind(sqlx.BindType(PluginName), query), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].DomainID = filter.DomainID
+		if rows[i].DatetimeValue != nil {
+			datetimeValue := mdb.converter.FromDateTime(*rows[i].DatetimeValue)
+			rows[i].DatetimeValue = &datetimeValue
+		}
+	}
+	return rows, nil
+}
+
+// DeleteFromVisibilitySearchAttributes deletes the search attributes of one or more executions
+func (mdb *DB) DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *sqlplugin.VisibilitySearchAttributeFilter) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args, err := sqlx.In(templateDeleteSearchAttributes, filter.DomainID, filter.RunIDs)
+	if err != nil {
+		return nil, err
+	}
+	return mdb.driver.ExecContext(ctx, dbShardID, sqlx.Rebind(sqlx.BindType(PluginName), query), args...)
+}
+
+// buildVisibilityQuery appends the filter condition to the given select statement
+// and converts time arguments to the database representation
+func (mdb *DB) buildVisibilityQuery(selectStmt string, filter *sqlplugin.VisibilityQueryFilter) (string, []interface{}) {
+	query := selectStmt
+	args := []interface{}{filter.DomainID}
+	if filter.Condition != "" {
+		query += ` AND (` + filter.Condition + `)`
+	}
+	for _, arg := range filter.Args {
+		if t, ok := arg.(time.Time); ok {
+			arg = mdb.converter.ToDateTime(t)
+		}
+		args = append(args, arg)
+	}
+	return query, args
+}
@@ -26,6 +26,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
@@ -97,7 +100,29 @@ const (
 		 WHERE domain_id = $1 AND close_status IS NOT NULL
 		 AND run_id = $2`
 
+	templateUpdateWorkflowExecutionMemo = `UPDATE executions_visibility SET memo = $1, encoding = $2, update_time = $3 WHERE domain_id = $4 AND run_id = $5`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=$1 AND run_id=$2"
+
+	// query based templates use ? placeholders as the condition is built at runtime, they are rebound before execution
+	templateQueryFieldNames = `v.workflow_id, v.run_id, v.start_time, v.execution_time, v.workflow_type_name, v.memo, v.encoding, v.is_cron, v.update_time, v.shard_id,
+		 v.close_time, v.close_status, v.history_length,
+		 COALESCE(v.cron_schedule, '') AS cron_schedule, COALESCE(v.num_clusters, 0) AS num_clusters, COALESCE(v.execution_status, 0) AS execution_status`
+
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility v WHERE v.domain_id = ?`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility v WHERE v.domain_id = ?`
+
+	templateCreateSearchAttributes = `INSERT INTO executions_visibility_search_attributes (` +
+		`domain_id, run_id, attr_key, attr_index, string_value, int_value, double_value, datetime_value) ` +
+		`VALUES (:domain_id, :run_id, :attr_key, :attr_index, :string_value, :int_value, :double_value, :datetime_value)`
+
+	templateGetSearchAttributes = `SELECT run_id, attr_key, attr_index, string_value, int_value, double_value, datetime_value
+		 FROM executions_visibility_search_attributes
+		 WHERE domain_id = ? AND run_id IN ( ? )
+		 ORDER BY run_id, attr_key, attr_index`
+
+	templateDeleteSearchAttributes = `DELETE FROM executions_visibility_search_attributes WHERE domain_id = ? AND run_id IN ( ? )`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -158,6 +183,17 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 	}
 }
 
+// UpdateVisibilityMemo updates the memo and the update time of an existing row in visibility table
+func (pdb *db) UpdateVisibilityMemo(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.UpdateTime,
+		row.DomainID,
+		row.RunID)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
@@ -253,3 +289,102 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads one page of rows from visibility table matching the filter condition
+func (pdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	query, args := pdb.buildVisibilityQuery(templateGetWorkflowExecutionsByQuery, filter)
+	query += ` ORDER BY ` + filter.OrderBy + ` LIMIT ? OFFSET ?`
+	args = append(args, filter.PageSize, filter.Offset)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, sqlx.Rebind(sqlx.BindType(PluginName), query), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows in visibility table matching the filter condition
+func (pdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	query, args := pdb.buildVisibilityQuery(templateCountWorkflowExecutionsByQuery, filter)
+	var count int64
+	err := pdb.driver.GetContext(ctx, dbShardID, &count, sqlx.Rebind(sqlx.BindType(PluginName), query), args...)
+	return count, err
+}
+
+// InsertIntoVisibilitySearchAttributes inserts one or more rows into executions_visibility_search_attributes table
+func (pdb *db) InsertIntoVisibilitySearchAttributes(ctx context.Context, rows []sqlplugin.VisibilitySearchAttributeRow) (sql.Result, error) {
+	if len(rows) == 0 {
+		return nil, errors.New("no search attribute rows to insert")
+	}
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(rows[0].DomainID, pdb.GetTotalNumDBShards())
+	converted := make([]sqlplugin.VisibilitySearchAttributeRow, len(rows))
+	for i, row := range rows {
+		if row.DatetimeValue != nil {
+			datetimeValue := pdb.converter.ToPostgresDateTime(*row.DatetimeValue)
+			row.DatetimeValue = &datetimeValue
+		}
+		converted[i] = row
+	}
+	return pdb.driver.NamedExecContext(ctx, dbShardID, templateCreateSearchAttributes, converted)
+}
+
+// SelectFromVisibilitySearchAttributes reads the search attributes of one or more executions
+func (pdb *db) SelectFromVisibilitySearchAttributes(ctx context.Context, filter *sqlplugin.VisibilitySearchAttributeFilter) ([]sqlplugin.VisibilitySearchAttributeRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	query, args, err := sqlx.In(templateGetSearchAttributes, filter.DomainID, filter.RunIDs)
+	if err != nil {
+		return nil, err
+	}
+	var rows []sqlplugin.VisibilitySearchAttributeRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, sqlx.Rebind(sqlx.BindType(PluginName), query), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].DomainID = filter.DomainID
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		if rows[i].DatetimeValue != nil {
+			datetimeValue := pdb.converter.FromPostgresDateTime(*rows[i].DatetimeValue)
+			rows[i].DatetimeValue = &datetimeValue
+		}
+	}
+	return rows, nil
+}
+
+// DeleteFromVisibilitySearchAttributes deletes the search attributes of one or more executions
+func (pdb *db) DeleteFromVisibilitySearchAttributes(ctx context.Context, filter *sqlplugin.VisibilitySearchAttributeFilter) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	query, args, err := sqlx.In(templateDeleteSearchAttributes, filter.DomainID, filter.RunIDs)
+	if err != nil {
+		return nil, err
+	}
+	return pdb.driver.ExecContext(ctx, dbShardID, sqlx.Rebind(sqlx.BindType(PluginName), query), args...)
+}
+
+// buildVisibilityQuery appends the filter condition to the given select statement
+// and converts time arguments to the database representation
+func (pdb *db) buildVisibilityQuery(selectStmt string, filter *sqlplugin.VisibilityQueryFilter) (string, []interface{}) {
+	query := selectStmt
+	args := []interface{}{filter.DomainID}
+	if filter.Condition != "" {
+		query += ` AND (` + filter.Condition + `)`
+	}
+	for _, arg := range filter.Args {
+		if t, ok := arg.(time.Time); ok {
+			arg = pdb.converter.ToPostgresDateTime(t)
+		}
+		args = append(args, arg)
+	}
+	return query, args
+}
@@ -83,6 +83,8 @@ func updateActivityInfos(
 				RetryLastFailureReason:   activityInfo.LastFailureReason,
 				RetryLastWorkerIdentity:  activityInfo.LastWorkerIdentity,
 				RetryLastFailureDetails:  activityInfo.LastFailureDetails,
+				Paused:                   activityInfo.Paused,
+				Priority:                 activityInfo.Priority,
 			}
 			blob, err := parser.ActivityInfoToBlob(info)
 			if err != nil {
@@ -181,6 +183,8 @@ func getActivityInfoMap(
 			LastFailureReason:        decoded.GetRetryLastFailureReason(),
 			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+			Paused:                   decoded.GetPaused(),
+			Priority:                 decoded.GetPriority(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, constants.EncodingType(decoded.GetStartedEventEncoding()))
@@ -55,6 +55,10 @@ type Task interface {
 	SetTaskID(id int64)
 	GetVisibilityTimestamp() time.Time
 	SetVisibilityTimestamp(timestamp time.Time)
+	// GetTraceContext returns the propagated trace context of the request
+	// that generated the task, so that task processing can continue the trace.
+	GetTraceContext() map[string]string
+	SetTraceContext(traceContext map[string]string)
 	ByteSize() uint64
 	ToTransferTaskInfo() (*TransferTaskInfo, error)
 	ToTimerTaskInfo() (*TimerTaskInfo, error)
@@ -84,6 +88,7 @@ type (
 		Version             int64
 		TaskID              int64
 		VisibilityTimestamp time.Time
+		TraceContext        map[string]string
 	}
 
 	// ActivityTask identifies a transfer task for activity
@@ -420,8 +425,22 @@ func (a *TaskData) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetTraceContext returns the trace context of the task
+func (a *TaskData) GetTraceContext() map[string]string {
+	return a.TraceContext
+}
+
+// SetTraceContext sets the trace context of the task
+func (a *TaskData) SetTraceContext(traceContext map[string]string) {
+	a.TraceContext = traceContext
+}
+
 func (a *TaskData) ByteSize() uint64 {
-	return uint64(8 + 8 + 24) // time.Time is 24 bytes
+	size := uint64(8 + 8 + 24) // time.Time is 24 bytes
+	for k, v := range a.TraceContext {
+		size += uint64(len(k) + len(v))
+	}
+	return size
 }
 
 // GetType returns the type of the activity task
@@ -467,6 +486,7 @@ func (a *ActivityTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
 		ScheduleID:          a.ScheduleID,
 		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
 		TargetRunID:         TransferTaskTransferTargetRunID,
+		TraceContext:        a.TraceContext,
 	}, nil
 }
 
@@ -523,6 +543,7 @@ func (d *DecisionTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
 		OriginalTaskListKind: d.OriginalTaskListKind,
 		TargetWorkflowID:     TransferTaskTransferTargetWorkflowID,
 		TargetRunID:          TransferTaskTransferTargetRunID,
+		TraceContext:         d.TraceContext,
 	}, nil
 }
 
@@ -576,6 +597,7 @@ func (a *RecordWorkflowStartedTask) ToTransferTaskInfo() (*TransferTaskInfo, err
 		TargetDomainID:      a.DomainID,
 		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
 		TargetRunID:         TransferTaskTransferTargetRunID,
+		TraceContext:        a.TraceContext,
 	}, nil
 }
 
@@ -629,6 +651,7 @@ func (a *ResetWorkflowTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
 		TargetDomainID:      a.DomainID,
 		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
 		TargetRunID:         TransferTaskTransferTargetRunID,
+		TraceContext:        a.TraceContext,
 	}, nil
 }
 
@@ -682,6 +705,7 @@ func (a *CloseExecutionTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
 		TargetDomainID:      a.DomainID,
 		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
 		TargetRunID:         TransferTaskTransferTargetRunID,
+		TraceContext:        a.TraceContext,
 	}, nil
 }
 
@@ -736,6 +760,7 @@ func (a *DeleteHistoryEventTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
 		VisibilityTimestamp: a.VisibilityTimestamp,
 		Version:             a.Version,
 		TaskList:            a.TaskList,
+		TraceContext:        a.TraceContext,
 	}, nil
 }
 
@@ -789,6 +814,7 @@ func (d *DecisionTimeoutTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
 		ScheduleAttempt:     d.ScheduleAttempt,
 		TimeoutType:         d.TimeoutType,
 		TaskList:            d.TaskList,
+		TraceContext:        d.TraceContext,
 	}, nil
 }
 
@@ -842,6 +868,7 @@ func (a *ActivityTimeoutTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
 		ScheduleAttempt:     a.Attempt,
 		TimeoutType:         a.TimeoutType,
 		TaskList:            a.TaskList,
+		TraceContext:        a.TraceContext,
 	}, nil
 }
 
@@ -893,6 +920,7 @@ func (u *UserTimerTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
 		Version:             u.Version,
 		EventID:             u.EventID,
 		TaskList:            u.TaskList,
+		TraceContext:        u.TraceContext,
 	}, nil
 }
 
@@ -945,6 +973,7 @@ func (r *ActivityRetryTimerTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
 		EventID:             r.EventID,
 		ScheduleAttempt:     r.Attempt,
 		TaskList:            r.TaskList,
+		TraceContext:        r.TraceContext,
 	}, nil
 }
 
@@ -996,6 +1025,7 @@ func (r *WorkflowBackoffTimerTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
 		Version:             r.Version,
 		TimeoutType:         r.TimeoutType,
 		TaskList:            r.TaskList,
+		TraceContext:        r.TraceContext,
 	}, nil
 }
 
@@ -1046,6 +1076,7 @@ func (u *WorkflowTimeoutTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
 		VisibilityTimestamp: u.VisibilityTimestamp,
 		Version:             u.Version,
 		TaskList:            u.TaskList,
+		TraceContext:        u.TraceContext,
 	}, nil
 }
 
@@ -1101,6 +1132,7 @@ func (u *CancelExecutionTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
 		TargetChildWorkflowOnly: u.TargetChildWorkflowOnly,
 		ScheduleID:              u.InitiatedID,
 		TaskList:                u.TaskList,
+		TraceContext:            u.TraceContext,
 	}, nil
 }
 
@@ -1160,6 +1192,7 @@ func (u *SignalExecutionTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
 		TargetChildWorkflowOnly: u.TargetChildWorkflowOnly,
 		ScheduleID:              u.InitiatedID,
 		TaskList:                u.TaskList,
+		TraceContext:            u.TraceContext,
 	}, nil
 }
 
@@ -1217,6 +1250,7 @@ func (u *RecordChildExecutionCompletedTask) ToTransferTaskInfo() (*TransferTaskI
 		TargetWorkflowID:    u.TargetWorkflowID,
 		TargetRunID:         targetRunID,
 		TaskList:            u.TaskList,
+		TraceContext:        u.TraceContext,
 	}, nil
 }
 
@@ -1270,6 +1304,7 @@ func (u *UpsertWorkflowSearchAttributesTask) ToTransferTaskInfo() (*TransferTask
 		TargetDomainID:      u.DomainID,
 		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
 		TargetRunID:         TransferTaskTransferTargetRunID,
+		TraceContext:        u.TraceContext,
 	}, nil
 }
 
@@ -1324,6 +1359,7 @@ func (u *StartChildExecutionTask) ToTransferTaskInfo() (*TransferTaskInfo, error
 		ScheduleID:          u.InitiatedID,
 		TaskList:            u.TaskList,
 		TargetRunID:         TransferTaskTransferTargetRunID,
+		TraceContext:        u.TraceContext,
 	}, nil
 }
 
@@ -1377,6 +1413,7 @@ func (u *RecordWorkflowClosedTask) ToTransferTaskInfo() (*TransferTaskInfo, erro
 		TargetDomainID:      u.DomainID,
 		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
 		TargetRunID:         TransferTaskTransferTargetRunID,
+		TraceContext:        u.TraceContext,
 	}, nil
 }
 
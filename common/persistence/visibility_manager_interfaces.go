@@ -25,6 +25,9 @@
 // Generate error injection wrapper.
 //go:generate gowrap gen -g -p . -i VisibilityManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/visibility_generated.go
 
+// Generate fault injection wrapper.
+//go:generate gowrap gen -g -p . -i VisibilityManager -t ./wrappers/templates/faultinjector.tmpl -o wrappers/faultinjectors/visibility_generated.go
+
 // Generate metered wrapper.
 //go:generate gowrap gen -g -p . -i VisibilityManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/visibility_generated.go
 
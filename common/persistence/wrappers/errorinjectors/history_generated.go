@@ -163,3 +163,18 @@ func (c *injectorHistoryManager) ReadRawHistoryBranch(ctx context.Context, reque
 	}
 	return
 }
+
+func (c *injectorHistoryManager) ReencryptHistoryBranch(ctx context.Context, request *persistence.ReencryptHistoryBranchRequest) (rp1 *persistence.ReencryptHistoryBranchResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		rp1, err = c.wrapped.ReencryptHistoryBranch(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "HistoryManager.ReencryptHistoryBranch", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
@@ -0,0 +1,55 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// faultInjectorConfigStoreManager implements persistence.ConfigStoreManager interface instrumented with fault injection.
+type faultInjectorConfigStoreManager struct {
+	wrapped  persistence.ConfigStoreManager
+	injector *Injector
+}
+
+// NewConfigStoreManager creates a new instance of ConfigStoreManager with fault injection.
+func NewConfigStoreManager(
+	wrapped persistence.ConfigStoreManager,
+	injector *Injector,
+) persistence.ConfigStoreManager {
+	return &faultInjectorConfigStoreManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorConfigStoreManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgType persistence.ConfigType) (fp1 *persistence.FetchDynamicConfigResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ConfigStoreManager.FetchDynamicConfig", cfgType, c.wrapped)
+	if forward {
+		fp1, err = c.wrapped.FetchDynamicConfig(ctx, cfgType)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *persistence.UpdateDynamicConfigRequest, cfgType persistence.ConfigType) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ConfigStoreManager.UpdateDynamicConfig", request, c.wrapped)
+	if forward {
+		err = c.wrapped.UpdateDynamicConfig(ctx, request, cfgType)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
@@ -0,0 +1,114 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// faultInjectorDomainManager implements persistence.DomainManager interface instrumented with fault injection.
+type faultInjectorDomainManager struct {
+	wrapped  persistence.DomainManager
+	injector *Injector
+}
+
+// NewDomainManager creates a new instance of DomainManager with fault injection.
+func NewDomainManager(
+	wrapped persistence.DomainManager,
+	injector *Injector,
+) persistence.DomainManager {
+	return &faultInjectorDomainManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorDomainManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorDomainManager) CreateDomain(ctx context.Context, request *persistence.CreateDomainRequest) (cp1 *persistence.CreateDomainResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "DomainManager.CreateDomain", request, c.wrapped)
+	if forward {
+		cp1, err = c.wrapped.CreateDomain(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorDomainManager) DeleteDomain(ctx context.Context, request *persistence.DeleteDomainRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "DomainManager.DeleteDomain", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteDomain(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorDomainManager) DeleteDomainByName(ctx context.Context, request *persistence.DeleteDomainByNameRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "DomainManager.DeleteDomainByName", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteDomainByName(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorDomainManager) GetDomain(ctx context.Context, request *persistence.GetDomainRequest) (gp1 *persistence.GetDomainResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "DomainManager.GetDomain", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetDomain(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorDomainManager) GetMetadata(ctx context.Context) (gp1 *persistence.GetMetadataResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "DomainManager.GetMetadata", nil, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetMetadata(ctx)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorDomainManager) GetName() (s1 string) {
+	return c.wrapped.GetName()
+}
+
+func (c *faultInjectorDomainManager) ListDomains(ctx context.Context, request *persistence.ListDomainsRequest) (lp1 *persistence.ListDomainsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "DomainManager.ListDomains", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListDomains(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorDomainManager) UpdateDomain(ctx context.Context, request *persistence.UpdateDomainRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "DomainManager.UpdateDomain", request, c.wrapped)
+	if forward {
+		err = c.wrapped.UpdateDomain(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
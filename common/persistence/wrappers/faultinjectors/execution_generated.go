@@ -0,0 +1,273 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// faultInjectorExecutionManager implements persistence.ExecutionManager interface instrumented with fault injection.
+type faultInjectorExecutionManager struct {
+	wrapped  persistence.ExecutionManager
+	injector *Injector
+}
+
+// NewExecutionManager creates a new instance of ExecutionManager with fault injection.
+func NewExecutionManager(
+	wrapped persistence.ExecutionManager,
+	injector *Injector,
+) persistence.ExecutionManager {
+	return &faultInjectorExecutionManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorExecutionManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorExecutionManager) CompleteHistoryTask(ctx context.Context, request *persistence.CompleteHistoryTaskRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.CompleteHistoryTask", request, c.wrapped)
+	if forward {
+		err = c.wrapped.CompleteHistoryTask(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) ConflictResolveWorkflowExecution(ctx context.Context, request *persistence.ConflictResolveWorkflowExecutionRequest) (cp1 *persistence.ConflictResolveWorkflowExecutionResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.ConflictResolveWorkflowExecution", request, c.wrapped)
+	if forward {
+		cp1, err = c.wrapped.ConflictResolveWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) CreateFailoverMarkerTasks(ctx context.Context, request *persistence.CreateFailoverMarkersRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.CreateFailoverMarkerTasks", request, c.wrapped)
+	if forward {
+		err = c.wrapped.CreateFailoverMarkerTasks(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (cp1 *persistence.CreateWorkflowExecutionResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.CreateWorkflowExecution", request, c.wrapped)
+	if forward {
+		cp1, err = c.wrapped.CreateWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) DeleteActiveClusterSelectionPolicy(ctx context.Context, request *persistence.DeleteActiveClusterSelectionPolicyRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.DeleteActiveClusterSelectionPolicy", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteActiveClusterSelectionPolicy(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *persistence.DeleteCurrentWorkflowExecutionRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.DeleteCurrentWorkflowExecution", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteCurrentWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.DeleteReplicationTaskFromDLQRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.DeleteReplicationTaskFromDLQ", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteReplicationTaskFromDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.DeleteWorkflowExecutionRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.DeleteWorkflowExecution", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) GetActiveClusterSelectionPolicy(ctx context.Context, request *persistence.GetActiveClusterSelectionPolicyRequest) (ap1 *types.ActiveClusterSelectionPolicy, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.GetActiveClusterSelectionPolicy", request, c.wrapped)
+	if forward {
+		ap1, err = c.wrapped.GetActiveClusterSelectionPolicy(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) GetCurrentExecution(ctx context.Context, request *persistence.GetCurrentExecutionRequest) (gp1 *persistence.GetCurrentExecutionResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.GetCurrentExecution", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetCurrentExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) GetHistoryTasks(ctx context.Context, request *persistence.GetHistoryTasksRequest) (gp1 *persistence.GetHistoryTasksResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.GetHistoryTasks", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetHistoryTasks(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) GetName() (s1 string) {
+	return c.wrapped.GetName()
+}
+
+func (c *faultInjectorExecutionManager) GetReplicationDLQSize(ctx context.Context, request *persistence.GetReplicationDLQSizeRequest) (gp1 *persistence.GetReplicationDLQSizeResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.GetReplicationDLQSize", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetReplicationDLQSize(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *persistence.GetReplicationTasksFromDLQRequest) (gp1 *persistence.GetHistoryTasksResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.GetReplicationTasksFromDLQ", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetReplicationTasksFromDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) GetShardID() (i1 int) {
+	return c.wrapped.GetShardID()
+}
+
+func (c *faultInjectorExecutionManager) GetWorkflowExecution(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (gp1 *persistence.GetWorkflowExecutionResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.GetWorkflowExecution", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) IsWorkflowExecutionExists(ctx context.Context, request *persistence.IsWorkflowExecutionExistsRequest) (ip1 *persistence.IsWorkflowExecutionExistsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.IsWorkflowExecutionExists", request, c.wrapped)
+	if forward {
+		ip1, err = c.wrapped.IsWorkflowExecutionExists(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) ListConcreteExecutions(ctx context.Context, request *persistence.ListConcreteExecutionsRequest) (lp1 *persistence.ListConcreteExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.ListConcreteExecutions", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListConcreteExecutions(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) ListCurrentExecutions(ctx context.Context, request *persistence.ListCurrentExecutionsRequest) (lp1 *persistence.ListCurrentExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.ListCurrentExecutions", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListCurrentExecutions(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *persistence.PutReplicationTaskToDLQRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.PutReplicationTaskToDLQ", request, c.wrapped)
+	if forward {
+		err = c.wrapped.PutReplicationTaskToDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) RangeCompleteHistoryTask(ctx context.Context, request *persistence.RangeCompleteHistoryTaskRequest) (rp1 *persistence.RangeCompleteHistoryTaskResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.RangeCompleteHistoryTask", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.RangeCompleteHistoryTask(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.RangeDeleteReplicationTaskFromDLQRequest) (rp1 *persistence.RangeDeleteReplicationTaskFromDLQResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (up1 *persistence.UpdateWorkflowExecutionResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ExecutionManager.UpdateWorkflowExecution", request, c.wrapped)
+	if forward {
+		up1, err = c.wrapped.UpdateWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
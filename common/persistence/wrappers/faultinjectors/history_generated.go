@@ -0,0 +1,136 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// faultInjectorHistoryManager implements persistence.HistoryManager interface instrumented with fault injection.
+type faultInjectorHistoryManager struct {
+	wrapped  persistence.HistoryManager
+	injector *Injector
+}
+
+// NewHistoryManager creates a new instance of HistoryManager with fault injection.
+func NewHistoryManager(
+	wrapped persistence.HistoryManager,
+	injector *Injector,
+) persistence.HistoryManager {
+	return &faultInjectorHistoryManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorHistoryManager) AppendHistoryNodes(ctx context.Context, request *persistence.AppendHistoryNodesRequest) (ap1 *persistence.AppendHistoryNodesResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.AppendHistoryNodes", request, c.wrapped)
+	if forward {
+		ap1, err = c.wrapped.AppendHistoryNodes(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorHistoryManager) DeleteHistoryBranch(ctx context.Context, request *persistence.DeleteHistoryBranchRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.DeleteHistoryBranch", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteHistoryBranch(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) ForkHistoryBranch(ctx context.Context, request *persistence.ForkHistoryBranchRequest) (fp1 *persistence.ForkHistoryBranchResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.ForkHistoryBranch", request, c.wrapped)
+	if forward {
+		fp1, err = c.wrapped.ForkHistoryBranch(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) GetAllHistoryTreeBranches(ctx context.Context, request *persistence.GetAllHistoryTreeBranchesRequest) (gp1 *persistence.GetAllHistoryTreeBranchesResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.GetAllHistoryTreeBranches", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetAllHistoryTreeBranches(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) GetHistoryTree(ctx context.Context, request *persistence.GetHistoryTreeRequest) (gp1 *persistence.GetHistoryTreeResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.GetHistoryTree", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetHistoryTree(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) GetName() (s1 string) {
+	return c.wrapped.GetName()
+}
+
+func (c *faultInjectorHistoryManager) ReadHistoryBranch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadHistoryBranchResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.ReadHistoryBranch", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.ReadHistoryBranch(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadHistoryBranchByBatchResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.ReadHistoryBranchByBatch", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.ReadHistoryBranchByBatch(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) ReadRawHistoryBranch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadRawHistoryBranchResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.ReadRawHistoryBranch", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.ReadRawHistoryBranch(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorHistoryManager) ReencryptHistoryBranch(ctx context.Context, request *persistence.ReencryptHistoryBranchRequest) (rp1 *persistence.ReencryptHistoryBranchResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "HistoryManager.ReencryptHistoryBranch", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.ReencryptHistoryBranch(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
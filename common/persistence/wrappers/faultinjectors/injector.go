@@ -0,0 +1,272 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjectors
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/wrappers/errorinjectors"
+)
+
+const (
+	// rulesRefreshInterval is how often rules are reloaded from dynamic config.
+	rulesRefreshInterval = 10 * time.Second
+	// maxBlackHoleDuration bounds how long a black-holed call without a deadline hangs.
+	maxBlackHoleDuration = time.Minute
+
+	msgInjectedFakeErr   = "Injected fake persistence error"
+	msgInjectedBlackHole = "Black-holed persistence call"
+)
+
+var (
+	// ErrFakeBlackHole is returned by black-holed calls once their context is done.
+	ErrFakeBlackHole = &persistence.TimeoutError{Msg: "Fake Persistence Black Hole Timeout."}
+
+	fakeErrors = []error{
+		errors.ErrFakeServiceBusy,
+		errors.ErrFakeInternalService,
+		errorinjectors.ErrFakeTimeout,
+		errors.ErrFakeUnhandled,
+	}
+)
+
+type (
+	// Injector decides which faults to inject into a persistence call, based on the
+	// rules currently configured in dynamic config. A single Injector is shared by
+	// all fault injection wrappers created by a persistence factory.
+	Injector struct {
+		rules      dynamicproperties.ListPropertyFn
+		logger     log.Logger
+		timeSource clock.TimeSource
+		createdAt  time.Time
+
+		// uniform and normal are the sources of randomness, replaceable in tests.
+		uniform func() float64
+		normal  func() float64
+
+		refreshLock sync.Mutex
+		state       atomic.Pointer[ruleState]
+	}
+
+	ruleState struct {
+		encoded   string
+		rules     []*compiledRule
+		refreshAt time.Time
+	}
+
+	// callTarget lazily resolves the shard and domain a call is made for,
+	// so that the cost is only paid by rules which filter on them.
+	callTarget struct {
+		request any
+		manager any
+
+		shard         int
+		shardResolved bool
+
+		domain         string
+		domainResolved bool
+	}
+)
+
+// NewInjector creates an Injector reading its rules from the given dynamic config property.
+func NewInjector(
+	rules dynamicproperties.ListPropertyFn,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+) *Injector {
+	return &Injector{
+		rules:      rules,
+		logger:     logger,
+		timeSource: timeSource,
+		createdAt:  timeSource.Now(),
+		uniform:    rand.Float64,
+		normal:     rand.NormFloat64,
+	}
+}
+
+// Inject applies the faults of every active rule matching the call, in order.
+// Latency is added before the call is forwarded, a black hole or an injected error ends the call.
+// The shard of the call is the one of the wrapped manager if it is bound to a shard,
+// or the ShardID field of the request otherwise.
+// It returns whether the call should still be forwarded to the wrapped manager, and the error
+// to return to the caller instead of the wrapped manager's error, if any.
+func (i *Injector) Inject(ctx context.Context, op string, request any, manager any) (forward bool, err error) {
+	rules := i.currentRules()
+	if len(rules) == 0 {
+		return true, nil
+	}
+
+	now := i.timeSource.Now()
+	target := &callTarget{request: request, manager: manager}
+	for _, r := range rules {
+		if !r.matchesOperation(op) || !r.active(now, i.createdAt) || !r.matchesTarget(target) {
+			continue
+		}
+
+		if r.BlackHole {
+			return false, i.blackHole(ctx, r, op)
+		}
+		if r.Latency != nil {
+			if d := r.latency(i.uniform, i.normal); d > 0 {
+				if err := i.timeSource.SleepWithContext(ctx, d); err != nil {
+					return false, err
+				}
+			}
+		}
+		if r.ErrorRate > 0 && i.uniform() < r.ErrorRate {
+			fakeErr := fakeErrors[int(i.uniform()*float64(len(fakeErrors)))%len(fakeErrors)]
+			forward = i.shouldForward(fakeErr)
+			i.logger.Error(msgInjectedFakeErr,
+				tag.OperationName(op),
+				tag.Name(r.Name),
+				tag.Error(fakeErr),
+				tag.Bool(forward),
+			)
+			return forward, fakeErr
+		}
+	}
+	return true, nil
+}
+
+// shouldForward mimics persistence errors which do not tell whether the write happened:
+// timeouts and unhandled errors are forwarded half of the time.
+func (i *Injector) shouldForward(fakeErr error) bool {
+	if fakeErr == errorinjectors.ErrFakeTimeout || fakeErr == errors.ErrFakeUnhandled {
+		return i.uniform() < 0.5
+	}
+	return false
+}
+
+func (i *Injector) blackHole(ctx context.Context, r *compiledRule, op string) error {
+	i.logger.Error(msgInjectedBlackHole, tag.OperationName(op), tag.Name(r.Name))
+	select {
+	case <-ctx.Done():
+	case <-i.timeSource.After(maxBlackHoleDuration):
+	}
+	return ErrFakeBlackHole
+}
+
+// currentRules returns the compiled rules, reloading them from dynamic config when they are stale.
+// Callers racing with a reload keep using the previous rules.
+func (i *Injector) currentRules() []*compiledRule {
+	state := i.state.Load()
+	if state != nil && i.timeSource.Now().Before(state.refreshAt) {
+		return state.rules
+	}
+	if state != nil && !i.refreshLock.TryLock() {
+		return state.rules
+	}
+	if state == nil {
+		i.refreshLock.Lock()
+	}
+	defer i.refreshLock.Unlock()
+
+	if current := i.state.Load(); current != state {
+		return current.rules
+	}
+	state = i.reload(state)
+	i.state.Store(state)
+	return state.rules
+}
+
+func (i *Injector) reload(previous *ruleState) *ruleState {
+	values := i.rules()
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		encoded = nil
+	}
+	next := &ruleState{
+		encoded:   string(encoded),
+		refreshAt: i.timeSource.Now().Add(rulesRefreshInterval),
+	}
+	if previous != nil && encoded != nil && previous.encoded == next.encoded {
+		next.rules = previous.rules
+		return next
+	}
+
+	for _, value := range values {
+		rule, err := ParseRule(value)
+		if err != nil {
+			i.logger.Error("Ignoring invalid persistence fault injection rule", tag.Error(err))
+			continue
+		}
+		next.rules = append(next.rules, compileRule(rule))
+	}
+	if previous != nil || len(next.rules) > 0 {
+		i.logger.Info("Persistence fault injection rules updated", tag.Counter(len(next.rules)))
+	}
+	return next
+}
+
+func (t *callTarget) shardID() (int, bool) {
+	if !t.shardResolved {
+		t.shardResolved = true
+		t.shard = -1
+		if m, ok := t.manager.(interface{ GetShardID() int }); ok {
+			t.shard = m.GetShardID()
+		} else if v, ok := structField(t.request, "ShardID"); ok && v.CanInt() {
+			t.shard = int(v.Int())
+		}
+	}
+	return t.shard, t.shard >= 0
+}
+
+func (t *callTarget) domainName() string {
+	if !t.domainResolved {
+		t.domainResolved = true
+		if r, ok := t.request.(interface{ GetDomainName() string }); ok {
+			t.domain = r.GetDomainName()
+		} else if v, ok := structField(t.request, "DomainName"); ok && v.Kind() == reflect.String {
+			t.domain = v.String()
+		} else if v, ok := structField(t.request, "Domain"); ok && v.Kind() == reflect.String {
+			t.domain = v.String()
+		}
+	}
+	return t.domain
+}
+
+// structField returns the named field of a request struct or pointer to one.
+func structField(request any, name string) (reflect.Value, bool) {
+	v := reflect.ValueOf(request)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	f := v.FieldByName(name)
+	return f, f.IsValid()
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjectors
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/wrappers/errorinjectors"
+)
+
+var _staticMethods = map[string]bool{
+	"Close":      true,
+	"GetName":    true,
+	"GetShardID": true,
+}
+
+type testShardBoundManager int
+
+func (m testShardBoundManager) GetShardID() int {
+	return int(m)
+}
+
+func newTestInjector(logger log.Logger, timeSource clock.TimeSource, rules ...interface{}) *Injector {
+	injector := NewInjector(func(...dynamicproperties.FilterOption) []interface{} {
+		return rules
+	}, logger, timeSource)
+	injector.uniform = func() float64 { return 0.1 }
+	injector.normal = func() float64 { return 0 }
+	return injector
+}
+
+func TestWrappersInjectFaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// Calls are never forwarded, so no calls are expected on the wrapped managers.
+	injector := newTestInjector(log.NewNoop(), clock.NewRealTimeSource(), Rule{ErrorRate: 1})
+	wrappers := []any{
+		NewConfigStoreManager(persistence.NewMockConfigStoreManager(ctrl), injector),
+		NewDomainManager(persistence.NewMockDomainManager(ctrl), injector),
+		NewHistoryManager(persistence.NewMockHistoryManager(ctrl), injector),
+		NewQueueManager(persistence.NewMockQueueManager(ctrl), injector),
+		NewShardManager(persistence.NewMockShardManager(ctrl), injector),
+		NewTaskManager(persistence.NewMockTaskManager(ctrl), injector),
+		NewVisibilityManager(persistence.NewMockVisibilityManager(ctrl), injector),
+		NewExecutionManager(persistence.NewMockExecutionManager(ctrl), injector),
+	}
+
+	for _, wrapper := range wrappers {
+		v := reflect.ValueOf(wrapper)
+		t.Run(v.Type().String(), func(t *testing.T) {
+			for i := 0; i < v.NumMethod(); i++ {
+				method := v.Type().Method(i)
+				if _staticMethods[method.Name] {
+					continue
+				}
+				t.Run(method.Name, func(t *testing.T) {
+					vals := []reflect.Value{reflect.ValueOf(context.Background())}
+					for i := 2; i < method.Type.NumIn(); i++ {
+						vals = append(vals, reflect.Zero(method.Type.In(i)))
+					}
+					callRes := v.Method(i).Call(vals)
+					err, _ := callRes[len(callRes)-1].Interface().(error)
+					assert.Equal(t, errors.ErrFakeServiceBusy, err)
+				})
+			}
+		})
+	}
+}
+
+func TestExecutionManagerTargetsShard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	injector := newTestInjector(log.NewNoop(), clock.NewRealTimeSource(), Rule{
+		Operations: []string{"ExecutionManager.GetWorkflowExecution"},
+		Domains:    []string{"test-domain"},
+		ShardIDs:   []int{2},
+		ErrorRate:  1,
+	})
+	request := &persistence.GetWorkflowExecutionRequest{DomainName: "test-domain"}
+	response := &persistence.GetWorkflowExecutionResponse{}
+
+	other := persistence.NewMockExecutionManager(ctrl)
+	other.EXPECT().GetShardID().Return(1)
+	other.EXPECT().GetWorkflowExecution(gomock.Any(), request).Return(response, nil)
+	got, err := NewExecutionManager(other, injector).GetWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, response, got)
+
+	targeted := persistence.NewMockExecutionManager(ctrl)
+	targeted.EXPECT().GetShardID().Return(2)
+	_, err = NewExecutionManager(targeted, injector).GetWorkflowExecution(context.Background(), request)
+	assert.Equal(t, errors.ErrFakeServiceBusy, err)
+}
+
+func TestInjectTargeting(t *testing.T) {
+	tests := []struct {
+		name        string
+		rule        Rule
+		op          string
+		request     any
+		manager     any
+		wantInjects bool
+	}{
+		{
+			name:        "no selectors",
+			rule:        Rule{ErrorRate: 1},
+			op:          "DomainManager.GetMetadata",
+			wantInjects: true,
+		},
+		{
+			name:        "other operation",
+			rule:        Rule{ErrorRate: 1, Operations: []string{"TaskManager.*"}},
+			op:          "DomainManager.GetMetadata",
+			wantInjects: false,
+		},
+		{
+			name:        "domain from GetDomainName",
+			rule:        Rule{ErrorRate: 1, Domains: []string{"test-domain"}},
+			op:          "ExecutionManager.GetCurrentExecution",
+			request:     &persistence.GetCurrentExecutionRequest{DomainName: "test-domain"},
+			wantInjects: true,
+		},
+		{
+			name:        "domain from DomainName field",
+			rule:        Rule{ErrorRate: 1, Domains: []string{"test-domain"}},
+			op:          "HistoryManager.ReadHistoryBranch",
+			request:     &persistence.ReadHistoryBranchRequest{DomainName: "test-domain"},
+			wantInjects: true,
+		},
+		{
+			name:        "domain from Domain field",
+			rule:        Rule{ErrorRate: 1, Domains: []string{"test-domain"}},
+			op:          "VisibilityManager.ListWorkflowExecutions",
+			request:     &persistence.ListWorkflowExecutionsByQueryRequest{Domain: "test-domain"},
+			wantInjects: true,
+		},
+		{
+			name:        "other domain",
+			rule:        Rule{ErrorRate: 1, Domains: []string{"test-domain"}},
+			op:          "HistoryManager.ReadHistoryBranch",
+			request:     &persistence.ReadHistoryBranchRequest{DomainName: "other-domain"},
+			wantInjects: false,
+		},
+		{
+			name:        "domain of a call without request",
+			rule:        Rule{ErrorRate: 1, Domains: []string{"test-domain"}},
+			op:          "DomainManager.GetMetadata",
+			wantInjects: false,
+		},
+		{
+			name:        "shard of the wrapped manager",
+			rule:        Rule{ErrorRate: 1, ShardIDs: []int{3}},
+			op:          "ExecutionManager.GetCurrentExecution",
+			request:     &persistence.GetCurrentExecutionRequest{},
+			manager:     testShardBoundManager(3),
+			wantInjects: true,
+		},
+		{
+			name:        "shard from ShardID field",
+			rule:        Rule{ErrorRate: 1, ShardIDs: []int{3}},
+			op:          "ShardManager.GetShard",
+			request:     &persistence.GetShardRequest{ShardID: 3},
+			wantInjects: true,
+		},
+		{
+			name:        "other shard",
+			rule:        Rule{ErrorRate: 1, ShardIDs: []int{3}},
+			op:          "ShardManager.GetShard",
+			request:     &persistence.GetShardRequest{ShardID: 4},
+			wantInjects: false,
+		},
+		{
+			name:        "unknown shard",
+			rule:        Rule{ErrorRate: 1, ShardIDs: []int{3}},
+			op:          "DomainManager.GetMetadata",
+			wantInjects: false,
+		},
+		{
+			name:        "error rate not reached",
+			rule:        Rule{ErrorRate: 0.05},
+			op:          "DomainManager.GetMetadata",
+			wantInjects: false,
+		},
+		{
+			name:        "inactive schedule",
+			rule:        Rule{ErrorRate: 1, Schedule: &Schedule{Delay: Duration(time.Minute)}},
+			op:          "DomainManager.GetMetadata",
+			wantInjects: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			injector := newTestInjector(log.NewNoop(), clock.NewMockedTimeSource(), tc.rule)
+			forward, err := injector.Inject(context.Background(), tc.op, tc.request, tc.manager)
+			if tc.wantInjects {
+				assert.False(t, forward)
+				assert.Equal(t, errors.ErrFakeServiceBusy, err)
+			} else {
+				assert.True(t, forward)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInjectForwardsAmbiguousErrors(t *testing.T) {
+	injector := newTestInjector(log.NewNoop(), clock.NewMockedTimeSource(), Rule{ErrorRate: 1})
+
+	// 0.6 selects the fake timeout, which is forwarded as 0.6 >= 0.5.
+	injector.uniform = func() float64 { return 0.6 }
+	forward, err := injector.Inject(context.Background(), "ShardManager.UpdateShard", nil, nil)
+	assert.False(t, forward)
+	assert.Equal(t, errorinjectors.ErrFakeTimeout, err)
+
+	// 0.3 injects an error, 0.6 selects the fake timeout, and 0.3 forwards it.
+	values := []float64{0.3, 0.6, 0.3}
+	injector.uniform = func() float64 {
+		v := values[0]
+		values = values[1:]
+		return v
+	}
+	forward, err = injector.Inject(context.Background(), "ShardManager.UpdateShard", nil, nil)
+	assert.True(t, forward)
+	assert.Equal(t, errorinjectors.ErrFakeTimeout, err)
+}
+
+func TestInjectLatency(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	injector := newTestInjector(testlogger.New(t), timeSource, Rule{
+		Latency: &Latency{Duration: Duration(100 * time.Millisecond)},
+	})
+
+	t.Run("delays the call", func(t *testing.T) {
+		var done atomic.Bool
+		go func() {
+			forward, err := injector.Inject(context.Background(), "ShardManager.GetShard", nil, nil)
+			assert.True(t, forward)
+			assert.NoError(t, err)
+			done.Store(true)
+		}()
+		timeSource.BlockUntil(1)
+		assert.False(t, done.Load())
+		timeSource.Advance(100 * time.Millisecond)
+		assert.Eventually(t, done.Load, time.Second, time.Millisecond)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		forward, err := injector.Inject(ctx, "ShardManager.GetShard", nil, nil)
+		assert.False(t, forward)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestInjectBlackHole(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	injector := newTestInjector(log.NewNoop(), timeSource, Rule{BlackHole: true})
+
+	t.Run("until the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var done atomic.Bool
+		go func() {
+			forward, err := injector.Inject(ctx, "ShardManager.GetShard", nil, nil)
+			assert.False(t, forward)
+			assert.Equal(t, ErrFakeBlackHole, err)
+			done.Store(true)
+		}()
+		timeSource.BlockUntil(1)
+		assert.False(t, done.Load())
+		cancel()
+		assert.Eventually(t, done.Load, time.Second, time.Millisecond)
+	})
+
+	t.Run("bounded without a deadline", func(t *testing.T) {
+		var done atomic.Bool
+		go func() {
+			forward, err := injector.Inject(context.Background(), "ShardManager.GetShard", nil, nil)
+			assert.False(t, forward)
+			assert.Equal(t, ErrFakeBlackHole, err)
+			done.Store(true)
+		}()
+		// the waiter of the previous subtest is never released
+		timeSource.BlockUntil(2)
+		timeSource.Advance(maxBlackHoleDuration)
+		assert.Eventually(t, done.Load, time.Second, time.Millisecond)
+	})
+}
+
+func TestInjectorReloadsRules(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	var rules atomic.Value
+	rules.Store([]interface{}{})
+	injector := NewInjector(func(...dynamicproperties.FilterOption) []interface{} {
+		return rules.Load().([]interface{})
+	}, log.NewNoop(), timeSource)
+	injector.uniform = func() float64 { return 0.1 }
+
+	forward, err := injector.Inject(context.Background(), "ShardManager.GetShard", nil, nil)
+	assert.True(t, forward)
+	assert.NoError(t, err)
+
+	rules.Store([]interface{}{
+		map[string]interface{}{"name": "invalid"},
+		map[string]interface{}{"name": "valid", "errorRate": 1.0},
+	})
+	forward, err = injector.Inject(context.Background(), "ShardManager.GetShard", nil, nil)
+	assert.True(t, forward, "rules are cached until the refresh interval")
+	assert.NoError(t, err)
+
+	timeSource.Advance(rulesRefreshInterval)
+	forward, err = injector.Inject(context.Background(), "ShardManager.GetShard", nil, nil)
+	assert.False(t, forward)
+	assert.Equal(t, errors.ErrFakeServiceBusy, err)
+	require.Len(t, injector.currentRules(), 1)
+	assert.Equal(t, "valid", injector.currentRules()[0].Name)
+}
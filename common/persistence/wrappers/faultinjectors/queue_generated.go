@@ -0,0 +1,165 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// faultInjectorQueueManager implements persistence.QueueManager interface instrumented with fault injection.
+type faultInjectorQueueManager struct {
+	wrapped  persistence.QueueManager
+	injector *Injector
+}
+
+// NewQueueManager creates a new instance of QueueManager with fault injection.
+func NewQueueManager(
+	wrapped persistence.QueueManager,
+	injector *Injector,
+) persistence.QueueManager {
+	return &faultInjectorQueueManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorQueueManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorQueueManager) DeleteMessageFromDLQ(ctx context.Context, request *persistence.DeleteMessageFromDLQRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.DeleteMessageFromDLQ", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteMessageFromDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) DeleteMessagesBefore(ctx context.Context, request *persistence.DeleteMessagesBeforeRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.DeleteMessagesBefore", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteMessagesBefore(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) EnqueueMessage(ctx context.Context, request *persistence.EnqueueMessageRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.EnqueueMessage", request, c.wrapped)
+	if forward {
+		err = c.wrapped.EnqueueMessage(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) EnqueueMessageToDLQ(ctx context.Context, request *persistence.EnqueueMessageToDLQRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.EnqueueMessageToDLQ", request, c.wrapped)
+	if forward {
+		err = c.wrapped.EnqueueMessageToDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) GetAckLevels(ctx context.Context, request *persistence.GetAckLevelsRequest) (gp1 *persistence.GetAckLevelsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.GetAckLevels", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetAckLevels(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) GetDLQAckLevels(ctx context.Context, request *persistence.GetDLQAckLevelsRequest) (gp1 *persistence.GetDLQAckLevelsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.GetDLQAckLevels", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetDLQAckLevels(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) GetDLQSize(ctx context.Context, request *persistence.GetDLQSizeRequest) (gp1 *persistence.GetDLQSizeResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.GetDLQSize", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetDLQSize(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, request *persistence.RangeDeleteMessagesFromDLQRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.RangeDeleteMessagesFromDLQ", request, c.wrapped)
+	if forward {
+		err = c.wrapped.RangeDeleteMessagesFromDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) ReadMessages(ctx context.Context, request *persistence.ReadMessagesRequest) (rp1 *persistence.ReadMessagesResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.ReadMessages", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.ReadMessages(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) ReadMessagesFromDLQ(ctx context.Context, request *persistence.ReadMessagesFromDLQRequest) (rp1 *persistence.ReadMessagesFromDLQResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.ReadMessagesFromDLQ", request, c.wrapped)
+	if forward {
+		rp1, err = c.wrapped.ReadMessagesFromDLQ(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) UpdateAckLevel(ctx context.Context, request *persistence.UpdateAckLevelRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.UpdateAckLevel", request, c.wrapped)
+	if forward {
+		err = c.wrapped.UpdateAckLevel(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorQueueManager) UpdateDLQAckLevel(ctx context.Context, request *persistence.UpdateDLQAckLevelRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "QueueManager.UpdateDLQAckLevel", request, c.wrapped)
+	if forward {
+		err = c.wrapped.UpdateDLQAckLevel(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
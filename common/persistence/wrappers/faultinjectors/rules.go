@@ -0,0 +1,332 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjectors
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	// LatencyFixed delays every affected call by Latency.Duration.
+	LatencyFixed = "fixed"
+	// LatencyUniform delays affected calls by a duration picked uniformly from [Latency.Min, Latency.Max).
+	LatencyUniform = "uniform"
+	// LatencyLongTail delays affected calls by a log-normally distributed duration,
+	// with Latency.Duration as the median and Latency.P99 as the 99th percentile.
+	LatencyLongTail = "longtail"
+)
+
+// z-score of the 99th percentile of the standard normal distribution.
+const p99ZScore = 2.3263
+
+type (
+	// Rule describes a fault injected into matching persistence calls.
+	// A call matches when it matches every non-empty selector (Operations, Domains and ShardIDs)
+	// and the rule's schedule is active.
+	Rule struct {
+		// Name identifies the rule in logs.
+		Name string `json:"name,omitempty"`
+		// Operations are "Manager.Method" names, e.g. "ExecutionManager.UpdateWorkflowExecution".
+		// A trailing "*" matches by prefix, e.g. "HistoryManager.*".
+		Operations []string `json:"operations,omitempty"`
+		Domains    []string `json:"domains,omitempty"`
+		ShardIDs   []int    `json:"shardIDs,omitempty"`
+
+		// ErrorRate is the fraction of matching calls failed with a fake persistence error.
+		ErrorRate float64 `json:"errorRate,omitempty"`
+		// Latency delays matching calls before they are forwarded.
+		Latency *Latency `json:"latency,omitempty"`
+		// BlackHole makes matching calls hang until their context is done, and then time out.
+		BlackHole bool `json:"blackHole,omitempty"`
+
+		// Schedule limits when the rule is active. A rule without a schedule is always active.
+		Schedule *Schedule `json:"schedule,omitempty"`
+	}
+
+	// Latency describes the delay added to matching calls.
+	Latency struct {
+		// Distribution is one of LatencyFixed (default), LatencyUniform or LatencyLongTail.
+		Distribution string `json:"distribution,omitempty"`
+		// Rate is the fraction of matching calls delayed. Zero delays every matching call.
+		Rate float64 `json:"rate,omitempty"`
+		// Duration is the fixed delay, or the median delay of the long-tail distribution.
+		Duration Duration `json:"duration,omitempty"`
+		// Min and Max bound the uniform distribution. Max also caps the long-tail distribution if set.
+		Min Duration `json:"min,omitempty"`
+		Max Duration `json:"max,omitempty"`
+		// P99 is the 99th percentile of the long-tail distribution.
+		P99 Duration `json:"p99,omitempty"`
+	}
+
+	// Schedule describes when a rule is active.
+	Schedule struct {
+		// Delay keeps the rule inactive until this long after the injector was created.
+		Delay Duration `json:"delay,omitempty"`
+		// Start and End bound the rule in wall clock time. Either may be omitted.
+		Start time.Time `json:"start,omitempty"`
+		End   time.Time `json:"end,omitempty"`
+		// Period and ActiveFor make the rule active for the first ActiveFor of every Period,
+		// counted from Start, or from the end of Delay if Start is not set.
+		Period    Duration `json:"period,omitempty"`
+		ActiveFor Duration `json:"activeFor,omitempty"`
+	}
+
+	// Duration is a time.Duration which decodes from either a duration string ("150ms")
+	// or a number of nanoseconds.
+	Duration time.Duration
+
+	compiledRule struct {
+		Rule
+
+		domains  map[string]struct{}
+		shardIDs map[int]struct{}
+		sigma    float64
+	}
+)
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch value := v.(type) {
+	case float64:
+		*d = Duration(value)
+	case string:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*d = Duration(parsed)
+	default:
+		return fmt.Errorf("invalid duration: %s", b)
+	}
+	return nil
+}
+
+// ParseRule converts a dynamic config list item into a Rule.
+// Items are either a Rule or the generic map produced by dynamic config clients.
+func ParseRule(value interface{}) (Rule, error) {
+	var rule Rule
+	switch v := value.(type) {
+	case Rule:
+		rule = v
+	case *Rule:
+		rule = *v
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return Rule{}, fmt.Errorf("unable to encode fault injection rule %#v: %w", value, err)
+		}
+		decoder := json.NewDecoder(bytes.NewReader(encoded))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&rule); err != nil {
+			return Rule{}, fmt.Errorf("invalid fault injection rule %s: %w", encoded, err)
+		}
+	}
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+	return rule, nil
+}
+
+// Validate checks that the rule injects at least one fault and that its settings are consistent.
+func (r Rule) Validate() error {
+	if r.ErrorRate < 0 || r.ErrorRate > 1 {
+		return fmt.Errorf("fault injection rule %q: errorRate must be within [0, 1], got %v", r.Name, r.ErrorRate)
+	}
+	if r.ErrorRate == 0 && r.Latency == nil && !r.BlackHole {
+		return fmt.Errorf("fault injection rule %q: no fault configured", r.Name)
+	}
+	for _, op := range r.Operations {
+		if strings.Contains(strings.TrimSuffix(op, "*"), "*") {
+			return fmt.Errorf("fault injection rule %q: wildcard is only supported as a suffix in operation %q", r.Name, op)
+		}
+	}
+	if r.Latency != nil {
+		if err := r.Latency.validate(); err != nil {
+			return fmt.Errorf("fault injection rule %q: %w", r.Name, err)
+		}
+	}
+	if r.Schedule != nil {
+		if err := r.Schedule.validate(); err != nil {
+			return fmt.Errorf("fault injection rule %q: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (l *Latency) validate() error {
+	if l.Rate < 0 || l.Rate > 1 {
+		return fmt.Errorf("latency rate must be within [0, 1], got %v", l.Rate)
+	}
+	switch l.Distribution {
+	case "", LatencyFixed:
+		if l.Duration <= 0 {
+			return fmt.Errorf("fixed latency requires a positive duration")
+		}
+	case LatencyUniform:
+		if l.Min < 0 || l.Max <= 0 || l.Min > l.Max {
+			return fmt.Errorf("uniform latency requires 0 <= min <= max and a positive max, got min %v, max %v",
+				time.Duration(l.Min), time.Duration(l.Max))
+		}
+	case LatencyLongTail:
+		if l.Duration <= 0 || l.P99 < l.Duration {
+			return fmt.Errorf("longtail latency requires a positive duration and p99 >= duration, got duration %v, p99 %v",
+				time.Duration(l.Duration), time.Duration(l.P99))
+		}
+		if l.Max > 0 && l.Max < l.Duration {
+			return fmt.Errorf("longtail latency max %v is below its median %v", time.Duration(l.Max), time.Duration(l.Duration))
+		}
+	default:
+		return fmt.Errorf("unknown latency distribution %q", l.Distribution)
+	}
+	return nil
+}
+
+func (s *Schedule) validate() error {
+	if s.Delay < 0 {
+		return fmt.Errorf("schedule delay must not be negative")
+	}
+	if !s.Start.IsZero() && !s.End.IsZero() && !s.End.After(s.Start) {
+		return fmt.Errorf("schedule end %v must be after start %v", s.End, s.Start)
+	}
+	if s.Period < 0 || s.ActiveFor < 0 {
+		return fmt.Errorf("schedule period and activeFor must not be negative")
+	}
+	if s.ActiveFor > 0 && s.Period == 0 {
+		return fmt.Errorf("schedule activeFor requires a period")
+	}
+	if s.Period > 0 && (s.ActiveFor == 0 || s.ActiveFor > s.Period) {
+		return fmt.Errorf("schedule activeFor must be within (0, period], got %v", time.Duration(s.ActiveFor))
+	}
+	return nil
+}
+
+func compileRule(r Rule) *compiledRule {
+	c := &compiledRule{Rule: r}
+	if len(r.Domains) > 0 {
+		c.domains = make(map[string]struct{}, len(r.Domains))
+		for _, domain := range r.Domains {
+			c.domains[domain] = struct{}{}
+		}
+	}
+	if len(r.ShardIDs) > 0 {
+		c.shardIDs = make(map[int]struct{}, len(r.ShardIDs))
+		for _, shardID := range r.ShardIDs {
+			c.shardIDs[shardID] = struct{}{}
+		}
+	}
+	if r.Latency != nil && r.Latency.Distribution == LatencyLongTail {
+		c.sigma = math.Log(float64(r.Latency.P99)/float64(r.Latency.Duration)) / p99ZScore
+	}
+	return c
+}
+
+func (r *compiledRule) matchesOperation(op string) bool {
+	if len(r.Operations) == 0 {
+		return true
+	}
+	for _, pattern := range r.Operations {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(op, prefix) {
+				return true
+			}
+		} else if pattern == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *compiledRule) matchesTarget(target *callTarget) bool {
+	if r.shardIDs != nil {
+		shardID, ok := target.shardID()
+		if !ok {
+			return false
+		}
+		if _, ok := r.shardIDs[shardID]; !ok {
+			return false
+		}
+	}
+	if r.domains != nil {
+		if _, ok := r.domains[target.domainName()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// active reports whether the rule's schedule is active at now, for an injector created at createdAt.
+func (r *compiledRule) active(now, createdAt time.Time) bool {
+	s := r.Schedule
+	if s == nil {
+		return true
+	}
+	origin := createdAt.Add(time.Duration(s.Delay))
+	if now.Before(origin) {
+		return false
+	}
+	if !s.Start.IsZero() {
+		if now.Before(s.Start) {
+			return false
+		}
+		origin = s.Start
+	}
+	if !s.End.IsZero() && !now.Before(s.End) {
+		return false
+	}
+	if s.Period > 0 {
+		return now.Sub(origin)%time.Duration(s.Period) < time.Duration(s.ActiveFor)
+	}
+	return true
+}
+
+// latency samples the delay for a call, using uniform and normal as sources of randomness.
+func (r *compiledRule) latency(uniform, normal func() float64) time.Duration {
+	l := r.Latency
+	if l.Rate > 0 && uniform() >= l.Rate {
+		return 0
+	}
+	switch l.Distribution {
+	case LatencyUniform:
+		return time.Duration(l.Min) + time.Duration(uniform()*float64(l.Max-l.Min))
+	case LatencyLongTail:
+		d := time.Duration(float64(l.Duration) * math.Exp(r.sigma*normal()))
+		if l.Max > 0 && d > time.Duration(l.Max) {
+			d = time.Duration(l.Max)
+		}
+		return d
+	default:
+		return time.Duration(l.Duration)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjectors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    Rule
+		wantErr string
+	}{
+		{
+			name: "generic value from dynamic config",
+			value: map[string]interface{}{
+				"name":       "slow-history",
+				"operations": []interface{}{"HistoryManager.*"},
+				"domains":    []interface{}{"test-domain"},
+				"shardIDs":   []interface{}{1, 2},
+				"errorRate":  0.1,
+				"latency": map[string]interface{}{
+					"distribution": "longtail",
+					"duration":     "10ms",
+					"p99":          "200ms",
+					"max":          1e9,
+				},
+				"schedule": map[string]interface{}{
+					"delay":     "30s",
+					"period":    "1m",
+					"activeFor": "10s",
+				},
+			},
+			want: Rule{
+				Name:       "slow-history",
+				Operations: []string{"HistoryManager.*"},
+				Domains:    []string{"test-domain"},
+				ShardIDs:   []int{1, 2},
+				ErrorRate:  0.1,
+				Latency: &Latency{
+					Distribution: LatencyLongTail,
+					Duration:     Duration(10 * time.Millisecond),
+					P99:          Duration(200 * time.Millisecond),
+					Max:          Duration(time.Second),
+				},
+				Schedule: &Schedule{
+					Delay:     Duration(30 * time.Second),
+					Period:    Duration(time.Minute),
+					ActiveFor: Duration(10 * time.Second),
+				},
+			},
+		},
+		{
+			name:  "typed value",
+			value: Rule{BlackHole: true, ShardIDs: []int{3}},
+			want:  Rule{BlackHole: true, ShardIDs: []int{3}},
+		},
+		{
+			name:    "unknown field",
+			value:   map[string]interface{}{"errorRate": 0.1, "errorPercent": 20},
+			wantErr: "unknown field",
+		},
+		{
+			name:    "invalid duration",
+			value:   map[string]interface{}{"latency": map[string]interface{}{"duration": "soon"}},
+			wantErr: "invalid fault injection rule",
+		},
+		{
+			name:    "no fault",
+			value:   map[string]interface{}{"name": "noop"},
+			wantErr: "no fault configured",
+		},
+		{
+			name:    "error rate out of range",
+			value:   map[string]interface{}{"errorRate": 1.5},
+			wantErr: "errorRate must be within [0, 1]",
+		},
+		{
+			name:    "wildcard not at the end",
+			value:   map[string]interface{}{"errorRate": 0.1, "operations": []interface{}{"*.GetShard"}},
+			wantErr: "wildcard is only supported as a suffix",
+		},
+		{
+			name:    "fixed latency without duration",
+			value:   map[string]interface{}{"latency": map[string]interface{}{}},
+			wantErr: "fixed latency requires a positive duration",
+		},
+		{
+			name:    "uniform latency with min above max",
+			value:   map[string]interface{}{"latency": map[string]interface{}{"distribution": "uniform", "min": "2s", "max": "1s"}},
+			wantErr: "uniform latency requires",
+		},
+		{
+			name:    "longtail latency with p99 below median",
+			value:   map[string]interface{}{"latency": map[string]interface{}{"distribution": "longtail", "duration": "1s", "p99": "10ms"}},
+			wantErr: "longtail latency requires",
+		},
+		{
+			name:    "unknown latency distribution",
+			value:   map[string]interface{}{"latency": map[string]interface{}{"distribution": "normal", "duration": "1s"}},
+			wantErr: "unknown latency distribution",
+		},
+		{
+			name:    "active window without period",
+			value:   map[string]interface{}{"blackHole": true, "schedule": map[string]interface{}{"activeFor": "1s"}},
+			wantErr: "activeFor requires a period",
+		},
+		{
+			name:    "active window longer than period",
+			value:   map[string]interface{}{"blackHole": true, "schedule": map[string]interface{}{"period": "1s", "activeFor": "2s"}},
+			wantErr: "activeFor must be within (0, period]",
+		},
+		{
+			name: "end before start",
+			value: map[string]interface{}{"blackHole": true, "schedule": map[string]interface{}{
+				"start": "2026-01-01T01:00:00Z",
+				"end":   "2026-01-01T00:00:00Z",
+			}},
+			wantErr: "must be after start",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := ParseRule(tc.value)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, rule)
+		})
+	}
+}
+
+func TestMatchesOperation(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations []string
+		op         string
+		want       bool
+	}{
+		{name: "no operations", op: "ShardManager.GetShard", want: true},
+		{name: "exact match", operations: []string{"ShardManager.GetShard"}, op: "ShardManager.GetShard", want: true},
+		{name: "exact mismatch", operations: []string{"ShardManager.GetShard"}, op: "ShardManager.UpdateShard", want: false},
+		{name: "prefix match", operations: []string{"TaskManager.Get*", "ShardManager.*"}, op: "ShardManager.UpdateShard", want: true},
+		{name: "prefix mismatch", operations: []string{"ShardManager.*"}, op: "TaskManager.GetTasks", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := compileRule(Rule{Operations: tc.operations, ErrorRate: 1})
+			assert.Equal(t, tc.want, r.matchesOperation(tc.op))
+		})
+	}
+}
+
+func TestScheduleActive(t *testing.T) {
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		now      time.Time
+		want     bool
+	}{
+		{name: "no schedule", now: createdAt, want: true},
+		{
+			name:     "within delay",
+			schedule: &Schedule{Delay: Duration(30 * time.Second)},
+			now:      createdAt.Add(29 * time.Second),
+			want:     false,
+		},
+		{
+			name:     "after delay",
+			schedule: &Schedule{Delay: Duration(30 * time.Second)},
+			now:      createdAt.Add(30 * time.Second),
+			want:     true,
+		},
+		{
+			name:     "before start",
+			schedule: &Schedule{Start: createdAt.Add(time.Hour)},
+			now:      createdAt.Add(time.Minute),
+			want:     false,
+		},
+		{
+			name:     "at end",
+			schedule: &Schedule{End: createdAt.Add(time.Hour)},
+			now:      createdAt.Add(time.Hour),
+			want:     false,
+		},
+		{
+			name:     "inside periodic window counted from delay",
+			schedule: &Schedule{Delay: Duration(time.Minute), Period: Duration(time.Minute), ActiveFor: Duration(5 * time.Second)},
+			now:      createdAt.Add(3*time.Minute + 4*time.Second),
+			want:     true,
+		},
+		{
+			name:     "outside periodic window counted from delay",
+			schedule: &Schedule{Delay: Duration(time.Minute), Period: Duration(time.Minute), ActiveFor: Duration(5 * time.Second)},
+			now:      createdAt.Add(3*time.Minute + 5*time.Second),
+			want:     false,
+		},
+		{
+			name:     "periodic window counted from start",
+			schedule: &Schedule{Start: createdAt.Add(30 * time.Second), Period: Duration(time.Minute), ActiveFor: Duration(time.Second)},
+			now:      createdAt.Add(90 * time.Second),
+			want:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := compileRule(Rule{BlackHole: true, Schedule: tc.schedule})
+			assert.Equal(t, tc.want, r.active(tc.now, createdAt))
+		})
+	}
+}
+
+func TestLatency(t *testing.T) {
+	constant := func(v float64) func() float64 {
+		return func() float64 { return v }
+	}
+	tests := []struct {
+		name    string
+		latency Latency
+		uniform float64
+		normal  float64
+		want    time.Duration
+	}{
+		{
+			name:    "fixed",
+			latency: Latency{Duration: Duration(100 * time.Millisecond)},
+			want:    100 * time.Millisecond,
+		},
+		{
+			name:    "not sampled by rate",
+			latency: Latency{Duration: Duration(100 * time.Millisecond), Rate: 0.1},
+			uniform: 0.5,
+			want:    0,
+		},
+		{
+			name:    "uniform",
+			latency: Latency{Distribution: LatencyUniform, Min: Duration(100 * time.Millisecond), Max: Duration(300 * time.Millisecond)},
+			uniform: 0.5,
+			want:    200 * time.Millisecond,
+		},
+		{
+			name:    "longtail median",
+			latency: Latency{Distribution: LatencyLongTail, Duration: Duration(10 * time.Millisecond), P99: Duration(time.Second)},
+			normal:  0,
+			want:    10 * time.Millisecond,
+		},
+		{
+			name:    "longtail p99",
+			latency: Latency{Distribution: LatencyLongTail, Duration: Duration(10 * time.Millisecond), P99: Duration(time.Second)},
+			normal:  p99ZScore,
+			want:    time.Second,
+		},
+		{
+			name:    "longtail capped by max",
+			latency: Latency{Distribution: LatencyLongTail, Duration: Duration(10 * time.Millisecond), P99: Duration(time.Second), Max: Duration(500 * time.Millisecond)},
+			normal:  p99ZScore,
+			want:    500 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			latency := tc.latency
+			r := compileRule(Rule{Latency: &latency})
+			got := r.latency(constant(tc.uniform), constant(tc.normal))
+			assert.InDelta(t, tc.want, got, float64(time.Millisecond))
+		})
+	}
+}
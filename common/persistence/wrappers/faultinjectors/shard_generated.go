@@ -0,0 +1,70 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// faultInjectorShardManager implements persistence.ShardManager interface instrumented with fault injection.
+type faultInjectorShardManager struct {
+	wrapped  persistence.ShardManager
+	injector *Injector
+}
+
+// NewShardManager creates a new instance of ShardManager with fault injection.
+func NewShardManager(
+	wrapped persistence.ShardManager,
+	injector *Injector,
+) persistence.ShardManager {
+	return &faultInjectorShardManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorShardManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorShardManager) CreateShard(ctx context.Context, request *persistence.CreateShardRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ShardManager.CreateShard", request, c.wrapped)
+	if forward {
+		err = c.wrapped.CreateShard(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorShardManager) GetName() (s1 string) {
+	return c.wrapped.GetName()
+}
+
+func (c *faultInjectorShardManager) GetShard(ctx context.Context, request *persistence.GetShardRequest) (gp1 *persistence.GetShardResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ShardManager.GetShard", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetShard(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorShardManager) UpdateShard(ctx context.Context, request *persistence.UpdateShardRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "ShardManager.UpdateShard", request, c.wrapped)
+	if forward {
+		err = c.wrapped.UpdateShard(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
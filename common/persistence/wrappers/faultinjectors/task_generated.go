@@ -0,0 +1,158 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// faultInjectorTaskManager implements persistence.TaskManager interface instrumented with fault injection.
+type faultInjectorTaskManager struct {
+	wrapped  persistence.TaskManager
+	injector *Injector
+}
+
+// NewTaskManager creates a new instance of TaskManager with fault injection.
+func NewTaskManager(
+	wrapped persistence.TaskManager,
+	injector *Injector,
+) persistence.TaskManager {
+	return &faultInjectorTaskManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorTaskManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorTaskManager) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.CompleteTask", request, c.wrapped)
+	if forward {
+		err = c.wrapped.CompleteTask(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (cp1 *persistence.CompleteTasksLessThanResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.CompleteTasksLessThan", request, c.wrapped)
+	if forward {
+		cp1, err = c.wrapped.CompleteTasksLessThan(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (cp1 *persistence.CreateTasksResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.CreateTasks", request, c.wrapped)
+	if forward {
+		cp1, err = c.wrapped.CreateTasks(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.DeleteTaskList", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteTaskList(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) GetName() (s1 string) {
+	return c.wrapped.GetName()
+}
+
+func (c *faultInjectorTaskManager) GetOrphanTasks(ctx context.Context, request *persistence.GetOrphanTasksRequest) (gp1 *persistence.GetOrphanTasksResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.GetOrphanTasks", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetOrphanTasks(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) GetTaskList(ctx context.Context, request *persistence.GetTaskListRequest) (gp1 *persistence.GetTaskListResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.GetTaskList", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetTaskList(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) GetTaskListSize(ctx context.Context, request *persistence.GetTaskListSizeRequest) (gp1 *persistence.GetTaskListSizeResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.GetTaskListSize", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetTaskListSize(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (gp1 *persistence.GetTasksResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.GetTasks", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetTasks(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (lp1 *persistence.LeaseTaskListResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.LeaseTaskList", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.LeaseTaskList(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) ListTaskList(ctx context.Context, request *persistence.ListTaskListRequest) (lp1 *persistence.ListTaskListResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.ListTaskList", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListTaskList(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorTaskManager) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (up1 *persistence.UpdateTaskListResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "TaskManager.UpdateTaskList", request, c.wrapped)
+	if forward {
+		up1, err = c.wrapped.UpdateTaskList(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
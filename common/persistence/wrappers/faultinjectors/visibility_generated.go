@@ -0,0 +1,224 @@
+package faultinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/faultinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// faultInjectorVisibilityManager implements persistence.VisibilityManager interface instrumented with fault injection.
+type faultInjectorVisibilityManager struct {
+	wrapped  persistence.VisibilityManager
+	injector *Injector
+}
+
+// NewVisibilityManager creates a new instance of VisibilityManager with fault injection.
+func NewVisibilityManager(
+	wrapped persistence.VisibilityManager,
+	injector *Injector,
+) persistence.VisibilityManager {
+	return &faultInjectorVisibilityManager{
+		wrapped:  wrapped,
+		injector: injector,
+	}
+}
+
+func (c *faultInjectorVisibilityManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *faultInjectorVisibilityManager) CountWorkflowExecutions(ctx context.Context, request *persistence.CountWorkflowExecutionsRequest) (cp1 *persistence.CountWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.CountWorkflowExecutions", request, c.wrapped)
+	if forward {
+		cp1, err = c.wrapped.CountWorkflowExecutions(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) DeleteUninitializedWorkflowExecution(ctx context.Context, request *persistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.DeleteUninitializedWorkflowExecution", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteUninitializedWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.DeleteWorkflowExecution", request, c.wrapped)
+	if forward {
+		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) GetClosedWorkflowExecution(ctx context.Context, request *persistence.GetClosedWorkflowExecutionRequest) (gp1 *persistence.GetClosedWorkflowExecutionResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.GetClosedWorkflowExecution", request, c.wrapped)
+	if forward {
+		gp1, err = c.wrapped.GetClosedWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) GetName() (s1 string) {
+	return c.wrapped.GetName()
+}
+
+func (c *faultInjectorVisibilityManager) ListClosedWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListClosedWorkflowExecutions", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListClosedWorkflowExecutions(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *persistence.ListClosedWorkflowExecutionsByStatusRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ListClosedWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByType", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByType(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ListOpenWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListOpenWorkflowExecutions", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListOpenWorkflowExecutions(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListOpenWorkflowExecutionsByType", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByType(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ListWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsByQueryRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ListWorkflowExecutions", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ListWorkflowExecutions(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Context, request *persistence.RecordWorkflowExecutionClosedRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.RecordWorkflowExecutionClosed", request, c.wrapped)
+	if forward {
+		err = c.wrapped.RecordWorkflowExecutionClosed(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) RecordWorkflowExecutionStarted(ctx context.Context, request *persistence.RecordWorkflowExecutionStartedRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.RecordWorkflowExecutionStarted", request, c.wrapped)
+	if forward {
+		err = c.wrapped.RecordWorkflowExecutionStarted(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) RecordWorkflowExecutionUninitialized(ctx context.Context, request *persistence.RecordWorkflowExecutionUninitializedRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.RecordWorkflowExecutionUninitialized", request, c.wrapped)
+	if forward {
+		err = c.wrapped.RecordWorkflowExecutionUninitialized(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) ScanWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsByQueryRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.ScanWorkflowExecutions", request, c.wrapped)
+	if forward {
+		lp1, err = c.wrapped.ScanWorkflowExecutions(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
+
+func (c *faultInjectorVisibilityManager) UpsertWorkflowExecution(ctx context.Context, request *persistence.UpsertWorkflowExecutionRequest) (err error) {
+	forward, fakeErr := c.injector.Inject(ctx, "VisibilityManager.UpsertWorkflowExecution", request, c.wrapped)
+	if forward {
+		err = c.wrapped.UpsertWorkflowExecution(ctx, request)
+	}
+	if fakeErr != nil {
+		err = fakeErr
+	}
+	return
+}
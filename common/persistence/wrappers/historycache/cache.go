@@ -0,0 +1,256 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historycache
+
+import (
+	"bytes"
+	"container/list"
+	"sync"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// entryOverhead approximates the memory used by an entry on top of its blobs.
+const entryOverhead = 256
+
+type (
+	// Cache is a size-bounded LRU cache of history node batches read from a HistoryStore.
+	// A page of nodes is identified by its branch, its node range and the (nodeID, transactionID)
+	// the read continues from, so cached pages never change as long as the range they cover is immutable.
+	//
+	// Only the tip of a branch may still be overridden by a later transaction, for instance when a
+	// shard owner which lost its range wrote nodes that were never committed. A page is therefore only
+	// served from the cache once the read of the following page returned nodes, which proves that every
+	// batch of the page ends strictly before the last batch of the requested range. The last page of a
+	// read and reads which are not bounded by a maximum node ID are never cached.
+	//
+	// Cached pages are invalidated when nodes are appended to their range and when a branch of their tree
+	// is deleted. This invalidation is local to the process: writes made by other hosts are not observed,
+	// which is safe for the nodes above since they are never rewritten, but leaves the pages of a branch
+	// deleted elsewhere in the cache until they are evicted.
+	//
+	// A single Cache is meant to be shared by all the history stores of a persistence factory.
+	Cache struct {
+		maxSize       dynamicproperties.IntPropertyFn
+		metricsClient metrics.Client
+
+		sync.Mutex
+		size    int
+		lru     *list.List // of *entry, most recently used first
+		entries map[pageKey]*list.Element
+		trees   map[string]map[pageKey]struct{}
+		// pending indexes the pages not served yet by the key of the read that continues them
+		pending map[pageKey]*list.Element
+	}
+
+	pageKey struct {
+		treeID            string
+		branchID          string
+		minNodeID         int64
+		maxNodeID         int64
+		lastNodeID        int64
+		lastTransactionID int64
+		pageSize          int
+		pageToken         string
+	}
+
+	entry struct {
+		key      pageKey
+		response *persistence.InternalReadHistoryBranchResponse
+		size     int
+		// next is the key of the read continuing this page, it is set until that read returned nodes
+		next *pageKey
+	}
+)
+
+// NewCache creates a Cache holding up to maxSize bytes of history. A non-positive size disables the cache.
+func NewCache(maxSize dynamicproperties.IntPropertyFn, metricsClient metrics.Client) *Cache {
+	if metricsClient == nil {
+		metricsClient = metrics.NewNoopMetricsClient()
+	}
+	return &Cache{
+		maxSize:       maxSize,
+		metricsClient: metricsClient,
+		lru:           list.New(),
+		entries:       make(map[pageKey]*list.Element),
+		trees:         make(map[string]map[pageKey]struct{}),
+		pending:       make(map[pageKey]*list.Element),
+	}
+}
+
+func newPageKey(request *persistence.InternalReadHistoryBranchRequest) pageKey {
+	return pageKey{
+		treeID:            request.TreeID,
+		branchID:          request.BranchID,
+		minNodeID:         request.MinNodeID,
+		maxNodeID:         request.MaxNodeID,
+		lastNodeID:        request.LastNodeID,
+		lastTransactionID: request.LastTransactionID,
+		pageSize:          request.PageSize,
+		pageToken:         string(request.NextPageToken),
+	}
+}
+
+func (c *Cache) get(
+	scope metrics.ScopeIdx,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, bool) {
+	if c.maxSize() <= 0 || !cacheable(request) {
+		return nil, false
+	}
+
+	c.Lock()
+	element, ok := c.entries[newPageKey(request)]
+	if ok && element.Value.(*entry).next != nil {
+		ok = false
+	}
+	if ok {
+		c.lru.MoveToFront(element)
+	}
+	c.Unlock()
+
+	if !ok {
+		c.metricsClient.IncCounter(scope, metrics.BaseCacheMiss)
+		return nil, false
+	}
+	c.metricsClient.IncCounter(scope, metrics.BaseCacheHit)
+	return copyResponse(element.Value.(*entry).response), true
+}
+
+func (c *Cache) put(
+	request *persistence.InternalReadHistoryBranchRequest,
+	response *persistence.InternalReadHistoryBranchResponse,
+) {
+	maxSize := c.maxSize()
+	if maxSize <= 0 || !cacheable(request) || response == nil || len(response.History) == 0 {
+		return
+	}
+	key := newPageKey(request)
+
+	c.Lock()
+	defer c.Unlock()
+	// the previous page is followed by the nodes of this one, so it no longer contains the tip of the range
+	if element, ok := c.pending[key]; ok {
+		delete(c.pending, key)
+		element.Value.(*entry).next = nil
+	}
+	if len(response.NextPageToken) == 0 {
+		return
+	}
+
+	next := key
+	next.lastNodeID = response.LastNodeID
+	next.lastTransactionID = response.LastTransactionID
+	next.pageToken = string(response.NextPageToken)
+	e := &entry{
+		key:      key,
+		response: copyResponse(response),
+		size:     entryOverhead + len(request.NextPageToken) + len(response.NextPageToken),
+		next:     &next,
+	}
+	for _, blob := range response.History {
+		e.size += len(blob.Data)
+	}
+	if e.size > maxSize {
+		return
+	}
+
+	if element, ok := c.entries[e.key]; ok {
+		c.remove(element)
+	}
+	if element, ok := c.pending[next]; ok {
+		c.remove(element)
+	}
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.pending[next] = c.entries[e.key]
+	c.size += e.size
+	pages, ok := c.trees[e.key.treeID]
+	if !ok {
+		pages = make(map[pageKey]struct{})
+		c.trees[e.key.treeID] = pages
+	}
+	pages[e.key] = struct{}{}
+	for c.size > maxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+// invalidateBranch drops the cached pages of a branch which may contain nodes from fromNodeID onwards.
+func (c *Cache) invalidateBranch(treeID, branchID string, fromNodeID int64) {
+	c.Lock()
+	defer c.Unlock()
+	for key := range c.trees[treeID] {
+		if key.branchID == branchID && key.maxNodeID > fromNodeID {
+			c.remove(c.entries[key])
+		}
+	}
+}
+
+// invalidateTree drops the cached pages of every branch of a tree.
+func (c *Cache) invalidateTree(treeID string) {
+	c.Lock()
+	defer c.Unlock()
+	for key := range c.trees[treeID] {
+		c.remove(c.entries[key])
+	}
+}
+
+// remove must be called with the lock held.
+func (c *Cache) remove(element *list.Element) {
+	e := c.lru.Remove(element).(*entry)
+	delete(c.entries, e.key)
+	if e.next != nil {
+		delete(c.pending, *e.next)
+	}
+	c.size -= e.size
+	if pages := c.trees[e.key.treeID]; pages != nil {
+		delete(pages, e.key)
+		if len(pages) == 0 {
+			delete(c.trees, e.key.treeID)
+		}
+	}
+}
+
+func cacheable(request *persistence.InternalReadHistoryBranchRequest) bool {
+	return request.MaxNodeID < constants.EndEventID
+}
+
+// copyResponse copies a response so that neither callers nor the cache observe each other's changes to it.
+func copyResponse(response *persistence.InternalReadHistoryBranchResponse) *persistence.InternalReadHistoryBranchResponse {
+	history := make([]*persistence.DataBlob, len(response.History))
+	for i, blob := range response.History {
+		if blob != nil {
+			history[i] = &persistence.DataBlob{
+				Encoding: blob.Encoding,
+				Data:     bytes.Clone(blob.Data),
+			}
+		}
+	}
+	return &persistence.InternalReadHistoryBranchResponse{
+		History:           history,
+		NextPageToken:     bytes.Clone(response.NextPageToken),
+		LastNodeID:        response.LastNodeID,
+		LastTransactionID: response.LastTransactionID,
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historycache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testTreeID   = "tree"
+	testBranchID = "branch"
+	testToken    = "token"
+	// blobSize is chosen so that two pages fit in testMaxSize, but not three.
+	blobSize    = 100
+	pageSize    = entryOverhead + len(testToken) + blobSize
+	testMaxSize = 2*pageSize + blobSize
+)
+
+type testStore struct {
+	store   persistence.HistoryStore
+	cache   *Cache
+	mock    *persistence.MockHistoryStore
+	scope   tally.TestScope
+	maxSize int
+}
+
+func newTestStore(t *testing.T) *testStore {
+	s := &testStore{
+		mock:    persistence.NewMockHistoryStore(gomock.NewController(t)),
+		scope:   tally.NewTestScope("", nil),
+		maxSize: testMaxSize,
+	}
+	s.cache = NewCache(
+		func(...dynamicproperties.FilterOption) int { return s.maxSize },
+		metrics.NewClient(s.scope, metrics.ServiceIdx(0), metrics.MigrationConfig{}),
+	)
+	s.store = NewHistoryStore(s.mock, s.cache)
+	return s
+}
+
+func (s *testStore) counter(name string) int64 {
+	var total int64
+	for _, c := range s.scope.Snapshot().Counters() {
+		if c.Name() == name && c.Tags()["operation"] == "ReadHistoryBranch" {
+			total += c.Value()
+		}
+	}
+	return total
+}
+
+func readRequest(branchID string, minNodeID, maxNodeID int64) *persistence.InternalReadHistoryBranchRequest {
+	return &persistence.InternalReadHistoryBranchRequest{
+		TreeID:    testTreeID,
+		BranchID:  branchID,
+		MinNodeID: minNodeID,
+		MaxNodeID: maxNodeID,
+		PageSize:  10,
+		ShardID:   1,
+	}
+}
+
+// nextRequest returns the read continuing the page returned for request by readResponse.
+func nextRequest(request *persistence.InternalReadHistoryBranchRequest) *persistence.InternalReadHistoryBranchRequest {
+	next := *request
+	next.LastNodeID = request.LastNodeID + 1
+	next.LastTransactionID = request.LastTransactionID + 1
+	next.NextPageToken = []byte(testToken)
+	return &next
+}
+
+func readResponse(request *persistence.InternalReadHistoryBranchRequest) *persistence.InternalReadHistoryBranchResponse {
+	next := nextRequest(request)
+	return &persistence.InternalReadHistoryBranchResponse{
+		History: []*persistence.DataBlob{{
+			Encoding: constants.EncodingTypeThriftRW,
+			Data:     make([]byte, blobSize),
+		}},
+		NextPageToken:     next.NextPageToken,
+		LastNodeID:        next.LastNodeID,
+		LastTransactionID: next.LastTransactionID,
+	}
+}
+
+// lastResponse returns the last page of a read, which contains the tip of the requested range.
+func lastResponse(request *persistence.InternalReadHistoryBranchRequest) *persistence.InternalReadHistoryBranchResponse {
+	response := readResponse(request)
+	response.NextPageToken = nil
+	return response
+}
+
+// read reads a page, expecting the wrapped store to be called only if called is true.
+func (s *testStore) read(t *testing.T, request *persistence.InternalReadHistoryBranchRequest, called bool) {
+	t.Helper()
+	s.readPage(t, request, readResponse(request), called)
+}
+
+func (s *testStore) readPage(
+	t *testing.T,
+	request *persistence.InternalReadHistoryBranchRequest,
+	response *persistence.InternalReadHistoryBranchResponse,
+	called bool,
+) {
+	t.Helper()
+	if called {
+		s.mock.EXPECT().ReadHistoryBranch(gomock.Any(), request).Return(response, nil)
+	}
+	got, err := s.store.ReadHistoryBranch(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, response, got)
+}
+
+// fill reads a page and the last page following it, so that the first one is served from the cache.
+func (s *testStore) fill(t *testing.T, request *persistence.InternalReadHistoryBranchRequest) {
+	t.Helper()
+	s.read(t, request, true)
+	next := nextRequest(request)
+	s.readPage(t, next, lastResponse(next), true)
+}
+
+func TestReadThrough(t *testing.T) {
+	s := newTestStore(t)
+	request := readRequest(testBranchID, 1, 10)
+
+	s.fill(t, request)
+	s.read(t, request, false)
+	assert.Equal(t, int64(2), s.counter("cache_miss"))
+	assert.Equal(t, int64(1), s.counter("cache_hit"))
+
+	t.Run("pages are keyed by position", func(t *testing.T) {
+		next := readRequest(testBranchID, 1, 10)
+		next.LastNodeID = 5
+		next.LastTransactionID = 12
+		s.read(t, next, true)
+	})
+
+	t.Run("callers do not share responses", func(t *testing.T) {
+		got, err := s.store.ReadHistoryBranch(context.Background(), request)
+		require.NoError(t, err)
+		got.History[0].Data[0] = 1
+		got.History[0].Encoding = constants.EncodingTypeJSON
+		got.History = nil
+		s.read(t, request, false)
+	})
+
+	t.Run("pages are served once followed by nodes", func(t *testing.T) {
+		request := readRequest(testBranchID, 10, 20)
+		next := nextRequest(request)
+		s.read(t, request, true)
+		s.read(t, request, true)
+		// an empty continuation does not prove that the page is not the tip of the range
+		s.readPage(t, next, &persistence.InternalReadHistoryBranchResponse{}, true)
+		s.read(t, request, true)
+		s.readPage(t, next, lastResponse(next), true)
+		s.read(t, request, false)
+		s.readPage(t, next, lastResponse(next), true)
+		assert.Empty(t, s.cache.pending)
+	})
+}
+
+func TestNotCached(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  *persistence.InternalReadHistoryBranchRequest
+		response *persistence.InternalReadHistoryBranchResponse
+		err      error
+		maxSize  int
+	}{
+		{
+			name:     "unbounded read",
+			request:  readRequest(testBranchID, 1, constants.EndEventID),
+			response: readResponse(readRequest(testBranchID, 1, constants.EndEventID)),
+			maxSize:  testMaxSize,
+		},
+		{
+			name:     "last page",
+			request:  readRequest(testBranchID, 1, 10),
+			response: lastResponse(readRequest(testBranchID, 1, 10)),
+			maxSize:  testMaxSize,
+		},
+		{
+			name:     "empty page",
+			request:  readRequest(testBranchID, 1, 10),
+			response: &persistence.InternalReadHistoryBranchResponse{},
+			maxSize:  testMaxSize,
+		},
+		{
+			name:    "error",
+			request: readRequest(testBranchID, 1, 10),
+			err:     &types.InternalServiceError{Message: "boom"},
+			maxSize: testMaxSize,
+		},
+		{
+			name:     "page larger than the cache",
+			request:  readRequest(testBranchID, 1, 10),
+			response: readResponse(readRequest(testBranchID, 1, 10)),
+			maxSize:  blobSize,
+		},
+		{
+			name:     "cache disabled",
+			request:  readRequest(testBranchID, 1, 10),
+			response: readResponse(readRequest(testBranchID, 1, 10)),
+			maxSize:  0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newTestStore(t)
+			s.maxSize = tc.maxSize
+			s.mock.EXPECT().ReadHistoryBranch(gomock.Any(), tc.request).Return(tc.response, tc.err).Times(2)
+			for i := 0; i < 2; i++ {
+				got, err := s.store.ReadHistoryBranch(context.Background(), tc.request)
+				assert.True(t, errors.Is(err, tc.err))
+				assert.Equal(t, tc.response, got)
+			}
+			assert.Zero(t, s.counter("cache_hit"))
+			assert.Empty(t, s.cache.pending)
+		})
+	}
+}
+
+func TestEviction(t *testing.T) {
+	s := newTestStore(t)
+	first := readRequest(testBranchID, 1, 10)
+	second := readRequest(testBranchID, 10, 20)
+	third := readRequest(testBranchID, 20, 30)
+
+	s.fill(t, first)
+	s.fill(t, second)
+	// makes the second page the least recently used one
+	s.read(t, first, false)
+	s.fill(t, third)
+
+	s.read(t, first, false)
+	s.read(t, third, false)
+	s.read(t, second, true)
+
+	t.Run("shrinking the cache evicts on the next insert", func(t *testing.T) {
+		s.maxSize = pageSize
+		s.fill(t, readRequest(testBranchID, 30, 40))
+		s.read(t, third, true)
+		assert.Len(t, s.cache.pending, 1)
+	})
+}
+
+func TestInvalidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		invalidate  func(t *testing.T, s *testStore)
+		wantCached  []*persistence.InternalReadHistoryBranchRequest
+		wantDropped []*persistence.InternalReadHistoryBranchRequest
+	}{
+		{
+			name: "append inside a cached range",
+			invalidate: func(t *testing.T, s *testStore) {
+				request := &persistence.InternalAppendHistoryNodesRequest{
+					BranchInfo: types.HistoryBranch{TreeID: testTreeID, BranchID: testBranchID},
+					NodeID:     15,
+				}
+				s.mock.EXPECT().AppendHistoryNodes(gomock.Any(), request).Return(nil)
+				require.NoError(t, s.store.AppendHistoryNodes(context.Background(), request))
+			},
+			wantCached:  []*persistence.InternalReadHistoryBranchRequest{readRequest(testBranchID, 1, 10), readRequest("other", 10, 20)},
+			wantDropped: []*persistence.InternalReadHistoryBranchRequest{readRequest(testBranchID, 10, 20)},
+		},
+		{
+			name: "failed append",
+			invalidate: func(t *testing.T, s *testStore) {
+				request := &persistence.InternalAppendHistoryNodesRequest{
+					BranchInfo: types.HistoryBranch{TreeID: testTreeID, BranchID: testBranchID},
+					NodeID:     1,
+				}
+				s.mock.EXPECT().AppendHistoryNodes(gomock.Any(), request).Return(&persistence.ConditionFailedError{})
+				require.Error(t, s.store.AppendHistoryNodes(context.Background(), request))
+			},
+			wantCached:  []*persistence.InternalReadHistoryBranchRequest{readRequest("other", 10, 20)},
+			wantDropped: []*persistence.InternalReadHistoryBranchRequest{readRequest(testBranchID, 1, 10), readRequest(testBranchID, 10, 20)},
+		},
+		{
+			name: "branch deletion",
+			invalidate: func(t *testing.T, s *testStore) {
+				request := &persistence.InternalDeleteHistoryBranchRequest{
+					BranchInfo: types.HistoryBranch{TreeID: testTreeID, BranchID: "other"},
+				}
+				s.mock.EXPECT().DeleteHistoryBranch(gomock.Any(), request).Return(nil)
+				require.NoError(t, s.store.DeleteHistoryBranch(context.Background(), request))
+			},
+			wantDropped: []*persistence.InternalReadHistoryBranchRequest{
+				readRequest(testBranchID, 1, 10),
+				readRequest(testBranchID, 10, 20),
+				readRequest("other", 10, 20),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newTestStore(t)
+			s.maxSize = 10 * testMaxSize
+			all := append(append([]*persistence.InternalReadHistoryBranchRequest{}, tc.wantCached...), tc.wantDropped...)
+			for _, request := range all {
+				s.fill(t, request)
+			}
+
+			tc.invalidate(t, s)
+
+			for _, request := range tc.wantCached {
+				s.read(t, request, false)
+			}
+			for _, request := range tc.wantDropped {
+				s.read(t, request, true)
+			}
+		})
+	}
+}
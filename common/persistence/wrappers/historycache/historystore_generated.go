@@ -0,0 +1,77 @@
+package historycache
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/historycache.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// cachedHistoryStore implements persistence.HistoryStore interface with a read-through cache of history node batches.
+type cachedHistoryStore struct {
+	wrapped persistence.HistoryStore
+	cache   *Cache
+}
+
+// NewHistoryStore creates a new instance of HistoryStore with a read-through cache.
+func NewHistoryStore(
+	wrapped persistence.HistoryStore,
+	cache *Cache,
+) persistence.HistoryStore {
+	return &cachedHistoryStore{
+		wrapped: wrapped,
+		cache:   cache,
+	}
+}
+
+func (c *cachedHistoryStore) AppendHistoryNodes(ctx context.Context, request *persistence.InternalAppendHistoryNodesRequest) (err error) {
+	err = c.wrapped.AppendHistoryNodes(ctx, request)
+	c.cache.invalidateBranch(request.BranchInfo.TreeID, request.BranchInfo.BranchID, request.NodeID)
+	return
+}
+
+func (c *cachedHistoryStore) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *cachedHistoryStore) DeleteHistoryBranch(ctx context.Context, request *persistence.InternalDeleteHistoryBranchRequest) (err error) {
+	err = c.wrapped.DeleteHistoryBranch(ctx, request)
+	c.cache.invalidateTree(request.BranchInfo.TreeID)
+	return
+}
+
+func (c *cachedHistoryStore) ForkHistoryBranch(ctx context.Context, request *persistence.InternalForkHistoryBranchRequest) (ip1 *persistence.InternalForkHistoryBranchResponse, err error) {
+	return c.wrapped.ForkHistoryBranch(ctx, request)
+}
+
+func (c *cachedHistoryStore) GetAllHistoryTreeBranches(ctx context.Context, request *persistence.GetAllHistoryTreeBranchesRequest) (gp1 *persistence.GetAllHistoryTreeBranchesResponse, err error) {
+	return c.wrapped.GetAllHistoryTreeBranches(ctx, request)
+}
+
+func (c *cachedHistoryStore) GetHistoryTree(ctx context.Context, request *persistence.InternalGetHistoryTreeRequest) (ip1 *persistence.InternalGetHistoryTreeResponse, err error) {
+	return c.wrapped.GetHistoryTree(ctx, request)
+}
+
+func (c *cachedHistoryStore) GetName() (s1 string) {
+	return c.wrapped.GetName()
+}
+
+func (c *cachedHistoryStore) ReadHistoryBranch(ctx context.Context, request *persistence.InternalReadHistoryBranchRequest) (ip1 *persistence.InternalReadHistoryBranchResponse, err error) {
+	if cached, ok := c.cache.get(metrics.PersistenceReadHistoryBranchScope, request); ok {
+		return cached, nil
+	}
+	ip1, err = c.wrapped.ReadHistoryBranch(ctx, request)
+	if err == nil {
+		c.cache.put(request, ip1)
+	}
+	return
+}
+
+func (c *cachedHistoryStore) UpdateHistoryNode(ctx context.Context, request *persistence.InternalUpdateHistoryNodeRequest) (err error) {
+	return c.wrapped.UpdateHistoryNode(ctx, request)
+}
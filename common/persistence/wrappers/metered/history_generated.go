@@ -133,3 +133,14 @@ func (c *meteredHistoryManager) ReadRawHistoryBranch(ctx context.Context, reques
 	err = c.call(metrics.PersistenceReadRawHistoryBranchScope, op, getCustomMetricTags(request)...)
 	return
 }
+
+func (c *meteredHistoryManager) ReencryptHistoryBranch(ctx context.Context, request *persistence.ReencryptHistoryBranchRequest) (rp1 *persistence.ReencryptHistoryBranchResponse, err error) {
+	op := func() error {
+		rp1, err = c.wrapped.ReencryptHistoryBranch(ctx, request)
+		c.emptyMetric("HistoryManager.ReencryptHistoryBranch", request, rp1, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceReencryptHistoryBranchScope, op, getCustomMetricTags(request)...)
+	return
+}
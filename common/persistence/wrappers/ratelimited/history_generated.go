@@ -103,3 +103,11 @@ func (c *ratelimitedHistoryManager) ReadRawHistoryBranch(ctx context.Context, re
 	}
 	return c.wrapped.ReadRawHistoryBranch(ctx, request)
 }
+
+func (c *ratelimitedHistoryManager) ReencryptHistoryBranch(ctx context.Context, request *persistence.ReencryptHistoryBranchRequest) (rp1 *persistence.ReencryptHistoryBranchResponse, err error) {
+	if !c.callerBypass.AllowLimiter(ctx, c.rateLimiter) {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.ReencryptHistoryBranch(ctx, request)
+}
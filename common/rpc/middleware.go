@@ -25,6 +25,10 @@ import (
 	"encoding/json"
 	"io"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
@@ -33,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -244,21 +249,101 @@ func (m *ForwardPartitionConfigMiddleware) Call(ctx context.Context, request *tr
 		} else {
 			request.Headers.Del(common.IsolationGroupHeaderName)
 		}
+		fairnessKey := isolationgroup.FairnessKeyFromContext(ctx)
+		if fairnessKey != "" {
+			request.Headers = request.Headers.With(common.ClientFairnessKeyHeaderName, fairnessKey)
+		} else {
+			request.Headers.Del(common.ClientFairnessKeyHeaderName)
+		}
 	}
 	return out.Call(ctx, request)
 }
 
 // ClientPartitionConfigMiddleware stores the partition config and isolation group of the request into the context
-// It reads a header from client request and uses it as the isolation group
+// It reads headers from client request and uses them as the isolation group and the fairness key
 type ClientPartitionConfigMiddleware struct{}
 
 func (m *ClientPartitionConfigMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, h transport.UnaryHandler) error {
+	partitionConfig := make(map[string]string)
 	zone, _ := req.Headers.Get(common.ClientIsolationGroupHeaderName)
 	if zone != "" {
-		ctx = isolationgroup.ContextWithConfig(ctx, map[string]string{
-			isolationgroup.GroupKey: zone,
-		})
+		partitionConfig[isolationgroup.GroupKey] = zone
 		ctx = isolationgroup.ContextWithIsolationGroup(ctx, zone)
 	}
+	if len(partitionConfig) > 0 {
+		ctx = isolationgroup.ContextWithConfig(ctx, partitionConfig)
+	}
+	if fairnessKey, _ := req.Headers.Get(common.ClientFairnessKeyHeaderName); fairnessKey != "" {
+		ctx = isolationgroup.ContextWithFairnessKey(ctx, fairnessKey)
+	}
 	return h.Handle(ctx, req, resw)
 }
+
+// TracingMiddleware starts a server span for every inbound call and a client span for every outbound call.
+// Trace context is propagated in W3C traceparent/tracestate headers, so traces continue across
+// frontend, history and matching, and into callers that use OpenTelemetry themselves.
+//
+// As an outbound middleware it should be applied after HeaderForwardingMiddleware,
+// so that a forwarded inbound traceparent is replaced by the current span.
+type TracingMiddleware struct {
+	// ServiceName is the cadence service handling or making the calls
+	ServiceName string
+}
+
+func (m *TracingMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, h transport.UnaryHandler) error {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headersCarrier{headers: &req.Headers})
+	ctx, span := tracing.Tracer().Start(ctx, req.Procedure,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(m.attributes(req)...),
+	)
+	err := h.Handle(ctx, req, resw)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (m *TracingMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	ctx, span := tracing.Tracer().Start(ctx, request.Procedure,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(m.attributes(request)...),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, headersCarrier{headers: &request.Headers})
+	response, err := out.Call(ctx, request)
+	if err == nil && response != nil && response.ApplicationError {
+		span.SetStatus(codes.Error, "application error")
+	}
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (m *TracingMiddleware) attributes(req *transport.Request) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("rpc.system", "yarpc"),
+		attribute.String("rpc.service", req.Service),
+		attribute.String("rpc.method", req.Procedure),
+		attribute.String("cadence.service", m.ServiceName),
+		attribute.String("cadence.caller", req.Caller),
+		attribute.String("cadence.transport", req.Transport),
+	}
+}
+
+// headersCarrier adapts yarpc headers to an OpenTelemetry TextMapCarrier
+type headersCarrier struct {
+	headers *transport.Headers
+}
+
+func (c headersCarrier) Get(key string) string {
+	value, _ := c.headers.Get(key)
+	return value
+}
+
+func (c headersCarrier) Set(key, value string) {
+	*c.headers = c.headers.With(key, value)
+}
+
+func (c headersCarrier) Keys() []string {
+	keys := make([]string, 0, c.headers.Len())
+	for key := range c.headers.Items() {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -31,6 +31,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/yarpctest"
 
@@ -249,6 +255,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 			ctx                               context.Context
 			expectedSerializedPartitionConfig string
 			expectedIsolationGroup            string
+			expectedFairnessKey               string
 		}{
 			{
 				message: "it retrieves partition config from the context and sets it in the request header",
@@ -258,6 +265,13 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 				expectedSerializedPartitionConfig: string(blob),
 				expectedIsolationGroup:            "abc",
 			},
+			{
+				message: "it retrieves the fairness key from the context and sets it in the request header",
+				headers: transport.NewHeaders().
+					With(common.AutoforwardingClusterHeaderName, "cluster0"),
+				ctx:                 isolationgroup.ContextWithFairnessKey(context.Background(), "tenant-a"),
+				expectedFairnessKey: "tenant-a",
+			},
 			{
 				message: "it retrieves partition config from the context and overwrites the existing request header",
 				headers: transport.NewHeaders().
@@ -273,6 +287,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 				headers: transport.NewHeaders().
 					With(common.IsolationGroupHeaderName, "lll").
 					With(common.PartitionConfigHeaderName, "asdfasf").
+					With(common.ClientFairnessKeyHeaderName, "tenant-b").
 					With(common.AutoforwardingClusterHeaderName, "cluster0"),
 				ctx:                               context.Background(),
 				expectedSerializedPartitionConfig: "",
@@ -296,6 +311,7 @@ func TestForwardPartitionConfigMiddleware(t *testing.T) {
 					verify: func(r *transport.Request) {
 						assert.Equal(t, tt.expectedIsolationGroup, r.Headers.Items()[common.IsolationGroupHeaderName])
 						assert.Equal(t, tt.expectedSerializedPartitionConfig, r.Headers.Items()[common.PartitionConfigHeaderName])
+						assert.Equal(t, tt.expectedFairnessKey, r.Headers.Items()[common.ClientFairnessKeyHeaderName])
 					},
 				}
 				_, err := m.Call(tt.ctx, &transport.Request{Headers: tt.headers}, o)
@@ -317,6 +333,19 @@ func TestClientPartitionConfigMiddleware(t *testing.T) {
 		assert.Equal(t, "dca1", isolationgroup.IsolationGroupFromContext(h.ctx))
 	})
 
+	t.Run("it sets the fairness key", func(t *testing.T) {
+		m := &ClientPartitionConfigMiddleware{}
+		h := &fakeHandler{}
+		headers := transport.NewHeaders().
+			With(common.ClientIsolationGroupHeaderName, "dca1").
+			With(common.ClientFairnessKeyHeaderName, "tenant-a")
+		err := m.Handle(context.Background(), &transport.Request{Headers: headers}, nil, h)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{isolationgroup.GroupKey: "dca1"}, isolationgroup.ConfigFromContext(h.ctx))
+		assert.Equal(t, "dca1", isolationgroup.IsolationGroupFromContext(h.ctx))
+		assert.Equal(t, "tenant-a", isolationgroup.FairnessKeyFromContext(h.ctx))
+	})
+
 	t.Run("noop when header is empty", func(t *testing.T) {
 		m := &ClientPartitionConfigMiddleware{}
 		h := &fakeHandler{}
@@ -326,6 +355,7 @@ func TestClientPartitionConfigMiddleware(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Nil(t, isolationgroup.ConfigFromContext(h.ctx))
 		assert.Equal(t, "", isolationgroup.IsolationGroupFromContext(h.ctx))
+		assert.Equal(t, "", isolationgroup.FairnessKeyFromContext(h.ctx))
 		assert.Equal(t, ctx, h.ctx)
 	})
 }
@@ -428,6 +458,68 @@ func TestCallerInfoOutboundMiddleware(t *testing.T) {
 	})
 }
 
+func TestTracingMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	m := &TracingMiddleware{ServiceName: "cadence-frontend"}
+
+	t.Run("inbound call continues remote trace", func(t *testing.T) {
+		traceparent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+		h := &fakeHandler{}
+		req := &transport.Request{
+			Procedure: "uber.cadence.api.v1.WorkflowAPI::StartWorkflowExecution",
+			Caller:    "x-caller",
+			Headers:   transport.NewHeaders().With("traceparent", traceparent),
+		}
+		require.NoError(t, m.Handle(context.Background(), req, nil, h))
+
+		span := trace.SpanFromContext(h.ctx)
+		assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", span.SpanContext().TraceID().String())
+		ended := recorder.Ended()[len(recorder.Ended())-1]
+		assert.Equal(t, req.Procedure, ended.Name())
+		assert.Equal(t, trace.SpanKindServer, ended.SpanKind())
+		assert.Equal(t, "b7ad6b7169203331", ended.Parent().SpanID().String())
+	})
+
+	t.Run("outbound call injects trace context", func(t *testing.T) {
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+		defer parent.End()
+
+		// a forwarded inbound traceparent must be replaced by the client span
+		headers := transport.NewHeaders().With("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+		var traceparent string
+		_, err := m.Call(ctx, &transport.Request{Procedure: "HistoryService::RecordActivityTaskStarted", Headers: headers}, &fakeOutbound{verify: func(r *transport.Request) {
+			traceparent, _ = r.Headers.Get("traceparent")
+		}})
+		require.NoError(t, err)
+
+		ended := recorder.Ended()[len(recorder.Ended())-1]
+		assert.Equal(t, trace.SpanKindClient, ended.SpanKind())
+		assert.Equal(t, parent.SpanContext().SpanID(), ended.Parent().SpanID())
+		assert.Contains(t, traceparent, ended.SpanContext().TraceID().String())
+		assert.Contains(t, traceparent, ended.SpanContext().SpanID().String())
+	})
+
+	t.Run("outbound errors are recorded", func(t *testing.T) {
+		_, err := m.Call(context.Background(), &transport.Request{}, &fakeOutbound{err: assert.AnError})
+		assert.Equal(t, assert.AnError, err)
+		ended := recorder.Ended()[len(recorder.Ended())-1]
+		assert.Equal(t, codes.Error, ended.Status().Code)
+
+		_, err = m.Call(context.Background(), &transport.Request{}, &fakeOutbound{response: &transport.Response{ApplicationError: true}})
+		assert.NoError(t, err)
+		ended = recorder.Ended()[len(recorder.Ended())-1]
+		assert.Equal(t, codes.Error, ended.Status().Code)
+	})
+}
+
 type fakeHandler struct {
 	ctx context.Context
 }
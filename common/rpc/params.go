@@ -170,12 +170,13 @@ func NewParams(serviceName string, config *config.Config, dc *dynamicconfig.Coll
 		OutboundTLS:      outboundTLS,
 		InboundMiddleware: yarpc.InboundMiddleware{
 			// order matters: ForwardPartitionConfigMiddleware must be applied after ClientPartitionConfigMiddleware
-			Unary: yarpc.UnaryInboundMiddleware(&InboundMetricsMiddleware{}, &CallerInfoMiddleware{}, &ClientPartitionConfigMiddleware{}, &ForwardPartitionConfigMiddleware{}),
+			Unary: yarpc.UnaryInboundMiddleware(&TracingMiddleware{ServiceName: serviceName}, &InboundMetricsMiddleware{}, &CallerInfoMiddleware{}, &ClientPartitionConfigMiddleware{}, &ForwardPartitionConfigMiddleware{}),
 		},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
+			// order matters: TracingMiddleware must be applied after HeaderForwardingMiddleware
 			Unary: yarpc.UnaryOutboundMiddleware(&HeaderForwardingMiddleware{
 				Rules: forwardingRules,
-			}, &ForwardPartitionConfigMiddleware{}),
+			}, &ForwardPartitionConfigMiddleware{}, &TracingMiddleware{ServiceName: serviceName}),
 		},
 	}, nil
 }
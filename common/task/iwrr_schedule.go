@@ -65,6 +65,20 @@ func newIWRRSchedule[K comparable, V any](items map[K]weightedContainer[V]) *iwr
 	}
 }
 
+// NewWeightedRoundRobinSchedule creates an IWRR schedule over the given keys,
+// in which each key is returned as many times as its weight
+// Keys with weight <= 0 are ignored
+func NewWeightedRoundRobinSchedule[K comparable](weights map[K]int) Schedule[K] {
+	items := make(map[K]weightedContainer[K], len(weights))
+	for key, weight := range weights {
+		items[key] = weightedContainer[K]{
+			item:   key,
+			weight: weight,
+		}
+	}
+	return newIWRRSchedule(items)
+}
+
 // NewIterator creates a new stateful iterator for this schedule
 func (s *iwrrSchedule[V]) NewIterator() Iterator[V] {
 	if len(s.items) == 0 {
@@ -348,3 +348,21 @@ func TestIWRRSchedule_StatelessSchedule_MultipleIterators(t *testing.T) {
 	require.True(t, ok2)
 	assert.Equal(t, item1, i2)
 }
+
+func TestNewWeightedRoundRobinSchedule(t *testing.T) {
+	schedule := NewWeightedRoundRobinSchedule(map[string]int{
+		"a": 3,
+		"b": 1,
+		"c": 0,
+	})
+
+	assert.Equal(t, 4, schedule.Len())
+
+	// IWRR for weights [3, 1]: [a, a, a, b]
+	var keys []string
+	iter := schedule.NewIterator()
+	for key, ok := iter.TryNext(); ok; key, ok = iter.TryNext() {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"a", "a", "a", "b"}, keys)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	// Initializer sets up the process wide tracer provider based on config
+	Initializer struct {
+		cfg      *config.Tracing
+		logger   log.Logger
+		provider *sdktrace.TracerProvider
+	}
+)
+
+const (
+	tracingNotInitialized int32 = 0
+	tracingInitialized    int32 = 1
+)
+
+// the tracer provider and propagator are process globals, and all services
+// running in one process share them, so they are only initialized once
+var tracingStatus = tracingNotInitialized
+
+// NewInitializer creates a new tracing Initializer
+func NewInitializer(cfg *config.Tracing, logger log.Logger) *Initializer {
+	return &Initializer{
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Start installs the propagator and, if an exporter is configured, the tracer provider
+func (i *Initializer) Start() error {
+	if !atomic.CompareAndSwapInt32(&tracingStatus, tracingNotInitialized, tracingInitialized) {
+		return nil
+	}
+
+	// trace context is always propagated, even when spans are not exported,
+	// so that callers' traces are not broken by a cadence hop
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !i.cfg.Enabled() {
+		i.logger.Info("Tracing export is disabled")
+		return nil
+	}
+
+	provider, err := newTracerProvider(i.cfg)
+	if err != nil {
+		atomic.StoreInt32(&tracingStatus, tracingNotInitialized)
+		return err
+	}
+	i.provider = provider
+	otel.SetTracerProvider(provider)
+	i.logger.Info("Tracing started",
+		tag.Dynamic("tracing-exporter", i.cfg.Exporter),
+		tag.Dynamic("tracing-endpoint", i.cfg.Endpoint),
+		tag.Dynamic("tracing-sample-rate", i.cfg.SampleRate))
+	return nil
+}
+
+// Stop flushes and shuts down the tracer provider if this Initializer created it
+func (i *Initializer) Stop(ctx context.Context) error {
+	if i.provider == nil {
+		return nil
+	}
+	return i.provider.Shutdown(ctx)
+}
+
+func newTracerProvider(cfg *config.Tracing) (*sdktrace.TracerProvider, error) {
+	switch cfg.Exporter {
+	case config.TracingExporterOTLP:
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+	}
+	// the grpc connection is established lazily, so this does not block on the collector
+	exporter, err := otlptracegrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create otlp trace exporter: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRate))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))),
+	), nil
+}
@@ -65,6 +65,11 @@ func EventTypeValues() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeUpsertWorkflowMemo,
+		EventTypeActivityTaskOptionsUpdated,
+		EventTypeWorkflowExecutionUpdateAccepted,
+		EventTypeActivityTaskPaused,
+		EventTypeActivityTaskUnpaused,
 	}
 }
 
@@ -84,5 +89,6 @@ func DecisionTypeValues() []DecisionType {
 		DecisionTypeStartChildWorkflowExecution,
 		DecisionTypeSignalExternalWorkflowExecution,
 		DecisionTypeUpsertWorkflowSearchAttributes,
+		DecisionTypeUpsertWorkflowMemo,
 	}
 }
@@ -669,21 +669,22 @@ func (v *RecordDecisionTaskStartedRequest) GetRequestID() (o string) {
 
 // RecordDecisionTaskStartedResponse is an internal type (TBD...)
 type RecordDecisionTaskStartedResponse struct {
-	WorkflowType              *WorkflowType             `json:"workflowType,omitempty"`
-	PreviousStartedEventID    *int64                    `json:"previousStartedEventId,omitempty"`
-	ScheduledEventID          int64                     `json:"scheduledEventId,omitempty"`
-	StartedEventID            int64                     `json:"startedEventId,omitempty"`
-	NextEventID               int64                     `json:"nextEventId,omitempty"`
-	Attempt                   int64                     `json:"attempt,omitempty"`
-	StickyExecutionEnabled    bool                      `json:"stickyExecutionEnabled,omitempty"`
-	DecisionInfo              *TransientDecisionInfo    `json:"decisionInfo,omitempty"`
-	WorkflowExecutionTaskList *TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
-	EventStoreVersion         int32                     `json:"eventStoreVersion,omitempty"`
-	BranchToken               []byte                    `json:"branchToken,omitempty"`
-	ScheduledTimestamp        *int64                    `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
-	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
-	HistorySize               int64                     `json:"historySize,omitempty"`
+	WorkflowType              *WorkflowType              `json:"workflowType,omitempty"`
+	PreviousStartedEventID    *int64                     `json:"previousStartedEventId,omitempty"`
+	ScheduledEventID          int64                      `json:"scheduledEventId,omitempty"`
+	StartedEventID            int64                      `json:"startedEventId,omitempty"`
+	NextEventID               int64                      `json:"nextEventId,omitempty"`
+	Attempt                   int64                      `json:"attempt,omitempty"`
+	StickyExecutionEnabled    bool                       `json:"stickyExecutionEnabled,omitempty"`
+	DecisionInfo              *TransientDecisionInfo     `json:"decisionInfo,omitempty"`
+	WorkflowExecutionTaskList *TaskList                  `json:"WorkflowExecutionTaskList,omitempty"`
+	EventStoreVersion         int32                      `json:"eventStoreVersion,omitempty"`
+	BranchToken               []byte                     `json:"branchToken,omitempty"`
+	ScheduledTimestamp        *int64                     `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp          *int64                     `json:"startedTimestamp,omitempty"`
+	Queries                   map[string]*WorkflowQuery  `json:"queries,omitempty"`
+	HistorySize               int64                      `json:"historySize,omitempty"`
+	Updates                   map[string]*WorkflowUpdate `json:"updates,omitempty"`
 }
 
 // GetPreviousStartedEventID is an internal getter (TBD...)
@@ -710,6 +711,14 @@ func (v *RecordDecisionTaskStartedResponse) GetAttempt() (o int64) {
 	return
 }
 
+// GetUpdates is an internal getter (TBD...)
+func (v *RecordDecisionTaskStartedResponse) GetUpdates() (o map[string]*WorkflowUpdate) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
+	}
+	return
+}
+
 // HistoryRefreshWorkflowTasksRequest is an internal type (TBD...)
 type HistoryRefreshWorkflowTasksRequest struct {
 	DomainUIID string                       `json:"domainUIID,omitempty"`
@@ -724,6 +733,94 @@ func (v *HistoryRefreshWorkflowTasksRequest) GetRequest() (o *RefreshWorkflowTas
 	return
 }
 
+// HistoryPauseActivityRequest is an internal type (TBD...)
+type HistoryPauseActivityRequest struct {
+	DomainUUID string                `json:"domainUUID,omitempty"`
+	Request    *PauseActivityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetRequest() (o *PauseActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryUnpauseActivityRequest is an internal type (TBD...)
+type HistoryUnpauseActivityRequest struct {
+	DomainUUID string                  `json:"domainUUID,omitempty"`
+	Request    *UnpauseActivityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetRequest() (o *UnpauseActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryUpdateActivityOptionsRequest is an internal type (TBD...)
+type HistoryUpdateActivityOptionsRequest struct {
+	DomainUUID string                        `json:"domainUUID,omitempty"`
+	Request    *UpdateActivityOptionsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetRequest() (o *UpdateActivityOptionsRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryUpdateWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryUpdateWorkflowExecutionRequest struct {
+	DomainUUID string                          `json:"domainUUID,omitempty"`
+	Request    *UpdateWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowExecutionRequest) GetRequest() (o *UpdateWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
 // RemoveSignalMutableStateRequest is an internal type (TBD...)
 type RemoveSignalMutableStateRequest struct {
 	DomainUUID        string             `json:"domainUUID,omitempty"`
@@ -955,6 +1052,7 @@ type HistorySignalWithStartWorkflowExecutionRequest struct {
 	DomainUUID             string                                   `json:"domainUUID,omitempty"`
 	SignalWithStartRequest *SignalWithStartWorkflowExecutionRequest `json:"signalWithStartRequest,omitempty"`
 	PartitionConfig        map[string]string
+	FairnessKey            string `json:"fairnessKey,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -973,6 +1071,14 @@ func (v *HistorySignalWithStartWorkflowExecutionRequest) GetPartitionConfig() (o
 	return
 }
 
+// GetFairnessKey is an internal getter (TBD...)
+func (v *HistorySignalWithStartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // HistorySignalWorkflowExecutionRequest is an internal type (TBD...)
 type HistorySignalWorkflowExecutionRequest struct {
 	DomainUUID                string                          `json:"domainUUID,omitempty"`
@@ -1010,6 +1116,7 @@ type HistoryStartWorkflowExecutionRequest struct {
 	LastCompletionResult            []byte                         `json:"lastCompletionResult,omitempty"`
 	FirstDecisionTaskBackoffSeconds *int32                         `json:"firstDecisionTaskBackoffSeconds,omitempty"`
 	PartitionConfig                 map[string]string
+	FairnessKey                     string `json:"fairnessKey,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -1052,6 +1159,14 @@ func (v *HistoryStartWorkflowExecutionRequest) GetPartitionConfig() (o map[strin
 	return
 }
 
+// GetFairnessKey is an internal getter (TBD...)
+func (v *HistoryStartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // SyncActivityRequest is an internal type (TBD...)
 type SyncActivityRequest struct {
 	DomainID           string          `json:"domainId,omitempty"`
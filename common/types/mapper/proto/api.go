@@ -6165,6 +6165,8 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		Version:   e.Version,
 		TaskId:    e.TaskID,
 	}
+	// the events which are only defined in the thrift IDL so far, like UpsertWorkflowMemo,
+	// have no attributes in apiv1.HistoryEvent yet and are mapped without attributes
 	switch *e.EventType {
 	case types.EventTypeWorkflowExecutionStarted:
 		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionStartedEventAttributes{
@@ -6484,6 +6486,8 @@ func FromDecision(d *types.Decision) *apiv1.Decision {
 		return nil
 	}
 	decision := apiv1.Decision{}
+	// the decisions which are only defined in the thrift IDL so far, like UpsertWorkflowMemo,
+	// have no attributes in apiv1.Decision yet and are mapped without attributes
 	switch *d.DecisionType {
 	case types.DecisionTypeScheduleActivityTask:
 		decision.Attributes = &apiv1.Decision_ScheduleActivityTaskDecisionAttributes{
@@ -1838,8 +1838,9 @@ func TestListFailoverHistoryResponseFuzz(t *testing.T) {
 func TestDescribeTaskListResponseFuzz(t *testing.T) {
 	// TaskListPartitionConfig has map[int] fields that get truncated to map[int32] in proto
 	// From and To are non-invertable operations, so we rely on testdata to verify the mapping is correct
+	// BuildID is not carried by the proto API yet.
 	testutils.RunMapperFuzzTest(t, FromDescribeTaskListResponse, ToDescribeTaskListResponse,
-		testutils.WithExcludedFields("ReadPartitions", "WritePartitions"),
+		testutils.WithExcludedFields("BuildID", "ReadPartitions", "WritePartitions"),
 	)
 }
 
@@ -1895,7 +1896,10 @@ func TestWorkflowQueryResultMapFuzz(t *testing.T) {
 }
 
 func TestDecisionTaskStartedEventAttributesFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromDecisionTaskStartedEventAttributes, ToDecisionTaskStartedEventAttributes)
+	// WorkerBuildID is not carried by the proto API yet.
+	testutils.RunMapperFuzzTest(t, FromDecisionTaskStartedEventAttributes, ToDecisionTaskStartedEventAttributes,
+		testutils.WithExcludedFields("WorkerBuildID"),
+	)
 }
 
 func TestResetWorkflowExecutionResponseFuzz(t *testing.T) {
@@ -2015,7 +2019,10 @@ func TestWorkflowExecutionTerminatedEventAttributesFuzz(t *testing.T) {
 }
 
 func TestWorkerVersionInfoFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromWorkerVersionInfo, ToWorkerVersionInfo)
+	// BuildID is not carried by the proto API yet.
+	testutils.RunMapperFuzzTest(t, FromWorkerVersionInfo, ToWorkerVersionInfo,
+		testutils.WithExcludedFields("BuildID"),
+	)
 }
 
 func TestListOpenWorkflowExecutionsRequestFuzz(t *testing.T) {
@@ -2068,6 +2075,9 @@ func TestWorkflowQueryFuzz(t *testing.T) {
 
 func TestHistoryEventFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromHistoryEvent, ToHistoryEvent,
+		// WorkerBuildID of the decision task started event and FairnessKey of the workflow execution
+		// started event are not carried by the proto API yet.
+		testutils.WithExcludedFields("WorkerBuildID", "FairnessKey"),
 		testutils.WithCustomFuncs(
 			EventTypeFuzzer,
 			DecisionTypeFuzzer,
@@ -2258,7 +2268,10 @@ func TestDescribeTaskListRequestFuzz(t *testing.T) {
 }
 
 func TestPollerInfoFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromPollerInfo, ToPollerInfo)
+	// BuildID is not carried by the proto API yet.
+	testutils.RunMapperFuzzTest(t, FromPollerInfo, ToPollerInfo,
+		testutils.WithExcludedFields("BuildID"),
+	)
 }
 
 func TestTerminateWorkflowExecutionRequestFuzz(t *testing.T) {
@@ -2443,7 +2456,10 @@ func TestPendingChildExecutionInfoFuzz(t *testing.T) {
 }
 
 func TestPollForDecisionTaskRequestFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromPollForDecisionTaskRequest, ToPollForDecisionTaskRequest)
+	// WorkerVersionInfo is not carried by the proto API yet.
+	testutils.RunMapperFuzzTest(t, FromPollForDecisionTaskRequest, ToPollForDecisionTaskRequest,
+		testutils.WithExcludedFields("WorkerVersionInfo"),
+	)
 }
 
 func TestRecordMarkerDecisionAttributesFuzz(t *testing.T) {
@@ -2620,6 +2636,14 @@ func TestDescribeTaskListResponseMapFuzz(t *testing.T) {
 						resp.PartitionConfig.ReadPartitions = nil
 						resp.PartitionConfig.WritePartitions = nil
 					}
+					// BuildID is not carried by the proto API yet.
+					if resp != nil {
+						for _, poller := range resp.Pollers {
+							if poller != nil {
+								poller.BuildID = ""
+							}
+						}
+					}
 				}
 			},
 		),
@@ -2760,7 +2784,10 @@ func TestSignalWithStartWorkflowExecutionAsyncRequestFuzz(t *testing.T) {
 }
 
 func TestPollerInfoArrayFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromPollerInfoArray, ToPollerInfoArray)
+	// BuildID is not carried by the proto API yet.
+	testutils.RunMapperFuzzTest(t, FromPollerInfoArray, ToPollerInfoArray,
+		testutils.WithExcludedFields("BuildID"),
+	)
 }
 
 func TestResetStickyTaskListResponseFuzz(t *testing.T) {
@@ -2937,7 +2964,8 @@ func TestWorkflowExecutionStartedEventAttributesFuzz(t *testing.T) {
 				}
 			},
 		),
-		testutils.WithExcludedFields("JitterStartSeconds"),
+		// FairnessKey is not carried by the proto API yet.
+		testutils.WithExcludedFields("JitterStartSeconds", "FairnessKey"),
 	)
 }
 
@@ -3001,10 +3029,12 @@ func TestAPITaskListPartitionConfigFuzz(t *testing.T) {
 }
 
 func TestRespondQueryTaskCompletedRequestFuzz(t *testing.T) {
+	// BuildID is not carried by the proto API yet.
 	testutils.RunMapperFuzzTest(t, FromRespondQueryTaskCompletedRequest, ToRespondQueryTaskCompletedRequest,
 		testutils.WithCustomFuncs(
 			CompletedTypeFuzzer,
 		),
+		testutils.WithExcludedFields("BuildID"),
 	)
 }
 
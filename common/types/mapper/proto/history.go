@@ -864,6 +864,7 @@ func FromHistoryRecordDecisionTaskStartedRequest(t *types.RecordDecisionTaskStar
 		TaskId:            t.TaskID,
 		RequestId:         t.RequestID,
 		PollRequest:       FromPollForDecisionTaskRequest(t.PollRequest),
+		WorkerBuildId:     t.PollRequest.GetWorkerVersionInfo().GetBuildID(),
 	}
 }
 
@@ -871,13 +872,17 @@ func ToHistoryRecordDecisionTaskStartedRequest(t *historyv1.RecordDecisionTaskSt
 	if t == nil {
 		return nil
 	}
+	pollRequest := ToPollForDecisionTaskRequest(t.PollRequest)
+	if pollRequest != nil {
+		pollRequest.WorkerVersionInfo = withWorkerBuildID(pollRequest.WorkerVersionInfo, t.WorkerBuildId)
+	}
 	return &types.RecordDecisionTaskStartedRequest{
 		DomainUUID:        t.DomainId,
 		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
 		ScheduleID:        t.ScheduleId,
 		TaskID:            t.TaskId,
 		RequestID:         t.RequestId,
-		PollRequest:       ToPollForDecisionTaskRequest(t.PollRequest),
+		PollRequest:       pollRequest,
 	}
 }
 
@@ -901,6 +906,7 @@ func FromHistoryRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskSta
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		Updates:                   FromHistoryWorkflowUpdateMap(t.Updates),
 	}
 }
 
@@ -924,6 +930,7 @@ func ToHistoryRecordDecisionTaskStartedResponse(t *historyv1.RecordDecisionTaskS
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		Updates:                   ToHistoryWorkflowUpdateMap(t.Updates),
 	}
 }
 
@@ -1283,6 +1290,7 @@ func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWi
 		Request:         FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
 		DomainId:        t.DomainUUID,
 		PartitionConfig: t.PartitionConfig,
+		FairnessKey:     t.FairnessKey,
 	}
 }
 
@@ -1294,6 +1302,7 @@ func ToHistorySignalWithStartWorkflowExecutionRequest(t *historyv1.SignalWithSta
 		SignalWithStartRequest: ToSignalWithStartWorkflowExecutionRequest(t.Request),
 		DomainUUID:             t.DomainId,
 		PartitionConfig:        t.PartitionConfig,
+		FairnessKey:            t.FairnessKey,
 	}
 }
 
@@ -1354,6 +1363,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		LastCompletionResult:     FromPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
 		PartitionConfig:          t.PartitionConfig,
+		FairnessKey:              t.FairnessKey,
 	}
 }
 
@@ -1373,6 +1383,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 		LastCompletionResult:            ToPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoffSeconds: durationToSeconds(t.FirstDecisionTaskBackoff),
 		PartitionConfig:                 t.PartitionConfig,
+		FairnessKey:                     t.FairnessKey,
 	}
 }
 
@@ -1622,3 +1633,257 @@ func ToHistoryRatelimitUpdateResponse(t *historyv1.RatelimitUpdateResponse) *typ
 		Any: ToAny(t.Data),
 	}
 }
+
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *historyv1.PauseActivityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.Execution),
+		ActivityId:        t.Request.ActivityID,
+		Identity:          t.Request.Identity,
+		Reason:            t.Request.Reason,
+	}
+}
+
+func ToHistoryPauseActivityRequest(t *historyv1.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.PauseActivityRequest{
+			Domain:     t.Domain,
+			Execution:  ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID: t.ActivityId,
+			Identity:   t.Identity,
+			Reason:     t.Reason,
+		},
+	}
+}
+
+func FromHistoryPauseActivityResponse(t *types.PauseActivityResponse) *historyv1.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityResponse{}
+}
+
+func ToHistoryPauseActivityResponse(t *historyv1.PauseActivityResponse) *types.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityResponse{}
+}
+
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *historyv1.UnpauseActivityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityRequest{
+		DomainId:              t.DomainUUID,
+		Domain:                t.Request.Domain,
+		WorkflowExecution:     FromWorkflowExecution(t.Request.Execution),
+		ActivityId:            t.Request.ActivityID,
+		ResetAttempts:         t.Request.ResetAttempts,
+		ResetHeartbeatDetails: t.Request.ResetHeartbeatDetails,
+		Identity:              t.Request.Identity,
+	}
+}
+
+func ToHistoryUnpauseActivityRequest(t *historyv1.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.UnpauseActivityRequest{
+			Domain:                t.Domain,
+			Execution:             ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:            t.ActivityId,
+			ResetAttempts:         t.ResetAttempts,
+			ResetHeartbeatDetails: t.ResetHeartbeatDetails,
+			Identity:              t.Identity,
+		},
+	}
+}
+
+func FromHistoryUnpauseActivityResponse(t *types.UnpauseActivityResponse) *historyv1.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityResponse{}
+}
+
+func ToHistoryUnpauseActivityResponse(t *historyv1.UnpauseActivityResponse) *types.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityResponse{}
+}
+
+func FromHistoryActivityOptions(t *types.ActivityOptions) *historyv1.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityOptions{
+		TaskList:               FromTaskList(t.TaskList),
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func ToHistoryActivityOptions(t *historyv1.ActivityOptions) *types.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityOptions{
+		TaskList:                      ToTaskList(t.TaskList),
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func FromHistoryUpdateActivityOptionsRequest(t *types.HistoryUpdateActivityOptionsRequest) *historyv1.UpdateActivityOptionsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+		ActivityOptions:   FromHistoryActivityOptions(t.Request.ActivityOptions),
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryUpdateActivityOptionsRequest(t *historyv1.UpdateActivityOptionsRequest) *types.HistoryUpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.UpdateActivityOptionsRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			ActivityOptions:   ToHistoryActivityOptions(t.ActivityOptions),
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryUpdateActivityOptionsResponse(t *types.UpdateActivityOptionsResponse) *historyv1.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsResponse{
+		ActivityOptions: FromHistoryActivityOptions(t.ActivityOptions),
+	}
+}
+
+func ToHistoryUpdateActivityOptionsResponse(t *historyv1.UpdateActivityOptionsResponse) *types.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsResponse{
+		ActivityOptions: ToHistoryActivityOptions(t.ActivityOptions),
+	}
+}
+
+func FromHistoryWorkflowUpdate(t *types.WorkflowUpdate) *historyv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.WorkflowUpdate{
+		UpdateType: t.UpdateType,
+		UpdateArgs: FromPayload(t.UpdateArgs),
+	}
+}
+
+func ToHistoryWorkflowUpdate(t *historyv1.WorkflowUpdate) *types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdate{
+		UpdateType: t.UpdateType,
+		UpdateArgs: ToPayload(t.UpdateArgs),
+	}
+}
+
+func FromHistoryWorkflowUpdateMap(t map[string]*types.WorkflowUpdate) map[string]*historyv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*historyv1.WorkflowUpdate, len(t))
+	for key := range t {
+		v[key] = FromHistoryWorkflowUpdate(t[key])
+	}
+	return v
+}
+
+func ToHistoryWorkflowUpdateMap(t map[string]*historyv1.WorkflowUpdate) map[string]*types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.WorkflowUpdate, len(t))
+	for key := range t {
+		v[key] = ToHistoryWorkflowUpdate(t[key])
+	}
+	return v
+}
+
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *historyv1.UpdateWorkflowExecutionRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		Update:            FromHistoryWorkflowUpdate(t.Request.Update),
+		Identity:          t.Request.Identity,
+		UpdateId:          t.Request.UpdateID,
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionRequest(t *historyv1.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.UpdateWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Update:            ToHistoryWorkflowUpdate(t.Update),
+			Identity:          t.Identity,
+			UpdateID:          t.UpdateId,
+		},
+	}
+}
+
+func FromHistoryUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *historyv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionResponse{
+		Result: FromPayload(t.Result),
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionResponse(t *historyv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result: ToPayload(t.Result),
+	}
+}
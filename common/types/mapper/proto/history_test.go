@@ -216,6 +216,40 @@ func TestHistoryRefreshWorkflowTasksRequest(t *testing.T) {
 	}
 	assert.Nil(t, FromHistoryRefreshWorkflowTasksRequest(&types.HistoryRefreshWorkflowTasksRequest{}))
 }
+func TestHistoryPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseActivityRequest{nil, &testdata.HistoryPauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryPauseActivityRequest(FromHistoryPauseActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryPauseActivityRequest(&types.HistoryPauseActivityRequest{}))
+}
+func TestHistoryUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseActivityRequest{nil, &testdata.HistoryUnpauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseActivityRequest(FromHistoryUnpauseActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUnpauseActivityRequest(&types.HistoryUnpauseActivityRequest{}))
+}
+func TestHistoryUpdateActivityOptionsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateActivityOptionsRequest{nil, &testdata.HistoryUpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToHistoryUpdateActivityOptionsRequest(FromHistoryUpdateActivityOptionsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUpdateActivityOptionsRequest(&types.HistoryUpdateActivityOptionsRequest{}))
+}
+func TestHistoryUpdateActivityOptionsResponse(t *testing.T) {
+	for _, item := range []*types.UpdateActivityOptionsResponse{nil, {}, &testdata.UpdateActivityOptionsResponse} {
+		assert.Equal(t, item, ToHistoryUpdateActivityOptionsResponse(FromHistoryUpdateActivityOptionsResponse(item)))
+	}
+}
+func TestHistoryUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionRequest{nil, &testdata.HistoryUpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionRequest(FromHistoryUpdateWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUpdateWorkflowExecutionRequest(&types.HistoryUpdateWorkflowExecutionRequest{}))
+}
+func TestHistoryUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionResponse(FromHistoryUpdateWorkflowExecutionResponse(item)))
+	}
+}
 func TestHistoryRemoveSignalMutableStateRequest(t *testing.T) {
 	for _, item := range []*types.RemoveSignalMutableStateRequest{nil, {}, &testdata.HistoryRemoveSignalMutableStateRequest} {
 		assert.Equal(t, item, ToHistoryRemoveSignalMutableStateRequest(FromHistoryRemoveSignalMutableStateRequest(item)))
@@ -780,6 +814,62 @@ func TestHistoryRefreshWorkflowTasksRequestFuzz(t *testing.T) {
 	)
 }
 
+func TestHistoryPauseActivityRequestFuzz(t *testing.T) {
+	// FromHistoryPauseActivityRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromHistoryPauseActivityRequest, ToHistoryPauseActivityRequest,
+		testutils.WithCustomFuncs(func(r *types.HistoryPauseActivityRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.PauseActivityRequest{}
+			}
+		}),
+	)
+}
+
+func TestHistoryUnpauseActivityRequestFuzz(t *testing.T) {
+	// FromHistoryUnpauseActivityRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromHistoryUnpauseActivityRequest, ToHistoryUnpauseActivityRequest,
+		testutils.WithCustomFuncs(func(r *types.HistoryUnpauseActivityRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.UnpauseActivityRequest{}
+			}
+		}),
+	)
+}
+
+func TestHistoryUpdateActivityOptionsRequestFuzz(t *testing.T) {
+	// FromHistoryUpdateActivityOptionsRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateActivityOptionsRequest, ToHistoryUpdateActivityOptionsRequest,
+		testutils.WithCustomFuncs(func(r *types.HistoryUpdateActivityOptionsRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.UpdateActivityOptionsRequest{}
+			}
+		}),
+	)
+}
+
+func TestHistoryUpdateActivityOptionsResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateActivityOptionsResponse, ToHistoryUpdateActivityOptionsResponse)
+}
+
+func TestHistoryUpdateWorkflowExecutionRequestFuzz(t *testing.T) {
+	// FromHistoryUpdateWorkflowExecutionRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateWorkflowExecutionRequest, ToHistoryUpdateWorkflowExecutionRequest,
+		testutils.WithCustomFuncs(func(r *types.HistoryUpdateWorkflowExecutionRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.UpdateWorkflowExecutionRequest{}
+			}
+		}),
+	)
+}
+
+func TestHistoryUpdateWorkflowExecutionResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateWorkflowExecutionResponse, ToHistoryUpdateWorkflowExecutionResponse)
+}
+
 func TestHistoryStartWorkflowExecutionRequestFuzz(t *testing.T) {
 	// [BUG] StartRequest contains WorkflowIDReusePolicy (out-of-range → nil) and
 	// ExternalEntityType/ExternalEntityKey fields not mapped through proto (silently dropped).
@@ -41,6 +41,8 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:          t.PartitionConfig,
+		Priority:                 t.Priority,
+		FairnessKey:              t.FairnessKey,
 	}
 }
 
@@ -59,6 +61,8 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -75,6 +79,9 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
 		PartitionConfig:        t.PartitionConfig,
+		Priority:               t.Priority,
+		WorkerBuildId:          t.WorkerBuildID,
+		FairnessKey:            t.FairnessKey,
 	}
 }
 
@@ -91,6 +98,9 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		WorkerBuildID:                 t.WorkerBuildId,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -217,7 +227,7 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:         FromPollerInfoArray(t.Pollers),
+		Pollers:         FromMatchingPollerInfoArray(t.Pollers),
 		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
 		PartitionConfig: FromAPITaskListPartitionConfig(t.PartitionConfig),
 		TaskList:        FromTaskList(t.TaskList),
@@ -229,7 +239,7 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:         ToPollerInfoArray(t.Pollers),
+		Pollers:         ToMatchingPollerInfoArray(t.Pollers),
 		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
 		PartitionConfig: ToAPITaskListPartitionConfig(t.PartitionConfig),
 		TaskList:        ToTaskList(t.TaskList),
@@ -498,6 +508,7 @@ func FromMatchingPollForDecisionTaskRequest(t *types.MatchingPollForDecisionTask
 		PollerId:       t.PollerID,
 		ForwardedFrom:  t.ForwardedFrom,
 		IsolationGroup: t.IsolationGroup,
+		WorkerBuildId:  t.PollRequest.GetWorkerVersionInfo().GetBuildID(),
 	}
 }
 
@@ -505,8 +516,12 @@ func ToMatchingPollForDecisionTaskRequest(t *matchingv1.PollForDecisionTaskReque
 	if t == nil {
 		return nil
 	}
+	pollRequest := ToPollForDecisionTaskRequest(t.Request)
+	if pollRequest != nil {
+		pollRequest.WorkerVersionInfo = withWorkerBuildID(pollRequest.WorkerVersionInfo, t.WorkerBuildId)
+	}
 	return &types.MatchingPollForDecisionTaskRequest{
-		PollRequest:    ToPollForDecisionTaskRequest(t.Request),
+		PollRequest:    pollRequest,
 		DomainUUID:     t.DomainId,
 		PollerID:       t.PollerId,
 		ForwardedFrom:  t.ForwardedFrom,
@@ -540,6 +555,7 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		PartitionConfig:           FromTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         FromLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
+		Updates:                   FromMatchingWorkflowUpdateMap(t.Updates),
 	}
 }
 
@@ -569,6 +585,7 @@ func ToMatchingPollForDecisionTaskResponse(t *matchingv1.PollForDecisionTaskResp
 		PartitionConfig:           ToTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         ToLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
+		Updates:                   ToMatchingWorkflowUpdateMap(t.Updates),
 	}
 }
 
@@ -623,10 +640,11 @@ func FromMatchingRespondQueryTaskCompletedRequest(t *types.MatchingRespondQueryT
 		return nil
 	}
 	return &matchingv1.RespondQueryTaskCompletedRequest{
-		Request:  FromRespondQueryTaskCompletedRequest(t.CompletedRequest),
-		DomainId: t.DomainUUID,
-		TaskList: FromTaskList(t.TaskList),
-		TaskId:   t.TaskID,
+		Request:       FromRespondQueryTaskCompletedRequest(t.CompletedRequest),
+		DomainId:      t.DomainUUID,
+		TaskList:      FromTaskList(t.TaskList),
+		TaskId:        t.TaskID,
+		WorkerBuildId: t.CompletedRequest.GetWorkerVersionInfo().GetBuildID(),
 	}
 }
 
@@ -634,8 +652,12 @@ func ToMatchingRespondQueryTaskCompletedRequest(t *matchingv1.RespondQueryTaskCo
 	if t == nil {
 		return nil
 	}
+	completedRequest := ToRespondQueryTaskCompletedRequest(t.Request)
+	if completedRequest != nil {
+		completedRequest.WorkerVersionInfo = withWorkerBuildID(completedRequest.WorkerVersionInfo, t.WorkerBuildId)
+	}
 	return &types.MatchingRespondQueryTaskCompletedRequest{
-		CompletedRequest: ToRespondQueryTaskCompletedRequest(t.Request),
+		CompletedRequest: completedRequest,
 		DomainUUID:       t.DomainId,
 		TaskList:         ToTaskList(t.TaskList),
 		TaskID:           t.TaskId,
@@ -737,3 +759,386 @@ func ToLoadBalancerHints(t *matchingv1.LoadBalancerHints) *types.LoadBalancerHin
 		RatePerSecond: t.RatePerSecond,
 	}
 }
+
+func FromMatchingWorkflowUpdate(t *types.WorkflowUpdate) *matchingv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.WorkflowUpdate{
+		UpdateType: t.UpdateType,
+		UpdateArgs: FromPayload(t.UpdateArgs),
+	}
+}
+
+func ToMatchingWorkflowUpdate(t *matchingv1.WorkflowUpdate) *types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdate{
+		UpdateType: t.UpdateType,
+		UpdateArgs: ToPayload(t.UpdateArgs),
+	}
+}
+
+func FromMatchingWorkflowUpdateMap(t map[string]*types.WorkflowUpdate) map[string]*matchingv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*matchingv1.WorkflowUpdate, len(t))
+	for key := range t {
+		v[key] = FromMatchingWorkflowUpdate(t[key])
+	}
+	return v
+}
+
+func ToMatchingWorkflowUpdateMap(t map[string]*matchingv1.WorkflowUpdate) map[string]*types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.WorkflowUpdate, len(t))
+	for key := range t {
+		v[key] = ToMatchingWorkflowUpdate(t[key])
+	}
+	return v
+}
+
+func FromMatchingListTaskListTasksRequest(t *types.MatchingListTaskListTasksRequest) *matchingv1.ListTaskListTasksRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &matchingv1.ListTaskListTasksRequest{
+		DomainId:      t.DomainUUID,
+		Domain:        t.Request.Domain,
+		TaskList:      FromTaskList(t.Request.TaskList),
+		TaskListType:  FromTaskListType(t.Request.TaskListType),
+		PageSize:      t.Request.PageSize,
+		NextPageToken: t.Request.NextPageToken,
+	}
+}
+
+func ToMatchingListTaskListTasksRequest(t *matchingv1.ListTaskListTasksRequest) *types.MatchingListTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingListTaskListTasksRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.ListTaskListTasksRequest{
+			Domain:        t.Domain,
+			TaskList:      ToTaskList(t.TaskList),
+			TaskListType:  ToTaskListType(t.TaskListType),
+			PageSize:      t.PageSize,
+			NextPageToken: t.NextPageToken,
+		},
+	}
+}
+
+func FromMatchingListTaskListTasksResponse(t *types.ListTaskListTasksResponse) *matchingv1.ListTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.ListTaskListTasksResponse{
+		Tasks:         FromMatchingTaskListTaskArray(t.Tasks),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToMatchingListTaskListTasksResponse(t *matchingv1.ListTaskListTasksResponse) *types.ListTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListTaskListTasksResponse{
+		Tasks:         ToMatchingTaskListTaskArray(t.Tasks),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromMatchingTaskListTask(t *types.TaskListTask) *matchingv1.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListTask{
+		WorkflowId:     t.WorkflowID,
+		RunId:          t.RunID,
+		TaskId:         t.TaskID,
+		ScheduleId:     t.ScheduleID,
+		CreatedTime:    unixNanoToTime(t.CreatedTime),
+		ExpiryTime:     unixNanoToTime(t.ExpiryTime),
+		IsolationGroup: t.IsolationGroup,
+		Priority:       t.Priority,
+	}
+}
+
+func ToMatchingTaskListTask(t *matchingv1.TaskListTask) *types.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListTask{
+		WorkflowID:     t.WorkflowId,
+		RunID:          t.RunId,
+		TaskID:         t.TaskId,
+		ScheduleID:     t.ScheduleId,
+		CreatedTime:    timeToUnixNano(t.CreatedTime),
+		ExpiryTime:     timeToUnixNano(t.ExpiryTime),
+		IsolationGroup: t.IsolationGroup,
+		Priority:       t.Priority,
+	}
+}
+
+func FromMatchingTaskListTaskArray(t []*types.TaskListTask) []*matchingv1.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.TaskListTask, len(t))
+	for i := range t {
+		v[i] = FromMatchingTaskListTask(t[i])
+	}
+	return v
+}
+
+func ToMatchingTaskListTaskArray(t []*matchingv1.TaskListTask) []*types.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListTask, len(t))
+	for i := range t {
+		v[i] = ToMatchingTaskListTask(t[i])
+	}
+	return v
+}
+
+func FromMatchingDeleteTaskListTasksRequest(t *types.MatchingDeleteTaskListTasksRequest) *matchingv1.DeleteTaskListTasksRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &matchingv1.DeleteTaskListTasksRequest{
+		DomainId:      t.DomainUUID,
+		Domain:        t.Request.Domain,
+		TaskList:      FromTaskList(t.Request.TaskList),
+		TaskListType:  FromTaskListType(t.Request.TaskListType),
+		WorkflowId:    t.Request.WorkflowID,
+		RunId:         t.Request.RunID,
+		CreatedBefore: unixNanoToTime(t.Request.CreatedBefore),
+	}
+}
+
+func ToMatchingDeleteTaskListTasksRequest(t *matchingv1.DeleteTaskListTasksRequest) *types.MatchingDeleteTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDeleteTaskListTasksRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.DeleteTaskListTasksRequest{
+			Domain:        t.Domain,
+			TaskList:      ToTaskList(t.TaskList),
+			TaskListType:  ToTaskListType(t.TaskListType),
+			WorkflowID:    t.WorkflowId,
+			RunID:         t.RunId,
+			CreatedBefore: timeToUnixNano(t.CreatedBefore),
+		},
+	}
+}
+
+func FromMatchingDeleteTaskListTasksResponse(t *types.DeleteTaskListTasksResponse) *matchingv1.DeleteTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DeleteTaskListTasksResponse{
+		DeletedCount: t.DeletedCount,
+	}
+}
+
+func ToMatchingDeleteTaskListTasksResponse(t *matchingv1.DeleteTaskListTasksResponse) *types.DeleteTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteTaskListTasksResponse{
+		DeletedCount: t.DeletedCount,
+	}
+}
+
+func FromMatchingPollerInfo(t *types.PollerInfo) *matchingv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.PollerInfo{
+		LastAccessTime: unixNanoToTime(t.LastAccessTime),
+		Identity:       t.Identity,
+		RatePerSecond:  t.RatePerSecond,
+		BuildId:        t.BuildID,
+	}
+}
+
+func ToMatchingPollerInfo(t *matchingv1.PollerInfo) *types.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.PollerInfo{
+		LastAccessTime: timeToUnixNano(t.LastAccessTime),
+		Identity:       t.Identity,
+		RatePerSecond:  t.RatePerSecond,
+		BuildID:        t.BuildId,
+	}
+}
+
+func FromMatchingPollerInfoArray(t []*types.PollerInfo) []*matchingv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.PollerInfo, len(t))
+	for i := range t {
+		v[i] = FromMatchingPollerInfo(t[i])
+	}
+	return v
+}
+
+func ToMatchingPollerInfoArray(t []*matchingv1.PollerInfo) []*types.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.PollerInfo, len(t))
+	for i := range t {
+		v[i] = ToMatchingPollerInfo(t[i])
+	}
+	return v
+}
+
+func FromMatchingUpdateTaskListBuildIDCompatibilityRequest(t *types.MatchingUpdateTaskListBuildIDCompatibilityRequest) *matchingv1.UpdateTaskListBuildIDCompatibilityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &matchingv1.UpdateTaskListBuildIDCompatibilityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		TaskList:          FromTaskList(t.Request.TaskList),
+		BuildId:           t.Request.BuildID,
+		CompatibleBuildId: t.Request.CompatibleBuildID,
+	}
+}
+
+func ToMatchingUpdateTaskListBuildIDCompatibilityRequest(t *matchingv1.UpdateTaskListBuildIDCompatibilityRequest) *types.MatchingUpdateTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.UpdateTaskListBuildIDCompatibilityRequest{
+			Domain:            t.Domain,
+			TaskList:          ToTaskList(t.TaskList),
+			BuildID:           t.BuildId,
+			CompatibleBuildID: t.CompatibleBuildId,
+		},
+	}
+}
+
+func FromMatchingUpdateTaskListBuildIDCompatibilityResponse(t *types.UpdateTaskListBuildIDCompatibilityResponse) *matchingv1.UpdateTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.UpdateTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: FromMatchingCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
+func ToMatchingUpdateTaskListBuildIDCompatibilityResponse(t *matchingv1.UpdateTaskListBuildIDCompatibilityResponse) *types.UpdateTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: ToMatchingCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
+func FromMatchingGetTaskListBuildIDCompatibilityRequest(t *types.MatchingGetTaskListBuildIDCompatibilityRequest) *matchingv1.GetTaskListBuildIDCompatibilityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListBuildIDCompatibilityRequest{
+		DomainId: t.DomainUUID,
+		Domain:   t.Request.Domain,
+		TaskList: FromTaskList(t.Request.TaskList),
+	}
+}
+
+func ToMatchingGetTaskListBuildIDCompatibilityRequest(t *matchingv1.GetTaskListBuildIDCompatibilityRequest) *types.MatchingGetTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.GetTaskListBuildIDCompatibilityRequest{
+			Domain:   t.Domain,
+			TaskList: ToTaskList(t.TaskList),
+		},
+	}
+}
+
+func FromMatchingGetTaskListBuildIDCompatibilityResponse(t *types.GetTaskListBuildIDCompatibilityResponse) *matchingv1.GetTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: FromMatchingCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
+func ToMatchingGetTaskListBuildIDCompatibilityResponse(t *matchingv1.GetTaskListBuildIDCompatibilityResponse) *types.GetTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: ToMatchingCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
+func FromMatchingCompatibleBuildIDSet(t *types.CompatibleBuildIDSet) *matchingv1.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.CompatibleBuildIDSet{
+		BuildIds: t.BuildIDs,
+	}
+}
+
+func ToMatchingCompatibleBuildIDSet(t *matchingv1.CompatibleBuildIDSet) *types.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	return &types.CompatibleBuildIDSet{
+		BuildIDs: t.BuildIds,
+	}
+}
+
+func FromMatchingCompatibleBuildIDSetArray(t []*types.CompatibleBuildIDSet) []*matchingv1.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.CompatibleBuildIDSet, len(t))
+	for i := range t {
+		v[i] = FromMatchingCompatibleBuildIDSet(t[i])
+	}
+	return v
+}
+
+func ToMatchingCompatibleBuildIDSetArray(t []*matchingv1.CompatibleBuildIDSet) []*types.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompatibleBuildIDSet, len(t))
+	for i := range t {
+		v[i] = ToMatchingCompatibleBuildIDSet(t[i])
+	}
+	return v
+}
+
+// withWorkerBuildID restores the build ID of a WorkerVersionInfo, which is carried next to the API requests
+// by the internal services since the API does not carry it.
+func withWorkerBuildID(info *types.WorkerVersionInfo, buildID string) *types.WorkerVersionInfo {
+	if buildID == "" {
+		return info
+	}
+	if info == nil {
+		info = &types.WorkerVersionInfo{}
+	}
+	info.BuildID = buildID
+	return info
+}
@@ -176,6 +176,71 @@ func TestMatchingRefreshTaskListPartitionConfigResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingListTaskListTasksRequest(t *testing.T) {
+	for _, item := range []*types.MatchingListTaskListTasksRequest{nil, &testdata.MatchingListTaskListTasksRequest} {
+		assert.Equal(t, item, ToMatchingListTaskListTasksRequest(FromMatchingListTaskListTasksRequest(item)))
+	}
+	assert.Nil(t, FromMatchingListTaskListTasksRequest(&types.MatchingListTaskListTasksRequest{}))
+}
+
+func TestMatchingListTaskListTasksResponse(t *testing.T) {
+	for _, item := range []*types.ListTaskListTasksResponse{nil, {}, &testdata.AdminListTaskListTasksResponse} {
+		assert.Equal(t, item, ToMatchingListTaskListTasksResponse(FromMatchingListTaskListTasksResponse(item)))
+	}
+}
+
+func TestMatchingDeleteTaskListTasksRequest(t *testing.T) {
+	for _, item := range []*types.MatchingDeleteTaskListTasksRequest{nil, &testdata.MatchingDeleteTaskListTasksRequest} {
+		assert.Equal(t, item, ToMatchingDeleteTaskListTasksRequest(FromMatchingDeleteTaskListTasksRequest(item)))
+	}
+	assert.Nil(t, FromMatchingDeleteTaskListTasksRequest(&types.MatchingDeleteTaskListTasksRequest{}))
+}
+
+func TestMatchingDeleteTaskListTasksResponse(t *testing.T) {
+	for _, item := range []*types.DeleteTaskListTasksResponse{nil, {}, &testdata.AdminDeleteTaskListTasksResponse} {
+		assert.Equal(t, item, ToMatchingDeleteTaskListTasksResponse(FromMatchingDeleteTaskListTasksResponse(item)))
+	}
+}
+
+func TestMatchingWorkerBuildID(t *testing.T) {
+	pollRequest := testdata.MatchingPollForDecisionTaskRequest
+	pollRequest.PollRequest = &types.PollForDecisionTaskRequest{WorkerVersionInfo: &types.WorkerVersionInfo{BuildID: testdata.BuildID}}
+	assert.Equal(t, &pollRequest, ToMatchingPollForDecisionTaskRequest(FromMatchingPollForDecisionTaskRequest(&pollRequest)))
+
+	completedRequest := testdata.MatchingRespondQueryTaskCompletedRequest
+	completedRequest.CompletedRequest = &types.RespondQueryTaskCompletedRequest{WorkerVersionInfo: &types.WorkerVersionInfo{BuildID: testdata.BuildID}}
+	assert.Equal(t, &completedRequest, ToMatchingRespondQueryTaskCompletedRequest(FromMatchingRespondQueryTaskCompletedRequest(&completedRequest)))
+
+	pollers := []*types.PollerInfo{{Identity: testdata.Identity, BuildID: testdata.BuildID}, nil}
+	assert.Equal(t, pollers, ToMatchingPollerInfoArray(FromMatchingPollerInfoArray(pollers)))
+}
+
+func TestMatchingUpdateTaskListBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.MatchingUpdateTaskListBuildIDCompatibilityRequest{nil, &testdata.MatchingUpdateTaskListBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, ToMatchingUpdateTaskListBuildIDCompatibilityRequest(FromMatchingUpdateTaskListBuildIDCompatibilityRequest(item)))
+	}
+	assert.Nil(t, FromMatchingUpdateTaskListBuildIDCompatibilityRequest(&types.MatchingUpdateTaskListBuildIDCompatibilityRequest{}))
+}
+
+func TestMatchingUpdateTaskListBuildIDCompatibilityResponse(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListBuildIDCompatibilityResponse{nil, {}, &testdata.UpdateTaskListBuildIDCompatibilityResponse} {
+		assert.Equal(t, item, ToMatchingUpdateTaskListBuildIDCompatibilityResponse(FromMatchingUpdateTaskListBuildIDCompatibilityResponse(item)))
+	}
+}
+
+func TestMatchingGetTaskListBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.MatchingGetTaskListBuildIDCompatibilityRequest{nil, &testdata.MatchingGetTaskListBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, ToMatchingGetTaskListBuildIDCompatibilityRequest(FromMatchingGetTaskListBuildIDCompatibilityRequest(item)))
+	}
+	assert.Nil(t, FromMatchingGetTaskListBuildIDCompatibilityRequest(&types.MatchingGetTaskListBuildIDCompatibilityRequest{}))
+}
+
+func TestMatchingGetTaskListBuildIDCompatibilityResponse(t *testing.T) {
+	for _, item := range []*types.GetTaskListBuildIDCompatibilityResponse{nil, {}, &testdata.GetTaskListBuildIDCompatibilityResponse} {
+		assert.Equal(t, item, ToMatchingGetTaskListBuildIDCompatibilityResponse(FromMatchingGetTaskListBuildIDCompatibilityResponse(item)))
+	}
+}
+
 func TestToMatchingTaskListPartitionConfig(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -428,3 +493,71 @@ func TestMatchingRefreshTaskListPartitionConfigRequestFuzz(t *testing.T) {
 func TestMatchingRefreshTaskListPartitionConfigResponseFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromMatchingRefreshTaskListPartitionConfigResponse, ToMatchingRefreshTaskListPartitionConfigResponse)
 }
+
+func TestMatchingListTaskListTasksRequestFuzz(t *testing.T) {
+	// FromMatchingListTaskListTasksRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromMatchingListTaskListTasksRequest, ToMatchingListTaskListTasksRequest,
+		testutils.WithCustomFuncs(func(r *types.MatchingListTaskListTasksRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.ListTaskListTasksRequest{}
+			}
+		}),
+	)
+}
+
+func TestMatchingListTaskListTasksResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingListTaskListTasksResponse, ToMatchingListTaskListTasksResponse)
+}
+
+func TestMatchingDeleteTaskListTasksRequestFuzz(t *testing.T) {
+	// FromMatchingDeleteTaskListTasksRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromMatchingDeleteTaskListTasksRequest, ToMatchingDeleteTaskListTasksRequest,
+		testutils.WithCustomFuncs(func(r *types.MatchingDeleteTaskListTasksRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.DeleteTaskListTasksRequest{}
+			}
+		}),
+	)
+}
+
+func TestMatchingDeleteTaskListTasksResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingDeleteTaskListTasksResponse, ToMatchingDeleteTaskListTasksResponse)
+}
+
+func TestMatchingPollerInfoArrayFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingPollerInfoArray, ToMatchingPollerInfoArray)
+}
+
+func TestMatchingUpdateTaskListBuildIDCompatibilityRequestFuzz(t *testing.T) {
+	// FromMatchingUpdateTaskListBuildIDCompatibilityRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromMatchingUpdateTaskListBuildIDCompatibilityRequest, ToMatchingUpdateTaskListBuildIDCompatibilityRequest,
+		testutils.WithCustomFuncs(func(r *types.MatchingUpdateTaskListBuildIDCompatibilityRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.UpdateTaskListBuildIDCompatibilityRequest{}
+			}
+		}),
+	)
+}
+
+func TestMatchingUpdateTaskListBuildIDCompatibilityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingUpdateTaskListBuildIDCompatibilityResponse, ToMatchingUpdateTaskListBuildIDCompatibilityResponse)
+}
+
+func TestMatchingGetTaskListBuildIDCompatibilityRequestFuzz(t *testing.T) {
+	// FromMatchingGetTaskListBuildIDCompatibilityRequest returns nil when t.Request == nil, so the fuzzer keeps Request set.
+	testutils.RunMapperFuzzTest(t, FromMatchingGetTaskListBuildIDCompatibilityRequest, ToMatchingGetTaskListBuildIDCompatibilityRequest,
+		testutils.WithCustomFuncs(func(r *types.MatchingGetTaskListBuildIDCompatibilityRequest, c fuzz.Continue) {
+			c.FuzzNoCustom(r)
+			if r.Request == nil {
+				r.Request = &types.GetTaskListBuildIDCompatibilityRequest{}
+			}
+		}),
+	)
+}
+
+func TestMatchingGetTaskListBuildIDCompatibilityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingGetTaskListBuildIDCompatibilityResponse, ToMatchingGetTaskListBuildIDCompatibilityResponse)
+}
@@ -86,6 +86,8 @@ func ToScheduleCatchUpPolicy(p apiv1.ScheduleCatchUpPolicy) types.ScheduleCatchU
 
 // --- Core type mappers ---
 
+// FromScheduleSpec maps the schedule spec to proto. CronExpressions, Intervals, ExcludeCalendars and TimeZone
+// are dropped since apiv1.ScheduleSpec has no fields for them yet.
 func FromScheduleSpec(t *types.ScheduleSpec) *apiv1.ScheduleSpec {
 	if t == nil {
 		return nil
@@ -282,6 +284,8 @@ func ToBackfillInfoArray(t []*apiv1.BackfillInfo) []*types.BackfillInfo {
 	return v
 }
 
+// FromScheduleInfo maps the schedule info to proto. FutureActionTimes and RecentRuns are dropped
+// since apiv1.ScheduleInfo has no fields for them yet.
 func FromScheduleInfo(t *types.ScheduleInfo) *apiv1.ScheduleInfo {
 	if t == nil {
 		return nil
@@ -88,6 +88,7 @@ func TestScheduleListEntry(t *testing.T) {
 func TestScheduleSpecFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleSpec, ToScheduleSpec,
 		WithScheduleEnumFuzzers(),
+		withScheduleFieldsNotInIDL(),
 	)
 }
 
@@ -130,6 +131,7 @@ func TestBackfillInfoFuzz(t *testing.T) {
 func TestScheduleInfoFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleInfo, ToScheduleInfo,
 		WithScheduleEnumFuzzers(),
+		withScheduleFieldsNotInIDL(),
 	)
 }
 
@@ -151,6 +153,12 @@ func WithScheduleEnumFuzzers() testutils.FuzzOption {
 	)
 }
 
+// withScheduleFieldsNotInIDL excludes the schedule spec and info fields which
+// are not part of the proto schedule API yet and are dropped by the mappers.
+func withScheduleFieldsNotInIDL() testutils.FuzzOption {
+	return testutils.WithExcludedFields("CronExpressions", "Intervals", "ExcludeCalendars", "TimeZone", "FutureActionTimes", "RecentRuns")
+}
+
 // --- CRUD request/response deterministic tests ---
 
 func TestCreateScheduleRequest(t *testing.T) {
@@ -206,6 +214,7 @@ func TestDeleteScheduleResponse(t *testing.T) {
 func TestCreateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromCreateScheduleRequest, ToCreateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		withScheduleFieldsNotInIDL(),
 	)
 }
 
@@ -218,12 +227,14 @@ func TestDescribeScheduleRequestFuzz(t *testing.T) {
 func TestDescribeScheduleResponseFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromDescribeScheduleResponse, ToDescribeScheduleResponse,
 		WithScheduleEnumFuzzers(),
+		withScheduleFieldsNotInIDL(),
 	)
 }
 
 func TestUpdateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromUpdateScheduleRequest, ToUpdateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		withScheduleFieldsNotInIDL(),
 	)
 }
 
@@ -349,3 +349,205 @@ func ToShardDistributorWatchNamespaceStateResponse(t *sharddistributorv1.WatchNa
 		Executors: executors,
 	}
 }
+
+// FromShardDistributorCordonExecutorRequest converts a types.CordonExecutorRequest to a sharddistributor.CordonExecutorRequest
+func FromShardDistributorCordonExecutorRequest(t *types.CordonExecutorRequest) *sharddistributorv1.CordonExecutorRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.CordonExecutorRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorId: t.GetExecutorID(),
+	}
+}
+
+// ToShardDistributorCordonExecutorRequest converts a sharddistributor.CordonExecutorRequest to a types.CordonExecutorRequest
+func ToShardDistributorCordonExecutorRequest(t *sharddistributorv1.CordonExecutorRequest) *types.CordonExecutorRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CordonExecutorRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorID: t.GetExecutorId(),
+	}
+}
+
+// FromShardDistributorUncordonExecutorRequest converts a types.UncordonExecutorRequest to a sharddistributor.UncordonExecutorRequest
+func FromShardDistributorUncordonExecutorRequest(t *types.UncordonExecutorRequest) *sharddistributorv1.UncordonExecutorRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.UncordonExecutorRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorId: t.GetExecutorID(),
+	}
+}
+
+// ToShardDistributorUncordonExecutorRequest converts a sharddistributor.UncordonExecutorRequest to a types.UncordonExecutorRequest
+func ToShardDistributorUncordonExecutorRequest(t *sharddistributorv1.UncordonExecutorRequest) *types.UncordonExecutorRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UncordonExecutorRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorID: t.GetExecutorId(),
+	}
+}
+
+// FromShardDistributorDrainExecutorRequest converts a types.DrainExecutorRequest to a sharddistributor.DrainExecutorRequest
+func FromShardDistributorDrainExecutorRequest(t *types.DrainExecutorRequest) *sharddistributorv1.DrainExecutorRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DrainExecutorRequest{
+		Namespace:          t.GetNamespace(),
+		ExecutorId:         t.GetExecutorID(),
+		MaxConcurrentMoves: t.GetMaxConcurrentMoves(),
+	}
+}
+
+// ToShardDistributorDrainExecutorRequest converts a sharddistributor.DrainExecutorRequest to a types.DrainExecutorRequest
+func ToShardDistributorDrainExecutorRequest(t *sharddistributorv1.DrainExecutorRequest) *types.DrainExecutorRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DrainExecutorRequest{
+		Namespace:          t.GetNamespace(),
+		ExecutorID:         t.GetExecutorId(),
+		MaxConcurrentMoves: t.GetMaxConcurrentMoves(),
+	}
+}
+
+// FromShardDistributorPinShardRequest converts a types.PinShardRequest to a sharddistributor.PinShardRequest
+func FromShardDistributorPinShardRequest(t *types.PinShardRequest) *sharddistributorv1.PinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.PinShardRequest{
+		Namespace:  t.GetNamespace(),
+		ShardKey:   t.GetShardKey(),
+		ExecutorId: t.GetExecutorID(),
+	}
+}
+
+// ToShardDistributorPinShardRequest converts a sharddistributor.PinShardRequest to a types.PinShardRequest
+func ToShardDistributorPinShardRequest(t *sharddistributorv1.PinShardRequest) *types.PinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PinShardRequest{
+		Namespace:  t.GetNamespace(),
+		ShardKey:   t.GetShardKey(),
+		ExecutorID: t.GetExecutorId(),
+	}
+}
+
+// FromShardDistributorUnpinShardRequest converts a types.UnpinShardRequest to a sharddistributor.UnpinShardRequest
+func FromShardDistributorUnpinShardRequest(t *types.UnpinShardRequest) *sharddistributorv1.UnpinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.UnpinShardRequest{
+		Namespace: t.GetNamespace(),
+		ShardKey:  t.GetShardKey(),
+	}
+}
+
+// ToShardDistributorUnpinShardRequest converts a sharddistributor.UnpinShardRequest to a types.UnpinShardRequest
+func ToShardDistributorUnpinShardRequest(t *sharddistributorv1.UnpinShardRequest) *types.UnpinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpinShardRequest{
+		Namespace: t.GetNamespace(),
+		ShardKey:  t.GetShardKey(),
+	}
+}
+
+// FromShardDistributorDescribeNamespaceControlsRequest converts a types.DescribeNamespaceControlsRequest to a sharddistributor.DescribeNamespaceControlsRequest
+func FromShardDistributorDescribeNamespaceControlsRequest(t *types.DescribeNamespaceControlsRequest) *sharddistributorv1.DescribeNamespaceControlsRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DescribeNamespaceControlsRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// ToShardDistributorDescribeNamespaceControlsRequest converts a sharddistributor.DescribeNamespaceControlsRequest to a types.DescribeNamespaceControlsRequest
+func ToShardDistributorDescribeNamespaceControlsRequest(t *sharddistributorv1.DescribeNamespaceControlsRequest) *types.DescribeNamespaceControlsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeNamespaceControlsRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// FromShardDistributorDescribeNamespaceControlsResponse converts a types.DescribeNamespaceControlsResponse to a sharddistributor.DescribeNamespaceControlsResponse
+func FromShardDistributorDescribeNamespaceControlsResponse(t *types.DescribeNamespaceControlsResponse) *sharddistributorv1.DescribeNamespaceControlsResponse {
+	if t == nil {
+		return nil
+	}
+
+	var executors []*sharddistributorv1.ExecutorControl
+	for _, executor := range t.GetExecutors() {
+		executors = append(executors, &sharddistributorv1.ExecutorControl{
+			ExecutorId:         executor.GetExecutorID(),
+			Cordoned:           executor.GetCordoned(),
+			Draining:           executor.GetDraining(),
+			MaxConcurrentMoves: executor.GetMaxConcurrentMoves(),
+			AssignedShards:     executor.GetAssignedShards(),
+		})
+	}
+
+	var pinnedShards []*sharddistributorv1.ShardPin
+	for _, pin := range t.GetPinnedShards() {
+		pinnedShards = append(pinnedShards, &sharddistributorv1.ShardPin{
+			ShardKey:   pin.GetShardKey(),
+			ExecutorId: pin.GetExecutorID(),
+		})
+	}
+
+	return &sharddistributorv1.DescribeNamespaceControlsResponse{
+		Executors:    executors,
+		PinnedShards: pinnedShards,
+	}
+}
+
+// ToShardDistributorDescribeNamespaceControlsResponse converts a sharddistributor.DescribeNamespaceControlsResponse to a types.DescribeNamespaceControlsResponse
+func ToShardDistributorDescribeNamespaceControlsResponse(t *sharddistributorv1.DescribeNamespaceControlsResponse) *types.DescribeNamespaceControlsResponse {
+	if t == nil {
+		return nil
+	}
+
+	var executors []*types.ExecutorControl
+	if t.GetExecutors() != nil {
+		executors = make([]*types.ExecutorControl, 0, len(t.GetExecutors()))
+		for _, executor := range t.GetExecutors() {
+			executors = append(executors, &types.ExecutorControl{
+				ExecutorID:         executor.GetExecutorId(),
+				Cordoned:           executor.GetCordoned(),
+				Draining:           executor.GetDraining(),
+				MaxConcurrentMoves: executor.GetMaxConcurrentMoves(),
+				AssignedShards:     executor.GetAssignedShards(),
+			})
+		}
+	}
+
+	var pinnedShards []*types.ShardPin
+	if t.GetPinnedShards() != nil {
+		pinnedShards = make([]*types.ShardPin, 0, len(t.GetPinnedShards()))
+		for _, pin := range t.GetPinnedShards() {
+			pinnedShards = append(pinnedShards, &types.ShardPin{
+				ShardKey:   pin.GetShardKey(),
+				ExecutorID: pin.GetExecutorId(),
+			})
+		}
+	}
+
+	return &types.DescribeNamespaceControlsResponse{
+		Executors:    executors,
+		PinnedShards: pinnedShards,
+	}
+}
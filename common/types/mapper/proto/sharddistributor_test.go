@@ -69,6 +69,48 @@ func TestFromShardDistributorWatchNamespaceStateResponse(t *testing.T) {
 	}
 }
 
+func TestFromShardDistributorCordonExecutorRequest(t *testing.T) {
+	for _, item := range []*types.CordonExecutorRequest{nil, {}, &testdata.ShardDistributorCordonExecutorRequest} {
+		assert.Equal(t, item, ToShardDistributorCordonExecutorRequest(FromShardDistributorCordonExecutorRequest(item)))
+	}
+}
+
+func TestFromShardDistributorUncordonExecutorRequest(t *testing.T) {
+	for _, item := range []*types.UncordonExecutorRequest{nil, {}, &testdata.ShardDistributorUncordonExecutorRequest} {
+		assert.Equal(t, item, ToShardDistributorUncordonExecutorRequest(FromShardDistributorUncordonExecutorRequest(item)))
+	}
+}
+
+func TestFromShardDistributorDrainExecutorRequest(t *testing.T) {
+	for _, item := range []*types.DrainExecutorRequest{nil, {}, &testdata.ShardDistributorDrainExecutorRequest} {
+		assert.Equal(t, item, ToShardDistributorDrainExecutorRequest(FromShardDistributorDrainExecutorRequest(item)))
+	}
+}
+
+func TestFromShardDistributorPinShardRequest(t *testing.T) {
+	for _, item := range []*types.PinShardRequest{nil, {}, &testdata.ShardDistributorPinShardRequest} {
+		assert.Equal(t, item, ToShardDistributorPinShardRequest(FromShardDistributorPinShardRequest(item)))
+	}
+}
+
+func TestFromShardDistributorUnpinShardRequest(t *testing.T) {
+	for _, item := range []*types.UnpinShardRequest{nil, {}, &testdata.ShardDistributorUnpinShardRequest} {
+		assert.Equal(t, item, ToShardDistributorUnpinShardRequest(FromShardDistributorUnpinShardRequest(item)))
+	}
+}
+
+func TestFromShardDistributorDescribeNamespaceControlsRequest(t *testing.T) {
+	for _, item := range []*types.DescribeNamespaceControlsRequest{nil, {}, &testdata.ShardDistributorDescribeNamespaceControlsRequest} {
+		assert.Equal(t, item, ToShardDistributorDescribeNamespaceControlsRequest(FromShardDistributorDescribeNamespaceControlsRequest(item)))
+	}
+}
+
+func TestFromShardDistributorDescribeNamespaceControlsResponse(t *testing.T) {
+	for _, item := range []*types.DescribeNamespaceControlsResponse{nil, {}, &testdata.ShardDistributorDescribeNamespaceControlsResponse} {
+		assert.Equal(t, item, ToShardDistributorDescribeNamespaceControlsResponse(FromShardDistributorDescribeNamespaceControlsResponse(item)))
+	}
+}
+
 // --- Fuzz tests for sharddistributor mapper functions ---
 
 // ExecutorStatusFuzzer generates valid ExecutorStatus enum values (0-3: INVALID, ACTIVE, DRAINING, DRAINED).
@@ -168,3 +210,11 @@ func TestExecutorHeartbeatResponseFuzz(t *testing.T) {
 		testutils.WithCustomFuncs(AssignmentStatusFuzzer, MigrationModeFuzzer, ExecutorHeartbeatResponseFuzzer),
 	)
 }
+
+func TestDrainExecutorRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromShardDistributorDrainExecutorRequest, ToShardDistributorDrainExecutorRequest)
+}
+
+func TestPinShardRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromShardDistributorPinShardRequest, ToShardDistributorPinShardRequest)
+}
@@ -31,6 +31,22 @@ import (
 var (
 	FromAdminRefreshWorkflowTasksRequest = FromRefreshWorkflowTasksRequest
 	ToAdminRefreshWorkflowTasksRequest   = ToRefreshWorkflowTasksRequest
+	FromAdminPauseActivityRequest        = FromPauseActivityRequest
+	ToAdminPauseActivityRequest          = ToPauseActivityRequest
+	FromAdminPauseActivityResponse       = FromPauseActivityResponse
+	ToAdminPauseActivityResponse         = ToPauseActivityResponse
+	FromAdminUnpauseActivityRequest      = FromUnpauseActivityRequest
+	ToAdminUnpauseActivityRequest        = ToUnpauseActivityRequest
+	FromAdminUnpauseActivityResponse     = FromUnpauseActivityResponse
+	ToAdminUnpauseActivityResponse       = ToUnpauseActivityResponse
+	FromAdminListTaskListTasksRequest    = FromListTaskListTasksRequest
+	ToAdminListTaskListTasksRequest      = ToListTaskListTasksRequest
+	FromAdminListTaskListTasksResponse   = FromListTaskListTasksResponse
+	ToAdminListTaskListTasksResponse     = ToListTaskListTasksResponse
+	FromAdminDeleteTaskListTasksRequest  = FromDeleteTaskListTasksRequest
+	ToAdminDeleteTaskListTasksRequest    = ToDeleteTaskListTasksRequest
+	FromAdminDeleteTaskListTasksResponse = FromDeleteTaskListTasksResponse
+	ToAdminDeleteTaskListTasksResponse   = ToDeleteTaskListTasksResponse
 )
 
 // FromAdminAddSearchAttributeRequest converts internal AddSearchAttributeRequest type to thrift
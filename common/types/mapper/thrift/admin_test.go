@@ -189,6 +189,46 @@ func TestAdminRefreshWorkflowTasksRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminRefreshWorkflowTasksRequest(FromAdminRefreshWorkflowTasksRequest(item)))
 	}
 }
+func TestAdminPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.PauseActivityRequest{nil, {}, &testdata.AdminPauseActivityRequest} {
+		assert.Equal(t, item, ToAdminPauseActivityRequest(FromAdminPauseActivityRequest(item)))
+	}
+}
+func TestAdminPauseActivityResponse(t *testing.T) {
+	for _, item := range []*types.PauseActivityResponse{nil, {}} {
+		assert.Equal(t, item, ToAdminPauseActivityResponse(FromAdminPauseActivityResponse(item)))
+	}
+}
+func TestAdminUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseActivityRequest{nil, {}, &testdata.AdminUnpauseActivityRequest} {
+		assert.Equal(t, item, ToAdminUnpauseActivityRequest(FromAdminUnpauseActivityRequest(item)))
+	}
+}
+func TestAdminUnpauseActivityResponse(t *testing.T) {
+	for _, item := range []*types.UnpauseActivityResponse{nil, {}} {
+		assert.Equal(t, item, ToAdminUnpauseActivityResponse(FromAdminUnpauseActivityResponse(item)))
+	}
+}
+func TestAdminListTaskListTasksRequest(t *testing.T) {
+	for _, item := range []*types.ListTaskListTasksRequest{nil, {}, &testdata.AdminListTaskListTasksRequest} {
+		assert.Equal(t, item, ToAdminListTaskListTasksRequest(FromAdminListTaskListTasksRequest(item)))
+	}
+}
+func TestAdminListTaskListTasksResponse(t *testing.T) {
+	for _, item := range []*types.ListTaskListTasksResponse{nil, {}, &testdata.AdminListTaskListTasksResponse} {
+		assert.Equal(t, item, ToAdminListTaskListTasksResponse(FromAdminListTaskListTasksResponse(item)))
+	}
+}
+func TestAdminDeleteTaskListTasksRequest(t *testing.T) {
+	for _, item := range []*types.DeleteTaskListTasksRequest{nil, {}, &testdata.AdminDeleteTaskListTasksRequest} {
+		assert.Equal(t, item, ToAdminDeleteTaskListTasksRequest(FromAdminDeleteTaskListTasksRequest(item)))
+	}
+}
+func TestAdminDeleteTaskListTasksResponse(t *testing.T) {
+	for _, item := range []*types.DeleteTaskListTasksResponse{nil, {}, &testdata.AdminDeleteTaskListTasksResponse} {
+		assert.Equal(t, item, ToAdminDeleteTaskListTasksResponse(FromAdminDeleteTaskListTasksResponse(item)))
+	}
+}
 func TestAdminRemoveTaskRequest(t *testing.T) {
 	for _, item := range []*types.RemoveTaskRequest{nil, {}, &testdata.AdminRemoveTaskRequest} {
 		assert.Equal(t, item, ToAdminRemoveTaskRequest(FromAdminRemoveTaskRequest(item)))
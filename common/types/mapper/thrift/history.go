@@ -58,6 +58,8 @@ var (
 	ToHistoryMergeDLQMessagesRequest                     = ToAdminMergeDLQMessagesRequest
 	FromHistoryMergeDLQMessagesResponse                  = FromAdminMergeDLQMessagesResponse
 	ToHistoryMergeDLQMessagesResponse                    = ToAdminMergeDLQMessagesResponse
+	FromHistoryPauseActivityResponse                     = FromPauseActivityResponse
+	ToHistoryPauseActivityResponse                       = ToPauseActivityResponse
 	FromHistoryPurgeDLQMessagesRequest                   = FromAdminPurgeDLQMessagesRequest
 	ToHistoryPurgeDLQMessagesRequest                     = ToAdminPurgeDLQMessagesRequest
 	FromHistoryReadDLQMessagesRequest                    = FromAdminReadDLQMessagesRequest
@@ -90,6 +92,12 @@ var (
 	ToHistorySignalWithStartWorkflowExecutionResponse    = ToStartWorkflowExecutionResponse
 	FromHistoryStartWorkflowExecutionResponse            = FromStartWorkflowExecutionResponse
 	ToHistoryStartWorkflowExecutionResponse              = ToStartWorkflowExecutionResponse
+	FromHistoryUnpauseActivityResponse                   = FromUnpauseActivityResponse
+	ToHistoryUnpauseActivityResponse                     = ToUnpauseActivityResponse
+	FromHistoryUpdateActivityOptionsResponse             = FromUpdateActivityOptionsResponse
+	ToHistoryUpdateActivityOptionsResponse               = ToUpdateActivityOptionsResponse
+	FromHistoryUpdateWorkflowExecutionResponse           = FromUpdateWorkflowExecutionResponse
+	ToHistoryUpdateWorkflowExecutionResponse             = ToUpdateWorkflowExecutionResponse
 )
 
 // FromHistoryDescribeMutableStateRequest converts internal DescribeMutableStateRequest type to thrift
@@ -700,6 +708,7 @@ func FromRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskStartedRes
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
+		Updates:                   FromWorkflowUpdateMap(t.Updates),
 		HistorySize:               &t.HistorySize,
 	}
 }
@@ -724,6 +733,7 @@ func ToRecordDecisionTaskStartedResponse(t *history.RecordDecisionTaskStartedRes
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
+		Updates:                   ToWorkflowUpdateMap(t.Updates),
 		HistorySize:               t.GetHistorySize(),
 	}
 }
@@ -750,6 +760,94 @@ func ToHistoryRefreshWorkflowTasksRequest(t *history.RefreshWorkflowTasksRequest
 	}
 }
 
+// FromHistoryPauseActivityRequest converts internal HistoryPauseActivityRequest type to thrift
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *history.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseActivityRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromPauseActivityRequest(t.Request),
+	}
+}
+
+// ToHistoryPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToHistoryPauseActivityRequest(t *history.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToPauseActivityRequest(t.Request),
+	}
+}
+
+// FromHistoryUnpauseActivityRequest converts internal HistoryUnpauseActivityRequest type to thrift
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *history.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseActivityRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromUnpauseActivityRequest(t.Request),
+	}
+}
+
+// ToHistoryUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToHistoryUnpauseActivityRequest(t *history.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToUnpauseActivityRequest(t.Request),
+	}
+}
+
+// FromHistoryUpdateActivityOptionsRequest converts internal HistoryUpdateActivityOptionsRequest type to thrift
+func FromHistoryUpdateActivityOptionsRequest(t *types.HistoryUpdateActivityOptionsRequest) *history.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateActivityOptionsRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromUpdateActivityOptionsRequest(t.Request),
+	}
+}
+
+// ToHistoryUpdateActivityOptionsRequest converts thrift UpdateActivityOptionsRequest type to internal
+func ToHistoryUpdateActivityOptionsRequest(t *history.UpdateActivityOptionsRequest) *types.HistoryUpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToUpdateActivityOptionsRequest(t.Request),
+	}
+}
+
+// FromHistoryUpdateWorkflowExecutionRequest converts internal HistoryUpdateWorkflowExecutionRequest type to thrift
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *history.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateWorkflowExecutionRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromUpdateWorkflowExecutionRequest(t.Request),
+	}
+}
+
+// ToHistoryUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToHistoryUpdateWorkflowExecutionRequest(t *history.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToUpdateWorkflowExecutionRequest(t.Request),
+	}
+}
+
 // FromHistoryRemoveSignalMutableStateRequest converts internal RemoveSignalMutableStateRequest type to thrift
 func FromHistoryRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *history.RemoveSignalMutableStateRequest {
 	if t == nil {
@@ -1077,6 +1175,7 @@ func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWi
 		DomainUUID:             &t.DomainUUID,
 		SignalWithStartRequest: FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
 		PartitionConfig:        t.PartitionConfig,
+		FairnessKey:            &t.FairnessKey,
 	}
 }
 
@@ -1089,6 +1188,7 @@ func ToHistorySignalWithStartWorkflowExecutionRequest(t *history.SignalWithStart
 		DomainUUID:             t.GetDomainUUID(),
 		SignalWithStartRequest: ToSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
 		PartitionConfig:        t.PartitionConfig,
+		FairnessKey:            t.GetFairnessKey(),
 	}
 }
 
@@ -1135,6 +1235,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
 		PartitionConfig:                 t.PartitionConfig,
+		FairnessKey:                     &t.FairnessKey,
 	}
 }
 
@@ -1155,6 +1256,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *history.StartWorkflowExecutionReq
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
 		PartitionConfig:                 t.PartitionConfig,
+		FairnessKey:                     t.GetFairnessKey(),
 	}
 }
 
@@ -364,6 +364,7 @@ func TestRecordDecisionTaskStartedResponseConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.HistoryRecordDecisionTaskStartedResponse,
+		{Updates: testdata.WorkflowUpdateMap},
 	}
 
 	for _, original := range testCases {
@@ -390,6 +391,62 @@ func TestHistoryRefreshWorkflowTasksRequestConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryPauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryPauseActivityRequest{
+		nil,
+		{},
+		&testdata.HistoryPauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryPauseActivityRequest(original)
+		roundTripObj := ToHistoryPauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryUnpauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUnpauseActivityRequest{
+		nil,
+		{},
+		&testdata.HistoryUnpauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUnpauseActivityRequest(original)
+		roundTripObj := ToHistoryUnpauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryUpdateActivityOptionsRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUpdateActivityOptionsRequest{
+		nil,
+		{},
+		&testdata.HistoryUpdateActivityOptionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUpdateActivityOptionsRequest(original)
+		roundTripObj := ToHistoryUpdateActivityOptionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryUpdateWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUpdateWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.HistoryUpdateWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUpdateWorkflowExecutionRequest(original)
+		roundTripObj := ToHistoryUpdateWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestRemoveSignalMutableStateRequestConversion(t *testing.T) {
 	testCases := []*types.RemoveSignalMutableStateRequest{
 		nil,
@@ -28,14 +28,22 @@ import (
 )
 
 var (
-	FromMatchingDescribeTaskListResponse       = FromDescribeTaskListResponse
-	ToMatchingDescribeTaskListResponse         = ToDescribeTaskListResponse
-	FromMatchingGetTaskListsByDomainRequest    = FromGetTaskListsByDomainRequest
-	ToMatchingGetTaskListsByDomainRequest      = ToGetTaskListsByDomainRequest
-	FromMatchingGetTaskListsByDomainResponse   = FromGetTaskListsByDomainResponse
-	ToMatchingGetTaskListsByDomainResponse     = ToGetTaskListsByDomainResponse
-	FromMatchingListTaskListPartitionsResponse = FromListTaskListPartitionsResponse
-	ToMatchingListTaskListPartitionsResponse   = ToListTaskListPartitionsResponse
+	FromMatchingDescribeTaskListResponse                   = FromDescribeTaskListResponse
+	ToMatchingDescribeTaskListResponse                     = ToDescribeTaskListResponse
+	FromMatchingGetTaskListsByDomainRequest                = FromGetTaskListsByDomainRequest
+	ToMatchingGetTaskListsByDomainRequest                  = ToGetTaskListsByDomainRequest
+	FromMatchingGetTaskListsByDomainResponse               = FromGetTaskListsByDomainResponse
+	ToMatchingGetTaskListsByDomainResponse                 = ToGetTaskListsByDomainResponse
+	FromMatchingListTaskListPartitionsResponse             = FromListTaskListPartitionsResponse
+	ToMatchingListTaskListPartitionsResponse               = ToListTaskListPartitionsResponse
+	FromMatchingListTaskListTasksResponse                  = FromListTaskListTasksResponse
+	ToMatchingListTaskListTasksResponse                    = ToListTaskListTasksResponse
+	FromMatchingDeleteTaskListTasksResponse                = FromDeleteTaskListTasksResponse
+	ToMatchingDeleteTaskListTasksResponse                  = ToDeleteTaskListTasksResponse
+	FromMatchingUpdateTaskListBuildIDCompatibilityResponse = FromUpdateTaskListBuildIDCompatibilityResponse
+	ToMatchingUpdateTaskListBuildIDCompatibilityResponse   = ToUpdateTaskListBuildIDCompatibilityResponse
+	FromMatchingGetTaskListBuildIDCompatibilityResponse    = FromGetTaskListBuildIDCompatibilityResponse
+	ToMatchingGetTaskListBuildIDCompatibilityResponse      = ToGetTaskListBuildIDCompatibilityResponse
 )
 
 // FromMatchingAddActivityTaskRequest converts internal AddActivityTaskRequest type to thrift
@@ -54,6 +62,8 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -73,6 +83,8 @@ func ToMatchingAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -121,6 +133,9 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
+		WorkerBuildId:                 &t.WorkerBuildID,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -138,6 +153,9 @@ func ToMatchingAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
+		WorkerBuildID:                 t.GetWorkerBuildId(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -211,6 +229,94 @@ func ToMatchingListTaskListPartitionsRequest(t *matching.ListTaskListPartitionsR
 	}
 }
 
+// FromMatchingUpdateTaskListBuildIDCompatibilityRequest converts internal UpdateTaskListBuildIDCompatibilityRequest type to thrift
+func FromMatchingUpdateTaskListBuildIDCompatibilityRequest(t *types.MatchingUpdateTaskListBuildIDCompatibilityRequest) *matching.UpdateTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.UpdateTaskListBuildIDCompatibilityRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromUpdateTaskListBuildIDCompatibilityRequest(t.Request),
+	}
+}
+
+// ToMatchingUpdateTaskListBuildIDCompatibilityRequest converts thrift UpdateTaskListBuildIDCompatibilityRequest type to internal
+func ToMatchingUpdateTaskListBuildIDCompatibilityRequest(t *matching.UpdateTaskListBuildIDCompatibilityRequest) *types.MatchingUpdateTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToUpdateTaskListBuildIDCompatibilityRequest(t.Request),
+	}
+}
+
+// FromMatchingGetTaskListBuildIDCompatibilityRequest converts internal GetTaskListBuildIDCompatibilityRequest type to thrift
+func FromMatchingGetTaskListBuildIDCompatibilityRequest(t *types.MatchingGetTaskListBuildIDCompatibilityRequest) *matching.GetTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.GetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromGetTaskListBuildIDCompatibilityRequest(t.Request),
+	}
+}
+
+// ToMatchingGetTaskListBuildIDCompatibilityRequest converts thrift GetTaskListBuildIDCompatibilityRequest type to internal
+func ToMatchingGetTaskListBuildIDCompatibilityRequest(t *matching.GetTaskListBuildIDCompatibilityRequest) *types.MatchingGetTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToGetTaskListBuildIDCompatibilityRequest(t.Request),
+	}
+}
+
+// FromMatchingListTaskListTasksRequest converts internal ListTaskListTasksRequest type to thrift
+func FromMatchingListTaskListTasksRequest(t *types.MatchingListTaskListTasksRequest) *matching.ListTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.ListTaskListTasksRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromListTaskListTasksRequest(t.Request),
+	}
+}
+
+// ToMatchingListTaskListTasksRequest converts thrift ListTaskListTasksRequest type to internal
+func ToMatchingListTaskListTasksRequest(t *matching.ListTaskListTasksRequest) *types.MatchingListTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingListTaskListTasksRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToListTaskListTasksRequest(t.Request),
+	}
+}
+
+// FromMatchingDeleteTaskListTasksRequest converts internal DeleteTaskListTasksRequest type to thrift
+func FromMatchingDeleteTaskListTasksRequest(t *types.MatchingDeleteTaskListTasksRequest) *matching.DeleteTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.DeleteTaskListTasksRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromDeleteTaskListTasksRequest(t.Request),
+	}
+}
+
+// ToMatchingDeleteTaskListTasksRequest converts thrift DeleteTaskListTasksRequest type to internal
+func ToMatchingDeleteTaskListTasksRequest(t *matching.DeleteTaskListTasksRequest) *types.MatchingDeleteTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDeleteTaskListTasksRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToDeleteTaskListTasksRequest(t.Request),
+	}
+}
+
 // FromMatchingPollForActivityTaskRequest converts internal PollForActivityTaskRequest type to thrift
 func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTaskRequest) *matching.PollForActivityTaskRequest {
 	if t == nil {
@@ -290,6 +396,7 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
+		Updates:                   FromWorkflowUpdateMap(t.Updates),
 		TotalHistoryBytes:         &t.TotalHistoryBytes,
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
 	}
@@ -318,6 +425,7 @@ func ToMatchingPollForDecisionTaskResponse(t *matching.PollForDecisionTaskRespon
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
+		Updates:                   ToWorkflowUpdateMap(t.Updates),
 		TotalHistoryBytes:         t.GetTotalHistoryBytes(),
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
 	}
@@ -40,6 +40,10 @@ func TestMatchingAddActivityTaskRequest(t *testing.T) {
 			desc:  "non-nil input test",
 			input: &testdata.MatchingAddActivityTaskRequest,
 		},
+		{
+			desc:  "priority test",
+			input: &types.AddActivityTaskRequest{DomainUUID: testdata.DomainID, Priority: 3},
+		},
 		{
 			desc:  "empty input test",
 			input: &types.AddActivityTaskRequest{},
@@ -93,6 +97,10 @@ func TestMatchingAddDecisionTaskRequest(t *testing.T) {
 			desc:  "non-nil input test",
 			input: &testdata.MatchingAddDecisionTaskRequest,
 		},
+		{
+			desc:  "priority test",
+			input: &types.AddDecisionTaskRequest{DomainUUID: testdata.DomainID, Priority: 3},
+		},
 		{
 			desc:  "empty input test",
 			input: &types.AddDecisionTaskRequest{},
@@ -184,6 +192,106 @@ func TestMatchingListTaskListPartitionsRequest(t *testing.T) {
 	}
 }
 
+func TestMatchingUpdateTaskListBuildIDCompatibilityRequest(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		input *types.MatchingUpdateTaskListBuildIDCompatibilityRequest
+	}{
+		{
+			desc:  "non-nil input test",
+			input: &testdata.MatchingUpdateTaskListBuildIDCompatibilityRequest,
+		},
+		{
+			desc:  "empty input test",
+			input: &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{},
+		},
+		{
+			desc:  "nil input test",
+			input: nil,
+		},
+	}
+	for _, tc := range testCases {
+		thriftObj := FromMatchingUpdateTaskListBuildIDCompatibilityRequest(tc.input)
+		roundTripObj := ToMatchingUpdateTaskListBuildIDCompatibilityRequest(thriftObj)
+		assert.Equal(t, tc.input, roundTripObj)
+	}
+}
+
+func TestMatchingGetTaskListBuildIDCompatibilityRequest(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		input *types.MatchingGetTaskListBuildIDCompatibilityRequest
+	}{
+		{
+			desc:  "non-nil input test",
+			input: &testdata.MatchingGetTaskListBuildIDCompatibilityRequest,
+		},
+		{
+			desc:  "empty input test",
+			input: &types.MatchingGetTaskListBuildIDCompatibilityRequest{},
+		},
+		{
+			desc:  "nil input test",
+			input: nil,
+		},
+	}
+	for _, tc := range testCases {
+		thriftObj := FromMatchingGetTaskListBuildIDCompatibilityRequest(tc.input)
+		roundTripObj := ToMatchingGetTaskListBuildIDCompatibilityRequest(thriftObj)
+		assert.Equal(t, tc.input, roundTripObj)
+	}
+}
+
+func TestMatchingListTaskListTasksRequest(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		input *types.MatchingListTaskListTasksRequest
+	}{
+		{
+			desc:  "non-nil input test",
+			input: &testdata.MatchingListTaskListTasksRequest,
+		},
+		{
+			desc:  "empty input test",
+			input: &types.MatchingListTaskListTasksRequest{},
+		},
+		{
+			desc:  "nil input test",
+			input: nil,
+		},
+	}
+	for _, tc := range testCases {
+		thriftObj := FromMatchingListTaskListTasksRequest(tc.input)
+		roundTripObj := ToMatchingListTaskListTasksRequest(thriftObj)
+		assert.Equal(t, tc.input, roundTripObj)
+	}
+}
+
+func TestMatchingDeleteTaskListTasksRequest(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		input *types.MatchingDeleteTaskListTasksRequest
+	}{
+		{
+			desc:  "non-nil input test",
+			input: &testdata.MatchingDeleteTaskListTasksRequest,
+		},
+		{
+			desc:  "empty input test",
+			input: &types.MatchingDeleteTaskListTasksRequest{},
+		},
+		{
+			desc:  "nil input test",
+			input: nil,
+		},
+	}
+	for _, tc := range testCases {
+		thriftObj := FromMatchingDeleteTaskListTasksRequest(tc.input)
+		roundTripObj := ToMatchingDeleteTaskListTasksRequest(thriftObj)
+		assert.Equal(t, tc.input, roundTripObj)
+	}
+}
+
 func TestMatchingPollForActivityRequest(t *testing.T) {
 	testCases := []struct {
 		desc  string
@@ -247,6 +355,10 @@ func TestMatchingPollForDecisionResponse(t *testing.T) {
 			desc:  "empty input test",
 			input: &types.MatchingPollForDecisionTaskResponse{},
 		},
+		{
+			desc:  "updates input test",
+			input: &types.MatchingPollForDecisionTaskResponse{Updates: testdata.WorkflowUpdateMap},
+		},
 		{
 			desc:  "nil input test",
 			input: nil,
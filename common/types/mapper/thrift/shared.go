@@ -190,6 +190,108 @@ func ToActivityTaskFailedEventAttributes(t *shared.ActivityTaskFailedEventAttrib
 	}
 }
 
+// FromActivityOptions converts internal ActivityOptions type to thrift
+func FromActivityOptions(t *types.ActivityOptions) *shared.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityOptions{
+		TaskList:                      FromTaskList(t.TaskList),
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// ToActivityOptions converts thrift ActivityOptions type to internal
+func ToActivityOptions(t *shared.ActivityOptions) *types.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityOptions{
+		TaskList:                      ToTaskList(t.TaskList),
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// FromActivityTaskOptionsUpdatedEventAttributes converts internal ActivityTaskOptionsUpdatedEventAttributes type to thrift
+func FromActivityTaskOptionsUpdatedEventAttributes(t *types.ActivityTaskOptionsUpdatedEventAttributes) *shared.ActivityTaskOptionsUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventId: &t.ScheduledEventID,
+		ActivityOptions:  FromActivityOptions(t.ActivityOptions),
+		Identity:         &t.Identity,
+	}
+}
+
+// ToActivityTaskOptionsUpdatedEventAttributes converts thrift ActivityTaskOptionsUpdatedEventAttributes type to internal
+func ToActivityTaskOptionsUpdatedEventAttributes(t *shared.ActivityTaskOptionsUpdatedEventAttributes) *types.ActivityTaskOptionsUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventID: t.GetScheduledEventId(),
+		ActivityOptions:  ToActivityOptions(t.ActivityOptions),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// FromActivityTaskPausedEventAttributes converts internal ActivityTaskPausedEventAttributes type to thrift
+func FromActivityTaskPausedEventAttributes(t *types.ActivityTaskPausedEventAttributes) *shared.ActivityTaskPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskPausedEventAttributes{
+		ScheduledEventId: &t.ScheduledEventID,
+		Reason:           &t.Reason,
+		Identity:         &t.Identity,
+	}
+}
+
+// ToActivityTaskPausedEventAttributes converts thrift ActivityTaskPausedEventAttributes type to internal
+func ToActivityTaskPausedEventAttributes(t *shared.ActivityTaskPausedEventAttributes) *types.ActivityTaskPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskPausedEventAttributes{
+		ScheduledEventID: t.GetScheduledEventId(),
+		Reason:           t.GetReason(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// FromActivityTaskUnpausedEventAttributes converts internal ActivityTaskUnpausedEventAttributes type to thrift
+func FromActivityTaskUnpausedEventAttributes(t *types.ActivityTaskUnpausedEventAttributes) *shared.ActivityTaskUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventId:      &t.ScheduledEventID,
+		ResetAttempts:         &t.ResetAttempts,
+		ResetHeartbeatDetails: &t.ResetHeartbeatDetails,
+		Identity:              &t.Identity,
+	}
+}
+
+// ToActivityTaskUnpausedEventAttributes converts thrift ActivityTaskUnpausedEventAttributes type to internal
+func ToActivityTaskUnpausedEventAttributes(t *shared.ActivityTaskUnpausedEventAttributes) *types.ActivityTaskUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventID:      t.GetScheduledEventId(),
+		ResetAttempts:         t.GetResetAttempts(),
+		ResetHeartbeatDetails: t.GetResetHeartbeatDetails(),
+		Identity:              t.GetIdentity(),
+	}
+}
+
 // FromActivityTaskScheduledEventAttributes converts internal ActivityTaskScheduledEventAttributes type to thrift
 func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
 	if t == nil {
@@ -208,6 +310,7 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId:  &t.DecisionTaskCompletedEventID,
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
+		Priority:                      &t.Priority,
 	}
 }
 
@@ -229,6 +332,7 @@ func ToActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEvent
 		DecisionTaskCompletedEventID:  t.GetDecisionTaskCompletedEventId(),
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		Priority:                      t.GetPriority(),
 	}
 }
 
@@ -1052,6 +1156,7 @@ func FromDecision(t *types.Decision) *shared.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         FromUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     FromUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1075,6 +1180,7 @@ func ToDecision(t *shared.Decision) *types.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         ToUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     ToUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1181,6 +1287,12 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) *shared.Decis
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		v := shared.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case types.DecisionTaskFailedCauseUnknownUpdate:
+		v := shared.DecisionTaskFailedCauseUnknownUpdate
+		return &v
+	case types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		v := shared.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1260,6 +1372,12 @@ func ToDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) *types.Decisio
 	case shared.DecisionTaskFailedCauseBadSearchAttributes:
 		v := types.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case shared.DecisionTaskFailedCauseUnknownUpdate:
+		v := types.DecisionTaskFailedCauseUnknownUpdate
+		return &v
+	case shared.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		v := types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1337,6 +1455,7 @@ func FromDecisionTaskStartedEventAttributes(t *types.DecisionTaskStartedEventAtt
 		ScheduledEventId: &t.ScheduledEventID,
 		Identity:         &t.Identity,
 		RequestId:        &t.RequestID,
+		WorkerBuildId:    &t.WorkerBuildID,
 	}
 }
 
@@ -1349,6 +1468,7 @@ func ToDecisionTaskStartedEventAttributes(t *shared.DecisionTaskStartedEventAttr
 		ScheduledEventID: t.GetScheduledEventId(),
 		Identity:         t.GetIdentity(),
 		RequestID:        t.GetRequestId(),
+		WorkerBuildID:    t.GetWorkerBuildId(),
 	}
 }
 
@@ -1465,6 +1585,9 @@ func FromDecisionType(t *types.DecisionType) *shared.DecisionType {
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := shared.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.DecisionTypeUpsertWorkflowMemo:
+		v := shared.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1514,6 +1637,9 @@ func ToDecisionType(t *shared.DecisionType) *types.DecisionType {
 	case shared.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := types.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.DecisionTypeUpsertWorkflowMemo:
+		v := types.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1540,6 +1666,56 @@ func ToDeleteDomainRequest(t *shared.DeleteDomainRequest) *types.DeleteDomainReq
 	}
 }
 
+// FromDeleteTaskListTasksRequest converts internal DeleteTaskListTasksRequest type to thrift
+func FromDeleteTaskListTasksRequest(t *types.DeleteTaskListTasksRequest) *shared.DeleteTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteTaskListTasksRequest{
+		Domain:        &t.Domain,
+		TaskList:      FromTaskList(t.TaskList),
+		TaskListType:  FromTaskListType(t.TaskListType),
+		WorkflowID:    &t.WorkflowID,
+		RunID:         &t.RunID,
+		CreatedBefore: t.CreatedBefore,
+	}
+}
+
+// ToDeleteTaskListTasksRequest converts thrift DeleteTaskListTasksRequest type to internal
+func ToDeleteTaskListTasksRequest(t *shared.DeleteTaskListTasksRequest) *types.DeleteTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteTaskListTasksRequest{
+		Domain:        t.GetDomain(),
+		TaskList:      ToTaskList(t.TaskList),
+		TaskListType:  ToTaskListType(t.TaskListType),
+		WorkflowID:    t.GetWorkflowID(),
+		RunID:         t.GetRunID(),
+		CreatedBefore: t.CreatedBefore,
+	}
+}
+
+// FromDeleteTaskListTasksResponse converts internal DeleteTaskListTasksResponse type to thrift
+func FromDeleteTaskListTasksResponse(t *types.DeleteTaskListTasksResponse) *shared.DeleteTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteTaskListTasksResponse{
+		DeletedCount: &t.DeletedCount,
+	}
+}
+
+// ToDeleteTaskListTasksResponse converts thrift DeleteTaskListTasksResponse type to internal
+func ToDeleteTaskListTasksResponse(t *shared.DeleteTaskListTasksResponse) *types.DeleteTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteTaskListTasksResponse{
+		DeletedCount: t.GetDeletedCount(),
+	}
+}
+
 // FromDeprecateDomainRequest converts internal DeprecateDomainRequest type to thrift
 func FromDeprecateDomainRequest(t *types.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
 	if t == nil {
@@ -2476,6 +2652,21 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeUpsertWorkflowMemo:
+		v := shared.EventTypeUpsertWorkflowMemo
+		return &v
+	case types.EventTypeActivityTaskOptionsUpdated:
+		v := shared.EventTypeActivityTaskOptionsUpdated
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateAccepted:
+		v := shared.EventTypeWorkflowExecutionUpdateAccepted
+		return &v
+	case types.EventTypeActivityTaskPaused:
+		v := shared.EventTypeActivityTaskPaused
+		return &v
+	case types.EventTypeActivityTaskUnpaused:
+		v := shared.EventTypeActivityTaskUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2612,6 +2803,21 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeUpsertWorkflowMemo:
+		v := types.EventTypeUpsertWorkflowMemo
+		return &v
+	case shared.EventTypeActivityTaskOptionsUpdated:
+		v := types.EventTypeActivityTaskOptionsUpdated
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateAccepted:
+		v := types.EventTypeWorkflowExecutionUpdateAccepted
+		return &v
+	case shared.EventTypeActivityTaskPaused:
+		v := types.EventTypeActivityTaskPaused
+		return &v
+	case shared.EventTypeActivityTaskUnpaused:
+		v := types.EventTypeActivityTaskUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2909,6 +3115,11 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              FromUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
+		ActivityTaskOptionsUpdatedEventAttributes:                      FromActivityTaskOptionsUpdatedEventAttributes(t.ActivityTaskOptionsUpdatedEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 FromWorkflowExecutionUpdateAcceptedEventAttributes(t.WorkflowExecutionUpdateAcceptedEventAttributes),
+		ActivityTaskPausedEventAttributes:                              FromActivityTaskPausedEventAttributes(t.ActivityTaskPausedEventAttributes),
+		ActivityTaskUnpausedEventAttributes:                            FromActivityTaskUnpausedEventAttributes(t.ActivityTaskUnpausedEventAttributes),
 	}
 }
 
@@ -2965,6 +3176,11 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              ToUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
+		ActivityTaskOptionsUpdatedEventAttributes:                      ToActivityTaskOptionsUpdatedEventAttributes(t.ActivityTaskOptionsUpdatedEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 ToWorkflowExecutionUpdateAcceptedEventAttributes(t.WorkflowExecutionUpdateAcceptedEventAttributes),
+		ActivityTaskPausedEventAttributes:                              ToActivityTaskPausedEventAttributes(t.ActivityTaskPausedEventAttributes),
+		ActivityTaskUnpausedEventAttributes:                            ToActivityTaskUnpausedEventAttributes(t.ActivityTaskUnpausedEventAttributes),
 	}
 }
 
@@ -3568,6 +3784,188 @@ func ToListTaskListPartitionsResponse(t *shared.ListTaskListPartitionsResponse)
 	}
 }
 
+// FromListTaskListTasksRequest converts internal ListTaskListTasksRequest type to thrift
+func FromListTaskListTasksRequest(t *types.ListTaskListTasksRequest) *shared.ListTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListTaskListTasksRequest{
+		Domain:        &t.Domain,
+		TaskList:      FromTaskList(t.TaskList),
+		TaskListType:  FromTaskListType(t.TaskListType),
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListTaskListTasksRequest converts thrift ListTaskListTasksRequest type to internal
+func ToListTaskListTasksRequest(t *shared.ListTaskListTasksRequest) *types.ListTaskListTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListTaskListTasksRequest{
+		Domain:        t.GetDomain(),
+		TaskList:      ToTaskList(t.TaskList),
+		TaskListType:  ToTaskListType(t.TaskListType),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListTaskListTasksResponse converts internal ListTaskListTasksResponse type to thrift
+func FromListTaskListTasksResponse(t *types.ListTaskListTasksResponse) *shared.ListTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListTaskListTasksResponse{
+		Tasks:         FromTaskListTaskArray(t.Tasks),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListTaskListTasksResponse converts thrift ListTaskListTasksResponse type to internal
+func ToListTaskListTasksResponse(t *shared.ListTaskListTasksResponse) *types.ListTaskListTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListTaskListTasksResponse{
+		Tasks:         ToTaskListTaskArray(t.Tasks),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromCompatibleBuildIDSet converts internal CompatibleBuildIDSet type to thrift
+func FromCompatibleBuildIDSet(t *types.CompatibleBuildIDSet) *shared.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompatibleBuildIDSet{
+		BuildIDs: t.BuildIDs,
+	}
+}
+
+// ToCompatibleBuildIDSet converts thrift CompatibleBuildIDSet type to internal
+func ToCompatibleBuildIDSet(t *shared.CompatibleBuildIDSet) *types.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	return &types.CompatibleBuildIDSet{
+		BuildIDs: t.BuildIDs,
+	}
+}
+
+// FromCompatibleBuildIDSetArray converts internal CompatibleBuildIDSet type array to thrift
+func FromCompatibleBuildIDSetArray(t []*types.CompatibleBuildIDSet) []*shared.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CompatibleBuildIDSet, len(t))
+	for i := range t {
+		v[i] = FromCompatibleBuildIDSet(t[i])
+	}
+	return v
+}
+
+// ToCompatibleBuildIDSetArray converts thrift CompatibleBuildIDSet type array to internal
+func ToCompatibleBuildIDSetArray(t []*shared.CompatibleBuildIDSet) []*types.CompatibleBuildIDSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompatibleBuildIDSet, len(t))
+	for i := range t {
+		v[i] = ToCompatibleBuildIDSet(t[i])
+	}
+	return v
+}
+
+// FromUpdateTaskListBuildIDCompatibilityRequest converts internal UpdateTaskListBuildIDCompatibilityRequest type to thrift
+func FromUpdateTaskListBuildIDCompatibilityRequest(t *types.UpdateTaskListBuildIDCompatibilityRequest) *shared.UpdateTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateTaskListBuildIDCompatibilityRequest{
+		Domain:            &t.Domain,
+		TaskList:          FromTaskList(t.TaskList),
+		BuildID:           &t.BuildID,
+		CompatibleBuildID: &t.CompatibleBuildID,
+	}
+}
+
+// ToUpdateTaskListBuildIDCompatibilityRequest converts thrift UpdateTaskListBuildIDCompatibilityRequest type to internal
+func ToUpdateTaskListBuildIDCompatibilityRequest(t *shared.UpdateTaskListBuildIDCompatibilityRequest) *types.UpdateTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListBuildIDCompatibilityRequest{
+		Domain:            t.GetDomain(),
+		TaskList:          ToTaskList(t.TaskList),
+		BuildID:           t.GetBuildID(),
+		CompatibleBuildID: t.GetCompatibleBuildID(),
+	}
+}
+
+// FromUpdateTaskListBuildIDCompatibilityResponse converts internal UpdateTaskListBuildIDCompatibilityResponse type to thrift
+func FromUpdateTaskListBuildIDCompatibilityResponse(t *types.UpdateTaskListBuildIDCompatibilityResponse) *shared.UpdateTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: FromCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
+// ToUpdateTaskListBuildIDCompatibilityResponse converts thrift UpdateTaskListBuildIDCompatibilityResponse type to internal
+func ToUpdateTaskListBuildIDCompatibilityResponse(t *shared.UpdateTaskListBuildIDCompatibilityResponse) *types.UpdateTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: ToCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
+// FromGetTaskListBuildIDCompatibilityRequest converts internal GetTaskListBuildIDCompatibilityRequest type to thrift
+func FromGetTaskListBuildIDCompatibilityRequest(t *types.GetTaskListBuildIDCompatibilityRequest) *shared.GetTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetTaskListBuildIDCompatibilityRequest{
+		Domain:   &t.Domain,
+		TaskList: FromTaskList(t.TaskList),
+	}
+}
+
+// ToGetTaskListBuildIDCompatibilityRequest converts thrift GetTaskListBuildIDCompatibilityRequest type to internal
+func ToGetTaskListBuildIDCompatibilityRequest(t *shared.GetTaskListBuildIDCompatibilityRequest) *types.GetTaskListBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListBuildIDCompatibilityRequest{
+		Domain:   t.GetDomain(),
+		TaskList: ToTaskList(t.TaskList),
+	}
+}
+
+// FromGetTaskListBuildIDCompatibilityResponse converts internal GetTaskListBuildIDCompatibilityResponse type to thrift
+func FromGetTaskListBuildIDCompatibilityResponse(t *types.GetTaskListBuildIDCompatibilityResponse) *shared.GetTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: FromCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
+// ToGetTaskListBuildIDCompatibilityResponse converts thrift GetTaskListBuildIDCompatibilityResponse type to internal
+func ToGetTaskListBuildIDCompatibilityResponse(t *shared.GetTaskListBuildIDCompatibilityResponse) *types.GetTaskListBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: ToCompatibleBuildIDSetArray(t.CompatibleSets),
+	}
+}
+
 // FromGetTaskListsByDomainRequest converts internal GetTaskListsByDomainRequest type to thrift
 func FromGetTaskListsByDomainRequest(t *types.GetTaskListsByDomainRequest) *shared.GetTaskListsByDomainRequest {
 	if t == nil {
@@ -3768,6 +4166,50 @@ func ToParentClosePolicy(t *shared.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+// FromPauseActivityRequest converts internal PauseActivityRequest type to thrift
+func FromPauseActivityRequest(t *types.PauseActivityRequest) *shared.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseActivityRequest{
+		Domain:     &t.Domain,
+		Execution:  FromWorkflowExecution(t.Execution),
+		ActivityID: &t.ActivityID,
+		Identity:   &t.Identity,
+		Reason:     &t.Reason,
+	}
+}
+
+// ToPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToPauseActivityRequest(t *shared.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:     t.GetDomain(),
+		Execution:  ToWorkflowExecution(t.Execution),
+		ActivityID: t.GetActivityID(),
+		Identity:   t.GetIdentity(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// FromPauseActivityResponse converts internal PauseActivityResponse type to thrift
+func FromPauseActivityResponse(t *types.PauseActivityResponse) *shared.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseActivityResponse{}
+}
+
+// ToPauseActivityResponse converts thrift PauseActivityResponse type to internal
+func ToPauseActivityResponse(t *shared.PauseActivityResponse) *types.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityResponse{}
+}
+
 // FromPendingActivityInfo converts internal PendingActivityInfo type to thrift
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivityInfo {
 	if t == nil {
@@ -3789,6 +4231,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivi
 		LastFailureDetails:     t.LastFailureDetails,
 		StartedWorkerIdentity:  &t.StartedWorkerIdentity,
 		ScheduleID:             &t.ScheduleID,
+		Paused:                 &t.Paused,
 	}
 }
 
@@ -3813,6 +4256,7 @@ func ToPendingActivityInfo(t *shared.PendingActivityInfo) *types.PendingActivity
 		LastFailureDetails:     t.LastFailureDetails,
 		StartedWorkerIdentity:  t.GetStartedWorkerIdentity(),
 		ScheduleID:             t.GetScheduleID(),
+		Paused:                 t.GetPaused(),
 	}
 }
 
@@ -4030,10 +4474,11 @@ func FromPollForDecisionTaskRequest(t *types.PollForDecisionTaskRequest) *shared
 		return nil
 	}
 	return &shared.PollForDecisionTaskRequest{
-		Domain:         &t.Domain,
-		TaskList:       FromTaskList(t.TaskList),
-		Identity:       &t.Identity,
-		BinaryChecksum: &t.BinaryChecksum,
+		Domain:            &t.Domain,
+		TaskList:          FromTaskList(t.TaskList),
+		Identity:          &t.Identity,
+		BinaryChecksum:    &t.BinaryChecksum,
+		WorkerVersionInfo: FromWorkerVersionInfo(t.WorkerVersionInfo),
 	}
 }
 
@@ -4043,10 +4488,11 @@ func ToPollForDecisionTaskRequest(t *shared.PollForDecisionTaskRequest) *types.P
 		return nil
 	}
 	return &types.PollForDecisionTaskRequest{
-		Domain:         t.GetDomain(),
-		TaskList:       ToTaskList(t.TaskList),
-		Identity:       t.GetIdentity(),
-		BinaryChecksum: t.GetBinaryChecksum(),
+		Domain:            t.GetDomain(),
+		TaskList:          ToTaskList(t.TaskList),
+		Identity:          t.GetIdentity(),
+		BinaryChecksum:    t.GetBinaryChecksum(),
+		WorkerVersionInfo: ToWorkerVersionInfo(t.WorkerVersionInfo),
 	}
 }
 
@@ -4070,6 +4516,7 @@ func FromPollForDecisionTaskResponse(t *types.PollForDecisionTaskResponse) *shar
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
+		Updates:                   FromWorkflowUpdateMap(t.Updates),
 		NextEventId:               &t.NextEventID,
 		TotalHistoryBytes:         &t.TotalHistoryBytes,
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
@@ -4096,6 +4543,7 @@ func ToPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *types
 		ScheduledTimestamp:        t.ScheduledTimestamp,
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
+		Updates:                   ToWorkflowUpdateMap(t.Updates),
 		NextEventID:               t.GetNextEventId(),
 		TotalHistoryBytes:         t.GetTotalHistoryBytes(),
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
@@ -4111,6 +4559,7 @@ func FromPollerInfo(t *types.PollerInfo) *shared.PollerInfo {
 		LastAccessTime: t.LastAccessTime,
 		Identity:       &t.Identity,
 		RatePerSecond:  &t.RatePerSecond,
+		BuildID:        &t.BuildID,
 	}
 }
 
@@ -4123,6 +4572,7 @@ func ToPollerInfo(t *shared.PollerInfo) *types.PollerInfo {
 		LastAccessTime: t.LastAccessTime,
 		Identity:       t.GetIdentity(),
 		RatePerSecond:  t.GetRatePerSecond(),
+		BuildID:        t.GetBuildID(),
 	}
 }
 
@@ -5087,6 +5537,7 @@ func FromRespondDecisionTaskCompletedRequest(t *types.RespondDecisionTaskComplet
 		ForceCreateNewDecisionTask: &t.ForceCreateNewDecisionTask,
 		BinaryChecksum:             &t.BinaryChecksum,
 		QueryResults:               FromWorkflowQueryResultMap(t.QueryResults),
+		UpdateResults:              FromWorkflowUpdateResultMap(t.UpdateResults),
 	}
 }
 
@@ -5105,6 +5556,7 @@ func ToRespondDecisionTaskCompletedRequest(t *shared.RespondDecisionTaskComplete
 		ForceCreateNewDecisionTask: t.GetForceCreateNewDecisionTask(),
 		BinaryChecksum:             t.GetBinaryChecksum(),
 		QueryResults:               ToWorkflowQueryResultMap(t.QueryResults),
+		UpdateResults:              ToWorkflowUpdateResultMap(t.UpdateResults),
 	}
 }
 
@@ -5292,6 +5744,7 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
 		RequestLocalDispatch:          &t.RequestLocalDispatch,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -5313,6 +5766,7 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.GetRequestLocalDispatch(),
+		Priority:                      t.Priority,
 	}
 }
 
@@ -5853,6 +6307,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		FirstRunAtTimestamp:                 t.FirstRunAtTimeStamp,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        thriftPolicy,
+		Priority:                            &t.Priority,
 	}
 }
 
@@ -5882,6 +6337,7 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		FirstRunAtTimeStamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		Priority:                            t.GetPriority(),
 	}
 }
 
@@ -6095,14 +6551,15 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint:      &t.BacklogCountHint,
-		ReadLevel:             &t.ReadLevel,
-		AckLevel:              &t.AckLevel,
-		RatePerSecond:         &t.RatePerSecond,
-		TaskIDBlock:           FromTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     &t.NewTasksPerSecond,
-		Empty:                 &t.Empty,
+		BacklogCountHint:       &t.BacklogCountHint,
+		ReadLevel:              &t.ReadLevel,
+		AckLevel:               &t.AckLevel,
+		RatePerSecond:          &t.RatePerSecond,
+		TaskIDBlock:            FromTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:  FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:      &t.NewTasksPerSecond,
+		Empty:                  &t.Empty,
+		BacklogCountByPriority: t.BacklogCountByPriority,
 	}
 }
 
@@ -6112,14 +6569,15 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint:      t.GetBacklogCountHint(),
-		ReadLevel:             t.GetReadLevel(),
-		AckLevel:              t.GetAckLevel(),
-		RatePerSecond:         t.GetRatePerSecond(),
-		TaskIDBlock:           ToTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
-		NewTasksPerSecond:     t.GetNewTasksPerSecond(),
-		Empty:                 t.GetEmpty(),
+		BacklogCountHint:       t.GetBacklogCountHint(),
+		ReadLevel:              t.GetReadLevel(),
+		AckLevel:               t.GetAckLevel(),
+		RatePerSecond:          t.GetRatePerSecond(),
+		TaskIDBlock:            ToTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:  ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
+		NewTasksPerSecond:      t.GetNewTasksPerSecond(),
+		Empty:                  t.GetEmpty(),
+		BacklogCountByPriority: t.BacklogCountByPriority,
 	}
 }
 
@@ -6140,6 +6598,40 @@ func ToIsolationGroupMetrics(t *shared.IsolationGroupMetrics) *types.IsolationGr
 	}
 }
 
+// FromTaskListTask converts internal TaskListTask type to thrift
+func FromTaskListTask(t *types.TaskListTask) *shared.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListTask{
+		WorkflowID:     &t.WorkflowID,
+		RunID:          &t.RunID,
+		TaskID:         &t.TaskID,
+		ScheduleID:     &t.ScheduleID,
+		CreatedTime:    t.CreatedTime,
+		ExpiryTime:     t.ExpiryTime,
+		IsolationGroup: &t.IsolationGroup,
+		Priority:       &t.Priority,
+	}
+}
+
+// ToTaskListTask converts thrift TaskListTask type to internal
+func ToTaskListTask(t *shared.TaskListTask) *types.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListTask{
+		WorkflowID:     t.GetWorkflowID(),
+		RunID:          t.GetRunID(),
+		TaskID:         t.GetTaskID(),
+		ScheduleID:     t.GetScheduleID(),
+		CreatedTime:    t.CreatedTime,
+		ExpiryTime:     t.ExpiryTime,
+		IsolationGroup: t.GetIsolationGroup(),
+		Priority:       t.GetPriority(),
+	}
+}
+
 // FromTaskListType converts internal TaskListType type to thrift
 func FromTaskListType(t *types.TaskListType) *shared.TaskListType {
 	if t == nil {
@@ -6350,6 +6842,132 @@ func ToTransientDecisionInfo(t *shared.TransientDecisionInfo) *types.TransientDe
 	}
 }
 
+// FromUnpauseActivityRequest converts internal UnpauseActivityRequest type to thrift
+func FromUnpauseActivityRequest(t *types.UnpauseActivityRequest) *shared.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseActivityRequest{
+		Domain:                &t.Domain,
+		Execution:             FromWorkflowExecution(t.Execution),
+		ActivityID:            &t.ActivityID,
+		ResetAttempts:         &t.ResetAttempts,
+		ResetHeartbeatDetails: &t.ResetHeartbeatDetails,
+		Identity:              &t.Identity,
+	}
+}
+
+// ToUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToUnpauseActivityRequest(t *shared.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:                t.GetDomain(),
+		Execution:             ToWorkflowExecution(t.Execution),
+		ActivityID:            t.GetActivityID(),
+		ResetAttempts:         t.GetResetAttempts(),
+		ResetHeartbeatDetails: t.GetResetHeartbeatDetails(),
+		Identity:              t.GetIdentity(),
+	}
+}
+
+// FromUnpauseActivityResponse converts internal UnpauseActivityResponse type to thrift
+func FromUnpauseActivityResponse(t *types.UnpauseActivityResponse) *shared.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseActivityResponse{}
+}
+
+// ToUnpauseActivityResponse converts thrift UnpauseActivityResponse type to internal
+func ToUnpauseActivityResponse(t *shared.UnpauseActivityResponse) *types.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityResponse{}
+}
+
+// FromUpdateActivityOptionsRequest converts internal UpdateActivityOptionsRequest type to thrift
+func FromUpdateActivityOptionsRequest(t *types.UpdateActivityOptionsRequest) *shared.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateActivityOptionsRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		ActivityOptions:   FromActivityOptions(t.ActivityOptions),
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUpdateActivityOptionsRequest converts thrift UpdateActivityOptionsRequest type to internal
+func ToUpdateActivityOptionsRequest(t *shared.UpdateActivityOptionsRequest) *types.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		ActivityOptions:   ToActivityOptions(t.ActivityOptions),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUpdateActivityOptionsResponse converts internal UpdateActivityOptionsResponse type to thrift
+func FromUpdateActivityOptionsResponse(t *types.UpdateActivityOptionsResponse) *shared.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateActivityOptionsResponse{
+		ActivityOptions: FromActivityOptions(t.ActivityOptions),
+	}
+}
+
+// ToUpdateActivityOptionsResponse converts thrift UpdateActivityOptionsResponse type to internal
+func ToUpdateActivityOptionsResponse(t *shared.UpdateActivityOptionsResponse) *types.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsResponse{
+		ActivityOptions: ToActivityOptions(t.ActivityOptions),
+	}
+}
+
+// FromUpdateResultType converts internal UpdateResultType type to thrift
+func FromUpdateResultType(t *types.UpdateResultType) *shared.UpdateResultType {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.UpdateResultTypeAccepted:
+		v := shared.UpdateResultTypeAccepted
+		return &v
+	case types.UpdateResultTypeRejected:
+		v := shared.UpdateResultTypeRejected
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToUpdateResultType converts thrift UpdateResultType type to internal
+func ToUpdateResultType(t *shared.UpdateResultType) *types.UpdateResultType {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.UpdateResultTypeAccepted:
+		v := types.UpdateResultTypeAccepted
+		return &v
+	case shared.UpdateResultTypeRejected:
+		v := types.UpdateResultTypeRejected
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromUpdateDomainRequest converts internal UpdateDomainRequest type to thrift
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainRequest {
 	if t == nil {
@@ -6456,6 +7074,54 @@ func ToUpdateDomainResponse(t *shared.UpdateDomainResponse) *types.UpdateDomainR
 	}
 }
 
+// FromUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Update:            FromWorkflowUpdate(t.Update),
+		Identity:          &t.Identity,
+		UpdateId:          &t.UpdateID,
+	}
+}
+
+// ToUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToUpdateWorkflowExecutionRequest(t *shared.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Update:            ToWorkflowUpdate(t.Update),
+		Identity:          t.GetIdentity(),
+		UpdateID:          t.GetUpdateId(),
+	}
+}
+
+// FromUpdateWorkflowExecutionResponse converts internal UpdateWorkflowExecutionResponse type to thrift
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *shared.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionResponse{
+		Result: t.Result,
+	}
+}
+
+// ToUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to internal
+func ToUpdateWorkflowExecutionResponse(t *shared.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		Result: t.Result,
+	}
+}
+
 // FromUpsertWorkflowSearchAttributesDecisionAttributes converts internal UpsertWorkflowSearchAttributesDecisionAttributes type to thrift
 func FromUpsertWorkflowSearchAttributesDecisionAttributes(t *types.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
 	if t == nil {
@@ -6498,6 +7164,48 @@ func ToUpsertWorkflowSearchAttributesEventAttributes(t *shared.UpsertWorkflowSea
 	}
 }
 
+// FromUpsertWorkflowMemoDecisionAttributes converts internal UpsertWorkflowMemoDecisionAttributes type to thrift
+func FromUpsertWorkflowMemoDecisionAttributes(t *types.UpsertWorkflowMemoDecisionAttributes) *shared.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoDecisionAttributes{
+		Memo: FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoDecisionAttributes converts thrift UpsertWorkflowMemoDecisionAttributes type to internal
+func ToUpsertWorkflowMemoDecisionAttributes(t *shared.UpsertWorkflowMemoDecisionAttributes) *types.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: ToMemo(t.Memo),
+	}
+}
+
+// FromUpsertWorkflowMemoEventAttributes converts internal UpsertWorkflowMemoEventAttributes type to thrift
+func FromUpsertWorkflowMemoEventAttributes(t *types.UpsertWorkflowMemoEventAttributes) *shared.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		Memo:                         FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoEventAttributes converts thrift UpsertWorkflowMemoEventAttributes type to internal
+func ToUpsertWorkflowMemoEventAttributes(t *shared.UpsertWorkflowMemoEventAttributes) *types.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		Memo:                         ToMemo(t.Memo),
+	}
+}
+
 // FromVersionHistories converts internal VersionHistories type to thrift
 func FromVersionHistories(t *types.VersionHistories) *shared.VersionHistories {
 	if t == nil {
@@ -6572,6 +7280,7 @@ func FromWorkerVersionInfo(t *types.WorkerVersionInfo) *shared.WorkerVersionInfo
 	return &shared.WorkerVersionInfo{
 		Impl:           &t.Impl,
 		FeatureVersion: &t.FeatureVersion,
+		BuildID:        &t.BuildID,
 	}
 }
 
@@ -6583,6 +7292,7 @@ func ToWorkerVersionInfo(t *shared.WorkerVersionInfo) *types.WorkerVersionInfo {
 	return &types.WorkerVersionInfo{
 		Impl:           t.GetImpl(),
 		FeatureVersion: t.GetFeatureVersion(),
+		BuildID:        t.GetBuildID(),
 	}
 }
 
@@ -7081,6 +7791,8 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		PartitionConfig:                     t.PartitionConfig,
 		RequestId:                           &t.RequestID,
 		ActiveClusterSelectionPolicy:        FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		Priority:                            &t.Priority,
+		FairnessKey:                         &t.FairnessKey,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 	}
 }
@@ -7121,6 +7833,8 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		PartitionConfig:                     t.PartitionConfig,
 		RequestID:                           t.GetRequestId(),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		Priority:                            t.GetPriority(),
+		FairnessKey:                         t.GetFairnessKey(),
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 	}
 }
@@ -7241,6 +7955,34 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimed
 	}
 }
 
+// FromWorkflowExecutionUpdateAcceptedEventAttributes converts internal WorkflowExecutionUpdateAcceptedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateAcceptedEventAttributes(t *types.WorkflowExecutionUpdateAcceptedEventAttributes) *shared.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:                     &t.UpdateID,
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		Update:                       FromWorkflowUpdate(t.Update),
+		Result:                       t.Result,
+		Identity:                     &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionUpdateAcceptedEventAttributes converts thrift WorkflowExecutionUpdateAcceptedEventAttributes type to internal
+func ToWorkflowExecutionUpdateAcceptedEventAttributes(t *shared.WorkflowExecutionUpdateAcceptedEventAttributes) *types.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:                     t.GetUpdateId(),
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		Update:                       ToWorkflowUpdate(t.Update),
+		Result:                       t.Result,
+		Identity:                     t.GetIdentity(),
+	}
+}
+
 // FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
 	if t == nil {
@@ -7371,6 +8113,52 @@ func ToWorkflowTypeFilter(t *shared.WorkflowTypeFilter) *types.WorkflowTypeFilte
 	}
 }
 
+// FromWorkflowUpdate converts internal WorkflowUpdate type to thrift
+func FromWorkflowUpdate(t *types.WorkflowUpdate) *shared.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowUpdate{
+		UpdateType: &t.UpdateType,
+		UpdateArgs: t.UpdateArgs,
+	}
+}
+
+// ToWorkflowUpdate converts thrift WorkflowUpdate type to internal
+func ToWorkflowUpdate(t *shared.WorkflowUpdate) *types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdate{
+		UpdateType: t.GetUpdateType(),
+		UpdateArgs: t.UpdateArgs,
+	}
+}
+
+// FromWorkflowUpdateResult converts internal WorkflowUpdateResult type to thrift
+func FromWorkflowUpdateResult(t *types.WorkflowUpdateResult) *shared.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowUpdateResult{
+		ResultType:   FromUpdateResultType(t.ResultType),
+		Result:       t.Result,
+		ErrorMessage: &t.ErrorMessage,
+	}
+}
+
+// ToWorkflowUpdateResult converts thrift WorkflowUpdateResult type to internal
+func ToWorkflowUpdateResult(t *shared.WorkflowUpdateResult) *types.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdateResult{
+		ResultType:   ToUpdateResultType(t.ResultType),
+		Result:       t.Result,
+		ErrorMessage: t.GetErrorMessage(),
+	}
+}
+
 // FromPendingActivityInfoArray converts internal PendingActivityInfo type array to thrift
 func FromPendingActivityInfoArray(t []*types.PendingActivityInfo) []*shared.PendingActivityInfo {
 	if t == nil {
@@ -7635,6 +8423,30 @@ func ToTaskListPartitionMetadataArray(t []*shared.TaskListPartitionMetadata) []*
 	return v
 }
 
+// FromTaskListTaskArray converts internal TaskListTask type array to thrift
+func FromTaskListTaskArray(t []*types.TaskListTask) []*shared.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.TaskListTask, len(t))
+	for i := range t {
+		v[i] = FromTaskListTask(t[i])
+	}
+	return v
+}
+
+// ToTaskListTaskArray converts thrift TaskListTask type array to internal
+func ToTaskListTaskArray(t []*shared.TaskListTask) []*types.TaskListTask {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListTask, len(t))
+	for i := range t {
+		v[i] = ToTaskListTask(t[i])
+	}
+	return v
+}
+
 // FromResetPointInfoArray converts internal ResetPointInfo type array to thrift
 func FromResetPointInfoArray(t []*types.ResetPointInfo) []*shared.ResetPointInfo {
 	if t == nil {
@@ -7779,6 +8591,54 @@ func ToWorkflowQueryResultMap(t map[string]*shared.WorkflowQueryResult) map[stri
 	return v
 }
 
+// FromWorkflowUpdateMap converts internal WorkflowUpdate type map to thrift
+func FromWorkflowUpdateMap(t map[string]*types.WorkflowUpdate) map[string]*shared.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*shared.WorkflowUpdate, len(t))
+	for key := range t {
+		v[key] = FromWorkflowUpdate(t[key])
+	}
+	return v
+}
+
+// ToWorkflowUpdateMap converts thrift WorkflowUpdate type map to internal
+func ToWorkflowUpdateMap(t map[string]*shared.WorkflowUpdate) map[string]*types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.WorkflowUpdate, len(t))
+	for key := range t {
+		v[key] = ToWorkflowUpdate(t[key])
+	}
+	return v
+}
+
+// FromWorkflowUpdateResultMap converts internal WorkflowUpdateResult type map to thrift
+func FromWorkflowUpdateResultMap(t map[string]*types.WorkflowUpdateResult) map[string]*shared.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*shared.WorkflowUpdateResult, len(t))
+	for key := range t {
+		v[key] = FromWorkflowUpdateResult(t[key])
+	}
+	return v
+}
+
+// ToWorkflowUpdateResultMap converts thrift WorkflowUpdateResult type map to internal
+func ToWorkflowUpdateResultMap(t map[string]*shared.WorkflowUpdateResult) map[string]*types.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.WorkflowUpdateResult, len(t))
+	for key := range t {
+		v[key] = ToWorkflowUpdateResult(t[key])
+	}
+	return v
+}
+
 // FromActivityLocalDispatchInfoMap converts internal ActivityLocalDispatchInfo type map to thrift
 func FromActivityLocalDispatchInfoMap(t map[string]*types.ActivityLocalDispatchInfo) map[string]*shared.ActivityLocalDispatchInfo {
 	if t == nil {
@@ -425,6 +425,7 @@ func TestActivityTaskScheduledEventAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ActivityTaskScheduledEventAttributes,
+		{ActivityID: testdata.ActivityID, Priority: 3},
 	}
 
 	for _, original := range testCases {
@@ -900,6 +901,8 @@ func TestDecisionTaskFailedCauseConversion(t *testing.T) {
 		types.DecisionTaskFailedCauseBadBinary.Ptr(),
 		types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr(),
 		types.DecisionTaskFailedCauseBadSearchAttributes.Ptr(),
+		types.DecisionTaskFailedCauseUnknownUpdate.Ptr(),
+		types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -942,6 +945,7 @@ func TestDecisionTaskStartedEventAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.DecisionTaskStartedEventAttributes,
+		{ScheduledEventID: testdata.EventID1, WorkerBuildID: testdata.BuildID},
 	}
 
 	for _, original := range testCases {
@@ -995,6 +999,7 @@ func TestDecisionTypeConversion(t *testing.T) {
 		types.DecisionTypeStartChildWorkflowExecution.Ptr(),
 		types.DecisionTypeSignalExternalWorkflowExecution.Ptr(),
 		types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.DecisionTypeUpsertWorkflowMemo.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -1432,6 +1437,7 @@ func TestEventTypeConversion(t *testing.T) {
 		types.EventTypeSignalExternalWorkflowExecutionFailed.Ptr(),
 		types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
 		types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.EventTypeUpsertWorkflowMemo.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -1583,6 +1589,20 @@ func TestHistoryEventConversion(t *testing.T) {
 			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
 			WorkflowExecutionStartedEventAttributes: &testdata.WorkflowExecutionStartedEventAttributes,
 		},
+		{
+			ID:        6,
+			Timestamp: common.Int64Ptr(10),
+			EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				TaskList:    &testdata.TaskList,
+				FairnessKey: testdata.FairnessKey,
+			},
+		},
+		&testdata.HistoryEvent_UpsertWorkflowMemo,
+		&testdata.HistoryEvent_ActivityTaskOptionsUpdated,
+		&testdata.HistoryEvent_WorkflowExecutionUpdateAccepted,
+		&testdata.HistoryEvent_ActivityTaskPaused,
+		&testdata.HistoryEvent_ActivityTaskUnpaused,
 	}
 
 	for _, original := range testCases {
@@ -1929,6 +1949,7 @@ func TestPendingActivityInfoConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.PendingActivityInfo,
+		{ActivityID: testdata.ActivityID, ScheduleID: testdata.ScheduleID, Paused: true},
 	}
 
 	for _, original := range testCases {
@@ -2028,6 +2049,7 @@ func TestPollForDecisionTaskRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.PollForDecisionTaskRequest,
+		{Domain: testdata.DomainName, WorkerVersionInfo: &types.WorkerVersionInfo{BuildID: testdata.BuildID}},
 	}
 
 	for _, original := range testCases {
@@ -2042,6 +2064,7 @@ func TestPollForDecisionTaskResponseConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.PollForDecisionTaskResponse,
+		{Updates: testdata.WorkflowUpdateMap},
 	}
 
 	for _, original := range testCases {
@@ -2059,6 +2082,7 @@ func TestPollerInfoConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.PollerInfo,
+		{Identity: testdata.Identity, BuildID: testdata.BuildID},
 	}
 
 	for _, original := range testCases {
@@ -2598,6 +2622,7 @@ func TestRespondDecisionTaskCompletedRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.RespondDecisionTaskCompletedRequest,
+		{UpdateResults: testdata.WorkflowUpdateResultMap},
 	}
 
 	for _, original := range testCases {
@@ -2682,6 +2707,7 @@ func TestScheduleActivityTaskDecisionAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ScheduleActivityTaskDecisionAttributes,
+		{ActivityID: testdata.ActivityID, Priority: common.Int32Ptr(3)},
 	}
 
 	for _, original := range testCases {
@@ -2878,6 +2904,7 @@ func TestStartWorkflowExecutionRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.StartWorkflowExecutionRequest,
+		{Domain: testdata.DomainName, WorkflowID: testdata.WorkflowID, Priority: 3},
 	}
 
 	for _, original := range testCases {
@@ -3060,6 +3087,7 @@ func TestTaskListStatusConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.TaskListStatus,
+		{BacklogCountHint: 7, BacklogCountByPriority: map[int32]int64{0: 5, 3: 2}},
 	}
 
 	for _, original := range testCases {
@@ -3214,6 +3242,174 @@ func TestUpsertWorkflowSearchAttributesEventAttributesConversion(t *testing.T) {
 	}
 }
 
+func TestUpsertWorkflowMemoDecisionAttributesConversion(t *testing.T) {
+	testCases := []*types.UpsertWorkflowMemoDecisionAttributes{
+		nil,
+		{},
+		&testdata.UpsertWorkflowMemoDecisionAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpsertWorkflowMemoDecisionAttributes(original)
+		roundTripObj := ToUpsertWorkflowMemoDecisionAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpsertWorkflowMemoEventAttributesConversion(t *testing.T) {
+	testCases := []*types.UpsertWorkflowMemoEventAttributes{
+		nil,
+		{},
+		&testdata.UpsertWorkflowMemoEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpsertWorkflowMemoEventAttributes(original)
+		roundTripObj := ToUpsertWorkflowMemoEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityTaskOptionsUpdatedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.ActivityTaskOptionsUpdatedEventAttributes{
+		nil,
+		{},
+		&testdata.ActivityTaskOptionsUpdatedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityTaskOptionsUpdatedEventAttributes(original)
+		roundTripObj := ToActivityTaskOptionsUpdatedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityTaskPausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.ActivityTaskPausedEventAttributes{
+		nil,
+		{},
+		&testdata.ActivityTaskPausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityTaskPausedEventAttributes(original)
+		roundTripObj := ToActivityTaskPausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityTaskUnpausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.ActivityTaskUnpausedEventAttributes{
+		nil,
+		{},
+		&testdata.ActivityTaskUnpausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityTaskUnpausedEventAttributes(original)
+		roundTripObj := ToActivityTaskUnpausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateActivityOptionsRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateActivityOptionsRequest{
+		nil,
+		{},
+		&testdata.UpdateActivityOptionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateActivityOptionsRequest(original)
+		roundTripObj := ToUpdateActivityOptionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateActivityOptionsResponseConversion(t *testing.T) {
+	testCases := []*types.UpdateActivityOptionsResponse{
+		nil,
+		{},
+		&testdata.UpdateActivityOptionsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateActivityOptionsResponse(original)
+		roundTripObj := ToUpdateActivityOptionsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionUpdateAcceptedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionUpdateAcceptedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionUpdateAcceptedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionUpdateAcceptedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.UpdateWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateWorkflowExecutionRequest(original)
+		roundTripObj := ToUpdateWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateWorkflowExecutionResponseConversion(t *testing.T) {
+	testCases := []*types.UpdateWorkflowExecutionResponse{
+		nil,
+		{},
+		&testdata.UpdateWorkflowExecutionResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateWorkflowExecutionResponse(original)
+		roundTripObj := ToUpdateWorkflowExecutionResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowUpdateConversion(t *testing.T) {
+	testCases := []*types.WorkflowUpdate{
+		nil,
+		{},
+		&testdata.WorkflowUpdate,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowUpdate(original)
+		roundTripObj := ToWorkflowUpdate(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowUpdateResultConversion(t *testing.T) {
+	testCases := []*types.WorkflowUpdateResult{
+		nil,
+		{},
+		&testdata.WorkflowUpdateResult,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowUpdateResult(original)
+		roundTripObj := ToWorkflowUpdateResult(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestVersionHistoriesConversion(t *testing.T) {
 	testCases := []*types.VersionHistories{
 		nil,
@@ -3261,6 +3457,7 @@ func TestWorkerVersionInfoConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.WorkerVersionInfo,
+		{Impl: testdata.ClientImpl, BuildID: testdata.BuildID},
 	}
 
 	for _, original := range testCases {
@@ -3270,6 +3467,76 @@ func TestWorkerVersionInfoConversion(t *testing.T) {
 	}
 }
 
+func TestCompatibleBuildIDSetConversion(t *testing.T) {
+	testCases := []*types.CompatibleBuildIDSet{
+		nil,
+		{},
+		&testdata.CompatibleBuildIDSet,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCompatibleBuildIDSet(original)
+		roundTripObj := ToCompatibleBuildIDSet(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateTaskListBuildIDCompatibilityRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateTaskListBuildIDCompatibilityRequest{
+		nil,
+		{},
+		&testdata.UpdateTaskListBuildIDCompatibilityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateTaskListBuildIDCompatibilityRequest(original)
+		roundTripObj := ToUpdateTaskListBuildIDCompatibilityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateTaskListBuildIDCompatibilityResponseConversion(t *testing.T) {
+	testCases := []*types.UpdateTaskListBuildIDCompatibilityResponse{
+		nil,
+		{},
+		&testdata.UpdateTaskListBuildIDCompatibilityResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateTaskListBuildIDCompatibilityResponse(original)
+		roundTripObj := ToUpdateTaskListBuildIDCompatibilityResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetTaskListBuildIDCompatibilityRequestConversion(t *testing.T) {
+	testCases := []*types.GetTaskListBuildIDCompatibilityRequest{
+		nil,
+		{},
+		&testdata.GetTaskListBuildIDCompatibilityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetTaskListBuildIDCompatibilityRequest(original)
+		roundTripObj := ToGetTaskListBuildIDCompatibilityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetTaskListBuildIDCompatibilityResponseConversion(t *testing.T) {
+	testCases := []*types.GetTaskListBuildIDCompatibilityResponse{
+		nil,
+		{},
+		&testdata.GetTaskListBuildIDCompatibilityResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetTaskListBuildIDCompatibilityResponse(original)
+		roundTripObj := ToGetTaskListBuildIDCompatibilityResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestWorkflowExecutionConversion(t *testing.T) {
 	testCases := []*types.WorkflowExecution{
 		nil,
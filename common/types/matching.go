@@ -38,6 +38,8 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32  `json:"priority,omitempty"`
+	FairnessKey                   string `json:"fairnessKey,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -112,6 +114,22 @@ func (v *AddActivityTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // ActivityTaskDispatchInfo is an internal type (TBD...)
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -133,6 +151,9 @@ type AddDecisionTaskRequest struct {
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32  `json:"priority,omitempty"`
+	WorkerBuildID                 string `json:"workerBuildId,omitempty"`
+	FairnessKey                   string `json:"fairnessKey,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -199,6 +220,30 @@ func (v *AddDecisionTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetWorkerBuildID is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetWorkerBuildID() (o string) {
+	if v != nil {
+		return v.WorkerBuildID
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 type AddActivityTaskResponse struct {
 	PartitionConfig *TaskListPartitionConfig
 }
@@ -291,6 +336,94 @@ func (v *MatchingListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
 	return
 }
 
+// MatchingUpdateTaskListBuildIDCompatibilityRequest is an internal type (TBD...)
+type MatchingUpdateTaskListBuildIDCompatibilityRequest struct {
+	DomainUUID string                                     `json:"domainUUID,omitempty"`
+	Request    *UpdateTaskListBuildIDCompatibilityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListBuildIDCompatibilityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListBuildIDCompatibilityRequest) GetRequest() (o *UpdateTaskListBuildIDCompatibilityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// MatchingGetTaskListBuildIDCompatibilityRequest is an internal type (TBD...)
+type MatchingGetTaskListBuildIDCompatibilityRequest struct {
+	DomainUUID string                                  `json:"domainUUID,omitempty"`
+	Request    *GetTaskListBuildIDCompatibilityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingGetTaskListBuildIDCompatibilityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *MatchingGetTaskListBuildIDCompatibilityRequest) GetRequest() (o *GetTaskListBuildIDCompatibilityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// MatchingListTaskListTasksRequest is an internal type (TBD...)
+type MatchingListTaskListTasksRequest struct {
+	DomainUUID string                    `json:"domainUUID,omitempty"`
+	Request    *ListTaskListTasksRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingListTaskListTasksRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *MatchingListTaskListTasksRequest) GetRequest() (o *ListTaskListTasksRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// MatchingDeleteTaskListTasksRequest is an internal type (TBD...)
+type MatchingDeleteTaskListTasksRequest struct {
+	DomainUUID string                      `json:"domainUUID,omitempty"`
+	Request    *DeleteTaskListTasksRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListTasksRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListTasksRequest) GetRequest() (o *DeleteTaskListTasksRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
 // MatchingGetTaskListsByDomainRequest is an internal type (TBD...)
 type MatchingGetTaskListsByDomainRequest struct {
 	Domain string `json:"domain,omitempty"`
@@ -451,6 +584,7 @@ type MatchingPollForDecisionTaskResponse struct {
 	PartitionConfig           *TaskListPartitionConfig
 	LoadBalancerHints         *LoadBalancerHints
 	AutoConfigHint            *AutoConfigHint
+	Updates                   map[string]*WorkflowUpdate `json:"updates,omitempty"`
 }
 
 // GetWorkflowExecution is an internal getter (TBD...)
@@ -139,14 +139,78 @@ func (e ScheduleCatchUpPolicy) MarshalText() ([]byte, error) {
 	return []byte(e.String()), nil
 }
 
+// ScheduleRunOutcome describes what happened when a schedule fired.
+type ScheduleRunOutcome int32
+
+const (
+	ScheduleRunOutcomeInvalid        ScheduleRunOutcome = iota
+	ScheduleRunOutcomeStarted                           // Target workflow was started
+	ScheduleRunOutcomeSkipped                           // Skipped by the overlap policy or because the run already existed
+	ScheduleRunOutcomeCatchUpSkipped                    // Missed while paused or unavailable and skipped by the catch-up policy
+	ScheduleRunOutcomeFailed                            // Target workflow could not be started
+)
+
+func (e ScheduleRunOutcome) Ptr() *ScheduleRunOutcome { return &e }
+
+func (e ScheduleRunOutcome) String() string {
+	switch e {
+	case ScheduleRunOutcomeInvalid:
+		return "INVALID"
+	case ScheduleRunOutcomeStarted:
+		return "STARTED"
+	case ScheduleRunOutcomeSkipped:
+		return "SKIPPED"
+	case ScheduleRunOutcomeCatchUpSkipped:
+		return "CATCH_UP_SKIPPED"
+	case ScheduleRunOutcomeFailed:
+		return "FAILED"
+	}
+	return fmt.Sprintf("ScheduleRunOutcome(%d)", int32(e))
+}
+
+func (e *ScheduleRunOutcome) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "INVALID":
+		*e = ScheduleRunOutcomeInvalid
+	case "STARTED":
+		*e = ScheduleRunOutcomeStarted
+	case "SKIPPED":
+		*e = ScheduleRunOutcomeSkipped
+	case "CATCH_UP_SKIPPED":
+		*e = ScheduleRunOutcomeCatchUpSkipped
+	case "FAILED":
+		*e = ScheduleRunOutcomeFailed
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleRunOutcome", err)
+		}
+		*e = ScheduleRunOutcome(val)
+	}
+	return nil
+}
+
+func (e ScheduleRunOutcome) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
 // --- Core Types ---
 
 // ScheduleSpec defines when a schedule should trigger.
+// The schedule fires at the union of the times produced by CronExpression,
+// CronExpressions and Intervals, minus any time falling on a day matched by
+// one of the ExcludeCalendars. Cron expressions and calendars are evaluated
+// in TimeZone (an IANA name), or in the local time zone of the scheduler worker
+// when it is empty.
 type ScheduleSpec struct {
-	CronExpression string        `json:"cronExpression,omitempty"`
-	StartTime      time.Time     `json:"startTime,omitempty"`
-	EndTime        time.Time     `json:"endTime,omitempty"`
-	Jitter         time.Duration `json:"jitter,omitempty"`
+	CronExpression   string                  `json:"cronExpression,omitempty"`
+	StartTime        time.Time               `json:"startTime,omitempty"`
+	EndTime          time.Time               `json:"endTime,omitempty"`
+	Jitter           time.Duration           `json:"jitter,omitempty"`
+	CronExpressions  []string                `json:"cronExpressions,omitempty"`
+	Intervals        []*ScheduleIntervalSpec `json:"intervals,omitempty"`
+	ExcludeCalendars []*ScheduleCalendar     `json:"excludeCalendars,omitempty"`
+	TimeZone         string                  `json:"timeZone,omitempty"`
 }
 
 func (v *ScheduleSpec) GetCronExpression() (o string) {
@@ -177,6 +241,84 @@ func (v *ScheduleSpec) GetJitter() (o time.Duration) {
 	return
 }
 
+func (v *ScheduleSpec) GetCronExpressions() (o []string) {
+	if v != nil {
+		return v.CronExpressions
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetIntervals() (o []*ScheduleIntervalSpec) {
+	if v != nil {
+		return v.Intervals
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetExcludeCalendars() (o []*ScheduleCalendar) {
+	if v != nil {
+		return v.ExcludeCalendars
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetTimeZone() (o string) {
+	if v != nil {
+		return v.TimeZone
+	}
+	return
+}
+
+// ScheduleIntervalSpec fires every Interval, shifted by Offset from the Unix epoch,
+// e.g. Interval=90m and Offset=15m fires at 00:15, 01:45, 03:15 UTC and so on.
+type ScheduleIntervalSpec struct {
+	Interval time.Duration `json:"interval,omitempty"`
+	Offset   time.Duration `json:"offset,omitempty"`
+}
+
+func (v *ScheduleIntervalSpec) GetInterval() (o time.Duration) {
+	if v != nil {
+		return v.Interval
+	}
+	return
+}
+
+func (v *ScheduleIntervalSpec) GetOffset() (o time.Duration) {
+	if v != nil {
+		return v.Offset
+	}
+	return
+}
+
+// ScheduleCalendar matches whole days on which a schedule must not fire.
+// A day is matched if it is one of Dates (formatted as YYYY-MM-DD) or falls on one of DaysOfWeek.
+type ScheduleCalendar struct {
+	Name       string         `json:"name,omitempty"`
+	Dates      []string       `json:"dates,omitempty"`
+	DaysOfWeek []time.Weekday `json:"daysOfWeek,omitempty"`
+}
+
+func (v *ScheduleCalendar) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+func (v *ScheduleCalendar) GetDates() (o []string) {
+	if v != nil {
+		return v.Dates
+	}
+	return
+}
+
+func (v *ScheduleCalendar) GetDaysOfWeek() (o []time.Weekday) {
+	if v != nil {
+		return v.DaysOfWeek
+	}
+	return
+}
+
 // StartWorkflowAction defines a workflow to start when the schedule triggers.
 type StartWorkflowAction struct {
 	WorkflowType                        *WorkflowType     `json:"workflowType,omitempty"`
@@ -390,6 +532,10 @@ type ScheduleInfo struct {
 	CreateTime       time.Time       `json:"createTime,omitempty"`
 	LastUpdateTime   time.Time       `json:"lastUpdateTime,omitempty"`
 	OngoingBackfills []*BackfillInfo `json:"ongoingBackfills,omitempty"`
+	// FutureActionTimes previews the next fire times of an unpaused schedule
+	FutureActionTimes []time.Time `json:"futureActionTimes,omitempty"`
+	// RecentRuns holds the most recent fires of the schedule, oldest first
+	RecentRuns []*ScheduleRunRecord `json:"recentRuns,omitempty"`
 }
 
 func (v *ScheduleInfo) GetLastRunTime() (o time.Time) {
@@ -434,6 +580,81 @@ func (v *ScheduleInfo) GetOngoingBackfills() (o []*BackfillInfo) {
 	return
 }
 
+func (v *ScheduleInfo) GetFutureActionTimes() (o []time.Time) {
+	if v != nil {
+		return v.FutureActionTimes
+	}
+	return
+}
+
+func (v *ScheduleInfo) GetRecentRuns() (o []*ScheduleRunRecord) {
+	if v != nil {
+		return v.RecentRuns
+	}
+	return
+}
+
+// ScheduleRunRecord describes a single fire of a schedule. WorkflowID and RunID
+// identify the started workflow, or for a skipped fire the workflow it overlapped with.
+type ScheduleRunRecord struct {
+	ScheduledTime time.Time          `json:"scheduledTime,omitempty"`
+	ActualTime    time.Time          `json:"actualTime,omitempty"`
+	WorkflowID    string             `json:"workflowId,omitempty"`
+	RunID         string             `json:"runId,omitempty"`
+	IsBackfill    bool               `json:"isBackfill,omitempty"`
+	Outcome       ScheduleRunOutcome `json:"outcome,omitempty"`
+	Failure       string             `json:"failure,omitempty"`
+}
+
+func (v *ScheduleRunRecord) GetScheduledTime() (o time.Time) {
+	if v != nil {
+		return v.ScheduledTime
+	}
+	return
+}
+
+func (v *ScheduleRunRecord) GetActualTime() (o time.Time) {
+	if v != nil {
+		return v.ActualTime
+	}
+	return
+}
+
+func (v *ScheduleRunRecord) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *ScheduleRunRecord) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+func (v *ScheduleRunRecord) GetIsBackfill() (o bool) {
+	if v != nil {
+		return v.IsBackfill
+	}
+	return
+}
+
+func (v *ScheduleRunRecord) GetOutcome() (o ScheduleRunOutcome) {
+	if v != nil {
+		return v.Outcome
+	}
+	return
+}
+
+func (v *ScheduleRunRecord) GetFailure() (o string) {
+	if v != nil {
+		return v.Failure
+	}
+	return
+}
+
 func (v *StartWorkflowAction) GetInput() (o []byte) {
 	if v != nil {
 		return v.Input
@@ -175,3 +175,53 @@ func TestScheduleCatchUpPolicy_Ptr(t *testing.T) {
 	ptr := val.Ptr()
 	assert.Equal(t, &val, ptr)
 }
+
+func TestScheduleRunOutcome_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want ScheduleRunOutcome
+		err  bool
+	}{
+		{name: "invalid", text: "INVALID", want: ScheduleRunOutcomeInvalid},
+		{name: "started", text: "STARTED", want: ScheduleRunOutcomeStarted},
+		{name: "skipped", text: "SKIPPED", want: ScheduleRunOutcomeSkipped},
+		{name: "catch_up_skipped", text: "CATCH_UP_SKIPPED", want: ScheduleRunOutcomeCatchUpSkipped},
+		{name: "failed", text: "FAILED", want: ScheduleRunOutcomeFailed},
+		{name: "lowercase", text: "failed", want: ScheduleRunOutcomeFailed},
+		{name: "numeric", text: "1", want: ScheduleRunOutcome(1)},
+		{name: "unknown", text: "UNKNOWN", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ScheduleRunOutcome
+			err := got.UnmarshalText([]byte(tt.text))
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScheduleRunOutcome_RoundTrip(t *testing.T) {
+	for _, val := range []ScheduleRunOutcome{
+		ScheduleRunOutcomeInvalid,
+		ScheduleRunOutcomeStarted,
+		ScheduleRunOutcomeSkipped,
+		ScheduleRunOutcomeCatchUpSkipped,
+		ScheduleRunOutcomeFailed,
+	} {
+		b, err := val.MarshalText()
+		assert.NoError(t, err)
+		var got ScheduleRunOutcome
+		err = got.UnmarshalText(b)
+		assert.NoError(t, err)
+		assert.Equal(t, val, got)
+	}
+	b, err := ScheduleRunOutcome(99).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "ScheduleRunOutcome(99)", string(b))
+}
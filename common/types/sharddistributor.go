@@ -319,3 +319,209 @@ func (v *Shard) GetShardKey() (o string) {
 	}
 	return
 }
+
+type CordonExecutorRequest struct {
+	Namespace  string
+	ExecutorID string
+}
+
+func (v *CordonExecutorRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *CordonExecutorRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+type UncordonExecutorRequest struct {
+	Namespace  string
+	ExecutorID string
+}
+
+func (v *UncordonExecutorRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *UncordonExecutorRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+type DrainExecutorRequest struct {
+	Namespace  string
+	ExecutorID string
+	// MaxConcurrentMoves is the maximum number of shards of the executor being moved at the same time, 0 means no limit.
+	MaxConcurrentMoves int32
+}
+
+func (v *DrainExecutorRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *DrainExecutorRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+func (v *DrainExecutorRequest) GetMaxConcurrentMoves() (o int32) {
+	if v != nil {
+		return v.MaxConcurrentMoves
+	}
+	return
+}
+
+type PinShardRequest struct {
+	Namespace  string
+	ShardKey   string
+	ExecutorID string
+}
+
+func (v *PinShardRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *PinShardRequest) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+func (v *PinShardRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+type UnpinShardRequest struct {
+	Namespace string
+	ShardKey  string
+}
+
+func (v *UnpinShardRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *UnpinShardRequest) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+type DescribeNamespaceControlsRequest struct {
+	Namespace string
+}
+
+func (v *DescribeNamespaceControlsRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+type DescribeNamespaceControlsResponse struct {
+	Executors    []*ExecutorControl
+	PinnedShards []*ShardPin
+}
+
+func (v *DescribeNamespaceControlsResponse) GetExecutors() (o []*ExecutorControl) {
+	if v != nil {
+		return v.Executors
+	}
+	return
+}
+
+func (v *DescribeNamespaceControlsResponse) GetPinnedShards() (o []*ShardPin) {
+	if v != nil {
+		return v.PinnedShards
+	}
+	return
+}
+
+// ExecutorControl is the operator state of a cordoned or draining executor.
+type ExecutorControl struct {
+	ExecutorID         string
+	Cordoned           bool
+	Draining           bool
+	MaxConcurrentMoves int32
+	// AssignedShards is the number of shards still assigned to the executor.
+	AssignedShards int32
+}
+
+func (v *ExecutorControl) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+func (v *ExecutorControl) GetCordoned() (o bool) {
+	if v != nil {
+		return v.Cordoned
+	}
+	return
+}
+
+func (v *ExecutorControl) GetDraining() (o bool) {
+	if v != nil {
+		return v.Draining
+	}
+	return
+}
+
+func (v *ExecutorControl) GetMaxConcurrentMoves() (o int32) {
+	if v != nil {
+		return v.MaxConcurrentMoves
+	}
+	return
+}
+
+func (v *ExecutorControl) GetAssignedShards() (o int32) {
+	if v != nil {
+		return v.AssignedShards
+	}
+	return
+}
+
+type ShardPin struct {
+	ShardKey   string
+	ExecutorID string
+}
+
+func (v *ShardPin) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+func (v *ShardPin) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
@@ -36,6 +36,56 @@ type AccessDeniedError struct {
 	Message string `json:"message,required"`
 }
 
+// ActivityOptions is an internal type (TBD...)
+// Nil fields are left unchanged when used to update an activity.
+type ActivityOptions struct {
+	TaskList                      *TaskList    `json:"taskList,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32       `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32       `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32       `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ActivityOptions) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityOptions) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityOptions) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetHeartbeatTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityOptions) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+	return
+}
+
+// GetRetryPolicy is an internal getter (TBD...)
+func (v *ActivityOptions) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
+}
+
 // ActivityLocalDispatchInfo is an internal type (TBD...)
 type ActivityLocalDispatchInfo struct {
 	ActivityID                      string `json:"activityId,omitempty"`
@@ -153,6 +203,78 @@ func (v *ActivityTaskFailedEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
+// ActivityTaskOptionsUpdatedEventAttributes is an internal type (TBD...)
+type ActivityTaskOptionsUpdatedEventAttributes struct {
+	ScheduledEventID int64            `json:"scheduledEventId,omitempty"`
+	ActivityOptions  *ActivityOptions `json:"activityOptions,omitempty"`
+	Identity         string           `json:"identity,omitempty"`
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// GetActivityOptions is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetActivityOptions() (o *ActivityOptions) {
+	if v != nil && v.ActivityOptions != nil {
+		return v.ActivityOptions
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ActivityTaskOptionsUpdatedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// ActivityTaskPausedEventAttributes is an internal type (TBD...)
+type ActivityTaskPausedEventAttributes struct {
+	ScheduledEventID int64  `json:"scheduledEventId,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+	Identity         string `json:"identity,omitempty"`
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskPausedEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *ActivityTaskPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityTaskPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ActivityTaskPausedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // ActivityTaskScheduledEventAttributes is an internal type (TBD...)
 type ActivityTaskScheduledEventAttributes struct {
 	ActivityID                    string        `json:"activityId,omitempty"`
@@ -167,6 +289,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventID  int64         `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -201,6 +324,14 @@ func (v *ActivityTaskScheduledEventAttributes) GetTaskList() (o *TaskList) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
 func (v *ActivityTaskScheduledEventAttributes) GetScheduleToCloseTimeoutSeconds() (o int32) {
 	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
@@ -300,6 +431,51 @@ func (v *ActivityTaskTimedOutEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
+// ActivityTaskUnpausedEventAttributes is an internal type (TBD...)
+type ActivityTaskUnpausedEventAttributes struct {
+	ScheduledEventID      int64  `json:"scheduledEventId,omitempty"`
+	ResetAttempts         bool   `json:"resetAttempts,omitempty"`
+	ResetHeartbeatDetails bool   `json:"resetHeartbeatDetails,omitempty"`
+	Identity              string `json:"identity,omitempty"`
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskUnpausedEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// GetResetAttempts is an internal getter (TBD...)
+func (v *ActivityTaskUnpausedEventAttributes) GetResetAttempts() (o bool) {
+	if v != nil {
+		return v.ResetAttempts
+	}
+	return
+}
+
+// GetResetHeartbeatDetails is an internal getter (TBD...)
+func (v *ActivityTaskUnpausedEventAttributes) GetResetHeartbeatDetails() (o bool) {
+	if v != nil {
+		return v.ResetHeartbeatDetails
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityTaskUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ActivityTaskUnpausedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // ActivityType is an internal type (TBD...)
 type ActivityType struct {
 	Name string `json:"name,omitempty"`
@@ -806,6 +982,19 @@ func (v *ClusterReplicationConfiguration) ByteSize() uint64 {
 	return size
 }
 
+// CompatibleBuildIDSet is an internal type (TBD...)
+type CompatibleBuildIDSet struct {
+	BuildIDs []string `json:"buildIDs,omitempty"`
+}
+
+// GetBuildIDs is an internal getter (TBD...)
+func (v *CompatibleBuildIDSet) GetBuildIDs() (o []string) {
+	if v != nil && v.BuildIDs != nil {
+		return v.BuildIDs
+	}
+	return
+}
+
 // CompleteWorkflowExecutionDecisionAttributes is an internal type (TBD...)
 type CompleteWorkflowExecutionDecisionAttributes struct {
 	Result []byte `json:"result,omitempty"`
@@ -1055,6 +1244,7 @@ type Decision struct {
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
 	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
+	UpsertWorkflowMemoDecisionAttributes                     *UpsertWorkflowMemoDecisionAttributes                     `json:"upsertWorkflowMemoDecisionAttributes,omitempty"`
 }
 
 // GetDecisionType is an internal getter (TBD...)
@@ -1153,6 +1343,10 @@ func (e DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_I_D"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "UNKNOWN_UPDATE"
+	case 24:
+		return "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -1229,6 +1423,12 @@ func (e *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*e = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "UNKNOWN_UPDATE":
+		*e = DecisionTaskFailedCauseUnknownUpdate
+		return nil
+	case "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES":
+		*e = DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1291,6 +1491,10 @@ const (
 	DecisionTaskFailedCauseScheduleActivityDuplicateID
 	// DecisionTaskFailedCauseBadSearchAttributes is an option for DecisionTaskFailedCause
 	DecisionTaskFailedCauseBadSearchAttributes
+	// DecisionTaskFailedCauseUnknownUpdate is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseUnknownUpdate
+	// DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
 )
 
 // DecisionTaskFailedEventAttributes is an internal type (TBD...)
@@ -1402,6 +1606,7 @@ type DecisionTaskStartedEventAttributes struct {
 	ScheduledEventID int64  `json:"scheduledEventId,omitempty"`
 	Identity         string `json:"identity,omitempty"`
 	RequestID        string `json:"requestId,omitempty"`
+	WorkerBuildID    string `json:"workerBuildId,omitempty"`
 }
 
 // GetScheduledEventID is an internal getter (TBD...)
@@ -1420,6 +1625,14 @@ func (v *DecisionTaskStartedEventAttributes) GetRequestID() (o string) {
 	return
 }
 
+// GetWorkerBuildID is an internal getter (TBD...)
+func (v *DecisionTaskStartedEventAttributes) GetWorkerBuildID() (o string) {
+	if v != nil {
+		return v.WorkerBuildID
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *DecisionTaskStartedEventAttributes) ByteSize() uint64 {
 	return 0
@@ -1588,6 +1801,8 @@ func (e DecisionType) String() string {
 		return "SignalExternalWorkflowExecution"
 	case 12:
 		return "UpsertWorkflowSearchAttributes"
+	case 13:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -1634,6 +1849,9 @@ func (e *DecisionType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = DecisionTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = DecisionTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1676,6 +1894,8 @@ const (
 	DecisionTypeSignalExternalWorkflowExecution
 	// DecisionTypeUpsertWorkflowSearchAttributes is an option for DecisionType
 	DecisionTypeUpsertWorkflowSearchAttributes
+	// DecisionTypeUpsertWorkflowMemo is an option for DecisionType
+	DecisionTypeUpsertWorkflowMemo
 )
 
 // DeleteDomainRequest is an internal type (TBD...)
@@ -1692,6 +1912,77 @@ func (v *DeleteDomainRequest) GetName() (o string) {
 	return
 }
 
+// DeleteTaskListTasksRequest is an internal type (TBD...)
+type DeleteTaskListTasksRequest struct {
+	Domain        string        `json:"domain,omitempty"`
+	TaskList      *TaskList     `json:"taskList,omitempty"`
+	TaskListType  *TaskListType `json:"taskListType,omitempty"`
+	WorkflowID    string        `json:"workflowID,omitempty"`
+	RunID         string        `json:"runID,omitempty"`
+	CreatedBefore *int64        `json:"createdBefore,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteTaskListTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *DeleteTaskListTasksRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *DeleteTaskListTasksRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *DeleteTaskListTasksRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *DeleteTaskListTasksRequest) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetCreatedBefore is an internal getter (TBD...)
+func (v *DeleteTaskListTasksRequest) GetCreatedBefore() (o int64) {
+	if v != nil && v.CreatedBefore != nil {
+		return *v.CreatedBefore
+	}
+	return
+}
+
+// DeleteTaskListTasksResponse is an internal type (TBD...)
+type DeleteTaskListTasksResponse struct {
+	DeletedCount int64 `json:"deletedCount,omitempty"`
+}
+
+// GetDeletedCount is an internal getter (TBD...)
+func (v *DeleteTaskListTasksResponse) GetDeletedCount() (o int64) {
+	if v != nil {
+		return v.DeletedCount
+	}
+	return
+}
+
 // DeprecateDomainRequest is an internal type (TBD...)
 type DeprecateDomainRequest struct {
 	Name          string `json:"name,omitempty"`
@@ -3105,6 +3396,16 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "UpsertWorkflowMemo"
+	case 43:
+		return "ActivityTaskOptionsUpdated"
+	case 44:
+		return "WorkflowExecutionUpdateAccepted"
+	case 45:
+		return "ActivityTaskPaused"
+	case 46:
+		return "ActivityTaskUnpaused"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -3238,6 +3539,21 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = EventTypeUpsertWorkflowMemo
+		return nil
+	case "ACTIVITYTASKOPTIONSUPDATED":
+		*e = EventTypeActivityTaskOptionsUpdated
+		return nil
+	case "WORKFLOWEXECUTIONUPDATEACCEPTED":
+		*e = EventTypeWorkflowExecutionUpdateAccepted
+		return nil
+	case "ACTIVITYTASKPAUSED":
+		*e = EventTypeActivityTaskPaused
+		return nil
+	case "ACTIVITYTASKUNPAUSED":
+		*e = EventTypeActivityTaskUnpaused
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3338,6 +3654,16 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeUpsertWorkflowMemo is an option for EventType
+	EventTypeUpsertWorkflowMemo
+	// EventTypeActivityTaskOptionsUpdated is an option for EventType
+	EventTypeActivityTaskOptionsUpdated
+	// EventTypeWorkflowExecutionUpdateAccepted is an option for EventType
+	EventTypeWorkflowExecutionUpdateAccepted
+	// EventTypeActivityTaskPaused is an option for EventType
+	EventTypeActivityTaskPaused
+	// EventTypeActivityTaskUnpaused is an option for EventType
+	EventTypeActivityTaskUnpaused
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -3424,6 +3750,41 @@ func (v *GetSearchAttributesResponse) GetKeys() (o map[string]IndexedValueType)
 	return
 }
 
+// GetTaskListBuildIDCompatibilityRequest is an internal type (TBD...)
+type GetTaskListBuildIDCompatibilityRequest struct {
+	Domain   string    `json:"domain,omitempty"`
+	TaskList *TaskList `json:"taskList,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetTaskListBuildIDCompatibilityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *GetTaskListBuildIDCompatibilityRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListBuildIDCompatibilityResponse is an internal type (TBD...)
+type GetTaskListBuildIDCompatibilityResponse struct {
+	CompatibleSets []*CompatibleBuildIDSet `json:"compatibleSets,omitempty"`
+}
+
+// GetCompatibleSets is an internal getter (TBD...)
+func (v *GetTaskListBuildIDCompatibilityResponse) GetCompatibleSets() (o []*CompatibleBuildIDSet) {
+	if v != nil && v.CompatibleSets != nil {
+		return v.CompatibleSets
+	}
+	return
+}
+
 // GetWorkflowExecutionHistoryRequest is an internal type (TBD...)
 type GetWorkflowExecutionHistoryRequest struct {
 	Domain                 string                  `json:"domain,omitempty"`
@@ -3654,6 +4015,11 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	UpsertWorkflowMemoEventAttributes                              *UpsertWorkflowMemoEventAttributes                              `json:"upsertWorkflowMemoEventAttributes,omitempty"`
+	ActivityTaskOptionsUpdatedEventAttributes                      *ActivityTaskOptionsUpdatedEventAttributes                      `json:"activityTaskOptionsUpdatedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateAcceptedEventAttributes                 *WorkflowExecutionUpdateAcceptedEventAttributes                 `json:"workflowExecutionUpdateAcceptedEventAttributes,omitempty"`
+	ActivityTaskPausedEventAttributes                              *ActivityTaskPausedEventAttributes                              `json:"activityTaskPausedEventAttributes,omitempty"`
+	ActivityTaskUnpausedEventAttributes                            *ActivityTaskUnpausedEventAttributes                            `json:"activityTaskUnpausedEventAttributes,omitempty"`
 }
 
 // GetTimestamp is an internal getter (TBD...)
@@ -4008,6 +4374,46 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetUpsertWorkflowMemoEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetUpsertWorkflowMemoEventAttributes() (o *UpsertWorkflowMemoEventAttributes) {
+	if v != nil && v.UpsertWorkflowMemoEventAttributes != nil {
+		return v.UpsertWorkflowMemoEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskOptionsUpdatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskOptionsUpdatedEventAttributes() (o *ActivityTaskOptionsUpdatedEventAttributes) {
+	if v != nil && v.ActivityTaskOptionsUpdatedEventAttributes != nil {
+		return v.ActivityTaskOptionsUpdatedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUpdateAcceptedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateAcceptedEventAttributes() (o *WorkflowExecutionUpdateAcceptedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateAcceptedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskPausedEventAttributes() (o *ActivityTaskPausedEventAttributes) {
+	if v != nil && v.ActivityTaskPausedEventAttributes != nil {
+		return v.ActivityTaskPausedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskUnpausedEventAttributes() (o *ActivityTaskUnpausedEventAttributes) {
+	if v != nil && v.ActivityTaskUnpausedEventAttributes != nil {
+		return v.ActivityTaskUnpausedEventAttributes
+	}
+	return
+}
+
 // Size is an internal method to get the estimated size of the event
 func (v *HistoryEvent) ByteSize() uint64 {
 	if v == nil {
@@ -4184,6 +4590,23 @@ func (v *HistoryEvent) ByteSize() uint64 {
 		size += v.UpsertWorkflowSearchAttributesEventAttributes.ByteSize()
 	}
 
+	if v.UpsertWorkflowMemoEventAttributes != nil {
+		size += v.UpsertWorkflowMemoEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskOptionsUpdatedEventAttributes != nil {
+		size += v.ActivityTaskOptionsUpdatedEventAttributes.ByteSize()
+	}
+	if v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		size += v.WorkflowExecutionUpdateAcceptedEventAttributes.ByteSize()
+	}
+	if v.ActivityTaskPausedEventAttributes != nil {
+		size += v.ActivityTaskPausedEventAttributes.ByteSize()
+	}
+	if v.ActivityTaskUnpausedEventAttributes != nil {
+		size += v.ActivityTaskUnpausedEventAttributes.ByteSize()
+	}
+
 	return size
 }
 
@@ -4539,23 +4962,94 @@ type ListTaskListPartitionsResponse struct {
 	DecisionTaskListPartitions []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
 }
 
-// GetTaskListsByDomainRequest is an internal type (TBD...)
-type GetTaskListsByDomainRequest struct {
-	Domain string `json:"domain,omitempty"`
+// ListTaskListTasksRequest is an internal type (TBD...)
+type ListTaskListTasksRequest struct {
+	Domain        string        `json:"domain,omitempty"`
+	TaskList      *TaskList     `json:"taskList,omitempty"`
+	TaskListType  *TaskListType `json:"taskListType,omitempty"`
+	PageSize      int32         `json:"pageSize,omitempty"`
+	NextPageToken []byte        `json:"nextPageToken,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *GetTaskListsByDomainRequest) GetDomain() (o string) {
+func (v *ListTaskListTasksRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetTaskListsByDomainResponse is an internal type (TBD...)
-type GetTaskListsByDomainResponse struct {
-	DecisionTaskListMap map[string]*DescribeTaskListResponse `json:"decisionTaskListMap,omitempty"`
-	ActivityTaskListMap map[string]*DescribeTaskListResponse `json:"activityTaskListMap,omitempty"`
+// GetTaskList is an internal getter (TBD...)
+func (v *ListTaskListTasksRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *ListTaskListTasksRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *ListTaskListTasksRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListTaskListTasksRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListTaskListTasksResponse is an internal type (TBD...)
+type ListTaskListTasksResponse struct {
+	Tasks         []*TaskListTask `json:"tasks,omitempty"`
+	NextPageToken []byte          `json:"nextPageToken,omitempty"`
+}
+
+// GetTasks is an internal getter (TBD...)
+func (v *ListTaskListTasksResponse) GetTasks() (o []*TaskListTask) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListTaskListTasksResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// GetTaskListsByDomainRequest is an internal type (TBD...)
+type GetTaskListsByDomainRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetTaskListsByDomainRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskListsByDomainResponse is an internal type (TBD...)
+type GetTaskListsByDomainResponse struct {
+	DecisionTaskListMap map[string]*DescribeTaskListResponse `json:"decisionTaskListMap,omitempty"`
+	ActivityTaskListMap map[string]*DescribeTaskListResponse `json:"activityTaskListMap,omitempty"`
 }
 
 // GetDecisionTaskListMap is an internal getter (TBD...)
@@ -4720,6 +5214,59 @@ const (
 	ParentClosePolicyTerminate
 )
 
+// PauseActivityRequest is an internal type (TBD...)
+type PauseActivityRequest struct {
+	Domain     string             `json:"domain,omitempty"`
+	Execution  *WorkflowExecution `json:"execution,omitempty"`
+	ActivityID string             `json:"activityID,omitempty"`
+	Identity   string             `json:"identity,omitempty"`
+	Reason     string             `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// PauseActivityResponse is an internal type (TBD...)
+type PauseActivityResponse struct {
+}
+
 // PendingActivityInfo is an internal type (TBD...)
 type PendingActivityInfo struct {
 	ActivityID             string                `json:"activityID,omitempty"`
@@ -4737,6 +5284,7 @@ type PendingActivityInfo struct {
 	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
 	ScheduleID             int64                 `json:"scheduleID,omitempty"`
+	Paused                 bool                  `json:"paused,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -4827,6 +5375,14 @@ func (v *PendingActivityInfo) GetScheduleID() (o int64) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -5051,10 +5607,11 @@ func (v *PollForActivityTaskResponse) GetActivityID() (o string) {
 
 // PollForDecisionTaskRequest is an internal type (TBD...)
 type PollForDecisionTaskRequest struct {
-	Domain         string    `json:"domain,omitempty"`
-	TaskList       *TaskList `json:"taskList,omitempty"`
-	Identity       string    `json:"identity,omitempty"`
-	BinaryChecksum string    `json:"binaryChecksum,omitempty"`
+	Domain            string             `json:"domain,omitempty"`
+	TaskList          *TaskList          `json:"taskList,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	BinaryChecksum    string             `json:"binaryChecksum,omitempty"`
+	WorkerVersionInfo *WorkerVersionInfo `json:"workerVersionInfo,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5089,25 +5646,34 @@ func (v *PollForDecisionTaskRequest) GetBinaryChecksum() (o string) {
 	return
 }
 
+// GetWorkerVersionInfo is an internal getter (TBD...)
+func (v *PollForDecisionTaskRequest) GetWorkerVersionInfo() (o *WorkerVersionInfo) {
+	if v != nil && v.WorkerVersionInfo != nil {
+		return v.WorkerVersionInfo
+	}
+	return
+}
+
 // PollForDecisionTaskResponse is an internal type (TBD...)
 type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte                    `json:"taskToken,omitempty"`
-	WorkflowExecution         *WorkflowExecution        `json:"workflowExecution,omitempty"`
-	WorkflowType              *WorkflowType             `json:"workflowType,omitempty"`
-	PreviousStartedEventID    *int64                    `json:"previousStartedEventId,omitempty"`
-	StartedEventID            int64                     `json:"startedEventId,omitempty"`
-	Attempt                   int64                     `json:"attempt,omitempty"`
-	BacklogCountHint          int64                     `json:"backlogCountHint,omitempty"`
-	History                   *History                  `json:"history,omitempty"`
-	NextPageToken             []byte                    `json:"nextPageToken,omitempty"`
-	Query                     *WorkflowQuery            `json:"query,omitempty"`
-	WorkflowExecutionTaskList *TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
-	ScheduledTimestamp        *int64                    `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
-	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
-	NextEventID               int64                     `json:"nextEventId,omitempty"`
-	TotalHistoryBytes         int64                     `json:"historySize,omitempty"`
-	AutoConfigHint            *AutoConfigHint           `json:"autoConfigHint,omitempty"`
+	TaskToken                 []byte                     `json:"taskToken,omitempty"`
+	WorkflowExecution         *WorkflowExecution         `json:"workflowExecution,omitempty"`
+	WorkflowType              *WorkflowType              `json:"workflowType,omitempty"`
+	PreviousStartedEventID    *int64                     `json:"previousStartedEventId,omitempty"`
+	StartedEventID            int64                      `json:"startedEventId,omitempty"`
+	Attempt                   int64                      `json:"attempt,omitempty"`
+	BacklogCountHint          int64                      `json:"backlogCountHint,omitempty"`
+	History                   *History                   `json:"history,omitempty"`
+	NextPageToken             []byte                     `json:"nextPageToken,omitempty"`
+	Query                     *WorkflowQuery             `json:"query,omitempty"`
+	WorkflowExecutionTaskList *TaskList                  `json:"WorkflowExecutionTaskList,omitempty"`
+	ScheduledTimestamp        *int64                     `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp          *int64                     `json:"startedTimestamp,omitempty"`
+	Queries                   map[string]*WorkflowQuery  `json:"queries,omitempty"`
+	NextEventID               int64                      `json:"nextEventId,omitempty"`
+	TotalHistoryBytes         int64                      `json:"historySize,omitempty"`
+	AutoConfigHint            *AutoConfigHint            `json:"autoConfigHint,omitempty"`
+	Updates                   map[string]*WorkflowUpdate `json:"updates,omitempty"`
 }
 
 // GetTaskToken is an internal getter (TBD...)
@@ -5150,6 +5716,14 @@ func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*WorkflowQuery)
 	return
 }
 
+// GetUpdates is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetUpdates() (o map[string]*WorkflowUpdate) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
+	}
+	return
+}
+
 // GetNextEventID is an internal getter (TBD...)
 func (v *PollForDecisionTaskResponse) GetNextEventID() (o int64) {
 	if v != nil {
@@ -5170,6 +5744,7 @@ type PollerInfo struct {
 	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
 	Identity       string  `json:"identity,omitempty"`
 	RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+	BuildID        string  `json:"buildID,omitempty"`
 }
 
 // GetLastAccessTime is an internal getter (TBD...)
@@ -5196,6 +5771,14 @@ func (v *PollerInfo) GetRatePerSecond() (o float64) {
 	return
 }
 
+// GetBuildID is an internal getter (TBD...)
+func (v *PollerInfo) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
 // QueryConsistencyLevel is an internal type (TBD...)
 type QueryConsistencyLevel int32
 
@@ -6435,15 +7018,16 @@ func (v *RespondActivityTaskFailedRequest) ByteSize() uint64 {
 
 // RespondDecisionTaskCompletedRequest is an internal type (TBD...)
 type RespondDecisionTaskCompletedRequest struct {
-	TaskToken                  []byte                          `json:"taskToken,omitempty"`
-	Decisions                  []*Decision                     `json:"decisions,omitempty"`
-	ExecutionContext           []byte                          `json:"executionContext,omitempty"`
-	Identity                   string                          `json:"identity,omitempty"`
-	StickyAttributes           *StickyExecutionAttributes      `json:"stickyAttributes,omitempty"`
-	ReturnNewDecisionTask      bool                            `json:"returnNewDecisionTask,omitempty"`
-	ForceCreateNewDecisionTask bool                            `json:"forceCreateNewDecisionTask,omitempty"`
-	BinaryChecksum             string                          `json:"binaryChecksum,omitempty"`
-	QueryResults               map[string]*WorkflowQueryResult `json:"queryResults,omitempty"`
+	TaskToken                  []byte                           `json:"taskToken,omitempty"`
+	Decisions                  []*Decision                      `json:"decisions,omitempty"`
+	ExecutionContext           []byte                           `json:"executionContext,omitempty"`
+	Identity                   string                           `json:"identity,omitempty"`
+	StickyAttributes           *StickyExecutionAttributes       `json:"stickyAttributes,omitempty"`
+	ReturnNewDecisionTask      bool                             `json:"returnNewDecisionTask,omitempty"`
+	ForceCreateNewDecisionTask bool                             `json:"forceCreateNewDecisionTask,omitempty"`
+	BinaryChecksum             string                           `json:"binaryChecksum,omitempty"`
+	QueryResults               map[string]*WorkflowQueryResult  `json:"queryResults,omitempty"`
+	UpdateResults              map[string]*WorkflowUpdateResult `json:"updateResults,omitempty"`
 }
 
 // GetIdentity is an internal getter (TBD...)
@@ -6486,6 +7070,14 @@ func (v *RespondDecisionTaskCompletedRequest) GetQueryResults() (o map[string]*W
 	return
 }
 
+// GetUpdateResults is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetUpdateResults() (o map[string]*WorkflowUpdateResult) {
+	if v != nil && v.UpdateResults != nil {
+		return v.UpdateResults
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *RespondDecisionTaskCompletedRequest) ByteSize() uint64 {
 	return 0
@@ -6724,6 +7316,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -6750,6 +7343,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetDomain() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
 // GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
 func (v *ScheduleActivityTaskDecisionAttributes) GetScheduleToCloseTimeoutSeconds() (o int32) {
 	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
@@ -7457,6 +8058,7 @@ type StartWorkflowExecutionRequest struct {
 	FirstRunAtTimeStamp                 *int64                        `json:"firstRunAtTimeStamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	Priority                            int32                         `json:"priority,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7547,6 +8149,14 @@ func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // GetCronOverlapPolicy is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetCronOverlapPolicy() (o CronOverlapPolicy) {
 	if v != nil && v.CronOverlapPolicy != nil {
@@ -7753,14 +8363,15 @@ type IsolationGroupMetrics struct {
 
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
-	BacklogCountHint      int64                             `json:"backlogCountHint,omitempty"`
-	ReadLevel             int64                             `json:"readLevel,omitempty"`
-	AckLevel              int64                             `json:"ackLevel,omitempty"`
-	RatePerSecond         float64                           `json:"ratePerSecond,omitempty"`
-	TaskIDBlock           *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
-	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
-	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
-	Empty                 bool                              `json:"empty,omitempty"`
+	BacklogCountHint       int64                             `json:"backlogCountHint,omitempty"`
+	ReadLevel              int64                             `json:"readLevel,omitempty"`
+	AckLevel               int64                             `json:"ackLevel,omitempty"`
+	RatePerSecond          float64                           `json:"ratePerSecond,omitempty"`
+	TaskIDBlock            *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
+	IsolationGroupMetrics  map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
+	NewTasksPerSecond      float64                           `json:"newTasksPerSecond,omitempty"`
+	Empty                  bool                              `json:"empty,omitempty"`
+	BacklogCountByPriority map[int32]int64                   `json:"backlogCountByPriority,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -7803,6 +8414,90 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetBacklogCountByPriority is an internal getter (TBD...)
+func (v *TaskListStatus) GetBacklogCountByPriority() (o map[int32]int64) {
+	if v != nil && v.BacklogCountByPriority != nil {
+		return v.BacklogCountByPriority
+	}
+	return
+}
+
+// TaskListTask is an internal type (TBD...)
+type TaskListTask struct {
+	WorkflowID     string `json:"workflowID,omitempty"`
+	RunID          string `json:"runID,omitempty"`
+	TaskID         int64  `json:"taskID,omitempty"`
+	ScheduleID     int64  `json:"scheduleID,omitempty"`
+	CreatedTime    *int64 `json:"createdTime,omitempty"`
+	ExpiryTime     *int64 `json:"expiryTime,omitempty"`
+	IsolationGroup string `json:"isolationGroup,omitempty"`
+	Priority       int32  `json:"priority,omitempty"`
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *TaskListTask) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *TaskListTask) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *TaskListTask) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *TaskListTask) GetScheduleID() (o int64) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetCreatedTime is an internal getter (TBD...)
+func (v *TaskListTask) GetCreatedTime() (o int64) {
+	if v != nil && v.CreatedTime != nil {
+		return *v.CreatedTime
+	}
+	return
+}
+
+// GetExpiryTime is an internal getter (TBD...)
+func (v *TaskListTask) GetExpiryTime() (o int64) {
+	if v != nil && v.ExpiryTime != nil {
+		return *v.ExpiryTime
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *TaskListTask) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *TaskListTask) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -8059,6 +8754,130 @@ type TransientDecisionInfo struct {
 	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
 }
 
+// UnpauseActivityRequest is an internal type (TBD...)
+type UnpauseActivityRequest struct {
+	Domain                string             `json:"domain,omitempty"`
+	Execution             *WorkflowExecution `json:"execution,omitempty"`
+	ActivityID            string             `json:"activityID,omitempty"`
+	ResetAttempts         bool               `json:"resetAttempts,omitempty"`
+	ResetHeartbeatDetails bool               `json:"resetHeartbeatDetails,omitempty"`
+	Identity              string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetResetAttempts is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetResetAttempts() (o bool) {
+	if v != nil {
+		return v.ResetAttempts
+	}
+	return
+}
+
+// GetResetHeartbeatDetails is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetResetHeartbeatDetails() (o bool) {
+	if v != nil {
+		return v.ResetHeartbeatDetails
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UnpauseActivityResponse is an internal type (TBD...)
+type UnpauseActivityResponse struct {
+}
+
+// UpdateActivityOptionsRequest is an internal type (TBD...)
+type UpdateActivityOptionsRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	ActivityOptions   *ActivityOptions   `json:"activityOptions,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetActivityOptions is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetActivityOptions() (o *ActivityOptions) {
+	if v != nil && v.ActivityOptions != nil {
+		return v.ActivityOptions
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UpdateActivityOptionsResponse is an internal type (TBD...)
+type UpdateActivityOptionsResponse struct {
+	ActivityOptions *ActivityOptions `json:"activityOptions,omitempty"`
+}
+
+// GetActivityOptions is an internal getter (TBD...)
+func (v *UpdateActivityOptionsResponse) GetActivityOptions() (o *ActivityOptions) {
+	if v != nil && v.ActivityOptions != nil {
+		return v.ActivityOptions
+	}
+	return
+}
+
 // UpdateDomainRequest is an internal type (TBD...)
 type UpdateDomainRequest struct {
 	Name                                   string                             `json:"name,omitempty"`
@@ -8175,6 +8994,172 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// UpdateResultType is an internal type (TBD...)
+type UpdateResultType int32
+
+// Ptr is a helper function for getting pointer value
+func (e UpdateResultType) Ptr() *UpdateResultType {
+	return &e
+}
+
+// String returns a readable string representation of UpdateResultType.
+func (e UpdateResultType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "ACCEPTED"
+	case 1:
+		return "REJECTED"
+	}
+	return fmt.Sprintf("UpdateResultType(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *UpdateResultType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "ACCEPTED":
+		*e = UpdateResultTypeAccepted
+		return nil
+	case "REJECTED":
+		*e = UpdateResultTypeRejected
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "UpdateResultType", err)
+		}
+		*e = UpdateResultType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes UpdateResultType to text.
+func (e UpdateResultType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// UpdateResultTypeAccepted is an option for UpdateResultType
+	UpdateResultTypeAccepted UpdateResultType = iota
+	// UpdateResultTypeRejected is an option for UpdateResultType
+	UpdateResultTypeRejected
+)
+
+// UpdateTaskListBuildIDCompatibilityRequest is an internal type (TBD...)
+type UpdateTaskListBuildIDCompatibilityRequest struct {
+	Domain            string    `json:"domain,omitempty"`
+	TaskList          *TaskList `json:"taskList,omitempty"`
+	BuildID           string    `json:"buildID,omitempty"`
+	CompatibleBuildID string    `json:"compatibleBuildID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateTaskListBuildIDCompatibilityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *UpdateTaskListBuildIDCompatibilityRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetBuildID is an internal getter (TBD...)
+func (v *UpdateTaskListBuildIDCompatibilityRequest) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
+// GetCompatibleBuildID is an internal getter (TBD...)
+func (v *UpdateTaskListBuildIDCompatibilityRequest) GetCompatibleBuildID() (o string) {
+	if v != nil {
+		return v.CompatibleBuildID
+	}
+	return
+}
+
+// UpdateTaskListBuildIDCompatibilityResponse is an internal type (TBD...)
+type UpdateTaskListBuildIDCompatibilityResponse struct {
+	CompatibleSets []*CompatibleBuildIDSet `json:"compatibleSets,omitempty"`
+}
+
+// GetCompatibleSets is an internal getter (TBD...)
+func (v *UpdateTaskListBuildIDCompatibilityResponse) GetCompatibleSets() (o []*CompatibleBuildIDSet) {
+	if v != nil && v.CompatibleSets != nil {
+		return v.CompatibleSets
+	}
+	return
+}
+
+// UpdateWorkflowExecutionRequest is an internal type (TBD...)
+type UpdateWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Update            *WorkflowUpdate    `json:"update,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	UpdateID          string             `json:"updateId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetUpdate is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdate() (o *WorkflowUpdate) {
+	if v != nil && v.Update != nil {
+		return v.Update
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// UpdateWorkflowExecutionResponse is an internal type (TBD...)
+type UpdateWorkflowExecutionResponse struct {
+	Result []byte `json:"result,omitempty"`
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
 // UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
@@ -8207,6 +9192,38 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
+// UpsertWorkflowMemoDecisionAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoDecisionAttributes struct {
+	Memo *Memo `json:"memo,omitempty"`
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoDecisionAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// UpsertWorkflowMemoEventAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoEventAttributes struct {
+	DecisionTaskCompletedEventID int64 `json:"decisionTaskCompletedEventId,omitempty"`
+	Memo                         *Memo `json:"memo,omitempty"`
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *UpsertWorkflowMemoEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // VersionHistories is an internal type (TBD...)
 type VersionHistories struct {
 	CurrentVersionHistoryIndex int32             `json:"currentVersionHistoryIndex,omitempty"`
@@ -8280,6 +9297,7 @@ func (v *VersionHistoryItem) ByteSize() uint64 {
 type WorkerVersionInfo struct {
 	Impl           string `json:"impl,omitempty"`
 	FeatureVersion string `json:"featureVersion,omitempty"`
+	BuildID        string `json:"buildID,omitempty"`
 }
 
 // GetImpl is an internal getter (TBD...)
@@ -8298,6 +9316,14 @@ func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
 	return
 }
 
+// GetBuildID is an internal getter (TBD...)
+func (v *WorkerVersionInfo) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
 // WorkflowExecution is an internal type (TBD...)
 type WorkflowExecution struct {
 	WorkflowID string `json:"workflowId,omitempty"`
@@ -8844,6 +9870,8 @@ type WorkflowExecutionStartedEventAttributes struct {
 	RequestID                           string                        `json:"requestId,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	Priority                            int32                         `json:"priority,omitempty"`
+	FairnessKey                         string                        `json:"fairnessKey,omitempty"`
 }
 
 // GetParentWorkflowDomain is an internal getter (TBD...)
@@ -8989,6 +10017,22 @@ func (v *WorkflowExecutionStartedEventAttributes) GetRequestID() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *WorkflowExecutionStartedEventAttributes) ByteSize() uint64 {
 	return 0
@@ -9040,6 +10084,60 @@ func (v *WorkflowExecutionTimedOutEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
+// WorkflowExecutionUpdateAcceptedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateAcceptedEventAttributes struct {
+	UpdateID                     string          `json:"updateId,omitempty"`
+	DecisionTaskCompletedEventID int64           `json:"decisionTaskCompletedEventId,omitempty"`
+	Update                       *WorkflowUpdate `json:"update,omitempty"`
+	Result                       []byte          `json:"result,omitempty"`
+	Identity                     string          `json:"identity,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetUpdate is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdate() (o *WorkflowUpdate) {
+	if v != nil && v.Update != nil {
+		return v.Update
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // WorkflowIDReusePolicy is an internal type (TBD...)
 type WorkflowIDReusePolicy int32
 
@@ -9184,6 +10282,59 @@ func (v *WorkflowTypeFilter) GetName() (o string) {
 	return
 }
 
+// WorkflowUpdate is an internal type (TBD...)
+type WorkflowUpdate struct {
+	UpdateType string `json:"updateType,omitempty"`
+	UpdateArgs []byte `json:"updateArgs,omitempty"`
+}
+
+// GetUpdateType is an internal getter (TBD...)
+func (v *WorkflowUpdate) GetUpdateType() (o string) {
+	if v != nil {
+		return v.UpdateType
+	}
+	return
+}
+
+// GetUpdateArgs is an internal getter (TBD...)
+func (v *WorkflowUpdate) GetUpdateArgs() (o []byte) {
+	if v != nil && v.UpdateArgs != nil {
+		return v.UpdateArgs
+	}
+	return
+}
+
+// WorkflowUpdateResult is an internal type (TBD...)
+type WorkflowUpdateResult struct {
+	ResultType   *UpdateResultType `json:"resultType,omitempty"`
+	Result       []byte            `json:"result,omitempty"`
+	ErrorMessage string            `json:"errorMessage,omitempty"`
+}
+
+// GetResultType is an internal getter (TBD...)
+func (v *WorkflowUpdateResult) GetResultType() (o UpdateResultType) {
+	if v != nil && v.ResultType != nil {
+		return *v.ResultType
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *WorkflowUpdateResult) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *WorkflowUpdateResult) GetErrorMessage() (o string) {
+	if v != nil {
+		return v.ErrorMessage
+	}
+	return
+}
+
 // CrossClusterTaskType is an internal type (TBD...)
 type CrossClusterTaskType int32
 
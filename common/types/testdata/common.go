@@ -44,6 +44,8 @@ const (
 	MarkerName           = "MarkerName"
 	SignalName           = "SignalName"
 	QueryType            = "QueryType"
+	UpdateType           = "UpdateType"
+	UpdateID             = "UpdateID"
 	HostName             = "HostName"
 	HostName2            = "HostName2"
 	Identity             = "Identity"
@@ -55,6 +57,9 @@ const (
 	VisibilityQuery      = "VisibilityQuery"
 	FeatureVersion       = "FeatureVersion"
 	ClientImpl           = "ClientImpl"
+	BuildID              = "BuildID"
+	BuildID2             = "BuildID2"
+	FairnessKey          = "FairnessKey"
 	ClientLibraryVersion = "ClientLibraryVersion"
 	SupportedVersions    = "SupportedVersions"
 	FeatureFlag          = "FeatureFlag"
@@ -125,6 +130,7 @@ var (
 	TaskToken        = []byte{140, 0}
 	BranchToken      = []byte{150, 0}
 	QueryArgs        = []byte{9, 9, 9}
+	UpdateArgs       = []byte{8, 8, 8}
 )
 
 var (
@@ -164,6 +170,13 @@ var (
 		NonRetriableErrorReasons:    []string{"a", "b"},
 		ExpirationIntervalInSeconds: 4,
 	}
+	ActivityOptions = types.ActivityOptions{
+		TaskList:                      &TaskList,
+		ScheduleToCloseTimeoutSeconds: &Duration1,
+		StartToCloseTimeoutSeconds:    &Duration2,
+		HeartbeatTimeoutSeconds:       &Duration3,
+		RetryPolicy:                   &RetryPolicy,
+	}
 	Header = types.Header{
 		Fields: map[string][]byte{
 			"HeaderField1": {211, 0},
@@ -236,6 +249,12 @@ var (
 	PollerInfoArray = []*types.PollerInfo{
 		&PollerInfo,
 	}
+	CompatibleBuildIDSet = types.CompatibleBuildIDSet{
+		BuildIDs: []string{BuildID, BuildID2},
+	}
+	CompatibleBuildIDSetArray = []*types.CompatibleBuildIDSet{
+		&CompatibleBuildIDSet,
+	}
 	TaskListStatus = types.TaskListStatus{
 		BacklogCountHint: BacklogCountHint,
 		ReadLevel:        ReadLevel,
@@ -426,6 +445,21 @@ var (
 	WorkflowQueryResultMap = map[string]*types.WorkflowQueryResult{
 		"WorkflowQuery1": &WorkflowQueryResult,
 	}
+	WorkflowUpdate = types.WorkflowUpdate{
+		UpdateType: UpdateType,
+		UpdateArgs: UpdateArgs,
+	}
+	WorkflowUpdateMap = map[string]*types.WorkflowUpdate{
+		UpdateID: &WorkflowUpdate,
+	}
+	WorkflowUpdateResult = types.WorkflowUpdateResult{
+		ResultType:   &UpdateResultType,
+		Result:       Payload1,
+		ErrorMessage: ErrorMessage,
+	}
+	WorkflowUpdateResultMap = map[string]*types.WorkflowUpdateResult{
+		UpdateID: &WorkflowUpdateResult,
+	}
 	QueryRejected = types.QueryRejected{
 		CloseStatus: &WorkflowExecutionCloseStatus,
 	}
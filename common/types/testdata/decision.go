@@ -94,6 +94,10 @@ var (
 		DecisionType: types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
 		UpsertWorkflowSearchAttributesDecisionAttributes: &UpsertWorkflowSearchAttributesDecisionAttributes,
 	}
+	Decision_UpsertWorkflowMemo = types.Decision{
+		DecisionType:                         types.DecisionTypeUpsertWorkflowMemo.Ptr(),
+		UpsertWorkflowMemoDecisionAttributes: &UpsertWorkflowMemoDecisionAttributes,
+	}
 
 	CancelTimerDecisionAttributes = types.CancelTimerDecisionAttributes{
 		TimerID: TimerID,
@@ -189,4 +193,7 @@ var (
 	UpsertWorkflowSearchAttributesDecisionAttributes = types.UpsertWorkflowSearchAttributesDecisionAttributes{
 		SearchAttributes: &SearchAttributes,
 	}
+	UpsertWorkflowMemoDecisionAttributes = types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: &Memo,
+	}
 )
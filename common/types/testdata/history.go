@@ -246,6 +246,26 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_UpsertWorkflowMemo = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeUpsertWorkflowMemo.Ptr()
+		e.UpsertWorkflowMemoEventAttributes = &UpsertWorkflowMemoEventAttributes
+	})
+	HistoryEvent_ActivityTaskOptionsUpdated = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeActivityTaskOptionsUpdated.Ptr()
+		e.ActivityTaskOptionsUpdatedEventAttributes = &ActivityTaskOptionsUpdatedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateAccepted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateAccepted.Ptr()
+		e.WorkflowExecutionUpdateAcceptedEventAttributes = &WorkflowExecutionUpdateAcceptedEventAttributes
+	})
+	HistoryEvent_ActivityTaskPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeActivityTaskPaused.Ptr()
+		e.ActivityTaskPausedEventAttributes = &ActivityTaskPausedEventAttributes
+	})
+	HistoryEvent_ActivityTaskUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeActivityTaskUnpaused.Ptr()
+		e.ActivityTaskUnpausedEventAttributes = &ActivityTaskUnpausedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -584,6 +604,33 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	UpsertWorkflowMemoEventAttributes = types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: EventID1,
+		Memo:                         &Memo,
+	}
+	ActivityTaskOptionsUpdatedEventAttributes = types.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventID: EventID1,
+		ActivityOptions:  &ActivityOptions,
+		Identity:         Identity,
+	}
+	WorkflowExecutionUpdateAcceptedEventAttributes = types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:                     UpdateID,
+		DecisionTaskCompletedEventID: EventID1,
+		Update:                       &WorkflowUpdate,
+		Result:                       Payload1,
+		Identity:                     Identity,
+	}
+	ActivityTaskPausedEventAttributes = types.ActivityTaskPausedEventAttributes{
+		ScheduledEventID: EventID1,
+		Reason:           Reason,
+		Identity:         Identity,
+	}
+	ActivityTaskUnpausedEventAttributes = types.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventID:      EventID1,
+		ResetAttempts:         true,
+		ResetHeartbeatDetails: true,
+		Identity:              Identity,
+	}
 	GetFailoverInfoRequest = types.GetFailoverInfoRequest{
 		DomainID: uuid.NewUUID().String(),
 	}
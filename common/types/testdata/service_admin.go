@@ -212,6 +212,54 @@ var (
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
 	}
+	AdminPauseActivityRequest = types.PauseActivityRequest{
+		Domain:     DomainName,
+		Execution:  &WorkflowExecution,
+		ActivityID: ActivityID,
+		Identity:   Identity,
+		Reason:     Reason,
+	}
+	AdminUnpauseActivityRequest = types.UnpauseActivityRequest{
+		Domain:                DomainName,
+		Execution:             &WorkflowExecution,
+		ActivityID:            ActivityID,
+		ResetAttempts:         true,
+		ResetHeartbeatDetails: true,
+		Identity:              Identity,
+	}
+	AdminListTaskListTasksRequest = types.ListTaskListTasksRequest{
+		Domain:        DomainName,
+		TaskList:      &TaskList,
+		TaskListType:  &TaskListType,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	AdminListTaskListTasksResponse = types.ListTaskListTasksResponse{
+		Tasks: []*types.TaskListTask{
+			{
+				WorkflowID:     WorkflowID,
+				RunID:          RunID,
+				TaskID:         TaskID,
+				ScheduleID:     ScheduleID,
+				CreatedTime:    &Timestamp,
+				ExpiryTime:     &Timestamp1,
+				IsolationGroup: IsolationGroup,
+				Priority:       1,
+			},
+		},
+		NextPageToken: NextPageToken,
+	}
+	AdminDeleteTaskListTasksRequest = types.DeleteTaskListTasksRequest{
+		Domain:        DomainName,
+		TaskList:      &TaskList,
+		TaskListType:  &TaskListType,
+		WorkflowID:    WorkflowID,
+		RunID:         RunID,
+		CreatedBefore: &Timestamp,
+	}
+	AdminDeleteTaskListTasksResponse = types.DeleteTaskListTasksResponse{
+		DeletedCount: 3,
+	}
 	AdminRemoveTaskRequest = types.RemoveTaskRequest{
 		ShardID:             ShardID,
 		Type:                common.Int32Ptr(QueueType),
@@ -296,6 +296,26 @@ var (
 		Details:    FailureDetails,
 		Identity:   Identity,
 	}
+	UpdateActivityOptionsRequest = types.UpdateActivityOptionsRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		ActivityOptions:   &ActivityOptions,
+		Identity:          Identity,
+	}
+	UpdateActivityOptionsResponse = types.UpdateActivityOptionsResponse{
+		ActivityOptions: &ActivityOptions,
+	}
+	UpdateWorkflowExecutionRequest = types.UpdateWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Update:            &WorkflowUpdate,
+		Identity:          Identity,
+		UpdateID:          UpdateID,
+	}
+	UpdateWorkflowExecutionResponse = types.UpdateWorkflowExecutionResponse{
+		Result: Payload1,
+	}
 	RespondActivityTaskCanceledRequest = types.RespondActivityTaskCanceledRequest{
 		TaskToken: TaskToken,
 		Details:   Payload1,
@@ -473,6 +493,22 @@ var (
 		ActivityTaskListPartitions: TaskListPartitionMetadataArray,
 		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
 	}
+	UpdateTaskListBuildIDCompatibilityRequest = types.UpdateTaskListBuildIDCompatibilityRequest{
+		Domain:            DomainName,
+		TaskList:          &TaskList,
+		BuildID:           BuildID2,
+		CompatibleBuildID: BuildID,
+	}
+	UpdateTaskListBuildIDCompatibilityResponse = types.UpdateTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: CompatibleBuildIDSetArray,
+	}
+	GetTaskListBuildIDCompatibilityRequest = types.GetTaskListBuildIDCompatibilityRequest{
+		Domain:   DomainName,
+		TaskList: &TaskList,
+	}
+	GetTaskListBuildIDCompatibilityResponse = types.GetTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: CompatibleBuildIDSetArray,
+	}
 	ResetStickyTaskListRequest = types.ResetStickyTaskListRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -174,11 +174,28 @@ var (
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
 		HistorySize:               HistorySizeInBytes,
+		Updates:                   WorkflowUpdateMap,
 	}
 	HistoryRefreshWorkflowTasksRequest = types.HistoryRefreshWorkflowTasksRequest{
 		DomainUIID: DomainID,
 		Request:    &AdminRefreshWorkflowTasksRequest,
 	}
+	HistoryPauseActivityRequest = types.HistoryPauseActivityRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminPauseActivityRequest,
+	}
+	HistoryUnpauseActivityRequest = types.HistoryUnpauseActivityRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminUnpauseActivityRequest,
+	}
+	HistoryUpdateActivityOptionsRequest = types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: DomainID,
+		Request:    &UpdateActivityOptionsRequest,
+	}
+	HistoryUpdateWorkflowExecutionRequest = types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: DomainID,
+		Request:    &UpdateWorkflowExecutionRequest,
+	}
 	HistoryRemoveSignalMutableStateRequest = types.RemoveSignalMutableStateRequest{
 		DomainUUID:        DomainID,
 		WorkflowExecution: &WorkflowExecution,
@@ -244,6 +261,7 @@ var (
 		DomainUUID:             DomainID,
 		SignalWithStartRequest: &SignalWithStartWorkflowExecutionRequest,
 		PartitionConfig:        PartitionConfig,
+		FairnessKey:            FairnessKey,
 	}
 	HistorySignalWithStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -266,6 +284,7 @@ var (
 		LastCompletionResult:            Payload1,
 		FirstDecisionTaskBackoffSeconds: &Duration1,
 		PartitionConfig:                 PartitionConfig,
+		FairnessKey:                     FairnessKey,
 	}
 	HistoryStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
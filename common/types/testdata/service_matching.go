@@ -61,6 +61,8 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      3,
+		FairnessKey:                   FairnessKey,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -71,6 +73,9 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      3,
+		WorkerBuildID:                 BuildID,
+		FairnessKey:                   FairnessKey,
 	}
 	MatchingAddActivityTaskResponse = types.AddActivityTaskResponse{
 		PartitionConfig: &TaskListPartitionConfig,
@@ -101,6 +106,22 @@ var (
 		ActivityTaskListPartitions: TaskListPartitionMetadataArray,
 		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
 	}
+	MatchingListTaskListTasksRequest = types.MatchingListTaskListTasksRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminListTaskListTasksRequest,
+	}
+	MatchingDeleteTaskListTasksRequest = types.MatchingDeleteTaskListTasksRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminDeleteTaskListTasksRequest,
+	}
+	MatchingUpdateTaskListBuildIDCompatibilityRequest = types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+		DomainUUID: DomainID,
+		Request:    &UpdateTaskListBuildIDCompatibilityRequest,
+	}
+	MatchingGetTaskListBuildIDCompatibilityRequest = types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: DomainID,
+		Request:    &GetTaskListBuildIDCompatibilityRequest,
+	}
 	MatchingPollForActivityTaskRequest = types.MatchingPollForActivityTaskRequest{
 		DomainUUID:     DomainID,
 		PollerID:       PollerID,
@@ -157,6 +178,7 @@ var (
 		PartitionConfig:           &TaskListPartitionConfig,
 		LoadBalancerHints:         &LoadBalancerHints,
 		AutoConfigHint:            &AutoConfigHint,
+		Updates:                   WorkflowUpdateMap,
 	}
 	MatchingQueryWorkflowRequest = types.MatchingQueryWorkflowRequest{
 		DomainUUID:    DomainID,
@@ -80,4 +80,48 @@ var (
 			},
 		},
 	}
+	ShardDistributorCordonExecutorRequest = types.CordonExecutorRequest{
+		Namespace:  "namespace",
+		ExecutorID: "executor-id",
+	}
+	ShardDistributorUncordonExecutorRequest = types.UncordonExecutorRequest{
+		Namespace:  "namespace",
+		ExecutorID: "executor-id",
+	}
+	ShardDistributorDrainExecutorRequest = types.DrainExecutorRequest{
+		Namespace:          "namespace",
+		ExecutorID:         "executor-id",
+		MaxConcurrentMoves: 5,
+	}
+	ShardDistributorPinShardRequest = types.PinShardRequest{
+		Namespace:  "namespace",
+		ShardKey:   "shard-key",
+		ExecutorID: "executor-id",
+	}
+	ShardDistributorUnpinShardRequest = types.UnpinShardRequest{
+		Namespace: "namespace",
+		ShardKey:  "shard-key",
+	}
+	ShardDistributorDescribeNamespaceControlsRequest = types.DescribeNamespaceControlsRequest{
+		Namespace: "namespace",
+	}
+	ShardDistributorDescribeNamespaceControlsResponse = types.DescribeNamespaceControlsResponse{
+		Executors: []*types.ExecutorControl{
+			{
+				ExecutorID:     "executor-1",
+				Cordoned:       true,
+				AssignedShards: 3,
+			},
+			{
+				ExecutorID:         "executor-2",
+				Cordoned:           true,
+				Draining:           true,
+				MaxConcurrentMoves: 5,
+				AssignedShards:     1,
+			},
+		},
+		PinnedShards: []*types.ShardPin{
+			{ShardKey: "shard-1", ExecutorID: "executor-3"},
+		},
+	}
 )
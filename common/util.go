@@ -476,6 +476,7 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *types.RecordDeci
 		ScheduledTimestamp:        historyResponse.ScheduledTimestamp,
 		StartedTimestamp:          historyResponse.StartedTimestamp,
 		Queries:                   historyResponse.Queries,
+		Updates:                   historyResponse.Updates,
 		TotalHistoryBytes:         historyResponse.HistorySize,
 	}
 	if historyResponse.GetPreviousStartedEventID() != constants.EmptyEventID {
@@ -520,11 +521,13 @@ func CreateHistoryStartWorkflowRequest(
 	startRequest *types.StartWorkflowExecutionRequest,
 	now time.Time,
 	partitionConfig map[string]string,
+	fairnessKey string,
 ) (*types.HistoryStartWorkflowExecutionRequest, error) {
 	histRequest := &types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:      domainID,
 		StartRequest:    startRequest,
 		PartitionConfig: partitionConfig,
+		FairnessKey:     fairnessKey,
 	}
 
 	delayStartSeconds := startRequest.GetDelayStartSeconds()
@@ -808,6 +811,13 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeUpsertWorkflowMemo:
+		if event.UpsertWorkflowMemoEventAttributes.Memo != nil {
+			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowMemoEventAttributes.Memo.Fields)
+		}
+	case types.EventTypeWorkflowExecutionUpdateAccepted:
+		res += len(event.WorkflowExecutionUpdateAcceptedEventAttributes.Update.GetUpdateArgs())
+		res += len(event.WorkflowExecutionUpdateAcceptedEventAttributes.Result)
 	}
 	return uint64(res)
 }
@@ -192,7 +192,7 @@ func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithCron(t *testing.T)
 		CronSchedule: "@every 300s",
 	}
 	now := time.Now()
-	startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, now, nil)
+	startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, now, nil, "")
 	require.NoError(t, err)
 	require.NotNil(t, startRequest)
 
@@ -270,7 +270,7 @@ func TestFirstDecisionTaskBackoffDuringStartWorkflow(t *testing.T) {
 			for i := 0; i < caseCount; i++ {
 				// Start at the minute boundary so we know what the backoff should be
 				startTime, _ := time.Parse(time.RFC3339, "2018-12-17T08:00:00+00:00")
-				startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, startTime, nil)
+				startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, startTime, nil, "")
 				require.NoError(t, err)
 				require.NotNil(t, startRequest)
 
@@ -356,7 +356,7 @@ func testExpirationTime(t *testing.T, delayStartSeconds int, cronSeconds int, ji
 	minDelay := delayStartSeconds + cronSeconds
 	maxDelay := delayStartSeconds + 2*cronSeconds + jitterSeconds
 	now := time.Now()
-	startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, now, nil)
+	startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, now, nil, "")
 	require.NoError(t, err)
 	require.NotNil(t, startRequest)
 
@@ -392,7 +392,7 @@ func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithoutCron(t *testing.
 		},
 	}
 	now := time.Now()
-	startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, now, nil)
+	startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, now, nil, "")
 	require.NoError(t, err)
 	require.NotNil(t, startRequest)
 
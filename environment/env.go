@@ -75,6 +75,17 @@ const (
 	// MongoDefaultPort is Mongo default port
 	MongoDefaultPort = "27017"
 
+	// DynamoDBSeeds env
+	DynamoDBSeeds = "DYNAMODB_SEEDS"
+	// DynamoDBPort env
+	DynamoDBPort = "DYNAMODB_PORT"
+	// DynamoDBDefaultPort is DynamoDB Local default port
+	DynamoDBDefaultPort = "8000"
+	// DynamoDBRegion env
+	DynamoDBRegion = "DYNAMODB_REGION"
+	// DynamoDBDefaultRegion is the region to sign the requests to DynamoDB Local
+	DynamoDBDefaultRegion = "us-east-1"
+
 	// KafkaSeeds env
 	KafkaSeeds = "KAFKA_SEEDS"
 	// KafkaPort env
@@ -277,6 +288,34 @@ func GetMongoPort() (int, error) {
 	return strconv.Atoi(port)
 }
 
+// GetDynamoDBAddress return the DynamoDB address
+func GetDynamoDBAddress() string {
+	addr := os.Getenv(DynamoDBSeeds)
+	if addr == "" {
+		addr = Localhost
+	}
+	return addr
+}
+
+// GetDynamoDBPort return the DynamoDB port
+func GetDynamoDBPort() (int, error) {
+	port := os.Getenv(DynamoDBPort)
+	if port == "" {
+		port = DynamoDBDefaultPort
+	}
+
+	return strconv.Atoi(port)
+}
+
+// GetDynamoDBRegion return the DynamoDB region
+func GetDynamoDBRegion() string {
+	region := os.Getenv(DynamoDBRegion)
+	if region == "" {
+		region = DynamoDBDefaultRegion
+	}
+	return region
+}
+
 func setEnv(key string, val string) error {
 	if err := os.Setenv(key, val); err != nil {
 		return fmt.Errorf("setting env %q: %w", key, err)
@@ -673,6 +673,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]membership.HostInfo, star
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for frontend", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjectionRules = getFaultInjectionRules(params)
 
 	if c.pinotConfig != nil {
 		pinotDataStoreName := "pinot-visibility"
@@ -754,6 +755,7 @@ func (c *cadenceImpl) startHistory(hosts map[string][]membership.HostInfo, start
 		if err != nil {
 			c.logger.Fatal("Failed to copy persistence config for history", tag.Error(err))
 		}
+		params.PersistenceConfig.FaultInjectionRules = getFaultInjectionRules(params)
 
 		if c.pinotConfig != nil {
 			pinotDataStoreName := "pinot-visibility"
@@ -835,6 +837,7 @@ func (c *cadenceImpl) startMatching(hosts map[string][]membership.HostInfo, star
 		if err != nil {
 			c.logger.Fatal("Failed to copy persistence config for matching", tag.Error(err))
 		}
+		params.PersistenceConfig.FaultInjectionRules = getFaultInjectionRules(params)
 
 		if c.historyConfig.MockClient != nil {
 			params.HistoryClientFn = func() historyClient.Client {
@@ -907,6 +910,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]membership.HostInfo, startW
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for worker", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjectionRules = getFaultInjectionRules(params)
 	params.PublicClient = newPublicClient(params.RPCFactory.GetDispatcher())
 	service := NewService(params)
 	service.Start()
@@ -1192,6 +1196,12 @@ func (vm *versionMiddleware) Handle(ctx context.Context, req *transport.Request,
 	return h.Handle(ctx, req, resw)
 }
 
+// getFaultInjectionRules reads persistence fault injection rules from the service's dynamic config,
+// so that tests and simulations can inject persistence faults through their dynamic config files.
+func getFaultInjectionRules(params *resource.Params) dynamicproperties.ListPropertyFn {
+	return dynamicconfig.NewCollection(params.DynamicConfig, params.Logger).GetListProperty(dynamicproperties.PersistenceFaultInjectionRules)
+}
+
 func getFromDynamicConfig(params *resource.Params) func() []string {
 	return func() []string {
 		list, err := params.DynamicConfig.GetListValue(dynamicproperties.AllIsolationGroups, nil)
@@ -20,17 +20,51 @@
 
 package cadence
 
+import "time"
+
 // below are the names of all mongoDB collections
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName                = "cluster_config"
+	ShardCollectionName                        = "shard"
+	CurrentWorkflowCollectionName              = "current_workflow"
+	WorkflowExecutionCollectionName            = "workflow_execution"
+	WorkflowRequestCollectionName              = "workflow_request"
+	ActiveClusterSelectionPolicyCollectionName = "active_cluster_selection_policy"
+	HistoryTaskCollectionName                  = "history_task"
+	HistoryTreeCollectionName                  = "history_tree"
+	HistoryNodeCollectionName                  = "history_node"
+	TaskListCollectionName                     = "task_list"
+	TaskCollectionName                         = "task"
+	QueueMessageCollectionName                 = "queue_message"
+	QueueMetadataCollectionName                = "queue_metadata"
+	DomainCollectionName                       = "domain"
+	DomainMetadataCollectionName               = "domain_metadata"
+	DomainAuditLogCollectionName               = "domain_audit_log"
+	VisibilityCollectionName                   = "visibility"
+	SchemaVersionCollectionName                = "schema_version"
+	SchemaUpdateHistoryCollectionName          = "schema_update_history"
+)
+
+// below are the values of HistoryTaskCollectionEntry.Category
+const (
+	HistoryTaskCategoryTransfer       = "transfer"
+	HistoryTaskCategoryTimer          = "timer"
+	HistoryTaskCategoryReplication    = "replication"
+	HistoryTaskCategoryReplicationDLQ = "replicationdlq"
+	HistoryTaskCategoryCrossCluster   = "crosscluster"
 )
 
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
 
 // NOTE2: MongoDB doesn't allow using camel case or underscore in the field names
 
+// NOTE3: the fields that are not used in any filter or index are "non-significant" and are stored as JSON payloads,
+// see NOTE 2 of nosqlplugin.tableCRUD. Timestamps that take part in ordering are stored as unix nanoseconds, because
+// BSON dates only have millisecond precision. expireat fields are BSON dates for the TTL indexes.
+
+// IMPORTANT: making change to the structs below is changing the MongoDB collection schema. Please make sure it's backward compatible(e.g., don't delete the field, or change the annotation value).
+
 // ClusterConfigCollectionEntry is the schema of configStore
-// IMPORTANT: making change to this struct is changing the MongoDB collection schema. Please make sure it's backward compatible(e.g., don't delete the field, or change the annotation value).
 type ClusterConfigCollectionEntry struct {
 	ID                   int    `json:"_id,omitempty"`
 	RowType              int    `json:"rowtype"`
@@ -39,3 +73,204 @@ type ClusterConfigCollectionEntry struct {
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
 }
+
+// ShardCollectionEntry is the schema of shard, txncounter is bumped by every transaction that is fenced by the shard
+type ShardCollectionEntry struct {
+	ShardID      int    `bson:"_id"`
+	RangeID      int64  `bson:"rangeid"`
+	Info         []byte `bson:"info"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	TxnCounter   int64  `bson:"txncounter"`
+}
+
+// CurrentWorkflowCollectionEntry is the schema of the current workflow of a workflowID
+type CurrentWorkflowCollectionEntry struct {
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	CreateRequestID  string `bson:"createrequestid"`
+	State            int    `bson:"state"`
+	CloseStatus      int    `bson:"closestatus"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+	CreatedTime      int64  `bson:"createdtime"`
+}
+
+// WorkflowExecutionCollectionEntry is the schema of a workflow execution(mutable state).
+// The keys of the maps are escaped, as MongoDB field names cannot contain '.' or start with '$'.
+type WorkflowExecutionCollectionEntry struct {
+	ShardID             int               `bson:"shardid"`
+	DomainID            string            `bson:"domainid"`
+	WorkflowID          string            `bson:"workflowid"`
+	RunID               string            `bson:"runid"`
+	NextEventID         int64             `bson:"nexteventid"`
+	State               int               `bson:"state"`
+	CloseStatus         int               `bson:"closestatus"`
+	LastWriteVersion    int64             `bson:"lastwriteversion"`
+	CreateRequestID     string            `bson:"createrequestid"`
+	ExecutionInfo       []byte            `bson:"executioninfo"`
+	VersionHistories    []byte            `bson:"versionhistories"`
+	Checksum            []byte            `bson:"checksum"`
+	ActivityInfos       map[string][]byte `bson:"activityinfos"`
+	TimerInfos          map[string][]byte `bson:"timerinfos"`
+	ChildExecutionInfos map[string][]byte `bson:"childexecutioninfos"`
+	RequestCancelInfos  map[string][]byte `bson:"requestcancelinfos"`
+	SignalInfos         map[string][]byte `bson:"signalinfos"`
+	SignalRequestedIDs  map[string]bool   `bson:"signalrequestedids"`
+	BufferedEvents      [][]byte          `bson:"bufferedevents"`
+}
+
+// WorkflowRequestCollectionEntry is the schema of the requests that are deduplicated
+type WorkflowRequestCollectionEntry struct {
+	ShardID     int       `bson:"shardid"`
+	RequestType int       `bson:"requesttype"`
+	DomainID    string    `bson:"domainid"`
+	WorkflowID  string    `bson:"workflowid"`
+	RequestID   string    `bson:"requestid"`
+	RunID       string    `bson:"runid"`
+	Version     int64     `bson:"version"`
+	CreatedTime int64     `bson:"createdtime"`
+	ExpireAt    time.Time `bson:"expireat"`
+}
+
+// ActiveClusterSelectionPolicyCollectionEntry is the schema of the active cluster selection policy of a workflow
+type ActiveClusterSelectionPolicyCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	DomainID     string `bson:"domainid"`
+	WorkflowID   string `bson:"workflowid"`
+	RunID        string `bson:"runid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	CreatedTime  int64  `bson:"createdtime"`
+}
+
+// HistoryTaskCollectionEntry is the schema of the history tasks of all categories,
+// cluster is the source cluster of DLQ tasks and the target cluster of cross cluster tasks, empty otherwise
+type HistoryTaskCollectionEntry struct {
+	ShardID             int    `bson:"shardid"`
+	Category            string `bson:"category"`
+	Cluster             string `bson:"cluster"`
+	VisibilityTimestamp int64  `bson:"visibilityts"`
+	TaskID              int64  `bson:"taskid"`
+	Task                []byte `bson:"task"`
+	Data                []byte `bson:"data"`
+	DataEncoding        string `bson:"dataencoding"`
+}
+
+// HistoryTreeCollectionEntry is the schema of a history branch
+type HistoryTreeCollectionEntry struct {
+	TreeID      string `bson:"treeid"`
+	BranchID    string `bson:"branchid"`
+	Ancestors   []byte `bson:"ancestors"`
+	Info        string `bson:"info"`
+	CreatedTime int64  `bson:"createdtime"`
+}
+
+// HistoryNodeCollectionEntry is the schema of a batch of history events
+type HistoryNodeCollectionEntry struct {
+	TreeID       string `bson:"treeid"`
+	BranchID     string `bson:"branchid"`
+	NodeID       int64  `bson:"nodeid"`
+	TxnID        int64  `bson:"txnid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	CreatedTime  int64  `bson:"createdtime"`
+}
+
+// TaskListCollectionEntry is the schema of a tasklist, txncounter is bumped by every insertion of tasks
+type TaskListCollectionEntry struct {
+	DomainID                string     `bson:"domainid"`
+	TaskListName            string     `bson:"tasklistname"`
+	TaskListType            int        `bson:"tasklisttype"`
+	RangeID                 int64      `bson:"rangeid"`
+	TaskListKind            int        `bson:"tasklistkind"`
+	AckLevel                int64      `bson:"acklevel"`
+	LastUpdatedTime         int64      `bson:"lastupdatedtime"`
+	AdaptivePartitionConfig []byte     `bson:"adaptivepartitionconfig"`
+	CompatibleBuildIDSets   [][]string `bson:"compatiblebuildidsets,omitempty"`
+	TxnCounter              int64      `bson:"txncounter"`
+	ExpireAt                *time.Time `bson:"expireat,omitempty"`
+}
+
+// TaskCollectionEntry is the schema of a matching task
+type TaskCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	TaskListName string     `bson:"tasklistname"`
+	TaskListType int        `bson:"tasklisttype"`
+	TaskID       int64      `bson:"taskid"`
+	Task         []byte     `bson:"task"`
+	ExpireAt     *time.Time `bson:"expireat,omitempty"`
+}
+
+// QueueMessageCollectionEntry is the schema of a message of the replication queues
+type QueueMessageCollectionEntry struct {
+	QueueType   int    `bson:"queuetype"`
+	MessageID   int64  `bson:"messageid"`
+	Payload     []byte `bson:"payload"`
+	CreatedTime int64  `bson:"createdtime"`
+}
+
+// QueueMetadataCollectionEntry is the schema of the metadata of a queue
+type QueueMetadataCollectionEntry struct {
+	QueueType        int    `bson:"_id"`
+	ClusterAckLevels []byte `bson:"clusteracklevels"`
+	Version          int64  `bson:"version"`
+	CreatedTime      int64  `bson:"createdtime"`
+	UpdatedTime      int64  `bson:"updatedtime"`
+}
+
+// DomainCollectionEntry is the schema of a domain
+type DomainCollectionEntry struct {
+	Name                string `bson:"_id"`
+	DomainID            string `bson:"domainid"`
+	NotificationVersion int64  `bson:"notificationversion"`
+	Domain              []byte `bson:"domain"`
+}
+
+// DomainMetadataCollectionEntry is the schema of the single document that holds the notification version of domains
+type DomainMetadataCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	NotificationVersion int64  `bson:"notificationversion"`
+}
+
+// DomainAuditLogCollectionEntry is the schema of an audit log entry of a domain operation
+type DomainAuditLogCollectionEntry struct {
+	DomainID      string     `bson:"domainid"`
+	OperationType int        `bson:"operationtype"`
+	CreatedTime   int64      `bson:"createdtime"`
+	EventID       string     `bson:"eventid"`
+	AuditLog      []byte     `bson:"auditlog"`
+	ExpireAt      *time.Time `bson:"expireat,omitempty"`
+}
+
+// VisibilityCollectionEntry is the schema of a visibility record, closetime is only set when closed is true
+type VisibilityCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	WorkflowID   string     `bson:"workflowid"`
+	RunID        string     `bson:"runid"`
+	WorkflowType string     `bson:"workflowtype"`
+	Closed       bool       `bson:"closed"`
+	StartTime    int64      `bson:"starttime"`
+	CloseTime    int64      `bson:"closetime"`
+	CloseStatus  int        `bson:"closestatus"`
+	Visibility   []byte     `bson:"visibility"`
+	ExpireAt     *time.Time `bson:"expireat,omitempty"`
+}
+
+// SchemaVersionCollectionEntry is the schema of the single document that holds the version of the database schema
+type SchemaVersionCollectionEntry struct {
+	ID                   string `bson:"_id"`
+	CurrVersion          string `bson:"currversion"`
+	MinCompatibleVersion string `bson:"mincompatibleversion"`
+	CreationTime         int64  `bson:"creationtime"`
+}
+
+// SchemaUpdateHistoryCollectionEntry is the schema of an entry of the schema update history
+type SchemaUpdateHistoryCollectionEntry struct {
+	UpdateTime  int64  `bson:"updatetime"`
+	OldVersion  string `bson:"oldversion"`
+	NewVersion  string `bson:"newversion"`
+	ManifestMD5 string `bson:"manifestmd5"`
+	Description string `bson:"description"`
+}
@@ -0,0 +1,46 @@
+CREATE TABLE shard_distributor_executors (
+  namespace     VARCHAR(255) NOT NULL,
+  executor_id   VARCHAR(255) NOT NULL,
+  --
+  status        INT NOT NULL,
+  data          MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_assignments (
+  namespace     VARCHAR(255) NOT NULL,
+  executor_id   VARCHAR(255) NOT NULL,
+  --
+  version       BIGINT NOT NULL,
+  data          MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_shards (
+  namespace   VARCHAR(255) NOT NULL,
+  shard_id    VARCHAR(255) NOT NULL,
+  --
+  executor_id VARCHAR(255) NOT NULL,
+  PRIMARY KEY (namespace, shard_id)
+);
+
+CREATE TABLE shard_distributor_shard_stats (
+  namespace     VARCHAR(255) NOT NULL,
+  shard_id      VARCHAR(255) NOT NULL,
+  --
+  executor_id   VARCHAR(255) NOT NULL,
+  data          BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace, shard_id)
+);
+
+CREATE TABLE shard_distributor_leaders (
+  namespace    VARCHAR(255) NOT NULL,
+  --
+  leader_id    VARCHAR(255) NOT NULL,
+  term         BIGINT NOT NULL,
+  lease_expiry DATETIME(6) NOT NULL,
+  PRIMARY KEY (namespace)
+);
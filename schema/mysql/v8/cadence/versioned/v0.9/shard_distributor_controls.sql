@@ -0,0 +1,8 @@
+CREATE TABLE shard_distributor_controls (
+  namespace     VARCHAR(255) NOT NULL,
+  --
+  version       BIGINT NOT NULL,
+  data          BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace)
+);
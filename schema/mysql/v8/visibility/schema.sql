@@ -26,3 +26,20 @@ CREATE INDEX by_type_start_time ON executions_visibility (domain_id, workflow_ty
 CREATE INDEX by_workflow_id_start_time ON executions_visibility (domain_id, workflow_id, close_status, start_time DESC, run_id);
 CREATE INDEX by_status_by_close_time ON executions_visibility (domain_id, close_status, start_time DESC, run_id);
 CREATE INDEX by_close_time_by_status ON executions_visibility (domain_id, close_time DESC, run_id, close_status);
+
+CREATE TABLE executions_visibility_search_attributes (
+  domain_id      CHAR(64) NOT NULL,
+  run_id         CHAR(64) NOT NULL,
+  attr_key       VARCHAR(255) NOT NULL,
+  attr_index     INT NULL, -- position within an array value, NULL for single values
+  string_value   TEXT NULL,
+  int_value      BIGINT NULL, -- also stores bool values as 0 or 1
+  double_value   DOUBLE NULL,
+  datetime_value DATETIME(6) NULL
+);
+
+CREATE INDEX by_search_attr_run_id ON executions_visibility_search_attributes (domain_id, run_id, attr_key);
+CREATE INDEX by_search_attr_string_value ON executions_visibility_search_attributes (domain_id, attr_key, string_value(255));
+CREATE INDEX by_search_attr_int_value ON executions_visibility_search_attributes (domain_id, attr_key, int_value);
+CREATE INDEX by_search_attr_double_value ON executions_visibility_search_attributes (domain_id, attr_key, double_value);
+CREATE INDEX by_search_attr_datetime_value ON executions_visibility_search_attributes (domain_id, attr_key, datetime_value);
@@ -0,0 +1,46 @@
+CREATE TABLE shard_distributor_executors (
+  namespace     TEXT NOT NULL,
+  executor_id   TEXT NOT NULL,
+  --
+  status        INTEGER NOT NULL,
+  data          BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_assignments (
+  namespace     TEXT NOT NULL,
+  executor_id   TEXT NOT NULL,
+  --
+  version       BIGINT NOT NULL,
+  data          BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_shards (
+  namespace   TEXT NOT NULL,
+  shard_id    TEXT NOT NULL,
+  --
+  executor_id TEXT NOT NULL,
+  PRIMARY KEY (namespace, shard_id)
+);
+
+CREATE TABLE shard_distributor_shard_stats (
+  namespace     TEXT NOT NULL,
+  shard_id      TEXT NOT NULL,
+  --
+  executor_id   TEXT NOT NULL,
+  data          BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace, shard_id)
+);
+
+CREATE TABLE shard_distributor_leaders (
+  namespace    TEXT NOT NULL,
+  --
+  leader_id    TEXT NOT NULL,
+  term         BIGINT NOT NULL,
+  lease_expiry TIMESTAMP NOT NULL,
+  PRIMARY KEY (namespace)
+);
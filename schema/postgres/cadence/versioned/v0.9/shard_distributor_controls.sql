@@ -0,0 +1,8 @@
+CREATE TABLE shard_distributor_controls (
+  namespace     TEXT NOT NULL,
+  --
+  version       BIGINT NOT NULL,
+  data          BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (namespace)
+);
@@ -0,0 +1,17 @@
+-- Stores search attributes for query based visibility, one row per value of array attributes
+CREATE TABLE executions_visibility_search_attributes (
+  domain_id      CHAR(64) NOT NULL,
+  run_id         CHAR(64) NOT NULL,
+  attr_key       VARCHAR(255) NOT NULL,
+  attr_index     INTEGER NULL, -- position within an array value, NULL for single values
+  string_value   TEXT NULL,
+  int_value      BIGINT NULL, -- also stores bool values as 0 or 1
+  double_value   DOUBLE PRECISION NULL,
+  datetime_value TIMESTAMP NULL
+);
+
+CREATE INDEX by_search_attr_run_id ON executions_visibility_search_attributes (domain_id, run_id, attr_key);
+CREATE INDEX by_search_attr_string_value ON executions_visibility_search_attributes (domain_id, attr_key, string_value);
+CREATE INDEX by_search_attr_int_value ON executions_visibility_search_attributes (domain_id, attr_key, int_value);
+CREATE INDEX by_search_attr_double_value ON executions_visibility_search_attributes (domain_id, attr_key, double_value);
+CREATE INDEX by_search_attr_datetime_value ON executions_visibility_search_attributes (domain_id, attr_key, datetime_value);
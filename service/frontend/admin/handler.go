@@ -825,7 +825,11 @@ func (adh *adminHandlerImpl) GetWorkflowExecutionRawHistoryV2(
 	rawBlobs := rawHistoryResponse.HistoryEventBlobs
 	blobs := []*types.DataBlob{}
 	for _, blob := range rawBlobs {
-		blobs = append(blobs, blob.ToInternal())
+		internal, err := blob.ToInternal()
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, internal)
 	}
 
 	result := &types.GetWorkflowExecutionRawHistoryV2Response{
@@ -1285,6 +1289,78 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// PauseActivity pauses a pending activity, it is not dispatched or retried until it is unpaused
+func (adh *adminHandlerImpl) PauseActivity(
+	ctx context.Context,
+	request *types.PauseActivityRequest,
+) (_ *types.PauseActivityResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminPauseActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return nil, adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().PauseActivity(ctx, &types.HistoryPauseActivityRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// UnpauseActivity unpauses a paused activity and reschedules it if it is not started
+func (adh *adminHandlerImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.UnpauseActivityRequest,
+) (_ *types.UnpauseActivityResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUnpauseActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return nil, adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
@@ -1827,6 +1903,89 @@ func (adh *adminHandlerImpl) UpdateTaskListPartitionConfig(ctx context.Context,
 	return &types.UpdateTaskListPartitionConfigResponse{}, nil
 }
 
+// ListTaskListTasks pages through the persisted backlog of a task list partition
+func (adh *adminHandlerImpl) ListTaskListTasks(
+	ctx context.Context,
+	request *types.ListTaskListTasksRequest,
+) (_ *types.ListTaskListTasksResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListTaskListTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(validate.ErrTaskListTypeNotSet, scope)
+	}
+	if request.GetPageSize() <= 0 {
+		return nil, adh.error(&types.BadRequestError{Message: "PageSize must be larger than 0."}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().ListTaskListTasks(ctx, &types.MatchingListTaskListTasksRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DeleteTaskListTasks deletes the tasks matching the given filters from the backlog of a task list partition
+func (adh *adminHandlerImpl) DeleteTaskListTasks(
+	ctx context.Context,
+	request *types.DeleteTaskListTasksRequest,
+) (_ *types.DeleteTaskListTasksResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDeleteTaskListTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(validate.ErrTaskListTypeNotSet, scope)
+	}
+	if request.GetWorkflowID() == "" && request.GetRunID() == "" && request.CreatedBefore == nil {
+		return nil, adh.error(&types.BadRequestError{Message: "At least one of WorkflowID, RunID or CreatedBefore must be set."}, scope)
+	}
+	if request.GetRunID() != "" {
+		if _, err := uuid.Parse(request.GetRunID()); err != nil {
+			return nil, adh.error(validate.ErrInvalidRunID, scope)
+		}
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().DeleteTaskListTasks(ctx, &types.MatchingDeleteTaskListTasksRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 func convertFromDataBlob(blob *types.DataBlob) (interface{}, error) {
 	switch *blob.EncodingType {
 	case types.EncodingTypeJSON:
@@ -2334,6 +2334,103 @@ func Test_RefreshWorkflowTasks(t *testing.T) {
 	}
 }
 
+func Test_PauseActivity(t *testing.T) {
+	tests := map[string]struct {
+		input         *types.PauseActivityRequest
+		hcHandlerFunc func(mock *history.MockClient)
+		dcHandlerFunc func(mock *cache.MockDomainCache)
+		wantErr       bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"empty activity id": {
+			input: &types.PauseActivityRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+				},
+			},
+			wantErr: true,
+		},
+		"normal request": {
+			input: &types.PauseActivityRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+				},
+				ActivityID: "test-activity-id",
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).Return(&types.PauseActivityResponse{}, nil)
+			},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomain("test-domain").Return(cache.NewGlobalDomainCacheEntryForTest(
+					&persistence.DomainInfo{ID: "test-domain-id"},
+					&persistence.DomainConfig{},
+					&persistence.DomainReplicationConfig{},
+					0,
+				), nil)
+			},
+			wantErr: false,
+		},
+		"pause activity error": {
+			input: &types.PauseActivityRequest{
+				Domain: "test-domain",
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+				},
+				ActivityID: "test-activity-id",
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomain("test-domain").Return(cache.NewGlobalDomainCacheEntryForTest(
+					&persistence.DomainInfo{ID: "test-domain-id"},
+					&persistence.DomainConfig{},
+					&persistence.DomainReplicationConfig{},
+					0,
+				), nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			hcMock := history.NewMockClient(ctrl)
+			dcMock := cache.NewMockDomainCache(ctrl)
+			if tt.hcHandlerFunc != nil {
+				tt.hcHandlerFunc(hcMock)
+			}
+			if tt.dcHandlerFunc != nil {
+				tt.dcHandlerFunc(dcMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					HistoryClient: hcMock,
+					DomainCache:   dcMock,
+				},
+			}
+
+			_, err := handler.PauseActivity(context.Background(), tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_ResendReplicationTasks(t *testing.T) {
 	tests := map[string]struct {
 		input         *types.ResendReplicationTasksRequest
@@ -2952,3 +3049,211 @@ func TestUpdateTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestListTaskListTasks(t *testing.T) {
+	domainName := "domain-name"
+	domainID := "domain-id"
+	taskListType := types.TaskListTypeActivity
+	request := &types.ListTaskListTasksRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "task-list"},
+		TaskListType: &taskListType,
+		PageSize:     10,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.ListTaskListTasksRequest
+		setupMocks    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache)
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  request,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().ListTaskListTasks(gomock.Any(), &types.MatchingListTaskListTasksRequest{
+					DomainUUID: domainID,
+					Request:    request,
+				}).Return(&types.ListTaskListTasksResponse{}, nil)
+			},
+		},
+		{
+			name:          "request not set",
+			req:           nil,
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name: "task list not set",
+			req: &types.ListTaskListTasksRequest{
+				Domain:       domainName,
+				TaskListType: &taskListType,
+				PageSize:     10,
+			},
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectError:   true,
+			expectedError: "TaskList is not set on request.",
+		},
+		{
+			name: "task list type not set",
+			req: &types.ListTaskListTasksRequest{
+				Domain:   domainName,
+				TaskList: &types.TaskList{Name: "task-list"},
+				PageSize: 10,
+			},
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectError:   true,
+			expectedError: "TaskListType is not set on request.",
+		},
+		{
+			name: "invalid page size",
+			req: &types.ListTaskListTasksRequest{
+				Domain:       domainName,
+				TaskList:     &types.TaskList{Name: "task-list"},
+				TaskListType: &taskListType,
+			},
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectError:   true,
+			expectedError: "PageSize must be larger than 0.",
+		},
+		{
+			name: "matching client error",
+			req:  request,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().ListTaskListTasks(gomock.Any(), gomock.Any()).Return(nil, errors.New("matching client error"))
+			},
+			expectError:   true,
+			expectedError: "matching client error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockMatchingClient := matching.NewMockClient(ctrl)
+			tc.setupMocks(mockMatchingClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					DomainCache:    mockDomainCache,
+					MatchingClient: mockMatchingClient,
+				},
+			}
+
+			resp, err := adh.ListTaskListTasks(context.Background(), tc.req)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestDeleteTaskListTasks(t *testing.T) {
+	domainName := "domain-name"
+	domainID := "domain-id"
+	taskListType := types.TaskListTypeDecision
+	request := &types.DeleteTaskListTasksRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "task-list"},
+		TaskListType: &taskListType,
+		WorkflowID:   "workflow-id",
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.DeleteTaskListTasksRequest
+		setupMocks    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache)
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  request,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().DeleteTaskListTasks(gomock.Any(), &types.MatchingDeleteTaskListTasksRequest{
+					DomainUUID: domainID,
+					Request:    request,
+				}).Return(&types.DeleteTaskListTasksResponse{DeletedCount: 2}, nil)
+			},
+		},
+		{
+			name: "no filter",
+			req: &types.DeleteTaskListTasksRequest{
+				Domain:       domainName,
+				TaskList:     &types.TaskList{Name: "task-list"},
+				TaskListType: &taskListType,
+			},
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectError:   true,
+			expectedError: "At least one of WorkflowID, RunID or CreatedBefore must be set.",
+		},
+		{
+			name: "invalid run id",
+			req: &types.DeleteTaskListTasksRequest{
+				Domain:       domainName,
+				TaskList:     &types.TaskList{Name: "task-list"},
+				TaskListType: &taskListType,
+				RunID:        "not-a-uuid",
+			},
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectError:   true,
+			expectedError: "Invalid RunId.",
+		},
+		{
+			name: "domain cache error",
+			req:  request,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return("", errors.New("domain cache error"))
+			},
+			expectError:   true,
+			expectedError: "domain cache error",
+		},
+		{
+			name: "matching client error",
+			req:  request,
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().DeleteTaskListTasks(gomock.Any(), gomock.Any()).Return(nil, errors.New("matching client error"))
+			},
+			expectError:   true,
+			expectedError: "matching client error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockMatchingClient := matching.NewMockClient(ctrl)
+			tc.setupMocks(mockMatchingClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					DomainCache:    mockDomainCache,
+					MatchingClient: mockMatchingClient,
+				},
+			}
+
+			resp, err := adh.DeleteTaskListTasks(context.Background(), tc.req)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), resp.DeletedCount)
+			}
+		})
+	}
+}
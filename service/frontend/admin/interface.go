@@ -49,6 +49,7 @@ type Handler interface {
 	GetWorkflowExecutionRawHistoryV2(context.Context, *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest) (*types.CountDLQMessagesResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
+	PauseActivity(context.Context, *types.PauseActivityRequest) (*types.PauseActivityResponse, error)
 	PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest) error
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.ReapplyEventsRequest) error
@@ -56,6 +57,7 @@ type Handler interface {
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 	ResetQueue(context.Context, *types.ResetQueueRequest) error
+	UnpauseActivity(context.Context, *types.UnpauseActivityRequest) (*types.UnpauseActivityResponse, error)
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
 	GetDynamicConfig(context.Context, *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error)
@@ -71,4 +73,6 @@ type Handler interface {
 	GetDomainAsyncWorkflowConfiguraton(context.Context, *types.GetDomainAsyncWorkflowConfiguratonRequest) (*types.GetDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateDomainAsyncWorkflowConfiguraton(context.Context, *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *types.UpdateTaskListPartitionConfigRequest) (*types.UpdateTaskListPartitionConfigResponse, error)
+	ListTaskListTasks(context.Context, *types.ListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error)
+	DeleteTaskListTasks(context.Context, *types.DeleteTaskListTasksRequest) (*types.DeleteTaskListTasksResponse, error)
 }
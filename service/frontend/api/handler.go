@@ -1664,7 +1664,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, err
 	}
 	historyRequest, err := common.CreateHistoryStartWorkflowRequest(
-		domainID, startRequest, time.Now(), wh.getPartitionConfig(ctx, domainName))
+		domainID, startRequest, time.Now(), wh.getPartitionConfig(ctx, domainName), isolationgroup.FairnessKeyFromContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1762,6 +1762,9 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if startRequest.GetJitterStartSeconds() < 0 {
 		return validate.ErrInvalidJitterStartSeconds
 	}
+	if startRequest.GetPriority() < 0 || startRequest.GetPriority() > constants.MaxTaskPriority {
+		return validate.ErrInvalidPriority
+	}
 	jitter := startRequest.GetJitterStartSeconds()
 	cron := startRequest.GetCronSchedule()
 	if cron != "" {
@@ -2298,6 +2301,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		DomainUUID:             domainID,
 		SignalWithStartRequest: signalWithStartRequest,
 		PartitionConfig:        wh.getPartitionConfig(ctx, domainName),
+		FairnessKey:            isolationgroup.FairnessKeyFromContext(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -2619,7 +2623,7 @@ func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request
 	}
 	startRequest := constructRestartWorkflowRequest(history.History.Events[0].WorkflowExecutionStartedEventAttributes,
 		domainName, request.Identity, wfExecution.WorkflowID)
-	req, err := common.CreateHistoryStartWorkflowRequest(domainID, startRequest, time.Now(), wh.getPartitionConfig(ctx, domainName))
+	req, err := common.CreateHistoryStartWorkflowRequest(domainID, startRequest, time.Now(), wh.getPartitionConfig(ctx, domainName), isolationgroup.FairnessKeyFromContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -3027,6 +3031,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		ScheduledTimestamp:        matchingResp.ScheduledTimestamp,
 		StartedTimestamp:          matchingResp.StartedTimestamp,
 		Queries:                   matchingResp.Queries,
+		Updates:                   matchingResp.Updates,
 		NextEventID:               matchingResp.NextEventID,
 		TotalHistoryBytes:         matchingResp.TotalHistoryBytes,
 		AutoConfigHint:            matchingResp.AutoConfigHint,
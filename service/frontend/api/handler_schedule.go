@@ -37,7 +37,7 @@ import (
 )
 
 const (
-	scheduleWorkflowIDPrefix          = "cadence-scheduler:"
+	scheduleWorkflowIDPrefix          = scheduler.WorkflowIDPrefix
 	schedulerWorkflowExecutionTimeout = 10 * 365 * 24 * time.Hour // ~10 years
 	schedulerWorkflowDecisionTimeout  = 10 * time.Second
 	defaultListSchedulesPageSize      = 10
@@ -47,6 +47,13 @@ func scheduleWorkflowID(scheduleID string) string {
 	return scheduleWorkflowIDPrefix + scheduleID
 }
 
+func validateScheduleSpec(spec *types.ScheduleSpec) error {
+	if err := scheduler.ValidateScheduleSpec(spec); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Spec is invalid: %v.", err)}
+	}
+	return nil
+}
+
 func validateSchedulePolicies(policies *types.SchedulePolicies) error {
 	if policies == nil {
 		return nil
@@ -101,8 +108,8 @@ func (wh *WorkflowHandler) CreateSchedule(
 	if request.GetSpec() == nil {
 		return nil, &types.BadRequestError{Message: "Spec is not set on request."}
 	}
-	if request.GetSpec().GetCronExpression() == "" {
-		return nil, &types.BadRequestError{Message: "CronExpression is not set on request."}
+	if err := validateScheduleSpec(request.GetSpec()); err != nil {
+		return nil, err
 	}
 	if request.GetAction() == nil || request.GetAction().GetStartWorkflow() == nil {
 		return nil, &types.BadRequestError{Message: "Action.StartWorkflow is not set on request."}
@@ -221,9 +228,11 @@ func (wh *WorkflowHandler) DescribeSchedule(
 			}(),
 		},
 		Info: &types.ScheduleInfo{
-			LastRunTime: desc.LastRunTime,
-			NextRunTime: desc.NextRunTime,
-			TotalRuns:   desc.TotalRuns,
+			LastRunTime:       desc.LastRunTime,
+			NextRunTime:       desc.NextRunTime,
+			TotalRuns:         desc.TotalRuns,
+			FutureActionTimes: desc.FutureRunTimes,
+			RecentRuns:        desc.RecentRuns,
 		},
 	}, nil
 }
@@ -250,6 +259,11 @@ func (wh *WorkflowHandler) UpdateSchedule(
 	if request.GetSpec() == nil && request.GetAction() == nil && request.GetPolicies() == nil {
 		return nil, &types.BadRequestError{Message: "At least one of Spec, Action, or Policies must be set on request."}
 	}
+	if request.GetSpec() != nil {
+		if err := validateScheduleSpec(request.GetSpec()); err != nil {
+			return nil, err
+		}
+	}
 	if err := validateSchedulePolicies(request.GetPolicies()); err != nil {
 		return nil, err
 	}
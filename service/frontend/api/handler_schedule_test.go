@@ -139,6 +139,21 @@ func TestCreateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"invalid spec time zone": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "* * * * *", TimeZone: "Mars/Olympus_Mons"},
+				Action: &types.ScheduleAction{
+					StartWorkflow: &types.StartWorkflowAction{
+						WorkflowType: &types.WorkflowType{Name: "wf"},
+						TaskList:     &types.TaskList{Name: "tl"},
+					},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"nil action": {
 			request: &types.CreateScheduleRequest{
 				Domain:     testDomain,
@@ -232,6 +247,33 @@ func TestCreateSchedule(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"success with interval spec only": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "my-schedule",
+				Spec: &types.ScheduleSpec{
+					Intervals: []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Offset: 15 * time.Minute}},
+				},
+				Action: &types.ScheduleAction{
+					StartWorkflow: &types.StartWorkflowAction{
+						WorkflowType: &types.WorkflowType{Name: "my-workflow"},
+						TaskList:     &types.TaskList{Name: "my-tasklist"},
+					},
+				},
+			},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistoryStartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var input scheduler.SchedulerWorkflowInput
+						require.NoError(t, json.Unmarshal(req.StartRequest.Input, &input))
+						assert.Equal(t, []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Offset: 15 * time.Minute}}, input.Spec.Intervals)
+
+						return &types.StartWorkflowExecutionResponse{RunID: "test-run-id"}, nil
+					})
+			},
+			wantErr: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -263,8 +305,26 @@ func TestDescribeSchedule(t *testing.T) {
 		PauseReason: "maintenance",
 		PausedBy:    "admin",
 		TotalRuns:   42,
+		RecentRuns: []*types.ScheduleRunRecord{
+			{
+				ScheduledTime: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+				ActualTime:    time.Date(2026, 1, 15, 10, 0, 1, 0, time.UTC),
+				WorkflowID:    "wf-1",
+				RunID:         "run-1",
+				Outcome:       types.ScheduleRunOutcomeStarted,
+			},
+			{
+				ScheduledTime: time.Date(2026, 1, 15, 10, 10, 0, 0, time.UTC),
+				Outcome:       types.ScheduleRunOutcomeFailed,
+				Failure:       "failed to start workflow",
+			},
+		},
 	}
 	descBytes, _ := json.Marshal(descResult)
+	futureRunTimes := []time.Time{
+		time.Date(2026, 1, 15, 10, 10, 0, 0, time.UTC),
+		time.Date(2026, 1, 15, 10, 20, 0, 0, time.UTC),
+	}
 
 	validRequest := &types.DescribeScheduleRequest{
 		Domain:     testDomain,
@@ -341,6 +401,37 @@ func TestDescribeSchedule(t *testing.T) {
 				assert.Equal(t, "maintenance", resp.State.PauseInfo.Reason)
 				assert.Equal(t, "admin", resp.State.PauseInfo.PausedBy)
 				assert.Equal(t, int64(42), resp.Info.TotalRuns)
+				require.Len(t, resp.Info.RecentRuns, 2)
+				assert.Equal(t, "wf-1", resp.Info.RecentRuns[0].WorkflowID)
+				assert.Equal(t, types.ScheduleRunOutcomeStarted, resp.Info.RecentRuns[0].Outcome)
+				assert.Equal(t, types.ScheduleRunOutcomeFailed, resp.Info.RecentRuns[1].Outcome)
+				assert.Equal(t, "failed to start workflow", resp.Info.RecentRuns[1].Failure)
+			},
+		},
+		"success with future run times": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *types.HistoryQueryWorkflowRequest, _ ...yarpc.CallOption) (*types.HistoryQueryWorkflowResponse, error) {
+						desc := descResult
+						desc.Paused = false
+						desc.FutureRunTimes = futureRunTimes
+						result, err := json.Marshal(desc)
+						require.NoError(t, err)
+						return &types.HistoryQueryWorkflowResponse{
+							Response: &types.QueryWorkflowResponse{QueryResult: result},
+						}, nil
+					})
+			},
+			wantErr: false,
+			check: func(t *testing.T, resp *types.DescribeScheduleResponse) {
+				assert.False(t, resp.State.Paused)
+				assert.Nil(t, resp.State.PauseInfo)
+				require.Len(t, resp.Info.FutureActionTimes, len(futureRunTimes))
+				for i := range futureRunTimes {
+					assert.True(t, futureRunTimes[i].Equal(resp.Info.FutureActionTimes[i]))
+				}
 			},
 		},
 	}
@@ -508,6 +599,15 @@ func TestUpdateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"invalid spec": {
+			request: &types.UpdateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "not a cron"},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"success with spec update": {
 			request: &types.UpdateScheduleRequest{
 				Domain:     testDomain,
@@ -513,6 +513,31 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSe
 	s.Equal(validate.ErrInvalidDelayStartSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadPriority() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	for _, priority := range []int32{-1, constants.MaxTaskPriority + 1} {
+		startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+			Domain:     s.testDomain,
+			WorkflowID: "workflow-id",
+			WorkflowType: &types.WorkflowType{
+				Name: "workflow-type",
+			},
+			TaskList: &types.TaskList{
+				Name: "task-list",
+			},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			RequestID:                           uuid.New(),
+			Priority:                            priority,
+		}
+		_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+		s.Equal(validate.ErrInvalidPriority, err)
+	}
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
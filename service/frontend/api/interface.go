@@ -24,6 +24,7 @@
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/versioncheck.tmpl -o ../wrappers/versioncheck/api_generated.go
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/metered.tmpl -o ../wrappers/metered/api_generated.go -v handler=API
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/ratelimited.tmpl -o ../wrappers/ratelimited/api_generated.go -v handler=API
+//go:generate gowrap gen -g -p . -i Handler -t ../templates/payloadoffload.tmpl -o ../wrappers/payloadoffload/api_generated.go
 //go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/api_generated.go -v handler=API -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix=
 //go:generate gowrap gen -g -p ../../../.gen/go/cadence/workflowserviceserver -i Interface -t ../../templates/thrift.tmpl -o ../wrappers/thrift/api_generated.go -v handler=API -v prefix=
 
@@ -55,7 +56,11 @@ type (
 		ListOpenWorkflowExecutions(context.Context, *types.ListOpenWorkflowExecutionsRequest) (*types.ListOpenWorkflowExecutionsResponse, error)
 		ListTaskListPartitions(context.Context, *types.ListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		UpdateTaskListBuildIDCompatibility(context.Context, *types.UpdateTaskListBuildIDCompatibilityRequest) (*types.UpdateTaskListBuildIDCompatibilityResponse, error)
+		GetTaskListBuildIDCompatibility(context.Context, *types.GetTaskListBuildIDCompatibilityRequest) (*types.GetTaskListBuildIDCompatibilityResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
+		UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
+		UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
 		ListWorkflowExecutions(context.Context, *types.ListWorkflowExecutionsRequest) (*types.ListWorkflowExecutionsResponse, error)
 		PollForActivityTask(context.Context, *types.PollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		PollForDecisionTask(context.Context, *types.PollForDecisionTaskRequest) (*types.PollForDecisionTaskResponse, error)
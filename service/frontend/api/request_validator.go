@@ -40,9 +40,13 @@ import (
 type (
 	RequestValidator interface {
 		ValidateRefreshWorkflowTasksRequest(context.Context, *types.RefreshWorkflowTasksRequest) error
+		ValidateUpdateActivityOptionsRequest(context.Context, *types.UpdateActivityOptionsRequest) error
+		ValidateUpdateWorkflowExecutionRequest(context.Context, *types.UpdateWorkflowExecutionRequest) error
 		ValidateDescribeTaskListRequest(context.Context, *types.DescribeTaskListRequest) error
 		ValidateListTaskListPartitionsRequest(context.Context, *types.ListTaskListPartitionsRequest) error
 		ValidateGetTaskListsByDomainRequest(context.Context, *types.GetTaskListsByDomainRequest) error
+		ValidateUpdateTaskListBuildIDCompatibilityRequest(context.Context, *types.UpdateTaskListBuildIDCompatibilityRequest) error
+		ValidateGetTaskListBuildIDCompatibilityRequest(context.Context, *types.GetTaskListBuildIDCompatibilityRequest) error
 		ValidateResetStickyTaskListRequest(context.Context, *types.ResetStickyTaskListRequest) error
 		ValidateCountWorkflowExecutionsRequest(context.Context, *types.CountWorkflowExecutionsRequest) error
 		ValidateListWorkflowExecutionsRequest(context.Context, *types.ListWorkflowExecutionsRequest) error
@@ -120,6 +124,82 @@ func (v *requestValidatorImpl) ValidateRefreshWorkflowTasksRequest(ctx context.C
 	return validate.CheckExecution(req.Execution)
 }
 
+func (v *requestValidatorImpl) ValidateUpdateActivityOptionsRequest(ctx context.Context, request *types.UpdateActivityOptionsRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return err
+	}
+	if request.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+	options := request.ActivityOptions
+	if options == nil {
+		return validate.ErrActivityOptionsNotSet
+	}
+	if options.TaskList == nil &&
+		options.ScheduleToCloseTimeoutSeconds == nil &&
+		options.StartToCloseTimeoutSeconds == nil &&
+		options.HeartbeatTimeoutSeconds == nil &&
+		options.RetryPolicy == nil {
+		return validate.ErrActivityOptionsNotSet
+	}
+	if options.ScheduleToCloseTimeoutSeconds != nil && options.GetScheduleToCloseTimeoutSeconds() <= 0 {
+		return validate.ErrInvalidActivityTimeoutSeconds
+	}
+	if options.StartToCloseTimeoutSeconds != nil && options.GetStartToCloseTimeoutSeconds() <= 0 {
+		return validate.ErrInvalidActivityTimeoutSeconds
+	}
+	if options.HeartbeatTimeoutSeconds != nil && options.GetHeartbeatTimeoutSeconds() < 0 {
+		return validate.ErrInvalidActivityTimeoutSeconds
+	}
+	if options.RetryPolicy != nil {
+		if err := common.ValidateRetryPolicy(options.RetryPolicy); err != nil {
+			return err
+		}
+	}
+	if options.TaskList != nil {
+		scope := getMetricsScopeWithDomain(metrics.FrontendUpdateActivityOptionsScope, request, v.metricsClient).Tagged(metrics.GetContextTags(ctx)...)
+		return v.validateTaskList(options.TaskList, scope, request.GetDomain())
+	}
+	return nil
+}
+
+func (v *requestValidatorImpl) ValidateUpdateWorkflowExecutionRequest(ctx context.Context, request *types.UpdateWorkflowExecutionRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return err
+	}
+	if request.Update == nil {
+		return validate.ErrUpdateNotSet
+	}
+	if request.Update.GetUpdateType() == "" {
+		return validate.ErrUpdateTypeNotSet
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, request, v.metricsClient).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		request.GetUpdateID(),
+		scope,
+		v.config.MaxIDLengthWarnLimit(),
+		v.config.RequestIDMaxLength(request.GetDomain()),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		request.GetDomain(),
+		v.logger,
+		tag.IDTypeRequestID) {
+		return validate.ErrUpdateIDTooLong
+	}
+	return nil
+}
+
 func (v *requestValidatorImpl) ValidateDescribeTaskListRequest(ctx context.Context, request *types.DescribeTaskListRequest) error {
 	if request == nil {
 		return validate.ErrRequestNotSet
@@ -155,6 +235,31 @@ func (v *requestValidatorImpl) ValidateGetTaskListsByDomainRequest(ctx context.C
 	return nil
 }
 
+func (v *requestValidatorImpl) ValidateUpdateTaskListBuildIDCompatibilityRequest(ctx context.Context, request *types.UpdateTaskListBuildIDCompatibilityRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	if request.GetBuildID() == "" {
+		return validate.ErrBuildIDNotSet
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendUpdateTaskListBuildIDCompatibilityScope, request, v.metricsClient).Tagged(metrics.GetContextTags(ctx)...)
+	return v.validateTaskList(request.TaskList, scope, request.GetDomain())
+}
+
+func (v *requestValidatorImpl) ValidateGetTaskListBuildIDCompatibilityRequest(ctx context.Context, request *types.GetTaskListBuildIDCompatibilityRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendGetTaskListBuildIDCompatibilityScope, request, v.metricsClient).Tagged(metrics.GetContextTags(ctx)...)
+	return v.validateTaskList(request.TaskList, scope, request.GetDomain())
+}
+
 func (v *requestValidatorImpl) ValidateResetStickyTaskListRequest(ctx context.Context, resetRequest *types.ResetStickyTaskListRequest) error {
 	if resetRequest == nil {
 		return validate.ErrRequestNotSet
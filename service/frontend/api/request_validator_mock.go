@@ -126,6 +126,20 @@ func (mr *MockRequestValidatorMockRecorder) ValidateFailoverDomainRequest(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateFailoverDomainRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateFailoverDomainRequest), arg0, arg1)
 }
 
+// ValidateGetTaskListBuildIDCompatibilityRequest mocks base method.
+func (m *MockRequestValidator) ValidateGetTaskListBuildIDCompatibilityRequest(arg0 context.Context, arg1 *types.GetTaskListBuildIDCompatibilityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateGetTaskListBuildIDCompatibilityRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateGetTaskListBuildIDCompatibilityRequest indicates an expected call of ValidateGetTaskListBuildIDCompatibilityRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateGetTaskListBuildIDCompatibilityRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGetTaskListBuildIDCompatibilityRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateGetTaskListBuildIDCompatibilityRequest), arg0, arg1)
+}
+
 // ValidateGetTaskListsByDomainRequest mocks base method.
 func (m *MockRequestValidator) ValidateGetTaskListsByDomainRequest(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -252,6 +266,20 @@ func (mr *MockRequestValidatorMockRecorder) ValidateResetStickyTaskListRequest(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateResetStickyTaskListRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateResetStickyTaskListRequest), arg0, arg1)
 }
 
+// ValidateUpdateActivityOptionsRequest mocks base method.
+func (m *MockRequestValidator) ValidateUpdateActivityOptionsRequest(arg0 context.Context, arg1 *types.UpdateActivityOptionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUpdateActivityOptionsRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateUpdateActivityOptionsRequest indicates an expected call of ValidateUpdateActivityOptionsRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateUpdateActivityOptionsRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpdateActivityOptionsRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateUpdateActivityOptionsRequest), arg0, arg1)
+}
+
 // ValidateUpdateDomainRequest mocks base method.
 func (m *MockRequestValidator) ValidateUpdateDomainRequest(arg0 context.Context, arg1 *types.UpdateDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -265,3 +293,31 @@ func (mr *MockRequestValidatorMockRecorder) ValidateUpdateDomainRequest(arg0, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpdateDomainRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateUpdateDomainRequest), arg0, arg1)
 }
+
+// ValidateUpdateTaskListBuildIDCompatibilityRequest mocks base method.
+func (m *MockRequestValidator) ValidateUpdateTaskListBuildIDCompatibilityRequest(arg0 context.Context, arg1 *types.UpdateTaskListBuildIDCompatibilityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUpdateTaskListBuildIDCompatibilityRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateUpdateTaskListBuildIDCompatibilityRequest indicates an expected call of ValidateUpdateTaskListBuildIDCompatibilityRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateUpdateTaskListBuildIDCompatibilityRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpdateTaskListBuildIDCompatibilityRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateUpdateTaskListBuildIDCompatibilityRequest), arg0, arg1)
+}
+
+// ValidateUpdateWorkflowExecutionRequest mocks base method.
+func (m *MockRequestValidator) ValidateUpdateWorkflowExecutionRequest(arg0 context.Context, arg1 *types.UpdateWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUpdateWorkflowExecutionRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateUpdateWorkflowExecutionRequest indicates an expected call of ValidateUpdateWorkflowExecutionRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateUpdateWorkflowExecutionRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpdateWorkflowExecutionRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateUpdateWorkflowExecutionRequest), arg0, arg1)
+}
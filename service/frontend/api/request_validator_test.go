@@ -24,6 +24,7 @@ package api
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -107,6 +108,207 @@ func TestValidateRefreshWorkflowTasksRequest(t *testing.T) {
 	}
 }
 
+func TestValidateUpdateActivityOptionsRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.UpdateActivityOptionsRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.UpdateActivityOptionsRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				ActivityID:        "activity",
+				ActivityOptions: &types.ActivityOptions{
+					TaskList:                   &types.TaskList{Name: "tl"},
+					StartToCloseTimeoutSeconds: common.Int32Ptr(10),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name: "domain not set",
+			req: &types.UpdateActivityOptionsRequest{
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+			},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name: "execution not set",
+			req: &types.UpdateActivityOptionsRequest{
+				Domain: "domain",
+			},
+			expectError:   true,
+			expectedError: "Execution is not set on request.",
+		},
+		{
+			name: "activity id not set",
+			req: &types.UpdateActivityOptionsRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+			},
+			expectError:   true,
+			expectedError: "ActivityID is not set on request.",
+		},
+		{
+			name: "options not set",
+			req: &types.UpdateActivityOptionsRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				ActivityID:        "activity",
+				ActivityOptions:   &types.ActivityOptions{},
+			},
+			expectError:   true,
+			expectedError: "ActivityOptions is not set on request.",
+		},
+		{
+			name: "invalid timeout",
+			req: &types.UpdateActivityOptionsRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				ActivityID:        "activity",
+				ActivityOptions: &types.ActivityOptions{
+					ScheduleToCloseTimeoutSeconds: common.Int32Ptr(0),
+				},
+			},
+			expectError:   true,
+			expectedError: "A valid activity timeout is not set on request.",
+		},
+		{
+			name: "invalid retry policy",
+			req: &types.UpdateActivityOptionsRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				ActivityID:        "activity",
+				ActivityOptions: &types.ActivityOptions{
+					RetryPolicy: &types.RetryPolicy{InitialIntervalInSeconds: -1},
+				},
+			},
+			expectError:   true,
+			expectedError: "InitialIntervalInSeconds must be greater than 0 on retry policy.",
+		},
+		{
+			name: "task list name not set",
+			req: &types.UpdateActivityOptionsRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				ActivityID:        "activity",
+				ActivityOptions: &types.ActivityOptions{
+					TaskList: &types.TaskList{},
+				},
+			},
+			expectError:   true,
+			expectedError: "TaskList is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, _ := setupMocksForRequestValidator(t)
+
+			err := v.ValidateUpdateActivityOptionsRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateWorkflowExecutionRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.UpdateWorkflowExecutionRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.UpdateWorkflowExecutionRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				Update:            &types.WorkflowUpdate{UpdateType: "update-type"},
+			},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name: "domain not set",
+			req: &types.UpdateWorkflowExecutionRequest{
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+			},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name: "execution not set",
+			req: &types.UpdateWorkflowExecutionRequest{
+				Domain: "domain",
+			},
+			expectError:   true,
+			expectedError: "Execution is not set on request.",
+		},
+		{
+			name: "update not set",
+			req: &types.UpdateWorkflowExecutionRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+			},
+			expectError:   true,
+			expectedError: "WorkflowUpdate is not set on request.",
+		},
+		{
+			name: "update type not set",
+			req: &types.UpdateWorkflowExecutionRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				Update:            &types.WorkflowUpdate{},
+			},
+			expectError:   true,
+			expectedError: "UpdateType is not set on request.",
+		},
+		{
+			name: "update ID too long",
+			req: &types.UpdateWorkflowExecutionRequest{
+				Domain:            "domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+				Update:            &types.WorkflowUpdate{UpdateType: "update-type"},
+				UpdateID:          strings.Repeat("a", 1001),
+			},
+			expectError:   true,
+			expectedError: "UpdateID length exceeds limit.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, _ := setupMocksForRequestValidator(t)
+
+			err := v.ValidateUpdateWorkflowExecutionRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateValidateDescribeTaskListRequest(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -274,6 +476,124 @@ func TestValidateValidateListTaskListPartitionsRequest(t *testing.T) {
 	}
 }
 
+func TestValidateUpdateTaskListBuildIDCompatibilityRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.UpdateTaskListBuildIDCompatibilityRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.UpdateTaskListBuildIDCompatibilityRequest{
+				Domain:   "domain",
+				TaskList: &types.TaskList{Name: "tl"},
+				BuildID:  "b1",
+			},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name: "domain not set",
+			req: &types.UpdateTaskListBuildIDCompatibilityRequest{
+				TaskList: &types.TaskList{Name: "tl"},
+				BuildID:  "b1",
+			},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name: "build ID not set",
+			req: &types.UpdateTaskListBuildIDCompatibilityRequest{
+				Domain:   "domain",
+				TaskList: &types.TaskList{Name: "tl"},
+			},
+			expectError:   true,
+			expectedError: "BuildID is not set on request.",
+		},
+		{
+			name: "task list not set",
+			req: &types.UpdateTaskListBuildIDCompatibilityRequest{
+				Domain:  "domain",
+				BuildID: "b1",
+			},
+			expectError:   true,
+			expectedError: "TaskList is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, _ := setupMocksForRequestValidator(t)
+
+			err := v.ValidateUpdateTaskListBuildIDCompatibilityRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGetTaskListBuildIDCompatibilityRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.GetTaskListBuildIDCompatibilityRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.GetTaskListBuildIDCompatibilityRequest{
+				Domain:   "domain",
+				TaskList: &types.TaskList{Name: "tl"},
+			},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name: "domain not set",
+			req: &types.GetTaskListBuildIDCompatibilityRequest{
+				TaskList: &types.TaskList{Name: "tl"},
+			},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name: "task list not set",
+			req: &types.GetTaskListBuildIDCompatibilityRequest{
+				Domain: "domain",
+			},
+			expectError:   true,
+			expectedError: "TaskList is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, _ := setupMocksForRequestValidator(t)
+
+			err := v.ValidateGetTaskListBuildIDCompatibilityRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateValidateGetTaskListsByDomainRequest(t *testing.T) {
 	testCases := []struct {
 		name          string
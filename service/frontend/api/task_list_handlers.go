@@ -91,6 +91,49 @@ func (wh *WorkflowHandler) GetTaskListsByDomain(
 	return resp, err
 }
 
+// UpdateTaskListBuildIDCompatibility adds a worker build ID to the compatibility sets of a decision task list,
+// either as the new default set or as compatible with an existing build ID.
+func (wh *WorkflowHandler) UpdateTaskListBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateTaskListBuildIDCompatibilityRequest,
+) (resp *types.UpdateTaskListBuildIDCompatibilityResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if err := wh.requestValidator.ValidateUpdateTaskListBuildIDCompatibilityRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	return wh.GetMatchingClient().UpdateTaskListBuildIDCompatibility(ctx, &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+}
+
+// GetTaskListBuildIDCompatibility returns the worker build ID compatibility sets of a decision task list
+func (wh *WorkflowHandler) GetTaskListBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetTaskListBuildIDCompatibilityRequest,
+) (resp *types.GetTaskListBuildIDCompatibilityResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if err := wh.requestValidator.ValidateGetTaskListBuildIDCompatibilityRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	return wh.GetMatchingClient().GetTaskListBuildIDCompatibility(ctx, &types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(
 	ctx context.Context,
@@ -387,3 +387,85 @@ func TestResetStickyTaskList(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateTaskListBuildIDCompatibility(t *testing.T) {
+	req := &types.UpdateTaskListBuildIDCompatibilityRequest{
+		Domain:   "domain",
+		TaskList: &types.TaskList{Name: "tl"},
+		BuildID:  "b2",
+	}
+	testCases := []struct {
+		name          string
+		setupMocks    func(*mockDeps)
+		expectError   bool
+		expectedError string
+		expected      *types.UpdateTaskListBuildIDCompatibilityResponse
+	}{
+		{
+			name: "success",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateUpdateTaskListBuildIDCompatibilityRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockMatchingClient.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+					DomainUUID: "domain-id",
+					Request:    req,
+				}).Return(&types.UpdateTaskListBuildIDCompatibilityResponse{
+					CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"b1"}}, {BuildIDs: []string{"b2"}}},
+				}, nil)
+			},
+			expected: &types.UpdateTaskListBuildIDCompatibilityResponse{
+				CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"b1"}}, {BuildIDs: []string{"b2"}}},
+			},
+		},
+		{
+			name: "domain cache error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateUpdateTaskListBuildIDCompatibilityRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("domain cache error"))
+			},
+			expectError:   true,
+			expectedError: "domain cache error",
+		},
+		{
+			name: "validator error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateUpdateTaskListBuildIDCompatibilityRequest(gomock.Any(), req).Return(errors.New("validator error"))
+			},
+			expectError:   true,
+			expectedError: "validator error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(deps)
+
+			resp, err := wh.UpdateTaskListBuildIDCompatibility(context.Background(), req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestGetTaskListBuildIDCompatibility(t *testing.T) {
+	req := &types.GetTaskListBuildIDCompatibilityRequest{
+		Domain:   "domain",
+		TaskList: &types.TaskList{Name: "tl"},
+	}
+	wh, deps := setupMocksForWorkflowHandler(t)
+	deps.mockRequestValidator.EXPECT().ValidateGetTaskListBuildIDCompatibilityRequest(gomock.Any(), req).Return(nil)
+	deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+	deps.mockMatchingClient.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), &types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: "domain-id",
+		Request:    req,
+	}).Return(&types.GetTaskListBuildIDCompatibilityResponse{}, nil)
+
+	resp, err := wh.GetTaskListBuildIDCompatibility(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.GetTaskListBuildIDCompatibilityResponse{}, resp)
+}
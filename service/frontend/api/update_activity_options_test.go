@@ -0,0 +1,109 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestUpdateActivityOptions(t *testing.T) {
+	req := &types.UpdateActivityOptionsRequest{
+		Domain: "domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "wf",
+		},
+		ActivityID: "activity",
+		ActivityOptions: &types.ActivityOptions{
+			StartToCloseTimeoutSeconds: common.Int32Ptr(10),
+		},
+	}
+	testCases := []struct {
+		name          string
+		setupMocks    func(*mockDeps)
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateUpdateActivityOptionsRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomain("domain").Return(testDomainCacheEntry, nil)
+				deps.mockHistoryClient.EXPECT().UpdateActivityOptions(gomock.Any(), &types.HistoryUpdateActivityOptionsRequest{
+					DomainUUID: "domain-id",
+					Request:    req,
+				}).Return(&types.UpdateActivityOptionsResponse{ActivityOptions: req.ActivityOptions}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "history client error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateUpdateActivityOptionsRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomain("domain").Return(testDomainCacheEntry, nil)
+				deps.mockHistoryClient.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any()).Return(nil, errors.New("history error"))
+			},
+			expectError:   true,
+			expectedError: "history error",
+		},
+		{
+			name: "cache error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateUpdateActivityOptionsRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomain("domain").Return(nil, errors.New("cache error"))
+			},
+			expectError:   true,
+			expectedError: "cache error",
+		},
+		{
+			name: "validator error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateUpdateActivityOptionsRequest(gomock.Any(), req).Return(errors.New("validator error"))
+			},
+			expectError:   true,
+			expectedError: "validator error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(deps)
+			resp, err := wh.UpdateActivityOptions(context.Background(), req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, req.ActivityOptions, resp.ActivityOptions)
+			}
+		})
+	}
+}
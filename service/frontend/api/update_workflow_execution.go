@@ -0,0 +1,82 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// UpdateWorkflowExecution delivers an update to a running workflow and returns the result
+// of the update once the worker accepted it
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.UpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if err := wh.requestValidator.ValidateUpdateWorkflowExecutionRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	domainName := request.GetDomain()
+	domainEntry, err := wh.GetDomainCache().GetDomain(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, request, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if err := common.CheckEventBlobSizeLimit(
+		len(request.GetUpdate().GetUpdateArgs()),
+		wh.config.BlobSizeLimitWarn(domainName),
+		wh.config.BlobSizeLimitError(domainName),
+		domainEntry.GetInfo().ID,
+		domainName,
+		request.GetWorkflowExecution().GetWorkflowID(),
+		request.GetWorkflowExecution().GetRunID(),
+		scope,
+		wh.GetLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+	); err != nil {
+		return nil, err
+	}
+
+	// an update without an ID is given one here, so that retries between frontend and history are deduplicated
+	if request.GetUpdateID() == "" {
+		updateRequest := *request
+		updateRequest.UpdateID = uuid.New()
+		request = &updateRequest
+	}
+
+	return wh.GetHistoryClient().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+}
@@ -98,6 +98,8 @@ type Config struct {
 	// size limit system protection
 	BlobSizeLimitError dynamicproperties.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn  dynamicproperties.IntPropertyFnWithDomainFilter
+	// PayloadOffloadThreshold is the size above which payloads are offloaded to the blobstore
+	PayloadOffloadThreshold dynamicproperties.IntPropertyFnWithDomainFilter
 
 	ThrottledLogRPS dynamicproperties.IntPropertyFn
 
@@ -183,6 +185,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		DisableListVisibilityByFilter:                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.DisableListVisibilityByFilter),
 		BlobSizeLimitError:                                dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitError),
 		BlobSizeLimitWarn:                                 dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitWarn),
+		PayloadOffloadThreshold:                           dc.GetIntPropertyFilteredByDomain(dynamicproperties.PayloadOffloadThreshold),
 		ThrottledLogRPS:                                   dc.GetIntProperty(dynamicproperties.FrontendThrottledLogRPS),
 		ShutdownDrainDuration:                             dc.GetDurationProperty(dynamicproperties.FrontendShutdownDrainDuration),
 		WarmupDuration:                                    dc.GetDurationProperty(dynamicproperties.FrontendWarmupDuration),
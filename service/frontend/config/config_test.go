@@ -84,6 +84,7 @@ func TestNewConfig(t *testing.T) {
 		"DisableListVisibilityByFilter":                     {dynamicproperties.DisableListVisibilityByFilter, false},
 		"BlobSizeLimitError":                                {dynamicproperties.BlobSizeLimitError, 29},
 		"BlobSizeLimitWarn":                                 {dynamicproperties.BlobSizeLimitWarn, 30},
+		"PayloadOffloadThreshold":                           {dynamicproperties.PayloadOffloadThreshold, 47},
 		"ThrottledLogRPS":                                   {dynamicproperties.FrontendThrottledLogRPS, 31},
 		"ShutdownDrainDuration":                             {dynamicproperties.FrontendShutdownDrainDuration, time.Duration(32)},
 		"WarmupDuration":                                    {dynamicproperties.FrontendWarmupDuration, time.Duration(40)},
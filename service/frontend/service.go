@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/offload"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/quotas/global/collection"
 	"github.com/uber/cadence/common/quotas/permember"
@@ -46,6 +47,7 @@ import (
 	"github.com/uber/cadence/service/frontend/wrappers/clusterredirection"
 	"github.com/uber/cadence/service/frontend/wrappers/grpc"
 	"github.com/uber/cadence/service/frontend/wrappers/metered"
+	"github.com/uber/cadence/service/frontend/wrappers/payloadoffload"
 	"github.com/uber/cadence/service/frontend/wrappers/ratelimited"
 	"github.com/uber/cadence/service/frontend/wrappers/thrift"
 	"github.com/uber/cadence/service/frontend/wrappers/versioncheck"
@@ -157,6 +159,9 @@ func (s *Service) Start() {
 
 	// Additional decorations
 	var handler api.Handler = s.handler
+	if blobstoreClient := s.GetBlobstoreClient(); blobstoreClient != nil {
+		handler = payloadoffload.NewAPIHandler(handler, s.GetDomainCache(), offload.NewOffloader(blobstoreClient, s.GetTimeSource()), s.config)
+	}
 	handler = versioncheck.NewAPIHandler(handler, s.config, client.NewVersionChecker())
 	callerBypass := quotas.NewCallerBypass(s.config.RateLimiterBypassCallerTypes)
 	handler = ratelimited.NewAPIHandler(handler, s.GetDomainCache(), userRateLimiter, workerRateLimiter, visibilityRateLimiter, asyncRateLimiter, s.config.MaxWorkerPollDelay, callerBypass)
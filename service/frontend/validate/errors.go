@@ -37,21 +37,27 @@ var (
 	ErrExecutionNotSet                            = &types.BadRequestError{Message: "Execution is not set on request."}
 	ErrWorkflowIDNotSet                           = &types.BadRequestError{Message: "WorkflowId is not set on request."}
 	ErrActivityIDNotSet                           = &types.BadRequestError{Message: "ActivityID is not set on request."}
+	ErrActivityOptionsNotSet                      = &types.BadRequestError{Message: "ActivityOptions is not set on request."}
 	ErrSignalNameNotSet                           = &types.BadRequestError{Message: "SignalName is not set on request."}
 	ErrInvalidRunID                               = &types.BadRequestError{Message: "Invalid RunId."}
 	ErrInvalidNextPageToken                       = &types.BadRequestError{Message: "Invalid NextPageToken."}
 	ErrNextPageTokenRunIDMismatch                 = &types.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
 	ErrQueryNotSet                                = &types.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	ErrQueryTypeNotSet                            = &types.BadRequestError{Message: "QueryType is not set on request."}
+	ErrBuildIDNotSet                              = &types.BadRequestError{Message: "BuildID is not set on request."}
+	ErrUpdateNotSet                               = &types.BadRequestError{Message: "WorkflowUpdate is not set on request."}
+	ErrUpdateTypeNotSet                           = &types.BadRequestError{Message: "UpdateType is not set on request."}
 	ErrRequestNotSet                              = &types.BadRequestError{Message: "Request is nil."}
 	ErrNoPermission                               = &types.BadRequestError{Message: "No permission to do this operation."}
 	ErrWorkflowTypeNotSet                         = &types.BadRequestError{Message: "WorkflowType is not set on request."}
 	ErrInvalidRetention                           = &types.BadRequestError{Message: "RetentionDays is invalid."}
 	ErrInvalidExecutionStartToCloseTimeoutSeconds = &types.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	ErrInvalidTaskStartToCloseTimeoutSeconds      = &types.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	ErrInvalidActivityTimeoutSeconds              = &types.BadRequestError{Message: "A valid activity timeout is not set on request."}
 	ErrInvalidDelayStartSeconds                   = &types.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
 	ErrInvalidJitterStartSeconds                  = &types.BadRequestError{Message: "A valid JitterStartSeconds is not set on request (negative)."}
 	ErrInvalidJitterStartSeconds2                 = &types.BadRequestError{Message: "A valid JitterStartSeconds is not set on request (larger than cron duration)."}
+	ErrInvalidPriority                            = &types.BadRequestError{Message: "A valid Priority is not set on request (must be between 0 and 10)."}
 	ErrQueryDisallowedForDomain                   = &types.BadRequestError{Message: "Domain is not allowed to query, please contact cadence team to re-enable queries."}
 	ErrClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	ErrEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
@@ -69,6 +75,7 @@ var (
 	ErrSignalNameTooLong   = &types.BadRequestError{Message: "SignalName length exceeds limit."}
 	ErrTaskListTooLong     = &types.BadRequestError{Message: "TaskList length exceeds limit."}
 	ErrRequestIDTooLong    = &types.BadRequestError{Message: "RequestID length exceeds limit."}
+	ErrUpdateIDTooLong     = &types.BadRequestError{Message: "UpdateID length exceeds limit."}
 	ErrIdentityTooLong     = &types.BadRequestError{Message: "Identity length exceeds limit."}
 )
 
@@ -86,6 +86,22 @@ func (a *adminHandler) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQ
 	return a.handler.CountDLQMessages(ctx, cp1)
 }
 
+func (a *adminHandler) DeleteTaskListTasks(ctx context.Context, dp1 *types.DeleteTaskListTasksRequest) (dp2 *types.DeleteTaskListTasksResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DeleteTaskListTasks",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DeleteTaskListTasks(ctx, dp1)
+}
+
 func (a *adminHandler) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DeleteWorkflow",
@@ -341,6 +357,22 @@ func (a *adminHandler) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyn
 	return a.handler.ListDynamicConfig(ctx, lp1)
 }
 
+func (a *adminHandler) ListTaskListTasks(ctx context.Context, lp1 *types.ListTaskListTasksRequest) (lp2 *types.ListTaskListTasksResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ListTaskListTasks",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListTaskListTasks(ctx, lp1)
+}
+
 func (a *adminHandler) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "MaintainCorruptWorkflow",
@@ -373,6 +405,22 @@ func (a *adminHandler) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQ
 	return a.handler.MergeDLQMessages(ctx, mp1)
 }
 
+func (a *adminHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (pp2 *types.PauseActivityResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "PauseActivity",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.PauseActivity(ctx, pp1)
+}
+
 func (a *adminHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "PurgeDLQMessages",
@@ -525,6 +573,22 @@ func (a *adminHandler) Stop() {
 	a.handler.Stop()
 }
 
+func (a *adminHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (up2 *types.UnpauseActivityResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseActivity",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UnpauseActivity(ctx, up1)
+}
+
 func (a *adminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, up1 *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (up2 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "UpdateDomainAsyncWorkflowConfiguraton",
@@ -247,6 +247,24 @@ func (a *apiHandler) GetSearchAttributes(ctx context.Context) (gp1 *types.GetSea
 	return a.handler.GetSearchAttributes(ctx)
 }
 
+func (a *apiHandler) GetTaskListBuildIDCompatibility(ctx context.Context, gp1 *types.GetTaskListBuildIDCompatibilityRequest) (gp2 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetTaskListBuildIDCompatibilityScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetTaskListBuildIDCompatibility",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetTaskListBuildIDCompatibility(ctx, gp1)
+}
+
 func (a *apiHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetTaskListsByDomainScope, gp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -766,6 +784,24 @@ func (a *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseSche
 	return a.handler.UnpauseSchedule(ctx, up1)
 }
 
+func (a *apiHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateActivityOptionsScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateActivityOptions",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateActivityOptions(ctx, up1)
+}
+
 func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendUpdateDomainScope)
 	attr := &authorization.Attributes{
@@ -800,3 +836,39 @@ func (a *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateSchedu
 	}
 	return a.handler.UpdateSchedule(ctx, up1)
 }
+
+func (a *apiHandler) UpdateTaskListBuildIDCompatibility(ctx context.Context, up1 *types.UpdateTaskListBuildIDCompatibilityRequest) (up2 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateTaskListBuildIDCompatibilityScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateTaskListBuildIDCompatibility",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateTaskListBuildIDCompatibility(ctx, up1)
+}
+
+func (a *apiHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateWorkflowExecution(ctx, up1)
+}
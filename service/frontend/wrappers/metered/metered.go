@@ -195,6 +195,22 @@ func toListTaskListPartitionsRequestTags(req *types.ListTaskListPartitionsReques
 	}
 }
 
+func toUpdateTaskListBuildIDCompatibilityRequestTags(req *types.UpdateTaskListBuildIDCompatibilityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowTaskListName(req.GetTaskList().GetName()),
+		tag.WorkflowTaskListKind(int32(req.GetTaskList().GetKind())),
+	}
+}
+
+func toGetTaskListBuildIDCompatibilityRequestTags(req *types.GetTaskListBuildIDCompatibilityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowTaskListName(req.GetTaskList().GetName()),
+		tag.WorkflowTaskListKind(int32(req.GetTaskList().GetKind())),
+	}
+}
+
 func toListWorkflowExecutionsRequestTags(req *types.ListWorkflowExecutionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -241,6 +257,22 @@ func toRefreshWorkflowTasksRequestTags(req *types.RefreshWorkflowTasksRequest) [
 	}
 }
 
+func toUpdateActivityOptionsRequestTags(req *types.UpdateActivityOptionsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toUpdateWorkflowExecutionRequestTags(req *types.UpdateWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
 func toRequestCancelWorkflowExecutionRequestTags(req *types.RequestCancelWorkflowExecutionRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
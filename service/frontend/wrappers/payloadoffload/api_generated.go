@@ -0,0 +1,243 @@
+package payloadoffload
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../templates/payloadoffload.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/offload"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/api"
+	"github.com/uber/cadence/service/frontend/config"
+)
+
+type (
+	// payloadOffloadHandler offloads large payloads of the requests to the blobstore,
+	// and rehydrates the offloaded payloads of the responses
+	payloadOffloadHandler struct {
+		frontendHandler api.Handler
+		domainCache     cache.DomainCache
+		offloader       *offload.Offloader
+		config          *config.Config
+		tokenSerializer common.TaskTokenSerializer
+	}
+)
+
+func NewAPIHandler(
+	wfHandler api.Handler,
+	domainCache cache.DomainCache,
+	offloader *offload.Offloader,
+	config *config.Config,
+) api.Handler {
+	return &payloadOffloadHandler{
+		frontendHandler: wfHandler,
+		domainCache:     domainCache,
+		offloader:       offloader,
+		config:          config,
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+	}
+}
+
+func (h *payloadOffloadHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (bp2 *types.BackfillScheduleResponse, err error) {
+	return h.frontendHandler.BackfillSchedule(ctx, bp1)
+}
+
+func (h *payloadOffloadHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
+	return h.frontendHandler.CountWorkflowExecutions(ctx, cp1)
+}
+
+func (h *payloadOffloadHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest) (cp2 *types.CreateScheduleResponse, err error) {
+	return h.frontendHandler.CreateSchedule(ctx, cp1)
+}
+
+func (h *payloadOffloadHandler) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest) (err error) {
+	return h.frontendHandler.DeleteDomain(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest) (dp2 *types.DeleteScheduleResponse, err error) {
+	return h.frontendHandler.DeleteSchedule(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
+	return h.frontendHandler.DeprecateDomain(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
+	return h.frontendHandler.DescribeDomain(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest) (dp2 *types.DescribeScheduleResponse, err error) {
+	return h.frontendHandler.DescribeSchedule(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
+	return h.frontendHandler.DescribeTaskList(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
+	return h.frontendHandler.DescribeWorkflowExecution(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) DiagnoseWorkflowExecution(ctx context.Context, dp1 *types.DiagnoseWorkflowExecutionRequest) (dp2 *types.DiagnoseWorkflowExecutionResponse, err error) {
+	return h.frontendHandler.DiagnoseWorkflowExecution(ctx, dp1)
+}
+
+func (h *payloadOffloadHandler) FailoverDomain(ctx context.Context, fp1 *types.FailoverDomainRequest) (fp2 *types.FailoverDomainResponse, err error) {
+	return h.frontendHandler.FailoverDomain(ctx, fp1)
+}
+
+func (h *payloadOffloadHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
+	return h.frontendHandler.GetClusterInfo(ctx)
+}
+
+func (h *payloadOffloadHandler) GetSearchAttributes(ctx context.Context) (gp1 *types.GetSearchAttributesResponse, err error) {
+	return h.frontendHandler.GetSearchAttributes(ctx)
+}
+
+func (h *payloadOffloadHandler) GetTaskListBuildIDCompatibility(ctx context.Context, gp1 *types.GetTaskListBuildIDCompatibilityRequest) (gp2 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	return h.frontendHandler.GetTaskListBuildIDCompatibility(ctx, gp1)
+}
+
+func (h *payloadOffloadHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
+	return h.frontendHandler.GetTaskListsByDomain(ctx, gp1)
+}
+
+func (h *payloadOffloadHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, err error) {
+	return h.frontendHandler.Health(ctx)
+}
+
+func (h *payloadOffloadHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *types.ListArchivedWorkflowExecutionsRequest) (lp2 *types.ListArchivedWorkflowExecutionsResponse, err error) {
+	return h.frontendHandler.ListArchivedWorkflowExecutions(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *types.ListClosedWorkflowExecutionsRequest) (lp2 *types.ListClosedWorkflowExecutionsResponse, err error) {
+	return h.frontendHandler.ListClosedWorkflowExecutions(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest) (lp2 *types.ListDomainsResponse, err error) {
+	return h.frontendHandler.ListDomains(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) ListFailoverHistory(ctx context.Context, lp1 *types.ListFailoverHistoryRequest) (lp2 *types.ListFailoverHistoryResponse, err error) {
+	return h.frontendHandler.ListFailoverHistory(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.ListOpenWorkflowExecutionsRequest) (lp2 *types.ListOpenWorkflowExecutionsResponse, err error) {
+	return h.frontendHandler.ListOpenWorkflowExecutions(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest) (lp2 *types.ListSchedulesResponse, err error) {
+	return h.frontendHandler.ListSchedules(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
+	return h.frontendHandler.ListTaskListPartitions(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
+	return h.frontendHandler.ListWorkflowExecutions(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
+	return h.frontendHandler.PauseSchedule(ctx, pp1)
+}
+
+func (h *payloadOffloadHandler) QueryWorkflow(ctx context.Context, qp1 *types.QueryWorkflowRequest) (qp2 *types.QueryWorkflowResponse, err error) {
+	return h.frontendHandler.QueryWorkflow(ctx, qp1)
+}
+
+func (h *payloadOffloadHandler) RecordActivityTaskHeartbeat(ctx context.Context, rp1 *types.RecordActivityTaskHeartbeatRequest) (rp2 *types.RecordActivityTaskHeartbeatResponse, err error) {
+	return h.frontendHandler.RecordActivityTaskHeartbeat(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, rp1 *types.RecordActivityTaskHeartbeatByIDRequest) (rp2 *types.RecordActivityTaskHeartbeatResponse, err error) {
+	return h.frontendHandler.RecordActivityTaskHeartbeatByID(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RefreshWorkflowTasks(ctx context.Context, rp1 *types.RefreshWorkflowTasksRequest) (err error) {
+	return h.frontendHandler.RefreshWorkflowTasks(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RegisterDomain(ctx context.Context, rp1 *types.RegisterDomainRequest) (err error) {
+	return h.frontendHandler.RegisterDomain(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionRequest) (err error) {
+	return h.frontendHandler.RequestCancelWorkflowExecution(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
+	return h.frontendHandler.ResetStickyTaskList(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) ResetWorkflowExecution(ctx context.Context, rp1 *types.ResetWorkflowExecutionRequest) (rp2 *types.ResetWorkflowExecutionResponse, err error) {
+	return h.frontendHandler.ResetWorkflowExecution(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RespondActivityTaskCanceled(ctx context.Context, rp1 *types.RespondActivityTaskCanceledRequest) (err error) {
+	return h.frontendHandler.RespondActivityTaskCanceled(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RespondActivityTaskCanceledByID(ctx context.Context, rp1 *types.RespondActivityTaskCanceledByIDRequest) (err error) {
+	return h.frontendHandler.RespondActivityTaskCanceledByID(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RespondActivityTaskFailed(ctx context.Context, rp1 *types.RespondActivityTaskFailedRequest) (err error) {
+	return h.frontendHandler.RespondActivityTaskFailed(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RespondActivityTaskFailedByID(ctx context.Context, rp1 *types.RespondActivityTaskFailedByIDRequest) (err error) {
+	return h.frontendHandler.RespondActivityTaskFailedByID(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RespondDecisionTaskCompleted(ctx context.Context, rp1 *types.RespondDecisionTaskCompletedRequest) (rp2 *types.RespondDecisionTaskCompletedResponse, err error) {
+	return h.frontendHandler.RespondDecisionTaskCompleted(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RespondDecisionTaskFailed(ctx context.Context, rp1 *types.RespondDecisionTaskFailedRequest) (err error) {
+	return h.frontendHandler.RespondDecisionTaskFailed(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RespondQueryTaskCompleted(ctx context.Context, rp1 *types.RespondQueryTaskCompletedRequest) (err error) {
+	return h.frontendHandler.RespondQueryTaskCompleted(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) RestartWorkflowExecution(ctx context.Context, rp1 *types.RestartWorkflowExecutionRequest) (rp2 *types.RestartWorkflowExecutionResponse, err error) {
+	return h.frontendHandler.RestartWorkflowExecution(ctx, rp1)
+}
+
+func (h *payloadOffloadHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
+	return h.frontendHandler.ScanWorkflowExecutions(ctx, lp1)
+}
+
+func (h *payloadOffloadHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.TerminateWorkflowExecutionRequest) (err error) {
+	return h.frontendHandler.TerminateWorkflowExecution(ctx, tp1)
+}
+
+func (h *payloadOffloadHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
+	return h.frontendHandler.UnpauseSchedule(ctx, up1)
+}
+
+func (h *payloadOffloadHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	return h.frontendHandler.UpdateActivityOptions(ctx, up1)
+}
+
+func (h *payloadOffloadHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
+	return h.frontendHandler.UpdateDomain(ctx, up1)
+}
+
+func (h *payloadOffloadHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest) (up2 *types.UpdateScheduleResponse, err error) {
+	return h.frontendHandler.UpdateSchedule(ctx, up1)
+}
+
+func (h *payloadOffloadHandler) UpdateTaskListBuildIDCompatibility(ctx context.Context, up1 *types.UpdateTaskListBuildIDCompatibilityRequest) (up2 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	return h.frontendHandler.UpdateTaskListBuildIDCompatibility(ctx, up1)
+}
+
+func (h *payloadOffloadHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	return h.frontendHandler.UpdateWorkflowExecution(ctx, up1)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadoffload
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/offload"
+	"github.com/uber/cadence/common/types"
+)
+
+func (h *payloadOffloadHandler) StartWorkflowExecution(ctx context.Context, request *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
+	request, err := h.offloadStartRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return h.frontendHandler.StartWorkflowExecution(ctx, request)
+}
+
+func (h *payloadOffloadHandler) StartWorkflowExecutionAsync(ctx context.Context, request *types.StartWorkflowExecutionAsyncRequest) (*types.StartWorkflowExecutionAsyncResponse, error) {
+	if request != nil {
+		startRequest, err := h.offloadStartRequest(ctx, request.StartWorkflowExecutionRequest)
+		if err != nil {
+			return nil, err
+		}
+		request = &types.StartWorkflowExecutionAsyncRequest{StartWorkflowExecutionRequest: startRequest}
+	}
+	return h.frontendHandler.StartWorkflowExecutionAsync(ctx, request)
+}
+
+func (h *payloadOffloadHandler) SignalWorkflowExecution(ctx context.Context, request *types.SignalWorkflowExecutionRequest) error {
+	if request != nil {
+		input, err := h.offloadByDomainName(ctx, request.GetDomain(), request.GetWorkflowExecution().GetWorkflowID(), request.Input)
+		if err != nil {
+			return err
+		}
+		offloaded := *request
+		offloaded.Input = input
+		request = &offloaded
+	}
+	return h.frontendHandler.SignalWorkflowExecution(ctx, request)
+}
+
+func (h *payloadOffloadHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *types.SignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
+	request, err := h.offloadSignalWithStartRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return h.frontendHandler.SignalWithStartWorkflowExecution(ctx, request)
+}
+
+func (h *payloadOffloadHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, request *types.SignalWithStartWorkflowExecutionAsyncRequest) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error) {
+	if request != nil {
+		signalWithStartRequest, err := h.offloadSignalWithStartRequest(ctx, request.SignalWithStartWorkflowExecutionRequest)
+		if err != nil {
+			return nil, err
+		}
+		request = &types.SignalWithStartWorkflowExecutionAsyncRequest{SignalWithStartWorkflowExecutionRequest: signalWithStartRequest}
+	}
+	return h.frontendHandler.SignalWithStartWorkflowExecutionAsync(ctx, request)
+}
+
+func (h *payloadOffloadHandler) RespondActivityTaskCompleted(ctx context.Context, request *types.RespondActivityTaskCompletedRequest) error {
+	if request != nil {
+		// invalid task tokens are left to the wrapped handler to report
+		if token, err := h.tokenSerializer.Deserialize(request.TaskToken); err == nil {
+			result, err := h.offloadByDomainID(ctx, token.DomainID, token.WorkflowID, request.Result)
+			if err != nil {
+				return err
+			}
+			offloaded := *request
+			offloaded.Result = result
+			request = &offloaded
+		}
+	}
+	return h.frontendHandler.RespondActivityTaskCompleted(ctx, request)
+}
+
+func (h *payloadOffloadHandler) RespondActivityTaskCompletedByID(ctx context.Context, request *types.RespondActivityTaskCompletedByIDRequest) error {
+	if request != nil {
+		result, err := h.offloadByDomainName(ctx, request.GetDomain(), request.WorkflowID, request.Result)
+		if err != nil {
+			return err
+		}
+		offloaded := *request
+		offloaded.Result = result
+		request = &offloaded
+	}
+	return h.frontendHandler.RespondActivityTaskCompletedByID(ctx, request)
+}
+
+func (h *payloadOffloadHandler) GetWorkflowExecutionHistory(ctx context.Context, request *types.GetWorkflowExecutionHistoryRequest) (*types.GetWorkflowExecutionHistoryResponse, error) {
+	response, err := h.frontendHandler.GetWorkflowExecutionHistory(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+	domainID, err := h.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	rehydrate := h.offloader.RehydrateHistory
+	if response.Archived {
+		rehydrate = h.offloader.RehydrateArchivedHistory
+	}
+	if err := rehydrate(ctx, domainID, request.GetWorkflowExecution().GetWorkflowID(), response.History); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (h *payloadOffloadHandler) PollForDecisionTask(ctx context.Context, request *types.PollForDecisionTaskRequest) (*types.PollForDecisionTaskResponse, error) {
+	response, err := h.frontendHandler.PollForDecisionTask(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+	domainID, err := h.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.offloader.RehydrateHistory(ctx, domainID, response.WorkflowExecution.GetWorkflowID(), response.History); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (h *payloadOffloadHandler) PollForActivityTask(ctx context.Context, request *types.PollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error) {
+	response, err := h.frontendHandler.PollForActivityTask(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+	domainID, err := h.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	if response.Input, err = h.offloader.Rehydrate(ctx, domainID, response.WorkflowExecution.GetWorkflowID(), response.Input); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (h *payloadOffloadHandler) offloadStartRequest(ctx context.Context, request *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	input, err := h.offloadByDomainName(ctx, request.GetDomain(), request.GetWorkflowID(), request.Input)
+	if err != nil {
+		return nil, err
+	}
+	offloaded := *request
+	offloaded.Input = input
+	return &offloaded, nil
+}
+
+func (h *payloadOffloadHandler) offloadSignalWithStartRequest(ctx context.Context, request *types.SignalWithStartWorkflowExecutionRequest) (*types.SignalWithStartWorkflowExecutionRequest, error) {
+	if request == nil {
+		return nil, nil
+	}
+	input, err := h.offloadByDomainName(ctx, request.GetDomain(), request.GetWorkflowID(), request.Input)
+	if err != nil {
+		return nil, err
+	}
+	signalInput, err := h.offloadByDomainName(ctx, request.GetDomain(), request.GetWorkflowID(), request.SignalInput)
+	if err != nil {
+		return nil, err
+	}
+	offloaded := *request
+	offloaded.Input = input
+	offloaded.SignalInput = signalInput
+	return &offloaded, nil
+}
+
+// offloadByDomainName offloads the payload if it is above the offload threshold of the domain
+func (h *payloadOffloadHandler) offloadByDomainName(ctx context.Context, domainName, workflowID string, payload []byte) ([]byte, error) {
+	if err := offload.ValidatePayload(payload); err != nil {
+		return nil, err
+	}
+	if !h.aboveThreshold(domainName, payload) {
+		return payload, nil
+	}
+	domainID, err := h.domainCache.GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+	return h.offloader.Offload(ctx, domainID, workflowID, payload)
+}
+
+// offloadByDomainID offloads the payload if it is above the offload threshold of the domain
+func (h *payloadOffloadHandler) offloadByDomainID(ctx context.Context, domainID, workflowID string, payload []byte) ([]byte, error) {
+	if err := offload.ValidatePayload(payload); err != nil {
+		return nil, err
+	}
+	if len(payload) == 0 {
+		return payload, nil
+	}
+	domainName, err := h.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return nil, err
+	}
+	if !h.aboveThreshold(domainName, payload) {
+		return payload, nil
+	}
+	return h.offloader.Offload(ctx, domainID, workflowID, payload)
+}
+
+func (h *payloadOffloadHandler) aboveThreshold(domainName string, payload []byte) bool {
+	threshold := h.config.PayloadOffloadThreshold(domainName)
+	return threshold > 0 && len(payload) > threshold
+}
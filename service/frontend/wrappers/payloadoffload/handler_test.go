@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadoffload
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/offload"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/api"
+	"github.com/uber/cadence/service/frontend/config"
+)
+
+const (
+	testDomainName = "test-domain"
+	testDomainID   = "test-domain-id"
+	testWorkflowID = "test-workflow-id"
+)
+
+type testDeps struct {
+	mockHandler     *api.MockHandler
+	mockDomainCache *cache.MockDomainCache
+	mockBlobstore   *blobstore.MockClient
+	handler         api.Handler
+	// blobs holds the payloads put in the mocked blobstore
+	blobs map[string][]byte
+}
+
+func newTestDeps(t *testing.T, threshold int) *testDeps {
+	ctrl := gomock.NewController(t)
+	deps := &testDeps{
+		mockHandler:     api.NewMockHandler(ctrl),
+		mockDomainCache: cache.NewMockDomainCache(ctrl),
+		mockBlobstore:   blobstore.NewMockClient(ctrl),
+		blobs:           map[string][]byte{},
+	}
+	deps.mockBlobstore.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+		assert.Equal(t, testDomainID, request.Blob.Tags[offload.TagDomainID])
+		assert.Equal(t, testWorkflowID, request.Blob.Tags[offload.TagWorkflowID])
+		deps.blobs[request.Key] = request.Blob.Body
+		return &blobstore.PutResponse{}, nil
+	}).AnyTimes()
+	deps.mockBlobstore.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+		return &blobstore.GetResponse{Blob: blobstore.Blob{Body: deps.blobs[request.Key]}}, nil
+	}).AnyTimes()
+	deps.handler = NewAPIHandler(
+		deps.mockHandler,
+		deps.mockDomainCache,
+		offload.NewOffloader(deps.mockBlobstore, clock.NewMockedTimeSource()),
+		&config.Config{PayloadOffloadThreshold: dynamicproperties.GetIntPropertyFilteredByDomain(threshold)},
+	)
+	return deps
+}
+
+func TestStartWorkflowExecution(t *testing.T) {
+	deps := newTestDeps(t, 16)
+	deps.mockDomainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil)
+
+	large := []byte(strings.Repeat("a", 17))
+	request := &types.StartWorkflowExecutionRequest{Domain: testDomainName, WorkflowID: testWorkflowID, Input: large}
+	deps.mockHandler.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, offloaded *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
+		assert.True(t, offload.IsReference(offloaded.Input))
+		assert.Equal(t, testWorkflowID, offloaded.WorkflowID)
+		return &types.StartWorkflowExecutionResponse{RunID: "run-id"}, nil
+	})
+	response, err := deps.handler.StartWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "run-id", response.RunID)
+	// the caller's request is left untouched
+	assert.Equal(t, large, request.Input)
+	assert.Len(t, deps.blobs, 1)
+
+	small := &types.StartWorkflowExecutionRequest{Domain: testDomainName, WorkflowID: testWorkflowID, Input: []byte("small")}
+	deps.mockHandler.EXPECT().StartWorkflowExecution(gomock.Any(), small).Return(&types.StartWorkflowExecutionResponse{}, nil)
+	_, err = deps.handler.StartWorkflowExecution(context.Background(), small)
+	require.NoError(t, err)
+	assert.Len(t, deps.blobs, 1)
+}
+
+func TestOffloadingDisabled(t *testing.T) {
+	deps := newTestDeps(t, 0)
+
+	request := &types.SignalWorkflowExecutionRequest{
+		Domain:            testDomainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		Input:             []byte(strings.Repeat("a", 1024)),
+	}
+	deps.mockHandler.EXPECT().SignalWorkflowExecution(gomock.Any(), request).Return(nil)
+	require.NoError(t, deps.handler.SignalWorkflowExecution(context.Background(), request))
+	assert.Empty(t, deps.blobs)
+}
+
+func TestSignalWithStartWorkflowExecution(t *testing.T) {
+	deps := newTestDeps(t, 4)
+	deps.mockDomainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil).Times(2)
+
+	request := &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:      testDomainName,
+		WorkflowID:  testWorkflowID,
+		Input:       []byte("workflow input"),
+		SignalInput: []byte("signal input"),
+	}
+	deps.mockHandler.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, offloaded *types.SignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
+		assert.True(t, offload.IsReference(offloaded.Input))
+		assert.True(t, offload.IsReference(offloaded.SignalInput))
+		return &types.StartWorkflowExecutionResponse{}, nil
+	})
+	_, err := deps.handler.SignalWithStartWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	assert.Len(t, deps.blobs, 2)
+}
+
+func TestRespondActivityTaskCompleted(t *testing.T) {
+	deps := newTestDeps(t, 4)
+	deps.mockDomainCache.EXPECT().GetDomainName(testDomainID).Return(testDomainName, nil)
+
+	token, err := common.NewJSONTaskTokenSerializer().Serialize(&common.TaskToken{DomainID: testDomainID, WorkflowID: testWorkflowID})
+	require.NoError(t, err)
+	request := &types.RespondActivityTaskCompletedRequest{TaskToken: token, Result: []byte("activity result")}
+	deps.mockHandler.EXPECT().RespondActivityTaskCompleted(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, offloaded *types.RespondActivityTaskCompletedRequest) error {
+		assert.True(t, offload.IsReference(offloaded.Result))
+		return nil
+	})
+	require.NoError(t, deps.handler.RespondActivityTaskCompleted(context.Background(), request))
+
+	// invalid tokens are passed through
+	invalid := &types.RespondActivityTaskCompletedRequest{TaskToken: []byte("invalid"), Result: []byte("activity result")}
+	deps.mockHandler.EXPECT().RespondActivityTaskCompleted(gomock.Any(), invalid).Return(&types.BadRequestError{})
+	assert.Error(t, deps.handler.RespondActivityTaskCompleted(context.Background(), invalid))
+}
+
+func TestRehydration(t *testing.T) {
+	deps := newTestDeps(t, 4)
+	deps.mockDomainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil).AnyTimes()
+
+	var reference []byte
+	deps.mockHandler.EXPECT().RespondActivityTaskCompletedByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, offloaded *types.RespondActivityTaskCompletedByIDRequest) error {
+		reference = offloaded.Result
+		return nil
+	})
+	require.NoError(t, deps.handler.RespondActivityTaskCompletedByID(context.Background(), &types.RespondActivityTaskCompletedByIDRequest{
+		Domain:     testDomainName,
+		WorkflowID: testWorkflowID,
+		Result:     []byte("activity result"),
+	}))
+	require.True(t, offload.IsReference(reference))
+
+	history := func() *types.History {
+		return &types.History{Events: []*types.HistoryEvent{{
+			EventType:                            types.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{Result: reference},
+		}}}
+	}
+
+	execution := &types.WorkflowExecution{WorkflowID: testWorkflowID}
+	deps.mockHandler.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{History: history()}, nil)
+	historyResponse, err := deps.handler.GetWorkflowExecutionHistory(context.Background(), &types.GetWorkflowExecutionHistoryRequest{Domain: testDomainName, Execution: execution})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("activity result"), historyResponse.History.Events[0].ActivityTaskCompletedEventAttributes.Result)
+
+	deps.mockHandler.EXPECT().PollForDecisionTask(gomock.Any(), gomock.Any()).Return(&types.PollForDecisionTaskResponse{WorkflowExecution: execution, History: history()}, nil)
+	decisionTask, err := deps.handler.PollForDecisionTask(context.Background(), &types.PollForDecisionTaskRequest{Domain: testDomainName})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("activity result"), decisionTask.History.Events[0].ActivityTaskCompletedEventAttributes.Result)
+
+	deps.mockHandler.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Return(&types.PollForActivityTaskResponse{WorkflowExecution: execution, Input: reference}, nil)
+	activityTask, err := deps.handler.PollForActivityTask(context.Background(), &types.PollForActivityTaskRequest{Domain: testDomainName})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("activity result"), activityTask.Input)
+
+	// references are only resolved for the workflow they were offloaded for
+	deps.mockHandler.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Return(&types.PollForActivityTaskResponse{
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "other-workflow-id"},
+		Input:             reference,
+	}, nil)
+	_, err = deps.handler.PollForActivityTask(context.Background(), &types.PollForActivityTaskRequest{Domain: testDomainName})
+	assert.Error(t, err)
+}
+
+func TestForgedReference(t *testing.T) {
+	deps := newTestDeps(t, 4)
+	deps.mockDomainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil)
+
+	var reference []byte
+	deps.mockHandler.EXPECT().RespondActivityTaskCompletedByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, offloaded *types.RespondActivityTaskCompletedByIDRequest) error {
+		reference = offloaded.Result
+		return nil
+	})
+	require.NoError(t, deps.handler.RespondActivityTaskCompletedByID(context.Background(), &types.RespondActivityTaskCompletedByIDRequest{
+		Domain:     testDomainName,
+		WorkflowID: testWorkflowID,
+		Result:     []byte("activity result"),
+	}))
+	require.True(t, offload.IsReference(reference))
+
+	// clients cannot submit references, which would let them read payloads of other workflows
+	err := deps.handler.SignalWorkflowExecution(context.Background(), &types.SignalWorkflowExecutionRequest{
+		Domain:            testDomainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		Input:             reference,
+	})
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
@@ -23,6 +23,11 @@ func (g AdminHandler) CloseShard(ctx context.Context, Request *shared.CloseShard
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) DeleteTaskListTasks(ctx context.Context, Request *shared.DeleteTaskListTasksRequest) (dp1 *shared.DeleteTaskListTasksResponse, err error) {
+	response, err := g.h.DeleteTaskListTasks(ctx, thrift.ToAdminDeleteTaskListTasksRequest(Request))
+	return thrift.FromAdminDeleteTaskListTasksResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) DeleteWorkflow(ctx context.Context, Request *admin.AdminDeleteWorkflowRequest) (ap1 *admin.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.h.DeleteWorkflow(ctx, thrift.ToAdminDeleteWorkflowRequest(Request))
 	return thrift.FromAdminDeleteWorkflowResponse(response), thrift.FromError(err)
@@ -103,6 +108,11 @@ func (g AdminHandler) ListDynamicConfig(ctx context.Context, Request *admin.List
 	return thrift.FromAdminListDynamicConfigResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) ListTaskListTasks(ctx context.Context, Request *shared.ListTaskListTasksRequest) (lp1 *shared.ListTaskListTasksResponse, err error) {
+	response, err := g.h.ListTaskListTasks(ctx, thrift.ToAdminListTaskListTasksRequest(Request))
+	return thrift.FromAdminListTaskListTasksResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) MaintainCorruptWorkflow(ctx context.Context, Request *admin.AdminMaintainWorkflowRequest) (ap1 *admin.AdminMaintainWorkflowResponse, err error) {
 	response, err := g.h.MaintainCorruptWorkflow(ctx, thrift.ToAdminMaintainCorruptWorkflowRequest(Request))
 	return thrift.FromAdminMaintainCorruptWorkflowResponse(response), thrift.FromError(err)
@@ -113,6 +123,11 @@ func (g AdminHandler) MergeDLQMessages(ctx context.Context, Request *replicator.
 	return thrift.FromAdminMergeDLQMessagesResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) PauseActivity(ctx context.Context, Request *shared.PauseActivityRequest) (pp1 *shared.PauseActivityResponse, err error) {
+	response, err := g.h.PauseActivity(ctx, thrift.ToAdminPauseActivityRequest(Request))
+	return thrift.FromAdminPauseActivityResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) PurgeDLQMessages(ctx context.Context, Request *replicator.PurgeDLQMessagesRequest) (err error) {
 	err = g.h.PurgeDLQMessages(ctx, thrift.ToAdminPurgeDLQMessagesRequest(Request))
 	return thrift.FromError(err)
@@ -158,6 +173,11 @@ func (g AdminHandler) RestoreDynamicConfig(ctx context.Context, Request *admin.R
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) UnpauseActivity(ctx context.Context, Request *shared.UnpauseActivityRequest) (up1 *shared.UnpauseActivityResponse, err error) {
+	response, err := g.h.UnpauseActivity(ctx, thrift.ToAdminUnpauseActivityRequest(Request))
+	return thrift.FromAdminUnpauseActivityResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest) (up1 *admin.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.h.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonRequest(Request))
 	return thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.FromError(err)
@@ -61,6 +61,11 @@ func (g APIHandler) GetSearchAttributes(ctx context.Context) (gp1 *shared.GetSea
 	return thrift.FromGetSearchAttributesResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) GetTaskListBuildIDCompatibility(ctx context.Context, Request *shared.GetTaskListBuildIDCompatibilityRequest) (gp1 *shared.GetTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.h.GetTaskListBuildIDCompatibility(ctx, thrift.ToGetTaskListBuildIDCompatibilityRequest(Request))
+	return thrift.FromGetTaskListBuildIDCompatibilityResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) GetTaskListsByDomain(ctx context.Context, Request *shared.GetTaskListsByDomainRequest) (gp1 *shared.GetTaskListsByDomainResponse, err error) {
 	response, err := g.h.GetTaskListsByDomain(ctx, thrift.ToGetTaskListsByDomainRequest(Request))
 	return thrift.FromGetTaskListsByDomainResponse(response), thrift.FromError(err)
@@ -241,7 +246,22 @@ func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, TerminateReq
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) UpdateActivityOptions(ctx context.Context, UpdateRequest *shared.UpdateActivityOptionsRequest) (up1 *shared.UpdateActivityOptionsResponse, err error) {
+	response, err := g.h.UpdateActivityOptions(ctx, thrift.ToUpdateActivityOptionsRequest(UpdateRequest))
+	return thrift.FromUpdateActivityOptionsResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (up1 *shared.UpdateDomainResponse, err error) {
 	response, err := g.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(UpdateRequest))
 	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
 }
+
+func (g APIHandler) UpdateTaskListBuildIDCompatibility(ctx context.Context, Request *shared.UpdateTaskListBuildIDCompatibilityRequest) (up1 *shared.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.h.UpdateTaskListBuildIDCompatibility(ctx, thrift.ToUpdateTaskListBuildIDCompatibilityRequest(Request))
+	return thrift.FromUpdateTaskListBuildIDCompatibilityResponse(response), thrift.FromError(err)
+}
+
+func (g APIHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest) (up1 *shared.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, thrift.ToUpdateWorkflowExecutionRequest(UpdateRequest))
+	return thrift.FromUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
@@ -260,10 +260,34 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateActivityOptions", func(t *testing.T) {
+		h.EXPECT().UpdateActivityOptions(ctx, &types.UpdateActivityOptionsRequest{}).Return(&types.UpdateActivityOptionsResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateActivityOptions(ctx, &shared.UpdateActivityOptionsRequest{})
+		assert.Equal(t, shared.UpdateActivityOptionsResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("UpdateDomain", func(t *testing.T) {
 		h.EXPECT().UpdateDomain(ctx, &types.UpdateDomainRequest{}).Return(&types.UpdateDomainResponse{}, internalErr).Times(1)
 		resp, err := th.UpdateDomain(ctx, &shared.UpdateDomainRequest{})
 		assert.Equal(t, shared.UpdateDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowExecution(ctx, &types.UpdateWorkflowExecutionRequest{}).Return(&types.UpdateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowExecution(ctx, &shared.UpdateWorkflowExecutionRequest{})
+		assert.Equal(t, shared.UpdateWorkflowExecutionResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpdateTaskListBuildIDCompatibility", func(t *testing.T) {
+		h.EXPECT().UpdateTaskListBuildIDCompatibility(ctx, &types.UpdateTaskListBuildIDCompatibilityRequest{}).Return(&types.UpdateTaskListBuildIDCompatibilityResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateTaskListBuildIDCompatibility(ctx, &shared.UpdateTaskListBuildIDCompatibilityRequest{})
+		assert.Equal(t, shared.UpdateTaskListBuildIDCompatibilityResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("GetTaskListBuildIDCompatibility", func(t *testing.T) {
+		h.EXPECT().GetTaskListBuildIDCompatibility(ctx, &types.GetTaskListBuildIDCompatibilityRequest{}).Return(&types.GetTaskListBuildIDCompatibilityResponse{}, internalErr).Times(1)
+		resp, err := th.GetTaskListBuildIDCompatibility(ctx, &shared.GetTaskListBuildIDCompatibilityRequest{})
+		assert.Equal(t, shared.GetTaskListBuildIDCompatibilityResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
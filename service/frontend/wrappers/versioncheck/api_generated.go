@@ -141,6 +141,14 @@ func (h *versionCheckHandler) GetSearchAttributes(ctx context.Context) (gp1 *typ
 	return h.frontendHandler.GetSearchAttributes(ctx)
 }
 
+func (h *versionCheckHandler) GetTaskListBuildIDCompatibility(ctx context.Context, gp1 *types.GetTaskListBuildIDCompatibilityRequest) (gp2 *types.GetTaskListBuildIDCompatibilityResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetTaskListBuildIDCompatibility(ctx, gp1)
+}
+
 func (h *versionCheckHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	return h.frontendHandler.GetTaskListsByDomain(ctx, gp1)
 }
@@ -445,6 +453,14 @@ func (h *versionCheckHandler) UnpauseSchedule(ctx context.Context, up1 *types.Un
 	return h.frontendHandler.UnpauseSchedule(ctx, up1)
 }
 
+func (h *versionCheckHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UpdateActivityOptions(ctx, up1)
+}
+
 func (h *versionCheckHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -460,3 +476,19 @@ func (h *versionCheckHandler) UpdateSchedule(ctx context.Context, up1 *types.Upd
 	}
 	return h.frontendHandler.UpdateSchedule(ctx, up1)
 }
+
+func (h *versionCheckHandler) UpdateTaskListBuildIDCompatibility(ctx context.Context, up1 *types.UpdateTaskListBuildIDCompatibilityRequest) (up2 *types.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UpdateTaskListBuildIDCompatibility(ctx, up1)
+}
+
+func (h *versionCheckHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UpdateWorkflowExecution(ctx, up1)
+}
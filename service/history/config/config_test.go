@@ -234,6 +234,7 @@ func TestNewConfig(t *testing.T) {
 		"EnableConsistentQuery":                                {dynamicproperties.EnableConsistentQuery, true},
 		"EnableConsistentQueryByDomain":                        {dynamicproperties.EnableConsistentQueryByDomain, true},
 		"MaxBufferedQueryCount":                                {dynamicproperties.MaxBufferedQueryCount, 89},
+		"MaxBufferedUpdateCount":                               {dynamicproperties.MaxBufferedUpdateCount, 102},
 		"EnableContextHeaderInVisibility":                      {dynamicproperties.EnableContextHeaderInVisibility, true},
 		"EnableCrossClusterOperationsForDomain":                {dynamicproperties.EnableCrossClusterOperationsForDomain, true},
 		"MutableStateChecksumGenProbability":                   {dynamicproperties.MutableStateChecksumGenProbability, 90},
@@ -244,6 +245,7 @@ func TestNewConfig(t *testing.T) {
 		"NotifyFailoverMarkerTimerJitterCoefficient":           {dynamicproperties.NotifyFailoverMarkerTimerJitterCoefficient, 16.0},
 		"EnableGracefulFailover":                               {dynamicproperties.EnableGracefulFailover, true},
 		"EnableActivityLocalDispatchByDomain":                  {dynamicproperties.EnableActivityLocalDispatchByDomain, true},
+		"ActivityLocalDispatchLimitPerDecision":                {dynamicproperties.ActivityLocalDispatchLimitPerDecision, 92},
 		"ActivityMaxScheduleToStartTimeoutForRetry":            {dynamicproperties.ActivityMaxScheduleToStartTimeoutForRetry, time.Second},
 		"EnableDebugMode":                                      {dynamicproperties.EnableDebugMode, true},
 		"EnableTaskInfoLogByDomainID":                          {dynamicproperties.HistoryEnableTaskInfoLogByDomainID, true},
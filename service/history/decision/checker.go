@@ -284,6 +284,14 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 	}
 	wfTimeout := executionInfo.WorkflowTimeout
 
+	// activities inherit the priority of their workflow unless the decision overrides it
+	if attributes.Priority == nil {
+		attributes.Priority = common.Int32Ptr(executionInfo.Priority)
+	}
+	if attributes.GetPriority() < 0 || attributes.GetPriority() > constants.MaxTaskPriority {
+		return &types.BadRequestError{Message: fmt.Sprintf("A valid Priority is not set on decision (must be between 0 and %d).", constants.MaxTaskPriority)}
+	}
+
 	// ensure activity timeout never larger than workflow timeout
 	if attributes.GetScheduleToCloseTimeoutSeconds() > wfTimeout {
 		attributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(wfTimeout)
@@ -648,6 +656,25 @@ func (v *attrValidator) validateUpsertWorkflowSearchAttributes(
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
+func (v *attrValidator) validateUpsertWorkflowMemo(
+	attributes *types.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "UpsertWorkflowMemoDecisionAttributes is not set on decision."}
+	}
+
+	if attributes.Memo == nil {
+		return &types.BadRequestError{Message: "Memo is not set on decision."}
+	}
+
+	if len(attributes.GetMemo().GetFields()) == 0 {
+		return &types.BadRequestError{Message: "Memo fields is empty on decision."}
+	}
+
+	return nil
+}
+
 func (v *attrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes,
 	executionInfo *persistence.WorkflowExecutionInfo,
@@ -185,6 +185,25 @@ func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *attrValidatorSuite) TestValidateUpsertWorkflowMemo() {
+	var attributes *types.UpsertWorkflowMemoDecisionAttributes
+
+	err := s.validator.validateUpsertWorkflowMemo(attributes)
+	s.EqualError(err, "UpsertWorkflowMemoDecisionAttributes is not set on decision.")
+
+	attributes = &types.UpsertWorkflowMemoDecisionAttributes{}
+	err = s.validator.validateUpsertWorkflowMemo(attributes)
+	s.EqualError(err, "Memo is not set on decision.")
+
+	attributes.Memo = &types.Memo{}
+	err = s.validator.validateUpsertWorkflowMemo(attributes)
+	s.EqualError(err, "Memo fields is empty on decision.")
+
+	attributes.Memo.Fields = map[string][]byte{"memoKey": []byte("memoValue")}
+	err = s.validator.validateUpsertWorkflowMemo(attributes)
+	s.Nil(err)
+}
+
 func (s *attrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: s.testDomainID},
@@ -625,6 +644,7 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_NoRetryPolic
 		ScheduleToStartTimeoutSeconds: attributes.ScheduleToStartTimeoutSeconds,
 		StartToCloseTimeoutSeconds:    attributes.StartToCloseTimeoutSeconds,
 		HeartbeatTimeoutSeconds:       common.Int32Ptr(wfTimeout),
+		Priority:                      common.Int32Ptr(0),
 	}
 
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
@@ -691,6 +711,7 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPol
 		StartToCloseTimeoutSeconds:    attributes.StartToCloseTimeoutSeconds,
 		HeartbeatTimeoutSeconds:       attributes.HeartbeatTimeoutSeconds,
 		RetryPolicy:                   attributes.RetryPolicy,
+		Priority:                      common.Int32Ptr(0),
 	}
 
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
@@ -755,6 +776,7 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPol
 		StartToCloseTimeoutSeconds:    attributes.StartToCloseTimeoutSeconds,
 		HeartbeatTimeoutSeconds:       attributes.HeartbeatTimeoutSeconds,
 		RetryPolicy:                   attributes.RetryPolicy,
+		Priority:                      common.Int32Ptr(0),
 	}
 
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
@@ -784,6 +806,80 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPol
 	s.Equal(expectedAttributesAfterValidation, attributes)
 }
 
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_Priority() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	targetDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testTargetDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		WorkflowTimeout: 100,
+		Priority:        3,
+	}
+
+	testCases := []struct {
+		name             string
+		priority         *int32
+		expectedPriority int32
+		expectErr        bool
+	}{
+		{
+			name:             "inherited from workflow",
+			priority:         nil,
+			expectedPriority: 3,
+		},
+		{
+			name:             "set on decision",
+			priority:         common.Int32Ptr(commonconstants.MaxTaskPriority),
+			expectedPriority: commonconstants.MaxTaskPriority,
+		},
+		{
+			name:      "negative",
+			priority:  common.Int32Ptr(-1),
+			expectErr: true,
+		},
+		{
+			name:      "above max",
+			priority:  common.Int32Ptr(commonconstants.MaxTaskPriority + 1),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(domainEntry, nil).Times(1)
+			s.mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(targetDomainEntry, nil).Times(1)
+
+			attributes := &types.ScheduleActivityTaskDecisionAttributes{
+				ActivityID:                    "some random activityID",
+				ActivityType:                  &types.ActivityType{Name: "some random activity type"},
+				Domain:                        s.testDomainID,
+				TaskList:                      &types.TaskList{Name: "some random task list"},
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(10),
+				Priority:                      tc.priority,
+			}
+			err := s.validator.validateActivityScheduleAttributes(
+				s.testDomainID,
+				s.testTargetDomainID,
+				attributes,
+				executionInfo,
+				metrics.HistoryRespondDecisionTaskCompletedScope,
+			)
+			if tc.expectErr {
+				s.IsType(&types.BadRequestError{}, err)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.expectedPriority, attributes.GetPriority())
+		})
+	}
+}
+
 const (
 	testDomainID   = "test-domain-id"
 	testDomainName = "test-domain"
@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -215,7 +216,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 			if decision.StartedID != constants.EmptyEventID {
 				// If decision is started as part of the current request scope then return a positive response
 				if decision.RequestID == requestID {
-					resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, decision, req.PollRequest.GetIdentity())
+					resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, context.GetUpdateRegistry(), decision, req.PollRequest.GetIdentity())
 					if err != nil {
 						return nil, err
 					}
@@ -234,7 +235,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.InternalServiceError{Message: "Unable to add DecisionTaskStarted event to history."}
 			}
 
-			resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, decision, req.PollRequest.GetIdentity())
+			resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, context.GetUpdateRegistry(), decision, req.PollRequest.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -331,6 +332,7 @@ func (handler *handlerImpl) HandleDecisionTaskCompleted(
 		return nil, err
 	}
 	defer func() { release(retError) }()
+	updateRegistry := wfContext.GetUpdateRegistry()
 
 Update_History_Loop:
 	for attempt := 0; attempt < workflow.ConditionalRetryCount; attempt++ {
@@ -408,6 +410,7 @@ Update_History_Loop:
 			continueAsNewBuilder        execution.MutableState
 			hasUnhandledEvents          bool
 			decisionResults             []*decisionResult
+			updateFailures              map[string]error
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
 
@@ -429,6 +432,10 @@ Update_History_Loop:
 			failDecision = true
 			failCause = types.DecisionTaskFailedCauseBadBinary
 			failMessage = fmt.Sprintf("binary %v is already marked as bad deployment", binChecksum)
+		} else if unknownUpdateID, ok := findUnknownUpdate(updateRegistry, request.GetUpdateResults()); ok {
+			failDecision = true
+			failCause = types.DecisionTaskFailedCauseUnknownUpdate
+			failMessage = fmt.Sprintf("update %v was not delivered to the worker", unknownUpdateID)
 		} else {
 			workflowSizeChecker := newWorkflowSizeChecker(
 				domainName,
@@ -459,6 +466,16 @@ Update_History_Loop:
 				handler.config,
 			)
 
+			if updateFailures, err = handler.recordAcceptedUpdates(
+				msBuilder,
+				updateRegistry,
+				completedEvent.ID,
+				request,
+				domainEntry,
+			); err != nil {
+				return nil, err
+			}
+
 			if decisionResults, err = decisionTaskHandler.handleDecisions(
 				ctx,
 				request.ExecutionContext,
@@ -494,9 +511,11 @@ Update_History_Loop:
 			continueAsNewBuilder = nil
 		}
 
-		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
-		logger.Debugf("createNewDecisionTask: %v, msBuilder.IsWorkflowExecutionRunning: %v, hasUnhandledEvents: %v, request.GetForceCreateNewDecisionTask: %v, activityNotStartedCancelled: %v",
-			createNewDecisionTask, msBuilder.IsWorkflowExecutionRunning(), hasUnhandledEvents, request.GetForceCreateNewDecisionTask(), activityNotStartedCancelled)
+		// updates which arrived while the decision was in flight are delivered with a new decision
+		hasBufferedUpdates := updateRegistry.HasBufferedUpdate()
+		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled || hasBufferedUpdates)
+		logger.Debugf("createNewDecisionTask: %v, msBuilder.IsWorkflowExecutionRunning: %v, hasUnhandledEvents: %v, request.GetForceCreateNewDecisionTask: %v, activityNotStartedCancelled: %v, hasBufferedUpdates: %v",
+			createNewDecisionTask, msBuilder.IsWorkflowExecutionRunning(), hasUnhandledEvents, request.GetForceCreateNewDecisionTask(), activityNotStartedCancelled, hasBufferedUpdates)
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			var newDecision *execution.DecisionInfo
@@ -610,6 +629,17 @@ Update_History_Loop:
 			domainEntry,
 			decisionHeartbeating)
 
+		// delivered updates are left for the next decision if this one was failed
+		if !failDecision {
+			handler.handleDeliveredUpdates(
+				msBuilder,
+				updateRegistry,
+				request.GetUpdateResults(),
+				updateFailures,
+				domainEntry,
+				decisionHeartbeating)
+		}
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, &types.EntityNotExistsError{
@@ -634,7 +664,7 @@ Update_History_Loop:
 
 		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
 			decision, _ := msBuilder.GetDecisionInfo(newDecisionTaskScheduledID)
-			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, decision, request.GetIdentity())
+			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, updateRegistry, decision, request.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -651,6 +681,7 @@ Update_History_Loop:
 func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	domainID string,
 	msBuilder execution.MutableState,
+	updateRegistry update.Registry,
 	decision *execution.DecisionInfo,
 	identity string,
 ) (*types.RecordDecisionTaskStartedResponse, error) {
@@ -710,10 +741,198 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 		queries[id] = input
 	}
 	response.Queries = queries
+	response.Updates = getUpdatesToDeliver(updateRegistry)
 	response.HistorySize = msBuilder.GetHistorySize()
 	return response, nil
 }
 
+// getUpdatesToDeliver marks all buffered updates as delivered and returns every delivered update,
+// updates delivered with an earlier decision which did not complete are delivered again
+func getUpdatesToDeliver(updateRegistry update.Registry) map[string]*types.WorkflowUpdate {
+	for _, id := range updateRegistry.GetBufferedIDs() {
+		// the update may have been failed concurrently because its registry was dropped
+		_ = updateRegistry.SetDelivered(id)
+	}
+	delivered := updateRegistry.GetDeliveredIDs()
+	if len(delivered) == 0 {
+		return nil
+	}
+	updates := make(map[string]*types.WorkflowUpdate, len(delivered))
+	for _, id := range delivered {
+		input, err := updateRegistry.GetUpdateInput(id)
+		if err != nil {
+			continue
+		}
+		updates[id] = input
+	}
+	return updates
+}
+
+// findUnknownUpdate returns the ID of an update result which does not belong to a delivered update
+func findUnknownUpdate(
+	updateRegistry update.Registry,
+	updateResults map[string]*types.WorkflowUpdateResult,
+) (string, bool) {
+	if len(updateResults) == 0 {
+		return "", false
+	}
+	delivered := make(map[string]struct{})
+	for _, id := range updateRegistry.GetDeliveredIDs() {
+		delivered[id] = struct{}{}
+	}
+	for id := range updateResults {
+		if _, ok := delivered[id]; !ok {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// recordAcceptedUpdates adds an UpdateAccepted event for every update accepted by the worker.
+// The event is recorded even when the result is too large, so that replay applies the update,
+// but the result is dropped and the caller receives the size error instead.
+func (handler *handlerImpl) recordAcceptedUpdates(
+	msBuilder execution.MutableState,
+	updateRegistry update.Registry,
+	decisionCompletedEventID int64,
+	request *types.RespondDecisionTaskCompletedRequest,
+	domainEntry *cache.DomainCacheEntry,
+) (map[string]error, error) {
+
+	domainID := domainEntry.GetInfo().ID
+	domain := domainEntry.GetInfo().Name
+	workflowID := msBuilder.GetExecutionInfo().WorkflowID
+	runID := msBuilder.GetExecutionInfo().RunID
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domain),
+		metrics.DecisionTypeTag("WorkflowUpdate"))
+
+	updateFailures := make(map[string]error)
+	for id, result := range request.GetUpdateResults() {
+		if result.GetResultType() != types.UpdateResultTypeAccepted {
+			continue
+		}
+		input, err := updateRegistry.GetUpdateInput(id)
+		if err != nil {
+			return nil, err
+		}
+		updateResult := result.GetResult()
+		if err := common.CheckEventBlobSizeLimit(
+			len(updateResult),
+			handler.config.BlobSizeLimitWarn(domain),
+			handler.config.BlobSizeLimitError(domain),
+			domainID,
+			domain,
+			workflowID,
+			runID,
+			scope,
+			handler.logger,
+			tag.BlobSizeViolationOperation("WorkflowUpdate"),
+		); err != nil {
+			updateFailures[id] = err
+			updateResult = nil
+		}
+		if _, err := msBuilder.AddWorkflowExecutionUpdateAcceptedEvent(
+			decisionCompletedEventID,
+			id,
+			input,
+			updateResult,
+			request.GetIdentity(),
+		); err != nil {
+			return nil, &types.InternalServiceError{Message: "Unable to add WorkflowExecutionUpdateAccepted event to history."}
+		}
+	}
+	return updateFailures, nil
+}
+
+// handleDeliveredUpdates completes the delivered updates with the results of a persisted decision.
+// Delivered updates without a result are failed, unless the decision is a heartbeat, and buffered
+// updates are failed if the decision closed the workflow.
+func (handler *handlerImpl) handleDeliveredUpdates(
+	msBuilder execution.MutableState,
+	updateRegistry update.Registry,
+	updateResults map[string]*types.WorkflowUpdateResult,
+	updateFailures map[string]error,
+	domainEntry *cache.DomainCacheEntry,
+	decisionHeartbeating bool,
+) {
+	if !updateRegistry.HasDeliveredUpdate() && !updateRegistry.HasBufferedUpdate() {
+		return
+	}
+
+	domain := domainEntry.GetInfo().Name
+	workflowID := msBuilder.GetExecutionInfo().WorkflowID
+	runID := msBuilder.GetExecutionInfo().RunID
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domain),
+		metrics.DecisionTypeTag("WorkflowUpdate"))
+
+	setTerminationState := func(id string, terminationState *update.TerminationState) {
+		if err := updateRegistry.SetTerminationState(id, terminationState); err != nil {
+			handler.logger.Error(
+				"failed to set update termination state",
+				tag.WorkflowDomainName(domain),
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(runID),
+				tag.UpdateID(id),
+				tag.Error(err))
+			scope.IncCounter(metrics.UpdateRegistryInvalidStateCount)
+		}
+	}
+
+	for _, id := range updateRegistry.GetDeliveredIDs() {
+		result, ok := updateResults[id]
+		switch {
+		case ok && updateFailures[id] != nil:
+			setTerminationState(id, &update.TerminationState{
+				TerminationType: update.TerminationTypeFailed,
+				Failure:         updateFailures[id],
+				Persisted:       true,
+			})
+		case ok:
+			persisted := false
+			if result.GetResultType() == types.UpdateResultTypeRejected {
+				// a rejected update has no result and always carries a reason for the caller
+				errorMessage := result.GetErrorMessage()
+				if errorMessage == "" {
+					errorMessage = "update rejected by the worker"
+				}
+				result = &types.WorkflowUpdateResult{
+					ResultType:   types.UpdateResultTypeRejected.Ptr(),
+					ErrorMessage: errorMessage,
+				}
+			} else {
+				result = &types.WorkflowUpdateResult{
+					ResultType: types.UpdateResultTypeAccepted.Ptr(),
+					Result:     result.GetResult(),
+				}
+				persisted = true
+			}
+			setTerminationState(id, &update.TerminationState{
+				TerminationType: update.TerminationTypeCompleted,
+				UpdateResult:    result,
+				Persisted:       persisted,
+			})
+		case !decisionHeartbeating:
+			setTerminationState(id, &update.TerminationState{
+				TerminationType: update.TerminationTypeFailed,
+				Failure:         &types.BadRequestError{Message: "update was delivered but not handled by the worker"},
+			})
+		}
+	}
+
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		for _, id := range updateRegistry.GetBufferedIDs() {
+			setTerminationState(id, &update.TerminationState{
+				TerminationType: update.TerminationTypeFailed,
+				Failure:         workflow.ErrAlreadyCompleted,
+			})
+		}
+	}
+}
+
 func (handler *handlerImpl) handleBufferedQueries(
 	msBuilder execution.MutableState,
 	clientImpl string,
@@ -56,6 +56,7 @@ import (
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -1276,7 +1277,10 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 				Attempt:    1,
 			}
 			test.expectCalls()
-			resp, err := s.decisionHandler.createRecordDecisionTaskStartedResponse(constants.TestDomainID, s.mockMutableState, decision, "test-identity")
+			updateRegistry := update.NewRegistry()
+			updateID := "update-id"
+			updateRegistry.BufferUpdate(updateID, &types.WorkflowUpdate{UpdateType: "test-update"})
+			resp, err := s.decisionHandler.createRecordDecisionTaskStartedResponse(constants.TestDomainID, s.mockMutableState, updateRegistry, decision, "test-identity")
 			s.Equal(test.expectedErr, err)
 			if err != nil {
 				s.Nil(resp)
@@ -1288,11 +1292,91 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 					_, ok := resp.Queries[index]
 					s.True(ok)
 				}
+				s.Equal(map[string]*types.WorkflowUpdate{updateID: {UpdateType: "test-update"}}, resp.Updates)
+				s.False(updateRegistry.HasBufferedUpdate())
+				s.True(updateRegistry.HasDeliveredUpdate())
 			}
 		})
 	}
 }
 
+func (s *DecisionHandlerSuite) TestFindUnknownUpdate() {
+	updateRegistry := update.NewRegistry()
+	deliveredID := "delivered-id"
+	updateRegistry.BufferUpdate(deliveredID, &types.WorkflowUpdate{UpdateType: "test-update"})
+	s.NoError(updateRegistry.SetDelivered(deliveredID))
+	bufferedID := "buffered-id"
+	updateRegistry.BufferUpdate(bufferedID, &types.WorkflowUpdate{UpdateType: "test-update"})
+
+	_, ok := findUnknownUpdate(updateRegistry, nil)
+	s.False(ok)
+	_, ok = findUnknownUpdate(updateRegistry, map[string]*types.WorkflowUpdateResult{deliveredID: {}})
+	s.False(ok)
+	id, ok := findUnknownUpdate(updateRegistry, map[string]*types.WorkflowUpdateResult{bufferedID: {}})
+	s.True(ok)
+	s.Equal(bufferedID, id)
+}
+
+func (s *DecisionHandlerSuite) TestHandleDeliveredUpdates() {
+	updateRegistry := update.NewRegistry()
+	deliver := func(id string) string {
+		updateRegistry.BufferUpdate(id, &types.WorkflowUpdate{UpdateType: "test-update"})
+		s.NoError(updateRegistry.SetDelivered(id))
+		return id
+	}
+	acceptedID := deliver("accepted-id")
+	rejectedID := deliver("rejected-id")
+	failedID := deliver("failed-id")
+	unhandledID := deliver("unhandled-id")
+	bufferedID := "buffered-id"
+	updateRegistry.BufferUpdate(bufferedID, &types.WorkflowUpdate{UpdateType: "test-update"})
+	sizeErr := &types.BadRequestError{Message: "size"}
+
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false)
+	s.decisionHandler.handleDeliveredUpdates(
+		s.mockMutableState,
+		updateRegistry,
+		map[string]*types.WorkflowUpdateResult{
+			acceptedID: {ResultType: types.UpdateResultTypeAccepted.Ptr(), Result: []byte("result")},
+			rejectedID: {ResultType: types.UpdateResultTypeRejected.Ptr()},
+			failedID:   {ResultType: types.UpdateResultTypeAccepted.Ptr(), Result: []byte("large-result")},
+		},
+		map[string]error{failedID: sizeErr},
+		constants.TestGlobalDomainEntry,
+		false,
+	)
+
+	assertTerminationState := func(id string, expected *update.TerminationState) {
+		state, err := updateRegistry.GetTerminationState(id)
+		s.NoError(err)
+		s.Equal(expected, state)
+	}
+	assertTerminationState(acceptedID, &update.TerminationState{
+		TerminationType: update.TerminationTypeCompleted,
+		UpdateResult:    &types.WorkflowUpdateResult{ResultType: types.UpdateResultTypeAccepted.Ptr(), Result: []byte("result")},
+		Persisted:       true,
+	})
+	assertTerminationState(rejectedID, &update.TerminationState{
+		TerminationType: update.TerminationTypeCompleted,
+		UpdateResult:    &types.WorkflowUpdateResult{ResultType: types.UpdateResultTypeRejected.Ptr(), ErrorMessage: "update rejected by the worker"},
+	})
+	assertTerminationState(failedID, &update.TerminationState{
+		TerminationType: update.TerminationTypeFailed,
+		Failure:         sizeErr,
+		Persisted:       true,
+	})
+	assertTerminationState(unhandledID, &update.TerminationState{
+		TerminationType: update.TerminationTypeFailed,
+		Failure:         &types.BadRequestError{Message: "update was delivered but not handled by the worker"},
+	})
+	assertTerminationState(bufferedID, &update.TerminationState{
+		TerminationType: update.TerminationTypeFailed,
+		Failure:         workflow.ErrAlreadyCompleted,
+	})
+	s.False(updateRegistry.HasDeliveredUpdate())
+	s.False(updateRegistry.HasBufferedUpdate())
+}
+
 func (s *DecisionHandlerSuite) TestHandleBufferedQueries_ClientNotSupports() {
 	s.mockMutableState.EXPECT().GetQueryRegistry().Return(s.queryRegistry)
 	s.assertQueryCounts(s.queryRegistry, 10, 0, 0, 0)
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -60,6 +61,7 @@ type (
 		activityNotStartedCancelled       bool
 		continueAsNewBuilder              execution.MutableState
 		stopProcessing                    bool // should stop processing any more decisions
+		activityLocalDispatchCount        int  // number of activities started locally by this decision task completion
 		mutableState                      execution.MutableState
 
 		// validation
@@ -201,6 +203,9 @@ func (handler *taskHandlerImpl) handleDecision(
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(ctx, decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case types.DecisionTypeUpsertWorkflowMemo:
+		return handler.handleDecisionUpsertWorkflowMemo(ctx, decision.UpsertWorkflowMemoDecisionAttributes)
+
 	default:
 		return &types.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -252,10 +257,18 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		return nil, err
 	}
 
+	if attr.RequestLocalDispatch && !handler.canDispatchActivityLocally(executionInfo, targetDomainID, attr) {
+		// fall back to dispatching the activity through matching
+		matchingDispatchAttr := *attr
+		matchingDispatchAttr.RequestLocalDispatch = false
+		attr = &matchingDispatchAttr
+	}
+
 	event, ai, activityDispatchInfo, err := handler.mutableState.AddActivityTaskScheduledEvent(handler.decisionTaskCompletedID, attr)
 	switch err.(type) {
 	case nil:
 		if activityDispatchInfo != nil {
+			handler.activityLocalDispatchCount++
 			if _, err1 := handler.mutableState.AddActivityTaskStartedEvent(ai, event.ID, uuid.New(), handler.identity); err1 != nil {
 				return nil, err1
 			}
@@ -294,6 +307,19 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 	}
 }
 
+// canDispatchActivityLocally returns true if the activity can be returned to the worker completing the decision as already started.
+// This is only the case for activities on the workflow's own task list and domain, up to a limit per decision task completion.
+func (handler *taskHandlerImpl) canDispatchActivityLocally(
+	executionInfo *persistence.WorkflowExecutionInfo,
+	targetDomainID string,
+	attr *types.ScheduleActivityTaskDecisionAttributes,
+) bool {
+	if targetDomainID != executionInfo.DomainID || attr.TaskList.GetName() != executionInfo.TaskList {
+		return false
+	}
+	return handler.activityLocalDispatchCount < handler.config.ActivityLocalDispatchLimitPerDecision(handler.domainEntry.GetInfo().Name)
+}
+
 // handleFailWorkflowError handles the certain types of error by failing the workflow
 func (handler *taskHandlerImpl) handleFailWorkflowError(failReason string, failDetails string) error {
 	// Fail the workflow immediately instead of just returning the error
@@ -1031,6 +1057,51 @@ func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowSearchAttributes(
 	return err
 }
 
+func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowMemo(
+	ctx context.Context,
+	attr *types.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeUpsertWorkflowMemoCounter,
+	)
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateUpsertWorkflowMemo(attr)
+		},
+		types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	// blob size limit check, done against the memo as it will be after the upsert
+	// so that repeated upserts cannot grow it past the limit
+	currentMemo := handler.mutableState.GetExecutionInfo().Memo
+	mergedMemo := make(map[string][]byte, len(currentMemo)+len(attr.GetMemo().GetFields()))
+	for k, v := range currentMemo {
+		mergedMemo[k] = v
+	}
+	for k, v := range attr.GetMemo().GetFields() {
+		mergedMemo[k] = v
+	}
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		metrics.DecisionTypeTag(types.DecisionTypeUpsertWorkflowMemo.String()),
+		convertSearchAttributesToByteArray(mergedMemo),
+		"UpsertWorkflowMemoDecisionAttributes exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	_, err = handler.mutableState.AddUpsertWorkflowMemoEvent(
+		handler.decisionTaskCompletedID, attr,
+	)
+	return err
+}
+
 func convertSearchAttributesToByteArray(fields map[string][]byte) []byte {
 	result := make([]byte, 0)
 
@@ -704,6 +704,109 @@ func TestHandleDecisionUpsertWorkflowSearchAttributes(t *testing.T) {
 	}
 }
 
+func TestHandleDecisionUpsertWorkflowMemo(t *testing.T) {
+	tests := []struct {
+		name            string
+		expectMockCalls func(taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes)
+		attributes      *types.UpsertWorkflowMemoDecisionAttributes
+		asserts         func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error)
+	}{
+		{
+			name: "success",
+			attributes: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{Fields: map[string][]byte{"some-key": []byte("some-value")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Times(2).Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+				})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddUpsertWorkflowMemoEvent(testTaskCompletedID, attr)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error) {
+				assert.False(t, taskHandler.failDecision)
+				assert.Empty(t, taskHandler.failMessage)
+				assert.Nil(t, taskHandler.failDecisionCause)
+				assert.Equal(t, nil, err)
+			},
+		},
+		{
+			name:       "attributes validation failure",
+			attributes: &types.UpsertWorkflowMemoDecisionAttributes{Memo: &types.Memo{}},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error) {
+				assert.True(t, taskHandler.failDecision)
+				assert.Equal(t, "Memo fields is empty on decision.", *taskHandler.failMessage)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes.Ptr(), taskHandler.failDecisionCause)
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name: "size limit checker failure",
+			attributes: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{Fields: map[string][]byte{"some-key": []byte("some-value")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes) {
+				taskHandler.sizeLimitChecker.blobSizeLimitWarn = 2
+				taskHandler.sizeLimitChecker.blobSizeLimitError = 1
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Times(2).Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+				})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddFailWorkflowEvent(testTaskCompletedID, &types.FailWorkflowExecutionDecisionAttributes{
+					Reason:  common.StringPtr(common.FailureReasonDecisionBlobSizeExceedsLimit),
+					Details: []byte("UpsertWorkflowMemoDecisionAttributes exceeds size limit."),
+				}).Return(nil, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error) {
+				assert.False(t, taskHandler.failDecision)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name: "merged memo exceeds size limit",
+			attributes: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{Fields: map[string][]byte{"k": []byte("v")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes) {
+				taskHandler.sizeLimitChecker.blobSizeLimitWarn = 10
+				taskHandler.sizeLimitChecker.blobSizeLimitError = 20
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Times(2).Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+					Memo:       map[string][]byte{"existing-key": []byte("existing-value")},
+				})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddFailWorkflowEvent(testTaskCompletedID, &types.FailWorkflowExecutionDecisionAttributes{
+					Reason:  common.StringPtr(common.FailureReasonDecisionBlobSizeExceedsLimit),
+					Details: []byte("UpsertWorkflowMemoDecisionAttributes exceeds size limit."),
+				}).Return(nil, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error) {
+				assert.False(t, taskHandler.failDecision)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Nil(t, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			if test.expectMockCalls != nil {
+				test.expectMockCalls(taskHandler, test.attributes)
+			}
+			decision := &types.Decision{
+				DecisionType:                         common.Ptr(types.DecisionTypeUpsertWorkflowMemo),
+				UpsertWorkflowMemoDecisionAttributes: test.attributes,
+			}
+			err := taskHandler.handleDecision(context.Background(), decision)
+			test.asserts(t, taskHandler, test.attributes, err)
+		})
+	}
+}
+
 func TestHandleDecisionStartTimer(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -1254,6 +1357,17 @@ func TestHandleDecisionScheduleActivity(t *testing.T) {
 		StartToCloseTimeoutSeconds:    func(i int32) *int32 { return &i }(80),
 		Input:                         []byte("some-input"),
 	}
+	localDispatchExecutionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:        testdata.DomainID,
+		WorkflowID:      testdata.WorkflowID,
+		WorkflowTimeout: 100,
+		TaskList:        testdata.TaskListName,
+	}
+	localDispatchAttr := *validAttr
+	localDispatchAttr.RequestLocalDispatch = true
+	matchingDispatchAttr := gomock.Cond(func(attr *types.ScheduleActivityTaskDecisionAttributes) bool {
+		return attr.ActivityID == validAttr.ActivityID && !attr.RequestLocalDispatch
+	})
 
 	tests := []struct {
 		name            string
@@ -1378,6 +1492,56 @@ func TestHandleDecisionScheduleActivity(t *testing.T) {
 				assert.Nil(t, res)
 			},
 		},
+		{
+			name:       "local dispatch - success",
+			attributes: &localDispatchAttr,
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(localDispatchExecutionInfo).Times(2)
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(attr.GetDomain()).Return(domainEntry, nil)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskScheduledEvent(taskHandler.decisionTaskCompletedID, attr).
+					Return(&types.HistoryEvent{}, &persistence.ActivityInfo{}, &types.ActivityLocalDispatchInfo{}, nil)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskStartedEvent(&persistence.ActivityInfo{}, int64(0), gomock.Any(), taskHandler.identity)
+				taskHandler.tokenSerializer.(*common.MockTaskTokenSerializer).EXPECT().Serialize(gomock.Any()).Return([]byte("some-serialized-data"), nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes, res *decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, res)
+				assert.Equal(t, 1, taskHandler.activityLocalDispatchCount)
+			},
+		},
+		{
+			name:       "local dispatch - different task list falls back to matching",
+			attributes: &localDispatchAttr,
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(executionInfo).Times(2)
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(attr.GetDomain()).Return(domainEntry, nil)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskScheduledEvent(taskHandler.decisionTaskCompletedID, matchingDispatchAttr).
+					Return(&types.HistoryEvent{}, &persistence.ActivityInfo{}, nil, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes, res *decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, res)
+				assert.True(t, attr.RequestLocalDispatch)
+				assert.Equal(t, 0, taskHandler.activityLocalDispatchCount)
+			},
+		},
+		{
+			name:       "local dispatch - limit reached falls back to matching",
+			attributes: &localDispatchAttr,
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes) {
+				taskHandler.config.ActivityLocalDispatchLimitPerDecision = func(domain string) int { return 1 }
+				taskHandler.activityLocalDispatchCount = 1
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(localDispatchExecutionInfo).Times(2)
+				taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(attr.GetDomain()).Return(domainEntry, nil)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddActivityTaskScheduledEvent(taskHandler.decisionTaskCompletedID, matchingDispatchAttr).
+					Return(&types.HistoryEvent{}, &persistence.ActivityInfo{}, nil, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.ScheduleActivityTaskDecisionAttributes, res *decisionResult, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, res)
+				assert.Equal(t, 1, taskHandler.activityLocalDispatchCount)
+			},
+		},
 		{
 			name:       "bad request error",
 			attributes: validAttr,
@@ -198,6 +198,7 @@ func mapPendingActivityInfo(ai *persistence.ActivityInfo, activityScheduledEvent
 	p := &types.PendingActivityInfo{
 		ActivityID: ai.ActivityID,
 		ScheduleID: ai.ScheduleID,
+		Paused:     ai.Paused,
 	}
 
 	state := types.PendingActivityStateScheduled
@@ -55,6 +55,7 @@ import (
 	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 	"github.com/uber/cadence/service/worker/archiver"
 )
@@ -108,6 +109,7 @@ type historyEngineImpl struct {
 	clientChecker             client.VersionChecker
 	replicationDLQHandler     replication.DLQHandler
 	failoverMarkerNotifier    failover.MarkerNotifier
+	taskRefresher             execution.MutableStateTaskRefresher
 
 	updateWithActionFn func(
 		context.Context,
@@ -207,6 +209,14 @@ func NewEngineWithShardContext(
 		replicationTaskStore: replicationTaskStore,
 		replicationMetricsEmitter: replication.NewMetricsEmitter(
 			shard.GetShardID(), shard, replicationReader, shard.GetMetricsClient()),
+		taskRefresher: execution.NewMutableStateTaskRefresher(
+			config,
+			shard.GetClusterMetadata(),
+			shard.GetDomainCache(),
+			shard.GetEventsCache(),
+			shard.GetShardID(),
+			logger,
+		),
 		updateWithActionFn: workflow.UpdateWithAction,
 		queueProcessors:    make(map[persistence.HistoryTaskCategory]queue.Processor),
 	}
@@ -344,6 +354,20 @@ func (e *historyEngineImpl) Stop() {
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(createShardNameFromShardID(e.shard.GetShardID()))
+
+	e.failInFlightUpdates()
+}
+
+// failInFlightUpdates fails the updates which are waiting on workflows of this shard,
+// so that their callers retry them on the new shard owner instead of timing out
+func (e *historyEngineImpl) failInFlightUpdates() {
+	it := e.executionCache.Iterator()
+	defer it.Close()
+	for it.HasNext() {
+		if wfContext, ok := it.Next().Value().(execution.Context); ok {
+			wfContext.GetUpdateRegistry().FailInFlightUpdates(update.ErrUpdateDropped)
+		}
+	}
 }
 
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
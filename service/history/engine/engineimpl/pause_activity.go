@@ -0,0 +1,118 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// PauseActivity pauses a pending activity, it will not be dispatched or retried until it is unpaused
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+) (*types.PauseActivityResponse, error) {
+
+	pauseRequest := request.GetRequest()
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, request.GetDomainUUID(), pauseRequest.GetExecution().GetWorkflowID(), pauseRequest.GetExecution().GetRunID())
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: pauseRequest.GetExecution().GetWorkflowID(),
+		RunID:      pauseRequest.GetExecution().GetRunID(),
+	}
+
+	err = workflow.UpdateWithAction(ctx, e.logger, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrNotExists
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(pauseRequest.GetActivityID())
+			if !ok {
+				return &types.EntityNotExistsError{Message: fmt.Sprintf("pending activity not found, activityID: %v", pauseRequest.GetActivityID())}
+			}
+			if ai.Paused {
+				return nil
+			}
+
+			_, err := mutableState.AddActivityTaskPausedEvent(ai, pauseRequest.GetReason(), pauseRequest.GetIdentity())
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &types.PauseActivityResponse{}, nil
+}
+
+// UnpauseActivity unpauses a paused activity, an activity which is not started is rescheduled immediately
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+) (*types.UnpauseActivityResponse, error) {
+
+	unpauseRequest := request.GetRequest()
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, request.GetDomainUUID(), unpauseRequest.GetExecution().GetWorkflowID(), unpauseRequest.GetExecution().GetRunID())
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: unpauseRequest.GetExecution().GetWorkflowID(),
+		RunID:      unpauseRequest.GetExecution().GetRunID(),
+	}
+
+	err = workflow.UpdateWithAction(ctx, e.logger, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrNotExists
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(unpauseRequest.GetActivityID())
+			if !ok {
+				return &types.EntityNotExistsError{Message: fmt.Sprintf("pending activity not found, activityID: %v", unpauseRequest.GetActivityID())}
+			}
+			if !ai.Paused {
+				return &types.BadRequestError{Message: fmt.Sprintf("activity is not paused, activityID: %v", unpauseRequest.GetActivityID())}
+			}
+
+			_, err := mutableState.AddActivityTaskUnpausedEvent(
+				ai,
+				unpauseRequest.GetResetAttempts(),
+				unpauseRequest.GetResetHeartbeatDetails(),
+				unpauseRequest.GetIdentity(),
+			)
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &types.UnpauseActivityResponse{}, nil
+}
@@ -0,0 +1,225 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/checksum"
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestPauseActivity(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	activityInfos := func(paused bool) map[int64]*persistence.ActivityInfo {
+		return map[int64]*persistence.ActivityInfo{
+			5: {
+				ScheduleID: 5,
+				StartedID:  commonconstants.EmptyEventID,
+				ActivityID: "activity-id",
+				Paused:     paused,
+			},
+		}
+	}
+	mockGetWorkflowExecution := func(engine *testdata.EngineForTest, activityInfos map[int64]*persistence.ActivityInfo) {
+		engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+			return req.Execution == *execution
+		})).Return(&persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: execution.WorkflowID,
+					RunID:      execution.RunID,
+				},
+				ActivityInfos:  activityInfos,
+				ExecutionStats: &persistence.ExecutionStats{},
+				Checksum:       checksum.Checksum{},
+			},
+		}, nil)
+		engine.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+			Return(&types.ActiveClusterInfo{ActiveClusterName: "test-active-cluster"}, nil).Times(1)
+	}
+	cases := []struct {
+		name        string
+		request     *types.HistoryPauseActivityRequest
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name: "Activity Not Found",
+			request: &types.HistoryPauseActivityRequest{
+				DomainUUID: constants.TestDomainID,
+				Request: &types.PauseActivityRequest{
+					Execution:  execution,
+					ActivityID: "unknown-activity-id",
+				},
+			},
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, activityInfos(false))
+			},
+			expectedErr: &types.EntityNotExistsError{Message: "pending activity not found, activityID: unknown-activity-id"},
+		},
+		{
+			name: "Success",
+			request: &types.HistoryPauseActivityRequest{
+				DomainUUID: constants.TestDomainID,
+				Request: &types.PauseActivityRequest{
+					Execution:  execution,
+					ActivityID: "activity-id",
+				},
+			},
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, activityInfos(false))
+				engine.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					upserts := req.UpdateWorkflowMutation.UpsertActivityInfos
+					return len(upserts) == 1 && upserts[0].ScheduleID == 5 && upserts[0].Paused
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+				engine.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(req *persistence.AppendHistoryNodesRequest) bool {
+					return len(req.Events) == 1 && req.Events[0].GetEventType() == types.EventTypeActivityTaskPaused
+				})).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			result, err := eft.Engine.PauseActivity(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			if testCase.expectedErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, &types.PauseActivityResponse{}, result)
+			} else {
+				assert.Equal(t, testCase.expectedErr, err)
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
+
+func TestUnpauseActivity(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	mockGetWorkflowExecution := func(engine *testdata.EngineForTest, paused bool) {
+		engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+			return req.Execution == *execution
+		})).Return(&persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: execution.WorkflowID,
+					RunID:      execution.RunID,
+				},
+				ActivityInfos: map[int64]*persistence.ActivityInfo{
+					5: {
+						ScheduleID: 5,
+						StartedID:  commonconstants.EmptyEventID,
+						ActivityID: "activity-id",
+						Attempt:    3,
+						Paused:     paused,
+					},
+				},
+				ExecutionStats: &persistence.ExecutionStats{},
+				Checksum:       checksum.Checksum{},
+			},
+		}, nil)
+		engine.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+			Return(&types.ActiveClusterInfo{ActiveClusterName: "test-active-cluster"}, nil).Times(1)
+	}
+	request := &types.HistoryUnpauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.UnpauseActivityRequest{
+			Execution:     execution,
+			ActivityID:    "activity-id",
+			ResetAttempts: true,
+		},
+	}
+	cases := []struct {
+		name        string
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name: "Activity Not Paused",
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, false)
+			},
+			expectedErr: &types.BadRequestError{Message: "activity is not paused, activityID: activity-id"},
+		},
+		{
+			name: "Success",
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, true)
+				engine.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					upserts := req.UpdateWorkflowMutation.UpsertActivityInfos
+					return len(upserts) == 1 && !upserts[0].Paused && upserts[0].Attempt == 0 &&
+						len(req.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer]) == 1
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+				engine.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(req *persistence.AppendHistoryNodesRequest) bool {
+					return len(req.Events) == 1 && req.Events[0].GetEventType() == types.EventTypeActivityTaskUnpaused
+				})).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			result, err := eft.Engine.UnpauseActivity(ctx.Background(), request)
+			eft.Engine.Stop()
+
+			if testCase.expectedErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, &types.UnpauseActivityResponse{}, result)
+			} else {
+				assert.Equal(t, testCase.expectedErr, err)
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
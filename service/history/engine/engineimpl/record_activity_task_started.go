@@ -126,7 +126,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if err != nil {
 				return err
 			}
-			response.ScheduledEvent = scheduledEvent
+			response.ScheduledEvent = scheduledEventWithActivityOptions(scheduledEvent, ai)
 			response.ScheduledTimestampOfThisAttempt = common.Int64Ptr(ai.ScheduledTime.UnixNano())
 
 			response.Attempt = int64(ai.Attempt)
@@ -148,6 +148,13 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if ai.Paused {
+				// The activity is dispatched again once it is unpaused.
+				// It is OK to drop the task at this point.
+				e.logger.Debug("Activity task is paused.", tag.TaskID(request.GetTaskID()), tag.WorkflowScheduleID(scheduleID), tag.TaskType(persistence.TransferTaskTypeActivityTask))
+				return workflow.ErrActivityTaskPaused
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -168,3 +175,32 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 
 	return response, err
 }
+
+// scheduledEventWithActivityOptions returns the scheduled event carrying the current task list and timeouts
+// of the activity, which are different from the original ones if they are changed by UpdateActivityOptions
+func scheduledEventWithActivityOptions(
+	event *types.HistoryEvent,
+	ai *persistence.ActivityInfo,
+) *types.HistoryEvent {
+	attributes := event.GetActivityTaskScheduledEventAttributes()
+	if attributes == nil ||
+		attributes.TaskList.GetName() == ai.TaskList &&
+			attributes.GetScheduleToCloseTimeoutSeconds() == ai.ScheduleToCloseTimeout &&
+			attributes.GetStartToCloseTimeoutSeconds() == ai.StartToCloseTimeout &&
+			attributes.GetHeartbeatTimeoutSeconds() == ai.HeartbeatTimeout {
+		return event
+	}
+
+	// the event is shared with events cache, so it must not be modified
+	copiedAttributes := *attributes
+	copiedAttributes.TaskList = &types.TaskList{
+		Name: ai.TaskList,
+		Kind: ai.TaskListKind.Ptr(),
+	}
+	copiedAttributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(ai.ScheduleToCloseTimeout)
+	copiedAttributes.StartToCloseTimeoutSeconds = common.Int32Ptr(ai.StartToCloseTimeout)
+	copiedAttributes.HeartbeatTimeoutSeconds = common.Int32Ptr(ai.HeartbeatTimeout)
+	copiedEvent := *event
+	copiedEvent.ActivityTaskScheduledEventAttributes = &copiedAttributes
+	return &copiedEvent
+}
@@ -609,7 +609,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	}
 
 	// Start workflow and signal
-	startRequest, err := getStartRequest(domainID, sRequest, signalWithStartRequest.PartitionConfig)
+	startRequest, err := getStartRequest(domainID, sRequest, signalWithStartRequest.PartitionConfig, signalWithStartRequest.FairnessKey)
 	if err != nil {
 		return nil, err
 	}
@@ -643,6 +643,7 @@ func getStartRequest(
 	domainID string,
 	request *types.SignalWithStartWorkflowExecutionRequest,
 	partitionConfig map[string]string,
+	fairnessKey string,
 ) (*types.HistoryStartWorkflowExecutionRequest, error) {
 
 	req := &types.StartWorkflowExecutionRequest{
@@ -668,7 +669,7 @@ func getStartRequest(
 		ActiveClusterSelectionPolicy:        request.ActiveClusterSelectionPolicy,
 	}
 
-	return common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now(), partitionConfig)
+	return common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now(), partitionConfig, fairnessKey)
 }
 
 func shouldTerminateAndStart(startRequest *types.HistoryStartWorkflowExecutionRequest, state int) bool {
@@ -775,7 +776,7 @@ func (e *historyEngineImpl) terminateAndStartWorkflow(
 	runningMutableState := runningWFCtx.GetMutableState()
 	var err error
 	if signalWithStartRequest != nil {
-		startRequest, err = getStartRequest(domainID, signalWithStartRequest.SignalWithStartRequest, signalWithStartRequest.PartitionConfig)
+		startRequest, err = getStartRequest(domainID, signalWithStartRequest.SignalWithStartRequest, signalWithStartRequest.PartitionConfig, signalWithStartRequest.FairnessKey)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,120 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// UpdateActivityOptions changes the task list, timeouts and retry policy of a pending activity
+// and regenerates its tasks, options which are not set in the request are left unchanged
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+) (*types.UpdateActivityOptionsResponse, error) {
+
+	updateRequest := request.GetRequest()
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, request.GetDomainUUID(), updateRequest.GetWorkflowExecution().GetWorkflowID(), updateRequest.GetWorkflowExecution().GetRunID())
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: updateRequest.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      updateRequest.GetWorkflowExecution().GetRunID(),
+	}
+
+	response := &types.UpdateActivityOptionsResponse{}
+	err = workflow.UpdateWithAction(ctx, e.logger, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrAlreadyCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(updateRequest.GetActivityID())
+			if !ok {
+				return &types.EntityNotExistsError{Message: fmt.Sprintf("pending activity not found, activityID: %v", updateRequest.GetActivityID())}
+			}
+			if ai.CancelRequested {
+				return &types.BadRequestError{Message: fmt.Sprintf("activity is requested to be canceled, activityID: %v", updateRequest.GetActivityID())}
+			}
+
+			event, err := mutableState.AddActivityTaskOptionsUpdatedEvent(ai, updateRequest.GetActivityOptions(), updateRequest.GetIdentity())
+			if err != nil {
+				return err
+			}
+
+			if err := e.taskRefresher.RefreshActivityTasks(ctx, e.timeSource.Now(), mutableState, ai.ScheduleID); err != nil {
+				return err
+			}
+
+			response.ActivityOptions = activityOptionsFromActivityInfo(ai, time.Unix(0, event.GetTimestamp()))
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// activityOptionsFromActivityInfo returns the effective options of a pending activity,
+// expiration of the retry policy is the remaining interval from the given time
+func activityOptionsFromActivityInfo(
+	ai *persistence.ActivityInfo,
+	now time.Time,
+) *types.ActivityOptions {
+	options := &types.ActivityOptions{
+		TaskList: &types.TaskList{
+			Name: ai.TaskList,
+			Kind: ai.TaskListKind.Ptr(),
+		},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(ai.ScheduleToCloseTimeout),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(ai.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(ai.HeartbeatTimeout),
+	}
+	if ai.HasRetryPolicy {
+		var expirationInterval int32
+		if !ai.ExpirationTime.IsZero() && ai.ExpirationTime.After(now) {
+			expirationInterval = int32(math.Ceil(ai.ExpirationTime.Sub(now).Seconds()))
+		}
+		options.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds:    ai.InitialInterval,
+			BackoffCoefficient:          ai.BackoffCoefficient,
+			MaximumIntervalInSeconds:    ai.MaximumInterval,
+			MaximumAttempts:             ai.MaximumAttempts,
+			NonRetriableErrorReasons:    ai.NonRetriableErrors,
+			ExpirationIntervalInSeconds: expirationInterval,
+		}
+	}
+	return options
+}
@@ -0,0 +1,160 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestUpdateActivityOptions(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	mockGetWorkflowExecution := func(engine *testdata.EngineForTest, cancelRequested bool) {
+		engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+			return req.Execution == *execution
+		})).Return(&persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: execution.WorkflowID,
+					RunID:      execution.RunID,
+				},
+				ActivityInfos: map[int64]*persistence.ActivityInfo{
+					5: {
+						ScheduleID:      5,
+						StartedID:       commonconstants.EmptyEventID,
+						ActivityID:      "activity-id",
+						CancelRequested: cancelRequested,
+					},
+				},
+				ExecutionStats: &persistence.ExecutionStats{},
+				Checksum:       checksum.Checksum{},
+			},
+		}, nil)
+		engine.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+			Return(&types.ActiveClusterInfo{ActiveClusterName: "test-active-cluster"}, nil).Times(1)
+	}
+	request := func(activityID string) *types.HistoryUpdateActivityOptionsRequest {
+		return &types.HistoryUpdateActivityOptionsRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.UpdateActivityOptionsRequest{
+				WorkflowExecution: execution,
+				ActivityID:        activityID,
+				ActivityOptions: &types.ActivityOptions{
+					StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+				},
+			},
+		}
+	}
+	cases := []struct {
+		name        string
+		request     *types.HistoryUpdateActivityOptionsRequest
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name:    "Activity Not Found",
+			request: request("unknown-activity-id"),
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, false)
+			},
+			expectedErr: &types.EntityNotExistsError{Message: "pending activity not found, activityID: unknown-activity-id"},
+		},
+		{
+			name:    "Activity Cancel Requested",
+			request: request("activity-id"),
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, true)
+			},
+			expectedErr: &types.BadRequestError{Message: "activity is requested to be canceled, activityID: activity-id"},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			result, err := eft.Engine.UpdateActivityOptions(ctx.Background(), testCase.request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestActivityOptionsFromActivityInfo(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ai := &persistence.ActivityInfo{
+		TaskList:               "tl",
+		TaskListKind:           types.TaskListKindNormal,
+		ScheduleToCloseTimeout: 100,
+		StartToCloseTimeout:    30,
+		HeartbeatTimeout:       10,
+		HasRetryPolicy:         true,
+		InitialInterval:        1,
+		BackoffCoefficient:     2,
+		MaximumInterval:        60,
+		MaximumAttempts:        5,
+		NonRetriableErrors:     []string{"bad"},
+		ExpirationTime:         now.Add(90500 * time.Millisecond),
+	}
+
+	assert.Equal(t, &types.ActivityOptions{
+		TaskList:                      &types.TaskList{Name: "tl", Kind: types.TaskListKindNormal.Ptr()},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(30),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds:    1,
+			BackoffCoefficient:          2,
+			MaximumIntervalInSeconds:    60,
+			MaximumAttempts:             5,
+			NonRetriableErrorReasons:    []string{"bad"},
+			ExpirationIntervalInSeconds: 91,
+		},
+	}, activityOptionsFromActivityInfo(ai, now))
+
+	ai.HasRetryPolicy = false
+	assert.Nil(t, activityOptionsFromActivityInfo(ai, now).RetryPolicy)
+}
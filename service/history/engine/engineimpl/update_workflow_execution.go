@@ -0,0 +1,148 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/update"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// UpdateWorkflowExecution delivers an update to the worker with a decision task and blocks until
+// the worker accepts or rejects it, an accepted update is recorded in history before its result is returned
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	updateRequest := request.GetRequest()
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, request.GetDomainUUID(), updateRequest.GetWorkflowExecution().GetWorkflowID(), updateRequest.GetWorkflowExecution().GetRunID())
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: updateRequest.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      updateRequest.GetWorkflowExecution().GetRunID(),
+	}
+
+	scope := e.metricsClient.Scope(metrics.HistoryUpdateWorkflowExecutionScope, metrics.DomainTag(domainEntry.GetInfo().Name))
+
+	updateID := updateRequest.GetUpdateID()
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+
+	var (
+		updateRegistry update.Registry
+		termCh         <-chan struct{}
+		buffered       bool
+	)
+	err = workflow.UpdateWithActionFunc(ctx, e.logger, e.executionCache, domainID, workflowExecution, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			// an update sent again with the same ID waits for the first one, even if the workflow has closed since
+			if updateRegistry == nil {
+				updateRegistry = wfContext.GetUpdateRegistry()
+				// the registry only knows the updates accepted since the execution context was loaded
+				if !updateRegistry.AcceptedUpdatesLoaded() {
+					accepted, err := execution.GetAcceptedUpdates(ctx, e.shard, mutableState)
+					if err != nil {
+						return nil, err
+					}
+					updateRegistry.LoadAcceptedUpdates(accepted)
+				}
+				if existingTermCh, err := updateRegistry.GetUpdateTermCh(updateID); err == nil {
+					termCh = existingTermCh
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+			}
+
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			// the action is retried on conflicts, the update is only buffered once
+			if termCh == nil {
+				inFlight := len(updateRegistry.GetBufferedIDs()) + len(updateRegistry.GetDeliveredIDs())
+				if inFlight >= e.config.MaxBufferedUpdateCount() {
+					scope.IncCounter(metrics.UpdateBufferExceededCount)
+					return nil, workflow.ErrUpdateBufferExceeded
+				}
+				termCh = updateRegistry.BufferUpdate(updateID, updateRequest.GetUpdate())
+				buffered = true
+			}
+
+			// a decision which is already scheduled or started delivers the update when it starts,
+			// or schedules a new decision when it completes
+			if mutableState.HasPendingDecision() {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			return &workflow.UpdateAction{CreateDecision: true}, nil
+		})
+	if err != nil {
+		// the update buffered by this call is failed, so that it is not applied after the caller got an error,
+		// unless a decision delivered it in the meantime
+		if !buffered || updateRegistry.FailBufferedUpdate(updateID, err) == nil {
+			return nil, err
+		}
+	}
+
+	select {
+	case <-termCh:
+		state, err := updateRegistry.GetTerminationState(updateID)
+		if err != nil {
+			scope.IncCounter(metrics.UpdateRegistryInvalidStateCount)
+			return nil, err
+		}
+		switch state.TerminationType {
+		case update.TerminationTypeCompleted:
+			result := state.UpdateResult
+			switch result.GetResultType() {
+			case types.UpdateResultTypeAccepted:
+				return &types.UpdateWorkflowExecutionResponse{
+					Result: result.GetResult(),
+				}, nil
+			case types.UpdateResultTypeRejected:
+				return nil, &types.BadRequestError{Message: result.GetErrorMessage()}
+			default:
+				scope.IncCounter(metrics.UpdateRegistryInvalidStateCount)
+				return nil, workflow.ErrUpdateEnteredInvalidState
+			}
+		case update.TerminationTypeFailed:
+			return nil, state.Failure
+		default:
+			scope.IncCounter(metrics.UpdateRegistryInvalidStateCount)
+			return nil, workflow.ErrUpdateEnteredInvalidState
+		}
+	case <-ctx.Done():
+		scope.IncCounter(metrics.WorkflowUpdateTimeoutCount)
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,223 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	ctx "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/update"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestUpdateWorkflowExecution(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	mockGetWorkflowExecution := func(engine *testdata.EngineForTest, state int) {
+		engine.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+			return req.Execution == *execution
+		})).Return(&persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:           constants.TestDomainID,
+					WorkflowID:         execution.WorkflowID,
+					RunID:              execution.RunID,
+					State:              state,
+					DecisionScheduleID: 2,
+					DecisionStartedID:  3,
+				},
+				ExecutionStats: &persistence.ExecutionStats{},
+				Checksum:       checksum.Checksum{},
+			},
+		}, nil)
+		engine.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+			Return(&types.ActiveClusterInfo{ActiveClusterName: "test-active-cluster"}, nil).Times(1)
+		engine.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{}, nil).Once()
+	}
+	request := &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.UpdateWorkflowExecutionRequest{
+			WorkflowExecution: execution,
+			Update: &types.WorkflowUpdate{
+				UpdateType: "update-type",
+			},
+		},
+	}
+	cases := []struct {
+		name        string
+		init        func(engine *testdata.EngineForTest)
+		expectedErr error
+	}{
+		{
+			name: "Workflow Completed",
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, persistence.WorkflowStateCompleted)
+			},
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+		{
+			name: "Update Buffer Exceeded",
+			init: func(engine *testdata.EngineForTest) {
+				engine.ShardCtx.GetConfig().MaxBufferedUpdateCount = dynamicproperties.GetIntPropertyFn(0)
+				mockGetWorkflowExecution(engine, persistence.WorkflowStateRunning)
+			},
+			expectedErr: workflow.ErrUpdateBufferExceeded,
+		},
+		{
+			name: "Update Not Handled Before Timeout",
+			init: func(engine *testdata.EngineForTest) {
+				mockGetWorkflowExecution(engine, persistence.WorkflowStateRunning)
+			},
+			expectedErr: ctx.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			if testCase.init != nil {
+				testCase.init(eft)
+			}
+			eft.Engine.Start()
+			timeoutCtx, cancel := ctx.WithTimeout(ctx.Background(), 100*time.Millisecond)
+			defer cancel()
+			result, err := eft.Engine.UpdateWorkflowExecution(timeoutCtx, request)
+			eft.Engine.Stop()
+
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestUpdateWorkflowExecution_DuplicateUpdate(t *testing.T) {
+	execution := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+	eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:   constants.TestDomainID,
+				WorkflowID: execution.WorkflowID,
+				RunID:      execution.RunID,
+				State:      persistence.WorkflowStateCompleted,
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+		},
+	}, nil)
+	eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+		Return(&types.ActiveClusterInfo{ActiveClusterName: "test-active-cluster"}, nil).Times(1)
+	// the update was accepted before the workflow completed and its execution context was loaded
+	eft.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{
+			EventType: types.EventTypeWorkflowExecutionUpdateAccepted.Ptr(),
+			WorkflowExecutionUpdateAcceptedEventAttributes: &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+				UpdateID: "update-id",
+				Update:   &types.WorkflowUpdate{UpdateType: "update-type"},
+				Result:   []byte("result"),
+			},
+		}},
+	}, nil).Once()
+	eft.Engine.Start()
+	defer eft.Engine.Stop()
+
+	result, err := eft.Engine.UpdateWorkflowExecution(ctx.Background(), &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.UpdateWorkflowExecutionRequest{
+			WorkflowExecution: &execution,
+			Update:            &types.WorkflowUpdate{UpdateType: "update-type"},
+			UpdateID:          "update-id",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &types.UpdateWorkflowExecutionResponse{Result: []byte("result")}, result)
+}
+
+func TestUpdateWorkflowExecution_EngineStopped(t *testing.T) {
+	execution := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+	eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:           constants.TestDomainID,
+				WorkflowID:         execution.WorkflowID,
+				RunID:              execution.RunID,
+				State:              persistence.WorkflowStateRunning,
+				DecisionScheduleID: 2,
+				DecisionStartedID:  3,
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+		},
+	}, nil)
+	eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+		Return(&types.ActiveClusterInfo{ActiveClusterName: "test-active-cluster"}, nil).Times(1)
+	eft.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{}, nil).Once()
+	eft.Engine.Start()
+
+	errCh := make(chan error, 1)
+	go func() {
+		timeoutCtx, cancel := ctx.WithTimeout(ctx.Background(), 10*time.Second)
+		defer cancel()
+		_, err := eft.Engine.UpdateWorkflowExecution(timeoutCtx, &types.HistoryUpdateWorkflowExecutionRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.UpdateWorkflowExecutionRequest{
+				WorkflowExecution: &execution,
+				Update:            &types.WorkflowUpdate{UpdateType: "update-type"},
+				UpdateID:          "update-id",
+			},
+		})
+		errCh <- err
+	}()
+
+	executionCache := eft.Engine.(*historyEngineImpl).executionCache
+	assert.Eventually(t, func() bool {
+		wfContext, release, err := executionCache.GetOrCreateWorkflowExecutionForBackground(constants.TestDomainID, execution)
+		if err != nil {
+			return false
+		}
+		defer release(nil)
+		return wfContext.GetUpdateRegistry().HasBufferedUpdate()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	eft.Engine.Stop()
+	assert.Equal(t, update.ErrUpdateDropped, <-errCh)
+}
@@ -70,6 +70,10 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *types.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error
+		PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) (*types.PauseActivityResponse, error)
+		UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) (*types.UnpauseActivityResponse, error)
+		UpdateActivityOptions(ctx context.Context, request *types.HistoryUpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
+		UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
 		ResetTransferQueue(ctx context.Context, clusterName string) error
 		ResetTimerQueue(ctx context.Context, clusterName string) error
 		DescribeTransferQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 )
 
 // Cache is a cache that holds workflow execution context
@@ -120,6 +121,13 @@ func NewCache(shard shard.Context) Cache {
 	opts.Logger = shard.GetLogger().WithTags(tag.ComponentHistoryCache)
 	opts.IsSizeBased = config.EnableSizeBasedHistoryExecutionCache
 	opts.MaxSize = config.ExecutionCacheMaxByteSize
+	// the update registry is dropped with the context, callers of its in-flight updates retry them
+	// against the context which replaces it
+	opts.RemovedFunc = func(value interface{}) {
+		if wfContext, ok := value.(Context); ok {
+			wfContext.GetUpdateRegistry().FailInFlightUpdates(update.ErrUpdateDropped)
+		}
+	}
 
 	return &cacheImpl{
 		Cache:            cache.New(opts),
@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 )
 
 const (
@@ -96,6 +97,8 @@ type (
 		GetHistorySize() int64
 		SetHistorySize(size int64)
 
+		GetUpdateRegistry() update.Registry
+
 		ReapplyEvents(
 			eventBatches []*persistence.WorkflowEvents,
 		) error
@@ -184,6 +187,9 @@ type (
 		maxLockDuration time.Duration
 		mutableState    MutableState
 		stats           *persistence.ExecutionStats
+		// updateRegistry is kept on the context rather than on the mutable state,
+		// so that in-flight updates survive the mutable state being cleared and reloaded
+		updateRegistry update.Registry
 
 		repairer WorkflowRepairer
 
@@ -233,6 +239,7 @@ func NewContext(
 		stats: &persistence.ExecutionStats{
 			HistorySize: 0,
 		},
+		updateRegistry: update.NewRegistry(),
 
 		appendHistoryNodesFn: func(ctx context.Context, domainID string, workflowExecution types.WorkflowExecution, request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
 			return appendHistoryV2EventsWithRetry(ctx, shard, common.CreatePersistenceRetryPolicy(), domainID, workflowExecution, request)
@@ -309,6 +316,10 @@ func (c *contextImpl) Clear() {
 	}
 }
 
+func (c *contextImpl) GetUpdateRegistry() update.Registry {
+	return c.updateRegistry
+}
+
 func (c *contextImpl) GetDomainID() string {
 	return c.domainID
 }
@@ -1108,6 +1119,7 @@ func (c *contextImpl) PersistStartWorkflowBatchEvents(
 			BranchToken: workflowEvents.BranchToken,
 			Events:      workflowEvents.Events,
 			DomainName:  domainName,
+			DomainID:    domainID,
 			// TransactionID is set by shard context
 		},
 	)
@@ -1149,6 +1161,7 @@ func (c *contextImpl) PersistNonStartWorkflowBatchEvents(
 			BranchToken: workflowEvents.BranchToken,
 			Events:      workflowEvents.Events,
 			DomainName:  domainName,
+			DomainID:    domainID,
 			// TransactionID is set by shard context
 		},
 	)
@@ -1443,6 +1456,10 @@ func (c *contextImpl) ReapplyEvents(
 	if err != nil {
 		return err
 	}
+	reapplyEventsBlob, err := reapplyEventsDataBlob.ToInternal()
+	if err != nil {
+		return err
+	}
 	// The active cluster of the domain is differ from the current cluster
 	// Use frontend client to route this request to the active cluster
 	// Reapplication only happens in active cluster
@@ -1457,7 +1474,7 @@ func (c *contextImpl) ReapplyEvents(
 		&types.ReapplyEventsRequest{
 			DomainName:        domainEntry.GetInfo().Name,
 			WorkflowExecution: execution,
-			Events:            reapplyEventsDataBlob.ToInternal(),
+			Events:            reapplyEventsBlob,
 		},
 	)
 }
@@ -19,6 +19,7 @@ import (
 	persistence "github.com/uber/cadence/common/persistence"
 	types "github.com/uber/cadence/common/types"
 	events "github.com/uber/cadence/service/history/events"
+	update "github.com/uber/cadence/service/history/update"
 )
 
 // MockContext is a mock of Context interface.
@@ -155,6 +156,20 @@ func (mr *MockContextMockRecorder) GetHistorySize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistorySize", reflect.TypeOf((*MockContext)(nil).GetHistorySize))
 }
 
+// GetUpdateRegistry mocks base method.
+func (m *MockContext) GetUpdateRegistry() update.Registry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateRegistry")
+	ret0, _ := ret[0].(update.Registry)
+	return ret0
+}
+
+// GetUpdateRegistry indicates an expected call of GetUpdateRegistry.
+func (mr *MockContextMockRecorder) GetUpdateRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateRegistry", reflect.TypeOf((*MockContext)(nil).GetUpdateRegistry))
+}
+
 // GetWorkflowExecution mocks base method.
 func (m *MockContext) GetWorkflowExecution() MutableState {
 	m.ctrl.T.Helper()
@@ -109,6 +109,8 @@ func (b *HistoryBuilder) AddWorkflowExecutionStartedEvent(
 		PartitionConfig:                     startRequest.PartitionConfig,
 		RequestID:                           request.RequestID,
 		ActiveClusterSelectionPolicy:        request.ActiveClusterSelectionPolicy,
+		Priority:                            request.GetPriority(),
+		FairnessKey:                         startRequest.FairnessKey,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -141,18 +143,18 @@ func (b *HistoryBuilder) AddTransientDecisionTaskScheduledEvent(taskList string,
 
 // AddDecisionTaskStartedEvent adds DecisionTaskStarted event to history
 func (b *HistoryBuilder) AddDecisionTaskStartedEvent(scheduleEventID int64, requestID string,
-	identity string) *types.HistoryEvent {
+	identity string, workerBuildID string) *types.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeDecisionTaskStarted)
-	event.DecisionTaskStartedEventAttributes = getDecisionTaskStartedEventAttributes(scheduleEventID, requestID, identity)
+	event.DecisionTaskStartedEventAttributes = getDecisionTaskStartedEventAttributes(scheduleEventID, requestID, identity, workerBuildID)
 
 	return b.addEventToHistory(event)
 }
 
 // AddTransientDecisionTaskStartedEvent adds transient DecisionTaskStarted event
 func (b *HistoryBuilder) AddTransientDecisionTaskStartedEvent(scheduleEventID int64, requestID string,
-	identity string, timestamp int64) *types.HistoryEvent {
+	identity string, workerBuildID string, timestamp int64) *types.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEventWithTimestamp(types.EventTypeDecisionTaskStarted, timestamp)
-	event.DecisionTaskStartedEventAttributes = getDecisionTaskStartedEventAttributes(scheduleEventID, requestID, identity)
+	event.DecisionTaskStartedEventAttributes = getDecisionTaskStartedEventAttributes(scheduleEventID, requestID, identity, workerBuildID)
 
 	return b.addTransientEvent(event)
 }
@@ -234,6 +236,7 @@ func (b *HistoryBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 		DecisionTaskCompletedEventID:  decisionCompletedEventID,
 		RetryPolicy:                   attributes.RetryPolicy,
 		Domain:                        domain,
+		Priority:                      attributes.GetPriority(),
 	}
 
 	return b.addEventToHistory(event)
@@ -439,6 +442,49 @@ func (b *HistoryBuilder) AddActivityTaskCancelRequestedEvent(decisionCompletedEv
 	return b.addEventToHistory(event)
 }
 
+// AddActivityTaskOptionsUpdatedEvent adds ActivityTaskOptionsUpdated event to history
+func (b *HistoryBuilder) AddActivityTaskOptionsUpdatedEvent(scheduleEventID int64,
+	options *types.ActivityOptions, identity string) *types.HistoryEvent {
+
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskOptionsUpdated)
+	event.ActivityTaskOptionsUpdatedEventAttributes = &types.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventID: scheduleEventID,
+		ActivityOptions:  options,
+		Identity:         identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddActivityTaskPausedEvent adds ActivityTaskPaused event to history
+func (b *HistoryBuilder) AddActivityTaskPausedEvent(scheduleEventID int64,
+	reason string, identity string) *types.HistoryEvent {
+
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskPaused)
+	event.ActivityTaskPausedEventAttributes = &types.ActivityTaskPausedEventAttributes{
+		ScheduledEventID: scheduleEventID,
+		Reason:           reason,
+		Identity:         identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddActivityTaskUnpausedEvent adds ActivityTaskUnpaused event to history
+func (b *HistoryBuilder) AddActivityTaskUnpausedEvent(scheduleEventID int64,
+	resetAttempts bool, resetHeartbeatDetails bool, identity string) *types.HistoryEvent {
+
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskUnpaused)
+	event.ActivityTaskUnpausedEventAttributes = &types.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventID:      scheduleEventID,
+		ResetAttempts:         resetAttempts,
+		ResetHeartbeatDetails: resetHeartbeatDetails,
+		Identity:              identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddRequestCancelActivityTaskFailedEvent add RequestCancelActivityTaskFailed event to history
 func (b *HistoryBuilder) AddRequestCancelActivityTaskFailedEvent(decisionCompletedEventID int64,
 	activityID string, cause string) *types.HistoryEvent {
@@ -613,6 +659,39 @@ func (b *HistoryBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddUpsertWorkflowMemoEvent adds UpsertWorkflowMemo event to history
+func (b *HistoryBuilder) AddUpsertWorkflowMemoEvent(
+	decisionTaskCompletedEventID int64,
+	attributes *types.UpsertWorkflowMemoDecisionAttributes) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeUpsertWorkflowMemo)
+	event.UpsertWorkflowMemoEventAttributes = &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: decisionTaskCompletedEventID,
+		Memo:                         attributes.GetMemo(),
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent adds WorkflowExecutionUpdateAccepted event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionTaskCompletedEventID int64,
+	updateID string,
+	update *types.WorkflowUpdate,
+	result []byte,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateAccepted)
+	event.WorkflowExecutionUpdateAcceptedEventAttributes = &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:                     updateID,
+		DecisionTaskCompletedEventID: decisionTaskCompletedEventID,
+		Update:                       update,
+		Result:                       result,
+		Identity:                     identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddSignalExternalWorkflowExecutionFailedEvent adds SignalExternalWorkflowExecutionFailed event to history
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
@@ -858,9 +937,9 @@ func newDecisionTaskScheduledEventWithInfo(eventID, timestamp int64, taskList st
 	return event
 }
 
-func newDecisionTaskStartedEventWithInfo(eventID, timestamp int64, scheduledEventID int64, requestID string, identity string) *types.HistoryEvent {
+func newDecisionTaskStartedEventWithInfo(eventID, timestamp int64, scheduledEventID int64, requestID string, identity string, workerBuildID string) *types.HistoryEvent {
 	event := createNewHistoryEvent(eventID, types.EventTypeDecisionTaskStarted, timestamp)
-	event.DecisionTaskStartedEventAttributes = getDecisionTaskStartedEventAttributes(scheduledEventID, requestID, identity)
+	event.DecisionTaskStartedEventAttributes = getDecisionTaskStartedEventAttributes(scheduledEventID, requestID, identity, workerBuildID)
 	return event
 }
 
@@ -882,11 +961,12 @@ func getDecisionTaskScheduledEventAttributes(taskList string, startToCloseTimeou
 	}
 }
 
-func getDecisionTaskStartedEventAttributes(scheduledEventID int64, requestID string, identity string) *types.DecisionTaskStartedEventAttributes {
+func getDecisionTaskStartedEventAttributes(scheduledEventID int64, requestID string, identity string, workerBuildID string) *types.DecisionTaskStartedEventAttributes {
 	return &types.DecisionTaskStartedEventAttributes{
 		ScheduledEventID: scheduledEventID,
 		Identity:         identity,
 		RequestID:        requestID,
+		WorkerBuildID:    workerBuildID,
 	}
 }
 
@@ -681,6 +681,31 @@ func (s *historyBuilderSuite) TestHistoryBuilderDecisionStartedFailures() {
 	s.Equal(commonconstants.EmptyEventID, s.getPreviousDecisionStartedEventID())
 }
 
+func (s *historyBuilderSuite) TestHistoryBuilderDecisionStartedWorkerBuildID() {
+	id := "historybuilder-decisionstarted-buildid-test-workflow-id"
+	rid := "historybuilder-decisionstarted-buildid-test-run-id"
+	wt := "historybuilder-decisionstarted-buildid-type"
+	tl := "historybuilder-decisionstarted-buildid-tasklist"
+	identity := "historybuilder-decisionstarted-buildid-worker"
+	input := []byte("historybuilder-decisionstarted-buildid-input")
+	we := types.WorkflowExecution{
+		WorkflowID: id,
+		RunID:      rid,
+	}
+
+	s.addWorkflowExecutionStartedEvent(we, wt, tl, input, 60, 10, identity, nil)
+	di := s.addDecisionTaskScheduledEvent()
+	event, _, err := s.msBuilder.AddDecisionTaskStartedEvent(di.ScheduleID, uuid.New(), &types.PollForDecisionTaskRequest{
+		TaskList:          &types.TaskList{Name: tl},
+		Identity:          identity,
+		WorkerVersionInfo: &types.WorkerVersionInfo{BuildID: "build-1"},
+	})
+	s.NoError(err)
+	s.Equal("build-1", event.DecisionTaskStartedEventAttributes.GetWorkerBuildID())
+	s.Equal("build-1", s.msBuilder.GetExecutionInfo().WorkerBuildID)
+	s.Empty(s.msBuilder.GetExecutionInfo().PartitionConfig)
+}
+
 func (s *historyBuilderSuite) TestHistoryBuilderFlushBufferedEvents() {
 	id := "flush-buffered-events-test-workflow-id"
 	rid := "flush-buffered-events-test-run-id"
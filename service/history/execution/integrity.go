@@ -173,3 +173,37 @@ func getHistoryPaginationFn(
 		return items, token, nil
 	}
 }
+
+// GetAcceptedUpdates returns the attributes of the WorkflowExecutionUpdateAccepted events in history,
+// so that an update sent again after its execution context was reloaded is not applied twice.
+func GetAcceptedUpdates(
+	ctx context.Context,
+	shard shard.Context,
+	mutableState MutableState,
+) ([]*types.WorkflowExecutionUpdateAcceptedEventAttributes, error) {
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return nil, err
+	}
+	domainID := mutableState.GetExecutionInfo().DomainID
+	iter := collection.NewPagingIterator(getHistoryPaginationFn(
+		ctx,
+		shard,
+		1,
+		mutableState.GetNextEventID(),
+		branchToken,
+		domainID,
+	))
+	var accepted []*types.WorkflowExecutionUpdateAcceptedEventAttributes
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		event := item.(*types.HistoryEvent)
+		if event.GetEventType() == types.EventTypeWorkflowExecutionUpdateAccepted {
+			accepted = append(accepted, event.WorkflowExecutionUpdateAcceptedEventAttributes)
+		}
+	}
+	return accepted, nil
+}
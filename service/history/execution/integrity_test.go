@@ -236,3 +236,78 @@ func TestGetResurrectedActivities(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAcceptedUpdates(t *testing.T) {
+	accepted := &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID: "update-id",
+		Update:   &types.WorkflowUpdate{UpdateType: "update-type"},
+		Result:   []byte("result"),
+	}
+	tests := []struct {
+		name    string
+		setup   func(mockShard *shard.MockContext, mockMutableState *MockMutableState, mockHistoryManager *persistence.MockHistoryManager, mockDomainCache *cache.MockDomainCache)
+		want    []*types.WorkflowExecutionUpdateAcceptedEventAttributes
+		wantErr bool
+	}{
+		{
+			name: "Accepted updates in history",
+			setup: func(mockShard *shard.MockContext, mockMutableState *MockMutableState, mockHistoryManager *persistence.MockHistoryManager, mockDomainCache *cache.MockDomainCache) {
+				mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte("branchToken"), nil).Times(1)
+				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{DomainID: "testDomain"}).Times(1)
+				mockMutableState.EXPECT().GetNextEventID().Return(int64(10)).Times(1)
+
+				events := []*types.HistoryEvent{
+					{EventType: types.EventTypeDecisionTaskCompleted.Ptr(), DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{}},
+					{EventType: types.EventTypeWorkflowExecutionUpdateAccepted.Ptr(), WorkflowExecutionUpdateAcceptedEventAttributes: accepted},
+				}
+
+				mockShard.EXPECT().GetShardID().Return(1).Times(1)
+				mockShard.EXPECT().GetDomainCache().Return(mockDomainCache).Times(1)
+				mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("testDomain", nil).Times(1)
+
+				mockShard.EXPECT().GetHistoryManager().Return(mockHistoryManager).Times(1)
+				mockHistoryManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchResponse{
+					HistoryEvents: events,
+				}, nil).Times(1)
+			},
+			want: []*types.WorkflowExecutionUpdateAcceptedEventAttributes{accepted},
+		},
+		{
+			name: "Error reading history",
+			setup: func(mockShard *shard.MockContext, mockMutableState *MockMutableState, mockHistoryManager *persistence.MockHistoryManager, mockDomainCache *cache.MockDomainCache) {
+				mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte("branchToken"), nil).Times(1)
+				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{DomainID: "testDomain"}).Times(1)
+				mockMutableState.EXPECT().GetNextEventID().Return(int64(10)).Times(1)
+
+				mockShard.EXPECT().GetShardID().Return(1).Times(1)
+				mockShard.EXPECT().GetDomainCache().Return(mockDomainCache).Times(1)
+				mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("testDomain", nil).Times(1)
+
+				mockShard.EXPECT().GetHistoryManager().Return(mockHistoryManager).Times(1)
+				mockHistoryManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(nil, errors.New("read failed")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+
+			mockShard := shard.NewMockContext(mockCtrl)
+			mockMutableState := NewMockMutableState(mockCtrl)
+			mockHistoryManager := persistence.NewMockHistoryManager(mockCtrl)
+			mockDomainCache := cache.NewMockDomainCache(mockCtrl)
+
+			tc.setup(mockShard, mockMutableState, mockHistoryManager, mockDomainCache)
+
+			got, err := GetAcceptedUpdates(context.Background(), mockShard, mockMutableState)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
@@ -62,6 +62,9 @@ type (
 		AddActivityTaskCanceledEvent(int64, int64, int64, []uint8, string) (*types.HistoryEvent, error)
 		AddActivityTaskCompletedEvent(int64, int64, *types.RespondActivityTaskCompletedRequest) (*types.HistoryEvent, error)
 		AddActivityTaskFailedEvent(int64, int64, *types.RespondActivityTaskFailedRequest) (*types.HistoryEvent, error)
+		AddActivityTaskOptionsUpdatedEvent(*persistence.ActivityInfo, *types.ActivityOptions, string) (*types.HistoryEvent, error)
+		AddActivityTaskPausedEvent(*persistence.ActivityInfo, string, string) (*types.HistoryEvent, error)
+		AddActivityTaskUnpausedEvent(*persistence.ActivityInfo, bool, bool, string) (*types.HistoryEvent, error)
 		AddActivityTaskScheduledEvent(int64, *types.ScheduleActivityTaskDecisionAttributes) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, error)
 		AddActivityTaskStartedEvent(*persistence.ActivityInfo, int64, string, string) (*types.HistoryEvent, error)
 		AddActivityTaskTimedOutEvent(int64, int64, types.TimeoutType, []uint8) (*types.HistoryEvent, error)
@@ -100,11 +103,13 @@ type (
 		AddTimerFiredEvent(string) (*types.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *types.StartTimerDecisionAttributes) (*types.HistoryEvent, *persistence.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error)
+		AddUpsertWorkflowMemoEvent(int64, *types.UpsertWorkflowMemoDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string, reqeustID string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID int64, updateID string, update *types.WorkflowUpdate, result []byte, identity string) (*types.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
@@ -174,6 +179,9 @@ type (
 		ReplicateActivityTaskCanceledEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskCompletedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskFailedEvent(*types.HistoryEvent) error
+		ReplicateActivityTaskOptionsUpdatedEvent(*types.HistoryEvent) error
+		ReplicateActivityTaskPausedEvent(*types.HistoryEvent) error
+		ReplicateActivityTaskUnpausedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskScheduledEvent(int64, *types.HistoryEvent, bool) (*persistence.ActivityInfo, error)
 		ReplicateActivityTaskStartedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskTimedOutEvent(*types.HistoryEvent) error
@@ -201,6 +209,7 @@ type (
 		ReplicateTimerStartedEvent(*types.HistoryEvent) (*persistence.TimerInfo, error)
 		ReplicateTransientDecisionTaskScheduled() error
 		ReplicateUpsertWorkflowSearchAttributesEvent(*types.HistoryEvent) error
+		ReplicateUpsertWorkflowMemoEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionCancelRequestedEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionCanceledEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionCompletedEvent(int64, *types.HistoryEvent) error
@@ -358,6 +358,17 @@ func (e *mutableStateBuilder) Load(
 
 	e.fillForBackwardsCompatibility()
 
+	if state.ReencryptionRequired {
+		// events not encrypted under the current key version are rewritten with the next update,
+		// the execution info being rewritten with every update
+		for _, ai := range e.pendingActivityInfoIDs {
+			e.updateActivityInfos[ai.ScheduleID] = ai
+		}
+		for _, ci := range e.pendingChildExecutionInfoIDs {
+			e.updateChildExecutionInfos[ci.InitiatedID] = ci
+		}
+	}
+
 	if len(state.Checksum.Value) > 0 {
 		if e.shouldInvalidateChecksum() {
 			e.checksum = checksum.Checksum{}
@@ -886,7 +897,9 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
-		types.EventTypeUpsertWorkflowSearchAttributes:
+		types.EventTypeUpsertWorkflowSearchAttributes,
+		types.EventTypeUpsertWorkflowMemo,
+		types.EventTypeWorkflowExecutionUpdateAccepted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -1129,6 +1142,37 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowMemoEvent(
+	decisionCompletedEventID int64,
+	request *types.UpsertWorkflowMemoDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionUpsertWorkflowMemo
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddUpsertWorkflowMemoEvent(decisionCompletedEventID, request)
+	if err := e.ReplicateUpsertWorkflowMemoEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateUpsertWorkflowMemoEvent(
+	event *types.HistoryEvent,
+) error {
+
+	upsertMemo := event.UpsertWorkflowMemoEventAttributes.GetMemo().GetFields()
+	// the current memo map may be shared with the cached start event, so merge into a copy
+	currentMemo := e.GetExecutionInfo().CopyMemo()
+
+	e.executionInfo.Memo = mergeMapOfByteArray(currentMemo, upsertMemo)
+
+	// memo is stored alongside search attributes in visibility, so the same task refreshes both
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
+
 func (e *mutableStateBuilder) AddRecordMarkerEvent(
 	decisionCompletedEventID int64,
 	attributes *types.RecordMarkerDecisionAttributes,
@@ -1142,6 +1186,24 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(
 	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes), nil
 }
 
+// AddWorkflowExecutionUpdateAcceptedEvent records an update accepted by the worker,
+// like markers it carries no mutable state and is only replayed by the worker
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionCompletedEventID int64,
+	updateID string,
+	update *types.WorkflowUpdate,
+	result []byte,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateAccepted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	return e.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, updateID, update, result, identity), nil
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	firstEventID int64,
 	reason string,
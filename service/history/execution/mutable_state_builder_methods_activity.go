@@ -310,6 +310,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TaskList:                 attributes.TaskList.GetName(),
 		TaskListKind:             attributes.TaskList.GetKind(),
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
+		Priority:                 attributes.GetPriority(),
 	}
 
 	if ai.HasRetryPolicy {
@@ -545,6 +546,88 @@ func (e *mutableStateBuilder) ReplicateActivityTaskTimedOutEvent(
 	return e.DeleteActivity(scheduleID)
 }
 
+// AddActivityTaskOptionsUpdatedEvent changes the task list, timeouts or retry policy of a pending activity,
+// options which are not set are left unchanged
+func (e *mutableStateBuilder) AddActivityTaskOptionsUpdatedEvent(
+	ai *persistence.ActivityInfo,
+	options *types.ActivityOptions,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskOptionsUpdated
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowScheduleID(ai.ScheduleID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddActivityTaskOptionsUpdatedEvent(ai.ScheduleID, options, identity)
+	if err := e.ReplicateActivityTaskOptionsUpdatedEvent(event); err != nil {
+		return nil, err
+	}
+
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskOptionsUpdatedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.ActivityTaskOptionsUpdatedEventAttributes
+	scheduleID := attributes.GetScheduledEventID()
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity event ID: %v in mutable state", scheduleID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	options := attributes.GetActivityOptions()
+	ai.Version = event.Version
+	if options.TaskList != nil {
+		ai.TaskList = options.TaskList.GetName()
+		if options.TaskList.Kind != nil {
+			ai.TaskListKind = options.TaskList.GetKind()
+		}
+	}
+	if options.ScheduleToCloseTimeoutSeconds != nil {
+		ai.ScheduleToCloseTimeout = options.GetScheduleToCloseTimeoutSeconds()
+	}
+	if options.StartToCloseTimeoutSeconds != nil {
+		ai.StartToCloseTimeout = options.GetStartToCloseTimeoutSeconds()
+	}
+	if options.HeartbeatTimeoutSeconds != nil {
+		ai.HeartbeatTimeout = options.GetHeartbeatTimeoutSeconds()
+	}
+	if options.RetryPolicy != nil {
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = options.RetryPolicy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = options.RetryPolicy.GetBackoffCoefficient()
+		ai.MaximumInterval = options.RetryPolicy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = options.RetryPolicy.GetMaximumAttempts()
+		ai.NonRetriableErrors = options.RetryPolicy.NonRetriableErrorReasons
+		// expiration of the new retry policy is counted from the time of the update
+		ai.ExpirationTime = time.Time{}
+		if options.RetryPolicy.GetExpirationIntervalInSeconds() != 0 {
+			ai.ExpirationTime = time.Unix(0, event.GetTimestamp()).Add(time.Duration(options.RetryPolicy.GetExpirationIntervalInSeconds()) * time.Second)
+		}
+	}
+
+	// activity timers are re-created with the new timeouts
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	return nil
+}
+
 func (e *mutableStateBuilder) AddActivityTaskCancelRequestedEvent(
 	decisionCompletedEventID int64,
 	activityID string,
@@ -717,13 +800,143 @@ func (e *mutableStateBuilder) RetryActivity(
 	ai.LastWorkerIdentity = ai.StartedIdentity
 	ai.LastFailureDetails = failureDetails
 
-	if err := e.taskGenerator.GenerateActivityRetryTasks(
-		ai.ScheduleID,
-	); err != nil {
-		return false, err
+	// paused activity is rescheduled once it is unpaused
+	if !ai.Paused {
+		if err := e.taskGenerator.GenerateActivityRetryTasks(
+			ai.ScheduleID,
+		); err != nil {
+			return false, err
+		}
 	}
 
 	e.updateActivityInfos[ai.ScheduleID] = ai
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 	return true, nil
 }
+
+// AddActivityTaskPausedEvent marks a pending activity as paused. A paused activity which is not started
+// will not be dispatched and none of its timeouts will fire until it is unpaused.
+// An already started activity keeps running, but it will not be retried while paused.
+func (e *mutableStateBuilder) AddActivityTaskPausedEvent(
+	ai *persistence.ActivityInfo,
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowScheduleID(ai.ScheduleID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddActivityTaskPausedEvent(ai.ScheduleID, reason, identity)
+	if err := e.ReplicateActivityTaskPausedEvent(event); err != nil {
+		return nil, err
+	}
+
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.ActivityTaskPausedEventAttributes
+	scheduleID := attributes.GetScheduledEventID()
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity event ID: %v in mutable state", scheduleID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	ai.Version = event.Version
+	ai.Paused = true
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	return nil
+}
+
+// AddActivityTaskUnpausedEvent clears the paused flag of a pending activity. An activity which is not
+// started is rescheduled immediately, optionally with its attempt and heartbeat details reset.
+func (e *mutableStateBuilder) AddActivityTaskUnpausedEvent(
+	ai *persistence.ActivityInfo,
+	resetAttempts bool,
+	resetHeartbeatDetails bool,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowScheduleID(ai.ScheduleID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddActivityTaskUnpausedEvent(ai.ScheduleID, resetAttempts, resetHeartbeatDetails, identity)
+	if err := e.ReplicateActivityTaskUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+
+	if ai.StartedID == constants.EmptyEventID {
+		if err := e.taskGenerator.GenerateActivityRetryTasks(
+			ai.ScheduleID,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.ActivityTaskUnpausedEventAttributes
+	scheduleID := attributes.GetScheduledEventID()
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity event ID: %v in mutable state", scheduleID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	ai.Version = event.Version
+	ai.Paused = false
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	if attributes.GetResetAttempts() {
+		ai.Attempt = 0
+	}
+	if attributes.GetResetHeartbeatDetails() {
+		ai.Details = nil
+		ai.LastHeartBeatUpdatedTime = time.Time{}
+	}
+	if ai.StartedID == constants.EmptyEventID {
+		// activity which is not started is rescheduled at the time it is unpaused
+		ai.ScheduledTime = time.Unix(0, event.GetTimestamp())
+		ai.RequestID = ""
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	return nil
+}
@@ -203,6 +203,46 @@ func Test__AddActivityTaskScheduledEvent(t *testing.T) {
 				NonRetriableErrors:     retryPolicy.NonRetriableErrorReasons,
 			},
 		},
+		{
+			name:             "success - priority",
+			workflowTaskList: &types.TaskList{Name: "taskList", Kind: types.TaskListKindNormal.Ptr()},
+			attr: &types.ScheduleActivityTaskDecisionAttributes{
+				ActivityID:                    "activityID",
+				ActivityType:                  activityType,
+				Domain:                        constants.TestDomainName,
+				TaskList:                      &types.TaskList{Name: "taskList"},
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(1),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(2),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(3),
+				Priority:                      common.Int32Ptr(7),
+			},
+			expectedAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityID:                    "activityID",
+				ActivityType:                  activityType,
+				Domain:                        &constants.TestDomainName,
+				TaskList:                      &types.TaskList{Name: "taskList"},
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(1),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(2),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(3),
+				HeartbeatTimeoutSeconds:       common.Int32Ptr(0),
+				Priority:                      7,
+			},
+			expectedInfo: &persistence.ActivityInfo{
+				Version:                commonconstants.EmptyVersion,
+				ScheduleID:             1,
+				ScheduledTime:          currentTime,
+				StartedID:              commonconstants.EmptyEventID,
+				DomainID:               constants.TestDomainID,
+				ActivityID:             "activityID",
+				ScheduleToCloseTimeout: 1,
+				ScheduleToStartTimeout: 2,
+				StartToCloseTimeout:    3,
+				CancelRequestID:        commonconstants.EmptyEventID,
+				TaskList:               "taskList",
+				TaskListKind:           types.TaskListKindNormal,
+				Priority:               7,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -293,6 +333,211 @@ func Test__UpdateActivity(t *testing.T) {
 	})
 }
 
+func Test__AddActivityTaskPausedEvent(t *testing.T) {
+	t.Run("error missing activity info", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		_, err := mb.AddActivityTaskPausedEvent(&persistence.ActivityInfo{ScheduleID: 1, ActivityID: "1"}, "reason", "test")
+		assert.Error(t, err)
+		assert.Equal(t, "add-activitytask-paused-event operation failed", err.Error())
+	})
+	t.Run("pause success", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.hBuilder = NewHistoryBuilder(mb)
+		ai := &persistence.ActivityInfo{ScheduleID: 1, ActivityID: "1"}
+		mb.pendingActivityInfoIDs[1] = ai
+		event, err := mb.AddActivityTaskPausedEvent(ai, "reason", "test")
+		assert.NoError(t, err)
+		assert.Equal(t, types.EventTypeActivityTaskPaused, event.GetEventType())
+		assert.Equal(t, &types.ActivityTaskPausedEventAttributes{
+			ScheduledEventID: 1,
+			Reason:           "reason",
+			Identity:         "test",
+		}, event.ActivityTaskPausedEventAttributes)
+		assert.True(t, ai.Paused)
+		assert.Equal(t, mb.GetCurrentVersion(), ai.Version)
+		assert.Equal(t, ai, mb.updateActivityInfos[1])
+		assert.NotNil(t, mb.syncActivityTasks[1])
+	})
+}
+
+func Test__AddActivityTaskUnpausedEvent(t *testing.T) {
+	t.Run("error missing activity info", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		_, err := mb.AddActivityTaskUnpausedEvent(&persistence.ActivityInfo{ScheduleID: 1}, false, false, "test")
+		assert.Error(t, err)
+		assert.Equal(t, "add-activitytask-unpaused-event operation failed", err.Error())
+	})
+	t.Run("scheduled activity is rescheduled with reset", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.hBuilder = NewHistoryBuilder(mb)
+		taskGenerator := NewMockMutableStateTaskGenerator(gomock.NewController(t))
+		mb.taskGenerator = taskGenerator
+		ai := &persistence.ActivityInfo{
+			ScheduleID:               1,
+			StartedID:                commonconstants.EmptyEventID,
+			Paused:                   true,
+			Attempt:                  3,
+			RequestID:                "request-id",
+			Details:                  []byte("details"),
+			LastHeartBeatUpdatedTime: currentTime,
+			TimerTaskStatus:          TimerTaskStatusCreatedScheduleToStart,
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+		taskGenerator.EXPECT().GenerateActivityRetryTasks(int64(1)).Return(nil).Times(1)
+
+		event, err := mb.AddActivityTaskUnpausedEvent(ai, true, true, "test")
+		assert.NoError(t, err)
+		assert.Equal(t, &types.ActivityTaskUnpausedEventAttributes{
+			ScheduledEventID:      1,
+			ResetAttempts:         true,
+			ResetHeartbeatDetails: true,
+			Identity:              "test",
+		}, event.ActivityTaskUnpausedEventAttributes)
+		assert.False(t, ai.Paused)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Empty(t, ai.RequestID)
+		assert.Nil(t, ai.Details)
+		assert.True(t, ai.LastHeartBeatUpdatedTime.IsZero())
+		assert.Equal(t, int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+		assert.Equal(t, time.Unix(0, event.GetTimestamp()), ai.ScheduledTime)
+		assert.Equal(t, ai, mb.updateActivityInfos[1])
+		assert.NotNil(t, mb.syncActivityTasks[1])
+	})
+	t.Run("started activity is not rescheduled", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.hBuilder = NewHistoryBuilder(mb)
+		ai := &persistence.ActivityInfo{
+			ScheduleID: 1,
+			StartedID:  2,
+			Paused:     true,
+			Attempt:    3,
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		_, err := mb.AddActivityTaskUnpausedEvent(ai, false, false, "test")
+		assert.NoError(t, err)
+		assert.False(t, ai.Paused)
+		assert.Equal(t, int32(3), ai.Attempt)
+		assert.Equal(t, ai, mb.updateActivityInfos[1])
+	})
+}
+
+func Test__ReplicateActivityTaskPausedEvent(t *testing.T) {
+	event := &types.HistoryEvent{
+		Version:   10,
+		EventType: types.EventTypeActivityTaskPaused.Ptr(),
+		ActivityTaskPausedEventAttributes: &types.ActivityTaskPausedEventAttributes{
+			ScheduledEventID: 1,
+		},
+	}
+	t.Run("error missing activity info", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		err := mb.ReplicateActivityTaskPausedEvent(event)
+		assert.Equal(t, ErrMissingActivityInfo, err)
+	})
+	t.Run("activity is paused", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{ScheduleID: 1}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.ReplicateActivityTaskPausedEvent(event)
+		assert.NoError(t, err)
+		assert.True(t, ai.Paused)
+		assert.Equal(t, int64(10), ai.Version)
+		assert.Equal(t, ai, mb.updateActivityInfos[1])
+	})
+}
+
+func Test__ReplicateActivityTaskUnpausedEvent(t *testing.T) {
+	event := &types.HistoryEvent{
+		Version:   10,
+		Timestamp: common.Int64Ptr(currentTime.UnixNano()),
+		EventType: types.EventTypeActivityTaskUnpaused.Ptr(),
+		ActivityTaskUnpausedEventAttributes: &types.ActivityTaskUnpausedEventAttributes{
+			ScheduledEventID: 1,
+			ResetAttempts:    true,
+		},
+	}
+	t.Run("error missing activity info", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		err := mb.ReplicateActivityTaskUnpausedEvent(event)
+		assert.Equal(t, ErrMissingActivityInfo, err)
+	})
+	t.Run("scheduled activity is rescheduled at unpause time", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID: 1,
+			StartedID:  commonconstants.EmptyEventID,
+			Paused:     true,
+			Attempt:    3,
+			RequestID:  "request-id",
+			Details:    []byte("details"),
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.ReplicateActivityTaskUnpausedEvent(event)
+		assert.NoError(t, err)
+		assert.False(t, ai.Paused)
+		assert.Equal(t, int64(10), ai.Version)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Equal(t, []byte("details"), ai.Details)
+		assert.Empty(t, ai.RequestID)
+		assert.Equal(t, currentTime.UnixNano(), ai.ScheduledTime.UnixNano())
+		assert.Equal(t, ai, mb.updateActivityInfos[1])
+	})
+}
+
+func Test__ReplicateActivityTaskOptionsUpdatedEvent(t *testing.T) {
+	event := &types.HistoryEvent{
+		Version:   10,
+		Timestamp: common.Int64Ptr(currentTime.UnixNano()),
+		EventType: types.EventTypeActivityTaskOptionsUpdated.Ptr(),
+		ActivityTaskOptionsUpdatedEventAttributes: &types.ActivityTaskOptionsUpdatedEventAttributes{
+			ScheduledEventID: 1,
+			ActivityOptions: &types.ActivityOptions{
+				TaskList:                   &types.TaskList{Name: "new-tl"},
+				StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+				RetryPolicy: &types.RetryPolicy{
+					InitialIntervalInSeconds:    1,
+					BackoffCoefficient:          2,
+					MaximumAttempts:             3,
+					ExpirationIntervalInSeconds: 60,
+				},
+			},
+		},
+	}
+	t.Run("error missing activity info", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		err := mb.ReplicateActivityTaskOptionsUpdatedEvent(event)
+		assert.Equal(t, ErrMissingActivityInfo, err)
+	})
+	t.Run("only set options are applied", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:             1,
+			TaskList:               "old-tl",
+			ScheduleToCloseTimeout: 100,
+			StartToCloseTimeout:    10,
+			HeartbeatTimeout:       5,
+			TimerTaskStatus:        TimerTaskStatusCreatedStartToClose,
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.ReplicateActivityTaskOptionsUpdatedEvent(event)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), ai.Version)
+		assert.Equal(t, "new-tl", ai.TaskList)
+		assert.Equal(t, int32(100), ai.ScheduleToCloseTimeout)
+		assert.Equal(t, int32(30), ai.StartToCloseTimeout)
+		assert.Equal(t, int32(5), ai.HeartbeatTimeout)
+		assert.True(t, ai.HasRetryPolicy)
+		assert.Equal(t, int32(3), ai.MaximumAttempts)
+		assert.Equal(t, currentTime.Add(time.Minute), ai.ExpirationTime)
+		assert.Equal(t, int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+		assert.Equal(t, ai, mb.updateActivityInfos[1])
+	})
+}
+
 func Test__GetActivityScheduledEvent(t *testing.T) {
 	mb := testMutableStateBuilder(t)
 	ai := &persistence.ActivityInfo{
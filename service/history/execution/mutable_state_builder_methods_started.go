@@ -82,6 +82,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		JitterStartSeconds:                  attributes.JitterStartSeconds,
 		CronOverlapPolicy:                   attributes.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:        attributes.ActiveClusterSelectionPolicy,
+		Priority:                            previousExecutionInfo.Priority,
 	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
@@ -94,6 +95,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		ContinueAsNewInitiator:          attributes.Initiator,
 		FirstDecisionTaskBackoffSeconds: attributes.BackoffStartIntervalInSeconds,
 		PartitionConfig:                 previousExecutionInfo.PartitionConfig,
+		FairnessKey:                     previousExecutionInfo.FairnessKey,
 	}
 
 	// if ContinueAsNew as Cron or decider, recalculate the expiration timestamp and set attempts to 0
@@ -215,6 +217,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.WorkflowTimeout = event.GetExecutionStartToCloseTimeoutSeconds()
 	e.executionInfo.DecisionStartToCloseTimeout = event.GetTaskStartToCloseTimeoutSeconds()
 	e.executionInfo.StartTimestamp = e.unixNanoToTime(startEvent.GetTimestamp())
+	e.executionInfo.Priority = event.GetPriority()
 
 	if err := e.UpdateWorkflowStateCloseStatus(
 		persistence.WorkflowStateCreated,
@@ -281,6 +284,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 		e.executionInfo.SearchAttributes = event.SearchAttributes.GetIndexedFields()
 	}
 	e.executionInfo.PartitionConfig = event.PartitionConfig
+	e.executionInfo.FairnessKey = event.FairnessKey
 	e.executionInfo.ActiveClusterSelectionPolicy = event.ActiveClusterSelectionPolicy
 
 	e.writeEventToCache(startEvent)
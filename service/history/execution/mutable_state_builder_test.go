@@ -244,6 +244,7 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeStartChildWorkflowExecutionInitiated:            true,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated:        true,
 		types.EventTypeUpsertWorkflowSearchAttributes:                  true,
+		types.EventTypeUpsertWorkflowMemo:                              true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -858,6 +859,18 @@ func (s *mutableStateSuite) TestLoad_BackwardsCompatibility() {
 	s.Equal(constants.TestDomainID, s.msBuilder.pendingChildExecutionInfoIDs[81].DomainID)
 }
 
+func (s *mutableStateSuite) TestLoad_ReencryptionRequired() {
+	mutableState := buildWorkflowMutableState()
+	mutableState.ReencryptionRequired = true
+
+	s.msBuilder.Load(context.Background(), mutableState)
+
+	s.NotEmpty(s.msBuilder.updateActivityInfos)
+	s.Equal(s.msBuilder.pendingActivityInfoIDs, s.msBuilder.updateActivityInfos)
+	s.NotEmpty(s.msBuilder.updateChildExecutionInfos)
+	s.Equal(s.msBuilder.pendingChildExecutionInfoIDs, s.msBuilder.updateChildExecutionInfos)
+}
+
 func (s *mutableStateSuite) TestUpdateCurrentVersion_WorkflowOpen() {
 	mutableState := buildWorkflowMutableState()
 
@@ -3431,6 +3444,110 @@ func TestAddUpsertWorkflowSearchAttributesEvent(t *testing.T) {
 	}
 }
 
+func TestAddUpsertWorkflowMemoEvent(t *testing.T) {
+
+	now := time.Unix(1730353941, 0)
+
+	tests := map[string]struct {
+		decisionCompletedEventID int64
+		request                  *types.UpsertWorkflowMemoDecisionAttributes
+		mutableStateBuilderSetup func(m *mutableStateBuilder)
+		expectedEvent            *types.HistoryEvent
+		expectedMemo             map[string][]byte
+		expectedErr              error
+	}{
+		"successful upsert": {
+			decisionCompletedEventID: 123,
+			request: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{
+					Fields: map[string][]byte{
+						"updated": []byte("new"),
+						"added":   []byte("value"),
+					},
+				},
+			},
+			mutableStateBuilderSetup: func(m *mutableStateBuilder) {
+				m.executionInfo.Memo = map[string][]byte{
+					"updated": []byte("old"),
+					"kept":    []byte("value"),
+				}
+			},
+			expectedEvent: &types.HistoryEvent{
+				ID:        1,
+				EventType: types.EventTypeUpsertWorkflowMemo.Ptr(),
+				UpsertWorkflowMemoEventAttributes: &types.UpsertWorkflowMemoEventAttributes{
+					DecisionTaskCompletedEventID: 123,
+					Memo: &types.Memo{
+						Fields: map[string][]byte{
+							"updated": []byte("new"),
+							"added":   []byte("value"),
+						},
+					},
+				},
+				TaskID:    commonconstants.EmptyEventTaskID,
+				Version:   commonconstants.EmptyVersion,
+				Timestamp: common.Ptr(now.UnixNano()),
+			},
+			expectedMemo: map[string][]byte{
+				"updated": []byte("new"),
+				"added":   []byte("value"),
+				"kept":    []byte("value"),
+			},
+			expectedErr: nil,
+		},
+		"mutability check fails": {
+			decisionCompletedEventID: 123,
+			request: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{
+					Fields: map[string][]byte{
+						"added": []byte("value"),
+					},
+				},
+			},
+			mutableStateBuilderSetup: func(m *mutableStateBuilder) {
+				m.executionInfo.State = persistence.WorkflowStateCompleted
+			},
+			expectedEvent: nil,
+			expectedErr:   &types.InternalServiceError{Message: "invalid mutable state action: mutation after finish"},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+
+			shardContext := shard.NewMockContext(ctrl)
+			shardContext.EXPECT().GetLogger().Return(testlogger.New(t)).AnyTimes()
+			mockCache := events.NewMockCache(ctrl)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+
+			nowClock := clock.NewMockedTimeSourceAt(now)
+
+			msb := createMSBWithMocks(mockCache, shardContext, mockDomainCache, nil)
+
+			msb.hBuilder = &HistoryBuilder{
+				history:   []*types.HistoryEvent{},
+				msBuilder: msb,
+			}
+
+			td.mutableStateBuilderSetup(msb)
+			originalMemo := msb.executionInfo.Memo
+
+			msb.timeSource = nowClock
+
+			event, err := msb.AddUpsertWorkflowMemoEvent(td.decisionCompletedEventID, td.request)
+
+			assert.Equal(t, td.expectedEvent, event)
+			assert.Equal(t, td.expectedErr, err)
+			if td.expectedMemo != nil {
+				assert.Equal(t, td.expectedMemo, msb.executionInfo.Memo)
+				assert.Equal(t, []byte("old"), originalMemo["updated"])
+			}
+		})
+	}
+}
+
 func TestCloseTransactionAsMutation(t *testing.T) {
 
 	now := time.Unix(500, 0)
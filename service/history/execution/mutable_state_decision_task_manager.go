@@ -525,10 +525,14 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskStartedEvent(
 		decision.Attempt = 0
 	}
 
+	if buildID := request.GetWorkerVersionInfo().GetBuildID(); buildID != "" {
+		m.pinWorkerBuildID(buildID)
+	}
+
 	// Avoid creating new history events when decisions are continuously failing
 	if decision.Attempt == 0 {
 		// Now create DecisionTaskStartedEvent
-		event = m.msb.hBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request.GetIdentity())
+		event = m.msb.hBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request.GetIdentity(), m.msb.executionInfo.WorkerBuildID)
 		startedID = event.ID
 		startTime = event.GetTimestamp()
 	}
@@ -537,6 +541,15 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskStartedEvent(
 	return event, decision, err
 }
 
+// pinWorkerBuildID pins the workflow to the build ID of the worker which started its first decision,
+// matching only dispatches the decision tasks of a pinned workflow to workers of a compatible build ID.
+// The pin is recorded in every DecisionTaskStarted event written afterwards, so it is replicated and rebuilt with the history.
+func (m *mutableStateDecisionTaskManagerImpl) pinWorkerBuildID(buildID string) {
+	if m.msb.executionInfo.WorkerBuildID == "" {
+		m.msb.executionInfo.WorkerBuildID = buildID
+	}
+}
+
 func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskCompletedEvent(
 	scheduleEventID int64,
 	startedEventID int64,
@@ -561,7 +574,7 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskCompletedEvent(
 		scheduledEvent := m.msb.hBuilder.AddTransientDecisionTaskScheduledEvent(m.msb.executionInfo.TaskList, decision.DecisionTimeout,
 			decision.Attempt, decision.ScheduledTimestamp)
 		startedEvent := m.msb.hBuilder.AddTransientDecisionTaskStartedEvent(scheduledEvent.ID, decision.RequestID,
-			request.GetIdentity(), decision.StartedTimestamp)
+			request.GetIdentity(), m.msb.executionInfo.WorkerBuildID, decision.StartedTimestamp)
 		startedEventID = startedEvent.ID
 	}
 	// Now write the completed event
@@ -835,6 +848,7 @@ func (m *mutableStateDecisionTaskManagerImpl) CreateTransientDecisionEvents(
 		decision.ScheduleID,
 		decision.RequestID,
 		identity,
+		m.msb.executionInfo.WorkerBuildID,
 	)
 
 	return scheduledEvent, startedEvent
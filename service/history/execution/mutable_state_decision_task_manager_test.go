@@ -36,6 +36,7 @@ import (
 
 	"github.com/uber/cadence/common/clock"
 	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -290,6 +291,30 @@ func TestGetDecisionScheduleToStartTimeout(t *testing.T) {
 	})
 }
 
+func TestPinWorkerBuildID(t *testing.T) {
+	t.Run("pin build ID", func(t *testing.T) {
+		partitionConfig := map[string]string{isolationgroup.GroupKey: "zone-a"}
+		m := &mutableStateDecisionTaskManagerImpl{
+			msb: &mutableStateBuilder{executionInfo: &persistence.WorkflowExecutionInfo{
+				PartitionConfig: partitionConfig,
+			}},
+		}
+		m.pinWorkerBuildID("build-1")
+		assert.Equal(t, "build-1", m.msb.executionInfo.WorkerBuildID)
+		assert.Equal(t, map[string]string{isolationgroup.GroupKey: "zone-a"}, m.msb.executionInfo.PartitionConfig)
+	})
+
+	t.Run("already pinned", func(t *testing.T) {
+		m := &mutableStateDecisionTaskManagerImpl{
+			msb: &mutableStateBuilder{executionInfo: &persistence.WorkflowExecutionInfo{
+				WorkerBuildID: "build-1",
+			}},
+		}
+		m.pinWorkerBuildID("build-2")
+		assert.Equal(t, "build-1", m.msb.executionInfo.WorkerBuildID)
+	})
+}
+
 func TestHasProcessedOrPendingDecision(t *testing.T) {
 	t.Run("true", func(t *testing.T) {
 		m := &mutableStateDecisionTaskManagerImpl{
@@ -109,6 +109,36 @@ func (mr *MockMutableStateMockRecorder) AddActivityTaskFailedEvent(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskFailedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskFailedEvent), arg0, arg1, arg2)
 }
 
+// AddActivityTaskOptionsUpdatedEvent mocks base method.
+func (m *MockMutableState) AddActivityTaskOptionsUpdatedEvent(arg0 *persistence.ActivityInfo, arg1 *types.ActivityOptions, arg2 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityTaskOptionsUpdatedEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityTaskOptionsUpdatedEvent indicates an expected call of AddActivityTaskOptionsUpdatedEvent.
+func (mr *MockMutableStateMockRecorder) AddActivityTaskOptionsUpdatedEvent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskOptionsUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskOptionsUpdatedEvent), arg0, arg1, arg2)
+}
+
+// AddActivityTaskPausedEvent mocks base method.
+func (m *MockMutableState) AddActivityTaskPausedEvent(arg0 *persistence.ActivityInfo, arg1, arg2 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityTaskPausedEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityTaskPausedEvent indicates an expected call of AddActivityTaskPausedEvent.
+func (mr *MockMutableStateMockRecorder) AddActivityTaskPausedEvent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskPausedEvent), arg0, arg1, arg2)
+}
+
 // AddActivityTaskScheduledEvent mocks base method.
 func (m *MockMutableState) AddActivityTaskScheduledEvent(arg0 int64, arg1 *types.ScheduleActivityTaskDecisionAttributes) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +186,21 @@ func (mr *MockMutableStateMockRecorder) AddActivityTaskTimedOutEvent(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskTimedOutEvent), arg0, arg1, arg2, arg3)
 }
 
+// AddActivityTaskUnpausedEvent mocks base method.
+func (m *MockMutableState) AddActivityTaskUnpausedEvent(arg0 *persistence.ActivityInfo, arg1, arg2 bool, arg3 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityTaskUnpausedEvent", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityTaskUnpausedEvent indicates an expected call of AddActivityTaskUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) AddActivityTaskUnpausedEvent(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskUnpausedEvent), arg0, arg1, arg2, arg3)
+}
+
 // AddCancelTimerFailedEvent mocks base method.
 func (m *MockMutableState) AddCancelTimerFailedEvent(arg0 int64, arg1 *types.CancelTimerDecisionAttributes, arg2 string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -700,6 +745,21 @@ func (mr *MockMutableStateMockRecorder) AddTransferTasks(transferTasks ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransferTasks", reflect.TypeOf((*MockMutableState)(nil).AddTransferTasks), transferTasks...)
 }
 
+// AddUpsertWorkflowMemoEvent mocks base method.
+func (m *MockMutableState) AddUpsertWorkflowMemoEvent(arg0 int64, arg1 *types.UpsertWorkflowMemoDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUpsertWorkflowMemoEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUpsertWorkflowMemoEvent indicates an expected call of AddUpsertWorkflowMemoEvent.
+func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowMemoEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowMemoEvent), arg0, arg1)
+}
+
 // AddUpsertWorkflowSearchAttributesEvent mocks base method.
 func (m *MockMutableState) AddUpsertWorkflowSearchAttributesEvent(arg0 int64, arg1 *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -790,6 +850,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity)
 }
 
+// AddWorkflowExecutionUpdateAcceptedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID int64, updateID string, update *types.WorkflowUpdate, result []byte, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateAcceptedEvent", decisionCompletedEventID, updateID, update, result, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent indicates an expected call of AddWorkflowExecutionUpdateAcceptedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, updateID, update, result, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateAcceptedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateAcceptedEvent), decisionCompletedEventID, updateID, update, result, identity)
+}
+
 // ByteSize mocks base method.
 func (m *MockMutableState) ByteSize() uint64 {
 	m.ctrl.T.Helper()
@@ -1865,6 +1940,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskFailedEvent(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskFailedEvent), arg0)
 }
 
+// ReplicateActivityTaskOptionsUpdatedEvent mocks base method.
+func (m *MockMutableState) ReplicateActivityTaskOptionsUpdatedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityTaskOptionsUpdatedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityTaskOptionsUpdatedEvent indicates an expected call of ReplicateActivityTaskOptionsUpdatedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskOptionsUpdatedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskOptionsUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskOptionsUpdatedEvent), arg0)
+}
+
+// ReplicateActivityTaskPausedEvent mocks base method.
+func (m *MockMutableState) ReplicateActivityTaskPausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityTaskPausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityTaskPausedEvent indicates an expected call of ReplicateActivityTaskPausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskPausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskPausedEvent), arg0)
+}
+
 // ReplicateActivityTaskScheduledEvent mocks base method.
 func (m *MockMutableState) ReplicateActivityTaskScheduledEvent(arg0 int64, arg1 *types.HistoryEvent, arg2 bool) (*persistence.ActivityInfo, error) {
 	m.ctrl.T.Helper()
@@ -1908,6 +2011,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskTimedOutEvent(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskTimedOutEvent), arg0)
 }
 
+// ReplicateActivityTaskUnpausedEvent mocks base method.
+func (m *MockMutableState) ReplicateActivityTaskUnpausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityTaskUnpausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityTaskUnpausedEvent indicates an expected call of ReplicateActivityTaskUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskUnpausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskUnpausedEvent), arg0)
+}
+
 // ReplicateChildWorkflowExecutionCanceledEvent mocks base method.
 func (m *MockMutableState) ReplicateChildWorkflowExecutionCanceledEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2236,6 +2353,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateTransientDecisionTaskScheduled(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateTransientDecisionTaskScheduled", reflect.TypeOf((*MockMutableState)(nil).ReplicateTransientDecisionTaskScheduled))
 }
 
+// ReplicateUpsertWorkflowMemoEvent mocks base method.
+func (m *MockMutableState) ReplicateUpsertWorkflowMemoEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateUpsertWorkflowMemoEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateUpsertWorkflowMemoEvent indicates an expected call of ReplicateUpsertWorkflowMemoEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateUpsertWorkflowMemoEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateUpsertWorkflowMemoEvent), arg0)
+}
+
 // ReplicateUpsertWorkflowSearchAttributesEvent mocks base method.
 func (m *MockMutableState) ReplicateUpsertWorkflowSearchAttributesEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -43,6 +43,7 @@ type (
 	// MutableStateTaskRefresher refreshes workflow transfer and timer tasks
 	MutableStateTaskRefresher interface {
 		RefreshTasks(ctx context.Context, startTime time.Time, mutableState MutableState) error
+		RefreshActivityTasks(ctx context.Context, now time.Time, mutableState MutableState, activityScheduleID int64) error
 	}
 
 	mutableStateTaskRefresherImpl struct {
@@ -207,6 +208,31 @@ func (r *mutableStateTaskRefresherImpl) RefreshTasks(
 	return nil
 }
 
+// RefreshActivityTasks regenerates the timer tasks of a single pending activity after its options
+// are changed, the activity is dispatched again if it is waiting in matching to be started
+func (r *mutableStateTaskRefresherImpl) RefreshActivityTasks(
+	ctx context.Context,
+	now time.Time,
+	mutableState MutableState,
+	activityScheduleID int64,
+) error {
+	taskGenerator := r.newMutableStateTaskGeneratorFn(
+		r.logger,
+		r.clusterMetadata,
+		r.domainCache,
+		mutableState,
+	)
+
+	return refreshTasksForPendingActivity(
+		ctx,
+		now,
+		mutableState,
+		taskGenerator,
+		activityScheduleID,
+		NewTimerSequence,
+	)
+}
+
 func refreshTasksForWorkflowStart(
 	ctx context.Context,
 	startTime time.Time,
@@ -358,6 +384,52 @@ func refreshTasksForActivity(
 	return nil
 }
 
+func refreshTasksForPendingActivity(
+	ctx context.Context,
+	now time.Time,
+	mutableState MutableState,
+	taskGenerator MutableStateTaskGenerator,
+	activityScheduleID int64,
+	newTimerSequenceFn func(MutableState) TimerSequence,
+) error {
+	activityInfo, ok := mutableState.GetActivityInfo(activityScheduleID)
+	if !ok {
+		return ErrMissingActivityInfo
+	}
+
+	// clear activity timer task mask for later activity timer task re-generation
+	activityInfo.TimerTaskStatus = TimerTaskStatusNone
+	if err := mutableState.UpdateActivity(
+		activityInfo,
+	); err != nil {
+		return err
+	}
+
+	// an activity waiting for its retry timer or paused is dispatched later,
+	// otherwise it is already in matching and needs to be dispatched again
+	if activityInfo.StartedID == constants.EmptyEventID &&
+		!activityInfo.Paused &&
+		!activityInfo.ScheduledTime.After(now) {
+		scheduleEvent, err := mutableState.GetActivityScheduledEvent(ctx, activityScheduleID)
+		if err != nil {
+			return err
+		}
+		if err := taskGenerator.GenerateActivityTransferTasks(
+			scheduleEvent,
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := newTimerSequenceFn(
+		mutableState,
+	).CreateNextActivityTimer(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func refreshTasksForTimer(
 	ctx context.Context,
 	mutableState MutableState,
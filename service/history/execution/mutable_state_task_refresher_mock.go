@@ -41,6 +41,20 @@ func (m *MockMutableStateTaskRefresher) EXPECT() *MockMutableStateTaskRefresherM
 	return m.recorder
 }
 
+// RefreshActivityTasks mocks base method.
+func (m *MockMutableStateTaskRefresher) RefreshActivityTasks(ctx context.Context, now time.Time, mutableState MutableState, activityScheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshActivityTasks", ctx, now, mutableState, activityScheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshActivityTasks indicates an expected call of RefreshActivityTasks.
+func (mr *MockMutableStateTaskRefresherMockRecorder) RefreshActivityTasks(ctx, now, mutableState, activityScheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshActivityTasks", reflect.TypeOf((*MockMutableStateTaskRefresher)(nil).RefreshActivityTasks), ctx, now, mutableState, activityScheduleID)
+}
+
 // RefreshTasks mocks base method.
 func (m *MockMutableStateTaskRefresher) RefreshTasks(ctx context.Context, startTime time.Time, mutableState MutableState) error {
 	m.ctrl.T.Helper()
@@ -348,6 +348,75 @@ func TestRefreshTasksForActivity(t *testing.T) {
 	}
 }
 
+func TestRefreshTasksForPendingActivity(t *testing.T) {
+	now := time.Unix(100, 0)
+	testCases := []struct {
+		name      string
+		mockSetup func(*MockMutableState, *MockMutableStateTaskGenerator, *MockTimerSequence)
+		wantErr   bool
+	}{
+		{
+			name: "missing activity info",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(nil, false)
+			},
+			wantErr: true,
+		},
+		{
+			name: "failed to get scheduled event",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, StartedID: constants.EmptyEventID, TimerTaskStatus: TimerTaskStatusCreated}, true)
+				ms.EXPECT().UpdateActivity(&persistence.ActivityInfo{ScheduleID: 12, StartedID: constants.EmptyEventID, TimerTaskStatus: TimerTaskStatusNone}).Return(nil)
+				ms.EXPECT().GetActivityScheduledEvent(gomock.Any(), int64(12)).Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "scheduled activity is dispatched again",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, StartedID: constants.EmptyEventID, ScheduledTime: now}, true)
+				ms.EXPECT().UpdateActivity(gomock.Any()).Return(nil)
+				ms.EXPECT().GetActivityScheduledEvent(gomock.Any(), int64(12)).Return(&types.HistoryEvent{ID: 12}, nil)
+				mtg.EXPECT().GenerateActivityTransferTasks(&types.HistoryEvent{ID: 12}).Return(nil)
+				mt.EXPECT().CreateNextActivityTimer().Return(true, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "activity waiting for retry is not dispatched",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, StartedID: constants.EmptyEventID, ScheduledTime: now.Add(time.Minute)}, true)
+				ms.EXPECT().UpdateActivity(gomock.Any()).Return(nil)
+				mt.EXPECT().CreateNextActivityTimer().Return(true, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "started activity is not dispatched",
+			mockSetup: func(ms *MockMutableState, mtg *MockMutableStateTaskGenerator, mt *MockTimerSequence) {
+				ms.EXPECT().GetActivityInfo(int64(12)).Return(&persistence.ActivityInfo{ScheduleID: 12, StartedID: 13}, true)
+				ms.EXPECT().UpdateActivity(gomock.Any()).Return(nil)
+				mt.EXPECT().CreateNextActivityTimer().Return(false, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ms := NewMockMutableState(ctrl)
+			mtg := NewMockMutableStateTaskGenerator(ctrl)
+			mt := NewMockTimerSequence(ctrl)
+			tc.mockSetup(ms, mtg, mt)
+			err := refreshTasksForPendingActivity(context.Background(), now, ms, mtg, 12, func(MutableState) TimerSequence { return mt })
+			if (err != nil) != tc.wantErr {
+				t.Errorf("refreshTasksForPendingActivity err = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestRefreshTasksForTimer(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -151,6 +151,7 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
 		CronOverlapPolicy:                  sourceInfo.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       sourceInfo.ActiveClusterSelectionPolicy,
+		Priority:                           sourceInfo.Priority,
 	}
 }
 
@@ -193,6 +194,7 @@ func CopyActivityInfo(t *testing.T, sourceInfo *persistence.ActivityInfo) *persi
 		LastFailureReason:        sourceInfo.LastFailureReason,
 		LastWorkerIdentity:       sourceInfo.LastWorkerIdentity,
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
+		Priority:                 sourceInfo.Priority,
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
 	}
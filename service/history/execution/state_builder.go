@@ -201,6 +201,9 @@ func (b *stateBuilderImpl) ApplyEvents(
 			if err != nil {
 				return nil, err
 			}
+			if buildID := attributes.GetWorkerBuildID(); buildID != "" {
+				b.mutableState.GetExecutionInfo().WorkerBuildID = buildID
+			}
 
 		case types.EventTypeDecisionTaskCompleted:
 			if err := b.mutableState.ReplicateDecisionTaskCompletedEvent(
@@ -270,6 +273,27 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeActivityTaskOptionsUpdated:
+			if err := b.mutableState.ReplicateActivityTaskOptionsUpdatedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeActivityTaskPaused:
+			if err := b.mutableState.ReplicateActivityTaskPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeActivityTaskUnpaused:
+			if err := b.mutableState.ReplicateActivityTaskUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeActivityTaskCancelRequested:
 			if err := b.mutableState.ReplicateActivityTaskCancelRequestedEvent(
 				event,
@@ -424,6 +448,9 @@ func (b *stateBuilderImpl) ApplyEvents(
 		case types.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case types.EventTypeWorkflowExecutionUpdateAccepted:
+			// No mutable state action is needed
+
 		case types.EventTypeWorkflowExecutionSignaled:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
 				event,
@@ -445,6 +472,13 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeUpsertWorkflowMemo:
+			if err := b.mutableState.ReplicateUpsertWorkflowMemoEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCompleted:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.ID,
@@ -661,6 +661,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeDecisionTaskStarted() {
 		DecisionTaskStartedEventAttributes: &types.DecisionTaskStartedEventAttributes{
 			ScheduledEventID: scheduleID,
 			RequestID:        decisionRequestID,
+			WorkerBuildID:    "build-1",
 		},
 	}
 	di := &DecisionInfo{
@@ -676,11 +677,13 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeDecisionTaskStarted() {
 		(*DecisionInfo)(nil), event.Version, scheduleID, event.ID, decisionRequestID, event.GetTimestamp(),
 	).Return(di, nil).Times(1)
 	s.mockUpdateVersion(event)
-	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	executionInfo := &persistence.WorkflowExecutionInfo{}
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
 	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
 
 	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
 	s.Nil(err)
+	s.Equal("build-1", executionInfo.WorkerBuildID)
 }
 
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeDecisionTaskTimedOut() {
@@ -242,6 +242,12 @@ func (t *timerSequenceImpl) LoadAndSortActivityTimers() []TimerSequenceID {
 
 	for _, activityInfo := range pendingActivities {
 
+		// timers of a paused activity are recreated once it is unpaused,
+		// a started attempt still times out as usual
+		if activityInfo.Paused && activityInfo.StartedID == constants.EmptyEventID {
+			continue
+		}
+
 		if sequenceID := t.getActivityScheduleToCloseTimeout(
 			activityInfo,
 		); sequenceID != nil {
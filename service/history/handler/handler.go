@@ -2031,6 +2031,166 @@ func (h *handlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// PauseActivity pauses a pending activity of a workflow
+func (h *handlerImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+) (resp *types.PauseActivityResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowID()
+	runID := request.GetRequest().GetExecution().GetRunID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.PauseActivity(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// UnpauseActivity unpauses a paused activity of a workflow
+func (h *handlerImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+) (resp *types.UnpauseActivityResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowID()
+	runID := request.GetRequest().GetExecution().GetRunID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.UnpauseActivity(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// UpdateActivityOptions updates the task list, timeouts and retry policy of a pending activity of a workflow
+func (h *handlerImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+) (resp *types.UpdateActivityOptionsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.UpdateActivityOptions(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// UpdateWorkflowExecution delivers an update to a workflow and waits for the worker to accept or reject it
+func (h *handlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	resp, err = engine.UpdateWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
 // NotifyFailoverMarkers sends the failover markers to failover coordinator.
 // The coordinator decides when the failover finishes based on received failover marker.
 func (h *handlerImpl) NotifyFailoverMarkers(
@@ -67,6 +67,8 @@ func TestReplicationTaskResetEvent(t *testing.T) {
 	events = append(events, event)
 	eventsBlob, err := historySerializer.SerializeBatchEvents(events, constants.EncodingTypeThriftRW)
 	require.NoError(t, err)
+	internalEventsBlob, err := eventsBlob.ToInternal()
+	require.NoError(t, err)
 	request := &types.ReplicateEventsV2Request{
 		DomainUUID: domainID,
 		WorkflowExecution: &types.WorkflowExecution{
@@ -74,7 +76,7 @@ func TestReplicationTaskResetEvent(t *testing.T) {
 			RunID:      runID,
 		},
 		VersionHistoryItems: versionHistoryItems,
-		Events:              eventsBlob.ToInternal(),
+		Events:              internalEventsBlob,
 		NewRunEvents:        nil,
 	}
 
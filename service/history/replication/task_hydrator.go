@@ -258,7 +258,7 @@ func (h historyLoader) getEventsBlob(ctx context.Context, domainID string, branc
 		return nil, &types.InternalDataInconsistencyError{Message: "replication hydrator encountered more than 1 NDC raw event batch"}
 	}
 
-	return resp.HistoryEventBlobs[0].ToInternal(), nil
+	return resp.HistoryEventBlobs[0].ToInternal()
 }
 
 // mutableStateLoader uses workflow execution cache to load mutable state
@@ -294,14 +294,14 @@ func (h immediateHistoryProvider) GetEventBlob(_ context.Context, _ *persistence
 	if h.blob == nil {
 		return nil, errors.New("history blob not set")
 	}
-	return h.blob.ToInternal(), nil
+	return h.blob.ToInternal()
 }
 
 func (h immediateHistoryProvider) GetNextRunEventBlob(_ context.Context, _ *persistence.HistoryReplicationTask) (*types.DataBlob, error) {
 	if h.nextBlob == nil {
 		return nil, nil // Expected and common
 	}
-	return h.nextBlob.ToInternal(), nil
+	return h.nextBlob.ToInternal()
 }
 
 type immediateMutableStateProvider struct {
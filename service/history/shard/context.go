@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -647,6 +648,9 @@ func (s *contextImpl) CreateWorkflowExecution(
 		return nil, err
 	}
 
+	// tasks carry the trace context of the request that generated them, so their processing joins the same trace
+	traceContext := tracing.Inject(ctx)
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -656,6 +660,7 @@ func (s *contextImpl) CreateWorkflowExecution(
 		workflowID,
 		request.NewWorkflowSnapshot.TasksByCategory,
 		&immediateTaskMaxReadLevel,
+		traceContext,
 	); err != nil {
 		return nil, err
 	}
@@ -743,6 +748,9 @@ func (s *contextImpl) UpdateWorkflowExecution(
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry.GetInfo().Name)
 
+	// tasks carry the trace context of the request that generated them, so their processing joins the same trace
+	traceContext := tracing.Inject(ctx)
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -752,6 +760,7 @@ func (s *contextImpl) UpdateWorkflowExecution(
 		workflowID,
 		request.UpdateWorkflowMutation.TasksByCategory,
 		&immediateTaskMaxReadLevel,
+		traceContext,
 	); err != nil {
 		return nil, err
 	}
@@ -761,6 +770,7 @@ func (s *contextImpl) UpdateWorkflowExecution(
 			workflowID,
 			request.NewWorkflowSnapshot.TasksByCategory,
 			&immediateTaskMaxReadLevel,
+			traceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -845,6 +855,9 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 
 	request.Encoding = s.getDefaultEncoding(domainEntry.GetInfo().Name)
 
+	// tasks carry the trace context of the request that generated them, so their processing joins the same trace
+	traceContext := tracing.Inject(ctx)
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -855,6 +868,7 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 			workflowID,
 			request.CurrentWorkflowMutation.TasksByCategory,
 			&immediateTaskMaxReadLevel,
+			traceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -864,6 +878,7 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 		workflowID,
 		request.ResetWorkflowSnapshot.TasksByCategory,
 		&immediateTaskMaxReadLevel,
+		traceContext,
 	); err != nil {
 		return nil, err
 	}
@@ -873,6 +888,7 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 			workflowID,
 			request.NewWorkflowSnapshot.TasksByCategory,
 			&immediateTaskMaxReadLevel,
+			traceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -1252,10 +1268,18 @@ func (s *contextImpl) allocateTaskIDsLocked(
 	workflowID string,
 	tasksByCategory map[persistence.HistoryTaskCategory][]persistence.Task,
 	immediateTaskMaxReadLevel *int64,
+	traceContext map[string]string,
 ) error {
 	var err error
 	var replicationTasks []persistence.Task
 	for c, tasks := range tasksByCategory {
+		if traceContext != nil && c.ID() != persistence.HistoryTaskCategoryIDReplication {
+			for _, task := range tasks {
+				if task.GetTraceContext() == nil {
+					task.SetTraceContext(traceContext)
+				}
+			}
+		}
 		switch c.Type() {
 		case persistence.HistoryTaskCategoryTypeImmediate:
 			if c.ID() == persistence.HistoryTaskCategoryIDReplication {
@@ -34,6 +34,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common"
@@ -612,6 +615,43 @@ func (s *contextTestSuite) TestCreateWorkflowExecution() {
 	}
 }
 
+func (s *contextTestSuite) TestCreateWorkflowExecution_TraceContext() {
+	prevPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(prevPropagator)
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "StartWorkflowExecution")
+	defer span.End()
+
+	transferTask := &persistence.DecisionTask{}
+	replicationTask := &persistence.HistoryReplicationTask{}
+	request := &persistence.CreateWorkflowExecutionRequest{
+		DomainName: testDomain,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:   testDomainID,
+				WorkflowID: testWorkflowID,
+			},
+			TasksByCategory: map[persistence.HistoryTaskCategory][]persistence.Task{
+				persistence.HistoryTaskCategoryTransfer:    {transferTask},
+				persistence.HistoryTaskCategoryReplication: {replicationTask},
+			},
+		},
+	}
+	domainCacheEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID},
+		&persistence.DomainConfig{Retention: 7},
+		testCluster,
+	)
+	s.mockResource.DomainCache.EXPECT().GetDomainByID(testDomainID).Return(domainCacheEntry, nil)
+	s.mockResource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Once().Return(&persistence.CreateWorkflowExecutionResponse{}, nil)
+
+	_, err := s.context.CreateWorkflowExecution(ctx, request)
+	s.NoError(err)
+	s.Contains(transferTask.GetTraceContext()["traceparent"], span.SpanContext().TraceID().String())
+	s.Nil(replicationTask.GetTraceContext())
+}
+
 func (s *contextTestSuite) TestUpdateWorkflowExecution() {
 	cases := []struct {
 		name            string
@@ -280,6 +280,20 @@ func (mr *MockTaskMockRecorder) GetTaskType() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskType", reflect.TypeOf((*MockTask)(nil).GetTaskType))
 }
 
+// GetTraceContext mocks base method.
+func (m *MockTask) GetTraceContext() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceContext")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// GetTraceContext indicates an expected call of GetTraceContext.
+func (mr *MockTaskMockRecorder) GetTraceContext() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceContext", reflect.TypeOf((*MockTask)(nil).GetTraceContext))
+}
+
 // GetVersion mocks base method.
 func (m *MockTask) GetVersion() int64 {
 	m.ctrl.T.Helper()
@@ -412,6 +426,18 @@ func (mr *MockTaskMockRecorder) SetTaskID(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskID", reflect.TypeOf((*MockTask)(nil).SetTaskID), id)
 }
 
+// SetTraceContext mocks base method.
+func (m *MockTask) SetTraceContext(traceContext map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTraceContext", traceContext)
+}
+
+// SetTraceContext indicates an expected call of SetTraceContext.
+func (mr *MockTaskMockRecorder) SetTraceContext(traceContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTraceContext", reflect.TypeOf((*MockTask)(nil).SetTraceContext), traceContext)
+}
+
 // SetVersion mocks base method.
 func (m *MockTask) SetVersion(version int64) {
 	m.ctrl.T.Helper()
@@ -768,6 +794,20 @@ func (mr *MockCrossClusterTaskMockRecorder) GetTaskType() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskType", reflect.TypeOf((*MockCrossClusterTask)(nil).GetTaskType))
 }
 
+// GetTraceContext mocks base method.
+func (m *MockCrossClusterTask) GetTraceContext() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceContext")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// GetTraceContext indicates an expected call of GetTraceContext.
+func (mr *MockCrossClusterTaskMockRecorder) GetTraceContext() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceContext", reflect.TypeOf((*MockCrossClusterTask)(nil).GetTraceContext))
+}
+
 // GetVersion mocks base method.
 func (m *MockCrossClusterTask) GetVersion() int64 {
 	m.ctrl.T.Helper()
@@ -942,6 +982,18 @@ func (mr *MockCrossClusterTaskMockRecorder) SetTaskID(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskID", reflect.TypeOf((*MockCrossClusterTask)(nil).SetTaskID), id)
 }
 
+// SetTraceContext mocks base method.
+func (m *MockCrossClusterTask) SetTraceContext(traceContext map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTraceContext", traceContext)
+}
+
+// SetTraceContext indicates an expected call of SetTraceContext.
+func (mr *MockCrossClusterTaskMockRecorder) SetTraceContext(traceContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTraceContext", reflect.TypeOf((*MockCrossClusterTask)(nil).SetTraceContext), traceContext)
+}
+
 // SetVersion mocks base method.
 func (m *MockCrossClusterTask) SetVersion(version int64) {
 	m.ctrl.T.Helper()
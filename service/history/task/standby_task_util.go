@@ -102,12 +102,17 @@ type (
 		activityScheduleToStartTimeout int32
 		tasklist                       types.TaskList
 		partitionConfig                map[string]string
+		priority                       int32
+		fairnessKey                    string
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
 		partitionConfig                map[string]string
+		priority                       int32
+		workerBuildID                  string
+		fairnessKey                    string
 	}
 )
 
@@ -115,12 +120,16 @@ func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
 	tasklist types.TaskList,
 	partitionConfig map[string]string,
+	priority int32,
+	fairnessKey string,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
 		tasklist:                       tasklist,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
 	}
 }
 
@@ -128,12 +137,18 @@ func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
 	partitionConfig map[string]string,
+	priority int32,
+	workerBuildID string,
+	fairnessKey string,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
+		workerBuildID:                  workerBuildID,
+		fairnessKey:                    fairnessKey,
 	}
 }
 
@@ -24,6 +24,8 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/constants"
@@ -31,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -183,6 +186,25 @@ func GetTimerTaskMetricScope(
 	}
 }
 
+// startTaskSpan starts a span for executing task, named after its metrics scope.
+// If the task carries the trace context of the request that created it, the span continues that trace.
+func startTaskSpan(
+	ctx context.Context,
+	task persistence.Task,
+	scopeIdx metrics.ScopeIdx,
+) (context.Context, trace.Span) {
+	ctx = tracing.Extract(ctx, task.GetTraceContext())
+	return tracing.Tracer().Start(ctx, metrics.ScopeDefs[metrics.History][scopeIdx].GetOperationString(),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("cadence.domain_id", task.GetDomainID()),
+			attribute.String("cadence.workflow_id", task.GetWorkflowID()),
+			attribute.String("cadence.run_id", task.GetRunID()),
+			attribute.Int64("cadence.task_id", task.GetTaskID()),
+		),
+	)
+}
+
 // verifyTaskVersion, will return true if failover version check is successful
 func verifyTaskVersion(
 	shard shard.Context,
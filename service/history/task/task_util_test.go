@@ -27,6 +27,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
@@ -39,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
@@ -361,6 +365,43 @@ func TestGetTimerTaskMetricScope(t *testing.T) {
 	}
 }
 
+func Test_startTaskSpan(t *testing.T) {
+	provider := sdktrace.NewTracerProvider()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	task := &persistence.ActivityTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   constants.TestDomainID,
+			WorkflowID: constants.TestWorkflowID,
+			RunID:      constants.TestRunID,
+		},
+	}
+
+	// without a stored trace context the task starts a new trace
+	_, span := startTaskSpan(context.Background(), task, metrics.TransferActiveTaskActivityScope)
+	span.End()
+	readOnly := span.(sdktrace.ReadOnlySpan)
+	assert.Equal(t, "TransferActiveTaskActivity", readOnly.Name())
+	assert.False(t, readOnly.Parent().IsValid())
+
+	// with a stored trace context the task continues the trace that created it
+	producerCtx, producer := provider.Tracer("test").Start(context.Background(), "producer")
+	producer.End()
+	task.TraceContext = tracing.Inject(producerCtx)
+
+	_, span = startTaskSpan(context.Background(), task, metrics.TransferActiveTaskActivityScope)
+	span.End()
+	readOnly = span.(sdktrace.ReadOnlySpan)
+	assert.Equal(t, producer.SpanContext().TraceID(), readOnly.SpanContext().TraceID())
+	assert.Equal(t, producer.SpanContext().SpanID(), readOnly.Parent().SpanID())
+}
+
 func Test_verifyTaskVersion(t *testing.T) {
 	testCases := []struct {
 		name      string
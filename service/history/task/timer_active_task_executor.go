@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -77,7 +78,7 @@ func NewTimerActiveTaskExecutor(
 	}
 }
 
-func (t *timerActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
+func (t *timerActiveTaskExecutor) Execute(task Task) (_ ExecuteResponse, retErr error) {
 	simulation.LogEvents(simulation.E{
 		EventName:  simulation.EventNameExecuteHistoryTask,
 		Host:       t.shard.GetConfig().HostName,
@@ -91,38 +92,42 @@ func (t *timerActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 			"task_key":      task.GetTaskKey(),
 		},
 	})
-	scope := getOrCreateDomainTaggedScope(t.shard, GetTimerTaskMetricScope(task.GetTaskType(), true), task.GetDomainID(), t.logger)
+	scopeIdx := GetTimerTaskMetricScope(task.GetTaskType(), true)
+	scope := getOrCreateDomainTaggedScope(t.shard, scopeIdx, task.GetDomainID(), t.logger)
 	executeResponse := ExecuteResponse{
 		Scope:        scope,
 		IsActiveTask: true,
 	}
+	spanCtx, span := startTaskSpan(t.ctx, task.GetInfo(), scopeIdx)
+	defer func() { tracing.EndSpan(span, retErr) }()
+
 	switch timerTask := task.GetInfo().(type) {
 	case *persistence.UserTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeUserTimerTimeoutTask(ctx, timerTask)
 	case *persistence.ActivityTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeActivityTimeoutTask(ctx, timerTask)
 	case *persistence.DecisionTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeDecisionTimeoutTask(ctx, timerTask)
 	case *persistence.WorkflowTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowTimeoutTask(ctx, timerTask)
 	case *persistence.ActivityRetryTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeActivityRetryTimerTask(ctx, timerTask)
 	case *persistence.WorkflowBackoffTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case *persistence.DeleteHistoryEventTask:
-		ctx, cancel := context.WithTimeout(t.ctx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
+		ctx, cancel := context.WithTimeout(spanCtx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
 		defer cancel()
 		return executeResponse, t.executeDeleteHistoryEventTask(ctx, timerTask)
 	default:
@@ -701,6 +706,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		}
 		return nil
 	}
+	if activityInfo.Paused {
+		// a new retry timer task is generated when the activity is unpaused
+		return nil
+	}
 	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, activityInfo.Version, task.Version, task)
 	if err != nil || !ok {
 		return err
@@ -754,6 +763,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
 		PartitionConfig:               mutableState.GetExecutionInfo().PartitionConfig,
+		Priority:                      activityInfo.Priority,
+		FairnessKey:                   mutableState.GetExecutionInfo().FairnessKey,
 	})
 	return err
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -85,7 +86,7 @@ func NewTimerStandbyTaskExecutor(
 	}
 }
 
-func (t *timerStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
+func (t *timerStandbyTaskExecutor) Execute(task Task) (_ ExecuteResponse, retErr error) {
 	simulation.LogEvents(simulation.E{
 		EventName:  simulation.EventNameExecuteHistoryTask,
 		Host:       t.shard.GetConfig().HostName,
@@ -99,26 +100,30 @@ func (t *timerStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 			"task_key":      task.GetTaskKey(),
 		},
 	})
-	scope := getOrCreateDomainTaggedScope(t.shard, GetTimerTaskMetricScope(task.GetTaskType(), false), task.GetDomainID(), t.logger)
+	scopeIdx := GetTimerTaskMetricScope(task.GetTaskType(), false)
+	scope := getOrCreateDomainTaggedScope(t.shard, scopeIdx, task.GetDomainID(), t.logger)
 	executeResponse := ExecuteResponse{
 		Scope:        scope,
 		IsActiveTask: false,
 	}
+	spanCtx, span := startTaskSpan(t.ctx, task.GetInfo(), scopeIdx)
+	defer func() { tracing.EndSpan(span, retErr) }()
+
 	switch timerTask := task.GetInfo().(type) {
 	case *persistence.UserTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeUserTimerTimeoutTask(ctx, timerTask)
 	case *persistence.ActivityTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeActivityTimeoutTask(ctx, timerTask)
 	case *persistence.DecisionTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeDecisionTimeoutTask(ctx, timerTask)
 	case *persistence.WorkflowTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowTimeoutTask(ctx, timerTask)
 	case *persistence.ActivityRetryTimerTask:
@@ -126,12 +131,12 @@ func (t *timerStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		// TODO: add error logs
 		return executeResponse, nil
 	case *persistence.WorkflowBackoffTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(spanCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case *persistence.DeleteHistoryEventTask:
 		// special timeout for delete history event
-		deleteHistoryEventContext, deleteHistoryEventCancel := context.WithTimeout(t.ctx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
+		deleteHistoryEventContext, deleteHistoryEventCancel := context.WithTimeout(spanCtx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
 		defer deleteHistoryEventCancel()
 		return executeResponse, t.executeDeleteHistoryEventTask(deleteHistoryEventContext, timerTask)
 	default:
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -112,7 +113,7 @@ func NewTransferActiveTaskExecutor(
 	}
 }
 
-func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
+func (t *transferActiveTaskExecutor) Execute(task Task) (_ ExecuteResponse, retErr error) {
 	simulation.LogEvents(simulation.E{
 		EventName:  simulation.EventNameExecuteHistoryTask,
 		Host:       t.shard.GetConfig().HostName,
@@ -126,12 +127,15 @@ func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error)
 			"task_key":      task.GetTaskKey(),
 		},
 	})
-	scope := getOrCreateDomainTaggedScope(t.shard, GetTransferTaskMetricsScope(task.GetTaskType(), true), task.GetDomainID(), t.logger)
+	scopeIdx := GetTransferTaskMetricsScope(task.GetTaskType(), true)
+	scope := getOrCreateDomainTaggedScope(t.shard, scopeIdx, task.GetDomainID(), t.logger)
 	executeResponse := ExecuteResponse{
 		Scope:        scope,
 		IsActiveTask: true,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), taskDefaultTimeout)
+	ctx, span := startTaskSpan(context.Background(), task.GetInfo(), scopeIdx)
+	defer func() { tracing.EndSpan(span, retErr) }()
+	ctx, cancel := context.WithTimeout(ctx, taskDefaultTimeout)
 	defer cancel()
 
 	switch transferTask := task.GetInfo().(type) {
@@ -197,6 +201,10 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		t.logger.Debug("Potentially duplicate ", tag.TaskID(task.TaskID), tag.WorkflowScheduleID(task.ScheduleID), tag.TaskType(persistence.TransferTaskTypeActivityTask))
 		return nil
 	}
+	if ai.Paused {
+		// a retry timer task is generated to dispatch the activity when it is unpaused
+		return nil
+	}
 	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, ai.Version, task.Version, task)
 	if err != nil || !ok {
 		return err
@@ -224,6 +232,8 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		activityScheduleToStartTimeout: timeout,
 		tasklist:                       taskList,
 		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		priority:                       ai.Priority,
+		fairnessKey:                    mutableState.GetExecutionInfo().FairnessKey,
 	}
 	err = t.pushActivity(ctx, task, pushActivityInfo)
 	if err == nil {
@@ -313,6 +323,9 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		decisionScheduleToStartTimeout: decisionTimeout,
 		tasklist:                       taskList,
 		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		priority:                       executionInfo.Priority,
+		workerBuildID:                  executionInfo.WorkerBuildID,
+		fairnessKey:                    executionInfo.FairnessKey,
 	})
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
@@ -329,6 +342,9 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 			decisionScheduleToStartTimeout: decisionTimeout,
 			tasklist:                       taskList,
 			partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+			priority:                       executionInfo.Priority,
+			workerBuildID:                  executionInfo.WorkerBuildID,
+			fairnessKey:                    executionInfo.FairnessKey,
 		})
 	}
 	if err == nil {
@@ -885,6 +901,7 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 		childInfo.CreateRequestID,
 		attributes,
 		mutableState.GetExecutionInfo().PartitionConfig,
+		mutableState.GetExecutionInfo().FairnessKey,
 		mutableState.GetExecutionInfo().ActiveClusterSelectionPolicy,
 	)
 	if err != nil {
@@ -1674,6 +1691,7 @@ func startWorkflowWithRetry(
 	requestID string,
 	attributes *types.StartChildWorkflowExecutionInitiatedEventAttributes,
 	partitionConfig map[string]string,
+	fairnessKey string,
 	activeClusterSelectionPolicy *types.ActiveClusterSelectionPolicy,
 ) (string, error) {
 
@@ -1710,7 +1728,7 @@ func startWorkflowWithRetry(
 		ActiveClusterSelectionPolicy: activeClusterSelectionPolicy,
 	}
 
-	historyStartReq, err := common.CreateHistoryStartWorkflowRequest(task.TargetDomainID, frontendStartReq, timeSource.Now(), partitionConfig)
+	historyStartReq, err := common.CreateHistoryStartWorkflowRequest(task.TargetDomainID, frontendStartReq, timeSource.Now(), partitionConfig, fairnessKey)
 	if err != nil {
 		return "", err
 	}
@@ -1521,6 +1521,7 @@ func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_Success
 				childInfo.CreateRequestID,
 				s.mockShard.GetTimeSource().Now(),
 				mutableState.GetExecutionInfo().PartitionConfig,
+				mutableState.GetExecutionInfo().FairnessKey,
 				mutableState.GetExecutionInfo().ActiveClusterSelectionPolicy,
 			)
 			require.NoError(s.T(), err)
@@ -1563,6 +1564,7 @@ func (s *transferActiveTaskExecutorSuite) TestProcessStartChildExecution_Failure
 				childInfo.CreateRequestID,
 				s.mockShard.GetTimeSource().Now(),
 				mutableState.GetExecutionInfo().PartitionConfig,
+				mutableState.GetExecutionInfo().FairnessKey,
 				mutableState.GetExecutionInfo().ActiveClusterSelectionPolicy,
 			)
 			require.NoError(s.T(), err)
@@ -2421,6 +2423,7 @@ func createTestChildWorkflowExecutionRequest(
 	requestID string,
 	now time.Time,
 	partitionConfig map[string]string,
+	fairnessKey string,
 	activeClusterSelectionPolicy *types.ActiveClusterSelectionPolicy,
 ) (*types.HistoryStartWorkflowExecutionRequest, error) {
 
@@ -2451,7 +2454,7 @@ func createTestChildWorkflowExecutionRequest(
 	}
 
 	historyStartReq, err := common.CreateHistoryStartWorkflowRequest(
-		taskInfo.TargetDomainID, frontendStartReq, now, partitionConfig)
+		taskInfo.TargetDomainID, frontendStartReq, now, partitionConfig, fairnessKey)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -79,7 +80,7 @@ func NewTransferStandbyTaskExecutor(
 	}
 }
 
-func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
+func (t *transferStandbyTaskExecutor) Execute(task Task) (_ ExecuteResponse, retErr error) {
 	simulation.LogEvents(simulation.E{
 		EventName:  simulation.EventNameExecuteHistoryTask,
 		Host:       t.shard.GetConfig().HostName,
@@ -93,12 +94,15 @@ func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error
 			"task_key":      task.GetTaskKey(),
 		},
 	})
-	scope := getOrCreateDomainTaggedScope(t.shard, GetTransferTaskMetricsScope(task.GetTaskType(), false), task.GetDomainID(), t.logger)
+	scopeIdx := GetTransferTaskMetricsScope(task.GetTaskType(), false)
+	scope := getOrCreateDomainTaggedScope(t.shard, scopeIdx, task.GetDomainID(), t.logger)
 	executeResponse := ExecuteResponse{
 		Scope:        scope,
 		IsActiveTask: false,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), taskDefaultTimeout)
+	ctx, span := startTaskSpan(context.Background(), task.GetInfo(), scopeIdx)
+	defer func() { tracing.EndSpan(span, retErr) }()
+	ctx, cancel := context.WithTimeout(ctx, taskDefaultTimeout)
 	defer cancel()
 
 	switch transferTask := task.GetInfo().(type) {
@@ -170,6 +174,8 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 				activityInfo.ScheduleToStartTimeout,
 				taskList,
 				mutableState.GetExecutionInfo().PartitionConfig,
+				activityInfo.Priority,
+				mutableState.GetExecutionInfo().FairnessKey,
 			), nil
 		}
 
@@ -227,6 +233,9 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 				decisionTimeout,
 				types.TaskList{Name: executionInfo.TaskList, Kind: executionInfo.TaskListKind.Ptr()}, // at standby, always use non-sticky tasklist
 				mutableState.GetExecutionInfo().PartitionConfig,
+				executionInfo.Priority,
+				executionInfo.WorkerBuildID,
+				executionInfo.FairnessKey,
 			), nil
 		}
 
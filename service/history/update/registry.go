@@ -0,0 +1,295 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2025 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination registry_mock.go -self_package github.com/uber/cadence/service/history/update
+
+package update
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// maxTerminatedUpdateCount is the number of terminated updates which are not recorded in history
+// kept per workflow, so that an update sent again with the same ID gets the result of the first one.
+// Updates recorded in history are kept for the lifetime of the registry instead, as applying them again
+// would change the workflow.
+const maxTerminatedUpdateCount = 100
+
+var (
+	// ErrUpdateDropped is the failure of in-flight updates whose registry is dropped with its execution context,
+	// the update was not applied and can be sent again
+	ErrUpdateDropped = &types.InternalServiceError{Message: "workflow update was dropped before it completed, it can be retried"}
+
+	errUpdateNotExists    = &types.InternalServiceError{Message: "update does not exist"}
+	errUpdateNotBuffered  = &types.InternalServiceError{Message: "update is not buffered"}
+	errUpdateNotDelivered = &types.InternalServiceError{Message: "update is not buffered or delivered"}
+)
+
+type (
+	// Registry manages all the in-flight updates for a workflow.
+	// An update is buffered until it is delivered to the worker with a decision task,
+	// and reaches a termination state once the decision task completes or fails.
+	// Updates are identified by the ID given by the caller, an update which is
+	// in-flight, recorded in history or recently terminated is not buffered again.
+	// The updates recorded in history before the registry was created must be
+	// loaded with LoadAcceptedUpdates before buffering updates.
+	Registry interface {
+		HasBufferedUpdate() bool
+		GetBufferedIDs() []string
+		HasDeliveredUpdate() bool
+		GetDeliveredIDs() []string
+
+		GetUpdateTermCh(string) (<-chan struct{}, error)
+		GetUpdateInput(string) (*types.WorkflowUpdate, error)
+		GetTerminationState(string) (*TerminationState, error)
+
+		AcceptedUpdatesLoaded() bool
+		LoadAcceptedUpdates([]*types.WorkflowExecutionUpdateAcceptedEventAttributes)
+
+		BufferUpdate(id string, updateInput *types.WorkflowUpdate) <-chan struct{}
+		SetDelivered(string) error
+		SetTerminationState(string, *TerminationState) error
+		FailBufferedUpdate(id string, failure error) error
+		FailInFlightUpdates(failure error)
+	}
+
+	registryImpl struct {
+		sync.RWMutex
+
+		buffered  map[string]update
+		delivered map[string]update
+		// persisted holds the terminated updates recorded in history, they are never dropped
+		persisted      map[string]update
+		acceptedLoaded bool
+		terminated     map[string]update
+		// terminatedIDs keeps the order in which updates terminated, the oldest are dropped first
+		terminatedIDs []string
+	}
+)
+
+// NewRegistry creates a new update registry
+func NewRegistry() Registry {
+	return &registryImpl{
+		buffered:   make(map[string]update),
+		delivered:  make(map[string]update),
+		persisted:  make(map[string]update),
+		terminated: make(map[string]update),
+	}
+}
+
+func (r *registryImpl) HasBufferedUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.buffered) > 0
+}
+
+func (r *registryImpl) GetBufferedIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.getIDs(r.buffered)
+}
+
+func (r *registryImpl) HasDeliveredUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.delivered) > 0
+}
+
+func (r *registryImpl) GetDeliveredIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.getIDs(r.delivered)
+}
+
+func (r *registryImpl) GetUpdateTermCh(id string) (<-chan struct{}, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getUpdateTermCh(), nil
+}
+
+func (r *registryImpl) GetUpdateInput(id string) (*types.WorkflowUpdate, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getUpdateInput(), nil
+}
+
+func (r *registryImpl) GetTerminationState(id string) (*TerminationState, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getTerminationState()
+}
+
+func (r *registryImpl) AcceptedUpdatesLoaded() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.acceptedLoaded
+}
+
+func (r *registryImpl) LoadAcceptedUpdates(accepted []*types.WorkflowExecutionUpdateAcceptedEventAttributes) {
+	r.Lock()
+	defer r.Unlock()
+	for _, attributes := range accepted {
+		id := attributes.GetUpdateID()
+		if _, err := r.getUpdateNoLock(id); err == nil {
+			continue
+		}
+		u := newUpdate(id, attributes.Update)
+		_ = u.setTerminationState(&TerminationState{
+			TerminationType: TerminationTypeCompleted,
+			UpdateResult: &types.WorkflowUpdateResult{
+				ResultType: types.UpdateResultTypeAccepted.Ptr(),
+				Result:     attributes.Result,
+			},
+			Persisted: true,
+		})
+		r.persisted[id] = u
+	}
+	r.acceptedLoaded = true
+}
+
+func (r *registryImpl) BufferUpdate(id string, updateInput *types.WorkflowUpdate) <-chan struct{} {
+	r.Lock()
+	defer r.Unlock()
+	if u, err := r.getUpdateNoLock(id); err == nil {
+		return u.getUpdateTermCh()
+	}
+	u := newUpdate(id, updateInput)
+	r.buffered[id] = u
+	return u.getUpdateTermCh()
+}
+
+func (r *registryImpl) SetDelivered(id string) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.buffered[id]
+	if !ok {
+		return errUpdateNotBuffered
+	}
+	delete(r.buffered, id)
+	r.delivered[id] = u
+	return nil
+}
+
+func (r *registryImpl) SetTerminationState(id string, terminationState *TerminationState) error {
+	r.Lock()
+	defer r.Unlock()
+	return r.setTerminationStateNoLock(id, terminationState)
+}
+
+// FailBufferedUpdate fails an update which has not been delivered yet and forgets it,
+// so that it can be sent again with the same ID
+func (r *registryImpl) FailBufferedUpdate(id string, failure error) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.buffered[id]
+	if !ok {
+		return errUpdateNotBuffered
+	}
+	if err := u.setTerminationState(&TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         failure,
+	}); err != nil {
+		return err
+	}
+	delete(r.buffered, id)
+	return nil
+}
+
+func (r *registryImpl) FailInFlightUpdates(failure error) {
+	r.Lock()
+	defer r.Unlock()
+	terminationState := &TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         failure,
+	}
+	for _, id := range r.getIDs(r.buffered) {
+		_ = r.setTerminationStateNoLock(id, terminationState)
+	}
+	for _, id := range r.getIDs(r.delivered) {
+		_ = r.setTerminationStateNoLock(id, terminationState)
+	}
+}
+
+func (r *registryImpl) setTerminationStateNoLock(id string, terminationState *TerminationState) error {
+	u, ok := r.buffered[id]
+	if !ok {
+		u, ok = r.delivered[id]
+	}
+	if !ok {
+		return errUpdateNotDelivered
+	}
+	if err := u.setTerminationState(terminationState); err != nil {
+		return err
+	}
+	delete(r.buffered, id)
+	delete(r.delivered, id)
+	if terminationState.Persisted {
+		r.persisted[id] = u
+		return nil
+	}
+	r.terminated[id] = u
+	r.terminatedIDs = append(r.terminatedIDs, id)
+	if len(r.terminatedIDs) > maxTerminatedUpdateCount {
+		delete(r.terminated, r.terminatedIDs[0])
+		r.terminatedIDs = r.terminatedIDs[1:]
+	}
+	return nil
+}
+
+func (r *registryImpl) getUpdateNoLock(id string) (update, error) {
+	if u, ok := r.buffered[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.delivered[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.persisted[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.terminated[id]; ok {
+		return u, nil
+	}
+	return nil, errUpdateNotExists
+}
+
+func (r *registryImpl) getIDs(m map[string]update) []string {
+	result := make([]string, len(m))
+	index := 0
+	for id := range m {
+		result[index] = id
+		index++
+	}
+	return result
+}
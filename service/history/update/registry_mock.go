@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: registry.go
+//
+// Generated by this command:
+//
+//	mockgen -package update -source registry.go -destination registry_mock.go -self_package github.com/uber/cadence/service/history/update
+//
+
+// Package update is a generated GoMock package.
+package update
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	types "github.com/uber/cadence/common/types"
+)
+
+// MockRegistry is a mock of Registry interface.
+type MockRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryMockRecorder
+	isgomock struct{}
+}
+
+// MockRegistryMockRecorder is the mock recorder for MockRegistry.
+type MockRegistryMockRecorder struct {
+	mock *MockRegistry
+}
+
+// NewMockRegistry creates a new mock instance.
+func NewMockRegistry(ctrl *gomock.Controller) *MockRegistry {
+	mock := &MockRegistry{ctrl: ctrl}
+	mock.recorder = &MockRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistry) EXPECT() *MockRegistryMockRecorder {
+	return m.recorder
+}
+
+// AcceptedUpdatesLoaded mocks base method.
+func (m *MockRegistry) AcceptedUpdatesLoaded() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptedUpdatesLoaded")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AcceptedUpdatesLoaded indicates an expected call of AcceptedUpdatesLoaded.
+func (mr *MockRegistryMockRecorder) AcceptedUpdatesLoaded() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptedUpdatesLoaded", reflect.TypeOf((*MockRegistry)(nil).AcceptedUpdatesLoaded))
+}
+
+// BufferUpdate mocks base method.
+func (m *MockRegistry) BufferUpdate(id string, updateInput *types.WorkflowUpdate) <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BufferUpdate", id, updateInput)
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// BufferUpdate indicates an expected call of BufferUpdate.
+func (mr *MockRegistryMockRecorder) BufferUpdate(id, updateInput any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BufferUpdate", reflect.TypeOf((*MockRegistry)(nil).BufferUpdate), id, updateInput)
+}
+
+// FailBufferedUpdate mocks base method.
+func (m *MockRegistry) FailBufferedUpdate(id string, failure error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailBufferedUpdate", id, failure)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailBufferedUpdate indicates an expected call of FailBufferedUpdate.
+func (mr *MockRegistryMockRecorder) FailBufferedUpdate(id, failure any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailBufferedUpdate", reflect.TypeOf((*MockRegistry)(nil).FailBufferedUpdate), id, failure)
+}
+
+// FailInFlightUpdates mocks base method.
+func (m *MockRegistry) FailInFlightUpdates(failure error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FailInFlightUpdates", failure)
+}
+
+// FailInFlightUpdates indicates an expected call of FailInFlightUpdates.
+func (mr *MockRegistryMockRecorder) FailInFlightUpdates(failure any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailInFlightUpdates", reflect.TypeOf((*MockRegistry)(nil).FailInFlightUpdates), failure)
+}
+
+// GetBufferedIDs mocks base method.
+func (m *MockRegistry) GetBufferedIDs() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBufferedIDs")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetBufferedIDs indicates an expected call of GetBufferedIDs.
+func (mr *MockRegistryMockRecorder) GetBufferedIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBufferedIDs", reflect.TypeOf((*MockRegistry)(nil).GetBufferedIDs))
+}
+
+// GetDeliveredIDs mocks base method.
+func (m *MockRegistry) GetDeliveredIDs() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveredIDs")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetDeliveredIDs indicates an expected call of GetDeliveredIDs.
+func (mr *MockRegistryMockRecorder) GetDeliveredIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveredIDs", reflect.TypeOf((*MockRegistry)(nil).GetDeliveredIDs))
+}
+
+// GetTerminationState mocks base method.
+func (m *MockRegistry) GetTerminationState(arg0 string) (*TerminationState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerminationState", arg0)
+	ret0, _ := ret[0].(*TerminationState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerminationState indicates an expected call of GetTerminationState.
+func (mr *MockRegistryMockRecorder) GetTerminationState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerminationState", reflect.TypeOf((*MockRegistry)(nil).GetTerminationState), arg0)
+}
+
+// GetUpdateInput mocks base method.
+func (m *MockRegistry) GetUpdateInput(arg0 string) (*types.WorkflowUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateInput", arg0)
+	ret0, _ := ret[0].(*types.WorkflowUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpdateInput indicates an expected call of GetUpdateInput.
+func (mr *MockRegistryMockRecorder) GetUpdateInput(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateInput", reflect.TypeOf((*MockRegistry)(nil).GetUpdateInput), arg0)
+}
+
+// GetUpdateTermCh mocks base method.
+func (m *MockRegistry) GetUpdateTermCh(arg0 string) (<-chan struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateTermCh", arg0)
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpdateTermCh indicates an expected call of GetUpdateTermCh.
+func (mr *MockRegistryMockRecorder) GetUpdateTermCh(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateTermCh", reflect.TypeOf((*MockRegistry)(nil).GetUpdateTermCh), arg0)
+}
+
+// HasBufferedUpdate mocks base method.
+func (m *MockRegistry) HasBufferedUpdate() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasBufferedUpdate")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasBufferedUpdate indicates an expected call of HasBufferedUpdate.
+func (mr *MockRegistryMockRecorder) HasBufferedUpdate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasBufferedUpdate", reflect.TypeOf((*MockRegistry)(nil).HasBufferedUpdate))
+}
+
+// HasDeliveredUpdate mocks base method.
+func (m *MockRegistry) HasDeliveredUpdate() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDeliveredUpdate")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasDeliveredUpdate indicates an expected call of HasDeliveredUpdate.
+func (mr *MockRegistryMockRecorder) HasDeliveredUpdate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDeliveredUpdate", reflect.TypeOf((*MockRegistry)(nil).HasDeliveredUpdate))
+}
+
+// LoadAcceptedUpdates mocks base method.
+func (m *MockRegistry) LoadAcceptedUpdates(arg0 []*types.WorkflowExecutionUpdateAcceptedEventAttributes) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LoadAcceptedUpdates", arg0)
+}
+
+// LoadAcceptedUpdates indicates an expected call of LoadAcceptedUpdates.
+func (mr *MockRegistryMockRecorder) LoadAcceptedUpdates(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadAcceptedUpdates", reflect.TypeOf((*MockRegistry)(nil).LoadAcceptedUpdates), arg0)
+}
+
+// SetDelivered mocks base method.
+func (m *MockRegistry) SetDelivered(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDelivered", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDelivered indicates an expected call of SetDelivered.
+func (mr *MockRegistryMockRecorder) SetDelivered(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDelivered", reflect.TypeOf((*MockRegistry)(nil).SetDelivered), arg0)
+}
+
+// SetTerminationState mocks base method.
+func (m *MockRegistry) SetTerminationState(arg0 string, arg1 *TerminationState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTerminationState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTerminationState indicates an expected call of SetTerminationState.
+func (mr *MockRegistryMockRecorder) SetTerminationState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTerminationState", reflect.TypeOf((*MockRegistry)(nil).SetTerminationState), arg0, arg1)
+}
@@ -0,0 +1,306 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2025 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package update
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	updateInput := &types.WorkflowUpdate{UpdateType: "update-type"}
+
+	termCh := r.BufferUpdate("update-id", updateInput)
+	assert.True(t, r.HasBufferedUpdate())
+	assert.False(t, r.HasDeliveredUpdate())
+	assert.Equal(t, []string{"update-id"}, r.GetBufferedIDs())
+	input, err := r.GetUpdateInput("update-id")
+	require.NoError(t, err)
+	assert.Equal(t, updateInput, input)
+	_, err = r.GetTerminationState("update-id")
+	assert.Equal(t, errUpdateNotInTerminalState, err)
+
+	require.NoError(t, r.SetDelivered("update-id"))
+	assert.Equal(t, errUpdateNotBuffered, r.SetDelivered("update-id"))
+	assert.False(t, r.HasBufferedUpdate())
+	assert.Equal(t, []string{"update-id"}, r.GetDeliveredIDs())
+
+	state := &TerminationState{
+		TerminationType: TerminationTypeCompleted,
+		UpdateResult: &types.WorkflowUpdateResult{
+			ResultType: types.UpdateResultTypeAccepted.Ptr(),
+			Result:     []byte("result"),
+		},
+	}
+	require.NoError(t, r.SetTerminationState("update-id", state))
+	assert.False(t, r.HasDeliveredUpdate())
+	assertClosed(t, termCh)
+	actual, err := r.GetTerminationState("update-id")
+	require.NoError(t, err)
+	assert.Equal(t, state, actual)
+	assert.Equal(t, errUpdateNotDelivered, r.SetTerminationState("update-id", state))
+
+	_, err = r.GetUpdateTermCh("unknown-id")
+	assert.Equal(t, errUpdateNotExists, err)
+}
+
+func TestRegistry_BufferDuplicateUpdate(t *testing.T) {
+	r := NewRegistry()
+	updateInput := &types.WorkflowUpdate{UpdateType: "update-type"}
+
+	termCh := r.BufferUpdate("update-id", updateInput)
+	assert.Equal(t, termCh, r.BufferUpdate("update-id", &types.WorkflowUpdate{UpdateType: "other-type"}))
+	assert.Equal(t, []string{"update-id"}, r.GetBufferedIDs())
+
+	require.NoError(t, r.SetDelivered("update-id"))
+	assert.Equal(t, termCh, r.BufferUpdate("update-id", updateInput))
+	assert.False(t, r.HasBufferedUpdate())
+
+	require.NoError(t, r.SetTerminationState("update-id", &TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         &types.InternalServiceError{Message: "failed"},
+	}))
+	assert.Equal(t, termCh, r.BufferUpdate("update-id", updateInput))
+	assert.False(t, r.HasBufferedUpdate())
+	input, err := r.GetUpdateInput("update-id")
+	require.NoError(t, err)
+	assert.Equal(t, updateInput, input)
+}
+
+func TestRegistry_TerminatedUpdatesAreBounded(t *testing.T) {
+	r := NewRegistry()
+	state := &TerminationState{
+		TerminationType: TerminationTypeFailed,
+		Failure:         &types.InternalServiceError{Message: "failed"},
+	}
+	for i := 0; i <= maxTerminatedUpdateCount; i++ {
+		id := fmt.Sprintf("update-id-%v", i)
+		r.BufferUpdate(id, &types.WorkflowUpdate{})
+		require.NoError(t, r.SetTerminationState(id, state))
+	}
+
+	_, err := r.GetTerminationState("update-id-0")
+	assert.Equal(t, errUpdateNotExists, err)
+	actual, err := r.GetTerminationState(fmt.Sprintf("update-id-%v", maxTerminatedUpdateCount))
+	require.NoError(t, err)
+	assert.Equal(t, state, actual)
+}
+
+func TestRegistry_PersistedUpdatesAreKept(t *testing.T) {
+	r := NewRegistry()
+	persisted := &TerminationState{
+		TerminationType: TerminationTypeCompleted,
+		UpdateResult: &types.WorkflowUpdateResult{
+			ResultType: types.UpdateResultTypeAccepted.Ptr(),
+			Result:     []byte("result"),
+		},
+		Persisted: true,
+	}
+	r.BufferUpdate("persisted-id", &types.WorkflowUpdate{})
+	require.NoError(t, r.SetTerminationState("persisted-id", persisted))
+	for i := 0; i <= maxTerminatedUpdateCount; i++ {
+		id := fmt.Sprintf("update-id-%v", i)
+		r.BufferUpdate(id, &types.WorkflowUpdate{})
+		require.NoError(t, r.SetTerminationState(id, &TerminationState{
+			TerminationType: TerminationTypeFailed,
+			Failure:         &types.InternalServiceError{Message: "failed"},
+		}))
+	}
+
+	actual, err := r.GetTerminationState("persisted-id")
+	require.NoError(t, err)
+	assert.Equal(t, persisted, actual)
+}
+
+func TestRegistry_LoadAcceptedUpdates(t *testing.T) {
+	r := NewRegistry()
+	assert.False(t, r.AcceptedUpdatesLoaded())
+	bufferedTermCh := r.BufferUpdate("buffered-id", &types.WorkflowUpdate{})
+
+	updateInput := &types.WorkflowUpdate{UpdateType: "update-type"}
+	r.LoadAcceptedUpdates([]*types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		{UpdateID: "accepted-id", Update: updateInput, Result: []byte("result")},
+		{UpdateID: "buffered-id", Update: updateInput, Result: []byte("result")},
+	})
+	assert.True(t, r.AcceptedUpdatesLoaded())
+
+	termCh := r.BufferUpdate("accepted-id", &types.WorkflowUpdate{})
+	assertClosed(t, termCh)
+	assert.Equal(t, []string{"buffered-id"}, r.GetBufferedIDs())
+	input, err := r.GetUpdateInput("accepted-id")
+	require.NoError(t, err)
+	assert.Equal(t, updateInput, input)
+	state, err := r.GetTerminationState("accepted-id")
+	require.NoError(t, err)
+	assert.Equal(t, &TerminationState{
+		TerminationType: TerminationTypeCompleted,
+		UpdateResult: &types.WorkflowUpdateResult{
+			ResultType: types.UpdateResultTypeAccepted.Ptr(),
+			Result:     []byte("result"),
+		},
+		Persisted: true,
+	}, state)
+
+	// updates known to the registry are left as they are
+	select {
+	case <-bufferedTermCh:
+		t.Fatal("expected channel to be open")
+	default:
+	}
+}
+
+func TestRegistry_FailBufferedUpdate(t *testing.T) {
+	r := NewRegistry()
+	failure := &types.InternalServiceError{Message: "failed"}
+	termCh := r.BufferUpdate("update-id", &types.WorkflowUpdate{})
+
+	require.NoError(t, r.FailBufferedUpdate("update-id", failure))
+	assertClosed(t, termCh)
+	assert.False(t, r.HasBufferedUpdate())
+	// the update is forgotten, so that it can be sent again
+	_, err := r.GetTerminationState("update-id")
+	assert.Equal(t, errUpdateNotExists, err)
+	assert.NotEqual(t, termCh, r.BufferUpdate("update-id", &types.WorkflowUpdate{}))
+
+	require.NoError(t, r.SetDelivered("update-id"))
+	assert.Equal(t, errUpdateNotBuffered, r.FailBufferedUpdate("update-id", failure))
+	assert.True(t, r.HasDeliveredUpdate())
+}
+
+func TestRegistry_FailInFlightUpdates(t *testing.T) {
+	r := NewRegistry()
+	bufferedTermCh := r.BufferUpdate("buffered-id", &types.WorkflowUpdate{})
+	deliveredTermCh := r.BufferUpdate("delivered-id", &types.WorkflowUpdate{})
+	require.NoError(t, r.SetDelivered("delivered-id"))
+
+	r.FailInFlightUpdates(ErrUpdateDropped)
+	assert.False(t, r.HasBufferedUpdate())
+	assert.False(t, r.HasDeliveredUpdate())
+	assertClosed(t, bufferedTermCh)
+	assertClosed(t, deliveredTermCh)
+	for _, id := range []string{"buffered-id", "delivered-id"} {
+		state, err := r.GetTerminationState(id)
+		require.NoError(t, err)
+		assert.Equal(t, TerminationTypeFailed, state.TerminationType)
+		assert.Equal(t, ErrUpdateDropped, state.Failure)
+	}
+}
+
+func TestValidateTerminationState(t *testing.T) {
+	testCases := []struct {
+		name    string
+		state   *TerminationState
+		wantErr bool
+	}{
+		{
+			name:    "nil state",
+			wantErr: true,
+		},
+		{
+			name: "accepted",
+			state: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType: types.UpdateResultTypeAccepted.Ptr(),
+					Result:     []byte("result"),
+				},
+			},
+		},
+		{
+			name: "rejected",
+			state: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType:   types.UpdateResultTypeRejected.Ptr(),
+					ErrorMessage: "invalid update",
+				},
+			},
+		},
+		{
+			name: "rejected without error message",
+			state: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+				UpdateResult: &types.WorkflowUpdateResult{
+					ResultType: types.UpdateResultTypeRejected.Ptr(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "completed without result",
+			state: &TerminationState{
+				TerminationType: TerminationTypeCompleted,
+			},
+			wantErr: true,
+		},
+		{
+			name: "failed",
+			state: &TerminationState{
+				TerminationType: TerminationTypeFailed,
+				Failure:         &types.InternalServiceError{Message: "failed"},
+			},
+		},
+		{
+			name: "failed with result",
+			state: &TerminationState{
+				TerminationType: TerminationTypeFailed,
+				UpdateResult:    &types.WorkflowUpdateResult{},
+				Failure:         &types.InternalServiceError{Message: "failed"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown termination type",
+			state: &TerminationState{
+				TerminationType: TerminationType(10),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u := newUpdate("update-id", &types.WorkflowUpdate{})
+			err := u.setTerminationState(tc.state)
+			if tc.wantErr {
+				assert.Equal(t, errTerminationStateInvalid, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, errAlreadyInTerminalState, u.setTerminationState(tc.state))
+		})
+	}
+}
+
+func assertClosed(t *testing.T, ch <-chan struct{}) {
+	select {
+	case <-ch:
+	default:
+		t.Fatal("expected channel to be closed")
+	}
+}
@@ -33,6 +33,8 @@ var (
 	ErrMaxAttemptsExceeded = errors.New("maximum attempts exceeded to update history")
 	// ErrActivityTaskNotFound is the error to indicate activity task could be duplicate and activity already completed
 	ErrActivityTaskNotFound = &types.EntityNotExistsError{Message: "activity task not found"}
+	// ErrActivityTaskPaused is the error to indicate activity is paused and the task can be dropped
+	ErrActivityTaskPaused = &types.EntityNotExistsError{Message: "activity task is paused"}
 	// ErrNotExists is the error to indicate workflow doesn't exist
 	ErrNotExists = &types.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrAlreadyCompleted is the error to indicate workflow execution already completed
@@ -57,6 +59,10 @@ var (
 	ErrConsistentQueryNotEnabled = &types.BadRequestError{Message: "cluster or domain does not enable strongly consistent query but strongly consistent query was requested"}
 	// ErrConsistentQueryBufferExceeded is error indicating that too many consistent queries have been buffered and until buffered queries are finished new consistent queries cannot be buffered
 	ErrConsistentQueryBufferExceeded = &types.InternalServiceError{Message: "consistent query buffer is full, cannot accept new consistent queries"}
+	// ErrUpdateBufferExceeded is error indicating that too many updates are in-flight and until they are finished new updates cannot be accepted
+	ErrUpdateBufferExceeded = &types.ServiceBusyError{Message: "workflow update buffer is full, cannot accept new updates"}
+	// ErrUpdateEnteredInvalidState is error indicating that an update entered an invalid state
+	ErrUpdateEnteredInvalidState = &types.BadRequestError{Message: "workflow update entered an invalid state, this should be impossible"}
 	// ErrConcurrentStartRequest is error indicating there is an outstanding start workflow request. The incoming request fails to acquires the lock before the outstanding request finishes.
 	ErrConcurrentStartRequest = &types.ServiceBusyError{Message: "an outstanding start workflow request is in-progress. Failed to acquire the resource."}
 )
@@ -85,6 +85,11 @@ func (g GRPCHandler) NotifyFailoverMarkers(ctx context.Context, request *history
 	return &historyv1.NotifyFailoverMarkersResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) PauseActivity(ctx context.Context, request *historyv1.PauseActivityRequest) (*historyv1.PauseActivityResponse, error) {
+	response, err := g.h.PauseActivity(ctx, proto.ToHistoryPauseActivityRequest(request))
+	return proto.FromHistoryPauseActivityResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) PollMutableState(ctx context.Context, request *historyv1.PollMutableStateRequest) (*historyv1.PollMutableStateResponse, error) {
 	response, err := g.h.PollMutableState(ctx, proto.ToHistoryPollMutableStateRequest(request))
 	return proto.FromHistoryPollMutableStateResponse(response), proto.FromError(err)
@@ -239,3 +244,18 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UnpauseActivity(ctx context.Context, request *historyv1.UnpauseActivityRequest) (*historyv1.UnpauseActivityResponse, error) {
+	response, err := g.h.UnpauseActivity(ctx, proto.ToHistoryUnpauseActivityRequest(request))
+	return proto.FromHistoryUnpauseActivityResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateActivityOptions(ctx context.Context, request *historyv1.UpdateActivityOptionsRequest) (*historyv1.UpdateActivityOptionsResponse, error) {
+	response, err := g.h.UpdateActivityOptions(ctx, proto.ToHistoryUpdateActivityOptionsRequest(request))
+	return proto.FromHistoryUpdateActivityOptionsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateWorkflowExecution(ctx context.Context, request *historyv1.UpdateWorkflowExecutionRequest) (*historyv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, proto.ToHistoryUpdateWorkflowExecutionRequest(request))
+	return proto.FromHistoryUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
@@ -121,6 +121,10 @@ func (h *historyHandler) NotifyFailoverMarkers(ctx context.Context, np1 *types.N
 	return h.wrapped.NotifyFailoverMarkers(ctx, np1)
 }
 
+func (h *historyHandler) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest) (pp1 *types.PauseActivityResponse, err error) {
+	return h.wrapped.PauseActivity(ctx, hp1)
+}
+
 func (h *historyHandler) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest) (pp2 *types.PollMutableStateResponse, err error) {
 	return h.wrapped.PollMutableState(ctx, pp1)
 }
@@ -327,3 +331,15 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest) (up1 *types.UnpauseActivityResponse, err error) {
+	return h.wrapped.UnpauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	return h.wrapped.UpdateActivityOptions(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+	return h.wrapped.UpdateWorkflowExecution(ctx, hp1)
+}
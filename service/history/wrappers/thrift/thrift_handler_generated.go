@@ -73,6 +73,11 @@ func (g ThriftHandler) NotifyFailoverMarkers(ctx context.Context, Request *histo
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) PauseActivity(ctx context.Context, Request *history.PauseActivityRequest) (pp1 *shared.PauseActivityResponse, err error) {
+	response, err := g.h.PauseActivity(ctx, thrift.ToHistoryPauseActivityRequest(Request))
+	return thrift.FromHistoryPauseActivityResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) PollMutableState(ctx context.Context, PollRequest *history.PollMutableStateRequest) (pp1 *history.PollMutableStateResponse, err error) {
 	response, err := g.h.PollMutableState(ctx, thrift.ToHistoryPollMutableStateRequest(PollRequest))
 	return thrift.FromHistoryPollMutableStateResponse(response), thrift.FromError(err)
@@ -227,3 +232,18 @@ func (g ThriftHandler) TerminateWorkflowExecution(ctx context.Context, Terminate
 	err = g.h.TerminateWorkflowExecution(ctx, thrift.ToHistoryTerminateWorkflowExecutionRequest(TerminateRequest))
 	return thrift.FromError(err)
 }
+
+func (g ThriftHandler) UnpauseActivity(ctx context.Context, Request *history.UnpauseActivityRequest) (up1 *shared.UnpauseActivityResponse, err error) {
+	response, err := g.h.UnpauseActivity(ctx, thrift.ToHistoryUnpauseActivityRequest(Request))
+	return thrift.FromHistoryUnpauseActivityResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) UpdateActivityOptions(ctx context.Context, UpdateRequest *history.UpdateActivityOptionsRequest) (up1 *shared.UpdateActivityOptionsResponse, err error) {
+	response, err := g.h.UpdateActivityOptions(ctx, thrift.ToHistoryUpdateActivityOptionsRequest(UpdateRequest))
+	return thrift.FromHistoryUpdateActivityOptionsResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *history.UpdateWorkflowExecutionRequest) (up1 *shared.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, thrift.ToHistoryUpdateWorkflowExecutionRequest(UpdateRequest))
+	return thrift.FromHistoryUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
@@ -301,4 +301,16 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateActivityOptions", func(t *testing.T) {
+		h.EXPECT().UpdateActivityOptions(ctx, &types.HistoryUpdateActivityOptionsRequest{}).Return(&types.UpdateActivityOptionsResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateActivityOptions(ctx, &hist.UpdateActivityOptionsRequest{})
+		assert.Equal(t, shared.UpdateActivityOptionsResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpdateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{}).Return(&types.UpdateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowExecution(ctx, &hist.UpdateWorkflowExecutionRequest{})
+		assert.Equal(t, shared.UpdateWorkflowExecutionResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
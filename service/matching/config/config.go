@@ -60,6 +60,12 @@ type (
 		LocalTaskWaitTime                         dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		TaskIsolationDuration                     dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		TaskIsolationPollerWindow                 dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
+		TaskPriorityAgingInterval                 dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
+		TaskPriorityReadAheadLimit                dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		EnableFairDispatch                        dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		FairnessKeyWeights                        dynamicproperties.MapPropertyFnWithDomainFilter
+		MaxCompatibleBuildIDSets                  dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		VersionedTaskMaxWait                      dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		EnableGetNumberOfPartitionsFromCache      dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
 		PartitionUpscaleRPS                       dynamicproperties.IntPropertyFnWithTaskListInfoFilters
 		PartitionDownscaleFactor                  dynamicproperties.FloatPropertyFnWithTaskListInfoFilters
@@ -166,6 +172,15 @@ type (
 		AllIsolationGroups        func() []string
 		TaskIsolationDuration     func() time.Duration
 		TaskIsolationPollerWindow func() time.Duration
+		// task priority configuration
+		TaskPriorityAgingInterval  func() time.Duration
+		TaskPriorityReadAheadLimit func() int
+		// fair dispatch configuration
+		EnableFairDispatch func() bool
+		FairnessKeyWeights func() map[string]interface{}
+		// worker versioning configuration
+		MaxCompatibleBuildIDSets func() int
+		VersionedTaskMaxWait     func() time.Duration
 		// hostname
 		HostName string
 		// rate limiter configuration
@@ -235,6 +250,12 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, rpcConfig config.R
 		IsolationGroupsPerPartition:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupsPerPartition),
 		TaskIsolationDuration:                      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationDuration),
 		TaskIsolationPollerWindow:                  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationPollerWindow),
+		TaskPriorityAgingInterval:                  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingTaskPriorityAgingInterval),
+		TaskPriorityReadAheadLimit:                 dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingTaskPriorityReadAheadLimit),
+		EnableFairDispatch:                         dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableFairDispatch),
+		FairnessKeyWeights:                         dc.GetMapPropertyFilteredByDomain(dynamicproperties.MatchingFairnessKeyWeights),
+		MaxCompatibleBuildIDSets:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingMaxCompatibleBuildIDSets),
+		VersionedTaskMaxWait:                       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingVersionedTaskMaxWait),
 		HostName:                                   hostName,
 		RPCConfig:                                  rpcConfig,
 		TaskDispatchRPS:                            100000.0,
@@ -98,6 +98,12 @@ func TestNewConfig(t *testing.T) {
 		"EnableClientAutoConfig":                     {dynamicproperties.MatchingEnableClientAutoConfig, false},
 		"TaskIsolationDuration":                      {dynamicproperties.TaskIsolationDuration, time.Duration(35)},
 		"TaskIsolationPollerWindow":                  {dynamicproperties.TaskIsolationPollerWindow, time.Duration(36)},
+		"TaskPriorityAgingInterval":                  {dynamicproperties.MatchingTaskPriorityAgingInterval, time.Duration(43)},
+		"TaskPriorityReadAheadLimit":                 {dynamicproperties.MatchingTaskPriorityReadAheadLimit, 45},
+		"EnableFairDispatch":                         {dynamicproperties.MatchingEnableFairDispatch, true},
+		"FairnessKeyWeights":                         {dynamicproperties.MatchingFairnessKeyWeights, map[string]interface{}{"tenant": 3}},
+		"MaxCompatibleBuildIDSets":                   {dynamicproperties.MatchingMaxCompatibleBuildIDSets, 44},
+		"VersionedTaskMaxWait":                       {dynamicproperties.MatchingVersionedTaskMaxWait, time.Duration(46)},
 		"EnablePartitionIsolationGroupAssignment":    {dynamicproperties.EnablePartitionIsolationGroupAssignment, true},
 		"IsolationGroupUpscaleSustainedDuration":     {dynamicproperties.MatchingIsolationGroupUpscaleSustainedDuration, time.Duration(37)},
 		"IsolationGroupDownscaleSustainedDuration":   {dynamicproperties.MatchingIsolationGroupDownscaleSustainedDuration, time.Duration(38)},
@@ -174,6 +180,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.MapPropertyFnWithDomainFilter:
+			return fn("domain")
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.FloatPropertyFnWithTaskListInfoFilters:
@@ -440,6 +440,9 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      request.GetPriority(),
+		WorkerBuildID:                 request.GetWorkerBuildID(),
+		FairnessKey:                   request.GetFairnessKey(),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
@@ -515,6 +518,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      request.GetPriority(),
+		FairnessKey:                   request.GetFairnessKey(),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
@@ -578,6 +583,7 @@ pollLoop:
 		pollerCtx := tasklist.ContextWithPollerID(hCtx.Context, pollerID)
 		pollerCtx = tasklist.ContextWithIdentity(pollerCtx, request.GetIdentity())
 		pollerCtx = tasklist.ContextWithIsolationGroup(pollerCtx, req.GetIsolationGroup())
+		pollerCtx = tasklist.ContextWithBuildID(pollerCtx, request.GetWorkerVersionInfo().GetBuildID())
 		tlMgr, err := e.getOrCreateTaskListManager(hCtx.Context, taskListID, taskListKind)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't load tasklist manager: %w", err)
@@ -1225,6 +1231,136 @@ func (e *matchingEngineImpl) RefreshTaskListPartitionConfig(
 	return &types.MatchingRefreshTaskListPartitionConfigResponse{}, nil
 }
 
+func (e *matchingEngineImpl) ListTaskListTasks(
+	hCtx *handlerContext,
+	request *types.MatchingListTaskListTasksRequest,
+) (*types.ListTaskListTasksResponse, error) {
+	if request.GetRequest() == nil {
+		return nil, &types.BadRequestError{Message: "Request is not set on request."}
+	}
+	tlMgr, err := e.getTaskListManagerForBacklog(
+		hCtx,
+		request.DomainUUID,
+		request.Request.TaskList,
+		request.Request.GetTaskListType(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.ListTasks(hCtx.Context, request.Request)
+}
+
+func (e *matchingEngineImpl) DeleteTaskListTasks(
+	hCtx *handlerContext,
+	request *types.MatchingDeleteTaskListTasksRequest,
+) (*types.DeleteTaskListTasksResponse, error) {
+	if request.GetRequest() == nil {
+		return nil, &types.BadRequestError{Message: "Request is not set on request."}
+	}
+	if request.Request.WorkflowID == "" && request.Request.RunID == "" && request.Request.CreatedBefore == nil {
+		return nil, &types.BadRequestError{Message: "At least one of WorkflowID, RunID or CreatedBefore must be set."}
+	}
+	tlMgr, err := e.getTaskListManagerForBacklog(
+		hCtx,
+		request.DomainUUID,
+		request.Request.TaskList,
+		request.Request.GetTaskListType(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := tlMgr.DeleteTasks(hCtx.Context, request.Request)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DeleteTaskListTasksResponse{DeletedCount: deleted}, nil
+}
+
+func (e *matchingEngineImpl) UpdateTaskListBuildIDCompatibility(
+	hCtx *handlerContext,
+	request *types.MatchingUpdateTaskListBuildIDCompatibilityRequest,
+) (*types.UpdateTaskListBuildIDCompatibilityResponse, error) {
+	if request.GetRequest() == nil {
+		return nil, &types.BadRequestError{Message: "Request is not set on request."}
+	}
+	if request.Request.GetBuildID() == "" {
+		return nil, &types.BadRequestError{Message: "BuildID is not set on request."}
+	}
+	tlMgr, err := e.getTaskListManagerForBuildIDs(hCtx, request.DomainUUID, request.Request.TaskList)
+	if err != nil {
+		return nil, err
+	}
+	sets, err := tlMgr.UpdateBuildIDCompatibility(hCtx.Context, request.Request.BuildID, request.Request.GetCompatibleBuildID())
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateTaskListBuildIDCompatibilityResponse{CompatibleSets: toCompatibleBuildIDSets(sets)}, nil
+}
+
+func (e *matchingEngineImpl) GetTaskListBuildIDCompatibility(
+	hCtx *handlerContext,
+	request *types.MatchingGetTaskListBuildIDCompatibilityRequest,
+) (*types.GetTaskListBuildIDCompatibilityResponse, error) {
+	if request.GetRequest() == nil {
+		return nil, &types.BadRequestError{Message: "Request is not set on request."}
+	}
+	tlMgr, err := e.getTaskListManagerForBuildIDs(hCtx, request.DomainUUID, request.Request.TaskList)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetTaskListBuildIDCompatibilityResponse{CompatibleSets: toCompatibleBuildIDSets(tlMgr.CompatibleBuildIDSets())}, nil
+}
+
+// getTaskListManagerForBuildIDs returns the manager of the root partition of the given decision task list,
+// which owns its build ID compatibility sets
+func (e *matchingEngineImpl) getTaskListManagerForBuildIDs(
+	hCtx *handlerContext,
+	domainID string,
+	taskList *types.TaskList,
+) (tasklist.Manager, error) {
+	if taskList.GetName() == "" {
+		return nil, &types.BadRequestError{Message: "TaskList is not set on request."}
+	}
+	taskListID, err := tasklist.NewIdentifier(domainID, taskList.GetName(), persistence.TaskListTypeDecision)
+	if err != nil {
+		return nil, err
+	}
+	if !taskListID.IsRoot() {
+		return nil, &types.BadRequestError{Message: "Build ID compatibility can only be managed on the root partition."}
+	}
+	return e.getOrCreateTaskListManager(hCtx.Context, taskListID, types.TaskListKindNormal)
+}
+
+func toCompatibleBuildIDSets(sets [][]string) []*types.CompatibleBuildIDSet {
+	result := make([]*types.CompatibleBuildIDSet, 0, len(sets))
+	for _, set := range sets {
+		result = append(result, &types.CompatibleBuildIDSet{BuildIDs: set})
+	}
+	return result
+}
+
+// getTaskListManagerForBacklog returns the manager owning the backlog of the given task list partition,
+// the partition is loaded if it is not yet owned by this host
+func (e *matchingEngineImpl) getTaskListManagerForBacklog(
+	hCtx *handlerContext,
+	domainID string,
+	taskList *types.TaskList,
+	taskListType types.TaskListType,
+) (tasklist.Manager, error) {
+	if taskList.GetName() == "" {
+		return nil, &types.BadRequestError{Message: "TaskList is not set on request."}
+	}
+	tlType := persistence.TaskListTypeDecision
+	if taskListType == types.TaskListTypeActivity {
+		tlType = persistence.TaskListTypeActivity
+	}
+	taskListID, err := tasklist.NewIdentifier(domainID, taskList.GetName(), tlType)
+	if err != nil {
+		return nil, err
+	}
+	return e.getOrCreateTaskListManager(hCtx.Context, taskListID, taskList.GetKind())
+}
+
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 	host, err := e.membershipResolver.Lookup(service.Matching, partitionKey)
 	if err != nil {
@@ -28,12 +28,14 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -1418,6 +1420,340 @@ func TestRefreshTaskListPartitionConfig(t *testing.T) {
 	}
 }
 
+func TestListTaskListTasks(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.MatchingListTaskListTasksRequest
+		mockSetup     func(*tasklist.MockManager, *gomock.Controller, *executorclient.MockExecutor[tasklist.ShardProcessor])
+		want          *types.ListTaskListTasksResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.MatchingListTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.ListTaskListTasksRequest{
+					Domain:       "test-domain",
+					TaskList:     &types.TaskList{Name: "test-tasklist"},
+					TaskListType: types.TaskListTypeActivity.Ptr(),
+					PageSize:     10,
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				executor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(ctrl), nil)
+				mockManager.EXPECT().ListTasks(gomock.Any(), gomock.Any()).Return(&types.ListTaskListTasksResponse{
+					Tasks: []*types.TaskListTask{{WorkflowID: "wid", RunID: "rid", TaskID: 1}},
+				}, nil)
+			},
+			want: &types.ListTaskListTasksResponse{
+				Tasks: []*types.TaskListTask{{WorkflowID: "wid", RunID: "rid", TaskID: 1}},
+			},
+		},
+		{
+			name: "tasklist manager error",
+			req: &types.MatchingListTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.ListTaskListTasksRequest{
+					TaskList:     &types.TaskList{Name: "test-tasklist"},
+					TaskListType: types.TaskListTypeActivity.Ptr(),
+					PageSize:     10,
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				executor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(ctrl), nil)
+				mockManager.EXPECT().ListTasks(gomock.Any(), gomock.Any()).Return(nil, errors.New("tasklist manager error"))
+			},
+			expectedError: "tasklist manager error",
+		},
+		{
+			name: "request not set",
+			req: &types.MatchingListTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "Request is not set on request.",
+		},
+		{
+			name: "tasklist not set",
+			req: &types.MatchingListTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+				Request:    &types.ListTaskListTasksRequest{PageSize: 10},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "TaskList is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			tasklistID := mustNewIdentifier(t, "test-domain-id", "test-tasklist", 1)
+			mockManager := newMockManagerWithTaskListID(mockCtrl, tasklistID)
+			mockExecutor := executorclient.NewMockExecutor[tasklist.ShardProcessor](mockCtrl)
+			tc.mockSetup(mockManager, mockCtrl, mockExecutor)
+			taskListRegistry := tasklist.NewTaskListRegistry(metrics.NewNoopMetricsClient())
+			engine := &matchingEngineImpl{
+				taskListRegistry: taskListRegistry,
+				timeSource:       clock.NewRealTimeSource(),
+				executor:         mockExecutor,
+				config: &config.Config{
+					ExcludeShortLivedTaskListsFromShardManager: func(opts ...dynamicproperties.FilterOption) bool { return false },
+					PercentageOnboardedToShardManager:          func(opts ...dynamicproperties.FilterOption) int { return 100 },
+				},
+			}
+			taskListRegistry.Register(*tasklistID, mockManager)
+			resp, err := engine.ListTaskListTasks(&handlerContext{Context: context.Background()}, tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, resp)
+			}
+		})
+	}
+}
+
+func TestDeleteTaskListTasks(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.MatchingDeleteTaskListTasksRequest
+		mockSetup     func(*tasklist.MockManager, *gomock.Controller, *executorclient.MockExecutor[tasklist.ShardProcessor])
+		want          *types.DeleteTaskListTasksResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.MatchingDeleteTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.DeleteTaskListTasksRequest{
+					Domain:       "test-domain",
+					TaskList:     &types.TaskList{Name: "test-tasklist"},
+					TaskListType: types.TaskListTypeActivity.Ptr(),
+					WorkflowID:   "wid",
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				executor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(ctrl), nil)
+				mockManager.EXPECT().DeleteTasks(gomock.Any(), gomock.Any()).Return(int64(3), nil)
+			},
+			want: &types.DeleteTaskListTasksResponse{DeletedCount: 3},
+		},
+		{
+			name: "tasklist manager error",
+			req: &types.MatchingDeleteTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.DeleteTaskListTasksRequest{
+					TaskList:      &types.TaskList{Name: "test-tasklist"},
+					TaskListType:  types.TaskListTypeActivity.Ptr(),
+					CreatedBefore: common.Int64Ptr(time.Now().UnixNano()),
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				executor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(ctrl), nil)
+				mockManager.EXPECT().DeleteTasks(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("tasklist manager error"))
+			},
+			expectedError: "tasklist manager error",
+		},
+		{
+			name: "no filter",
+			req: &types.MatchingDeleteTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.DeleteTaskListTasksRequest{
+					TaskList:     &types.TaskList{Name: "test-tasklist"},
+					TaskListType: types.TaskListTypeActivity.Ptr(),
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "At least one of WorkflowID, RunID or CreatedBefore must be set.",
+		},
+		{
+			name: "request not set",
+			req: &types.MatchingDeleteTaskListTasksRequest{
+				DomainUUID: "test-domain-id",
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "Request is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			tasklistID := mustNewIdentifier(t, "test-domain-id", "test-tasklist", 1)
+			mockManager := newMockManagerWithTaskListID(mockCtrl, tasklistID)
+			mockExecutor := executorclient.NewMockExecutor[tasklist.ShardProcessor](mockCtrl)
+			tc.mockSetup(mockManager, mockCtrl, mockExecutor)
+			taskListRegistry := tasklist.NewTaskListRegistry(metrics.NewNoopMetricsClient())
+			engine := &matchingEngineImpl{
+				taskListRegistry: taskListRegistry,
+				timeSource:       clock.NewRealTimeSource(),
+				executor:         mockExecutor,
+				config: &config.Config{
+					ExcludeShortLivedTaskListsFromShardManager: func(opts ...dynamicproperties.FilterOption) bool { return false },
+					PercentageOnboardedToShardManager:          func(opts ...dynamicproperties.FilterOption) int { return 100 },
+				},
+			}
+			taskListRegistry.Register(*tasklistID, mockManager)
+			resp, err := engine.DeleteTaskListTasks(&handlerContext{Context: context.Background()}, tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, resp)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskListBuildIDCompatibility(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.MatchingUpdateTaskListBuildIDCompatibilityRequest
+		mockSetup     func(*tasklist.MockManager, *gomock.Controller, *executorclient.MockExecutor[tasklist.ShardProcessor])
+		want          *types.UpdateTaskListBuildIDCompatibilityResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.UpdateTaskListBuildIDCompatibilityRequest{
+					Domain:            "test-domain",
+					TaskList:          &types.TaskList{Name: "test-tasklist"},
+					BuildID:           "build-2",
+					CompatibleBuildID: "build-1",
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				executor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(ctrl), nil)
+				mockManager.EXPECT().UpdateBuildIDCompatibility(gomock.Any(), "build-2", "build-1").Return([][]string{{"build-0"}, {"build-1", "build-2"}}, nil)
+			},
+			want: &types.UpdateTaskListBuildIDCompatibilityResponse{
+				CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-0"}}, {BuildIDs: []string{"build-1", "build-2"}}},
+			},
+		},
+		{
+			name: "tasklist manager error",
+			req: &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.UpdateTaskListBuildIDCompatibilityRequest{
+					TaskList: &types.TaskList{Name: "test-tasklist"},
+					BuildID:  "build-1",
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				executor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(ctrl), nil)
+				mockManager.EXPECT().UpdateBuildIDCompatibility(gomock.Any(), "build-1", "").Return(nil, errors.New("tasklist manager error"))
+			},
+			expectedError: "tasklist manager error",
+		},
+		{
+			name: "build ID not set",
+			req: &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.UpdateTaskListBuildIDCompatibilityRequest{
+					TaskList: &types.TaskList{Name: "test-tasklist"},
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "BuildID is not set on request.",
+		},
+		{
+			name: "non-root partition",
+			req: &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.UpdateTaskListBuildIDCompatibilityRequest{
+					TaskList: &types.TaskList{Name: "/__cadence_sys/test-tasklist/1"},
+					BuildID:  "build-1",
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "Build ID compatibility can only be managed on the root partition.",
+		},
+		{
+			name: "request not set",
+			req: &types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+				DomainUUID: "test-domain-id",
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, ctrl *gomock.Controller, executor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "Request is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			tasklistID := mustNewIdentifier(t, "test-domain-id", "test-tasklist", persistence.TaskListTypeDecision)
+			mockManager := newMockManagerWithTaskListID(mockCtrl, tasklistID)
+			mockExecutor := executorclient.NewMockExecutor[tasklist.ShardProcessor](mockCtrl)
+			tc.mockSetup(mockManager, mockCtrl, mockExecutor)
+			taskListRegistry := tasklist.NewTaskListRegistry(metrics.NewNoopMetricsClient())
+			engine := &matchingEngineImpl{
+				taskListRegistry: taskListRegistry,
+				timeSource:       clock.NewRealTimeSource(),
+				executor:         mockExecutor,
+				config: &config.Config{
+					ExcludeShortLivedTaskListsFromShardManager: func(opts ...dynamicproperties.FilterOption) bool { return false },
+					PercentageOnboardedToShardManager:          func(opts ...dynamicproperties.FilterOption) int { return 100 },
+				},
+			}
+			taskListRegistry.Register(*tasklistID, mockManager)
+			resp, err := engine.UpdateTaskListBuildIDCompatibility(&handlerContext{Context: context.Background()}, tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, resp)
+			}
+		})
+	}
+}
+
+func TestGetTaskListBuildIDCompatibility(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	tasklistID := mustNewIdentifier(t, "test-domain-id", "test-tasklist", persistence.TaskListTypeDecision)
+	mockManager := newMockManagerWithTaskListID(mockCtrl, tasklistID)
+	mockManager.EXPECT().CompatibleBuildIDSets().Return([][]string{{"build-1"}})
+	mockExecutor := executorclient.NewMockExecutor[tasklist.ShardProcessor](mockCtrl)
+	mockExecutor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(mockCtrl), nil)
+	taskListRegistry := tasklist.NewTaskListRegistry(metrics.NewNoopMetricsClient())
+	engine := &matchingEngineImpl{
+		taskListRegistry: taskListRegistry,
+		timeSource:       clock.NewRealTimeSource(),
+		executor:         mockExecutor,
+		config: &config.Config{
+			ExcludeShortLivedTaskListsFromShardManager: func(opts ...dynamicproperties.FilterOption) bool { return false },
+			PercentageOnboardedToShardManager:          func(opts ...dynamicproperties.FilterOption) int { return 100 },
+		},
+	}
+	taskListRegistry.Register(*tasklistID, mockManager)
+
+	resp, err := engine.GetTaskListBuildIDCompatibility(&handlerContext{Context: context.Background()}, &types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: "test-domain-id",
+		Request: &types.GetTaskListBuildIDCompatibilityRequest{
+			Domain:   "test-domain",
+			TaskList: &types.TaskList{Name: "test-tasklist"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &types.GetTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+	}, resp)
+
+	_, err = engine.GetTaskListBuildIDCompatibility(&handlerContext{Context: context.Background()}, &types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: "test-domain-id",
+		Request:    &types.GetTaskListBuildIDCompatibilityRequest{Domain: "test-domain"},
+	})
+	assert.ErrorContains(t, err, "TaskList is not set on request.")
+}
+
 func Test_domainChangeCallback(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockDomainCache := cache.NewMockDomainCache(mockCtrl)
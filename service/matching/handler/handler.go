@@ -468,6 +468,106 @@ func (h *handlerImpl) RefreshTaskListPartitionConfig(
 	return response, hCtx.handleErr(err)
 }
 
+func (h *handlerImpl) ListTaskListTasks(
+	ctx context.Context,
+	request *types.MatchingListTaskListTasksRequest,
+) (resp *types.ListTaskListTasksResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.DomainUUID)
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetRequest().GetTaskList(),
+		metrics.MatchingListTaskListTasksScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.ListTaskListTasks(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func (h *handlerImpl) DeleteTaskListTasks(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListTasksRequest,
+) (resp *types.DeleteTaskListTasksResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.DomainUUID)
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetRequest().GetTaskList(),
+		metrics.MatchingDeleteTaskListTasksScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.DeleteTaskListTasks(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func (h *handlerImpl) UpdateTaskListBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListBuildIDCompatibilityRequest,
+) (resp *types.UpdateTaskListBuildIDCompatibilityResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.DomainUUID)
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetRequest().GetTaskList(),
+		metrics.MatchingUpdateTaskListBuildIDCompatibilityScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.UpdateTaskListBuildIDCompatibility(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func (h *handlerImpl) GetTaskListBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetTaskListBuildIDCompatibilityRequest,
+) (resp *types.GetTaskListBuildIDCompatibilityResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.DomainUUID)
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetRequest().GetTaskList(),
+		metrics.MatchingGetTaskListBuildIDCompatibilityScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.GetTaskListBuildIDCompatibility(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 func (h *handlerImpl) domainName(id string) string {
 	domainName, err := h.domainCache.GetDomainName(id)
 	if err != nil {
@@ -902,6 +902,128 @@ func (s *handlerSuite) TestUpdateTaskListPartitionConfig() {
 	}
 }
 
+func (s *handlerSuite) TestListTaskListTasks() {
+	request := types.MatchingListTaskListTasksRequest{
+		DomainUUID: "test-domain-id",
+		Request: &types.ListTaskListTasksRequest{
+			Domain:   s.testDomain,
+			TaskList: &types.TaskList{Name: "test-task-list"},
+			PageSize: 10,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.ListTaskListTasksResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().ListTaskListTasks(gomock.Any(), &request).
+					Return(&types.ListTaskListTasksResponse{Tasks: []*types.TaskListTask{{WorkflowID: "wid", TaskID: 1}}}, nil).Times(1)
+			},
+			want: &types.ListTaskListTasksResponse{Tasks: []*types.TaskListTask{{WorkflowID: "wid", TaskID: 1}}},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - ListTaskListTasks failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().ListTaskListTasks(gomock.Any(), &request).
+					Return(nil, errors.New("list-tasks-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "list-tasks-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.ListTaskListTasks(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestDeleteTaskListTasks() {
+	request := types.MatchingDeleteTaskListTasksRequest{
+		DomainUUID: "test-domain-id",
+		Request: &types.DeleteTaskListTasksRequest{
+			Domain:     s.testDomain,
+			TaskList:   &types.TaskList{Name: "test-task-list"},
+			WorkflowID: "wid",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.DeleteTaskListTasksResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DeleteTaskListTasks(gomock.Any(), &request).
+					Return(&types.DeleteTaskListTasksResponse{DeletedCount: 1}, nil).Times(1)
+			},
+			want: &types.DeleteTaskListTasksResponse{DeletedCount: 1},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - DeleteTaskListTasks failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DeleteTaskListTasks(gomock.Any(), &request).
+					Return(nil, errors.New("delete-tasks-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "delete-tasks-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.DeleteTaskListTasks(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
 func partitions(num int) map[int]*types.TaskListPartition {
 	result := make(map[int]*types.TaskListPartition, num)
 	for i := 0; i < num; i++ {
@@ -909,3 +1031,124 @@ func partitions(num int) map[int]*types.TaskListPartition {
 	}
 	return result
 }
+
+func (s *handlerSuite) TestUpdateTaskListBuildIDCompatibility() {
+	request := types.MatchingUpdateTaskListBuildIDCompatibilityRequest{
+		DomainUUID: "test-domain-id",
+		Request: &types.UpdateTaskListBuildIDCompatibilityRequest{
+			Domain:   s.testDomain,
+			TaskList: &types.TaskList{Name: "test-task-list"},
+			BuildID:  "build-1",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.UpdateTaskListBuildIDCompatibilityResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), &request).
+					Return(&types.UpdateTaskListBuildIDCompatibilityResponse{CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}}}, nil).Times(1)
+			},
+			want: &types.UpdateTaskListBuildIDCompatibilityResponse{CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}}},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - UpdateTaskListBuildIDCompatibility failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), &request).
+					Return(nil, errors.New("build-id-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "build-id-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.UpdateTaskListBuildIDCompatibility(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestGetTaskListBuildIDCompatibility() {
+	request := types.MatchingGetTaskListBuildIDCompatibilityRequest{
+		DomainUUID: "test-domain-id",
+		Request: &types.GetTaskListBuildIDCompatibilityRequest{
+			Domain:   s.testDomain,
+			TaskList: &types.TaskList{Name: "test-task-list"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.GetTaskListBuildIDCompatibilityResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), &request).
+					Return(&types.GetTaskListBuildIDCompatibilityResponse{CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}}}, nil).Times(1)
+			},
+			want: &types.GetTaskListBuildIDCompatibilityResponse{CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}}},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - GetTaskListBuildIDCompatibility failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), &request).
+					Return(nil, errors.New("build-id-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "build-id-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.GetTaskListBuildIDCompatibility(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
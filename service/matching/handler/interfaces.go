@@ -48,6 +48,10 @@ type (
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
 		UpdateTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 		RefreshTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+		ListTaskListTasks(hCtx *handlerContext, request *types.MatchingListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error)
+		DeleteTaskListTasks(hCtx *handlerContext, request *types.MatchingDeleteTaskListTasksRequest) (*types.DeleteTaskListTasksResponse, error)
+		UpdateTaskListBuildIDCompatibility(hCtx *handlerContext, request *types.MatchingUpdateTaskListBuildIDCompatibilityRequest) (*types.UpdateTaskListBuildIDCompatibilityResponse, error)
+		GetTaskListBuildIDCompatibility(hCtx *handlerContext, request *types.MatchingGetTaskListBuildIDCompatibilityRequest) (*types.GetTaskListBuildIDCompatibilityResponse, error)
 	}
 
 	// Handler interface for matching service
@@ -67,5 +71,9 @@ type (
 		RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest) error
 		UpdateTaskListPartitionConfig(context.Context, *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 		RefreshTaskListPartitionConfig(context.Context, *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+		ListTaskListTasks(context.Context, *types.MatchingListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error)
+		DeleteTaskListTasks(context.Context, *types.MatchingDeleteTaskListTasksRequest) (*types.DeleteTaskListTasksResponse, error)
+		UpdateTaskListBuildIDCompatibility(context.Context, *types.MatchingUpdateTaskListBuildIDCompatibilityRequest) (*types.UpdateTaskListBuildIDCompatibilityResponse, error)
+		GetTaskListBuildIDCompatibility(context.Context, *types.MatchingGetTaskListBuildIDCompatibilityRequest) (*types.GetTaskListBuildIDCompatibilityResponse, error)
 	}
 )
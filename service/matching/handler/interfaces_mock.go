@@ -86,6 +86,21 @@ func (mr *MockEngineMockRecorder) CancelOutstandingPoll(hCtx, request any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockEngine)(nil).CancelOutstandingPoll), hCtx, request)
 }
 
+// DeleteTaskListTasks mocks base method.
+func (m *MockEngine) DeleteTaskListTasks(hCtx *handlerContext, request *types.MatchingDeleteTaskListTasksRequest) (*types.DeleteTaskListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskListTasks", hCtx, request)
+	ret0, _ := ret[0].(*types.DeleteTaskListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListTasks indicates an expected call of DeleteTaskListTasks.
+func (mr *MockEngineMockRecorder) DeleteTaskListTasks(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListTasks", reflect.TypeOf((*MockEngine)(nil).DeleteTaskListTasks), hCtx, request)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockEngine) DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +116,21 @@ func (mr *MockEngineMockRecorder) DescribeTaskList(hCtx, request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockEngine)(nil).DescribeTaskList), hCtx, request)
 }
 
+// GetTaskListBuildIDCompatibility mocks base method.
+func (m *MockEngine) GetTaskListBuildIDCompatibility(hCtx *handlerContext, request *types.MatchingGetTaskListBuildIDCompatibilityRequest) (*types.GetTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListBuildIDCompatibility", hCtx, request)
+	ret0, _ := ret[0].(*types.GetTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListBuildIDCompatibility indicates an expected call of GetTaskListBuildIDCompatibility.
+func (mr *MockEngineMockRecorder) GetTaskListBuildIDCompatibility(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListBuildIDCompatibility", reflect.TypeOf((*MockEngine)(nil).GetTaskListBuildIDCompatibility), hCtx, request)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockEngine) GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +161,21 @@ func (mr *MockEngineMockRecorder) ListTaskListPartitions(hCtx, request any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockEngine)(nil).ListTaskListPartitions), hCtx, request)
 }
 
+// ListTaskListTasks mocks base method.
+func (m *MockEngine) ListTaskListTasks(hCtx *handlerContext, request *types.MatchingListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskListTasks", hCtx, request)
+	ret0, _ := ret[0].(*types.ListTaskListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskListTasks indicates an expected call of ListTaskListTasks.
+func (mr *MockEngineMockRecorder) ListTaskListTasks(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListTasks", reflect.TypeOf((*MockEngine)(nil).ListTaskListTasks), hCtx, request)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockEngine) PollForActivityTask(hCtx *handlerContext, request *types.MatchingPollForActivityTaskRequest) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +274,21 @@ func (mr *MockEngineMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockEngine)(nil).Stop))
 }
 
+// UpdateTaskListBuildIDCompatibility mocks base method.
+func (m *MockEngine) UpdateTaskListBuildIDCompatibility(hCtx *handlerContext, request *types.MatchingUpdateTaskListBuildIDCompatibilityRequest) (*types.UpdateTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListBuildIDCompatibility", hCtx, request)
+	ret0, _ := ret[0].(*types.UpdateTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListBuildIDCompatibility indicates an expected call of UpdateTaskListBuildIDCompatibility.
+func (mr *MockEngineMockRecorder) UpdateTaskListBuildIDCompatibility(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListBuildIDCompatibility", reflect.TypeOf((*MockEngine)(nil).UpdateTaskListBuildIDCompatibility), hCtx, request)
+}
+
 // UpdateTaskListPartitionConfig mocks base method.
 func (m *MockEngine) UpdateTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -312,6 +372,21 @@ func (mr *MockHandlerMockRecorder) CancelOutstandingPoll(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockHandler)(nil).CancelOutstandingPoll), arg0, arg1)
 }
 
+// DeleteTaskListTasks mocks base method.
+func (m *MockHandler) DeleteTaskListTasks(arg0 context.Context, arg1 *types.MatchingDeleteTaskListTasksRequest) (*types.DeleteTaskListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskListTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.DeleteTaskListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListTasks indicates an expected call of DeleteTaskListTasks.
+func (mr *MockHandlerMockRecorder) DeleteTaskListTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListTasks", reflect.TypeOf((*MockHandler)(nil).DeleteTaskListTasks), arg0, arg1)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockHandler) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -327,6 +402,21 @@ func (mr *MockHandlerMockRecorder) DescribeTaskList(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockHandler)(nil).DescribeTaskList), arg0, arg1)
 }
 
+// GetTaskListBuildIDCompatibility mocks base method.
+func (m *MockHandler) GetTaskListBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingGetTaskListBuildIDCompatibilityRequest) (*types.GetTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListBuildIDCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListBuildIDCompatibility indicates an expected call of GetTaskListBuildIDCompatibility.
+func (mr *MockHandlerMockRecorder) GetTaskListBuildIDCompatibility(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListBuildIDCompatibility", reflect.TypeOf((*MockHandler)(nil).GetTaskListBuildIDCompatibility), arg0, arg1)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockHandler) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -372,6 +462,21 @@ func (mr *MockHandlerMockRecorder) ListTaskListPartitions(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockHandler)(nil).ListTaskListPartitions), arg0, arg1)
 }
 
+// ListTaskListTasks mocks base method.
+func (m *MockHandler) ListTaskListTasks(arg0 context.Context, arg1 *types.MatchingListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskListTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListTaskListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskListTasks indicates an expected call of ListTaskListTasks.
+func (mr *MockHandlerMockRecorder) ListTaskListTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListTasks", reflect.TypeOf((*MockHandler)(nil).ListTaskListTasks), arg0, arg1)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockHandler) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +575,21 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockHandler)(nil).Stop))
 }
 
+// UpdateTaskListBuildIDCompatibility mocks base method.
+func (m *MockHandler) UpdateTaskListBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingUpdateTaskListBuildIDCompatibilityRequest) (*types.UpdateTaskListBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListBuildIDCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateTaskListBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListBuildIDCompatibility indicates an expected call of UpdateTaskListBuildIDCompatibility.
+func (mr *MockHandlerMockRecorder) UpdateTaskListBuildIDCompatibility(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListBuildIDCompatibility", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListBuildIDCompatibility), arg0, arg1)
+}
+
 // UpdateTaskListPartitionConfig mocks base method.
 func (m *MockHandler) UpdateTaskListPartitionConfig(arg0 context.Context, arg1 *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error) {
 	m.ctrl.T.Helper()
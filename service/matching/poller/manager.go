@@ -42,6 +42,7 @@ type (
 		Identity       string
 		RatePerSecond  float64
 		IsolationGroup string
+		BuildID        string
 	}
 
 	Manager interface {
@@ -216,6 +217,7 @@ func (m *manager) ListInfo() []*types.PollerInfo {
 			Identity:       identity,
 			LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
 			RatePerSecond:  info.RatePerSecond,
+			BuildID:        info.BuildID,
 		})
 	})
 
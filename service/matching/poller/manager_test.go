@@ -305,6 +305,20 @@ func TestManager_ListInfo(t *testing.T) {
 			},
 			result: []*types.PollerInfo{},
 		},
+		{
+			name: "include build ID",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "aIdent", BuildID: "build-1"})
+			},
+			result: []*types.PollerInfo{
+				{
+					LastAccessTime: common.Int64Ptr(startTime.UnixNano()),
+					Identity:       "aIdent",
+					RatePerSecond:  0,
+					BuildID:        "build-1",
+				},
+			},
+		},
 		{
 			name: "include pollers with no pollerID",
 			fn: func(mockTime clock.MockedTimeSource, m Manager) {
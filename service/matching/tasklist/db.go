@@ -42,6 +42,7 @@ type (
 		backlogCount    int64
 		ackLevel        int64
 		partitionConfig *persistence.TaskListPartitionConfig
+		buildIDSets     [][]string
 		store           persistence.TaskManager
 		logger          log.Logger
 	}
@@ -91,6 +92,13 @@ func (db *taskListDB) PartitionConfig() *persistence.TaskListPartitionConfig {
 	return db.partitionConfig
 }
 
+// CompatibleBuildIDSets returns the worker build ID compatibility sets of the taskList
+func (db *taskListDB) CompatibleBuildIDSets() [][]string {
+	db.RLock()
+	defer db.RUnlock()
+	return db.buildIDSets
+}
+
 // RenewLease renews the lease on a tasklist. If there is no previous lease,
 // this method will attempt to steal tasklist from current owner
 func (db *taskListDB) RenewLease() (taskListState, error) {
@@ -110,6 +118,7 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	db.rangeID = resp.TaskListInfo.RangeID
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.partitionConfig = resp.TaskListInfo.AdaptivePartitionConfig
+	db.buildIDSets = resp.TaskListInfo.CompatibleBuildIDSets
 	return taskListState{rangeID: db.rangeID, ackLevel: resp.TaskListInfo.AckLevel}, nil
 }
 
@@ -126,6 +135,7 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 			RangeID:                 db.rangeID,
 			Kind:                    db.taskListKind,
 			AdaptivePartitionConfig: db.partitionConfig,
+			CompatibleBuildIDSets:   db.buildIDSets,
 		},
 		DomainName: db.domainName,
 	})
@@ -148,6 +158,7 @@ func (db *taskListDB) UpdateTaskListPartitionConfig(partitionConfig *persistence
 			RangeID:                 db.rangeID,
 			Kind:                    db.taskListKind,
 			AdaptivePartitionConfig: partitionConfig,
+			CompatibleBuildIDSets:   db.buildIDSets,
 		},
 		DomainName: db.domainName,
 	})
@@ -158,6 +169,30 @@ func (db *taskListDB) UpdateTaskListPartitionConfig(partitionConfig *persistence
 	return nil
 }
 
+// UpdateCompatibleBuildIDSets persists the worker build ID compatibility sets of the taskList
+func (db *taskListDB) UpdateCompatibleBuildIDSets(buildIDSets [][]string) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:                db.domainID,
+			Name:                    db.taskListName,
+			TaskType:                db.taskType,
+			AckLevel:                db.ackLevel,
+			RangeID:                 db.rangeID,
+			Kind:                    db.taskListKind,
+			AdaptivePartitionConfig: db.partitionConfig,
+			CompatibleBuildIDSets:   buildIDSets,
+		},
+		DomainName: db.domainName,
+	})
+	if err != nil {
+		return err
+	}
+	db.buildIDSets = buildIDSets
+	return nil
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -187,6 +222,28 @@ func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int)
 	})
 }
 
+// CompleteTask deletes a single task
+func (db *taskListDB) CompleteTask(taskID int64) error {
+	err := db.store.CompleteTask(context.Background(), &persistence.CompleteTaskRequest{
+		TaskList: &persistence.TaskListInfo{
+			DomainID: db.domainID,
+			Name:     db.taskListName,
+			TaskType: db.taskType,
+		},
+		TaskID:     taskID,
+		DomainName: db.domainName,
+	})
+	if err != nil {
+		db.logger.Error("Persistent store operation failure",
+			tag.StoreOperationCompleteTask,
+			tag.Error(err),
+			tag.TaskID(taskID),
+			tag.TaskType(db.taskType),
+			tag.WorkflowTaskListName(db.taskListName))
+	}
+	return err
+}
+
 // CompleteTasksLessThan deletes of tasks less than the given taskID. Limit is
 // the upper bound of number of tasks that can be deleted by this method. It may
 // or may not be honored
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/task"
+)
+
+const defaultFairnessKeyWeight = 1
+
+type (
+	// fairQueue is a queue of tasks which keeps one FIFO queue per fairness key and takes
+	// tasks from them in weighted round robin order, so that a key with a large backlog does
+	// not delay the tasks of other keys. It is not safe for concurrent use.
+	fairQueue struct {
+		queues  map[string][]*persistence.TaskInfo
+		size    int
+		weights func() map[string]interface{}
+		iter    task.Iterator[string]
+		// next is the key of the queue the next task is taken from, valid if hasNext is set
+		next    string
+		hasNext bool
+	}
+)
+
+func newFairQueue(weights func() map[string]interface{}) *fairQueue {
+	return &fairQueue{
+		queues:  make(map[string][]*persistence.TaskInfo),
+		weights: weights,
+		iter:    task.NewWeightedRoundRobinSchedule[string](nil).NewIterator(),
+	}
+}
+
+func (q *fairQueue) push(key string, taskInfo *persistence.TaskInfo) {
+	q.queues[key] = append(q.queues[key], taskInfo)
+	q.size++
+}
+
+// peek returns the task which the next pop will return, or nil if the queue is empty.
+func (q *fairQueue) peek() *persistence.TaskInfo {
+	if q.size == 0 {
+		return nil
+	}
+	for !q.hasNext {
+		key, ok := q.iter.TryNext()
+		if !ok {
+			// keys which were added since the last round are picked up here
+			q.iter = q.newSchedule().NewIterator()
+			continue
+		}
+		if len(q.queues[key]) > 0 {
+			q.next, q.hasNext = key, true
+		}
+	}
+	return q.queues[q.next][0]
+}
+
+// pop removes and returns the next task, or nil if the queue is empty.
+func (q *fairQueue) pop() *persistence.TaskInfo {
+	taskInfo := q.peek()
+	if taskInfo == nil {
+		return nil
+	}
+	queue := q.queues[q.next]
+	queue[0] = nil
+	if len(queue) == 1 {
+		delete(q.queues, q.next)
+	} else {
+		q.queues[q.next] = queue[1:]
+	}
+	q.hasNext = false
+	q.size--
+	return taskInfo
+}
+
+// removeIf removes and returns the tasks for which the predicate is true.
+func (q *fairQueue) removeIf(pred func(*persistence.TaskInfo) bool) []*persistence.TaskInfo {
+	var removed []*persistence.TaskInfo
+	for key, queue := range q.queues {
+		kept := queue[:0]
+		for _, taskInfo := range queue {
+			if pred(taskInfo) {
+				removed = append(removed, taskInfo)
+			} else {
+				kept = append(kept, taskInfo)
+			}
+		}
+		clear(queue[len(kept):])
+		if len(kept) == 0 {
+			delete(q.queues, key)
+		} else {
+			q.queues[key] = kept
+		}
+	}
+	if q.hasNext && len(q.queues[q.next]) == 0 {
+		q.hasNext = false
+	}
+	q.size -= len(removed)
+	return removed
+}
+
+func (q *fairQueue) len() int {
+	return q.size
+}
+
+func (q *fairQueue) newSchedule() task.Schedule[string] {
+	var configured map[string]interface{}
+	if q.weights != nil {
+		configured = q.weights()
+	}
+	weights := make(map[string]int, len(q.queues))
+	for key := range q.queues {
+		weights[key] = fairnessKeyWeight(configured, key)
+	}
+	return task.NewWeightedRoundRobinSchedule(weights)
+}
+
+// fairnessKeyWeight returns the configured weight of the key, or the default weight if
+// the key is not configured or its weight is not a positive number.
+func fairnessKeyWeight(weights map[string]interface{}, key string) int {
+	var weight int
+	switch value := weights[key].(type) {
+	case float64:
+		weight = int(value)
+	case int:
+		weight = value
+	case int32:
+		weight = int(value)
+	case int64:
+		weight = int(value)
+	}
+	if weight <= 0 {
+		return defaultFairnessKeyWeight
+	}
+	return weight
+}
+
+// fairnessKey returns the key the task is fairly dispatched by: the key supplied by the
+// client which started the workflow if any, otherwise the workflow ID.
+func fairnessKey(taskInfo *persistence.TaskInfo) string {
+	if key := taskInfo.FairnessKey; key != "" {
+		return key
+	}
+	return taskInfo.WorkflowID
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestFairQueue(t *testing.T) {
+	q := newFairQueue(func() map[string]interface{} { return map[string]interface{}{"a": 2} })
+	assert.Nil(t, q.peek())
+	assert.Nil(t, q.pop())
+
+	for i := 1; i <= 3; i++ {
+		q.push("a", &persistence.TaskInfo{TaskID: int64(i)})
+	}
+	q.push("b", &persistence.TaskInfo{TaskID: 4})
+	assert.Equal(t, 4, q.len())
+
+	// peek does not change which task is popped next
+	next := q.peek()
+	assert.Equal(t, next, q.peek())
+	assert.Equal(t, next, q.pop())
+
+	var taskIDs []int64
+	taskIDs = append(taskIDs, next.TaskID)
+	for q.len() > 0 {
+		taskIDs = append(taskIDs, q.pop().TaskID)
+	}
+	assert.Equal(t, []int64{1, 2, 4, 3}, taskIDs)
+	assert.Empty(t, q.queues)
+}
+
+func TestFairnessKeyWeight(t *testing.T) {
+	weights := map[string]interface{}{
+		"float":    float64(3),
+		"int":      4,
+		"zero":     0,
+		"negative": -1,
+		"string":   "5",
+	}
+	tests := map[string]int{
+		"float":    3,
+		"int":      4,
+		"zero":     defaultFairnessKeyWeight,
+		"negative": defaultFairnessKeyWeight,
+		"string":   defaultFairnessKeyWeight,
+		"missing":  defaultFairnessKeyWeight,
+	}
+	for key, expected := range tests {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, expected, fairnessKeyWeight(weights, key))
+		})
+	}
+	assert.Equal(t, defaultFairnessKeyWeight, fairnessKeyWeight(nil, "missing"))
+}
+
+func TestFairnessKey(t *testing.T) {
+	assert.Equal(t, "wf", fairnessKey(&persistence.TaskInfo{WorkflowID: "wf"}))
+	assert.Equal(t, "tenant", fairnessKey(&persistence.TaskInfo{WorkflowID: "wf", FairnessKey: "tenant"}))
+}
@@ -145,6 +145,9 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			Priority:                      task.Event.Priority,
+			WorkerBuildID:                 task.Event.WorkerBuildID,
+			FairnessKey:                   task.Event.FairnessKey,
 		})
 	case persistence.TaskListTypeActivity:
 		_, err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -160,6 +163,8 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			Priority:                      task.Event.Priority,
+			FairnessKey:                   task.Event.FairnessKey,
 		})
 	default:
 		return ErrInvalidTaskListType
@@ -217,7 +222,7 @@ func (fwdr *forwarderImpl) ForwardPoll(ctx context.Context) (*InternalTask, erro
 
 	switch fwdr.taskListID.GetType() {
 	case persistence.TaskListTypeDecision:
-		resp, err := fwdr.client.PollForDecisionTask(ctx, &types.MatchingPollForDecisionTaskRequest{
+		request := &types.MatchingPollForDecisionTaskRequest{
 			DomainUUID: fwdr.taskListID.GetDomainID(),
 			PollerID:   pollerID,
 			PollRequest: &types.PollForDecisionTaskRequest{
@@ -229,7 +234,11 @@ func (fwdr *forwarderImpl) ForwardPoll(ctx context.Context) (*InternalTask, erro
 			},
 			ForwardedFrom:  fwdr.taskListID.GetName(),
 			IsolationGroup: isolationGroup,
-		})
+		}
+		if buildID := BuildIDFromContext(ctx); buildID != "" {
+			request.PollRequest.WorkerVersionInfo = &types.WorkerVersionInfo{BuildID: buildID}
+		}
+		resp, err := fwdr.client.PollForDecisionTask(ctx, request)
 		if err != nil {
 			return nil, fwdr.handleErr(err)
 		}
@@ -105,6 +105,9 @@ func (t *ForwarderTestSuite) TestForwardDecisionTask() {
 	t.Equal(taskInfo.ScheduleID, request.GetScheduleID())
 	t.Equal(taskInfo.ScheduleToStartTimeoutSeconds, request.GetScheduleToStartTimeoutSeconds())
 	t.Equal(t.taskList.name, request.GetForwardedFrom())
+	t.Equal(taskInfo.Priority, request.GetPriority())
+	t.Equal(taskInfo.WorkerBuildID, request.GetWorkerBuildID())
+	t.Equal(taskInfo.FairnessKey, request.GetFairnessKey())
 }
 
 func (t *ForwarderTestSuite) TestForwardActivityTask() {
@@ -130,6 +133,8 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.Equal(taskInfo.ScheduleID, request.GetScheduleID())
 	t.Equal(taskInfo.ScheduleToStartTimeoutSeconds, request.GetScheduleToStartTimeoutSeconds())
 	t.Equal(t.taskList.name, request.GetForwardedFrom())
+	t.Equal(taskInfo.Priority, request.GetPriority())
+	t.Equal(taskInfo.FairnessKey, request.GetFairnessKey())
 }
 
 func (t *ForwarderTestSuite) TestForwardTaskRateExceeded() {
@@ -228,6 +233,25 @@ func (t *ForwarderTestSuite) TestForwardPollForDecision() {
 	t.Nil(task.PollForActivityResponse())
 }
 
+func (t *ForwarderTestSuite) TestForwardPollForDecisionWithBuildID() {
+	t.usingTasklistPartition(persistence.TaskListTypeDecision)
+
+	ctx := ContextWithPollerID(context.Background(), uuid.New())
+	ctx = ContextWithBuildID(ctx, "build-1")
+
+	var request *types.MatchingPollForDecisionTaskRequest
+	t.client.EXPECT().PollForDecisionTask(gomock.Any(), gomock.Any()).Do(
+		func(arg0 context.Context, arg1 *types.MatchingPollForDecisionTaskRequest, option ...yarpc.CallOption) {
+			request = arg1
+		},
+	).Return(&types.MatchingPollForDecisionTaskResponse{}, nil).Times(1)
+
+	_, err := t.fwdr.ForwardPoll(ctx)
+	t.NoError(err)
+	t.NotNil(request)
+	t.Equal("build-1", request.GetPollRequest().GetWorkerVersionInfo().GetBuildID())
+}
+
 func (t *ForwarderTestSuite) TestForwardPollForActivity() {
 	t.usingTasklistPartition(persistence.TaskListTypeActivity)
 
@@ -350,5 +374,8 @@ func (t *ForwarderTestSuite) newTaskInfo() *persistence.TaskInfo {
 		TaskID:                        rand.Int63(),
 		ScheduleID:                    rand.Int63(),
 		ScheduleToStartTimeoutSeconds: rand.Int31(),
+		Priority:                      rand.Int31n(constants.MaxTaskPriority + 1),
+		WorkerBuildID:                 uuid.New(),
+		FairnessKey:                   uuid.New(),
 	}
 }
@@ -88,6 +88,15 @@ type (
 		TaskListPartitionConfig() *types.TaskListPartitionConfig
 		UpdateTaskListPartitionConfig(context.Context, *types.TaskListPartitionConfig) error
 		RefreshTaskListPartitionConfig(context.Context, *types.TaskListPartitionConfig) error
+		// ListTasks returns a page of the tasks persisted in the backlog of the task list
+		ListTasks(context.Context, *types.ListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error)
+		// DeleteTasks deletes the backlog tasks which match the filters of the request and returns the number of deleted tasks
+		DeleteTasks(context.Context, *types.DeleteTaskListTasksRequest) (int64, error)
+		// CompatibleBuildIDSets returns the sets of compatible worker build IDs of the task list, the last one is the default set
+		CompatibleBuildIDSets() [][]string
+		// UpdateBuildIDCompatibility adds the build ID to the set of compatibleBuildID, or to a new default set
+		// if compatibleBuildID is empty, and returns the updated sets. It is only allowed on the root partition
+		UpdateBuildIDCompatibility(ctx context.Context, buildID string, compatibleBuildID string) ([][]string, error)
 		LoadBalancerHints() *types.LoadBalancerHints
 		QueriesPerSecond() float64
 		ReleaseBlockedPollers() error
@@ -99,7 +108,7 @@ type (
 		OfferOrTimeout(ctx context.Context, startT time.Time, task *InternalTask) (bool, error)
 		OfferQuery(ctx context.Context, task *InternalTask) (*types.MatchingQueryWorkflowResponse, error)
 		MustOffer(ctx context.Context, task *InternalTask) error
-		Poll(ctx context.Context, isolationGroup string) (*InternalTask, error)
+		Poll(ctx context.Context, isolationGroup string, versionKey string) (*InternalTask, error)
 		PollForQuery(ctx context.Context) (*InternalTask, error)
 		RefreshCancelContext()
 	}
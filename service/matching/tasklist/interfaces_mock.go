@@ -178,6 +178,35 @@ func (mr *MockManagerMockRecorder) CancelPoller(pollerID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPoller", reflect.TypeOf((*MockManager)(nil).CancelPoller), pollerID)
 }
 
+// CompatibleBuildIDSets mocks base method.
+func (m *MockManager) CompatibleBuildIDSets() [][]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompatibleBuildIDSets")
+	ret0, _ := ret[0].([][]string)
+	return ret0
+}
+
+// CompatibleBuildIDSets indicates an expected call of CompatibleBuildIDSets.
+func (mr *MockManagerMockRecorder) CompatibleBuildIDSets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompatibleBuildIDSets", reflect.TypeOf((*MockManager)(nil).CompatibleBuildIDSets))
+}
+
+// DeleteTasks mocks base method.
+func (m *MockManager) DeleteTasks(arg0 context.Context, arg1 *types.DeleteTaskListTasksRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTasks", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTasks indicates an expected call of DeleteTasks.
+func (mr *MockManagerMockRecorder) DeleteTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTasks", reflect.TypeOf((*MockManager)(nil).DeleteTasks), arg0, arg1)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockManager) DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse {
 	m.ctrl.T.Helper()
@@ -278,6 +307,21 @@ func (mr *MockManagerMockRecorder) HasPollerAfter(accessTime any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPollerAfter", reflect.TypeOf((*MockManager)(nil).HasPollerAfter), accessTime)
 }
 
+// ListTasks mocks base method.
+func (m *MockManager) ListTasks(arg0 context.Context, arg1 *types.ListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListTaskListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockManagerMockRecorder) ListTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockManager)(nil).ListTasks), arg0, arg1)
+}
+
 // LoadBalancerHints mocks base method.
 func (m *MockManager) LoadBalancerHints() *types.LoadBalancerHints {
 	m.ctrl.T.Helper()
@@ -402,6 +446,21 @@ func (mr *MockManagerMockRecorder) TaskListPartitionConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskListPartitionConfig", reflect.TypeOf((*MockManager)(nil).TaskListPartitionConfig))
 }
 
+// UpdateBuildIDCompatibility mocks base method.
+func (m *MockManager) UpdateBuildIDCompatibility(ctx context.Context, buildID, compatibleBuildID string) ([][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBuildIDCompatibility", ctx, buildID, compatibleBuildID)
+	ret0, _ := ret[0].([][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBuildIDCompatibility indicates an expected call of UpdateBuildIDCompatibility.
+func (mr *MockManagerMockRecorder) UpdateBuildIDCompatibility(ctx, buildID, compatibleBuildID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuildIDCompatibility", reflect.TypeOf((*MockManager)(nil).UpdateBuildIDCompatibility), ctx, buildID, compatibleBuildID)
+}
+
 // UpdateTaskListPartitionConfig mocks base method.
 func (m *MockManager) UpdateTaskListPartitionConfig(arg0 context.Context, arg1 *types.TaskListPartitionConfig) error {
 	m.ctrl.T.Helper()
@@ -512,18 +571,18 @@ func (mr *MockTaskMatcherMockRecorder) OfferQuery(ctx, task any) *gomock.Call {
 }
 
 // Poll mocks base method.
-func (m *MockTaskMatcher) Poll(ctx context.Context, isolationGroup string) (*InternalTask, error) {
+func (m *MockTaskMatcher) Poll(ctx context.Context, isolationGroup, versionKey string) (*InternalTask, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Poll", ctx, isolationGroup)
+	ret := m.ctrl.Call(m, "Poll", ctx, isolationGroup, versionKey)
 	ret0, _ := ret[0].(*InternalTask)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Poll indicates an expected call of Poll.
-func (mr *MockTaskMatcherMockRecorder) Poll(ctx, isolationGroup any) *gomock.Call {
+func (mr *MockTaskMatcherMockRecorder) Poll(ctx, isolationGroup, versionKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Poll", reflect.TypeOf((*MockTaskMatcher)(nil).Poll), ctx, isolationGroup)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Poll", reflect.TypeOf((*MockTaskMatcher)(nil).Poll), ctx, isolationGroup, versionKey)
 }
 
 // PollForQuery mocks base method.
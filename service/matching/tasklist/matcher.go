@@ -55,6 +55,15 @@ type taskMatcherImpl struct {
 	// are interested in queryTasks but not others. Example is when domain is
 	// not active in a cluster
 	queryTaskC chan *InternalTask
+	// priority gates of taskC (keyed by "") and of each isolatedTaskC, which make
+	// lower priority producers wait while a higher priority one is waiting for a poller
+	priorityGates map[string]*priorityGate
+	// synchronous task channels to match producer/consumer for a set of compatible worker build IDs,
+	// the key is the version key of the set. Tasks pinned to a build ID are only added to these channels,
+	// they are created on first use since the build IDs are not known upfront and removed once
+	// no poller or producer is using them anymore
+	versioned     map[string]*versionedChannel
+	versionedLock sync.Mutex
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter quotas.Limiter
 
@@ -72,6 +81,14 @@ type taskMatcherImpl struct {
 	numReadPartitionsFn func(*config.TaskListConfig) int
 }
 
+// versionedChannel is the task channel and the priority gate shared by the pollers
+// and producers of a version key, refs counts the ones currently using them
+type versionedChannel struct {
+	taskC chan *InternalTask
+	gate  *priorityGate
+	refs  int
+}
+
 // ErrTasklistThrottled implies a tasklist was throttled
 var ErrTasklistThrottled = errors.New("tasklist limit exceeded")
 
@@ -89,8 +106,10 @@ func newTaskMatcher(
 	limiter quotas.Limiter,
 ) TaskMatcher {
 	isolatedTaskC := make(map[string]chan *InternalTask)
+	priorityGates := map[string]*priorityGate{"": newPriorityGate()}
 	for _, g := range isolationGroups {
 		isolatedTaskC[g] = make(chan *InternalTask)
+		priorityGates[g] = newPriorityGate()
 	}
 
 	cancelCtx, cancelFunc := context.WithCancel(context.Background())
@@ -102,7 +121,9 @@ func newTaskMatcher(
 		taskC:         make(chan *InternalTask),
 		isolatedTaskC: isolatedTaskC,
 		queryTaskC:    make(chan *InternalTask),
+		priorityGates: priorityGates,
 		config:        config,
+		versioned:     make(map[string]*versionedChannel),
 		tasklist:      tasklist,
 		tasklistKind:  tasklistKind,
 		limiter:       limiter,
@@ -127,7 +148,8 @@ func (tm *taskMatcherImpl) DisconnectBlockedPollers() {
 //
 // When a local poller is not available and forwarding to a parent
 // task list partition is possible, this method will attempt forwarding
-// to the parent partition.
+// to the parent partition. A task is not matched locally while a task of
+// higher priority is waiting for the same pollers.
 //
 // Cases when this method will block:
 //
@@ -156,17 +178,19 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 			return false, err
 		}
 	}
+	defer tm.acquireVersion(task.versionKey)()
 	e := event.E{
 		TaskListName: tm.tasklist.GetName(),
 		TaskListType: tm.tasklist.GetType(),
 		TaskListKind: tm.tasklistKind.Ptr(),
 	}
+	priority := tm.taskPriority(task)
 	localWaitTime := tm.config.LocalTaskWaitTime()
 	if localWaitTime > 0 {
 		childCtx, cancel := context.WithTimeout(ctx, localWaitTime)
-		select {
-		case tm.getTaskC(task) <- task: // poller picked up the task
-			cancel()
+		matched := tm.offerLocal(childCtx, task, priority)
+		cancel()
+		if matched { // poller picked up the task
 			if task.ResponseC != nil {
 				// if there is a response channel, block until resp is received
 				// and return error if the response contains error
@@ -182,12 +206,12 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 				return true, err
 			}
 			return false, nil
-		case <-childCtx.Done():
-			cancel()
 		}
 	}
+	// taskC is nil while a higher priority task is waiting, which skips the local match
+	taskC, _ := tm.gatedTaskC(task, priority)
 	select {
-	case tm.getTaskC(task) <- task: // poller picked up the task
+	case taskC <- task: // poller picked up the task
 		if task.ResponseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -240,21 +264,21 @@ func (tm *taskMatcherImpl) OfferOrTimeout(ctx context.Context, startT time.Time,
 			return false, err
 		}
 	}
-	select {
-	case tm.getTaskC(task) <- task: // poller picked up the task
-		if task.ResponseC != nil {
-			select {
-			case err := <-task.ResponseC:
-				tm.scope.RecordTimer(metrics.SyncMatchLocalPollLatencyPerTaskList, time.Since(startT))
-				return true, err
-			case <-ctx.Done():
-				return false, nil
-			}
-		}
-		return task.ActivityTaskDispatchInfo != nil, nil
-	case <-ctx.Done():
+	defer tm.acquireVersion(task.versionKey)()
+	if !tm.offerLocal(ctx, task, tm.taskPriority(task)) {
 		return false, nil
 	}
+	// poller picked up the task
+	if task.ResponseC != nil {
+		select {
+		case err := <-task.ResponseC:
+			tm.scope.RecordTimer(metrics.SyncMatchLocalPollLatencyPerTaskList, time.Since(startT))
+			return true, err
+		case <-ctx.Done():
+			return false, nil
+		}
+	}
+	return task.ActivityTaskDispatchInfo != nil, nil
 }
 
 // OfferQuery will either match task to local poller or will forward query task.
@@ -295,6 +319,7 @@ func (tm *taskMatcherImpl) OfferQuery(ctx context.Context, task *InternalTask) (
 }
 
 // MustOffer blocks until a consumer is found to handle this task
+// The task is only handed over to a local poller while no task of higher priority is waiting for it.
 // Returns error only when context is canceled, expired or the ratelimit is set to zero (allow nothing)
 func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) error {
 	e := event.E{
@@ -308,30 +333,32 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 		event.Log(e)
 		return fmt.Errorf("rate limit error dispatching: %w", err)
 	}
+	defer tm.acquireVersion(task.versionKey)()
 
 	startT := time.Now()
+	priority := tm.taskPriority(task)
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
-	taskC := tm.getTaskC(task)
 	localWaitTime := tm.config.LocalTaskWaitTime()
 	childCtx, cancel := context.WithTimeout(ctx, localWaitTime)
-	select {
-	case taskC <- task: // poller picked up the task
-		cancel()
+	matched := tm.offerLocal(childCtx, task, priority)
+	cancel()
+	if matched { // poller picked up the task
 		tm.scope.IncCounter(metrics.AsyncMatchLocalPollCounterPerTaskList)
 		tm.scope.RecordTimer(metrics.AsyncMatchLocalPollLatencyPerTaskList, time.Since(startT))
 		e.EventName = "Dispatched to Local Poller"
 		event.Log(e)
 		return nil
-	case <-ctx.Done():
-		cancel()
+	}
+	if ctx.Err() != nil {
 		e.EventName = "Context Done While Dispatching to Local Poller"
 		event.Log(e)
 		return fmt.Errorf("context done when trying to forward local task: %w", ctx.Err())
-	case <-childCtx.Done():
-		cancel()
 	}
 
+	leave := tm.getPriorityGate(task).enter(priority)
+	defer leave()
+
 	attempt := 0
 forLoop:
 	for {
@@ -340,7 +367,11 @@ forLoop:
 			event.Log(e)
 			return fmt.Errorf("failed to offer task: %w", ctx.Err())
 		}
+		taskC, gateChangeC := tm.gatedTaskC(task, priority)
 		select {
+		case <-gateChangeC:
+			// a task of higher priority started or stopped waiting for a poller
+			continue forLoop
 		case taskC <- task: // poller picked up the task
 			e.EventName = "Dispatched to Local Poller"
 			event.Log(e)
@@ -370,8 +401,7 @@ forLoop:
 				// avoid a busy loop on such rate limiting events, we only attempt to make
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
-				select {
-				case taskC <- task: // poller picked up the task
+				if tm.offerLocal(childCtx, task, priority) { // poller picked up the task
 					e.EventName = "Dispatched to Local Poller (after failed forward)"
 					event.Log(e)
 					cancel()
@@ -379,11 +409,10 @@ forLoop:
 					tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAfterForwardFailedAttemptPerTaskList, time.Duration(attempt))
 					tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAfterForwardFailedLatencyPerTaskList, time.Since(startT))
 					return nil
-				case <-childCtx.Done():
-					attempt++
-					cancel()
-					continue forLoop
 				}
+				attempt++
+				cancel()
+				continue forLoop
 			}
 			cancel()
 
@@ -408,9 +437,10 @@ forLoop:
 
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
+// A non-empty versionKey makes the poller also receive the tasks pinned to its compatible build IDs
 // Returns ErrNoTasks when context deadline is exceeded
 // Returns ErrMatcherClosed when matching is closed
-func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*InternalTask, error) {
+func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string, versionKey string) (*InternalTask, error) {
 	startT := time.Now()
 	isolatedTaskC, ok := tm.isolatedTaskC[isolationGroup]
 	if !ok && isolationGroup != "" {
@@ -418,6 +448,11 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		isolatedTaskC = tm.taskC
 		tm.scope.IncCounter(metrics.PollerInvalidIsolationGroupCounter)
 	}
+	var versionedTaskC chan *InternalTask
+	if versionKey != "" {
+		defer tm.acquireVersion(versionKey)()
+		versionedTaskC = tm.getVersioned(versionKey).taskC
+	}
 
 	// we want cancellation of taskMatcher to be treated as cancellation of client context
 	// original context (ctx) won't be affected
@@ -436,7 +471,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 	}()
 
 	// try local match first without blocking until context timeout
-	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, tm.taskC, tm.queryTaskC); err == nil {
+	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, versionedTaskC, isolatedTaskC, tm.taskC, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
@@ -454,7 +489,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		TaskListKind: tm.tasklistKind.Ptr(),
 		EventName:    "Matcher Falling Back to Non-Local Polling",
 	})
-	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, isolatedTaskC, tm.taskC, tm.queryTaskC)
+	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, versionedTaskC, isolatedTaskC, tm.taskC, tm.queryTaskC)
 	return task, err
 }
 
@@ -464,7 +499,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 func (tm *taskMatcherImpl) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	startT := time.Now()
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, nil, nil, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
@@ -475,7 +510,7 @@ func (tm *taskMatcherImpl) PollForQuery(ctx context.Context) (*InternalTask, err
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctxWithCancelPropagation, startT, "", nil, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctxWithCancelPropagation, startT, "", nil, nil, nil, tm.queryTaskC)
 }
 
 func (tm *taskMatcherImpl) RefreshCancelContext() {
@@ -488,11 +523,33 @@ func (tm *taskMatcherImpl) pollOrForward(
 	ctx context.Context,
 	startT time.Time,
 	isolationGroup string,
+	versionedTaskC <-chan *InternalTask,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task (pollOrForward)",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"VersionKey":        task.versionKey,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-isolatedTaskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -568,18 +625,40 @@ func (tm *taskMatcherImpl) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, startT, isolatedTaskC, taskC, queryTaskC)
+		return tm.poll(ctx, startT, versionedTaskC, isolatedTaskC, taskC, queryTaskC)
 	}
 }
 
 func (tm *taskMatcherImpl) poll(
 	ctx context.Context,
 	startT time.Time,
+	versionedTaskC <-chan *InternalTask,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.RecordTimer(metrics.PollLocalMatchAfterForwardFailedLatencyPerTaskList, time.Since(startT))
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task (poll)",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"VersionKey":        task.versionKey,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-isolatedTaskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -638,11 +717,32 @@ func (tm *taskMatcherImpl) poll(
 
 func (tm *taskMatcherImpl) pollLocalWait(
 	ctx context.Context,
+	versionedTaskC <-chan *InternalTask,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task Nonblocking",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"VersionKey":        task.versionKey,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-isolatedTaskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -698,6 +798,7 @@ func (tm *taskMatcherImpl) pollLocalWait(
 
 func (tm *taskMatcherImpl) pollNonBlocking(
 	ctx context.Context,
+	versionedTaskC <-chan *InternalTask,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
@@ -706,9 +807,29 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 	if waitTime > 0 {
 		childCtx, cancel := context.WithTimeout(ctx, waitTime)
 		defer cancel()
-		return tm.pollLocalWait(childCtx, isolatedTaskC, taskC, queryTaskC)
+		return tm.pollLocalWait(childCtx, versionedTaskC, isolatedTaskC, taskC, queryTaskC)
 	}
 	select {
+	case task := <-versionedTaskC:
+		if task.ResponseC != nil {
+			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+		event.Log(event.E{
+			TaskListName: tm.tasklist.GetName(),
+			TaskListType: tm.tasklist.GetType(),
+			TaskListKind: tm.tasklistKind.Ptr(),
+			TaskInfo:     task.Info(),
+			EventName:    "Matched Task Nonblocking",
+			Payload: map[string]any{
+				"TaskIsForwarded":   task.IsForwarded(),
+				"SyncMatched":       task.ResponseC != nil,
+				"FromIsolatedTaskC": false,
+				"VersionKey":        task.versionKey,
+				"IsolationGroup":    task.isolationGroup,
+			},
+		})
+		return task, nil
 	case task := <-isolatedTaskC:
 		if task.ResponseC != nil {
 			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
@@ -793,10 +914,99 @@ func (tm *taskMatcherImpl) isForwardingAllowed() bool {
 	return tm.fwdr != nil
 }
 
+// offerLocal blocks until the task is picked up by a local poller or ctx is done,
+// holding the task back while a task of higher priority is waiting for the same pollers.
+func (tm *taskMatcherImpl) offerLocal(ctx context.Context, task *InternalTask, priority int32) bool {
+	leave := tm.getPriorityGate(task).enter(priority)
+	defer leave()
+	for {
+		taskC, gateChangeC := tm.gatedTaskC(task, priority)
+		select {
+		case taskC <- task:
+			return true
+		case <-gateChangeC:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// gatedTaskC returns the channel the task can be sent to, or nil while a task of higher priority
+// is waiting on the same channel, along with a channel closed when that may have changed.
+func (tm *taskMatcherImpl) gatedTaskC(task *InternalTask, priority int32) (chan<- *InternalTask, <-chan struct{}) {
+	allowed, changeC := tm.getPriorityGate(task).check(priority)
+	if !allowed {
+		return nil, changeC
+	}
+	return tm.getTaskC(task), changeC
+}
+
+// taskPriority returns the priority a task competes for pollers with.
+// Backlog tasks have been waiting already, so they are aged the same way as in the task buffer.
+func (tm *taskMatcherImpl) taskPriority(task *InternalTask) int32 {
+	if task.Event == nil || task.Event.TaskInfo == nil {
+		return 0
+	}
+	info := task.Event.TaskInfo
+	if task.source == types.TaskSourceDbBacklog {
+		return effectivePriority(info.Priority, info.CreatedTime, time.Now(), tm.config.TaskPriorityAgingInterval())
+	}
+	return clampPriority(info.Priority)
+}
+
+func (tm *taskMatcherImpl) getPriorityGate(task *InternalTask) *priorityGate {
+	if task.versionKey != "" {
+		return tm.getVersioned(task.versionKey).gate
+	}
+	if gate, ok := tm.priorityGates[task.isolationGroup]; ok {
+		return gate
+	}
+	return tm.priorityGates[""]
+}
+
 func (tm *taskMatcherImpl) getTaskC(task *InternalTask) chan<- *InternalTask {
+	if task.versionKey != "" {
+		return tm.getVersioned(task.versionKey).taskC
+	}
 	taskC := tm.taskC
 	if isolatedTaskC, ok := tm.isolatedTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
 		taskC = isolatedTaskC
 	}
 	return taskC
 }
+
+// acquireVersion creates the task channel and the priority gate of the given version key on first use
+// and keeps them until the returned func is called by every poller and producer that acquired them.
+// An empty version key is not versioned and needs no release.
+func (tm *taskMatcherImpl) acquireVersion(versionKey string) func() {
+	if versionKey == "" {
+		return func() {}
+	}
+	tm.versionedLock.Lock()
+	defer tm.versionedLock.Unlock()
+	v, ok := tm.versioned[versionKey]
+	if !ok {
+		v = &versionedChannel{
+			taskC: make(chan *InternalTask),
+			gate:  newPriorityGate(),
+		}
+		tm.versioned[versionKey] = v
+	}
+	v.refs++
+	return func() {
+		tm.versionedLock.Lock()
+		defer tm.versionedLock.Unlock()
+		v.refs--
+		if v.refs == 0 {
+			delete(tm.versioned, versionKey)
+		}
+	}
+}
+
+// getVersioned returns the task channel and the priority gate of the given version key,
+// the caller must hold them with acquireVersion.
+func (tm *taskMatcherImpl) getVersioned(versionKey string) *versionedChannel {
+	tm.versionedLock.Lock()
+	defer tm.versionedLock.Unlock()
+	return tm.versioned[versionKey]
+}
@@ -102,7 +102,7 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -123,7 +123,7 @@ func (t *MatcherTestSuite) TestIsolationLocalSyncMatch() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, isolationGroup)
+		task, err := t.matcher.Poll(ctx, isolationGroup, "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -165,7 +165,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource types.TaskSource, isol
 			// so lets delay polling by a bit to verify that
 			time.Sleep(time.Millisecond * 10)
 		}
-		task, err := t.matcher.Poll(bgctx, isolationGroup)
+		task, err := t.matcher.Poll(bgctx, isolationGroup, "")
 		bgcancel()
 		if err == nil && !task.IsStarted() {
 			task.Finish(nil)
@@ -174,7 +174,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource types.TaskSource, isol
 
 	t.client.EXPECT().PollForDecisionTask(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(arg0 context.Context, arg1 *types.MatchingPollForDecisionTaskRequest, option ...yarpc.CallOption) (*types.MatchingPollForDecisionTaskResponse, error) {
-			task, err := t.rootMatcher.Poll(arg0, isolationGroup)
+			task, err := t.rootMatcher.Poll(arg0, isolationGroup, "")
 			if err != nil {
 				return nil, err
 			}
@@ -258,7 +258,7 @@ func (t *MatcherTestSuite) TestIsolationSyncMatchFailure() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, isolationGroup)
+		task, err := t.matcher.Poll(ctx, isolationGroup, "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -378,7 +378,7 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -397,7 +397,7 @@ func (t *MatcherTestSuite) TestIsolationMustOfferLocalMatch() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "dca1")
+		task, err := t.matcher.Poll(ctx, "dca1", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -411,6 +411,93 @@ func (t *MatcherTestSuite) TestIsolationMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestVersionedMustOfferLocalMatch() {
+	t.disableRemoteForwarding()
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ctx, "", "build-1")
+		if err == nil {
+			task.Finish(nil)
+		}
+	})
+
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task.versionKey = "build-1"
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	err := t.matcher.MustOffer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+}
+
+func (t *MatcherTestSuite) TestVersionedMustOfferNotMatchedByOtherBuildIDs() {
+	t.disableRemoteForwarding()
+
+	for _, versionKey := range []string{"build-2", ""} {
+		wait := ensureAsyncReady(300*time.Millisecond, func(ctx context.Context) {
+			task, err := t.matcher.Poll(ctx, "", versionKey)
+			t.ErrorIs(err, ErrNoTasks)
+			t.Nil(task)
+		})
+
+		task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+		task.versionKey = "build-1"
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		err := t.matcher.MustOffer(ctx, task)
+		cancel()
+		wait()
+		t.ErrorIs(err, context.DeadlineExceeded)
+	}
+}
+
+func (t *MatcherTestSuite) TestVersionedChannelsRemovedWhenUnused() {
+	t.disableRemoteForwarding()
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ctx, "", "build-1")
+		if err == nil {
+			task.Finish(nil)
+		}
+	})
+
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task.versionKey = "build-1"
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	err := t.matcher.MustOffer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+
+	task = newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	task.versionKey = "build-2"
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	err = t.matcher.MustOffer(ctx, task)
+	cancel()
+	t.ErrorIs(err, context.DeadlineExceeded)
+
+	t.matcher.versionedLock.Lock()
+	defer t.matcher.versionedLock.Unlock()
+	t.Empty(t.matcher.versioned)
+}
+
+func (t *MatcherTestSuite) TestVersionedPollMatchesUnversionedTask() {
+	t.disableRemoteForwarding()
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.Poll(ctx, "", "build-1")
+		if err == nil {
+			task.Finish(nil)
+		}
+	})
+
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false, nil, "")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	err := t.matcher.MustOffer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+}
+
 func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	pollSigC := make(chan struct{})
 	forwardPollSigC := make(chan struct{})
@@ -420,7 +507,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 			close(forwardPollSigC)
 			<-pollSigC
 			time.Sleep(time.Millisecond * 500) // delay poll to verify that offer blocks on parent
-			task, err := t.rootMatcher.Poll(arg0, "")
+			task, err := t.rootMatcher.Poll(arg0, "", "")
 			if err != nil {
 				return nil, err
 			}
@@ -460,7 +547,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	wait := ensureAsyncReady(time.Second*3, func(ctx context.Context) {
 		pollResultMu.Lock()
 		defer pollResultMu.Unlock()
-		polledTask, pollErr = t.matcher.Poll(ctx, "")
+		polledTask, pollErr = t.matcher.Poll(ctx, "", "")
 	})
 	<-forwardPollSigC
 
@@ -495,6 +582,41 @@ func (t *MatcherTestSuite) TestMustOfferRemoteRateLimit() {
 	}
 }
 
+func (t *MatcherTestSuite) TestMustOfferPriority() {
+	t.disableRemoteForwarding()
+
+	gate := t.matcher.priorityGates[""]
+	waiting := func(priority int32) bool {
+		gate.Lock()
+		defer gate.Unlock()
+		return gate.waiting[priority] > 0
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, priority := range []int32{0, 5} {
+		info := t.newTaskInfo()
+		info.Priority = priority
+		task := newInternalTask(info, nil, types.TaskSourceHistory, "", false, nil, "")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			t.NoError(t.matcher.MustOffer(ctx, task))
+		}()
+		t.True(awaitCondition(func() bool { return waiting(priority) }, time.Second))
+	}
+
+	// the higher priority task is matched first although it was offered last
+	for _, expected := range []int32{5, 0} {
+		task, err := t.matcher.Poll(ctx, "", "")
+		t.NoError(err)
+		t.Equal(expected, task.Event.Priority)
+	}
+	wg.Wait()
+}
+
 func (t *MatcherTestSuite) TestIsolationMustOfferRemoteMatch() {
 	pollSigC := make(chan struct{})
 	forwardPollSigC := make(chan struct{})
@@ -504,7 +626,7 @@ func (t *MatcherTestSuite) TestIsolationMustOfferRemoteMatch() {
 			close(forwardPollSigC)
 			<-pollSigC
 			time.Sleep(time.Millisecond * 500) // delay poll to verify that offer blocks on parent
-			task, err := t.rootMatcher.Poll(arg0, "dca1")
+			task, err := t.rootMatcher.Poll(arg0, "dca1", "")
 			if err != nil {
 				return nil, err
 			}
@@ -544,7 +666,7 @@ func (t *MatcherTestSuite) TestIsolationMustOfferRemoteMatch() {
 	wait := ensureAsyncReady(time.Second*3, func(ctx context.Context) {
 		pollResultMu.Lock()
 		defer pollResultMu.Unlock()
-		polledTask, pollErr = t.matcher.Poll(ctx, "dca1")
+		polledTask, pollErr = t.matcher.Poll(ctx, "dca1", "")
 	})
 	<-forwardPollSigC
 
@@ -571,7 +693,7 @@ func (t *MatcherTestSuite) TestPollersDisconnectedAfterDisconnectBlockedPollers(
 	longPollingCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	task, err := t.matcher.Poll(longPollingCtx, "")
+	task, err := t.matcher.Poll(longPollingCtx, "", "")
 	t.ErrorIs(err, ErrNoTasks, "closed matcher should result in no tasks")
 	t.Nil(task)
 	t.NoError(longPollingCtx.Err(), "the parent context was not cancelled, the child context was cancelled")
@@ -586,7 +708,7 @@ func (t *MatcherTestSuite) TestPollersConnectedAfterDisconnectBlockedPollersSetC
 	longPollingCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	task, err := t.matcher.Poll(longPollingCtx, "")
+	task, err := t.matcher.Poll(longPollingCtx, "", "")
 	t.ErrorIs(err, ErrNoTasks, "no tasks to be matched to poller")
 	t.Nil(task)
 	t.ErrorIs(longPollingCtx.Err(), context.DeadlineExceeded, "the child context wasn't cancelled, the parent context timed out")
@@ -609,7 +731,7 @@ func (t *MatcherTestSuite) TestRemotePoll() {
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	ready()
-	task, err := t.matcher.Poll(ctx, "")
+	task, err := t.matcher.Poll(ctx, "", "")
 	cancel()
 	wait()
 	t.NoError(err)
@@ -647,7 +769,7 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 func (t *MatcherTestSuite) TestIsolationPollFailure() {
 	t.disableRemoteForwarding()
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	task, err := t.matcher.Poll(ctx, "invalid-group")
+	task, err := t.matcher.Poll(ctx, "invalid-group", "")
 	cancel()
 	t.Error(err)
 	t.Nil(task)
@@ -698,7 +820,7 @@ func (t *MatcherTestSuite) TestOffer_NoTimeoutSyncMatchedNoError() {
 	t.matcher.config.LocalTaskWaitTime = func() time.Duration { return 0 }
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -723,7 +845,7 @@ func (t *MatcherTestSuite) TestOffer_NoTimeoutSyncMatchedError() {
 	t.matcher.config.LocalTaskWaitTime = func() time.Duration { return 0 }
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -749,7 +871,7 @@ func (t *MatcherTestSuite) TestOffer_NoTimeoutAsyncMatchedNoError() {
 	t.matcher.config.LocalTaskWaitTime = func() time.Duration { return 0 }
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -772,7 +894,7 @@ func (t *MatcherTestSuite) TestOffer_AsyncMatchedNoError() {
 	defer goleak.VerifyNone(t.T())
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -800,7 +922,7 @@ func (t *MatcherTestSuite) TestOfferOrTimeout_SyncMatchTimedOut() {
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
 		time.Sleep(time.Millisecond * 100)
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -821,7 +943,7 @@ func (t *MatcherTestSuite) TestOfferOrTimeout_AsyncMatchNotMatched() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -844,7 +966,7 @@ func (t *MatcherTestSuite) TestOfferOrTimeout_AsyncMatchMatched() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			task.Finish(nil)
 		}
@@ -960,7 +1082,7 @@ func (t *MatcherTestSuite) TestMustOffer_LocalMatchAfterChildCtxExpired() {
 		// Forces no forwarding and the context background will never expire, therefore guaranteeing that the poller
 		// will pick up the task after the first attempt.
 		time.Sleep(200 * time.Millisecond)
-		retTask, err := t.matcher.Poll(ctx, "")
+		retTask, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			retTask.Finish(nil)
 		}
@@ -994,7 +1116,7 @@ func (t *MatcherTestSuite) TestMustOffer_LocalMatchAfterForwardError() {
 
 	go func() {
 		<-forwardToken.ch
-		retTask, err := t.matcher.Poll(ctx, "")
+		retTask, err := t.matcher.Poll(ctx, "", "")
 		if err == nil {
 			retTask.Finish(nil)
 		}
@@ -1054,7 +1176,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollIsolatedTask() {
 	// Test pollOrForward for isolated task - poll
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, isolatedTaskC, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1077,7 +1199,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollTask() {
 	// Test pollOrForward for regular task - poll
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1097,7 +1219,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollQueryTask() {
 	// Test pollOrForward for query task - poll
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1122,7 +1244,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTask() {
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	mockForwarder.EXPECT().ForwardPoll(ctx).Return(task, nil).Times(1)
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1157,7 +1279,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTaskThenPoll() {
 		}
 	}()
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1171,7 +1293,7 @@ func (t *MatcherTestSuite) Test_poll_IsolatedTask() {
 
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.poll(ctx, startT, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, isolatedTaskC, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1184,7 +1306,7 @@ func (t *MatcherTestSuite) Test_poll_Task() {
 
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.poll(ctx, startT, nil, taskC, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1197,7 +1319,7 @@ func (t *MatcherTestSuite) Test_poll_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, queryTaskC)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1209,7 +1331,7 @@ func (t *MatcherTestSuite) Test_poll_ContextExpired() {
 
 	startT := time.Now()
 
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
@@ -1224,7 +1346,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_IsolatedTask() {
 
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, isolatedTaskC, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1238,7 +1360,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_Task() {
 
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, taskC, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, taskC, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1252,7 +1374,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1263,7 +1385,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_NoTasks() {
 
 	ctx := context.Background()
 
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
@@ -1643,7 +1765,7 @@ func (t *MatcherTestSuite) Test_LocalSyncMatch_AutoConfigHint() {
 	t.disableRemoteForwarding()
 
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
-		task, err := t.matcher.Poll(ctx, "")
+		task, err := t.matcher.Poll(ctx, "", "")
 		t.NotNil(task.AutoConfigHint)
 		t.Equal(false, task.AutoConfigHint.EnableAutoConfig) // disabled by default
 		if err == nil {
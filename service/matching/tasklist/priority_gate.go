@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+type (
+	// priorityGate orders the producers waiting to hand tasks over to the pollers of the same
+	// task channel: a producer may only send its task while no producer of a higher priority
+	// is waiting. Pollers are unaware of the gate, they keep receiving from the task channel.
+	priorityGate struct {
+		sync.Mutex
+		waiting [constants.MaxTaskPriority + 1]int
+		highest int32
+		// changeC is closed and replaced whenever the highest waiting priority changes
+		changeC chan struct{}
+	}
+)
+
+func newPriorityGate() *priorityGate {
+	return &priorityGate{changeC: make(chan struct{})}
+}
+
+// enter registers a producer waiting with the given priority.
+// The returned function unregisters it and must be called once the producer stops waiting.
+func (g *priorityGate) enter(priority int32) (leave func()) {
+	p := clampPriority(priority)
+	g.Lock()
+	g.waiting[p]++
+	g.updateHighestLocked()
+	g.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			g.Lock()
+			g.waiting[p]--
+			g.updateHighestLocked()
+			g.Unlock()
+		})
+	}
+}
+
+// check returns whether a producer with the given priority may send now,
+// and a channel which is closed the next time the answer may change.
+func (g *priorityGate) check(priority int32) (bool, <-chan struct{}) {
+	g.Lock()
+	defer g.Unlock()
+	return clampPriority(priority) >= g.highest, g.changeC
+}
+
+func (g *priorityGate) updateHighestLocked() {
+	// the lowest priority is always allowed, so it is the highest one when nobody waits
+	highest := int32(0)
+	for p := len(g.waiting) - 1; p > 0; p-- {
+		if g.waiting[p] > 0 {
+			highest = int32(p)
+			break
+		}
+	}
+	if highest != g.highest {
+		g.highest = highest
+		close(g.changeC)
+		g.changeC = make(chan struct{})
+	}
+}
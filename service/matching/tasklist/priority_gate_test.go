@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityGate(t *testing.T) {
+	gate := newPriorityGate()
+
+	allowed, changeC := gate.check(0)
+	assert.True(t, allowed)
+
+	leaveLow := gate.enter(0)
+	assertNotClosed(t, changeC)
+
+	leaveHigh := gate.enter(5)
+	assertClosed(t, changeC)
+
+	allowed, changeC = gate.check(0)
+	assert.False(t, allowed)
+	allowed, _ = gate.check(5)
+	assert.True(t, allowed)
+	allowed, _ = gate.check(7)
+	assert.True(t, allowed)
+
+	leaveHigh()
+	assertClosed(t, changeC)
+	// leaving twice has no effect
+	leaveHigh()
+
+	allowed, changeC = gate.check(0)
+	assert.True(t, allowed)
+	leaveLow()
+	assertNotClosed(t, changeC)
+}
+
+func assertClosed(t *testing.T, c <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-c:
+	default:
+		t.Fatal("expected channel to be closed")
+	}
+}
+
+func assertNotClosed(t *testing.T, c <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-c:
+		t.Fatal("expected channel not to be closed")
+	default:
+	}
+}
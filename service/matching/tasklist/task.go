@@ -21,6 +21,8 @@
 package tasklist
 
 import (
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -55,10 +57,12 @@ type (
 		source                   types.TaskSource
 		forwardedFrom            string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		isolationGroup           string     // isolation group of this task (empty if it can be polled by workers from any isolation group)
+		versionKey               string     // key of the compatible build IDs of this task (empty if it can be polled by workers of any build ID)
 		ResponseC                chan error // non-nil only where there is a caller waiting for response (sync-match)
 		BacklogCountHint         int64
 		ActivityTaskDispatchInfo *types.ActivityTaskDispatchInfo
 		AutoConfigHint           *types.AutoConfigHint // worker auto-scaler hint, which includes enable auto config flag and poller wait time on the matching engine
+		spanContext              trace.SpanContext     // span of the AddTask call, set only for sync-matched tasks
 	}
 )
 
@@ -89,7 +93,7 @@ func newInternalTask(
 	// OriginalIsolationGroup is populated here and isn't written to the DB. If it's already
 	// present then it's a forwarded task and we should respect it.
 	if configIsolationGroup, ok := task.Event.PartitionConfig[isolationgroup.GroupKey]; ok {
-		partitionConfig := make(map[string]string, 3)
+		partitionConfig := make(map[string]string, 4)
 		if originalIsolationGroup, ok := task.Event.PartitionConfig[isolationgroup.OriginalGroupKey]; ok {
 			partitionConfig[isolationgroup.OriginalGroupKey] = originalIsolationGroup
 		} else {
@@ -0,0 +1,227 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+type (
+	// taskBuffer is the bounded in-memory queue of backlog tasks waiting to be dispatched.
+	// Tasks are kept in one queue per priority level, and Get returns the next task of the
+	// level with the highest effective priority, see effectivePriority. Within a level tasks
+	// are FIFO, or fairly dispatched across fairness keys if fair dispatch is enabled.
+	taskBuffer struct {
+		sync.Mutex
+		levels     [constants.MaxTaskPriority + 1]*fairQueue
+		size       int
+		capacity   int
+		config     *config.TaskListConfig
+		timeSource clock.TimeSource
+
+		// readyC and spaceC are signaled when a task is added and removed respectively.
+		// Both have a capacity of one so that a signal is never lost while nobody is waiting.
+		readyC chan struct{}
+		spaceC chan struct{}
+	}
+)
+
+func newTaskBuffer(capacity int, config *config.TaskListConfig, timeSource clock.TimeSource) *taskBuffer {
+	b := &taskBuffer{
+		capacity:   max(capacity, 1),
+		config:     config,
+		timeSource: timeSource,
+		readyC:     make(chan struct{}, 1),
+		spaceC:     make(chan struct{}, 1),
+	}
+	for p := range b.levels {
+		b.levels[p] = newFairQueue(config.FairnessKeyWeights)
+	}
+	return b
+}
+
+// Put adds a task to the buffer, blocking while the buffer is full.
+// It returns an error only if ctx is done before the task could be added.
+func (b *taskBuffer) Put(ctx context.Context, task *persistence.TaskInfo) error {
+	for !b.TryPut(task) {
+		select {
+		case <-b.spaceC:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// TryPut adds a task to the buffer without waiting for space. It returns false if the buffer is full.
+func (b *taskBuffer) TryPut(task *persistence.TaskInfo) bool {
+	b.Lock()
+	if b.size >= b.capacity {
+		b.Unlock()
+		return false
+	}
+	b.levels[clampPriority(task.Priority)].push(b.fairnessKey(task), task)
+	b.size++
+	hasSpace := b.size < b.capacity
+	b.Unlock()
+	signal(b.readyC)
+	if hasSpace {
+		signal(b.spaceC)
+	}
+	return true
+}
+
+// PutBack adds a task which could not be dispatched back to the buffer without waiting for space,
+// so that it is dispatched again after the other buffered tasks of its priority.
+func (b *taskBuffer) PutBack(task *persistence.TaskInfo) {
+	b.Lock()
+	b.levels[clampPriority(task.Priority)].push(b.fairnessKey(task), task)
+	b.size++
+	b.Unlock()
+	signal(b.readyC)
+}
+
+// Get removes and returns the next task to dispatch, blocking while the buffer is empty.
+// It returns an error only if ctx is done before a task is available.
+func (b *taskBuffer) Get(ctx context.Context) (*persistence.TaskInfo, error) {
+	for {
+		b.Lock()
+		if b.size > 0 {
+			task := b.popLocked()
+			hasMore := b.size > 0
+			b.Unlock()
+			signal(b.spaceC)
+			if hasMore {
+				signal(b.readyC)
+			}
+			return task, nil
+		}
+		b.Unlock()
+
+		select {
+		case <-b.readyC:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// RemoveIf removes and returns the buffered tasks for which the predicate is true.
+func (b *taskBuffer) RemoveIf(pred func(*persistence.TaskInfo) bool) []*persistence.TaskInfo {
+	b.Lock()
+	var removed []*persistence.TaskInfo
+	for _, level := range b.levels {
+		removed = append(removed, level.removeIf(pred)...)
+	}
+	b.size -= len(removed)
+	b.Unlock()
+	if len(removed) > 0 {
+		signal(b.spaceC)
+	}
+	return removed
+}
+
+// Len returns the number of buffered tasks.
+func (b *taskBuffer) Len() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.size
+}
+
+// Cap returns the maximum number of buffered tasks.
+func (b *taskBuffer) Cap() int {
+	return b.capacity
+}
+
+// CountByPriority returns the number of buffered tasks of each priority which has any.
+func (b *taskBuffer) CountByPriority() map[int32]int64 {
+	b.Lock()
+	defer b.Unlock()
+	counts := make(map[int32]int64)
+	for p, level := range b.levels {
+		if level.len() > 0 {
+			counts[int32(p)] = int64(level.len())
+		}
+	}
+	return counts
+}
+
+func (b *taskBuffer) popLocked() *persistence.TaskInfo {
+	now := b.timeSource.Now()
+	agingInterval := b.config.TaskPriorityAgingInterval()
+	next, nextPriority := -1, int32(-1)
+	// levels are visited from the highest priority down, so ties go to the higher base priority
+	for p := len(b.levels) - 1; p >= 0; p-- {
+		head := b.levels[p].peek()
+		if head == nil {
+			continue
+		}
+		if priority := effectivePriority(int32(p), head.CreatedTime, now, agingInterval); priority > nextPriority {
+			next, nextPriority = p, priority
+		}
+	}
+	b.size--
+	return b.levels[next].pop()
+}
+
+// fairnessKey returns the key the task is queued by within its priority level.
+// All tasks share the same key, making the level a FIFO queue, unless fair dispatch is enabled.
+func (b *taskBuffer) fairnessKey(task *persistence.TaskInfo) string {
+	if !b.config.EnableFairDispatch() {
+		return ""
+	}
+	return fairnessKey(task)
+}
+
+// effectivePriority returns the priority a task is dispatched with. A task gains one priority level
+// for every agingInterval it has been waiting since it was created, up to constants.MaxTaskPriority,
+// so that low priority tasks are eventually dispatched even when higher priority tasks keep coming.
+func effectivePriority(priority int32, createdTime time.Time, now time.Time, agingInterval time.Duration) int32 {
+	priority = clampPriority(priority)
+	if agingInterval <= 0 || createdTime.IsZero() || !now.After(createdTime) {
+		return priority
+	}
+	boost := int64(now.Sub(createdTime) / agingInterval)
+	if boost >= int64(constants.MaxTaskPriority-priority) {
+		return constants.MaxTaskPriority
+	}
+	return priority + int32(boost)
+}
+
+// clampPriority maps priorities outside of the valid range, which may come from old or
+// unvalidated tasks, to the closest valid priority.
+func clampPriority(priority int32) int32 {
+	return min(max(priority, 0), constants.MaxTaskPriority)
+}
+
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
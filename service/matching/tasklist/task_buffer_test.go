@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+func TestTaskBuffer_OrderedByPriority(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	buffer := newTaskBuffer(10, newTestTaskBufferConfig(0, false, nil), timeSource)
+	ctx := context.Background()
+
+	for i, priority := range []int32{0, 5, 0, 10, 5} {
+		require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: int64(i), Priority: priority}))
+	}
+	assert.Equal(t, 5, buffer.Len())
+	assert.Equal(t, map[int32]int64{0: 2, 5: 2, 10: 1}, buffer.CountByPriority())
+
+	var taskIDs []int64
+	for i := 0; i < 5; i++ {
+		task, err := buffer.Get(ctx)
+		require.NoError(t, err)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	// FIFO within a priority level
+	assert.Equal(t, []int64{3, 1, 4, 0, 2}, taskIDs)
+	assert.Empty(t, buffer.CountByPriority())
+}
+
+func TestTaskBuffer_Aging(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	buffer := newTaskBuffer(10, newTestTaskBufferConfig(time.Minute, false, nil), timeSource)
+	ctx := context.Background()
+
+	now := timeSource.Now()
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 1, Priority: 0, CreatedTime: now.Add(-3 * time.Minute)}))
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 2, Priority: 2, CreatedTime: now}))
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 3, Priority: 4, CreatedTime: now}))
+
+	// the first task aged up to priority 3, above the second task but still below the third one
+	for _, expected := range []int64{3, 1, 2} {
+		task, err := buffer.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expected, task.TaskID)
+	}
+}
+
+func TestTaskBuffer_AgingTieGoesToHigherPriority(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	buffer := newTaskBuffer(10, newTestTaskBufferConfig(time.Minute, false, nil), timeSource)
+	ctx := context.Background()
+
+	now := timeSource.Now()
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 1, Priority: 1, CreatedTime: now.Add(-time.Minute)}))
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 2, Priority: 2, CreatedTime: now}))
+
+	task, err := buffer.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), task.TaskID)
+}
+
+func TestTaskBuffer_FairDispatch(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	buffer := newTaskBuffer(10, newTestTaskBufferConfig(0, true, map[string]interface{}{"tenant": 3}), timeSource)
+	ctx := context.Background()
+
+	// a tenant floods the task list before another workflow adds its tasks
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{
+			TaskID:      int64(i),
+			WorkflowID:  "wf-tenant",
+			FairnessKey: "tenant",
+		}))
+	}
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 5, WorkflowID: "wf"}))
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 6, WorkflowID: "wf"}))
+	// a higher priority level is still dispatched first
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 7, WorkflowID: "wf", Priority: 1}))
+
+	var taskIDs []int64
+	for i := 0; i < 7; i++ {
+		task, err := buffer.Get(ctx)
+		require.NoError(t, err)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	// the tenant gets three tasks dispatched for every task of the other workflow
+	assert.Equal(t, []int64{7, 1, 2, 3, 5, 4, 6}, taskIDs)
+}
+
+func TestTaskBuffer_RemoveIf(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	buffer := newTaskBuffer(10, newTestTaskBufferConfig(0, true, nil), timeSource)
+	ctx := context.Background()
+
+	for i, workflowID := range []string{"a", "b", "a", "c", "a"} {
+		require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: int64(i), WorkflowID: workflowID}))
+	}
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 5, WorkflowID: "b", Priority: 1}))
+	// peek the next task of the lower priority level before removing tasks from it
+	task, err := buffer.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), task.TaskID)
+
+	removed := buffer.RemoveIf(func(task *persistence.TaskInfo) bool { return task.WorkflowID != "c" })
+	assert.ElementsMatch(t, []int64{0, 1, 2, 4}, toTaskIDs(removed))
+	assert.Equal(t, 1, buffer.Len())
+	assert.Empty(t, buffer.RemoveIf(func(task *persistence.TaskInfo) bool { return task.WorkflowID != "c" }))
+
+	task, err = buffer.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), task.TaskID)
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func TestTaskBuffer_Blocking(t *testing.T) {
+	buffer := newTaskBuffer(1, newTestTaskBufferConfig(0, false, nil), clock.NewMockedTimeSource())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	_, err := buffer.Get(ctx)
+	cancel()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, buffer.Put(context.Background(), &persistence.TaskInfo{TaskID: 1}))
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	err = buffer.Put(ctx, &persistence.TaskInfo{TaskID: 2})
+	cancel()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	putDone := make(chan error)
+	go func() {
+		putDone <- buffer.Put(context.Background(), &persistence.TaskInfo{TaskID: 3})
+	}()
+	task, err := buffer.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), task.TaskID)
+	require.NoError(t, <-putDone)
+
+	task, err = buffer.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), task.TaskID)
+}
+
+func TestTaskBuffer_PutBack(t *testing.T) {
+	buffer := newTaskBuffer(2, newTestTaskBufferConfig(0, false, nil), clock.NewMockedTimeSource())
+	ctx := context.Background()
+
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 1}))
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 2}))
+	task, err := buffer.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, buffer.Put(ctx, &persistence.TaskInfo{TaskID: 3}))
+
+	// the task is put back even though the buffer is full, behind the tasks of the same priority
+	buffer.PutBack(task)
+	assert.Equal(t, 3, buffer.Len())
+	var taskIDs []int64
+	for i := 0; i < 3; i++ {
+		task, err := buffer.Get(ctx)
+		require.NoError(t, err)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	assert.Equal(t, []int64{2, 3, 1}, taskIDs)
+}
+
+func TestEffectivePriority(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tests := []struct {
+		name          string
+		priority      int32
+		createdTime   time.Time
+		agingInterval time.Duration
+		expected      int32
+	}{
+		{"no aging", 2, now.Add(-time.Hour), 0, 2},
+		{"unknown created time", 2, time.Time{}, time.Minute, 2},
+		{"created in the future", 2, now.Add(time.Minute), time.Minute, 2},
+		{"partially aged", 2, now.Add(-150 * time.Second), time.Minute, 4},
+		{"capped", 2, now.Add(-time.Hour), time.Minute, constants.MaxTaskPriority},
+		{"negative priority", -3, now, time.Minute, 0},
+		{"priority above max", constants.MaxTaskPriority + 5, now, time.Minute, constants.MaxTaskPriority},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, effectivePriority(tc.priority, tc.createdTime, now, tc.agingInterval))
+		})
+	}
+}
+
+func newTestTaskBufferConfig(agingInterval time.Duration, enableFairDispatch bool, fairnessKeyWeights map[string]interface{}) *config.TaskListConfig {
+	return &config.TaskListConfig{
+		TaskPriorityAgingInterval: func() time.Duration { return agingInterval },
+		EnableFairDispatch:        func() bool { return enableFairDispatch },
+		FairnessKeyWeights:        func() map[string]interface{} { return fairnessKeyWeights },
+	}
+}
+
+func toTaskIDs(tasks []*persistence.TaskInfo) []int64 {
+	ids := make([]int64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.TaskID)
+	}
+	return ids
+}
@@ -25,6 +25,7 @@ package tasklist
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"reflect"
@@ -33,6 +34,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/uber/cadence/client/history"
@@ -87,6 +89,7 @@ type (
 	pollerIDCtxKey       struct{}
 	identityCtxKey       struct{}
 	isolationGroupCtxKey struct{}
+	buildIDCtxKey        struct{}
 
 	ManagerParams struct {
 		DomainCache     cache.DomainCache
@@ -152,6 +155,10 @@ type (
 		partitionConfig     *types.TaskListPartitionConfig
 		historyService      history.Client
 		taskCompleter       TaskCompleter
+
+		// sets of compatible worker build IDs of a decision task list, the last one is the default set
+		buildIDSetsLock sync.RWMutex
+		buildIDSets     [][]string
 	}
 )
 
@@ -282,6 +289,7 @@ func (c *taskListManagerImpl) Start(ctx context.Context) error {
 			}
 		} else {
 			c.partitionConfig = info.AdaptivePartitionConfig.ToInternalType()
+			c.buildIDSets = info.CompatibleBuildIDSets
 		}
 	}
 	if err := c.taskWriter.Start(); err != nil {
@@ -290,6 +298,7 @@ func (c *taskListManagerImpl) Start(ctx context.Context) error {
 	}
 	if c.taskListID.IsRoot() && c.taskListKind == types.TaskListKindNormal {
 		c.partitionConfig = c.db.PartitionConfig().ToInternalType()
+		c.buildIDSets = c.db.CompatibleBuildIDSets()
 		c.logger.Debug("get task list partition config from db", tag.Dynamic("root-partition", c.taskListID.GetRoot()), tag.Dynamic("task-list-partition-config", c.partitionConfig))
 		if c.partitionConfig != nil {
 			startConfig := c.partitionConfig
@@ -428,6 +437,9 @@ func (c *taskListManagerImpl) RefreshTaskListPartitionConfig(ctx context.Context
 		c.partitionConfigLock.Lock()
 		c.partitionConfig = config
 		c.partitionConfigLock.Unlock()
+		c.buildIDSetsLock.Lock()
+		c.buildIDSets = info.CompatibleBuildIDSets
+		c.buildIDSetsLock.Unlock()
 		return nil
 	}
 	c.partitionConfigLock.Lock()
@@ -487,10 +499,6 @@ func (c *taskListManagerImpl) updatePartitionConfig(ctx context.Context, newConf
 }
 
 func (c *taskListManagerImpl) notifyPartitionConfig(ctx context.Context, oldConfig, newConfig *types.TaskListPartitionConfig) {
-	taskListType := types.TaskListTypeDecision.Ptr()
-	if c.taskListID.GetType() == persistence.TaskListTypeActivity {
-		taskListType = types.TaskListTypeActivity.Ptr()
-	}
 	toNotify := make(map[int]any)
 	if oldConfig != nil {
 		for id := range oldConfig.ReadPartitions {
@@ -504,6 +512,16 @@ func (c *taskListManagerImpl) notifyPartitionConfig(ctx context.Context, oldConf
 			toNotify[id] = true
 		}
 	}
+	c.notifyPartitions(ctx, toNotify, newConfig)
+}
+
+// notifyPartitions pushes the partition config to the given non-root partitions,
+// a nil config makes them reload the partition config and the compatible build IDs from the database
+func (c *taskListManagerImpl) notifyPartitions(ctx context.Context, toNotify map[int]any, newConfig *types.TaskListPartitionConfig) {
+	taskListType := types.TaskListTypeDecision.Ptr()
+	if c.taskListID.GetType() == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity.Ptr()
+	}
 	g := &errgroup.Group{}
 	for p := range toNotify {
 		taskListName := c.taskListID.GetPartition(p)
@@ -528,6 +546,59 @@ func (c *taskListManagerImpl) notifyPartitionConfig(ctx context.Context, oldConf
 	}
 }
 
+// CompatibleBuildIDSets returns the sets of compatible worker build IDs of the task list, the last one is the default set
+func (c *taskListManagerImpl) CompatibleBuildIDSets() [][]string {
+	c.buildIDSetsLock.RLock()
+	defer c.buildIDSetsLock.RUnlock()
+	return c.buildIDSets
+}
+
+// UpdateBuildIDCompatibility updates the sets of compatible worker build IDs of the task list. It is called on the root partition,
+// which persists the sets in the database and notifies all non-root partitions to reload them.
+func (c *taskListManagerImpl) UpdateBuildIDCompatibility(ctx context.Context, buildID string, compatibleBuildID string) ([][]string, error) {
+	c.startWG.Wait()
+	if !c.taskListID.IsRoot() || c.taskListKind != types.TaskListKindNormal || c.taskListID.GetType() != persistence.TaskListTypeDecision {
+		return nil, &types.BadRequestError{Message: "Build ID compatibility can only be updated on the root partition of a normal decision task list."}
+	}
+	sets, updated, err := c.updateBuildIDSets(ctx, buildID, compatibleBuildID)
+	if err != nil {
+		return nil, err
+	}
+	if partitionConfig := c.TaskListPartitionConfig(); updated && partitionConfig != nil {
+		toNotify := make(map[int]any)
+		for id := range partitionConfig.ReadPartitions {
+			if id != 0 {
+				toNotify[id] = true
+			}
+		}
+		c.notifyPartitions(ctx, toNotify, nil)
+	}
+	return sets, nil
+}
+
+func (c *taskListManagerImpl) updateBuildIDSets(ctx context.Context, buildID string, compatibleBuildID string) ([][]string, bool, error) {
+	c.buildIDSetsLock.Lock()
+	defer c.buildIDSetsLock.Unlock()
+	newSets, err := updateCompatibleBuildIDSets(c.buildIDSets, buildID, compatibleBuildID, c.config.MaxCompatibleBuildIDSets())
+	if err != nil {
+		return nil, false, err
+	}
+	if reflect.DeepEqual(c.buildIDSets, newSets) {
+		return c.buildIDSets, false, nil
+	}
+	err = c.throttleRetry.Do(ctx, func(ctx context.Context) error {
+		return c.db.UpdateCompatibleBuildIDSets(newSets)
+	})
+	if err != nil {
+		// We're not sure whether the update was persisted or not,
+		// Stop the tasklist manager and let it be reloaded
+		c.Stop()
+		return nil, false, err
+	}
+	c.buildIDSets = newSets
+	return newSets, true, nil
+}
+
 // AddTask adds a task to the task list. This method will first attempt a synchronous
 // match with a poller. When there are no pollers or if rate limit is exceeded, task will
 // be written to database and later asynchronously matched with a poller.
@@ -688,6 +759,10 @@ func (c *taskListManagerImpl) GetTask(
 	}
 	task.domainName = c.domainName
 	task.BacklogCountHint = c.taskAckManager.GetBacklogCount()
+	if task.spanContext.IsValid() {
+		// the poll and the AddTask call belong to different traces, so link the producer to the poll
+		trace.SpanFromContext(ctx).AddLink(trace.Link{SpanContext: task.spanContext})
+	}
 	return task, nil
 }
 
@@ -703,6 +778,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	isolationGroup := IsolationGroupFromContext(ctx)
 	pollerID := PollerIDFromContext(ctx)
 	identity := IdentityFromContext(ctx)
+	buildID := BuildIDFromContext(ctx)
 	rps := -1.0
 	rpsOverride := c.config.OverrideTaskListRPS()
 	if rpsOverride > 0 {
@@ -719,6 +795,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		Identity:       identity,
 		IsolationGroup: isolationGroup,
 		RatePerSecond:  rps,
+		BuildID:        buildID,
 	})
 	defer c.pollers.EndPoll(pollerID)
 
@@ -731,10 +808,11 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		return c.matcher.PollForQuery(childCtx)
 	}
 
+	versionKey := c.getVersionKeyForPoller(buildID)
 	if c.isIsolationMatcherEnabled() {
-		return c.matcher.Poll(childCtx, isolationGroup)
+		return c.matcher.Poll(childCtx, isolationGroup, versionKey)
 	}
-	return c.matcher.Poll(childCtx, "")
+	return c.matcher.Poll(childCtx, "", versionKey)
 }
 
 // GetAllPollerInfo returns all pollers that polled from this tasklist in last few minutes
@@ -791,11 +869,107 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 		IsolationGroupMetrics: isolationGroupMetrics,
 		NewTasksPerSecond:     c.qpsTracker.QPS(),
 		Empty:                 c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		// the tasks which are not read from the backlog yet are not counted, like for BacklogCountHint
+		BacklogCountByPriority: c.taskReader.backlogCountByPriority(),
 	}
 
 	return response
 }
 
+// ListTasks returns a page of the tasks persisted in the backlog of the task list, in the order of their task IDs
+func (c *taskListManagerImpl) ListTasks(ctx context.Context, request *types.ListTaskListTasksRequest) (*types.ListTaskListTasksResponse, error) {
+	c.startWG.Wait()
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		return nil, &types.BadRequestError{Message: "PageSize must be larger than 0."}
+	}
+	readLevel := c.taskAckManager.GetAckLevel()
+	if len(request.GetNextPageToken()) > 0 {
+		taskID, err := deserializeTaskIDPageToken(request.GetNextPageToken())
+		if err != nil {
+			return nil, &types.BadRequestError{Message: "Invalid NextPageToken."}
+		}
+		readLevel = max(readLevel, taskID)
+	}
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	if readLevel >= maxReadLevel {
+		return &types.ListTaskListTasksResponse{}, nil
+	}
+
+	tasks, err := c.getTasks(ctx, readLevel, maxReadLevel, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	response := &types.ListTaskListTasksResponse{
+		Tasks: make([]*types.TaskListTask, 0, len(tasks)),
+	}
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, toTaskListTask(task))
+	}
+	if len(tasks) >= pageSize {
+		response.NextPageToken = serializeTaskIDPageToken(tasks[len(tasks)-1].TaskID)
+	}
+	return response, nil
+}
+
+// DeleteTasks deletes the tasks in the backlog of the task list which match all the filters of the request,
+// and returns the number of deleted tasks. Matching tasks which were already read into memory are removed
+// from the buffers and acked, so that the ack level and the garbage collection of the task list keep moving.
+// A task which is being dispatched to a poller at the time is not deleted.
+func (c *taskListManagerImpl) DeleteTasks(ctx context.Context, request *types.DeleteTaskListTasksRequest) (int64, error) {
+	c.startWG.Wait()
+	readLevel := c.taskAckManager.GetAckLevel()
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	batchSize := max(c.config.GetTasksBatchSize(), 1)
+
+	deleted := make(map[int64]struct{})
+	for readLevel < maxReadLevel {
+		tasks, err := c.getTasks(ctx, readLevel, maxReadLevel, batchSize)
+		if err != nil {
+			return int64(len(deleted)), err
+		}
+		if len(tasks) == 0 {
+			break
+		}
+		for _, task := range tasks {
+			if !matchesDeleteTasksRequest(request, task) {
+				continue
+			}
+			err := c.throttleRetry.Do(ctx, func(ctx context.Context) error {
+				return c.db.CompleteTask(task.TaskID)
+			})
+			if err != nil {
+				return int64(len(deleted)), err
+			}
+			deleted[task.TaskID] = struct{}{}
+		}
+		readLevel = tasks[len(tasks)-1].TaskID
+	}
+
+	// the buffers are cleaned up after the tasks are deleted from the database,
+	// so that tasks read into memory while the backlog was being scanned are removed too
+	removed := c.taskReader.removeBufferedTasks(func(task *persistence.TaskInfo) bool {
+		_, ok := deleted[task.TaskID]
+		return ok
+	})
+	c.logger.Info("Deleted tasks from task list backlog",
+		tag.Counter(len(deleted)),
+		tag.Dynamic("buffered-tasks-removed", removed))
+	return int64(len(deleted)), nil
+}
+
+func (c *taskListManagerImpl) getTasks(ctx context.Context, readLevel int64, maxReadLevel int64, batchSize int) ([]*persistence.TaskInfo, error) {
+	var response *persistence.GetTasksResponse
+	err := c.throttleRetry.Do(ctx, func(ctx context.Context) (err error) {
+		response, err = c.db.GetTasks(readLevel, maxReadLevel, batchSize)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tasks, nil
+}
+
 func (c *taskListManagerImpl) ReleaseBlockedPollers() error {
 	c.stoppedLock.RLock()
 	defer c.stoppedLock.RUnlock()
@@ -838,6 +1012,8 @@ func (c *taskListManagerImpl) TaskListID() *Identifier {
 
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params AddTaskParams, isolationGroup string) (bool, error) {
 	task := newInternalTask(params.TaskInfo, nil, params.Source, params.ForwardedFrom, true, params.ActivityTaskDispatchInfo, isolationGroup)
+	task.versionKey = c.getVersionKeyForTask(params.TaskInfo)
+	task.spanContext = trace.SpanContextFromContext(ctx)
 	childCtx := ctx
 	cancel := func() {}
 	waitTime := maxSyncMatchWaitTime
@@ -895,6 +1071,39 @@ func (c *taskListManagerImpl) isIsolationMatcherEnabled() bool {
 	return c.taskListKind != types.TaskListKindSticky && c.enableIsolation
 }
 
+// isVersioningEnabled returns whether the tasks are routed by the worker build IDs, which only applies to decision tasks
+// since they must be processed by a worker compatible with the one which started the workflow
+func (c *taskListManagerImpl) isVersioningEnabled() bool {
+	return c.taskListKind != types.TaskListKindSticky && c.taskListID.GetType() == persistence.TaskListTypeDecision
+}
+
+// getVersionKeyForTask returns the version key of the pollers the task can be dispatched to. The task of a workflow
+// pinned to a build ID goes to the pollers of compatible build IDs, any other task goes to the pollers of the default
+// set once the task list has compatible build IDs, or to any poller otherwise.
+func (c *taskListManagerImpl) getVersionKeyForTask(taskInfo *persistence.TaskInfo) string {
+	if !c.isVersioningEnabled() {
+		return ""
+	}
+	c.buildIDSetsLock.RLock()
+	defer c.buildIDSetsLock.RUnlock()
+	if buildID := taskInfo.WorkerBuildID; buildID != "" {
+		return versionKey(c.buildIDSets, buildID)
+	}
+	if len(c.buildIDSets) == 0 {
+		return ""
+	}
+	return c.buildIDSets[len(c.buildIDSets)-1][0]
+}
+
+func (c *taskListManagerImpl) getVersionKeyForPoller(buildID string) string {
+	if !c.isVersioningEnabled() || buildID == "" {
+		return ""
+	}
+	c.buildIDSetsLock.RLock()
+	defer c.buildIDSetsLock.RUnlock()
+	return versionKey(c.buildIDSets, buildID)
+}
+
 func (c *taskListManagerImpl) shouldReload() bool {
 	return c.config.EnableTasklistIsolation() != c.enableIsolation
 }
@@ -1026,6 +1235,115 @@ func validatePartitionConfig(config *types.TaskListPartitionConfig) error {
 	return nil
 }
 
+// versionKey returns the key which tasks and pollers of the build ID are matched by. It is the first build ID of
+// the set containing the build ID, which never changes since build IDs are only appended to a set, or the build ID
+// itself when it isn't compatible with any other.
+func versionKey(sets [][]string, buildID string) string {
+	if i := findBuildIDSet(sets, buildID); i >= 0 {
+		return sets[i][0]
+	}
+	return buildID
+}
+
+func findBuildIDSet(sets [][]string, buildID string) int {
+	return slices.IndexFunc(sets, func(set []string) bool {
+		return slices.Contains(set, buildID)
+	})
+}
+
+// updateCompatibleBuildIDSets returns the sets of compatible build IDs with the update applied, leaving the given sets as is.
+// Without compatibleBuildID, the set of the build ID, or a new set if it has none, becomes the default set and the
+// oldest sets above maxSets are dropped. Otherwise the build ID is added to the set of compatibleBuildID.
+func updateCompatibleBuildIDSets(sets [][]string, buildID string, compatibleBuildID string, maxSets int) ([][]string, error) {
+	current := findBuildIDSet(sets, buildID)
+	result := make([][]string, 0, len(sets)+1)
+	if compatibleBuildID == "" {
+		for i, set := range sets {
+			if i != current {
+				result = append(result, set)
+			}
+		}
+		if current >= 0 {
+			result = append(result, sets[current])
+		} else {
+			result = append(result, []string{buildID})
+		}
+		if maxSets > 0 && len(result) > maxSets {
+			result = result[len(result)-maxSets:]
+		}
+		return result, nil
+	}
+
+	target := findBuildIDSet(sets, compatibleBuildID)
+	if target < 0 {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Compatible build ID %v is not found.", compatibleBuildID)}
+	}
+	if current == target {
+		return sets, nil
+	}
+	if current >= 0 {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v is already compatible with other build IDs.", buildID)}
+	}
+	for i, set := range sets {
+		if i == target {
+			set = append(slices.Clone(set), buildID)
+		}
+		result = append(result, set)
+	}
+	return result, nil
+}
+
+func toTaskListTask(task *persistence.TaskInfo) *types.TaskListTask {
+	// a task which failed to sync match is persisted with the isolation group it was offered to,
+	// the isolation group of the workflow is kept as the original one
+	isolationGroup, ok := task.PartitionConfig[isolationgroup.OriginalGroupKey]
+	if !ok {
+		isolationGroup = task.PartitionConfig[isolationgroup.GroupKey]
+	}
+	result := &types.TaskListTask{
+		WorkflowID:     task.WorkflowID,
+		RunID:          task.RunID,
+		TaskID:         task.TaskID,
+		ScheduleID:     task.ScheduleID,
+		IsolationGroup: isolationGroup,
+		Priority:       task.Priority,
+	}
+	if !task.CreatedTime.IsZero() {
+		result.CreatedTime = common.Int64Ptr(task.CreatedTime.UnixNano())
+	}
+	if !task.Expiry.IsZero() && task.Expiry.After(epochStartTime) {
+		result.ExpiryTime = common.Int64Ptr(task.Expiry.UnixNano())
+	}
+	return result
+}
+
+func matchesDeleteTasksRequest(request *types.DeleteTaskListTasksRequest, task *persistence.TaskInfo) bool {
+	if request.GetWorkflowID() != "" && request.GetWorkflowID() != task.WorkflowID {
+		return false
+	}
+	if request.GetRunID() != "" && request.GetRunID() != task.RunID {
+		return false
+	}
+	if request.CreatedBefore != nil && !task.CreatedTime.Before(time.Unix(0, request.GetCreatedBefore())) {
+		return false
+	}
+	return true
+}
+
+// the page token of ListTasks is the ID of the last task of the page
+func serializeTaskIDPageToken(taskID int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(taskID))
+	return b
+}
+
+func deserializeTaskIDPageToken(token []byte) (int64, error) {
+	if len(token) != 8 {
+		return 0, fmt.Errorf("invalid token of %v length", len(token))
+	}
+	return int64(binary.BigEndian.Uint64(token)), nil
+}
+
 func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *config.TaskListConfig {
 	taskListName := id.GetName()
 	taskType := id.GetType()
@@ -1139,6 +1457,24 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		TaskIsolationPollerWindow: func() time.Duration {
 			return cfg.TaskIsolationPollerWindow(domainName, taskListName, taskType)
 		},
+		TaskPriorityAgingInterval: func() time.Duration {
+			return cfg.TaskPriorityAgingInterval(domainName, taskListName, taskType)
+		},
+		TaskPriorityReadAheadLimit: func() int {
+			return cfg.TaskPriorityReadAheadLimit(domainName, taskListName, taskType)
+		},
+		EnableFairDispatch: func() bool {
+			return cfg.EnableFairDispatch(domainName, taskListName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]interface{} {
+			return cfg.FairnessKeyWeights(domainName)
+		},
+		MaxCompatibleBuildIDSets: func() int {
+			return cfg.MaxCompatibleBuildIDSets(domainName, taskListName, taskType)
+		},
+		VersionedTaskMaxWait: func() time.Duration {
+			return cfg.VersionedTaskMaxWait(domainName, taskListName, taskType)
+		},
 		ForwarderConfig: config.ForwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return cfg.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -1202,6 +1538,18 @@ func ContextWithIsolationGroup(ctx context.Context, isolationGroup string) conte
 	return context.WithValue(ctx, isolationGroupCtxKey{}, isolationGroup)
 }
 
+func BuildIDFromContext(ctx context.Context) string {
+	val, ok := ctx.Value(buildIDCtxKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+func ContextWithBuildID(ctx context.Context, buildID string) context.Context {
+	return context.WithValue(ctx, buildIDCtxKey{}, buildID)
+}
+
 func validateParams(p ManagerParams) (err error) {
 	if p.DomainCache == nil {
 		return errors.New("ManagerParams.DomainCache is required")
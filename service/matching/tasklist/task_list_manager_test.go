@@ -23,6 +23,7 @@ package tasklist
 import (
 	"context"
 	"errors"
+	"slices"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -33,6 +34,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-go/tally"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/sync/errgroup"
@@ -132,7 +134,7 @@ func TestDeliverBufferTasks(t *testing.T) {
 		func(tlm *taskListManagerImpl) { tlm.taskReader.cancelFunc() },
 		func(tlm *taskListManagerImpl) {
 			tlm.limiter.ReportLimit(0.1)
-			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+			require.NoError(t, tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Put(context.Background(), &persistence.TaskInfo{}))
 			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -166,7 +168,7 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	logger := testlogger.New(t)
 
 	tlm := createTestTaskListManager(t, logger, controller)
-	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+	require.NoError(t, tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Put(context.Background(), &persistence.TaskInfo{}))
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -964,6 +966,8 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 	cfg := defaultTestConfig()
 	cfg.RangeSize = rangeSize
 	cfg.ReadRangeSize = dynamicproperties.GetIntPropertyFn(rangeSize / 2)
+	// the pump stops reading at a full buffer instead of reading ahead
+	cfg.TaskPriorityReadAheadLimit = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(0)
 	mockRegistry := NewMockTaskListRegistry(controller)
 	mockRegistry.EXPECT().Unregister(gomock.Any()).AnyTimes()
 	params := ManagerParams{
@@ -1007,9 +1011,9 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := min(cap(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]), taskCount)
+	expectedBufSize := min(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Cap(), taskCount)
 	assert.True(t, awaitCondition(func() bool {
-		return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) == expectedBufSize
+		return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Len() == expectedBufSize
 	}, 10*time.Second))
 
 	// stop all goroutines that read / write tasks in the background
@@ -1294,7 +1298,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 			// wait until all tasks are loaded by into in-memory buffers by task list manager
 			// the buffer size should be one less than expected because dispatcher will dequeue the head
 			assert.True(t, awaitCondition(func() bool {
-				return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) >= (taskCount/2 - 1)
+				return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Len() >= (taskCount/2 - 1)
 			}, time.Second))
 
 			remaining := taskCount
@@ -1316,6 +1320,145 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 	}
 }
 
+func addBacklogTasks(t *testing.T, tlm *taskListManagerImpl, workflowIDs ...string) {
+	for i, workflowID := range workflowIDs {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domain",
+				RunID:                         "run1",
+				WorkflowID:                    workflowID,
+				ScheduleID:                    int64(i),
+				ScheduleToStartTimeoutSeconds: 100,
+				PartitionConfig:               map[string]string{isolationgroup.GroupKey: "zone-a"},
+			},
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestListTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, defaultTestConfig(), clock.NewRealTimeSource())
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+	workflowIDs := []string{"wf1", "wf2", "wf3", "wf4", "wf5"}
+	addBacklogTasks(t, tlm, workflowIDs...)
+
+	var tasks []*types.TaskListTask
+	var pages int
+	request := &types.ListTaskListTasksRequest{PageSize: 2}
+	for {
+		response, err := tlm.ListTasks(context.Background(), request)
+		require.NoError(t, err)
+		tasks = append(tasks, response.Tasks...)
+		pages++
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	assert.Equal(t, 3, pages)
+	require.Len(t, tasks, 5)
+	for i, task := range tasks {
+		assert.Equal(t, workflowIDs[i], task.WorkflowID)
+		assert.Equal(t, "run1", task.RunID)
+		assert.Equal(t, int64(i), task.ScheduleID)
+		assert.Equal(t, "zone-a", task.IsolationGroup)
+		assert.NotNil(t, task.ExpiryTime)
+	}
+
+	_, err := tlm.ListTasks(context.Background(), &types.ListTaskListTasksRequest{PageSize: 0})
+	assert.IsType(t, &types.BadRequestError{}, err)
+	_, err = tlm.ListTasks(context.Background(), &types.ListTaskListTasksRequest{PageSize: 2, NextPageToken: []byte("bad")})
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestDeleteTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, defaultTestConfig(), clock.NewRealTimeSource())
+	tm := tlm.db.store.(*TestTaskManager)
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+	// the first task is taken out of the buffer by the dispatcher, which keeps trying to dispatch it as there are no pollers
+	addBacklogTasks(t, tlm, "keep", "terminated", "keep", "terminated", "terminated")
+	buffer := tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]
+	require.True(t, awaitCondition(func() bool { return buffer.Len() == 4 }, 10*time.Second))
+
+	deleted, err := tlm.DeleteTasks(context.Background(), &types.DeleteTaskListTasksRequest{WorkflowID: "terminated", RunID: "run1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	assert.Equal(t, 2, tm.GetTaskCount(tlm.taskListID))
+	assert.Equal(t, 1, buffer.Len())
+
+	deleted, err = tlm.DeleteTasks(context.Background(), &types.DeleteTaskListTasksRequest{WorkflowID: "keep", RunID: "other-run"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	assert.Equal(t, 2, tm.GetTaskCount(tlm.taskListID))
+}
+
+func TestMatchesDeleteTasksRequest(t *testing.T) {
+	now := time.Now()
+	task := &persistence.TaskInfo{WorkflowID: "wf", RunID: "run", CreatedTime: now}
+	tests := map[string]struct {
+		request  *types.DeleteTaskListTasksRequest
+		expected bool
+	}{
+		"workflow ID":                   {&types.DeleteTaskListTasksRequest{WorkflowID: "wf"}, true},
+		"other workflow ID":             {&types.DeleteTaskListTasksRequest{WorkflowID: "other"}, false},
+		"workflow and run ID":           {&types.DeleteTaskListTasksRequest{WorkflowID: "wf", RunID: "run"}, true},
+		"other run ID":                  {&types.DeleteTaskListTasksRequest{WorkflowID: "wf", RunID: "other"}, false},
+		"created before":                {&types.DeleteTaskListTasksRequest{CreatedBefore: common.Int64Ptr(now.Add(time.Second).UnixNano())}, true},
+		"created at the cutoff":         {&types.DeleteTaskListTasksRequest{CreatedBefore: common.Int64Ptr(now.UnixNano())}, false},
+		"workflow ID and created after": {&types.DeleteTaskListTasksRequest{WorkflowID: "wf", CreatedBefore: common.Int64Ptr(now.Add(-time.Second).UnixNano())}, false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, matchesDeleteTasksRequest(tc.request, task))
+		})
+	}
+}
+
+func TestTaskListManagerSyncMatchLinksProducerSpan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), ctrl, defaultTestConfig(), clock.NewRealTimeSource())
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+
+	tracer := sdktrace.NewTracerProvider().Tracer("test")
+	pollCtx, pollSpan := tracer.Start(context.Background(), "PollForActivityTask")
+	addCtx, addSpan := tracer.Start(context.Background(), "AddActivityTask")
+	defer addSpan.End()
+
+	var g errgroup.Group
+	g.Go(func() error {
+		params := AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      uuid.New(),
+				WorkflowID:                    "workflow1",
+				RunID:                         uuid.New(),
+				ScheduleID:                    1,
+				ScheduleToStartTimeoutSeconds: 100,
+			},
+		}
+		for {
+			matched, err := tlm.trySyncMatch(addCtx, params, "")
+			if err != nil || matched {
+				return err
+			}
+		}
+	})
+
+	task, err := tlm.GetTask(pollCtx, nil)
+	require.NoError(t, err)
+	task.Finish(nil)
+	require.NoError(t, g.Wait())
+	pollSpan.End()
+
+	links := pollSpan.(sdktrace.ReadOnlySpan).Links()
+	require.Len(t, links, 1)
+	assert.Equal(t, addSpan.SpanContext(), links[0].SpanContext)
+}
+
 func TestTaskListManagerImpl_HasPollerAfter(t *testing.T) {
 	for name, tc := range map[string]struct {
 		outstandingPollers []string
@@ -1676,6 +1819,259 @@ func TestUpdateTaskListPartitionConfig(t *testing.T) {
 	}
 }
 
+func TestUpdateBuildIDCompatibility(t *testing.T) {
+	tlID, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeDecision)
+	require.NoError(t, err)
+	tlm, deps := setupMocksForTaskListManager(t, tlID, types.TaskListKindNormal)
+	deps.mockTaskManager.EXPECT().UpdateTaskList(gomock.Any(), &persistence.UpdateTaskListRequest{
+		DomainName: "domainName",
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:              "domain-id",
+			Name:                  "tl",
+			Kind:                  persistence.TaskListKindNormal,
+			CompatibleBuildIDSets: [][]string{{"build-1", "build-2"}, {"build-3"}},
+		},
+	}).Return(&persistence.UpdateTaskListResponse{}, nil)
+	deps.mockMatchingClient.EXPECT().RefreshTaskListPartitionConfig(gomock.Any(), &types.MatchingRefreshTaskListPartitionConfigRequest{
+		DomainUUID:   "domain-id",
+		TaskList:     &types.TaskList{Name: "/__cadence_sys/tl/1", Kind: types.TaskListKindNormal.Ptr()},
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+	}).Return(&types.MatchingRefreshTaskListPartitionConfigResponse{}, nil)
+	tlm.partitionConfig = &types.TaskListPartitionConfig{
+		Version:         1,
+		ReadPartitions:  partitions(2),
+		WritePartitions: partitions(2),
+	}
+	tlm.buildIDSets = [][]string{{"build-1"}, {"build-3"}}
+	tlm.startWG.Done()
+
+	sets, err := tlm.UpdateBuildIDCompatibility(context.Background(), "build-2", "build-1")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"build-1", "build-2"}, {"build-3"}}, sets)
+	assert.Equal(t, sets, tlm.CompatibleBuildIDSets())
+
+	// no op updates are neither persisted nor pushed to the partitions
+	sets, err = tlm.UpdateBuildIDCompatibility(context.Background(), "build-3", "")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"build-1", "build-2"}, {"build-3"}}, sets)
+
+	_, err = tlm.UpdateBuildIDCompatibility(context.Background(), "build-4", "build-5")
+	assert.ErrorAs(t, err, new(*types.BadRequestError))
+}
+
+func TestUpdateBuildIDCompatibility_NotAllowed(t *testing.T) {
+	testCases := []struct {
+		name         string
+		taskListName string
+		taskListType int
+	}{
+		{
+			name:         "non-root partition",
+			taskListName: "/__cadence_sys/tl/1",
+			taskListType: persistence.TaskListTypeDecision,
+		},
+		{
+			name:         "activity task list",
+			taskListName: "tl",
+			taskListType: persistence.TaskListTypeActivity,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tlID, err := NewIdentifier("domain-id", tc.taskListName, tc.taskListType)
+			require.NoError(t, err)
+			tlm, _ := setupMocksForTaskListManager(t, tlID, types.TaskListKindNormal)
+			tlm.startWG.Done()
+
+			_, err = tlm.UpdateBuildIDCompatibility(context.Background(), "build-1", "")
+			assert.ErrorAs(t, err, new(*types.BadRequestError))
+		})
+	}
+}
+
+func TestUpdateCompatibleBuildIDSets(t *testing.T) {
+	testCases := []struct {
+		name              string
+		sets              [][]string
+		buildID           string
+		compatibleBuildID string
+		maxSets           int
+		expected          [][]string
+		expectError       bool
+	}{
+		{
+			name:     "first default set",
+			buildID:  "build-1",
+			maxSets:  10,
+			expected: [][]string{{"build-1"}},
+		},
+		{
+			name:     "new default set",
+			sets:     [][]string{{"build-1"}},
+			buildID:  "build-2",
+			maxSets:  10,
+			expected: [][]string{{"build-1"}, {"build-2"}},
+		},
+		{
+			name:     "existing set becomes the default",
+			sets:     [][]string{{"build-1"}, {"build-2"}, {"build-3"}},
+			buildID:  "build-1",
+			maxSets:  10,
+			expected: [][]string{{"build-2"}, {"build-3"}, {"build-1"}},
+		},
+		{
+			name:     "oldest sets are dropped",
+			sets:     [][]string{{"build-1"}, {"build-2"}, {"build-3"}},
+			buildID:  "build-4",
+			maxSets:  2,
+			expected: [][]string{{"build-3"}, {"build-4"}},
+		},
+		{
+			name:              "added to a compatible set",
+			sets:              [][]string{{"build-1"}, {"build-2"}},
+			buildID:           "build-3",
+			compatibleBuildID: "build-1",
+			maxSets:           10,
+			expected:          [][]string{{"build-1", "build-3"}, {"build-2"}},
+		},
+		{
+			name:              "already in the compatible set",
+			sets:              [][]string{{"build-1", "build-3"}, {"build-2"}},
+			buildID:           "build-3",
+			compatibleBuildID: "build-1",
+			maxSets:           10,
+			expected:          [][]string{{"build-1", "build-3"}, {"build-2"}},
+		},
+		{
+			name:              "compatible build ID not found",
+			sets:              [][]string{{"build-1"}},
+			buildID:           "build-2",
+			compatibleBuildID: "build-3",
+			maxSets:           10,
+			expectError:       true,
+		},
+		{
+			name:              "already in another set",
+			sets:              [][]string{{"build-1"}, {"build-2"}},
+			buildID:           "build-2",
+			compatibleBuildID: "build-1",
+			maxSets:           10,
+			expectError:       true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := slices.Clone(tc.sets)
+			result, err := updateCompatibleBuildIDSets(tc.sets, tc.buildID, tc.compatibleBuildID, tc.maxSets)
+			if tc.expectError {
+				assert.ErrorAs(t, err, new(*types.BadRequestError))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+			assert.Equal(t, original, tc.sets)
+		})
+	}
+}
+
+func TestGetVersionKey(t *testing.T) {
+	decisionID, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeDecision)
+	require.NoError(t, err)
+	activityID, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeActivity)
+	require.NoError(t, err)
+	pinnedTo := func(buildID string) *persistence.TaskInfo {
+		return &persistence.TaskInfo{WorkerBuildID: buildID}
+	}
+	testCases := []struct {
+		name              string
+		taskListID        *Identifier
+		taskListKind      types.TaskListKind
+		sets              [][]string
+		task              *persistence.TaskInfo
+		expectedTaskKey   string
+		pollerBuildID     string
+		expectedPollerKey string
+	}{
+		{
+			name:              "no compatible build IDs",
+			taskListID:        decisionID,
+			taskListKind:      types.TaskListKindNormal,
+			task:              &persistence.TaskInfo{},
+			expectedTaskKey:   "",
+			pollerBuildID:     "build-1",
+			expectedPollerKey: "build-1",
+		},
+		{
+			name:              "pinned without compatible build IDs",
+			taskListID:        decisionID,
+			taskListKind:      types.TaskListKindNormal,
+			task:              pinnedTo("build-1"),
+			expectedTaskKey:   "build-1",
+			pollerBuildID:     "",
+			expectedPollerKey: "",
+		},
+		{
+			name:              "unpinned goes to the default set",
+			taskListID:        decisionID,
+			taskListKind:      types.TaskListKindNormal,
+			sets:              [][]string{{"build-1", "build-2"}, {"build-3", "build-4"}},
+			task:              &persistence.TaskInfo{},
+			expectedTaskKey:   "build-3",
+			pollerBuildID:     "build-4",
+			expectedPollerKey: "build-3",
+		},
+		{
+			name:              "pinned goes to the compatible set",
+			taskListID:        decisionID,
+			taskListKind:      types.TaskListKindNormal,
+			sets:              [][]string{{"build-1", "build-2"}, {"build-3"}},
+			task:              pinnedTo("build-2"),
+			expectedTaskKey:   "build-1",
+			pollerBuildID:     "build-2",
+			expectedPollerKey: "build-1",
+		},
+		{
+			name:              "pinned to a build ID of no set",
+			taskListID:        decisionID,
+			taskListKind:      types.TaskListKindNormal,
+			sets:              [][]string{{"build-1"}},
+			task:              pinnedTo("build-5"),
+			expectedTaskKey:   "build-5",
+			pollerBuildID:     "build-5",
+			expectedPollerKey: "build-5",
+		},
+		{
+			name:              "activity task list",
+			taskListID:        activityID,
+			taskListKind:      types.TaskListKindNormal,
+			sets:              [][]string{{"build-1"}},
+			task:              pinnedTo("build-1"),
+			expectedTaskKey:   "",
+			pollerBuildID:     "build-1",
+			expectedPollerKey: "",
+		},
+		{
+			name:              "sticky task list",
+			taskListID:        decisionID,
+			taskListKind:      types.TaskListKindSticky,
+			sets:              [][]string{{"build-1"}},
+			task:              pinnedTo("build-1"),
+			expectedTaskKey:   "",
+			pollerBuildID:     "build-1",
+			expectedPollerKey: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tlm, _ := setupMocksForTaskListManager(t, tc.taskListID, tc.taskListKind)
+			tlm.buildIDSets = tc.sets
+
+			assert.Equal(t, tc.expectedTaskKey, tlm.getVersionKeyForTask(tc.task))
+			assert.Equal(t, tc.expectedPollerKey, tlm.getVersionKeyForPoller(tc.pollerBuildID))
+		})
+	}
+}
+
 func TestRefreshTaskListPartitionConfigConcurrency(t *testing.T) {
 	tlID, err := NewIdentifier("domain-id", "/__cadence_sys/tl/1", persistence.TaskListTypeDecision)
 	require.NoError(t, err)
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -55,16 +56,22 @@ const (
 
 	// a buffer to add to the dispatch timeout to have some room even task waits for the whole rate-limit period
 	taskDispatchTimeoutBuffer = 100 * time.Millisecond
+
+	// the fairness key tag of the tasks whose fairness key is not configured in matching.fairnessKeyWeights
+	otherFairnessKey = "other"
+
+	// the spill level of a priority whose tasks are buffered as they are read
+	noSpillLevel int64 = -1
 )
 
 type (
 	taskReader struct {
 
 		// taskBuffers: This is the in-memory queue of tasks for dispatch
-		// that are enqueued for pollers to pickup. It's written to by
+		// that are enqueued for pollers to pickup, ordered by task priority. It's written to by
 		// - getTasksPump - the primary means of loading async matching tasks
 		// - task dispatch redirection - when a task is redirected from another isolation group
-		taskBuffers     map[string]chan *persistence.TaskInfo
+		taskBuffers     map[string]*taskBuffer
 		notifyC         chan struct{} // Used as signal to notify pump of new tasks
 		tlMgr           *taskListManagerImpl
 		taskListID      *Identifier
@@ -91,8 +98,20 @@ type (
 		onFatalErr               func()
 		dispatchTask             func(context.Context, *InternalTask) error
 		getIsolationGroupForTask func(context.Context, *persistence.TaskInfo) (string, time.Duration)
+		getVersionKeyForTask     func(*persistence.TaskInfo) string
 		rateLimit                func() rate.Limit
 
+		// When the buffer a task belongs to is full, the task is read into taskAckManager without being
+		// buffered and its priority is spilled, so that the tasks of other priorities behind it can still
+		// be read. spillLevels holds the ID below which all the tasks of a spilled priority are buffered,
+		// or noSpillLevel, and the spilled tasks are read again from there once the buffers have space.
+		// spillLevels and readAheadPaused are only accessed by getTasksPump.
+		spillLevels     [constants.MaxTaskPriority + 1]int64
+		readAheadPaused bool
+		// spilledTasks is the number of tasks read into taskAckManager which are not buffered yet
+		spilledTasks atomic.Int64
+		// backlogByPriority is the number of tasks of each priority read into taskAckManager and not acked yet
+		backlogByPriority [constants.MaxTaskPriority + 1]atomic.Int64
 		// stopWg is used to wait for all dispatchers to stop.
 		stopWg sync.WaitGroup
 	}
@@ -100,7 +119,7 @@ type (
 
 func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
-	taskBuffers := make(map[string]chan *persistence.TaskInfo)
+	taskBuffers := make(map[string]*taskBuffer)
 
 	// Validate batch size to prevent system failures
 	batchSize := tlMgr.config.GetTasksBatchSize()
@@ -112,23 +131,23 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		batchSize = fallback
 	}
 
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, batchSize-1)
+	// we always dequeue the head of the buffer and try to dispatch it to a poller
+	// so allocate one less than desired target buffer size
+	taskBuffers[defaultTaskBufferIsolationGroup] = newTaskBuffer(batchSize-1, tlMgr.config, tlMgr.timeSource)
 	for _, g := range isolationGroups {
-		taskBuffers[g] = make(chan *persistence.TaskInfo, batchSize-1)
-	}
-	return &taskReader{
-		tlMgr:          tlMgr,
-		taskListID:     tlMgr.taskListID,
-		config:         tlMgr.config,
-		db:             tlMgr.db,
-		taskWriter:     tlMgr.taskWriter,
-		taskGC:         tlMgr.taskGC,
-		taskAckManager: tlMgr.taskAckManager,
-		cancelCtx:      ctx,
-		cancelFunc:     cancel,
-		notifyC:        make(chan struct{}, 1),
-		// we always dequeue the head of the buffer and try to dispatch it to a poller
-		// so allocate one less than desired target buffer size
+		taskBuffers[g] = newTaskBuffer(batchSize-1, tlMgr.config, tlMgr.timeSource)
+	}
+	tr := &taskReader{
+		tlMgr:                    tlMgr,
+		taskListID:               tlMgr.taskListID,
+		config:                   tlMgr.config,
+		db:                       tlMgr.db,
+		taskWriter:               tlMgr.taskWriter,
+		taskGC:                   tlMgr.taskGC,
+		taskAckManager:           tlMgr.taskAckManager,
+		cancelCtx:                ctx,
+		cancelFunc:               cancel,
+		notifyC:                  make(chan struct{}, 1),
 		taskBuffers:              taskBuffers,
 		domainCache:              tlMgr.domainCache,
 		clusterMetadata:          tlMgr.clusterMetadata,
@@ -139,12 +158,17 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		onFatalErr:               tlMgr.Stop,
 		dispatchTask:             tlMgr.DispatchTask,
 		getIsolationGroupForTask: tlMgr.getIsolationGroupForTask,
+		getVersionKeyForTask:     tlMgr.getVersionKeyForTask,
 		rateLimit:                tlMgr.limiter.Limit,
 		throttleRetry: backoff.NewThrottleRetry(
 			backoff.WithRetryPolicy(persistenceOperationRetryPolicy),
 			backoff.WithRetryableError(persistence.IsTransientError),
 		),
 	}
+	for p := range tr.spillLevels {
+		tr.spillLevels[p] = noSpillLevel
+	}
+	return tr
 }
 
 func (tr *taskReader) Start() {
@@ -186,31 +210,49 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
-dispatchLoop:
+	buffer := tr.taskBuffers[isolationGroup]
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			event.Log(event.E{
-				TaskListName: tr.taskListID.GetName(),
-				TaskListType: tr.taskListID.GetType(),
-				TaskListKind: &tr.tlMgr.taskListKind,
-				TaskInfo:     *taskInfo,
-				EventName:    "Attempting to Dispatch Buffered Task",
-			})
-			breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
-			if breakDispatchLoop {
-				// shutting down
-				break dispatchLoop
-			}
-		case <-tr.cancelCtx.Done():
-			break dispatchLoop
+		taskInfo, err := buffer.Get(tr.cancelCtx)
+		if err != nil { // Task list is shutting down
+			return
+		}
+		if tr.spilledTasks.Load() > 0 && buffer.Len() <= buffer.Cap()/2 {
+			// wake up the pump to buffer the spilled tasks
+			tr.Signal()
+		}
+		if tr.config.EnableFairDispatch() {
+			tr.emitFairnessKeyBacklogAge(taskInfo)
+		}
+		event.Log(event.E{
+			TaskListName: tr.taskListID.GetName(),
+			TaskListType: tr.taskListID.GetType(),
+			TaskListKind: &tr.tlMgr.taskListKind,
+			TaskInfo:     *taskInfo,
+			EventName:    "Attempting to Dispatch Buffered Task",
+		})
+		breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
+		if breakDispatchLoop {
+			// shutting down
+			return
 		}
 	}
 }
 
+// emitFairnessKeyBacklogAge records how long the task waited in the backlog, tagged by its fairness key.
+// Only the keys configured in matching.fairnessKeyWeights are tagged individually, all other keys are
+// reported as otherFairnessKey, so callers cannot grow the number of time series without bound.
+func (tr *taskReader) emitFairnessKeyBacklogAge(taskInfo *persistence.TaskInfo) {
+	if taskInfo.CreatedTime.IsZero() {
+		return
+	}
+	key := taskInfo.FairnessKey
+	if _, ok := tr.config.FairnessKeyWeights()[key]; !ok || key == "" {
+		key = otherFairnessKey
+	}
+	tr.scope.Tagged(metrics.FairnessKeyTag(key)).
+		RecordTimer(metrics.FairnessKeyBacklogAgePerTaskList, tr.timeSource.Now().Sub(taskInfo.CreatedTime))
+}
+
 func (tr *taskReader) getTasksPump() {
 	updateAckTimer := tr.timeSource.NewTimer(tr.config.UpdateAckInterval())
 	defer updateAckTimer.Stop()
@@ -222,6 +264,11 @@ getTasksPumpLoop:
 			break getTasksPumpLoop
 		case <-tr.notifyC:
 			{
+				if !tr.bufferSpilledTasks() {
+					tr.Signal() // re-enqueue the event
+					continue getTasksPumpLoop
+				}
+
 				initialReadLevel := tr.taskAckManager.GetReadLevel()
 				maxReadLevel := tr.taskWriter.GetMaxReadLevel()
 
@@ -251,6 +298,10 @@ getTasksPumpLoop:
 				if !tr.addTasksToBuffer(tasks) {
 					break getTasksPumpLoop
 				}
+				if tr.readAheadPaused {
+					// too many tasks are spilled, the dispatchers signal the pump once they are buffered
+					continue getTasksPumpLoop
+				}
 				// There maybe more tasks. We yield now, but signal pump to check again later.
 				tr.Signal()
 			}
@@ -332,15 +383,31 @@ func (tr *taskReader) isTaskExpired(t *persistence.TaskInfo) bool {
 }
 
 func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) bool {
+	tr.readAheadPaused = false
 	for _, t := range tasks {
 		if !tr.addSingleTaskToBuffer(t) {
 			return false // we are shutting down the task list
 		}
+		if tr.readAheadPaused {
+			// the rest of the tasks are read again once the spilled tasks are buffered
+			return true
+		}
 	}
 	return true
 }
 
 func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
+	priority := clampPriority(task.Priority)
+	if tr.spillLevels[priority] != noSpillLevel {
+		// the task must be buffered after the spilled tasks of its priority, expired or not
+		if tr.spilledTasks.Load() >= int64(tr.config.TaskPriorityReadAheadLimit()) {
+			tr.readAheadPaused = true
+			return true
+		}
+		tr.readTask(task)
+		tr.spilledTasks.Add(1)
+		return true
+	}
 	if tr.isTaskExpired(task) {
 		tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
 		// Also increment readLevel for expired tasks otherwise it could result in
@@ -348,22 +415,129 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 		tr.taskAckManager.SetReadLevel(task.TaskID)
 		return true
 	}
+	tr.readTask(task)
+	buffer := tr.getTaskBuffer(task)
+	if buffer.TryPut(task) {
+		return true
+	}
+	if tr.spilledTasks.Load() < int64(tr.config.TaskPriorityReadAheadLimit()) {
+		tr.spillLevels[priority] = task.TaskID - 1
+		tr.spilledTasks.Add(1)
+		return true
+	}
+	return buffer.Put(tr.cancelCtx, task) == nil
+}
+
+// bufferSpilledTasks reads the spilled tasks again, from the highest priority down, and buffers them
+// until the buffers are full. It returns false if the tasks could not be read.
+func (tr *taskReader) bufferSpilledTasks() bool {
+	for p := len(tr.spillLevels) - 1; p >= 0; p-- {
+		if tr.spillLevels[p] == noSpillLevel {
+			continue
+		}
+		if !tr.hasBufferSpace() {
+			return true
+		}
+		if !tr.bufferSpilledTasksOfPriority(int32(p)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tr *taskReader) bufferSpilledTasksOfPriority(priority int32) bool {
+	readLevel := tr.spillLevels[priority]
+	maxReadLevel := tr.taskAckManager.GetReadLevel()
+	for readLevel < maxReadLevel {
+		tasks, err := tr.getTaskBatchWithRange(readLevel, maxReadLevel)
+		if err != nil {
+			tr.spillLevels[priority] = readLevel
+			return false
+		}
+		if len(tasks) == 0 {
+			break
+		}
+		for _, t := range tasks {
+			if clampPriority(t.Priority) != priority {
+				readLevel = t.TaskID
+				continue
+			}
+			if tr.isTaskExpired(t) {
+				tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
+				tr.taskGC.Run(tr.ackTask(t))
+			} else if !tr.getTaskBuffer(t).TryPut(t) {
+				tr.spillLevels[priority] = readLevel
+				return true
+			}
+			tr.spilledTasks.Add(-1)
+			readLevel = t.TaskID
+		}
+	}
+	tr.spillLevels[priority] = noSpillLevel
+	return true
+}
+
+func (tr *taskReader) hasBufferSpace() bool {
+	for _, buffer := range tr.taskBuffers {
+		if buffer.Len() < buffer.Cap() {
+			return true
+		}
+	}
+	return false
+}
+
+// readTask adds a task read from persistence to taskAckManager
+func (tr *taskReader) readTask(task *persistence.TaskInfo) {
 	err := tr.taskAckManager.ReadItem(task.TaskID)
 	if err != nil {
 		tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
 	}
+	tr.backlogByPriority[clampPriority(task.Priority)].Add(1)
+}
+
+// ackTask acks a task read by readTask and returns the new ack level
+func (tr *taskReader) ackTask(task *persistence.TaskInfo) int64 {
+	tr.backlogByPriority[clampPriority(task.Priority)].Add(-1)
+	return tr.taskAckManager.AckItem(task.TaskID)
+}
+
+func (tr *taskReader) getTaskBuffer(task *persistence.TaskInfo) *taskBuffer {
 	// Ignore the isolation duration as we're just putting it into a buffer to be dispatched later.
 	isolationGroup, _ := tr.getIsolationGroupForTask(tr.cancelCtx, task)
 	buffer, ok := tr.taskBuffers[isolationGroup]
 	if !ok {
 		buffer = tr.taskBuffers[defaultTaskBufferIsolationGroup]
 	}
-	select {
-	case buffer <- task:
-		return true
-	case <-tr.cancelCtx.Done():
-		return false
+	return buffer
+}
+
+// backlogCountByPriority returns the number of tasks of each priority read from the backlog and not
+// acked yet, whether they are buffered or spilled, or nil if there are none.
+func (tr *taskReader) backlogCountByPriority() map[int32]int64 {
+	var counts map[int32]int64
+	for p := range tr.backlogByPriority {
+		if count := tr.backlogByPriority[p].Load(); count > 0 {
+			if counts == nil {
+				counts = make(map[int32]int64)
+			}
+			counts[int32(p)] = count
+		}
+	}
+	return counts
+}
+
+// removeBufferedTasks removes the tasks for which the predicate is true from the buffers and acks them.
+// It returns the number of removed tasks.
+func (tr *taskReader) removeBufferedTasks(pred func(*persistence.TaskInfo) bool) int {
+	removed := 0
+	for _, buffer := range tr.taskBuffers {
+		for _, task := range buffer.RemoveIf(pred) {
+			ackLevel := tr.ackTask(task)
+			tr.taskGC.Run(ackLevel)
+			removed++
+		}
 	}
+	return removed
 }
 
 func (tr *taskReader) persistAckLevel() error {
@@ -407,13 +581,13 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 		}
 		tr.Signal()
 	}
-	ackLevel := tr.taskAckManager.AckItem(task.TaskID)
+	ackLevel := tr.ackTask(task)
 	tr.taskGC.Run(ackLevel)
 }
 
-func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuration time.Duration) (context.Context, context.CancelFunc) {
+func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuration time.Duration, versionKey string) (context.Context, context.CancelFunc) {
 	rps := float64(tr.rateLimit())
-	if isolationGroup != "" || rps > 1e-7 { // 1e-7 is a random number chosen to avoid overflow, normally user don't set such a low rps
+	if isolationGroup != "" || versionKey != "" || rps > 1e-7 { // 1e-7 is a random number chosen to avoid overflow, normally user don't set such a low rps
 		timeout := tr.getDispatchTimeout(rps, isolationDuration)
 		domainEntry, err := tr.domainCache.GetDomainByID(tr.taskListID.GetDomainID())
 		if err != nil {
@@ -431,6 +605,12 @@ func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuratio
 	return tr.cancelCtx, func() {}
 }
 
+// hasWaitedForVersionTooLong returns true if the task has been in the backlog longer than matching.versionedTaskMaxWait
+func (tr *taskReader) hasWaitedForVersionTooLong(taskInfo *persistence.TaskInfo) bool {
+	maxWait := tr.config.VersionedTaskMaxWait()
+	return maxWait > 0 && !taskInfo.CreatedTime.IsZero() && tr.timeSource.Now().Sub(taskInfo.CreatedTime) > maxWait
+}
+
 func (tr *taskReader) getDispatchTimeout(rps float64, isolationDuration time.Duration) time.Duration {
 	// this is the minimum timeout required to dispatch a task, if the timeout value is smaller than this
 	// async task dispatch can be completely throttled, which could happen when ratePerSecond is pretty low
@@ -468,7 +648,7 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		e.EventName = "Task Expired"
 		event.Log(e)
 		tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
-		tr.taskAckManager.AckItem(taskInfo.TaskID)
+		tr.ackTask(taskInfo)
 		return false, true
 	}
 	isolationGroup, isolationDuration := tr.getIsolationGroupForTask(tr.cancelCtx, taskInfo)
@@ -478,7 +658,14 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		isolationDuration = noIsolationTimeout
 	}
 	task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
-	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, isolationDuration)
+	task.versionKey = tr.getVersionKeyForTask(taskInfo)
+	if task.versionKey != "" && tr.hasWaitedForVersionTooLong(taskInfo) {
+		// no poller of a compatible build ID has picked up the task for too long, let any poller have it
+		// rather than putting it back forever
+		tr.scope.IncCounter(metrics.VersionedTaskFallbackPerTaskList)
+		task.versionKey = ""
+	}
+	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, isolationDuration, task.versionKey)
 	timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
 	err := tr.dispatchTask(dispatchCtx, task)
 	timerScope.Stop()
@@ -498,8 +685,8 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 	}
 
 	if errors.Is(err, context.DeadlineExceeded) {
-		// it only happens when isolation is enabled and there is no pollers from the given isolation group
-		// if this happens, we don't want to block the task dispatching, because there might be pollers from
+		// it only happens when isolation is enabled and there is no pollers from the given isolation group,
+		// or when the task is pinned to a build ID which has no pollers. If this happens, we don't want to block the task dispatching, because there might be pollers from
 		// other isolation groups, we just simply continue and dispatch the task to a new isolation group which
 		// has pollers
 		tr.logger.Debug("Async task dispatch timed out",
@@ -513,6 +700,13 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		e.EventName = "Dispatch Timed Out"
 		event.Log(e)
 		tr.scope.IncCounter(metrics.AsyncMatchDispatchTimeoutCounterPerTaskList)
+		if task.versionKey != "" {
+			// there is no poller of a compatible build ID, which no other poller can stand in for,
+			// so the task is put back to the buffer rather than holding up the tasks behind it
+			tr.scope.IncCounter(metrics.VersionedTaskPutBackPerTaskList)
+			tr.getTaskBuffer(taskInfo).PutBack(taskInfo)
+			return false, true
+		}
 		return false, false
 	}
 
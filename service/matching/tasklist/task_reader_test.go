@@ -32,6 +32,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/clock"
@@ -39,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/service/matching/config"
 )
@@ -208,6 +210,48 @@ func TestDispatchSingleTaskFromBuffer(t *testing.T) {
 			breakDispatch: false,
 			breakRetries:  false,
 		},
+		{
+			name: "Error - versioned task dispatch timed out, should put it back to the buffer",
+			allowances: func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource) {
+				reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration) {
+					return "", -1
+				}
+				reader.getVersionKeyForTask = func(info *persistence.TaskInfo) string {
+					return "build-1"
+				}
+				reader.dispatchTask = func(ctx context.Context, task *InternalTask) error {
+					assert.Equal(t, "build-1", task.versionKey)
+					return context.DeadlineExceeded
+				}
+				t.Cleanup(func() {
+					assert.Equal(t, 1, reader.taskBuffers[defaultTaskBufferIsolationGroup].Len())
+				})
+			},
+			ttl:           1,
+			breakDispatch: false,
+			breakRetries:  true,
+		},
+		{
+			name: "success - versioned task waited too long, should dispatch it to any poller",
+			allowances: func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource) {
+				reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration) {
+					return "", -1
+				}
+				reader.getVersionKeyForTask = func(info *persistence.TaskInfo) string {
+					mockTime.Advance(time.Hour)
+					return "build-1"
+				}
+				markCalled := requireCallbackInvocation(t, "expected task to be dispatched")
+				reader.dispatchTask = func(ctx context.Context, task *InternalTask) error {
+					assert.Equal(t, "", task.versionKey)
+					markCalled()
+					return nil
+				}
+			},
+			ttl:           1,
+			breakDispatch: false,
+			breakRetries:  true,
+		},
 		{
 			name: "Error - time not reached to complete task without workflow execution, should retry",
 			allowances: func(t *testing.T, reader *taskReader, mockTime clock.MockedTimeSource) {
@@ -299,6 +343,117 @@ func TestGetDispatchTimeout(t *testing.T) {
 	}
 }
 
+func TestEmitFairnessKeyBacklogAge(t *testing.T) {
+	testScope := tally.NewTestScope("", nil)
+	timeSource := clock.NewMockedTimeSource()
+	reader := &taskReader{
+		config: &config.TaskListConfig{
+			FairnessKeyWeights: func() map[string]interface{} { return map[string]interface{}{"tenant": 3} },
+		},
+		scope:      metrics.NewClient(testScope, metrics.Matching, metrics.MigrationConfig{}).Scope(metrics.MatchingTaskListMgrScope),
+		timeSource: timeSource,
+	}
+	createdTime := timeSource.Now()
+	timeSource.Advance(time.Second)
+
+	reader.emitFairnessKeyBacklogAge(&persistence.TaskInfo{CreatedTime: createdTime, FairnessKey: "tenant"})
+	reader.emitFairnessKeyBacklogAge(&persistence.TaskInfo{CreatedTime: createdTime, FairnessKey: "unconfigured"})
+	reader.emitFairnessKeyBacklogAge(&persistence.TaskInfo{CreatedTime: createdTime, WorkflowID: "wf"})
+	reader.emitFairnessKeyBacklogAge(&persistence.TaskInfo{FairnessKey: "tenant"})
+
+	samples := make(map[string]int)
+	for _, timer := range testScope.Snapshot().Timers() {
+		if key, ok := timer.Tags()["fairness_key"]; ok {
+			samples[key] += len(timer.Values())
+		}
+	}
+	assert.Equal(t, map[string]int{"tenant": 1, otherFairnessKey: 2}, samples)
+}
+
+func TestAddTasksToBufferReadsPastFullBuffer(t *testing.T) {
+	controller := gomock.NewController(t)
+	timeSource := clock.NewMockedTimeSource()
+	c := defaultConfig()
+	// the buffer holds two tasks
+	c.GetTasksBatchSize = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(3)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, c, timeSource)
+	reader := tlm.taskReader
+	reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration) {
+		return defaultTaskBufferIsolationGroup, -1
+	}
+	buffer := reader.taskBuffers[defaultTaskBufferIsolationGroup]
+
+	var tasks []*persistence.TaskInfo
+	for i, priority := range []int32{0, 0, 0, 0, 10} {
+		task := newTask(timeSource)
+		task.TaskID = int64(i + 1)
+		task.Priority = priority
+		_, err := tlm.db.CreateTasks([]*persistence.CreateTaskInfo{{Data: task, TaskID: task.TaskID}})
+		require.NoError(t, err)
+		tasks = append(tasks, task)
+	}
+	tlm.taskWriter.maxReadLevel = int64(len(tasks))
+
+	require.True(t, reader.addTasksToBuffer(tasks))
+	assert.False(t, reader.readAheadPaused)
+	assert.Equal(t, int64(len(tasks)), tlm.taskAckManager.GetReadLevel())
+	assert.Equal(t, map[int32]int64{0: 2}, buffer.CountByPriority())
+	assert.Equal(t, int64(3), reader.spilledTasks.Load())
+	assert.Equal(t, int64(2), reader.spillLevels[0])
+	assert.Equal(t, int64(4), reader.spillLevels[10])
+	assert.Equal(t, map[int32]int64{0: 4, 10: 1}, reader.backlogCountByPriority())
+
+	getTaskID := func() int64 {
+		task, err := buffer.Get(context.Background())
+		require.NoError(t, err)
+		return task.TaskID
+	}
+	assert.Equal(t, int64(1), getTaskID())
+	require.True(t, reader.bufferSpilledTasks())
+	assert.Equal(t, noSpillLevel, reader.spillLevels[10])
+	assert.Equal(t, int64(2), reader.spillLevels[0])
+	assert.Equal(t, int64(5), getTaskID())
+	assert.Equal(t, int64(2), getTaskID())
+
+	require.True(t, reader.bufferSpilledTasks())
+	assert.Equal(t, noSpillLevel, reader.spillLevels[0])
+	assert.Equal(t, int64(0), reader.spilledTasks.Load())
+	assert.Equal(t, int64(3), getTaskID())
+	assert.Equal(t, int64(4), getTaskID())
+
+	for _, task := range tasks {
+		reader.completeTask(task, nil)
+	}
+	assert.Nil(t, reader.backlogCountByPriority())
+	assert.Equal(t, int64(len(tasks)), tlm.taskAckManager.GetAckLevel())
+}
+
+func TestAddTasksToBufferPausesAtReadAheadLimit(t *testing.T) {
+	controller := gomock.NewController(t)
+	timeSource := clock.NewMockedTimeSource()
+	c := defaultConfig()
+	c.GetTasksBatchSize = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(2)
+	c.TaskPriorityReadAheadLimit = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(1)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, c, timeSource)
+	reader := tlm.taskReader
+	reader.getIsolationGroupForTask = func(ctx context.Context, info *persistence.TaskInfo) (string, time.Duration) {
+		return defaultTaskBufferIsolationGroup, -1
+	}
+
+	var tasks []*persistence.TaskInfo
+	for i := 0; i < 4; i++ {
+		task := newTask(timeSource)
+		task.TaskID = int64(i + 1)
+		tasks = append(tasks, task)
+	}
+
+	require.True(t, reader.addTasksToBuffer(tasks))
+	assert.True(t, reader.readAheadPaused)
+	assert.Equal(t, int64(2), tlm.taskAckManager.GetReadLevel())
+	assert.Equal(t, int64(1), reader.spilledTasks.Load())
+	assert.Equal(t, map[int32]int64{0: 2}, reader.backlogCountByPriority())
+}
+
 func TestTaskPump(t *testing.T) {
 	cases := []struct {
 		name       string
@@ -258,6 +258,7 @@ func (m *TestTaskManager) CreateTasks(
 			ScheduleID:      scheduleID,
 			TaskID:          task.TaskID,
 			PartitionConfig: task.Data.PartitionConfig,
+			Priority:        task.Data.Priority,
 		}
 		if task.Data.ScheduleToStartTimeoutSeconds != 0 {
 			info.Expiry = m.timeSource.Now().Add(time.Duration(task.Data.ScheduleToStartTimeoutSeconds) * time.Second)
@@ -291,6 +292,9 @@ func (m *TestTaskManager) GetTasks(
 			break
 		}
 		tasks = append(tasks, it.Value().(*persistence.TaskInfo))
+		if request.BatchSize > 0 && len(tasks) == request.BatchSize {
+			break
+		}
 	}
 	return &persistence.GetTasksResponse{
 		Tasks: tasks,
@@ -35,11 +35,21 @@ func (g GRPCHandler) CancelOutstandingPoll(ctx context.Context, request *matchin
 	return &matchingv1.CancelOutstandingPollResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) DeleteTaskListTasks(ctx context.Context, request *matchingv1.DeleteTaskListTasksRequest) (*matchingv1.DeleteTaskListTasksResponse, error) {
+	response, err := g.h.DeleteTaskListTasks(ctx, proto.ToMatchingDeleteTaskListTasksRequest(request))
+	return proto.FromMatchingDeleteTaskListTasksResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) DescribeTaskList(ctx context.Context, request *matchingv1.DescribeTaskListRequest) (*matchingv1.DescribeTaskListResponse, error) {
 	response, err := g.h.DescribeTaskList(ctx, proto.ToMatchingDescribeTaskListRequest(request))
 	return proto.FromMatchingDescribeTaskListResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) GetTaskListBuildIDCompatibility(ctx context.Context, request *matchingv1.GetTaskListBuildIDCompatibilityRequest) (*matchingv1.GetTaskListBuildIDCompatibilityResponse, error) {
+	response, err := g.h.GetTaskListBuildIDCompatibility(ctx, proto.ToMatchingGetTaskListBuildIDCompatibilityRequest(request))
+	return proto.FromMatchingGetTaskListBuildIDCompatibilityResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) GetTaskListsByDomain(ctx context.Context, request *matchingv1.GetTaskListsByDomainRequest) (*matchingv1.GetTaskListsByDomainResponse, error) {
 	response, err := g.h.GetTaskListsByDomain(ctx, proto.ToMatchingGetTaskListsByDomainRequest(request))
 	return proto.FromMatchingGetTaskListsByDomainResponse(response), proto.FromError(err)
@@ -50,6 +60,11 @@ func (g GRPCHandler) ListTaskListPartitions(ctx context.Context, request *matchi
 	return proto.FromMatchingListTaskListPartitionsResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) ListTaskListTasks(ctx context.Context, request *matchingv1.ListTaskListTasksRequest) (*matchingv1.ListTaskListTasksResponse, error) {
+	response, err := g.h.ListTaskListTasks(ctx, proto.ToMatchingListTaskListTasksRequest(request))
+	return proto.FromMatchingListTaskListTasksResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) PollForActivityTask(ctx context.Context, request *matchingv1.PollForActivityTaskRequest) (*matchingv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(ctx, proto.ToMatchingPollForActivityTaskRequest(request))
 	return proto.FromMatchingPollForActivityTaskResponse(response), proto.FromError(err)
@@ -75,6 +90,11 @@ func (g GRPCHandler) RespondQueryTaskCompleted(ctx context.Context, request *mat
 	return &matchingv1.RespondQueryTaskCompletedResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) UpdateTaskListBuildIDCompatibility(ctx context.Context, request *matchingv1.UpdateTaskListBuildIDCompatibilityRequest) (*matchingv1.UpdateTaskListBuildIDCompatibilityResponse, error) {
+	response, err := g.h.UpdateTaskListBuildIDCompatibility(ctx, proto.ToMatchingUpdateTaskListBuildIDCompatibilityRequest(request))
+	return proto.FromMatchingUpdateTaskListBuildIDCompatibilityResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) UpdateTaskListPartitionConfig(ctx context.Context, request *matchingv1.UpdateTaskListPartitionConfigRequest) (*matchingv1.UpdateTaskListPartitionConfigResponse, error) {
 	response, err := g.h.UpdateTaskListPartitionConfig(ctx, proto.ToMatchingUpdateTaskListPartitionConfigRequest(request))
 	return proto.FromMatchingUpdateTaskListPartitionConfigResponse(response), proto.FromError(err)
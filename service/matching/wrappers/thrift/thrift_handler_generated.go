@@ -27,11 +27,21 @@ func (g ThriftHandler) CancelOutstandingPoll(ctx context.Context, Request *match
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) DeleteTaskListTasks(ctx context.Context, Request *matching.DeleteTaskListTasksRequest) (dp1 *shared.DeleteTaskListTasksResponse, err error) {
+	response, err := g.h.DeleteTaskListTasks(ctx, thrift.ToMatchingDeleteTaskListTasksRequest(Request))
+	return thrift.FromMatchingDeleteTaskListTasksResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) DescribeTaskList(ctx context.Context, Request *matching.DescribeTaskListRequest) (dp1 *shared.DescribeTaskListResponse, err error) {
 	response, err := g.h.DescribeTaskList(ctx, thrift.ToMatchingDescribeTaskListRequest(Request))
 	return thrift.FromMatchingDescribeTaskListResponse(response), thrift.FromError(err)
 }
 
+func (g ThriftHandler) GetTaskListBuildIDCompatibility(ctx context.Context, Request *matching.GetTaskListBuildIDCompatibilityRequest) (gp1 *shared.GetTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.h.GetTaskListBuildIDCompatibility(ctx, thrift.ToMatchingGetTaskListBuildIDCompatibilityRequest(Request))
+	return thrift.FromMatchingGetTaskListBuildIDCompatibilityResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) GetTaskListsByDomain(ctx context.Context, Request *shared.GetTaskListsByDomainRequest) (gp1 *shared.GetTaskListsByDomainResponse, err error) {
 	response, err := g.h.GetTaskListsByDomain(ctx, thrift.ToMatchingGetTaskListsByDomainRequest(Request))
 	return thrift.FromMatchingGetTaskListsByDomainResponse(response), thrift.FromError(err)
@@ -42,6 +52,11 @@ func (g ThriftHandler) ListTaskListPartitions(ctx context.Context, Request *matc
 	return thrift.FromMatchingListTaskListPartitionsResponse(response), thrift.FromError(err)
 }
 
+func (g ThriftHandler) ListTaskListTasks(ctx context.Context, Request *matching.ListTaskListTasksRequest) (lp1 *shared.ListTaskListTasksResponse, err error) {
+	response, err := g.h.ListTaskListTasks(ctx, thrift.ToMatchingListTaskListTasksRequest(Request))
+	return thrift.FromMatchingListTaskListTasksResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) PollForActivityTask(ctx context.Context, PollRequest *matching.PollForActivityTaskRequest) (pp1 *shared.PollForActivityTaskResponse, err error) {
 	response, err := g.h.PollForActivityTask(ctx, thrift.ToMatchingPollForActivityTaskRequest(PollRequest))
 	return thrift.FromMatchingPollForActivityTaskResponse(response), thrift.FromError(err)
@@ -61,3 +76,8 @@ func (g ThriftHandler) RespondQueryTaskCompleted(ctx context.Context, Request *m
 	err = g.h.RespondQueryTaskCompleted(ctx, thrift.ToMatchingRespondQueryTaskCompletedRequest(Request))
 	return thrift.FromError(err)
 }
+
+func (g ThriftHandler) UpdateTaskListBuildIDCompatibility(ctx context.Context, Request *matching.UpdateTaskListBuildIDCompatibilityRequest) (up1 *shared.UpdateTaskListBuildIDCompatibilityResponse, err error) {
+	response, err := g.h.UpdateTaskListBuildIDCompatibility(ctx, thrift.ToMatchingUpdateTaskListBuildIDCompatibilityRequest(Request))
+	return thrift.FromMatchingUpdateTaskListBuildIDCompatibilityResponse(response), thrift.FromError(err)
+}
@@ -62,6 +62,9 @@ type (
 
 	// Store is a generic container for any storage configuration that should be parsed by the implementation.
 	Store struct {
+		// Type is the storage backend. Supported values: etcd|sql.
+		// Default: etcd
+		Type          string    `yaml:"type"`
 		StorageParams *YamlNode `yaml:"storageParams"`
 	}
 
@@ -71,6 +74,32 @@ type (
 		Mode string `yaml:"mode"` // TODO: this should be an ENUM with possible modes: enabled, read_only, proxy, disabled
 		// ShardNum is defined for fixed namespace.
 		ShardNum int64 `yaml:"shardNum"`
+		// Placement selects the strategy used to place shards on executors.
+		Placement Placement `yaml:"placement"`
+	}
+
+	// Placement is the shard placement configuration of a namespace.
+	Placement struct {
+		// Strategy is the placement strategy. Supported values: default|consistent_hash|bin_packing|affinity.
+		// Default: default, which scatters reassigned shards round-robin and moves hot shards one at a time.
+		Strategy string `yaml:"strategy"`
+
+		// DryRun only reports the moves the strategy would make, the default strategy is still applied.
+		DryRun bool `yaml:"dryRun"`
+
+		// CapacityKey is the executor metadata key holding the executor capacity used by bin_packing.
+		// Executors that do not report a capacity get a capacity of 1.
+		// Default: capacity
+		CapacityKey string `yaml:"capacityKey"`
+
+		// Affinity lists the executor labels that shards must be placed on when using the affinity strategy.
+		Affinity []PlacementAffinity `yaml:"affinity"`
+	}
+
+	// PlacementAffinity pins a set of shards to the executors reporting all the given metadata labels.
+	PlacementAffinity struct {
+		Shards []string          `yaml:"shards"`
+		Labels map[string]string `yaml:"labels"`
 	}
 
 	Election struct {
@@ -105,11 +134,23 @@ type (
 	}
 )
 
+const (
+	StoreTypeEtcd = "etcd"
+	StoreTypeSQL  = "sql"
+)
+
 const (
 	NamespaceTypeFixed     = "fixed"
 	NamespaceTypeEphemeral = "ephemeral"
 )
 
+const (
+	PlacementStrategyDefault        = "default"
+	PlacementStrategyConsistentHash = "consistent_hash"
+	PlacementStrategyBinPacking     = "bin_packing"
+	PlacementStrategyAffinity       = "affinity"
+)
+
 const (
 	MigrationModeINVALID          = "invalid"
 	MigrationModeLOCALPASSTHROUGH = "local_pass"
@@ -0,0 +1,256 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+// CordonExecutor stops the rebalance loop from assigning new shards to an executor.
+// The shards the executor already owns are left in place.
+func (h *handlerImpl) CordonExecutor(ctx context.Context, request *types.CordonExecutorRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateExecutorRequest(ctx, request.GetNamespace(), request.GetExecutorID()); err != nil {
+		return err
+	}
+
+	return h.updateControls(ctx, request.GetNamespace(), func(controls *store.NamespaceControls) error {
+		control := controls.Executors[request.GetExecutorID()]
+		control.Cordoned = true
+		control.UpdatedAt = h.timeSource.Now()
+		controls.Executors[request.GetExecutorID()] = control
+		return nil
+	})
+}
+
+// UncordonExecutor clears both the cordon and the drain of an executor,
+// making it eligible for new shards again.
+func (h *handlerImpl) UncordonExecutor(ctx context.Context, request *types.UncordonExecutorRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateNamespace(request.GetNamespace()); err != nil {
+		return err
+	}
+	if request.GetExecutorID() == "" {
+		return &types.BadRequestError{Message: "executor ID is not set"}
+	}
+
+	return h.updateControls(ctx, request.GetNamespace(), func(controls *store.NamespaceControls) error {
+		delete(controls.Executors, request.GetExecutorID())
+		return nil
+	})
+}
+
+// DrainExecutor cordons an executor and makes the rebalance loop move its shards
+// to other executors, at most MaxConcurrentMoves at a time.
+func (h *handlerImpl) DrainExecutor(ctx context.Context, request *types.DrainExecutorRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if request.GetMaxConcurrentMoves() < 0 {
+		return &types.BadRequestError{Message: fmt.Sprintf("max concurrent moves must not be negative, got %d", request.GetMaxConcurrentMoves())}
+	}
+	if err := h.validateExecutorRequest(ctx, request.GetNamespace(), request.GetExecutorID()); err != nil {
+		return err
+	}
+
+	return h.updateControls(ctx, request.GetNamespace(), func(controls *store.NamespaceControls) error {
+		control := controls.Executors[request.GetExecutorID()]
+		control.Draining = true
+		control.MaxConcurrentMoves = request.GetMaxConcurrentMoves()
+		control.UpdatedAt = h.timeSource.Now()
+		controls.Executors[request.GetExecutorID()] = control
+		return nil
+	})
+}
+
+// PinShard makes the rebalance loop keep a shard on the given executor.
+// A pin takes precedence over cordons and drains.
+func (h *handlerImpl) PinShard(ctx context.Context, request *types.PinShardRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if request.GetShardKey() == "" {
+		return &types.BadRequestError{Message: "shard key is not set"}
+	}
+	if err := h.validateExecutorRequest(ctx, request.GetNamespace(), request.GetExecutorID()); err != nil {
+		return err
+	}
+
+	return h.updateControls(ctx, request.GetNamespace(), func(controls *store.NamespaceControls) error {
+		controls.PinnedShards[request.GetShardKey()] = request.GetExecutorID()
+		return nil
+	})
+}
+
+// UnpinShard returns a pinned shard to the control of the rebalance loop.
+func (h *handlerImpl) UnpinShard(ctx context.Context, request *types.UnpinShardRequest) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateNamespace(request.GetNamespace()); err != nil {
+		return err
+	}
+	if request.GetShardKey() == "" {
+		return &types.BadRequestError{Message: "shard key is not set"}
+	}
+
+	return h.updateControls(ctx, request.GetNamespace(), func(controls *store.NamespaceControls) error {
+		if _, ok := controls.PinnedShards[request.GetShardKey()]; !ok {
+			return &types.BadRequestError{Message: fmt.Sprintf("shard %q is not pinned", request.GetShardKey())}
+		}
+		delete(controls.PinnedShards, request.GetShardKey())
+		return nil
+	})
+}
+
+// DescribeNamespaceControls returns the controls of every executor of the namespace,
+// and of executors that have controls but are no longer registered, together with the pinned shards.
+func (h *handlerImpl) DescribeNamespaceControls(ctx context.Context, request *types.DescribeNamespaceControlsRequest) (resp *types.DescribeNamespaceControlsResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateNamespace(request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	state, err := h.storage.GetState(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to get namespace state: %v", err)}
+	}
+
+	executorIDs := make(map[string]struct{}, len(state.Executors))
+	for executorID := range state.Executors {
+		executorIDs[executorID] = struct{}{}
+	}
+	for executorID := range state.Controls.Executors {
+		executorIDs[executorID] = struct{}{}
+	}
+
+	resp = &types.DescribeNamespaceControlsResponse{
+		Executors:    make([]*types.ExecutorControl, 0, len(executorIDs)),
+		PinnedShards: make([]*types.ShardPin, 0, len(state.Controls.PinnedShards)),
+	}
+	for executorID := range executorIDs {
+		control := state.Controls.Executors[executorID]
+		resp.Executors = append(resp.Executors, &types.ExecutorControl{
+			ExecutorID:         executorID,
+			Cordoned:           control.Cordoned,
+			Draining:           control.Draining,
+			MaxConcurrentMoves: control.MaxConcurrentMoves,
+			AssignedShards:     int32(len(state.ShardAssignments[executorID].AssignedShards)),
+		})
+	}
+	sort.Slice(resp.Executors, func(i, j int) bool {
+		return resp.Executors[i].ExecutorID < resp.Executors[j].ExecutorID
+	})
+
+	for shardKey, executorID := range state.Controls.PinnedShards {
+		resp.PinnedShards = append(resp.PinnedShards, &types.ShardPin{
+			ShardKey:   shardKey,
+			ExecutorID: executorID,
+		})
+	}
+	sort.Slice(resp.PinnedShards, func(i, j int) bool {
+		return resp.PinnedShards[i].ShardKey < resp.PinnedShards[j].ShardKey
+	})
+
+	return resp, nil
+}
+
+func (h *handlerImpl) validateNamespace(namespace string) error {
+	if !slices.ContainsFunc(h.shardDistributionCfg.Namespaces, func(ns config.Namespace) bool {
+		return ns.Name == namespace
+	}) {
+		return &types.NamespaceNotFoundError{Namespace: namespace}
+	}
+	return nil
+}
+
+// validateExecutorRequest checks that the namespace exists and that the executor is registered in it.
+func (h *handlerImpl) validateExecutorRequest(ctx context.Context, namespace, executorID string) error {
+	if err := h.validateNamespace(namespace); err != nil {
+		return err
+	}
+	if executorID == "" {
+		return &types.BadRequestError{Message: "executor ID is not set"}
+	}
+
+	_, _, err := h.storage.GetHeartbeat(ctx, namespace, executorID)
+	if errors.Is(err, store.ErrExecutorNotFound) {
+		return &types.BadRequestError{Message: fmt.Sprintf("executor %q is not registered in namespace %q", executorID, namespace)}
+	}
+	if err != nil {
+		return &types.InternalServiceError{Message: fmt.Sprintf("failed to get executor: %v", err)}
+	}
+	return nil
+}
+
+// updateControls applies update to the current controls of the namespace and writes them back.
+// The read-modify-write is retried if the controls were changed concurrently.
+func (h *handlerImpl) updateControls(ctx context.Context, namespace string, update func(*store.NamespaceControls) error) error {
+	retryPolicy := backoff.NewExponentialRetryPolicy(versionConflictRetryInitialInterval)
+	retryPolicy.SetMaximumInterval(versionConflictRetryMaxInterval)
+	retryPolicy.SetMaximumAttempts(versionConflictRetryMaxAttempts)
+
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(retryPolicy),
+		backoff.WithRetryableError(func(err error) bool {
+			return errors.Is(err, store.ErrVersionConflict)
+		}),
+	)
+
+	err := throttleRetry.Do(ctx, func(ctx context.Context) error {
+		controls, err := h.storage.GetNamespaceControls(ctx, namespace)
+		if err != nil {
+			return err
+		}
+		if controls.Executors == nil {
+			controls.Executors = make(map[string]store.ExecutorControl)
+		}
+		if controls.PinnedShards == nil {
+			controls.PinnedShards = make(map[string]string)
+		}
+
+		if err := update(controls); err != nil {
+			return err
+		}
+		return h.storage.UpdateNamespaceControls(ctx, namespace, controls)
+	})
+
+	var badRequestErr *types.BadRequestError
+	if errors.As(err, &badRequestErr) {
+		return err
+	}
+	if err != nil {
+		return &types.InternalServiceError{Message: fmt.Sprintf("failed to update namespace controls: %v", err)}
+	}
+	return nil
+}
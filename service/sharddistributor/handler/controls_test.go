@@ -0,0 +1,260 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+func newTestControlsHandler(t *testing.T) (*handlerImpl, *store.MockStore, clock.MockedTimeSource) {
+	ctrl := gomock.NewController(t)
+	mockStore := store.NewMockStore(ctrl)
+	timeSource := clock.NewMockedTimeSource()
+	handler := &handlerImpl{
+		logger: testlogger.New(t),
+		shardDistributionCfg: config.ShardDistribution{
+			Namespaces: []config.Namespace{{Name: _testNamespaceFixed, Type: config.NamespaceTypeFixed, ShardNum: 4}},
+		},
+		storage:    mockStore,
+		timeSource: timeSource,
+	}
+	return handler, mockStore, timeSource
+}
+
+// expectControlsUpdate expects a read-modify-write of the controls and returns the written controls.
+func expectControlsUpdate(mockStore *store.MockStore, current *store.NamespaceControls) *store.NamespaceControls {
+	written := &store.NamespaceControls{}
+	mockStore.EXPECT().GetNamespaceControls(gomock.Any(), _testNamespaceFixed).Return(current, nil)
+	mockStore.EXPECT().UpdateNamespaceControls(gomock.Any(), _testNamespaceFixed, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, controls *store.NamespaceControls) error {
+			*written = *controls
+			return nil
+		},
+	)
+	return written
+}
+
+func TestCordonExecutor(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockStore, timeSource := newTestControlsHandler(t)
+		mockStore.EXPECT().GetHeartbeat(gomock.Any(), _testNamespaceFixed, "exec-1").Return(&store.HeartbeatState{}, nil, nil)
+		written := expectControlsUpdate(mockStore, &store.NamespaceControls{ModRevision: 5})
+
+		err := handler.CordonExecutor(context.Background(), &types.CordonExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1"})
+		require.NoError(t, err)
+		require.Equal(t, store.ExecutorControl{Cordoned: true, UpdatedAt: timeSource.Now()}, written.Executors["exec-1"])
+		require.Equal(t, int64(5), written.ModRevision)
+	})
+
+	t.Run("NamespaceNotFound", func(t *testing.T) {
+		handler, _, _ := newTestControlsHandler(t)
+		err := handler.CordonExecutor(context.Background(), &types.CordonExecutorRequest{Namespace: "unknown", ExecutorID: "exec-1"})
+		var notFoundErr *types.NamespaceNotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("ExecutorIDNotSet", func(t *testing.T) {
+		handler, _, _ := newTestControlsHandler(t)
+		err := handler.CordonExecutor(context.Background(), &types.CordonExecutorRequest{Namespace: _testNamespaceFixed})
+		var badRequestErr *types.BadRequestError
+		require.ErrorAs(t, err, &badRequestErr)
+	})
+
+	t.Run("ExecutorNotFound", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetHeartbeat(gomock.Any(), _testNamespaceFixed, "exec-1").Return(nil, nil, store.ErrExecutorNotFound)
+
+		err := handler.CordonExecutor(context.Background(), &types.CordonExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1"})
+		var badRequestErr *types.BadRequestError
+		require.ErrorAs(t, err, &badRequestErr)
+		require.Contains(t, err.Error(), "is not registered")
+	})
+
+	t.Run("GetHeartbeatFailure", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetHeartbeat(gomock.Any(), _testNamespaceFixed, "exec-1").Return(nil, nil, errors.New("storage failure"))
+
+		err := handler.CordonExecutor(context.Background(), &types.CordonExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1"})
+		var internalErr *types.InternalServiceError
+		require.ErrorAs(t, err, &internalErr)
+	})
+
+	t.Run("RetriesOnVersionConflict", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetHeartbeat(gomock.Any(), _testNamespaceFixed, "exec-1").Return(&store.HeartbeatState{}, nil, nil)
+		gomock.InOrder(
+			mockStore.EXPECT().GetNamespaceControls(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceControls{}, nil),
+			mockStore.EXPECT().UpdateNamespaceControls(gomock.Any(), _testNamespaceFixed, gomock.Any()).Return(store.ErrVersionConflict),
+			mockStore.EXPECT().GetNamespaceControls(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceControls{ModRevision: 2}, nil),
+			mockStore.EXPECT().UpdateNamespaceControls(gomock.Any(), _testNamespaceFixed, gomock.Any()).Return(nil),
+		)
+
+		err := handler.CordonExecutor(context.Background(), &types.CordonExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1"})
+		require.NoError(t, err)
+	})
+
+	t.Run("UpdateFailure", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetHeartbeat(gomock.Any(), _testNamespaceFixed, "exec-1").Return(&store.HeartbeatState{}, nil, nil)
+		mockStore.EXPECT().GetNamespaceControls(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceControls{}, nil)
+		mockStore.EXPECT().UpdateNamespaceControls(gomock.Any(), _testNamespaceFixed, gomock.Any()).Return(errors.New("storage failure"))
+
+		err := handler.CordonExecutor(context.Background(), &types.CordonExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1"})
+		var internalErr *types.InternalServiceError
+		require.ErrorAs(t, err, &internalErr)
+	})
+}
+
+func TestUncordonExecutor(t *testing.T) {
+	handler, mockStore, _ := newTestControlsHandler(t)
+	written := expectControlsUpdate(mockStore, &store.NamespaceControls{
+		Executors: map[string]store.ExecutorControl{
+			"exec-1": {Draining: true, MaxConcurrentMoves: 2},
+			"exec-2": {Cordoned: true},
+		},
+	})
+
+	// Uncordoning does not require the executor to be registered, so controls of removed executors can be cleared.
+	err := handler.UncordonExecutor(context.Background(), &types.UncordonExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]store.ExecutorControl{"exec-2": {Cordoned: true}}, written.Executors)
+}
+
+func TestDrainExecutor(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockStore, timeSource := newTestControlsHandler(t)
+		mockStore.EXPECT().GetHeartbeat(gomock.Any(), _testNamespaceFixed, "exec-1").Return(&store.HeartbeatState{}, nil, nil)
+		written := expectControlsUpdate(mockStore, &store.NamespaceControls{
+			Executors: map[string]store.ExecutorControl{"exec-1": {Cordoned: true}},
+		})
+
+		err := handler.DrainExecutor(context.Background(), &types.DrainExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1", MaxConcurrentMoves: 3})
+		require.NoError(t, err)
+		require.Equal(t, store.ExecutorControl{Cordoned: true, Draining: true, MaxConcurrentMoves: 3, UpdatedAt: timeSource.Now()}, written.Executors["exec-1"])
+	})
+
+	t.Run("NegativeMaxConcurrentMoves", func(t *testing.T) {
+		handler, _, _ := newTestControlsHandler(t)
+		err := handler.DrainExecutor(context.Background(), &types.DrainExecutorRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1", MaxConcurrentMoves: -1})
+		var badRequestErr *types.BadRequestError
+		require.ErrorAs(t, err, &badRequestErr)
+	})
+}
+
+func TestPinShard(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetHeartbeat(gomock.Any(), _testNamespaceFixed, "exec-1").Return(&store.HeartbeatState{}, nil, nil)
+		written := expectControlsUpdate(mockStore, &store.NamespaceControls{})
+
+		err := handler.PinShard(context.Background(), &types.PinShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1", ExecutorID: "exec-1"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"1": "exec-1"}, written.PinnedShards)
+	})
+
+	t.Run("ShardKeyNotSet", func(t *testing.T) {
+		handler, _, _ := newTestControlsHandler(t)
+		err := handler.PinShard(context.Background(), &types.PinShardRequest{Namespace: _testNamespaceFixed, ExecutorID: "exec-1"})
+		var badRequestErr *types.BadRequestError
+		require.ErrorAs(t, err, &badRequestErr)
+	})
+}
+
+func TestUnpinShard(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		written := expectControlsUpdate(mockStore, &store.NamespaceControls{
+			PinnedShards: map[string]string{"1": "exec-1", "2": "exec-2"},
+		})
+
+		err := handler.UnpinShard(context.Background(), &types.UnpinShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"2": "exec-2"}, written.PinnedShards)
+	})
+
+	t.Run("ShardNotPinned", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetNamespaceControls(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceControls{}, nil)
+
+		err := handler.UnpinShard(context.Background(), &types.UnpinShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1"})
+		var badRequestErr *types.BadRequestError
+		require.ErrorAs(t, err, &badRequestErr)
+		require.Contains(t, err.Error(), "is not pinned")
+	})
+}
+
+func TestDescribeNamespaceControls(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceState{
+			Executors: map[string]store.HeartbeatState{
+				"exec-2": {Status: types.ExecutorStatusACTIVE},
+				"exec-1": {Status: types.ExecutorStatusACTIVE},
+			},
+			ShardAssignments: map[string]store.AssignedState{
+				"exec-1": {AssignedShards: map[string]*types.ShardAssignment{"0": {}, "1": {}}},
+				"exec-2": {AssignedShards: map[string]*types.ShardAssignment{"2": {}}},
+			},
+			Controls: store.NamespaceControls{
+				Executors: map[string]store.ExecutorControl{
+					"exec-1": {Draining: true, MaxConcurrentMoves: 1},
+					"exec-3": {Cordoned: true},
+				},
+				PinnedShards: map[string]string{"2": "exec-2", "0": "exec-1"},
+			},
+		}, nil)
+
+		resp, err := handler.DescribeNamespaceControls(context.Background(), &types.DescribeNamespaceControlsRequest{Namespace: _testNamespaceFixed})
+		require.NoError(t, err)
+		require.Equal(t, &types.DescribeNamespaceControlsResponse{
+			Executors: []*types.ExecutorControl{
+				{ExecutorID: "exec-1", Draining: true, MaxConcurrentMoves: 1, AssignedShards: 2},
+				{ExecutorID: "exec-2", AssignedShards: 1},
+				{ExecutorID: "exec-3", Cordoned: true},
+			},
+			PinnedShards: []*types.ShardPin{
+				{ShardKey: "0", ExecutorID: "exec-1"},
+				{ShardKey: "2", ExecutorID: "exec-2"},
+			},
+		}, resp)
+	})
+
+	t.Run("GetStateFailure", func(t *testing.T) {
+		handler, mockStore, _ := newTestControlsHandler(t)
+		mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(nil, errors.New("storage failure"))
+
+		_, err := handler.DescribeNamespaceControls(context.Background(), &types.DescribeNamespaceControlsRequest{Namespace: _testNamespaceFixed})
+		var internalErr *types.InternalServiceError
+		require.ErrorAs(t, err, &internalErr)
+	})
+}
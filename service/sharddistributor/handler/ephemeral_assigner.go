@@ -44,6 +44,8 @@ import (
 //
 // Within the batch the least-loaded ACTIVE executor is chosen per shard, with
 // the in-batch running count updated after each pick so load is spread evenly.
+// Cordoned and draining executors are skipped, and pinned shards go to their
+// pinned executor.
 func (h *handlerImpl) assignEphemeralBatch(ctx context.Context, namespace string, shardKeys []string) (map[string]*types.GetShardOwnerResponse, error) {
 	state, err := h.storage.GetState(ctx, namespace)
 	if err != nil {
@@ -55,7 +57,7 @@ func (h *handlerImpl) assignEphemeralBatch(ctx context.Context, namespace string
 		return nil, err
 	}
 
-	chosenExecutors, err := pickExecutors(namespace, shardKeys, assignedCounts)
+	chosenExecutors, err := pickExecutors(namespace, shardKeys, assignedCounts, activePins(state))
 	if err != nil {
 		return nil, err
 	}
@@ -80,25 +82,55 @@ func (h *handlerImpl) assignEphemeralBatch(ctx context.Context, namespace string
 }
 
 // buildAssignedCounts returns a map of executorID -> current shard count for
-// all ACTIVE executors in the given namespace state.
+// all ACTIVE executors in the given namespace state. Executors cordoned or
+// drained by an operator are left out, unless no other executor remains.
 func buildAssignedCounts(state *store.NamespaceState) (map[string]int, error) {
 	counts := make(map[string]int, len(state.ShardAssignments))
+	excluded := make(map[string]int)
 	for executorID, assignment := range state.ShardAssignments {
 		executorState, ok := state.Executors[executorID]
 		if !ok || executorState.Status != types.ExecutorStatusACTIVE {
 			continue
 		}
+		if state.Controls.Executors[executorID].IsExcluded() {
+			excluded[executorID] = len(assignment.AssignedShards)
+			continue
+		}
 		counts[executorID] = len(assignment.AssignedShards)
 	}
+	if len(counts) == 0 {
+		return excluded, nil
+	}
 	return counts, nil
 }
 
-// pickExecutors assigns each shard key to the least-loaded active executor,
-// updating the in-batch running count after every pick to spread load evenly.
+// activePins returns the shards pinned to an ACTIVE executor.
+// It returns a map of shardKey -> pinned executorID.
+func activePins(state *store.NamespaceState) map[string]string {
+	pins := make(map[string]string, len(state.Controls.PinnedShards))
+	for shardKey, executorID := range state.Controls.PinnedShards {
+		if state.Executors[executorID].Status == types.ExecutorStatusACTIVE {
+			pins[shardKey] = executorID
+		}
+	}
+	return pins
+}
+
+// pickExecutors assigns each shard key to its pinned executor if it has one, and
+// otherwise to the least-loaded active executor, updating the in-batch running
+// count after every pick to spread load evenly.
 // It returns a map of shardKey -> chosen executorID.
-func pickExecutors(namespace string, shardKeys []string, assignedCounts map[string]int) (map[string]string, error) {
+func pickExecutors(namespace string, shardKeys []string, assignedCounts map[string]int, pinnedShards map[string]string) (map[string]string, error) {
 	chosenExecutors := make(map[string]string, len(shardKeys))
 	for _, shardKey := range shardKeys {
+		if executorID, ok := pinnedShards[shardKey]; ok {
+			chosenExecutors[shardKey] = executorID
+			if _, counted := assignedCounts[executorID]; counted {
+				assignedCounts[executorID]++
+			}
+			continue
+		}
+
 		chosenExecutor := ""
 		minCount := math.MaxInt
 		for executorID, count := range assignedCounts {
@@ -115,6 +115,100 @@ func TestAssignEphemeralBatch(t *testing.T) {
 			},
 			expectedOwners: map[string]string{"NON-EXISTING-SHARD": "owner1"},
 		},
+		{
+			name:      "SkipCordonedExecutor",
+			namespace: _testNamespaceEphemeral,
+			shardKeys: []string{"NON-EXISTING-SHARD"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceEphemeral).Return(&store.NamespaceState{
+					Executors: map[string]store.HeartbeatState{
+						"owner1": {Status: types.ExecutorStatusACTIVE},
+						"owner2": {Status: types.ExecutorStatusACTIVE},
+					},
+					ShardAssignments: map[string]store.AssignedState{
+						"owner1": {
+							AssignedShards: map[string]*types.ShardAssignment{
+								"shard1": {Status: types.AssignmentStatusREADY},
+								"shard2": {Status: types.AssignmentStatusREADY},
+							},
+						},
+						// owner2 has fewer shards but is cordoned by an operator, so should be skipped
+						"owner2": {AssignedShards: map[string]*types.ShardAssignment{}},
+					},
+					Controls: store.NamespaceControls{
+						Executors: map[string]store.ExecutorControl{"owner2": {Cordoned: true}},
+					},
+				}, nil)
+				mockStore.EXPECT().AssignShards(gomock.Any(), _testNamespaceEphemeral, gomock.Any(), gomock.Any()).Return(nil)
+				mockStore.EXPECT().GetExecutor(gomock.Any(), _testNamespaceEphemeral, "owner1").Return(&store.ShardOwner{
+					ExecutorID: "owner1",
+					Metadata:   map[string]string{"ip": "127.0.0.1", "port": "1234"},
+				}, nil)
+			},
+			expectedOwners: map[string]string{"NON-EXISTING-SHARD": "owner1"},
+		},
+		{
+			name:      "AllExecutorsCordoned",
+			namespace: _testNamespaceEphemeral,
+			shardKeys: []string{"NON-EXISTING-SHARD"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceEphemeral).Return(&store.NamespaceState{
+					Executors: map[string]store.HeartbeatState{
+						"owner1": {Status: types.ExecutorStatusACTIVE},
+					},
+					ShardAssignments: map[string]store.AssignedState{
+						"owner1": {AssignedShards: map[string]*types.ShardAssignment{}},
+					},
+					Controls: store.NamespaceControls{
+						Executors: map[string]store.ExecutorControl{"owner1": {Draining: true}},
+					},
+				}, nil)
+				// The cordon is ignored rather than failing the assignment.
+				mockStore.EXPECT().AssignShards(gomock.Any(), _testNamespaceEphemeral, gomock.Any(), gomock.Any()).Return(nil)
+				mockStore.EXPECT().GetExecutor(gomock.Any(), _testNamespaceEphemeral, "owner1").Return(&store.ShardOwner{
+					ExecutorID: "owner1",
+					Metadata:   map[string]string{"ip": "127.0.0.1", "port": "1234"},
+				}, nil)
+			},
+			expectedOwners: map[string]string{"NON-EXISTING-SHARD": "owner1"},
+		},
+		{
+			name:      "PinnedShard",
+			namespace: _testNamespaceEphemeral,
+			shardKeys: []string{"PINNED-SHARD", "PINNED-TO-INACTIVE-SHARD"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceEphemeral).Return(&store.NamespaceState{
+					Executors: map[string]store.HeartbeatState{
+						"owner1": {Status: types.ExecutorStatusACTIVE},
+						"owner2": {Status: types.ExecutorStatusACTIVE},
+					},
+					ShardAssignments: map[string]store.AssignedState{
+						"owner1": {
+							AssignedShards: map[string]*types.ShardAssignment{
+								"shard1": {Status: types.AssignmentStatusREADY},
+								"shard2": {Status: types.AssignmentStatusREADY},
+							},
+						},
+						"owner2": {AssignedShards: map[string]*types.ShardAssignment{}},
+					},
+					Controls: store.NamespaceControls{
+						// The pin wins over both the load and the cordon of owner1.
+						Executors:    map[string]store.ExecutorControl{"owner1": {Cordoned: true}},
+						PinnedShards: map[string]string{"PINNED-SHARD": "owner1", "PINNED-TO-INACTIVE-SHARD": "owner3"},
+					},
+				}, nil)
+				mockStore.EXPECT().AssignShards(gomock.Any(), _testNamespaceEphemeral, gomock.Any(), gomock.Any()).Return(nil)
+				mockStore.EXPECT().GetExecutor(gomock.Any(), _testNamespaceEphemeral, "owner1").Return(&store.ShardOwner{
+					ExecutorID: "owner1",
+					Metadata:   map[string]string{"ip": "127.0.0.1", "port": "1234"},
+				}, nil)
+				mockStore.EXPECT().GetExecutor(gomock.Any(), _testNamespaceEphemeral, "owner2").Return(&store.ShardOwner{
+					ExecutorID: "owner2",
+					Metadata:   map[string]string{"ip": "127.0.0.1", "port": "1234"},
+				}, nil)
+			},
+			expectedOwners: map[string]string{"PINNED-SHARD": "owner1", "PINNED-TO-INACTIVE-SHARD": "owner2"},
+		},
 		{
 			name:      "GetStateFailure",
 			namespace: _testNamespaceEphemeral,
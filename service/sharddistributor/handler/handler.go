@@ -61,6 +61,7 @@ func NewHandler(
 ) Handler {
 	handler := &handlerImpl{
 		logger:               logger,
+		timeSource:           timeSource,
 		shardDistributionCfg: shardDistributionCfg,
 		storage:              storage,
 	}
@@ -73,7 +74,8 @@ func NewHandler(
 }
 
 type handlerImpl struct {
-	logger log.Logger
+	logger     log.Logger
+	timeSource clock.TimeSource
 
 	startWG sync.WaitGroup
 
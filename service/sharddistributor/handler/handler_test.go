@@ -54,6 +54,7 @@ func newTestHandler(t *testing.T, cfg config.ShardDistribution, mockStore *store
 		logger:               testlogger.New(t),
 		shardDistributionCfg: cfg,
 		storage:              mockStore,
+		timeSource:           clock.NewRealTimeSource(),
 	}
 	handler.batcher = newShardBatcher(clock.NewRealTimeSource(), 10*time.Millisecond, handler.assignEphemeralBatch)
 	handler.batcher.Start()
@@ -44,6 +44,13 @@ type Handler interface {
 	GetShardOwner(context.Context, *types.GetShardOwnerRequest) (*types.GetShardOwnerResponse, error)
 
 	WatchNamespaceState(*types.WatchNamespaceStateRequest, WatchNamespaceStateServer) error
+
+	CordonExecutor(context.Context, *types.CordonExecutorRequest) error
+	UncordonExecutor(context.Context, *types.UncordonExecutorRequest) error
+	DrainExecutor(context.Context, *types.DrainExecutorRequest) error
+	PinShard(context.Context, *types.PinShardRequest) error
+	UnpinShard(context.Context, *types.UnpinShardRequest) error
+	DescribeNamespaceControls(context.Context, *types.DescribeNamespaceControlsRequest) (*types.DescribeNamespaceControlsResponse, error)
 }
 
 type Executor interface {
@@ -42,6 +42,49 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// CordonExecutor mocks base method.
+func (m *MockHandler) CordonExecutor(arg0 context.Context, arg1 *types.CordonExecutorRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CordonExecutor", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CordonExecutor indicates an expected call of CordonExecutor.
+func (mr *MockHandlerMockRecorder) CordonExecutor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CordonExecutor", reflect.TypeOf((*MockHandler)(nil).CordonExecutor), arg0, arg1)
+}
+
+// DescribeNamespaceControls mocks base method.
+func (m *MockHandler) DescribeNamespaceControls(arg0 context.Context, arg1 *types.DescribeNamespaceControlsRequest) (*types.DescribeNamespaceControlsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceControls", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeNamespaceControlsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceControls indicates an expected call of DescribeNamespaceControls.
+func (mr *MockHandlerMockRecorder) DescribeNamespaceControls(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceControls", reflect.TypeOf((*MockHandler)(nil).DescribeNamespaceControls), arg0, arg1)
+}
+
+// DrainExecutor mocks base method.
+func (m *MockHandler) DrainExecutor(arg0 context.Context, arg1 *types.DrainExecutorRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainExecutor", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DrainExecutor indicates an expected call of DrainExecutor.
+func (mr *MockHandlerMockRecorder) DrainExecutor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainExecutor", reflect.TypeOf((*MockHandler)(nil).DrainExecutor), arg0, arg1)
+}
+
 // GetShardOwner mocks base method.
 func (m *MockHandler) GetShardOwner(arg0 context.Context, arg1 *types.GetShardOwnerRequest) (*types.GetShardOwnerResponse, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +115,20 @@ func (mr *MockHandlerMockRecorder) Health(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockHandler)(nil).Health), arg0)
 }
 
+// PinShard mocks base method.
+func (m *MockHandler) PinShard(arg0 context.Context, arg1 *types.PinShardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinShard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinShard indicates an expected call of PinShard.
+func (mr *MockHandlerMockRecorder) PinShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinShard", reflect.TypeOf((*MockHandler)(nil).PinShard), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockHandler) Start() {
 	m.ctrl.T.Helper()
@@ -96,6 +153,34 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockHandler)(nil).Stop))
 }
 
+// UncordonExecutor mocks base method.
+func (m *MockHandler) UncordonExecutor(arg0 context.Context, arg1 *types.UncordonExecutorRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UncordonExecutor", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UncordonExecutor indicates an expected call of UncordonExecutor.
+func (mr *MockHandlerMockRecorder) UncordonExecutor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UncordonExecutor", reflect.TypeOf((*MockHandler)(nil).UncordonExecutor), arg0, arg1)
+}
+
+// UnpinShard mocks base method.
+func (m *MockHandler) UnpinShard(arg0 context.Context, arg1 *types.UnpinShardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinShard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinShard indicates an expected call of UnpinShard.
+func (mr *MockHandlerMockRecorder) UnpinShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinShard", reflect.TypeOf((*MockHandler)(nil).UnpinShard), arg0, arg1)
+}
+
 // WatchNamespaceState mocks base method.
 func (m *MockHandler) WatchNamespaceState(arg0 *types.WatchNamespaceStateRequest, arg1 WatchNamespaceStateServer) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,159 @@
+package process
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+// controlledPlacement is the outcome of applying the operator controls before placement.
+type controlledPlacement struct {
+	// eligibleExecutors are the active executors that may receive shards.
+	eligibleExecutors []string
+	// shardsToReassign are the shards to place, including the shards drained in this cycle.
+	shardsToReassign []string
+	// heldAssignments are the shards that are kept out of placement, because they are pinned
+	// or owned by a cordoned executor. They are merged back by mergeHeldAssignments.
+	heldAssignments map[string][]string
+}
+
+// applyControls applies the cordons, drains and pins of the namespace to the shards to reassign
+// and the current assignments, which are modified in place:
+//   - pinned shards are moved to their pinned executor, if it is active, and kept out of placement;
+//   - the shards of cordoned executors are kept out of placement;
+//   - draining executors give up to MaxConcurrentMoves of their shards, minus the shards they have not released yet.
+//
+// Cordoned and draining executors are not eligible for new shards, unless no other active executor remains.
+func (p *namespaceProcessor) applyControls(
+	namespaceState *store.NamespaceState,
+	activeExecutors []string,
+	shardsToReassign []string,
+	currentAssignments map[string][]string,
+	metricsScope metrics.Scope,
+) controlledPlacement {
+	controls := namespaceState.Controls
+	result := controlledPlacement{
+		eligibleExecutors: activeExecutors,
+		shardsToReassign:  shardsToReassign,
+		heldAssignments:   make(map[string][]string),
+	}
+	if controls.IsEmpty() {
+		return result
+	}
+
+	p.applyPins(controls.PinnedShards, &result, currentAssignments)
+
+	var excludedExecutors []string
+	for _, executorID := range activeExecutors {
+		if controls.Executors[executorID].IsExcluded() {
+			excludedExecutors = append(excludedExecutors, executorID)
+		}
+	}
+	if len(excludedExecutors) == 0 {
+		return result
+	}
+	if len(excludedExecutors) == len(activeExecutors) {
+		p.logger.Warn("All active executors are cordoned or draining, ignoring cordons and drains", tag.ShardExecutors(excludedExecutors))
+		return result
+	}
+
+	for _, executorID := range excludedExecutors {
+		shards := slices.Clone(currentAssignments[executorID])
+		sort.Strings(shards)
+		delete(currentAssignments, executorID)
+
+		control := controls.Executors[executorID]
+		if control.Draining {
+			moves := drainBudget(control, namespaceState, executorID, len(shards))
+			if moves > 0 {
+				p.logger.Info("Draining shards from executor",
+					tag.ShardExecutor(executorID),
+					tag.Dynamic("shards", shards[:moves]),
+					tag.Dynamic("remaining_shards", len(shards)-moves),
+				)
+				metricsScope.AddCounter(metrics.ShardDistributorAssignLoopDrainMoves, int64(moves))
+			}
+			result.shardsToReassign = append(result.shardsToReassign, shards[:moves]...)
+			shards = shards[moves:]
+		}
+		// The executor is kept even without shards, so that its assigned state is written.
+		result.heldAssignments[executorID] = append(result.heldAssignments[executorID], shards...)
+	}
+
+	result.eligibleExecutors = slices.DeleteFunc(slices.Clone(activeExecutors), func(executorID string) bool {
+		return slices.Contains(excludedExecutors, executorID)
+	})
+	return result
+}
+
+// applyPins takes the pinned shards out of placement and holds them on their pinned executor.
+// Pins to executors that are not active are ignored, so the shard is placed as usual.
+func (p *namespaceProcessor) applyPins(pinnedShards map[string]string, result *controlledPlacement, currentAssignments map[string][]string) {
+	if len(pinnedShards) == 0 {
+		return
+	}
+
+	owners := make(map[string]string)
+	for executorID, shardIDs := range currentAssignments {
+		for _, shardID := range shardIDs {
+			owners[shardID] = executorID
+		}
+	}
+
+	for _, shardID := range sortedKeys(pinnedShards) {
+		executorID := pinnedShards[shardID]
+		if !slices.Contains(result.eligibleExecutors, executorID) {
+			p.logger.Warn("Shard is pinned to an executor that is not active, ignoring the pin", tag.ShardKey(shardID), tag.ShardExecutor(executorID))
+			continue
+		}
+
+		if owner, ok := owners[shardID]; ok {
+			currentAssignments[owner] = slices.DeleteFunc(currentAssignments[owner], func(id string) bool { return id == shardID })
+		} else if idx := slices.Index(result.shardsToReassign, shardID); idx >= 0 {
+			result.shardsToReassign = slices.Delete(result.shardsToReassign, idx, idx+1)
+		} else {
+			// The shard does not exist in the namespace, for example a deleted ephemeral shard.
+			continue
+		}
+		result.heldAssignments[executorID] = append(result.heldAssignments[executorID], shardID)
+	}
+}
+
+// drainBudget returns how many of the shards still assigned to a draining executor may be moved away in this cycle.
+// Shards that were already moved away but are still reported as READY by the executor count against the limit.
+func drainBudget(control store.ExecutorControl, namespaceState *store.NamespaceState, executorID string, assignedShards int) int {
+	if control.MaxConcurrentMoves <= 0 {
+		return assignedShards
+	}
+
+	inFlight := 0
+	assigned := namespaceState.ShardAssignments[executorID].AssignedShards
+	for shardID, report := range namespaceState.Executors[executorID].ReportedShards {
+		if _, ok := assigned[shardID]; !ok && report != nil && report.Status == types.ShardStatusREADY {
+			inFlight++
+		}
+	}
+
+	budget := int(control.MaxConcurrentMoves) - inFlight
+	return max(0, min(budget, assignedShards))
+}
+
+// mergeHeldAssignments adds the shards held by applyControls back to the assignments.
+func mergeHeldAssignments(currentAssignments map[string][]string, heldAssignments map[string][]string) {
+	for executorID, shardIDs := range heldAssignments {
+		currentAssignments[executorID] = append(currentAssignments[executorID], shardIDs...)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,270 @@
+package process
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+func assignedShards(shardIDs ...string) store.AssignedState {
+	shards := make(map[string]*types.ShardAssignment, len(shardIDs))
+	for _, shardID := range shardIDs {
+		shards[shardID] = &types.ShardAssignment{Status: types.AssignmentStatusREADY}
+	}
+	return store.AssignedState{AssignedShards: shards}
+}
+
+func TestApplyControls(t *testing.T) {
+	tests := []struct {
+		name               string
+		namespaceState     *store.NamespaceState
+		activeExecutors    []string
+		shardsToReassign   []string
+		currentAssignments map[string][]string
+		expected           controlledPlacement
+		expectedAssignment map[string][]string
+	}{
+		{
+			name:               "no controls",
+			namespaceState:     &store.NamespaceState{},
+			activeExecutors:    []string{"exec-1", "exec-2"},
+			shardsToReassign:   []string{"2"},
+			currentAssignments: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+			expected: controlledPlacement{
+				eligibleExecutors: []string{"exec-1", "exec-2"},
+				shardsToReassign:  []string{"2"},
+				heldAssignments:   map[string][]string{},
+			},
+			expectedAssignment: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+		},
+		{
+			name: "cordoned executor keeps its shards and receives none",
+			namespaceState: &store.NamespaceState{
+				Controls: store.NamespaceControls{
+					Executors: map[string]store.ExecutorControl{"exec-1": {Cordoned: true}},
+				},
+			},
+			activeExecutors:    []string{"exec-1", "exec-2"},
+			shardsToReassign:   []string{"2"},
+			currentAssignments: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+			expected: controlledPlacement{
+				eligibleExecutors: []string{"exec-2"},
+				shardsToReassign:  []string{"2"},
+				heldAssignments:   map[string][]string{"exec-1": {"0"}},
+			},
+			expectedAssignment: map[string][]string{"exec-2": {"1"}},
+		},
+		{
+			name: "draining executor without limit gives up all its shards",
+			namespaceState: &store.NamespaceState{
+				Controls: store.NamespaceControls{
+					Executors: map[string]store.ExecutorControl{"exec-1": {Draining: true}},
+				},
+			},
+			activeExecutors:    []string{"exec-1", "exec-2"},
+			currentAssignments: map[string][]string{"exec-1": {"0", "1"}, "exec-2": {"2"}},
+			expected: controlledPlacement{
+				eligibleExecutors: []string{"exec-2"},
+				shardsToReassign:  []string{"0", "1"},
+				heldAssignments:   map[string][]string{"exec-1": {}},
+			},
+			expectedAssignment: map[string][]string{"exec-2": {"2"}},
+		},
+		{
+			name: "draining executor gives up at most max concurrent moves minus the shards in flight",
+			namespaceState: &store.NamespaceState{
+				Executors: map[string]store.HeartbeatState{
+					"exec-1": {
+						ReportedShards: map[string]*types.ShardStatusReport{
+							"0": {Status: types.ShardStatusREADY},
+							"1": {Status: types.ShardStatusREADY},
+							"2": {Status: types.ShardStatusREADY},
+							"3": {Status: types.ShardStatusREADY},
+						},
+					},
+				},
+				ShardAssignments: map[string]store.AssignedState{
+					// Shard 0 was moved away in an earlier cycle but exec-1 still reports it.
+					"exec-1": assignedShards("1", "2", "3"),
+				},
+				Controls: store.NamespaceControls{
+					Executors: map[string]store.ExecutorControl{"exec-1": {Draining: true, MaxConcurrentMoves: 2}},
+				},
+			},
+			activeExecutors:    []string{"exec-1", "exec-2"},
+			currentAssignments: map[string][]string{"exec-1": {"3", "1", "2"}, "exec-2": {"0"}},
+			expected: controlledPlacement{
+				eligibleExecutors: []string{"exec-2"},
+				shardsToReassign:  []string{"1"},
+				heldAssignments:   map[string][]string{"exec-1": {"2", "3"}},
+			},
+			expectedAssignment: map[string][]string{"exec-2": {"0"}},
+		},
+		{
+			name: "pinned shard is moved to its executor even if it is cordoned",
+			namespaceState: &store.NamespaceState{
+				Controls: store.NamespaceControls{
+					Executors:    map[string]store.ExecutorControl{"exec-1": {Cordoned: true}},
+					PinnedShards: map[string]string{"1": "exec-1", "2": "exec-2"},
+				},
+			},
+			activeExecutors:    []string{"exec-1", "exec-2"},
+			shardsToReassign:   []string{"2"},
+			currentAssignments: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+			expected: controlledPlacement{
+				eligibleExecutors: []string{"exec-2"},
+				shardsToReassign:  []string{},
+				heldAssignments:   map[string][]string{"exec-1": {"1", "0"}, "exec-2": {"2"}},
+			},
+			expectedAssignment: map[string][]string{"exec-2": {}},
+		},
+		{
+			name: "pin to an inactive executor is ignored",
+			namespaceState: &store.NamespaceState{
+				Controls: store.NamespaceControls{
+					PinnedShards: map[string]string{"0": "exec-3"},
+				},
+			},
+			activeExecutors:    []string{"exec-1", "exec-2"},
+			currentAssignments: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+			expected: controlledPlacement{
+				eligibleExecutors: []string{"exec-1", "exec-2"},
+				heldAssignments:   map[string][]string{},
+			},
+			expectedAssignment: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+		},
+		{
+			name: "cordons are ignored when all active executors are cordoned",
+			namespaceState: &store.NamespaceState{
+				Controls: store.NamespaceControls{
+					Executors: map[string]store.ExecutorControl{"exec-1": {Cordoned: true}, "exec-2": {Draining: true}},
+				},
+			},
+			activeExecutors:    []string{"exec-1", "exec-2"},
+			shardsToReassign:   []string{"2"},
+			currentAssignments: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+			expected: controlledPlacement{
+				eligibleExecutors: []string{"exec-1", "exec-2"},
+				shardsToReassign:  []string{"2"},
+				heldAssignments:   map[string][]string{},
+			},
+			expectedAssignment: map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+			processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+
+			result := processor.applyControls(tt.namespaceState, tt.activeExecutors, tt.shardsToReassign, tt.currentAssignments, metrics.NoopScope)
+
+			assert.ElementsMatch(t, tt.expected.eligibleExecutors, result.eligibleExecutors)
+			assert.ElementsMatch(t, tt.expected.shardsToReassign, result.shardsToReassign)
+			assert.Len(t, result.heldAssignments, len(tt.expected.heldAssignments))
+			for executorID, shards := range tt.expected.heldAssignments {
+				assert.ElementsMatch(t, shards, result.heldAssignments[executorID], "held shards of %s", executorID)
+			}
+			assert.Len(t, tt.currentAssignments, len(tt.expectedAssignment))
+			for executorID, shards := range tt.expectedAssignment {
+				assert.ElementsMatch(t, shards, tt.currentAssignments[executorID], "assigned shards of %s", executorID)
+			}
+		})
+	}
+}
+
+func TestRebalanceShards_Controls(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	mocks.cfg.ShardNum = 4
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+
+	now := mocks.timeSource.Now()
+	heartbeats := map[string]store.HeartbeatState{
+		"exec-1": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+		"exec-2": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+		"exec-3": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+	}
+	assignments := map[string]store.AssignedState{
+		"exec-1": assignedShards("0", "1"),
+		"exec-2": assignedShards("2", "3"),
+	}
+	mocks.store.EXPECT().GetState(gomock.Any(), mocks.cfg.Name).Return(&store.NamespaceState{
+		Executors:        heartbeats,
+		ShardAssignments: assignments,
+		Controls: store.NamespaceControls{
+			Executors: map[string]store.ExecutorControl{
+				"exec-1": {Draining: true, MaxConcurrentMoves: 1},
+				"exec-3": {Cordoned: true},
+			},
+			PinnedShards: map[string]string{"3": "exec-3"},
+		},
+	}, nil)
+	mocks.store.EXPECT().GetShardOwner(gomock.Any(), mocks.cfg.Name, gomock.Any()).Return(nil, nil).AnyTimes()
+	mocks.election.EXPECT().Guard().Return(store.NopGuard())
+	mocks.store.EXPECT().AssignShards(gomock.Any(), mocks.cfg.Name, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, request store.AssignShardsRequest, _ store.GuardFunc) error {
+			newAssignments := request.NewState.ShardAssignments
+			// exec-1 drains one shard per cycle, to exec-2 as the cordoned exec-3 receives none.
+			assert.Len(t, newAssignments["exec-1"].AssignedShards, 1)
+			assert.Len(t, newAssignments["exec-2"].AssignedShards, 2)
+			assert.Contains(t, newAssignments["exec-2"].AssignedShards, "2")
+			// The pinned shard is moved to the cordoned exec-3.
+			assert.Len(t, newAssignments["exec-3"].AssignedShards, 1)
+			assert.Contains(t, newAssignments["exec-3"].AssignedShards, "3")
+			return nil
+		},
+	)
+
+	err := processor.rebalanceShards(context.Background())
+	require.NoError(t, err)
+}
+
+func TestRebalanceShards_PinOnlyChange(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+
+	now := mocks.timeSource.Now()
+	heartbeats := map[string]store.HeartbeatState{
+		"exec-1": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+		"exec-2": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+	}
+	assignments := map[string]store.AssignedState{
+		"exec-1": assignedShards("0"),
+		"exec-2": assignedShards("1"),
+	}
+	mocks.store.EXPECT().GetState(gomock.Any(), mocks.cfg.Name).Return(&store.NamespaceState{
+		Executors:        heartbeats,
+		ShardAssignments: assignments,
+		Controls: store.NamespaceControls{
+			PinnedShards: map[string]string{"1": "exec-1"},
+		},
+	}, nil)
+	mocks.store.EXPECT().GetShardOwner(gomock.Any(), mocks.cfg.Name, gomock.Any()).Return(nil, nil).AnyTimes()
+	mocks.election.EXPECT().Guard().Return(store.NopGuard())
+	mocks.store.EXPECT().AssignShards(gomock.Any(), mocks.cfg.Name, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, request store.AssignShardsRequest, _ store.GuardFunc) error {
+			exec1Shards := make([]string, 0)
+			for shardID := range request.NewState.ShardAssignments["exec-1"].AssignedShards {
+				exec1Shards = append(exec1Shards, shardID)
+			}
+			slices.Sort(exec1Shards)
+			assert.Equal(t, []string{"0", "1"}, exec1Shards)
+			assert.Empty(t, request.NewState.ShardAssignments["exec-2"].AssignedShards)
+			return nil
+		},
+	)
+
+	err := processor.rebalanceShards(context.Background())
+	require.NoError(t, err)
+}
@@ -0,0 +1,353 @@
+package process
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/dgryski/go-farm"
+
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+const (
+	_defaultCapacityKey = "capacity"
+	_virtualNodes       = 128
+)
+
+// PlacementStrategy decides which active executor owns each shard of a namespace.
+type PlacementStrategy interface {
+	// Place returns the shards owned by every active executor after placement.
+	// Every shard in input.Shards must be placed exactly once.
+	Place(input PlacementInput) map[string][]string
+}
+
+// PlacementInput is the namespace state a placement strategy works on.
+type PlacementInput struct {
+	// Shards holds all the shards of the namespace, sorted.
+	Shards []string
+	// CurrentAssignments holds the shards kept by each active executor.
+	// Every active executor has an entry, shards that must be reassigned are not present.
+	CurrentAssignments map[string][]string
+	// Executors holds the heartbeat states of the executors, it is used to read executor metadata.
+	Executors map[string]store.HeartbeatState
+	// ShardLoad holds the latest reported load of each shard.
+	ShardLoad map[string]float64
+	// MaxDeviation is the tolerated ratio between the most and the least utilized executors.
+	MaxDeviation float64
+}
+
+// ShardMove describes a shard changing owner. From is empty for shards that had no owner.
+type ShardMove struct {
+	ShardID string `json:"shardID"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to"`
+}
+
+// NewPlacementStrategy creates the placement strategy configured for a namespace.
+// It returns nil for the default strategy, which is implemented by the processor itself.
+func NewPlacementStrategy(cfg config.Placement) (PlacementStrategy, error) {
+	switch cfg.Strategy {
+	case "", config.PlacementStrategyDefault:
+		return nil, nil
+	case config.PlacementStrategyConsistentHash:
+		return &consistentHashPlacement{virtualNodes: _virtualNodes}, nil
+	case config.PlacementStrategyBinPacking:
+		capacityKey := cfg.CapacityKey
+		if capacityKey == "" {
+			capacityKey = _defaultCapacityKey
+		}
+		return &binPackingPlacement{capacityKey: capacityKey}, nil
+	case config.PlacementStrategyAffinity:
+		return &affinityPlacement{rules: cfg.Affinity}, nil
+	default:
+		return nil, fmt.Errorf("unknown placement strategy %q", cfg.Strategy)
+	}
+}
+
+// consistentHashPlacement places shards on a hash ring of the active executors,
+// so adding or removing an executor only moves the shards of its ring segments.
+type consistentHashPlacement struct {
+	virtualNodes int
+}
+
+type ringPoint struct {
+	hash       uint64
+	executorID string
+}
+
+func (c *consistentHashPlacement) Place(input PlacementInput) map[string][]string {
+	executors := sortedExecutors(input.CurrentAssignments)
+	assignments := newEmptyAssignments(executors)
+	if len(executors) == 0 {
+		return assignments
+	}
+
+	ring := make([]ringPoint, 0, len(executors)*c.virtualNodes)
+	for _, executorID := range executors {
+		for i := 0; i < c.virtualNodes; i++ {
+			ring = append(ring, ringPoint{
+				hash:       farm.Fingerprint64([]byte(executorID + "#" + strconv.Itoa(i))),
+				executorID: executorID,
+			})
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool {
+		if ring[i].hash == ring[j].hash {
+			return ring[i].executorID < ring[j].executorID
+		}
+		return ring[i].hash < ring[j].hash
+	})
+
+	for _, shardID := range input.Shards {
+		hash := farm.Fingerprint64([]byte(shardID))
+		idx := sort.Search(len(ring), func(i int) bool { return ring[i].hash >= hash })
+		if idx == len(ring) {
+			idx = 0
+		}
+		executorID := ring[idx].executorID
+		assignments[executorID] = append(assignments[executorID], shardID)
+	}
+	return assignments
+}
+
+// binPackingPlacement keeps the utilization, the shard load divided by the executor capacity,
+// of all executors within MaxDeviation. When no load is reported every shard weighs the same.
+type binPackingPlacement struct {
+	capacityKey string
+}
+
+func (b *binPackingPlacement) Place(input PlacementInput) map[string][]string {
+	executors := sortedExecutors(input.CurrentAssignments)
+	assignments := copyAssignments(input.CurrentAssignments)
+	if len(executors) == 0 {
+		return assignments
+	}
+
+	totalLoad := float64(0)
+	for _, shardID := range input.Shards {
+		totalLoad += input.ShardLoad[shardID]
+	}
+	weight := func(shardID string) float64 {
+		if totalLoad <= 0 {
+			return 1
+		}
+		return input.ShardLoad[shardID]
+	}
+
+	capacity := make(map[string]float64, len(executors))
+	load := make(map[string]float64, len(executors))
+	placed := make(map[string]struct{})
+	for _, executorID := range executors {
+		capacity[executorID] = b.executorCapacity(input.Executors[executorID])
+		for _, shardID := range assignments[executorID] {
+			load[executorID] += weight(shardID)
+			placed[shardID] = struct{}{}
+		}
+	}
+	utilization := func(executorID string, delta float64) float64 {
+		return (load[executorID] + delta) / capacity[executorID]
+	}
+
+	// Place the heaviest unassigned shards first on the executor that ends up the least utilized.
+	var unassigned []string
+	for _, shardID := range input.Shards {
+		if _, ok := placed[shardID]; !ok {
+			unassigned = append(unassigned, shardID)
+		}
+	}
+	sort.SliceStable(unassigned, func(i, j int) bool { return weight(unassigned[i]) > weight(unassigned[j]) })
+	for _, shardID := range unassigned {
+		target := executors[0]
+		for _, executorID := range executors[1:] {
+			if utilization(executorID, weight(shardID)) < utilization(target, weight(shardID)) {
+				target = executorID
+			}
+		}
+		assignments[target] = append(assignments[target], shardID)
+		load[target] += weight(shardID)
+	}
+
+	// Move shards from the most to the least utilized executor until they are within MaxDeviation.
+	for i := 0; i < len(input.Shards); i++ {
+		hottest, coldest := executors[0], executors[0]
+		for _, executorID := range executors[1:] {
+			if utilization(executorID, 0) > utilization(hottest, 0) {
+				hottest = executorID
+			}
+			if utilization(executorID, 0) < utilization(coldest, 0) {
+				coldest = executorID
+			}
+		}
+		hottestUtilization, coldestUtilization := utilization(hottest, 0), utilization(coldest, 0)
+		if hottest == coldest || hottestUtilization == 0 {
+			break
+		}
+		if coldestUtilization > 0 && hottestUtilization/coldestUtilization < input.MaxDeviation {
+			break
+		}
+
+		// Pick the heaviest shard that does not make the coldest executor hotter than the hottest one.
+		moveIdx := -1
+		for idx, shardID := range assignments[hottest] {
+			w := weight(shardID)
+			if utilization(coldest, w) > utilization(hottest, -w) {
+				continue
+			}
+			if moveIdx == -1 || w > weight(assignments[hottest][moveIdx]) {
+				moveIdx = idx
+			}
+		}
+		if moveIdx == -1 {
+			break
+		}
+
+		shardID := assignments[hottest][moveIdx]
+		assignments[hottest] = slices.Delete(assignments[hottest], moveIdx, moveIdx+1)
+		assignments[coldest] = append(assignments[coldest], shardID)
+		load[hottest] -= weight(shardID)
+		load[coldest] += weight(shardID)
+	}
+	return assignments
+}
+
+func (b *binPackingPlacement) executorCapacity(state store.HeartbeatState) float64 {
+	capacity, err := strconv.ParseFloat(state.Metadata[b.capacityKey], 64)
+	if err != nil || capacity <= 0 || math.IsInf(capacity, 0) || math.IsNaN(capacity) {
+		return 1
+	}
+	return capacity
+}
+
+// affinityPlacement places shards on the executors whose metadata has all the labels of the shard's
+// affinity rule. Shards without a rule, or whose rule no active executor satisfies, may go to any executor.
+// Shards stay on their current executor as long as it is eligible.
+type affinityPlacement struct {
+	rules []config.PlacementAffinity
+}
+
+func (a *affinityPlacement) Place(input PlacementInput) map[string][]string {
+	executors := sortedExecutors(input.CurrentAssignments)
+	assignments := newEmptyAssignments(executors)
+	if len(executors) == 0 {
+		return assignments
+	}
+
+	shardLabels := make(map[string]map[string]string)
+	for _, rule := range a.rules {
+		for _, shardID := range rule.Shards {
+			if _, ok := shardLabels[shardID]; !ok {
+				shardLabels[shardID] = rule.Labels
+			}
+		}
+	}
+	eligibleExecutors := func(shardID string) []string {
+		labels, ok := shardLabels[shardID]
+		if !ok {
+			return executors
+		}
+		var eligible []string
+		for _, executorID := range executors {
+			if hasLabels(input.Executors[executorID].Metadata, labels) {
+				eligible = append(eligible, executorID)
+			}
+		}
+		if len(eligible) == 0 {
+			return executors
+		}
+		return eligible
+	}
+
+	var pending []string
+	for _, executorID := range executors {
+		for _, shardID := range input.CurrentAssignments[executorID] {
+			if slices.Contains(eligibleExecutors(shardID), executorID) {
+				assignments[executorID] = append(assignments[executorID], shardID)
+			} else {
+				pending = append(pending, shardID)
+			}
+		}
+	}
+	placed := make(map[string]struct{})
+	for _, shardIDs := range input.CurrentAssignments {
+		for _, shardID := range shardIDs {
+			placed[shardID] = struct{}{}
+		}
+	}
+	for _, shardID := range input.Shards {
+		if _, ok := placed[shardID]; !ok {
+			pending = append(pending, shardID)
+		}
+	}
+	sort.Strings(pending)
+
+	for _, shardID := range pending {
+		eligible := eligibleExecutors(shardID)
+		target := eligible[0]
+		for _, executorID := range eligible[1:] {
+			if len(assignments[executorID]) < len(assignments[target]) {
+				target = executorID
+			}
+		}
+		assignments[target] = append(assignments[target], shardID)
+	}
+	return assignments
+}
+
+func hasLabels(metadata map[string]string, labels map[string]string) bool {
+	for key, value := range labels {
+		if metadata[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// computeShardMoves returns the shards whose owner in assignments differs from the stored assignments, sorted by shard ID.
+func computeShardMoves(namespaceState *store.NamespaceState, assignments map[string][]string) []ShardMove {
+	previousOwner := make(map[string]string)
+	for executorID, state := range namespaceState.ShardAssignments {
+		for shardID := range state.AssignedShards {
+			previousOwner[shardID] = executorID
+		}
+	}
+
+	var moves []ShardMove
+	for executorID, shardIDs := range assignments {
+		for _, shardID := range shardIDs {
+			if previousOwner[shardID] != executorID {
+				moves = append(moves, ShardMove{ShardID: shardID, From: previousOwner[shardID], To: executorID})
+			}
+		}
+	}
+	sort.Slice(moves, func(i, j int) bool { return moves[i].ShardID < moves[j].ShardID })
+	return moves
+}
+
+func sortedExecutors(assignments map[string][]string) []string {
+	executors := make([]string, 0, len(assignments))
+	for executorID := range assignments {
+		executors = append(executors, executorID)
+	}
+	sort.Strings(executors)
+	return executors
+}
+
+func newEmptyAssignments(executors []string) map[string][]string {
+	assignments := make(map[string][]string, len(executors))
+	for _, executorID := range executors {
+		assignments[executorID] = []string{}
+	}
+	return assignments
+}
+
+func copyAssignments(assignments map[string][]string) map[string][]string {
+	copied := make(map[string][]string, len(assignments))
+	for executorID, shardIDs := range assignments {
+		copied[executorID] = slices.Clone(shardIDs)
+	}
+	return copied
+}
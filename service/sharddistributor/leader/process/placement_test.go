@@ -0,0 +1,274 @@
+package process
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+func TestNewPlacementStrategy(t *testing.T) {
+	tests := []struct {
+		strategy string
+		expected PlacementStrategy
+		wantErr  bool
+	}{
+		{strategy: "", expected: nil},
+		{strategy: config.PlacementStrategyDefault, expected: nil},
+		{strategy: config.PlacementStrategyConsistentHash, expected: &consistentHashPlacement{virtualNodes: _virtualNodes}},
+		{strategy: config.PlacementStrategyBinPacking, expected: &binPackingPlacement{capacityKey: _defaultCapacityKey}},
+		{strategy: config.PlacementStrategyAffinity, expected: &affinityPlacement{}},
+		{strategy: "unknown", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			strategy, err := NewPlacementStrategy(config.Placement{Strategy: tt.strategy})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, strategy)
+		})
+	}
+}
+
+func TestConsistentHashPlacement(t *testing.T) {
+	strategy := &consistentHashPlacement{virtualNodes: _virtualNodes}
+	shards := makeShards(100)
+	sort.Strings(shards)
+
+	threeExecutors := strategy.Place(PlacementInput{
+		Shards:             shards,
+		CurrentAssignments: map[string][]string{"exec-1": {}, "exec-2": {}, "exec-3": {}},
+	})
+	assertAllShardsPlaced(t, shards, threeExecutors)
+	for executorID, shardIDs := range threeExecutors {
+		assert.NotEmpty(t, shardIDs, executorID)
+	}
+
+	// Adding an executor must only move shards to the new executor.
+	fourExecutors := strategy.Place(PlacementInput{
+		Shards:             shards,
+		CurrentAssignments: map[string][]string{"exec-1": {}, "exec-2": {}, "exec-3": {}, "exec-4": {}},
+	})
+	assertAllShardsPlaced(t, shards, fourExecutors)
+	before, after := ownerByShard(threeExecutors), ownerByShard(fourExecutors)
+	for shardID, owner := range after {
+		if owner != before[shardID] {
+			assert.Equal(t, "exec-4", owner, shardID)
+		}
+	}
+	assert.NotEmpty(t, fourExecutors["exec-4"])
+}
+
+func TestBinPackingPlacement(t *testing.T) {
+	strategy := &binPackingPlacement{capacityKey: _defaultCapacityKey}
+
+	t.Run("unassigned shards go to the least utilized executor", func(t *testing.T) {
+		assignments := strategy.Place(PlacementInput{
+			Shards:             []string{"0", "1", "2", "3"},
+			CurrentAssignments: map[string][]string{"exec-1": {"0"}, "exec-2": {}},
+			ShardLoad:          map[string]float64{"0": 10, "1": 6, "2": 3, "3": 1},
+			MaxDeviation:       2,
+		})
+		assertAllShardsPlaced(t, []string{"0", "1", "2", "3"}, assignments)
+		assert.ElementsMatch(t, []string{"0"}, assignments["exec-1"])
+		assert.ElementsMatch(t, []string{"1", "2", "3"}, assignments["exec-2"])
+	})
+
+	t.Run("capacity weights the placement", func(t *testing.T) {
+		assignments := strategy.Place(PlacementInput{
+			Shards:             []string{"0", "1", "2", "3", "4", "5"},
+			CurrentAssignments: map[string][]string{"exec-1": {}, "exec-2": {}},
+			Executors: map[string]store.HeartbeatState{
+				"exec-1": {Metadata: map[string]string{"capacity": "2"}},
+				"exec-2": {Metadata: map[string]string{"capacity": "invalid"}},
+			},
+			MaxDeviation: 2,
+		})
+		assert.Len(t, assignments["exec-1"], 4)
+		assert.Len(t, assignments["exec-2"], 2)
+	})
+
+	t.Run("overloaded executor is relieved", func(t *testing.T) {
+		assignments := strategy.Place(PlacementInput{
+			Shards:             []string{"0", "1", "2", "3"},
+			CurrentAssignments: map[string][]string{"exec-1": {"0", "1", "2", "3"}, "exec-2": {}},
+			ShardLoad:          map[string]float64{"0": 4, "1": 3, "2": 2, "3": 1},
+			MaxDeviation:       2,
+		})
+		assertAllShardsPlaced(t, []string{"0", "1", "2", "3"}, assignments)
+		assert.ElementsMatch(t, []string{"1", "2", "3"}, assignments["exec-1"])
+		assert.ElementsMatch(t, []string{"0"}, assignments["exec-2"])
+	})
+
+	t.Run("balanced executors are left alone", func(t *testing.T) {
+		current := map[string][]string{"exec-1": {"0", "1"}, "exec-2": {"2"}}
+		assignments := strategy.Place(PlacementInput{
+			Shards:             []string{"0", "1", "2"},
+			CurrentAssignments: current,
+			MaxDeviation:       2.5,
+		})
+		assert.Equal(t, current, assignments)
+	})
+}
+
+func TestAffinityPlacement(t *testing.T) {
+	strategy := &affinityPlacement{rules: []config.PlacementAffinity{
+		{Shards: []string{"0", "1"}, Labels: map[string]string{"zone": "a"}},
+		{Shards: []string{"2"}, Labels: map[string]string{"zone": "c"}},
+	}}
+	executors := map[string]store.HeartbeatState{
+		"exec-1": {Metadata: map[string]string{"zone": "a"}},
+		"exec-2": {Metadata: map[string]string{"zone": "b"}},
+		"exec-3": {Metadata: map[string]string{"zone": "b"}},
+	}
+
+	assignments := strategy.Place(PlacementInput{
+		Shards:             []string{"0", "1", "2", "3", "4"},
+		CurrentAssignments: map[string][]string{"exec-1": {"3"}, "exec-2": {"0"}, "exec-3": {}},
+		Executors:          executors,
+	})
+	assertAllShardsPlaced(t, []string{"0", "1", "2", "3", "4"}, assignments)
+	// shard 0 and 1 must be on zone a, shard 2 has no eligible executor and 3 keeps its owner
+	assert.ElementsMatch(t, []string{"0", "1", "3"}, assignments["exec-1"])
+	assert.ElementsMatch(t, []string{"2"}, assignments["exec-2"])
+	assert.ElementsMatch(t, []string{"4"}, assignments["exec-3"])
+}
+
+func TestComputeShardMoves(t *testing.T) {
+	namespaceState := &store.NamespaceState{
+		ShardAssignments: map[string]store.AssignedState{
+			"exec-1": {AssignedShards: map[string]*types.ShardAssignment{"0": {}, "1": {}}},
+			"exec-2": {AssignedShards: map[string]*types.ShardAssignment{"2": {}}},
+		},
+	}
+
+	moves := computeShardMoves(namespaceState, map[string][]string{
+		"exec-1": {"0"},
+		"exec-2": {"1", "2", "3"},
+	})
+	assert.Equal(t, []ShardMove{
+		{ShardID: "1", From: "exec-1", To: "exec-2"},
+		{ShardID: "3", To: "exec-2"},
+	}, moves)
+}
+
+func TestRebalanceShards_PlacementStrategy(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	mocks.cfg.ShardNum = 4
+	mocks.cfg.Placement = config.Placement{
+		Strategy: config.PlacementStrategyAffinity,
+		Affinity: []config.PlacementAffinity{{Shards: []string{"0", "1", "2"}, Labels: map[string]string{"zone": "a"}}},
+	}
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+
+	now := mocks.timeSource.Now()
+	mocks.store.EXPECT().GetState(gomock.Any(), mocks.cfg.Name).Return(&store.NamespaceState{
+		Executors: map[string]store.HeartbeatState{
+			"exec-1": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now, Metadata: map[string]string{"zone": "a"}},
+			"exec-2": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now, Metadata: map[string]string{"zone": "b"}},
+		},
+	}, nil)
+	mocks.store.EXPECT().GetShardOwner(gomock.Any(), mocks.cfg.Name, gomock.Any()).Return(nil, nil).Times(4)
+	mocks.election.EXPECT().Guard().Return(store.NopGuard())
+	mocks.store.EXPECT().AssignShards(gomock.Any(), mocks.cfg.Name, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, request store.AssignShardsRequest, _ store.GuardFunc) error {
+			assert.Len(t, request.NewState.ShardAssignments["exec-1"].AssignedShards, 3)
+			assert.Contains(t, request.NewState.ShardAssignments["exec-2"].AssignedShards, "3")
+			return nil
+		},
+	)
+
+	err := processor.rebalanceShards(context.Background())
+	require.NoError(t, err)
+}
+
+func TestRebalanceShards_PlacementStrategyNoMoves(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	mocks.cfg.Placement = config.Placement{Strategy: config.PlacementStrategyConsistentHash}
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+
+	now := mocks.timeSource.Now()
+	assignments := (&consistentHashPlacement{virtualNodes: _virtualNodes}).Place(PlacementInput{
+		Shards:             []string{"0", "1"},
+		CurrentAssignments: map[string][]string{"exec-1": {}, "exec-2": {}},
+	})
+	assignedState := make(map[string]store.AssignedState)
+	for executorID, shardIDs := range assignments {
+		assigned := make(map[string]*types.ShardAssignment)
+		for _, shardID := range shardIDs {
+			assigned[shardID] = &types.ShardAssignment{Status: types.AssignmentStatusREADY}
+		}
+		assignedState[executorID] = store.AssignedState{AssignedShards: assigned}
+	}
+	mocks.store.EXPECT().GetState(gomock.Any(), mocks.cfg.Name).Return(&store.NamespaceState{
+		Executors: map[string]store.HeartbeatState{
+			"exec-1": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+			"exec-2": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+		},
+		ShardAssignments: assignedState,
+	}, nil)
+
+	err := processor.rebalanceShards(context.Background())
+	require.NoError(t, err)
+}
+
+func TestReportPlacementDryRun(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	mocks.cfg.Placement = config.Placement{
+		Strategy: config.PlacementStrategyAffinity,
+		DryRun:   true,
+		Affinity: []config.PlacementAffinity{{Shards: []string{"0", "1"}, Labels: map[string]string{"zone": "a"}}},
+	}
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+
+	namespaceState := &store.NamespaceState{
+		Executors: map[string]store.HeartbeatState{
+			"exec-1": {Metadata: map[string]string{"zone": "a"}},
+			"exec-2": {Metadata: map[string]string{"zone": "b"}},
+		},
+		ShardAssignments: map[string]store.AssignedState{
+			"exec-2": {AssignedShards: map[string]*types.ShardAssignment{"0": {}, "1": {}}},
+		},
+	}
+	currentAssignments := map[string][]string{"exec-1": {}, "exec-2": {"0", "1"}}
+
+	moves := processor.reportPlacementDryRun(namespaceState, nil, currentAssignments, processor.metricsClient.Scope(0))
+	assert.Equal(t, []ShardMove{
+		{ShardID: "0", From: "exec-2", To: "exec-1"},
+		{ShardID: "1", From: "exec-2", To: "exec-1"},
+	}, moves)
+	assert.Equal(t, map[string][]string{"exec-1": {}, "exec-2": {"0", "1"}}, currentAssignments, "dry run must not change the assignments")
+}
+
+func assertAllShardsPlaced(t *testing.T, shards []string, assignments map[string][]string) {
+	t.Helper()
+	var placed []string
+	for _, shardIDs := range assignments {
+		placed = append(placed, shardIDs...)
+	}
+	assert.ElementsMatch(t, shards, placed)
+}
+
+func ownerByShard(assignments map[string][]string) map[string]string {
+	owners := make(map[string]string)
+	for executorID, shardIDs := range assignments {
+		for _, shardID := range shardIDs {
+			owners[shardID] = executorID
+		}
+	}
+	return owners
+}
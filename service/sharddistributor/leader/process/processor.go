@@ -72,6 +72,7 @@ type namespaceProcessor struct {
 	wg            sync.WaitGroup
 	shardStore    store.Store
 	election      store.Election
+	placement     PlacementStrategy
 }
 
 // NewProcessorFactory creates a new processor factory
@@ -106,15 +107,22 @@ func NewProcessorFactory(
 
 // CreateProcessor creates a new processor for the given namespace
 func (f *processorFactory) CreateProcessor(cfg config.Namespace, shardStore store.Store, election store.Election) Processor {
+	logger := f.logger.WithTags(tag.ComponentLeaderProcessor, tag.ShardNamespace(cfg.Name))
+	placement, err := NewPlacementStrategy(cfg.Placement)
+	if err != nil {
+		logger.Error("Invalid placement strategy, falling back to the default strategy", tag.Error(err))
+	}
+
 	return &namespaceProcessor{
 		namespaceCfg:  cfg,
-		logger:        f.logger.WithTags(tag.ComponentLeaderProcessor, tag.ShardNamespace(cfg.Name)),
+		logger:        logger,
 		timeSource:    f.timeSource,
 		cfg:           f.cfg,
 		shardStore:    shardStore,
 		election:      election, // Store the election object
 		metricsClient: f.metricsClient,
 		sdConfig:      f.sdConfig,
+		placement:     placement,
 	}
 }
 
@@ -441,15 +449,34 @@ func (p *namespaceProcessor) rebalanceShardsImpl(ctx context.Context, metricsLoo
 
 	shardsToReassign, currentAssignments := p.findShardsToReassign(activeExecutors, namespaceState, deletedShards, staleExecutors)
 
+	// Cordoned, draining and pinned shards are held out of placement and merged back afterwards.
+	controlled := p.applyControls(namespaceState, activeExecutors, shardsToReassign, currentAssignments, metricsLoopScope)
+	shardsToReassign = controlled.shardsToReassign
+
 	metricsLoopScope.AddCounter(metrics.ShardDistributorAssignLoopNumRebalancedShards, int64(len(shardsToReassign)))
 
-	// If there are deleted shards or stale executors, the distribution has changed.
-	assignedToEmptyExecutors := assignShardsToEmptyExecutors(currentAssignments)
-	updatedAssignments := p.updateAssignments(shardsToReassign, activeExecutors, currentAssignments)
-	isRebalancedByShardLoad := p.rebalanceByShardLoad(calcShardLoad(namespaceState), currentAssignments, metricsLoopScope)
+	var placementChanged bool
+	if p.placement != nil && !p.namespaceCfg.Placement.DryRun {
+		placementChanged = p.placeShards(namespaceState, shardsToReassign, currentAssignments, metricsLoopScope)
+	} else {
+		if p.placement != nil {
+			p.reportPlacementDryRun(namespaceState, shardsToReassign, currentAssignments, metricsLoopScope)
+		}
+		assignedToEmptyExecutors := assignShardsToEmptyExecutors(currentAssignments)
+		updatedAssignments := p.updateAssignments(shardsToReassign, controlled.eligibleExecutors, currentAssignments)
+		isRebalancedByShardLoad := p.rebalanceByShardLoad(calcShardLoad(namespaceState), currentAssignments, metricsLoopScope)
+		placementChanged = assignedToEmptyExecutors || updatedAssignments || isRebalancedByShardLoad
+	}
 	p.emitExecutorMetric(namespaceState, metricsLoopScope)
 
-	distributionChanged := len(deletedShards) > 0 || len(staleExecutors) > 0 || assignedToEmptyExecutors || updatedAssignments || isRebalancedByShardLoad
+	if len(controlled.heldAssignments) > 0 {
+		mergeHeldAssignments(currentAssignments, controlled.heldAssignments)
+		// Pinning a shard moves it without going through placement.
+		placementChanged = placementChanged || len(computeShardMoves(namespaceState, currentAssignments)) > 0
+	}
+
+	// If there are deleted shards or stale executors, the distribution has changed.
+	distributionChanged := len(deletedShards) > 0 || len(staleExecutors) > 0 || placementChanged
 	if !distributionChanged {
 		p.logger.Info("No changes to distribution detected. Skipping rebalance.")
 		return nil
@@ -684,6 +711,66 @@ func (p *namespaceProcessor) rebalanceByShardLoad(shardLoad map[string]float64,
 	return true
 }
 
+// placeShards replaces currentAssignments with the placement computed by the configured strategy.
+func (p *namespaceProcessor) placeShards(
+	namespaceState *store.NamespaceState,
+	shardsToReassign []string,
+	currentAssignments map[string][]string,
+	metricsScope metrics.Scope,
+) (distributionChanged bool) {
+	assignments := p.placement.Place(p.newPlacementInput(namespaceState, shardsToReassign, currentAssignments))
+	for executorID, shardIDs := range assignments {
+		currentAssignments[executorID] = shardIDs
+	}
+
+	moves := computeShardMoves(namespaceState, assignments)
+	if len(moves) > 0 {
+		p.logger.Info("Placement strategy shard moves",
+			tag.Dynamic("strategy", p.namespaceCfg.Placement.Strategy),
+			tag.Dynamic("moves", moves),
+		)
+	}
+	metricsScope.AddCounter(metrics.ShardDistributorAssignLoopPlacementMoves, int64(len(moves)))
+	return len(moves) > 0
+}
+
+// reportPlacementDryRun logs the moves the configured strategy would make without changing currentAssignments.
+func (p *namespaceProcessor) reportPlacementDryRun(
+	namespaceState *store.NamespaceState,
+	shardsToReassign []string,
+	currentAssignments map[string][]string,
+	metricsScope metrics.Scope,
+) []ShardMove {
+	assignments := p.placement.Place(p.newPlacementInput(namespaceState, shardsToReassign, currentAssignments))
+	moves := computeShardMoves(namespaceState, assignments)
+	p.logger.Info("Placement strategy dry run",
+		tag.Dynamic("strategy", p.namespaceCfg.Placement.Strategy),
+		tag.Dynamic("moves", moves),
+	)
+	metricsScope.AddCounter(metrics.ShardDistributorAssignLoopPlacementDryRunMoves, int64(len(moves)))
+	return moves
+}
+
+func (p *namespaceProcessor) newPlacementInput(
+	namespaceState *store.NamespaceState,
+	shardsToReassign []string,
+	currentAssignments map[string][]string,
+) PlacementInput {
+	shards := slices.Clone(shardsToReassign)
+	for _, shardIDs := range currentAssignments {
+		shards = append(shards, shardIDs...)
+	}
+	sort.Strings(shards)
+
+	return PlacementInput{
+		Shards:             shards,
+		CurrentAssignments: copyAssignments(currentAssignments),
+		Executors:          namespaceState.Executors,
+		ShardLoad:          calcShardLoad(namespaceState),
+		MaxDeviation:       p.sdConfig.LoadBalancingNaive.MaxDeviation(p.namespaceCfg.Name),
+	}
+}
+
 func (p *namespaceProcessor) getNewAssignmentsState(namespaceState *store.NamespaceState, currentAssignments map[string][]string) map[string]store.AssignedState {
 	newState := make(map[string]store.AssignedState, len(currentAssignments))
 
@@ -59,3 +59,29 @@ func (c *meteredShardDistributorClient) WatchNamespaceState(ctx context.Context,
 	}
 	return stream, err
 }
+
+// The operator methods are not used by spectators, so they are passed through without metrics.
+
+func (c *meteredShardDistributorClient) CordonExecutor(ctx context.Context, request *types.CordonExecutorRequest, opts ...yarpc.CallOption) error {
+	return c.client.CordonExecutor(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) UncordonExecutor(ctx context.Context, request *types.UncordonExecutorRequest, opts ...yarpc.CallOption) error {
+	return c.client.UncordonExecutor(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) DrainExecutor(ctx context.Context, request *types.DrainExecutorRequest, opts ...yarpc.CallOption) error {
+	return c.client.DrainExecutor(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) PinShard(ctx context.Context, request *types.PinShardRequest, opts ...yarpc.CallOption) error {
+	return c.client.PinShard(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) UnpinShard(ctx context.Context, request *types.UnpinShardRequest, opts ...yarpc.CallOption) error {
+	return c.client.UnpinShard(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) DescribeNamespaceControls(ctx context.Context, request *types.DescribeNamespaceControlsRequest, opts ...yarpc.CallOption) (*types.DescribeNamespaceControlsResponse, error) {
+	return c.client.DescribeNamespaceControls(ctx, request, opts...)
+}
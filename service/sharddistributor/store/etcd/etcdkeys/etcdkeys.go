@@ -23,6 +23,12 @@ func BuildExecutorIDPrefix(prefix, namespace, executorID string) string {
 	return fmt.Sprintf("%s%s/", BuildExecutorsPrefix(prefix, namespace), executorID)
 }
 
+// BuildControlsKey constructs the etcd key holding the operator controls of a namespace.
+// result: <prefix>/<namespace>/controls
+func BuildControlsKey(prefix, namespace string) string {
+	return fmt.Sprintf("%s/controls", BuildNamespacePrefix(prefix, namespace))
+}
+
 // ExecutorKeyType represents the allowed executor-level key types in etcd.
 // Use BuildExecutorKey to construct keys of these types.
 type ExecutorKeyType string
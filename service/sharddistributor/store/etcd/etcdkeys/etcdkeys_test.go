@@ -16,6 +16,11 @@ func TestBuildExecutorsPrefix(t *testing.T) {
 	assert.Equal(t, "/cadence/test-ns/executors/", got)
 }
 
+func TestBuildControlsKey(t *testing.T) {
+	got := BuildControlsKey("/cadence", "test-ns")
+	assert.Equal(t, "/cadence/test-ns/controls", got)
+}
+
 func TestBuildExecutorKey(t *testing.T) {
 	got := BuildExecutorKey("/cadence", "test-ns", "exec-1", "heartbeat")
 	assert.Equal(t, "/cadence/test-ns/executors/exec-1/heartbeat", got)
@@ -102,6 +102,74 @@ func FromShardStatistics(src *store.ShardStatistics) *ShardStatistics {
 	}
 }
 
+type NamespaceControls struct {
+	Executors    map[string]ExecutorControl `json:"executors,omitempty"`
+	PinnedShards map[string]string          `json:"pinned_shards,omitempty"`
+	// ModRevision is the etcd mod revision for this record. It is not serialized.
+	ModRevision int64 `json:"-"`
+}
+
+// ToNamespaceControls converts the current NamespaceControls to store.NamespaceControls.
+func (s *NamespaceControls) ToNamespaceControls() *store.NamespaceControls {
+	if s == nil {
+		return nil
+	}
+
+	return &store.NamespaceControls{
+		Executors:    convertMap(s.Executors, ToExecutorControl),
+		PinnedShards: s.PinnedShards,
+		ModRevision:  s.ModRevision,
+	}
+}
+
+// FromNamespaceControls creates a NamespaceControls from a store.NamespaceControls.
+func FromNamespaceControls(src *store.NamespaceControls) *NamespaceControls {
+	if src == nil {
+		return nil
+	}
+
+	return &NamespaceControls{
+		Executors:    convertMap(src.Executors, FromExecutorControl),
+		PinnedShards: src.PinnedShards,
+		ModRevision:  src.ModRevision,
+	}
+}
+
+type ExecutorControl struct {
+	Cordoned           bool  `json:"cordoned,omitempty"`
+	Draining           bool  `json:"draining,omitempty"`
+	MaxConcurrentMoves int32 `json:"max_concurrent_moves,omitempty"`
+	UpdatedAt          Time  `json:"updated_at"`
+}
+
+// FromExecutorControl creates an ExecutorControl from a store.ExecutorControl.
+func FromExecutorControl(src *store.ExecutorControl) *ExecutorControl {
+	if src == nil {
+		return nil
+	}
+
+	return &ExecutorControl{
+		Cordoned:           src.Cordoned,
+		Draining:           src.Draining,
+		MaxConcurrentMoves: src.MaxConcurrentMoves,
+		UpdatedAt:          Time(src.UpdatedAt),
+	}
+}
+
+// ToExecutorControl converts an ExecutorControl to store.ExecutorControl.
+func ToExecutorControl(src *ExecutorControl) *store.ExecutorControl {
+	if src == nil {
+		return nil
+	}
+
+	return &store.ExecutorControl{
+		Cordoned:           src.Cordoned,
+		Draining:           src.Draining,
+		MaxConcurrentMoves: src.MaxConcurrentMoves,
+		UpdatedAt:          src.UpdatedAt.ToTime(),
+	}
+}
+
 // ConvertMap converts a map[K]SrcType to map[K]DstType using a provided converter function.
 func convertMap[K comparable, SrcType any, DstType any](src map[K]SrcType, converter func(*SrcType) *DstType) map[K]DstType {
 	if src == nil {
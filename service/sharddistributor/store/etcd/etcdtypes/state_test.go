@@ -428,3 +428,63 @@ func TestShardHandoverStats_JSONMarshalling(t *testing.T) {
 	require.Equal(t, stats.HandoverType, unmarshalled.HandoverType)
 	require.Equal(t, time.Time(stats.PreviousExecutorLastHeartbeatTime).UnixNano(), time.Time(unmarshalled.PreviousExecutorLastHeartbeatTime).UnixNano())
 }
+
+func TestNamespaceControls_FieldNumberMatched(t *testing.T) {
+	require.Equal(t,
+		reflect.TypeOf(NamespaceControls{}).NumField(),
+		reflect.TypeOf(store.NamespaceControls{}).NumField(),
+		"NamespaceControls field count mismatch with store.NamespaceControls; ensure conversion is updated",
+	)
+	require.Equal(t,
+		reflect.TypeOf(ExecutorControl{}).NumField(),
+		reflect.TypeOf(store.ExecutorControl{}).NumField(),
+		"ExecutorControl field count mismatch with store.ExecutorControl; ensure conversion is updated",
+	)
+}
+
+func TestNamespaceControls_Conversion(t *testing.T) {
+	require.Nil(t, (*NamespaceControls)(nil).ToNamespaceControls())
+	require.Nil(t, FromNamespaceControls(nil))
+
+	controls := &store.NamespaceControls{
+		Executors: map[string]store.ExecutorControl{
+			"exec-1": {Cordoned: true, UpdatedAt: time.Date(2025, 11, 18, 12, 0, 0, 0, time.UTC)},
+			"exec-2": {Draining: true, MaxConcurrentMoves: 3, UpdatedAt: time.Date(2025, 11, 18, 13, 0, 0, 0, time.UTC)},
+		},
+		PinnedShards: map[string]string{"shard-1": "exec-2"},
+		ModRevision:  42,
+	}
+
+	got := FromNamespaceControls(controls).ToNamespaceControls()
+	require.Equal(t, controls.PinnedShards, got.PinnedShards)
+	require.Equal(t, controls.ModRevision, got.ModRevision)
+	require.Len(t, got.Executors, 2)
+	for executorID, control := range controls.Executors {
+		require.Equal(t, control.Cordoned, got.Executors[executorID].Cordoned)
+		require.Equal(t, control.Draining, got.Executors[executorID].Draining)
+		require.Equal(t, control.MaxConcurrentMoves, got.Executors[executorID].MaxConcurrentMoves)
+		require.Equal(t, control.UpdatedAt.UnixNano(), got.Executors[executorID].UpdatedAt.UnixNano())
+	}
+}
+
+func TestNamespaceControls_JSONMarshalling(t *testing.T) {
+	const jsonStr = `{"executors":{"exec-1":{"draining":true,"max_concurrent_moves":2,"updated_at":"2025-11-18T12:00:00Z"}},"pinned_shards":{"shard-1":"exec-2"}}`
+
+	controls := &NamespaceControls{
+		Executors: map[string]ExecutorControl{
+			"exec-1": {Draining: true, MaxConcurrentMoves: 2, UpdatedAt: Time(time.Date(2025, 11, 18, 12, 0, 0, 0, time.UTC))},
+		},
+		PinnedShards: map[string]string{"shard-1": "exec-2"},
+		ModRevision:  7,
+	}
+
+	b, err := json.Marshal(controls)
+	require.NoError(t, err)
+	require.JSONEq(t, jsonStr, string(b))
+
+	var unmarshalled NamespaceControls
+	require.NoError(t, json.Unmarshal([]byte(jsonStr), &unmarshalled))
+	require.Equal(t, controls.PinnedShards, unmarshalled.PinnedShards)
+	require.Equal(t, int64(0), unmarshalled.ModRevision)
+	require.True(t, unmarshalled.Executors["exec-1"].Draining)
+}
@@ -311,13 +311,63 @@ func (s *executorStoreImpl) GetState(ctx context.Context, namespace string) (*st
 		}
 	}
 
+	controls, err := s.GetNamespaceControls(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	return &store.NamespaceState{
 		Executors:        heartbeatStates,
 		ShardStats:       shardStats,
 		ShardAssignments: assignedStates,
+		Controls:         *controls,
 	}, nil
 }
 
+func (s *executorStoreImpl) GetNamespaceControls(ctx context.Context, namespace string) (*store.NamespaceControls, error) {
+	resp, err := s.client.Get(ctx, etcdkeys.BuildControlsKey(s.prefix, namespace))
+	if err != nil {
+		return nil, fmt.Errorf("get namespace controls: %w", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return &store.NamespaceControls{}, nil
+	}
+
+	var controls etcdtypes.NamespaceControls
+	if err := common.DecompressAndUnmarshal(resp.Kvs[0].Value, &controls); err != nil {
+		return nil, fmt.Errorf("parse namespace controls: %w", err)
+	}
+	controls.ModRevision = resp.Kvs[0].ModRevision
+
+	return controls.ToNamespaceControls(), nil
+}
+
+func (s *executorStoreImpl) UpdateNamespaceControls(ctx context.Context, namespace string, controls *store.NamespaceControls) error {
+	controlsKey := etcdkeys.BuildControlsKey(s.prefix, namespace)
+
+	value, err := json.Marshal(etcdtypes.FromNamespaceControls(controls))
+	if err != nil {
+		return fmt.Errorf("marshal namespace controls: %w", err)
+	}
+	compressedValue, err := s.recordWriter.Write(value)
+	if err != nil {
+		return fmt.Errorf("compress namespace controls: %w", err)
+	}
+
+	// A mod revision of 0 means the key must not exist yet.
+	resp, err := s.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(controlsKey), "=", controls.ModRevision)).
+		Then(clientv3.OpPut(controlsKey, string(compressedValue))).
+		Commit()
+	if err != nil {
+		return fmt.Errorf("update namespace controls: %w", err)
+	}
+	if !resp.Succeeded {
+		return store.ErrVersionConflict
+	}
+	return nil
+}
+
 func (s *executorStoreImpl) SubscribeToAssignmentChanges(ctx context.Context, namespace string) (<-chan map[*store.ShardOwner][]string, func(), error) {
 	return s.shardCache.Subscribe(ctx, namespace)
 }
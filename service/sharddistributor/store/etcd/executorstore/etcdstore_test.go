@@ -353,6 +353,39 @@ func TestGetState(t *testing.T) {
 	assert.Contains(t, namespaceState.ShardAssignments[executorID2].AssignedShards, shardID2)
 }
 
+func TestNamespaceControls(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Controls that were never written are empty.
+	controls, err := executorStore.GetNamespaceControls(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.True(t, controls.IsEmpty())
+	assert.Zero(t, controls.ModRevision)
+
+	controls.Executors = map[string]store.ExecutorControl{"exec-1": {Draining: true, MaxConcurrentMoves: 2}}
+	controls.PinnedShards = map[string]string{"shard-1": "exec-2"}
+	require.NoError(t, executorStore.UpdateNamespaceControls(ctx, tc.Namespace, controls))
+
+	// A write based on a stale revision is rejected.
+	err = executorStore.UpdateNamespaceControls(ctx, tc.Namespace, controls)
+	require.ErrorIs(t, err, store.ErrVersionConflict)
+
+	updated, err := executorStore.GetNamespaceControls(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.NotZero(t, updated.ModRevision)
+	assert.Equal(t, controls.PinnedShards, updated.PinnedShards)
+	assert.True(t, updated.Executors["exec-1"].Draining)
+	assert.Equal(t, int32(2), updated.Executors["exec-1"].MaxConcurrentMoves)
+
+	// The controls are part of the namespace state.
+	namespaceState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.Equal(t, *updated, namespaceState.Controls)
+}
+
 // TestAssignShards_WithRevisions tests the optimistic locking logic of AssignShards.
 func TestAssignShards_WithRevisions(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
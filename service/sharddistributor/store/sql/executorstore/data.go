@@ -0,0 +1,175 @@
+package executorstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+// executorData is the payload of a shard_distributor_executors row, the status is stored in its own column.
+type executorData struct {
+	LastHeartbeat  time.Time                           `json:"last_heartbeat"`
+	ReportedShards map[string]*types.ShardStatusReport `json:"reported_shards,omitempty"`
+	Metadata       map[string]string                   `json:"metadata,omitempty"`
+}
+
+// assignedStateData is the payload of a shard_distributor_assignments row, the version is stored in its own column.
+type assignedStateData struct {
+	AssignedShards     map[string]*types.ShardAssignment `json:"assigned_shards"`
+	ShardHandoverStats map[string]shardHandoverStatsData `json:"shard_handover_stats,omitempty"`
+	LastUpdated        time.Time                         `json:"last_updated"`
+}
+
+type shardHandoverStatsData struct {
+	PreviousExecutorLastHeartbeatTime time.Time          `json:"previous_executor_last_heartbeat_time"`
+	HandoverType                      types.HandoverType `json:"handover_type"`
+}
+
+// shardStatisticsData is the payload of a shard_distributor_shard_stats row.
+type shardStatisticsData struct {
+	SmoothedLoad   float64   `json:"smoothed_load"`
+	LastUpdateTime time.Time `json:"last_update_time"`
+	LastMoveTime   time.Time `json:"last_move_time"`
+}
+
+// namespaceControlsData is the payload of a shard_distributor_controls row, the version is stored in its own column.
+type namespaceControlsData struct {
+	Executors    map[string]executorControlData `json:"executors,omitempty"`
+	PinnedShards map[string]string              `json:"pinned_shards,omitempty"`
+}
+
+type executorControlData struct {
+	Cordoned           bool      `json:"cordoned,omitempty"`
+	Draining           bool      `json:"draining,omitempty"`
+	MaxConcurrentMoves int32     `json:"max_concurrent_moves,omitempty"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+func encode(v any) ([]byte, string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, string(constants.EncodingTypeJSON), nil
+}
+
+func decode(data []byte, encoding string, v any) error {
+	if encoding != string(constants.EncodingTypeJSON) {
+		return fmt.Errorf("unsupported encoding %q", encoding)
+	}
+	return json.Unmarshal(data, v)
+}
+
+func toHeartbeatState(row sqlplugin.ShardDistributorExecutorRow) (store.HeartbeatState, error) {
+	var data executorData
+	if err := decode(row.Data, row.DataEncoding, &data); err != nil {
+		return store.HeartbeatState{}, fmt.Errorf("parse executor %s: %w", row.ExecutorID, err)
+	}
+	return store.HeartbeatState{
+		LastHeartbeat:  data.LastHeartbeat,
+		Status:         types.ExecutorStatus(row.Status),
+		ReportedShards: data.ReportedShards,
+		Metadata:       data.Metadata,
+	}, nil
+}
+
+func toAssignedState(row sqlplugin.ShardDistributorAssignmentRow) (store.AssignedState, error) {
+	var data assignedStateData
+	if err := decode(row.Data, row.DataEncoding, &data); err != nil {
+		return store.AssignedState{}, fmt.Errorf("parse assigned state of executor %s: %w", row.ExecutorID, err)
+	}
+
+	var handoverStats map[string]store.ShardHandoverStats
+	if data.ShardHandoverStats != nil {
+		handoverStats = make(map[string]store.ShardHandoverStats, len(data.ShardHandoverStats))
+		for shardID, stats := range data.ShardHandoverStats {
+			handoverStats[shardID] = store.ShardHandoverStats{
+				PreviousExecutorLastHeartbeatTime: stats.PreviousExecutorLastHeartbeatTime,
+				HandoverType:                      stats.HandoverType,
+			}
+		}
+	}
+	return store.AssignedState{
+		AssignedShards:     data.AssignedShards,
+		ShardHandoverStats: handoverStats,
+		LastUpdated:        data.LastUpdated,
+		ModRevision:        row.Version,
+	}, nil
+}
+
+func fromAssignedState(state store.AssignedState) assignedStateData {
+	data := assignedStateData{
+		AssignedShards: state.AssignedShards,
+		LastUpdated:    state.LastUpdated,
+	}
+	if state.ShardHandoverStats != nil {
+		data.ShardHandoverStats = make(map[string]shardHandoverStatsData, len(state.ShardHandoverStats))
+		for shardID, stats := range state.ShardHandoverStats {
+			data.ShardHandoverStats[shardID] = shardHandoverStatsData{
+				PreviousExecutorLastHeartbeatTime: stats.PreviousExecutorLastHeartbeatTime,
+				HandoverType:                      stats.HandoverType,
+			}
+		}
+	}
+	return data
+}
+
+func toShardStatistics(row sqlplugin.ShardDistributorShardStatsRow) (store.ShardStatistics, error) {
+	var data shardStatisticsData
+	if err := decode(row.Data, row.DataEncoding, &data); err != nil {
+		return store.ShardStatistics{}, fmt.Errorf("parse statistics of shard %s: %w", row.ShardID, err)
+	}
+	return store.ShardStatistics(data), nil
+}
+
+func toShardStatsRow(namespace, shardID, executorID string, stats store.ShardStatistics) (sqlplugin.ShardDistributorShardStatsRow, error) {
+	data, encoding, err := encode(shardStatisticsData(stats))
+	if err != nil {
+		return sqlplugin.ShardDistributorShardStatsRow{}, fmt.Errorf("marshal statistics of shard %s: %w", shardID, err)
+	}
+	return sqlplugin.ShardDistributorShardStatsRow{
+		Namespace:    namespace,
+		ShardID:      shardID,
+		ExecutorID:   executorID,
+		Data:         data,
+		DataEncoding: encoding,
+	}, nil
+}
+
+func toNamespaceControls(row sqlplugin.ShardDistributorControlRow) (*store.NamespaceControls, error) {
+	var data namespaceControlsData
+	if err := decode(row.Data, row.DataEncoding, &data); err != nil {
+		return nil, fmt.Errorf("parse controls of namespace %s: %w", row.Namespace, err)
+	}
+
+	var executors map[string]store.ExecutorControl
+	if data.Executors != nil {
+		executors = make(map[string]store.ExecutorControl, len(data.Executors))
+		for executorID, control := range data.Executors {
+			executors[executorID] = store.ExecutorControl(control)
+		}
+	}
+	return &store.NamespaceControls{
+		Executors:    executors,
+		PinnedShards: data.PinnedShards,
+		ModRevision:  row.Version,
+	}, nil
+}
+
+func fromNamespaceControls(controls *store.NamespaceControls) namespaceControlsData {
+	data := namespaceControlsData{
+		PinnedShards: controls.PinnedShards,
+	}
+	if controls.Executors != nil {
+		data.Executors = make(map[string]executorControlData, len(controls.Executors))
+		for executorID, control := range controls.Executors {
+			data.Executors[executorID] = executorControlData(control)
+		}
+	}
+	return data
+}
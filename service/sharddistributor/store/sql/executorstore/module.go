@@ -0,0 +1,7 @@
+package executorstore
+
+import "go.uber.org/fx"
+
+var Module = fx.Module("executorstore",
+	fx.Provide(NewStore),
+)
@@ -0,0 +1,1041 @@
+package executorstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/statistics"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+)
+
+const (
+	// defaultPollInterval is how often the database is polled for changes when no interval is configured.
+	defaultPollInterval = time.Second
+
+	// maxShardIDsPerQuery bounds the number of shard IDs used in a single IN clause or batch insert.
+	maxShardIDsPerQuery = 1000
+)
+
+// errConcurrentUpdate is returned from an AssignShard transaction that lost a race and should be retried.
+var errConcurrentUpdate = errors.New("concurrent update")
+
+type executorStoreImpl struct {
+	db            sqlplugin.DB
+	logger        log.Logger
+	timeSource    clock.TimeSource
+	cfg           *config.Config
+	metricsClient metrics.Client
+	pollInterval  time.Duration
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// ExecutorStoreParams defines the dependencies for the SQL store, for use with fx.
+type ExecutorStoreParams struct {
+	fx.In
+
+	DB            sqlplugin.DB `name:"executorstore"`
+	SQLConfig     sqlclient.ExecutorStoreConfig
+	Lifecycle     fx.Lifecycle
+	Logger        log.Logger
+	TimeSource    clock.TimeSource
+	Config        *config.Config
+	MetricsClient metrics.Client
+}
+
+// NewStore creates a new SQL-backed store and provides it to the fx application.
+func NewStore(p ExecutorStoreParams) (store.Store, error) {
+	timeSource := p.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+
+	pollInterval := p.SQLConfig.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	store := &executorStoreImpl{
+		db:            p.DB,
+		logger:        p.Logger,
+		timeSource:    timeSource,
+		cfg:           p.Config,
+		metricsClient: p.MetricsClient,
+		pollInterval:  pollInterval,
+		stopCh:        make(chan struct{}),
+	}
+
+	p.Lifecycle.Append(fx.StopHook(store.Stop))
+
+	return store, nil
+}
+
+// Stop stops all the subscriptions and waits for them to exit.
+func (s *executorStoreImpl) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+// --- HeartbeatStore Implementation ---
+
+func (s *executorStoreImpl) RecordHeartbeat(ctx context.Context, namespace, executorID string, request store.HeartbeatState) error {
+	err := sqlclient.ExecuteInTx(ctx, s.db, s.logger, func(tx sqlplugin.Tx) error {
+		// Metadata keys are only ever added or overwritten by heartbeats, so merge them with the stored ones.
+		metadata := make(map[string]string)
+		previous, err := s.getExecutorRow(ctx, tx, namespace, executorID)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			previousState, err := toHeartbeatState(*previous)
+			if err != nil {
+				return err
+			}
+			maps.Copy(metadata, previousState.Metadata)
+		}
+		maps.Copy(metadata, request.Metadata)
+
+		data, encoding, err := encode(executorData{
+			LastHeartbeat:  request.LastHeartbeat,
+			ReportedShards: request.ReportedShards,
+			Metadata:       metadata,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal executor data: %w", err)
+		}
+		if _, err := tx.ReplaceIntoShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorRow{
+			Namespace:    namespace,
+			ExecutorID:   executorID,
+			Status:       int32(request.Status),
+			Data:         data,
+			DataEncoding: encoding,
+		}); err != nil {
+			return err
+		}
+
+		if s.cfg.GetLoadBalancingMode(namespace) == types.LoadBalancingModeGREEDY {
+			if err := s.updateShardStatistics(ctx, tx, namespace, executorID, request.ReportedShards); err != nil {
+				return fmt.Errorf("update shard statistics: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("record heartbeat: %w", err)
+	}
+	return nil
+}
+
+// updateShardStatistics updates the smoothed load of the reported shards that are owned by the executor.
+func (s *executorStoreImpl) updateShardStatistics(ctx context.Context, tx sqlplugin.Tx, namespace, executorID string, reported map[string]*types.ShardStatusReport) error {
+	shardIDs := make([]string, 0, len(reported))
+	for shardID, report := range reported {
+		if report == nil {
+			s.logger.Warn("empty report; skipping smoothed load update",
+				tag.ShardNamespace(namespace),
+				tag.ShardExecutor(executorID),
+				tag.ShardKey(shardID),
+			)
+			continue
+		}
+		shardIDs = append(shardIDs, shardID)
+	}
+	if len(shardIDs) == 0 {
+		return nil
+	}
+	sort.Strings(shardIDs)
+
+	owners, err := s.getShardOwners(ctx, tx, namespace, shardIDs)
+	if err != nil {
+		return err
+	}
+	oldStats, err := s.getShardStatistics(ctx, tx, namespace, shardIDs)
+	if err != nil {
+		return err
+	}
+
+	now := s.timeSource.Now().UTC()
+	var rows []sqlplugin.ShardDistributorShardStatsRow
+	for _, shardID := range shardIDs {
+		if owners[shardID] != executorID {
+			continue
+		}
+		stats := s.updateShardStatistic(namespace, executorID, shardID, reported[shardID].ShardLoad, now, oldStats)
+		row, err := toShardStatsRow(namespace, shardID, executorID, stats)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	return s.replaceShardStatistics(ctx, tx, rows)
+}
+
+func (s *executorStoreImpl) updateShardStatistic(namespace, executorID, shardID string, shardLoad float64, now time.Time, oldStats map[string]store.ShardStatistics) store.ShardStatistics {
+	var stats store.ShardStatistics
+
+	prevStats, ok := oldStats[shardID]
+	if ok {
+		stats.LastMoveTime = prevStats.LastMoveTime
+	}
+
+	newSmoothed, err := statistics.CalculateSmoothedLoad(prevStats.SmoothedLoad, shardLoad, prevStats.LastUpdateTime, now)
+	if err != nil {
+		s.logger.Error("failed to calculate smoothed load",
+			tag.ShardNamespace(namespace),
+			tag.ShardExecutor(executorID),
+			tag.ShardKey(shardID),
+		)
+		return store.ShardStatistics{LastMoveTime: stats.LastMoveTime}
+	}
+
+	stats.SmoothedLoad = newSmoothed
+	stats.LastUpdateTime = now
+
+	return stats
+}
+
+// GetHeartbeat retrieves the last known heartbeat state for a single executor.
+func (s *executorStoreImpl) GetHeartbeat(ctx context.Context, namespace string, executorID string) (*store.HeartbeatState, *store.AssignedState, error) {
+	executorRow, err := s.getExecutorRow(ctx, s.db, namespace, executorID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get executor %s: %w", executorID, err)
+	}
+	assignmentRow, err := s.getAssignmentRow(ctx, s.db, namespace, executorID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get assigned state of executor %s: %w", executorID, err)
+	}
+	if executorRow == nil && assignmentRow == nil {
+		return nil, nil, store.ErrExecutorNotFound
+	}
+
+	heartbeatState := &store.HeartbeatState{}
+	if executorRow != nil {
+		*heartbeatState, err = toHeartbeatState(*executorRow)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var assignedState *store.AssignedState
+	if assignmentRow != nil {
+		state, err := toAssignedState(*assignmentRow)
+		if err != nil {
+			return nil, nil, err
+		}
+		assignedState = &state
+	}
+
+	return heartbeatState, assignedState, nil
+}
+
+// --- ShardStore Implementation ---
+
+func (s *executorStoreImpl) GetState(ctx context.Context, namespace string) (*store.NamespaceState, error) {
+	heartbeatStates := make(map[string]store.HeartbeatState)
+	assignedStates := make(map[string]store.AssignedState)
+	shardStats := make(map[string]store.ShardStatistics)
+
+	executorRows, err := s.db.SelectFromShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorFilter{Namespace: namespace})
+	if err != nil {
+		return nil, fmt.Errorf("get executor data: %w", err)
+	}
+	for _, row := range executorRows {
+		heartbeatStates[row.ExecutorID], err = toHeartbeatState(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	assignmentRows, err := s.db.SelectFromShardDistributorAssignments(ctx, &sqlplugin.ShardDistributorAssignmentFilter{Namespace: namespace})
+	if err != nil {
+		return nil, fmt.Errorf("get assigned states: %w", err)
+	}
+	for _, row := range assignmentRows {
+		assignedStates[row.ExecutorID], err = toAssignedState(row)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := heartbeatStates[row.ExecutorID]; !ok {
+			heartbeatStates[row.ExecutorID] = store.HeartbeatState{}
+		}
+	}
+
+	// Only load shard statistics if the load balancing mode requires it
+	if s.cfg.GetLoadBalancingMode(namespace) == types.LoadBalancingModeGREEDY {
+		shardStats, err = s.getShardStatistics(ctx, s.db, namespace, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	controls, err := s.GetNamespaceControls(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &store.NamespaceState{
+		Executors:        heartbeatStates,
+		ShardStats:       shardStats,
+		ShardAssignments: assignedStates,
+		Controls:         *controls,
+	}, nil
+}
+
+func (s *executorStoreImpl) GetNamespaceControls(ctx context.Context, namespace string) (*store.NamespaceControls, error) {
+	row, err := s.db.SelectFromShardDistributorControls(ctx, namespace)
+	if err != nil {
+		if s.db.IsNotFoundError(err) {
+			return &store.NamespaceControls{}, nil
+		}
+		return nil, fmt.Errorf("get namespace controls: %w", err)
+	}
+	return toNamespaceControls(*row)
+}
+
+func (s *executorStoreImpl) UpdateNamespaceControls(ctx context.Context, namespace string, controls *store.NamespaceControls) error {
+	data, encoding, err := encode(fromNamespaceControls(controls))
+	if err != nil {
+		return fmt.Errorf("marshal namespace controls: %w", err)
+	}
+	row := &sqlplugin.ShardDistributorControlRow{
+		Namespace:    namespace,
+		Version:      controls.ModRevision + 1,
+		Data:         data,
+		DataEncoding: encoding,
+	}
+
+	// A version of 0 means the controls were never written.
+	if controls.ModRevision == 0 {
+		if _, err := s.db.InsertIntoShardDistributorControls(ctx, row); err != nil {
+			if s.db.IsDupEntryError(err) {
+				return fmt.Errorf("%w: namespace controls were concurrently created", store.ErrVersionConflict)
+			}
+			return fmt.Errorf("insert namespace controls: %w", err)
+		}
+		return nil
+	}
+
+	result, err := s.db.UpdateShardDistributorControls(ctx, row, controls.ModRevision)
+	if err != nil {
+		return fmt.Errorf("update namespace controls: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update namespace controls: %w", err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: namespace controls were concurrently updated", store.ErrVersionConflict)
+	}
+	return nil
+}
+
+// SubscribeToAssignmentChanges sends the current shard assignments of the namespace to the returned channel,
+// and then polls the database and sends the assignments again every time they change.
+// Updates are dropped while the subscriber is not reading, and sent again on the next poll.
+func (s *executorStoreImpl) SubscribeToAssignmentChanges(ctx context.Context, namespace string) (<-chan map[*store.ShardOwner][]string, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	assignmentChan := make(chan map[*store.ShardOwner][]string)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.pollAssignments(ctx, namespace, assignmentChan)
+	}()
+
+	return assignmentChan, cancel, nil
+}
+
+func (s *executorStoreImpl) pollAssignments(ctx context.Context, namespace string, assignmentChan chan<- map[*store.ShardOwner][]string) {
+	var sent *assignmentSnapshot
+	blocking := true
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		snapshot, err := s.getAssignmentSnapshot(ctx, namespace)
+		if err != nil {
+			s.logger.Warn("failed to poll shard assignments", tag.ShardNamespace(namespace), tag.Error(err))
+		} else if sent == nil || !reflect.DeepEqual(*sent, snapshot) {
+			// The initial state is always delivered, later updates are dropped if the subscriber is not ready.
+			if blocking {
+				select {
+				case assignmentChan <- snapshot.toState():
+					sent = &snapshot
+					blocking = false
+				case <-ctx.Done():
+					return
+				case <-s.stopCh:
+					return
+				}
+			} else {
+				select {
+				case assignmentChan <- snapshot.toState():
+					sent = &snapshot
+				default:
+					s.logger.Warn("Subscriber not keeping up with state updates, dropping update", tag.ShardNamespace(namespace))
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// assignmentSnapshot is a comparable view of the shard assignments of a namespace.
+type assignmentSnapshot struct {
+	// shards holds the sorted shard IDs assigned to each executor with an assigned state.
+	shards map[string][]string
+	// metadata holds the metadata of each executor with an assigned state.
+	metadata map[string]map[string]string
+}
+
+func (a assignmentSnapshot) toState() map[*store.ShardOwner][]string {
+	state := make(map[*store.ShardOwner][]string, len(a.shards))
+	for executorID, shardIDs := range a.shards {
+		owner := &store.ShardOwner{ExecutorID: executorID, Metadata: maps.Clone(a.metadata[executorID])}
+		state[owner] = slices.Clone(shardIDs)
+	}
+	return state
+}
+
+func (s *executorStoreImpl) getAssignmentSnapshot(ctx context.Context, namespace string) (assignmentSnapshot, error) {
+	snapshot := assignmentSnapshot{
+		shards:   make(map[string][]string),
+		metadata: make(map[string]map[string]string),
+	}
+
+	assignmentRows, err := s.db.SelectFromShardDistributorAssignments(ctx, &sqlplugin.ShardDistributorAssignmentFilter{Namespace: namespace})
+	if err != nil {
+		return snapshot, fmt.Errorf("get assigned states: %w", err)
+	}
+	for _, row := range assignmentRows {
+		state, err := toAssignedState(row)
+		if err != nil {
+			return snapshot, err
+		}
+		shardIDs := slices.Sorted(maps.Keys(state.AssignedShards))
+		if shardIDs == nil {
+			shardIDs = []string{}
+		}
+		snapshot.shards[row.ExecutorID] = shardIDs
+		snapshot.metadata[row.ExecutorID] = make(map[string]string)
+	}
+
+	executorRows, err := s.db.SelectFromShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorFilter{Namespace: namespace})
+	if err != nil {
+		return snapshot, fmt.Errorf("get executor data: %w", err)
+	}
+	for _, row := range executorRows {
+		if _, ok := snapshot.shards[row.ExecutorID]; !ok {
+			continue
+		}
+		heartbeatState, err := toHeartbeatState(row)
+		if err != nil {
+			return snapshot, err
+		}
+		maps.Copy(snapshot.metadata[row.ExecutorID], heartbeatState.Metadata)
+	}
+
+	return snapshot, nil
+}
+
+// SubscribeToExecutorStatusChanges polls the statuses of the executors of the namespace, and sends
+// an increasing revision to the returned channel every time an executor is added, removed or changes its status.
+func (s *executorStoreImpl) SubscribeToExecutorStatusChanges(ctx context.Context, namespace string) (<-chan int64, error) {
+	statuses, err := s.getExecutorStatuses(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("get executor statuses: %w", err)
+	}
+
+	revisionChan := make(chan int64, 1)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(revisionChan)
+
+		scope := s.metricsClient.Scope(metrics.ShardDistributorWatchScope).
+			Tagged(metrics.NamespaceTag(namespace)).
+			Tagged(metrics.ShardDistributorWatchTypeTag("rebalance"))
+
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		var revision int64
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+			}
+
+			sw := scope.StartTimer(metrics.ShardDistributorWatchProcessingLatency)
+			current, err := s.getExecutorStatuses(ctx, namespace)
+			if err != nil {
+				s.logger.Warn("failed to poll executor statuses", tag.ShardNamespace(namespace), tag.Error(err))
+				sw.Stop()
+				continue
+			}
+			if maps.Equal(statuses, current) {
+				sw.Stop()
+				continue
+			}
+			statuses = current
+			revision++
+
+			// If the channel is full, it means the previous revision hasn't been processed yet.
+			// Pop the old revision to make room for the new one, ensuring we always have the latest revision.
+			select {
+			case <-revisionChan:
+			default:
+			}
+
+			revisionChan <- revision
+			sw.Stop()
+		}
+	}()
+
+	return revisionChan, nil
+}
+
+func (s *executorStoreImpl) getExecutorStatuses(ctx context.Context, namespace string) (map[string]int32, error) {
+	rows, err := s.db.SelectFromShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorFilter{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]int32, len(rows))
+	for _, row := range rows {
+		statuses[row.ExecutorID] = row.Status
+	}
+	return statuses, nil
+}
+
+func (s *executorStoreImpl) AssignShards(ctx context.Context, namespace string, request store.AssignShardsRequest, guard store.GuardFunc) error {
+	if len(request.ExecutorsToDelete) == 0 && len(request.NewState.ShardAssignments) == 0 {
+		return nil
+	}
+
+	return sqlclient.ExecuteInTx(ctx, s.db, s.logger, func(tx sqlplugin.Tx) error {
+		// 1. Apply the guard within the transaction, so a leadership change aborts all the writes.
+		if _, err := guard(sqlclient.Txn{Ctx: ctx, Tx: tx}); err != nil {
+			return fmt.Errorf("apply transaction guard: %w", err)
+		}
+
+		// 2. Check that the assigned states haven't changed since the new state was computed.
+		assignmentRows, err := tx.SelectFromShardDistributorAssignments(ctx, &sqlplugin.ShardDistributorAssignmentFilter{Namespace: namespace})
+		if err != nil {
+			return fmt.Errorf("get assigned states: %w", err)
+		}
+		versions := make(map[string]int64, len(assignmentRows))
+		for _, row := range assignmentRows {
+			versions[row.ExecutorID] = row.Version
+		}
+		var failingVersions []string
+		checkVersion := func(executorID string, expected int64) {
+			if versions[executorID] != expected {
+				failingVersions = append(failingVersions, fmt.Sprintf("{ executor: %s, expected:%v, actual: %v }", executorID, expected, versions[executorID]))
+			}
+		}
+		for executorID, expectedVersion := range request.ExecutorsToDelete {
+			checkVersion(executorID, expectedVersion)
+		}
+		for executorID, state := range request.NewState.ShardAssignments {
+			checkVersion(executorID, state.ModRevision)
+		}
+		if len(failingVersions) > 0 {
+			sort.Strings(failingVersions)
+			return fmt.Errorf("%w: transaction failed, a shard may have been concurrently assigned, %v", store.ErrVersionConflict, strings.Join(failingVersions, ""))
+		}
+
+		// TODO: Should be extracted to a higher level so that statistics updates are prepared
+		var statsRows []sqlplugin.ShardDistributorShardStatsRow
+		if s.cfg.GetLoadBalancingMode(namespace) == types.LoadBalancingModeGREEDY {
+			statsRows, err = s.prepareShardStatisticsUpdates(ctx, tx, namespace, request.NewState.ShardAssignments)
+			if err != nil {
+				return fmt.Errorf("prepare shard statistics: %w", err)
+			}
+		}
+
+		// 3. Delete stale executors.
+		for executorID := range request.ExecutorsToDelete {
+			if err := s.deleteExecutor(ctx, tx, namespace, executorID); err != nil {
+				return fmt.Errorf("delete executor %s: %w", executorID, err)
+			}
+		}
+
+		// 4. Write the new assigned states, the update only succeeds if the version is unchanged.
+		var shardRows []sqlplugin.ShardDistributorShardRow
+		for executorID, state := range request.NewState.ShardAssignments {
+			if err := s.writeAssignedState(ctx, tx, namespace, executorID, state); err != nil {
+				return err
+			}
+			if _, err := tx.DeleteFromShardDistributorShards(ctx, &sqlplugin.ShardDistributorShardFilter{Namespace: namespace, ExecutorID: &executorID}); err != nil {
+				return fmt.Errorf("delete shard owners of executor %s: %w", executorID, err)
+			}
+			for shardID := range state.AssignedShards {
+				shardRows = append(shardRows, sqlplugin.ShardDistributorShardRow{Namespace: namespace, ShardID: shardID, ExecutorID: executorID})
+			}
+		}
+
+		// 5. Replace the shard owners, shards may still be owned by executors that are not part of the request.
+		sort.Slice(shardRows, func(i, j int) bool { return shardRows[i].ShardID < shardRows[j].ShardID })
+		for start := 0; start < len(shardRows); start += maxShardIDsPerQuery {
+			batch := shardRows[start:min(start+maxShardIDsPerQuery, len(shardRows))]
+			shardIDs := make([]string, 0, len(batch))
+			for _, row := range batch {
+				shardIDs = append(shardIDs, row.ShardID)
+			}
+			if _, err := tx.DeleteFromShardDistributorShards(ctx, &sqlplugin.ShardDistributorShardFilter{Namespace: namespace, ShardIDs: shardIDs}); err != nil {
+				return fmt.Errorf("delete shard owners: %w", err)
+			}
+			if _, err := tx.InsertIntoShardDistributorShards(ctx, batch); err != nil {
+				return fmt.Errorf("insert shard owners: %w", err)
+			}
+		}
+
+		return s.replaceShardStatistics(ctx, tx, statsRows)
+	})
+}
+
+func (s *executorStoreImpl) writeAssignedState(ctx context.Context, tx sqlplugin.Tx, namespace, executorID string, state store.AssignedState) error {
+	data, encoding, err := encode(fromAssignedState(state))
+	if err != nil {
+		return fmt.Errorf("marshal assigned shards for executor %s: %w", executorID, err)
+	}
+	row := &sqlplugin.ShardDistributorAssignmentRow{
+		Namespace:    namespace,
+		ExecutorID:   executorID,
+		Version:      state.ModRevision + 1,
+		Data:         data,
+		DataEncoding: encoding,
+	}
+
+	if state.ModRevision == 0 {
+		if _, err := tx.InsertIntoShardDistributorAssignments(ctx, row); err != nil {
+			if tx.IsDupEntryError(err) {
+				return fmt.Errorf("%w: assigned state of executor %s was concurrently created", store.ErrVersionConflict, executorID)
+			}
+			return fmt.Errorf("insert assigned state of executor %s: %w", executorID, err)
+		}
+		return nil
+	}
+
+	result, err := tx.UpdateShardDistributorAssignments(ctx, row, state.ModRevision)
+	if err != nil {
+		return fmt.Errorf("update assigned state of executor %s: %w", executorID, err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update assigned state of executor %s: %w", executorID, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: assigned state of executor %s was concurrently updated", store.ErrVersionConflict, executorID)
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) AssignShard(ctx context.Context, namespace, shardID, executorID string) error {
+	// Retry the transaction when it loses a race with a concurrent assignment.
+	for {
+		err := sqlclient.ExecuteInTx(ctx, s.db, s.logger, func(tx sqlplugin.Tx) error {
+			return s.assignShard(ctx, tx, namespace, shardID, executorID)
+		})
+		if !errors.Is(err, errConcurrentUpdate) {
+			return err
+		}
+		s.logger.Info("Assign shard transaction failed due to a conflict. Retrying...", tag.ShardNamespace(namespace), tag.ShardKey(shardID), tag.ShardExecutor(executorID))
+	}
+}
+
+func (s *executorStoreImpl) assignShard(ctx context.Context, tx sqlplugin.Tx, namespace, shardID, executorID string) error {
+	// 1. Check that the executor is active.
+	executorRow, err := s.getExecutorRow(ctx, tx, namespace, executorID)
+	if err != nil {
+		return fmt.Errorf("get executor status: %w", err)
+	}
+	if executorRow == nil {
+		return store.ErrExecutorNotFound
+	}
+	if status := types.ExecutorStatus(executorRow.Status); status != types.ExecutorStatusACTIVE {
+		return fmt.Errorf("%w: executor status is %s", store.ErrVersionConflict, status)
+	}
+
+	// 2. Check that the shard is not assigned yet.
+	owners, err := s.getShardOwners(ctx, tx, namespace, []string{shardID})
+	if err != nil {
+		return fmt.Errorf("checking shard owner: %w", err)
+	}
+	if owner, ok := owners[shardID]; ok {
+		metadata, err := s.getExecutorMetadata(ctx, tx, namespace, owner)
+		if err != nil {
+			return fmt.Errorf("get shard owner metadata: %w", err)
+		}
+		return &store.ErrShardAlreadyAssigned{ShardID: shardID, AssignedTo: owner, Metadata: metadata}
+	}
+
+	// 3. Add the shard to the assigned state of the executor.
+	state := store.AssignedState{AssignedShards: make(map[string]*types.ShardAssignment)}
+	assignmentRow, err := s.getAssignmentRow(ctx, tx, namespace, executorID)
+	if err != nil {
+		return fmt.Errorf("get executor assigned state: %w", err)
+	}
+	if assignmentRow != nil {
+		if state, err = toAssignedState(*assignmentRow); err != nil {
+			return err
+		}
+		if state.AssignedShards == nil {
+			state.AssignedShards = make(map[string]*types.ShardAssignment)
+		}
+	}
+	if _, alreadyAssigned := state.AssignedShards[shardID]; !alreadyAssigned {
+		state.AssignedShards[shardID] = &types.ShardAssignment{Status: types.AssignmentStatusREADY}
+	}
+	now := s.timeSource.Now().UTC()
+	state.LastUpdated = now
+
+	if err := s.writeAssignedState(ctx, tx, namespace, executorID, state); err != nil {
+		if errors.Is(err, store.ErrVersionConflict) {
+			return errConcurrentUpdate
+		}
+		return err
+	}
+
+	// 4. Record the shard owner, a duplicate means the shard was concurrently assigned.
+	if _, err := tx.InsertIntoShardDistributorShards(ctx, []sqlplugin.ShardDistributorShardRow{
+		{Namespace: namespace, ShardID: shardID, ExecutorID: executorID},
+	}); err != nil {
+		if tx.IsDupEntryError(err) {
+			return errConcurrentUpdate
+		}
+		return fmt.Errorf("insert shard owner: %w", err)
+	}
+
+	// TODO: Extract to higher level so that statistics updates are prepared
+	if s.cfg.GetLoadBalancingMode(namespace) == types.LoadBalancingModeGREEDY {
+		oldStats, err := s.getShardStatistics(ctx, tx, namespace, []string{shardID})
+		if err != nil {
+			return fmt.Errorf("get shard statistics: %w", err)
+		}
+		shardStats, ok := oldStats[shardID]
+		if !ok {
+			shardStats.SmoothedLoad = 0
+			shardStats.LastUpdateTime = now
+		}
+		shardStats.LastMoveTime = now
+
+		row, err := toShardStatsRow(namespace, shardID, executorID, shardStats)
+		if err != nil {
+			return err
+		}
+		if err := s.replaceShardStatistics(ctx, tx, []sqlplugin.ShardDistributorShardStatsRow{row}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteExecutors deletes the given executors from the store. It does not reassign the shards owned by the executors, this
+// should be handled by the namespace processor loop as we want to reassign, not delete the shards.
+func (s *executorStoreImpl) DeleteExecutors(ctx context.Context, namespace string, executorIDs []string, guard store.GuardFunc) error {
+	if len(executorIDs) == 0 {
+		return nil
+	}
+
+	err := s.executeGuarded(ctx, guard, func(tx sqlplugin.Tx) error {
+		for _, executorID := range executorIDs {
+			if err := s.deleteExecutor(ctx, tx, namespace, executorID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete executors: %w", err)
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) DeleteAssignedStates(ctx context.Context, namespace string, executorIDs []string, guard store.GuardFunc) error {
+	if len(executorIDs) == 0 {
+		return nil
+	}
+
+	err := s.executeGuarded(ctx, guard, func(tx sqlplugin.Tx) error {
+		for _, executorID := range executorIDs {
+			if _, err := tx.DeleteFromShardDistributorAssignments(ctx, &sqlplugin.ShardDistributorAssignmentFilter{Namespace: namespace, ExecutorID: &executorID}); err != nil {
+				return err
+			}
+			if _, err := tx.DeleteFromShardDistributorShards(ctx, &sqlplugin.ShardDistributorShardFilter{Namespace: namespace, ExecutorID: &executorID}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete assigned states: %w", err)
+	}
+	return nil
+}
+
+// DeleteShardStats deletes shard statistics for the given shard IDs.
+// If the operation fails (e.g. due to leadership loss), none of the statistics are deleted.
+func (s *executorStoreImpl) DeleteShardStats(ctx context.Context, namespace string, shardIDs []string, guard store.GuardFunc) error {
+	if len(shardIDs) == 0 {
+		return nil
+	}
+
+	err := s.executeGuarded(ctx, guard, func(tx sqlplugin.Tx) error {
+		for start := 0; start < len(shardIDs); start += maxShardIDsPerQuery {
+			batch := shardIDs[start:min(start+maxShardIDsPerQuery, len(shardIDs))]
+			if _, err := tx.DeleteFromShardDistributorShardStats(ctx, &sqlplugin.ShardDistributorShardStatsFilter{Namespace: namespace, ShardIDs: batch}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete shard stats: %w", err)
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) GetShardOwner(ctx context.Context, namespace, shardID string) (*store.ShardOwner, error) {
+	owners, err := s.getShardOwners(ctx, s.db, namespace, []string{shardID})
+	if err != nil {
+		return nil, fmt.Errorf("get shard owner: %w", err)
+	}
+	executorID, ok := owners[shardID]
+	if !ok {
+		return nil, store.ErrShardNotFound
+	}
+
+	metadata, err := s.getExecutorMetadata(ctx, s.db, namespace, executorID)
+	if err != nil {
+		return nil, fmt.Errorf("get shard owner metadata: %w", err)
+	}
+	return &store.ShardOwner{ExecutorID: executorID, Metadata: metadata}, nil
+}
+
+func (s *executorStoreImpl) GetExecutor(ctx context.Context, namespace string, executorID string) (*store.ShardOwner, error) {
+	executorRow, err := s.getExecutorRow(ctx, s.db, namespace, executorID)
+	if err != nil {
+		return nil, fmt.Errorf("get executor %s: %w", executorID, err)
+	}
+	if executorRow == nil {
+		assignmentRow, err := s.getAssignmentRow(ctx, s.db, namespace, executorID)
+		if err != nil {
+			return nil, fmt.Errorf("get assigned state of executor %s: %w", executorID, err)
+		}
+		if assignmentRow == nil {
+			return nil, store.ErrExecutorNotFound
+		}
+		return &store.ShardOwner{ExecutorID: executorID, Metadata: make(map[string]string)}, nil
+	}
+
+	heartbeatState, err := toHeartbeatState(*executorRow)
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[string]string, len(heartbeatState.Metadata))
+	maps.Copy(metadata, heartbeatState.Metadata)
+	return &store.ShardOwner{ExecutorID: executorID, Metadata: metadata}, nil
+}
+
+// prepareShardStatisticsUpdates calculates the shard statistics rows to write for a new shard assignment plan.
+// Moved shards keep their accumulated load and record the time of the move, new shards start without load.
+func (s *executorStoreImpl) prepareShardStatisticsUpdates(ctx context.Context, tx sqlplugin.Tx, namespace string, newAssignments map[string]store.AssignedState) ([]sqlplugin.ShardDistributorShardStatsRow, error) {
+	owners, err := s.getShardOwners(ctx, tx, namespace, nil)
+	if err != nil {
+		return nil, fmt.Errorf("lookup shard owners: %w", err)
+	}
+	previousStats, err := s.getShardStatistics(ctx, tx, namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.timeSource.Now().UTC()
+	var rows []sqlplugin.ShardDistributorShardStatsRow
+	for newOwnerID, state := range newAssignments {
+		for shardID := range state.AssignedShards {
+			oldOwnerID, hasOwner := owners[shardID]
+			if hasOwner && oldOwnerID == newOwnerID {
+				// Already owned by the target, nothing to move.
+				continue
+			}
+
+			// Leave LastMoveTime zero so the shard isn't blocked from rebalancing
+			// before we have any load measurements.
+			newStatForShard := store.ShardStatistics{
+				SmoothedLoad:   0,
+				LastUpdateTime: now,
+			}
+			if previousStatForShard, ok := previousStats[shardID]; ok && hasOwner {
+				// Carry over the accumulated load and update the move time.
+				newStatForShard = previousStatForShard
+				newStatForShard.LastMoveTime = now
+			}
+
+			row, err := toShardStatsRow(namespace, shardID, newOwnerID, newStatForShard)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// executeGuarded runs fn in a transaction after applying the guard to it.
+func (s *executorStoreImpl) executeGuarded(ctx context.Context, guard store.GuardFunc, fn func(tx sqlplugin.Tx) error) error {
+	return sqlclient.ExecuteInTx(ctx, s.db, s.logger, func(tx sqlplugin.Tx) error {
+		if _, err := guard(sqlclient.Txn{Ctx: ctx, Tx: tx}); err != nil {
+			return fmt.Errorf("apply transaction guard: %w", err)
+		}
+		return fn(tx)
+	})
+}
+
+// deleteExecutor deletes all the rows of an executor: its heartbeat, assigned state, shard owners and statistics.
+func (s *executorStoreImpl) deleteExecutor(ctx context.Context, tx sqlplugin.Tx, namespace, executorID string) error {
+	if _, err := tx.DeleteFromShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorFilter{Namespace: namespace, ExecutorID: &executorID}); err != nil {
+		return err
+	}
+	if _, err := tx.DeleteFromShardDistributorAssignments(ctx, &sqlplugin.ShardDistributorAssignmentFilter{Namespace: namespace, ExecutorID: &executorID}); err != nil {
+		return err
+	}
+	if _, err := tx.DeleteFromShardDistributorShards(ctx, &sqlplugin.ShardDistributorShardFilter{Namespace: namespace, ExecutorID: &executorID}); err != nil {
+		return err
+	}
+	if _, err := tx.DeleteFromShardDistributorShardStats(ctx, &sqlplugin.ShardDistributorShardStatsFilter{Namespace: namespace, ExecutorID: &executorID}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// queryer is the subset of sqlplugin.DB and sqlplugin.Tx used for reads, so they can run in or out of a transaction.
+type queryer interface {
+	SelectFromShardDistributorExecutors(ctx context.Context, filter *sqlplugin.ShardDistributorExecutorFilter) ([]sqlplugin.ShardDistributorExecutorRow, error)
+	SelectFromShardDistributorAssignments(ctx context.Context, filter *sqlplugin.ShardDistributorAssignmentFilter) ([]sqlplugin.ShardDistributorAssignmentRow, error)
+	SelectFromShardDistributorShards(ctx context.Context, filter *sqlplugin.ShardDistributorShardFilter) ([]sqlplugin.ShardDistributorShardRow, error)
+	SelectFromShardDistributorShardStats(ctx context.Context, filter *sqlplugin.ShardDistributorShardStatsFilter) ([]sqlplugin.ShardDistributorShardStatsRow, error)
+}
+
+func (s *executorStoreImpl) getExecutorRow(ctx context.Context, q queryer, namespace, executorID string) (*sqlplugin.ShardDistributorExecutorRow, error) {
+	rows, err := q.SelectFromShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorFilter{Namespace: namespace, ExecutorID: &executorID})
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return &rows[0], nil
+}
+
+func (s *executorStoreImpl) getAssignmentRow(ctx context.Context, q queryer, namespace, executorID string) (*sqlplugin.ShardDistributorAssignmentRow, error) {
+	rows, err := q.SelectFromShardDistributorAssignments(ctx, &sqlplugin.ShardDistributorAssignmentFilter{Namespace: namespace, ExecutorID: &executorID})
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return &rows[0], nil
+}
+
+// getExecutorMetadata returns the metadata of an executor, or an empty map if the executor has no heartbeat.
+func (s *executorStoreImpl) getExecutorMetadata(ctx context.Context, q queryer, namespace, executorID string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	row, err := s.getExecutorRow(ctx, q, namespace, executorID)
+	if err != nil || row == nil {
+		return metadata, err
+	}
+	heartbeatState, err := toHeartbeatState(*row)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(metadata, heartbeatState.Metadata)
+	return metadata, nil
+}
+
+// getShardOwners returns the owner of each of the given shards that has one, or of all shards of the namespace if shardIDs is empty.
+func (s *executorStoreImpl) getShardOwners(ctx context.Context, q queryer, namespace string, shardIDs []string) (map[string]string, error) {
+	owners := make(map[string]string)
+	err := forEachShardBatch(shardIDs, func(batch []string) error {
+		rows, err := q.SelectFromShardDistributorShards(ctx, &sqlplugin.ShardDistributorShardFilter{Namespace: namespace, ShardIDs: batch})
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			owners[row.ShardID] = row.ExecutorID
+		}
+		return nil
+	})
+	return owners, err
+}
+
+// getShardStatistics returns the statistics of each of the given shards that has them, or of all shards of the namespace if shardIDs is empty.
+func (s *executorStoreImpl) getShardStatistics(ctx context.Context, q queryer, namespace string, shardIDs []string) (map[string]store.ShardStatistics, error) {
+	stats := make(map[string]store.ShardStatistics)
+	err := forEachShardBatch(shardIDs, func(batch []string) error {
+		rows, err := q.SelectFromShardDistributorShardStats(ctx, &sqlplugin.ShardDistributorShardStatsFilter{Namespace: namespace, ShardIDs: batch})
+		if err != nil {
+			return fmt.Errorf("get shard statistics: %w", err)
+		}
+		for _, row := range rows {
+			if stats[row.ShardID], err = toShardStatistics(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return stats, err
+}
+
+func (s *executorStoreImpl) replaceShardStatistics(ctx context.Context, tx sqlplugin.Tx, rows []sqlplugin.ShardDistributorShardStatsRow) error {
+	for start := 0; start < len(rows); start += maxShardIDsPerQuery {
+		if _, err := tx.ReplaceIntoShardDistributorShardStats(ctx, rows[start:min(start+maxShardIDsPerQuery, len(rows))]); err != nil {
+			return fmt.Errorf("write shard statistics: %w", err)
+		}
+	}
+	return nil
+}
+
+// forEachShardBatch calls fn with batches of at most maxShardIDsPerQuery shard IDs.
+// fn is called once with a nil batch if shardIDs is empty.
+func forEachShardBatch(shardIDs []string, fn func(batch []string) error) error {
+	if len(shardIDs) == 0 {
+		return fn(nil)
+	}
+	for start := 0; start < len(shardIDs); start += maxShardIDsPerQuery {
+		if err := fn(shardIDs[start:min(start+maxShardIDsPerQuery, len(shardIDs))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
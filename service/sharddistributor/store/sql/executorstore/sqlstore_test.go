@@ -0,0 +1,1000 @@
+package executorstore
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/statistics"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/leaderstore"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/testhelper"
+)
+
+// TestRecordHeartbeat verifies that an executor's heartbeat is correctly stored.
+func TestRecordHeartbeat(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	executorID := "executor-TestRecordHeartbeat"
+	req := store.HeartbeatState{
+		LastHeartbeat: now,
+		Status:        types.ExecutorStatusACTIVE,
+		ReportedShards: map[string]*types.ShardStatusReport{
+			"shard-TestRecordHeartbeat": {Status: types.ShardStatusREADY},
+		},
+		Metadata: map[string]string{
+			"key-1": "value-1",
+			"key-2": "value-2",
+		},
+	}
+
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
+	require.NoError(t, err)
+
+	// Verify directly in the database
+	executorID2 := executorID
+	rows, err := tc.DB.SelectFromShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorFilter{Namespace: tc.Namespace, ExecutorID: &executorID2})
+	require.NoError(t, err)
+	require.Len(t, rows, 1, "Executor row should exist")
+	assert.Equal(t, int32(types.ExecutorStatusACTIVE), rows[0].Status)
+
+	heartbeatState, err := toHeartbeatState(rows[0])
+	require.NoError(t, err)
+	assert.Equal(t, now, heartbeatState.LastHeartbeat)
+	require.Len(t, heartbeatState.ReportedShards, 1)
+	assert.Equal(t, types.ShardStatusREADY, heartbeatState.ReportedShards["shard-TestRecordHeartbeat"].Status)
+	assert.Equal(t, req.Metadata, heartbeatState.Metadata)
+
+	// Metadata keys are kept by later heartbeats
+	req.Metadata = map[string]string{"key-2": "value-2-updated"}
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req))
+	owner, err := executorStore.GetExecutor(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key-1": "value-1", "key-2": "value-2-updated"}, owner.Metadata)
+}
+
+func TestRecordHeartbeatUpdatesShardStatistics(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	setLoadBalancingMode(executorStore, config.LoadBalancingModeGREEDY)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "executor-shard-stats"
+	shardID := "shard-with-load"
+
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID))
+
+	stateBeforeHeartbeat, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	beforeStats, ok := stateBeforeHeartbeat.ShardStats[shardID]
+	require.True(t, ok)
+
+	impl := executorStore.(*executorStoreImpl)
+	impl.timeSource.(clock.MockedTimeSource).Advance(5 * time.Second)
+
+	req := store.HeartbeatState{
+		LastHeartbeat: impl.timeSource.Now().UTC(),
+		Status:        types.ExecutorStatusACTIVE,
+		ReportedShards: map[string]*types.ShardStatusReport{
+			shardID: {
+				Status:    types.ShardStatusREADY,
+				ShardLoad: 45.6,
+			},
+		},
+	}
+
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req))
+
+	nsState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	updated, ok := nsState.ShardStats[shardID]
+	require.True(t, ok)
+	assert.True(t, updated.LastUpdateTime.After(beforeStats.LastUpdateTime))
+	expectedLoad, err := statistics.CalculateSmoothedLoad(beforeStats.SmoothedLoad, req.ReportedShards[shardID].ShardLoad, beforeStats.LastUpdateTime, updated.LastUpdateTime)
+	require.NoError(t, err)
+	assert.InDelta(t, expectedLoad, updated.SmoothedLoad, 1e-9)
+	assert.Equal(t, beforeStats.LastMoveTime, updated.LastMoveTime)
+}
+
+func TestRecordHeartbeatSkipsShardStatisticsWithNilReport(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	setLoadBalancingMode(executorStore, config.LoadBalancingModeGREEDY)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "executor-missing-load"
+	validShardID := "shard-with-valid-load"
+	skippedShardID := "shard-missing-load"
+	notOwnedShardID := "shard-not-owned"
+
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, validShardID, executorID))
+
+	stateBeforeHeartbeat, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	beforeStats, ok := stateBeforeHeartbeat.ShardStats[validShardID]
+	require.True(t, ok)
+
+	impl := executorStore.(*executorStoreImpl)
+	impl.timeSource.(clock.MockedTimeSource).Advance(5 * time.Second)
+
+	req := store.HeartbeatState{
+		LastHeartbeat: impl.timeSource.Now().UTC(),
+		Status:        types.ExecutorStatusACTIVE,
+		ReportedShards: map[string]*types.ShardStatusReport{
+			validShardID: {
+				Status:    types.ShardStatusREADY,
+				ShardLoad: 3.21,
+			},
+			skippedShardID:  nil,
+			notOwnedShardID: {Status: types.ShardStatusREADY, ShardLoad: 1},
+		},
+	}
+
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req))
+
+	nsState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	validStats, ok := nsState.ShardStats[validShardID]
+	require.True(t, ok)
+	expectedLoad, err := statistics.CalculateSmoothedLoad(beforeStats.SmoothedLoad, req.ReportedShards[validShardID].ShardLoad, beforeStats.LastUpdateTime, validStats.LastUpdateTime)
+	require.NoError(t, err)
+	assert.InDelta(t, expectedLoad, validStats.SmoothedLoad, 1e-9)
+	assert.False(t, validStats.LastUpdateTime.IsZero())
+	assert.Equal(t, beforeStats.LastMoveTime, validStats.LastMoveTime)
+
+	assert.NotContains(t, nsState.ShardStats, skippedShardID)
+	assert.NotContains(t, nsState.ShardStats, notOwnedShardID)
+}
+
+func TestGetHeartbeat(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	executorID := "executor-get"
+	req := store.HeartbeatState{
+		Status:        types.ExecutorStatusDRAINING,
+		LastHeartbeat: now,
+	}
+
+	// 1. Record a heartbeat
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
+	require.NoError(t, err)
+
+	// Assign shards to one executor
+	assignState := map[string]store.AssignedState{
+		executorID: {
+			AssignedShards: map[string]*types.ShardAssignment{
+				"shard-1": {Status: types.AssignmentStatusREADY},
+			},
+		},
+	}
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: assignState,
+		},
+	}, store.NopGuard()))
+
+	// 2. Get the heartbeat back
+	hb, assignedFromDB, err := executorStore.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	require.NotNil(t, hb)
+
+	// 3. Verify the state
+	assert.Equal(t, types.ExecutorStatusDRAINING, hb.Status)
+	assert.Equal(t, now, hb.LastHeartbeat)
+	require.NotNil(t, assignedFromDB.AssignedShards)
+	assert.Equal(t, assignState[executorID].AssignedShards, assignedFromDB.AssignedShards)
+
+	// 4. Test getting a non-existent executor
+	_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, "executor-non-existent")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound)
+}
+
+// TestGetState verifies that the store can accurately retrieve the state of all executors.
+func TestGetState(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-TestGetState-1"
+	executorID2 := "exec-TestGetState-2"
+	shardID1 := "shard-1"
+	shardID2 := "shard-2"
+
+	// Setup: Record heartbeats and assign shards.
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID2, store.HeartbeatState{Status: types.ExecutorStatusDRAINING}))
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID1: {}}},
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID2: {}}},
+			},
+		},
+	}, store.NopGuard()))
+
+	// Action: Get the state.
+	namespaceState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	// Verification:
+	// Check Executors
+	require.Len(t, namespaceState.Executors, 2, "Should retrieve two heartbeat states")
+	assert.Equal(t, types.ExecutorStatusACTIVE, namespaceState.Executors[executorID1].Status)
+	assert.Equal(t, types.ExecutorStatusDRAINING, namespaceState.Executors[executorID2].Status)
+
+	// Check ShardAssignments (from executor records)
+	require.Len(t, namespaceState.ShardAssignments, 2, "Should retrieve two assignment states")
+	assert.Contains(t, namespaceState.ShardAssignments[executorID1].AssignedShards, shardID1)
+	assert.Contains(t, namespaceState.ShardAssignments[executorID2].AssignedShards, shardID2)
+}
+
+// TestAssignShards_WithRevisions tests the optimistic locking logic of AssignShards.
+func TestNamespaceControls(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Controls that were never written are empty.
+	controls, err := executorStore.GetNamespaceControls(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.True(t, controls.IsEmpty())
+	assert.Zero(t, controls.ModRevision)
+
+	controls.Executors = map[string]store.ExecutorControl{"exec-1": {Draining: true, MaxConcurrentMoves: 2}}
+	controls.PinnedShards = map[string]string{"shard-1": "exec-2"}
+	require.NoError(t, executorStore.UpdateNamespaceControls(ctx, tc.Namespace, controls))
+
+	// A write based on a stale version is rejected, both when creating and when updating the controls.
+	err = executorStore.UpdateNamespaceControls(ctx, tc.Namespace, controls)
+	require.ErrorIs(t, err, store.ErrVersionConflict)
+
+	updated, err := executorStore.GetNamespaceControls(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated.ModRevision)
+	assert.Equal(t, controls.PinnedShards, updated.PinnedShards)
+	assert.True(t, updated.Executors["exec-1"].Draining)
+	assert.Equal(t, int32(2), updated.Executors["exec-1"].MaxConcurrentMoves)
+
+	updated.PinnedShards = nil
+	require.NoError(t, executorStore.UpdateNamespaceControls(ctx, tc.Namespace, updated))
+	err = executorStore.UpdateNamespaceControls(ctx, tc.Namespace, updated)
+	require.ErrorIs(t, err, store.ErrVersionConflict)
+
+	// The controls are part of the namespace state.
+	namespaceState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), namespaceState.Controls.ModRevision)
+	assert.Empty(t, namespaceState.Controls.PinnedShards)
+	assert.True(t, namespaceState.Controls.Executors["exec-1"].Draining)
+}
+
+func TestAssignShards_WithRevisions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-rev-1"
+	executorID2 := "exec-rev-2"
+
+	t.Run("Success", func(t *testing.T) {
+		tc := testhelper.SetupStoreTestCluster(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// Define a new state: assign shard1 to exec1
+		newState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}}},
+			},
+		}
+
+		// Assign - should succeed
+		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: newState}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Verify the assignment
+		state, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		assert.Contains(t, state.ShardAssignments[executorID1].AssignedShards, "shard-1")
+		owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, "shard-1")
+		require.NoError(t, err)
+		assert.Equal(t, executorID1, owner.ExecutorID)
+	})
+
+	t.Run("ConflictOnNewShard", func(t *testing.T) {
+		tc := testhelper.SetupStoreTestCluster(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// Process A defines its desired state: assign shard-new to exec1
+		processAState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
+				executorID2: {},
+			},
+		}
+
+		// Process B defines its desired state: assign shard-new to exec2
+		processBState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {},
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
+			},
+		}
+
+		// Process A succeeds
+		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processAState}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Process B tries to commit, but its version check for exec1 (version=0) will fail.
+		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processBState}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+		// The failed transaction didn't change the owner.
+		owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, "shard-new")
+		require.NoError(t, err)
+		assert.Equal(t, executorID1, owner.ExecutorID)
+	})
+
+	t.Run("ConflictOnExistingShard", func(t *testing.T) {
+		tc := testhelper.SetupStoreTestCluster(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		shardID := "shard-to-move"
+		// 1. Setup: Assign the shard to executor1
+		setupState, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		setupState.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}},
+		}
+		require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: setupState}, store.NopGuard()))
+
+		// 2. Process A reads the state, intending to move the shard to executor2
+		stateForProcA, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		stateForProcA.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {ModRevision: stateForProcA.ShardAssignments[executorID1].ModRevision},
+			executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}, ModRevision: 0},
+		}
+
+		// 3. In the meantime, another process makes a different change (e.g., re-assigns to same executor, which changes version)
+		intermediateState, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		intermediateState.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {
+				AssignedShards: map[string]*types.ShardAssignment{shardID: {}},
+				ModRevision:    intermediateState.ShardAssignments[executorID1].ModRevision,
+			},
+		}
+		require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: intermediateState}, store.NopGuard()))
+
+		// 4. Process A tries to commit its change. It will fail because its stored version for the executor is now stale.
+		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: stateForProcA}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+	})
+
+	t.Run("MoveShard", func(t *testing.T) {
+		tc := testhelper.SetupStoreTestCluster(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		shardID := "shard-to-move"
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID1))
+
+		state, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		state.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {AssignedShards: map[string]*types.ShardAssignment{}, ModRevision: state.ShardAssignments[executorID1].ModRevision},
+			executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}},
+		}
+		require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: state}, store.NopGuard()))
+
+		owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, shardID)
+		require.NoError(t, err)
+		assert.Equal(t, executorID2, owner.ExecutorID)
+	})
+
+	t.Run("ConflictOnDeletedExecutor", func(t *testing.T) {
+		tc := testhelper.SetupStoreTestCluster(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// The executor received a shard after the decision to delete it was taken.
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID1))
+
+		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+			NewState:          &store.NamespaceState{},
+			ExecutorsToDelete: map[string]int64{executorID1: 0},
+		}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, executorID1)
+		require.NoError(t, err, "Executor should not have been deleted")
+	})
+
+	t.Run("NoChanges", func(t *testing.T) {
+		tc := testhelper.SetupStoreTestCluster(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// Get the current state
+		state, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+
+		// Call AssignShards with the same assignments
+		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: state}, store.NopGuard())
+		require.NoError(t, err, "Assigning with no changes should succeed")
+	})
+}
+
+// TestGuardedOperations verifies that AssignShards and DeleteExecutors respect the leader guard.
+func TestGuardedOperations(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	namespace := "test-guarded-ns"
+	executorID := "exec-to-delete"
+
+	// 1. Create two potential leaders
+	leaderCfg, err := sqlclient.NewLeaderStoreConfig(tc.SDConfig)
+	require.NoError(t, err)
+	elector, err := leaderstore.NewLeaderStore(leaderstore.StoreParams{DB: tc.DB, Cfg: leaderCfg})
+	require.NoError(t, err)
+	election1, err := elector.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer func() { _ = election1.Cleanup(ctx) }()
+	election2, err := elector.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer func() { _ = election2.Cleanup(ctx) }()
+
+	// 2. First node becomes leader
+	require.NoError(t, election1.Campaign(ctx, "host-1"))
+	validGuard := election1.Guard()
+
+	// 3. Use the valid guard to assign shards - should succeed
+	assignState := map[string]store.AssignedState{"exec-1": {}}
+	err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, validGuard)
+	require.NoError(t, err, "Assigning shards with a valid leader guard should succeed")
+
+	// 4. First node resigns, second node becomes leader
+	require.NoError(t, election1.Resign(ctx))
+	require.NoError(t, election2.Campaign(ctx, "host-2"))
+
+	// 5. Use the now-invalid guard from the first leader - should fail
+	err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, validGuard)
+	require.Error(t, err, "Assigning shards with a stale leader guard should fail")
+	assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+	// 6. The stale guard also protects the deletions
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	err = executorStore.DeleteExecutors(ctx, tc.Namespace, []string{executorID}, validGuard)
+	require.Error(t, err, "Deleting an executor with a stale leader guard should fail")
+	_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.NoError(t, err, "Executor should not have been deleted")
+
+	// 7. Use the NopGuard to delete an executor - should succeed
+	err = executorStore.DeleteExecutors(ctx, tc.Namespace, []string{executorID}, store.NopGuard())
+	require.NoError(t, err, "Deleting an executor without a guard should succeed")
+
+	// Verify deletion
+	_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor should have been deleted")
+}
+
+// TestSubscribeToExecutorStatusChanges verifies that the subscription channel receives notifications for significant changes.
+func TestSubscribeToExecutorStatusChanges(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-sub"
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// Start subscription
+	sub, err := executorStore.SubscribeToExecutorStatusChanges(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	heartbeat := func(status types.ExecutorStatus, reportedShards map[string]*types.ShardStatusReport) {
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+			LastHeartbeat:  time.Now().UTC(),
+			Status:         status,
+			ReportedShards: reportedShards,
+		}))
+	}
+
+	// Test case #1: Update heartbeat without changing status or reported shards - should NOT trigger notification
+	{
+		heartbeat(types.ExecutorStatusACTIVE, nil)
+
+		select {
+		case <-sub:
+			t.Fatal("Should not receive notification for a heartbeat-only update")
+		case <-time.After(100 * time.Millisecond):
+			// Expected behavior
+		}
+	}
+
+	// Test case #2: Update reported shards without changing status - should NOT trigger notification
+	{
+		heartbeat(types.ExecutorStatusACTIVE, map[string]*types.ShardStatusReport{"shard-1": {Status: types.ShardStatusREADY}})
+
+		select {
+		case <-sub:
+			t.Fatal("Should not receive notification for a reported-shards-only update")
+		case <-time.After(100 * time.Millisecond):
+			// Expected behavior
+		}
+	}
+
+	// Test case #3: Update status - should trigger notification
+	{
+		heartbeat(types.ExecutorStatusDRAINING, nil)
+
+		select {
+		case rev, ok := <-sub:
+			require.True(t, ok, "Channel should be open")
+			assert.Greater(t, rev, int64(0), "Should receive a valid revision for status change")
+		case <-time.After(1 * time.Second):
+			t.Fatal("Should have received a notification for a status change")
+		}
+	}
+
+	// Test case #4: Update status with the same value - should NOT trigger notification
+	{
+		heartbeat(types.ExecutorStatusDRAINING, nil)
+
+		select {
+		case <-sub:
+			t.Fatal("Should not receive notification")
+		case <-time.After(100 * time.Millisecond):
+			// Expected behavior
+		}
+	}
+
+	// Test case #5: Delete the executor - should trigger notification
+	{
+		require.NoError(t, executorStore.DeleteExecutors(ctx, tc.Namespace, []string{executorID}, store.NopGuard()))
+
+		select {
+		case rev, ok := <-sub:
+			require.True(t, ok, "Channel should be open")
+			assert.Greater(t, rev, int64(0), "Should receive a valid revision for status change")
+		case <-time.After(1 * time.Second):
+			t.Fatal("Should have received a notification for a status change")
+		}
+	}
+
+	// The channel is closed when the context is cancelled
+	cancel()
+	select {
+	case _, ok := <-sub:
+		assert.False(t, ok, "Channel should be closed")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Channel should have been closed")
+	}
+}
+
+// TestSubscribeToAssignmentChanges verifies that subscribers receive the initial assignments and their changes.
+func TestSubscribeToAssignmentChanges(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-assignments"
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: map[string]string{"hostname": "host-1"},
+	}))
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID))
+
+	sub, unSubscribe, err := executorStore.SubscribeToAssignmentChanges(ctx, tc.Namespace)
+	require.NoError(t, err)
+	defer unSubscribe()
+
+	verifyState := func(state map[*store.ShardOwner][]string, shardIDs []string) {
+		require.Len(t, state, 1)
+		for owner, ownedShardIDs := range state {
+			assert.Equal(t, executorID, owner.ExecutorID)
+			assert.Equal(t, map[string]string{"hostname": "host-1"}, owner.Metadata)
+			assert.ElementsMatch(t, shardIDs, ownedShardIDs)
+		}
+	}
+
+	// The initial state is sent to the subscriber
+	select {
+	case state := <-sub:
+		verifyState(state, []string{"shard-1"})
+	case <-time.After(1 * time.Second):
+		t.Fatal("Should have received the initial state")
+	}
+
+	// Changes of the assignments are sent to the subscriber
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-2", executorID))
+	select {
+	case state := <-sub:
+		verifyState(state, []string{"shard-1", "shard-2"})
+	case <-time.After(1 * time.Second):
+		t.Fatal("Should have received the updated state")
+	}
+}
+
+func TestDeleteExecutors_Empty(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{}, store.NopGuard())
+	require.NoError(t, err)
+}
+
+// TestDeleteExecutors covers various scenarios for the DeleteExecutors method.
+func TestDeleteExecutors(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("SucceedsForNonExistentExecutor", func(t *testing.T) {
+		// Action: Delete a non-existent executor.
+		err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{"non-existent-executor"}, store.NopGuard())
+		// Verification: Should not return an error.
+		require.NoError(t, err)
+	})
+
+	t.Run("DeletesMultipleExecutors", func(t *testing.T) {
+		// Setup: Create and assign shards to multiple executors.
+		execToDelete1 := "multi-delete-1"
+		execToDelete2 := "multi-delete-2"
+		execToKeep := "multi-keep-1"
+		shardOfDeletedExecutor1 := "multi-shard-1"
+		shardOfDeletedExecutor2 := "multi-shard-2"
+		shardOfSurvivingExecutor := "multi-shard-keep"
+
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToDelete1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToDelete2, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToKeep, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor1, execToDelete1))
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor2, execToDelete2))
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfSurvivingExecutor, execToKeep))
+
+		// Action: Delete two of the three executors in one call.
+		err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{execToDelete1, execToDelete2}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Verification:
+		// 1. Check deleted executors are gone.
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToDelete1)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 1 should be gone")
+
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToDelete2)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 2 should be gone")
+
+		_, err = executorStore.GetShardOwner(ctx, tc.Namespace, shardOfDeletedExecutor1)
+		assert.ErrorIs(t, err, store.ErrShardNotFound, "Shards of deleted executors should have no owner")
+
+		// 2. Check that the surviving executor remain.
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToKeep)
+		assert.NoError(t, err, "Surviving executor should still exist")
+	})
+}
+
+func TestDeleteAssignedStates(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-delete-assigned"
+	recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID)
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID))
+
+	require.NoError(t, executorStore.DeleteAssignedStates(ctx, tc.Namespace, []string{executorID}, store.NopGuard()))
+
+	hb, assigned, err := executorStore.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ExecutorStatusACTIVE, hb.Status)
+	assert.Nil(t, assigned)
+	_, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-1")
+	assert.ErrorIs(t, err, store.ErrShardNotFound)
+}
+
+// TestAssignAndGetShardOwnerRoundtrip verifies the successful assignment and retrieval of a shard owner.
+func TestAssignAndGetShardOwnerRoundtrip(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc).(*executorStoreImpl)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := executorStore.timeSource.Now().UTC()
+	executorID := "executor-roundtrip"
+	shardID := "shard-roundtrip"
+
+	// Setup: Create an active executor.
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: map[string]string{"hostname": "host-1"},
+	})
+	require.NoError(t, err)
+
+	// 1. Assign a shard to the active executor.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID)
+	require.NoError(t, err, "Should successfully assign shard to an active executor")
+
+	// 2. Get the owner and verify it's the correct executor.
+	state, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.Contains(t, state.ShardAssignments[executorID].AssignedShards, shardID)
+	assert.Equal(t, now, state.ShardAssignments[executorID].LastUpdated)
+
+	owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, shardID)
+	require.NoError(t, err)
+	assert.Equal(t, &store.ShardOwner{ExecutorID: executorID, Metadata: map[string]string{"hostname": "host-1"}}, owner)
+
+	_, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-unknown")
+	assert.ErrorIs(t, err, store.ErrShardNotFound)
+}
+
+// TestAssignShardErrors tests the various error conditions when assigning a shard.
+func TestAssignShardErrors(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	activeExecutorID := "executor-active-errors"
+	drainingExecutorID := "executor-draining-errors"
+	shardID1 := "shard-err-1"
+	shardID2 := "shard-err-2"
+
+	// Setup: Create an active and a draining executor, and assign one shard.
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, activeExecutorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE})
+	require.NoError(t, err)
+	err = executorStore.RecordHeartbeat(ctx, tc.Namespace, drainingExecutorID, store.HeartbeatState{Status: types.ExecutorStatusDRAINING})
+	require.NoError(t, err)
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
+	require.NoError(t, err)
+
+	// Case 1: Assigning an already-assigned shard.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
+	require.Error(t, err, "Should fail to assign an already-assigned shard")
+	var alreadyAssigned *store.ErrShardAlreadyAssigned
+	require.ErrorAs(t, err, &alreadyAssigned)
+	assert.Equal(t, shardID1, alreadyAssigned.ShardID)
+	assert.Equal(t, activeExecutorID, alreadyAssigned.AssignedTo)
+	assert.NotNil(t, alreadyAssigned.Metadata)
+
+	// Case 2: Assigning to a non-existent executor.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID2, "non-existent-executor")
+	require.Error(t, err, "Should fail to assign to a non-existent executor")
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Error should be ErrExecutorNotFound")
+
+	// Case 3: Assigning to a non-active (draining) executor.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID2, drainingExecutorID)
+	require.Error(t, err, "Should fail to assign to a draining executor")
+	assert.ErrorIs(t, err, store.ErrVersionConflict, "Error should be ErrVersionConflict for non-active executor")
+}
+
+// TestShardStatisticsPersistence verifies that shard statistics are preserved on assignment
+// when they already exist, and that GetState exposes them.
+func TestShardStatisticsPersistence(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	setLoadBalancingMode(executorStore, config.LoadBalancingModeGREEDY)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-stats"
+	shardID := "shard-stats"
+
+	// 1. Setup: ensure executor is ACTIVE
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// 2. Pre-create shard statistics as if coming from prior history
+	stats := store.ShardStatistics{SmoothedLoad: 12.5, LastUpdateTime: time.Unix(1234, 0).UTC(), LastMoveTime: time.Unix(5678, 0).UTC()}
+	writeShardStatistics(ctx, t, tc, executorID, map[string]store.ShardStatistics{shardID: stats})
+
+	// 3. Assign the shard via AssignShard (should not clobber existing metrics)
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID))
+
+	// 4. Verify via GetState that metrics are preserved and exposed
+	nsState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	require.Contains(t, nsState.ShardStats, shardID)
+	updatedStats := nsState.ShardStats[shardID]
+	assert.Equal(t, stats.SmoothedLoad, updatedStats.SmoothedLoad)
+	assert.Equal(t, stats.LastUpdateTime, updatedStats.LastUpdateTime)
+	// This should be greater than the last move time
+	assert.Greater(t, updatedStats.LastMoveTime, stats.LastMoveTime)
+
+	// 5. Also ensure assignment recorded correctly
+	require.Contains(t, nsState.ShardAssignments[executorID].AssignedShards, shardID)
+}
+
+// TestAssignShardsCarriesShardStatistics verifies that moved shards keep their load and record the move.
+func TestAssignShardsCarriesShardStatistics(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	setLoadBalancingMode(executorStore, config.LoadBalancingModeGREEDY)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-stats-1"
+	executorID2 := "exec-stats-2"
+	movedShardID := "shard-moved"
+	newShardID := "shard-new"
+	recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, movedShardID, executorID1))
+
+	stats := store.ShardStatistics{SmoothedLoad: 7.5, LastUpdateTime: time.Unix(1234, 0).UTC(), LastMoveTime: time.Unix(5678, 0).UTC()}
+	writeShardStatistics(ctx, t, tc, executorID1, map[string]store.ShardStatistics{movedShardID: stats})
+
+	state, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	state.ShardAssignments = map[string]store.AssignedState{
+		executorID1: {AssignedShards: map[string]*types.ShardAssignment{}, ModRevision: state.ShardAssignments[executorID1].ModRevision},
+		executorID2: {AssignedShards: map[string]*types.ShardAssignment{movedShardID: {}, newShardID: {}}},
+	}
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: state}, store.NopGuard()))
+
+	nsState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	movedStats := nsState.ShardStats[movedShardID]
+	assert.Equal(t, stats.SmoothedLoad, movedStats.SmoothedLoad)
+	assert.Greater(t, movedStats.LastMoveTime, stats.LastMoveTime)
+	newStats := nsState.ShardStats[newShardID]
+	assert.Zero(t, newStats.SmoothedLoad)
+	assert.True(t, newStats.LastMoveTime.IsZero())
+}
+
+// TestGetShardStatisticsForMissingShard verifies GetState does not report statistics for unknown shards.
+func TestGetShardStatisticsForMissingShard(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// No metrics are written; GetState should not contain unknown shard
+	st, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.NotContains(t, st.ShardStats, "unknown")
+}
+
+// TestDeleteShardStatsDeletesAllStats verifies that shard statistics are correctly deleted.
+func TestDeleteShardStatsDeletesAllStats(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t)
+	executorStore := createStore(t, tc)
+	setLoadBalancingMode(executorStore, config.LoadBalancingModeGREEDY)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	totalShardStats := maxShardIDsPerQuery + 135 // number of stats to add and make stale, more than a single query
+	shardIDs := make([]string, 0, totalShardStats)
+	executorID := "exec-delete-stats"
+
+	// ensure executor exists
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// Create stale stats
+	executorStats := make(map[string]store.ShardStatistics)
+	for i := 0; i < totalShardStats; i++ {
+		shardID := "stale-stats-" + strconv.Itoa(i)
+		shardIDs = append(shardIDs, shardID)
+
+		executorStats[shardID] = store.ShardStatistics{
+			SmoothedLoad:   float64(i),
+			LastUpdateTime: time.Unix(int64(i), 0).UTC(),
+			LastMoveTime:   time.Unix(int64(i), 0).UTC(),
+		}
+	}
+	writeShardStatistics(ctx, t, tc, executorID, executorStats)
+
+	require.NoError(t, executorStore.DeleteShardStats(ctx, tc.Namespace, shardIDs, store.NopGuard()))
+
+	nsState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	// All stats should be deleted
+	assert.Empty(t, nsState.ShardStats)
+}
+
+// --- Test Setup ---
+
+func recordHeartbeats(ctx context.Context, t *testing.T, executorStore store.Store, namespace string, executorIDs ...string) {
+	t.Helper()
+
+	for _, executorID := range executorIDs {
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	}
+}
+
+func writeShardStatistics(ctx context.Context, t *testing.T, tc *testhelper.StoreTestCluster, executorID string, stats map[string]store.ShardStatistics) {
+	t.Helper()
+
+	rows := make([]sqlplugin.ShardDistributorShardStatsRow, 0, len(stats))
+	for shardID, shardStats := range stats {
+		row, err := toShardStatsRow(tc.Namespace, shardID, executorID, shardStats)
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+	for start := 0; start < len(rows); start += maxShardIDsPerQuery {
+		_, err := tc.DB.ReplaceIntoShardDistributorShardStats(ctx, rows[start:min(start+maxShardIDsPerQuery, len(rows))])
+		require.NoError(t, err)
+	}
+}
+
+func setLoadBalancingMode(executorStore store.Store, mode string) {
+	impl := executorStore.(*executorStoreImpl)
+	if impl.cfg == nil {
+		impl.cfg = &config.Config{}
+	}
+	impl.cfg.LoadBalancingMode = func(string) string { return mode }
+}
+
+func createStore(t *testing.T, tc *testhelper.StoreTestCluster) store.Store {
+	t.Helper()
+
+	sqlConfig, err := sqlclient.NewExecutorStoreConfig(tc.SDConfig)
+	require.NoError(t, err)
+
+	store, err := NewStore(ExecutorStoreParams{
+		DB:            tc.DB,
+		SQLConfig:     sqlConfig,
+		Lifecycle:     fxtest.NewLifecycle(t),
+		Logger:        testlogger.New(t),
+		TimeSource:    clock.NewMockedTimeSourceAt(time.Now()),
+		MetricsClient: metrics.NewNoopMetricsClient(),
+		Config: &config.Config{
+			LoadBalancingMode: func(namespace string) string { return config.LoadBalancingModeNAIVE },
+			MaxEtcdTxnOps:     dynamicproperties.GetIntPropertyFn(128),
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(store.(*executorStoreImpl).Stop)
+	return store
+}
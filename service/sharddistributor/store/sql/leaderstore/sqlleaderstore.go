@@ -0,0 +1,322 @@
+package leaderstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+)
+
+const (
+	// defaultElectionTTL is the default time-to-live for a leadership lease.
+	// If the leader does not renew its lease within this time, it will lose leadership.
+	defaultElectionTTL = 10 * time.Second
+
+	// defaultPollInterval is how often a campaigning node checks whether the leadership can be acquired.
+	defaultPollInterval = time.Second
+)
+
+var errElectionClosed = errors.New("election closed")
+
+type LeaderStore struct {
+	db         sqlplugin.DB
+	config     sqlclient.LeaderStoreConfig
+	logger     log.Logger
+	timeSource clock.TimeSource
+}
+
+// StoreParams defines the dependencies for the SQL store, for use with fx.
+type StoreParams struct {
+	fx.In
+
+	DB         sqlplugin.DB `name:"leaderstore"`
+	Cfg        sqlclient.LeaderStoreConfig
+	Logger     log.Logger
+	TimeSource clock.TimeSource `optional:"true"`
+}
+
+// NewLeaderStore creates a new leaderstore backed by a SQL database.
+// The leader of a namespace holds a lease on its shard_distributor_leaders row, that it renews while it is leading.
+func NewLeaderStore(p StoreParams) (store.Elector, error) {
+	cfg := p.Cfg
+	if cfg.ElectionTTL == 0 {
+		cfg.ElectionTTL = defaultElectionTTL
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+
+	timeSource := p.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+	logger := p.Logger
+	if logger == nil {
+		logger = log.NewNoop()
+	}
+
+	return &LeaderStore{
+		db:         p.DB,
+		config:     cfg,
+		logger:     logger,
+		timeSource: timeSource,
+	}, nil
+}
+
+func (ls *LeaderStore) CreateElection(ctx context.Context, namespace string) (store.Election, error) {
+	return &election{
+		db:           ls.db,
+		namespace:    namespace,
+		id:           uuid.New().String(),
+		ttl:          ls.config.ElectionTTL,
+		pollInterval: ls.config.PollInterval,
+		logger:       ls.logger.WithTags(tag.ShardNamespace(namespace)),
+		timeSource:   ls.timeSource,
+		done:         make(chan struct{}),
+	}, nil
+}
+
+// election campaigns for the leader row of a namespace. A node leads while the row has its leader ID
+// and an unexpired lease, every acquisition of the row increments its term.
+type election struct {
+	db           sqlplugin.DB
+	namespace    string
+	id           string
+	ttl          time.Duration
+	pollInterval time.Duration
+	logger       log.Logger
+	timeSource   clock.TimeSource
+
+	sync.Mutex
+	// leaderID and term identify the leadership held by this election, term is 0 when it is not leading.
+	leaderID      string
+	term          int64
+	stopKeepAlive chan struct{}
+	keepAliveWG   sync.WaitGroup
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (e *election) Campaign(ctx context.Context, host string) error {
+	leaderID := fmt.Sprintf("%s/%s", host, e.id)
+
+	ticker := e.timeSource.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.done:
+			return errElectionClosed
+		default:
+		}
+
+		term, leaseExpiry, err := e.tryAcquire(ctx, leaderID)
+		if err != nil {
+			return fmt.Errorf("campaign: %w", err)
+		}
+		if term > 0 {
+			e.startKeepAlive(leaderID, term, leaseExpiry)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-e.done:
+			return errElectionClosed
+		case <-ticker.Chan():
+		}
+	}
+}
+
+// tryAcquire takes the leader row if it does not exist, was resigned or its lease expired.
+// It returns the acquired term, or 0 if another node is leading.
+func (e *election) tryAcquire(ctx context.Context, leaderID string) (int64, time.Time, error) {
+	now := e.timeSource.Now().UTC()
+	leaseExpiry := now.Add(e.ttl)
+
+	current, err := e.db.SelectFromShardDistributorLeaders(ctx, e.namespace)
+	if err != nil {
+		if !e.db.IsNotFoundError(err) {
+			return 0, time.Time{}, fmt.Errorf("get leader: %w", err)
+		}
+		_, err := e.db.InsertIntoShardDistributorLeaders(ctx, &sqlplugin.ShardDistributorLeaderRow{
+			Namespace:   e.namespace,
+			LeaderID:    leaderID,
+			Term:        1,
+			LeaseExpiry: leaseExpiry,
+		})
+		if err != nil {
+			if e.db.IsDupEntryError(err) {
+				return 0, time.Time{}, nil
+			}
+			return 0, time.Time{}, fmt.Errorf("insert leader: %w", err)
+		}
+		return 1, leaseExpiry, nil
+	}
+
+	if current.LeaderID != "" && current.LeaseExpiry.After(now) {
+		return 0, time.Time{}, nil
+	}
+
+	term := current.Term + 1
+	acquired, err := e.updateLeader(ctx, leaderID, term, leaseExpiry, current.Term)
+	if err != nil || !acquired {
+		return 0, time.Time{}, err
+	}
+	return term, leaseExpiry, nil
+}
+
+// updateLeader updates the leader row if its term is still previousTerm.
+func (e *election) updateLeader(ctx context.Context, leaderID string, term int64, leaseExpiry time.Time, previousTerm int64) (bool, error) {
+	result, err := e.db.UpdateShardDistributorLeaders(ctx, &sqlplugin.ShardDistributorLeaderRow{
+		Namespace:   e.namespace,
+		LeaderID:    leaderID,
+		Term:        term,
+		LeaseExpiry: leaseExpiry,
+	}, previousTerm)
+	if err != nil {
+		return false, fmt.Errorf("update leader: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("update leader: %w", err)
+	}
+	return rowsAffected == 1, nil
+}
+
+func (e *election) startKeepAlive(leaderID string, term int64, leaseExpiry time.Time) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.leaderID = leaderID
+	e.term = term
+	e.stopKeepAlive = make(chan struct{})
+
+	e.keepAliveWG.Add(1)
+	go e.keepAlive(e.stopKeepAlive, leaderID, term, leaseExpiry)
+}
+
+// keepAlive renews the lease of the leadership until it is stopped. If the lease cannot be renewed before
+// it expires, or another node took over the leader row, the election is closed.
+func (e *election) keepAlive(stopCh <-chan struct{}, leaderID string, term int64, leaseExpiry time.Time) {
+	defer e.keepAliveWG.Done()
+
+	renewInterval := e.ttl / 3
+	ticker := e.timeSource.NewTicker(renewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-e.done:
+			return
+		case <-ticker.Chan():
+		}
+
+		now := e.timeSource.Now().UTC()
+		ctx, cancel := context.WithTimeout(context.Background(), renewInterval)
+		renewed, err := e.updateLeader(ctx, leaderID, term, now.Add(e.ttl), term)
+		cancel()
+
+		switch {
+		case err == nil && renewed:
+			leaseExpiry = now.Add(e.ttl)
+			continue
+		case err == nil:
+			e.logger.Warn("leadership was taken over by another node")
+		case now.Before(leaseExpiry):
+			e.logger.Warn("failed to renew leadership lease, retrying", tag.Error(err))
+			continue
+		default:
+			e.logger.Error("leadership lease expired", tag.Error(err))
+		}
+
+		e.Lock()
+		if e.term == term {
+			e.term = 0
+		}
+		e.Unlock()
+		e.close()
+		return
+	}
+}
+
+func (e *election) Resign(ctx context.Context) error {
+	e.Lock()
+	leaderID, term, stopCh := e.leaderID, e.term, e.stopKeepAlive
+	e.term = 0
+	e.stopKeepAlive = nil
+	e.Unlock()
+
+	if stopCh != nil {
+		close(stopCh)
+	}
+	e.keepAliveWG.Wait()
+
+	if term == 0 {
+		return nil
+	}
+
+	// Clear the leader ID, so other nodes can acquire the leadership without waiting for the lease to expire.
+	if _, err := e.updateLeader(ctx, "", term, e.timeSource.Now().UTC(), term); err != nil {
+		return fmt.Errorf("resign leader %s: %w", leaderID, err)
+	}
+	return nil
+}
+
+func (e *election) Cleanup(ctx context.Context) error {
+	defer e.close()
+
+	if err := e.Resign(ctx); err != nil {
+		return fmt.Errorf("close session: %w", err)
+	}
+	return nil
+}
+
+func (e *election) Done() <-chan struct{} {
+	return e.done
+}
+
+func (e *election) close() {
+	e.closeOnce.Do(func() {
+		close(e.done)
+	})
+}
+
+func (e *election) Guard() store.GuardFunc {
+	e.Lock()
+	leaderID, term := e.leaderID, e.term
+	e.Unlock()
+
+	return func(txn store.Txn) (store.Txn, error) {
+		// The guard receives the generic Txn and asserts it to the concrete type it expects.
+		sqlTxn, ok := txn.(sqlclient.Txn)
+		if !ok {
+			return nil, fmt.Errorf("invalid transaction type for sql guard: expected sqlclient.Txn, got %T", txn)
+		}
+
+		// Locking the leader row keeps the leadership from changing until the transaction completes.
+		current, err := sqlTxn.Tx.LockShardDistributorLeaders(sqlTxn.Ctx, e.namespace)
+		if err != nil && !sqlTxn.Tx.IsNotFoundError(err) {
+			return nil, fmt.Errorf("lock leader: %w", err)
+		}
+		if err != nil || term == 0 || current.LeaderID != leaderID || current.Term != term || !current.LeaseExpiry.After(e.timeSource.Now()) {
+			return nil, fmt.Errorf("%w: transaction failed, leadership may have changed", store.ErrVersionConflict)
+		}
+		return txn, nil
+	}
+}
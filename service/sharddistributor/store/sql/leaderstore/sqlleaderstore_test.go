@@ -0,0 +1,264 @@
+package leaderstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/testhelper"
+)
+
+// TestCreateElection tests that an election can be created successfully
+func TestCreateElection(t *testing.T) {
+	tc := setupTestCluster(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	elect, err := tc.store.CreateElection(ctx, "test-namespace")
+	require.NoError(t, err)
+	require.NotNil(t, elect)
+
+	// Clean up
+	err = elect.Cleanup(ctx)
+	require.NoError(t, err)
+}
+
+// TestCampaign tests that a node can campaign for leadership
+func TestCampaign(t *testing.T) {
+	tc := setupTestCluster(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-campaign"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	// Start campaigning for leadership
+	err = election.Campaign(ctx, "test-host-1")
+	require.NoError(t, err)
+
+	// Verify leadership was obtained by checking the leader row
+	row, err := tc.db.SelectFromShardDistributorLeaders(ctx, namespace)
+	require.NoError(t, err)
+	assert.Contains(t, row.LeaderID, "test-host-1/")
+	assert.Equal(t, int64(1), row.Term)
+	assert.True(t, row.LeaseExpiry.After(time.Now()), "Lease should not have expired")
+}
+
+// TestResign tests resigning leadership
+func TestResign(t *testing.T) {
+	tc := setupTestCluster(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-resign"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	// Start campaigning for leadership
+	err = election.Campaign(ctx, "test-host-1")
+	require.NoError(t, err)
+
+	// Resign the leadership
+	err = election.Resign(ctx)
+	require.NoError(t, err)
+
+	row, err := tc.db.SelectFromShardDistributorLeaders(ctx, namespace)
+	require.NoError(t, err)
+	assert.Empty(t, row.LeaderID)
+
+	// Verify leadership was resigned by checking that someone else can become leader
+	election2, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election2.Cleanup(ctx)
+
+	err = election2.Campaign(ctx, "host-2")
+	require.NoError(t, err, "Second host should be able to become leader after first resigned")
+
+	row, err = tc.db.SelectFromShardDistributorLeaders(ctx, namespace)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), row.Term, "Every acquisition should increment the term")
+}
+
+// TestMultipleNodes tests multiple nodes competing for leadership
+func TestMultipleNodes(t *testing.T) {
+	tc := setupTestCluster(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-multiple"
+
+	election1, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election1.Cleanup(ctx)
+
+	election2, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election2.Cleanup(ctx)
+
+	// First node campaigns
+	err = election1.Campaign(ctx, "host1")
+	require.NoError(t, err)
+
+	// Second node campaigns - this should block as first node already has leadership
+	ctxTimeout, cancelTimeout := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancelTimeout()
+
+	err = election2.Campaign(ctxTimeout, "host2")
+	require.ErrorIs(t, err, context.DeadlineExceeded, "Second node should not become leader while first node holds leadership")
+
+	// First node resigns
+	err = election1.Resign(ctx)
+	require.NoError(t, err)
+
+	// Now the second node should be able to become leader
+	err = election2.Campaign(ctx, "host2")
+	require.NoError(t, err, "Second host should be able to become leader after first host resigned")
+}
+
+// TestLeaseExpiry tests that a node that stops renewing its lease loses the leadership
+func TestLeaseExpiry(t *testing.T) {
+	tc := setupTestCluster(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-expiry"
+	election1, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election1.Cleanup(ctx)
+
+	err = election1.Campaign(ctx, "host1")
+	require.NoError(t, err)
+	staleGuard := election1.Guard()
+
+	// Another node overwrites the lease as if it had expired
+	row, err := tc.db.SelectFromShardDistributorLeaders(ctx, namespace)
+	require.NoError(t, err)
+	_, err = tc.db.UpdateShardDistributorLeaders(ctx, &sqlplugin.ShardDistributorLeaderRow{
+		Namespace:   namespace,
+		LeaderID:    "other-host/other-id",
+		Term:        row.Term + 1,
+		LeaseExpiry: time.Now().Add(time.Minute),
+	}, row.Term)
+	require.NoError(t, err)
+
+	// The first node notices it lost the leadership on its next renewal
+	select {
+	case <-election1.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("Election should be closed after losing the leadership")
+	}
+
+	// The guard of the first node no longer allows transactions
+	err = sqlclient.ExecuteInTx(ctx, tc.db, testlogger.New(t), func(tx sqlplugin.Tx) error {
+		_, err := staleGuard(sqlclient.Txn{Ctx: ctx, Tx: tx})
+		return err
+	})
+	require.ErrorIs(t, err, store.ErrVersionConflict)
+}
+
+// TestGuard tests that the guard only accepts transactions of the current leader
+func TestGuard(t *testing.T) {
+	tc := setupTestCluster(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-guard"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	applyGuard := func(guard store.GuardFunc) error {
+		return sqlclient.ExecuteInTx(ctx, tc.db, testlogger.New(t), func(tx sqlplugin.Tx) error {
+			_, err := guard(sqlclient.Txn{Ctx: ctx, Tx: tx})
+			return err
+		})
+	}
+
+	// The guard of a node that is not leading fails
+	require.ErrorIs(t, applyGuard(election.Guard()), store.ErrVersionConflict)
+
+	require.NoError(t, election.Campaign(ctx, "host1"))
+	require.NoError(t, applyGuard(election.Guard()))
+
+	// The guard rejects other transaction types
+	_, err = election.Guard()(nil)
+	require.ErrorContains(t, err, "invalid transaction type")
+
+	// The guard taken before resigning fails
+	guard := election.Guard()
+	require.NoError(t, election.Resign(ctx))
+	require.ErrorIs(t, applyGuard(guard), store.ErrVersionConflict)
+}
+
+// TestSessionDone tests the Done channel behavior
+func TestSessionDone(t *testing.T) {
+	tc := setupTestCluster(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	election, err := tc.store.CreateElection(ctx, "test-namespace-done")
+	require.NoError(t, err)
+
+	doneCh := election.Done()
+	require.NotNil(t, doneCh)
+
+	// Clean up should close the session, which should close the Done channel
+	err = election.Cleanup(ctx)
+	require.NoError(t, err)
+
+	// Verify the Done channel is closed
+	select {
+	case <-doneCh:
+		// Expected - channel should be closed
+	case <-time.After(2 * time.Second):
+		t.Error("Done channel should be closed after Cleanup")
+	}
+
+	// Campaigning on a closed election fails
+	require.ErrorIs(t, election.Campaign(ctx, "host1"), errElectionClosed)
+}
+
+// testCluster represents a test SQL database with the leader store using it
+type testCluster struct {
+	store store.Elector
+	db    sqlplugin.DB
+}
+
+func setupTestCluster(t *testing.T) *testCluster {
+	t.Helper()
+
+	tc := testhelper.SetupStoreTestCluster(t)
+
+	cfg, err := sqlclient.NewLeaderStoreConfig(tc.SDConfig)
+	require.NoError(t, err)
+
+	store, err := NewLeaderStore(StoreParams{
+		DB:         tc.DB,
+		Cfg:        cfg,
+		TimeSource: clock.NewRealTimeSource(),
+	})
+	require.NoError(t, err)
+
+	return &testCluster{
+		store: store,
+		db:    tc.DB,
+	}
+}
@@ -0,0 +1,53 @@
+package sql
+
+import (
+	"fmt"
+
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/executorstore"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/leaderstore"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+)
+
+var Module = fx.Module("sql",
+	executorstore.Module,
+	fx.Provide(leaderstore.NewLeaderStore),
+	fx.Provide(sqlclient.NewExecutorStoreConfig),
+	fx.Provide(sqlclient.NewLeaderStoreConfig),
+	fx.Provide(NewExecutorStoreDB),
+	fx.Provide(NewLeaderStoreDB),
+)
+
+// ExecutorStoreDBOutput provides the executor store database.
+type ExecutorStoreDBOutput struct {
+	fx.Out
+
+	DB sqlplugin.DB `name:"executorstore"`
+}
+
+// NewExecutorStoreDB creates a new SQL database connection for the executor store.
+func NewExecutorStoreDB(cfg sqlclient.ExecutorStoreConfig, lc fx.Lifecycle) (ExecutorStoreDBOutput, error) {
+	db, err := sqlclient.NewDBFromConfig(cfg.BaseConfig, lc)
+	if err != nil {
+		return ExecutorStoreDBOutput{}, fmt.Errorf("executor store db: %w", err)
+	}
+	return ExecutorStoreDBOutput{DB: db}, nil
+}
+
+// LeaderStoreDBOutput provides the leader store database.
+type LeaderStoreDBOutput struct {
+	fx.Out
+
+	DB sqlplugin.DB `name:"leaderstore"`
+}
+
+// NewLeaderStoreDB creates a new SQL database connection for the leader store.
+func NewLeaderStoreDB(cfg sqlclient.LeaderStoreConfig, lc fx.Lifecycle) (LeaderStoreDBOutput, error) {
+	db, err := sqlclient.NewDBFromConfig(cfg.BaseConfig, lc)
+	if err != nil {
+		return LeaderStoreDBOutput{}, fmt.Errorf("leader store db: %w", err)
+	}
+	return LeaderStoreDBOutput{DB: db}, nil
+}
@@ -0,0 +1,49 @@
+package sqlclient
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+// Txn is the transaction handed to a store.GuardFunc by the SQL stores.
+// A guard runs its checks inside Tx, so they are committed atomically with the guarded writes.
+type Txn struct {
+	Ctx context.Context
+	Tx  sqlplugin.Tx
+}
+
+// NewDBFromConfig creates a new SQL database connection from configuration.
+func NewDBFromConfig(cfg BaseConfig, lc fx.Lifecycle) (sqlplugin.DB, error) {
+	db, err := sql.NewSQLDB(&cfg.SQL)
+	if err != nil {
+		return nil, fmt.Errorf("create sql db: %w", err)
+	}
+	lc.Append(fx.StopHook(db.Close))
+
+	return db, nil
+}
+
+// ExecuteInTx runs fn in a transaction. The transaction is committed if fn succeeds and rolled back otherwise.
+func ExecuteInTx(ctx context.Context, db sqlplugin.DB, logger log.Logger, fn func(tx sqlplugin.Tx) error) error {
+	tx, err := db.BeginTx(ctx, sqlplugin.DbDefaultShard)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			logger.Error("transaction rollback error", tag.Error(rollbackErr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package sqlclient
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/config"
+	sdconfig "github.com/uber/cadence/service/sharddistributor/config"
+)
+
+// BaseConfig has common SQL connection and store settings.
+type BaseConfig struct {
+	config.SQL `yaml:",inline"`
+	// PollInterval is how often the database is polled for the changes watched by subscribers.
+	PollInterval time.Duration `yaml:"pollInterval"`
+}
+
+// ExecutorStoreConfig extends BaseConfig with executor-specific settings.
+type ExecutorStoreConfig struct {
+	BaseConfig `yaml:",inline"`
+}
+
+// LeaderStoreConfig extends BaseConfig with leader-specific settings.
+type LeaderStoreConfig struct {
+	BaseConfig  `yaml:",inline"`
+	ElectionTTL time.Duration `yaml:"electionTTL"`
+}
+
+// NewExecutorStoreConfig parses ExecutorStoreConfig from ShardDistribution config.
+func NewExecutorStoreConfig(cfg sdconfig.ShardDistribution) (ExecutorStoreConfig, error) {
+	var out ExecutorStoreConfig
+	if err := cfg.Store.StorageParams.Decode(&out); err != nil {
+		return out, fmt.Errorf("bad config for executor store: %w", err)
+	}
+	return out, nil
+}
+
+// NewLeaderStoreConfig parses LeaderStoreConfig from ShardDistribution config.
+func NewLeaderStoreConfig(cfg sdconfig.ShardDistribution) (LeaderStoreConfig, error) {
+	var out LeaderStoreConfig
+	if err := cfg.LeaderStore.StorageParams.Decode(&out); err != nil {
+		return out, fmt.Errorf("bad config for leader store: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,80 @@
+package testhelper
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	commonconfig "github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+	"github.com/uber/cadence/service/sharddistributor/config"
+)
+
+// schemaFile is the cadence sqlite schema, relative to this file.
+const schemaFile = "../../../../../schema/sqlite/cadence/schema.sql"
+
+type StoreTestCluster struct {
+	Namespace string
+	SDConfig  config.ShardDistribution
+	DB        sqlplugin.DB
+}
+
+// SetupStoreTestCluster creates a sqlite database with the cadence schema in a temporary directory.
+func SetupStoreTestCluster(t *testing.T) *StoreTestCluster {
+	t.Helper()
+
+	namespace := fmt.Sprintf("ns-%s", strings.ToLower(t.Name()))
+	sqlConfig := commonconfig.SQL{
+		PluginName:   sqlite.PluginName,
+		DatabaseName: filepath.Join(t.TempDir(), "sharddistributor.db"),
+		// A single connection serializes the transactions, sqlite fails transactions that upgrade to a write lock concurrently.
+		MaxConns: 1,
+	}
+
+	sqlConfigRaw := map[string]interface{}{
+		"pluginName":   sqlConfig.PluginName,
+		"databaseName": sqlConfig.DatabaseName,
+		"maxConns":     sqlConfig.MaxConns,
+		"pollInterval": "10ms",
+		"electionTTL":  "1s", // Needed for leader config part
+	}
+	yamlCfg, err := yaml.Marshal(sqlConfigRaw)
+	require.NoError(t, err)
+	var yamlNode *config.YamlNode
+	err = yaml.Unmarshal(yamlCfg, &yamlNode)
+	require.NoError(t, err)
+
+	sdConfig := config.ShardDistribution{
+		Store:       config.Store{Type: config.StoreTypeSQL, StorageParams: yamlNode},
+		LeaderStore: config.Store{Type: config.StoreTypeSQL, StorageParams: yamlNode},
+	}
+
+	_, currentFile, _, ok := runtime.Caller(0)
+	require.True(t, ok)
+	schema, err := os.ReadFile(filepath.Join(filepath.Dir(currentFile), schemaFile))
+	require.NoError(t, err)
+
+	adminDB, err := sql.NewSQLAdminDB(&sqlConfig)
+	require.NoError(t, err)
+	require.NoError(t, adminDB.ExecSchemaOperationQuery(context.Background(), string(schema)))
+	require.NoError(t, adminDB.Close())
+
+	db, err := sql.NewSQLDB(&sqlConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return &StoreTestCluster{
+		Namespace: namespace,
+		SDConfig:  sdConfig,
+		DB:        db,
+	}
+}
@@ -54,6 +54,49 @@ type NamespaceState struct {
 	// ShardAssignments holds the assignment states of all shards in the namespace.
 	// Key: ExecutorID
 	ShardAssignments map[string]AssignedState
+
+	// Controls holds the operator controls (cordons, drains and pins) of the namespace.
+	Controls NamespaceControls
+}
+
+// NamespaceControls holds the operator overrides the rebalance loop has to respect.
+type NamespaceControls struct {
+	// Executors holds the controls set on executors.
+	// Key: ExecutorID
+	Executors map[string]ExecutorControl
+
+	// PinnedShards holds the shards pinned to an executor by an operator.
+	// Key: ShardID, Value: ExecutorID
+	PinnedShards map[string]string
+
+	// ModRevision is the revision the controls were read at, zero if they were never written.
+	ModRevision int64
+}
+
+// ExecutorControl holds the controls set on a single executor.
+type ExecutorControl struct {
+	// Cordoned executors keep their shards but do not receive new ones.
+	Cordoned bool
+
+	// Draining executors do not receive new shards and have their shards moved away.
+	Draining bool
+
+	// MaxConcurrentMoves limits the number of shards moved away from a draining executor at a time.
+	// Zero means no limit.
+	MaxConcurrentMoves int32
+
+	// UpdatedAt is the time the control was last changed
+	UpdatedAt time.Time
+}
+
+// IsExcluded returns true if the executor must not receive new shards.
+func (c ExecutorControl) IsExcluded() bool {
+	return c.Cordoned || c.Draining
+}
+
+// IsEmpty returns true if no control is set in the namespace.
+func (c *NamespaceControls) IsEmpty() bool {
+	return len(c.Executors) == 0 && len(c.PinnedShards) == 0
 }
 
 type ShardState struct {
@@ -90,4 +90,12 @@ type Store interface {
 	RecordHeartbeat(ctx context.Context, namespace, executorID string, state HeartbeatState) error
 
 	DeleteShardStats(ctx context.Context, namespace string, shardIDs []string, guard GuardFunc) error
+
+	// GetNamespaceControls retrieves the operator controls of a namespace.
+	// It returns empty controls if none were ever set.
+	GetNamespaceControls(ctx context.Context, namespace string) (*NamespaceControls, error)
+
+	// UpdateNamespaceControls replaces the operator controls of a namespace.
+	// It returns ErrVersionConflict if the controls changed since they were read at controls.ModRevision.
+	UpdateNamespaceControls(ctx context.Context, namespace string, controls *NamespaceControls) error
 }
@@ -165,6 +165,21 @@ func (mr *MockStoreMockRecorder) GetHeartbeat(ctx, namespace, executorID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeartbeat", reflect.TypeOf((*MockStore)(nil).GetHeartbeat), ctx, namespace, executorID)
 }
 
+// GetNamespaceControls mocks base method.
+func (m *MockStore) GetNamespaceControls(ctx context.Context, namespace string) (*NamespaceControls, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceControls", ctx, namespace)
+	ret0, _ := ret[0].(*NamespaceControls)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceControls indicates an expected call of GetNamespaceControls.
+func (mr *MockStoreMockRecorder) GetNamespaceControls(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceControls", reflect.TypeOf((*MockStore)(nil).GetNamespaceControls), ctx, namespace)
+}
+
 // GetShardOwner mocks base method.
 func (m *MockStore) GetShardOwner(ctx context.Context, namespace, shardID string) (*ShardOwner, error) {
 	m.ctrl.T.Helper()
@@ -239,3 +254,17 @@ func (mr *MockStoreMockRecorder) SubscribeToExecutorStatusChanges(ctx, namespace
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToExecutorStatusChanges", reflect.TypeOf((*MockStore)(nil).SubscribeToExecutorStatusChanges), ctx, namespace)
 }
+
+// UpdateNamespaceControls mocks base method.
+func (m *MockStore) UpdateNamespaceControls(ctx context.Context, namespace string, controls *NamespaceControls) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespaceControls", ctx, namespace, controls)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNamespaceControls indicates an expected call of UpdateNamespaceControls.
+func (mr *MockStoreMockRecorder) UpdateNamespaceControls(ctx, namespace, controls any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceControls", reflect.TypeOf((*MockStore)(nil).UpdateNamespaceControls), ctx, namespace, controls)
+}
@@ -0,0 +1,129 @@
+package storefx
+
+import (
+	"fmt"
+
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/etcd/etcdclient"
+	etcdexecutorstore "github.com/uber/cadence/service/sharddistributor/store/etcd/executorstore"
+	etcdleaderstore "github.com/uber/cadence/service/sharddistributor/store/etcd/leaderstore"
+	sqlexecutorstore "github.com/uber/cadence/service/sharddistributor/store/sql/executorstore"
+	sqlleaderstore "github.com/uber/cadence/service/sharddistributor/store/sql/leaderstore"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+)
+
+// Module provides the store.Store and store.Elector of the storage backends selected by
+// the type of the store and leader store configs.
+var Module = fx.Module("store",
+	fx.Provide(NewStore),
+	fx.Provide(NewElector),
+)
+
+// StoreParams defines the dependencies of the executor store backends.
+type StoreParams struct {
+	fx.In
+
+	Cfg           config.ShardDistribution
+	Lifecycle     fx.Lifecycle
+	Logger        log.Logger
+	TimeSource    clock.TimeSource
+	Config        *config.Config
+	MetricsClient metrics.Client
+}
+
+// NewStore creates the executor store of the configured backend.
+func NewStore(p StoreParams) (store.Store, error) {
+	switch p.Cfg.Store.Type {
+	case "", config.StoreTypeEtcd:
+		cfg, err := etcdclient.NewExecutorStoreConfig(p.Cfg)
+		if err != nil {
+			return nil, err
+		}
+		client, err := etcdclient.NewClientFromConfig(cfg.BaseConfig, p.Lifecycle)
+		if err != nil {
+			return nil, fmt.Errorf("executor store client: %w", err)
+		}
+		return etcdexecutorstore.NewStore(etcdexecutorstore.ExecutorStoreParams{
+			Client:        client,
+			ETCDConfig:    cfg,
+			Lifecycle:     p.Lifecycle,
+			Logger:        p.Logger,
+			TimeSource:    p.TimeSource,
+			Config:        p.Config,
+			MetricsClient: p.MetricsClient,
+		})
+	case config.StoreTypeSQL:
+		cfg, err := sqlclient.NewExecutorStoreConfig(p.Cfg)
+		if err != nil {
+			return nil, err
+		}
+		db, err := sqlclient.NewDBFromConfig(cfg.BaseConfig, p.Lifecycle)
+		if err != nil {
+			return nil, fmt.Errorf("executor store db: %w", err)
+		}
+		return sqlexecutorstore.NewStore(sqlexecutorstore.ExecutorStoreParams{
+			DB:            db,
+			SQLConfig:     cfg,
+			Lifecycle:     p.Lifecycle,
+			Logger:        p.Logger,
+			TimeSource:    p.TimeSource,
+			Config:        p.Config,
+			MetricsClient: p.MetricsClient,
+		})
+	default:
+		return nil, fmt.Errorf("unknown executor store type %q", p.Cfg.Store.Type)
+	}
+}
+
+// ElectorParams defines the dependencies of the leader store backends.
+type ElectorParams struct {
+	fx.In
+
+	Cfg        config.ShardDistribution
+	Lifecycle  fx.Lifecycle
+	Logger     log.Logger
+	TimeSource clock.TimeSource
+}
+
+// NewElector creates the leader store of the configured backend.
+func NewElector(p ElectorParams) (store.Elector, error) {
+	switch p.Cfg.LeaderStore.Type {
+	case "", config.StoreTypeEtcd:
+		cfg, err := etcdclient.NewLeaderStoreConfig(p.Cfg)
+		if err != nil {
+			return nil, err
+		}
+		client, err := etcdclient.NewClientFromConfig(cfg.BaseConfig, p.Lifecycle)
+		if err != nil {
+			return nil, fmt.Errorf("leader store client: %w", err)
+		}
+		return etcdleaderstore.NewLeaderStore(etcdleaderstore.StoreParams{
+			Client: client,
+			Cfg:    cfg,
+			Logger: p.Logger,
+		})
+	case config.StoreTypeSQL:
+		cfg, err := sqlclient.NewLeaderStoreConfig(p.Cfg)
+		if err != nil {
+			return nil, err
+		}
+		db, err := sqlclient.NewDBFromConfig(cfg.BaseConfig, p.Lifecycle)
+		if err != nil {
+			return nil, fmt.Errorf("leader store db: %w", err)
+		}
+		return sqlleaderstore.NewLeaderStore(sqlleaderstore.StoreParams{
+			DB:         db,
+			Cfg:        cfg,
+			Logger:     p.Logger,
+			TimeSource: p.TimeSource,
+		})
+	default:
+		return nil, fmt.Errorf("unknown leader store type %q", p.Cfg.LeaderStore.Type)
+	}
+}
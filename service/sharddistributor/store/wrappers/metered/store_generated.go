@@ -109,6 +109,16 @@ func (c *meteredStore) GetHeartbeat(ctx context.Context, namespace string, execu
 	return
 }
 
+func (c *meteredStore) GetNamespaceControls(ctx context.Context, namespace string) (np1 *store.NamespaceControls, err error) {
+	op := func() error {
+		np1, err = c.wrapped.GetNamespaceControls(ctx, namespace)
+		return err
+	}
+
+	err = c.call(metrics.ShardDistributorStoreGetNamespaceControlsScope, op, metrics.NamespaceTag(namespace))
+	return
+}
+
 func (c *meteredStore) GetShardOwner(ctx context.Context, namespace string, shardID string) (sp1 *store.ShardOwner, err error) {
 	op := func() error {
 		sp1, err = c.wrapped.GetShardOwner(ctx, namespace, shardID)
@@ -158,3 +168,13 @@ func (c *meteredStore) SubscribeToExecutorStatusChanges(ctx context.Context, nam
 	err = c.call(metrics.ShardDistributorStoreSubscribeToExecutorStatusChangesScope, op, metrics.NamespaceTag(namespace))
 	return
 }
+
+func (c *meteredStore) UpdateNamespaceControls(ctx context.Context, namespace string, controls *store.NamespaceControls) (err error) {
+	op := func() error {
+		err = c.wrapped.UpdateNamespaceControls(ctx, namespace, controls)
+		return err
+	}
+
+	err = c.call(metrics.ShardDistributorStoreUpdateNamespaceControlsScope, op, metrics.NamespaceTag(namespace))
+	return
+}
@@ -21,11 +21,41 @@ func NewGRPCHandler(h handler.Handler) GRPCHandler {
 	return GRPCHandler{h}
 }
 
+func (g GRPCHandler) CordonExecutor(ctx context.Context, request *sharddistributorv1.CordonExecutorRequest) (*sharddistributorv1.CordonExecutorResponse, error) {
+	err := g.h.CordonExecutor(ctx, proto.ToShardDistributorCordonExecutorRequest(request))
+	return &sharddistributorv1.CordonExecutorResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) DescribeNamespaceControls(ctx context.Context, request *sharddistributorv1.DescribeNamespaceControlsRequest) (*sharddistributorv1.DescribeNamespaceControlsResponse, error) {
+	response, err := g.h.DescribeNamespaceControls(ctx, proto.ToShardDistributorDescribeNamespaceControlsRequest(request))
+	return proto.FromShardDistributorDescribeNamespaceControlsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) DrainExecutor(ctx context.Context, request *sharddistributorv1.DrainExecutorRequest) (*sharddistributorv1.DrainExecutorResponse, error) {
+	err := g.h.DrainExecutor(ctx, proto.ToShardDistributorDrainExecutorRequest(request))
+	return &sharddistributorv1.DrainExecutorResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) GetShardOwner(ctx context.Context, request *sharddistributorv1.GetShardOwnerRequest) (*sharddistributorv1.GetShardOwnerResponse, error) {
 	response, err := g.h.GetShardOwner(ctx, proto.ToShardDistributorGetShardOwnerRequest(request))
 	return proto.FromShardDistributorGetShardOwnerResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) PinShard(ctx context.Context, request *sharddistributorv1.PinShardRequest) (*sharddistributorv1.PinShardResponse, error) {
+	err := g.h.PinShard(ctx, proto.ToShardDistributorPinShardRequest(request))
+	return &sharddistributorv1.PinShardResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) UncordonExecutor(ctx context.Context, request *sharddistributorv1.UncordonExecutorRequest) (*sharddistributorv1.UncordonExecutorResponse, error) {
+	err := g.h.UncordonExecutor(ctx, proto.ToShardDistributorUncordonExecutorRequest(request))
+	return &sharddistributorv1.UncordonExecutorResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) UnpinShard(ctx context.Context, request *sharddistributorv1.UnpinShardRequest) (*sharddistributorv1.UnpinShardResponse, error) {
+	err := g.h.UnpinShard(ctx, proto.ToShardDistributorUnpinShardRequest(request))
+	return &sharddistributorv1.UnpinShardResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) WatchNamespaceState(request *sharddistributorv1.WatchNamespaceStateRequest, server sharddistributorv1.ShardDistributorAPIServiceWatchNamespaceStateYARPCServer) error {
 	err := g.h.WatchNamespaceState(proto.ToShardDistributorWatchNamespaceStateRequest(request), &watchnamespacestateServer{server: server})
 	return err
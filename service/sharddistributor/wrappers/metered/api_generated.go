@@ -28,6 +28,63 @@ func NewMetricsHandler(handler handler.Handler, logger log.Logger, metricsClient
 	}
 }
 
+func (h *metricsHandler) CordonExecutor(ctx context.Context, cp1 *types.CordonExecutorRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorCordonExecutorScope)
+	scope = scope.Tagged(metrics.NamespaceTag(cp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(cp1.GetNamespace()))
+
+	err = h.handler.CordonExecutor(ctx, cp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return err
+}
+
+func (h *metricsHandler) DescribeNamespaceControls(ctx context.Context, dp1 *types.DescribeNamespaceControlsRequest) (dp2 *types.DescribeNamespaceControlsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorDescribeNamespaceControlsScope)
+	scope = scope.Tagged(metrics.NamespaceTag(dp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(dp1.GetNamespace()))
+
+	dp2, err = h.handler.DescribeNamespaceControls(ctx, dp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return dp2, err
+}
+
+func (h *metricsHandler) DrainExecutor(ctx context.Context, dp1 *types.DrainExecutorRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorDrainExecutorScope)
+	scope = scope.Tagged(metrics.NamespaceTag(dp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(dp1.GetNamespace()))
+
+	err = h.handler.DrainExecutor(ctx, dp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return err
+}
+
 func (h *metricsHandler) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest) (gp2 *types.GetShardOwnerResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 
@@ -51,6 +108,25 @@ func (h *metricsHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, e
 	return h.handler.Health(ctx)
 }
 
+func (h *metricsHandler) PinShard(ctx context.Context, pp1 *types.PinShardRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorPinShardScope)
+	scope = scope.Tagged(metrics.NamespaceTag(pp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(pp1.GetNamespace()))
+
+	err = h.handler.PinShard(ctx, pp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return err
+}
+
 func (h *metricsHandler) Start() {
 	h.handler.Start()
 	return
@@ -61,6 +137,44 @@ func (h *metricsHandler) Stop() {
 	return
 }
 
+func (h *metricsHandler) UncordonExecutor(ctx context.Context, up1 *types.UncordonExecutorRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorUncordonExecutorScope)
+	scope = scope.Tagged(metrics.NamespaceTag(up1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(up1.GetNamespace()))
+
+	err = h.handler.UncordonExecutor(ctx, up1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return err
+}
+
+func (h *metricsHandler) UnpinShard(ctx context.Context, up1 *types.UnpinShardRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorUnpinShardScope)
+	scope = scope.Tagged(metrics.NamespaceTag(up1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(up1.GetNamespace()))
+
+	err = h.handler.UnpinShard(ctx, up1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return err
+}
+
 func (h *metricsHandler) WatchNamespaceState(wp1 *types.WatchNamespaceStateRequest, w1 handler.WatchNamespaceStateServer) (err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 
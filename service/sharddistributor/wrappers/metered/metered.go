@@ -45,6 +45,9 @@ func handleErr(err error, scope metrics.Scope, logger log.Logger) error {
 	case errors.As(err, new(*types.ShardNotFoundError)):
 		scope.IncCounter(metrics.ShardDistributorErrShardNotFound)
 		return err
+	case errors.As(err, new(*types.BadRequestError)):
+		scope.IncCounter(metrics.ShardDistributorErrBadRequest)
+		return err
 	}
 	if errors.Is(err, context.DeadlineExceeded) {
 		logger.Error("request timeout", tag.Error(err))
@@ -205,6 +205,13 @@ func TestHandleErr(t *testing.T) {
 			setupMocks:    func(mockLogger *log.MockLogger) {},
 			metricName:    "shard_distributor_err_shard_not_found",
 		},
+		{
+			name:          "BadRequestError",
+			err:           &types.BadRequestError{},
+			expectedError: &types.BadRequestError{},
+			setupMocks:    func(mockLogger *log.MockLogger) {},
+			metricName:    "shard_distributor_err_bad_request",
+		},
 		{
 			name:          "ContextDeadlineExceeded",
 			err:           context.DeadlineExceeded,
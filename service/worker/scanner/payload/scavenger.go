@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/offload"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// ScavengerHeartbeatDetails is the heartbeat detail for PayloadScavengerActivity
+	ScavengerHeartbeatDetails struct {
+		NextPageToken []byte
+		CurrentPage   int
+		SkipCount     int
+		ErrorCount    int
+		DeletedCount  int
+	}
+
+	// ExecutionManagerProvider returns the execution manager of a shard
+	ExecutionManagerProvider func(shardID int) (p.ExecutionManager, error)
+
+	// Scavenger is the type that holds the state for offloaded payload scavenger daemon
+	Scavenger struct {
+		blobstore        blobstore.Client
+		executionManager ExecutionManagerProvider
+		numShards        int
+		domainCache      cache.DomainCache
+		hbd              ScavengerHeartbeatDetails
+		limiter          *rate.Limiter
+		archivalWindow   dynamicproperties.DurationPropertyFn
+		timeSource       clock.TimeSource
+		metrics          metrics.Client
+		logger           log.Logger
+		isInTest         bool
+	}
+)
+
+const (
+	pageSize = 1000
+
+	// gracePeriod protects the payloads of workflows which are still being started,
+	// including the ones started asynchronously, from being deleted before their execution exists.
+	gracePeriod = 24 * time.Hour
+)
+
+// NewScavenger returns an instance of offloaded payload scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the offloaded payloads in the blobstore. For
+// each payload older than the grace period, the scavenger will
+//   - delete the payload, if its domain has been deleted
+//   - look up the current execution of its workflow, found from the key of the payload
+//   - delete the payload, if all of the executions of its workflow have been deleted, unless its
+//     domain archives history and the payload is younger than the retention of the domain plus
+//     the archival window, since archived histories keep referencing it
+func NewScavenger(
+	blobstoreClient blobstore.Client,
+	executionManager ExecutionManagerProvider,
+	numShards int,
+	domainCache cache.DomainCache,
+	rps int,
+	hbd ScavengerHeartbeatDetails,
+	archivalWindow dynamicproperties.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Scavenger {
+	return &Scavenger{
+		blobstore:        blobstoreClient,
+		executionManager: executionManager,
+		numShards:        numShards,
+		domainCache:      domainCache,
+		hbd:              hbd,
+		limiter:          rate.NewLimiter(rate.Limit(rps), rps),
+		archivalWindow:   archivalWindow,
+		timeSource:       timeSource,
+		metrics:          metricsClient,
+		logger:           logger,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error) {
+	for {
+		resp, err := s.blobstore.List(ctx, &blobstore.ListRequest{
+			Prefix:        offload.KeyPrefix,
+			PageSize:      pageSize,
+			NextPageToken: s.hbd.NextPageToken,
+		})
+		if err != nil {
+			return s.hbd, err
+		}
+
+		for _, key := range resp.Keys {
+			if err := s.limiter.Wait(ctx); err != nil {
+				return s.hbd, err
+			}
+			deleted, err := s.scavenge(ctx, key)
+			switch {
+			case err != nil:
+				s.hbd.ErrorCount++
+				s.metrics.IncCounter(metrics.PayloadScavengerScope, metrics.PayloadScavengerErrorCount)
+				s.logger.Error("scavenger: unable to clean up offloaded payload", tag.Key(key), tag.Error(err))
+			case deleted:
+				s.hbd.DeletedCount++
+				s.metrics.IncCounter(metrics.PayloadScavengerScope, metrics.PayloadScavengerDeletedCount)
+				s.logger.Info("deleted offloaded payload", tag.Key(key))
+			default:
+				s.hbd.SkipCount++
+				s.metrics.IncCounter(metrics.PayloadScavengerScope, metrics.PayloadScavengerSkipCount)
+			}
+		}
+
+		s.hbd.CurrentPage++
+		s.hbd.NextPageToken = resp.NextPageToken
+		if !s.isInTest {
+			activity.RecordHeartbeat(ctx, s.hbd)
+		}
+		if len(s.hbd.NextPageToken) == 0 {
+			break
+		}
+	}
+	return s.hbd, nil
+}
+
+// scavenge deletes the offloaded payload if it is no longer referenced, and returns whether it was deleted
+func (s *Scavenger) scavenge(ctx context.Context, key string) (bool, error) {
+	parsed, err := offload.ParseKey(key)
+	if err != nil {
+		return false, err
+	}
+	age := s.timeSource.Now().Sub(parsed.CreatedAt)
+	if age < gracePeriod {
+		return false, nil
+	}
+
+	domain, err := s.domainCache.GetDomainByID(parsed.DomainID)
+	if err != nil {
+		var notExists *types.EntityNotExistsError
+		if !errors.As(err, &notExists) {
+			return false, err
+		}
+		return true, s.delete(ctx, key)
+	}
+	// archived histories are read through GetWorkflowExecutionHistory, which resolves the references
+	// they contain after the executions of the workflow have been deleted, until the archival window ends
+	if domain.GetConfig().HistoryArchivalStatus == types.ArchivalStatusEnabled &&
+		age < common.DaysToDuration(domain.GetRetentionDays(parsed.WorkflowID))+s.archivalWindow() {
+		return false, nil
+	}
+
+	// the current execution of a workflow is deleted by retention together with its last run
+	executionManager, err := s.executionManager(common.WorkflowIDToHistoryShard(parsed.WorkflowID, s.numShards))
+	if err != nil {
+		return false, err
+	}
+	_, err = executionManager.GetCurrentExecution(ctx, &p.GetCurrentExecutionRequest{
+		DomainID:   parsed.DomainID,
+		WorkflowID: parsed.WorkflowID,
+		DomainName: domain.GetInfo().Name,
+	})
+	if err == nil {
+		return false, nil
+	}
+	var notExists *types.EntityNotExistsError
+	if !errors.As(err, &notExists) {
+		return false, err
+	}
+	return true, s.delete(ctx, key)
+}
+
+func (s *Scavenger) delete(ctx context.Context, key string) error {
+	_, err := s.blobstore.Delete(ctx, &blobstore.DeleteRequest{Key: key})
+	return err
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/offload"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const numShards = 4
+
+func TestScavenger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Unix(1700000000, 0)
+	mockBlobstore := blobstore.NewMockClient(ctrl)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockExecutionManager := p.NewMockExecutionManager(ctrl)
+
+	key := func(domainID, workflowID string, age time.Duration) string {
+		return fmt.Sprintf("%v%v.%v.%v.%v", offload.KeyPrefix, domainID, now.Add(-age).Unix(), base64.RawURLEncoding.EncodeToString([]byte(workflowID)), workflowID)
+	}
+	recent := key("domain-id", "recent-wf", time.Hour)
+	running := key("domain-id", "running-wf", 48*time.Hour)
+	deleted := key("domain-id", "deleted-wf", 48*time.Hour)
+	deletedDomain := key("deleted-domain-id", "deleted-domain-wf", 48*time.Hour)
+	invalid := offload.KeyPrefix + "invalid"
+	failing := key("domain-id", "failing-wf", 48*time.Hour)
+	// the archival domain has a retention of 1 day and an archival window of 7 days
+	archived := key("archival-domain-id", "archived-wf", 48*time.Hour)
+	expiredArchived := key("archival-domain-id", "expired-archived-wf", 9*24*time.Hour)
+
+	gomock.InOrder(
+		mockBlobstore.EXPECT().List(gomock.Any(), &blobstore.ListRequest{Prefix: offload.KeyPrefix, PageSize: pageSize}).
+			Return(&blobstore.ListResponse{Keys: []string{recent, running, invalid}, NextPageToken: []byte(invalid)}, nil),
+		mockBlobstore.EXPECT().List(gomock.Any(), &blobstore.ListRequest{Prefix: offload.KeyPrefix, PageSize: pageSize, NextPageToken: []byte(invalid)}).
+			Return(&blobstore.ListResponse{Keys: []string{deleted, deletedDomain, failing, archived, expiredArchived}}, nil),
+	)
+
+	mockDomainCache.EXPECT().GetDomainByID("domain-id").Return(cache.NewLocalDomainCacheEntryForTest(
+		&p.DomainInfo{ID: "domain-id", Name: "domain"},
+		&p.DomainConfig{HistoryArchivalStatus: types.ArchivalStatusDisabled},
+		"active",
+	), nil).AnyTimes()
+	mockDomainCache.EXPECT().GetDomainByID("archival-domain-id").Return(cache.NewLocalDomainCacheEntryForTest(
+		&p.DomainInfo{ID: "archival-domain-id", Name: "archival-domain"},
+		&p.DomainConfig{Retention: 1, HistoryArchivalStatus: types.ArchivalStatusEnabled},
+		"active",
+	), nil).Times(2)
+	mockDomainCache.EXPECT().GetDomainByID("deleted-domain-id").Return(nil, &types.EntityNotExistsError{})
+
+	currentExecution := func(domainID, domainName, workflowID string) *gomock.Call {
+		return mockExecutionManager.EXPECT().GetCurrentExecution(gomock.Any(), &p.GetCurrentExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: workflowID,
+			DomainName: domainName,
+		})
+	}
+	currentExecution("domain-id", "domain", "running-wf").Return(&p.GetCurrentExecutionResponse{}, nil)
+	currentExecution("domain-id", "domain", "deleted-wf").Return(nil, &types.EntityNotExistsError{})
+	currentExecution("domain-id", "domain", "failing-wf").Return(nil, errors.New("persistence failure"))
+	currentExecution("archival-domain-id", "archival-domain", "expired-archived-wf").Return(nil, &types.EntityNotExistsError{})
+
+	mockBlobstore.EXPECT().Delete(gomock.Any(), &blobstore.DeleteRequest{Key: deleted}).Return(&blobstore.DeleteResponse{}, nil)
+	mockBlobstore.EXPECT().Delete(gomock.Any(), &blobstore.DeleteRequest{Key: deletedDomain}).Return(&blobstore.DeleteResponse{}, nil)
+	mockBlobstore.EXPECT().Delete(gomock.Any(), &blobstore.DeleteRequest{Key: expiredArchived}).Return(&blobstore.DeleteResponse{}, nil)
+
+	scavenger := NewScavenger(
+		mockBlobstore,
+		func(shardID int) (p.ExecutionManager, error) {
+			assert.Less(t, shardID, numShards)
+			return mockExecutionManager, nil
+		},
+		numShards,
+		mockDomainCache,
+		1000,
+		ScavengerHeartbeatDetails{},
+		dynamicproperties.GetDurationPropertyFn(7*24*time.Hour),
+		clock.NewMockedTimeSourceAt(now),
+		metrics.NewClient(tally.NoopScope, metrics.Worker, metrics.MigrationConfig{}),
+		testlogger.New(t),
+	)
+	scavenger.isInTest = true
+
+	hbd, err := scavenger.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ScavengerHeartbeatDetails{
+		CurrentPage:  2,
+		SkipCount:    3,
+		ErrorCount:   2,
+		DeletedCount: 3,
+	}, hbd)
+}
+
+func TestScavengerListError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockBlobstore := blobstore.NewMockClient(ctrl)
+	mockBlobstore.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("list failed"))
+
+	hbd := ScavengerHeartbeatDetails{NextPageToken: []byte("token"), CurrentPage: 3}
+	scavenger := NewScavenger(
+		mockBlobstore,
+		nil,
+		numShards,
+		cache.NewMockDomainCache(ctrl),
+		1000,
+		hbd,
+		dynamicproperties.GetDurationPropertyFn(0),
+		clock.NewMockedTimeSource(),
+		metrics.NewClient(tally.NoopScope, metrics.Worker, metrics.MigrationConfig{}),
+		testlogger.New(t),
+	)
+	scavenger.isInTest = true
+
+	result, err := scavenger.Run(context.Background())
+	assert.ErrorContains(t, err, "list failed")
+	assert.Equal(t, hbd, result)
+}
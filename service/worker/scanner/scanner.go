@@ -67,6 +67,10 @@ type (
 		ClusterMetadata cluster.Metadata
 		// HistoryScannerEnabled indicates if history scanner should be started as part of scanner
 		HistoryScannerEnabled dynamicproperties.BoolPropertyFn
+		// PayloadScannerEnabled indicates if offloaded payload scanner should be started as part of scanner
+		PayloadScannerEnabled dynamicproperties.BoolPropertyFn
+		// PayloadScavengerArchivalWindow is how long offloaded payloads of archival enabled domains are kept after retention
+		PayloadScavengerArchivalWindow dynamicproperties.DurationPropertyFn
 		// ShardScanners is a list of shard scanner configs
 		ShardScanners              []*shardscanner.ScannerConfig
 		MaxWorkflowRetentionInDays dynamicproperties.IntPropertyFn
@@ -155,6 +159,13 @@ func (s *Scanner) Start() error {
 			historyScannerWFTypeName)
 		workerTaskListNames = append(workerTaskListNames, historyScannerTaskListName)
 	}
+	if s.context.cfg.PayloadScannerEnabled() && s.context.resource.GetBlobstoreClient() != nil {
+		ctx = s.startScanner(
+			ctx,
+			payloadScannerWFStartOptions,
+			payloadScannerWFTypeName)
+		workerTaskListNames = append(workerTaskListNames, payloadScannerTaskListName)
+	}
 
 	workerOpts := worker.Options{
 		Logger:                                 s.zapLogger,
@@ -149,6 +149,9 @@ func (s *scannerTestSuite) TestStart() {
 				HistoryScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
 					return false
 				},
+				PayloadScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
 			},
 			setupMocks: func() {
 				// this is mocking the worker being instantiated and started
@@ -175,6 +178,9 @@ func (s *scannerTestSuite) TestStart() {
 				HistoryScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
 					return false
 				},
+				PayloadScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
 			},
 			setupMocks: func() {
 				s.mockWorker.EXPECT().Start().Return(nil).Times(1)
@@ -197,6 +203,34 @@ func (s *scannerTestSuite) TestStart() {
 				HistoryScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
 					return true
 				},
+				PayloadScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
+			},
+			setupMocks: func() {
+				s.mockWorker.EXPECT().Start().Return(nil).Times(1)
+			},
+		},
+		{
+			name: "with PayloadScanner enabled",
+			cfg: Config{
+				Persistence: &config.Persistence{
+					DefaultStore: "nosql",
+					DataStores: map[string]config.DataStore{
+						"nosql": {
+							NoSQL: &config.NoSQL{},
+						},
+					},
+				},
+				TaskListScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
+				HistoryScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
+				PayloadScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return true
+				},
 			},
 			setupMocks: func() {
 				s.mockWorker.EXPECT().Start().Return(nil).Times(1)
@@ -219,6 +253,9 @@ func (s *scannerTestSuite) TestStart() {
 				HistoryScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
 					return true
 				},
+				PayloadScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
 			},
 			setupMocks: func() {
 				s.mockWorker.EXPECT().Start().Return(errors.New("some new error")).Times(1)
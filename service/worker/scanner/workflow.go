@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/history"
+	"github.com/uber/cadence/service/worker/scanner/payload"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
 )
@@ -50,6 +51,11 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+
+	payloadScannerWFID           = "cadence-sys-payload-scanner"
+	payloadScannerWFTypeName     = "cadence-sys-payload-scanner-workflow"
+	payloadScannerTaskListName   = "cadence-sys-payload-scanner-tasklist-0"
+	payloadScavengerActivityName = "cadence-sys-payload-scanner-scvg-activity"
 )
 
 var (
@@ -81,6 +87,13 @@ var (
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 		CronSchedule:                 "0 */12 * * *",
 	}
+	payloadScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           payloadScannerWFID,
+		TaskList:                     payloadScannerTaskListName,
+		ExecutionStartToCloseTimeout: infiniteDuration,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 */12 * * *",
+	}
 )
 
 func init() {
@@ -90,6 +103,9 @@ func init() {
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 
+	workflow.RegisterWithOptions(PayloadScannerWorkflow, workflow.RegisterOptions{Name: payloadScannerWFTypeName})
+	activity.RegisterWithOptions(PayloadScavengerActivity, activity.RegisterOptions{Name: payloadScavengerActivityName})
+
 	workflow.RegisterWithOptions(executions.ConcreteScannerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.CurrentScannerWorkflow, workflow.RegisterOptions{Name: executions.CurrentExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.ConcreteFixerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsFixerWFTypeName})
@@ -152,6 +168,50 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// PayloadScannerWorkflow is the workflow that runs the offloaded payload scanner background daemon
+func PayloadScannerWorkflow(
+	ctx workflow.Context,
+) error {
+
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		payloadScavengerActivityName,
+	)
+	return future.Get(ctx, nil)
+}
+
+// PayloadScavengerActivity is the activity that runs offloaded payload scavenger
+func PayloadScavengerActivity(
+	activityCtx context.Context,
+) (payload.ScavengerHeartbeatDetails, error) {
+
+	ctx, err := getScannerContext(activityCtx)
+	if err != nil {
+		return payload.ScavengerHeartbeatDetails{}, err
+	}
+
+	res := ctx.resource
+	hbd := payload.ScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			res.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+	scavenger := payload.NewScavenger(
+		res.GetBlobstoreClient(),
+		res.GetExecutionManager,
+		ctx.cfg.Persistence.NumHistoryShards,
+		res.GetDomainCache(),
+		ctx.cfg.ScannerPersistenceMaxQPS(),
+		hbd,
+		ctx.cfg.PayloadScavengerArchivalWindow,
+		res.GetTimeSource(),
+		res.GetMetricsClient(),
+		res.GetLogger(),
+	)
+	return scavenger.Run(activityCtx)
+}
+
 // TaskListScavengerActivity is the activity that runs task list scavenger
 func TaskListScavengerActivity(
 	activityCtx context.Context,
@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// minScheduleInterval matches the one minute granularity of cron expressions
+	minScheduleInterval = time.Minute
+	// maxExcludedDaysSkipped bounds how many consecutive excluded days are skipped
+	// while looking for the next fire time before giving up
+	maxExcludedDaysSkipped = 1000
+	// calendarDateLayout is the layout of the dates in an exclusion calendar
+	calendarDateLayout = "2006-01-02"
+)
+
+// compiledSpec is a cron.Schedule which fires at the union of all cron expressions
+// and intervals of a ScheduleSpec, skipping days matched by its exclusion calendars.
+type compiledSpec struct {
+	location  *time.Location
+	crons     []cron.Schedule
+	intervals []types.ScheduleIntervalSpec
+	excludes  []compiledCalendar
+}
+
+type compiledCalendar struct {
+	dates      map[string]struct{}
+	daysOfWeek map[time.Weekday]struct{}
+}
+
+var _ cron.Schedule = (*compiledSpec)(nil)
+
+// ValidateScheduleSpec returns an error if the spec has no cron expression or interval,
+// or if any of its cron expressions, intervals, calendars or time zone is invalid.
+func ValidateScheduleSpec(spec *types.ScheduleSpec) error {
+	if spec == nil {
+		return errors.New("spec is not set")
+	}
+	_, err := compileScheduleSpec(*spec)
+	return err
+}
+
+// NextFireTimes returns up to count fire times of the spec after now, honoring its start and end time.
+func NextFireTimes(spec types.ScheduleSpec, now time.Time, count int) ([]time.Time, error) {
+	sched, err := compileScheduleSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	return nextFireTimes(sched, spec, now, count), nil
+}
+
+func nextFireTimes(sched cron.Schedule, spec types.ScheduleSpec, now time.Time, count int) []time.Time {
+	var times []time.Time
+	for len(times) < count {
+		next := computeNextRunTime(sched, now, spec)
+		if next.IsZero() {
+			break
+		}
+		times = append(times, next)
+		now = next
+	}
+	return times
+}
+
+// scheduleCronExpressions returns all cron expressions of the spec.
+func scheduleCronExpressions(spec types.ScheduleSpec) []string {
+	var exprs []string
+	if spec.CronExpression != "" {
+		exprs = append(exprs, spec.CronExpression)
+	}
+	return append(exprs, spec.CronExpressions...)
+}
+
+func compileScheduleSpec(spec types.ScheduleSpec) (*compiledSpec, error) {
+	exprs := scheduleCronExpressions(spec)
+	if len(exprs) == 0 && len(spec.Intervals) == 0 {
+		return nil, errors.New("at least one cron expression or interval must be set")
+	}
+
+	compiled := &compiledSpec{}
+	if spec.TimeZone != "" {
+		loc, err := time.LoadLocation(spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", spec.TimeZone, err)
+		}
+		compiled.location = loc
+	}
+
+	for _, expr := range exprs {
+		sched, err := cron.ParseStandard(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		compiled.crons = append(compiled.crons, sched)
+	}
+
+	for _, interval := range spec.Intervals {
+		if interval == nil {
+			return nil, errors.New("interval is not set")
+		}
+		if interval.Interval < minScheduleInterval {
+			return nil, fmt.Errorf("interval %v is shorter than %v", interval.Interval, minScheduleInterval)
+		}
+		if interval.Offset < 0 || interval.Offset >= interval.Interval {
+			return nil, fmt.Errorf("offset %v must be non-negative and less than interval %v", interval.Offset, interval.Interval)
+		}
+		compiled.intervals = append(compiled.intervals, *interval)
+	}
+
+	for _, calendar := range spec.ExcludeCalendars {
+		if calendar == nil {
+			return nil, errors.New("exclusion calendar is not set")
+		}
+		excluded := compiledCalendar{
+			dates:      make(map[string]struct{}, len(calendar.Dates)),
+			daysOfWeek: make(map[time.Weekday]struct{}, len(calendar.DaysOfWeek)),
+		}
+		for _, date := range calendar.Dates {
+			parsed, err := time.Parse(calendarDateLayout, date)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date %q in exclusion calendar %q: %w", date, calendar.Name, err)
+			}
+			excluded.dates[parsed.Format(calendarDateLayout)] = struct{}{}
+		}
+		for _, day := range calendar.DaysOfWeek {
+			if day < time.Sunday || day > time.Saturday {
+				return nil, fmt.Errorf("invalid day of week %d in exclusion calendar %q", day, calendar.Name)
+			}
+			excluded.daysOfWeek[day] = struct{}{}
+		}
+		if len(excluded.daysOfWeek) == 7 {
+			return nil, fmt.Errorf("exclusion calendar %q excludes every day of the week", calendar.Name)
+		}
+		compiled.excludes = append(compiled.excludes, excluded)
+	}
+	return compiled, nil
+}
+
+// Next returns the earliest fire time after t which is not on an excluded day,
+// or the zero time if there is none.
+func (s *compiledSpec) Next(t time.Time) time.Time {
+	for skipped := 0; skipped <= maxExcludedDaysSkipped; skipped++ {
+		next := s.nextUnfiltered(t)
+		if next.IsZero() {
+			return next
+		}
+		local := s.inLocation(next)
+		if !s.isExcluded(local) {
+			return next.In(t.Location())
+		}
+		// continue the search from the end of the excluded day
+		year, month, day := local.Date()
+		t = time.Date(year, month, day+1, 0, 0, 0, 0, local.Location()).Add(-time.Nanosecond)
+	}
+	return time.Time{}
+}
+
+func (s *compiledSpec) nextUnfiltered(t time.Time) time.Time {
+	var earliest time.Time
+	for _, sched := range s.crons {
+		next := sched.Next(s.inLocation(t))
+		if !next.IsZero() && (earliest.IsZero() || next.Before(earliest)) {
+			earliest = next
+		}
+	}
+	for _, interval := range s.intervals {
+		next := nextIntervalTime(interval, t)
+		if earliest.IsZero() || next.Before(earliest) {
+			earliest = next
+		}
+	}
+	return earliest
+}
+
+// inLocation converts t to the time zone of the spec; without one, t is
+// used as is so that cron expressions keep the time zone of the worker.
+func (s *compiledSpec) inLocation(t time.Time) time.Time {
+	if s.location == nil {
+		return t
+	}
+	return t.In(s.location)
+}
+
+func (s *compiledSpec) isExcluded(t time.Time) bool {
+	date := t.Format(calendarDateLayout)
+	for _, calendar := range s.excludes {
+		if _, ok := calendar.dates[date]; ok {
+			return true
+		}
+		if _, ok := calendar.daysOfWeek[t.Weekday()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// nextIntervalTime returns the first time after t of the form epoch + offset + k * interval.
+func nextIntervalTime(interval types.ScheduleIntervalSpec, t time.Time) time.Time {
+	elapsed := t.UnixNano() - int64(interval.Offset)
+	period := int64(interval.Interval)
+	k := elapsed / period
+	if elapsed < 0 && elapsed%period != 0 {
+		k--
+	}
+	return time.Unix(0, (k+1)*period+int64(interval.Offset)).In(t.Location())
+}
+
+// scheduleCronSearchAttribute returns the value of the cron search attribute for the spec.
+func scheduleCronSearchAttribute(spec types.ScheduleSpec) string {
+	return strings.Join(scheduleCronExpressions(spec), "; ")
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidateScheduleSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *types.ScheduleSpec
+		wantErr bool
+	}{
+		{
+			name:    "nil spec",
+			spec:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "no cron expression or interval",
+			spec:    &types.ScheduleSpec{},
+			wantErr: true,
+		},
+		{
+			name: "cron expression",
+			spec: &types.ScheduleSpec{CronExpression: "0 * * * *"},
+		},
+		{
+			name: "multiple cron expressions, intervals, calendars and time zone",
+			spec: &types.ScheduleSpec{
+				CronExpressions: []string{"0 9 * * *", "30 17 * * *"},
+				Intervals:       []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Offset: 15 * time.Minute}},
+				ExcludeCalendars: []*types.ScheduleCalendar{
+					{Name: "weekends", DaysOfWeek: []time.Weekday{time.Saturday, time.Sunday}},
+					{Name: "holidays", Dates: []string{"2026-12-25"}},
+				},
+				TimeZone: "America/New_York",
+			},
+		},
+		{
+			name:    "invalid cron expression",
+			spec:    &types.ScheduleSpec{CronExpressions: []string{"0 * * * *", "not a cron"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			spec:    &types.ScheduleSpec{CronExpression: "0 * * * *", TimeZone: "Mars/Olympus_Mons"},
+			wantErr: true,
+		},
+		{
+			name:    "nil interval",
+			spec:    &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{nil}},
+			wantErr: true,
+		},
+		{
+			name:    "interval too short",
+			spec:    &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Second}}},
+			wantErr: true,
+		},
+		{
+			name:    "offset not less than interval",
+			spec:    &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Offset: time.Hour}}},
+			wantErr: true,
+		},
+		{
+			name:    "negative offset",
+			spec:    &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Offset: -time.Minute}}},
+			wantErr: true,
+		},
+		{
+			name: "invalid calendar date",
+			spec: &types.ScheduleSpec{
+				CronExpression:   "0 * * * *",
+				ExcludeCalendars: []*types.ScheduleCalendar{{Name: "holidays", Dates: []string{"25/12/2026"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid day of week",
+			spec: &types.ScheduleSpec{
+				CronExpression:   "0 * * * *",
+				ExcludeCalendars: []*types.ScheduleCalendar{{DaysOfWeek: []time.Weekday{7}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "calendar excludes every day",
+			spec: &types.ScheduleSpec{
+				CronExpression: "0 * * * *",
+				ExcludeCalendars: []*types.ScheduleCalendar{{DaysOfWeek: []time.Weekday{
+					time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+				}}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScheduleSpec(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNextIntervalTime(t *testing.T) {
+	interval := types.ScheduleIntervalSpec{Interval: 90 * time.Minute, Offset: 15 * time.Minute}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "between fires",
+			now:  time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 1, 15, 0, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "exactly on a fire is exclusive",
+			now:  time.Date(2026, 1, 15, 0, 15, 0, 0, time.UTC),
+			want: time.Date(2026, 1, 15, 1, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "before the epoch",
+			now:  time.Date(1969, 12, 31, 23, 0, 0, 0, time.UTC),
+			want: time.Date(1970, 1, 1, 0, 15, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, nextIntervalTime(interval, tt.now))
+		})
+	}
+}
+
+func TestNextFireTimes(t *testing.T) {
+	// Thursday
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		spec  types.ScheduleSpec
+		count int
+		want  []time.Time
+	}{
+		{
+			name:  "union of cron expressions",
+			spec:  types.ScheduleSpec{CronExpression: "0 12 * * *", CronExpressions: []string{"0 11 * * *", "0 12 * * *"}},
+			count: 3,
+			want: []time.Time{
+				time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 16, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "union of cron expression and interval",
+			spec: types.ScheduleSpec{
+				CronExpression: "0 11 * * *",
+				Intervals:      []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Offset: 15 * time.Minute}},
+			},
+			count: 4,
+			want: []time.Time{
+				time.Date(2026, 1, 15, 10, 45, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 12, 15, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 13, 45, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "cron expression in time zone",
+			spec:  types.ScheduleSpec{CronExpression: "0 9 * * *", TimeZone: "America/New_York"},
+			count: 2,
+			want: []time.Time{
+				time.Date(2026, 1, 15, 14, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 16, 14, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "weekends and holidays excluded",
+			spec: types.ScheduleSpec{
+				CronExpression: "0 9 * * *",
+				ExcludeCalendars: []*types.ScheduleCalendar{
+					{Name: "weekends", DaysOfWeek: []time.Weekday{time.Saturday, time.Sunday}},
+					{Name: "holidays", Dates: []string{"2026-01-19"}},
+				},
+			},
+			count: 3,
+			want: []time.Time{
+				time.Date(2026, 1, 16, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 20, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 21, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "exclusion calendar evaluated in time zone",
+			spec: types.ScheduleSpec{
+				// 23:00 in Tokyo on Friday is 14:00 UTC on Friday, midnight Saturday in Tokyo is 15:00 UTC
+				CronExpression:   "0 23 * * *",
+				TimeZone:         "Asia/Tokyo",
+				ExcludeCalendars: []*types.ScheduleCalendar{{DaysOfWeek: []time.Weekday{time.Friday}}},
+			},
+			count: 2,
+			want: []time.Time{
+				time.Date(2026, 1, 15, 14, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 17, 14, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "stops at end time",
+			spec: types.ScheduleSpec{
+				CronExpression: "0 * * * *",
+				EndTime:        time.Date(2026, 1, 15, 12, 30, 0, 0, time.UTC),
+			},
+			count: 5,
+			want: []time.Time{
+				time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextFireTimes(tt.spec, now, tt.count)
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				assert.True(t, tt.want[i].Equal(got[i]), "fire %d: want %v, got %v", i, tt.want[i], got[i])
+			}
+		})
+	}
+
+	_, err := NextFireTimes(types.ScheduleSpec{}, now, 1)
+	assert.Error(t, err)
+}
+
+func TestScheduleCronSearchAttribute(t *testing.T) {
+	assert.Equal(t, "", scheduleCronSearchAttribute(types.ScheduleSpec{}))
+	assert.Equal(t, "0 * * * *", scheduleCronSearchAttribute(types.ScheduleSpec{CronExpression: "0 * * * *"}))
+	assert.Equal(t, "0 * * * *; 0 9 * * 1", scheduleCronSearchAttribute(types.ScheduleSpec{
+		CronExpression:  "0 * * * *",
+		CronExpressions: []string{"0 9 * * 1"},
+	}))
+}
+
+func TestPreviewFutureRunTimes(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+	input := &SchedulerWorkflowInput{Spec: types.ScheduleSpec{CronExpression: "0 * * * *"}}
+
+	got := previewFutureRunTimes(input, &SchedulerWorkflowState{}, now)
+	require.Len(t, got, describeFutureRunCount)
+	assert.Equal(t, time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC), got[0])
+	assert.Equal(t, time.Date(2026, 1, 15, 15, 0, 0, 0, time.UTC), got[describeFutureRunCount-1])
+
+	assert.Empty(t, previewFutureRunTimes(input, &SchedulerWorkflowState{Paused: true}, now))
+	assert.Empty(t, previewFutureRunTimes(&SchedulerWorkflowInput{}, &SchedulerWorkflowState{}, now))
+}
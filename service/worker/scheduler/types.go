@@ -29,6 +29,8 @@ import (
 const (
 	WorkflowTypeName = "cadence-scheduler"
 	TaskListName     = "cadence-scheduler"
+	// WorkflowIDPrefix is prepended to the schedule ID to build the ID of its scheduler workflow
+	WorkflowIDPrefix = "cadence-scheduler:"
 
 	SignalNamePause    = "scheduler-pause"
 	SignalNameUnpause  = "scheduler-unpause"
@@ -38,6 +40,9 @@ const (
 
 	QueryTypeDescribe = "scheduler-describe"
 
+	// describeFutureRunCount is the number of upcoming fire times previewed by the describe query
+	describeFutureRunCount = 5
+
 	// Search attribute keys set on target workflows started by the scheduler.
 	SearchAttrScheduleID   = "CadenceScheduleID"
 	SearchAttrScheduleTime = "CadenceScheduleTime"
@@ -50,8 +55,8 @@ const (
 	// search attributes. "Deleted" is not a value because a deleted schedule's
 	// workflow is closed and filtered by workflow status instead.
 	SearchAttrScheduleState = "CadenceScheduleState"
-	// CadenceScheduleCron holds the current cron expression so ListSchedules
-	// can display it without querying each scheduler workflow. Refreshed on
+	// CadenceScheduleCron holds the current cron expressions, joined by "; ", so
+	// ListSchedules can display them without querying each scheduler workflow. Refreshed on
 	// workflow start (including after ContinueAsNew triggered by UpdateSchedule).
 	SearchAttrScheduleCron = "CadenceScheduleCron"
 	// CadenceScheduleWorkflowType holds the target workflow type name that the
@@ -65,6 +70,8 @@ const (
 	maxCatchUpFiresPerExecution      = 10
 	maxBackfillFiresPerExecution     = 10
 	maxPendingBackfills              = 10
+	// maxRecentRuns bounds the run history kept in state, older fires are dropped first
+	maxRecentRuns = 20
 
 	localActivityScheduleToCloseTimeout = 60 * time.Second
 	localActivityMaxRetries             = 3
@@ -102,6 +109,8 @@ type SchedulerWorkflowState struct {
 	// the overlap policy can check whether it is still running before starting
 	// the next one. Nil when no workflow has been started yet.
 	LastStartedWorkflow *RunningWorkflowInfo `json:"lastStartedWorkflow,omitempty"`
+	// RecentRuns is a bounded history of the latest fires, oldest first.
+	RecentRuns []*types.ScheduleRunRecord `json:"recentRuns,omitempty"`
 }
 
 // RunningWorkflowInfo identifies a target workflow started by the scheduler,
@@ -162,6 +171,9 @@ type ScheduleDescription struct {
 	TotalRuns   int64                  `json:"totalRuns"`
 	MissedRuns  int64                  `json:"missedRuns"`
 	SkippedRuns int64                  `json:"skippedRuns"`
+	// FutureRunTimes previews the next fire times, it is empty while paused
+	FutureRunTimes []time.Time                `json:"futureRunTimes,omitempty"`
+	RecentRuns     []*types.ScheduleRunRecord `json:"recentRuns,omitempty"`
 }
 
 // TriggerSource identifies what caused a schedule fire, used to differentiate
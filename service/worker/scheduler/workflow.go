@@ -61,7 +61,9 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 	state := &input.State
 
 	err := workflow.SetQueryHandler(ctx, QueryTypeDescribe, func() (*ScheduleDescription, error) {
-		return buildScheduleDescription(&input, state), nil
+		desc := buildScheduleDescription(&input, state)
+		desc.FutureRunTimes = previewFutureRunTimes(&input, state, workflow.Now(ctx))
+		return desc, nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed to register query handler: %w", err)
@@ -84,10 +86,10 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		delete:   workflow.GetSignalChannel(ctx, SignalNameDelete),
 	}
 
-	sched, err := cron.ParseStandard(input.Spec.CronExpression)
+	sched, err := compileScheduleSpec(input.Spec)
 	if err != nil {
-		logger.Error("invalid cron expression, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
-		return fmt.Errorf("invalid cron expression %q: %w", input.Spec.CronExpression, err)
+		logger.Error("invalid schedule spec, terminating", zap.Error(err))
+		return fmt.Errorf("invalid schedule spec: %w", err)
 	}
 
 	// On the first iteration (after ContinueAsNew or fresh start), check for
@@ -313,7 +315,7 @@ func buildScheduleSearchAttributes(input *SchedulerWorkflowInput, state *Schedul
 	sa := map[string]interface{}{
 		SearchAttrScheduleState: scheduleStateFromPaused(state.Paused),
 	}
-	if cron := input.Spec.CronExpression; cron != "" {
+	if cron := scheduleCronSearchAttribute(input.Spec); cron != "" {
 		sa[SearchAttrScheduleCron] = cron
 	}
 	if sw := input.Action.StartWorkflow; sw != nil && sw.WorkflowType != nil && sw.WorkflowType.Name != "" {
@@ -349,9 +351,8 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 	}
 	changed := false
 	if sig.Spec != nil {
-		if _, err := cron.ParseStandard(sig.Spec.CronExpression); err != nil {
-			logger.Error("ignoring update with invalid cron expression",
-				zap.String("cron", sig.Spec.CronExpression), zap.Error(err))
+		if _, err := compileScheduleSpec(*sig.Spec); err != nil {
+			logger.Error("ignoring update with invalid schedule spec", zap.Error(err))
 		} else {
 			input.Spec = *sig.Spec
 			changed = true
@@ -429,8 +430,19 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *Schedu
 		zap.Time("scheduledTime", scheduledTime),
 	)
 
+	record := &types.ScheduleRunRecord{
+		ScheduledTime: scheduledTime,
+		IsBackfill:    trigger == TriggerSourceBackfill,
+	}
+	defer func() {
+		record.ActualTime = workflow.Now(ctx)
+		recordRun(state, record)
+	}()
+
 	if input.Action.StartWorkflow == nil {
 		state.MissedRuns++
+		record.Outcome = types.ScheduleRunOutcomeFailed
+		record.Failure = "schedule action has no StartWorkflow configuration"
 		logger.Error("schedule action has no StartWorkflow configuration")
 		return
 	}
@@ -450,6 +462,8 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *Schedu
 	var result ProcessFireResult
 	if err := workflow.ExecuteLocalActivity(actCtx, processScheduleFireActivity, req).Get(ctx, &result); err != nil {
 		state.MissedRuns++
+		record.Outcome = types.ScheduleRunOutcomeFailed
+		record.Failure = err.Error()
 		logger.Error("processScheduleFireActivity failed",
 			zap.Time("scheduledTime", scheduledTime),
 			zap.Error(err),
@@ -461,6 +475,13 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *Schedu
 	state.SkippedRuns += result.SkippedDelta
 	if result.StartedWorkflow != nil {
 		state.LastStartedWorkflow = result.StartedWorkflow
+		record.WorkflowID = result.StartedWorkflow.WorkflowID
+		record.RunID = result.StartedWorkflow.RunID
+	}
+	if result.TotalDelta > 0 {
+		record.Outcome = types.ScheduleRunOutcomeStarted
+	} else {
+		record.Outcome = types.ScheduleRunOutcomeSkipped
 	}
 
 	if result.TotalDelta > 0 && result.StartedWorkflow != nil {
@@ -475,6 +496,15 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *Schedu
 	}
 }
 
+// recordRun appends a fire to the run history, dropping the oldest
+// entries once it holds more than maxRecentRuns.
+func recordRun(state *SchedulerWorkflowState, record *types.ScheduleRunRecord) {
+	state.RecentRuns = append(state.RecentRuns, record)
+	if overflow := len(state.RecentRuns) - maxRecentRuns; overflow > 0 {
+		state.RecentRuns = append([]*types.ScheduleRunRecord(nil), state.RecentRuns[overflow:]...)
+	}
+}
+
 // defaultActivityOptions returns the standard local activity options used by
 // all scheduler activities.
 func defaultActivityOptions() workflow.LocalActivityOptions {
@@ -604,6 +634,7 @@ func processMissedRuns(ctx workflow.Context, logger *zap.Logger, sched cron.Sche
 	}
 
 	result := applyMissedRunPolicy(input.Policies.CatchUpPolicy, input.Policies.CatchUpWindow, fires.times, now, logger)
+	recordCatchUpSkippedRuns(state, fires.times, result.toFire)
 
 	fired := 0
 	for _, t := range result.toFire {
@@ -637,6 +668,25 @@ func processMissedRuns(ctx workflow.Context, logger *zap.Logger, sched cron.Sche
 	return unfired > 0 || fires.truncated
 }
 
+// recordCatchUpSkippedRuns adds the missed fires which the catch-up policy
+// did not select to the run history. Skipped fires always precede the
+// selected ones, so recording them first keeps the history in order.
+func recordCatchUpSkippedRuns(state *SchedulerWorkflowState, missed, toFire []time.Time) {
+	selected := make(map[int64]struct{}, len(toFire))
+	for _, t := range toFire {
+		selected[t.UnixNano()] = struct{}{}
+	}
+	for _, t := range missed {
+		if _, ok := selected[t.UnixNano()]; ok {
+			continue
+		}
+		recordRun(state, &types.ScheduleRunRecord{
+			ScheduledTime: t,
+			Outcome:       types.ScheduleRunOutcomeCatchUpSkipped,
+		})
+	}
+}
+
 // processBackfills drains pending backfill requests from state, computing
 // cron fire times for each request's time range and executing them.
 // Like processMissedRuns, it caps fires per execution and returns true
@@ -692,6 +742,18 @@ func processBackfills(ctx workflow.Context, logger *zap.Logger, sched cron.Sched
 	return false
 }
 
+// previewFutureRunTimes returns the next fire times of an unpaused schedule.
+func previewFutureRunTimes(input *SchedulerWorkflowInput, state *SchedulerWorkflowState, now time.Time) []time.Time {
+	if state.Paused {
+		return nil
+	}
+	sched, err := compileScheduleSpec(input.Spec)
+	if err != nil {
+		return nil
+	}
+	return nextFireTimes(sched, input.Spec, now, describeFutureRunCount)
+}
+
 // buildScheduleDescription creates a snapshot of the current schedule
 // configuration and runtime state for the describe query handler.
 func buildScheduleDescription(input *SchedulerWorkflowInput, state *SchedulerWorkflowState) *ScheduleDescription {
@@ -709,6 +771,7 @@ func buildScheduleDescription(input *SchedulerWorkflowInput, state *SchedulerWor
 		TotalRuns:   state.TotalRuns,
 		MissedRuns:  state.MissedRuns,
 		SkippedRuns: state.SkippedRuns,
+		RecentRuns:  state.RecentRuns,
 	}
 }
 
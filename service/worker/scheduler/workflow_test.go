@@ -371,6 +371,9 @@ func TestBuildScheduleDescription(t *testing.T) {
 				TotalRuns:   42,
 				MissedRuns:  1,
 				SkippedRuns: 3,
+				RecentRuns: []*types.ScheduleRunRecord{
+					{ScheduledTime: lastRun, ActualTime: lastRun, WorkflowID: "wf-1", RunID: "run-1", Outcome: types.ScheduleRunOutcomeStarted},
+				},
 			},
 			want: &ScheduleDescription{
 				ScheduleID: "sched-1",
@@ -387,6 +390,9 @@ func TestBuildScheduleDescription(t *testing.T) {
 				TotalRuns:   42,
 				MissedRuns:  1,
 				SkippedRuns: 3,
+				RecentRuns: []*types.ScheduleRunRecord{
+					{ScheduledTime: lastRun, ActualTime: lastRun, WorkflowID: "wf-1", RunID: "run-1", Outcome: types.ScheduleRunOutcomeStarted},
+				},
 			},
 		},
 		{
@@ -594,6 +600,26 @@ func TestHandleUpdate(t *testing.T) {
 			wantPol:     types.ScheduleOverlapPolicySkipNew,
 			wantChanged: false,
 		},
+		{
+			name: "interval-only spec is accepted",
+			sig: UpdateSignal{
+				Spec: &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour}}},
+			},
+			wantCron:    "",
+			wantWF:      "old-workflow",
+			wantPol:     types.ScheduleOverlapPolicySkipNew,
+			wantChanged: true,
+		},
+		{
+			name: "invalid time zone is rejected, spec unchanged",
+			sig: UpdateSignal{
+				Spec: &types.ScheduleSpec{CronExpression: "*/5 * * * *", TimeZone: "Not/AZone"},
+			},
+			wantCron:    "0 * * * *",
+			wantWF:      "old-workflow",
+			wantPol:     types.ScheduleOverlapPolicySkipNew,
+			wantChanged: false,
+		},
 		{
 			name: "invalid cron rejected but action and policies still applied",
 			sig: UpdateSignal{
@@ -925,3 +951,36 @@ func TestBuildScheduleSearchAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordRun(t *testing.T) {
+	base := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	state := &SchedulerWorkflowState{}
+	for i := 0; i < maxRecentRuns+5; i++ {
+		recordRun(state, &types.ScheduleRunRecord{
+			ScheduledTime: base.Add(time.Duration(i) * time.Minute),
+			Outcome:       types.ScheduleRunOutcomeStarted,
+		})
+	}
+
+	require.Len(t, state.RecentRuns, maxRecentRuns)
+	assert.Equal(t, base.Add(5*time.Minute), state.RecentRuns[0].ScheduledTime, "oldest runs should be dropped first")
+	assert.Equal(t, base.Add(time.Duration(maxRecentRuns+4)*time.Minute), state.RecentRuns[maxRecentRuns-1].ScheduledTime)
+}
+
+func TestRecordCatchUpSkippedRuns(t *testing.T) {
+	t1 := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	t3 := t2.Add(time.Hour)
+
+	state := &SchedulerWorkflowState{}
+	recordCatchUpSkippedRuns(state, []time.Time{t1, t2, t3}, []time.Time{t3})
+
+	assert.Equal(t, []*types.ScheduleRunRecord{
+		{ScheduledTime: t1, Outcome: types.ScheduleRunOutcomeCatchUpSkipped},
+		{ScheduledTime: t2, Outcome: types.ScheduleRunOutcomeCatchUpSkipped},
+	}, state.RecentRuns)
+
+	state = &SchedulerWorkflowState{}
+	recordCatchUpSkippedRuns(state, []time.Time{t1, t2}, []time.Time{t1, t2})
+	assert.Empty(t, state.RecentRuns)
+}
@@ -147,10 +147,12 @@ func NewConfig(params *resource.Params) *Config {
 				EnableCleaning:           dc.GetBoolProperty(dynamicproperties.EnableCleaningOrphanTaskInTasklistScavenger),
 				MaxTasksPerJobFn:         dc.GetIntProperty(dynamicproperties.ScannerMaxTasksProcessedPerTasklistJob),
 			},
-			Persistence:            &params.PersistenceConfig,
-			ClusterMetadata:        params.ClusterMetadata,
-			TaskListScannerEnabled: dc.GetBoolProperty(dynamicproperties.TaskListScannerEnabled),
-			HistoryScannerEnabled:  dc.GetBoolProperty(dynamicproperties.HistoryScannerEnabled),
+			Persistence:                    &params.PersistenceConfig,
+			ClusterMetadata:                params.ClusterMetadata,
+			TaskListScannerEnabled:         dc.GetBoolProperty(dynamicproperties.TaskListScannerEnabled),
+			HistoryScannerEnabled:          dc.GetBoolProperty(dynamicproperties.HistoryScannerEnabled),
+			PayloadScannerEnabled:          dc.GetBoolProperty(dynamicproperties.PayloadScannerEnabled),
+			PayloadScavengerArchivalWindow: dc.GetDurationProperty(dynamicproperties.PayloadScavengerArchivalWindow),
 			ShardScanners: []*shardscanner.ScannerConfig{
 				executions.ConcreteExecutionConfig(dc),
 				executions.CurrentExecutionConfig(dc),
@@ -36,6 +36,7 @@ import (
 var (
 	cassandra = "CASSANDRA"
 	mongodb   = "MONGODB"
+	dynamodb  = "DYNAMODB"
 	mysql     = "MYSQL"
 	postgres  = "POSTGRES"
 	etcd      = "ETCD"
@@ -59,6 +60,10 @@ func RequireMongoDB(t *testing.T) {
 	require(t, mongodb)
 }
 
+func RequireDynamoDB(t *testing.T) {
+	require(t, dynamodb)
+}
+
 func RequireCassandra(t *testing.T) {
 	require(t, cassandra)
 }
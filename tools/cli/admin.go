@@ -106,6 +106,64 @@ func newAdminWorkflowCommands() []*cli.Command {
 			},
 			Action: AdminRefreshWorkflowTasks,
 		},
+		{
+			Name:    "pause-activity",
+			Aliases: []string{"pa"},
+			Usage:   "Pause a pending activity, it will not be dispatched or retried until it is unpaused",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason to pause the activity",
+				},
+			},
+			Action: AdminPauseActivity,
+		},
+		{
+			Name:    "unpause-activity",
+			Aliases: []string{"upa"},
+			Usage:   "Unpause a paused activity, it is rescheduled immediately if it is not started",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Reset the attempt counter of the activity",
+				},
+				&cli.BoolFlag{
+					Name:  FlagResetHeartbeatDetails,
+					Usage: "Clear the last recorded heartbeat details of the activity",
+				},
+			},
+			Action: AdminUnpauseActivity,
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -744,6 +802,80 @@ func newAdminTaskListCommands() []*cli.Command {
 			},
 			Action: AdminUpdateTaskListPartitionConfig,
 		},
+		{
+			Name:    "list-tasks",
+			Aliases: []string{"lt"},
+			Usage:   "List the persisted tasks in the backlog of a tasklist partition",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList Name",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskListType,
+					Aliases: []string{"tlt"},
+					Value:   "decision",
+					Usage:   "Optional TaskList type [decision|activity]",
+				},
+				&cli.IntFlag{
+					Name:  FlagTaskListPartition,
+					Usage: "Optional TaskList partition, default is the root partition",
+				},
+				&cli.BoolFlag{
+					Name:    FlagMore,
+					Aliases: []string{"m"},
+					Usage:   "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   10,
+					Usage:   "Result page size",
+				},
+				getFormatFlag(),
+			},
+			Action: AdminListTaskListTasks,
+		},
+		{
+			Name:    "delete-tasks",
+			Aliases: []string{"dt"},
+			Usage:   "Delete the persisted tasks matching the given filters from the backlog of a tasklist partition",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList Name",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskListType,
+					Aliases: []string{"tlt"},
+					Value:   "decision",
+					Usage:   "Optional TaskList type [decision|activity]",
+				},
+				&cli.IntFlag{
+					Name:  FlagTaskListPartition,
+					Usage: "Optional TaskList partition, default is the root partition",
+				},
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "Delete only the tasks of this WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "Delete only the tasks of this RunID",
+				},
+				&cli.StringFlag{
+					Name: FlagCreatedBefore,
+					Usage: "Delete only the tasks created before this time. Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
+						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
+				},
+			},
+			Action: AdminDeleteTaskListTasks,
+		},
 	}
 }
 
@@ -998,6 +1130,47 @@ func newDBCommands() []*cli.Command {
 
 			Action: AdminDBScanUnsupportedWorkflow,
 		},
+		{
+			Name:  "encryption-report",
+			Usage: "read the mutable state and history of all workflows in a range of shards and report the encryption key versions in use",
+			Flags: append(getDBFlags(),
+				&cli.IntFlag{
+					Name:     FlagLowerShardBound,
+					Usage:    "FlagLowerShardBound for the start shard to scan. (Default: 0)",
+					Value:    0,
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:     FlagUpperShardBound,
+					Usage:    "FlagUpperShardBound for the end shard to scan. (Default: 16383)",
+					Value:    16383,
+					Required: true,
+				},
+				getFormatFlag(),
+			),
+
+			Action: AdminDBEncryptionReport,
+		},
+		{
+			Name:  "reencrypt-history",
+			Usage: "rewrite the history of all workflows in a range of shards which is not encrypted under the current key version",
+			Flags: append(getDBFlags(),
+				&cli.IntFlag{
+					Name:     FlagLowerShardBound,
+					Usage:    "FlagLowerShardBound for the start shard to re-encrypt. (Default: 0)",
+					Value:    0,
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:     FlagUpperShardBound,
+					Usage:    "FlagUpperShardBound for the end shard to re-encrypt. (Default: 16383)",
+					Value:    16383,
+					Required: true,
+				},
+			),
+
+			Action: AdminDBReencryptHistory,
+		},
 		{
 			Name:  "clean",
 			Usage: "clean up corrupted workflows",
@@ -1365,6 +1538,100 @@ func newAdminConfigStoreCommands() []*cli.Command {
 	}
 }
 
+func newAdminShardDistributorCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "cordon",
+			Aliases: []string{"c"},
+			Usage:   "Stop assigning new shards to an executor, the shards it owns are left in place",
+			Flags: append(getShardDistributorFlags(),
+				&cli.StringFlag{
+					Name:     FlagExecutorID,
+					Aliases:  []string{"ex"},
+					Usage:    "ID of the executor to cordon",
+					Required: true,
+				},
+			),
+			Action: AdminCordonExecutor,
+		},
+		{
+			Name:    "uncordon",
+			Aliases: []string{"uc"},
+			Usage:   "Clear the cordon and the drain of an executor",
+			Flags: append(getShardDistributorFlags(),
+				&cli.StringFlag{
+					Name:     FlagExecutorID,
+					Aliases:  []string{"ex"},
+					Usage:    "ID of the executor to uncordon",
+					Required: true,
+				},
+			),
+			Action: AdminUncordonExecutor,
+		},
+		{
+			Name:    "drain",
+			Aliases: []string{"d"},
+			Usage:   "Cordon an executor and move its shards to other executors",
+			Flags: append(getShardDistributorFlags(),
+				&cli.StringFlag{
+					Name:     FlagExecutorID,
+					Aliases:  []string{"ex"},
+					Usage:    "ID of the executor to drain",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxConcurrentMoves,
+					Usage: "Maximum number of shards moving away from the executor at a time, 0 moves all shards at once",
+				},
+			),
+			Action: AdminDrainExecutor,
+		},
+		{
+			Name:    "pin",
+			Aliases: []string{"p"},
+			Usage:   "Keep a shard on the given executor, overriding cordons and drains",
+			Flags: append(getShardDistributorFlags(),
+				&cli.StringFlag{
+					Name:     FlagShardKey,
+					Aliases:  []string{"sk"},
+					Usage:    "Key of the shard to pin",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagExecutorID,
+					Aliases:  []string{"ex"},
+					Usage:    "ID of the executor to pin the shard to",
+					Required: true,
+				},
+			),
+			Action: AdminPinShard,
+		},
+		{
+			Name:    "unpin",
+			Aliases: []string{"up"},
+			Usage:   "Return a pinned shard to the control of the rebalance loop",
+			Flags: append(getShardDistributorFlags(),
+				&cli.StringFlag{
+					Name:     FlagShardKey,
+					Aliases:  []string{"sk"},
+					Usage:    "Key of the shard to unpin",
+					Required: true,
+				},
+			),
+			Action: AdminUnpinShard,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the cordoned and draining executors and the pinned shards of a namespace",
+			Flags: append(getShardDistributorFlags(),
+				getFormatFlag(),
+			),
+			Action: AdminDescribeNamespaceControls,
+		},
+	}
+}
+
 func newAdminIsolationGroupCommands() []*cli.Command {
 	return []*cli.Command{
 		{
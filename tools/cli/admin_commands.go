@@ -712,6 +712,93 @@ func AdminRefreshWorkflowTasks(c *cli.Context) error {
 	return nil
 }
 
+// AdminPauseActivity pauses a pending activity
+func AdminPauseActivity(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	_, err = adminClient.PauseActivity(ctx, &types.PauseActivityRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		ActivityID: activityID,
+		Identity:   getCliIdentity(),
+		Reason:     c.String(FlagReason),
+	})
+	if err != nil {
+		return commoncli.Problem("Pause activity failed", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), "Pause activity succeeded.")
+	return nil
+}
+
+// AdminUnpauseActivity unpauses a paused activity
+func AdminUnpauseActivity(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	_, err = adminClient.UnpauseActivity(ctx, &types.UnpauseActivityRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		ActivityID:            activityID,
+		ResetAttempts:         c.Bool(FlagResetAttempts),
+		ResetHeartbeatDetails: c.Bool(FlagResetHeartbeatDetails),
+		Identity:              getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Unpause activity failed", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), "Unpause activity succeeded.")
+	return nil
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) error {
 	adminClient, err := getDeps(c).ServerAdminClient(c)
@@ -373,6 +373,176 @@ func TestAdminRefreshWorkflowTasks(t *testing.T) {
 	}
 }
 
+func TestAdminPauseActivity(t *testing.T) {
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing activityID argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					/* no activityID argument */
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "all arguments provided",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+					clitest.StringArgument(FlagActivityID, "activity-id"),
+					clitest.StringArgument(FlagReason, "bad deployment"),
+				)
+
+				td.mockAdminClient.EXPECT().PauseActivity(gomock.Any(), &types.PauseActivityRequest{
+					Domain: testDomain,
+					Execution: &types.WorkflowExecution{
+						WorkflowID: testWorkflowID,
+						RunID:      testRunID,
+					},
+					ActivityID: "activity-id",
+					Identity:   getCliIdentity(),
+					Reason:     "bad deployment",
+				}).Return(&types.PauseActivityResponse{}, nil)
+
+				return cliCtx
+			},
+			expectedOutput: "Pause activity succeeded.\n",
+		},
+		{
+			name: "PauseActivity returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagActivityID, "activity-id"),
+				)
+
+				td.mockAdminClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("critical error"))
+
+				return cliCtx
+			},
+			errContains: "Pause activity failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminPauseActivity(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
+func TestAdminUnpauseActivity(t *testing.T) {
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing activityID argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					/* no activityID argument */
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "all arguments provided",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+					clitest.StringArgument(FlagActivityID, "activity-id"),
+					clitest.BoolArgument(FlagResetAttempts, true),
+					clitest.BoolArgument(FlagResetHeartbeatDetails, true),
+				)
+
+				td.mockAdminClient.EXPECT().UnpauseActivity(gomock.Any(), &types.UnpauseActivityRequest{
+					Domain: testDomain,
+					Execution: &types.WorkflowExecution{
+						WorkflowID: testWorkflowID,
+						RunID:      testRunID,
+					},
+					ActivityID:            "activity-id",
+					ResetAttempts:         true,
+					ResetHeartbeatDetails: true,
+					Identity:              getCliIdentity(),
+				}).Return(&types.UnpauseActivityResponse{}, nil)
+
+				return cliCtx
+			},
+			expectedOutput: "Unpause activity succeeded.\n",
+		},
+		{
+			name: "UnpauseActivity returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagActivityID, "activity-id"),
+				)
+
+				td.mockAdminClient.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("critical error"))
+
+				return cliCtx
+			},
+			errContains: "Unpause activity failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminUnpauseActivity(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
 func TestAdminDescribeHistoryHost(t *testing.T) {
 	tests := []struct {
 		name           string
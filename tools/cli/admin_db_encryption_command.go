@@ -0,0 +1,288 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+const encryptionReportPageSize = 1000
+
+// KeyVersionUsageRow is a row of the encryption key usage report
+type KeyVersionUsageRow struct {
+	KeyVersion string `header:"Key Version" json:"keyVersion"`
+	Blobs      int64  `header:"Encrypted Blobs" json:"blobs"`
+	Current    bool   `header:"Current" json:"current"`
+}
+
+// AdminDBEncryptionReport reads the mutable state and history of every workflow in a range of shards,
+// and reports how many encrypted blobs were read under each key version.
+// Key versions which are not reported for any shard are no longer in use and can be removed from the keyring.
+func AdminDBEncryptionReport(c *cli.Context) error {
+	startShardID := c.Int(FlagLowerShardBound)
+	endShardID := c.Int(FlagUpperShardBound)
+
+	historyManager, err := getDeps(c).initializeHistoryManager(c)
+	if err != nil {
+		return commoncli.Problem("initialize history manager:", err)
+	}
+	defer historyManager.Close()
+
+	codecs, err := getDeps(c).initializeBlobCodecs(c)
+	if err != nil {
+		return commoncli.Problem("initialize persistence codecs:", err)
+	}
+	encryptor := codecs.Encryptor
+	if encryptor == nil {
+		return commoncli.Problem(fmt.Sprintf("Persistence encryption is not configured, please set --%v or persistence.encryption in the service configuration", FlagKeyringFile), nil)
+	}
+
+	for shardID := startShardID; shardID <= endShardID; shardID++ {
+		if err := readShardBlobs(c, shardID, historyManager); err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to read shard ID: %v. Please retry.", shardID), err)
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Shard %v scan operation is completed.\n", shardID)
+	}
+
+	table := []KeyVersionUsageRow{}
+	for version, blobs := range encryptor.DecryptedByKeyVersion() {
+		table = append(table, KeyVersionUsageRow{
+			KeyVersion: version,
+			Blobs:      blobs,
+			Current:    version == encryptor.CurrentVersion(),
+		})
+	}
+	sort.Slice(table, func(i, j int) bool {
+		return table[i].KeyVersion < table[j].KeyVersion
+	})
+	return Render(c, table, RenderOptions{Color: true, DefaultTemplate: templateTable})
+}
+
+// AdminDBReencryptHistory rewrites the history nodes of every workflow in a range of shards
+// which are not encrypted under the current key version.
+// Mutable state is re-encrypted when its workflow is next updated.
+func AdminDBReencryptHistory(c *cli.Context) error {
+	startShardID := c.Int(FlagLowerShardBound)
+	endShardID := c.Int(FlagUpperShardBound)
+
+	historyManager, err := getDeps(c).initializeHistoryManager(c)
+	if err != nil {
+		return commoncli.Problem("initialize history manager:", err)
+	}
+	defer historyManager.Close()
+
+	codecs, err := getDeps(c).initializeBlobCodecs(c)
+	if err != nil {
+		return commoncli.Problem("initialize persistence codecs:", err)
+	}
+	if codecs.Encryptor == nil {
+		return commoncli.Problem(fmt.Sprintf("Persistence encryption is not configured, please set --%v or persistence.encryption in the service configuration", FlagKeyringFile), nil)
+	}
+
+	for shardID := startShardID; shardID <= endShardID; shardID++ {
+		reencrypted := 0
+		err := scanShardWorkflows(c, shardID, func(executionManager persistence.ExecutionManager, executionInfo *persistence.WorkflowExecutionInfo) error {
+			nodes, err := reencryptWorkflowHistory(c, shardID, executionInfo, executionManager, historyManager)
+			reencrypted += nodes
+			return err
+		})
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to re-encrypt shard ID: %v. Please retry.", shardID), err)
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Shard %v re-encrypt operation is completed, %v history nodes were rewritten.\n", shardID, reencrypted)
+	}
+	return nil
+}
+
+// readShardBlobs reads every encrypted blob of a shard, through the managers so that they are decrypted.
+func readShardBlobs(c *cli.Context, shardID int, historyManager persistence.HistoryManager) error {
+	return scanShardWorkflows(c, shardID, func(executionManager persistence.ExecutionManager, executionInfo *persistence.WorkflowExecutionInfo) error {
+		return readWorkflowBlobs(c, shardID, executionInfo, executionManager, historyManager)
+	})
+}
+
+// scanShardWorkflows calls fn with every workflow of a shard.
+func scanShardWorkflows(
+	c *cli.Context,
+	shardID int,
+	fn func(persistence.ExecutionManager, *persistence.WorkflowExecutionInfo) error,
+) error {
+	executionManager, err := getDeps(c).initializeExecutionManager(c, shardID)
+	if err != nil {
+		return fmt.Errorf("initialize execution manager: %w", err)
+	}
+	defer executionManager.Close()
+
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		ctx, cancel := context.WithTimeout(c.Context, listContextTimeout)
+		defer cancel()
+
+		resp, err := executionManager.ListConcreteExecutions(
+			ctx,
+			&persistence.ListConcreteExecutionsRequest{
+				PageSize:  encryptionReportPageSize,
+				PageToken: paginationToken,
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		var paginateItems []interface{}
+		for _, execution := range resp.Executions {
+			paginateItems = append(paginateItems, execution)
+		}
+		return paginateItems, resp.PageToken, nil
+	}
+
+	executionIterator := collection.NewPagingIterator(paginationFunc)
+	for executionIterator.HasNext() {
+		result, err := executionIterator.Next()
+		if err != nil {
+			return err
+		}
+		executionInfo := result.(*persistence.ListConcreteExecutionsEntity).ExecutionInfo
+		if executionInfo == nil {
+			continue
+		}
+		if err := fn(executionManager, executionInfo); err != nil {
+			return fmt.Errorf("workflow %v, run %v: %w", executionInfo.WorkflowID, executionInfo.RunID, err)
+		}
+	}
+	return nil
+}
+
+// reencryptWorkflowHistory re-encrypts every history branch of a workflow and returns the number of rewritten nodes.
+func reencryptWorkflowHistory(
+	c *cli.Context,
+	shardID int,
+	executionInfo *persistence.WorkflowExecutionInfo,
+	executionManager persistence.ExecutionManager,
+	historyManager persistence.HistoryManager,
+) (int, error) {
+	ctx, cancel := context.WithTimeout(c.Context, listContextTimeout)
+	defer cancel()
+
+	resp, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: executionInfo.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// deleted since it was listed
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	branchTokens := [][]byte{resp.State.ExecutionInfo.BranchToken}
+	if resp.State.VersionHistories != nil {
+		branchTokens = nil
+		for _, versionHistory := range resp.State.VersionHistories.Histories {
+			branchTokens = append(branchTokens, versionHistory.GetBranchToken())
+		}
+	}
+
+	reencrypted := 0
+	for _, branchToken := range branchTokens {
+		reencryptResp, err := historyManager.ReencryptHistoryBranch(ctx, &persistence.ReencryptHistoryBranchRequest{
+			BranchToken: branchToken,
+			DomainID:    executionInfo.DomainID,
+			PageSize:    encryptionReportPageSize,
+			ShardID:     common.Ptr(shardID),
+		})
+		if err != nil {
+			return reencrypted, err
+		}
+		reencrypted += reencryptResp.ReencryptedNodes
+	}
+	return reencrypted, nil
+}
+
+func readWorkflowBlobs(
+	c *cli.Context,
+	shardID int,
+	executionInfo *persistence.WorkflowExecutionInfo,
+	executionManager persistence.ExecutionManager,
+	historyManager persistence.HistoryManager,
+) error {
+	ctx, cancel := context.WithTimeout(c.Context, listContextTimeout)
+	defer cancel()
+
+	resp, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: executionInfo.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// deleted since it was listed
+			return nil
+		}
+		return err
+	}
+
+	branchToken := resp.State.ExecutionInfo.BranchToken
+	if resp.State.VersionHistories != nil {
+		versionHistory, err := resp.State.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return err
+		}
+		branchToken = versionHistory.GetBranchToken()
+	}
+
+	var pageToken []byte
+	for {
+		history, err := historyManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    constants.FirstEventID,
+			MaxEventID:    constants.EndEventID,
+			PageSize:      encryptionReportPageSize,
+			NextPageToken: pageToken,
+			ShardID:       common.Ptr(shardID),
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				return nil
+			}
+			return err
+		}
+		pageToken = history.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/encryption"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminDBEncryptionReport(t *testing.T) {
+	keys := map[string]string{"1": randomKeyringKey(t), "2": randomKeyringKey(t)}
+	old := encryptTestBlob(t, newTestBlobCodecs(t, keys, "1"))
+	codecs := newTestBlobCodecs(t, keys, "2")
+	current := encryptTestBlob(t, codecs)
+
+	td := newCLITestData(t)
+	td.mockManagerFactory.EXPECT().initializeBlobCodecs(gomock.Any()).Return(codecs, nil).Times(1)
+	mockHistoryManager := persistence.NewMockHistoryManager(td.ctrl)
+	mockHistoryManager.EXPECT().Close().Times(1)
+	td.mockManagerFactory.EXPECT().initializeHistoryManager(gomock.Any()).Return(mockHistoryManager, nil).Times(1)
+
+	mockExecutionManager := persistence.NewMockExecutionManager(td.ctrl)
+	mockExecutionManager.EXPECT().Close().Times(1)
+	td.mockManagerFactory.EXPECT().initializeExecutionManager(gomock.Any(), 3).Return(mockExecutionManager, nil).Times(1)
+
+	execution := createListConcreteExecutionsEntity(1, 3)
+	mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{execution},
+		}, nil).Times(1)
+	mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		DomainID: execution.ExecutionInfo.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: execution.ExecutionInfo.WorkflowID,
+			RunID:      execution.ExecutionInfo.RunID,
+		},
+	}).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{},
+			VersionHistories: &persistence.VersionHistories{
+				Histories: []*persistence.VersionHistory{{BranchToken: []byte("branch-token")}},
+			},
+		},
+	}, nil).Times(1)
+
+	// the history manager decrypts the blobs it reads
+	readPage := func(nextPageToken []byte, blobs ...*persistence.DataBlob) func(context.Context, *persistence.ReadHistoryBranchRequest) (*persistence.ReadRawHistoryBranchResponse, error) {
+		return func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadRawHistoryBranchResponse, error) {
+			assert.Equal(t, []byte("branch-token"), request.BranchToken)
+			assert.Equal(t, 3, *request.ShardID)
+			for _, blob := range blobs {
+				_, err := codecs.Decrypt(blob)
+				require.NoError(t, err)
+			}
+			return &persistence.ReadRawHistoryBranchResponse{NextPageToken: nextPageToken}, nil
+		}
+	}
+	gomock.InOrder(
+		mockHistoryManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(readPage([]byte("next-page"), old, current)),
+		mockHistoryManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(readPage(nil, current)),
+	)
+
+	cliCtx := clitest.NewCLIContext(t, td.app,
+		clitest.IntArgument(FlagLowerShardBound, 3),
+		clitest.IntArgument(FlagUpperShardBound, 3),
+		clitest.StringArgument(FlagFormat, formatJSON),
+	)
+	require.NoError(t, AdminDBEncryptionReport(cliCtx))
+	assert.Equal(t, "Shard 3 scan operation is completed.\n"+
+		`[{"keyVersion":"1","blobs":1,"current":false},{"keyVersion":"2","blobs":2,"current":true}]`+"\n",
+		td.consoleOutput())
+}
+
+func TestAdminDBEncryptionReportNotConfigured(t *testing.T) {
+	td := newCLITestData(t)
+	td.mockManagerFactory.EXPECT().initializeBlobCodecs(gomock.Any()).Return(persistence.BlobCodecs{}, nil).Times(1)
+	mockHistoryManager := persistence.NewMockHistoryManager(td.ctrl)
+	mockHistoryManager.EXPECT().Close().Times(1)
+	td.mockManagerFactory.EXPECT().initializeHistoryManager(gomock.Any()).Return(mockHistoryManager, nil).Times(1)
+
+	cliCtx := clitest.NewCLIContext(t, td.app,
+		clitest.IntArgument(FlagLowerShardBound, 0),
+		clitest.IntArgument(FlagUpperShardBound, 1),
+	)
+	err := AdminDBEncryptionReport(cliCtx)
+	assert.ErrorContains(t, err, "Persistence encryption is not configured")
+}
+
+func TestAdminDBReencryptHistory(t *testing.T) {
+	codecs := newTestBlobCodecs(t, map[string]string{"1": randomKeyringKey(t)}, "1")
+
+	td := newCLITestData(t)
+	td.mockManagerFactory.EXPECT().initializeBlobCodecs(gomock.Any()).Return(codecs, nil).Times(1)
+	mockHistoryManager := persistence.NewMockHistoryManager(td.ctrl)
+	mockHistoryManager.EXPECT().Close().Times(1)
+	td.mockManagerFactory.EXPECT().initializeHistoryManager(gomock.Any()).Return(mockHistoryManager, nil).Times(1)
+
+	mockExecutionManager := persistence.NewMockExecutionManager(td.ctrl)
+	mockExecutionManager.EXPECT().Close().Times(1)
+	td.mockManagerFactory.EXPECT().initializeExecutionManager(gomock.Any(), 3).Return(mockExecutionManager, nil).Times(1)
+
+	execution := createListConcreteExecutionsEntity(1, 3)
+	mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{execution},
+		}, nil).Times(1)
+	mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{},
+			VersionHistories: &persistence.VersionHistories{
+				Histories: []*persistence.VersionHistory{{BranchToken: []byte("branch-1")}, {BranchToken: []byte("branch-2")}},
+			},
+		},
+	}, nil).Times(1)
+
+	// every branch of the workflow is re-encrypted
+	for branchToken, nodes := range map[string]int{"branch-1": 2, "branch-2": 1} {
+		mockHistoryManager.EXPECT().ReencryptHistoryBranch(gomock.Any(), &persistence.ReencryptHistoryBranchRequest{
+			BranchToken: []byte(branchToken),
+			DomainID:    execution.ExecutionInfo.DomainID,
+			PageSize:    encryptionReportPageSize,
+			ShardID:     common.Ptr(3),
+		}).Return(&persistence.ReencryptHistoryBranchResponse{ReencryptedNodes: nodes}, nil).Times(1)
+	}
+
+	cliCtx := clitest.NewCLIContext(t, td.app,
+		clitest.IntArgument(FlagLowerShardBound, 3),
+		clitest.IntArgument(FlagUpperShardBound, 3),
+	)
+	require.NoError(t, AdminDBReencryptHistory(cliCtx))
+	assert.Equal(t, "Shard 3 re-encrypt operation is completed, 3 history nodes were rewritten.\n", td.consoleOutput())
+}
+
+func encryptTestBlob(t *testing.T, codecs persistence.BlobCodecs) *persistence.DataBlob {
+	blob, err := codecs.Encrypt("domain-id", persistence.NewDataBlob([]byte("events"), constants.EncodingTypeThriftRW))
+	require.NoError(t, err)
+	return blob
+}
+
+func randomKeyringKey(t *testing.T) string {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// newTestBlobCodecs returns codecs encrypting with the given keys.
+func newTestBlobCodecs(t *testing.T, keys map[string]string, currentVersion string) persistence.BlobCodecs {
+	provider, err := encryption.NewKeyringProvider(encryption.Keyring{CurrentVersion: currentVersion, Keys: keys})
+	require.NoError(t, err)
+	return persistence.BlobCodecs{Encryptor: encryption.NewEncryptor(provider)}
+}
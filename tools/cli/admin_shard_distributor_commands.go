@@ -0,0 +1,195 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/client/sharddistributor"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// ExecutorControlRow is a row of the executors table printed by the shard distributor describe command
+type ExecutorControlRow struct {
+	ExecutorID         string `header:"Executor ID"`
+	Cordoned           bool   `header:"Cordoned"`
+	Draining           bool   `header:"Draining"`
+	MaxConcurrentMoves int32  `header:"Max Concurrent Moves"`
+	AssignedShards     int32  `header:"Assigned Shards"`
+}
+
+// ShardPinRow is a row of the pinned shards table printed by the shard distributor describe command
+type ShardPinRow struct {
+	ShardKey   string `header:"Shard Key"`
+	ExecutorID string `header:"Executor ID"`
+}
+
+// AdminCordonExecutor stops the shard distributor from assigning new shards to an executor
+func AdminCordonExecutor(c *cli.Context) error {
+	namespace := c.String(FlagNamespace)
+	executorID := c.String(FlagExecutorID)
+	err := withShardDistributorClient(c, func(ctx context.Context, sdClient sharddistributor.Client) error {
+		return sdClient.CordonExecutor(ctx, &types.CordonExecutorRequest{
+			Namespace:  namespace,
+			ExecutorID: executorID,
+		})
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to cordon executor", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Successfully cordoned executor %v in namespace %v.\n", executorID, namespace)
+	return nil
+}
+
+// AdminUncordonExecutor clears the cordon and the drain of an executor
+func AdminUncordonExecutor(c *cli.Context) error {
+	namespace := c.String(FlagNamespace)
+	executorID := c.String(FlagExecutorID)
+	err := withShardDistributorClient(c, func(ctx context.Context, sdClient sharddistributor.Client) error {
+		return sdClient.UncordonExecutor(ctx, &types.UncordonExecutorRequest{
+			Namespace:  namespace,
+			ExecutorID: executorID,
+		})
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to uncordon executor", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Successfully uncordoned executor %v in namespace %v.\n", executorID, namespace)
+	return nil
+}
+
+// AdminDrainExecutor cordons an executor and makes the shard distributor move its shards away
+func AdminDrainExecutor(c *cli.Context) error {
+	namespace := c.String(FlagNamespace)
+	executorID := c.String(FlagExecutorID)
+	maxConcurrentMoves := c.Int(FlagMaxConcurrentMoves)
+	if maxConcurrentMoves < 0 {
+		return commoncli.Problem(fmt.Sprintf("--%v must not be negative", FlagMaxConcurrentMoves), nil)
+	}
+	err := withShardDistributorClient(c, func(ctx context.Context, sdClient sharddistributor.Client) error {
+		return sdClient.DrainExecutor(ctx, &types.DrainExecutorRequest{
+			Namespace:          namespace,
+			ExecutorID:         executorID,
+			MaxConcurrentMoves: int32(maxConcurrentMoves),
+		})
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to drain executor", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Successfully started draining executor %v in namespace %v.\n", executorID, namespace)
+	return nil
+}
+
+// AdminPinShard pins a shard to an executor
+func AdminPinShard(c *cli.Context) error {
+	namespace := c.String(FlagNamespace)
+	shardKey := c.String(FlagShardKey)
+	executorID := c.String(FlagExecutorID)
+	err := withShardDistributorClient(c, func(ctx context.Context, sdClient sharddistributor.Client) error {
+		return sdClient.PinShard(ctx, &types.PinShardRequest{
+			Namespace:  namespace,
+			ShardKey:   shardKey,
+			ExecutorID: executorID,
+		})
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to pin shard", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Successfully pinned shard %v to executor %v in namespace %v.\n", shardKey, executorID, namespace)
+	return nil
+}
+
+// AdminUnpinShard removes the pin of a shard
+func AdminUnpinShard(c *cli.Context) error {
+	namespace := c.String(FlagNamespace)
+	shardKey := c.String(FlagShardKey)
+	err := withShardDistributorClient(c, func(ctx context.Context, sdClient sharddistributor.Client) error {
+		return sdClient.UnpinShard(ctx, &types.UnpinShardRequest{
+			Namespace: namespace,
+			ShardKey:  shardKey,
+		})
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to unpin shard", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Successfully unpinned shard %v in namespace %v.\n", shardKey, namespace)
+	return nil
+}
+
+// AdminDescribeNamespaceControls prints the controls of the executors and the pinned shards of a namespace
+func AdminDescribeNamespaceControls(c *cli.Context) error {
+	var resp *types.DescribeNamespaceControlsResponse
+	err := withShardDistributorClient(c, func(ctx context.Context, sdClient sharddistributor.Client) (err error) {
+		resp, err = sdClient.DescribeNamespaceControls(ctx, &types.DescribeNamespaceControlsRequest{
+			Namespace: c.String(FlagNamespace),
+		})
+		return err
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe namespace controls", err)
+	}
+
+	executors := make([]ExecutorControlRow, 0, len(resp.Executors))
+	for _, executor := range resp.Executors {
+		executors = append(executors, ExecutorControlRow{
+			ExecutorID:         executor.ExecutorID,
+			Cordoned:           executor.Cordoned,
+			Draining:           executor.Draining,
+			MaxConcurrentMoves: executor.MaxConcurrentMoves,
+			AssignedShards:     executor.AssignedShards,
+		})
+	}
+	pins := make([]ShardPinRow, 0, len(resp.PinnedShards))
+	for _, pin := range resp.PinnedShards {
+		pins = append(pins, ShardPinRow{
+			ShardKey:   pin.ShardKey,
+			ExecutorID: pin.ExecutorID,
+		})
+	}
+
+	opts := RenderOptions{DefaultTemplate: templateTable, Color: true}
+	if err := Render(c, executors, opts); err != nil {
+		return fmt.Errorf("error rendering: %w", err)
+	}
+	if len(pins) == 0 {
+		return nil
+	}
+	return Render(c, pins, opts)
+}
+
+func withShardDistributorClient(c *cli.Context, fn func(ctx context.Context, sdClient sharddistributor.Client) error) error {
+	sdClient, err := getDeps(c).ShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return fmt.Errorf("error in creating context: %w", err)
+	}
+	defer cancel()
+	return fn(ctx, sdClient)
+}
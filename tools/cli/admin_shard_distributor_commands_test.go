@@ -0,0 +1,158 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/sharddistributor"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminShardDistributorCommands(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdline       string
+		setupMocks    func(*sharddistributor.MockClient)
+		expectedError string
+		expectedStr   []string
+	}{
+		{
+			name:    "cordon",
+			cmdline: "cadence admin shard-distributor cordon --namespace test-ns --executor_id exec-1",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().CordonExecutor(gomock.Any(), &types.CordonExecutorRequest{Namespace: "test-ns", ExecutorID: "exec-1"}).Return(nil)
+			},
+			expectedStr: []string{"Successfully cordoned executor exec-1"},
+		},
+		{
+			name:    "cordon failure",
+			cmdline: "cadence admin sd cordon --ns test-ns --ex exec-1",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().CordonExecutor(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "executor is not registered"})
+			},
+			expectedError: "Failed to cordon executor",
+		},
+		{
+			name:          "cordon without executor",
+			cmdline:       "cadence admin shard-distributor cordon --namespace test-ns",
+			setupMocks:    func(client *sharddistributor.MockClient) {},
+			expectedError: FlagExecutorID,
+		},
+		{
+			name:    "uncordon",
+			cmdline: "cadence admin shard-distributor uncordon --namespace test-ns --executor_id exec-1",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().UncordonExecutor(gomock.Any(), &types.UncordonExecutorRequest{Namespace: "test-ns", ExecutorID: "exec-1"}).Return(nil)
+			},
+			expectedStr: []string{"Successfully uncordoned executor exec-1"},
+		},
+		{
+			name:    "drain",
+			cmdline: "cadence admin shard-distributor drain --namespace test-ns --executor_id exec-1 --max_concurrent_moves 5",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().DrainExecutor(gomock.Any(), &types.DrainExecutorRequest{Namespace: "test-ns", ExecutorID: "exec-1", MaxConcurrentMoves: 5}).Return(nil)
+			},
+			expectedStr: []string{"Successfully started draining executor exec-1"},
+		},
+		{
+			name:          "drain with negative max concurrent moves",
+			cmdline:       "cadence admin shard-distributor drain --namespace test-ns --executor_id exec-1 --max_concurrent_moves -1",
+			setupMocks:    func(client *sharddistributor.MockClient) {},
+			expectedError: "must not be negative",
+		},
+		{
+			name:    "pin",
+			cmdline: "cadence admin shard-distributor pin --namespace test-ns --shard_key shard-1 --executor_id exec-1",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().PinShard(gomock.Any(), &types.PinShardRequest{Namespace: "test-ns", ShardKey: "shard-1", ExecutorID: "exec-1"}).Return(nil)
+			},
+			expectedStr: []string{"Successfully pinned shard shard-1 to executor exec-1"},
+		},
+		{
+			name:    "unpin",
+			cmdline: "cadence admin shard-distributor unpin --namespace test-ns --shard_key shard-1",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().UnpinShard(gomock.Any(), &types.UnpinShardRequest{Namespace: "test-ns", ShardKey: "shard-1"}).Return(nil)
+			},
+			expectedStr: []string{"Successfully unpinned shard shard-1"},
+		},
+		{
+			name:    "unpin failure",
+			cmdline: "cadence admin shard-distributor unpin --namespace test-ns --shard_key shard-1",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().UnpinShard(gomock.Any(), gomock.Any()).Return(errors.New("shard is not pinned"))
+			},
+			expectedError: "Failed to unpin shard",
+		},
+		{
+			name:    "describe",
+			cmdline: "cadence admin shard-distributor describe --namespace test-ns",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().DescribeNamespaceControls(gomock.Any(), &types.DescribeNamespaceControlsRequest{Namespace: "test-ns"}).Return(&types.DescribeNamespaceControlsResponse{
+					Executors: []*types.ExecutorControl{
+						{ExecutorID: "exec-1", Draining: true, MaxConcurrentMoves: 2, AssignedShards: 3},
+						{ExecutorID: "exec-2", Cordoned: true},
+					},
+					PinnedShards: []*types.ShardPin{{ShardKey: "shard-1", ExecutorID: "exec-2"}},
+				}, nil)
+			},
+			expectedStr: []string{"EXECUTOR ID", "exec-1", "exec-2", "SHARD KEY", "shard-1"},
+		},
+		{
+			name:    "describe failure",
+			cmdline: "cadence admin shard-distributor describe --namespace test-ns",
+			setupMocks: func(client *sharddistributor.MockClient) {
+				client.EXPECT().DescribeNamespaceControls(gomock.Any(), gomock.Any()).Return(nil, &types.NamespaceNotFoundError{Namespace: "test-ns"})
+			},
+			expectedError: "Failed to describe namespace controls",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			sdClient := sharddistributor.NewMockClient(mockCtrl)
+			tt.setupMocks(sdClient)
+			ioHandler := &testIOHandler{}
+
+			app := NewCliApp(&clientFactoryMock{
+				shardDistributor: sdClient,
+			}, WithIOHandler(ioHandler))
+
+			err := clitest.RunCommandLine(t, app, tt.cmdline)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			for _, expected := range tt.expectedStr {
+				assert.Contains(t, ioHandler.outputBytes.String(), expected)
+			}
+		})
+	}
+}
@@ -60,6 +60,16 @@ type (
 		ReadPartitions  map[int]*types.TaskListPartition `header:"Read Partitions"`
 		WritePartitions map[int]*types.TaskListPartition `header:"Write Partitions"`
 	}
+	TaskListTaskRow struct {
+		TaskID         int64  `header:"Task ID"`
+		WorkflowID     string `header:"Workflow ID"`
+		RunID          string `header:"Run ID"`
+		ScheduleID     int64  `header:"Schedule ID"`
+		CreatedTime    string `header:"Created Time"`
+		ExpiryTime     string `header:"Expiry Time"`
+		IsolationGroup string `header:"Isolation Group"`
+		Priority       int32  `header:"Priority"`
+	}
 )
 
 // AdminDescribeTaskList displays poller and status information of task list.
@@ -285,6 +295,127 @@ func AdminUpdateTaskListPartitionConfig(c *cli.Context) error {
 	return nil
 }
 
+// AdminListTaskListTasks pages through the persisted backlog of a task list partition.
+func AdminListTaskListTasks(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskListType, err := getTaskListType(c)
+	if err != nil {
+		return err
+	}
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		return commoncli.Problem(fmt.Sprintf("Invalid page size %d: must be larger than 0", pageSize), nil)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+
+	output := getDeps(c).Output()
+	request := &types.ListTaskListTasksRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: getPartitionTaskListName(taskList, c.Int(FlagTaskListPartition))},
+		TaskListType: taskListType.Ptr(),
+		PageSize:     int32(pageSize),
+	}
+	for {
+		response, err := adminClient.ListTaskListTasks(ctx, request)
+		if err != nil {
+			return commoncli.Problem("Operation ListTaskListTasks failed.", err)
+		}
+		if err := Render(c, newTaskListTaskRows(response.GetTasks()), RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true}); err != nil {
+			return fmt.Errorf("failed to render task list tasks: %w", err)
+		}
+		if len(response.GetNextPageToken()) == 0 || !c.Bool(FlagMore) || !showNextPage(output) {
+			return nil
+		}
+		request.NextPageToken = response.GetNextPageToken()
+	}
+}
+
+// AdminDeleteTaskListTasks deletes the tasks matching the given filters from the backlog of a task list partition.
+func AdminDeleteTaskListTasks(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskListType, err := getTaskListType(c)
+	if err != nil {
+		return err
+	}
+	request := &types.DeleteTaskListTasksRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: getPartitionTaskListName(taskList, c.Int(FlagTaskListPartition))},
+		TaskListType: taskListType.Ptr(),
+		WorkflowID:   c.String(FlagWorkflowID),
+		RunID:        c.String(FlagRunID),
+	}
+	if c.IsSet(FlagCreatedBefore) {
+		createdBefore, err := parseTime(c.String(FlagCreatedBefore), 0)
+		if err != nil {
+			return commoncli.Problem("Invalid created before time: ", err)
+		}
+		request.CreatedBefore = &createdBefore
+	}
+	if request.WorkflowID == "" && request.RunID == "" && request.CreatedBefore == nil {
+		return commoncli.Problem(fmt.Sprintf("At least one of --%s, --%s or --%s must be provided", FlagWorkflowID, FlagRunID, FlagCreatedBefore), nil)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+
+	response, err := adminClient.DeleteTaskListTasks(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Operation DeleteTaskListTasks failed.", err)
+	}
+	_, _ = fmt.Fprintf(getDeps(c).Output(), "Deleted %d tasks from %s:%s\n", response.GetDeletedCount(), request.TaskList.GetName(), taskListType)
+	return nil
+}
+
+func newTaskListTaskRows(tasks []*types.TaskListTask) []TaskListTaskRow {
+	rows := make([]TaskListTaskRow, 0, len(tasks))
+	for _, task := range tasks {
+		row := TaskListTaskRow{
+			TaskID:         task.GetTaskID(),
+			WorkflowID:     task.GetWorkflowID(),
+			RunID:          task.GetRunID(),
+			ScheduleID:     task.GetScheduleID(),
+			IsolationGroup: task.GetIsolationGroup(),
+			Priority:       task.GetPriority(),
+		}
+		if task.CreatedTime != nil {
+			row.CreatedTime = timestampToString(*task.CreatedTime, false)
+		}
+		if task.ExpiryTime != nil {
+			row.ExpiryTime = timestampToString(*task.ExpiryTime, false)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
 func validateChange(ctx context.Context, client frontend.Client, domain string, tl *types.TaskList, tlt *types.TaskListType, newCfg *types.TaskListPartitionConfig) (bool, error) {
 	description, err := client.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
 		Domain:       domain,
@@ -349,3 +480,14 @@ func getTaskListTypes(c *cli.Context) ([]types.TaskListType, error) {
 	}
 	return taskListTypes, nil
 }
+
+func getTaskListType(c *cli.Context) (types.TaskListType, error) {
+	switch strings.ToLower(c.String(FlagTaskListType)) {
+	case "decision", "":
+		return types.TaskListTypeDecision, nil
+	case "activity":
+		return types.TaskListTypeActivity, nil
+	default:
+		return types.TaskListTypeDecision, commoncli.Problem("Invalid task list type: valid types are 'activity' or 'decision'", nil)
+	}
+}
@@ -35,6 +35,7 @@ import (
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/tools/cli/clitest"
 )
@@ -836,3 +837,241 @@ func TestAdminUpdateTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminListTaskListTasks(t *testing.T) {
+	createdTime := int64(1700000000000000000)
+	expectedResponse := &types.ListTaskListTasksResponse{
+		Tasks: []*types.TaskListTask{
+			{
+				WorkflowID:     "test-workflow-id",
+				RunID:          "test-run-id",
+				TaskID:         10,
+				ScheduleID:     5,
+				CreatedTime:    &createdTime,
+				IsolationGroup: "zone-a",
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		setupMocks    func(*admin.MockClient)
+		arguments     []clitest.CliArgument
+		assertions    func(t *testing.T, td *cliTestData)
+		expectedError string
+	}{
+		{
+			name: "Success",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().
+					ListTaskListTasks(gomock.Any(), &types.ListTaskListTasksRequest{
+						Domain:       testDomain,
+						TaskList:     &types.TaskList{Name: "/__cadence_sys/test-tasklist/2"},
+						TaskListType: types.TaskListTypeActivity.Ptr(),
+						PageSize:     10,
+					}).
+					Return(expectedResponse, nil).
+					Times(1)
+			},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.StringArgument(FlagTaskListType, "activity"),
+				clitest.IntArgument(FlagTaskListPartition, 2),
+				clitest.IntArgument(FlagPageSize, 10),
+			},
+			assertions: func(t *testing.T, td *cliTestData) {
+				output := td.consoleOutput()
+				assert.Contains(t, output, "test-workflow-id")
+				assert.Contains(t, output, "zone-a")
+			},
+		},
+		{
+			name: "Success - json",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().
+					ListTaskListTasks(gomock.Any(), gomock.Any()).
+					Return(expectedResponse, nil).
+					Times(1)
+			},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.IntArgument(FlagPageSize, 10),
+				clitest.StringArgument(FlagFormat, formatJSON),
+			},
+			assertions: func(t *testing.T, td *cliTestData) {
+				var result []TaskListTaskRow
+				require.NoError(t, json.Unmarshal([]byte(td.consoleOutput()), &result))
+				assert.Equal(t, newTaskListTaskRows(expectedResponse.Tasks), result)
+			},
+		},
+		{
+			name: "ListTaskListTasksFails",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().
+					ListTaskListTasks(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("ListTaskListTasks failed")).
+					Times(1)
+			},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.IntArgument(FlagPageSize, 10),
+			},
+			expectedError: "Operation ListTaskListTasks failed",
+		},
+		{
+			name:       "InvalidPageSize",
+			setupMocks: func(client *admin.MockClient) {},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+			},
+			expectedError: "Invalid page size 0",
+		},
+		{
+			name:       "NoTaskListFlag",
+			setupMocks: func(client *admin.MockClient) {},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+			},
+			expectedError: "Required flag not found",
+		},
+		{
+			name:       "InvalidTaskListType",
+			setupMocks: func(client *admin.MockClient) {},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.StringArgument(FlagTaskListType, "invalid"),
+			},
+			expectedError: "Invalid task list type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMocks(td.mockAdminClient)
+
+			cliCtx := clitest.NewCLIContext(t, td.app, tt.arguments...)
+
+			err := AdminListTaskListTasks(cliCtx)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+
+			if tt.assertions != nil {
+				tt.assertions(t, td)
+			}
+		})
+	}
+}
+
+func TestAdminDeleteTaskListTasks(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*admin.MockClient)
+		arguments     []clitest.CliArgument
+		assertions    func(t *testing.T, td *cliTestData)
+		expectedError string
+	}{
+		{
+			name: "Success",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().
+					DeleteTaskListTasks(gomock.Any(), &types.DeleteTaskListTasksRequest{
+						Domain:       testDomain,
+						TaskList:     &types.TaskList{Name: "test-tasklist"},
+						TaskListType: types.TaskListTypeDecision.Ptr(),
+						WorkflowID:   "test-workflow-id",
+					}).
+					Return(&types.DeleteTaskListTasksResponse{DeletedCount: 3}, nil).
+					Times(1)
+			},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.StringArgument(FlagWorkflowID, "test-workflow-id"),
+			},
+			assertions: func(t *testing.T, td *cliTestData) {
+				assert.Contains(t, td.consoleOutput(), "Deleted 3 tasks from test-tasklist:Decision")
+			},
+		},
+		{
+			name: "Success - created before",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().
+					DeleteTaskListTasks(gomock.Any(), &types.DeleteTaskListTasksRequest{
+						Domain:        testDomain,
+						TaskList:      &types.TaskList{Name: "test-tasklist"},
+						TaskListType:  types.TaskListTypeDecision.Ptr(),
+						CreatedBefore: common.Int64Ptr(1700000000000000000),
+					}).
+					Return(&types.DeleteTaskListTasksResponse{DeletedCount: 1}, nil).
+					Times(1)
+			},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.StringArgument(FlagCreatedBefore, "1700000000000000000"),
+			},
+		},
+		{
+			name: "DeleteTaskListTasksFails",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().
+					DeleteTaskListTasks(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("DeleteTaskListTasks failed")).
+					Times(1)
+			},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.StringArgument(FlagRunID, "test-run-id"),
+			},
+			expectedError: "Operation DeleteTaskListTasks failed",
+		},
+		{
+			name:       "NoFilter",
+			setupMocks: func(client *admin.MockClient) {},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+			},
+			expectedError: "At least one of",
+		},
+		{
+			name:       "InvalidCreatedBefore",
+			setupMocks: func(client *admin.MockClient) {},
+			arguments: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, "test-tasklist"),
+				clitest.StringArgument(FlagCreatedBefore, "invalid"),
+			},
+			expectedError: "Invalid created before time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMocks(td.mockAdminClient)
+
+			cliCtx := clitest.NewCLIContext(t, td.app, tt.arguments...)
+
+			err := AdminDeleteTaskListTasks(cliCtx)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+
+			if tt.assertions != nil {
+				tt.assertions(t, td)
+			}
+		})
+	}
+}
@@ -239,6 +239,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "shard-distributor",
+					Aliases:     []string{"sd"},
+					Usage:       "Run admin operation on shard distributor executors and shards",
+					Subcommands: newAdminShardDistributorCommands(),
+				},
 			},
 		},
 		{
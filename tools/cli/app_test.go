@@ -39,6 +39,7 @@ import (
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/sharddistributor"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
@@ -68,6 +69,7 @@ var _ ClientFactory = (*clientFactoryMock)(nil)
 type clientFactoryMock struct {
 	serverFrontendClient frontend.Client
 	serverAdminClient    admin.Client
+	shardDistributor     sharddistributor.Client
 	config               *config.Config
 }
 
@@ -91,6 +93,10 @@ func (m *clientFactoryMock) ElasticSearchClient(c *cli.Context) (*elastic.Client
 	panic("not implemented")
 }
 
+func (m *clientFactoryMock) ShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	return m.shardDistributor, nil
+}
+
 func (m *clientFactoryMock) ServerConfig(c *cli.Context) (*config.Config, error) {
 	if m.config != nil {
 		return m.config, nil
@@ -686,6 +692,47 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDescribeTaskList_WithBuildIDs() {
+	resp := &types.DescribeTaskListResponse{
+		Pollers: []*types.PollerInfo{
+			{Identity: "worker-2", BuildID: "build-2", LastAccessTime: common.Int64Ptr(time.Now().UnixNano())},
+			{Identity: "worker-1", BuildID: "build-1", LastAccessTime: common.Int64Ptr(time.Now().UnixNano())},
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateTaskListBuildIDCompatibility() {
+	s.serverFrontendClient.EXPECT().UpdateTaskListBuildIDCompatibility(gomock.Any(), &types.UpdateTaskListBuildIDCompatibilityRequest{
+		Domain:            domainName,
+		TaskList:          &types.TaskList{Name: "test-taskList"},
+		BuildID:           "build-2",
+		CompatibleBuildID: "build-1",
+	}).Return(&types.UpdateTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1", "build-2"}}},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "update-build-id-compatibility", "-tl", "test-taskList", "--build_id", "build-2", "--compatible_build_id", "build-1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateTaskListBuildIDCompatibility_MissingBuildID() {
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "update-build-id-compatibility", "-tl", "test-taskList"})
+	s.Error(err)
+}
+
+func (s *cliAppSuite) TestGetTaskListBuildIDCompatibility() {
+	s.serverFrontendClient.EXPECT().GetTaskListBuildIDCompatibility(gomock.Any(), &types.GetTaskListBuildIDCompatibilityRequest{
+		Domain:   domainName,
+		TaskList: &types.TaskList{Name: "test-taskList"},
+	}).Return(&types.GetTaskListBuildIDCompatibilityResponse{
+		CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}, {BuildIDs: []string{"build-2"}}},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "gbc", "-tl", "test-taskList"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
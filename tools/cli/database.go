@@ -150,6 +150,10 @@ func getDBFlags() []cli.Flag {
 			Usage: "target rps of database queries",
 			Value: 100,
 		},
+		&cli.StringFlag{
+			Name:  FlagKeyringFile,
+			Usage: "keyring used to read encrypted data, overrides persistence.encryption.keyringFile of the service configuration",
+		},
 	}
 }
 
@@ -159,6 +163,7 @@ type ManagerFactory interface {
 	initializeShardManager(c *cli.Context) (persistence.ShardManager, error)
 	initializeDomainManager(c *cli.Context) (persistence.DomainManager, error)
 	initPersistenceFactory(c *cli.Context) (client.Factory, error)
+	initializeBlobCodecs(c *cli.Context) (persistence.BlobCodecs, error)
 	initializeInvariantManager(ivs []invariant.Invariant) (invariant.Manager, error)
 }
 
@@ -214,6 +219,14 @@ func (f *defaultManagerFactory) initializeDomainManager(c *cli.Context) (persist
 	return domainManager, nil
 }
 
+func (f *defaultManagerFactory) initializeBlobCodecs(c *cli.Context) (persistence.BlobCodecs, error) {
+	factory, err := f.getPersistenceFactory(c)
+	if err != nil {
+		return persistence.BlobCodecs{}, fmt.Errorf("Failed to get persistence factory: %w", err)
+	}
+	return factory.BlobCodecs(), nil
+}
+
 func (f *defaultManagerFactory) getPersistenceFactory(c *cli.Context) (client.Factory, error) {
 	var err error
 	if f.persistenceFactory == nil {
@@ -250,6 +263,10 @@ func (f *defaultManagerFactory) initPersistenceFactory(c *cli.Context) (client.F
 	}
 	cfg.Persistence.DataStores[cfg.Persistence.DefaultStore] = defaultStore
 
+	if c.IsSet(FlagKeyringFile) {
+		cfg.Persistence.Encryption = &config.PersistenceEncryption{KeyringFile: c.String(FlagKeyringFile)}
+	}
+
 	cfg.Persistence.TransactionSizeLimit = dynamicproperties.GetIntPropertyFn(constants.DefaultTransactionSizeLimit)
 	cfg.Persistence.ErrorInjectionRate = dynamicproperties.GetFloatPropertyFn(0.0)
 
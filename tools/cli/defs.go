@@ -34,6 +34,8 @@ const (
 	tchannelPort = localHost + ":7933"
 	grpcPort     = localHost + ":7833"
 
+	shardDistributorGRPCPort = localHost + ":7943"
+
 	grpcTransport   = "grpc"
 	thriftTransport = "tchannel"
 
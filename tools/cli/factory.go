@@ -45,13 +45,16 @@ import (
 
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	sharddistributorv1 "github.com/uber/cadence/.gen/proto/sharddistributor/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/sharddistributor"
 	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
 	"github.com/uber/cadence/client/wrappers/thrift"
 	"github.com/uber/cadence/common"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
@@ -81,12 +84,16 @@ type ClientFactory interface {
 
 	ElasticSearchClient(c *cli.Context) (*elastic.Client, error)
 
+	// ShardDistributorClient builds a client of the shard distributor service, which is only served over gRPC
+	ShardDistributorClient(c *cli.Context) (sharddistributor.Client, error)
+
 	ServerConfig(c *cli.Context) (*config.Config, error)
 }
 
 type clientFactory struct {
 	dispatcher          *yarpc.Dispatcher // lazy, via ensureDispatcher
 	dispatcherMigration *yarpc.Dispatcher // lazy, via ensureDispatcherForMigration
+	dispatcherSD        *yarpc.Dispatcher // lazy, via ensureDispatcherForShardDistributor
 	logger              *zap.Logger
 }
 
@@ -182,6 +189,16 @@ func (b *clientFactory) ServerAdminClientForMigration(c *cli.Context) (admin.Cli
 	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
 }
 
+// ShardDistributorClient builds a shard distributor client (based on server side proto interface)
+func (b *clientFactory) ShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	err := b.ensureDispatcherForShardDistributor(c)
+	if err != nil {
+		return nil, commoncli.Problem("failed to create shard distributor client dependency", err)
+	}
+	clientConfig := b.dispatcherSD.ClientConfig(service.ShardDistributor)
+	return grpcClient.NewShardDistributorClient(sharddistributorv1.NewShardDistributorAPIYARPCClient(clientConfig)), nil
+}
+
 // ElasticSearchClient builds an ElasticSearch client
 func (b *clientFactory) ElasticSearchClient(c *cli.Context) (*elastic.Client, error) {
 
@@ -237,6 +254,25 @@ func (b *clientFactory) ensureDispatcherForMigration(c *cli.Context) error {
 	return nil
 }
 
+func (b *clientFactory) ensureDispatcherForShardDistributor(c *cli.Context) error {
+	if b.dispatcherSD != nil {
+		return nil
+	}
+	hostPort := shardDistributorGRPCPort
+	if c.String(FlagShardDistributorAddress) != "" {
+		hostPort = c.String(FlagShardDistributorAddress)
+	}
+	d, err := b.newDispatcher(c, hostPort, service.ShardDistributor, true)
+	if err != nil {
+		return commoncli.Problem(
+			fmt.Sprintf("failed to create dispatcher (for --%v %q)", FlagShardDistributorAddress, c.String(FlagShardDistributorAddress)),
+			err,
+		)
+	}
+	b.dispatcherSD = d
+	return nil
+}
+
 func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride string) (*yarpc.Dispatcher, error) {
 	shouldUseGrpc := c.String(FlagTransport) == grpcTransport
 
@@ -247,6 +283,10 @@ func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride str
 	if hostPortOverride != "" {
 		hostPort = hostPortOverride
 	}
+	return b.newDispatcher(c, hostPort, cadenceFrontendService, shouldUseGrpc)
+}
+
+func (b *clientFactory) newDispatcher(c *cli.Context, hostPort string, serviceName string, shouldUseGrpc bool) (*yarpc.Dispatcher, error) {
 	var outbounds transport.Outbounds
 	if shouldUseGrpc {
 		grpcTransport := grpc.NewTransport()
@@ -282,7 +322,7 @@ func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride str
 
 	dispatcher := yarpc.NewDispatcher(yarpc.Config{
 		Name:      cadenceClientName,
-		Outbounds: yarpc.Outbounds{cadenceFrontendService: outbounds},
+		Outbounds: yarpc.Outbounds{serviceName: outbounds},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
 			Unary: &versionMiddleware{},
 		},
@@ -127,6 +127,7 @@ const (
 	FlagVisibilityArchivalStatus       = "visibility_archival_status"
 	FlagVisibilityArchivalURI          = "visibility_uri"
 	FlagName                           = "name"
+	FlagUpdateID                       = "update_id"
 	FlagOutputFilename                 = "output_filename"
 	FlagOutputFormat                   = "output"
 	FlagQueryType                      = "query_type"
@@ -142,6 +143,8 @@ const (
 	FlagDomainData                     = "domain_data"
 	FlagEventID                        = "event_id"
 	FlagActivityID                     = "activity_id"
+	FlagResetAttempts                  = "reset_attempts"
+	FlagResetHeartbeatDetails          = "reset_heartbeat_details"
 	FlagMaxFieldLength                 = "max_field_length"
 	FlagSecurityToken                  = "security_token"
 	FlagSkipErrorMode                  = "skip_errors"
@@ -186,6 +189,7 @@ const (
 	FlagTLSKeyPath                     = "tls_key_path"
 	FlagTLSCaPath                      = "tls_ca_path"
 	FlagTLSEnableHostVerification      = "tls_enable_host_verification"
+	FlagKeyringFile                    = "keyring_file"
 	FlagDLQType                        = "dlq_type"
 	FlagMaxMessageCount                = "max_message_count"
 	FlagLastMessageID                  = "last_message_id"
@@ -198,6 +202,8 @@ const (
 	FlagFailoverType                   = "failover_type"
 	FlagFailoverTimeout                = "failover_timeout_seconds"
 	FlagActivityHeartBeatTimeout       = "heart_beat_timeout_seconds"
+	FlagScheduleToCloseTimeout         = "schedule_to_close_timeout_seconds"
+	FlagStartToCloseTimeout            = "start_to_close_timeout_seconds"
 	FlagFailoverWaitTime               = "failover_wait_time_second"
 	FlagFailoverBatchSize              = "failover_batch_size"
 	FlagFailoverDomains                = "domains"
@@ -234,10 +240,19 @@ const (
 	FlagSearchAttribute                = "search_attr"
 	FlagNumReadPartitions              = "num_read_partitions"
 	FlagNumWritePartitions             = "num_write_partitions"
+	FlagTaskListPartition              = "partition"
+	FlagCreatedBefore                  = "created_before"
+	FlagBuildID                        = "build_id"
+	FlagCompatibleBuildID              = "compatible_build_id"
 	FlagCronOverlapPolicy              = "cron_overlap_policy"
 	FlagClusterAttributeScope          = "cluster_attribute_scope"
 	FlagClusterAttributeName           = "cluster_attribute_name"
 	FlagBatchV2                        = "v2"
+	FlagShardDistributorAddress        = "shard_distributor_address"
+	FlagNamespace                      = "namespace"
+	FlagExecutorID                     = "executor_id"
+	FlagShardKey                       = "shard_key"
+	FlagMaxConcurrentMoves             = "max_concurrent_moves"
 
 	FlagClustersUsage = "Clusters (example: --clusters clusterA,clusterB or --cl clusterA --cl clusterB)"
 )
@@ -517,6 +532,40 @@ func getFlagsForSignal() []cli.Flag {
 	}
 }
 
+func getFlagsForUpdate() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: []string{"w", "wid"},
+			Usage:   "WorkflowID",
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: []string{"r", "rid"},
+			Usage:   "RunID",
+		},
+		&cli.StringFlag{
+			Name:    FlagName,
+			Aliases: []string{"n"},
+			Usage:   "UpdateType",
+		},
+		&cli.StringFlag{
+			Name:    FlagInput,
+			Aliases: []string{"i"},
+			Usage:   "Input for the update, in JSON format.",
+		},
+		&cli.StringFlag{
+			Name:    FlagInputFile,
+			Aliases: []string{"if"},
+			Usage:   "Input for the update from JSON file.",
+		},
+		&cli.StringFlag{
+			Name:  FlagUpdateID,
+			Usage: "Optional ID of the update, an update sent again with the same ID is applied at most once",
+		},
+	}
+}
+
 func getFlagsForSignalWithStart() []cli.Flag {
 	return append(getFlagsForStart(),
 		&cli.StringFlag{
@@ -552,6 +601,23 @@ func getFlagsForCancel() []cli.Flag {
 	})
 }
 
+func getShardDistributorFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagShardDistributorAddress,
+			Aliases: []string{"sdad"},
+			Usage:   "host:port for the gRPC endpoint of the shard distributor service",
+			EnvVars: []string{"CADENCE_CLI_SHARD_DISTRIBUTOR_ADDRESS"},
+		},
+		&cli.StringFlag{
+			Name:     FlagNamespace,
+			Aliases:  []string{"ns"},
+			Usage:    "Shard distributor namespace",
+			Required: true,
+		},
+	}
+}
+
 func getFormatFlag() cli.Flag {
 	return &cli.StringFlag{
 		Name:  FlagFormat,
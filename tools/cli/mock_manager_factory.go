@@ -59,6 +59,21 @@ func (mr *MockManagerFactoryMockRecorder) initPersistenceFactory(c any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "initPersistenceFactory", reflect.TypeOf((*MockManagerFactory)(nil).initPersistenceFactory), c)
 }
 
+// initializeBlobCodecs mocks base method.
+func (m *MockManagerFactory) initializeBlobCodecs(c *cli.Context) (persistence.BlobCodecs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "initializeBlobCodecs", c)
+	ret0, _ := ret[0].(persistence.BlobCodecs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// initializeBlobCodecs indicates an expected call of initializeBlobCodecs.
+func (mr *MockManagerFactoryMockRecorder) initializeBlobCodecs(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "initializeBlobCodecs", reflect.TypeOf((*MockManagerFactory)(nil).initializeBlobCodecs), c)
+}
+
 // initializeDomainManager mocks base method.
 func (m *MockManagerFactory) initializeDomainManager(c *cli.Context) (persistence.DomainManager, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +85,15 @@ var (
 		},
 	}
 
+	scheduleHistoryFlags = []cli.Flag{
+		scheduleIDFlag,
+		&cli.BoolFlag{
+			Name:    FlagPrintJSON,
+			Aliases: []string{"pjson"},
+			Usage:   "Print output in JSON format",
+		},
+	}
+
 	updateScheduleFlags = []cli.Flag{
 		scheduleIDFlag,
 		&cli.StringFlag{
@@ -192,6 +201,20 @@ func newScheduleCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "history",
+			Aliases: []string{"hist"},
+			Usage:   "Show the recent runs of a schedule, including skipped and failed ones",
+			Flags:   scheduleHistoryFlags,
+			Action: func(c *cli.Context) error {
+				if err := checkNoAdditionalArgsPassed(c); err != nil {
+					return err
+				}
+				return withScheduleClient(c, func(sc *scheduleCLIImpl) error {
+					return sc.ScheduleHistory(c)
+				})
+			},
+		},
 		{
 			Name:    "update",
 			Aliases: []string{"u"},
@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
 	commoncli "github.com/uber/cadence/tools/common/commoncli"
 )
 
@@ -142,6 +143,71 @@ func (sc *scheduleCLIImpl) DescribeSchedule(c *cli.Context) error {
 	return nil
 }
 
+func (sc *scheduleCLIImpl) ScheduleHistory(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return err
+	}
+	scheduleID := c.String(FlagScheduleID)
+	printJSON := c.Bool(FlagPrintJSON)
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return commoncli.Problem("Error creating context", err)
+	}
+	defer cancel()
+
+	// the run history is read from the scheduler workflow itself, the query result is an opaque
+	// payload and is not dropped by the gRPC transport like ScheduleInfo.RecentRuns
+	resp, err := sc.frontendClient.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: scheduler.WorkflowIDPrefix + scheduleID,
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: scheduler.QueryTypeDescribe,
+		},
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to query schedule", err)
+	}
+	var desc scheduler.ScheduleDescription
+	if err := json.Unmarshal(resp.GetQueryResult(), &desc); err != nil {
+		return commoncli.Problem("Failed to decode schedule query result", err)
+	}
+
+	runs := desc.RecentRuns
+	if printJSON {
+		data, err := json.MarshalIndent(runs, "", "  ")
+		if err != nil {
+			return commoncli.Problem("Failed to marshal response", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(runs) == 0 {
+		fmt.Println("No runs recorded.")
+		return nil
+	}
+	for _, run := range runs {
+		trigger := "schedule"
+		if run.IsBackfill {
+			trigger = "backfill"
+		}
+		actualTime := ""
+		if !run.ActualTime.IsZero() {
+			actualTime = run.ActualTime.Format(time.RFC3339)
+		}
+		fmt.Printf("  %-25s  %-25s  %-8s  %-16s  %s  %s\n",
+			run.ScheduledTime.Format(time.RFC3339), actualTime, trigger, run.Outcome, run.WorkflowID, run.RunID)
+		if run.Failure != "" {
+			fmt.Printf("      failure: %s\n", run.Failure)
+		}
+	}
+	return nil
+}
+
 func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
 	if err != nil {
@@ -21,15 +21,19 @@
 package cli
 
 import (
+	"encoding/json"
 	"flag"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 func newScheduleTestApp(t *testing.T, mockClient *frontend.MockClient) *cli.App {
@@ -103,6 +107,68 @@ func TestScheduleCLI_DescribeSchedule(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestScheduleCLI_ScheduleHistory(t *testing.T) {
+	scheduledTime := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string][]*types.ScheduleRunRecord{
+		"with runs": {
+			{
+				ScheduledTime: scheduledTime,
+				ActualTime:    scheduledTime.Add(time.Second),
+				WorkflowID:    "wf-1",
+				RunID:         "run-1",
+				Outcome:       types.ScheduleRunOutcomeStarted,
+			},
+			{
+				ScheduledTime: scheduledTime.Add(time.Hour),
+				IsBackfill:    true,
+				Outcome:       types.ScheduleRunOutcomeFailed,
+				Failure:       "failed to start workflow",
+			},
+		},
+		"no runs": nil,
+	}
+
+	for name, runs := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(mockCtrl)
+			app := newScheduleTestApp(t, mockClient)
+
+			result, err := json.Marshal(scheduler.ScheduleDescription{ScheduleID: "my-sched", RecentRuns: runs})
+			require.NoError(t, err)
+			mockClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ interface{}, req *types.QueryWorkflowRequest, _ ...interface{}) (*types.QueryWorkflowResponse, error) {
+					assert.Equal(t, "test-domain", req.Domain)
+					assert.Equal(t, scheduler.WorkflowIDPrefix+"my-sched", req.Execution.WorkflowID)
+					assert.Equal(t, scheduler.QueryTypeDescribe, req.Query.QueryType)
+					return &types.QueryWorkflowResponse{QueryResult: result}, nil
+				})
+
+			c := newScheduleCLIContext(app, map[string]string{
+				FlagScheduleID: "my-sched",
+			})
+			sc := &scheduleCLIImpl{frontendClient: mockClient}
+			assert.NoError(t, sc.ScheduleHistory(c))
+		})
+	}
+}
+
+func TestScheduleCLI_ScheduleHistory_QueryError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	mockClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+		Return(nil, &types.EntityNotExistsError{Message: "not found"})
+
+	c := newScheduleCLIContext(app, map[string]string{
+		FlagScheduleID: "my-sched",
+	})
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	assert.Error(t, sc.ScheduleHistory(c))
+}
+
 func TestScheduleCLI_PauseSchedule(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockClient := frontend.NewMockClient(mockCtrl)
@@ -62,5 +62,39 @@ func newTaskListCommands() []*cli.Command {
 			},
 			Action: ListTaskListPartitions,
 		},
+		{
+			Name:    "update-build-id-compatibility",
+			Aliases: []string{"ubc"},
+			Usage:   "Add a worker build ID to the compatibility sets of a decision tasklist, as the new default or compatible with an existing build ID",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList name",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Worker build ID to add",
+				},
+				&cli.StringFlag{
+					Name:  FlagCompatibleBuildID,
+					Usage: "Optional existing build ID the new build ID is compatible with. If not set, the build ID becomes the new default",
+				},
+			},
+			Action: UpdateTaskListBuildIDCompatibility,
+		},
+		{
+			Name:    "get-build-id-compatibility",
+			Aliases: []string{"gbc"},
+			Usage:   "Show the worker build ID compatibility sets of a decision tasklist",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList name",
+				},
+			},
+			Action: GetTaskListBuildIDCompatibility,
+		},
 	}
 }
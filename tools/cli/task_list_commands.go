@@ -23,6 +23,8 @@ package cli
 import (
 	"io"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli/v2"
@@ -35,8 +37,13 @@ type (
 	TaskListPollerRow struct {
 		ActivityIdentity string    `header:"Activity Poller Identity"`
 		DecisionIdentity string    `header:"Decision Poller Identity"`
+		BuildID          string    `header:"Build ID"`
 		LastAccessTime   time.Time `header:"Last Access Time"`
 	}
+	CompatibleBuildIDSetRow struct {
+		BuildIDs string `header:"Compatible Build IDs"`
+		Default  bool   `header:"Default"`
+	}
 	TaskListPartitionRow struct {
 		ActivityPartition string `header:"Activity Task List Partition"`
 		DecisionPartition string `header:"Decision Task List Partition"`
@@ -127,20 +134,106 @@ func ListTaskListPartitions(c *cli.Context) error {
 	}
 }
 
+// UpdateTaskListBuildIDCompatibility adds a worker build ID to the compatibility sets of a decision tasklist
+func UpdateTaskListBuildIDCompatibility(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	buildID, err := getRequiredOption(c, FlagBuildID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	response, err := wfClient.UpdateTaskListBuildIDCompatibility(ctx, &types.UpdateTaskListBuildIDCompatibilityRequest{
+		Domain:            domain,
+		TaskList:          &types.TaskList{Name: taskList},
+		BuildID:           buildID,
+		CompatibleBuildID: c.String(FlagCompatibleBuildID),
+	})
+	if err != nil {
+		return commoncli.Problem("Operation UpdateTaskListBuildIDCompatibility failed.", err)
+	}
+	return printCompatibleBuildIDSets(getDeps(c).Output(), response.GetCompatibleSets())
+}
+
+// GetTaskListBuildIDCompatibility shows the worker build ID compatibility sets of a decision tasklist
+func GetTaskListBuildIDCompatibility(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	response, err := wfClient.GetTaskListBuildIDCompatibility(ctx, &types.GetTaskListBuildIDCompatibilityRequest{
+		Domain:   domain,
+		TaskList: &types.TaskList{Name: taskList},
+	})
+	if err != nil {
+		return commoncli.Problem("Operation GetTaskListBuildIDCompatibility failed.", err)
+	}
+	return printCompatibleBuildIDSets(getDeps(c).Output(), response.GetCompatibleSets())
+}
+
 func printTaskListPollers(w io.Writer, pollers []*types.PollerInfo, taskListType types.TaskListType) error {
 	table := []TaskListPollerRow{}
+	hasBuildID := false
 	for _, poller := range pollers {
 		table = append(table, TaskListPollerRow{
 			ActivityIdentity: poller.GetIdentity(),
 			DecisionIdentity: poller.GetIdentity(),
+			BuildID:          poller.GetBuildID(),
 			LastAccessTime:   time.Unix(0, poller.GetLastAccessTime())})
+		hasBuildID = hasBuildID || poller.GetBuildID() != ""
 	}
+	// group the pollers of the same build ID together
+	sort.SliceStable(table, func(i, j int) bool {
+		return table[i].BuildID < table[j].BuildID
+	})
 	return RenderTable(w, table, RenderOptions{Color: true, PrintDateTime: true, OptionalColumns: map[string]bool{
 		"Activity Poller Identity": taskListType == types.TaskListTypeActivity,
 		"Decision Poller Identity": taskListType == types.TaskListTypeDecision,
+		"Build ID":                 hasBuildID,
 	}})
 }
 
+func printCompatibleBuildIDSets(w io.Writer, sets []*types.CompatibleBuildIDSet) error {
+	table := []CompatibleBuildIDSetRow{}
+	// the default set is the last one, print it first
+	for i := len(sets) - 1; i >= 0; i-- {
+		table = append(table, CompatibleBuildIDSetRow{
+			BuildIDs: strings.Join(sets[i].GetBuildIDs(), ", "),
+			Default:  i == len(sets)-1,
+		})
+	}
+	return RenderTable(w, table, RenderOptions{Color: true})
+}
+
 func printTaskListPartitions(taskListType types.TaskListType, partitions []*types.TaskListPartitionMetadata) error {
 	table := []TaskListPartitionRow{}
 	for _, partition := range partitions {
@@ -104,6 +104,12 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForSignal(),
 			Action:  SignalWorkflow,
 		},
+		{
+			Name:   "update",
+			Usage:  "send an update to a workflow execution and wait for its result",
+			Flags:  getFlagsForUpdate(),
+			Action: UpdateWorkflow,
+		},
 		{
 			Name:   "signalwithstart",
 			Usage:  "signal the current open workflow if exists, or attempt to start a new run based on IDResuePolicy and signals it",
@@ -423,6 +429,71 @@ func newActivityCommands() []*cli.Command {
 			},
 			Action: FailActivity,
 		},
+		{
+			Name:  "update-options",
+			Usage: "update task list, timeouts or retry policy of a pending activity",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"wid", "w"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "New task list of the activity",
+				},
+				&cli.IntFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "New schedule to close timeout of the activity in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "New start to close timeout of the activity in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagActivityHeartBeatTimeout,
+					Usage: "New heartbeat timeout of the activity in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryAttempts,
+					Usage: "Retry attempts of the new retry policy. retry_attempts and retry_expiration must not both be 0.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryExpiration,
+					Usage: "Retry expiration in seconds of the new retry policy, counted from the time of the update. retry_attempts and retry_expiration must not both be 0.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryInterval,
+					Value: 10,
+					Usage: "Retry interval in seconds of the new retry policy.",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRetryBackoff,
+					Value: 1.0,
+					Usage: "Retry backoff coefficient of the new retry policy. Must be or equal or greater than 1.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryMaxInterval,
+					Usage: "Retry maximum interval in seconds of the new retry policy.",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: UpdateActivityOptions,
+		},
 	}
 }
 
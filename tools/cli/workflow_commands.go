@@ -837,6 +837,59 @@ func SignalWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// UpdateWorkflow sends an update to a workflow execution and prints its result
+func UpdateWorkflow(c *cli.Context) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	updateType, err := getRequiredOption(c, FlagName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	input, err := processJSONInput(c)
+	if err != nil {
+		return commoncli.Problem("Error proccessing JSON input: ", err)
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	resp, err := serviceClient.UpdateWorkflowExecution(
+		tcCtx,
+		&types.UpdateWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Update: &types.WorkflowUpdate{
+				UpdateType: updateType,
+				UpdateArgs: []byte(input),
+			},
+			Identity: getCliIdentity(),
+			UpdateID: c.String(FlagUpdateID),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Update workflow failed.", err)
+	}
+	// assume it is json encoded
+	fmt.Print(string(resp.GetResult()))
+	return nil
+}
+
 // SignalWithStartWorkflowExecution starts a workflow execution if not already exists and signals it
 func SignalWithStartWorkflowExecution(c *cli.Context) error {
 	serviceClient, err := getDeps(c).ServerFrontendClient(c)
@@ -2678,6 +2731,75 @@ func FailActivity(c *cli.Context) error {
 	return nil
 }
 
+// UpdateActivityOptions updates task list, timeouts or retry policy of a pending activity
+func UpdateActivityOptions(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	options := &types.ActivityOptions{}
+	if c.IsSet(FlagTaskList) {
+		options.TaskList = &types.TaskList{Name: c.String(FlagTaskList)}
+	}
+	if c.IsSet(FlagScheduleToCloseTimeout) {
+		options.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagScheduleToCloseTimeout)))
+	}
+	if c.IsSet(FlagStartToCloseTimeout) {
+		options.StartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagStartToCloseTimeout)))
+	}
+	if c.IsSet(FlagActivityHeartBeatTimeout) {
+		options.HeartbeatTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagActivityHeartBeatTimeout)))
+	}
+	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) {
+		options.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds:    int32(c.Int(FlagRetryInterval)),
+			BackoffCoefficient:          c.Float64(FlagRetryBackoff),
+			MaximumIntervalInSeconds:    int32(c.Int(FlagRetryMaxInterval)),
+			MaximumAttempts:             int32(c.Int(FlagRetryAttempts)),
+			ExpirationIntervalInSeconds: int32(c.Int(FlagRetryExpiration)),
+		}
+	}
+
+	identity := c.String(FlagIdentity)
+	if identity == "" {
+		identity = getCliIdentity()
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	resp, err := frontendClient.UpdateActivityOptions(ctx, &types.UpdateActivityOptionsRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      c.String(FlagRunID),
+		},
+		ActivityID:      activityID,
+		ActivityOptions: options,
+		Identity:        identity,
+	})
+	if err != nil {
+		return commoncli.Problem("Update activity options failed", replaceRetryPropertiesInErrorMessageWithRetryArguments(err))
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), resp.ActivityOptions)
+	return nil
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
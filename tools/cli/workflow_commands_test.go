@@ -1007,6 +1007,109 @@ func (s *cliAppSuite) TestFailActivity() {
 	}
 }
 
+func (s *cliAppSuite) TestUpdateActivityOptions() {
+	testCases := []testcase{
+		{
+			name:    "happy",
+			command: `cadence --do test-domain wf activity update-options -w wid -aid 3 --tl new-tl --start_to_close_timeout_seconds 30 --retry_attempts 5 --identity tester`,
+			err:     "",
+			mock: func() {
+				s.serverFrontendClient.EXPECT().UpdateActivityOptions(gomock.Any(), &types.UpdateActivityOptionsRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid"},
+					ActivityID:        "3",
+					ActivityOptions: &types.ActivityOptions{
+						TaskList:                   &types.TaskList{Name: "new-tl"},
+						StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+						RetryPolicy: &types.RetryPolicy{
+							InitialIntervalInSeconds: 10,
+							BackoffCoefficient:       1.0,
+							MaximumAttempts:          5,
+						},
+					},
+					Identity: "tester",
+				}).Return(&types.UpdateActivityOptionsResponse{ActivityOptions: &types.ActivityOptions{}}, nil)
+			},
+		},
+		{
+			name:    "missing activity id",
+			command: `cadence --do test-domain wf activity update-options -w wid --tl new-tl`,
+			err:     "Required flag not found",
+		},
+		{
+			name:    "server error",
+			command: `cadence --do test-domain wf activity update-options -w wid -aid 3 --tl new-tl`,
+			err:     "Update activity options failed",
+			mock: func() {
+				s.serverFrontendClient.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "bad"})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	testCases := []testcase{
+		{
+			name:    "happy",
+			command: `cadence --do test-domain wf update -w wid -n update-type -i '"input"'`,
+			err:     "",
+			mock: func() {
+				s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), &types.UpdateWorkflowExecutionRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid"},
+					Update: &types.WorkflowUpdate{
+						UpdateType: "update-type",
+						UpdateArgs: []byte(`"input"`),
+					},
+					Identity: getCliIdentity(),
+				}).Return(&types.UpdateWorkflowExecutionResponse{Result: []byte(`"result"`)}, nil)
+			},
+		},
+		{
+			name:    "with update ID",
+			command: `cadence --do test-domain wf update -w wid -n update-type -i '"input"' --update_id update-id`,
+			err:     "",
+			mock: func() {
+				s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), &types.UpdateWorkflowExecutionRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid"},
+					Update: &types.WorkflowUpdate{
+						UpdateType: "update-type",
+						UpdateArgs: []byte(`"input"`),
+					},
+					Identity: getCliIdentity(),
+					UpdateID: "update-id",
+				}).Return(&types.UpdateWorkflowExecutionResponse{}, nil)
+			},
+		},
+		{
+			name:    "missing update type",
+			command: `cadence --do test-domain wf update -w wid`,
+			err:     "Required flag not found",
+		},
+		{
+			name:    "server error",
+			command: `cadence --do test-domain wf update -w wid -n update-type`,
+			err:     "Update workflow failed.",
+			mock: func() {
+				s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "bad"})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
 func (s *cliAppSuite) TestListAllWorkflow() {
 	testCases := []testcase{
 		{
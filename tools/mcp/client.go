@@ -0,0 +1,110 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
+
+	"github.com/uber/cadence/client/frontend"
+	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
+	"github.com/uber/cadence/common"
+	cc "github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	cadenceClientName      = "cadence-mcp"
+	cadenceFrontendService = "cadence-frontend"
+)
+
+// readOnlyProcedures are the only frontend methods the MCP server is allowed to call.
+// None of them changes any state of the cluster, queries are answered by the query
+// handlers of the workers, which can not change their workflow.
+var readOnlyProcedures = map[string]struct{}{
+	"DescribeDomain":              {},
+	"DescribeTaskList":            {},
+	"DescribeWorkflowExecution":   {},
+	"GetWorkflowExecutionHistory": {},
+	"ListFailoverHistory":         {},
+	"ListWorkflowExecutions":      {},
+	"QueryWorkflow":               {},
+}
+
+// newFrontendClient creates a gRPC frontend client for the cluster at the given address.
+// Every outgoing call is checked against readOnlyProcedures.
+func newFrontendClient(address string) (frontend.Client, *yarpc.Dispatcher, error) {
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: cadenceClientName,
+		Outbounds: yarpc.Outbounds{
+			cadenceFrontendService: {Unary: grpc.NewTransport().NewSingleOutbound(address)},
+		},
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: &readOnlyMiddleware{},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		return nil, nil, fmt.Errorf("failed to start dispatcher: %w", err)
+	}
+
+	clientConfig := dispatcher.ClientConfig(cadenceFrontendService)
+	client := grpcClient.NewFrontendClient(
+		apiv1.NewDomainAPIYARPCClient(clientConfig),
+		apiv1.NewWorkflowAPIYARPCClient(clientConfig),
+		apiv1.NewWorkerAPIYARPCClient(clientConfig),
+		apiv1.NewVisibilityAPIYARPCClient(clientConfig),
+		apiv1.NewScheduleAPIYARPCClient(clientConfig),
+	)
+	return client, dispatcher, nil
+}
+
+type readOnlyMiddleware struct{}
+
+func (m *readOnlyMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	if !isReadOnlyProcedure(request.Procedure) {
+		return nil, fmt.Errorf("procedure %q is not allowed, the cadence MCP server is read-only", request.Procedure)
+	}
+	request.Headers = request.Headers.
+		With(common.ClientImplHeaderName, cc.CLI).
+		With(common.FeatureVersionHeaderName, cc.SupportedCLIVersion).
+		With(common.ClientFeatureFlagsHeaderName, cc.FeatureFlagsHeader(cc.DefaultCLIFeatureFlags)).
+		With(common.CallerTypeHeaderName, types.CallerTypeCLI.String())
+	return out.Call(ctx, request)
+}
+
+// isReadOnlyProcedure checks the method part of a procedure name, e.g.
+// uber.cadence.api.v1.WorkflowAPI::DescribeWorkflowExecution
+func isReadOnlyProcedure(procedure string) bool {
+	method := procedure
+	if idx := strings.LastIndex(procedure, "::"); idx >= 0 {
+		method = procedure[idx+2:]
+	}
+	_, ok := readOnlyProcedures[method]
+	return ok
+}
@@ -26,6 +26,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"flag"
 	"fmt"
 	"os"
 	"os/exec"
@@ -38,6 +39,8 @@ import (
 )
 
 func main() {
+	address := flag.String("address", "localhost:7833", "gRPC endpoint of the cadence frontend used by the operations tools")
+	flag.Parse()
 
 	// Create MCP server
 	s := server.NewMCPServer(
@@ -83,6 +86,15 @@ func main() {
 		),
 	), cadenceCommandGeneratorHandler)
 
+	// Add read-only operations tools which call the cluster at the given address
+	client, dispatcher, err := newFrontendClient(*address)
+	if err != nil {
+		debugLog("Failed to create frontend client: %v\n", err)
+		os.Exit(1)
+	}
+	defer dispatcher.Stop()
+	newOpsTools(client).register(s)
+
 	debugLog("Cadence MCP started")
 
 	// Start the stdio server
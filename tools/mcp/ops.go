@@ -0,0 +1,527 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultCallTimeout      = 30 * time.Second
+	defaultHistoryMaxEvents = 10000
+	defaultHistoryPageSize  = 1000
+	defaultListPageSize     = 20
+	maxListPageSize         = 1000
+	historyFailuresToShow   = 10
+	historyLastEventsToShow = 10
+	failoverHistoryPageSize = 20
+
+	// diagnosticsReportQueryType is the query handler of the workflows started by DiagnoseWorkflowExecution
+	diagnosticsReportQueryType = "query-diagnostics-report"
+)
+
+// opsTools are read-only tools which call the frontend of a cadence cluster
+type opsTools struct {
+	client  frontend.Client
+	timeout time.Duration
+}
+
+func newOpsTools(client frontend.Client) *opsTools {
+	return &opsTools{
+		client:  client,
+		timeout: defaultCallTimeout,
+	}
+}
+
+func (o *opsTools) register(s *server.MCPServer) {
+	s.AddTool(mcp.NewTool("describe_workflow",
+		mcp.WithDescription("Describe a Cadence workflow execution: its status, pending activities, pending children and pending decision"),
+		mcp.WithString("domain", mcp.Required(), mcp.Description("Domain of the workflow")),
+		mcp.WithString("workflow_id", mcp.Required(), mcp.Description("WorkflowID of the workflow")),
+		mcp.WithString("run_id", mcp.Description("RunID of the workflow, the current run is used if not set")),
+	), o.describeWorkflowHandler)
+
+	s.AddTool(mcp.NewTool("workflow_history",
+		mcp.WithDescription("Fetch the history of a Cadence workflow execution and summarize it: event counts by type, failures and timeouts, and the last events"),
+		mcp.WithString("domain", mcp.Required(), mcp.Description("Domain of the workflow")),
+		mcp.WithString("workflow_id", mcp.Required(), mcp.Description("WorkflowID of the workflow")),
+		mcp.WithString("run_id", mcp.Description("RunID of the workflow, the current run is used if not set")),
+		mcp.WithNumber("max_events",
+			mcp.DefaultNumber(defaultHistoryMaxEvents),
+			mcp.Description("Maximum number of events to fetch"),
+		),
+	), o.workflowHistoryHandler)
+
+	s.AddTool(mcp.NewTool("list_workflows",
+		mcp.WithDescription("List Cadence workflow executions of a domain matching a visibility query, e.g. \"WorkflowType = 'MyWorkflow' AND CloseStatus = 'FAILED'\""),
+		mcp.WithString("domain", mcp.Required(), mcp.Description("Domain of the workflows")),
+		mcp.WithString("query", mcp.Description("Visibility query, all workflows are listed if not set")),
+		mcp.WithNumber("page_size",
+			mcp.DefaultNumber(defaultListPageSize),
+			mcp.Max(maxListPageSize),
+			mcp.Description("Maximum number of workflows to return"),
+		),
+	), o.listWorkflowsHandler)
+
+	s.AddTool(mcp.NewTool("describe_task_list",
+		mcp.WithDescription("Describe a Cadence task list: its backlog, dispatch rate and pollers"),
+		mcp.WithString("domain", mcp.Required(), mcp.Description("Domain of the task list")),
+		mcp.WithString("task_list", mcp.Required(), mcp.Description("Name of the task list")),
+		mcp.WithString("task_list_type",
+			mcp.DefaultString("decision"),
+			mcp.Enum("decision", "activity"),
+			mcp.Description("Type of the task list"),
+		),
+	), o.describeTaskListHandler)
+
+	s.AddTool(mcp.NewTool("diagnostics_report",
+		mcp.WithDescription("Fetch the report of an existing Cadence diagnostics workflow, e.g. one started with \"cadence workflow diagnose\". The diagnostics workflow runs in the "+constants.SystemLocalDomainName+" domain, its workflow ID is <domain>-<run ID> of the diagnosed workflow. This does not start a new diagnosis."),
+		mcp.WithString("workflow_id", mcp.Required(), mcp.Description("WorkflowID of the diagnostics workflow")),
+		mcp.WithString("run_id", mcp.Description("RunID of the diagnostics workflow, the current run is used if not set")),
+	), o.diagnosticsReportHandler)
+
+	s.AddTool(mcp.NewTool("domain_failover_history",
+		mcp.WithDescription("Show the recent failovers of a Cadence domain"),
+		mcp.WithString("domain", mcp.Required(), mcp.Description("Name of the domain")),
+	), o.domainFailoverHistoryHandler)
+}
+
+func (o *opsTools) describeWorkflowHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := workflowArguments(request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	resp, err := o.client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: execution,
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error describing workflow: " + err.Error()), nil
+	}
+	return jsonResult(resp)
+}
+
+func (o *opsTools) workflowHistoryHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := workflowArguments(request)
+	if err != nil {
+		return nil, err
+	}
+	maxEvents := intArgument(request, "max_events", defaultHistoryMaxEvents)
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	var events []*types.HistoryEvent
+	var token []byte
+	for {
+		resp, err := o.client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+			Domain:          domain,
+			Execution:       execution,
+			MaximumPageSize: defaultHistoryPageSize,
+			NextPageToken:   token,
+		})
+		if err != nil {
+			return mcp.NewToolResultError("Error fetching workflow history: " + err.Error()), nil
+		}
+		events = append(events, resp.GetHistory().GetEvents()...)
+		token = resp.NextPageToken
+		if len(token) == 0 || len(events) >= maxEvents {
+			break
+		}
+	}
+
+	truncated := len(token) != 0
+	if len(events) > maxEvents {
+		events = events[:maxEvents]
+		truncated = true
+	}
+	return mcp.NewToolResultText(summarizeHistory(events, truncated)), nil
+}
+
+func (o *opsTools) listWorkflowsHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := stringArgument(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+	query, _ := request.Params.Arguments["query"].(string)
+	pageSize := intArgument(request, "page_size", defaultListPageSize)
+	if pageSize <= 0 || pageSize > maxListPageSize {
+		return nil, fmt.Errorf("page_size must be between 1 and %d", maxListPageSize)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	resp, err := o.client.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:   domain,
+		PageSize: int32(pageSize),
+		Query:    query,
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error listing workflows: " + err.Error()), nil
+	}
+
+	executions := resp.GetExecutions()
+	if len(executions) == 0 {
+		return mcp.NewToolResultText("No workflows found."), nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Found %d workflows", len(executions))
+	if len(resp.GetNextPageToken()) != 0 {
+		sb.WriteString(", more workflows match the query")
+	}
+	sb.WriteString(":\n")
+	for _, e := range executions {
+		status := "RUNNING"
+		if e.CloseStatus != nil {
+			status = e.CloseStatus.String()
+		}
+		fmt.Fprintf(&sb, "- WorkflowID: %s, RunID: %s, Type: %s, Status: %s, Start: %s",
+			e.GetExecution().GetWorkflowID(),
+			e.GetExecution().GetRunID(),
+			e.GetType().GetName(),
+			status,
+			formatTimestamp(e.GetStartTime()),
+		)
+		if e.CloseTime != nil {
+			fmt.Fprintf(&sb, ", Close: %s", formatTimestamp(e.GetCloseTime()))
+		}
+		fmt.Fprintf(&sb, ", HistoryLength: %d\n", e.HistoryLength)
+	}
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func (o *opsTools) describeTaskListHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := stringArgument(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+	taskList, err := stringArgument(request, "task_list")
+	if err != nil {
+		return nil, err
+	}
+	taskListType := types.TaskListTypeDecision
+	if t, _ := request.Params.Arguments["task_list_type"].(string); t == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	resp, err := o.client.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+		Domain:                domain,
+		TaskList:              &types.TaskList{Name: taskList},
+		TaskListType:          taskListType.Ptr(),
+		IncludeTaskListStatus: true,
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error describing task list: " + err.Error()), nil
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Task list %s (%s)\n", taskList, strings.ToLower(taskListType.String()))
+	if status := resp.GetTaskListStatus(); status != nil {
+		fmt.Fprintf(&sb, "Backlog: %d tasks\n", status.GetBacklogCountHint())
+		fmt.Fprintf(&sb, "Dispatch rate limit: %.2f per second\n", status.GetRatePerSecond())
+	}
+	pollers := resp.GetPollers()
+	if len(pollers) == 0 {
+		sb.WriteString("No pollers. Tasks of this task list are not being processed.\n")
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+	fmt.Fprintf(&sb, "Pollers (%d):\n", len(pollers))
+	for _, p := range pollers {
+		fmt.Fprintf(&sb, "- %s, last access: %s\n", p.GetIdentity(), formatTimestamp(p.GetLastAccessTime()))
+	}
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func (o *opsTools) diagnosticsReportHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	workflowID, err := stringArgument(request, "workflow_id")
+	if err != nil {
+		return nil, err
+	}
+	runID, _ := request.Params.Arguments["run_id"].(string)
+	execution := &types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	describeResp, err := o.client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: execution,
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error describing diagnostics workflow: " + err.Error()), nil
+	}
+	info := describeResp.GetWorkflowExecutionInfo()
+	if info == nil {
+		return mcp.NewToolResultError("Error describing diagnostics workflow: no workflow execution info returned"), nil
+	}
+	status := "RUNNING"
+	if info.CloseStatus != nil {
+		status = info.CloseStatus.String()
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Diagnostics workflow: WorkflowID: %s, RunID: %s, Status: %s, Start: %s\n",
+		info.GetExecution().GetWorkflowID(),
+		info.GetExecution().GetRunID(),
+		status,
+		formatTimestamp(info.GetStartTime()),
+	)
+	// the report is partial until the diagnostics workflow completes
+	queryResp, err := o.client.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: info.GetExecution(),
+		Query:     &types.WorkflowQuery{QueryType: diagnosticsReportQueryType},
+	})
+	if err != nil {
+		fmt.Fprintf(&sb, "Error querying the diagnostics report: %v\n", err)
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+	var report bytes.Buffer
+	if err := json.Indent(&report, queryResp.GetQueryResult(), "", "  "); err != nil {
+		report.Reset()
+		report.Write(queryResp.GetQueryResult())
+	}
+	sb.WriteString("Report:\n")
+	sb.Write(report.Bytes())
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+func (o *opsTools) domainFailoverHistoryHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := stringArgument(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	describeResp, err := o.client.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domain)})
+	if err != nil {
+		return mcp.NewToolResultError("Error describing domain: " + err.Error()), nil
+	}
+	resp, err := o.client.ListFailoverHistory(ctx, &types.ListFailoverHistoryRequest{
+		Filters: &types.ListFailoverHistoryRequestFilters{
+			DomainID: describeResp.GetDomainInfo().GetUUID(),
+		},
+		Pagination: &types.PaginationOptions{
+			PageSize: common.Int32Ptr(failoverHistoryPageSize),
+		},
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error listing failover history: " + err.Error()), nil
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Domain %s, global: %v, active cluster: %s\n",
+		domain,
+		describeResp.GetIsGlobalDomain(),
+		describeResp.ReplicationConfiguration.GetActiveClusterName(),
+	)
+	events := resp.GetFailoverEvents()
+	if len(events) == 0 {
+		sb.WriteString("No failovers found.\n")
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+	fmt.Fprintf(&sb, "Failovers (%d most recent):\n", len(events))
+	for _, e := range events {
+		fmt.Fprintf(&sb, "- %s %s failover", formatTimestamp(e.GetCreatedTime()), failoverTypeName(e.GetFailoverType()))
+		for _, cf := range e.GetClusterFailovers() {
+			fmt.Fprintf(&sb, ", %s -> %s", cf.GetFromCluster().GetActiveClusterName(), cf.GetToCluster().GetActiveClusterName())
+			if attr := cf.GetClusterAttribute(); attr != nil {
+				fmt.Fprintf(&sb, " (%s/%s)", attr.GetScope(), attr.GetName())
+			}
+		}
+		sb.WriteString("\n")
+	}
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// summarizeHistory describes the workflow, counts events by type and lists
+// failures, timeouts and the last events of the history
+func summarizeHistory(events []*types.HistoryEvent, truncated bool) string {
+	var sb strings.Builder
+	if len(events) == 0 {
+		return "History is empty."
+	}
+
+	if attr := events[0].GetWorkflowExecutionStartedEventAttributes(); attr != nil {
+		fmt.Fprintf(&sb, "Workflow type: %s, task list: %s, started: %s\n",
+			attr.WorkflowType.GetName(),
+			attr.TaskList.GetName(),
+			formatTimestamp(events[0].GetTimestamp()),
+		)
+	}
+	fmt.Fprintf(&sb, "Events: %d", len(events))
+	if truncated {
+		sb.WriteString(" (truncated, the history has more events)")
+	}
+	sb.WriteString("\n")
+
+	counts := make(map[string]int)
+	var failures []string
+	for _, e := range events {
+		counts[e.GetEventType().String()]++
+		if failure := describeFailure(e); failure != "" {
+			failures = append(failures, fmt.Sprintf("- [%d] %s %s: %s", e.ID, formatTimestamp(e.GetTimestamp()), e.GetEventType().String(), failure))
+		}
+	}
+
+	eventTypes := make([]string, 0, len(counts))
+	for eventType := range counts {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	sb.WriteString("Event counts:\n")
+	for _, eventType := range eventTypes {
+		fmt.Fprintf(&sb, "- %s: %d\n", eventType, counts[eventType])
+	}
+
+	if len(failures) != 0 {
+		fmt.Fprintf(&sb, "Failures and timeouts (%d):\n", len(failures))
+		if len(failures) > historyFailuresToShow {
+			failures = failures[len(failures)-historyFailuresToShow:]
+			fmt.Fprintf(&sb, "(showing the last %d)\n", historyFailuresToShow)
+		}
+		for _, failure := range failures {
+			sb.WriteString(failure + "\n")
+		}
+	}
+
+	last := events
+	if len(last) > historyLastEventsToShow {
+		last = last[len(last)-historyLastEventsToShow:]
+	}
+	sb.WriteString("Last events:\n")
+	for _, e := range last {
+		fmt.Fprintf(&sb, "- [%d] %s %s\n", e.ID, formatTimestamp(e.GetTimestamp()), e.GetEventType().String())
+	}
+	return sb.String()
+}
+
+// describeFailure returns the reason of a failure or timeout event, or empty string for other events
+func describeFailure(e *types.HistoryEvent) string {
+	switch e.GetEventType() {
+	case types.EventTypeWorkflowExecutionFailed:
+		return e.GetWorkflowExecutionFailedEventAttributes().GetReason()
+	case types.EventTypeWorkflowExecutionTimedOut:
+		return e.GetWorkflowExecutionTimedOutEventAttributes().GetTimeoutType().String()
+	case types.EventTypeWorkflowExecutionTerminated:
+		return e.GetWorkflowExecutionTerminatedEventAttributes().GetReason()
+	case types.EventTypeDecisionTaskFailed:
+		return e.GetDecisionTaskFailedEventAttributes().GetCause().String()
+	case types.EventTypeDecisionTaskTimedOut:
+		return e.GetDecisionTaskTimedOutEventAttributes().GetTimeoutType().String()
+	case types.EventTypeActivityTaskFailed:
+		if attr := e.ActivityTaskFailedEventAttributes; attr != nil && attr.Reason != nil {
+			return *attr.Reason
+		}
+	case types.EventTypeActivityTaskTimedOut:
+		return e.GetActivityTaskTimedOutEventAttributes().GetTimeoutType().String()
+	case types.EventTypeChildWorkflowExecutionFailed:
+		if attr := e.ChildWorkflowExecutionFailedEventAttributes; attr != nil && attr.Reason != nil {
+			return *attr.Reason
+		}
+	case types.EventTypeChildWorkflowExecutionTimedOut:
+		if attr := e.ChildWorkflowExecutionTimedOutEventAttributes; attr != nil && attr.TimeoutType != nil {
+			return attr.TimeoutType.String()
+		}
+	}
+	return ""
+}
+
+func failoverTypeName(failoverType types.FailoverType) string {
+	switch failoverType {
+	case types.FailoverTypeForce:
+		return "force"
+	case types.FailoverTypeGraceful:
+		return "graceful"
+	}
+	return "unknown"
+}
+
+func workflowArguments(request mcp.CallToolRequest) (string, *types.WorkflowExecution, error) {
+	domain, err := stringArgument(request, "domain")
+	if err != nil {
+		return "", nil, err
+	}
+	workflowID, err := stringArgument(request, "workflow_id")
+	if err != nil {
+		return "", nil, err
+	}
+	runID, _ := request.Params.Arguments["run_id"].(string)
+	return domain, &types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}, nil
+}
+
+func stringArgument(request mcp.CallToolRequest, name string) (string, error) {
+	value, ok := request.Params.Arguments[name].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%s must be a string", name)
+	}
+	return value, nil
+}
+
+// intArgument returns a numeric argument, JSON numbers are decoded as float64
+func intArgument(request mcp.CallToolRequest, name string, defaultValue int) int {
+	value, ok := request.Params.Arguments[name].(float64)
+	if !ok {
+		return defaultValue
+	}
+	return int(value)
+}
+
+func jsonResult(v interface{}) (*mcp.CallToolResult, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result: %w", err)
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+func formatTimestamp(unixNano int64) string {
+	if unixNano == 0 {
+		return "-"
+	}
+	return time.Unix(0, unixNano).UTC().Format(time.RFC3339)
+}
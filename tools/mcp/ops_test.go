@@ -0,0 +1,366 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestIsReadOnlyProcedure(t *testing.T) {
+	assert.True(t, isReadOnlyProcedure("uber.cadence.api.v1.WorkflowAPI::DescribeWorkflowExecution"))
+	assert.True(t, isReadOnlyProcedure("ListWorkflowExecutions"))
+	assert.False(t, isReadOnlyProcedure("uber.cadence.api.v1.WorkflowAPI::TerminateWorkflowExecution"))
+	assert.False(t, isReadOnlyProcedure("uber.cadence.api.v1.DomainAPI::UpdateDomain"))
+	assert.False(t, isReadOnlyProcedure("uber.cadence.api.v1.WorkflowAPI::DiagnoseWorkflowExecution"))
+	assert.True(t, isReadOnlyProcedure("uber.cadence.api.v1.WorkflowAPI::QueryWorkflow"))
+}
+
+func TestDiagnosticsReportHandler(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "domain-rid", RunID: "diagnostics-rid"}
+	describeRequest := &types.DescribeWorkflowExecutionRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: "domain-rid"},
+	}
+	queryRequest := &types.QueryWorkflowRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: execution,
+		Query:     &types.WorkflowQuery{QueryType: diagnosticsReportQueryType},
+	}
+	tests := []struct {
+		name       string
+		setupMocks func(client *frontend.MockClient)
+		expected   []string
+		isError    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeWorkflowExecution(gomock.Any(), describeRequest).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						Execution:   execution,
+						CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+					},
+				}, nil)
+				client.EXPECT().QueryWorkflow(gomock.Any(), queryRequest).Return(&types.QueryWorkflowResponse{
+					QueryResult: []byte(`{"DiagnosticsCompleted":true}`),
+				}, nil)
+			},
+			expected: []string{
+				"WorkflowID: domain-rid, RunID: diagnostics-rid, Status: COMPLETED",
+				"Report:\n{\n  \"DiagnosticsCompleted\": true\n}",
+			},
+		},
+		{
+			name: "query error",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeWorkflowExecution(gomock.Any(), describeRequest).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: execution},
+				}, nil)
+				client.EXPECT().QueryWorkflow(gomock.Any(), queryRequest).Return(nil, errors.New("no worker"))
+			},
+			expected: []string{
+				"Status: RUNNING",
+				"Error querying the diagnostics report: no worker",
+			},
+		},
+		{
+			name: "describe error",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeWorkflowExecution(gomock.Any(), describeRequest).Return(nil, &types.EntityNotExistsError{Message: "not found"})
+			},
+			expected: []string{"Error describing diagnostics workflow: not found"},
+			isError:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := frontend.NewMockClient(ctrl)
+			tc.setupMocks(client)
+
+			tools := newOpsTools(client)
+			result, err := tools.diagnosticsReportHandler(context.Background(), newCallToolRequest(map[string]interface{}{
+				"workflow_id": "domain-rid",
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.isError, result.IsError)
+			text := resultText(t, result)
+			for _, expected := range tc.expected {
+				assert.Contains(t, text, expected)
+			}
+		})
+	}
+}
+
+func TestWorkflowHistoryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := frontend.NewMockClient(ctrl)
+	execution := &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()
+
+	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+		Domain:          "domain",
+		Execution:       execution,
+		MaximumPageSize: defaultHistoryPageSize,
+	}).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{
+				ID:        1,
+				Timestamp: common.Int64Ptr(startTime),
+				EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+					WorkflowType: &types.WorkflowType{Name: "wf-type"},
+					TaskList:     &types.TaskList{Name: "tl"},
+				},
+			},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+		}},
+		NextPageToken: []byte("token"),
+	}, nil)
+	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+		Domain:          "domain",
+		Execution:       execution,
+		MaximumPageSize: defaultHistoryPageSize,
+		NextPageToken:   []byte("token"),
+	}).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{
+				ID:        3,
+				EventType: types.EventTypeWorkflowExecutionFailed.Ptr(),
+				WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+					Reason: common.StringPtr("some-reason"),
+				},
+			},
+		}},
+	}, nil)
+
+	tools := newOpsTools(client)
+	result, err := tools.workflowHistoryHandler(context.Background(), newCallToolRequest(map[string]interface{}{
+		"domain":      "domain",
+		"workflow_id": "wid",
+		"run_id":      "rid",
+	}))
+	require.NoError(t, err)
+	text := resultText(t, result)
+	assert.Contains(t, text, "Workflow type: wf-type, task list: tl, started: 2025-01-01T00:00:00Z")
+	assert.Contains(t, text, "Events: 3\n")
+	assert.Contains(t, text, "- WorkflowExecutionFailed: 1")
+	assert.Contains(t, text, "- [3] - WorkflowExecutionFailed: some-reason")
+}
+
+func TestWorkflowHistoryHandler_Truncated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := frontend.NewMockClient(ctrl)
+	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+		}},
+	}, nil)
+
+	tools := newOpsTools(client)
+	result, err := tools.workflowHistoryHandler(context.Background(), newCallToolRequest(map[string]interface{}{
+		"domain":      "domain",
+		"workflow_id": "wid",
+		"max_events":  float64(1),
+	}))
+	require.NoError(t, err)
+	assert.Contains(t, resultText(t, result), "Events: 1 (truncated, the history has more events)")
+}
+
+func TestListWorkflowsHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		arguments   map[string]interface{}
+		setupMocks  func(client *frontend.MockClient)
+		expectedErr string
+		expected    []string
+		isError     bool
+	}{
+		{
+			name:      "success",
+			arguments: map[string]interface{}{"domain": "domain", "query": "WorkflowType = 'wf-type'"},
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().ListWorkflowExecutions(gomock.Any(), &types.ListWorkflowExecutionsRequest{
+					Domain:   "domain",
+					PageSize: defaultListPageSize,
+					Query:    "WorkflowType = 'wf-type'",
+				}).Return(&types.ListWorkflowExecutionsResponse{
+					Executions: []*types.WorkflowExecutionInfo{
+						{
+							Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"},
+							Type:      &types.WorkflowType{Name: "wf-type"},
+						},
+						{
+							Execution:   &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"},
+							Type:        &types.WorkflowType{Name: "wf-type"},
+							CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(),
+						},
+					},
+					NextPageToken: []byte("token"),
+				}, nil)
+			},
+			expected: []string{
+				"Found 2 workflows, more workflows match the query",
+				"WorkflowID: wid1, RunID: rid1, Type: wf-type, Status: RUNNING",
+				"WorkflowID: wid2, RunID: rid2, Type: wf-type, Status: FAILED",
+			},
+		},
+		{
+			name:      "no workflows",
+			arguments: map[string]interface{}{"domain": "domain"},
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListWorkflowExecutionsResponse{}, nil)
+			},
+			expected: []string{"No workflows found."},
+		},
+		{
+			name:      "client error",
+			arguments: map[string]interface{}{"domain": "domain"},
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expected: []string{"Error listing workflows: some error"},
+			isError:  true,
+		},
+		{
+			name:        "invalid page size",
+			arguments:   map[string]interface{}{"domain": "domain", "page_size": float64(0)},
+			expectedErr: "page_size must be between 1 and 1000",
+		},
+		{
+			name:        "missing domain",
+			arguments:   map[string]interface{}{},
+			expectedErr: "domain must be a string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := frontend.NewMockClient(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(client)
+			}
+
+			result, err := newOpsTools(client).listWorkflowsHandler(context.Background(), newCallToolRequest(tc.arguments))
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.isError, result.IsError)
+			text := resultText(t, result)
+			for _, expected := range tc.expected {
+				assert.Contains(t, text, expected)
+			}
+		})
+	}
+}
+
+func TestDescribeTaskListHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := frontend.NewMockClient(ctrl)
+	client.EXPECT().DescribeTaskList(gomock.Any(), &types.DescribeTaskListRequest{
+		Domain:                "domain",
+		TaskList:              &types.TaskList{Name: "tl"},
+		TaskListType:          types.TaskListTypeActivity.Ptr(),
+		IncludeTaskListStatus: true,
+	}).Return(&types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{BacklogCountHint: 42, RatePerSecond: 100},
+	}, nil)
+
+	result, err := newOpsTools(client).describeTaskListHandler(context.Background(), newCallToolRequest(map[string]interface{}{
+		"domain":         "domain",
+		"task_list":      "tl",
+		"task_list_type": "activity",
+	}))
+	require.NoError(t, err)
+	text := resultText(t, result)
+	assert.Contains(t, text, "Task list tl (activity)")
+	assert.Contains(t, text, "Backlog: 42 tasks")
+	assert.Contains(t, text, "No pollers.")
+}
+
+func TestDomainFailoverHistoryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := frontend.NewMockClient(ctrl)
+	client.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("domain")}).Return(&types.DescribeDomainResponse{
+		DomainInfo:               &types.DomainInfo{Name: "domain", UUID: "domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "cluster1"},
+		IsGlobalDomain:           true,
+	}, nil)
+	client.EXPECT().ListFailoverHistory(gomock.Any(), &types.ListFailoverHistoryRequest{
+		Filters:    &types.ListFailoverHistoryRequestFilters{DomainID: "domain-id"},
+		Pagination: &types.PaginationOptions{PageSize: common.Int32Ptr(failoverHistoryPageSize)},
+	}).Return(&types.ListFailoverHistoryResponse{
+		FailoverEvents: []*types.FailoverEvent{
+			{
+				CreatedTime:  common.Int64Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()),
+				FailoverType: types.FailoverTypeGraceful.Ptr(),
+				ClusterFailovers: []*types.ClusterFailover{
+					{
+						FromCluster: &types.ActiveClusterInfo{ActiveClusterName: "cluster0"},
+						ToCluster:   &types.ActiveClusterInfo{ActiveClusterName: "cluster1"},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	result, err := newOpsTools(client).domainFailoverHistoryHandler(context.Background(), newCallToolRequest(map[string]interface{}{
+		"domain": "domain",
+	}))
+	require.NoError(t, err)
+	text := resultText(t, result)
+	assert.Contains(t, text, "Domain domain, global: true, active cluster: cluster1")
+	assert.Contains(t, text, "- 2025-01-01T00:00:00Z graceful failover, cluster0 -> cluster1")
+}
+
+func newCallToolRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = arguments
+	return request
+}
+
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	require.Len(t, result.Content, 1)
+	content, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok)
+	return content.Text
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/schema/mongodb/cadence"
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+type (
+	// MongoClientConfig contains the configuration of the schema tool client
+	MongoClientConfig struct {
+		Hosts             string
+		Port              int
+		User              string
+		Password          string
+		Database          string
+		ConnectAttributes map[string]string
+		Timeout           int
+	}
+
+	mongoClient struct {
+		client  *mongo.Client
+		db      *mongo.Database
+		timeout time.Duration
+	}
+)
+
+const (
+	// DefaultMongoPort is the default port of MongoDB
+	DefaultMongoPort = 27017
+	// DefaultTimeout is the default timeout of a command in seconds
+	DefaultTimeout = 30
+
+	// error code returned by the create command when the collection already exists
+	namespaceExistsErrorCode = 48
+)
+
+var _ schema.SchemaClient = (*mongoClient)(nil)
+
+// newMongoClient returns a new instance of the schema tool client
+func newMongoClient(cfg *MongoClientConfig) (*mongoClient, error) {
+	if cfg.Database == "" {
+		return nil, fmt.Errorf("database name cannot be empty")
+	}
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(buildURI(cfg)))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		client.Disconnect(context.Background())
+		return nil, err
+	}
+	return &mongoClient{
+		client:  client,
+		db:      client.Database(cfg.Database),
+		timeout: timeout,
+	}, nil
+}
+
+func buildURI(cfg *MongoClientConfig) string {
+	uri := fmt.Sprintf("mongodb://%v:%v/", cfg.Hosts, cfg.Port)
+	if cfg.User != "" {
+		uri = fmt.Sprintf("mongodb://%v:%v@%v:%v/", url.QueryEscape(cfg.User), url.QueryEscape(cfg.Password), cfg.Hosts, cfg.Port)
+	}
+	if len(cfg.ConnectAttributes) == 0 {
+		return uri
+	}
+	params := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		params.Set(k, v)
+	}
+	return uri + "?" + params.Encode()
+}
+
+// ExecDDLQuery runs a database command in the MongoDB extended JSON format
+func (c *mongoClient) ExecDDLQuery(stmt string, args ...interface{}) error {
+	var cmd bson.D
+	if err := bson.UnmarshalExtJSON([]byte(stmt), false, &cmd); err != nil {
+		return fmt.Errorf("error parsing command %v: %v", stmt, err)
+	}
+	return c.execCommand(cmd)
+}
+
+func (c *mongoClient) execCommand(cmd bson.D) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return c.db.RunCommand(ctx, cmd).Err()
+}
+
+// DropAllTables drops all the collections of the database
+func (c *mongoClient) DropAllTables() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	names, err := c.db.ListCollectionNames(ctx, bson.D{})
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := c.db.Collection(name).Drop(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateSchemaVersionTables sets up the schema version collections
+func (c *mongoClient) CreateSchemaVersionTables() error {
+	for _, name := range []string{cadence.SchemaVersionCollectionName, cadence.SchemaUpdateHistoryCollectionName} {
+		err := c.execCommand(bson.D{{Key: "create", Value: name}})
+		if cmdErr, ok := err.(mongo.CommandError); ok && cmdErr.Code == namespaceExistsErrorCode {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadSchemaVersion returns the current schema version of the database
+func (c *mongoClient) ReadSchemaVersion() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	var entry cadence.SchemaVersionCollectionEntry
+	err := c.db.Collection(cadence.SchemaVersionCollectionName).
+		FindOne(ctx, bson.D{{Key: "_id", Value: c.db.Name()}}).
+		Decode(&entry)
+	if err != nil {
+		return "", err
+	}
+	return entry.CurrVersion, nil
+}
+
+// UpdateSchemaVersion updates the schema version of the database
+func (c *mongoClient) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	_, err := c.db.Collection(cadence.SchemaVersionCollectionName).ReplaceOne(
+		ctx,
+		bson.D{{Key: "_id", Value: c.db.Name()}},
+		&cadence.SchemaVersionCollectionEntry{
+			ID:                   c.db.Name(),
+			CurrVersion:          newVersion,
+			MinCompatibleVersion: minCompatibleVersion,
+			CreationTime:         time.Now().UnixNano(),
+		},
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history
+func (c *mongoClient) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	_, err := c.db.Collection(cadence.SchemaUpdateHistoryCollectionName).InsertOne(ctx, &cadence.SchemaUpdateHistoryCollectionEntry{
+		UpdateTime:  time.Now().UnixNano(),
+		OldVersion:  oldVersion,
+		NewVersion:  newVersion,
+		ManifestMD5: manifestMD5,
+		Description: desc,
+	})
+	return err
+}
+
+// Close disconnects from the database
+func (c *mongoClient) Close() {
+	c.client.Disconnect(context.Background())
+}
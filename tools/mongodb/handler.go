@@ -0,0 +1,402 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	// md5 is only used for versioning the manifest of a schema change, same as the other schema tools
+	"crypto/md5" // #nosec
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+	"go.mongodb.org/mongo-driver/bson"
+
+	cliflag "github.com/uber/cadence/tools/common/flag"
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+type (
+	// SetupSchemaConfig contains the configuration of the setup-schema command
+	SetupSchemaConfig struct {
+		MongoClientConfig
+		schema.SetupConfig
+	}
+
+	// UpdateSchemaConfig contains the configuration of the update-schema command
+	UpdateSchemaConfig struct {
+		MongoClientConfig
+		schema.UpdateConfig
+	}
+
+	// manifest is the deserialized manifest.json file of a schema version directory,
+	// it shares the format with the other databases
+	manifest struct {
+		CurrVersion          string
+		MinCompatibleVersion string
+		Description          string
+		SchemaUpdateCqlFiles []string
+		md5                  string
+	}
+
+	// changeSet holds the commands to update the schema to a version
+	changeSet struct {
+		version  string
+		manifest *manifest
+		commands []bson.D
+	}
+)
+
+const manifestFileName = "manifest.json"
+
+var (
+	versionDirRegex = regexp.MustCompile(`^v(\d+)\.(\d+)$`)
+	versionRegex    = regexp.MustCompile(`^v?(\d+)\.(\d+)$`)
+)
+
+// SetupSchema sets up the MongoDB schema
+func SetupSchema(config *SetupSchemaConfig) error {
+	if err := validateSetupConfig(&config.SetupConfig); err != nil {
+		return err
+	}
+	db, err := newMongoClient(&config.MongoClientConfig)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return runSetupSchema(db, &config.SetupConfig)
+}
+
+// UpdateSchema updates the MongoDB schema to the target version
+func UpdateSchema(config *UpdateSchemaConfig) error {
+	if err := validateUpdateConfig(&config.UpdateConfig); err != nil {
+		return err
+	}
+	db, err := newMongoClient(&config.MongoClientConfig)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return runUpdateSchema(db, &config.UpdateConfig)
+}
+
+// setupSchema executes the setup-schema command using the given command line arguments as input
+func setupSchema(c *cli.Context) error {
+	config := &SetupSchemaConfig{
+		MongoClientConfig: newMongoClientConfig(c),
+		SetupConfig: schema.SetupConfig{
+			SchemaFilePath:    c.String(schema.CLIOptSchemaFile),
+			InitialVersion:    c.String(schema.CLIOptVersion),
+			DisableVersioning: c.Bool(schema.CLIOptDisableVersioning),
+			Overwrite:         c.Bool(schema.CLIOptOverwrite),
+		},
+	}
+	return SetupSchema(config)
+}
+
+// updateSchema executes the update-schema command using the given command line arguments as input
+func updateSchema(c *cli.Context) error {
+	schemaDir := c.String(schema.CLIOptSchemaDir)
+	if schemaDir == "" {
+		return schema.NewConfigError("missing " + flag(schema.CLIOptSchemaDir) + " argument")
+	}
+	config := &UpdateSchemaConfig{
+		MongoClientConfig: newMongoClientConfig(c),
+		UpdateConfig: schema.UpdateConfig{
+			DBName:        c.String(schema.CLIOptDatabase),
+			TargetVersion: c.String(schema.CLIOptTargetVersion),
+			SchemaFS:      os.DirFS(schemaDir),
+			IsDryRun:      c.Bool(schema.CLIOptDryrun),
+		},
+	}
+	return UpdateSchema(config)
+}
+
+func newMongoClientConfig(c *cli.Context) MongoClientConfig {
+	return MongoClientConfig{
+		Hosts:             c.String(schema.CLIOptEndpoint),
+		Port:              c.Int(schema.CLIOptPort),
+		User:              c.String(schema.CLIOptUser),
+		Password:          c.String(schema.CLIOptPassword),
+		Database:          c.String(schema.CLIOptDatabase),
+		ConnectAttributes: c.Generic(schema.CLIOptConnectAttributes).(*cliflag.StringMap).Value(),
+		Timeout:           c.Int(schema.CLIOptTimeout),
+	}
+}
+
+func validateSetupConfig(config *schema.SetupConfig) error {
+	if len(config.SchemaFilePath) == 0 && config.DisableVersioning {
+		return schema.NewConfigError("missing schemaFilePath " + flag(schema.CLIOptSchemaFile))
+	}
+	if config.DisableVersioning == (len(config.InitialVersion) > 0) {
+		return schema.NewConfigError("either " + flag(schema.CLIOptDisableVersioning) + " or " +
+			flag(schema.CLIOptVersion) + " but not both must be specified")
+	}
+	if !config.DisableVersioning {
+		ver, err := parseValidateVersion(config.InitialVersion)
+		if err != nil {
+			return schema.NewConfigError("invalid " + flag(schema.CLIOptVersion) + " argument:" + err.Error())
+		}
+		config.InitialVersion = ver
+	}
+	return nil
+}
+
+func validateUpdateConfig(config *schema.UpdateConfig) error {
+	if config.SchemaFS == nil {
+		return schema.NewConfigError("schema file system is not set")
+	}
+	if len(config.TargetVersion) > 0 {
+		ver, err := parseValidateVersion(config.TargetVersion)
+		if err != nil {
+			return schema.NewConfigError("invalid " + flag(schema.CLIOptTargetVersion) + " argument:" + err.Error())
+		}
+		config.TargetVersion = ver
+	}
+	return nil
+}
+
+func runSetupSchema(db *mongoClient, config *schema.SetupConfig) error {
+	log.Printf("Starting schema setup, config=%+v\n", config)
+
+	if config.Overwrite {
+		if err := db.DropAllTables(); err != nil {
+			return err
+		}
+	}
+
+	if !config.DisableVersioning {
+		log.Printf("Setting up version collections\n")
+		if err := db.CreateSchemaVersionTables(); err != nil {
+			return err
+		}
+	}
+
+	if len(config.SchemaFilePath) > 0 {
+		path := config.SchemaFilePath
+		commands, err := readCommands(os.DirFS(filepath.Dir(path)), filepath.Base(path))
+		if err != nil {
+			return err
+		}
+		log.Println("----- Creating collections and indexes -----")
+		if err := execCommands(db, commands); err != nil {
+			return err
+		}
+		log.Println("----- Done -----")
+	}
+
+	if !config.DisableVersioning {
+		log.Printf("Setting initial schema version to %v\n", config.InitialVersion)
+		if err := db.UpdateSchemaVersion(config.InitialVersion, config.InitialVersion); err != nil {
+			return err
+		}
+		log.Printf("Updating schema update log\n")
+		if err := db.WriteSchemaUpdateLog("0", config.InitialVersion, "", "initial version"); err != nil {
+			return err
+		}
+	}
+
+	log.Println("Schema setup complete")
+	return nil
+}
+
+func runUpdateSchema(db *mongoClient, config *schema.UpdateConfig) error {
+	log.Printf("UpdateSchemaTask started, config=%+v\n", config)
+
+	currVer, err := db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	updates, err := buildChangeSets(config.SchemaFS, currVer, config.TargetVersion)
+	if err != nil {
+		return err
+	}
+	if len(updates) == 0 {
+		log.Printf("found zero updates from current version %v", currVer)
+		return nil
+	}
+
+	for _, cs := range updates {
+		if config.IsDryRun {
+			log.Printf("DryRun of updating to version: %s, manifest: %+v \n", cs.version, cs.manifest)
+			for _, cmd := range cs.commands {
+				log.Printf("DryRun command:%v \n", cmd)
+			}
+			continue
+		}
+
+		log.Printf("---- Executing updates for version %v ----\n", cs.version)
+		if err := execCommands(db, cs.commands); err != nil {
+			return err
+		}
+		if err := db.UpdateSchemaVersion(cs.version, cs.manifest.MinCompatibleVersion); err != nil {
+			return fmt.Errorf("failed to update schema_version collection, err=%v", err.Error())
+		}
+		if err := db.WriteSchemaUpdateLog(currVer, cs.version, cs.manifest.md5, cs.manifest.Description); err != nil {
+			return fmt.Errorf("failed to add entry to schema_update_history, err=%v", err.Error())
+		}
+		log.Printf("Schema updated from %v to %v\n", currVer, cs.version)
+		currVer = cs.version
+	}
+
+	log.Printf("UpdateSchemaTask done\n")
+	return nil
+}
+
+func execCommands(db *mongoClient, commands []bson.D) error {
+	for _, cmd := range commands {
+		log.Println(cmd)
+		if err := db.execCommand(cmd); err != nil {
+			return fmt.Errorf("error executing command %v: %v", cmd, err)
+		}
+	}
+	return nil
+}
+
+// readCommands parses a file holding a JSON array of database commands
+func readCommands(fileSystem fs.FS, path string) ([]bson.D, error) {
+	data, err := fs.ReadFile(fileSystem, path)
+	if err != nil {
+		return nil, err
+	}
+	var commands []bson.D
+	if err := bson.UnmarshalExtJSON(data, false, &commands); err != nil {
+		return nil, fmt.Errorf("error parsing file %v, err=%v", path, err)
+	}
+	return commands, nil
+}
+
+// buildChangeSets returns the changes of the versions in the range currVer < ver <= targetVer in order,
+// all versions greater than currVer are returned when targetVer is empty
+func buildChangeSets(fileSystem fs.FS, currVer string, targetVer string) ([]changeSet, error) {
+	entries, err := fs.ReadDir(fileSystem, ".")
+	if err != nil {
+		return nil, fmt.Errorf("error listing schema dir:%v", err.Error())
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		if !entry.IsDir() || !versionDirRegex.MatchString(entry.Name()) {
+			continue
+		}
+		ver := entry.Name()[1:]
+		if cmpVersion(ver, currVer) > 0 && (targetVer == "" || cmpVersion(ver, targetVer) <= 0) {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		return cmpVersion(dirs[i][1:], dirs[j][1:]) < 0
+	})
+	if targetVer != "" && cmpVersion(targetVer, currVer) > 0 && (len(dirs) == 0 || dirs[len(dirs)-1][1:] != targetVer) {
+		return nil, fmt.Errorf("version dir not found for target version %v", targetVer)
+	}
+
+	result := make([]changeSet, 0, len(dirs))
+	for _, dir := range dirs {
+		m, err := readManifest(fileSystem, dir)
+		if err != nil {
+			return nil, fmt.Errorf("error processing manifest for version %v:%v", dir, err.Error())
+		}
+		if m.CurrVersion != dir[1:] {
+			return nil, fmt.Errorf("manifest version doesn't match with dirname, dir=%v,manifest.version=%v", dir, m.CurrVersion)
+		}
+		cs := changeSet{version: m.CurrVersion, manifest: m}
+		for _, file := range m.SchemaUpdateCqlFiles {
+			commands, err := readCommands(fileSystem, dir+"/"+file)
+			if err != nil {
+				return nil, err
+			}
+			cs.commands = append(cs.commands, commands...)
+		}
+		if len(cs.commands) == 0 {
+			return nil, fmt.Errorf("found 0 updates in dir %v", dir)
+		}
+		result = append(result, cs)
+	}
+	return result, nil
+}
+
+func readManifest(fileSystem fs.FS, dir string) (*manifest, error) {
+	data, err := fs.ReadFile(fileSystem, dir+"/"+manifestFileName)
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	if m.CurrVersion, err = parseValidateVersion(m.CurrVersion); err != nil {
+		return nil, fmt.Errorf("invalid CurrVersion in manifest")
+	}
+	if m.MinCompatibleVersion, err = parseValidateVersion(m.MinCompatibleVersion); err != nil {
+		return nil, fmt.Errorf("invalid MinCompatibleVersion in manifest")
+	}
+	if len(m.SchemaUpdateCqlFiles) == 0 {
+		return nil, fmt.Errorf("manifest missing SchemaUpdateCqlFiles")
+	}
+	// #nosec
+	md5Bytes := md5.Sum(data)
+	m.md5 = hex.EncodeToString(md5Bytes[:])
+	return &m, nil
+}
+
+// parseValidateVersion validates that the given input conforms to either of vx.x or x.x and returns x.x
+func parseValidateVersion(ver string) (string, error) {
+	if !versionRegex.MatchString(ver) {
+		return "", fmt.Errorf("invalid version, expected format is x.x")
+	}
+	if ver[0] == 'v' {
+		return ver[1:], nil
+	}
+	return ver, nil
+}
+
+// cmpVersion returns 0 if a == b, < 0 if a < b and > 0 if a > b
+func cmpVersion(a, b string) int {
+	aMajor, aMinor := parseVersion(a)
+	bMajor, bMinor := parseVersion(b)
+	if aMajor != bMajor {
+		return aMajor - bMajor
+	}
+	return aMinor - bMinor
+}
+
+func parseVersion(ver string) (int, int) {
+	matches := versionRegex.FindStringSubmatch(ver)
+	if matches == nil {
+		return 0, 0
+	}
+	major, _ := strconv.Atoi(matches[1])
+	minor, _ := strconv.Atoi(matches[2])
+	return major, minor
+}
+
+func flag(opt string) string {
+	return "(-" + opt + ")"
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/schema/mongodb"
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+func TestBuildChangeSets(t *testing.T) {
+	manifest := func(version string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(`{"CurrVersion": "` + version + `", "MinCompatibleVersion": "` + version + `", "SchemaUpdateCqlFiles": ["changes.json"]}`)}
+	}
+	changes := &fstest.MapFile{Data: []byte(`[{"create": "a"}, {"createIndexes": "a", "indexes": [{"key": {"x": 1}, "name": "x"}]}]`)}
+	fileSystem := fstest.MapFS{
+		"v0.1/manifest.json":  manifest("0.1"),
+		"v0.1/changes.json":   changes,
+		"v0.10/manifest.json": manifest("0.10"),
+		"v0.10/changes.json":  changes,
+		"v0.2/manifest.json":  manifest("0.2"),
+		"v0.2/changes.json":   changes,
+		"README.md":           &fstest.MapFile{},
+	}
+
+	tests := map[string]struct {
+		currVer   string
+		targetVer string
+		want      []string
+		wantErr   bool
+	}{
+		"all versions":           {currVer: "0.0", want: []string{"0.1", "0.2", "0.10"}},
+		"from current version":   {currVer: "0.1", want: []string{"0.2", "0.10"}},
+		"to target version":      {currVer: "0.0", targetVer: "0.2", want: []string{"0.1", "0.2"}},
+		"up to date":             {currVer: "0.10", want: []string{}},
+		"target version missing": {currVer: "0.0", targetVer: "0.3", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := buildChangeSets(fileSystem, tc.currVer, tc.targetVer)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			versions := make([]string, 0, len(result))
+			for _, cs := range result {
+				versions = append(versions, cs.version)
+				assert.Len(t, cs.commands, 2)
+				assert.Equal(t, "create", cs.commands[0][0].Key)
+				assert.NotEmpty(t, cs.manifest.md5)
+			}
+			assert.Equal(t, tc.want, versions)
+		})
+	}
+}
+
+func TestBuildChangeSets_SchemaDir(t *testing.T) {
+	result, err := buildChangeSets(os.DirFS("../../schema/mongodb/cadence/versioned"), "0.0", "")
+	require.NoError(t, err)
+	require.NotEmpty(t, result)
+	assert.Equal(t, mongodb.Version, result[len(result)-1].version)
+}
+
+func TestValidateSetupConfig(t *testing.T) {
+	tests := map[string]struct {
+		config  schema.SetupConfig
+		wantErr bool
+		wantVer string
+	}{
+		"missing version":         {config: schema.SetupConfig{SchemaFilePath: "schema.json"}, wantErr: true},
+		"missing schema file":     {config: schema.SetupConfig{DisableVersioning: true}, wantErr: true},
+		"version and no versions": {config: schema.SetupConfig{SchemaFilePath: "schema.json", InitialVersion: "0.1", DisableVersioning: true}, wantErr: true},
+		"invalid version":         {config: schema.SetupConfig{InitialVersion: "x"}, wantErr: true},
+		"valid":                   {config: schema.SetupConfig{SchemaFilePath: "schema.json", InitialVersion: "v0.1"}, wantVer: "0.1"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateSetupConfig(&tc.config)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantVer, tc.config.InitialVersion)
+		})
+	}
+}